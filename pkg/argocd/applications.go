@@ -2,11 +2,12 @@ package argocd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/argocd/argocdtypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,12 +32,12 @@ type ApplicationBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// used to store latest error message upon defining or mutating application definition.
-	errorMsg string
+	errorMsg error
 }
 
 // PullApplication pulls existing application into ApplicationBuilder struct.
 func PullApplication(apiClient *clients.Settings, name, nsname string) (*ApplicationBuilder, error) {
-	glog.V(100).Infof("Pulling existing Application name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing Application name %s under namespace %s from cluster", name, nsname)
 
 	builder := ApplicationBuilder{
 		apiClient: apiClient,
@@ -49,15 +50,15 @@ func PullApplication(apiClient *clients.Settings, name, nsname string) (*Applica
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the Application is empty")
+		logging.V(100).Infof("The name of the Application is empty")
 
-		builder.errorMsg = "Application 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Application 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the Application is empty")
+		logging.V(100).Infof("The namespace of the Application is empty")
 
-		builder.errorMsg = "Application 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Application 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -75,7 +76,7 @@ func (builder *ApplicationBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if argocd app %s exists in namespace %s",
+	logging.V(100).Infof("Checking if argocd app %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -90,7 +91,7 @@ func (builder *ApplicationBuilder) Get() (*argocdtypes.Application, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting argocd app %s in namespace %s",
+	logging.V(100).Infof("Getting argocd app %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	unsObject, err := builder.apiClient.Resource(
@@ -98,8 +99,8 @@ func (builder *ApplicationBuilder) Get() (*argocdtypes.Application, error) {
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to Get Application object in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+		logging.V(100).Infof(
+			"Failed to Get Application object %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
 	}
@@ -113,13 +114,13 @@ func (builder *ApplicationBuilder) Update(force bool) (*ApplicationBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the argocd application object %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Updating the argocd application object %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	unstructuredApplication, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured Application to unstructured object")
+		logging.V(100).Infof("Failed to convert structured Application to unstructured object")
 
 		return nil, err
 	}
@@ -130,13 +131,13 @@ func (builder *ApplicationBuilder) Update(force bool) (*ApplicationBuilder, erro
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("Application", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("Application", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -155,7 +156,7 @@ func (builder *ApplicationBuilder) Delete() (*ApplicationBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the argocd application object %s from namespace: %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting the argocd application object %s from namespace: %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	err := builder.apiClient.Resource(
@@ -177,7 +178,7 @@ func (builder *ApplicationBuilder) Create() (*ApplicationBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating argocd application %s in namespace: %s", builder.Definition.Name,
+	logging.V(100).Infof("Creating argocd application %s in namespace: %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	var err error
@@ -185,7 +186,7 @@ func (builder *ApplicationBuilder) Create() (*ApplicationBuilder, error) {
 		unstructuredApplication, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured Application to unstructured object")
+			logging.V(100).Infof("Failed to convert structured Application to unstructured object")
 
 			return nil, err
 		}
@@ -195,7 +196,7 @@ func (builder *ApplicationBuilder) Create() (*ApplicationBuilder, error) {
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredApplication}, metav1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create Application")
+			logging.V(100).Infof("Failed to create Application")
 
 			return nil, err
 		}
@@ -223,27 +224,29 @@ func (builder *ApplicationBuilder) validate() (bool, error) {
 	resourceCRD := "Application"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -256,30 +259,30 @@ func (builder *ApplicationBuilder) WithGitDetails(gitRepo, gitBranch, gitPath st
 	}
 
 	if gitRepo == "" {
-		glog.V(100).Infof("The 'gitRepo' of the argocd application is empty")
+		logging.V(100).Infof("The 'gitRepo' of the argocd application is empty")
 
-		builder.errorMsg = "'gitRepo' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'gitRepo' parameter is empty"))
 	}
 
 	if gitBranch == "" {
-		glog.V(100).Infof("The 'gitBranch' of the argocd application is empty")
+		logging.V(100).Infof("The 'gitBranch' of the argocd application is empty")
 
-		builder.errorMsg = "'gitBranch' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'gitBranch' parameter is empty"))
 	}
 
 	if gitPath == "" {
-		glog.V(100).Infof("The 'gitPath' of the argocd application is empty")
+		logging.V(100).Infof("The 'gitPath' of the argocd application is empty")
 
-		builder.errorMsg = "'gitPath' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'gitPath' parameter is empty"))
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding the following git details to the argocd application: %s in namespace: %s "+
 			"RepoURL: %s,TargetRevision: %s, Path: %s", builder.Definition.Name, builder.Definition.Namespace,
 		gitRepo, gitBranch, gitPath,
 	)
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -296,8 +299,8 @@ func (builder *ApplicationBuilder) convertToStructured(
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, application)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to Application object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to Application object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
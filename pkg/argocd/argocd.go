@@ -2,11 +2,12 @@ package argocd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	argocdoperatorv1alpha1 "github.com/argoproj-labs/argocd-operator/api/v1alpha1"
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,7 +24,7 @@ type Builder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// used to store latest error message upon defining the argocd definition.
-	errorMsg string
+	errorMsg error
 }
 
 // NewBuilder creates a new instance of Builder.
@@ -40,15 +41,15 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the argocd is empty")
+		logging.V(100).Infof("The name of the argocd is empty")
 
-		builder.errorMsg = "argocd 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("argocd 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the argocd is empty")
+		logging.V(100).Infof("The namespace of the argocd is empty")
 
-		builder.errorMsg = "argocd 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("argocd 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -56,7 +57,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull pulls existing argocd from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing argocd name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing argocd name %s under namespace %s from cluster", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -69,15 +70,15 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the argocd is empty")
+		logging.V(100).Infof("The name of the argocd is empty")
 
-		builder.errorMsg = "argocd 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("argocd 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the argocd is empty")
+		logging.V(100).Infof("The namespace of the argocd is empty")
 
-		builder.errorMsg = "argocd 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("argocd 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -95,7 +96,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if argocd %s exists in namespace %s",
+	logging.V(100).Infof("Checking if argocd %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -110,7 +111,7 @@ func (builder *Builder) Get() (*argocdoperatorv1alpha1.ArgoCD, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting argocd %s in namespace %s",
+	logging.V(100).Infof("Getting argocd %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	argocd := &argocdoperatorv1alpha1.ArgoCD{}
@@ -132,7 +133,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the argocd %s in namespace %s",
+	logging.V(100).Infof("Creating the argocd %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -152,7 +153,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the argocd %s in namespace %s",
+	logging.V(100).Infof("Deleting the argocd %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -176,19 +177,19 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the argocd object", builder.Definition.Name)
+	logging.V(100).Infof("Updating the argocd object %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("argocd", builder.Definition.Name))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("argocd", builder.Definition.Name))
 
 				return nil, err
@@ -207,27 +208,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "argocds"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
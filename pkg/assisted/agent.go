@@ -2,12 +2,14 @@ package assisted
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift/assisted-service/api/common"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/models"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,7 +19,8 @@ import (
 )
 
 const (
-	nonExistentMsg = "Cannot update non-existent agent"
+	nonExistentMsg           = "Cannot update non-existent agent"
+	agentValidationSucceeded = "success"
 )
 
 // agentBuilder provides struct for the agent object containing connection to
@@ -25,7 +28,7 @@ const (
 type agentBuilder struct {
 	Definition *agentInstallV1Beta1.Agent
 	Object     *agentInstallV1Beta1.Agent
-	errorMsg   string
+	errorMsg   error
 	apiClient  *clients.Settings
 }
 
@@ -39,7 +42,7 @@ func newAgentBuilder(apiClient *clients.Settings, definition *agentInstallV1Beta
 		return nil
 	}
 
-	glog.V(100).Infof("Initializing new agent structure for the following agent %s",
+	logging.V(100).Infof("Initializing new agent structure for the following agent %s",
 		definition.Name)
 
 	builder := agentBuilder{
@@ -53,7 +56,7 @@ func newAgentBuilder(apiClient *clients.Settings, definition *agentInstallV1Beta
 
 // PullAgent pulls existing agent from cluster.
 func PullAgent(apiClient *clients.Settings, name, nsname string) (*agentBuilder, error) {
-	glog.V(100).Infof("Pulling existing agent name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing agent name %s under namespace %s from cluster", name, nsname)
 
 	builder := agentBuilder{
 		apiClient: apiClient,
@@ -66,15 +69,15 @@ func PullAgent(apiClient *clients.Settings, name, nsname string) (*agentBuilder,
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the agent is empty")
+		logging.V(100).Infof("The name of the agent is empty")
 
-		builder.errorMsg = "agent 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agent 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the agent is empty")
+		logging.V(100).Infof("The namespace of the agent is empty")
 
-		builder.errorMsg = "agent 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agent 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -86,23 +89,65 @@ func PullAgent(apiClient *clients.Settings, name, nsname string) (*agentBuilder,
 	return &builder, nil
 }
 
+// ListAgentsByInfraEnv returns agentBuilders of all the agents which booted the discovery ISO of the given infraenv.
+func ListAgentsByInfraEnv(apiClient *clients.Settings, infraEnvName, nsname string) ([]*agentBuilder, error) {
+	logging.V(100).Infof("Listing agents of infraenv %s in namespace %s", infraEnvName, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("agent 'apiClient' cannot be empty")
+	}
+
+	if infraEnvName == "" {
+		logging.V(100).Infof("The name of the infraenv is empty")
+
+		return nil, fmt.Errorf("infraenv 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the infraenv is empty")
+
+		return nil, fmt.Errorf("infraenv 'namespace' cannot be empty")
+	}
+
+	var agents agentInstallV1Beta1.AgentList
+
+	err := apiClient.List(context.TODO(), &agents,
+		goclient.InNamespace(nsname), goclient.MatchingLabels{agentInfraEnvLabel: infraEnvName})
+	if err != nil {
+		logging.V(100).Infof("Failed to list agents of infraenv %s in namespace %s: %v", infraEnvName, nsname, err)
+
+		return nil, err
+	}
+
+	var agentBuilders []*agentBuilder
+
+	for _, agent := range agents.Items {
+		copiedAgent := agent
+		agentBuilders = append(agentBuilders, newAgentBuilder(apiClient, &copiedAgent))
+	}
+
+	return agentBuilders, nil
+}
+
 // WithHostName sets the hostname of the agent resource.
 func (builder *agentBuilder) WithHostName(hostname string) *agentBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s hostname to %s",
+	logging.V(100).Infof("Setting agent %s in namespace %s hostname to %s",
 		builder.Definition.Name, builder.Definition.Namespace, hostname)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("agent %s in namespace %s does not exist",
+		logging.V(100).Infof("agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = nonExistentMsg
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(nonExistentMsg))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -117,17 +162,28 @@ func (builder *agentBuilder) WithRole(role string) *agentBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s to role %s",
+	logging.V(100).Infof("Setting agent %s in namespace %s to role %s",
 		builder.Definition.Name, builder.Definition.Namespace, role)
 
+	switch models.HostRole(role) {
+	case models.HostRoleMaster, models.HostRoleWorker, models.HostRoleAutoAssign:
+	default:
+		logging.V(100).Infof("The agent role %s is not supported", role)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("agent role %s is not supported, must be master, worker or auto-assign", role))
+
+		return builder
+	}
+
 	if !builder.Exists() {
-		glog.V(100).Infof("agent %s in namespace %s does not exist",
+		logging.V(100).Infof("agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = nonExistentMsg
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(nonExistentMsg))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -142,7 +198,7 @@ func (builder *agentBuilder) WithInstallationDisk(diskID string) *agentBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s installation disk id to %s",
+	logging.V(100).Infof("Setting agent %s in namespace %s installation disk id to %s",
 		builder.Definition.Name, builder.Definition.Namespace, diskID)
 
 	builder.Definition.Spec.InstallationDiskID = diskID
@@ -156,7 +212,7 @@ func (builder *agentBuilder) WithIgnitionConfigOverride(override string) *agentB
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s ignitionConfigOverride to %s",
+	logging.V(100).Infof("Setting agent %s in namespace %s ignitionConfigOverride to %s",
 		builder.Definition.Name, builder.Definition.Namespace, override)
 
 	builder.Definition.Spec.IgnitionConfigOverrides = override
@@ -170,7 +226,7 @@ func (builder *agentBuilder) WithApproval(approved bool) *agentBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent %s in namespace %s approval to %v",
+	logging.V(100).Infof("Setting agent %s in namespace %s approval to %v",
 		builder.Definition.Name, builder.Definition.Namespace, approved)
 
 	builder.Definition.Spec.Approved = approved
@@ -178,13 +234,92 @@ func (builder *agentBuilder) WithApproval(approved bool) *agentBuilder {
 	return builder
 }
 
+// WithClusterDeploymentRef binds the agent to the given clusterdeployment, making it part of its installation.
+func (builder *agentBuilder) WithClusterDeploymentRef(name, nsname string) *agentBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Binding agent %s in namespace %s to clusterdeployment %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace, name, nsname)
+
+	if name == "" || nsname == "" {
+		logging.V(100).Infof("The name or namespace of the agent clusterdeployment is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("agent clusterdeployment 'name' and 'namespace' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterDeploymentName = &agentInstallV1Beta1.ClusterReference{
+		Name:      name,
+		Namespace: nsname,
+	}
+
+	return builder
+}
+
+// Approve approves the agent on the cluster, allowing it to be installed.
+func (builder *agentBuilder) Approve() (*agentBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Approving agent %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.WithApproval(true).Update()
+}
+
+// WaitForValidation waits the specified timeout for the given host validation, such as has-inventory or
+// ntp-synced, to succeed. On timeout, the last status and message of the validation are included in the error.
+func (builder *agentBuilder) WaitForValidation(validationID string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for validation %s of agent %s in namespace %s to succeed",
+		validationID, builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastResult *common.ValidationResult
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			for _, results := range builder.Object.Status.ValidationsInfo {
+				for index := range results {
+					if results[index].ID == validationID {
+						lastResult = &results[index]
+
+						return lastResult.Status == agentValidationSucceeded, nil
+					}
+				}
+			}
+
+			return false, nil
+		})
+
+	if err != nil && lastResult != nil {
+		return fmt.Errorf("validation %s of agent %s is %s: %s: %w",
+			validationID, builder.Definition.Name, lastResult.Status, lastResult.Message, err)
+	}
+
+	return err
+}
+
 // WaitForState waits the specified timeout for the agent to report the specified state.
 func (builder *agentBuilder) WaitForState(state string, timeout time.Duration) (*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for agent %s in namespace %s to report state %s",
+	logging.V(100).Infof("Waiting for agent %s in namespace %s to report state %s",
 		builder.Definition.Name, builder.Definition.Namespace, state)
 
 	// Polls every retryInterval to determine if agent is in desired state.
@@ -213,7 +348,7 @@ func (builder *agentBuilder) WaitForStateInfo(stateInfo string, timeout time.Dur
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for agent %s in namespace %s to report stateInfo %s",
+	logging.V(100).Infof("Waiting for agent %s in namespace %s to report stateInfo %s",
 		builder.Definition.Name, builder.Definition.Namespace, stateInfo)
 
 	// Polls every retryInterval to determine if agent is in desired state.
@@ -242,16 +377,16 @@ func (builder *agentBuilder) WithOptions(options ...AgentAdditionalOptions) *age
 		return builder
 	}
 
-	glog.V(100).Infof("Setting agent additional options")
+	logging.V(100).Infof("Setting agent additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -267,7 +402,7 @@ func (builder *agentBuilder) Get() (*agentInstallV1Beta1.Agent, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent %s in namespace %s",
+	logging.V(100).Infof("Getting agent %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	agent := &agentInstallV1Beta1.Agent{}
@@ -291,18 +426,18 @@ func (builder *agentBuilder) Update() (*agentBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating agent %s in namespace %s",
+	logging.V(100).Infof("Updating agent %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("agent %s in namespace %s does not exist",
+		logging.V(100).Infof("agent %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = nonExistentMsg
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(nonExistentMsg))
 	}
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
@@ -319,7 +454,7 @@ func (builder *agentBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if agent %s exists in namespace %s",
+	logging.V(100).Infof("Checking if agent %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -334,7 +469,7 @@ func (builder *agentBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the agent %s in namespace %s",
+	logging.V(100).Infof("Deleting the agent %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -358,27 +493,29 @@ func (builder *agentBuilder) validate() (bool, error) {
 	resourceCRD := "Agent"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,252 @@
+package assisted
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift/assisted-service/api/common"
+	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultAgentName = "test-agent"
+
+func TestListAgentsByInfraEnv(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		infraEnvName  string
+		nsname        string
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			client:        true,
+			infraEnvName:  defaultInfraEnvName,
+			nsname:        defaultInfraEnvNamespace,
+			expectedNames: []string{defaultAgentName},
+			expectedError: "",
+		},
+		{
+			client:        true,
+			infraEnvName:  "other-infraenv",
+			nsname:        defaultInfraEnvNamespace,
+			expectedNames: nil,
+			expectedError: "",
+		},
+		{
+			client:        true,
+			infraEnvName:  "",
+			nsname:        defaultInfraEnvNamespace,
+			expectedError: "infraenv 'name' cannot be empty",
+		},
+		{
+			client:        true,
+			infraEnvName:  defaultInfraEnvName,
+			nsname:        "",
+			expectedError: "infraenv 'namespace' cannot be empty",
+		},
+		{
+			client:        false,
+			infraEnvName:  defaultInfraEnvName,
+			nsname:        defaultInfraEnvNamespace,
+			expectedError: "agent 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+				buildDummyAgent(defaultAgentName, defaultInfraEnvName),
+				buildDummyAgent("agent-of-other-infraenv", "another-infraenv"),
+			}})
+		}
+
+		agentBuilders, err := ListAgentsByInfraEnv(testSettings, testCase.infraEnvName, testCase.nsname)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			var agentNames []string
+
+			for _, agentBuilder := range agentBuilders {
+				agentNames = append(agentNames, agentBuilder.Definition.Name)
+			}
+
+			assert.Equal(t, testCase.expectedNames, agentNames)
+		}
+	}
+}
+
+func TestAgentWithRole(t *testing.T) {
+	testCases := []struct {
+		role                string
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			role:                "master",
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			role:                "auto-assign",
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			role:                "bootstrap",
+			addToRuntimeObjects: true,
+			expectedError:       "agent role bootstrap is not supported, must be master, worker or auto-assign",
+		},
+		{
+			role:                "worker",
+			addToRuntimeObjects: false,
+			expectedError:       nonExistentMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyAgent(defaultAgentName, defaultInfraEnvName))
+		}
+
+		testBuilder := newAgentBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}),
+			buildDummyAgent(defaultAgentName, defaultInfraEnvName)).WithRole(testCase.role)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, models.HostRole(testCase.role), testBuilder.Definition.Spec.Role)
+		}
+	}
+}
+
+func TestAgentWithClusterDeploymentRef(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		expectedError string
+	}{
+		{
+			name:          "test-cluster-deployment",
+			nsname:        defaultInfraEnvNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultInfraEnvNamespace,
+			expectedError: "agent clusterdeployment 'name' and 'namespace' cannot be empty",
+		},
+		{
+			name:          "test-cluster-deployment",
+			nsname:        "",
+			expectedError: "agent clusterdeployment 'name' and 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := newAgentBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			buildDummyAgent(defaultAgentName, defaultInfraEnvName)).
+			WithClusterDeploymentRef(testCase.name, testCase.nsname)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, &agentInstallV1Beta1.ClusterReference{Name: testCase.name, Namespace: testCase.nsname},
+				testBuilder.Definition.Spec.ClusterDeploymentName)
+		}
+	}
+}
+
+func TestAgentApprove(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       nonExistentMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyAgent(defaultAgentName, defaultInfraEnvName))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		testBuilder := newAgentBuilder(testSettings, buildDummyAgent(defaultAgentName, defaultInfraEnvName))
+
+		if testCase.addToRuntimeObjects {
+			var err error
+
+			testBuilder, err = PullAgent(testSettings, defaultAgentName, defaultInfraEnvNamespace)
+			assert.Nil(t, err)
+		}
+
+		testBuilder, err := testBuilder.Approve()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			agent, err := testBuilder.Get()
+			assert.Nil(t, err)
+			assert.True(t, agent.Spec.Approved)
+		}
+	}
+}
+
+func TestAgentWaitForValidation(t *testing.T) {
+	testCases := []struct {
+		validations   common.ValidationsStatus
+		expectedError string
+	}{
+		{
+			validations: common.ValidationsStatus{"network": {{
+				ID: "ntp-synced", Status: agentValidationSucceeded, Message: "Host NTP is synced",
+			}}},
+			expectedError: "",
+		},
+		{
+			validations: common.ValidationsStatus{"network": {{
+				ID: "ntp-synced", Status: "failure", Message: "Host couldn't synchronize with any NTP server",
+			}}},
+			expectedError: fmt.Sprintf("validation ntp-synced of agent %s is failure: "+
+				"Host couldn't synchronize with any NTP server: context deadline exceeded", defaultAgentName),
+		},
+		{
+			validations:   nil,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		agent := buildDummyAgent(defaultAgentName, defaultInfraEnvName)
+		agent.Status.ValidationsInfo = testCase.validations
+
+		testBuilder := newAgentBuilder(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{agent}}),
+			buildDummyAgent(defaultAgentName, defaultInfraEnvName))
+
+		err := testBuilder.WaitForValidation("ntp-synced", time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildDummyAgent(name, infraEnvName string) *agentInstallV1Beta1.Agent {
+	return &agentInstallV1Beta1.Agent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultInfraEnvNamespace,
+			Labels:    map[string]string{agentInfraEnvLabel: infraEnvName},
+		},
+	}
+}
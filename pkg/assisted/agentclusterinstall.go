@@ -4,14 +4,15 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	"github.com/openshift/assisted-service/models"
@@ -28,7 +29,7 @@ import (
 type AgentClusterInstallBuilder struct {
 	Definition *hiveextV1Beta1.AgentClusterInstall
 	Object     *hiveextV1Beta1.AgentClusterInstall
-	errorMsg   string
+	errorMsg   error
 	apiClient  *clients.Settings
 }
 
@@ -65,21 +66,22 @@ func NewAgentClusterInstallBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the agentclusterinstall is empty")
+		logging.V(100).Infof("The name of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the agentclusterinstall is empty")
+		logging.V(100).Infof("The namespace of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall 'namespace' cannot be empty"))
 	}
 
 	if clusterDeployment == "" {
-		glog.V(100).Infof("The clusterDeployment ref for the agentclusterinstall is empty")
+		logging.V(100).Infof("The clusterDeployment ref for the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'clusterDeployment' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"agentclusterinstall 'clusterDeployment' cannot be empty"))
 	}
 
 	return &builder
@@ -92,12 +94,12 @@ func (builder *AgentClusterInstallBuilder) WithAPIVip(apiVIP string) *AgentClust
 	}
 
 	if net.ParseIP(apiVIP) == nil {
-		glog.V(100).Infof("The apiVIP is not a properly formatted IP address")
+		logging.V(100).Infof("The apiVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall apiVIP incorrectly formatted"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall apiVIP incorrectly formatted"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -113,12 +115,12 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalAPIVip(apiVIP string) *
 	}
 
 	if net.ParseIP(apiVIP) == nil {
-		glog.V(100).Infof("The apiVIP is not a properly formatted IP address")
+		logging.V(100).Infof("The apiVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall apiVIP incorrectly formatted"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall apiVIP incorrectly formatted"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -134,12 +136,12 @@ func (builder *AgentClusterInstallBuilder) WithIngressVip(ingressVIP string) *Ag
 	}
 
 	if net.ParseIP(ingressVIP) == nil {
-		glog.V(100).Infof("The ingressVIP is not a properly formatted IP address")
+		logging.V(100).Infof("The ingressVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall ingressVIP incorrectly formatted"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall ingressVIP incorrectly formatted"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -155,12 +157,12 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalIngressVip(ingressVIP s
 	}
 
 	if net.ParseIP(ingressVIP) == nil {
-		glog.V(100).Infof("The ingressVIP is not a properly formatted IP address")
+		logging.V(100).Infof("The ingressVIP is not a properly formatted IP address")
 
-		builder.errorMsg = "agentclusterinstall ingressVIP incorrectly formatted"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall ingressVIP incorrectly formatted"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -180,13 +182,25 @@ func (builder *AgentClusterInstallBuilder) WithUserManagedNetworking(enabled boo
 	return builder
 }
 
-// WithPlatformType sets platformType field (Supported values: "", None, BareMetal, VSphere).
+// WithPlatformType sets platformType field (Supported values: "", None, BareMetal, VSphere, Nutanix, External).
 func (builder *AgentClusterInstallBuilder) WithPlatformType(
 	platform hiveextV1Beta1.PlatformType) *AgentClusterInstallBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
+	switch platform {
+	case "", hiveextV1Beta1.NonePlatformType, hiveextV1Beta1.BareMetalPlatformType, hiveextV1Beta1.VSpherePlatformType,
+		hiveextV1Beta1.NutanixPlatformType, hiveextV1Beta1.ExternalPlatformType:
+	default:
+		logging.V(100).Infof("The agentclusterinstall platformType %s is not supported", platform)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("agentclusterinstall platformType %s is not supported", platform))
+
+		return builder
+	}
+
 	builder.Definition.Spec.PlatformType = platform
 
 	return builder
@@ -256,12 +270,12 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalClusterNetwork(
 	}
 
 	if _, _, err := net.ParseCIDR(cidr); err != nil {
-		glog.V(100).Infof("The agentclusterinstall passed invalid clusterNetwork cidr: %s", cidr)
+		logging.V(100).Infof("The agentclusterinstall passed invalid clusterNetwork cidr: %s", cidr)
 
-		builder.errorMsg = "Got invalid cidr for clusternetwork"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Got invalid cidr for clusternetwork"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -279,12 +293,12 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalServiceNetwork(cidr str
 	}
 
 	if _, _, err := net.ParseCIDR(cidr); err != nil {
-		glog.V(100).Infof("The agentclusterinstall passed invalid serviceNetwork cidr: %s", cidr)
+		logging.V(100).Infof("The agentclusterinstall passed invalid serviceNetwork cidr: %s", cidr)
 
-		builder.errorMsg = "Got invalid cidr for servicenetwork"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Got invalid cidr for servicenetwork"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -293,7 +307,31 @@ func (builder *AgentClusterInstallBuilder) WithAdditionalServiceNetwork(cidr str
 	return builder
 }
 
-// WaitForState will wait the defined timeout for the agentclusterinstall to have the defined state.
+// WithAdditionalMachineNetwork appends additional machine networks the nodes of the cluster are addressed from.
+func (builder *AgentClusterInstallBuilder) WithAdditionalMachineNetwork(cidr string) *AgentClusterInstallBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		logging.V(100).Infof("The agentclusterinstall passed invalid machineNetwork cidr: %s", cidr)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Got invalid cidr for machinenetwork"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Networking.MachineNetwork = append(builder.Definition.Spec.Networking.MachineNetwork,
+		hiveextV1Beta1.MachineNetworkEntry{CIDR: cidr})
+
+	return builder
+}
+
+// WaitForState will wait the defined timeout for the agentclusterinstall to have the defined state. Unless the
+// defined state is the error state, it fails early with the message of the Failed condition once the installation
+// fails.
 func (builder *AgentClusterInstallBuilder) WaitForState(
 	state string,
 	timeout time.Duration) (*AgentClusterInstallBuilder, error) {
@@ -311,7 +349,20 @@ func (builder *AgentClusterInstallBuilder) WaitForState(
 				return false, nil
 			}
 
-			return builder.Object.Status.DebugInfo.State == state, err
+			if builder.Object.Status.DebugInfo.State == state {
+				return true, nil
+			}
+
+			if state != models.ClusterStatusError {
+				for _, condition := range builder.Object.Status.Conditions {
+					if condition.Type == hiveextV1Beta1.ClusterFailedCondition && condition.Status == corev1.ConditionTrue {
+						return false, fmt.Errorf("agentclusterinstall %s in namespace %s failed: %s",
+							builder.Definition.Name, builder.Definition.Namespace, condition.Message)
+					}
+				}
+			}
+
+			return false, nil
 		})
 
 	if err == nil {
@@ -356,16 +407,16 @@ func (builder *AgentClusterInstallBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting AgentClusterInstall additional options")
+	logging.V(100).Infof("Setting AgentClusterInstall additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -423,7 +474,7 @@ func (builder *AgentClusterInstallBuilder) GetEvents(skipCertVerify bool) (model
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting cluster events from agentclusterinstall %s in namespace %s",
+	logging.V(100).Infof("Getting cluster events from agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -434,7 +485,7 @@ func (builder *AgentClusterInstallBuilder) GetEvents(skipCertVerify bool) (model
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: skipCertVerify}}}
 
-	glog.V(100).Infof("Getting events from url: %s", builder.Object.Status.DebugInfo.EventsURL)
+	logging.V(100).Infof("Getting events from url: %s", builder.Object.Status.DebugInfo.EventsURL)
 
 	res, err := client.Get(builder.Object.Status.DebugInfo.EventsURL)
 	if err != nil {
@@ -448,7 +499,7 @@ func (builder *AgentClusterInstallBuilder) GetEvents(skipCertVerify bool) (model
 		return nil, err
 	}
 
-	glog.V(100).Infof("Creating EventList from returned events")
+	logging.V(100).Infof("Creating EventList from returned events")
 
 	var events models.EventList
 
@@ -466,7 +517,7 @@ func (builder *AgentClusterInstallBuilder) Get() (*hiveextV1Beta1.AgentClusterIn
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agentclusterinstall %s in namespace %s",
+	logging.V(100).Infof("Getting agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	agentClusterInstall := &hiveextV1Beta1.AgentClusterInstall{}
@@ -485,7 +536,7 @@ func (builder *AgentClusterInstallBuilder) Get() (*hiveextV1Beta1.AgentClusterIn
 
 // PullAgentClusterInstall pulls existing agentclusterinstall from cluster.
 func PullAgentClusterInstall(apiClient *clients.Settings, name, nsname string) (*AgentClusterInstallBuilder, error) {
-	glog.V(100).Infof("Pulling existing agentclusterinstall name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing agentclusterinstall name %s under namespace %s from cluster", name, nsname)
 
 	builder := AgentClusterInstallBuilder{
 		apiClient: apiClient,
@@ -498,15 +549,15 @@ func PullAgentClusterInstall(apiClient *clients.Settings, name, nsname string) (
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the agentclusterinstall is empty")
+		logging.V(100).Infof("The name of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the agentclusterinstall is empty")
+		logging.V(100).Infof("The namespace of the agentclusterinstall is empty")
 
-		builder.errorMsg = "agentclusterinstall 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentclusterinstall 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -524,7 +575,7 @@ func (builder *AgentClusterInstallBuilder) Create() (*AgentClusterInstallBuilder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the agentclusterinstall %s in namespace %s",
+	logging.V(100).Infof("Creating the agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -544,22 +595,22 @@ func (builder *AgentClusterInstallBuilder) Update(force bool) (*AgentClusterInst
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating agentclusterinstall %s in namespace %s",
+	logging.V(100).Infof("Updating agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
-		builder.errorMsg = "Cannot update non-existent agentclusterinstall"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Cannot update non-existent agentclusterinstall"))
 	}
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("agentclusterinstall", builder.Definition.Name, builder.Definition.Namespace))
 
 			err = builder.DeleteAndWait(time.Second * 10)
@@ -567,7 +618,7 @@ func (builder *AgentClusterInstallBuilder) Update(force bool) (*AgentClusterInst
 			// fmt.Printf("agentclusterinstall exists: %v\n", builder.Exists())
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("agentclusterinstall", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -590,7 +641,7 @@ func (builder *AgentClusterInstallBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the agentclusterinstall %s in namespace %s",
+	logging.V(100).Infof("Deleting the agentclusterinstall %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -614,7 +665,7 @@ func (builder *AgentClusterInstallBuilder) DeleteAndWait(timeout time.Duration)
 		return err
 	}
 
-	glog.V(100).Infof(`Deleting agentclusterinstall %s in namespace %s and 
+	logging.V(100).Infof(`Deleting agentclusterinstall %s in namespace %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -640,7 +691,7 @@ func (builder *AgentClusterInstallBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if agentclusterinstall %s exists in namespace %s",
+	logging.V(100).Infof("Checking if agentclusterinstall %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -690,27 +741,29 @@ func (builder *AgentClusterInstallBuilder) validate() (bool, error) {
 	resourceCRD := "AgentClusterInstall"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
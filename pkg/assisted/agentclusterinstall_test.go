@@ -0,0 +1,165 @@
+package assisted
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	hiveV1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultAgentClusterInstallName      = "test-aci"
+	defaultAgentClusterInstallNamespace = "test-ns"
+)
+
+func TestAgentClusterInstallWithPlatformType(t *testing.T) {
+	testCases := []struct {
+		platform      hiveextV1Beta1.PlatformType
+		expectedError string
+	}{
+		{
+			platform:      "",
+			expectedError: "",
+		},
+		{
+			platform:      hiveextV1Beta1.BareMetalPlatformType,
+			expectedError: "",
+		},
+		{
+			platform:      hiveextV1Beta1.NutanixPlatformType,
+			expectedError: "",
+		},
+		{
+			platform:      hiveextV1Beta1.ExternalPlatformType,
+			expectedError: "",
+		},
+		{
+			platform:      "AWS",
+			expectedError: "agentclusterinstall platformType AWS is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidAgentClusterInstallBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithPlatformType(testCase.platform)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.platform, testBuilder.Definition.Spec.PlatformType)
+		}
+	}
+}
+
+func TestAgentClusterInstallWithAdditionalMachineNetwork(t *testing.T) {
+	testCases := []struct {
+		cidr          string
+		expectedError string
+	}{
+		{
+			cidr:          "192.168.10.0/24",
+			expectedError: "",
+		},
+		{
+			cidr:          "fd2e:6f44:5dd8:c956::/64",
+			expectedError: "",
+		},
+		{
+			cidr:          "192.168.10.0",
+			expectedError: "Got invalid cidr for machinenetwork",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidAgentClusterInstallBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithAdditionalMachineNetwork(testCase.cidr)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, []hiveextV1Beta1.MachineNetworkEntry{{CIDR: testCase.cidr}},
+				testBuilder.Definition.Spec.Networking.MachineNetwork)
+		}
+	}
+}
+
+func TestAgentClusterInstallWaitForState(t *testing.T) {
+	testCases := []struct {
+		currentState  string
+		failed        bool
+		state         string
+		expectedError string
+	}{
+		{
+			currentState:  models.ClusterStatusInstalled,
+			failed:        false,
+			state:         models.ClusterStatusInstalled,
+			expectedError: "",
+		},
+		{
+			currentState:  models.ClusterStatusInstalling,
+			failed:        false,
+			state:         models.ClusterStatusInstalled,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			currentState: models.ClusterStatusError,
+			failed:       true,
+			state:        models.ClusterStatusInstalled,
+			expectedError: fmt.Sprintf("agentclusterinstall %s in namespace %s failed: installation timed out",
+				defaultAgentClusterInstallName, defaultAgentClusterInstallNamespace),
+		},
+		{
+			currentState:  models.ClusterStatusError,
+			failed:        true,
+			state:         models.ClusterStatusError,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidAgentClusterInstallBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyAgentClusterInstall(testCase.currentState, testCase.failed)},
+		}))
+
+		testBuilder, err := testBuilder.WaitForState(testCase.state, time.Second)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.state, testBuilder.Object.Status.DebugInfo.State)
+		}
+	}
+}
+
+func buildValidAgentClusterInstallBuilder(apiClient *clients.Settings) *AgentClusterInstallBuilder {
+	return NewAgentClusterInstallBuilder(apiClient, defaultAgentClusterInstallName, defaultAgentClusterInstallNamespace,
+		"test-cluster-deployment", 3, 0, hiveextV1Beta1.Networking{})
+}
+
+func buildDummyAgentClusterInstall(state string, failed bool) *hiveextV1Beta1.AgentClusterInstall {
+	failedStatus := corev1.ConditionFalse
+
+	if failed {
+		failedStatus = corev1.ConditionTrue
+	}
+
+	return &hiveextV1Beta1.AgentClusterInstall{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultAgentClusterInstallName,
+			Namespace: defaultAgentClusterInstallNamespace,
+		},
+		Status: hiveextV1Beta1.AgentClusterInstallStatus{
+			DebugInfo: hiveextV1Beta1.DebugInfo{State: state},
+			Conditions: []hiveV1.ClusterInstallCondition{{
+				Type:    hiveextV1Beta1.ClusterFailedCondition,
+				Status:  failedStatus,
+				Message: "installation timed out",
+			}},
+		},
+	}
+}
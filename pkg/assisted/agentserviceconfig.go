@@ -2,11 +2,12 @@ package assisted
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -29,7 +30,7 @@ const (
 type AgentServiceConfigBuilder struct {
 	Definition *agentInstallV1Beta1.AgentServiceConfig
 	Object     *agentInstallV1Beta1.AgentServiceConfig
-	errorMsg   string
+	errorMsg   error
 	apiClient  *clients.Settings
 }
 
@@ -41,7 +42,7 @@ func NewAgentServiceConfigBuilder(
 	apiClient *clients.Settings,
 	databaseStorageSpec,
 	filesystemStorageSpec corev1.PersistentVolumeClaimSpec) *AgentServiceConfigBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new agentserviceconfig structure with the following params: "+
 			"databaseStorageSpec: %v, filesystemStorageSpec: %v",
 		databaseStorageSpec, filesystemStorageSpec)
@@ -65,7 +66,7 @@ func NewAgentServiceConfigBuilder(
 // NewDefaultAgentServiceConfigBuilder creates a new instance of AgentServiceConfigBuilder
 // with default storage specs already set.
 func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServiceConfigBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new agentserviceconfig structure")
 
 	builder := AgentServiceConfigBuilder{
@@ -80,27 +81,27 @@ func NewDefaultAgentServiceConfigBuilder(apiClient *clients.Settings) *AgentServ
 
 	imageStorageSpec, err := GetDefaultStorageSpec(defaultImageStoreStorageSize)
 	if err != nil {
-		glog.V(100).Infof("The ImageStorage size is in wrong format")
+		logging.V(100).Infof("The ImageStorage size is in wrong format")
 
-		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error retrieving the storage size: %v", err))
 	}
 
 	builder.Definition.Spec.ImageStorage = &imageStorageSpec
 
 	databaseStorageSpec, err := GetDefaultStorageSpec(defaultDatabaseStorageSize)
 	if err != nil {
-		glog.V(100).Infof("The DatabaseStorage size is in wrong format")
+		logging.V(100).Infof("The DatabaseStorage size is in wrong format")
 
-		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error retrieving the storage size: %v", err))
 	}
 
 	builder.Definition.Spec.DatabaseStorage = databaseStorageSpec
 
 	fileSystemStorageSpec, err := GetDefaultStorageSpec(defaultFilesystemStorageSize)
 	if err != nil {
-		glog.V(100).Infof("The FileSystemStorage size is in wrong format")
+		logging.V(100).Infof("The FileSystemStorage size is in wrong format")
 
-		builder.errorMsg = fmt.Sprintf("error retrieving the storage size: %v", err)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error retrieving the storage size: %v", err))
 	}
 
 	builder.Definition.Spec.FileSystemStorage = fileSystemStorageSpec
@@ -115,7 +116,7 @@ func (builder *AgentServiceConfigBuilder) WithImageStorage(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting imageStorage %v in agentserviceconfig", imageStorageSpec)
+	logging.V(100).Infof("Setting imageStorage %v in agentserviceconfig", imageStorageSpec)
 
 	builder.Definition.Spec.ImageStorage = &imageStorageSpec
 
@@ -128,15 +129,16 @@ func (builder *AgentServiceConfigBuilder) WithMirrorRegistryRef(configMapName st
 		return builder
 	}
 
-	glog.V(100).Infof("Adding mirrorRegistryRef %s to agentserviceconfig %s", configMapName, builder.Definition.Name)
+	logging.V(100).Infof("Adding mirrorRegistryRef %s to agentserviceconfig %s", configMapName, builder.Definition.Name)
 
 	if configMapName == "" {
-		glog.V(100).Infof("The configMapName is empty")
+		logging.V(100).Infof("The configMapName is empty")
 
-		builder.errorMsg = "cannot add agentserviceconfig mirrorRegistryRef with empty configmap name"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"cannot add agentserviceconfig mirrorRegistryRef with empty configmap name"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -153,7 +155,7 @@ func (builder *AgentServiceConfigBuilder) WithOSImage(osImage agentInstallV1Beta
 		return builder
 	}
 
-	glog.V(100).Infof("Adding OSImage %v to agentserviceconfig %s", osImage, builder.Definition.Name)
+	logging.V(100).Infof("Adding OSImage %v to agentserviceconfig %s", osImage, builder.Definition.Name)
 
 	builder.Definition.Spec.OSImages = append(builder.Definition.Spec.OSImages, osImage)
 
@@ -166,7 +168,7 @@ func (builder *AgentServiceConfigBuilder) WithUnauthenticatedRegistry(registry s
 		return builder
 	}
 
-	glog.V(100).Infof("Adding unauthenticatedRegistry %s to agentserviceconfig %s", registry, builder.Definition.Name)
+	logging.V(100).Infof("Adding unauthenticatedRegistry %s to agentserviceconfig %s", registry, builder.Definition.Name)
 
 	builder.Definition.Spec.UnauthenticatedRegistries = append(builder.Definition.Spec.UnauthenticatedRegistries, registry)
 
@@ -179,7 +181,7 @@ func (builder *AgentServiceConfigBuilder) WithIPXEHTTPRoute(route string) *Agent
 		return builder
 	}
 
-	glog.V(100).Infof("Adding IPXEHTTPRout %s to agentserviceconfig %s", route, builder.Definition.Name)
+	logging.V(100).Infof("Adding IPXEHTTPRout %s to agentserviceconfig %s", route, builder.Definition.Name)
 
 	builder.Definition.Spec.IPXEHTTPRoute = route
 
@@ -193,16 +195,16 @@ func (builder *AgentServiceConfigBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting AgentServiceConfig additional options")
+	logging.V(100).Infof("Setting AgentServiceConfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -218,22 +220,23 @@ func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duratio
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for agetserviceconfig %s to be deployed", builder.Definition.Name)
+	logging.V(100).Infof("Waiting for agetserviceconfig %s to be deployed", builder.Definition.Name)
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The agentserviceconfig is undefined")
+		logging.V(100).Infof("The agentserviceconfig is undefined")
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString("AgentServiceConfig")
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("AgentServiceConfig")))
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("The agentserviceconfig does not exist on the cluster")
+		logging.V(100).Infof("The agentserviceconfig does not exist on the cluster")
 
-		builder.errorMsg = "cannot wait for non-existent agentserviceconfig to be deployed"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"cannot wait for non-existent agentserviceconfig to be deployed"))
 	}
 
-	if builder.errorMsg != "" {
-		return builder, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return builder, builder.errorMsg
 	}
 
 	// Polls every retryInterval to determine if agentserviceconfig is in desired state.
@@ -272,7 +275,7 @@ func (builder *AgentServiceConfigBuilder) WaitUntilDeployed(timeout time.Duratio
 
 // PullAgentServiceConfig loads the existing agentserviceconfig into AgentServiceConfigBuilder struct.
 func PullAgentServiceConfig(apiClient *clients.Settings) (*AgentServiceConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing agentserviceconfig name: %s", agentServiceConfigName)
+	logging.V(100).Infof("Pulling existing agentserviceconfig name: %s", agentServiceConfigName)
 
 	builder := AgentServiceConfigBuilder{
 		apiClient: apiClient,
@@ -298,7 +301,7 @@ func (builder *AgentServiceConfigBuilder) Get() (*agentInstallV1Beta1.AgentServi
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agentserviceconfig %s",
+	logging.V(100).Infof("Getting agentserviceconfig %s",
 		builder.Definition.Name)
 
 	agentServiceConfig := &agentInstallV1Beta1.AgentServiceConfig{}
@@ -320,7 +323,7 @@ func (builder *AgentServiceConfigBuilder) Create() (*AgentServiceConfigBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the agentserviceconfig %s",
+	logging.V(100).Infof("Creating the agentserviceconfig %s",
 		builder.Definition.Name)
 
 	var err error
@@ -340,25 +343,25 @@ func (builder *AgentServiceConfigBuilder) Update(force bool) (*AgentServiceConfi
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating agentserviceconfig %s",
+	logging.V(100).Infof("Updating agentserviceconfig %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("agentserviceconfig %s does not exist",
+		logging.V(100).Infof("agentserviceconfig %s does not exist",
 			builder.Definition.Name)
 
-		builder.errorMsg = "Cannot update non-existent agentserviceconfig"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Cannot update non-existent agentserviceconfig"))
 	}
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("agentserviceconfig", builder.Definition.Name))
 
 			err = builder.DeleteAndWait(time.Second * 5)
@@ -366,7 +369,7 @@ func (builder *AgentServiceConfigBuilder) Update(force bool) (*AgentServiceConfi
 			builder.Definition.CreationTimestamp = metav1.Time{}
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("agentserviceconfig", builder.Definition.Name))
 
 				return nil, err
@@ -389,7 +392,7 @@ func (builder *AgentServiceConfigBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the agentserviceconfig %s",
+	logging.V(100).Infof("Deleting the agentserviceconfig %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -414,7 +417,7 @@ func (builder *AgentServiceConfigBuilder) DeleteAndWait(timeout time.Duration) e
 		return err
 	}
 
-	glog.V(100).Infof(`Deleting agentserviceconfig %s and 
+	logging.V(100).Infof(`Deleting agentserviceconfig %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name)
 
@@ -440,7 +443,7 @@ func (builder *AgentServiceConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if agentserviceconfig %s exists",
+	logging.V(100).Infof("Checking if agentserviceconfig %s exists",
 		builder.Definition.Name)
 
 	var err error
@@ -468,7 +471,7 @@ func GetDefaultStorageSpec(defaultStorageSize string) (corev1.PersistentVolumeCl
 		},
 	}
 
-	glog.V(100).Infof("Getting default PVC spec: %v", defaultSpec)
+	logging.V(100).Infof("Getting default PVC spec: %v", defaultSpec)
 
 	return defaultSpec, nil
 }
@@ -479,27 +482,29 @@ func (builder *AgentServiceConfigBuilder) validate() (bool, error) {
 	resourceCRD := "AgentServiceConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -2,13 +2,14 @@ package assisted
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"math/rand"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
@@ -31,7 +32,7 @@ const (
 type InfraEnvBuilder struct {
 	Definition *agentInstallV1Beta1.InfraEnv
 	Object     *agentInstallV1Beta1.InfraEnv
-	errorMsg   string
+	errorMsg   error
 	apiClient  *clients.Settings
 }
 
@@ -40,7 +41,7 @@ type InfraEnvAdditionalOptions func(builder *InfraEnvBuilder) (*InfraEnvBuilder,
 
 // NewInfraEnvBuilder creates a new instance of InfraEnvBuilder.
 func NewInfraEnvBuilder(apiClient *clients.Settings, name, nsname, psName string) *InfraEnvBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new infraenv structure with the following params: "+
 			"name: %s, namespace: %s, pull-secret: %s",
 		name, nsname, psName)
@@ -61,21 +62,21 @@ func NewInfraEnvBuilder(apiClient *clients.Settings, name, nsname, psName string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the infraenv is empty")
+		logging.V(100).Infof("The name of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("infraenv 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the infraenv is empty")
+		logging.V(100).Infof("The namespace of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("infraenv 'namespace' cannot be empty"))
 	}
 
 	if psName == "" {
-		glog.V(100).Infof("The pull-secret ref of the infraenv is empty")
+		logging.V(100).Infof("The pull-secret ref of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'pull-secret' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("infraenv 'pull-secret' cannot be empty"))
 	}
 
 	return &builder
@@ -87,21 +88,21 @@ func (builder *InfraEnvBuilder) WithClusterRef(name, nsname string) *InfraEnvBui
 		return builder
 	}
 
-	glog.V(100).Infof("Adding clusterRef %s in namespace %s to InfraEnv %s", name, nsname, builder.Definition.Name)
+	logging.V(100).Infof("Adding clusterRef %s in namespace %s to InfraEnv %s", name, nsname, builder.Definition.Name)
 
 	if name == "" {
-		glog.V(100).Infof("The name of the infraenv clusterRef is empty")
+		logging.V(100).Infof("The name of the infraenv clusterRef is empty")
 
-		builder.errorMsg = "infraenv clusterRef 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("infraenv clusterRef 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the infraenv clusterRef is empty")
+		logging.V(100).Infof("The namespace of the infraenv clusterRef is empty")
 
-		builder.errorMsg = "infraenv clusterRef 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("infraenv clusterRef 'namespace' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -119,7 +120,7 @@ func (builder *InfraEnvBuilder) WithAdditionalNTPSource(ntpSource string) *Infra
 		return builder
 	}
 
-	glog.V(100).Infof("Adding ntpSource %s to InfraEnv %s", ntpSource, builder.Definition.Name)
+	logging.V(100).Infof("Adding ntpSource %s to InfraEnv %s", ntpSource, builder.Definition.Name)
 
 	builder.Definition.Spec.AdditionalNTPSources = append(builder.Definition.Spec.AdditionalNTPSources, ntpSource)
 
@@ -132,7 +133,7 @@ func (builder *InfraEnvBuilder) WithSSHAuthorizedKey(sshAuthKey string) *InfraEn
 		return builder
 	}
 
-	glog.V(100).Infof("Adding sshAuthorizedKey %s to InfraEnv %s", sshAuthKey, builder.Definition.Name)
+	logging.V(100).Infof("Adding sshAuthorizedKey %s to InfraEnv %s", sshAuthKey, builder.Definition.Name)
 
 	builder.Definition.Spec.SSHAuthorizedKey = sshAuthKey
 
@@ -145,7 +146,7 @@ func (builder *InfraEnvBuilder) WithAgentLabel(key, value string) *InfraEnvBuild
 		return builder
 	}
 
-	glog.V(100).Infof("Adding agentLabel %s:%s to InfraEnv %s", key, value, builder.Definition.Name)
+	logging.V(100).Infof("Adding agentLabel %s:%s to InfraEnv %s", key, value, builder.Definition.Name)
 
 	if builder.Definition.Spec.AgentLabels == nil {
 		builder.Definition.Spec.AgentLabels = make(map[string]string)
@@ -162,7 +163,7 @@ func (builder *InfraEnvBuilder) WithProxy(proxy agentInstallV1Beta1.Proxy) *Infr
 		return builder
 	}
 
-	glog.V(100).Infof("Adding proxy %s to InfraEnv %s", proxy, builder.Definition.Name)
+	logging.V(100).Infof("Adding proxy %s to InfraEnv %s", proxy, builder.Definition.Name)
 
 	builder.Definition.Spec.Proxy = &proxy
 
@@ -176,20 +177,42 @@ func (builder *InfraEnvBuilder) WithNmstateConfigLabelSelector(selector metav1.L
 		return builder
 	}
 
-	glog.V(100).Infof("Adding nmstateconfig selector %s to InfraEnv %s", &selector, builder.Definition.Name)
+	logging.V(100).Infof("Adding nmstateconfig selector %s to InfraEnv %s", &selector, builder.Definition.Name)
 
 	builder.Definition.Spec.NMStateConfigLabelSelector = selector
 
 	return builder
 }
 
+// WithAdditionalTrustBundle sets the PEM-encoded certificates trusted by the discovery ISO and the installed hosts.
+func (builder *InfraEnvBuilder) WithAdditionalTrustBundle(trustBundle string) *InfraEnvBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding additionalTrustBundle to InfraEnv %s", builder.Definition.Name)
+
+	if trustBundle == "" {
+		logging.V(100).Infof("The additionalTrustBundle of the infraenv is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("infraenv 'additionalTrustBundle' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.AdditionalTrustBundle = trustBundle
+
+	return builder
+}
+
 // WithCPUType sets the cpu architecture for the discovery ISO.
 func (builder *InfraEnvBuilder) WithCPUType(arch string) *InfraEnvBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding cpuArchitecture %s to InfraEnv %s", arch, builder.Definition.Name)
+	logging.V(100).Infof("Adding cpuArchitecture %s to InfraEnv %s", arch, builder.Definition.Name)
 
 	builder.Definition.Spec.CpuArchitecture = arch
 
@@ -202,7 +225,7 @@ func (builder *InfraEnvBuilder) WithIgnitionConfigOverride(override string) *Inf
 		return builder
 	}
 
-	glog.V(100).Infof("Adding ignitionConfigOverride %s to InfraEnv %s", override, builder.Definition.Name)
+	logging.V(100).Infof("Adding ignitionConfigOverride %s to InfraEnv %s", override, builder.Definition.Name)
 
 	builder.Definition.Spec.IgnitionConfigOverride = override
 
@@ -215,7 +238,7 @@ func (builder *InfraEnvBuilder) WithIPXEScriptType(scriptType agentInstallV1Beta
 		return builder
 	}
 
-	glog.V(100).Infof("Adding ipxeScriptType %s to InfraEnv %s", scriptType, builder.Definition.Name)
+	logging.V(100).Infof("Adding ipxeScriptType %s to InfraEnv %s", scriptType, builder.Definition.Name)
 
 	builder.Definition.Spec.IPXEScriptType = scriptType
 
@@ -228,7 +251,7 @@ func (builder *InfraEnvBuilder) WithKernelArgument(kernelArg agentInstallV1Beta1
 		return builder
 	}
 
-	glog.V(100).Infof("Adding kernelArgument %s to InfraEnv %s", kernelArg, builder.Definition.Name)
+	logging.V(100).Infof("Adding kernelArgument %s to InfraEnv %s", kernelArg, builder.Definition.Name)
 
 	builder.Definition.Spec.KernelArguments = append(builder.Definition.Spec.KernelArguments, kernelArg)
 
@@ -242,16 +265,16 @@ func (builder *InfraEnvBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting InfraEnv additional options")
+	logging.V(100).Infof("Setting InfraEnv additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -261,7 +284,8 @@ func (builder *InfraEnvBuilder) WithOptions(
 	return builder
 }
 
-// WaitForDiscoveryISOCreation waits the defined timeout for the discovery ISO to be generated.
+// WaitForDiscoveryISOCreation waits the defined timeout for the discovery ISO to be generated. It fails early when
+// the image creation fails.
 func (builder *InfraEnvBuilder) WaitForDiscoveryISOCreation(timeout time.Duration) (*InfraEnvBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
@@ -277,6 +301,14 @@ func (builder *InfraEnvBuilder) WaitForDiscoveryISOCreation(timeout time.Duratio
 				return false, nil
 			}
 
+			for _, condition := range builder.Object.Status.Conditions {
+				if condition.Type == agentInstallV1Beta1.ImageCreatedCondition &&
+					condition.Reason == agentInstallV1Beta1.ImageCreationErrorReason {
+					return false, fmt.Errorf("infraenv %s failed to create the discovery ISO: %s",
+						builder.Definition.Name, condition.Message)
+				}
+			}
+
 			return builder.Object.Status.CreatedTime != nil, nil
 		})
 
@@ -287,13 +319,50 @@ func (builder *InfraEnvBuilder) WaitForDiscoveryISOCreation(timeout time.Duratio
 	return nil, err
 }
 
+// GetISODownloadURL returns the url the discovery ISO of the infraenv is downloaded from.
+func (builder *InfraEnvBuilder) GetISODownloadURL() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting the discovery ISO url of infraenv %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("cannot get discovery ISO url of non-existent infraenv")
+	}
+
+	if builder.Object.Status.ISODownloadURL == "" {
+		return "", fmt.Errorf("infraenv %s has not generated its discovery ISO yet", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.ISODownloadURL, nil
+}
+
+// WaitForISODownloadURL waits the defined timeout for the discovery ISO to be generated and returns the url it is
+// downloaded from.
+func (builder *InfraEnvBuilder) WaitForISODownloadURL(timeout time.Duration) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Waiting for the discovery ISO url of infraenv %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if _, err := builder.WaitForDiscoveryISOCreation(timeout); err != nil {
+		return "", err
+	}
+
+	return builder.GetISODownloadURL()
+}
+
 // GetAllAgents returns a slice of agentBuilders of all agents belonging to the infraenv.
 func (builder *InfraEnvBuilder) GetAllAgents() ([]*agentBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting all agents from infraenv %s",
+	logging.V(100).Infof("Getting all agents from infraenv %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -314,11 +383,11 @@ func (builder *InfraEnvBuilder) GetAgentsByRole(role string) ([]*agentBuilder, e
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agents from infraenv %s matching role %s",
+	logging.V(100).Infof("Getting agents from infraenv %s matching role %s",
 		builder.Definition.Name, role)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Cannot get agents from non-existent infraenv: %s",
+		logging.V(100).Infof("Cannot get agents from non-existent infraenv: %s",
 			role)
 
 		return nil, fmt.Errorf("cannot get agents from non-existent infraenv")
@@ -346,7 +415,7 @@ func (builder *InfraEnvBuilder) GetAgentByBMH(bmhName string) (*agentBuilder, er
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent from infraenv %s matching bmh %s",
+	logging.V(100).Infof("Getting agent from infraenv %s matching bmh %s",
 		builder.Definition.Name, bmhName)
 
 	if !builder.Exists() {
@@ -362,11 +431,11 @@ func (builder *InfraEnvBuilder) GetAgentByBMH(bmhName string) (*agentBuilder, er
 	case 1:
 		return agents[0], nil
 	case 0:
-		glog.V(100).Infof("Found no agents referencing bmh %s", bmhName)
+		logging.V(100).Infof("Found no agents referencing bmh %s", bmhName)
 
 		return nil, fmt.Errorf("found no agents referencing bmh %s", bmhName)
 	default:
-		glog.V(100).Infof("Found multiple agent referencing bmh %s", bmhName)
+		logging.V(100).Infof("Found multiple agent referencing bmh %s", bmhName)
 
 		return nil, fmt.Errorf("found multiple agents referencing bmh %s", bmhName)
 	}
@@ -378,7 +447,7 @@ func (builder *InfraEnvBuilder) GetAgentByName(name string) (*agentBuilder, erro
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent from infraenv %s with name %s",
+	logging.V(100).Infof("Getting agent from infraenv %s with name %s",
 		builder.Definition.Name, name)
 
 	if !builder.Exists() {
@@ -399,7 +468,7 @@ func (builder *InfraEnvBuilder) GetAgentsByLabel(key, value string) ([]*agentBui
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agent matching label %s:%s",
+	logging.V(100).Infof("Getting agent matching label %s:%s",
 		key, value)
 
 	if !builder.Exists() {
@@ -630,14 +699,14 @@ func (builder *InfraEnvBuilder) GetAgentClusterInstallFromInfraEnv() (*hiveextV1
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Getting infraenv %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+		logging.V(100).Infof("Getting infraenv %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, fmt.Errorf("cannot wait from agents to register with non-existent infraenv")
 	}
 
 	var clusterdeployment hiveV1.ClusterDeployment
 
-	glog.V(100).Infof("Getting clusterdeployment %s in namespace %s",
+	logging.V(100).Infof("Getting clusterdeployment %s in namespace %s",
 		builder.Object.Spec.ClusterRef.Name, builder.Object.Spec.ClusterRef.Namespace)
 
 	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
@@ -646,13 +715,13 @@ func (builder *InfraEnvBuilder) GetAgentClusterInstallFromInfraEnv() (*hiveextV1
 	}, &clusterdeployment)
 
 	if err != nil {
-		glog.V(100).Infof("Unable to get clusterdeployment %s referenced by infraenv %s",
+		logging.V(100).Infof("Unable to get clusterdeployment %s referenced by infraenv %s",
 			builder.Object.Spec.ClusterRef.Name, builder.Definition.Name)
 
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting agentclusterinstall %s",
+	logging.V(100).Infof("Getting agentclusterinstall %s",
 		clusterdeployment.Spec.ClusterInstallRef.Name)
 
 	var agentclusterinstall hiveextV1Beta1.AgentClusterInstall
@@ -663,7 +732,7 @@ func (builder *InfraEnvBuilder) GetAgentClusterInstallFromInfraEnv() (*hiveextV1
 	}, &agentclusterinstall)
 
 	if err != nil {
-		glog.V(100).Infof("Unable to get agentclusterinstall %s referenced by clusterdeployment %s",
+		logging.V(100).Infof("Unable to get agentclusterinstall %s referenced by clusterdeployment %s",
 			clusterdeployment.Spec.ClusterInstallRef.Name, clusterdeployment.Name)
 
 		return nil, err
@@ -678,7 +747,7 @@ func (builder *InfraEnvBuilder) Get() (*agentInstallV1Beta1.InfraEnv, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting infraenv %s in namespace %s",
+	logging.V(100).Infof("Getting infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	infraEnv := &agentInstallV1Beta1.InfraEnv{}
@@ -697,7 +766,7 @@ func (builder *InfraEnvBuilder) Get() (*agentInstallV1Beta1.InfraEnv, error) {
 
 // PullInfraEnvInstall pulls existing infraenv from cluster.
 func PullInfraEnvInstall(apiClient *clients.Settings, name, nsname string) (*InfraEnvBuilder, error) {
-	glog.V(100).Infof("Pulling existing infraenv name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing infraenv name %s under namespace %s from cluster", name, nsname)
 
 	builder := InfraEnvBuilder{
 		apiClient: apiClient,
@@ -710,15 +779,15 @@ func PullInfraEnvInstall(apiClient *clients.Settings, name, nsname string) (*Inf
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the infraenv is empty")
+		logging.V(100).Infof("The name of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("infraenv 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the infraenv is empty")
+		logging.V(100).Infof("The namespace of the infraenv is empty")
 
-		builder.errorMsg = "infraenv 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("infraenv 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -736,7 +805,7 @@ func (builder *InfraEnvBuilder) Create() (*InfraEnvBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the infraenv %s in namespace %s",
+	logging.V(100).Infof("Creating the infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -756,32 +825,32 @@ func (builder *InfraEnvBuilder) Update(force bool) (*InfraEnvBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating infraenv %s in namespace %s",
+	logging.V(100).Infof("Updating infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("infraenv %s in namespace %s does not exist",
+		logging.V(100).Infof("infraenv %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "Cannot update non-existent infraenv"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Cannot update non-existent infraenv"))
 	}
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("infraenv", builder.Definition.Name, builder.Definition.Namespace))
 
 			err = builder.DeleteAndWait(time.Second * 5)
 			builder.Definition.ResourceVersion = ""
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					"Failed to update the infraenv object %s in namespace %s, "+
 						"due to error in delete function",
 					builder.Definition.Name, builder.Definition.Namespace,
@@ -807,7 +876,7 @@ func (builder *InfraEnvBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the infraenv %s in namespace %s",
+	logging.V(100).Infof("Deleting the infraenv %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -831,7 +900,7 @@ func (builder *InfraEnvBuilder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof(`Deleting InfraEnv %s and 
+	logging.V(100).Infof(`Deleting InfraEnv %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name)
 
@@ -857,7 +926,7 @@ func (builder *InfraEnvBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if infraenv %s exists in namespace %s",
+	logging.V(100).Infof("Checking if infraenv %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -872,27 +941,29 @@ func (builder *InfraEnvBuilder) validate() (bool, error) {
 	resourceCRD := "InfraEnv"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,177 @@
+package assisted
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	agentInstallV1Beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultInfraEnvName      = "test-infraenv"
+	defaultInfraEnvNamespace = "test-ns"
+	defaultInfraEnvISOURL    = "https://assisted-image-service/images/test-infraenv.iso"
+)
+
+func TestInfraEnvWithAdditionalTrustBundle(t *testing.T) {
+	testCases := []struct {
+		trustBundle   string
+		expectedError string
+	}{
+		{
+			trustBundle:   "-----BEGIN CERTIFICATE-----",
+			expectedError: "",
+		},
+		{
+			trustBundle:   "",
+			expectedError: "infraenv 'additionalTrustBundle' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidInfraEnvBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithAdditionalTrustBundle(testCase.trustBundle)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.trustBundle, testBuilder.Definition.Spec.AdditionalTrustBundle)
+		}
+	}
+}
+
+func TestInfraEnvWaitForDiscoveryISOCreation(t *testing.T) {
+	testCases := []struct {
+		created       bool
+		conditions    []conditionsv1.Condition
+		expectedError string
+	}{
+		{
+			created:       true,
+			expectedError: "",
+		},
+		{
+			created:       false,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			created: false,
+			conditions: []conditionsv1.Condition{{
+				Type:    agentInstallV1Beta1.ImageCreatedCondition,
+				Status:  corev1.ConditionFalse,
+				Reason:  agentInstallV1Beta1.ImageCreationErrorReason,
+				Message: "failed to generate ignition",
+			}},
+			expectedError: fmt.Sprintf(
+				"infraenv %s failed to create the discovery ISO: failed to generate ignition", defaultInfraEnvName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		infraEnv := buildDummyInfraEnv(testCase.created)
+		infraEnv.Status.Conditions = testCase.conditions
+
+		testBuilder := buildValidInfraEnvBuilder(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{infraEnv}}))
+
+		testBuilder, err := testBuilder.WaitForDiscoveryISOCreation(time.Second)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.NotNil(t, testBuilder.Object.Status.CreatedTime)
+		}
+	}
+}
+
+func TestInfraEnvGetISODownloadURL(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		created             bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			created:             true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: true,
+			created:             false,
+			expectedError:       fmt.Sprintf("infraenv %s has not generated its discovery ISO yet", defaultInfraEnvName),
+		},
+		{
+			addToRuntimeObjects: false,
+			created:             true,
+			expectedError:       "cannot get discovery ISO url of non-existent infraenv",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyInfraEnv(testCase.created))
+		}
+
+		testBuilder := buildValidInfraEnvBuilder(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}))
+
+		isoURL, err := testBuilder.GetISODownloadURL()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, defaultInfraEnvISOURL, isoURL)
+		}
+	}
+}
+
+func TestInfraEnvWaitForISODownloadURL(t *testing.T) {
+	testCases := []struct {
+		created       bool
+		expectedError string
+	}{
+		{
+			created:       true,
+			expectedError: "",
+		},
+		{
+			created:       false,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidInfraEnvBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyInfraEnv(testCase.created)},
+		}))
+
+		isoURL, err := testBuilder.WaitForISODownloadURL(time.Second)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, defaultInfraEnvISOURL, isoURL)
+		}
+	}
+}
+
+func buildValidInfraEnvBuilder(apiClient *clients.Settings) *InfraEnvBuilder {
+	return NewInfraEnvBuilder(apiClient, defaultInfraEnvName, defaultInfraEnvNamespace, "pull-secret")
+}
+
+// buildDummyInfraEnv returns an infraenv which, when created is true, has generated its discovery ISO.
+func buildDummyInfraEnv(created bool) *agentInstallV1Beta1.InfraEnv {
+	infraEnv := &agentInstallV1Beta1.InfraEnv{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultInfraEnvName,
+			Namespace: defaultInfraEnvNamespace,
+		},
+	}
+
+	if created {
+		infraEnv.Status.CreatedTime = &metav1.Time{Time: time.Now()}
+		infraEnv.Status.ISODownloadURL = defaultInfraEnvISOURL
+	}
+
+	return infraEnv
+}
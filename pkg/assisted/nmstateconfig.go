@@ -2,10 +2,11 @@ package assisted
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	assistedv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,12 +25,12 @@ type NmStateConfigBuilder struct {
 	// API client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before NMStateConfig object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewNmStateConfigBuilder creates a new instance of NMStateConfig Builder.
 func NewNmStateConfigBuilder(apiClient *clients.Settings, name, namespace string) *NmStateConfigBuilder {
-	glog.V(100).Infof("Initializing new nmstateconfig structure with the name: %s in namespace: %s", name, namespace)
+	logging.V(100).Infof("Initializing new nmstateconfig structure with the name: %s in namespace: %s", name, namespace)
 
 	builder := NmStateConfigBuilder{
 		apiClient: apiClient,
@@ -42,15 +43,15 @@ func NewNmStateConfigBuilder(apiClient *clients.Settings, name, namespace string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the nmstateconfig is empty")
+		logging.V(100).Infof("The name of the nmstateconfig is empty")
 
-		builder.errorMsg = "nmstateconfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("nmstateconfig 'name' cannot be empty"))
 	}
 
 	if namespace == "" {
-		glog.V(100).Infof("The namespace of the nmstateconfig is empty")
+		logging.V(100).Infof("The namespace of the nmstateconfig is empty")
 
-		builder.errorMsg = "nmstateconfig namespace's name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("nmstateconfig namespace's name is empty"))
 	}
 
 	return &builder
@@ -62,7 +63,7 @@ func (builder *NmStateConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if nmstateconfig %s exists in namespace: %s",
+	logging.V(100).Infof("Checking if nmstateconfig %s exists in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -77,7 +78,7 @@ func (builder *NmStateConfigBuilder) Get() (*assistedv1beta1.NMStateConfig, erro
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting nmstateconfig object %s in namespace: %s",
+	logging.V(100).Infof("Collecting nmstateconfig object %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	nmStateConfig := &assistedv1beta1.NMStateConfig{}
@@ -87,7 +88,7 @@ func (builder *NmStateConfigBuilder) Get() (*assistedv1beta1.NMStateConfig, erro
 	}, nmStateConfig)
 
 	if err != nil {
-		glog.V(100).Infof("nmstateconfig object %s doesn't exist", builder.Definition.Name)
+		logging.V(100).Infof("nmstateconfig object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
 	}
@@ -101,7 +102,7 @@ func (builder *NmStateConfigBuilder) Create() (*NmStateConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the nmstateconfig %s in namespace: %s",
+	logging.V(100).Infof("Creating the nmstateconfig %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -121,7 +122,7 @@ func (builder *NmStateConfigBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the nmstateconfig object %s in namespace: %s",
+	logging.V(100).Infof("Deleting the nmstateconfig object %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
@@ -142,7 +143,7 @@ func ListNmStateConfigsInAllNamespaces(apiClient *clients.Settings) ([]*NmStateC
 	err := apiClient.List(context.TODO(), nmStateConfigList, &goclient.ListOptions{})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list nmStateConfigs across all namespaces due to %s", err.Error())
+		logging.V(100).Infof("Failed to list nmStateConfigs across all namespaces due to %s", err.Error())
 
 		return nil, err
 	}
@@ -174,7 +175,7 @@ func ListNmStateConfigs(apiClient *clients.Settings, namespace string) ([]*NmSta
 	err := apiClient.List(context.TODO(), nmStateConfigList, &goclient.ListOptions{Namespace: namespace})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list nmStateConfigs in namespace: %s due to %s",
+		logging.V(100).Infof("Failed to list nmStateConfigs in namespace: %s due to %s",
 			namespace, err.Error())
 
 		return nil, err
@@ -202,27 +203,29 @@ func (builder *NmStateConfigBuilder) validate() (bool, error) {
 	resourceCRD := "NMStateConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
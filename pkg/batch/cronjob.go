@@ -0,0 +1,427 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// manualInstantiateAnnotation marks jobs created from a cronjob outside of its schedule, as kubectl create job does.
+const manualInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+// CronJobBuilder provides struct for cronjob object containing connection to the cluster and the cronjob
+// definitions.
+type CronJobBuilder struct {
+	// CronJob definition. Used to create the cronjob object.
+	Definition *batchv1.CronJob
+	// Created cronjob object.
+	Object *batchv1.CronJob
+	// Used in functions that define or mutate cronjob definition. errorMsg is processed before the cronjob object is
+	// created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// NewCronJobBuilder creates a new instance of CronJobBuilder running the container on the given cron schedule.
+func NewCronJobBuilder(
+	apiClient *clients.Settings, name, nsname, schedule string, containerSpec *corev1.Container) *CronJobBuilder {
+	logging.V(100).Infof(
+		"Initializing new cronjob structure with the following params: name: %s, namespace: %s, schedule: %s, "+
+			"containerSpec %v", name, nsname, schedule, containerSpec)
+
+	builder := CronJobBuilder{
+		apiClient: apiClient,
+		Definition: &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: batchv1.CronJobSpec{
+				Schedule: schedule,
+				JobTemplate: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								RestartPolicy: corev1.RestartPolicyNever,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the cronjob is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the cronjob is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob 'namespace' cannot be empty"))
+	}
+
+	if schedule == "" {
+		logging.V(100).Infof("The schedule of the cronjob is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob 'schedule' cannot be empty"))
+	}
+
+	if containerSpec == nil {
+		logging.V(100).Infof("The container spec of the cronjob is nil")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob 'containerSpec' cannot be nil"))
+
+		return &builder
+	}
+
+	builder.Definition.Spec.JobTemplate.Spec.Template.Spec.Containers = []corev1.Container{*containerSpec}
+
+	return &builder
+}
+
+// PullCronJob loads an existing cronjob into CronJobBuilder struct.
+func PullCronJob(apiClient *clients.Settings, name, nsname string) (*CronJobBuilder, error) {
+	logging.V(100).Infof("Pulling existing cronjob name: %s under namespace: %s", name, nsname)
+
+	builder := CronJobBuilder{
+		apiClient: apiClient,
+		Definition: &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob 'namespace' cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("cronjob object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithSchedule sets the cron schedule of the cronjob, e.g. "*/5 * * * *".
+func (builder *CronJobBuilder) WithSchedule(schedule string) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting schedule %s in cronjob %s in namespace %s",
+		schedule, builder.Definition.Name, builder.Definition.Namespace)
+
+	if schedule == "" {
+		logging.V(100).Infof("The schedule of the cronjob is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob 'schedule' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Schedule = schedule
+
+	return builder
+}
+
+// WithConcurrencyPolicy sets how the cronjob treats a new run while the job of the previous run is still active.
+func (builder *CronJobBuilder) WithConcurrencyPolicy(policy batchv1.ConcurrencyPolicy) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting concurrencyPolicy %s in cronjob %s in namespace %s",
+		policy, builder.Definition.Name, builder.Definition.Namespace)
+
+	if policy != batchv1.AllowConcurrent && policy != batchv1.ForbidConcurrent && policy != batchv1.ReplaceConcurrent {
+		logging.V(100).Infof("The concurrencyPolicy %s is not supported", policy)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid concurrencyPolicy %s, supported values are %s, %s and %s",
+			policy, batchv1.AllowConcurrent, batchv1.ForbidConcurrent, batchv1.ReplaceConcurrent))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ConcurrencyPolicy = policy
+
+	return builder
+}
+
+// WithJobBackoffLimit sets the number of retries before a job created by the cronjob is marked as failed.
+func (builder *CronJobBuilder) WithJobBackoffLimit(backoffLimit int32) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting job backoffLimit %d in cronjob %s in namespace %s",
+		backoffLimit, builder.Definition.Name, builder.Definition.Namespace)
+
+	if backoffLimit < 0 {
+		logging.V(100).Infof("The job backoffLimit of the cronjob is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cronjob job 'backoffLimit' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.JobTemplate.Spec.BackoffLimit = &backoffLimit
+
+	return builder
+}
+
+// WithSuspend sets whether the cronjob stops creating new jobs on its schedule.
+func (builder *CronJobBuilder) WithSuspend(suspend bool) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting suspend %t in cronjob %s in namespace %s",
+		suspend, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.Suspend = &suspend
+
+	return builder
+}
+
+// Create generates a cronjob in cluster and stores the created object in struct.
+func (builder *CronJobBuilder) Create() (*CronJobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating cronjob %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
+		builder.Object, err = builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *CronJobBuilder) WithDryRun(dryRun bool) *CronJobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for cronjob %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the cronjob definition against the API server and its admission webhooks with a server-side
+// dry-run request. The cronjob is not created and the builder Object is not modified.
+func (builder *CronJobBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the cronjob %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Exists checks whether the given cronjob exists.
+func (builder *CronJobBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if cronjob %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the cronjob and the jobs it created from the cluster.
+func (builder *CronJobBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting cronjob %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+
+	deleteOptions := metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.K8sClient.BatchV1().CronJobs(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// TriggerJob runs the cronjob immediately by creating a job named jobName from its job template, the same way
+// kubectl create job --from=cronjob does. The created job is owned by the cronjob.
+func (builder *CronJobBuilder) TriggerJob(jobName string) (*JobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Triggering job %s from cronjob %s in namespace %s",
+		jobName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if jobName == "" {
+		logging.V(100).Infof("The name of the job to trigger is empty")
+
+		return nil, fmt.Errorf("job 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("cannot trigger job from cronjob %s in namespace %s because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	annotations := map[string]string{manualInstantiateAnnotation: "manual"}
+	for key, value := range builder.Object.Spec.JobTemplate.Annotations {
+		annotations[key] = value
+	}
+
+	jobBuilder := &JobBuilder{
+		apiClient: builder.apiClient,
+		Definition: &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        jobName,
+				Namespace:   builder.Definition.Namespace,
+				Labels:      builder.Object.Spec.JobTemplate.Labels,
+				Annotations: annotations,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: batchv1.SchemeGroupVersion.String(),
+					Kind:       "CronJob",
+					Name:       builder.Object.Name,
+					UID:        builder.Object.UID,
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: *builder.Object.Spec.JobTemplate.Spec.DeepCopy(),
+		},
+	}
+
+	return jobBuilder.Create()
+}
+
+// GetCronJobGVR returns cronjob's GroupVersionResource which could be used for Clean function.
+func GetCronJobGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}
+}
+
+// Diff compares the cronjob definition with the cronjob object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *CronJobBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing cronjob %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"cronjob object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *CronJobBuilder) validate() (bool, error) {
+	resourceCRD := "CronJob"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,195 @@
+package batch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultCronJobName     = "test-cronjob"
+	defaultCronJobSchedule = "*/5 * * * *"
+)
+
+func TestNewCronJobBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		schedule      string
+		expectedError string
+	}{
+		{
+			name:          defaultCronJobName,
+			schedule:      defaultCronJobSchedule,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			schedule:      defaultCronJobSchedule,
+			expectedError: "cronjob 'name' cannot be empty",
+		},
+		{
+			name:          defaultCronJobName,
+			schedule:      "",
+			expectedError: "cronjob 'schedule' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewCronJobBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			testCase.name, defaultJobNamespace, testCase.schedule, &corev1.Container{Name: "test-container"})
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.schedule, testBuilder.Definition.Spec.Schedule)
+		assert.Equal(t, "test-container",
+			testBuilder.Definition.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Name)
+	}
+}
+
+func TestCronJobMutators(t *testing.T) {
+	testBuilder := buildValidCronJobBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithSchedule("0 * * * *").
+		WithConcurrencyPolicy(batchv1.ForbidConcurrent).
+		WithJobBackoffLimit(1).
+		WithSuspend(true)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "0 * * * *", testBuilder.Definition.Spec.Schedule)
+	assert.Equal(t, batchv1.ForbidConcurrent, testBuilder.Definition.Spec.ConcurrencyPolicy)
+	assert.Equal(t, int32(1), *testBuilder.Definition.Spec.JobTemplate.Spec.BackoffLimit)
+	assert.True(t, *testBuilder.Definition.Spec.Suspend)
+
+	testBuilder = buildValidCronJobBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithConcurrencyPolicy("Queue")
+	assert.EqualError(t, testBuilder.errorMsg,
+		"invalid concurrencyPolicy Queue, supported values are Allow, Forbid and Replace")
+
+	testBuilder = buildValidCronJobBuilder(clients.GetTestClients(clients.TestClientParams{})).WithSchedule("")
+	assert.EqualError(t, testBuilder.errorMsg, "cronjob 'schedule' cannot be empty")
+}
+
+func TestCronJobTriggerJob(t *testing.T) {
+	testCases := []struct {
+		jobName       string
+		exists        bool
+		expectedError string
+	}{
+		{
+			jobName:       "test-cronjob-manual",
+			exists:        true,
+			expectedError: "",
+		},
+		{
+			jobName:       "",
+			exists:        true,
+			expectedError: "job 'name' cannot be empty",
+		},
+		{
+			jobName: "test-cronjob-manual",
+			exists:  false,
+			expectedError: "cannot trigger job from cronjob test-cronjob in namespace test-namespace " +
+				"because it does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, &batchv1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{Name: defaultCronJobName, Namespace: defaultJobNamespace, UID: "test-uid"},
+				Spec: batchv1.CronJobSpec{
+					Schedule: defaultCronJobSchedule,
+					JobTemplate: batchv1.JobTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+						Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "test-container"}}}}},
+					},
+				},
+			})
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		jobBuilder, err := buildValidCronJobBuilder(testSettings).TriggerJob(testCase.jobName)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, "manual", jobBuilder.Object.Annotations[manualInstantiateAnnotation])
+		assert.Equal(t, "test", jobBuilder.Object.Labels["app"])
+		assert.Equal(t, "test-container", jobBuilder.Object.Spec.Template.Spec.Containers[0].Name)
+		assert.Equal(t, "test-uid", string(jobBuilder.Object.OwnerReferences[0].UID))
+	}
+}
+
+func TestCronJobCreateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testBuilder, err := buildValidCronJobBuilder(testSettings).Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+}
+
+func buildValidCronJobBuilder(apiClient *clients.Settings) *CronJobBuilder {
+	return NewCronJobBuilder(apiClient, defaultCronJobName, defaultJobNamespace, defaultCronJobSchedule,
+		&corev1.Container{Name: "test-container"})
+}
+
+func TestCronJobDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidCronJobBuilder(testSettings)
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("cronjob object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestCronJobWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "cronjobs", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidCronJobBuilder(testSettings).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidCronJobBuilder(existingSettings).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
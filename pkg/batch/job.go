@@ -0,0 +1,421 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// JobBuilder provides struct for job object containing connection to the cluster and the job definitions.
+type JobBuilder struct {
+	// Job definition. Used to create the job object.
+	Definition *batchv1.Job
+	// Created job object.
+	Object *batchv1.Job
+	// Used in functions that define or mutate job definition. errorMsg is processed before the job object is
+	// created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// JobAdditionalOptions additional options for job object.
+type JobAdditionalOptions func(builder *JobBuilder) (*JobBuilder, error)
+
+// NewJobBuilder creates a new instance of JobBuilder. The pods of the job are not restarted on failure, failed pods
+// are replaced until the backoffLimit is reached.
+func NewJobBuilder(apiClient *clients.Settings, name, nsname string, containerSpec *corev1.Container) *JobBuilder {
+	logging.V(100).Infof(
+		"Initializing new job structure with the following params: name: %s, namespace: %s, containerSpec %v",
+		name, nsname, containerSpec)
+
+	builder := JobBuilder{
+		apiClient: apiClient,
+		Definition: &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyNever,
+					},
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the job is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the job is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'namespace' cannot be empty"))
+	}
+
+	if containerSpec == nil {
+		logging.V(100).Infof("The container spec of the job is nil")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'containerSpec' cannot be nil"))
+
+		return &builder
+	}
+
+	builder.Definition.Spec.Template.Spec.Containers = []corev1.Container{*containerSpec}
+
+	return &builder
+}
+
+// PullJob loads an existing job into JobBuilder struct.
+func PullJob(apiClient *clients.Settings, name, nsname string) (*JobBuilder, error) {
+	logging.V(100).Infof("Pulling existing job name: %s under namespace: %s", name, nsname)
+
+	builder := JobBuilder{
+		apiClient: apiClient,
+		Definition: &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'namespace' cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("job object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithBackoffLimit sets the number of retries before the job is marked as failed.
+func (builder *JobBuilder) WithBackoffLimit(backoffLimit int32) *JobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting backoffLimit %d in job %s in namespace %s",
+		backoffLimit, builder.Definition.Name, builder.Definition.Namespace)
+
+	if backoffLimit < 0 {
+		logging.V(100).Infof("The backoffLimit of the job is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'backoffLimit' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.BackoffLimit = &backoffLimit
+
+	return builder
+}
+
+// WithCompletions sets the number of pods that must complete successfully for the job to complete.
+func (builder *JobBuilder) WithCompletions(completions int32) *JobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting completions %d in job %s in namespace %s",
+		completions, builder.Definition.Name, builder.Definition.Namespace)
+
+	if completions < 1 {
+		logging.V(100).Infof("The completions of the job is not positive")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'completions' must be positive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Completions = &completions
+
+	return builder
+}
+
+// WithParallelism sets the maximum number of pods of the job running at the same time.
+func (builder *JobBuilder) WithParallelism(parallelism int32) *JobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting parallelism %d in job %s in namespace %s",
+		parallelism, builder.Definition.Name, builder.Definition.Namespace)
+
+	if parallelism < 0 {
+		logging.V(100).Infof("The parallelism of the job is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("job 'parallelism' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Parallelism = &parallelism
+
+	return builder
+}
+
+// WithOptions creates job with generic mutation options.
+func (builder *JobBuilder) WithOptions(options ...JobAdditionalOptions) *JobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting job additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a job in cluster and stores the created object in struct.
+func (builder *JobBuilder) Create() (*JobBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating job %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
+		builder.Object, err = builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *JobBuilder) WithDryRun(dryRun bool) *JobBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for job %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the job definition against the API server and its admission webhooks with a server-side
+// dry-run request. The job is not created and the builder Object is not modified.
+func (builder *JobBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the job %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Exists checks whether the given job exists.
+func (builder *JobBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if job %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the job and its pods from the cluster.
+func (builder *JobBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting job %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+
+	deleteOptions := metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitForCompletion waits for the duration of the defined timeout or until the job completes. It returns early with
+// the reason and message of the Failed condition when the job fails.
+func (builder *JobBuilder) WaitForCompletion(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until job %s in namespace %s completes",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for job completion because it does not exist")
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.K8sClient.BatchV1().Jobs(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				return false, nil
+			}
+
+			for _, condition := range builder.Object.Status.Conditions {
+				if condition.Status != corev1.ConditionTrue {
+					continue
+				}
+
+				if condition.Type == batchv1.JobComplete {
+					return true, nil
+				}
+
+				if condition.Type == batchv1.JobFailed {
+					logging.V(100).Infof("The job %s failed with reason %s", builder.Definition.Name, condition.Reason)
+
+					return false, fmt.Errorf("job %s in namespace %s failed with reason %s: %s",
+						builder.Definition.Name, builder.Definition.Namespace, condition.Reason, condition.Message)
+				}
+			}
+
+			return false, nil
+		})
+}
+
+// GetJobGVR returns job's GroupVersionResource which could be used for Clean function.
+func GetJobGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+}
+
+// Diff compares the job definition with the job object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *JobBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing job %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"job object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *JobBuilder) validate() (bool, error) {
+	resourceCRD := "Job"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
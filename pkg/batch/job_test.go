@@ -0,0 +1,263 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultJobName      = "test-job"
+	defaultJobNamespace = "test-namespace"
+)
+
+func TestNewJobBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		containerSpec *corev1.Container
+		expectedError string
+	}{
+		{
+			name:          defaultJobName,
+			nsname:        defaultJobNamespace,
+			containerSpec: &corev1.Container{Name: "test-container"},
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultJobNamespace,
+			containerSpec: &corev1.Container{Name: "test-container"},
+			expectedError: "job 'name' cannot be empty",
+		},
+		{
+			name:          defaultJobName,
+			nsname:        "",
+			containerSpec: &corev1.Container{Name: "test-container"},
+			expectedError: "job 'namespace' cannot be empty",
+		},
+		{
+			name:          defaultJobName,
+			nsname:        defaultJobNamespace,
+			containerSpec: nil,
+			expectedError: "job 'containerSpec' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewJobBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.nsname, testCase.containerSpec)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, corev1.RestartPolicyNever, testBuilder.Definition.Spec.Template.Spec.RestartPolicy)
+		assert.Equal(t, "test-container", testBuilder.Definition.Spec.Template.Spec.Containers[0].Name)
+	}
+}
+
+func TestPullJob(t *testing.T) {
+	testCases := []struct {
+		name          string
+		exists        bool
+		expectedError string
+	}{
+		{
+			name:          defaultJobName,
+			exists:        true,
+			expectedError: "",
+		},
+		{
+			name:          defaultJobName,
+			exists:        false,
+			expectedError: "job object test-job doesn't exist in namespace test-namespace",
+		},
+		{
+			name:          "",
+			exists:        true,
+			expectedError: "job 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyJob(batchv1.JobStatus{}))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := PullJob(testSettings, testCase.name, defaultJobNamespace)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, defaultJobName, testBuilder.Definition.Name)
+	}
+}
+
+func TestJobMutators(t *testing.T) {
+	testBuilder := buildValidJobBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithBackoffLimit(2).
+		WithCompletions(3).
+		WithParallelism(1)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, int32(2), *testBuilder.Definition.Spec.BackoffLimit)
+	assert.Equal(t, int32(3), *testBuilder.Definition.Spec.Completions)
+	assert.Equal(t, int32(1), *testBuilder.Definition.Spec.Parallelism)
+
+	testCases := []struct {
+		mutator       func(*JobBuilder) *JobBuilder
+		expectedError string
+	}{
+		{
+			mutator:       func(builder *JobBuilder) *JobBuilder { return builder.WithBackoffLimit(-1) },
+			expectedError: "job 'backoffLimit' cannot be negative",
+		},
+		{
+			mutator:       func(builder *JobBuilder) *JobBuilder { return builder.WithCompletions(0) },
+			expectedError: "job 'completions' must be positive",
+		},
+		{
+			mutator:       func(builder *JobBuilder) *JobBuilder { return builder.WithParallelism(-1) },
+			expectedError: "job 'parallelism' cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.mutator(buildValidJobBuilder(clients.GetTestClients(clients.TestClientParams{})))
+		assert.EqualError(t, testBuilder.errorMsg, testCase.expectedError)
+	}
+}
+
+func TestJobCreateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testBuilder, err := buildValidJobBuilder(testSettings).Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestJobWaitForCompletion(t *testing.T) {
+	testCases := []struct {
+		status        batchv1.JobStatus
+		exists        bool
+		expectedError error
+	}{
+		{
+			status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+				Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}},
+			exists:        true,
+			expectedError: nil,
+		},
+		{
+			status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			}}},
+			exists: true,
+			expectedError: fmt.Errorf("job test-job in namespace test-namespace failed with reason " +
+				"BackoffLimitExceeded: Job has reached the specified backoff limit"),
+		},
+		{
+			status:        batchv1.JobStatus{Active: 1},
+			exists:        true,
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("cannot wait for job completion because it does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyJob(testCase.status))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		err := buildValidJobBuilder(testSettings).WaitForCompletion(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidJobBuilder(apiClient *clients.Settings) *JobBuilder {
+	return NewJobBuilder(apiClient, defaultJobName, defaultJobNamespace, &corev1.Container{Name: "test-container"})
+}
+
+func buildDummyJob(status batchv1.JobStatus) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultJobName,
+			Namespace: defaultJobNamespace,
+		},
+		Status: status,
+	}
+}
+
+func TestJobDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidJobBuilder(testSettings)
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("job object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestJobWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "jobs", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidJobBuilder(testSettings).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidJobBuilder(existingSettings).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
@@ -2,11 +2,13 @@ package bmh
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"fmt"
@@ -17,6 +19,7 @@ import (
 	"golang.org/x/exp/slices"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // BmhBuilder provides struct for the bmh object containing connection to
@@ -25,7 +28,7 @@ type BmhBuilder struct {
 	Definition *bmhv1alpha1.BareMetalHost
 	Object     *bmhv1alpha1.BareMetalHost
 	apiClient  *clients.Settings
-	errorMsg   string
+	errorMsg   error
 }
 
 // AdditionalOptions additional options for bmh object.
@@ -63,36 +66,36 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the baremetalhost is empty")
+		logging.V(100).Infof("The name of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BMH 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the baremetalhost is empty")
+		logging.V(100).Infof("The namespace of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BMH 'nsname' cannot be empty"))
 	}
 
 	if bmcAddress == "" {
-		glog.V(100).Infof("The bootmacaddress of the baremetalhost is empty")
+		logging.V(100).Infof("The bootmacaddress of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'bmcAddress' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BMH 'bmcAddress' cannot be empty"))
 	}
 
 	if bmcSecretName == "" {
-		glog.V(100).Infof("The bmcsecret of the baremetalhost is empty")
+		logging.V(100).Infof("The bmcsecret of the baremetalhost is empty")
 
-		builder.errorMsg = "BMH 'bmcSecretName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BMH 'bmcSecretName' cannot be empty"))
 	}
 
 	bootModeAcceptable := []string{"UEFI", "UEFISecureBoot", "legacy"}
 	if !slices.Contains(bootModeAcceptable, bootMode) {
-		builder.errorMsg = "Not acceptable 'bootMode' value"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Not acceptable 'bootMode' value"))
 	}
 
 	if bootMacAddress == "" {
-		builder.errorMsg = "BMH 'bootMacAddress' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BMH 'bootMacAddress' cannot be empty"))
 	}
 
 	return &builder
@@ -105,12 +108,13 @@ func (builder *BmhBuilder) WithRootDeviceDeviceName(deviceName string) *BmhBuild
 	}
 
 	if deviceName == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint deviceName is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint deviceName is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint deviceName cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the baremetalhost rootDeviceHint deviceName cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -130,12 +134,12 @@ func (builder *BmhBuilder) WithRootDeviceHTCL(hctl string) *BmhBuilder {
 	}
 
 	if hctl == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint hctl is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint hctl is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint hctl cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the baremetalhost rootDeviceHint hctl cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -155,12 +159,12 @@ func (builder *BmhBuilder) WithRootDeviceModel(model string) *BmhBuilder {
 	}
 
 	if model == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint model is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint model is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint model cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the baremetalhost rootDeviceHint model cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -180,12 +184,13 @@ func (builder *BmhBuilder) WithRootDeviceVendor(vendor string) *BmhBuilder {
 	}
 
 	if vendor == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint vendor is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint vendor is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint vendor cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the baremetalhost rootDeviceHint vendor cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -205,12 +210,13 @@ func (builder *BmhBuilder) WithRootDeviceSerialNumber(serialNumber string) *BmhB
 	}
 
 	if serialNumber == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint serialNumber is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint serialNumber is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint serialNumber cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the baremetalhost rootDeviceHint serialNumber cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -230,12 +236,13 @@ func (builder *BmhBuilder) WithRootDeviceMinSizeGigabytes(size int) *BmhBuilder
 	}
 
 	if size < 0 {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint size is less than 0")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint size is less than 0")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint size cannot be less than 0"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the baremetalhost rootDeviceHint size cannot be less than 0"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -255,12 +262,12 @@ func (builder *BmhBuilder) WithRootDeviceWWN(wwn string) *BmhBuilder {
 	}
 
 	if wwn == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint wwn is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint wwn is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint wwn cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the baremetalhost rootDeviceHint wwn cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -280,12 +287,13 @@ func (builder *BmhBuilder) WithRootDeviceWWNWithExtension(wwnWithExtension strin
 	}
 
 	if wwnWithExtension == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint wwnWithExtension is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint wwnWithExtension is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint wwnWithExtension cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the baremetalhost rootDeviceHint wwnWithExtension cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -305,12 +313,13 @@ func (builder *BmhBuilder) WithRootDeviceWWNVendorExtension(wwnVendorExtension s
 	}
 
 	if wwnVendorExtension == "" {
-		glog.V(100).Infof("The baremetalhost rootDeviceHint wwnVendorExtension is empty")
+		logging.V(100).Infof("The baremetalhost rootDeviceHint wwnVendorExtension is empty")
 
-		builder.errorMsg = "the baremetalhost rootDeviceHint wwnVendorExtension cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the baremetalhost rootDeviceHint wwnVendorExtension cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -344,16 +353,16 @@ func (builder *BmhBuilder) WithOptions(options ...AdditionalOptions) *BmhBuilder
 		return builder
 	}
 
-	glog.V(100).Infof("Setting bmh additional options")
+	logging.V(100).Infof("Setting bmh additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -365,7 +374,7 @@ func (builder *BmhBuilder) WithOptions(options ...AdditionalOptions) *BmhBuilder
 
 // Pull pulls existing baremetalhost from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error) {
-	glog.V(100).Infof("Pulling existing baremetalhost name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing baremetalhost name %s under namespace %s from cluster", name, nsname)
 
 	builder := BmhBuilder{
 		apiClient: apiClient,
@@ -378,15 +387,15 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the baremetalhost is empty")
+		logging.V(100).Infof("The name of the baremetalhost is empty")
 
-		builder.errorMsg = "baremetalhost 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("baremetalhost 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the baremetalhost is empty")
+		logging.V(100).Infof("The namespace of the baremetalhost is empty")
 
-		builder.errorMsg = "baremetalhost 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("baremetalhost 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -404,7 +413,7 @@ func (builder *BmhBuilder) Create() (*BmhBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the baremetalhost %s in namespace %s",
+	logging.V(100).Infof("Creating the baremetalhost %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -424,7 +433,7 @@ func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the baremetalhost %s in namespace %s",
+	logging.V(100).Infof("Deleting the baremetalhost %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -442,13 +451,38 @@ func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
 	return builder, nil
 }
 
+// Update renovates the existing bmh object with the bmh definition in builder.
+func (builder *BmhBuilder) Update() (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the baremetalhost %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update bmh, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err != nil {
+		return builder, fmt.Errorf("can not update bmh: %w", err)
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
 // Get returns bmh object if found.
 func (builder *BmhBuilder) Get() (*bmhv1alpha1.BareMetalHost, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting baremetalhost %s in namespace %s",
+	logging.V(100).Infof("Getting baremetalhost %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	bmh := &bmhv1alpha1.BareMetalHost{}
@@ -470,7 +504,7 @@ func (builder *BmhBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if baremetalhost %s exists in namespace %s",
+	logging.V(100).Infof("Checking if baremetalhost %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -485,7 +519,7 @@ func (builder *BmhBuilder) GetBmhOperationalState() bmhv1alpha1.OperationalStatu
 		return ""
 	}
 
-	glog.V(100).Infof("Pull OperationalStatus value for %s baremetalhost within %s namespace",
+	logging.V(100).Infof("Pull OperationalStatus value for %s baremetalhost within %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -501,7 +535,7 @@ func (builder *BmhBuilder) GetBmhPowerOnStatus() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Pull PoweredOn value for %s baremetalhost within %s namespace",
+	logging.V(100).Infof("Pull PoweredOn value for %s baremetalhost within %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -511,13 +545,81 @@ func (builder *BmhBuilder) GetBmhPowerOnStatus() bool {
 	return builder.Object.Status.PoweredOn
 }
 
+// GetProvisioningState returns the current provisioning state of the bmh.
+func (builder *BmhBuilder) GetProvisioningState() bmhv1alpha1.ProvisioningState {
+	if valid, _ := builder.validate(); !valid {
+		return ""
+	}
+
+	logging.V(100).Infof("Pull provisioning state value for %s baremetalhost within %s namespace",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return ""
+	}
+
+	return builder.Object.Status.Provisioning.State
+}
+
+// GetHardwareProfile returns the name of the hardware profile matching the inspected hardware of the bmh.
+func (builder *BmhBuilder) GetHardwareProfile() string {
+	if valid, _ := builder.validate(); !valid {
+		return ""
+	}
+
+	logging.V(100).Infof("Pull HardwareProfile value for %s baremetalhost within %s namespace",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return ""
+	}
+
+	return builder.Object.Status.HardwareProfile
+}
+
+// PowerOn powers the bmh on and waits for timeout duration or until it reports being powered on.
+func (builder *BmhBuilder) PowerOn(timeout time.Duration) error {
+	return builder.setOnline(true, timeout)
+}
+
+// PowerOff powers the bmh off and waits for timeout duration or until it reports being powered off.
+func (builder *BmhBuilder) PowerOff(timeout time.Duration) error {
+	return builder.setOnline(false, timeout)
+}
+
+// Reboot asks the baremetal operator to power cycle the bmh through the reboot annotation. The operator removes the
+// annotation once the host is rebooted.
+func (builder *BmhBuilder) Reboot() (*BmhBuilder, error) {
+	return builder.setAnnotation(bmhv1alpha1.RebootAnnotationPrefix, "", true)
+}
+
+// Pause stops the baremetal operator from reconciling the bmh.
+func (builder *BmhBuilder) Pause() (*BmhBuilder, error) {
+	return builder.setAnnotation(bmhv1alpha1.PausedAnnotation, "", false)
+}
+
+// Unpause resumes the reconciliation of the bmh by the baremetal operator.
+func (builder *BmhBuilder) Unpause() (*BmhBuilder, error) {
+	return builder.removeAnnotation(bmhv1alpha1.PausedAnnotation)
+}
+
+// DisableInspection keeps the baremetal operator from inspecting the hardware of the bmh.
+func (builder *BmhBuilder) DisableInspection() (*BmhBuilder, error) {
+	return builder.setAnnotation(bmhv1alpha1.InspectAnnotationPrefix, "disabled", false)
+}
+
+// Detach stops the provisioner from managing the bmh without deprovisioning it.
+func (builder *BmhBuilder) Detach() (*BmhBuilder, error) {
+	return builder.setAnnotation(bmhv1alpha1.DetachedAnnotation, "", false)
+}
+
 // CreateAndWaitUntilProvisioned creates bmh object and waits until bmh is provisioned.
 func (builder *BmhBuilder) CreateAndWaitUntilProvisioned(timeout time.Duration) (*BmhBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof(`Creating the baremetalhost %s in namespace %s and 
+	logging.V(100).Infof(`Creating the baremetalhost %s in namespace %s and 
 	waiting for the defined period until it's created`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -580,7 +682,7 @@ func (builder *BmhBuilder) DeleteAndWaitUntilDeleted(timeout time.Duration) (*Bm
 		return builder, err
 	}
 
-	glog.V(100).Infof(`Deleting baremetalhost %s in namespace %s and 
+	logging.V(100).Infof(`Deleting baremetalhost %s in namespace %s and 
 	waiting for the defined period until it's removed`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -604,7 +706,7 @@ func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration) error {
 		context.TODO(), time.Second, timeout, false, func(ctx context.Context) (bool, error) {
 			_, err := builder.Get()
 			if err == nil {
-				glog.V(100).Infof("bmh %s/%s still present",
+				logging.V(100).Infof("bmh %s/%s still present",
 					builder.Definition.Namespace,
 					builder.Definition.Name)
 
@@ -612,14 +714,14 @@ func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration) error {
 			}
 
 			if k8serrors.IsNotFound(err) {
-				glog.V(100).Infof("bmh %s/%s is gone",
+				logging.V(100).Infof("bmh %s/%s is gone",
 					builder.Definition.Namespace,
 					builder.Definition.Name)
 
 				return true, nil
 			}
 
-			glog.V(100).Infof("failed to get bmh %s/%s: %v",
+			logging.V(100).Infof("failed to get bmh %s/%s: %v",
 				builder.Definition.Namespace,
 				builder.Definition.Name, err)
 
@@ -629,33 +731,134 @@ func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration) error {
 	return err
 }
 
+// setOnline sets the online field of the bmh and waits for timeout duration or until the power state of the bmh
+// matches it. Only the online field is patched, so the rest of the live spec is not overwritten by the definition.
+func (builder *BmhBuilder) setOnline(online bool, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Setting online to %t for baremetalhost %s in namespace %s",
+		online, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := builder.patch(map[string]interface{}{"spec": map[string]interface{}{"online": online}})
+	if err != nil {
+		return err
+	}
+
+	builder.Definition.Spec.Online = online
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			return builder.Object.Status.PoweredOn == online, nil
+		})
+}
+
+// setAnnotation sets the given annotation on the bmh on the cluster. Unless oneShot is set, the annotation is also
+// kept in the definition so that a later Update does not remove it. One-shot annotations, such as the reboot one,
+// are removed by the operator once handled and must not be sent again by a later Update.
+func (builder *BmhBuilder) setAnnotation(key, value string, oneShot bool) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Setting annotation %s=%s on baremetalhost %s in namespace %s",
+		key, value, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := builder.patch(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{key: value}},
+	})
+	if err != nil {
+		return builder, err
+	}
+
+	if !oneShot {
+		if builder.Definition.Annotations == nil {
+			builder.Definition.Annotations = make(map[string]string)
+		}
+
+		builder.Definition.Annotations[key] = value
+	}
+
+	return builder, nil
+}
+
+// removeAnnotation removes the given annotation from the bmh on the cluster and from the definition.
+func (builder *BmhBuilder) removeAnnotation(key string) (*BmhBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Removing annotation %s from baremetalhost %s in namespace %s",
+		key, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := builder.patch(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{key: nil}},
+	})
+	if err != nil {
+		return builder, err
+	}
+
+	delete(builder.Definition.Annotations, key)
+
+	return builder, nil
+}
+
+// patch sends the given merge patch for the live bmh and stores the patched object in the builder.
+func (builder *BmhBuilder) patch(patch map[string]interface{}) error {
+	if !builder.Exists() {
+		return fmt.Errorf("failed to patch bmh, object doesn't exist on cluster")
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	err = builder.apiClient.Patch(context.TODO(), builder.Object, goclient.RawPatch(types.MergePatchType, data))
+	if err != nil {
+		return fmt.Errorf("can not patch bmh: %w", err)
+	}
+
+	return nil
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BmhBuilder) validate() (bool, error) {
 	resourceCRD := "BareMetalHost"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,242 @@
+package bmh
+
+import (
+	"testing"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultBmhName      = "test-bmh"
+	defaultBmhNamespace = "test-ns"
+	defaultBmhImageURL  = "http://images/rhcos.iso"
+)
+
+func TestBmhAnnotationOperations(t *testing.T) {
+	testCases := []struct {
+		operation           func(*BmhBuilder) (*BmhBuilder, error)
+		annotation          string
+		expectedValue       string
+		keptInDefinition    bool
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			operation:           (*BmhBuilder).Reboot,
+			annotation:          bmhv1alpha1.RebootAnnotationPrefix,
+			expectedValue:       "",
+			keptInDefinition:    false,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			operation:           (*BmhBuilder).Pause,
+			annotation:          bmhv1alpha1.PausedAnnotation,
+			expectedValue:       "",
+			keptInDefinition:    true,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			operation:           (*BmhBuilder).Detach,
+			annotation:          bmhv1alpha1.DetachedAnnotation,
+			expectedValue:       "",
+			keptInDefinition:    true,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			operation:           (*BmhBuilder).DisableInspection,
+			annotation:          bmhv1alpha1.InspectAnnotationPrefix,
+			expectedValue:       "disabled",
+			keptInDefinition:    true,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			operation:           (*BmhBuilder).Reboot,
+			annotation:          bmhv1alpha1.RebootAnnotationPrefix,
+			addToRuntimeObjects: false,
+			expectedError:       "failed to patch bmh, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyBmh(false))
+		}
+
+		testBuilder := buildValidBmhBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}))
+
+		testBuilder, err := testCase.operation(testBuilder)
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		liveBmh, err := testBuilder.Get()
+		assert.Nil(t, err)
+
+		value, found := liveBmh.Annotations[testCase.annotation]
+		assert.True(t, found)
+		assert.Equal(t, testCase.expectedValue, value)
+
+		// The stale definition, which has no image, must not overwrite the live spec.
+		assert.Equal(t, defaultBmhImageURL, liveBmh.Spec.Image.URL)
+
+		_, found = testBuilder.Definition.Annotations[testCase.annotation]
+		assert.Equal(t, testCase.keptInDefinition, found)
+	}
+}
+
+func TestBmhRebootNotRepeatedByUpdate(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyBmh(false)},
+	})
+
+	testBuilder, err := Pull(testSettings, defaultBmhName, defaultBmhNamespace)
+	assert.Nil(t, err)
+
+	testBuilder, err = testBuilder.Reboot()
+	assert.Nil(t, err)
+	assert.NotContains(t, testBuilder.Definition.Annotations, bmhv1alpha1.RebootAnnotationPrefix)
+
+	// The update must not send the reboot annotation again, which would reboot the host a second time.
+	testBuilder, err = testBuilder.Update()
+	assert.Nil(t, err)
+
+	liveBmh, err := testBuilder.Get()
+	assert.Nil(t, err)
+	assert.NotContains(t, liveBmh.Annotations, bmhv1alpha1.RebootAnnotationPrefix)
+}
+
+func TestBmhUnpause(t *testing.T) {
+	bmh := buildDummyBmh(false)
+	bmh.Annotations = map[string]string{bmhv1alpha1.PausedAnnotation: "", "keep": "true"}
+
+	testBuilder := buildValidBmhBuilder(clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{bmh},
+	}))
+
+	testBuilder, err := testBuilder.Pause()
+	assert.Nil(t, err)
+
+	testBuilder, err = testBuilder.Unpause()
+	assert.Nil(t, err)
+	assert.NotContains(t, testBuilder.Definition.Annotations, bmhv1alpha1.PausedAnnotation)
+
+	liveBmh, err := testBuilder.Get()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"keep": "true"}, liveBmh.Annotations)
+}
+
+func TestBmhPower(t *testing.T) {
+	testCases := []struct {
+		powerOn       bool
+		poweredOn     bool
+		expectedError string
+	}{
+		{
+			powerOn:       true,
+			poweredOn:     true,
+			expectedError: "",
+		},
+		{
+			powerOn:       false,
+			poweredOn:     false,
+			expectedError: "",
+		},
+		{
+			powerOn:       true,
+			poweredOn:     false,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		bmh := buildDummyBmh(testCase.poweredOn)
+		bmh.Spec.Online = !testCase.powerOn
+
+		testBuilder := buildValidBmhBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{bmh},
+		}))
+
+		var err error
+
+		if testCase.powerOn {
+			err = testBuilder.PowerOn(time.Second)
+		} else {
+			err = testBuilder.PowerOff(time.Second)
+		}
+
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+
+		liveBmh, err := testBuilder.Get()
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.powerOn, liveBmh.Spec.Online)
+		assert.Equal(t, defaultBmhImageURL, liveBmh.Spec.Image.URL)
+		assert.Equal(t, testCase.powerOn, testBuilder.Definition.Spec.Online)
+	}
+}
+
+func TestBmhStatusAccessors(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedState       bmhv1alpha1.ProvisioningState
+		expectedProfile     string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedState:       bmhv1alpha1.StateProvisioned,
+			expectedProfile:     "unknown",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedState:       "",
+			expectedProfile:     "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyBmh(true))
+		}
+
+		testBuilder := buildValidBmhBuilder(clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}))
+
+		assert.Equal(t, testCase.expectedState, testBuilder.GetProvisioningState())
+		assert.Equal(t, testCase.expectedProfile, testBuilder.GetHardwareProfile())
+	}
+}
+
+func buildValidBmhBuilder(apiClient *clients.Settings) *BmhBuilder {
+	return NewBuilder(apiClient, defaultBmhName, defaultBmhNamespace, "redfish-virtualmedia://10.1.1.1/redfish/v1",
+		"bmc-secret", "aa:bb:cc:dd:ee:ff", "UEFI")
+}
+
+func buildDummyBmh(poweredOn bool) *bmhv1alpha1.BareMetalHost {
+	return &bmhv1alpha1.BareMetalHost{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBmhName,
+			Namespace: defaultBmhNamespace,
+		},
+		Spec: bmhv1alpha1.BareMetalHostSpec{
+			Online: true,
+			Image:  &bmhv1alpha1.Image{URL: defaultBmhImageURL},
+		},
+		Status: bmhv1alpha1.BareMetalHostStatus{
+			PoweredOn:       poweredOn,
+			HardwareProfile: "unknown",
+			Provisioning:    bmhv1alpha1.ProvisionStatus{State: bmhv1alpha1.StateProvisioned},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package bmhtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DataImageSpec defines the desired state of DataImage.
+type DataImageSpec struct {
+	// url of the image attached to the host as virtual media.
+	URL string `json:"url"`
+}
+
+// AttachedImageReference describes the image attached to the host.
+type AttachedImageReference struct {
+	// url of the attached image.
+	URL string `json:"url"`
+}
+
+// DataImageError describes the last error met attaching or detaching the image.
+type DataImageError struct {
+	// count of the consecutive failures.
+	Count int `json:"count"`
+	// message of the last failure.
+	Message string `json:"message"`
+}
+
+// DataImageStatus defines the observed state of DataImage.
+type DataImageStatus struct {
+	// lastReconciled is when the DataImage was last reconciled.
+	LastReconciled *metav1.Time `json:"lastReconciled,omitempty"`
+	// attachedImage is the image currently attached to the host.
+	AttachedImage AttachedImageReference `json:"attachedImage,omitempty"`
+	// error met attaching or detaching the image.
+	Error DataImageError `json:"error,omitempty"`
+}
+
+// DataImage is the Schema for the dataimages API. It attaches an image to the BareMetalHost of the same name as
+// virtual media.
+type DataImage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataImageSpec   `json:"spec,omitempty"`
+	Status DataImageStatus `json:"status,omitempty"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImage.
+func (in *DataImage) DeepCopy() *DataImage {
+	if in == nil {
+		return nil
+	}
+
+	out := new(DataImage)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+
+	if in.Status.LastReconciled != nil {
+		out.Status.LastReconciled = in.Status.LastReconciled.DeepCopy()
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataImage) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
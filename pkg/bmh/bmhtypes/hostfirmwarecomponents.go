@@ -0,0 +1,112 @@
+package bmhtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// FirmwareUpdate defines a firmware update of a component of the host.
+type FirmwareUpdate struct {
+	// component to update, bios or bmc.
+	Component string `json:"component"`
+	// url of the firmware image.
+	URL string `json:"url"`
+}
+
+// FirmwareComponentStatus describes the firmware of a component of the host.
+type FirmwareComponentStatus struct {
+	// component of the host, bios or bmc.
+	Component string `json:"component"`
+	// initialVersion of the firmware, when the host was registered.
+	InitialVersion string `json:"initialVersion"`
+	// currentVersion of the firmware.
+	CurrentVersion string `json:"currentVersion,omitempty"`
+	// lastVersionFlashed is the version of the last firmware update applied.
+	LastVersionFlashed string `json:"lastVersionFlashed,omitempty"`
+	// updated is when the firmware of the component was last updated.
+	Updated metav1.Time `json:"updated,omitempty"`
+}
+
+// HostFirmwareComponentsSpec defines the desired state of HostFirmwareComponents.
+type HostFirmwareComponentsSpec struct {
+	// updates to apply on the next reboot of the host.
+	Updates []FirmwareUpdate `json:"updates"`
+}
+
+// HostFirmwareComponentsStatus defines the observed state of HostFirmwareComponents.
+type HostFirmwareComponentsStatus struct {
+	// updates applied, or being applied, to the host.
+	Updates []FirmwareUpdate `json:"updates,omitempty"`
+	// components of the host and the version of their firmware.
+	Components []FirmwareComponentStatus `json:"components,omitempty"`
+	// lastUpdated is when the status was last updated.
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+	// conditions of the HostFirmwareComponents.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// HostFirmwareComponents is the Schema for the hostfirmwarecomponents API. It is generated by the baremetal operator
+// for every BareMetalHost and shares its name.
+type HostFirmwareComponents struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HostFirmwareComponentsSpec   `json:"spec,omitempty"`
+	Status HostFirmwareComponentsStatus `json:"status,omitempty"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostFirmwareComponents.
+func (in *HostFirmwareComponents) DeepCopy() *HostFirmwareComponents {
+	if in == nil {
+		return nil
+	}
+
+	out := new(HostFirmwareComponents)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.Updates = copyFirmwareUpdates(in.Spec.Updates)
+	out.Status.Updates = copyFirmwareUpdates(in.Status.Updates)
+
+	if in.Status.Components != nil {
+		out.Status.Components = make([]FirmwareComponentStatus, len(in.Status.Components))
+
+		for index := range in.Status.Components {
+			out.Status.Components[index] = in.Status.Components[index]
+			in.Status.Components[index].Updated.DeepCopyInto(&out.Status.Components[index].Updated)
+		}
+	}
+
+	if in.Status.LastUpdated != nil {
+		out.Status.LastUpdated = in.Status.LastUpdated.DeepCopy()
+	}
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostFirmwareComponents) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+func copyFirmwareUpdates(in []FirmwareUpdate) []FirmwareUpdate {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]FirmwareUpdate, len(in))
+	copy(out, in)
+
+	return out
+}
@@ -0,0 +1,10 @@
+package bmh
+
+const (
+	// APIGroup represents the metal3 api group.
+	APIGroup = "metal3.io"
+	// APIVersion represents the version of the metal3 api.
+	APIVersion = "v1alpha1"
+	// DataImageKind represents kind of DataImage object.
+	DataImageKind = "DataImage"
+)
@@ -0,0 +1,326 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh/bmhtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DataImageBuilder provides struct for the dataImage object containing connection to
+// the cluster and the dataImage definitions.
+type DataImageBuilder struct {
+	// dataImage Definition, used to create the dataImage object.
+	Definition *bmhtypes.DataImage
+	// created dataImage object.
+	Object *bmhtypes.DataImage
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// used to store latest error message upon defining or mutating dataImage definition.
+	errorMsg error
+}
+
+// NewDataImageBuilder creates a new instance of DataImageBuilder. The dataImage must share the name and namespace of
+// the bmh the image is attached to.
+func NewDataImageBuilder(apiClient *clients.Settings, name, nsname, url string) *DataImageBuilder {
+	logging.V(100).Infof(
+		"Initializing new dataImage structure with the following params: name: %s, namespace: %s, url: %s",
+		name, nsname, url)
+
+	builder := DataImageBuilder{
+		apiClient: apiClient,
+		Definition: &bmhtypes.DataImage{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       DataImageKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: bmhtypes.DataImageSpec{
+				URL: url,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the dataImage is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("dataImage 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the dataImage is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("dataImage 'nsname' cannot be empty"))
+	}
+
+	if url == "" {
+		logging.V(100).Infof("The url of the dataImage is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("dataImage 'url' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullDataImage pulls existing dataImage into Builder struct.
+func PullDataImage(apiClient *clients.Settings, name, nsname string) (*DataImageBuilder, error) {
+	logging.V(100).Infof("Pulling existing dataImage name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("dataImage 'apiClient' cannot be empty")
+	}
+
+	builder := DataImageBuilder{
+		apiClient: apiClient,
+		Definition: &bmhtypes.DataImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the dataImage is empty")
+
+		return nil, fmt.Errorf("dataImage 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the dataImage is empty")
+
+		return nil, fmt.Errorf("dataImage 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("dataImage object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+
+	return &builder, nil
+}
+
+// Exists checks whether the given dataImage exists.
+func (builder *DataImageBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if dataImage %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Get returns a dataImage object if found.
+func (builder *DataImageBuilder) Get() (*bmhtypes.DataImage, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting dataImage %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetDataImageGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("dataImage object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertDataImageToStructured(unsObject)
+}
+
+// Create makes a dataImage in the cluster and stores the created object in struct. The image is attached to the bmh
+// on its next reboot.
+func (builder *DataImageBuilder) Create() (*DataImageBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the dataImage %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredDataImage, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured dataImage to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetDataImageGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredDataImage}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create dataImage %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertDataImageToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes a dataImage from the cluster, which detaches the image from the bmh.
+func (builder *DataImageBuilder) Delete() (*DataImageBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Deleting the dataImage %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("dataImage cannot be deleted because it does not exist")
+	}
+
+	err := builder.apiClient.Resource(GetDataImageGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("can not delete dataImage: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
+
+// WaitUntilAttached waits for timeout duration or until the image of the dataImage is attached to the bmh. On
+// timeout, the last error reported by the dataImage is included in the returned error.
+func (builder *DataImageBuilder) WaitUntilAttached(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until dataImage %s in namespace %s is attached",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			return builder.Object.Status.AttachedImage.URL == builder.Object.Spec.URL, nil
+		})
+
+	if err != nil && builder.Object != nil && builder.Object.Status.Error.Message != "" {
+		return fmt.Errorf("dataImage %s is not attached after %d failures, last error %s: %w",
+			builder.Definition.Name, builder.Object.Status.Error.Count, builder.Object.Status.Error.Message, err)
+	}
+
+	return err
+}
+
+// DeleteAndWaitUntilDetached deletes the dataImage and waits for timeout duration or until the image is detached
+// from the bmh, at which point the baremetal operator removes the dataImage finalizer.
+func (builder *DataImageBuilder) DeleteAndWaitUntilDetached(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting dataImage %s in namespace %s and waiting until it is detached",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if _, err := builder.Delete(); err != nil {
+		return err
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.Get()
+			if err == nil {
+				return false, nil
+			}
+
+			if k8serrors.IsNotFound(err) {
+				return true, nil
+			}
+
+			return false, err
+		})
+}
+
+// GetDataImageGVR returns dataImage's GroupVersionResource which could be used for Clean function.
+func GetDataImageGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "dataimages"}
+}
+
+// convertDataImageToStructured converts the unstructured object returned by the dynamic client to a dataImage.
+func convertDataImageToStructured(unsObject *unstructured.Unstructured) (*bmhtypes.DataImage, error) {
+	dataImage := &bmhtypes.DataImage{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, dataImage)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to dataImage object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return dataImage, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *DataImageBuilder) validate() (bool, error) {
+	resourceCRD := "dataImage"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,296 @@
+package bmh
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh/bmhtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const defaultDataImageURL = "http://images/data.iso"
+
+var dataImageGVK = schema.GroupVersionKind{
+	Group:   APIGroup,
+	Version: APIVersion,
+	Kind:    DataImageKind,
+}
+
+func TestNewDataImageBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		url           string
+		expectedError string
+	}{
+		{
+			name:          defaultBmhName,
+			nsname:        defaultBmhNamespace,
+			url:           defaultDataImageURL,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultBmhNamespace,
+			url:           defaultDataImageURL,
+			expectedError: "dataImage 'name' cannot be empty",
+		},
+		{
+			name:          defaultBmhName,
+			nsname:        "",
+			url:           defaultDataImageURL,
+			expectedError: "dataImage 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultBmhName,
+			nsname:        defaultBmhNamespace,
+			url:           "",
+			expectedError: "dataImage 'url' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewDataImageBuilder(
+			buildTestClientWithDummyDataImage(nil), testCase.name, testCase.nsname, testCase.url)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.nsname, testBuilder.Definition.Namespace)
+			assert.Equal(t, testCase.url, testBuilder.Definition.Spec.URL)
+		}
+	}
+}
+
+func TestPullDataImage(t *testing.T) {
+	testCases := []struct {
+		name                string
+		nsname              string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "dataImage 'name' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "dataImage 'nsname' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: "dataImage object " + defaultBmhName +
+				" doesn't exist in namespace " + defaultBmhNamespace,
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "dataImage 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDataImage(""))
+		}
+
+		if testCase.client {
+			testSettings = buildTestClientWithDummyDataImage(runtimeObjects)
+		}
+
+		testBuilder, err := PullDataImage(testSettings, testCase.name, testCase.nsname)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+
+			// The definition must be a copy, so mutating it does not change the pulled object.
+			testBuilder.Definition.Spec.URL = "http://images/other.iso"
+			assert.Equal(t, defaultDataImageURL, testBuilder.Object.Spec.URL)
+		}
+	}
+}
+
+func TestDataImageCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *DataImageBuilder
+		expectedError string
+	}{
+		{
+			testBuilder: NewDataImageBuilder(
+				buildTestClientWithDummyDataImage(nil), defaultBmhName, defaultBmhNamespace, defaultDataImageURL),
+			expectedError: "",
+		},
+		{
+			testBuilder: NewDataImageBuilder(
+				buildTestClientWithDummyDataImage([]runtime.Object{buildDummyDataImage("")}),
+				defaultBmhName, defaultBmhNamespace, defaultDataImageURL),
+			expectedError: "",
+		},
+		{
+			testBuilder: NewDataImageBuilder(
+				buildTestClientWithDummyDataImage(nil), defaultBmhName, defaultBmhNamespace, ""),
+			expectedError: "dataImage 'url' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, defaultBmhName, testBuilder.Object.Name)
+			assert.Equal(t, defaultDataImageURL, testBuilder.Object.Spec.URL)
+		}
+	}
+}
+
+func TestDataImageDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "dataImage cannot be deleted because it does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDataImage(""))
+		}
+
+		testBuilder := NewDataImageBuilder(buildTestClientWithDummyDataImage(runtimeObjects),
+			defaultBmhName, defaultBmhNamespace, defaultDataImageURL)
+
+		testBuilder, err := testBuilder.Delete()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Nil(t, testBuilder.Object)
+			assert.False(t, testBuilder.Exists())
+		}
+	}
+}
+
+func TestDataImageWaitUntilAttached(t *testing.T) {
+	testCases := []struct {
+		attachedURL   string
+		errorMessage  string
+		expectedError string
+	}{
+		{
+			attachedURL:   defaultDataImageURL,
+			expectedError: "",
+		},
+		{
+			attachedURL:   "",
+			expectedError: "context deadline exceeded",
+		},
+		{
+			attachedURL:  "",
+			errorMessage: "failed to attach virtual media",
+			expectedError: fmt.Sprintf("dataImage %s is not attached after 2 failures, last error "+
+				"failed to attach virtual media: context deadline exceeded", defaultBmhName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		dataImage := buildDummyDataImage(testCase.attachedURL)
+
+		if testCase.errorMessage != "" {
+			dataImage.Status.Error = bmhtypes.DataImageError{Count: 2, Message: testCase.errorMessage}
+		}
+
+		testBuilder := NewDataImageBuilder(buildTestClientWithDummyDataImage([]runtime.Object{dataImage}),
+			defaultBmhName, defaultBmhNamespace, defaultDataImageURL)
+
+		err := testBuilder.WaitUntilAttached(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestDataImageDeleteAndWaitUntilDetached(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "dataImage cannot be deleted because it does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDataImage(defaultDataImageURL))
+		}
+
+		testBuilder := NewDataImageBuilder(buildTestClientWithDummyDataImage(runtimeObjects),
+			defaultBmhName, defaultBmhNamespace, defaultDataImageURL)
+
+		err := testBuilder.DeleteAndWaitUntilDetached(time.Second)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.False(t, testBuilder.Exists())
+		}
+	}
+}
+
+func buildDummyDataImage(attachedURL string) *bmhtypes.DataImage {
+	return &bmhtypes.DataImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBmhName,
+			Namespace: defaultBmhNamespace,
+		},
+		Spec: bmhtypes.DataImageSpec{
+			URL: defaultDataImageURL,
+		},
+		Status: bmhtypes.DataImageStatus{
+			AttachedImage: bmhtypes.AttachedImageReference{URL: attachedURL},
+		},
+	}
+}
+
+func buildTestClientWithDummyDataImage(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{dataImageGVK},
+	})
+}
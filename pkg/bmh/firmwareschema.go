@@ -0,0 +1,192 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FirmwareSchemaBuilder provides struct for the FirmwareSchema object containing connection to the cluster and the
+// FirmwareSchema definitions. FirmwareSchemas are generated by the baremetal operator, so they can only be pulled.
+type FirmwareSchemaBuilder struct {
+	Definition *bmhv1alpha1.FirmwareSchema
+	Object     *bmhv1alpha1.FirmwareSchema
+	apiClient  *clients.Settings
+	errorMsg   error
+}
+
+// PullFirmwareSchema pulls existing FirmwareSchema from cluster.
+func PullFirmwareSchema(apiClient *clients.Settings, name, nsname string) (*FirmwareSchemaBuilder, error) {
+	logging.V(100).Infof("Pulling existing firmwareschema name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("firmwareschema 'apiClient' cannot be empty")
+	}
+
+	builder := FirmwareSchemaBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.FirmwareSchema{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the firmwareschema is empty")
+
+		return nil, fmt.Errorf("firmwareschema 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the firmwareschema is empty")
+
+		return nil, fmt.Errorf("firmwareschema 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("firmwareschema object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+
+	return &builder, nil
+}
+
+// Get returns FirmwareSchema object if found.
+func (builder *FirmwareSchemaBuilder) Get() (*bmhv1alpha1.FirmwareSchema, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting firmwareschema %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	firmwareSchema := &bmhv1alpha1.FirmwareSchema{}
+
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, firmwareSchema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return firmwareSchema, nil
+}
+
+// Exists checks whether the given FirmwareSchema exists.
+func (builder *FirmwareSchemaBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if firmwareschema %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetSettingSchema returns the schema of the given firmware setting.
+func (builder *FirmwareSchemaBuilder) GetSettingSchema(name string) (*bmhv1alpha1.SettingSchema, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting schema of setting %s from firmwareschema %s in namespace %s",
+		name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("firmwareschema object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	settingSchema, found := builder.Object.Spec.Schema[name]
+	if !found {
+		return nil, fmt.Errorf("firmwareschema %s has no setting %s", builder.Definition.Name, name)
+	}
+
+	return &settingSchema, nil
+}
+
+// ValidateSettings checks the given firmware settings against the schema, returning the errors of all the invalid
+// settings joined.
+func (builder *FirmwareSchemaBuilder) ValidateSettings(settings bmhv1alpha1.DesiredSettingsMap) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Validating settings against firmwareschema %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("firmwareschema object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	names := make([]string, 0, len(settings))
+
+	for name := range settings {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var err error
+
+	for _, name := range names {
+		err = errors.Join(err, builder.Object.ValidateSetting(name, settings[name], builder.Object.Spec.Schema))
+	}
+
+	return err
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *FirmwareSchemaBuilder) validate() (bool, error) {
+	resourceCRD := "FirmwareSchema"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,187 @@
+package bmh
+
+import (
+	"testing"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const defaultFirmwareSchemaName = "schema-test"
+
+func TestPullFirmwareSchema(t *testing.T) {
+	testCases := []struct {
+		name                string
+		nsname              string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultFirmwareSchemaName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "firmwareschema 'name' cannot be empty",
+		},
+		{
+			name:                defaultFirmwareSchemaName,
+			nsname:              "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "firmwareschema 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultFirmwareSchemaName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: "firmwareschema object " + defaultFirmwareSchemaName +
+				" doesn't exist in namespace " + defaultBmhNamespace,
+		},
+		{
+			name:                defaultFirmwareSchemaName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "firmwareschema 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyFirmwareSchema())
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullFirmwareSchema(testSettings, testCase.name, testCase.nsname)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+
+			// The definition must be a copy, so mutating it does not change the pulled object.
+			testBuilder.Definition.Spec.HardwareVendor = "changed"
+			assert.NotEqual(t, "changed", testBuilder.Object.Spec.HardwareVendor)
+		}
+	}
+}
+
+func TestFirmwareSchemaGetSettingSchema(t *testing.T) {
+	testCases := []struct {
+		setting       string
+		expectedType  string
+		expectedError string
+	}{
+		{
+			setting:       "ProcVirtualization",
+			expectedType:  "Enumeration",
+			expectedError: "",
+		},
+		{
+			setting:       "Unknown",
+			expectedError: "firmwareschema " + defaultFirmwareSchemaName + " has no setting Unknown",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidFirmwareSchemaBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyFirmwareSchema()},
+		}))
+
+		settingSchema, err := testBuilder.GetSettingSchema(testCase.setting)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedType, settingSchema.AttributeType)
+		}
+	}
+}
+
+func TestFirmwareSchemaValidateSettings(t *testing.T) {
+	testCases := []struct {
+		settings      bmhv1alpha1.DesiredSettingsMap
+		expectedError string
+	}{
+		{
+			settings: bmhv1alpha1.DesiredSettingsMap{
+				"ProcVirtualization": intstr.FromString("Enabled"),
+				"BootRetries":        intstr.FromInt(3),
+			},
+			expectedError: "",
+		},
+		{
+			settings: bmhv1alpha1.DesiredSettingsMap{
+				"ProcVirtualization": intstr.FromString("Maybe"),
+				"BootRetries":        intstr.FromInt(10),
+			},
+			expectedError: "Setting BootRetries is invalid, integer 10 is above maximum value 5\n" +
+				"Setting ProcVirtualization is invalid, unknown enumeration value - Maybe",
+		},
+		{
+			settings:      bmhv1alpha1.DesiredSettingsMap{"Unknown": intstr.FromInt(1)},
+			expectedError: "Setting Unknown is invalid, it is not in the associated schema",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidFirmwareSchemaBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyFirmwareSchema()},
+		}))
+
+		err := testBuilder.ValidateSettings(testCase.settings)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidFirmwareSchemaBuilder(apiClient *clients.Settings) *FirmwareSchemaBuilder {
+	return &FirmwareSchemaBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.FirmwareSchema{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      defaultFirmwareSchemaName,
+				Namespace: defaultBmhNamespace,
+			},
+		},
+	}
+}
+
+func buildDummyFirmwareSchema() *bmhv1alpha1.FirmwareSchema {
+	upperBound := 5
+
+	return &bmhv1alpha1.FirmwareSchema{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultFirmwareSchemaName,
+			Namespace: defaultBmhNamespace,
+		},
+		Spec: bmhv1alpha1.FirmwareSchemaSpec{
+			HardwareVendor: "Dell Inc.",
+			Schema: map[string]bmhv1alpha1.SettingSchema{
+				"ProcVirtualization": {
+					AttributeType:   "Enumeration",
+					AllowableValues: []string{"Enabled", "Disabled"},
+				},
+				"BootRetries": {
+					AttributeType: "Integer",
+					UpperBound:    &upperBound,
+				},
+			},
+		},
+	}
+}
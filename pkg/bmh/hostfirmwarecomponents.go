@@ -0,0 +1,308 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh/bmhtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// hfcValidCondition is the condition reporting whether the desired firmware updates are valid.
+	hfcValidCondition = "Valid"
+	// hfcChangeDetectedCondition is the condition reporting whether the desired firmware updates are not applied yet.
+	hfcChangeDetectedCondition = "ChangeDetected"
+)
+
+// HFCBuilder provides struct for the HostFirmwareComponents object containing connection to the cluster and the
+// HostFirmwareComponents definitions. HostFirmwareComponents are generated by the baremetal operator for every bmh and
+// share its name, so they can only be pulled and updated.
+type HFCBuilder struct {
+	// HostFirmwareComponents definition, used to update the HostFirmwareComponents object.
+	Definition *bmhtypes.HostFirmwareComponents
+	// Pulled HostFirmwareComponents object.
+	Object *bmhtypes.HostFirmwareComponents
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// used to store latest error message upon defining or mutating HostFirmwareComponents definition.
+	errorMsg error
+}
+
+// PullHFC pulls existing HostFirmwareComponents from cluster.
+func PullHFC(apiClient *clients.Settings, name, nsname string) (*HFCBuilder, error) {
+	logging.V(100).Infof("Pulling existing hostfirmwarecomponents name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("hostfirmwarecomponents 'apiClient' cannot be empty")
+	}
+
+	builder := HFCBuilder{
+		apiClient: apiClient,
+		Definition: &bmhtypes.HostFirmwareComponents{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the hostfirmwarecomponents is empty")
+
+		return nil, fmt.Errorf("hostfirmwarecomponents 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the hostfirmwarecomponents is empty")
+
+		return nil, fmt.Errorf("hostfirmwarecomponents 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hostfirmwarecomponents object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+
+	return &builder, nil
+}
+
+// WithFirmwareUpdate sets the firmware image the given component, bios or bmc, is updated to on the next reboot of
+// the bmh. It replaces the update already defined for the component.
+func (builder *HFCBuilder) WithFirmwareUpdate(component, url string) *HFCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting firmware update of component %s to %s in hostfirmwarecomponents %s in namespace %s",
+		component, url, builder.Definition.Name, builder.Definition.Namespace)
+
+	if component != "bios" && component != "bmc" {
+		logging.V(100).Infof("The hostfirmwarecomponents component %s is not supported", component)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("hostfirmwarecomponents component %s is not supported, must be bios or bmc", component))
+
+		return builder
+	}
+
+	if url == "" {
+		logging.V(100).Infof("The url of the hostfirmwarecomponents update is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("hostfirmwarecomponents update 'url' cannot be empty"))
+
+		return builder
+	}
+
+	for index, update := range builder.Definition.Spec.Updates {
+		if update.Component == component {
+			builder.Definition.Spec.Updates[index].URL = url
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Updates = append(builder.Definition.Spec.Updates,
+		bmhtypes.FirmwareUpdate{Component: component, URL: url})
+
+	return builder
+}
+
+// Get returns HostFirmwareComponents object if found.
+func (builder *HFCBuilder) Get() (*bmhtypes.HostFirmwareComponents, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting hostfirmwarecomponents %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetHFCGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("hostfirmwarecomponents object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertHFCToStructured(unsObject)
+}
+
+// Exists checks whether the given HostFirmwareComponents exists.
+func (builder *HFCBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if hostfirmwarecomponents %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Update renovates the existing HostFirmwareComponents object with the definition in builder.
+func (builder *HFCBuilder) Update() (*HFCBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the hostfirmwarecomponents %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update hostfirmwarecomponents, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredHFC, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured hostfirmwarecomponents to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetHFCGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredHFC}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("can not update hostfirmwarecomponents: %w", err)
+	}
+
+	builder.Object, err = convertHFCToStructured(unsObject)
+
+	return builder, err
+}
+
+// GetComponentStatus returns the firmware status of the given component, bios or bmc.
+func (builder *HFCBuilder) GetComponentStatus(component string) (*bmhtypes.FirmwareComponentStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting status of component %s from hostfirmwarecomponents %s in namespace %s",
+		component, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hostfirmwarecomponents object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for index := range builder.Object.Status.Components {
+		if builder.Object.Status.Components[index].Component == component {
+			return &builder.Object.Status.Components[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("hostfirmwarecomponents %s has no component %s", builder.Definition.Name, component)
+}
+
+// WaitUntilUpdatesApplied waits for timeout duration or until the firmware updates of the HostFirmwareComponents are
+// applied, which happens once the bmh is rebooted. It fails early when the baremetal operator reports the updates as
+// invalid.
+func (builder *HFCBuilder) WaitUntilUpdatesApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until updates of hostfirmwarecomponents %s in namespace %s are applied",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			condition := meta.FindStatusCondition(builder.Object.Status.Conditions, hfcValidCondition)
+			if condition != nil && condition.Status == metav1.ConditionFalse {
+				return false, fmt.Errorf("hostfirmwarecomponents %s has invalid updates: %s",
+					builder.Definition.Name, condition.Message)
+			}
+
+			if meta.IsStatusConditionTrue(builder.Object.Status.Conditions, hfcChangeDetectedCondition) {
+				return false, nil
+			}
+
+			return reflect.DeepEqual(builder.Object.Spec.Updates, builder.Object.Status.Updates), nil
+		})
+}
+
+// GetHFCGVR returns HostFirmwareComponents's GroupVersionResource which could be used for Clean function.
+func GetHFCGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "hostfirmwarecomponents"}
+}
+
+// convertHFCToStructured converts the unstructured object returned by the dynamic client to a
+// HostFirmwareComponents.
+func convertHFCToStructured(unsObject *unstructured.Unstructured) (*bmhtypes.HostFirmwareComponents, error) {
+	hostFirmwareComponents := &bmhtypes.HostFirmwareComponents{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, hostFirmwareComponents)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to hostfirmwarecomponents object %s",
+			unsObject.GetName())
+
+		return nil, err
+	}
+
+	return hostFirmwareComponents, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HFCBuilder) validate() (bool, error) {
+	resourceCRD := "HostFirmwareComponents"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
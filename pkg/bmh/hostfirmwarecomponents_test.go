@@ -0,0 +1,301 @@
+package bmh
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh/bmhtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultFirmwareURL = "http://images/bios.bin"
+
+func TestPullHFC(t *testing.T) {
+	testCases := []struct {
+		name                string
+		nsname              string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "hostfirmwarecomponents 'name' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "hostfirmwarecomponents 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: "hostfirmwarecomponents object " + defaultBmhName +
+				" doesn't exist in namespace " + defaultBmhNamespace,
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "hostfirmwarecomponents 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			objects      []*bmhtypes.HostFirmwareComponents
+			testSettings *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			objects = append(objects, buildDummyHFC(nil, nil))
+		}
+
+		if testCase.client {
+			testSettings = buildTestClientWithDummyHFC(t, objects...)
+		}
+
+		testBuilder, err := PullHFC(testSettings, testCase.name, testCase.nsname)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+
+			// The definition must be a copy, so mutating it does not change the pulled object.
+			testBuilder.WithFirmwareUpdate("bios", defaultFirmwareURL)
+			assert.Empty(t, testBuilder.Object.Spec.Updates)
+		}
+	}
+}
+
+func TestHFCWithFirmwareUpdate(t *testing.T) {
+	testCases := []struct {
+		existingUpdates []bmhtypes.FirmwareUpdate
+		component       string
+		url             string
+		expectedUpdates []bmhtypes.FirmwareUpdate
+		expectedError   string
+	}{
+		{
+			existingUpdates: nil,
+			component:       "bios",
+			url:             defaultFirmwareURL,
+			expectedUpdates: []bmhtypes.FirmwareUpdate{{Component: "bios", URL: defaultFirmwareURL}},
+			expectedError:   "",
+		},
+		{
+			existingUpdates: []bmhtypes.FirmwareUpdate{{Component: "bios", URL: "http://images/old.bin"}},
+			component:       "bios",
+			url:             defaultFirmwareURL,
+			expectedUpdates: []bmhtypes.FirmwareUpdate{{Component: "bios", URL: defaultFirmwareURL}},
+			expectedError:   "",
+		},
+		{
+			existingUpdates: []bmhtypes.FirmwareUpdate{{Component: "bios", URL: defaultFirmwareURL}},
+			component:       "bmc",
+			url:             "http://images/bmc.bin",
+			expectedUpdates: []bmhtypes.FirmwareUpdate{
+				{Component: "bios", URL: defaultFirmwareURL},
+				{Component: "bmc", URL: "http://images/bmc.bin"},
+			},
+			expectedError: "",
+		},
+		{
+			component:     "nic",
+			url:           defaultFirmwareURL,
+			expectedError: "hostfirmwarecomponents component nic is not supported, must be bios or bmc",
+		},
+		{
+			component:     "bios",
+			url:           "",
+			expectedError: "hostfirmwarecomponents update 'url' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHFCBuilder(buildTestClientWithDummyHFC(t))
+		testBuilder.Definition.Spec.Updates = testCase.existingUpdates
+
+		testBuilder = testBuilder.WithFirmwareUpdate(testCase.component, testCase.url)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedUpdates, testBuilder.Definition.Spec.Updates)
+		}
+	}
+}
+
+func TestHFCUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "failed to update hostfirmwarecomponents, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var objects []*bmhtypes.HostFirmwareComponents
+
+		if testCase.addToRuntimeObjects {
+			objects = append(objects, buildDummyHFC(nil, nil))
+		}
+
+		testBuilder, err := buildValidHFCBuilder(buildTestClientWithDummyHFC(t, objects...)).
+			WithFirmwareUpdate("bios", defaultFirmwareURL).Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			hostFirmwareComponents, err := testBuilder.Get()
+			assert.Nil(t, err)
+			assert.Equal(t, []bmhtypes.FirmwareUpdate{{Component: "bios", URL: defaultFirmwareURL}},
+				hostFirmwareComponents.Spec.Updates)
+		}
+	}
+}
+
+func TestHFCGetComponentStatus(t *testing.T) {
+	testCases := []struct {
+		component       string
+		expectedVersion string
+		expectedError   string
+	}{
+		{
+			component:       "bios",
+			expectedVersion: "2.1.0",
+			expectedError:   "",
+		},
+		{
+			component:     "bmc",
+			expectedError: "hostfirmwarecomponents " + defaultBmhName + " has no component bmc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		hostFirmwareComponents := buildDummyHFC(nil, nil)
+		hostFirmwareComponents.Status.Components = []bmhtypes.FirmwareComponentStatus{{
+			Component: "bios", InitialVersion: "2.0.0", CurrentVersion: "2.1.0",
+		}}
+
+		testBuilder := buildValidHFCBuilder(buildTestClientWithDummyHFC(t, hostFirmwareComponents))
+
+		componentStatus, err := testBuilder.GetComponentStatus(testCase.component)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedVersion, componentStatus.CurrentVersion)
+		}
+	}
+}
+
+func TestHFCWaitUntilUpdatesApplied(t *testing.T) {
+	testCases := []struct {
+		statusUpdates []bmhtypes.FirmwareUpdate
+		conditions    []metav1.Condition
+		expectedError string
+	}{
+		{
+			statusUpdates: []bmhtypes.FirmwareUpdate{{Component: "bios", URL: defaultFirmwareURL}},
+			conditions:    nil,
+			expectedError: "",
+		},
+		{
+			statusUpdates: []bmhtypes.FirmwareUpdate{{Component: "bios", URL: defaultFirmwareURL}},
+			conditions: []metav1.Condition{{
+				Type:   hfcChangeDetectedCondition,
+				Status: metav1.ConditionTrue,
+			}},
+			expectedError: "context deadline exceeded",
+		},
+		{
+			statusUpdates: nil,
+			conditions:    nil,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			statusUpdates: nil,
+			conditions: []metav1.Condition{{
+				Type:    hfcValidCondition,
+				Status:  metav1.ConditionFalse,
+				Message: "Invalid firmware url",
+			}},
+			expectedError: "hostfirmwarecomponents " + defaultBmhName + " has invalid updates: Invalid firmware url",
+		},
+	}
+
+	for _, testCase := range testCases {
+		hostFirmwareComponents := buildDummyHFC(testCase.statusUpdates, testCase.conditions)
+		hostFirmwareComponents.Spec.Updates = []bmhtypes.FirmwareUpdate{{Component: "bios", URL: defaultFirmwareURL}}
+
+		testBuilder := buildValidHFCBuilder(buildTestClientWithDummyHFC(t, hostFirmwareComponents))
+
+		err := testBuilder.WaitUntilUpdatesApplied(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidHFCBuilder(apiClient *clients.Settings) *HFCBuilder {
+	return &HFCBuilder{
+		apiClient: apiClient,
+		Definition: &bmhtypes.HostFirmwareComponents{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      defaultBmhName,
+				Namespace: defaultBmhNamespace,
+			},
+		},
+	}
+}
+
+func buildDummyHFC(
+	statusUpdates []bmhtypes.FirmwareUpdate, conditions []metav1.Condition) *bmhtypes.HostFirmwareComponents {
+	return &bmhtypes.HostFirmwareComponents{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBmhName,
+			Namespace: defaultBmhNamespace,
+		},
+		Status: bmhtypes.HostFirmwareComponentsStatus{
+			Updates:    statusUpdates,
+			Conditions: conditions,
+		},
+	}
+}
+
+// buildTestClientWithDummyHFC creates the given HostFirmwareComponents through the dynamic client, since the fake
+// dynamic client would otherwise guess their resource as hostfirmwarecomponentses.
+func buildTestClientWithDummyHFC(t *testing.T, objects ...*bmhtypes.HostFirmwareComponents) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	for _, object := range objects {
+		unstructuredHFC, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		assert.Nil(t, err)
+
+		_, err = testSettings.Resource(GetHFCGVR()).Namespace(object.Namespace).Create(
+			context.TODO(), &unstructured.Unstructured{Object: unstructuredHFC}, metav1.CreateOptions{})
+		assert.Nil(t, err)
+	}
+
+	return testSettings
+}
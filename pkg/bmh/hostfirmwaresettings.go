@@ -0,0 +1,272 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HFSBuilder provides struct for the HostFirmwareSettings object containing connection to the cluster and the
+// HostFirmwareSettings definitions. HostFirmwareSettings are generated by the baremetal operator for every bmh and
+// share its name, so they can only be pulled and updated.
+type HFSBuilder struct {
+	Definition *bmhv1alpha1.HostFirmwareSettings
+	Object     *bmhv1alpha1.HostFirmwareSettings
+	apiClient  *clients.Settings
+	errorMsg   error
+}
+
+// PullHFS pulls existing HostFirmwareSettings from cluster.
+func PullHFS(apiClient *clients.Settings, name, nsname string) (*HFSBuilder, error) {
+	logging.V(100).Infof("Pulling existing hostfirmwaresettings name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("hostfirmwaresettings 'apiClient' cannot be empty")
+	}
+
+	builder := HFSBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.HostFirmwareSettings{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the hostfirmwaresettings is empty")
+
+		return nil, fmt.Errorf("hostfirmwaresettings 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the hostfirmwaresettings is empty")
+
+		return nil, fmt.Errorf("hostfirmwaresettings 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hostfirmwaresettings object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+
+	return &builder, nil
+}
+
+// WithSetting sets the desired value of the given firmware setting. The value is applied on the next reboot of the
+// bmh.
+func (builder *HFSBuilder) WithSetting(name string, value intstr.IntOrString) *HFSBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting firmware setting %s to %s in hostfirmwaresettings %s in namespace %s",
+		name, value.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		logging.V(100).Infof("The name of the hostfirmwaresettings setting is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("hostfirmwaresettings setting 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Settings == nil {
+		builder.Definition.Spec.Settings = make(bmhv1alpha1.DesiredSettingsMap)
+	}
+
+	builder.Definition.Spec.Settings[name] = value
+
+	return builder
+}
+
+// Get returns HostFirmwareSettings object if found.
+func (builder *HFSBuilder) Get() (*bmhv1alpha1.HostFirmwareSettings, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting hostfirmwaresettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	hostFirmwareSettings := &bmhv1alpha1.HostFirmwareSettings{}
+
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, hostFirmwareSettings)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hostFirmwareSettings, nil
+}
+
+// Exists checks whether the given HostFirmwareSettings exists.
+func (builder *HFSBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if hostfirmwaresettings %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Update renovates the existing HostFirmwareSettings object with the definition in builder.
+func (builder *HFSBuilder) Update() (*HFSBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the hostfirmwaresettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update hostfirmwaresettings, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err != nil {
+		return builder, fmt.Errorf("can not update hostfirmwaresettings: %w", err)
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// GetFirmwareSchema pulls the FirmwareSchema the settings of the HostFirmwareSettings are validated against.
+func (builder *HFSBuilder) GetFirmwareSchema() (*FirmwareSchemaBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting firmwareschema of hostfirmwaresettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("hostfirmwaresettings object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	schemaRef := builder.Object.Status.FirmwareSchema
+	if schemaRef == nil {
+		return nil, fmt.Errorf("hostfirmwaresettings %s has no firmwareschema", builder.Definition.Name)
+	}
+
+	return PullFirmwareSchema(builder.apiClient, schemaRef.Name, schemaRef.Namespace)
+}
+
+// ValidateSettings checks the desired settings of the HostFirmwareSettings definition against its FirmwareSchema
+// before they are updated.
+func (builder *HFSBuilder) ValidateSettings() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Validating settings of hostfirmwaresettings %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	firmwareSchema, err := builder.GetFirmwareSchema()
+	if err != nil {
+		return err
+	}
+
+	return firmwareSchema.ValidateSettings(builder.Definition.Spec.Settings)
+}
+
+// WaitUntilSettingsApplied waits for timeout duration or until the current settings reported by the
+// HostFirmwareSettings match the desired ones, which happens once the bmh is rebooted. It fails early when the
+// baremetal operator reports the desired settings as invalid.
+func (builder *HFSBuilder) WaitUntilSettingsApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until settings of hostfirmwaresettings %s in namespace %s are applied",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			condition := meta.FindStatusCondition(
+				builder.Object.Status.Conditions, string(bmhv1alpha1.FirmwareSettingsValid))
+			if condition != nil && condition.Status == metav1.ConditionFalse {
+				return false, fmt.Errorf("hostfirmwaresettings %s has invalid settings: %s",
+					builder.Definition.Name, condition.Message)
+			}
+
+			for name, value := range builder.Object.Spec.Settings {
+				if builder.Object.Status.Settings[name] != value.String() {
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HFSBuilder) validate() (bool, error) {
+	resourceCRD := "HostFirmwareSettings"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,260 @@
+package bmh
+
+import (
+	"testing"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPullHFS(t *testing.T) {
+	testCases := []struct {
+		name                string
+		nsname              string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "hostfirmwaresettings 'name' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "hostfirmwaresettings 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: "hostfirmwaresettings object " + defaultBmhName +
+				" doesn't exist in namespace " + defaultBmhNamespace,
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "hostfirmwaresettings 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyHFS(nil, nil))
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullHFS(testSettings, testCase.name, testCase.nsname)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+
+			// The definition must be a copy, so mutating it does not change the pulled object.
+			testBuilder.WithSetting("BootRetries", intstr.FromInt(3))
+			assert.NotContains(t, testBuilder.Object.Spec.Settings, "BootRetries")
+		}
+	}
+}
+
+func TestHFSWithSetting(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         intstr.IntOrString
+		expectedError string
+	}{
+		{
+			name:          "ProcVirtualization",
+			value:         intstr.FromString("Enabled"),
+			expectedError: "",
+		},
+		{
+			name:          "BootRetries",
+			value:         intstr.FromInt(3),
+			expectedError: "",
+		},
+		{
+			name:          "",
+			value:         intstr.FromInt(3),
+			expectedError: "hostfirmwaresettings setting 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHFSBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithSetting(testCase.name, testCase.value)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.value, testBuilder.Definition.Spec.Settings[testCase.name])
+		}
+	}
+}
+
+func TestHFSUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "failed to update hostfirmwaresettings, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyHFS(nil, nil))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		testBuilder := buildValidHFSBuilder(testSettings)
+
+		if testCase.addToRuntimeObjects {
+			var err error
+
+			testBuilder, err = PullHFS(testSettings, defaultBmhName, defaultBmhNamespace)
+			assert.Nil(t, err)
+		}
+
+		testBuilder, err := testBuilder.WithSetting("BootRetries", intstr.FromInt(3)).Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			hostFirmwareSettings, err := testBuilder.Get()
+			assert.Nil(t, err)
+			assert.Equal(t, intstr.FromInt(3), hostFirmwareSettings.Spec.Settings["BootRetries"])
+		}
+	}
+}
+
+func TestHFSValidateSettings(t *testing.T) {
+	testCases := []struct {
+		schemaRef     *bmhv1alpha1.SchemaReference
+		value         intstr.IntOrString
+		expectedError string
+	}{
+		{
+			schemaRef:     &bmhv1alpha1.SchemaReference{Name: defaultFirmwareSchemaName, Namespace: defaultBmhNamespace},
+			value:         intstr.FromInt(3),
+			expectedError: "",
+		},
+		{
+			schemaRef:     &bmhv1alpha1.SchemaReference{Name: defaultFirmwareSchemaName, Namespace: defaultBmhNamespace},
+			value:         intstr.FromInt(10),
+			expectedError: "Setting BootRetries is invalid, integer 10 is above maximum value 5",
+		},
+		{
+			schemaRef:     nil,
+			value:         intstr.FromInt(3),
+			expectedError: "hostfirmwaresettings " + defaultBmhName + " has no firmwareschema",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHFSBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyHFS(testCase.schemaRef, nil), buildDummyFirmwareSchema()},
+		})).WithSetting("BootRetries", testCase.value)
+
+		err := testBuilder.ValidateSettings()
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestHFSWaitUntilSettingsApplied(t *testing.T) {
+	testCases := []struct {
+		currentValue  string
+		conditions    []metav1.Condition
+		expectedError string
+	}{
+		{
+			currentValue:  "3",
+			conditions:    nil,
+			expectedError: "",
+		},
+		{
+			currentValue:  "1",
+			conditions:    nil,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			currentValue: "1",
+			conditions: []metav1.Condition{{
+				Type:    string(bmhv1alpha1.FirmwareSettingsValid),
+				Status:  metav1.ConditionFalse,
+				Message: "Invalid BIOS setting",
+			}},
+			expectedError: "hostfirmwaresettings " + defaultBmhName + " has invalid settings: Invalid BIOS setting",
+		},
+	}
+
+	for _, testCase := range testCases {
+		hostFirmwareSettings := buildDummyHFS(nil, testCase.conditions)
+		hostFirmwareSettings.Spec.Settings = bmhv1alpha1.DesiredSettingsMap{"BootRetries": intstr.FromInt(3)}
+		hostFirmwareSettings.Status.Settings = bmhv1alpha1.SettingsMap{"BootRetries": testCase.currentValue}
+
+		testBuilder := buildValidHFSBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{hostFirmwareSettings},
+		}))
+
+		err := testBuilder.WaitUntilSettingsApplied(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidHFSBuilder(apiClient *clients.Settings) *HFSBuilder {
+	return &HFSBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.HostFirmwareSettings{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      defaultBmhName,
+				Namespace: defaultBmhNamespace,
+			},
+		},
+	}
+}
+
+func buildDummyHFS(
+	schemaRef *bmhv1alpha1.SchemaReference, conditions []metav1.Condition) *bmhv1alpha1.HostFirmwareSettings {
+	return &bmhv1alpha1.HostFirmwareSettings{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBmhName,
+			Namespace: defaultBmhNamespace,
+		},
+		Status: bmhv1alpha1.HostFirmwareSettingsStatus{
+			FirmwareSchema: schemaRef,
+			Conditions:     conditions,
+		},
+	}
+}
@@ -7,7 +7,7 @@ import (
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
@@ -21,7 +21,7 @@ const (
 // List returns bareMetalHosts inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...goclient.ListOptions) ([]*BmhBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("bareMetalHost 'nsname' parameter can not be empty")
+		logging.V(100).Infof("bareMetalHost 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list bareMetalHosts, 'nsname' parameter is empty")
 	}
@@ -30,7 +30,7 @@ func List(apiClient *clients.Settings, nsname string, options ...goclient.ListOp
 	passedOptions := goclient.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -42,13 +42,13 @@ func List(apiClient *clients.Settings, nsname string, options ...goclient.ListOp
 
 	passedOptions.Namespace = nsname
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	var bmhList bmhv1alpha1.BareMetalHostList
 	err := apiClient.List(context.TODO(), &bmhList, &passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list bareMetalHosts in the nsname %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list bareMetalHosts in the nsname %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
@@ -75,12 +75,12 @@ func WaitForAllBareMetalHostsInGoodOperationalState(apiClient *clients.Settings,
 	nsname string,
 	timeout time.Duration,
 	options ...goclient.ListOptions) (bool, error) {
-	glog.V(100).Infof("Waiting for all bareMetalHosts in %s namespace to have OK operationalStatus",
+	logging.V(100).Infof("Waiting for all bareMetalHosts in %s namespace to have OK operationalStatus",
 		nsname)
 
 	bmhList, err := List(apiClient, nsname, options...)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all bareMetalHosts in the %s namespace due to %s",
+		logging.V(100).Infof("Failed to list all bareMetalHosts in the %s namespace due to %s",
 			nsname, err.Error())
 
 		return false, err
@@ -94,7 +94,7 @@ func WaitForAllBareMetalHostsInGoodOperationalState(apiClient *clients.Settings,
 				status := baremetalhost.GetBmhOperationalState()
 
 				if status != bmhv1alpha1.OperationalStatusOK {
-					glog.V(100).Infof("The %s bareMetalHost in namespace %s has an unexpected operational status: %s",
+					logging.V(100).Infof("The %s bareMetalHost in namespace %s has an unexpected operational status: %s",
 						baremetalhost.Object.Name, baremetalhost.Object.Namespace, status)
 
 					return false, nil
@@ -105,14 +105,14 @@ func WaitForAllBareMetalHostsInGoodOperationalState(apiClient *clients.Settings,
 		})
 
 	if err == nil {
-		glog.V(100).Infof("All baremetalhosts were found in the good Operational State "+
+		logging.V(100).Infof("All baremetalhosts were found in the good Operational State "+
 			"during defined timeout: %v", timeout)
 
 		return true, nil
 	}
 
 	// Here err is "timed out waiting for the condition"
-	glog.V(100).Infof("Not all baremetalhosts were found in the good Operational State "+
+	logging.V(100).Infof("Not all baremetalhosts were found in the good Operational State "+
 		"during defined timeout: %v", timeout)
 
 	return false, err
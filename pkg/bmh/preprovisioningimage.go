@@ -0,0 +1,192 @@
+package bmh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PreprovisioningImageBuilder provides struct for the PreprovisioningImage object containing connection to the
+// cluster and the PreprovisioningImage definitions. PreprovisioningImages are generated by the baremetal operator
+// for every bmh and share its name, so they can only be pulled.
+type PreprovisioningImageBuilder struct {
+	Definition *bmhv1alpha1.PreprovisioningImage
+	Object     *bmhv1alpha1.PreprovisioningImage
+	apiClient  *clients.Settings
+	errorMsg   error
+}
+
+// PullPreprovisioningImage pulls existing PreprovisioningImage from cluster.
+func PullPreprovisioningImage(apiClient *clients.Settings, name, nsname string) (*PreprovisioningImageBuilder, error) {
+	logging.V(100).Infof("Pulling existing preprovisioningimage name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("preprovisioningimage 'apiClient' cannot be empty")
+	}
+
+	builder := PreprovisioningImageBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.PreprovisioningImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the preprovisioningimage is empty")
+
+		return nil, fmt.Errorf("preprovisioningimage 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the preprovisioningimage is empty")
+
+		return nil, fmt.Errorf("preprovisioningimage 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("preprovisioningimage object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+
+	return &builder, nil
+}
+
+// Get returns PreprovisioningImage object if found.
+func (builder *PreprovisioningImageBuilder) Get() (*bmhv1alpha1.PreprovisioningImage, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting preprovisioningimage %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	preprovisioningImage := &bmhv1alpha1.PreprovisioningImage{}
+
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, preprovisioningImage)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return preprovisioningImage, nil
+}
+
+// Exists checks whether the given PreprovisioningImage exists.
+func (builder *PreprovisioningImageBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if preprovisioningimage %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetImageURL returns the url of the image the bmh boots from before it is provisioned.
+func (builder *PreprovisioningImageBuilder) GetImageURL() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting image url of preprovisioningimage %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("preprovisioningimage object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status.ImageUrl == "" {
+		return "", fmt.Errorf("preprovisioningimage %s has no image url yet", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.ImageUrl, nil
+}
+
+// WaitUntilReady waits for timeout duration or until the image of the PreprovisioningImage is ready. It fails early
+// when the image reports an error.
+func (builder *PreprovisioningImageBuilder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until preprovisioningimage %s in namespace %s is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			condition := meta.FindStatusCondition(
+				builder.Object.Status.Conditions, string(bmhv1alpha1.ConditionImageError))
+			if condition != nil && condition.Status == metav1.ConditionTrue {
+				return false, fmt.Errorf("preprovisioningimage %s failed: %s", builder.Definition.Name, condition.Message)
+			}
+
+			return meta.IsStatusConditionTrue(
+				builder.Object.Status.Conditions, string(bmhv1alpha1.ConditionImageReady)), nil
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PreprovisioningImageBuilder) validate() (bool, error) {
+	resourceCRD := "PreprovisioningImage"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,192 @@
+package bmh
+
+import (
+	"testing"
+	"time"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultPreprovisioningImageURL = "http://images/discovery.iso"
+
+func TestPullPreprovisioningImage(t *testing.T) {
+	testCases := []struct {
+		name                string
+		nsname              string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "preprovisioningimage 'name' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "preprovisioningimage 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: "preprovisioningimage object " + defaultBmhName +
+				" doesn't exist in namespace " + defaultBmhNamespace,
+		},
+		{
+			name:                defaultBmhName,
+			nsname:              defaultBmhNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "preprovisioningimage 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPreprovisioningImage(defaultPreprovisioningImageURL, nil))
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullPreprovisioningImage(testSettings, testCase.name, testCase.nsname)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+
+			// The definition must be a copy, so mutating it does not change the pulled object.
+			testBuilder.Definition.Status.ImageUrl = ""
+			assert.Equal(t, defaultPreprovisioningImageURL, testBuilder.Object.Status.ImageUrl)
+		}
+	}
+}
+
+func TestPreprovisioningImageGetImageURL(t *testing.T) {
+	testCases := []struct {
+		imageURL            string
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			imageURL:            defaultPreprovisioningImageURL,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			imageURL:            "",
+			addToRuntimeObjects: true,
+			expectedError:       "preprovisioningimage " + defaultBmhName + " has no image url yet",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError: "preprovisioningimage object " + defaultBmhName +
+				" doesn't exist in namespace " + defaultBmhNamespace,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPreprovisioningImage(testCase.imageURL, nil))
+		}
+
+		testBuilder := buildValidPreprovisioningImageBuilder(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}))
+
+		imageURL, err := testBuilder.GetImageURL()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.imageURL, imageURL)
+		}
+	}
+}
+
+func TestPreprovisioningImageWaitUntilReady(t *testing.T) {
+	testCases := []struct {
+		conditions    []metav1.Condition
+		expectedError string
+	}{
+		{
+			conditions: []metav1.Condition{{
+				Type:   string(bmhv1alpha1.ConditionImageReady),
+				Status: metav1.ConditionTrue,
+			}},
+			expectedError: "",
+		},
+		{
+			conditions: []metav1.Condition{{
+				Type:   string(bmhv1alpha1.ConditionImageReady),
+				Status: metav1.ConditionFalse,
+			}},
+			expectedError: "context deadline exceeded",
+		},
+		{
+			conditions: []metav1.Condition{{
+				Type:    string(bmhv1alpha1.ConditionImageError),
+				Status:  metav1.ConditionTrue,
+				Message: "failed to build image",
+			}},
+			expectedError: "preprovisioningimage " + defaultBmhName + " failed: failed to build image",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPreprovisioningImageBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{
+				buildDummyPreprovisioningImage(defaultPreprovisioningImageURL, testCase.conditions)},
+		}))
+
+		err := testBuilder.WaitUntilReady(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidPreprovisioningImageBuilder(apiClient *clients.Settings) *PreprovisioningImageBuilder {
+	return &PreprovisioningImageBuilder{
+		apiClient: apiClient,
+		Definition: &bmhv1alpha1.PreprovisioningImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      defaultBmhName,
+				Namespace: defaultBmhNamespace,
+			},
+		},
+	}
+}
+
+func buildDummyPreprovisioningImage(
+	imageURL string, conditions []metav1.Condition) *bmhv1alpha1.PreprovisioningImage {
+	return &bmhv1alpha1.PreprovisioningImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBmhName,
+			Namespace: defaultBmhNamespace,
+		},
+		Status: bmhv1alpha1.PreprovisioningImageStatus{
+			ImageUrl:   imageURL,
+			Conditions: conditions,
+		},
+	}
+}
@@ -2,14 +2,18 @@ package cgu
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	clientCgu "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/generated/clientset/versioned"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"golang.org/x/exp/slices"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -18,8 +22,15 @@ import (
 const (
 	isTrue     = "True"
 	isComplete = "Succeeded"
+
+	backupSucceeded          = "Succeeded"
+	backupUnrecoverableError = "UnrecoverableError"
+	backupTimeout            = "BackupTimeout"
 )
 
+// backupEndStates are the states of the backup of a cluster which are no longer updated by TALM.
+var backupEndStates = []string{backupSucceeded, backupUnrecoverableError, backupTimeout}
+
 // CguBuilder provides struct for the cgu object containing connection to
 // the cluster and the cgu definitions.
 type CguBuilder struct {
@@ -30,12 +41,12 @@ type CguBuilder struct {
 	// api client to interact with the cluster.
 	apiClient clientCgu.Interface
 	// used to store latest error message upon defining or mutating application definition.
-	errorMsg string
+	errorMsg error
 }
 
 // NewCguBuilder creates a new instance of CguBuilder.
 func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurrency int) *CguBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new CGU structure with the following params: name: %s, nsname: %s, maxConcurrency: %d",
 		name, nsname, maxConcurrency)
 
@@ -55,21 +66,21 @@ func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurre
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the CGU is empty")
+		logging.V(100).Infof("The name of the CGU is empty")
 
-		builder.errorMsg = "CGU 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("CGU 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the CGU is empty")
+		logging.V(100).Infof("The namespace of the CGU is empty")
 
-		builder.errorMsg = "CGU 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("CGU 'nsname' cannot be empty"))
 	}
 
 	if maxConcurrency < 1 {
-		glog.V(100).Infof("The maxConcurrency of the CGU has a minimum of 1")
+		logging.V(100).Infof("The maxConcurrency of the CGU has a minimum of 1")
 
-		builder.errorMsg = "CGU 'maxConcurrency' cannot be less than 1"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("CGU 'maxConcurrency' cannot be less than 1"))
 	}
 
 	return &builder
@@ -82,9 +93,9 @@ func (builder *CguBuilder) WithCluster(cluster string) *CguBuilder {
 	}
 
 	if cluster == "" {
-		glog.V(100).Infof("The cluster to be added to the CGU is empty")
+		logging.V(100).Infof("The cluster to be added to the CGU is empty")
 
-		builder.errorMsg = "cluster in CGU cluster spec cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cluster in CGU cluster spec cannot be empty"))
 
 		return builder
 	}
@@ -101,9 +112,9 @@ func (builder *CguBuilder) WithManagedPolicy(policy string) *CguBuilder {
 	}
 
 	if policy == "" {
-		glog.V(100).Infof("The policy to be added to the CGU's ManagedPolicies is empty")
+		logging.V(100).Infof("The policy to be added to the CGU's ManagedPolicies is empty")
 
-		builder.errorMsg = "policy in CGU managedpolicies spec cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policy in CGU managedpolicies spec cannot be empty"))
 
 		return builder
 	}
@@ -120,9 +131,9 @@ func (builder *CguBuilder) WithCanary(canary string) *CguBuilder {
 	}
 
 	if canary == "" {
-		glog.V(100).Infof("The canary to be added to the CGU's RemediationStrategy is empty")
+		logging.V(100).Infof("The canary to be added to the CGU's RemediationStrategy is empty")
 
-		builder.errorMsg = "canary in CGU remediationstrategy spec cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("canary in CGU remediationstrategy spec cannot be empty"))
 
 		return builder
 	}
@@ -133,12 +144,105 @@ func (builder *CguBuilder) WithCanary(canary string) *CguBuilder {
 	return builder
 }
 
+// WithBlockingCR appends a CGU which must complete before this CGU starts to the blockingCRs list in the CGU
+// definition.
+func (builder *CguBuilder) WithBlockingCR(name, namespace string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the blocking CR to be added to the CGU is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("blocking CR name in CGU blockingCRs spec cannot be empty"))
+
+		return builder
+	}
+
+	if namespace == "" {
+		logging.V(100).Infof("The namespace of the blocking CR to be added to the CGU is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("blocking CR namespace in CGU blockingCRs spec cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.BlockingCRs = append(builder.Definition.Spec.BlockingCRs,
+		v1alpha1.BlockingCR{Name: name, Namespace: namespace})
+
+	return builder
+}
+
+// WithBeforeEnableAction sets the labels added to and deleted from the clusters before the CGU is enabled.
+func (builder *CguBuilder) WithBeforeEnableAction(addClusterLabels, deleteClusterLabels map[string]string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if len(addClusterLabels) == 0 && len(deleteClusterLabels) == 0 {
+		logging.V(100).Infof("The beforeEnable action of the CGU has no labels to add or delete")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("CGU beforeEnable action must add or delete at least one cluster label"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Actions.BeforeEnable = v1alpha1.BeforeEnable{
+		AddClusterLabels:    addClusterLabels,
+		DeleteClusterLabels: deleteClusterLabels,
+	}
+
+	return builder
+}
+
+// WithAfterCompletionAction sets the labels added to and deleted from the clusters after they complete the upgrade
+// and whether the objects created by TALM for the CGU are deleted on completion.
+func (builder *CguBuilder) WithAfterCompletionAction(
+	addClusterLabels, deleteClusterLabels map[string]string, deleteObjects bool) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.Definition.Spec.Actions.AfterCompletion = v1alpha1.AfterCompletion{
+		AddClusterLabels:    addClusterLabels,
+		DeleteClusterLabels: deleteClusterLabels,
+		DeleteObjects:       &deleteObjects,
+	}
+
+	return builder
+}
+
+// WithBatchTimeoutAction sets what TALM does when a batch times out, either Continue with the next batch or Abort
+// the CGU.
+func (builder *CguBuilder) WithBatchTimeoutAction(action string) *CguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if action != v1alpha1.BatchTimeoutAction.Continue && action != v1alpha1.BatchTimeoutAction.Abort {
+		logging.V(100).Infof("The batchTimeoutAction %s of the CGU is not supported", action)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"CGU 'batchTimeoutAction' %s is not supported, must be %s or %s",
+			action, v1alpha1.BatchTimeoutAction.Continue, v1alpha1.BatchTimeoutAction.Abort))
+
+		return builder
+	}
+
+	builder.Definition.Spec.BatchTimeoutAction = action
+
+	return builder
+}
+
 // Pull pulls existing cgu into CguBuilder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error) {
-	glog.V(100).Infof("Pulling existing cgu name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing cgu name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("cgu 'apiClient' cannot be empty")
 	}
@@ -154,13 +258,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the cgu is empty")
+		logging.V(100).Infof("The name of the cgu is empty")
 
 		return nil, fmt.Errorf("cgu 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the cgu is empty")
+		logging.V(100).Infof("The namespace of the cgu is empty")
 
 		return nil, fmt.Errorf("cgu 'namespace' cannot be empty")
 	}
@@ -180,7 +284,7 @@ func (builder *CguBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if cgu %s exists in namespace %s",
+	logging.V(100).Infof("Checking if cgu %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -196,7 +300,7 @@ func (builder *CguBuilder) Create() (*CguBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the cgu %s in namespace %s",
+	logging.V(100).Infof("Creating the cgu %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -214,7 +318,7 @@ func (builder *CguBuilder) Delete() (*CguBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the cgu %s in namespace %s",
+	logging.V(100).Infof("Deleting the cgu %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -239,7 +343,7 @@ func (builder *CguBuilder) Update(force bool) (*CguBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the cgu object", builder.Definition.Name)
+	logging.V(100).Infof("Updating the cgu object %s", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.RanV1alpha1().ClusterGroupUpgrades(builder.Definition.Namespace).Update(
@@ -247,13 +351,13 @@ func (builder *CguBuilder) Update(force bool) (*CguBuilder, error) {
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("cgu", builder.Definition.Name))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("cgu", builder.Definition.Name))
 
 				return nil, err
@@ -276,27 +380,29 @@ func (builder *CguBuilder) validate() (bool, error) {
 	resourceCRD := "cgu"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -308,12 +414,12 @@ func (builder *CguBuilder) WaitUntilComplete(timeout time.Duration) (*CguBuilder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for CGU %s to complete", builder.Definition.Name)
+	logging.V(100).Infof("Waiting for CGU %s to complete", builder.Definition.Name)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("The CGU does not exist on the cluster")
+		logging.V(100).Infof("The CGU does not exist on the cluster")
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	// Polls periodically to determine if CGU is in desired state.
@@ -344,3 +450,106 @@ func (builder *CguBuilder) WaitUntilComplete(timeout time.Duration) (*CguBuilder
 
 	return nil, err
 }
+
+// GetCurrentBatch returns the index, starting from 1, of the batch of clusters currently being remediated.
+func (builder *CguBuilder) GetCurrentBatch() (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.V(100).Infof("Getting current batch of cgu %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("cgu object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Status.CurrentBatch, nil
+}
+
+// GetCurrentBatchProgress returns the remediation progress of every cluster of the current batch, keyed by cluster
+// name. The state of a cluster is NotStarted, InProgress or Completed.
+func (builder *CguBuilder) GetCurrentBatchProgress() (map[string]*v1alpha1.ClusterRemediationProgress, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting current batch progress of cgu %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("cgu object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Status.CurrentBatchRemediationProgress, nil
+}
+
+// GetPrecachingStatus returns the precaching status of every cluster, keyed by cluster name.
+func (builder *CguBuilder) GetPrecachingStatus() (map[string]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting precaching status of cgu %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("cgu object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status.Precaching == nil {
+		return nil, fmt.Errorf("cgu %s in namespace %s has no precaching status",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Precaching.Status, nil
+}
+
+// WaitUntilBackupDone waits the specified timeout for the backup of every cluster of the CGU to end. It returns an
+// error listing the clusters whose backup did not succeed.
+func (builder *CguBuilder) WaitUntilBackupDone(timeout time.Duration) (*CguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Waiting for backup of CGU %s to be done", builder.Definition.Name)
+
+	var failedClusters []string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second*3, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() || builder.Object.Status.Backup == nil || len(builder.Object.Status.Backup.Status) == 0 {
+				return false, nil
+			}
+
+			failedClusters = nil
+
+			for cluster, state := range builder.Object.Status.Backup.Status {
+				if !slices.Contains(backupEndStates, state) {
+					return false, nil
+				}
+
+				if state != backupSucceeded {
+					failedClusters = append(failedClusters, fmt.Sprintf("%s: %s", cluster, state))
+				}
+			}
+
+			return true, nil
+		})
+
+	if err != nil {
+		return builder, err
+	}
+
+	if len(failedClusters) > 0 {
+		sort.Strings(failedClusters)
+
+		return builder, fmt.Errorf("backup of cgu %s in namespace %s failed on clusters [%s]",
+			builder.Definition.Name, builder.Definition.Namespace, strings.Join(failedClusters, ", "))
+	}
+
+	return builder, nil
+}
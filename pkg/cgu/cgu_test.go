@@ -1,11 +1,15 @@
 package cgu
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -157,7 +161,7 @@ func TestNewCguBuilder(t *testing.T) {
 			testCase.cguNamespace,
 			testCase.cguMaxConcurrency)
 		assert.NotNil(t, testCguStructure)
-		assert.Equal(t, testCguStructure.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testCguStructure.errorMsg)
 	}
 }
 
@@ -179,7 +183,7 @@ func TestCguWithCluster(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyCguObject()
 		cguBuilder := buildValidCguTestBuilder(testSettings).WithCluster(testCase.cluster)
-		assert.Equal(t, cguBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, cguBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, cguBuilder.Definition.Spec.Clusters, []string{testCase.cluster})
@@ -205,7 +209,7 @@ func TestCguWithManagedPolicy(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyCguObject()
 		cguBuilder := buildValidCguTestBuilder(testSettings).WithManagedPolicy(testCase.policy)
-		assert.Equal(t, cguBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, cguBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, cguBuilder.Definition.Spec.ManagedPolicies, []string{testCase.policy})
@@ -231,7 +235,7 @@ func TestCguWithCanary(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyCguObject()
 		cguBuilder := buildValidCguTestBuilder(testSettings).WithCanary(testCase.canary)
-		assert.Equal(t, cguBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, cguBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, cguBuilder.Definition.Spec.RemediationStrategy.Canaries, []string{testCase.canary})
@@ -250,7 +254,7 @@ func TestCguCreate(t *testing.T) {
 		},
 		{
 			testCgu:       buildInvalidCguTestBuilder(buildTestClientWithDummyCguObject()),
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: errors.Join(fmt.Errorf("CGU 'nsname' cannot be empty")),
 		},
 	}
 
@@ -275,7 +279,7 @@ func TestCguDelete(t *testing.T) {
 		},
 		{
 			testCgu:       buildInvalidCguTestBuilder(buildTestClientWithDummyCguObject()),
-			expectedError: fmt.Errorf("CGU 'nsname' cannot be empty"),
+			expectedError: errors.Join(fmt.Errorf("CGU 'nsname' cannot be empty")),
 		},
 	}
 
@@ -351,3 +355,229 @@ func buildInvalidCguTestBuilder(apiClient *clients.Settings) *CguBuilder {
 		"",
 		defaultCguMaxConcurrency)
 }
+
+func TestCguWithBlockingCR(t *testing.T) {
+	testCases := []struct {
+		name              string
+		namespace         string
+		expectedErrorText string
+	}{
+		{
+			name:              "blocking-cgu",
+			namespace:         "blocking-ns",
+			expectedErrorText: "",
+		},
+		{
+			name:              "",
+			namespace:         "blocking-ns",
+			expectedErrorText: "blocking CR name in CGU blockingCRs spec cannot be empty",
+		},
+		{
+			name:              "blocking-cgu",
+			namespace:         "",
+			expectedErrorText: "blocking CR namespace in CGU blockingCRs spec cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyCguObject()
+		cguBuilder := buildValidCguTestBuilder(testSettings).WithBlockingCR(testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, cguBuilder.errorMsg) {
+			assert.Equal(t, []v1alpha1.BlockingCR{{Name: testCase.name, Namespace: testCase.namespace}},
+				cguBuilder.Definition.Spec.BlockingCRs)
+		}
+	}
+}
+
+func TestCguWithBeforeEnableAction(t *testing.T) {
+	testCases := []struct {
+		addClusterLabels    map[string]string
+		deleteClusterLabels map[string]string
+		expectedErrorText   string
+	}{
+		{
+			addClusterLabels:    map[string]string{"upgrade": "started"},
+			deleteClusterLabels: nil,
+			expectedErrorText:   "",
+		},
+		{
+			addClusterLabels:    nil,
+			deleteClusterLabels: map[string]string{"upgrade": ""},
+			expectedErrorText:   "",
+		},
+		{
+			addClusterLabels:    nil,
+			deleteClusterLabels: nil,
+			expectedErrorText:   "CGU beforeEnable action must add or delete at least one cluster label",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyCguObject()
+		cguBuilder := buildValidCguTestBuilder(testSettings).
+			WithBeforeEnableAction(testCase.addClusterLabels, testCase.deleteClusterLabels)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, cguBuilder.errorMsg) {
+			assert.Equal(t, testCase.addClusterLabels, cguBuilder.Definition.Spec.Actions.BeforeEnable.AddClusterLabels)
+			assert.Equal(t, testCase.deleteClusterLabels,
+				cguBuilder.Definition.Spec.Actions.BeforeEnable.DeleteClusterLabels)
+		}
+	}
+}
+
+func TestCguWithAfterCompletionAction(t *testing.T) {
+	testSettings := buildTestClientWithDummyCguObject()
+	cguBuilder := buildValidCguTestBuilder(testSettings).
+		WithAfterCompletionAction(map[string]string{"upgrade": "done"}, nil, true)
+
+	assert.Nil(t, cguBuilder.errorMsg)
+	assert.Equal(t, map[string]string{"upgrade": "done"},
+		cguBuilder.Definition.Spec.Actions.AfterCompletion.AddClusterLabels)
+	assert.NotNil(t, cguBuilder.Definition.Spec.Actions.AfterCompletion.DeleteObjects)
+	assert.True(t, *cguBuilder.Definition.Spec.Actions.AfterCompletion.DeleteObjects)
+}
+
+func TestCguWithBatchTimeoutAction(t *testing.T) {
+	testCases := []struct {
+		action            string
+		expectedErrorText string
+	}{
+		{
+			action:            v1alpha1.BatchTimeoutAction.Continue,
+			expectedErrorText: "",
+		},
+		{
+			action:            v1alpha1.BatchTimeoutAction.Abort,
+			expectedErrorText: "",
+		},
+		{
+			action: "Retry",
+			expectedErrorText: fmt.Sprintf("CGU 'batchTimeoutAction' Retry is not supported, must be %s or %s",
+				v1alpha1.BatchTimeoutAction.Continue, v1alpha1.BatchTimeoutAction.Abort),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyCguObject()
+		cguBuilder := buildValidCguTestBuilder(testSettings).WithBatchTimeoutAction(testCase.action)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, cguBuilder.errorMsg) {
+			assert.Equal(t, testCase.action, cguBuilder.Definition.Spec.BatchTimeoutAction)
+		}
+	}
+}
+
+func TestCguGetCurrentBatch(t *testing.T) {
+	testCases := []struct {
+		exists        bool
+		expectedBatch int
+		expectedError error
+	}{
+		{
+			exists:        true,
+			expectedBatch: 2,
+			expectedError: nil,
+		},
+		{
+			exists:        false,
+			expectedBatch: 0,
+			expectedError: fmt.Errorf("cgu object %s doesn't exist in namespace %s", defaultCguName, defaultCguNsName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			cgu := buildDummyCgu(defaultCguName, defaultCguNsName, defaultCguMaxConcurrency)
+			cgu.Status.Status.CurrentBatch = 2
+			runtimeObjects = append(runtimeObjects, cgu)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		batch, err := buildValidCguTestBuilder(testSettings).GetCurrentBatch()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedBatch, batch)
+	}
+}
+
+func TestCguGetCurrentBatchProgress(t *testing.T) {
+	policyIndex := 1
+	progress := map[string]*v1alpha1.ClusterRemediationProgress{
+		"spoke1": {State: v1alpha1.InProgress, PolicyIndex: &policyIndex},
+		"spoke2": {State: v1alpha1.Completed},
+	}
+
+	cgu := buildDummyCgu(defaultCguName, defaultCguNsName, defaultCguMaxConcurrency)
+	cgu.Status.Status.CurrentBatchRemediationProgress = progress
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{cgu}})
+
+	batchProgress, err := buildValidCguTestBuilder(testSettings).GetCurrentBatchProgress()
+	assert.Nil(t, err)
+	assert.Equal(t, progress, batchProgress)
+}
+
+func TestCguGetPrecachingStatus(t *testing.T) {
+	testCases := []struct {
+		precaching     *v1alpha1.PrecachingStatus
+		expectedStatus map[string]string
+		expectedError  error
+	}{
+		{
+			precaching:     &v1alpha1.PrecachingStatus{Status: map[string]string{"spoke1": "Succeeded"}},
+			expectedStatus: map[string]string{"spoke1": "Succeeded"},
+			expectedError:  nil,
+		},
+		{
+			precaching:     nil,
+			expectedStatus: nil,
+			expectedError: fmt.Errorf(
+				"cgu %s in namespace %s has no precaching status", defaultCguName, defaultCguNsName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		cgu := buildDummyCgu(defaultCguName, defaultCguNsName, defaultCguMaxConcurrency)
+		cgu.Status.Precaching = testCase.precaching
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{cgu}})
+
+		status, err := buildValidCguTestBuilder(testSettings).GetPrecachingStatus()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedStatus, status)
+	}
+}
+
+func TestCguWaitUntilBackupDone(t *testing.T) {
+	testCases := []struct {
+		backupStatus  map[string]string
+		expectedError error
+	}{
+		{
+			backupStatus:  map[string]string{"spoke1": "Succeeded", "spoke2": "Succeeded"},
+			expectedError: nil,
+		},
+		{
+			backupStatus: map[string]string{"spoke1": "Succeeded", "spoke2": "BackupTimeout", "spoke3": "UnrecoverableError"},
+			expectedError: fmt.Errorf("backup of cgu %s in namespace %s failed on clusters [%s]",
+				defaultCguName, defaultCguNsName, "spoke2: BackupTimeout, spoke3: UnrecoverableError"),
+		},
+		{
+			backupStatus:  map[string]string{"spoke1": "Succeeded", "spoke2": "Starting"},
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		cgu := buildDummyCgu(defaultCguName, defaultCguNsName, defaultCguMaxConcurrency)
+		cgu.Status.Backup = &v1alpha1.BackupStatus{Status: testCase.backupStatus}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{cgu}})
+
+		_, err := buildValidCguTestBuilder(testSettings).WaitUntilBackupDone(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
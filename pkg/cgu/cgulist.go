@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -18,7 +18,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...runtimeclient.L
 	passedOptions := runtimeclient.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -28,12 +28,12 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...runtimeclient.L
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	err := apiClient.Client.List(context.TODO(), cguList, &passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all CGUs in all namespaces due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all CGUs in all namespaces due to %s", err.Error())
 
 		return nil, err
 	}
@@ -0,0 +1,231 @@
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ResourceBuilder is the lifecycle interface of the resources registered with the cleaner. The eco-goinfra builders
+// return their own type from Create and some of them from Delete, so they do not implement it directly and are
+// adapted to it with Wrap or WrapDeleteReturner, e.g. Wrap[*service.Builder](serviceBuilder) or
+// WrapDeleteReturner[*route.Builder](routeBuilder). Only the builders that expose a GetGVR method can be adapted.
+type ResourceBuilder interface {
+	Exists() bool
+	Create() error
+	Delete() error
+	GetGVR() schema.GroupVersionResource
+}
+
+// Builder is implemented by the builders that expose GetGVR and whose Delete method returns only an error.
+type Builder[T any] interface {
+	Exists() bool
+	Create() (T, error)
+	Delete() error
+	GetGVR() schema.GroupVersionResource
+}
+
+// DeleteReturner is implemented by the builders that expose GetGVR and whose Delete method returns the builder
+// alongside the error.
+type DeleteReturner[T any] interface {
+	Exists() bool
+	Create() (T, error)
+	Delete() (T, error)
+	GetGVR() schema.GroupVersionResource
+}
+
+// wrappedBuilder adapts a Builder to the ResourceBuilder interface.
+type wrappedBuilder[T any] struct {
+	builder Builder[T]
+}
+
+// Exists checks whether the wrapped resource exists on the cluster.
+func (wrapped *wrappedBuilder[T]) Exists() bool {
+	return wrapped.builder.Exists()
+}
+
+// Create creates the wrapped resource on the cluster.
+func (wrapped *wrappedBuilder[T]) Create() error {
+	_, err := wrapped.builder.Create()
+
+	return err
+}
+
+// Delete removes the wrapped resource from the cluster.
+func (wrapped *wrappedBuilder[T]) Delete() error {
+	return wrapped.builder.Delete()
+}
+
+// GetGVR returns the GroupVersionResource of the wrapped resource.
+func (wrapped *wrappedBuilder[T]) GetGVR() schema.GroupVersionResource {
+	return wrapped.builder.GetGVR()
+}
+
+// wrappedDeleteReturner adapts a DeleteReturner to the ResourceBuilder interface.
+type wrappedDeleteReturner[T any] struct {
+	builder DeleteReturner[T]
+}
+
+// Exists checks whether the wrapped resource exists on the cluster.
+func (wrapped *wrappedDeleteReturner[T]) Exists() bool {
+	return wrapped.builder.Exists()
+}
+
+// Create creates the wrapped resource on the cluster.
+func (wrapped *wrappedDeleteReturner[T]) Create() error {
+	_, err := wrapped.builder.Create()
+
+	return err
+}
+
+// Delete removes the wrapped resource from the cluster.
+func (wrapped *wrappedDeleteReturner[T]) Delete() error {
+	_, err := wrapped.builder.Delete()
+
+	return err
+}
+
+// GetGVR returns the GroupVersionResource of the wrapped resource.
+func (wrapped *wrappedDeleteReturner[T]) GetGVR() schema.GroupVersionResource {
+	return wrapped.builder.GetGVR()
+}
+
+// Wrap adapts a builder whose Delete method returns only an error to the ResourceBuilder interface.
+func Wrap[T any](builder Builder[T]) ResourceBuilder {
+	return &wrappedBuilder[T]{builder: builder}
+}
+
+// WrapDeleteReturner adapts a builder whose Delete method returns the builder alongside the error to the
+// ResourceBuilder interface.
+func WrapDeleteReturner[T any](builder DeleteReturner[T]) ResourceBuilder {
+	return &wrappedDeleteReturner[T]{builder: builder}
+}
+
+// Cleaner provides struct for a registry of builders that are removed from the cluster in a single CleanAll call.
+type Cleaner struct {
+	// Maximum number of resources deleted at the same time.
+	parallelism int
+	// Maximum time to wait for a single resource to be removed from the cluster.
+	timeout time.Duration
+
+	mutex     sync.Mutex
+	resources []ResourceBuilder
+}
+
+// NewCleaner creates a new instance of Cleaner which deletes up to parallelism resources at the same time and waits
+// up to timeout for each of them to be removed.
+func NewCleaner(parallelism int, timeout time.Duration) *Cleaner {
+	logging.V(100).Infof(
+		"Initializing new Cleaner structure with parallelism %d and per-resource timeout %s", parallelism, timeout)
+
+	if parallelism < 1 {
+		logging.V(100).Infof("The parallelism of the cleaner is %d, defaulting to 1", parallelism)
+
+		parallelism = 1
+	}
+
+	return &Cleaner{
+		parallelism: parallelism,
+		timeout:     timeout,
+	}
+}
+
+// Register adds the given builders to the cleaner registry.
+func (cleaner *Cleaner) Register(builders ...ResourceBuilder) {
+	cleaner.mutex.Lock()
+	defer cleaner.mutex.Unlock()
+
+	for _, builder := range builders {
+		if builder == nil {
+			logging.V(100).Infof("Skipping registration of nil builder")
+
+			continue
+		}
+
+		cleaner.resources = append(cleaner.resources, builder)
+	}
+}
+
+// Len returns the number of builders currently registered.
+func (cleaner *Cleaner) Len() int {
+	cleaner.mutex.Lock()
+	defer cleaner.mutex.Unlock()
+
+	return len(cleaner.resources)
+}
+
+// CleanAll deletes every registered resource in reverse order of registration and waits for it to be removed
+// from the cluster. With a parallelism of 1 each resource is removed before the deletion of the next one starts, so
+// resources registered after their dependencies are always removed first. With a higher parallelism the deletions
+// are only started in reverse order and may complete in any order. The registry is emptied and all the errors that
+// occurred are returned together.
+func (cleaner *Cleaner) CleanAll() error {
+	cleaner.mutex.Lock()
+	resources := cleaner.resources
+	cleaner.resources = nil
+	cleaner.mutex.Unlock()
+
+	logging.V(100).Infof("Cleaning up %d registered resources", len(resources))
+
+	var (
+		waitGroup sync.WaitGroup
+		errMutex  sync.Mutex
+		cleanErr  error
+	)
+
+	semaphore := make(chan struct{}, cleaner.parallelism)
+
+	for index := len(resources) - 1; index >= 0; index-- {
+		resource := resources[index]
+		semaphore <- struct{}{}
+
+		waitGroup.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+				waitGroup.Done()
+			}()
+
+			err := cleaner.deleteAndWait(resource)
+			if err != nil {
+				errMutex.Lock()
+				cleanErr = errors.Join(cleanErr, err)
+				errMutex.Unlock()
+			}
+		}()
+	}
+
+	waitGroup.Wait()
+
+	return cleanErr
+}
+
+// deleteAndWait deletes the resource and waits until it no longer exists.
+func (cleaner *Cleaner) deleteAndWait(resource ResourceBuilder) error {
+	err := resource.Delete()
+	if err != nil {
+		logging.V(100).Infof("Failed to delete resource %s due to %s", resource.GetGVR().Resource, err.Error())
+
+		return fmt.Errorf("failed to delete resource %s: %w", resource.GetGVR().Resource, err)
+	}
+
+	err = wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, cleaner.timeout, true, func(ctx context.Context) (bool, error) {
+			return !resource.Exists(), nil
+		})
+
+	if err != nil {
+		logging.V(100).Infof("Resource %s was not removed within %s", resource.GetGVR().Resource, cleaner.timeout)
+
+		return fmt.Errorf("resource %s was not removed within %s: %w", resource.GetGVR().Resource, cleaner.timeout, err)
+	}
+
+	return nil
+}
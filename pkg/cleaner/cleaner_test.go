@@ -0,0 +1,135 @@
+package cleaner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/configmap"
+	"github.com/openshift-kni/eco-goinfra/pkg/route"
+	"github.com/openshift-kni/eco-goinfra/pkg/service"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCleanAll(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "test-namespace"}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "test-namespace"}},
+			&routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "test-route", Namespace: "test-namespace"}},
+		},
+	})
+
+	testService := service.NewBuilder(
+		testSettings, "test-service", "test-namespace", map[string]string{"app": "test"}, corev1.ServicePort{Port: 80})
+	testConfigMap := configmap.NewBuilder(testSettings, "test-configmap", "test-namespace")
+	testRoute := route.NewBuilder(testSettings, "test-route", "test-namespace", "test-service")
+
+	testCleaner := NewCleaner(2, 5*time.Second)
+	testCleaner.Register(
+		Wrap[*service.Builder](testService),
+		Wrap[*configmap.Builder](testConfigMap),
+		WrapDeleteReturner[*route.Builder](testRoute),
+		nil)
+	assert.Equal(t, 3, testCleaner.Len())
+
+	assert.True(t, testService.Exists())
+	assert.True(t, testConfigMap.Exists())
+	assert.True(t, testRoute.Exists())
+
+	err := testCleaner.CleanAll()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, testCleaner.Len())
+
+	assert.False(t, testService.Exists())
+	assert.False(t, testConfigMap.Exists())
+	assert.False(t, testRoute.Exists())
+}
+
+func TestCleanAllErrors(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testCleaner := NewCleaner(0, time.Second)
+	testCleaner.Register(
+		Wrap[*configmap.Builder](configmap.NewBuilder(testSettings, "", "test-namespace")),
+		Wrap[*configmap.Builder](configmap.NewBuilder(testSettings, "test-configmap", "")))
+
+	err := testCleaner.CleanAll()
+	assert.ErrorContains(t, err, "configmap 'name' cannot be empty")
+	assert.ErrorContains(t, err, "configmap 'nsname' cannot be empty")
+	assert.Equal(t, 0, testCleaner.Len())
+}
+
+func TestCleanAllOrder(t *testing.T) {
+	var deleted []string
+
+	testCleaner := NewCleaner(1, time.Second)
+	testCleaner.Register(
+		&orderedBuilder{name: "first", deleted: &deleted},
+		&orderedBuilder{name: "second", deleted: &deleted},
+		&orderedBuilder{name: "third", deleted: &deleted})
+
+	err := testCleaner.CleanAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"third", "second", "first"}, deleted)
+}
+
+func TestWrap(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	wrappedConfigMap := Wrap[*configmap.Builder](configmap.NewBuilder(testSettings, "test-configmap", "test-namespace"))
+	assert.Equal(t, configmap.GetGVR(), wrappedConfigMap.GetGVR())
+	assert.False(t, wrappedConfigMap.Exists())
+
+	err := wrappedConfigMap.Create()
+	assert.Nil(t, err)
+	assert.True(t, wrappedConfigMap.Exists())
+
+	err = wrappedConfigMap.Delete()
+	assert.Nil(t, err)
+	assert.False(t, wrappedConfigMap.Exists())
+
+	wrappedRoute := WrapDeleteReturner[*route.Builder](
+		route.NewBuilder(testSettings, "test-route", "test-namespace", "test-service"))
+	assert.Equal(t, "routes", wrappedRoute.GetGVR().Resource)
+
+	err = wrappedRoute.Create()
+	assert.Nil(t, err)
+	assert.True(t, wrappedRoute.Exists())
+
+	err = wrappedRoute.Delete()
+	assert.Nil(t, err)
+	assert.False(t, wrappedRoute.Exists())
+}
+
+// orderedBuilder is a ResourceBuilder that records the order in which the cleaner deletes it.
+type orderedBuilder struct {
+	name    string
+	exists  bool
+	deleted *[]string
+}
+
+func (builder *orderedBuilder) Exists() bool {
+	return builder.exists
+}
+
+func (builder *orderedBuilder) Create() error {
+	builder.exists = true
+
+	return nil
+}
+
+func (builder *orderedBuilder) Delete() error {
+	*builder.deleted = append(*builder.deleted, builder.name)
+
+	return nil
+}
+
+func (builder *orderedBuilder) GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Version: "v1", Resource: builder.name}
+}
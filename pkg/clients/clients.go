@@ -4,10 +4,26 @@ import (
 	"fmt"
 	"log"
 	"os"
-
+	"reflect"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/bmh/bmhtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/dns/dnstypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/egress/egtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/lca/ibgutypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/lvms/lvmstypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
-
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/nrop/nroptypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/nto/tunedtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/oadp/oadptypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/ocm/ocmtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/odf/odftypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/siteconfig/siteconfigtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/sriov-fec/sriovfectypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/volumesnapshot/snapshottypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/whereabouts/wbtypes"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
@@ -69,12 +85,20 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	scalingv1 "k8s.io/api/autoscaling/v1"
+	scalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	netv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	k8sFakeClient "k8s.io/client-go/kubernetes/fake"
+	k8sTesting "k8s.io/client-go/testing"
 	fakeRuntimeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/v1"
 	grafanaV4V1Alpha1 "github.com/grafana-operator/grafana-operator/v4/api/integreatly/v1alpha1"
@@ -83,7 +107,9 @@ import (
 	machinev1beta1client "github.com/openshift/client-go/machine/clientset/versioned/typed/machine/v1beta1"
 	operatorv1alpha1 "github.com/openshift/client-go/operator/clientset/versioned/typed/operator/v1alpha1"
 	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
+	lsoV1 "github.com/openshift/local-storage-operator/api/v1"
 	lsoV1alpha1 "github.com/openshift/local-storage-operator/api/v1alpha1"
+	ptpV1Types "github.com/openshift/ptp-operator/api/v1"
 	mcmV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api-hub/v1beta1"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	veleroClient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
@@ -127,10 +153,12 @@ type Settings struct {
 	clientCguV1.RanV1alpha1Interface
 }
 
-// New returns a *Settings with the given kubeconfig.
+// New returns a *Settings with the given kubeconfig. The rate limiting and timeouts of the clients can be set with
+// options, e.g. WithQPS, or with the matching environment variables, e.g. ECO_K8S_CLIENT_QPS. The kubeconfig
+// context and the impersonated identity are set with WithContext and WithImpersonation.
 //
 //nolint:funlen
-func New(kubeconfig string) *Settings {
+func New(kubeconfig string, options ...ClientOption) *Settings {
 	var (
 		config *rest.Config
 		err    error
@@ -140,10 +168,19 @@ func New(kubeconfig string) *Settings {
 		kubeconfig = os.Getenv("KUBECONFIG")
 	}
 
+	clientOpts, err := newClientOptions(options...)
+	if err != nil {
+		log.Printf("Error to load client options: %v", err)
+
+		return nil
+	}
+
 	if kubeconfig != "" {
 		log.Printf("Loading kube client config from path %q", kubeconfig)
 
-		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+		config, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+			&clientcmd.ConfigOverrides{CurrentContext: clientOpts.context}).ClientConfig()
 	} else {
 		log.Print("Using in-cluster kube client config")
 
@@ -154,6 +191,8 @@ func New(kubeconfig string) *Settings {
 		return nil
 	}
 
+	clientOpts.apply(config)
+
 	clientSet := &Settings{}
 	clientSet.CoreV1Interface = coreV1Client.NewForConfigOrDie(config)
 	clientSet.ConfigV1Interface = clientConfigV1.NewForConfigOrDie(config)
@@ -253,6 +292,10 @@ func SetScheme(crScheme *runtime.Scheme) error {
 		return err
 	}
 
+	if err := lsoV1.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
 	if err := lsoV1alpha1.AddToScheme(crScheme); err != nil {
 		return err
 	}
@@ -285,6 +328,10 @@ func SetScheme(crScheme *runtime.Scheme) error {
 		return err
 	}
 
+	if err := ptpV1Types.AddToScheme(crScheme); err != nil {
+		return err
+	}
+
 	if err := grafanaV4V1Alpha1.AddToScheme(crScheme); err != nil {
 		return err
 	}
@@ -331,7 +378,7 @@ func SetScheme(crScheme *runtime.Scheme) error {
 // GetAPIClient implements the cluster.APIClientGetter interface.
 func (settings *Settings) GetAPIClient() (*Settings, error) {
 	if settings == nil {
-		glog.V(100).Infof("APIClient is nil")
+		logging.V(100).Infof("APIClient is nil")
 
 		return nil, fmt.Errorf("APIClient cannot be nil")
 	}
@@ -342,11 +389,51 @@ func (settings *Settings) GetAPIClient() (*Settings, error) {
 // TestClientParams provides the struct to store the parameters for the test client.
 type TestClientParams struct {
 	K8sMockObjects []runtime.Object
-	GVK            []schema.GroupVersionKind
+	// GVK registers the kinds of generic objects which are missing from the scheme. The first one is registered for
+	// the first generic object, the following ones for the generic object whose type is named after their kind.
+	GVK []schema.GroupVersionKind
+	// Reactors are added to the fake typed and dynamic clientsets in the given order, e.g. to fail Create of
+	// services with a Conflict error.
+	Reactors []TestReactor
+	// Interceptors wrap the calls of the fake runtime client, e.g. to time out on Get.
+	Interceptors interceptor.Funcs
 
 	// Note: Add more fields below if/when needed.
 }
 
+// TestReactor provides the struct to store a reaction of the fake clientsets to the matching actions.
+type TestReactor struct {
+	// Verb of the matching actions, e.g. create, or * for all verbs.
+	Verb string
+	// Resource of the matching actions, e.g. services, or * for all resources.
+	Resource string
+	// Reaction handles the matching actions.
+	Reaction k8sTesting.ReactionFunc
+}
+
+// NewErrorReactor returns a TestReactor that fails every action with the given verb and resource with err.
+func NewErrorReactor(verb, resource string, err error) TestReactor {
+	return TestReactor{
+		Verb:     verb,
+		Resource: resource,
+		Reaction: func(action k8sTesting.Action) (bool, runtime.Object, error) {
+			return true, nil, err
+		},
+	}
+}
+
+// reactorPrepender is implemented by every fake clientset.
+type reactorPrepender interface {
+	PrependReactor(verb, resource string, reaction k8sTesting.ReactionFunc)
+}
+
+// prependReactors adds the reactors to the fake clientset so that the first reactor is evaluated first.
+func prependReactors(fakeClient reactorPrepender, reactors []TestReactor) {
+	for index := len(reactors) - 1; index >= 0; index-- {
+		fakeClient.PrependReactor(reactors[index].Verb, reactors[index].Resource, reactors[index].Reaction)
+	}
+}
+
 // GetTestClients returns a fake clientset for testing.
 //
 //nolint:funlen,gocyclo
@@ -382,8 +469,16 @@ func GetTestClients(tcp TestClientParams) *Settings {
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *appsv1.StatefulSet:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *appsv1.DaemonSet:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *batchv1.Job:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *batchv1.CronJob:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.ResourceQuota:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *corev1.LimitRange:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.PersistentVolume:
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.PersistentVolumeClaim:
@@ -392,12 +487,26 @@ func GetTestClients(tcp TestClientParams) *Settings {
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *scalingv1.HorizontalPodAutoscaler:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *scalingv2.HorizontalPodAutoscaler:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *storagev1.StorageClass:
 			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.ConfigMap:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *corev1.Secret:
+			k8sClientObjects = append(k8sClientObjects, v)
 		case *corev1.Event:
 			k8sClientObjects = append(k8sClientObjects, v)
+		case *discoveryv1.EndpointSlice:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *netv1.Ingress:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *netv1.NetworkPolicy:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *schedulingv1.PriorityClass:
+			k8sClientObjects = append(k8sClientObjects, v)
+		case *nodev1.RuntimeClass:
+			k8sClientObjects = append(k8sClientObjects, v)
 		// Generic Client Objects
 		case *routev1.Route:
 			genericClientObjects = append(genericClientObjects, v)
@@ -407,11 +516,127 @@ func GetTestClients(tcp TestClientParams) *Settings {
 			genericClientObjects = append(genericClientObjects, v)
 		case *mlbtypes.BGPPeer:
 			genericClientObjects = append(genericClientObjects, v)
+		case *mlbtypes.FRRConfiguration:
+			genericClientObjects = append(genericClientObjects, v)
+		case *mlbtypes.FRRNodeState:
+			genericClientObjects = append(genericClientObjects, v)
+		case *wbtypes.IPPool:
+			genericClientObjects = append(genericClientObjects, v)
+		case *wbtypes.OverlappingRangeIPReservation:
+			genericClientObjects = append(genericClientObjects, v)
+		case *egtypes.EgressIP:
+			genericClientObjects = append(genericClientObjects, v)
+		case *egtypes.EgressService:
+			genericClientObjects = append(genericClientObjects, v)
+		case *egtypes.EgressFirewall:
+			genericClientObjects = append(genericClientObjects, v)
+		case *gwtypes.GatewayClass:
+			genericClientObjects = append(genericClientObjects, v)
+		case *gwtypes.Gateway:
+			genericClientObjects = append(genericClientObjects, v)
+		case *gwtypes.HTTPRoute:
+			genericClientObjects = append(genericClientObjects, v)
+		case *gwtypes.GRPCRoute:
+			genericClientObjects = append(genericClientObjects, v)
+		case *dnstypes.DNSRecord:
+			genericClientObjects = append(genericClientObjects, v)
+		case *snapshottypes.VolumeSnapshot:
+			genericClientObjects = append(genericClientObjects, v)
+		case *snapshottypes.VolumeSnapshotClass:
+			genericClientObjects = append(genericClientObjects, v)
+		case *snapshottypes.VolumeSnapshotContent:
+			genericClientObjects = append(genericClientObjects, v)
+		case *lvmstypes.LVMCluster:
+			genericClientObjects = append(genericClientObjects, v)
+		case *lvmstypes.LVMVolumeGroupNodeStatus:
+			genericClientObjects = append(genericClientObjects, v)
+		case *odftypes.StorageCluster:
+			genericClientObjects = append(genericClientObjects, v)
+		case *odftypes.CephCluster:
+			genericClientObjects = append(genericClientObjects, v)
+		case *oadptypes.DataProtectionApplication:
+			genericClientObjects = append(genericClientObjects, v)
+		case *ocmtypes.ManagedCluster:
+			genericClientObjects = append(genericClientObjects, v)
+		case *ocmtypes.ManagedClusterAddOn:
+			genericClientObjects = append(genericClientObjects, v)
+		case *policiesv1.Policy:
+			genericClientObjects = append(genericClientObjects, v)
+		case *policiesv1.PlacementBinding:
+			genericClientObjects = append(genericClientObjects, v)
+		case *placementrulev1.PlacementRule:
+			genericClientObjects = append(genericClientObjects, v)
+		case *siteconfigtypes.ClusterInstance:
+			genericClientObjects = append(genericClientObjects, v)
+		case *bmhv1alpha1.BareMetalHost:
+			genericClientObjects = append(genericClientObjects, v)
+		case *bmhv1alpha1.HostFirmwareSettings:
+			genericClientObjects = append(genericClientObjects, v)
+		case *bmhv1alpha1.FirmwareSchema:
+			genericClientObjects = append(genericClientObjects, v)
+		case *bmhv1alpha1.PreprovisioningImage:
+			genericClientObjects = append(genericClientObjects, v)
+		case *bmhtypes.DataImage:
+			genericClientObjects = append(genericClientObjects, v)
+		case *bmhtypes.HostFirmwareComponents:
+			genericClientObjects = append(genericClientObjects, v)
+		case *nroptypes.NUMAResourcesOperator:
+			genericClientObjects = append(genericClientObjects, v)
+		case *nroptypes.NUMAResourcesScheduler:
+			genericClientObjects = append(genericClientObjects, v)
+		case *nroptypes.NodeResourceTopology:
+			genericClientObjects = append(genericClientObjects, v)
+		case *performanceV2.PerformanceProfile:
+			genericClientObjects = append(genericClientObjects, v)
+		case *tunedtypes.Tuned:
+			genericClientObjects = append(genericClientObjects, v)
+		case *tunedtypes.Profile:
+			genericClientObjects = append(genericClientObjects, v)
+		case *sriovfectypes.SriovFecClusterConfig:
+			genericClientObjects = append(genericClientObjects, v)
+		case *sriovfectypes.SriovFecNodeConfig:
+			genericClientObjects = append(genericClientObjects, v)
+		case *lsoV1.LocalVolume:
+			genericClientObjects = append(genericClientObjects, v)
+		case *ptpV1Types.PtpConfig:
+			genericClientObjects = append(genericClientObjects, v)
+		case *ptpV1Types.NodePtpDevice:
+			genericClientObjects = append(genericClientObjects, v)
+		case *ptpV1Types.PtpOperatorConfig:
+			genericClientObjects = append(genericClientObjects, v)
+		case *lsoV1alpha1.LocalVolumeSet:
+			genericClientObjects = append(genericClientObjects, v)
+		case *lcav1alpha1.ImageBasedUpgrade:
+			genericClientObjects = append(genericClientObjects, v)
+		case *lcasgv1alpha1.SeedGenerator:
+			genericClientObjects = append(genericClientObjects, v)
+		case *agentInstallV1Beta1.InfraEnv:
+			genericClientObjects = append(genericClientObjects, v)
+		case *agentInstallV1Beta1.Agent:
+			genericClientObjects = append(genericClientObjects, v)
+		case *hiveextV1Beta1.AgentClusterInstall:
+			genericClientObjects = append(genericClientObjects, v)
+		case *operatorV1.DNS:
+			genericClientObjects = append(genericClientObjects, v)
+		case *nmstatev1.NodeNetworkConfigurationPolicy:
+			genericClientObjects = append(genericClientObjects, v)
+		case *nmstateV1alpha1.NodeNetworkConfigurationEnactment:
+			genericClientObjects = append(genericClientObjects, v)
+		case *nmstateV1alpha1.NodeNetworkState:
+			genericClientObjects = append(genericClientObjects, v)
+		case *moduleV1Beta1.Module:
+			genericClientObjects = append(genericClientObjects, v)
+		case *ibgutypes.ImageBasedGroupUpgrade:
+			genericClientObjects = append(genericClientObjects, v)
 		// Velero Client Objects
 		case *velerov1.Backup:
 			veleroClientObjects = append(veleroClientObjects, v)
 		case *velerov1.Restore:
 			veleroClientObjects = append(veleroClientObjects, v)
+		case *velerov1.Schedule:
+			veleroClientObjects = append(veleroClientObjects, v)
+		case *velerov1.BackupStorageLocation:
+			veleroClientObjects = append(veleroClientObjects, v)
 		// SrIov Client Objects
 		case *srIovV1.SriovNetwork:
 			srIovObjects = append(srIovObjects, v)
@@ -429,18 +654,32 @@ func GetTestClients(tcp TestClientParams) *Settings {
 	}
 
 	// Assign the fake clientset to the clientSet
-	clientSet.K8sClient = k8sFakeClient.NewSimpleClientset(k8sClientObjects...)
+	fakeK8sClient := k8sFakeClient.NewSimpleClientset(k8sClientObjects...)
+	prependReactors(fakeK8sClient, tcp.Reactors)
+
+	clientSet.K8sClient = fakeK8sClient
 	clientSet.CoreV1Interface = clientSet.K8sClient.CoreV1()
 	clientSet.AppsV1Interface = clientSet.K8sClient.AppsV1()
 	clientSet.NetworkingV1Interface = clientSet.K8sClient.NetworkingV1()
 	clientSet.RbacV1Interface = clientSet.K8sClient.RbacV1()
-	clientSet.ClientSrIov = clientSrIovFake.NewSimpleClientset(srIovObjects...)
+	clientSet.StorageV1Interface = clientSet.K8sClient.StorageV1()
+
+	fakeSrIovClient := clientSrIovFake.NewSimpleClientset(srIovObjects...)
+	prependReactors(fakeSrIovClient, tcp.Reactors)
+
+	clientSet.ClientSrIov = fakeSrIovClient
 
 	// Assign the fake velero clientset to the clientSet
-	clientSet.VeleroClient = veleroFakeClient.NewSimpleClientset(veleroClientObjects...)
+	fakeVeleroClient := veleroFakeClient.NewSimpleClientset(veleroClientObjects...)
+	prependReactors(fakeVeleroClient, tcp.Reactors)
+
+	clientSet.VeleroClient = fakeVeleroClient
 	clientSet.VeleroV1Interface = clientSet.VeleroClient.VeleroV1()
 
-	clientSet.ClientCgu = clientCguFake.NewSimpleClientset(cguObjects...)
+	fakeCguClient := clientCguFake.NewSimpleClientset(cguObjects...)
+	prependReactors(fakeCguClient, tcp.Reactors)
+
+	clientSet.ClientCgu = fakeCguClient
 
 	// Update the generic client with schemes of generic resources
 	fakeClientScheme := runtime.NewScheme()
@@ -453,12 +692,25 @@ func GetTestClients(tcp TestClientParams) *Settings {
 	if len(tcp.GVK) > 0 && len(genericClientObjects) > 0 {
 		fakeClientScheme.AddKnownTypeWithName(
 			tcp.GVK[0], genericClientObjects[0])
+
+		for _, gvk := range tcp.GVK[1:] {
+			for _, object := range genericClientObjects {
+				if reflect.TypeOf(object).Elem().Name() == gvk.Kind {
+					fakeClientScheme.AddKnownTypeWithName(gvk, object)
+
+					break
+				}
+			}
+		}
 	}
 
-	clientSet.Interface = dynamicFake.NewSimpleDynamicClient(fakeClientScheme, genericClientObjects...)
+	fakeDynamicClient := dynamicFake.NewSimpleDynamicClient(fakeClientScheme, genericClientObjects...)
+	prependReactors(fakeDynamicClient, tcp.Reactors)
+
+	clientSet.Interface = fakeDynamicClient
 	// Add fake runtime client to clientSet runtime client
 	clientSet.Client = fakeRuntimeClient.NewClientBuilder().WithScheme(fakeClientScheme).
-		WithRuntimeObjects(genericClientObjects...).Build()
+		WithRuntimeObjects(genericClientObjects...).WithInterceptorFuncs(tcp.Interceptors).Build()
 
 	return clientSet
 }
@@ -0,0 +1,69 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTesting "k8s.io/client-go/testing"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestGetTestClientsReactors(t *testing.T) {
+	conflictErr := k8serrors.NewConflict(schema.GroupResource{Resource: "services"}, "test-service", nil)
+	timeoutErr := k8serrors.NewTimeoutError("get timed out", 0)
+
+	testSettings := GetTestClients(TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "test-namespace"}},
+		},
+		Reactors: []TestReactor{
+			NewErrorReactor("create", "services", conflictErr),
+			NewErrorReactor("get", "*", timeoutErr),
+			{
+				Verb:     "get",
+				Resource: "configmaps",
+				Reaction: func(action k8sTesting.Action) (bool, runtime.Object, error) {
+					return true, &corev1.ConfigMap{}, nil
+				},
+			},
+		},
+	})
+
+	_, err := testSettings.Services("test-namespace").Create(
+		context.TODO(), &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test-service"}}, metav1.CreateOptions{})
+	assert.True(t, k8serrors.IsConflict(err))
+
+	_, err = testSettings.ConfigMaps("test-namespace").Get(context.TODO(), "test-configmap", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsTimeout(err))
+
+	_, err = testSettings.ConfigMaps("test-namespace").Create(
+		context.TODO(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other-configmap"}}, metav1.CreateOptions{})
+	assert.Nil(t, err)
+}
+
+func TestGetTestClientsInterceptors(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{
+		Interceptors: interceptor.Funcs{
+			Get: func(ctx context.Context, client runtimeClient.WithWatch, key runtimeClient.ObjectKey,
+				obj runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+				return k8serrors.NewTimeoutError("get timed out", 0)
+			},
+		},
+	})
+
+	err := testSettings.Get(
+		context.TODO(), runtimeClient.ObjectKey{Name: "test-route", Namespace: "test-namespace"}, &routev1.Route{})
+	assert.True(t, k8serrors.IsTimeout(err))
+
+	err = testSettings.Create(
+		context.TODO(), &routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "test-route", Namespace: "test-namespace"}})
+	assert.Nil(t, err)
+}
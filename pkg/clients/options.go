@@ -0,0 +1,232 @@
+package clients
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/client-go/rest"
+)
+
+// Environment variables used to set the default client options. The names mirror the ArgoCD client settings.
+const (
+	// EnvK8sClientQPS sets the maximum queries per second sent by the clients.
+	EnvK8sClientQPS = "ECO_K8S_CLIENT_QPS"
+	// EnvK8sClientBurst sets the maximum burst of queries sent by the clients.
+	EnvK8sClientBurst = "ECO_K8S_CLIENT_BURST"
+	// EnvK8sClientTimeout sets the timeout of a single request, e.g. 30s.
+	EnvK8sClientTimeout = "ECO_K8S_CLIENT_TIMEOUT"
+	// EnvK8sTCPTimeout sets the timeout for establishing a TCP connection, e.g. 30s.
+	EnvK8sTCPTimeout = "ECO_K8S_TCP_TIMEOUT"
+	// EnvK8sTCPKeepAlive sets the interval between TCP keep-alive probes, e.g. 30s.
+	EnvK8sTCPKeepAlive = "ECO_K8S_TCP_KEEPALIVE"
+	// EnvK8sTLSHandshakeTimeout sets the timeout of the TLS handshake, e.g. 10s.
+	EnvK8sTLSHandshakeTimeout = "ECO_K8S_TLS_HANDSHAKE_TIMEOUT"
+)
+
+// defaultTCPTimeout is the client-go default for the TCP connection timeout and keep-alive interval.
+const defaultTCPTimeout = 30 * time.Second
+
+// ClientOption sets an option of the clients created by New. Options passed to New take precedence over the
+// environment variables.
+type ClientOption func(options *clientOptions)
+
+// clientOptions holds the rate limiting and timeout settings applied to the rest config of every client.
+type clientOptions struct {
+	qps                 float32
+	burst               int
+	timeout             time.Duration
+	tcpTimeout          time.Duration
+	tcpKeepAlive        time.Duration
+	tlsHandshakeTimeout time.Duration
+	context             string
+	impersonate         rest.ImpersonationConfig
+	maxRetries          int
+	initialBackoff      time.Duration
+}
+
+// WithQPS sets the maximum queries per second sent by the clients.
+func WithQPS(qps float32) ClientOption {
+	return func(options *clientOptions) {
+		options.qps = qps
+	}
+}
+
+// WithBurst sets the maximum burst of queries sent by the clients.
+func WithBurst(burst int) ClientOption {
+	return func(options *clientOptions) {
+		options.burst = burst
+	}
+}
+
+// WithTimeout sets the timeout of a single request sent by the clients.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithTCPTimeout sets the timeout for establishing a TCP connection to the API server.
+func WithTCPTimeout(timeout time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.tcpTimeout = timeout
+	}
+}
+
+// WithTCPKeepAlive sets the interval between TCP keep-alive probes of the connections to the API server.
+func WithTCPKeepAlive(keepAlive time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.tcpKeepAlive = keepAlive
+	}
+}
+
+// WithTLSHandshakeTimeout sets the timeout of the TLS handshake with the API server.
+func WithTLSHandshakeTimeout(timeout time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.tlsHandshakeTimeout = timeout
+	}
+}
+
+// WithContext sets the kubeconfig context used to build the clients instead of the current context. It has no effect
+// with the in-cluster config.
+func WithContext(contextName string) ClientOption {
+	return func(options *clientOptions) {
+		options.context = contextName
+	}
+}
+
+// WithImpersonation makes the clients act as the given user and groups.
+func WithImpersonation(userName string, groups ...string) ClientOption {
+	return func(options *clientOptions) {
+		options.impersonate.UserName = userName
+		options.impersonate.Groups = groups
+	}
+}
+
+// WithServiceAccountImpersonation makes the clients act as the given service account.
+func WithServiceAccountImpersonation(name, nsname string) ClientOption {
+	return func(options *clientOptions) {
+		options.impersonate.UserName = fmt.Sprintf("system:serviceaccount:%s:%s", nsname, name)
+		options.impersonate.Groups = nil
+	}
+}
+
+// newClientOptions returns the client options read from the environment and overridden by the given options.
+func newClientOptions(options ...ClientOption) (*clientOptions, error) {
+	clientOpts := &clientOptions{}
+
+	if value := os.Getenv(EnvK8sClientQPS); value != "" {
+		qps, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of %s: %w", value, EnvK8sClientQPS, err)
+		}
+
+		clientOpts.qps = float32(qps)
+	}
+
+	if value := os.Getenv(EnvK8sClientBurst); value != "" {
+		burst, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of %s: %w", value, EnvK8sClientBurst, err)
+		}
+
+		clientOpts.burst = burst
+	}
+
+	for env, duration := range map[string]*time.Duration{
+		EnvK8sClientTimeout:       &clientOpts.timeout,
+		EnvK8sTCPTimeout:          &clientOpts.tcpTimeout,
+		EnvK8sTCPKeepAlive:        &clientOpts.tcpKeepAlive,
+		EnvK8sTLSHandshakeTimeout: &clientOpts.tlsHandshakeTimeout,
+	} {
+		value := os.Getenv(env)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of %s: %w", value, env, err)
+		}
+
+		*duration = parsed
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(clientOpts)
+		}
+	}
+
+	return clientOpts, nil
+}
+
+// apply sets the client options on the rest config. Unset options keep the client-go defaults.
+func (clientOpts *clientOptions) apply(config *rest.Config) {
+	logging.V(100).Infof("Applying client options: qps %v, burst %d, timeout %s, tcp timeout %s, "+
+		"tcp keep-alive %s, tls handshake timeout %s", clientOpts.qps, clientOpts.burst, clientOpts.timeout,
+		clientOpts.tcpTimeout, clientOpts.tcpKeepAlive, clientOpts.tlsHandshakeTimeout)
+
+	if clientOpts.qps > 0 {
+		config.QPS = clientOpts.qps
+	}
+
+	if clientOpts.burst > 0 {
+		config.Burst = clientOpts.burst
+	}
+
+	if clientOpts.timeout > 0 {
+		config.Timeout = clientOpts.timeout
+	}
+
+	if clientOpts.impersonate.UserName != "" || len(clientOpts.impersonate.Groups) > 0 {
+		logging.V(100).Infof("Impersonating user %s with groups %v",
+			clientOpts.impersonate.UserName, clientOpts.impersonate.Groups)
+
+		config.Impersonate = clientOpts.impersonate
+	}
+
+	if clientOpts.tcpTimeout > 0 || clientOpts.tcpKeepAlive > 0 || clientOpts.tlsHandshakeTimeout > 0 {
+		// Setting Dial also makes client-go build a dedicated transport for the config instead of sharing
+		// http.DefaultTransport, so the TLS handshake timeout below can be set on it safely.
+		dialer := &net.Dialer{Timeout: defaultTCPTimeout, KeepAlive: defaultTCPTimeout}
+
+		if clientOpts.tcpTimeout > 0 {
+			dialer.Timeout = clientOpts.tcpTimeout
+		}
+
+		if clientOpts.tcpKeepAlive > 0 {
+			dialer.KeepAlive = clientOpts.tcpKeepAlive
+		}
+
+		config.Dial = dialer.DialContext
+	}
+
+	if clientOpts.tlsHandshakeTimeout > 0 {
+		// The wrapper is added before the retry wrapper so that it receives the *http.Transport built by client-go.
+		config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+			transport, ok := roundTripper.(*http.Transport)
+			if !ok || transport == http.DefaultTransport {
+				logging.V(100).Infof("Cannot set TLS handshake timeout on round tripper %T", roundTripper)
+
+				return roundTripper
+			}
+
+			transport.TLSHandshakeTimeout = clientOpts.tlsHandshakeTimeout
+
+			return transport
+		})
+	}
+
+	if clientOpts.maxRetries > 0 {
+		logging.V(100).Infof("Retrying transient errors up to %d times", clientOpts.maxRetries)
+
+		config.Wrap(func(roundTripper http.RoundTripper) http.RoundTripper {
+			return newRetryRoundTripper(roundTripper, clientOpts.maxRetries, clientOpts.initialBackoff)
+		})
+	}
+}
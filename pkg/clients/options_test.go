@@ -0,0 +1,175 @@
+package clients
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	testCases := []struct {
+		env             map[string]string
+		options         []ClientOption
+		expectedOptions *clientOptions
+		expectedError   string
+	}{
+		{
+			expectedOptions: &clientOptions{},
+		},
+		{
+			env: map[string]string{
+				EnvK8sClientQPS:           "50",
+				EnvK8sClientBurst:         "100",
+				EnvK8sClientTimeout:       "1m",
+				EnvK8sTLSHandshakeTimeout: "5s",
+			},
+			options: []ClientOption{WithBurst(200), WithTCPTimeout(10 * time.Second), nil},
+			expectedOptions: &clientOptions{
+				qps:                 50,
+				burst:               200,
+				timeout:             time.Minute,
+				tcpTimeout:          10 * time.Second,
+				tlsHandshakeTimeout: 5 * time.Second,
+			},
+		},
+		{
+			options: []ClientOption{
+				WithQPS(20), WithTimeout(time.Second), WithTCPKeepAlive(time.Minute), WithTLSHandshakeTimeout(time.Second)},
+			expectedOptions: &clientOptions{
+				qps:                 20,
+				timeout:             time.Second,
+				tcpKeepAlive:        time.Minute,
+				tlsHandshakeTimeout: time.Second,
+			},
+		},
+		{
+			env:           map[string]string{EnvK8sClientBurst: "many"},
+			expectedError: "invalid value \"many\" of ECO_K8S_CLIENT_BURST",
+		},
+		{
+			env:           map[string]string{EnvK8sTCPTimeout: "30"},
+			expectedError: "invalid value \"30\" of ECO_K8S_TCP_TIMEOUT",
+		},
+	}
+
+	for _, testCase := range testCases {
+		for env, value := range testCase.env {
+			t.Setenv(env, value)
+		}
+
+		clientOpts, err := newClientOptions(testCase.options...)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedOptions, clientOpts)
+		}
+
+		for env := range testCase.env {
+			t.Setenv(env, "")
+		}
+	}
+}
+
+func TestClientOptionsApply(t *testing.T) {
+	config := &rest.Config{
+		Host: "https://api.test.example.com:6443", QPS: 5, Burst: 10, TLSClientConfig: rest.TLSClientConfig{Insecure: true}}
+
+	(&clientOptions{}).apply(config)
+	assert.Equal(t, float32(5), config.QPS)
+	assert.Equal(t, 10, config.Burst)
+	assert.Nil(t, config.Dial)
+	assert.Nil(t, config.WrapTransport)
+
+	(&clientOptions{
+		qps: 100, burst: 200, timeout: time.Minute, tcpTimeout: 5 * time.Second, tlsHandshakeTimeout: time.Second,
+	}).apply(config)
+	assert.Equal(t, float32(100), config.QPS)
+	assert.Equal(t, 200, config.Burst)
+	assert.Equal(t, time.Minute, config.Timeout)
+	assert.NotNil(t, config.Dial)
+	assert.Nil(t, config.Transport)
+	assert.True(t, config.Insecure)
+
+	roundTripper, err := rest.TransportFor(config)
+	assert.Nil(t, err)
+
+	transport, ok := roundTripper.(*http.Transport)
+	assert.True(t, ok)
+	assert.NotSame(t, http.DefaultTransport, transport)
+	assert.Equal(t, time.Second, transport.TLSHandshakeTimeout)
+	assert.NotNil(t, transport.Proxy)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestClientOptionsApplyKeepsAuthentication(t *testing.T) {
+	execConfig := &clientcmdapi.ExecConfig{
+		Command: "test-credential-plugin", APIVersion: "client.authentication.k8s.io/v1"}
+	config := &rest.Config{Host: "https://api.test.example.com:6443", ExecProvider: execConfig}
+
+	(&clientOptions{
+		tcpTimeout:          5 * time.Second,
+		tlsHandshakeTimeout: time.Second,
+		impersonate:         rest.ImpersonationConfig{UserName: "test-user"},
+	}).apply(config)
+	assert.Same(t, execConfig, config.ExecProvider)
+	assert.Equal(t, "test-user", config.Impersonate.UserName)
+	assert.Nil(t, config.Transport)
+}
+
+func TestNewWithContextAndImpersonation(t *testing.T) {
+	kubeconfigPath := filepath.Join(t.TempDir(), "kubeconfig")
+
+	err := os.WriteFile(kubeconfigPath, []byte(testKubeconfig), 0600)
+	assert.Nil(t, err)
+
+	apiClient := New(kubeconfigPath)
+	assert.NotNil(t, apiClient)
+	assert.Equal(t, "https://hub.example.com:6443", apiClient.Config.Host)
+	assert.Empty(t, apiClient.Config.Impersonate.UserName)
+
+	apiClient = New(kubeconfigPath, WithContext("spoke"), WithImpersonation("test-user", "test-group"))
+	assert.NotNil(t, apiClient)
+	assert.Equal(t, "https://spoke.example.com:6443", apiClient.Config.Host)
+	assert.Equal(t, "test-user", apiClient.Config.Impersonate.UserName)
+	assert.Equal(t, []string{"test-group"}, apiClient.Config.Impersonate.Groups)
+
+	apiClient = New(kubeconfigPath, WithServiceAccountImpersonation("test-sa", "test-namespace"))
+	assert.NotNil(t, apiClient)
+	assert.Equal(t, "system:serviceaccount:test-namespace:test-sa", apiClient.Config.Impersonate.UserName)
+
+	apiClient = New(kubeconfigPath, WithContext("missing"))
+	assert.Nil(t, apiClient)
+}
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: hub
+  cluster:
+    server: https://hub.example.com:6443
+- name: spoke
+  cluster:
+    server: https://spoke.example.com:6443
+users:
+- name: admin
+  user:
+    token: test-token
+contexts:
+- name: hub
+  context:
+    cluster: hub
+    user: admin
+- name: spoke
+  context:
+    cluster: spoke
+    user: admin
+current-context: hub
+`
@@ -0,0 +1,279 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const portForwardProtocolV1Name = "portforward.k8s.io"
+
+// PortForward forwards localPort on the loopback interface to remotePort of a pod in namespace nsname. The
+// podOrService argument is a pod name, optionally prefixed with pod/, or a service name prefixed with service/ or
+// svc/. For services a running pod selected by the service is used and remotePort is the service port, which is
+// translated to the target port of the pod. Forwarding stops when the returned function is called or ctx is done.
+func (settings *Settings) PortForward(
+	ctx context.Context, nsname, podOrService string, localPort, remotePort int) (func(), error) {
+	logging.V(100).Infof("Forwarding local port %d to port %d of %s in namespace %s",
+		localPort, remotePort, podOrService, nsname)
+
+	if nsname == "" || podOrService == "" {
+		logging.V(100).Infof("The namespace or the target of the port-forward is empty")
+
+		return nil, fmt.Errorf("port-forward namespace and target cannot be empty")
+	}
+
+	if !isValidPort(localPort) || !isValidPort(remotePort) {
+		logging.V(100).Infof("The local port %d or the remote port %d is invalid", localPort, remotePort)
+
+		return nil, fmt.Errorf("port-forward ports must be between 1 and 65535, got %d and %d", localPort, remotePort)
+	}
+
+	podName, podPort, err := settings.resolvePortForwardTarget(ctx, nsname, podOrService, remotePort)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.Config == nil {
+		logging.V(100).Infof("The apiclient has no rest config")
+
+		return nil, fmt.Errorf("cannot port-forward to pod %s without rest config", podName)
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(settings.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	url := settings.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(nsname).
+		Resource("pods").
+		Name(podName).
+		SubResource("portforward").
+		URL()
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	streamConn, _, err := dialer.Dial(portForwardProtocolV1Name)
+	if err != nil {
+		logging.V(100).Infof("Failed to connect to pod %s due to %s", podName, err.Error())
+
+		return nil, fmt.Errorf("failed to port-forward to pod %s: %w", podName, err)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(localPort)))
+	if err != nil {
+		_ = streamConn.Close()
+
+		logging.V(100).Infof("Failed to listen on local port %d due to %s", localPort, err.Error())
+
+		return nil, fmt.Errorf("failed to listen on local port %d: %w", localPort, err)
+	}
+
+	var stopOnce sync.Once
+
+	stop := func() {
+		stopOnce.Do(func() {
+			logging.V(100).Infof("Stopping port-forward from local port %d to pod %s", localPort, podName)
+
+			_ = listener.Close()
+			_ = streamConn.Close()
+		})
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-streamConn.CloseChan():
+		}
+
+		stop()
+	}()
+
+	go acceptPortForwardConnections(listener, streamConn, podName, podPort)
+
+	return stop, nil
+}
+
+// resolvePortForwardTarget returns the pod and the pod port to forward to.
+func (settings *Settings) resolvePortForwardTarget(
+	ctx context.Context, nsname, podOrService string, remotePort int) (string, int, error) {
+	kind, name, found := strings.Cut(podOrService, "/")
+	if !found {
+		kind, name = "pod", podOrService
+	}
+
+	switch kind {
+	case "pod", "pods", "po":
+		_, err := settings.Pods(nsname).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			logging.V(100).Infof("Failed to get pod %s due to %s", name, err.Error())
+
+			return "", 0, fmt.Errorf("failed to get pod %s in namespace %s: %w", name, nsname, err)
+		}
+
+		return name, remotePort, nil
+	case "service", "services", "svc":
+		return settings.resolveServicePod(ctx, nsname, name, remotePort)
+	default:
+		logging.V(100).Infof("The port-forward target kind %s is not supported", kind)
+
+		return "", 0, fmt.Errorf("unsupported port-forward target kind %s", kind)
+	}
+}
+
+// resolveServicePod returns a running pod selected by the service and the target port of the service port.
+func (settings *Settings) resolveServicePod(
+	ctx context.Context, nsname, name string, servicePort int) (string, int, error) {
+	service, err := settings.Services(nsname).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		logging.V(100).Infof("Failed to get service %s due to %s", name, err.Error())
+
+		return "", 0, fmt.Errorf("failed to get service %s in namespace %s: %w", name, nsname, err)
+	}
+
+	if len(service.Spec.Selector) == 0 {
+		return "", 0, fmt.Errorf("service %s in namespace %s has no selector", name, nsname)
+	}
+
+	podList, err := settings.Pods(nsname).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(service.Spec.Selector).String()})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to list pods of service %s in namespace %s: %w", name, nsname, err)
+	}
+
+	for index := range podList.Items {
+		pod := &podList.Items[index]
+
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		podPort, err := servicePortToPodPort(service, pod, servicePort)
+		if err != nil {
+			return "", 0, err
+		}
+
+		return pod.Name, podPort, nil
+	}
+
+	logging.V(100).Infof("The service %s has no running pods", name)
+
+	return "", 0, fmt.Errorf("service %s in namespace %s has no running pods", name, nsname)
+}
+
+// servicePortToPodPort translates the service port to the matching port of the pod.
+func servicePortToPodPort(service *corev1.Service, pod *corev1.Pod, servicePort int) (int, error) {
+	for _, port := range service.Spec.Ports {
+		if int(port.Port) != servicePort {
+			continue
+		}
+
+		switch {
+		case port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal == 0:
+			return servicePort, nil
+		case port.TargetPort.Type == intstr.Int:
+			return int(port.TargetPort.IntVal), nil
+		}
+
+		for _, container := range pod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == port.TargetPort.StrVal {
+					return int(containerPort.ContainerPort), nil
+				}
+			}
+		}
+
+		return 0, fmt.Errorf("pod %s has no port named %s", pod.Name, port.TargetPort.StrVal)
+	}
+
+	return 0, fmt.Errorf("service %s does not expose port %d", service.Name, servicePort)
+}
+
+// acceptPortForwardConnections forwards every connection accepted by the listener until it is closed.
+func acceptPortForwardConnections(
+	listener net.Listener, streamConn httpstream.Connection, podName string, podPort int) {
+	for requestID := 0; ; requestID++ {
+		localConn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go handlePortForwardConnection(localConn, streamConn, podName, podPort, requestID)
+	}
+}
+
+// handlePortForwardConnection copies data between the local connection and a new data stream to the pod.
+func handlePortForwardConnection(
+	localConn net.Conn, streamConn httpstream.Connection, podName string, podPort, requestID int) {
+	defer localConn.Close()
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(podPort))
+	headers.Set(corev1.PortForwardRequestIDHeader, strconv.Itoa(requestID))
+
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		logging.V(100).Infof("Failed to create error stream to pod %s due to %s", podName, err.Error())
+
+		return
+	}
+
+	// The error stream is only read from.
+	_ = errorStream.Close()
+
+	go func() {
+		message, err := io.ReadAll(errorStream)
+		if err == nil && len(message) > 0 {
+			logging.V(100).Infof("Port-forward to port %d of pod %s failed: %s", podPort, podName, string(message))
+		}
+	}()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		logging.V(100).Infof("Failed to create data stream to pod %s due to %s", podName, err.Error())
+
+		return
+	}
+
+	defer streamConn.RemoveStreams(errorStream, dataStream)
+
+	remoteDone := make(chan struct{})
+
+	go func() {
+		_, _ = io.Copy(localConn, dataStream)
+
+		close(remoteDone)
+	}()
+
+	go func() {
+		_, _ = io.Copy(dataStream, localConn)
+
+		// Closing the write side tells the pod that no more data is coming.
+		_ = dataStream.Close()
+	}()
+
+	<-remoteDone
+}
+
+// isValidPort checks that port is a valid TCP port number.
+func isValidPort(port int) bool {
+	return port > 0 && port <= 65535
+}
@@ -0,0 +1,132 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPortForward(t *testing.T) {
+	testCases := []struct {
+		nsname        string
+		target        string
+		localPort     int
+		remotePort    int
+		expectedError string
+	}{
+		{
+			nsname:        "test-namespace",
+			target:        "test-pod",
+			localPort:     8080,
+			remotePort:    80,
+			expectedError: "cannot port-forward to pod test-pod without rest config",
+		},
+		{
+			nsname:        "",
+			target:        "test-pod",
+			localPort:     8080,
+			remotePort:    80,
+			expectedError: "port-forward namespace and target cannot be empty",
+		},
+		{
+			nsname:        "test-namespace",
+			target:        "test-pod",
+			localPort:     0,
+			remotePort:    80,
+			expectedError: "port-forward ports must be between 1 and 65535, got 0 and 80",
+		},
+		{
+			nsname:        "test-namespace",
+			target:        "pod/missing-pod",
+			localPort:     8080,
+			remotePort:    80,
+			expectedError: "failed to get pod missing-pod in namespace test-namespace: pods \"missing-pod\" not found",
+		},
+		{
+			nsname:        "test-namespace",
+			target:        "deployment/test-deployment",
+			localPort:     8080,
+			remotePort:    80,
+			expectedError: "unsupported port-forward target kind deployment",
+		},
+		{
+			nsname:        "test-namespace",
+			target:        "svc/test-service",
+			localPort:     8080,
+			remotePort:    443,
+			expectedError: "service test-service does not expose port 443",
+		},
+	}
+
+	testSettings := buildPortForwardTestClients()
+
+	for _, testCase := range testCases {
+		stop, err := testSettings.PortForward(
+			context.TODO(), testCase.nsname, testCase.target, testCase.localPort, testCase.remotePort)
+		assert.Nil(t, stop)
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+func TestResolvePortForwardTarget(t *testing.T) {
+	testCases := []struct {
+		target          string
+		remotePort      int
+		expectedPod     string
+		expectedPort    int
+		expectedFailure bool
+	}{
+		{target: "test-pod", remotePort: 80, expectedPod: "test-pod", expectedPort: 80},
+		{target: "service/test-service", remotePort: 80, expectedPod: "test-pod", expectedPort: 8080},
+		{target: "svc/test-service", remotePort: 9090, expectedPod: "test-pod", expectedPort: 9443},
+		{target: "svc/test-service", remotePort: 7070, expectedPod: "test-pod", expectedPort: 7070},
+		{target: "svc/missing-service", remotePort: 80, expectedFailure: true},
+	}
+
+	testSettings := buildPortForwardTestClients()
+
+	for _, testCase := range testCases {
+		podName, podPort, err := testSettings.resolvePortForwardTarget(
+			context.TODO(), "test-namespace", testCase.target, testCase.remotePort)
+
+		if testCase.expectedFailure {
+			assert.NotNil(t, err)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedPod, podName)
+		assert.Equal(t, testCase.expectedPort, podPort)
+	}
+}
+
+func buildPortForwardTestClients() *Settings {
+	return GetTestClients(TestClientParams{K8sMockObjects: []runtime.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-pod", Namespace: "test-namespace", Labels: map[string]string{"app": "test"}},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:  "test-container",
+				Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9443}},
+			}}},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "test-namespace"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "test"},
+				Ports: []corev1.ServicePort{
+					{Port: 80, TargetPort: intstr.FromInt(8080)},
+					{Port: 9090, TargetPort: intstr.FromString("metrics")},
+					{Port: 7070},
+				},
+			},
+		},
+	}})
+}
@@ -0,0 +1,120 @@
+package clients
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// ClusterRegistry provides struct for a set of named clients, e.g. hub, spoke1 and spoke2, used in tests that run
+// against several clusters.
+type ClusterRegistry struct {
+	mutex    sync.RWMutex
+	clusters map[string]*Settings
+}
+
+// NewClusterRegistry creates a new instance of ClusterRegistry.
+func NewClusterRegistry() *ClusterRegistry {
+	logging.V(100).Infof("Initializing new ClusterRegistry structure")
+
+	return &ClusterRegistry{clusters: make(map[string]*Settings)}
+}
+
+// Register adds the given clients to the registry under the given name. A cluster that is already registered under
+// the same name is replaced.
+func (registry *ClusterRegistry) Register(name string, apiClient *Settings) error {
+	logging.V(100).Infof("Registering cluster %s", name)
+
+	if name == "" {
+		logging.V(100).Infof("The name of the cluster is empty")
+
+		return fmt.Errorf("cluster 'name' cannot be empty")
+	}
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient of cluster %s is empty", name)
+
+		return fmt.Errorf("cluster %s 'apiClient' cannot be empty", name)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.clusters[name] = apiClient
+
+	return nil
+}
+
+// RegisterKubeconfig builds the clients from the given kubeconfig with New and adds them to the registry under the
+// given name.
+func (registry *ClusterRegistry) RegisterKubeconfig(name, kubeconfig string, options ...ClientOption) error {
+	logging.V(100).Infof("Registering cluster %s from kubeconfig %s", name, kubeconfig)
+
+	if kubeconfig == "" {
+		logging.V(100).Infof("The kubeconfig of cluster %s is empty", name)
+
+		return fmt.Errorf("cluster %s 'kubeconfig' cannot be empty", name)
+	}
+
+	apiClient := New(kubeconfig, options...)
+	if apiClient == nil {
+		logging.V(100).Infof("Failed to load clients of cluster %s from kubeconfig %s", name, kubeconfig)
+
+		return fmt.Errorf("failed to load clients of cluster %s from kubeconfig %s", name, kubeconfig)
+	}
+
+	return registry.Register(name, apiClient)
+}
+
+// Get returns the clients registered under the given name.
+func (registry *ClusterRegistry) Get(name string) (*Settings, error) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	apiClient, ok := registry.clusters[name]
+	if !ok {
+		logging.V(100).Infof("Cluster %s is not registered", name)
+
+		return nil, fmt.Errorf("cluster %s is not registered", name)
+	}
+
+	return apiClient, nil
+}
+
+// Exists checks whether clients are registered under the given name.
+func (registry *ClusterRegistry) Exists(name string) bool {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	_, ok := registry.clusters[name]
+
+	return ok
+}
+
+// Remove deletes the clients registered under the given name from the registry.
+func (registry *ClusterRegistry) Remove(name string) {
+	logging.V(100).Infof("Removing cluster %s from the registry", name)
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	delete(registry.clusters, name)
+}
+
+// Names returns the sorted names of all registered clusters.
+func (registry *ClusterRegistry) Names() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	names := make([]string, 0, len(registry.clusters))
+
+	for name := range registry.clusters {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
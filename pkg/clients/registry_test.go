@@ -0,0 +1,80 @@
+package clients
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterRegistryRegister(t *testing.T) {
+	testCases := []struct {
+		name          string
+		apiClient     *Settings
+		expectedError string
+	}{
+		{
+			name:      "hub",
+			apiClient: GetTestClients(TestClientParams{}),
+		},
+		{
+			name:          "",
+			apiClient:     GetTestClients(TestClientParams{}),
+			expectedError: "cluster 'name' cannot be empty",
+		},
+		{
+			name:          "spoke1",
+			apiClient:     nil,
+			expectedError: "cluster spoke1 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		registry := NewClusterRegistry()
+
+		err := registry.Register(testCase.name, testCase.apiClient)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Empty(t, registry.Names())
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.True(t, registry.Exists(testCase.name))
+	}
+}
+
+func TestClusterRegistryGet(t *testing.T) {
+	hubClient := GetTestClients(TestClientParams{})
+	spokeClient := GetTestClients(TestClientParams{})
+
+	registry := NewClusterRegistry()
+	assert.Nil(t, registry.Register("spoke1", spokeClient))
+	assert.Nil(t, registry.Register("hub", hubClient))
+	assert.Equal(t, []string{"hub", "spoke1"}, registry.Names())
+
+	apiClient, err := registry.Get("hub")
+	assert.Nil(t, err)
+	assert.Same(t, hubClient, apiClient)
+
+	_, err = registry.Get("spoke2")
+	assert.EqualError(t, err, "cluster spoke2 is not registered")
+
+	registry.Remove("spoke1")
+	assert.False(t, registry.Exists("spoke1"))
+	assert.Equal(t, []string{"hub"}, registry.Names())
+}
+
+func TestClusterRegistryRegisterKubeconfig(t *testing.T) {
+	registry := NewClusterRegistry()
+
+	err := registry.RegisterKubeconfig("hub", "")
+	assert.EqualError(t, err, "cluster hub 'kubeconfig' cannot be empty")
+
+	missingKubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	err = registry.RegisterKubeconfig("hub", missingKubeconfig)
+	assert.EqualError(t, err, "failed to load clients of cluster hub from kubeconfig "+missingKubeconfig)
+	assert.False(t, registry.Exists("hub"))
+}
@@ -0,0 +1,124 @@
+package clients
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+const (
+	// maxRetryBackoff is the upper limit of the delay between two attempts of the same request.
+	maxRetryBackoff = 30 * time.Second
+	// defaultRetryBackoff is the initial delay used when WithRetry is given a non-positive initialBackoff.
+	defaultRetryBackoff = 500 * time.Millisecond
+)
+
+// retryRoundTripper retries requests that failed with a transient error using exponential backoff.
+type retryRoundTripper struct {
+	delegate       http.RoundTripper
+	maxRetries     int
+	initialBackoff time.Duration
+}
+
+// WithRetry makes the clients retry requests that failed with a transient error, i.e. 429 Too Many Requests, a
+// refused connection or, for requests other than POST and PATCH, a 5xx server error. POST and PATCH requests are not
+// retried on a 5xx error because the server may have applied them already. The delay starts at initialBackoff, or
+// 500ms when it is not positive, and doubles after every attempt unless the server sets the Retry-After header.
+// Retries are disabled by default.
+func WithRetry(maxRetries int, initialBackoff time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.maxRetries = maxRetries
+		options.initialBackoff = initialBackoff
+	}
+}
+
+// newRetryRoundTripper wraps the delegate with the retry logic.
+func newRetryRoundTripper(delegate http.RoundTripper, maxRetries int, initialBackoff time.Duration) http.RoundTripper {
+	if initialBackoff <= 0 {
+		logging.V(100).Infof("The initial retry backoff is %s, defaulting to %s", initialBackoff, defaultRetryBackoff)
+
+		initialBackoff = defaultRetryBackoff
+	}
+
+	return &retryRoundTripper{
+		delegate:       delegate,
+		maxRetries:     maxRetries,
+		initialBackoff: initialBackoff,
+	}
+}
+
+// RoundTrip sends the request and retries it while it fails with a transient error.
+func (retrier *retryRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		response, err := retrier.delegate.RoundTrip(request)
+
+		if attempt >= retrier.maxRetries || !isTransient(request, response, err) ||
+			(request.Body != nil && request.GetBody == nil) {
+			return response, err
+		}
+
+		delay := retrier.getDelay(attempt, response)
+
+		logging.V(100).Infof("Retrying %s request to %s in %s after transient error, attempt %d of %d",
+			request.Method, request.URL.Path, delay, attempt+1, retrier.maxRetries)
+
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		if request.Body != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			request = request.Clone(request.Context())
+			request.Body = body
+		}
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// getDelay returns the delay before the next attempt, preferring the Retry-After header of the response.
+func (retrier *retryRoundTripper) getDelay(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	delay := retrier.initialBackoff << attempt
+	if delay <= 0 || delay > maxRetryBackoff {
+		delay = maxRetryBackoff
+	}
+
+	return delay
+}
+
+// isTransient checks whether the request failed with an error that is expected to go away on its own and can be
+// retried without applying the request twice.
+func isTransient(request *http.Request, response *http.Response, err error) bool {
+	if err != nil {
+		return utilnet.IsConnectionRefused(err)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return request.Method != http.MethodPost && request.Method != http.MethodPatch
+	default:
+		return false
+	}
+}
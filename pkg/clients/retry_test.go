@@ -0,0 +1,128 @@
+package clients
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryRoundTripper(t *testing.T) {
+	testCases := []struct {
+		method           string
+		statusCodes      []int
+		retryAfter       string
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			method:           http.MethodPut,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			method:           http.MethodPut,
+			statusCodes:      []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries:       1,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 2,
+		},
+		{
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusConflict, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusConflict,
+			expectedAttempts: 1,
+		},
+		{
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:       "0",
+			maxRetries:       1,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+		{
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+		{
+			method:           http.MethodPatch,
+			statusCodes:      []int{http.StatusInternalServerError, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusInternalServerError,
+			expectedAttempts: 1,
+		},
+		{
+			method:           http.MethodDelete,
+			statusCodes:      []int{http.StatusGatewayTimeout, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			attempts int
+			bodies   []string
+		)
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			bodies = append(bodies, string(body))
+
+			if testCase.retryAfter != "" {
+				writer.Header().Set("Retry-After", testCase.retryAfter)
+			}
+
+			writer.WriteHeader(testCase.statusCodes[attempts])
+			attempts++
+		}))
+
+		client := &http.Client{
+			Transport: newRetryRoundTripper(http.DefaultTransport, testCase.maxRetries, time.Millisecond),
+		}
+
+		request, err := http.NewRequest(testCase.method, server.URL, strings.NewReader("test-body"))
+		assert.Nil(t, err)
+
+		response, err := client.Do(request)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedStatus, response.StatusCode)
+		assert.Equal(t, testCase.expectedAttempts, attempts)
+
+		for _, body := range bodies {
+			assert.Equal(t, "test-body", body)
+		}
+
+		response.Body.Close()
+		server.Close()
+	}
+}
+
+func TestRetryRoundTripperGetDelay(t *testing.T) {
+	retrier := &retryRoundTripper{initialBackoff: time.Second}
+
+	assert.Equal(t, time.Second, retrier.getDelay(0, nil))
+	assert.Equal(t, 4*time.Second, retrier.getDelay(2, nil))
+	assert.Equal(t, maxRetryBackoff, retrier.getDelay(10, nil))
+	assert.Equal(t, 5*time.Second, retrier.getDelay(0, &http.Response{Header: http.Header{"Retry-After": {"5"}}}))
+	assert.Equal(t, time.Second, retrier.getDelay(0, &http.Response{Header: http.Header{"Retry-After": {"soon"}}}))
+
+	for _, initialBackoff := range []time.Duration{0, -time.Second} {
+		retrier, ok := newRetryRoundTripper(http.DefaultTransport, 1, initialBackoff).(*retryRoundTripper)
+		assert.True(t, ok)
+		assert.Equal(t, defaultRetryBackoff, retrier.getDelay(0, nil))
+	}
+}
@@ -2,10 +2,11 @@ package clusterlogging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	clov1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,13 +24,13 @@ type ClusterLogForwarderBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before clusterlogforwarder object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewClusterLogForwarderBuilder method creates new instance of builder.
 func NewClusterLogForwarderBuilder(
 	apiClient *clients.Settings, name, nsname string) *ClusterLogForwarderBuilder {
-	glog.V(100).Infof("Initializing new clusterlogforwarder structure with the following params: "+
+	logging.V(100).Infof("Initializing new clusterlogforwarder structure with the following params: "+
 		"name: %s, namespace: %s", name, nsname)
 
 	builder := &ClusterLogForwarderBuilder{
@@ -43,15 +44,15 @@ func NewClusterLogForwarderBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterlogforwarder is empty")
+		logging.V(100).Infof("The name of the clusterlogforwarder is empty")
 
-		builder.errorMsg = "The clusterlogforwarder 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The clusterlogforwarder 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterlogforwarder is empty")
+		logging.V(100).Infof("The namespace of the clusterlogforwarder is empty")
 
-		builder.errorMsg = "The clusterlogforwarder 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The clusterlogforwarder 'namespace' cannot be empty"))
 	}
 
 	return builder
@@ -63,16 +64,16 @@ func (builder *ClusterLogForwarderBuilder) WithOutput(outputSpec *clov1.OutputSp
 		return builder
 	}
 
-	glog.V(100).Infof("Setting output %v on clusterlogforwarder %s in namespace %s",
+	logging.V(100).Infof("Setting output %v on clusterlogforwarder %s in namespace %s",
 		outputSpec, builder.Definition.Name, builder.Definition.Namespace)
 
 	if outputSpec == nil {
-		glog.V(100).Infof("The 'outputSpec' of the deployment is empty")
+		logging.V(100).Infof("The 'outputSpec' of the deployment is empty")
 
-		builder.errorMsg = "'outputSpec' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'outputSpec' parameter is empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -91,16 +92,16 @@ func (builder *ClusterLogForwarderBuilder) WithPipeline(pipelineSpec *clov1.Pipe
 		return builder
 	}
 
-	glog.V(100).Infof("Setting pipeline %v on clusterlogforwarder %s in namespace %s",
+	logging.V(100).Infof("Setting pipeline %v on clusterlogforwarder %s in namespace %s",
 		pipelineSpec, builder.Definition.Name, builder.Definition.Namespace)
 
 	if pipelineSpec == nil {
-		glog.V(100).Infof("The 'pipelineSpec' of the deployment is empty")
+		logging.V(100).Infof("The 'pipelineSpec' of the deployment is empty")
 
-		builder.errorMsg = "'pipelineSpec' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'pipelineSpec' parameter is empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -115,7 +116,7 @@ func (builder *ClusterLogForwarderBuilder) WithPipeline(pipelineSpec *clov1.Pipe
 
 // PullClusterLogForwarder retrieves an existing clusterlogforwarder object from the cluster.
 func PullClusterLogForwarder(apiClient *clients.Settings, name, namespace string) (*clov1.ClusterLogForwarder, error) {
-	glog.V(100).Infof("Pulling existing clusterlogforwarder %s in namespace %s", name, namespace)
+	logging.V(100).Infof("Pulling existing clusterlogforwarder %s in namespace %s", name, namespace)
 
 	builder := ClusterLogForwarderBuilder{
 		apiClient: apiClient,
@@ -128,15 +129,15 @@ func PullClusterLogForwarder(apiClient *clients.Settings, name, namespace string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterlogforwarder is empty")
+		logging.V(100).Infof("The name of the clusterlogforwarder is empty")
 
-		builder.errorMsg = "clusterlogforwarder 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterlogforwarder 'name' cannot be empty"))
 	}
 
 	if namespace == "" {
-		glog.V(100).Infof("The namespace of the clusterlogforwarder is empty")
+		logging.V(100).Infof("The namespace of the clusterlogforwarder is empty")
 
-		builder.errorMsg = "clusterlogforwarder 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterlogforwarder 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -152,7 +153,7 @@ func (builder *ClusterLogForwarderBuilder) Get() (*clov1.ClusterLogForwarder, er
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterlogforwarder %s in namespace %s",
+	logging.V(100).Infof("Getting clusterlogforwarder %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	clusterLogForwarder := &clov1.ClusterLogForwarder{}
@@ -174,7 +175,7 @@ func (builder *ClusterLogForwarderBuilder) Create() (*ClusterLogForwarderBuilder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterlogforwarder %s in namespace %s",
+	logging.V(100).Infof("Creating the clusterlogforwarder %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -194,7 +195,7 @@ func (builder *ClusterLogForwarderBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the clusterlogforwarder %s in namespace %s",
+	logging.V(100).Infof("Deleting the clusterlogforwarder %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -218,7 +219,7 @@ func (builder *ClusterLogForwarderBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterlogforwarder %s exists in namespace %s",
+	logging.V(100).Infof("Checking if clusterlogforwarder %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -233,20 +234,20 @@ func (builder *ClusterLogForwarderBuilder) Update(force bool) (*ClusterLogForwar
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating clusterlogforwarder %s in namespace %s",
+	logging.V(100).Infof("Updating clusterlogforwarder %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("clusterlogforwarder", builder.Definition.Name, builder.Definition.Namespace))
 
 			err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError(
 						"clusterlogforwarder", builder.Definition.Name, builder.Definition.Namespace))
 
@@ -270,19 +271,19 @@ func (builder *ClusterLogForwarderBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterLogForwarder"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
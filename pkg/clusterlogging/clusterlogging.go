@@ -2,10 +2,11 @@ package clusterlogging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	clov1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -22,13 +23,13 @@ type Builder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before clusterLogging object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewBuilder method creates new instance of builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string) *Builder {
-	glog.V(100).Infof("Initializing new clusterLogging structure with the following params: name: %s, namespace: %s",
+	logging.V(100).Infof("Initializing new clusterLogging structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
 	builder := &Builder{
@@ -42,15 +43,15 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterLogging is empty")
+		logging.V(100).Infof("The name of the clusterLogging is empty")
 
-		builder.errorMsg = "The clusterLogging 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The clusterLogging 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterLogging is empty")
+		logging.V(100).Infof("The namespace of the clusterLogging is empty")
 
-		builder.errorMsg = "The clusterLogging 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The clusterLogging 'namespace' cannot be empty"))
 	}
 
 	return builder
@@ -58,7 +59,7 @@ func NewBuilder(
 
 // Pull retrieves an existing clusterLogging object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling clusterLogging object name:%s in namespace: %s", name, nsname)
 
 	builder := Builder{
@@ -72,15 +73,15 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterLogging is empty")
+		logging.V(100).Infof("The name of the clusterLogging is empty")
 
-		builder.errorMsg = "clusterLogging 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterLogging 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterLogging is empty")
+		logging.V(100).Infof("The namespace of the clusterLogging is empty")
 
-		builder.errorMsg = "clusterLogging 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterLogging 'nsname' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -98,7 +99,7 @@ func (builder *Builder) Get() (*clov1.ClusterLogging, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterLogging %s in namespace %s",
+	logging.V(100).Infof("Getting clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	clusterLogging := &clov1.ClusterLogging{}
@@ -120,7 +121,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterLogging %s in namespace %s",
+	logging.V(100).Infof("Creating the clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -140,7 +141,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the clusterLogging %s in namespace %s",
+	logging.V(100).Infof("Deleting the clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -164,7 +165,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterLogging %s exists in namespace %s",
+	logging.V(100).Infof("Checking if clusterLogging %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -179,20 +180,20 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating clusterLogging %s in namespace %s",
+	logging.V(100).Infof("Updating clusterLogging %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("clusterLogging", builder.Definition.Name, builder.Definition.Namespace))
 
 			err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("clusterLogging", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -215,27 +216,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterLogging"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -2,6 +2,7 @@ package clusteroperator
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,8 +11,8 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/wait"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -32,12 +33,12 @@ type Builder struct {
 	apiClient *clients.Settings
 	// Used in functions that define or mutate clusterOperator definition. errorMsg is processed before the
 	// ClusterOperator object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // Pull loads an existing clusterOperator into Builder struct.
 func Pull(apiClient *clients.Settings, clusterOperatorName string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing clusterOperator: %s", clusterOperatorName)
+	logging.V(100).Infof("Pulling existing clusterOperator: %s", clusterOperatorName)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -63,7 +64,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterOperator %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if clusterOperator %s exists", builder.Definition.Name)
 
 	_, err := builder.apiClient.ClusterOperators().Get(
 		context.TODO(),
@@ -75,7 +76,7 @@ func (builder *Builder) Exists() bool {
 
 // IsAvailable check if the clusterOperator is available.
 func (builder *Builder) IsAvailable() bool {
-	glog.V(100).Infof("Verify the availability of %s clusterOperator", builder.Definition.Name)
+	logging.V(100).Infof("Verify the availability of %s clusterOperator", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return false
@@ -92,7 +93,7 @@ func (builder *Builder) IsAvailable() bool {
 
 // IsDegraded checks if the clusterOperator is degraded.
 func (builder *Builder) IsDegraded() bool {
-	glog.V(100).Infof("Check if %s clusterOperator is degraded", builder.Definition.Name)
+	logging.V(100).Infof("Check if %s clusterOperator is degraded", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return false
@@ -109,7 +110,7 @@ func (builder *Builder) IsDegraded() bool {
 
 // IsProgressing checks if the clusterOperator is progressing.
 func (builder *Builder) IsProgressing() bool {
-	glog.V(100).Infof("Check if %s clusterOperator is progressing", builder.Definition.Name)
+	logging.V(100).Infof("Check if %s clusterOperator is progressing", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return false
@@ -173,27 +174,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterOperator"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
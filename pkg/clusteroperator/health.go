@@ -0,0 +1,177 @@
+package clusteroperator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	v1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ConditionState is the state of a single clusterOperator condition.
+type ConditionState struct {
+	// Status is true when the condition is set to True. A missing condition is reported as false.
+	Status bool
+	// Reason is the machine readable reason of the last transition of the condition.
+	Reason string
+	// Message is the human readable message of the condition.
+	Message string
+	// LastTransitionTime is the time the condition last changed its status.
+	LastTransitionTime metav1.Time
+}
+
+// OperatorHealth is the health of a single clusterOperator.
+type OperatorHealth struct {
+	// Name of the clusterOperator.
+	Name string
+	// Available condition of the clusterOperator.
+	Available ConditionState
+	// Progressing condition of the clusterOperator.
+	Progressing ConditionState
+	// Degraded condition of the clusterOperator.
+	Degraded ConditionState
+}
+
+// HealthReport is a snapshot of the health of all clusterOperators.
+type HealthReport struct {
+	// Operators contains the health of every listed clusterOperator.
+	Operators []OperatorHealth
+}
+
+// GetHealthReport lists all clusterOperators and returns a snapshot of their Available, Progressing and Degraded
+// conditions.
+func GetHealthReport(apiClient *clients.Settings, options ...metav1.ListOptions) (*HealthReport, error) {
+	logging.V(100).Infof("Getting health report of all clusterOperators")
+
+	coList, err := List(apiClient, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &HealthReport{}
+
+	for _, clusterOperator := range coList {
+		report.Operators = append(report.Operators, newOperatorHealth(clusterOperator.Object))
+	}
+
+	return report, nil
+}
+
+// WaitForAllAvailable waits for timeout duration or until all clusterOperators are available, not progressing and not
+// degraded. The last health report is returned and on timeout the error lists the unhealthy clusterOperators.
+func WaitForAllAvailable(
+	apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (*HealthReport, error) {
+	logging.V(100).Infof("Waiting for all clusterOperators to be available, not progressing and not degraded")
+
+	var report *HealthReport
+
+	err := wait.PollUntilContextTimeout(context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		report, err = GetHealthReport(apiClient, options...)
+
+		if err != nil {
+			logging.V(100).Infof("Failed to get health report of clusterOperators due to %s", err.Error())
+
+			return false, nil
+		}
+
+		return report.IsHealthy(), nil
+	})
+
+	if err != nil {
+		if report != nil {
+			return report, fmt.Errorf("not all clusterOperators are healthy before timeout %v: %s: %w",
+				timeout, report.String(), err)
+		}
+
+		return nil, err
+	}
+
+	logging.V(100).Infof("All clusterOperators were found healthy before timeout: %v", timeout)
+
+	return report, nil
+}
+
+// IsHealthy returns true when all clusterOperators in the report are available, not progressing and not degraded.
+func (report *HealthReport) IsHealthy() bool {
+	return len(report.Unavailable()) == 0 && len(report.Progressing()) == 0 && len(report.Degraded()) == 0
+}
+
+// Unavailable returns the clusterOperators which are not available.
+func (report *HealthReport) Unavailable() []OperatorHealth {
+	return report.filter(func(operator OperatorHealth) bool { return !operator.Available.Status })
+}
+
+// Progressing returns the clusterOperators which are progressing.
+func (report *HealthReport) Progressing() []OperatorHealth {
+	return report.filter(func(operator OperatorHealth) bool { return operator.Progressing.Status })
+}
+
+// Degraded returns the clusterOperators which are degraded.
+func (report *HealthReport) Degraded() []OperatorHealth {
+	return report.filter(func(operator OperatorHealth) bool { return operator.Degraded.Status })
+}
+
+// String summarizes the unhealthy clusterOperators of the report along with the reasons of their conditions.
+func (report *HealthReport) String() string {
+	var summary []string
+
+	for _, operator := range report.Unavailable() {
+		summary = append(summary, fmt.Sprintf("%s unavailable (%s)", operator.Name, operator.Available.Reason))
+	}
+
+	for _, operator := range report.Progressing() {
+		summary = append(summary, fmt.Sprintf("%s progressing (%s)", operator.Name, operator.Progressing.Reason))
+	}
+
+	for _, operator := range report.Degraded() {
+		summary = append(summary, fmt.Sprintf("%s degraded (%s)", operator.Name, operator.Degraded.Reason))
+	}
+
+	if len(summary) == 0 {
+		return "all clusterOperators are healthy"
+	}
+
+	return strings.Join(summary, ", ")
+}
+
+func (report *HealthReport) filter(match func(OperatorHealth) bool) []OperatorHealth {
+	var operators []OperatorHealth
+
+	for _, operator := range report.Operators {
+		if match(operator) {
+			operators = append(operators, operator)
+		}
+	}
+
+	return operators
+}
+
+func newOperatorHealth(clusterOperator *v1.ClusterOperator) OperatorHealth {
+	health := OperatorHealth{Name: clusterOperator.Name}
+
+	for _, condition := range clusterOperator.Status.Conditions {
+		state := ConditionState{
+			Status:             condition.Status == isTrue,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+		}
+
+		switch condition.Type {
+		case v1.OperatorAvailable:
+			health.Available = state
+		case v1.OperatorProgressing:
+			health.Progressing = state
+		case v1.OperatorDegraded:
+			health.Degraded = state
+		}
+	}
+
+	return health
+}
@@ -0,0 +1,180 @@
+package clusteroperator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetHealthReport(t *testing.T) {
+	testCases := []struct {
+		operators           []runtime.Object
+		options             []metav1.ListOptions
+		expectedUnavailable []string
+		expectedProgressing []string
+		expectedDegraded    []string
+		expectedHealthy     bool
+		expectedError       string
+	}{
+		{
+			operators: []runtime.Object{
+				buildDummyClusterOperator("dns", isTrue, "False", "False"),
+				buildDummyClusterOperator("etcd", isTrue, "False", "False"),
+			},
+			expectedHealthy: true,
+			expectedError:   "",
+		},
+		{
+			operators: []runtime.Object{
+				buildDummyClusterOperator("dns", "False", "False", "False"),
+				buildDummyClusterOperator("etcd", isTrue, isTrue, "False"),
+				buildDummyClusterOperator("network", isTrue, "False", isTrue),
+			},
+			expectedUnavailable: []string{"dns"},
+			expectedProgressing: []string{"etcd"},
+			expectedDegraded:    []string{"network"},
+			expectedHealthy:     false,
+			expectedError:       "",
+		},
+		{
+			operators: []runtime.Object{&configv1.ClusterOperator{
+				ObjectMeta: metav1.ObjectMeta{Name: "dns"},
+			}},
+			expectedUnavailable: []string{"dns"},
+			expectedHealthy:     false,
+			expectedError:       "",
+		},
+		{
+			options:       []metav1.ListOptions{{}, {}},
+			expectedError: "error: more than one ListOptions was passed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		report, err := GetHealthReport(buildTestClientWithConfigV1(t, testCase.operators...), testCase.options...)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, report.Operators, len(testCase.operators))
+			assert.Equal(t, testCase.expectedUnavailable, getOperatorNames(report.Unavailable()))
+			assert.Equal(t, testCase.expectedProgressing, getOperatorNames(report.Progressing()))
+			assert.Equal(t, testCase.expectedDegraded, getOperatorNames(report.Degraded()))
+			assert.Equal(t, testCase.expectedHealthy, report.IsHealthy())
+		}
+	}
+}
+
+func TestHealthReportString(t *testing.T) {
+	testCases := []struct {
+		report         HealthReport
+		expectedString string
+	}{
+		{
+			report: HealthReport{Operators: []OperatorHealth{{
+				Name:      "dns",
+				Available: ConditionState{Status: true},
+			}}},
+			expectedString: "all clusterOperators are healthy",
+		},
+		{
+			report: HealthReport{Operators: []OperatorHealth{
+				{
+					Name:      "dns",
+					Available: ConditionState{Status: false, Reason: "NoPods"},
+				},
+				{
+					Name:        "etcd",
+					Available:   ConditionState{Status: true},
+					Progressing: ConditionState{Status: true, Reason: "Rolling"},
+					Degraded:    ConditionState{Status: true, Reason: "QuorumLost"},
+				},
+			}},
+			expectedString: "dns unavailable (NoPods), etcd progressing (Rolling), etcd degraded (QuorumLost)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedString, testCase.report.String())
+	}
+}
+
+func TestWaitForAllAvailable(t *testing.T) {
+	testCases := []struct {
+		operators     []runtime.Object
+		expectedError string
+	}{
+		{
+			operators: []runtime.Object{
+				buildDummyClusterOperator("dns", isTrue, "False", "False"),
+				buildDummyClusterOperator("etcd", isTrue, "False", "False"),
+			},
+			expectedError: "",
+		},
+		{
+			operators: []runtime.Object{
+				buildDummyClusterOperator("dns", isTrue, "False", "False"),
+				buildDummyClusterOperator("etcd", isTrue, isTrue, "False"),
+			},
+			expectedError: "not all clusterOperators are healthy before timeout 1s: " +
+				"etcd progressing (AsExpected): context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		report, err := WaitForAllAvailable(buildTestClientWithConfigV1(t, testCase.operators...), time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+
+		assert.NotNil(t, report)
+		assert.Len(t, report.Operators, len(testCase.operators))
+	}
+}
+
+func getOperatorNames(operators []OperatorHealth) []string {
+	var names []string
+
+	for _, operator := range operators {
+		names = append(names, operator.Name)
+	}
+
+	return names
+}
+
+func buildDummyClusterOperator(name string, available, progressing, degraded string) *configv1.ClusterOperator {
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: configv1.ClusterOperatorStatus{
+			Conditions: []configv1.ClusterOperatorStatusCondition{
+				{
+					Type:   configv1.OperatorAvailable,
+					Status: configv1.ConditionStatus(available),
+					Reason: "AsExpected",
+				},
+				{
+					Type:   configv1.OperatorProgressing,
+					Status: configv1.ConditionStatus(progressing),
+					Reason: "AsExpected",
+				},
+				{
+					Type:   configv1.OperatorDegraded,
+					Status: configv1.ConditionStatus(degraded),
+					Reason: "AsExpected",
+				},
+			},
+		},
+	}
+}
+
+func buildTestClientWithConfigV1(t *testing.T, objects ...runtime.Object) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testSettings.ConfigV1Interface = testhelper.NewConfigV1Client(t, objects...)
+
+	return testSettings
+}
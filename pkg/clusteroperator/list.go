@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -17,7 +17,7 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 	passedOptions := metav1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -27,12 +27,12 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	coList, err := apiClient.ClusterOperators().List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list clusterOperators due to %s", err.Error())
+		logging.V(100).Infof("Failed to list clusterOperators due to %s", err.Error())
 
 		return nil, err
 	}
@@ -56,20 +56,20 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 // WaitForAllClusteroperatorsAvailable waits until all clusterOperators are in available state.
 func WaitForAllClusteroperatorsAvailable(
 	apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
-	glog.V(100).Info("Waiting for all clusterOperators to be in available state")
+	logging.V(100).Info("Waiting for all clusterOperators to be in available state")
 
 	err := wait.PollUntilContextTimeout(context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
 		coList, err := List(apiClient, options...)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
+			logging.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
 
 			return false, err
 		}
 
 		for _, clusteroperator := range coList {
 			if !clusteroperator.IsAvailable() {
-				glog.V(100).Infof("The %s clusterOperator is not available",
+				logging.V(100).Infof("The %s clusterOperator is not available",
 					clusteroperator.Object.Name)
 
 				return false, nil
@@ -80,14 +80,14 @@ func WaitForAllClusteroperatorsAvailable(
 	})
 
 	if err == nil {
-		glog.V(100).Infof("All clusterOperators were found available before timeout: %v",
+		logging.V(100).Infof("All clusterOperators were found available before timeout: %v",
 			timeout)
 
 		return true, nil
 	}
 
 	// Here err is "timed out waiting for the condition"
-	glog.V(100).Infof("Not all clusterOperators were found available before timeout: %v",
+	logging.V(100).Infof("Not all clusterOperators were found available before timeout: %v",
 		timeout)
 
 	return false, err
@@ -96,11 +96,11 @@ func WaitForAllClusteroperatorsAvailable(
 // WaitForAllClusteroperatorsStopProgressing waits until all clusterOperators stopped progressing.
 func WaitForAllClusteroperatorsStopProgressing(
 	apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
-	glog.V(100).Infof("Waiting for all clusteroperators to stop progressing")
+	logging.V(100).Infof("Waiting for all clusteroperators to stop progressing")
 
 	coList, err := List(apiClient, options...)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
 
 		return false, err
 	}
@@ -108,7 +108,7 @@ func WaitForAllClusteroperatorsStopProgressing(
 	err = wait.PollUntilContextTimeout(context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
 		for _, clusteroperator := range coList {
 			if clusteroperator.IsProgressing() {
-				glog.V(100).Infof("The %s clusterOperator is still progressing",
+				logging.V(100).Infof("The %s clusterOperator is still progressing",
 					clusteroperator.Object.Name)
 
 				return false, nil
@@ -119,14 +119,14 @@ func WaitForAllClusteroperatorsStopProgressing(
 	})
 
 	if err == nil {
-		glog.V(100).Infof("All clusterOperators stopped progressing before timeout: %v",
+		logging.V(100).Infof("All clusterOperators stopped progressing before timeout: %v",
 			timeout)
 
 		return true, nil
 	}
 
 	// Here err is "timed out waiting for the condition"
-	glog.V(100).Infof("Not all clusterOperators stopped progressing before timeout: %v",
+	logging.V(100).Infof("Not all clusterOperators stopped progressing before timeout: %v",
 		timeout)
 
 	return false, err
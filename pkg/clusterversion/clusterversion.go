@@ -2,14 +2,19 @@ package clusterversion
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 const (
@@ -24,11 +29,13 @@ type Builder struct {
 	Object *v1.ClusterVersion
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
+	// used to store latest error message upon defining or mutating clusterversion definition.
+	errorMsg error
 }
 
 // Pull loads an existing clusterversion into Builder struct.
 func Pull(apiClient *clients.Settings) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing clusterversion name: %s", clusterVersionName)
+	logging.V(100).Infof("Pulling existing clusterversion name: %s", clusterVersionName)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -54,7 +61,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if clusterversion %s exists",
 		builder.Definition.Name)
 
@@ -65,28 +72,216 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// WithChannel sets the upgrade channel the cluster retrieves its available updates from.
+func (builder *Builder) WithChannel(channel string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting channel %s in clusterversion %s", channel, builder.Definition.Name)
+
+	if channel == "" {
+		logging.V(100).Infof("The channel of the clusterversion is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterversion 'channel' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Channel = channel
+
+	return builder
+}
+
+// WithDesiredUpdate sets the release the cluster updates to, either by image or by version. Force skips the
+// verification and upgradeable checks of the release and must only be used with trusted images.
+func (builder *Builder) WithDesiredUpdate(image, version string, force bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting desired update image: %s, version: %s, force: %t in clusterversion %s",
+		image, version, force, builder.Definition.Name)
+
+	if (image == "") == (version == "") {
+		logging.V(100).Infof("Exactly one of the image and version of the clusterversion desired update must be set")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("exactly one of clusterversion desired update 'image' and 'version' must be set"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.DesiredUpdate = &v1.Update{
+		Image:   image,
+		Version: version,
+		Force:   force,
+	}
+
+	return builder
+}
+
+// Update renovates the existing clusterversion object with the clusterversion definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating clusterversion %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update clusterversion, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.ConfigV1Interface.ClusterVersions().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// GetAvailableUpdates returns the releases the cluster can update to from its current channel.
+func (builder *Builder) GetAvailableUpdates() ([]v1.Release, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting available updates of clusterversion %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("clusterversion object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.AvailableUpdates, nil
+}
+
+// GetConditionalUpdates returns the releases the cluster can update to once the risks they carry are accepted.
+func (builder *Builder) GetConditionalUpdates() ([]v1.ConditionalUpdate, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting conditional updates of clusterversion %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("clusterversion object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.ConditionalUpdates, nil
+}
+
+// WaitForUpdateCompleted waits for the duration of the defined timeout or until the update to the desired release is
+// completed. The progress of the update is logged as the number of clusteroperators already reporting the desired
+// version, and the clusteroperators still pending are included in the error on timeout.
+func (builder *Builder) WaitForUpdateCompleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until the update of clusterversion %s is completed", builder.Definition.Name)
+
+	var pendingOperators []string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 10*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				logging.V(100).Infof("Failed to get clusterversion %s", builder.Definition.Name)
+
+				return false, nil
+			}
+
+			desiredVersion := builder.Object.Status.Desired.Version
+
+			var err error
+			pendingOperators, err = builder.getPendingOperators(desiredVersion)
+
+			if err != nil {
+				logging.V(100).Infof("Failed to list clusteroperators: %v", err)
+
+				return false, nil
+			}
+
+			history := builder.Object.Status.History
+			if len(history) == 0 {
+				return false, nil
+			}
+
+			return history[0].State == v1.CompletedUpdate && history[0].Version == desiredVersion &&
+				len(pendingOperators) == 0, nil
+		})
+
+	if err != nil && len(pendingOperators) > 0 {
+		return fmt.Errorf("update of clusterversion %s is not completed, clusteroperators pending: %s: %w",
+			builder.Definition.Name, strings.Join(pendingOperators, ", "), err)
+	}
+
+	return err
+}
+
+// getPendingOperators returns the sorted names of the clusteroperators which do not report the given version yet.
+func (builder *Builder) getPendingOperators(version string) ([]string, error) {
+	operatorList, err := builder.apiClient.ConfigV1Interface.ClusterOperators().List(
+		context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingOperators []string
+
+	for _, operator := range operatorList.Items {
+		updated := false
+
+		for _, operandVersion := range operator.Status.Versions {
+			if operandVersion.Name == "operator" && operandVersion.Version == version {
+				updated = true
+
+				break
+			}
+		}
+
+		if !updated {
+			pendingOperators = append(pendingOperators, operator.Name)
+		}
+	}
+
+	sort.Strings(pendingOperators)
+
+	logging.V(100).Infof("%d/%d clusteroperators updated to version %s",
+		len(operatorList.Items)-len(pendingOperators), len(operatorList.Items), version)
+
+	return pendingOperators, nil
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterVersion"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
 	return true, nil
 }
@@ -1 +1,279 @@
 package clusterversion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultCurrentVersion = "4.16.1"
+	defaultDesiredVersion = "4.16.2"
+)
+
+func TestClusterVersionPull(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "clusterversion object version doesn't exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterVersion(configv1.CompletedUpdate))
+		}
+
+		testBuilder, err := Pull(buildTestClientWithConfigV1(t, runtimeObjects...))
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, clusterVersionName, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestClusterVersionWithChannel(t *testing.T) {
+	testCases := []struct {
+		channel       string
+		expectedError string
+	}{
+		{
+			channel:       "stable-4.16",
+			expectedError: "",
+		},
+		{
+			channel:       "",
+			expectedError: "clusterversion 'channel' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterVersionBuilder(buildTestClientWithConfigV1(t)).WithChannel(testCase.channel)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.channel, testBuilder.Definition.Spec.Channel)
+		}
+	}
+}
+
+func TestClusterVersionWithDesiredUpdate(t *testing.T) {
+	testCases := []struct {
+		image         string
+		version       string
+		force         bool
+		expectedError string
+	}{
+		{
+			image:         "",
+			version:       defaultDesiredVersion,
+			force:         false,
+			expectedError: "",
+		},
+		{
+			image:         "quay.io/openshift-release-dev/ocp-release@sha256:0123",
+			version:       "",
+			force:         true,
+			expectedError: "",
+		},
+		{
+			image:         "quay.io/openshift-release-dev/ocp-release@sha256:0123",
+			version:       defaultDesiredVersion,
+			expectedError: "exactly one of clusterversion desired update 'image' and 'version' must be set",
+		},
+		{
+			image:         "",
+			version:       "",
+			expectedError: "exactly one of clusterversion desired update 'image' and 'version' must be set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterVersionBuilder(buildTestClientWithConfigV1(t)).
+			WithDesiredUpdate(testCase.image, testCase.version, testCase.force)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, &configv1.Update{Image: testCase.image, Version: testCase.version, Force: testCase.force},
+				testBuilder.Definition.Spec.DesiredUpdate)
+		}
+	}
+}
+
+func TestClusterVersionUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "failed to update clusterversion, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterVersion(configv1.CompletedUpdate))
+		}
+
+		testBuilder, err := buildValidClusterVersionBuilder(buildTestClientWithConfigV1(t, runtimeObjects...)).
+			WithChannel("fast-4.16").WithDesiredUpdate("", defaultDesiredVersion, false).Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.True(t, testBuilder.Exists())
+			assert.Equal(t, "fast-4.16", testBuilder.Object.Spec.Channel)
+			assert.Equal(t, defaultDesiredVersion, testBuilder.Object.Spec.DesiredUpdate.Version)
+		}
+	}
+}
+
+func TestClusterVersionGetUpdates(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "clusterversion object version doesn't exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			clusterVersion := buildDummyClusterVersion(configv1.CompletedUpdate)
+			clusterVersion.Status.AvailableUpdates = []configv1.Release{{Version: defaultDesiredVersion}}
+			clusterVersion.Status.ConditionalUpdates = []configv1.ConditionalUpdate{{
+				Release: configv1.Release{Version: "4.16.3"},
+				Risks:   []configv1.ConditionalUpdateRisk{{Name: "KnownIssue"}},
+			}}
+
+			runtimeObjects = append(runtimeObjects, clusterVersion)
+		}
+
+		testBuilder := buildValidClusterVersionBuilder(buildTestClientWithConfigV1(t, runtimeObjects...))
+
+		availableUpdates, err := testBuilder.GetAvailableUpdates()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, []configv1.Release{{Version: defaultDesiredVersion}}, availableUpdates)
+		}
+
+		conditionalUpdates, err := testBuilder.GetConditionalUpdates()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, conditionalUpdates, 1)
+			assert.Equal(t, "4.16.3", conditionalUpdates[0].Release.Version)
+		}
+	}
+}
+
+func TestClusterVersionWaitForUpdateCompleted(t *testing.T) {
+	testCases := []struct {
+		state            configv1.UpdateState
+		operatorVersions map[string]string
+		expectedError    string
+	}{
+		{
+			state:            configv1.CompletedUpdate,
+			operatorVersions: map[string]string{"dns": defaultDesiredVersion, "etcd": defaultDesiredVersion},
+			expectedError:    "",
+		},
+		{
+			state:            configv1.PartialUpdate,
+			operatorVersions: map[string]string{"dns": defaultDesiredVersion, "etcd": defaultDesiredVersion},
+			expectedError:    "context deadline exceeded",
+		},
+		{
+			state: configv1.PartialUpdate,
+			operatorVersions: map[string]string{
+				"dns": defaultDesiredVersion, "etcd": defaultCurrentVersion, "network": defaultCurrentVersion},
+			expectedError: "update of clusterversion version is not completed, clusteroperators pending: " +
+				"etcd, network: context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		runtimeObjects := []runtime.Object{buildDummyClusterVersion(testCase.state)}
+
+		for name, version := range testCase.operatorVersions {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterOperator(name, version))
+		}
+
+		testBuilder := buildValidClusterVersionBuilder(buildTestClientWithConfigV1(t, runtimeObjects...))
+
+		err := testBuilder.WaitForUpdateCompleted(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidClusterVersionBuilder(apiClient *clients.Settings) *Builder {
+	return &Builder{
+		apiClient: apiClient,
+		Definition: &configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterVersionName,
+			},
+		},
+	}
+}
+
+func buildDummyClusterVersion(state configv1.UpdateState) *configv1.ClusterVersion {
+	return &configv1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterVersionName,
+		},
+		Spec: configv1.ClusterVersionSpec{
+			Channel: "stable-4.16",
+		},
+		Status: configv1.ClusterVersionStatus{
+			Desired: configv1.Release{Version: defaultDesiredVersion},
+			History: []configv1.UpdateHistory{
+				{State: state, Version: defaultDesiredVersion},
+				{State: configv1.CompletedUpdate, Version: defaultCurrentVersion},
+			},
+		},
+	}
+}
+
+func buildDummyClusterOperator(name, version string) *configv1.ClusterOperator {
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: configv1.ClusterOperatorStatus{
+			Versions: []configv1.OperandVersion{{Name: "operator", Version: version}},
+		},
+	}
+}
+
+func buildTestClientWithConfigV1(t *testing.T, objects ...runtime.Object) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testSettings.ConfigV1Interface = testhelper.NewConfigV1Client(t, objects...)
+
+	return testSettings
+}
@@ -2,15 +2,22 @@ package configmap
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	corev1Typed "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
@@ -22,8 +29,12 @@ type Builder struct {
 	Object *corev1.ConfigMap
 	// Used in functions that defines or mutates configmap definition. errorMsg is processed before the configmap
 	// object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient corev1Typed.CoreV1Interface
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+	// Keys removed with RemoveData, which Update drops from the cluster instead of preserving them.
+	removedKeys map[string]bool
 }
 
 // AdditionalOptions additional options for configmap object.
@@ -42,18 +53,18 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the configmap is empty")
+		logging.V(100).Infof("The name of the configmap is empty")
 
 		return nil, fmt.Errorf("configmap 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the configmap is empty")
+		logging.V(100).Infof("The namespace of the configmap is empty")
 
 		return nil, fmt.Errorf("configmap 'nsname' cannot be empty")
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling configmap object name:%s in namespace: %s", name, nsname)
 
 	if !builder.Exists() {
@@ -67,7 +78,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new configmap structure with the following params: %s, %s", name, nsname)
 
 	builder := &Builder{
@@ -81,34 +92,87 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the configmap is empty")
+		logging.V(100).Infof("The name of the configmap is empty")
 
-		builder.errorMsg = "configmap 'name' cannot be empty"
-
-		return builder
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("configmap 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the configmap is empty")
+		logging.V(100).Infof("The namespace of the configmap is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("configmap 'nsname' cannot be empty"))
+	}
+
+	return builder
+}
+
+// NewBuilderFromYAML creates a new instance of Builder from a configmap manifest with a single document in YAML or
+// JSON format.
+func NewBuilderFromYAML(apiClient *clients.Settings, data []byte) *Builder {
+	logging.V(100).Infof("Initializing new configmap structure from manifest")
+
+	builder := &Builder{
+		apiClient:  apiClient.CoreV1Interface,
+		Definition: &corev1.ConfigMap{},
+	}
+
+	err := manifest.DecodeInto(data, builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to decode the configmap manifest due to %s", err.Error())
 
-		builder.errorMsg = "configmap 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to decode configmap manifest: %w", err))
 
 		return builder
 	}
 
+	if builder.Definition.Name == "" {
+		logging.V(100).Infof("The name of the configmap is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("configmap 'name' cannot be empty"))
+	}
+
+	if builder.Definition.Namespace == "" {
+		logging.V(100).Infof("The namespace of the configmap is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("configmap 'nsname' cannot be empty"))
+	}
+
 	return builder
 }
 
+// NewBuilderFromFile creates a new instance of Builder from the configmap manifest stored in the given file.
+func NewBuilderFromFile(apiClient *clients.Settings, path string) *Builder {
+	logging.V(100).Infof("Initializing new configmap structure from manifest file %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logging.V(100).Infof("Failed to read the configmap manifest file %s due to %s", path, err.Error())
+
+		return &Builder{
+			apiClient:  apiClient.CoreV1Interface,
+			Definition: &corev1.ConfigMap{},
+			errorMsg:   fmt.Errorf("failed to read configmap manifest file %s: %w", path, err),
+		}
+	}
+
+	return NewBuilderFromYAML(apiClient, data)
+}
+
 // Create makes a configmap in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof(
+		"Creating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -116,25 +180,125 @@ func (builder *Builder) Create() (*Builder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for configmap %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the configmap definition against the API server and its admission webhooks with a server-side
+// dry-run request. The configmap is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the configmap %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.ConfigMaps(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the configmap on the cluster with the definition in builder. Data and binaryData keys that exist
+// on the cluster but are not in the definition are preserved, so keys managed by other components are kept. Keys
+// removed with RemoveData are deleted from the cluster.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof(
+		"Updating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("configmap object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Immutable != nil && *builder.Object.Immutable {
+		return builder, fmt.Errorf("configmap %s in namespace %s is immutable and cannot be updated",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for key, value := range builder.Object.Data {
+		if _, ok := builder.Definition.BinaryData[key]; ok || builder.removedKeys[key] {
+			continue
+		}
+
+		if _, ok := builder.Definition.Data[key]; !ok {
+			if builder.Definition.Data == nil {
+				builder.Definition.Data = make(map[string]string)
+			}
+
+			builder.Definition.Data[key] = value
+		}
+	}
+
+	for key, value := range builder.Object.BinaryData {
+		if _, ok := builder.Definition.Data[key]; ok || builder.removedKeys[key] {
+			continue
+		}
+
+		if _, ok := builder.Definition.BinaryData[key]; !ok {
+			if builder.Definition.BinaryData == nil {
+				builder.Definition.BinaryData = make(map[string][]byte)
+			}
+
+			builder.Definition.BinaryData[key] = value
+		}
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
 // Delete removes a configmap.
 func (builder *Builder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the configmap %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof(
+		"Deleting the configmap %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.ConfigMaps(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return nil
+	}
+
 	builder.Object = nil
 
 	return nil
@@ -146,7 +310,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if configmap %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -157,24 +321,182 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the configmap definition with the configmap object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing configmap %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"configmap object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // WithData defines the data placed in the configmap.
 func (builder *Builder) WithData(data map[string]string) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating configmap %s in namespace %s with this data: %s",
 		builder.Definition.Name, builder.Definition.Namespace, data)
 
 	if len(data) == 0 {
-		builder.errorMsg = "'data' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'data' cannot be empty"))
 
 		return builder
 	}
 
 	builder.Definition.Data = data
 
+	for key := range data {
+		delete(builder.removedKeys, key)
+	}
+
+	return builder
+}
+
+// WithBinaryData defines the binary data placed in the configmap. Keys must not be used in the data as well.
+func (builder *Builder) WithBinaryData(binaryData map[string][]byte) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating configmap %s in namespace %s with %d binaryData keys",
+		builder.Definition.Name, builder.Definition.Namespace, len(binaryData))
+
+	if len(binaryData) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'binaryData' cannot be empty"))
+
+		return builder
+	}
+
+	for key, value := range binaryData {
+		builder.setKey(key, value, true)
+	}
+
+	return builder
+}
+
+// WithDataFromFile adds the content of the file at path to the configmap under key. When key is empty the file base
+// name is used. Content which is not valid UTF-8 is stored in binaryData instead of data.
+func (builder *Builder) WithDataFromFile(key, path string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if key == "" {
+		key = filepath.Base(path)
+	}
+
+	logging.V(100).Infof(
+		"Adding file %s as key %s to configmap %s in namespace %s",
+		path, key, builder.Definition.Name, builder.Definition.Namespace)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		logging.V(100).Infof("Failed to read file %s due to %s", path, err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("failed to read configmap data file %s: %w", path, err))
+
+		return builder
+	}
+
+	builder.setKey(key, content, !utf8.Valid(content))
+
+	return builder
+}
+
+// WithDataFromDir adds every regular file of dir to the configmap, using the file names as keys. Subdirectories and
+// files whose names are not valid configmap keys are skipped, like kubectl create configmap --from-file does.
+func (builder *Builder) WithDataFromDir(dir string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding files of directory %s to configmap %s in namespace %s",
+		dir, builder.Definition.Name, builder.Definition.Namespace)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		logging.V(100).Infof("Failed to read directory %s due to %s", dir, err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("failed to read configmap data directory %s: %w", dir, err))
+
+		return builder
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || len(validation.IsConfigMapKey(entry.Name())) != 0 {
+			logging.V(100).Infof("Skipping %s which is not a regular file with a valid key name", entry.Name())
+
+			continue
+		}
+
+		builder.WithDataFromFile(entry.Name(), filepath.Join(dir, entry.Name()))
+	}
+
+	return builder
+}
+
+// RemoveData removes the given keys from the data and binaryData of the configmap. Unlike keys which are only
+// missing from the definition, removed keys are also deleted from the cluster by Update.
+func (builder *Builder) RemoveData(keys ...string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Removing keys %v from configmap %s in namespace %s",
+		keys, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(keys) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'keys' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.removedKeys == nil {
+		builder.removedKeys = make(map[string]bool)
+	}
+
+	for _, key := range keys {
+		delete(builder.Definition.Data, key)
+		delete(builder.Definition.BinaryData, key)
+
+		builder.removedKeys[key] = true
+	}
+
+	return builder
+}
+
+// WithImmutable sets whether the configmap data can be updated after creation. The flag cannot be unset on the
+// cluster once the configmap is created immutable.
+func (builder *Builder) WithImmutable(immutable bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting immutable to %t for configmap %s in namespace %s",
+		immutable, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Immutable = &immutable
+
 	return builder
 }
 
@@ -184,16 +506,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting configmap additional options")
+	logging.V(100).Infof("Setting configmap additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -210,34 +532,72 @@ func GetGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetGVR returns the configmap GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ConfigMap"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		return false, fmt.Errorf(fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
 }
+
+// setKey stores value under key in binaryData or data, removing the key from the other map so it is never defined
+// twice.
+func (builder *Builder) setKey(key string, value []byte, binary bool) {
+	if errs := validation.IsConfigMapKey(key); len(errs) != 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("configmap key '%s' is invalid: %s", key, strings.Join(errs, ", ")))
+
+		return
+	}
+
+	delete(builder.removedKeys, key)
+
+	if !binary {
+		if builder.Definition.Data == nil {
+			builder.Definition.Data = make(map[string]string)
+		}
+
+		builder.Definition.Data[key] = string(value)
+		delete(builder.Definition.BinaryData, key)
+
+		return
+	}
+
+	if builder.Definition.BinaryData == nil {
+		builder.Definition.BinaryData = make(map[string][]byte)
+	}
+
+	builder.Definition.BinaryData[key] = value
+	delete(builder.Definition.Data, key)
+}
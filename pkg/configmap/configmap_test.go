@@ -2,9 +2,12 @@ package configmap
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,6 +45,12 @@ func TestNewBuilder(t *testing.T) {
 			expectedCM:  nil,
 			expectedErr: "configmap 'nsname' cannot be empty",
 		},
+		{
+			name:        "",
+			nsname:      "",
+			expectedCM:  nil,
+			expectedErr: "configmap 'name' cannot be empty\nconfigmap 'nsname' cannot be empty",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -54,11 +63,59 @@ func TestNewBuilder(t *testing.T) {
 			assert.Equal(t, testCase.expectedCM.Name, testBuilder.Definition.Name)
 			assert.Equal(t, testCase.expectedCM.Namespace, testBuilder.Definition.Namespace)
 		} else {
-			assert.Equal(t, testCase.expectedErr, testBuilder.errorMsg)
+			assert.EqualError(t, testBuilder.errorMsg, testCase.expectedErr)
+		}
+	}
+}
+
+func TestNewBuilderFromYAML(t *testing.T) {
+	testCases := []struct {
+		manifest    string
+		expectedErr string
+	}{
+		{
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n  namespace: testns\n" +
+				"data:\n  key: value\n",
+			expectedErr: "",
+		},
+		{
+			manifest:    "metadata:\n  name: test\n",
+			expectedErr: "configmap 'nsname' cannot be empty",
+		},
+		{
+			manifest:    "apiVersion: v1\nkind: Secret\nmetadata:\n  name: test\n",
+			expectedErr: "failed to decode configmap manifest: manifest kind Secret does not match object *v1.ConfigMap",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+		testBuilder := NewBuilderFromYAML(testSettings, []byte(testCase.manifest))
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErr, testBuilder.errorMsg) {
+			assert.Equal(t, "test", testBuilder.Definition.Name)
+			assert.Equal(t, "testns", testBuilder.Definition.Namespace)
+			assert.Equal(t, map[string]string{"key": "value"}, testBuilder.Definition.Data)
 		}
 	}
 }
 
+func TestNewBuilderFromFile(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	manifestPath := filepath.Join(t.TempDir(), "configmap.yaml")
+
+	err := os.WriteFile(manifestPath, []byte("metadata:\n  name: test\n  namespace: testns\n"), 0600)
+	assert.Nil(t, err)
+
+	testBuilder := NewBuilderFromFile(testSettings, manifestPath)
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "test", testBuilder.Definition.Name)
+
+	testBuilder = NewBuilderFromFile(testSettings, filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, testBuilder.errorMsg, "failed to read configmap manifest file")
+}
+
 func TestPull(t *testing.T) {
 	testCases := []struct {
 		name                string
@@ -203,13 +260,13 @@ func TestWithOptions(t *testing.T) {
 		return builder, nil
 	})
 
-	assert.Equal(t, "", testBuilder.errorMsg)
+	assert.Nil(t, testBuilder.errorMsg)
 
 	testBuilder.WithOptions(func(builder *Builder) (*Builder, error) {
 		return builder, errors.New("error")
 	})
 
-	assert.Equal(t, "error", testBuilder.errorMsg)
+	assert.EqualError(t, testBuilder.errorMsg, "error")
 }
 
 func TestGetGVR(t *testing.T) {
@@ -244,6 +301,12 @@ func TestValidate(t *testing.T) {
 			apiClientNil:  true,
 			expectedError: "ConfigMap builder cannot have nil apiClient",
 		},
+		{
+			builderNil:    false,
+			definitionNil: true,
+			apiClientNil:  true,
+			expectedError: "can not redefine the undefined ConfigMap\nConfigMap builder cannot have nil apiClient",
+		},
 		{
 			builderNil:    false,
 			definitionNil: false,
@@ -307,7 +370,233 @@ func TestWithData(t *testing.T) {
 		} else {
 			testBuilder.WithData(map[string]string{})
 
-			assert.Equal(t, testCase.expectedErr, testBuilder.errorMsg)
+			assert.EqualError(t, testBuilder.errorMsg, testCase.expectedErr)
+		}
+	}
+}
+
+func TestWithBinaryData(t *testing.T) {
+	testCases := []struct {
+		binaryData  map[string][]byte
+		expectedErr string
+	}{
+		{
+			binaryData:  map[string][]byte{"key": []byte("value")},
+			expectedErr: "",
+		},
+		{
+			binaryData:  map[string][]byte{},
+			expectedErr: "'binaryData' cannot be empty",
+		},
+		{
+			binaryData:  map[string][]byte{"bad/key": {0xff}},
+			expectedErr: "configmap key 'bad/key' is invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{})
+		testBuilder.WithData(map[string]string{"key": "text"}).WithBinaryData(testCase.binaryData)
+
+		if testCase.expectedErr == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, testCase.binaryData, testBuilder.Definition.BinaryData)
+			assert.NotContains(t, testBuilder.Definition.Data, "key")
+		} else {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedErr)
+		}
+	}
+}
+
+func TestWithDataFromFile(t *testing.T) {
+	tempDir := t.TempDir()
+	textPath := filepath.Join(tempDir, "config.yaml")
+	binaryPath := filepath.Join(tempDir, "blob.bin")
+
+	assert.Nil(t, os.WriteFile(textPath, []byte("key: value\n"), 0600))
+	assert.Nil(t, os.WriteFile(binaryPath, []byte{0xff, 0xfe, 0x00}, 0600))
+
+	testCases := []struct {
+		key         string
+		path        string
+		expectedKey string
+		binary      bool
+		expectedErr string
+	}{
+		{
+			key:         "",
+			path:        textPath,
+			expectedKey: "config.yaml",
+		},
+		{
+			key:         "custom",
+			path:        textPath,
+			expectedKey: "custom",
+		},
+		{
+			key:         "",
+			path:        binaryPath,
+			expectedKey: "blob.bin",
+			binary:      true,
+		},
+		{
+			key:         "",
+			path:        filepath.Join(tempDir, "missing"),
+			expectedErr: "failed to read configmap data file",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{})
+		testBuilder.WithDataFromFile(testCase.key, testCase.path)
+
+		switch {
+		case testCase.expectedErr != "":
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedErr)
+		case testCase.binary:
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, []byte{0xff, 0xfe, 0x00}, testBuilder.Definition.BinaryData[testCase.expectedKey])
+		default:
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, "key: value\n", testBuilder.Definition.Data[testCase.expectedKey])
+		}
+	}
+}
+
+func TestWithDataFromDir(t *testing.T) {
+	tempDir := t.TempDir()
+
+	assert.Nil(t, os.WriteFile(filepath.Join(tempDir, "a.conf"), []byte("a"), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(tempDir, "b.conf"), []byte("b"), 0600))
+	assert.Nil(t, os.Mkdir(filepath.Join(tempDir, "subdir"), 0700))
+
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{})
+	testBuilder.WithDataFromDir(tempDir)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, map[string]string{"a.conf": "a", "b.conf": "b"}, testBuilder.Definition.Data)
+
+	testBuilder = buildTestBuilderWithFakeObjects([]runtime.Object{})
+	testBuilder.WithDataFromDir(filepath.Join(tempDir, "missing"))
+
+	assert.ErrorContains(t, testBuilder.errorMsg, "failed to read configmap data directory")
+}
+
+func TestWithImmutable(t *testing.T) {
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{})
+	testBuilder.WithImmutable(true)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.True(t, *testBuilder.Definition.Immutable)
+}
+
+func TestRemoveData(t *testing.T) {
+	testCases := []struct {
+		keys               []string
+		expectedData       map[string]string
+		expectedBinaryData map[string][]byte
+		expectedErr        string
+	}{
+		{
+			keys:               []string{"text", "blob"},
+			expectedData:       map[string]string{"kept": "value"},
+			expectedBinaryData: map[string][]byte{},
+			expectedErr:        "",
+		},
+		{
+			keys:               []string{"missing"},
+			expectedData:       map[string]string{"kept": "value", "text": "value"},
+			expectedBinaryData: map[string][]byte{"blob": {0xff}},
+			expectedErr:        "",
+		},
+		{
+			keys:        nil,
+			expectedErr: "'keys' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{}).
+			WithData(map[string]string{"kept": "value", "text": "value"}).
+			WithBinaryData(map[string][]byte{"blob": {0xff}}).
+			RemoveData(testCase.keys...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErr, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.expectedData, testBuilder.Definition.Data)
+			assert.Equal(t, testCase.expectedBinaryData, testBuilder.Definition.BinaryData)
+
+			for _, key := range testCase.keys {
+				assert.True(t, testBuilder.removedKeys[key])
+			}
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	immutable := true
+
+	testCases := []struct {
+		existing           *corev1.ConfigMap
+		removeKeys         []string
+		expectedData       map[string]string
+		expectedBinaryData map[string][]byte
+		expectedErr        string
+	}{
+		{
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "test-namespace"},
+				Data:       map[string]string{"managed": "old", "external": "kept"},
+			},
+			expectedData: map[string]string{"managed": "new", "external": "kept"},
+		},
+		{
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "test-namespace"},
+				Data:       map[string]string{"managed": "old", "external": "kept", "obsolete": "old"},
+				BinaryData: map[string][]byte{"blob": {0xff}},
+			},
+			removeKeys:   []string{"obsolete", "blob"},
+			expectedData: map[string]string{"managed": "new", "external": "kept"},
+		},
+		{
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "test-namespace"},
+				BinaryData: map[string][]byte{"blob": {0xff}},
+			},
+			expectedData:       map[string]string{"managed": "new"},
+			expectedBinaryData: map[string][]byte{"blob": {0xff}},
+		},
+		{
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "test-namespace"},
+				Immutable:  &immutable,
+			},
+			expectedErr: "configmap test-name in namespace test-namespace is immutable and cannot be updated",
+		},
+		{
+			existing:    nil,
+			expectedErr: "configmap object test-name doesn't exist in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.existing != nil {
+			runtimeObjects = append(runtimeObjects, testCase.existing)
+		}
+
+		testBuilder := buildTestBuilderWithFakeObjects(runtimeObjects).WithData(map[string]string{"managed": "new"})
+
+		if len(testCase.removeKeys) > 0 {
+			testBuilder.RemoveData(testCase.removeKeys...)
+		}
+
+		testBuilder, err := testBuilder.Update()
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErr, err) {
+			assert.Equal(t, testCase.expectedData, testBuilder.Object.Data)
+			assert.Equal(t, testCase.expectedBinaryData, testBuilder.Object.BinaryData)
 		}
 	}
 }
@@ -2,12 +2,13 @@ package console
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "github.com/openshift/api/config/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,12 +23,12 @@ type Builder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before console object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name string) *Builder {
-	glog.V(100).Info("Initializing new console %s structure", name)
+	logging.V(100).Infof("Initializing new console %s structure", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -39,9 +40,9 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Info("The name of the Console is empty")
+		logging.V(100).Info("The name of the Console is empty")
 
-		builder.errorMsg = "console 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("console 'name' cannot be empty"))
 	}
 
 	return &builder
@@ -59,12 +60,12 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Info("The name of the Console is empty")
+		logging.V(100).Info("The name of the Console is empty")
 
-		builder.errorMsg = "console 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("console 'name' cannot be empty"))
 	}
 
-	glog.V(100).Infof("Pulling cluster console %s", name)
+	logging.V(100).Infof("Pulling cluster console %s", name)
 
 	if !builder.Exists() {
 		return nil, fmt.Errorf("the console object %s doesn't exist", name)
@@ -81,7 +82,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the console %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating the console %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -98,7 +99,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if console %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if console %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Consoles().Get(
@@ -113,7 +114,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the console object %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the console object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("console cannot be deleted because it does not exist")
@@ -136,7 +137,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating cluster console %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating cluster console %s", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Consoles().Update(context.TODO(), builder.Definition,
@@ -151,19 +152,19 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Console"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
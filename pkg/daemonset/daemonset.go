@@ -2,16 +2,22 @@ package daemonset
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
@@ -23,8 +29,10 @@ type Builder struct {
 	Object *appsv1.DaemonSet
 	// Used in functions that define or mutate daemonset definition. errorMsg is processed before the daemonset
 	// object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // AdditionalOptions additional options for daemonset object.
@@ -35,7 +43,7 @@ var retryInterval = time.Second * 3
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string, labels map[string]string, containerSpec corev1.Container) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new daemonset structure with the following params: "+
 			"name: %s, namespace: %s, labels: %s, containerSpec %v",
 		name, nsname, labels, containerSpec)
@@ -63,21 +71,21 @@ func NewBuilder(
 	builder.WithAdditionalContainerSpecs([]corev1.Container{containerSpec})
 
 	if name == "" {
-		glog.V(100).Infof("The name of the daemonset is empty")
+		logging.V(100).Infof("The name of the daemonset is empty")
 
-		builder.errorMsg = "daemonset 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("daemonset 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the daemonset is empty")
+		logging.V(100).Infof("The namespace of the daemonset is empty")
 
-		builder.errorMsg = "daemonset 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("daemonset 'namespace' cannot be empty"))
 	}
 
 	if len(labels) == 0 {
-		glog.V(100).Infof("There are no labels for the daemonset")
+		logging.V(100).Infof("There are no labels for the daemonset")
 
-		builder.errorMsg = "daemonset 'labels' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("daemonset 'labels' cannot be empty"))
 	}
 
 	return &builder
@@ -85,7 +93,7 @@ func NewBuilder(
 
 // Pull loads an existing daemonSet into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing daemonset name:%s under namespace:%s", name, nsname)
+	logging.V(100).Infof("Pulling existing daemonset name:%s under namespace:%s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -98,11 +106,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "daemonset 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("daemonset 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "daemonset 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("daemonset 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -120,16 +128,16 @@ func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying nodeSelector %s to daemonset %s in namespace %s",
+	logging.V(100).Infof("Applying nodeSelector %s to daemonset %s in namespace %s",
 		selector, builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(selector) == 0 {
-		glog.V(100).Infof("The nodeselector is empty")
+		logging.V(100).Infof("The nodeselector is empty")
 
-		builder.errorMsg = "cannot accept empty map as nodeselector"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot accept empty map as nodeselector"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -144,7 +152,7 @@ func (builder *Builder) WithHostNetwork() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Enabling hostnetwork flag to daemonset %s in namespace %s",
+	logging.V(100).Infof("Enabling hostnetwork flag to daemonset %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Template.Spec.HostNetwork = true
@@ -159,14 +167,14 @@ func (builder *Builder) WithVolume(dsVolume corev1.Volume) *Builder {
 	}
 
 	if dsVolume.Name == "" {
-		glog.V(100).Infof("The Volume name parameter is empty")
+		logging.V(100).Infof("The Volume name parameter is empty")
 
-		builder.errorMsg = "Volume name parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Volume name parameter is empty"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Adding volume %s for daemonset %s pod template in namespace %s",
+	logging.V(100).Infof("Adding volume %s for daemonset %s pod template in namespace %s",
 		dsVolume.Name, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Template.Spec.Volumes = append(
@@ -182,16 +190,16 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 		return builder
 	}
 
-	glog.V(100).Infof("Appending a list of container specs %v to daemonset %s in namespace %s",
+	logging.V(100).Infof("Appending a list of container specs %v to daemonset %s in namespace %s",
 		specs, builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(specs) == 0 {
-		glog.V(100).Infof("The container specs are empty")
+		logging.V(100).Infof("The container specs are empty")
 
-		builder.errorMsg = "cannot accept empty list as container specs"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot accept empty list as container specs"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -204,22 +212,50 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 	return builder
 }
 
+// WithMaxUnavailable sets the maximum number or percentage of nodes whose daemon pods can be unavailable during a
+// rolling update, e.g. intstr.FromInt(1) or intstr.FromString("25%").
+func (builder *Builder) WithMaxUnavailable(maxUnavailable intstr.IntOrString) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting maxUnavailable %s in daemonset %s in namespace %s",
+		maxUnavailable.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, 100, true)
+	if err != nil || maxUnavailable.Type == intstr.Int && maxUnavailable.IntVal < 0 {
+		logging.V(100).Infof("The maxUnavailable %s is invalid", maxUnavailable.String())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid maxUnavailable %s, must be a non-negative integer or a percentage", maxUnavailable.String()))
+
+		return builder
+	}
+
+	builder.Definition.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateDaemonSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &maxUnavailable},
+	}
+
+	return builder
+}
+
 // WithOptions creates daemonset with generic mutation options.
 func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting daemonset additional options")
+	logging.V(100).Infof("Setting daemonset additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -235,10 +271,14 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Creating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -246,13 +286,45 @@ func (builder *Builder) Create() (*Builder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for daemonset %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the daemonset definition against the API server and its admission webhooks with a server-side
+// dry-run request. The daemonset is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the daemonset %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Update renovates the existing daemonset object with daemonset definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Updating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Update(
@@ -267,20 +339,29 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting daemonset %s in namespace %s",
+	logging.V(100).Infof("Deleting daemonset %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return err
+	}
+
 	builder.Object = nil
 
 	return err
@@ -292,7 +373,7 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating daemonset %s in namespace %s and waiting for the defined period until it's ready",
+	logging.V(100).Infof("Creating daemonset %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	_, err := builder.Create()
@@ -332,7 +413,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting daemonset %s in namespace %s and waiting for the defined period until it's removed",
+	logging.V(100).Infof("Deleting daemonset %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if err := builder.Delete(); err != nil {
@@ -358,7 +439,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if daemonset %s exists in namespace %s",
+	logging.V(100).Infof("Checking if daemonset %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -374,7 +455,7 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		return false
 	}
 
-	glog.V(100).Infof("Running periodic check until daemonset %s in namespace %s is ready or "+
+	logging.V(100).Infof("Running periodic check until daemonset %s in namespace %s is ready or "+
 		"timeout %s exceeded", builder.Definition.Name, builder.Definition.Namespace, timeout.String())
 
 	// Polls every retryInterval to determine if daemonset is available.
@@ -407,33 +488,179 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 	return err == nil
 }
 
+// WaitUntilScheduledOnAllNodes waits for the duration of the defined timeout or until the latest generation of the
+// daemonset is observed and an updated, ready daemon pod runs on every node it should be scheduled on. On timeout the
+// error lists the nodes that are still missing a ready daemon pod.
+func (builder *Builder) WaitUntilScheduledOnAllNodes(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until daemonset %s in namespace %s is scheduled on all nodes",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for daemonset to be scheduled because it does not exist")
+	}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				return false, nil
+			}
+
+			status := builder.Object.Status
+
+			return builder.Object.Generation <= status.ObservedGeneration &&
+				status.NumberReady == status.DesiredNumberScheduled &&
+				status.UpdatedNumberScheduled == status.DesiredNumberScheduled, nil
+		})
+
+	if err == nil {
+		return nil
+	}
+
+	missingNodes, nodesErr := builder.GetNodesMissingPods()
+	if nodesErr != nil || len(missingNodes) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("daemonset %s in namespace %s has no ready pod on nodes %v: %w",
+		builder.Definition.Name, builder.Definition.Namespace, missingNodes, err)
+}
+
+// GetNodesMissingPods returns the sorted names of the nodes matching the nodeSelector of the daemonset that do not
+// run a ready daemon pod. Taints and node affinity are not taken into account.
+func (builder *Builder) GetNodesMissingPods() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting nodes without a ready pod of daemonset %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("daemonset object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	nodeList, err := builder.apiClient.CoreV1Interface.Nodes().List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(builder.Object.Spec.Template.Spec.NodeSelector).String()})
+	if err != nil {
+		logging.V(100).Infof("Failed to list nodes due to %s", err.Error())
+
+		return nil, err
+	}
+
+	podSelector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector of daemonset %s: %w", builder.Definition.Name, err)
+	}
+
+	podList, err := builder.apiClient.Pods(builder.Definition.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: podSelector.String()})
+	if err != nil {
+		logging.V(100).Infof("Failed to list pods of daemonset %s due to %s", builder.Definition.Name, err.Error())
+
+		return nil, err
+	}
+
+	nodesWithPods := make(map[string]bool)
+
+	for index := range podList.Items {
+		if isPodReady(&podList.Items[index]) {
+			nodesWithPods[podList.Items[index].Spec.NodeName] = true
+		}
+	}
+
+	var missingNodes []string
+
+	for _, node := range nodeList.Items {
+		if !nodesWithPods[node.Name] {
+			missingNodes = append(missingNodes, node.Name)
+		}
+	}
+
+	sort.Strings(missingNodes)
+
+	return missingNodes, nil
+}
+
+// isPodReady checks whether the pod is running and has the Ready condition.
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+		return false
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// Diff compares the daemonset definition with the daemonset object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing daemonset %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"daemonset object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// GetGVR returns the daemonset GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "apps", Version: "v1", Resource: "daemonsets",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "DaemonSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -1,11 +1,18 @@
 package daemonset
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // buildValidTestBuilder returns a valid Builder for testing purposes.
@@ -52,3 +59,168 @@ func TestWithOptions(t *testing.T) {
 	assert.Equal(t, "test-container-name",
 		testBuilder.Definition.Spec.Template.Spec.Containers[0].Name)
 }
+
+func TestWithMaxUnavailable(t *testing.T) {
+	testCases := []struct {
+		maxUnavailable intstr.IntOrString
+		expectedError  string
+	}{
+		{
+			maxUnavailable: intstr.FromInt(2),
+			expectedError:  "",
+		},
+		{
+			maxUnavailable: intstr.FromString("25%"),
+			expectedError:  "",
+		},
+		{
+			maxUnavailable: intstr.FromInt(-1),
+			expectedError:  "invalid maxUnavailable -1, must be a non-negative integer or a percentage",
+		},
+		{
+			maxUnavailable: intstr.FromString("two"),
+			expectedError:  "invalid maxUnavailable two, must be a non-negative integer or a percentage",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithMaxUnavailable(testCase.maxUnavailable)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, appsv1.RollingUpdateDaemonSetStrategyType, testBuilder.Definition.Spec.UpdateStrategy.Type)
+		assert.Equal(t, testCase.maxUnavailable, *testBuilder.Definition.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable)
+	}
+}
+
+func TestGetNodesMissingPods(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: append(buildDaemonSetTestObjects(), buildDummyDaemonSet())})
+
+	testBuilder := NewBuilder(testSettings, "test-name", "test-namespace",
+		map[string]string{"app": "test"}, corev1.Container{Name: "test-container"})
+
+	missingNodes, err := testBuilder.GetNodesMissingPods()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"worker-1", "worker-2"}, missingNodes)
+}
+
+func TestWaitUntilScheduledOnAllNodes(t *testing.T) {
+	testCases := []struct {
+		status        appsv1.DaemonSetStatus
+		expectedError string
+	}{
+		{
+			status:        appsv1.DaemonSetStatus{DesiredNumberScheduled: 1, NumberReady: 1, UpdatedNumberScheduled: 1},
+			expectedError: "",
+		},
+		{
+			status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 1, UpdatedNumberScheduled: 1},
+			expectedError: "daemonset test-name in namespace test-namespace has no ready pod on nodes " +
+				"[worker-1 worker-2]: context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testDaemonSet := buildDummyDaemonSet()
+		testDaemonSet.Status = testCase.status
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: append(buildDaemonSetTestObjects(), testDaemonSet)})
+
+		testBuilder := NewBuilder(testSettings, "test-name", "test-namespace",
+			map[string]string{"app": "test"}, corev1.Container{Name: "test-container"})
+
+		err := testBuilder.WaitUntilScheduledOnAllNodes(time.Second)
+
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildDummyDaemonSet() *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "test-namespace"},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""}}},
+		},
+	}
+}
+
+func buildDaemonSetTestObjects() []runtime.Object {
+	readyPod := func(name, nodeName string, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-namespace", Labels: map[string]string{"app": "test"}},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			},
+		}
+	}
+
+	return []runtime.Object{
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-0", Labels: map[string]string{"node-role.kubernetes.io/worker": ""}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-1", Labels: map[string]string{"node-role.kubernetes.io/worker": ""}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-2", Labels: map[string]string{"node-role.kubernetes.io/worker": ""}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: "master-0", Labels: map[string]string{"node-role.kubernetes.io/master": ""}}},
+		readyPod("test-name-a", "worker-0", corev1.ConditionTrue),
+		readyPod("test-name-b", "worker-1", corev1.ConditionFalse),
+		readyPod("test-name-c", "master-0", corev1.ConditionTrue),
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := NewBuilder(testSettings, "test-name", "test-namespace", map[string]string{"test-key": "test-value"},
+		corev1.Container{Name: "test-container"})
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("daemonset object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "daemonsets", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := NewBuilder(testSettings, "test-name", "test-namespace", map[string]string{"test-key": "test-value"},
+		corev1.Container{Name: "test-container"}).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := NewBuilder(
+		existingSettings, "test-name", "test-namespace", map[string]string{"test-key": "test-value"},
+		corev1.Container{Name: "test-container"}).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
@@ -3,23 +3,29 @@ package deployment
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	appsv1Typed "k8s.io/client-go/kubernetes/typed/apps/v1"
 )
 
+// restartedAtAnnotation is the pod template annotation set by kubectl rollout restart.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
 // Builder provides struct for deployment object containing connection to the cluster and the deployment definitions.
 type Builder struct {
 	// Deployment definition. Used to create the deployment object.
@@ -28,8 +34,10 @@ type Builder struct {
 	Object *appsv1.Deployment
 	// Used in functions that define or mutate deployment definition. errorMsg is processed before the deployment
 	// object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient appsv1Typed.AppsV1Interface
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // AdditionalOptions additional options for deployment object.
@@ -38,7 +46,7 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string, labels map[string]string, containerSpec *corev1.Container) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new deployment structure with the following params: "+
 			"name: %s, namespace: %s, labels: %s, containerSpec %v",
 		name, nsname, labels, containerSpec)
@@ -66,21 +74,21 @@ func NewBuilder(
 	builder.WithAdditionalContainerSpecs([]corev1.Container{*containerSpec})
 
 	if name == "" {
-		glog.V(100).Infof("The name of the deployment is empty")
+		logging.V(100).Infof("The name of the deployment is empty")
 
-		builder.errorMsg = "deployment 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("deployment 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the deployment is empty")
+		logging.V(100).Infof("The namespace of the deployment is empty")
 
-		builder.errorMsg = "deployment 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("deployment 'namespace' cannot be empty"))
 	}
 
 	if len(labels) == 0 {
-		glog.V(100).Infof("There are no labels for the deployment")
+		logging.V(100).Infof("There are no labels for the deployment")
 
-		builder.errorMsg = "deployment 'labels' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("deployment 'labels' cannot be empty"))
 	}
 
 	return &builder
@@ -93,7 +101,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("apiClient cannot be nil")
 	}
 
-	glog.V(100).Infof("Pulling existing deployment name: %s under namespace: %s", name, nsname)
+	logging.V(100).Infof("Pulling existing deployment name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient.AppsV1Interface,
@@ -128,7 +136,7 @@ func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying nodeSelector %s to deployment %s in namespace %s",
+	logging.V(100).Infof("Applying nodeSelector %s to deployment %s in namespace %s",
 		selector, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Template.Spec.NodeSelector = selector
@@ -142,7 +150,7 @@ func (builder *Builder) WithReplicas(replicas int32) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting %d replicas in deployment %s in namespace %s",
+	logging.V(100).Infof("Setting %d replicas in deployment %s in namespace %s",
 		replicas, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Replicas = &replicas
@@ -156,13 +164,13 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 		return builder
 	}
 
-	glog.V(100).Infof("Appending a list of container specs %v to deployment %s in namespace %s",
+	logging.V(100).Infof("Appending a list of container specs %v to deployment %s in namespace %s",
 		specs, builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(specs) == 0 {
-		glog.V(100).Infof("The container specs are empty")
+		logging.V(100).Infof("The container specs are empty")
 
-		builder.errorMsg = "cannot accept empty list as container specs"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot accept empty list as container specs"))
 
 		return builder
 	}
@@ -178,10 +186,10 @@ func (builder *Builder) WithSecondaryNetwork(networks []*multus.NetworkSelection
 		return builder
 	}
 
-	glog.V(100).Infof("Applying secondary networks %v to deployment %s", networks, builder.Definition.Name)
+	logging.V(100).Infof("Applying secondary networks %v to deployment %s", networks, builder.Definition.Name)
 
 	if len(networks) == 0 {
-		builder.errorMsg = "can not apply empty networks list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not apply empty networks list"))
 
 		return builder
 	}
@@ -189,7 +197,8 @@ func (builder *Builder) WithSecondaryNetwork(networks []*multus.NetworkSelection
 	netAnnotation, err := json.Marshal(networks)
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error to unmarshal networks annotation due to: %s", err.Error())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error to unmarshal networks annotation due to: %s", err.Error()))
 
 		return builder
 	}
@@ -206,7 +215,7 @@ func (builder *Builder) WithHugePages() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying hugePages configuration to all containers in deployment: %s",
+	logging.V(100).Infof("Applying hugePages configuration to all containers in deployment: %s",
 		builder.Definition.Name)
 
 	// If volumes are not defined, create an empty list of volumes.
@@ -240,13 +249,13 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 		return builder
 	}
 
-	glog.V(100).Infof("Applying SecurityContext configuration on deployment %s in namespace %s",
+	logging.V(100).Infof("Applying SecurityContext configuration on deployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if securityContext == nil {
-		glog.V(100).Infof("The 'securityContext' of the deployment is empty")
+		logging.V(100).Infof("The 'securityContext' of the deployment is empty")
 
-		builder.errorMsg = "'securityContext' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'securityContext' parameter is empty"))
 
 		return builder
 	}
@@ -262,12 +271,12 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof(fmt.Sprintf("Defining deployment's label to %s:%s", labelKey, labelValue))
+	logging.V(100).Infof(fmt.Sprintf("Defining deployment's label to %s:%s", labelKey, labelValue))
 
 	if labelKey == "" {
-		glog.V(100).Infof("The 'labelKey' of the deployment is empty")
+		logging.V(100).Infof("The 'labelKey' of the deployment is empty")
 
-		builder.errorMsg = "can not apply empty labelKey"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not apply empty labelKey"))
 
 		return builder
 	}
@@ -287,13 +296,13 @@ func (builder *Builder) WithServiceAccountName(serviceAccountName string) *Build
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ServiceAccount %s on deployment %s in namespace %s",
+	logging.V(100).Infof("Setting ServiceAccount %s on deployment %s in namespace %s",
 		serviceAccountName, builder.Definition.Name, builder.Definition.Namespace)
 
 	if serviceAccountName == "" {
-		glog.V(100).Infof("The 'serviceAccount' of the deployment is empty")
+		logging.V(100).Infof("The 'serviceAccount' of the deployment is empty")
 
-		builder.errorMsg = "can not apply empty serviceAccount"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not apply empty serviceAccount"))
 
 		return builder
 	}
@@ -310,14 +319,14 @@ func (builder *Builder) WithVolume(deployVolume corev1.Volume) *Builder {
 	}
 
 	if deployVolume.Name == "" {
-		glog.V(100).Infof("The volume's name cannot be empty")
+		logging.V(100).Infof("The volume's name cannot be empty")
 
-		builder.errorMsg = "The volume's name cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The volume's name cannot be empty"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Adding volume %s to deployment %s in namespace %s",
+	logging.V(100).Infof("Adding volume %s to deployment %s in namespace %s",
 		deployVolume.Name, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Template.Spec.Volumes = append(
@@ -334,14 +343,14 @@ func (builder *Builder) WithSchedulerName(schedulerName string) *Builder {
 	}
 
 	if schedulerName == "" {
-		glog.V(100).Infof("Scheduler's name cannot be empty")
+		logging.V(100).Infof("Scheduler's name cannot be empty")
 
-		builder.errorMsg = "Scheduler's name cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Scheduler's name cannot be empty"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Setting scheduler %s for deployment %s in namespace %s",
+	logging.V(100).Infof("Setting scheduler %s for deployment %s in namespace %s",
 		schedulerName, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Template.Spec.SchedulerName = schedulerName
@@ -355,16 +364,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting deployment additional options")
+	logging.V(100).Infof("Setting deployment additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -380,10 +389,14 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Creating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -391,13 +404,45 @@ func (builder *Builder) Create() (*Builder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for deployment %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the deployment definition against the API server and its admission webhooks with a server-side
+// dry-run request. The deployment is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the deployment %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Update renovates the existing deployment object with the deployment definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Updating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
@@ -412,7 +457,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting deployment %s in namespace %s",
+	logging.V(100).Infof("Deleting deployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -421,13 +466,22 @@ func (builder *Builder) Delete() error {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.Deployments(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return err
+	}
+
 	builder.Object = nil
 
 	return err
@@ -439,7 +493,7 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating deployment %s in namespace %s and waiting for the defined period until it's ready",
+	logging.V(100).Infof("Creating deployment %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if _, err := builder.Create(); err != nil {
@@ -461,7 +515,7 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		return false
 	}
 
-	glog.V(100).Infof("Running periodic check until deployment %s in namespace %s is ready",
+	logging.V(100).Infof("Running periodic check until deployment %s in namespace %s is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -494,7 +548,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting deployment %s in namespace %s and waiting for the defined period until it's removed",
+	logging.V(100).Infof("Deleting deployment %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if err := builder.Delete(); err != nil {
@@ -520,7 +574,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if deployment %s exists in namespace %s",
+	logging.V(100).Infof("Checking if deployment %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -537,7 +591,7 @@ func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionT
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s has condition %v",
+	logging.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
 	if !builder.Exists() {
@@ -562,38 +616,199 @@ func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionT
 		})
 }
 
+// RolloutRestart triggers a rolling restart of the deployment pods the same way kubectl rollout restart does, by
+// stamping the pod template with the current time.
+func (builder *Builder) RolloutRestart() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Restarting rollout of deployment %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot restart rollout of deployment %s in namespace %s because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{restartedAtAnnotation: time.Now().Format(time.RFC3339)},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Patch(
+		context.TODO(), builder.Definition.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		logging.V(100).Infof("Failed to restart rollout of deployment %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WaitUntilRolledOut waits for the duration of the defined timeout or until the latest generation of the deployment
+// is observed and every replica is updated and available. It fails early when the deployment exceeds its progress
+// deadline.
+func (builder *Builder) WaitUntilRolledOut(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s is rolled out",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for deployment rollout because it does not exist")
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				return false, nil
+			}
+
+			return isRolledOut(builder.Object)
+		})
+}
+
+// Scale sets the desired number of replicas of the deployment on the cluster. When waitForRollout is true it also waits for
+// the duration of the defined timeout or until the deployment is rolled out with the new number of replicas.
+func (builder *Builder) Scale(replicas int32, waitForRollout bool, timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Scaling deployment %s in namespace %s to %d replicas",
+		builder.Definition.Name, builder.Definition.Namespace, replicas)
+
+	if replicas < 0 {
+		return builder, fmt.Errorf("cannot scale deployment to negative number of replicas %d", replicas)
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot scale deployment %s in namespace %s because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Object.Spec.Replicas = &replicas
+
+	var err error
+	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Object, metav1.UpdateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to scale deployment %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Definition = builder.Object
+
+	if !waitForRollout {
+		return builder, nil
+	}
+
+	return builder, builder.WaitUntilRolledOut(timeout)
+}
+
 // GetGVR returns deployment's GroupVersionResource which could be used for Clean function.
 func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
 }
 
+// GetGVR returns the deployment GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// isRolledOut checks the deployment status the same way kubectl rollout status does.
+func isRolledOut(deployment *appsv1.Deployment) (bool, error) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return false, nil
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			return false, fmt.Errorf("deployment %s exceeded its progress deadline", deployment.Name)
+		}
+	}
+
+	desiredReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desiredReplicas = *deployment.Spec.Replicas
+	}
+
+	status := deployment.Status
+
+	return status.UpdatedReplicas == desiredReplicas &&
+		status.Replicas == status.UpdatedReplicas &&
+		status.AvailableReplicas == status.UpdatedReplicas, nil
+}
+
+// Diff compares the deployment definition with the deployment object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing deployment %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"deployment object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterDeployment"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -606,14 +821,14 @@ func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
 	}
 
 	if toleration == (corev1.Toleration{}) {
-		glog.V(100).Infof("The toleration cannot be empty")
+		logging.V(100).Infof("The toleration cannot be empty")
 
-		builder.errorMsg = "The toleration cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The toleration cannot be empty"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Adding TaintToleration %v to deployment %s in namespace %s",
+	logging.V(100).Infof("Adding TaintToleration %v to deployment %s in namespace %s",
 		toleration, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Template.Spec.Tolerations = append(
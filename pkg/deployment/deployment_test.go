@@ -1,10 +1,12 @@
 package deployment
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 	appsv1 "k8s.io/api/apps/v1"
@@ -195,7 +197,7 @@ func TestWithAdditionalContainerSpecs(t *testing.T) {
 			testBuilder.WithAdditionalContainerSpecs([]corev1.Container{})
 		}
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 	}
 }
 
@@ -228,7 +230,7 @@ func TestWithSecondaryNetwork(t *testing.T) {
 			)
 		}
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 
 		if testCase.secondaryNetworkAvailable {
 			assert.Equal(t,
@@ -281,7 +283,7 @@ func TestWithSecurityContext(t *testing.T) {
 			testBuilder.WithSecurityContext(nil)
 		}
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 
 		if testCase.securityContextAvailable {
 			assert.Equal(t, true, *testBuilder.Definition.Spec.Template.Spec.SecurityContext.RunAsNonRoot)
@@ -322,7 +324,7 @@ func TestWithLabel(t *testing.T) {
 
 		testBuilder.WithLabel(testCase.labelKey, testCase.labelValue)
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 
 		if testCase.expectedErrMsg == "" {
 			assert.Equal(t, testCase.labelValue, testBuilder.Definition.Spec.Template.Labels[testCase.labelKey])
@@ -349,7 +351,7 @@ func TestWithServiceAccountName(t *testing.T) {
 
 		testBuilder.WithServiceAccountName(testCase.serviceAccountName)
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 
 		if testCase.expectedErrMsg == "" {
 			assert.Equal(t, testCase.serviceAccountName, testBuilder.Definition.Spec.Template.Spec.ServiceAccountName)
@@ -378,7 +380,7 @@ func TestWithVolume(t *testing.T) {
 			Name: testCase.volumeName,
 		})
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 
 		if testCase.expectedErrMsg == "" {
 			assert.Equal(t, testCase.volumeName, testBuilder.Definition.Spec.Template.Spec.Volumes[0].Name)
@@ -405,7 +407,7 @@ func TestWithSchedulerName(t *testing.T) {
 
 		testBuilder.WithSchedulerName(testCase.schedulerName)
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 
 		if testCase.expectedErrMsg == "" {
 			assert.Equal(t, testCase.schedulerName, testBuilder.Definition.Spec.Template.Spec.SchedulerName)
@@ -420,7 +422,7 @@ func TestWithOptions(t *testing.T) {
 		return builder, nil
 	})
 
-	assert.Equal(t, "", testBuilder.errorMsg)
+	assert.Nil(t, testBuilder.errorMsg)
 }
 
 func TestWithToleration(t *testing.T) {
@@ -446,7 +448,7 @@ func TestWithToleration(t *testing.T) {
 
 		testBuilder.WithToleration(testCase.toleration)
 
-		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg)
 
 		if testCase.expectedErrMsg == "" {
 			assert.Equal(t, testCase.toleration, testBuilder.Definition.Spec.Template.Spec.Tolerations[0])
@@ -715,3 +717,176 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestRolloutRestart(t *testing.T) {
+	testCases := []struct {
+		exists        bool
+		expectedError string
+	}{
+		{
+			exists:        true,
+			expectedError: "",
+		},
+		{
+			exists: false,
+			expectedError: "cannot restart rollout of deployment test-name in namespace test-namespace " +
+				"because it does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildRolloutTestDeployment(1, appsv1.DeploymentStatus{}))
+		}
+
+		testBuilder := buildTestBuilderWithFakeObjects(runtimeObjects)
+
+		testBuilder, err := testBuilder.RolloutRestart()
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.NotEmpty(t, testBuilder.Object.Spec.Template.Annotations[restartedAtAnnotation])
+	}
+}
+
+func TestWaitUntilRolledOut(t *testing.T) {
+	testCases := []struct {
+		status        appsv1.DeploymentStatus
+		expectedError string
+	}{
+		{
+			status:        appsv1.DeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			expectedError: "",
+		},
+		{
+			status:        appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 2, AvailableReplicas: 2},
+			expectedError: "context deadline exceeded",
+		},
+		{
+			status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+				Type: appsv1.DeploymentProgressing, Reason: "ProgressDeadlineExceeded"}}},
+			expectedError: "deployment test-name exceeded its progress deadline",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestBuilderWithFakeObjects(
+			[]runtime.Object{buildRolloutTestDeployment(2, testCase.status)})
+
+		err := testBuilder.WaitUntilRolledOut(time.Second)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.ErrorContains(t, err, testCase.expectedError)
+		}
+	}
+}
+
+func TestScale(t *testing.T) {
+	testCases := []struct {
+		replicas      int32
+		exists        bool
+		expectedError string
+	}{
+		{
+			replicas:      3,
+			exists:        true,
+			expectedError: "",
+		},
+		{
+			replicas:      -1,
+			exists:        true,
+			expectedError: "cannot scale deployment to negative number of replicas -1",
+		},
+		{
+			replicas:      3,
+			exists:        false,
+			expectedError: "cannot scale deployment test-name in namespace test-namespace because it does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildRolloutTestDeployment(1, appsv1.DeploymentStatus{}))
+		}
+
+		testBuilder := buildTestBuilderWithFakeObjects(runtimeObjects)
+
+		testBuilder, err := testBuilder.Scale(testCase.replicas, false, time.Second)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.replicas, *testBuilder.Object.Spec.Replicas)
+		assert.Equal(t, testCase.replicas, *testBuilder.Definition.Spec.Replicas)
+	}
+}
+
+func buildRolloutTestDeployment(replicas int32, status appsv1.DeploymentStatus) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-namespace",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+		},
+		Status: status,
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := NewBuilder(testSettings, "test-name", "test-namespace", map[string]string{"test-key": "test-value"},
+		&corev1.Container{Name: "test-container"})
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("deployment object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "deployments", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := NewBuilder(testSettings, "test-name", "test-namespace", map[string]string{"test-key": "test-value"},
+		&corev1.Container{Name: "test-container"}).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := NewBuilder(
+		existingSettings, "test-name", "test-namespace", map[string]string{"test-key": "test-value"},
+		&corev1.Container{Name: "test-container"}).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
@@ -4,15 +4,15 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // List returns deployment inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("deployment 'nsname' parameter can not be empty")
+		logging.V(100).Infof("deployment 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list deployments, 'nsname' parameter is empty")
 	}
@@ -21,7 +21,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 	logMessage := fmt.Sprintf("Listing deployments in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -31,12 +31,12 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	deploymentList, err := apiClient.Deployments(nsname).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list deployments in the namespace %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list deployments in the namespace %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 	logMessage := "Listing deployments in all namespaces"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be either empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be either empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -73,12 +73,12 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	deploymentList, err := apiClient.Deployments("").List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list deployments in all namespaces due to %s", err.Error())
+		logging.V(100).Infof("Failed to list deployments in all namespaces due to %s", err.Error())
 
 		return nil, err
 	}
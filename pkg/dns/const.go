@@ -0,0 +1,17 @@
+package dns
+
+const (
+	// APIGroup represents the ingress operator api group of DNSRecord.
+	APIGroup = "ingress.operator.openshift.io"
+	// APIVersion represents the version of the ingress operator api.
+	APIVersion = "v1"
+	// DNSRecordKind represents kind of DNSRecord object.
+	DNSRecordKind = "DNSRecord"
+	// IngressOperatorNamespace represents the namespace DNSRecords are reconciled in by the ingress operator.
+	IngressOperatorNamespace = "openshift-ingress-operator"
+	// ConditionPublished represents the DNSRecord zone condition set once the record exists in the zone.
+	ConditionPublished = "Published"
+
+	// operatorName is the only name the DNS operator reconciles.
+	operatorName = "default"
+)
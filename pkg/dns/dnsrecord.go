@@ -0,0 +1,378 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/dns/dnstypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// RecordBuilder provides struct for the DNSRecord object containing connection to the cluster and the DNSRecord
+// definitions.
+type RecordBuilder struct {
+	// DNSRecord definition. Used to create the DNSRecord object.
+	Definition *dnstypes.DNSRecord
+	// Created DNSRecord object.
+	Object *dnstypes.DNSRecord
+	// Used in functions that define or mutate DNSRecord definition. errorMsg is processed before the DNSRecord
+	// object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewRecordBuilder creates a new instance of RecordBuilder. The ingress operator only publishes records created in
+// IngressOperatorNamespace.
+func NewRecordBuilder(
+	apiClient *clients.Settings,
+	name, nsname, dnsName string,
+	recordType dnstypes.DNSRecordType,
+	targets ...string) *RecordBuilder {
+	logging.V(100).Infof(
+		"Initializing new DNSRecord structure with the following params: name: %s, namespace: %s, dnsName: %s, "+
+			"recordType: %s, targets: %v", name, nsname, dnsName, recordType, targets)
+
+	builder := RecordBuilder{
+		apiClient: apiClient,
+		Definition: &dnstypes.DNSRecord{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       DNSRecordKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: dnstypes.DNSRecordSpec{
+				DNSName:             dnsName,
+				Targets:             targets,
+				RecordType:          recordType,
+				DNSManagementPolicy: dnstypes.ManagedDNS,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the DNSRecord is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("DNSRecord 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the DNSRecord is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("DNSRecord 'namespace' cannot be empty"))
+	}
+
+	if dnsName == "" {
+		logging.V(100).Infof("The dnsName of the DNSRecord is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("DNSRecord 'dnsName' cannot be empty"))
+	}
+
+	switch recordType {
+	case dnstypes.ARecordType, dnstypes.AAAARecordType, dnstypes.CNAMERecordType:
+	default:
+		logging.V(100).Infof("The recordType %s of the DNSRecord is not supported", recordType)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"DNSRecord 'recordType' %s is not supported, must be %s, %s or %s", recordType,
+			dnstypes.ARecordType, dnstypes.AAAARecordType, dnstypes.CNAMERecordType))
+	}
+
+	if len(targets) == 0 {
+		logging.V(100).Infof("The targets of the DNSRecord are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("DNSRecord 'targets' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullRecord pulls existing DNSRecord from cluster.
+func PullRecord(apiClient *clients.Settings, name, nsname string) (*RecordBuilder, error) {
+	logging.V(100).Infof("Pulling existing DNSRecord name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("DNSRecord 'apiClient' cannot be empty")
+	}
+
+	builder := RecordBuilder{
+		apiClient: apiClient,
+		Definition: &dnstypes.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the DNSRecord is empty")
+
+		return nil, fmt.Errorf("DNSRecord 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the DNSRecord is empty")
+
+		return nil, fmt.Errorf("DNSRecord 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("DNSRecord object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithTTL sets the record TTL in seconds. The ingress operator uses 30 seconds when it is not set.
+func (builder *RecordBuilder) WithTTL(ttl int64) *RecordBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting TTL %d on DNSRecord %s in namespace %s",
+		ttl, builder.Definition.Name, builder.Definition.Namespace)
+
+	if ttl < 1 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("DNSRecord 'recordTTL' must be positive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.RecordTTL = ttl
+
+	return builder
+}
+
+// Get returns DNSRecord object if found.
+func (builder *RecordBuilder) Get() (*dnstypes.DNSRecord, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting DNSRecord object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetDNSRecordGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("DNSRecord object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertDNSRecordToStructured(unsObject)
+}
+
+// Exists checks whether the given DNSRecord exists.
+func (builder *RecordBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if DNSRecord %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a DNSRecord in the cluster and stores the created object in struct.
+func (builder *RecordBuilder) Create() (*RecordBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the DNSRecord %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredDNSRecord, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured DNSRecord to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetDNSRecordGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredDNSRecord}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create DNSRecord %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertDNSRecordToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes DNSRecord object from a cluster.
+func (builder *RecordBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the DNSRecord object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetDNSRecordGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete DNSRecord: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsPublished returns true when the DNSRecord is reported as published in every zone it is managed in.
+func (builder *RecordBuilder) IsPublished() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if DNSRecord %s in namespace %s is published",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get DNSRecord %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	if len(builder.Object.Status.Zones) == 0 {
+		return false, nil
+	}
+
+	for _, zone := range builder.Object.Status.Zones {
+		if !isZonePublished(zone) {
+			logging.V(100).Infof("DNSRecord %s is not yet published in zone %s", builder.Definition.Name, zone.DNSZone.ID)
+
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// WaitUntilPublished waits for the duration of the defined timeout or until the DNSRecord is published in every
+// zone.
+func (builder *RecordBuilder) WaitUntilPublished(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until DNSRecord %s in namespace %s is published",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			published, err := builder.IsPublished()
+			if err != nil {
+				logging.V(100).Infof("Failed to check DNSRecord %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return published, nil
+		})
+}
+
+// GetDNSRecordGVR returns DNSRecord's GroupVersionResource which could be used for Clean function.
+func GetDNSRecordGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "dnsrecords"}
+}
+
+func isZonePublished(zone dnstypes.DNSZoneStatus) bool {
+	for _, condition := range zone.Conditions {
+		if condition.Type == ConditionPublished {
+			return condition.Status == string(metav1.ConditionTrue)
+		}
+	}
+
+	return false
+}
+
+// convertDNSRecordToStructured converts the unstructured object returned by the dynamic client to a DNSRecord.
+func convertDNSRecordToStructured(unsObject *unstructured.Unstructured) (*dnstypes.DNSRecord, error) {
+	dnsRecord := &dnstypes.DNSRecord{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, dnsRecord)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to DNSRecord object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return dnsRecord, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *RecordBuilder) validate() (bool, error) {
+	resourceCRD := "DNSRecord"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
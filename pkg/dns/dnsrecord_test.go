@@ -0,0 +1,299 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/dns/dnstypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	dnsRecordGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    DNSRecordKind,
+	}
+	defaultDNSRecordName    = "dnsrecord-test"
+	defaultDNSRecordDNSName = "app.apps.example.com."
+	defaultDNSRecordTarget  = "192.0.2.10"
+)
+
+func TestNewRecordBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		dnsName       string
+		recordType    dnstypes.DNSRecordType
+		targets       []string
+		expectedError string
+	}{
+		{
+			name:          defaultDNSRecordName,
+			namespace:     IngressOperatorNamespace,
+			dnsName:       defaultDNSRecordDNSName,
+			recordType:    dnstypes.ARecordType,
+			targets:       []string{defaultDNSRecordTarget},
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     IngressOperatorNamespace,
+			dnsName:       defaultDNSRecordDNSName,
+			recordType:    dnstypes.ARecordType,
+			targets:       []string{defaultDNSRecordTarget},
+			expectedError: "DNSRecord 'name' cannot be empty",
+		},
+		{
+			name:          defaultDNSRecordName,
+			namespace:     "",
+			dnsName:       defaultDNSRecordDNSName,
+			recordType:    dnstypes.ARecordType,
+			targets:       []string{defaultDNSRecordTarget},
+			expectedError: "DNSRecord 'namespace' cannot be empty",
+		},
+		{
+			name:          defaultDNSRecordName,
+			namespace:     IngressOperatorNamespace,
+			dnsName:       "",
+			recordType:    dnstypes.ARecordType,
+			targets:       []string{defaultDNSRecordTarget},
+			expectedError: "DNSRecord 'dnsName' cannot be empty",
+		},
+		{
+			name:          defaultDNSRecordName,
+			namespace:     IngressOperatorNamespace,
+			dnsName:       defaultDNSRecordDNSName,
+			recordType:    "TXT",
+			targets:       []string{defaultDNSRecordTarget},
+			expectedError: "DNSRecord 'recordType' TXT is not supported, must be A, AAAA or CNAME",
+		},
+		{
+			name:          defaultDNSRecordName,
+			namespace:     IngressOperatorNamespace,
+			dnsName:       defaultDNSRecordDNSName,
+			recordType:    dnstypes.ARecordType,
+			targets:       nil,
+			expectedError: "DNSRecord 'targets' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewRecordBuilder(testSettings, testCase.name, testCase.namespace, testCase.dnsName,
+			testCase.recordType, testCase.targets...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.dnsName, testBuilder.Definition.Spec.DNSName)
+			assert.Equal(t, testCase.targets, testBuilder.Definition.Spec.Targets)
+			assert.Equal(t, dnstypes.ManagedDNS, testBuilder.Definition.Spec.DNSManagementPolicy)
+		}
+	}
+}
+
+func TestPullRecord(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultDNSRecordName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("DNSRecord 'name' cannot be empty"),
+		},
+		{
+			name:                defaultDNSRecordName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("DNSRecord object %s doesn't exist in namespace %s",
+				defaultDNSRecordName, IngressOperatorNamespace),
+		},
+		{
+			name:                defaultDNSRecordName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("DNSRecord 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDNSRecord(nil))
+		}
+
+		if testCase.client {
+			testSettings = buildDNSRecordTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullRecord(testSettings, testCase.name, IngressOperatorNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestRecordWithTTL(t *testing.T) {
+	testCases := []struct {
+		ttl           int64
+		expectedError string
+	}{
+		{
+			ttl:           300,
+			expectedError: "",
+		},
+		{
+			ttl:           0,
+			expectedError: "DNSRecord 'recordTTL' must be positive",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDNSRecordBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithTTL(testCase.ttl)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.ttl, testBuilder.Definition.Spec.RecordTTL)
+		}
+	}
+}
+
+func TestRecordCreate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: false},
+		{addToRuntimeObjects: true},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDNSRecord(nil))
+		}
+
+		testBuilder, err := buildValidDNSRecordBuilder(buildDNSRecordTestClientWithDummyObject(runtimeObjects)).Create()
+		assert.Nil(t, err)
+		assert.Equal(t, defaultDNSRecordName, testBuilder.Object.Name)
+	}
+}
+
+func TestRecordDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDNSRecord(nil))
+		}
+
+		testBuilder := buildValidDNSRecordBuilder(buildDNSRecordTestClientWithDummyObject(runtimeObjects))
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+	}
+}
+
+func TestRecordWaitUntilPublished(t *testing.T) {
+	testCases := []struct {
+		zones         []dnstypes.DNSZoneStatus
+		expectedError error
+	}{
+		{
+			zones: []dnstypes.DNSZoneStatus{
+				buildDummyZoneStatus("public", metav1.ConditionTrue),
+				buildDummyZoneStatus("private", metav1.ConditionTrue),
+			},
+			expectedError: nil,
+		},
+		{
+			zones: []dnstypes.DNSZoneStatus{
+				buildDummyZoneStatus("public", metav1.ConditionTrue),
+				buildDummyZoneStatus("private", metav1.ConditionFalse),
+			},
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			zones:         nil,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDNSRecordBuilder(
+			buildDNSRecordTestClientWithDummyObject([]runtime.Object{buildDummyDNSRecord(testCase.zones)}))
+
+		err := testBuilder.WaitUntilPublished(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidDNSRecordBuilder(apiClient *clients.Settings) *RecordBuilder {
+	return NewRecordBuilder(apiClient, defaultDNSRecordName, IngressOperatorNamespace, defaultDNSRecordDNSName,
+		dnstypes.ARecordType, defaultDNSRecordTarget)
+}
+
+func buildDummyDNSRecord(zones []dnstypes.DNSZoneStatus) *dnstypes.DNSRecord {
+	return &dnstypes.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultDNSRecordName,
+			Namespace: IngressOperatorNamespace,
+		},
+		Spec: dnstypes.DNSRecordSpec{
+			DNSName:    defaultDNSRecordDNSName,
+			Targets:    []string{defaultDNSRecordTarget},
+			RecordType: dnstypes.ARecordType,
+		},
+		Status: dnstypes.DNSRecordStatus{
+			Zones: zones,
+		},
+	}
+}
+
+func buildDummyZoneStatus(zoneID string, status metav1.ConditionStatus) dnstypes.DNSZoneStatus {
+	return dnstypes.DNSZoneStatus{
+		DNSZone:    dnstypes.DNSZone{ID: zoneID},
+		Conditions: []dnstypes.DNSZoneCondition{{Type: ConditionPublished, Status: string(status)}},
+	}
+}
+
+func buildDNSRecordTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{dnsRecordGVK},
+	})
+}
@@ -0,0 +1,149 @@
+package dnstypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DNSRecordType is a DNS resource record type.
+type DNSRecordType string
+
+const (
+	// CNAMERecordType is an RFC 1035 CNAME record.
+	CNAMERecordType DNSRecordType = "CNAME"
+	// ARecordType is an RFC 1035 A record.
+	ARecordType DNSRecordType = "A"
+	// AAAARecordType is an RFC 3596 AAAA record.
+	AAAARecordType DNSRecordType = "AAAA"
+)
+
+// DNSManagementPolicy is a policy for configuring how the dns controller manages DNSRecords.
+type DNSManagementPolicy string
+
+const (
+	// ManagedDNS configures the dns controller to manage the lifecycle of the DNS record on the cloud platform.
+	ManagedDNS DNSManagementPolicy = "Managed"
+	// UnmanagedDNS configures the dns controller not to create the DNS record or manage any existing record.
+	UnmanagedDNS DNSManagementPolicy = "Unmanaged"
+)
+
+// DNSRecordSpec contains the details of a DNS record.
+type DNSRecordSpec struct {
+	// dnsName is the hostname of the DNS record.
+	DNSName string `json:"dnsName"`
+	// targets are record targets.
+	Targets []string `json:"targets"`
+	// recordType is the DNS record type. For example, "A" or "CNAME".
+	RecordType DNSRecordType `json:"recordType"`
+	// recordTTL is the record TTL in seconds. If zero, the default is 30.
+	RecordTTL int64 `json:"recordTTL"`
+	// dnsManagementPolicy denotes the current policy applied on the DNS record.
+	DNSManagementPolicy DNSManagementPolicy `json:"dnsManagementPolicy,omitempty"`
+}
+
+// DNSZone is used to define a DNS hosted zone.
+type DNSZone struct {
+	// id is the identifier that can be used to find the DNS hosted zone.
+	ID string `json:"id,omitempty"`
+	// tags can be used to query the DNS hosted zone.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// DNSZoneCondition is just the standard condition fields.
+type DNSZoneCondition struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+}
+
+// DNSZoneStatus is the status of a record within a specific zone.
+type DNSZoneStatus struct {
+	// dnsZone is the zone where the record is published.
+	DNSZone DNSZone `json:"dnsZone"`
+	// conditions are any conditions associated with the record in the zone.
+	Conditions []DNSZoneCondition `json:"conditions,omitempty"`
+}
+
+// DNSRecordStatus is the most recently observed status of each record.
+type DNSRecordStatus struct {
+	// zones are the status of the record in each zone.
+	Zones []DNSZoneStatus `json:"zones,omitempty"`
+	// observedGeneration is the most recently observed generation of the DNSRecord.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// DNSRecord is a DNS record managed in the zones defined by dns.config.openshift.io/cluster .spec.publicZone and
+// .spec.privateZone.
+type DNSRecord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DNSRecordSpec   `json:"spec,omitempty"`
+	Status DNSRecordStatus `json:"status,omitempty"`
+}
+
+// DNSRecordList contains a list of dnsrecords.
+type DNSRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DNSRecord `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
+	if in == nil {
+		return nil
+	}
+
+	out := new(DNSRecord)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+
+	if in.Spec.Targets != nil {
+		out.Spec.Targets = make([]string, len(in.Spec.Targets))
+		copy(out.Spec.Targets, in.Spec.Targets)
+	}
+
+	out.Status.ObservedGeneration = in.Status.ObservedGeneration
+
+	if in.Status.Zones != nil {
+		out.Status.Zones = make([]DNSZoneStatus, len(in.Status.Zones))
+
+		for index, zone := range in.Status.Zones {
+			out.Status.Zones[index].DNSZone.ID = zone.DNSZone.ID
+
+			if zone.DNSZone.Tags != nil {
+				out.Status.Zones[index].DNSZone.Tags = make(map[string]string, len(zone.DNSZone.Tags))
+
+				for key, value := range zone.DNSZone.Tags {
+					out.Status.Zones[index].DNSZone.Tags[key] = value
+				}
+			}
+
+			if zone.Conditions != nil {
+				out.Status.Zones[index].Conditions = make([]DNSZoneCondition, len(zone.Conditions))
+
+				for condIndex, condition := range zone.Conditions {
+					out.Status.Zones[index].Conditions[condIndex] = condition
+					condition.LastTransitionTime.DeepCopyInto(
+						&out.Status.Zones[index].Conditions[condIndex].LastTransitionTime)
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecord) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
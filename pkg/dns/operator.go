@@ -0,0 +1,296 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	operatorV1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OperatorBuilder provides a struct for the dns.operator object from the cluster and a dns.operator definition.
+type OperatorBuilder struct {
+	// dns.operator definition, used to update the dns.operator object.
+	Definition *operatorV1.DNS
+	// Created dns.operator object.
+	Object *operatorV1.DNS
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	errorMsg  error
+}
+
+// PullOperator loads the existing cluster dns.operator into OperatorBuilder struct.
+func PullOperator(apiClient *clients.Settings) (*OperatorBuilder, error) {
+	logging.V(100).Infof("Pulling existing dns.operator name: %s", operatorName)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("dns.operator 'apiClient' cannot be empty")
+	}
+
+	builder := OperatorBuilder{
+		apiClient: apiClient,
+		Definition: &operatorV1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: operatorName,
+			},
+		},
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("dns.operator object %s doesn't exist", operatorName)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithServer adds a server forwarding queries for the given zones to the upstream resolvers, replacing any server
+// with the same name. Upstreams are IP addresses with an optional port and are queried according to the policy.
+func (builder *OperatorBuilder) WithServer(
+	name string, zones []string, policy operatorV1.ForwardingPolicy, upstreams ...string) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding server %s for zones %v with upstreams %v and policy %s to dns.operator %s",
+		name, zones, upstreams, policy, builder.Definition.Name)
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("dns.operator server 'name' cannot be empty"))
+	}
+
+	if len(zones) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("dns.operator server 'zones' cannot be empty"))
+	}
+
+	if len(upstreams) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("dns.operator server 'upstreams' cannot be empty"))
+	}
+
+	if err := validateForwardingPolicy(policy); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	server := operatorV1.Server{
+		Name:  name,
+		Zones: zones,
+		ForwardPlugin: operatorV1.ForwardPlugin{
+			Upstreams: upstreams,
+			Policy:    policy,
+		},
+	}
+
+	for index := range builder.Definition.Spec.Servers {
+		if builder.Definition.Spec.Servers[index].Name == name {
+			builder.Definition.Spec.Servers[index] = server
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Servers = append(builder.Definition.Spec.Servers, server)
+
+	return builder
+}
+
+// WithUpstreamResolvers sets the resolvers used for queries not matching any server. An empty upstream list keeps
+// the operator default of forwarding to the node /etc/resolv.conf.
+func (builder *OperatorBuilder) WithUpstreamResolvers(
+	policy operatorV1.ForwardingPolicy, upstreams ...operatorV1.Upstream) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting upstream resolvers %v with policy %s on dns.operator %s",
+		upstreams, policy, builder.Definition.Name)
+
+	if err := validateForwardingPolicy(policy); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	for _, upstream := range upstreams {
+		if upstream.Type == operatorV1.NetworkResolverType && upstream.Address == "" {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("dns.operator upstream of type %s must have an address", operatorV1.NetworkResolverType))
+		}
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.UpstreamResolvers.Policy = policy
+	builder.Definition.Spec.UpstreamResolvers.Upstreams = upstreams
+
+	return builder
+}
+
+// WithNodePlacement restricts the nodes the DNS daemonset runs on.
+func (builder *OperatorBuilder) WithNodePlacement(
+	nodeSelector map[string]string, tolerations ...corev1.Toleration) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting node placement with nodeSelector %v and tolerations %v on dns.operator %s",
+		nodeSelector, tolerations, builder.Definition.Name)
+
+	if len(nodeSelector) == 0 && len(tolerations) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("dns.operator node placement requires a nodeSelector or tolerations"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodePlacement = operatorV1.DNSNodePlacement{
+		NodeSelector: nodeSelector,
+		Tolerations:  tolerations,
+	}
+
+	return builder
+}
+
+// Exists checks whether the given dns.operator exists.
+func (builder *OperatorBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if dns.operator %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Get returns dns.operator object.
+func (builder *OperatorBuilder) Get() (*operatorV1.DNS, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting dns.operator %s", builder.Definition.Name)
+
+	dnsOperator := &operatorV1.DNS{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name: builder.Definition.Name,
+	}, dnsOperator)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsOperator, nil
+}
+
+// Update renovates the existing dns.operator object with the new definition in builder.
+func (builder *OperatorBuilder) Update() (*OperatorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the dns.operator object %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("dns.operator object %s doesn't exist", builder.Definition.Name)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err == nil {
+		builder.Object = builder.Definition
+	}
+
+	return builder, err
+}
+
+// WaitUntilInCondition waits for a specific time duration until the dns.operator has the specified condition type
+// with the expected status.
+func (builder *OperatorBuilder) WaitUntilInCondition(
+	condition string, timeout time.Duration, status operatorV1.ConditionStatus) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Wait until dns.operator object %s is in condition %s %s",
+		builder.Definition.Name, condition, status)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, fmt.Errorf("dns.operator object doesn't exist")
+			}
+
+			for _, c := range builder.Object.Status.Conditions {
+				if c.Type == condition && c.Status == status {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		})
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *OperatorBuilder) validate() (bool, error) {
+	resourceCRD := "dns.operator"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func validateForwardingPolicy(policy operatorV1.ForwardingPolicy) error {
+	switch policy {
+	case "", operatorV1.RandomForwardingPolicy, operatorV1.RoundRobinForwardingPolicy,
+		operatorV1.SequentialForwardingPolicy:
+		return nil
+	default:
+		return fmt.Errorf("dns.operator forwarding policy %s is not supported, must be %s, %s or %s", policy,
+			operatorV1.RandomForwardingPolicy, operatorV1.RoundRobinForwardingPolicy,
+			operatorV1.SequentialForwardingPolicy)
+	}
+}
@@ -0,0 +1,234 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	operatorV1 "github.com/openshift/api/operator/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPullOperator(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("dns.operator object %s doesn't exist", operatorName),
+		},
+		{
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("dns.operator 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDNSOperator(nil))
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullOperator(testSettings)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, operatorName, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestOperatorWithServer(t *testing.T) {
+	testCases := []struct {
+		name          string
+		zones         []string
+		policy        operatorV1.ForwardingPolicy
+		upstreams     []string
+		expectedError string
+	}{
+		{
+			name:      "example",
+			zones:     []string{"example.com"},
+			policy:    operatorV1.RoundRobinForwardingPolicy,
+			upstreams: []string{"192.0.2.53"},
+		},
+		{
+			name:          "",
+			zones:         []string{"example.com"},
+			upstreams:     []string{"192.0.2.53"},
+			expectedError: "dns.operator server 'name' cannot be empty",
+		},
+		{
+			name:          "example",
+			zones:         []string{"example.com"},
+			expectedError: "dns.operator server 'upstreams' cannot be empty",
+		},
+		{
+			name:          "example",
+			zones:         []string{"example.com"},
+			policy:        "Fastest",
+			upstreams:     []string{"192.0.2.53"},
+			expectedError: "dns.operator forwarding policy Fastest is not supported, must be Random, RoundRobin or Sequential",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDNSOperatorBuilder().
+			WithServer("example", []string{"old.example.com"}, "", "192.0.2.1").
+			WithServer(testCase.name, testCase.zones, testCase.policy, testCase.upstreams...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, []operatorV1.Server{{
+				Name:  testCase.name,
+				Zones: testCase.zones,
+				ForwardPlugin: operatorV1.ForwardPlugin{
+					Upstreams: testCase.upstreams,
+					Policy:    testCase.policy,
+				},
+			}}, testBuilder.Definition.Spec.Servers)
+		}
+	}
+}
+
+func TestOperatorWithUpstreamResolvers(t *testing.T) {
+	testCases := []struct {
+		upstreams     []operatorV1.Upstream
+		expectedError string
+	}{
+		{
+			upstreams: []operatorV1.Upstream{
+				{Type: operatorV1.SystemResolveConfType},
+				{Type: operatorV1.NetworkResolverType, Address: "192.0.2.53", Port: 53},
+			},
+		},
+		{
+			upstreams:     []operatorV1.Upstream{{Type: operatorV1.NetworkResolverType}},
+			expectedError: "dns.operator upstream of type Network must have an address",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDNSOperatorBuilder().
+			WithUpstreamResolvers(operatorV1.SequentialForwardingPolicy, testCase.upstreams...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.upstreams, testBuilder.Definition.Spec.UpstreamResolvers.Upstreams)
+			assert.Equal(t, operatorV1.SequentialForwardingPolicy, testBuilder.Definition.Spec.UpstreamResolvers.Policy)
+		}
+	}
+}
+
+func TestOperatorWithNodePlacement(t *testing.T) {
+	testCases := []struct {
+		nodeSelector  map[string]string
+		tolerations   []corev1.Toleration
+		expectedError string
+	}{
+		{
+			nodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+		},
+		{
+			tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+		},
+		{
+			expectedError: "dns.operator node placement requires a nodeSelector or tolerations",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDNSOperatorBuilder().WithNodePlacement(testCase.nodeSelector, testCase.tolerations...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Spec.NodePlacement.NodeSelector)
+			assert.Equal(t, testCase.tolerations, testBuilder.Definition.Spec.NodePlacement.Tolerations)
+		}
+	}
+}
+
+func TestOperatorUpdate(t *testing.T) {
+	testBuilder := buildValidDNSOperatorBuilder().
+		WithServer("example", []string{"example.com"}, "", "192.0.2.53")
+
+	testBuilder, err := testBuilder.Update()
+	assert.Nil(t, err)
+
+	dnsOperator, err := testBuilder.Get()
+	assert.Nil(t, err)
+	assert.Len(t, dnsOperator.Spec.Servers, 1)
+}
+
+func TestOperatorWaitUntilInCondition(t *testing.T) {
+	testCases := []struct {
+		conditions    []operatorV1.OperatorCondition
+		expectedError error
+	}{
+		{
+			conditions: []operatorV1.OperatorCondition{
+				{Type: operatorV1.OperatorStatusTypeAvailable, Status: operatorV1.ConditionTrue}},
+			expectedError: nil,
+		},
+		{
+			conditions: []operatorV1.OperatorCondition{
+				{Type: operatorV1.OperatorStatusTypeAvailable, Status: operatorV1.ConditionFalse}},
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyDNSOperator(testCase.conditions)}})
+
+		testBuilder, err := PullOperator(testSettings)
+		assert.Nil(t, err)
+
+		err = testBuilder.WaitUntilInCondition(
+			operatorV1.OperatorStatusTypeAvailable, time.Second, operatorV1.ConditionTrue)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidDNSOperatorBuilder() *OperatorBuilder {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyDNSOperator(nil)}})
+
+	testBuilder, _ := PullOperator(testSettings)
+
+	return testBuilder
+}
+
+func buildDummyDNSOperator(conditions []operatorV1.OperatorCondition) *operatorV1.DNS {
+	return &operatorV1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: operatorName,
+		},
+		Status: operatorV1.DNSStatus{
+			Conditions: conditions,
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package egress
+
+const (
+	// APIGroup represents OVN-Kubernetes api group.
+	APIGroup = "k8s.ovn.org"
+	// APIVersion represents version of OVN-Kubernetes egress api.
+	APIVersion = "v1"
+	// EgressIPKind represents kind of EgressIP object.
+	EgressIPKind = "EgressIP"
+	// EgressServiceKind represents kind of EgressService object.
+	EgressServiceKind = "EgressService"
+	// EgressFirewallKind represents kind of EgressFirewall object.
+	EgressFirewallKind = "EgressFirewall"
+	// EgressAssignableLabel represents the label of the nodes that can host egress IPs.
+	EgressAssignableLabel = "k8s.ovn.org/egress-assignable"
+	// EgressFirewallName represents the only name OVN-Kubernetes accepts for an EgressFirewall in a namespace.
+	EgressFirewallName = "default"
+	// EgressFirewallApplied represents the EgressFirewall status once all its rules are applied.
+	EgressFirewallApplied = "EgressFirewall Rules applied"
+
+	// nodePrimaryIfAddrAnnotation is the node annotation holding the addresses of the primary interface.
+	nodePrimaryIfAddrAnnotation = "k8s.ovn.org/node-primary-ifaddr"
+	// egressFirewallFailed is the prefix of the EgressFirewall status when some rules failed to apply.
+	egressFirewallFailed = "EgressFirewall Rules not correctly applied"
+)
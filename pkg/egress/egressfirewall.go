@@ -0,0 +1,432 @@
+package egress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/egress/egtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// EgressFirewallBuilder provides struct for the EgressFirewall object containing connection to the cluster and the
+// EgressFirewall definitions.
+type EgressFirewallBuilder struct {
+	// EgressFirewall definition. Used to create the EgressFirewall object.
+	Definition *egtypes.EgressFirewall
+	// Created EgressFirewall object.
+	Object *egtypes.EgressFirewall
+	// Used in functions that define or mutate EgressFirewall definition. errorMsg is processed before the
+	// EgressFirewall object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewEgressFirewallBuilder creates a new instance of EgressFirewallBuilder. OVN-Kubernetes only accepts a single
+// EgressFirewall per namespace, named EgressFirewallName. Rules are evaluated in the order they are added.
+func NewEgressFirewallBuilder(apiClient *clients.Settings, nsname string) *EgressFirewallBuilder {
+	logging.V(100).Infof("Initializing new EgressFirewall structure with the following params: namespace: %s", nsname)
+
+	builder := EgressFirewallBuilder{
+		apiClient: apiClient,
+		Definition: &egtypes.EgressFirewall{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       EgressFirewallKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      EgressFirewallName,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the EgressFirewall is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressFirewall 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullEgressFirewall pulls existing EgressFirewall of the namespace from cluster.
+func PullEgressFirewall(apiClient *clients.Settings, nsname string) (*EgressFirewallBuilder, error) {
+	logging.V(100).Infof("Pulling existing EgressFirewall under namespace %s from cluster", nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("EgressFirewall 'apiClient' cannot be empty")
+	}
+
+	builder := EgressFirewallBuilder{
+		apiClient: apiClient,
+		Definition: &egtypes.EgressFirewall{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      EgressFirewallName,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the EgressFirewall is empty")
+
+		return nil, fmt.Errorf("EgressFirewall 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("EgressFirewall object doesn't exist in namespace %s", nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithCIDRRule appends a rule allowing or denying the traffic to the given CIDR. When no ports are given the rule
+// applies to all ports and protocols.
+func (builder *EgressFirewallBuilder) WithCIDRRule(
+	ruleType egtypes.EgressFirewallRuleType, cidr string, ports ...egtypes.EgressFirewallPort) *EgressFirewallBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding %s rule to CIDR %s on EgressFirewall in namespace %s",
+		ruleType, cidr, builder.Definition.Namespace)
+
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		logging.V(100).Infof("The cidrSelector %s of the EgressFirewall rule is not a valid CIDR", cidr)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("EgressFirewall rule 'cidrSelector' %s is not a valid CIDR", cidr))
+
+		return builder
+	}
+
+	return builder.withRule(egtypes.EgressFirewallRule{
+		Type:  ruleType,
+		Ports: ports,
+		To:    egtypes.EgressFirewallDestination{CIDRSelector: cidr},
+	})
+}
+
+// WithDNSNameRule appends a rule allowing or denying the traffic to the given domain name. When no ports are given
+// the rule applies to all ports and protocols.
+func (builder *EgressFirewallBuilder) WithDNSNameRule(
+	ruleType egtypes.EgressFirewallRuleType, dnsName string, ports ...egtypes.EgressFirewallPort) *EgressFirewallBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding %s rule to DNS name %s on EgressFirewall in namespace %s",
+		ruleType, dnsName, builder.Definition.Namespace)
+
+	if dnsName == "" {
+		logging.V(100).Infof("The dnsName of the EgressFirewall rule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressFirewall rule 'dnsName' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.withRule(egtypes.EgressFirewallRule{
+		Type:  ruleType,
+		Ports: ports,
+		To:    egtypes.EgressFirewallDestination{DNSName: dnsName},
+	})
+}
+
+// WithNodeSelectorRule appends a rule allowing or denying the traffic to the node IPs of the nodes matching
+// nodeSelector. When no ports are given the rule applies to all ports and protocols.
+func (builder *EgressFirewallBuilder) WithNodeSelectorRule(
+	ruleType egtypes.EgressFirewallRuleType,
+	nodeSelector map[string]string,
+	ports ...egtypes.EgressFirewallPort) *EgressFirewallBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding %s rule to nodes matching %v on EgressFirewall in namespace %s",
+		ruleType, nodeSelector, builder.Definition.Namespace)
+
+	if len(nodeSelector) == 0 {
+		logging.V(100).Infof("The nodeSelector of the EgressFirewall rule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("EgressFirewall rule 'nodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.withRule(egtypes.EgressFirewallRule{
+		Type:  ruleType,
+		Ports: ports,
+		To:    egtypes.EgressFirewallDestination{NodeSelector: &metav1.LabelSelector{MatchLabels: nodeSelector}},
+	})
+}
+
+// Get returns EgressFirewall object if found.
+func (builder *EgressFirewallBuilder) Get() (*egtypes.EgressFirewall, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting EgressFirewall object in namespace %s", builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetEgressFirewallGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("EgressFirewall object doesn't exist in namespace %s", builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertEgressFirewallToStructured(unsObject)
+}
+
+// Exists checks whether the given EgressFirewall exists.
+func (builder *EgressFirewallBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if EgressFirewall exists in namespace %s", builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an EgressFirewall in the cluster and stores the created object in struct.
+func (builder *EgressFirewallBuilder) Create() (*EgressFirewallBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the EgressFirewall in namespace %s", builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.Egress) == 0 {
+		return builder, fmt.Errorf("EgressFirewall in namespace %s must have at least one rule",
+			builder.Definition.Namespace)
+	}
+
+	unstructuredEgressFirewall, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured EgressFirewall to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetEgressFirewallGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredEgressFirewall}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create EgressFirewall in namespace %s due to %s",
+			builder.Definition.Namespace, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertEgressFirewallToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing EgressFirewall object with the EgressFirewall definition in builder.
+func (builder *EgressFirewallBuilder) Update() (*EgressFirewallBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to update EgressFirewall, object doesn't exist on cluster")
+	}
+
+	logging.V(100).Infof("Updating the EgressFirewall object in namespace %s", builder.Definition.Namespace)
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredEgressFirewall, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured EgressFirewall to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetEgressFirewallGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredEgressFirewall}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertEgressFirewallToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes EgressFirewall object from a cluster.
+func (builder *EgressFirewallBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the EgressFirewall object in namespace %s", builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetEgressFirewallGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete EgressFirewall: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilApplied waits for the duration of the defined timeout or until all rules of the EgressFirewall are
+// applied. It returns early with the status messages if OVN-Kubernetes failed to apply some rules.
+func (builder *EgressFirewallBuilder) WaitUntilApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until EgressFirewall in namespace %s is applied",
+		builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			if strings.HasPrefix(builder.Object.Status.Status, egressFirewallFailed) {
+				return false, fmt.Errorf("EgressFirewall in namespace %s failed to apply: %s",
+					builder.Definition.Namespace, strings.Join(builder.Object.Status.Messages, "; "))
+			}
+
+			return builder.Object.Status.Status == EgressFirewallApplied, nil
+		})
+}
+
+// GetEgressFirewallGVR returns EgressFirewall's GroupVersionResource which could be used for Clean function.
+func GetEgressFirewallGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "egressfirewalls"}
+}
+
+// withRule validates the type and ports of the rule before appending it to the EgressFirewall definition.
+func (builder *EgressFirewallBuilder) withRule(rule egtypes.EgressFirewallRule) *EgressFirewallBuilder {
+	if rule.Type != egtypes.EgressFirewallRuleAllow && rule.Type != egtypes.EgressFirewallRuleDeny {
+		logging.V(100).Infof("The type %s of the EgressFirewall rule is not supported", rule.Type)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressFirewall rule 'type' must be %s or %s, got %s",
+			egtypes.EgressFirewallRuleAllow, egtypes.EgressFirewallRuleDeny, rule.Type))
+
+		return builder
+	}
+
+	for _, port := range rule.Ports {
+		switch strings.ToUpper(port.Protocol) {
+		case "TCP", "UDP", "SCTP":
+		default:
+			logging.V(100).Infof("The protocol %s of the EgressFirewall rule is not supported", port.Protocol)
+
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("EgressFirewall rule 'protocol' must be TCP, UDP or SCTP, got %s", port.Protocol))
+
+			return builder
+		}
+
+		if port.Port < 0 || port.Port > 65535 {
+			logging.V(100).Infof("The port %d of the EgressFirewall rule is out of range", port.Port)
+
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("EgressFirewall rule 'port' %d is out of range", port.Port))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Egress = append(builder.Definition.Spec.Egress, rule)
+
+	return builder
+}
+
+// convertEgressFirewallToStructured converts the unstructured object returned by the dynamic client to an
+// EgressFirewall.
+func convertEgressFirewallToStructured(unsObject *unstructured.Unstructured) (*egtypes.EgressFirewall, error) {
+	egressFirewall := &egtypes.EgressFirewall{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, egressFirewall)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to EgressFirewall object in namespace %s",
+			unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return egressFirewall, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *EgressFirewallBuilder) validate() (bool, error) {
+	resourceCRD := "EgressFirewall"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,329 @@
+package egress
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/egress/egtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	egressFirewallGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    EgressFirewallKind,
+	}
+	defaultEgressFirewallNamespace = "egress-test"
+)
+
+func TestNewEgressFirewallBuilder(t *testing.T) {
+	testCases := []struct {
+		namespace     string
+		expectedError string
+	}{
+		{
+			namespace:     defaultEgressFirewallNamespace,
+			expectedError: "",
+		},
+		{
+			namespace:     "",
+			expectedError: "EgressFirewall 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewEgressFirewallBuilder(testSettings, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, EgressFirewallName, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullEgressFirewall(t *testing.T) {
+	testCases := []struct {
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			namespace:           defaultEgressFirewallNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("EgressFirewall 'namespace' cannot be empty"),
+		},
+		{
+			namespace:           defaultEgressFirewallNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"EgressFirewall object doesn't exist in namespace %s", defaultEgressFirewallNamespace),
+		},
+		{
+			namespace:           defaultEgressFirewallNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("EgressFirewall 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyEgressFirewall(testCase.namespace, "", nil))
+		}
+
+		if testCase.client {
+			testSettings = buildEgressFirewallTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullEgressFirewall(testSettings, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestEgressFirewallWithRules(t *testing.T) {
+	testCases := []struct {
+		mutate        func(*EgressFirewallBuilder) *EgressFirewallBuilder
+		expectedRule  egtypes.EgressFirewallRule
+		expectedError string
+	}{
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithCIDRRule(egtypes.EgressFirewallRuleAllow, "10.0.0.0/8",
+					egtypes.EgressFirewallPort{Protocol: "TCP", Port: 443})
+			},
+			expectedRule: egtypes.EgressFirewallRule{
+				Type:  egtypes.EgressFirewallRuleAllow,
+				Ports: []egtypes.EgressFirewallPort{{Protocol: "TCP", Port: 443}},
+				To:    egtypes.EgressFirewallDestination{CIDRSelector: "10.0.0.0/8"},
+			},
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithDNSNameRule(egtypes.EgressFirewallRuleDeny, "www.example.com")
+			},
+			expectedRule: egtypes.EgressFirewallRule{
+				Type: egtypes.EgressFirewallRuleDeny,
+				To:   egtypes.EgressFirewallDestination{DNSName: "www.example.com"},
+			},
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithNodeSelectorRule(egtypes.EgressFirewallRuleAllow, map[string]string{"test": "node"})
+			},
+			expectedRule: egtypes.EgressFirewallRule{
+				Type: egtypes.EgressFirewallRuleAllow,
+				To: egtypes.EgressFirewallDestination{
+					NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"test": "node"}},
+				},
+			},
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithCIDRRule(egtypes.EgressFirewallRuleAllow, "10.0.0.0")
+			},
+			expectedError: "EgressFirewall rule 'cidrSelector' 10.0.0.0 is not a valid CIDR",
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithDNSNameRule(egtypes.EgressFirewallRuleDeny, "")
+			},
+			expectedError: "EgressFirewall rule 'dnsName' cannot be empty",
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithNodeSelectorRule(egtypes.EgressFirewallRuleAllow, map[string]string{})
+			},
+			expectedError: "EgressFirewall rule 'nodeSelector' cannot be empty",
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithCIDRRule("Drop", "10.0.0.0/8")
+			},
+			expectedError: "EgressFirewall rule 'type' must be Allow or Deny, got Drop",
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithCIDRRule(egtypes.EgressFirewallRuleAllow, "10.0.0.0/8",
+					egtypes.EgressFirewallPort{Protocol: "ICMP"})
+			},
+			expectedError: "EgressFirewall rule 'protocol' must be TCP, UDP or SCTP, got ICMP",
+		},
+		{
+			mutate: func(builder *EgressFirewallBuilder) *EgressFirewallBuilder {
+				return builder.WithCIDRRule(egtypes.EgressFirewallRuleAllow, "10.0.0.0/8",
+					egtypes.EgressFirewallPort{Protocol: "UDP", Port: 70000})
+			},
+			expectedError: "EgressFirewall rule 'port' 70000 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidEgressFirewallBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testCase.mutate(testBuilder)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, []egtypes.EgressFirewallRule{testCase.expectedRule}, testBuilder.Definition.Spec.Egress)
+		}
+	}
+}
+
+func TestEgressFirewallCreate(t *testing.T) {
+	testCases := []struct {
+		testEgressFirewall *EgressFirewallBuilder
+		expectedError      error
+	}{
+		{
+			testEgressFirewall: buildValidEgressFirewallBuilder(buildEgressFirewallTestClientWithDummyObject(nil)).
+				WithCIDRRule(egtypes.EgressFirewallRuleDeny, "0.0.0.0/0"),
+			expectedError: nil,
+		},
+		{
+			testEgressFirewall: buildValidEgressFirewallBuilder(buildEgressFirewallTestClientWithDummyObject(nil)),
+			expectedError: fmt.Errorf(
+				"EgressFirewall in namespace %s must have at least one rule", defaultEgressFirewallNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testEgressFirewall.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, EgressFirewallName, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Spec.Egress, testBuilder.Object.Spec.Egress)
+		}
+	}
+}
+
+func TestEgressFirewallUpdate(t *testing.T) {
+	testSettings := buildEgressFirewallTestClientWithDummyObject(
+		[]runtime.Object{buildDummyEgressFirewall(defaultEgressFirewallNamespace, "", nil)})
+
+	testBuilder, err := PullEgressFirewall(testSettings, defaultEgressFirewallNamespace)
+	assert.Nil(t, err)
+
+	testBuilder, err = testBuilder.WithDNSNameRule(egtypes.EgressFirewallRuleAllow, "www.example.com").Update()
+	assert.Nil(t, err)
+	assert.Len(t, testBuilder.Object.Spec.Egress, 2)
+
+	_, err = buildValidEgressFirewallBuilder(buildEgressFirewallTestClientWithDummyObject(nil)).Update()
+	assert.EqualError(t, err, "failed to update EgressFirewall, object doesn't exist on cluster")
+}
+
+func TestEgressFirewallDelete(t *testing.T) {
+	testCases := []struct {
+		testEgressFirewall *EgressFirewallBuilder
+		expectedError      error
+	}{
+		{
+			testEgressFirewall: buildValidEgressFirewallBuilder(buildEgressFirewallTestClientWithDummyObject(
+				[]runtime.Object{buildDummyEgressFirewall(defaultEgressFirewallNamespace, "", nil)})),
+			expectedError: nil,
+		},
+		{
+			testEgressFirewall: buildValidEgressFirewallBuilder(buildEgressFirewallTestClientWithDummyObject(nil)),
+			expectedError:      nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testEgressFirewall.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testEgressFirewall.Object)
+		}
+	}
+}
+
+func TestEgressFirewallWaitUntilApplied(t *testing.T) {
+	testCases := []struct {
+		status        string
+		messages      []string
+		expectedError error
+	}{
+		{
+			status:        EgressFirewallApplied,
+			expectedError: nil,
+		},
+		{
+			status:   egressFirewallFailed,
+			messages: []string{"worker-0: invalid rule"},
+			expectedError: fmt.Errorf("EgressFirewall in namespace %s failed to apply: worker-0: invalid rule",
+				defaultEgressFirewallNamespace),
+		},
+		{
+			status:        "",
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildEgressFirewallTestClientWithDummyObject([]runtime.Object{
+			buildDummyEgressFirewall(defaultEgressFirewallNamespace, testCase.status, testCase.messages)})
+		testBuilder := buildValidEgressFirewallBuilder(testSettings)
+
+		err := testBuilder.WaitUntilApplied(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidEgressFirewallBuilder(apiClient *clients.Settings) *EgressFirewallBuilder {
+	return NewEgressFirewallBuilder(apiClient, defaultEgressFirewallNamespace)
+}
+
+func buildDummyEgressFirewall(namespace, status string, messages []string) *egtypes.EgressFirewall {
+	return &egtypes.EgressFirewall{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      EgressFirewallName,
+			Namespace: namespace,
+		},
+		Spec: egtypes.EgressFirewallSpec{
+			Egress: []egtypes.EgressFirewallRule{{
+				Type: egtypes.EgressFirewallRuleDeny,
+				To:   egtypes.EgressFirewallDestination{CIDRSelector: "0.0.0.0/0"},
+			}},
+		},
+		Status: egtypes.EgressFirewallStatus{
+			Status:   status,
+			Messages: messages,
+		},
+	}
+}
+
+func buildEgressFirewallTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{egressFirewallGVK},
+	})
+}
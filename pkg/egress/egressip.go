@@ -0,0 +1,476 @@
+package egress
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/egress/egtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// EgressIPBuilder provides struct for the EgressIP object containing connection to the cluster and the EgressIP
+// definitions.
+type EgressIPBuilder struct {
+	// EgressIP definition. Used to create the EgressIP object.
+	Definition *egtypes.EgressIP
+	// Created EgressIP object.
+	Object *egtypes.EgressIP
+	// Used in functions that define or mutate EgressIP definition. errorMsg is processed before the EgressIP object
+	// is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// nodePrimaryIfAddr represents the content of the node-primary-ifaddr annotation set by OVN-Kubernetes.
+type nodePrimaryIfAddr struct {
+	IPv4 string `json:"ipv4,omitempty"`
+	IPv6 string `json:"ipv6,omitempty"`
+}
+
+// NewEgressIPBuilder creates a new instance of EgressIPBuilder. The egress IPs are applied to the pods of the
+// namespaces matching namespaceSelector.
+func NewEgressIPBuilder(
+	apiClient *clients.Settings, name string, egressIPs []string, namespaceSelector map[string]string) *EgressIPBuilder {
+	logging.V(100).Infof(
+		"Initializing new EgressIP structure with the following params: name: %s, egressIPs: %v, namespaceSelector: %v",
+		name, egressIPs, namespaceSelector)
+
+	builder := EgressIPBuilder{
+		apiClient: apiClient,
+		Definition: &egtypes.EgressIP{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       EgressIPKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: egtypes.EgressIPSpec{
+				EgressIPs:         egressIPs,
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: namespaceSelector},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the EgressIP is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressIP 'name' cannot be empty"))
+	}
+
+	if len(egressIPs) == 0 {
+		logging.V(100).Infof("The egressIPs of the EgressIP are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressIP 'egressIPs' cannot be empty"))
+	}
+
+	for _, egressIP := range egressIPs {
+		if net.ParseIP(egressIP) == nil {
+			logging.V(100).Infof("The egressIP %s of the EgressIP is not a valid IP address", egressIP)
+
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("EgressIP 'egressIPs' contains invalid IP address %s", egressIP))
+		}
+	}
+
+	if len(namespaceSelector) == 0 {
+		logging.V(100).Infof("The namespaceSelector of the EgressIP is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressIP 'namespaceSelector' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullEgressIP pulls existing EgressIP from cluster.
+func PullEgressIP(apiClient *clients.Settings, name string) (*EgressIPBuilder, error) {
+	logging.V(100).Infof("Pulling existing EgressIP name %s from cluster", name)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("EgressIP 'apiClient' cannot be empty")
+	}
+
+	builder := EgressIPBuilder{
+		apiClient: apiClient,
+		Definition: &egtypes.EgressIP{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the EgressIP is empty")
+
+		return nil, fmt.Errorf("EgressIP 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("EgressIP object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithPodSelector restricts the EgressIP to the pods matching podSelector in the selected namespaces.
+func (builder *EgressIPBuilder) WithPodSelector(podSelector map[string]string) *EgressIPBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting podSelector %v on EgressIP %s", podSelector, builder.Definition.Name)
+
+	if len(podSelector) == 0 {
+		logging.V(100).Infof("The podSelector of the EgressIP is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressIP 'podSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.PodSelector = metav1.LabelSelector{MatchLabels: podSelector}
+
+	return builder
+}
+
+// Get returns EgressIP object if found.
+func (builder *EgressIPBuilder) Get() (*egtypes.EgressIP, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting EgressIP object %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetEgressIPGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("EgressIP object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	return convertEgressIPToStructured(unsObject)
+}
+
+// Exists checks whether the given EgressIP exists.
+func (builder *EgressIPBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if EgressIP %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an EgressIP in the cluster and stores the created object in struct. Every egress IP must belong to
+// the primary subnet of at least one node labeled with EgressAssignableLabel, otherwise OVN-Kubernetes would never
+// assign it.
+func (builder *EgressIPBuilder) Create() (*EgressIPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the EgressIP %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if err := builder.validateEgressIPsInNodeSubnets(); err != nil {
+		return builder, err
+	}
+
+	unstructuredEgressIP, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured EgressIP to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetEgressIPGVR()).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredEgressIP}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create EgressIP %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertEgressIPToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing EgressIP object with the EgressIP definition in builder.
+func (builder *EgressIPBuilder) Update() (*EgressIPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to update EgressIP, object doesn't exist on cluster")
+	}
+
+	logging.V(100).Infof("Updating the EgressIP object %s", builder.Definition.Name)
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredEgressIP, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured EgressIP to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetEgressIPGVR()).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredEgressIP}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertEgressIPToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes EgressIP object from a cluster.
+func (builder *EgressIPBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the EgressIP object %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetEgressIPGVR()).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete EgressIP: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetAssignments returns the nodes hosting the egress IPs of the EgressIP keyed by egress IP. Egress IPs that are not
+// assigned yet are not part of the result.
+func (builder *EgressIPBuilder) GetAssignments() (map[string]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting assignments of EgressIP %s", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EgressIP %s: %w", builder.Definition.Name, err)
+	}
+
+	assignments := make(map[string]string, len(builder.Object.Status.Items))
+
+	for _, item := range builder.Object.Status.Items {
+		if parsedIP := net.ParseIP(item.EgressIP); parsedIP != nil {
+			assignments[parsedIP.String()] = item.Node
+		}
+	}
+
+	return assignments, nil
+}
+
+// GetAssignedNode returns the name of the node hosting the given egress IP.
+func (builder *EgressIPBuilder) GetAssignedNode(egressIP string) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting the node hosting egress IP %s of EgressIP %s", egressIP, builder.Definition.Name)
+
+	parsedIP := net.ParseIP(egressIP)
+	if parsedIP == nil {
+		return "", fmt.Errorf("cannot get node of invalid IP address %s", egressIP)
+	}
+
+	assignments, err := builder.GetAssignments()
+	if err != nil {
+		return "", err
+	}
+
+	node, assigned := assignments[parsedIP.String()]
+	if !assigned {
+		return "", fmt.Errorf("egress IP %s of EgressIP %s is not assigned to any node",
+			egressIP, builder.Definition.Name)
+	}
+
+	return node, nil
+}
+
+// WaitUntilAssigned waits for the duration of the defined timeout or until every egress IP of the EgressIP is
+// assigned to a node.
+func (builder *EgressIPBuilder) WaitUntilAssigned(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until all egress IPs of EgressIP %s are assigned",
+		builder.Definition.Name)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			assignments, err := builder.GetAssignments()
+			if err != nil {
+				return false, nil
+			}
+
+			for _, egressIP := range builder.Definition.Spec.EgressIPs {
+				if _, assigned := assignments[net.ParseIP(egressIP).String()]; !assigned {
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+}
+
+// GetEgressIPGVR returns EgressIP's GroupVersionResource which could be used for Clean function.
+func GetEgressIPGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "egressips"}
+}
+
+// validateEgressIPsInNodeSubnets checks that every egress IP of the definition belongs to the primary subnet of an
+// egress assignable node.
+func (builder *EgressIPBuilder) validateEgressIPsInNodeSubnets() error {
+	nodeList, err := builder.apiClient.CoreV1Interface.Nodes().List(
+		context.TODO(), metav1.ListOptions{LabelSelector: EgressAssignableLabel})
+	if err != nil {
+		return fmt.Errorf("failed to list egress assignable nodes: %w", err)
+	}
+
+	if len(nodeList.Items) == 0 {
+		return fmt.Errorf("no nodes labeled with %s found to host EgressIP %s",
+			EgressAssignableLabel, builder.Definition.Name)
+	}
+
+	var subnets []*net.IPNet
+
+	for _, node := range nodeList.Items {
+		annotation, found := node.Annotations[nodePrimaryIfAddrAnnotation]
+		if !found {
+			logging.V(100).Infof("Node %s has no %s annotation", node.Name, nodePrimaryIfAddrAnnotation)
+
+			continue
+		}
+
+		ifAddr := nodePrimaryIfAddr{}
+
+		if err := json.Unmarshal([]byte(annotation), &ifAddr); err != nil {
+			logging.V(100).Infof("Failed to parse %s annotation of node %s: %v", nodePrimaryIfAddrAnnotation, node.Name, err)
+
+			continue
+		}
+
+		for _, address := range []string{ifAddr.IPv4, ifAddr.IPv6} {
+			if _, subnet, err := net.ParseCIDR(address); err == nil {
+				subnets = append(subnets, subnet)
+			}
+		}
+	}
+
+	for _, egressIP := range builder.Definition.Spec.EgressIPs {
+		if !subnetsContainIP(subnets, net.ParseIP(egressIP)) {
+			return fmt.Errorf("egress IP %s is not in the primary subnet of any node labeled with %s",
+				egressIP, EgressAssignableLabel)
+		}
+	}
+
+	return nil
+}
+
+// subnetsContainIP returns true if any of the subnets contains the IP address.
+func subnetsContainIP(subnets []*net.IPNet, ipAddress net.IP) bool {
+	for _, subnet := range subnets {
+		if subnet.Contains(ipAddress) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// convertEgressIPToStructured converts the unstructured object returned by the dynamic client to an EgressIP.
+func convertEgressIPToStructured(unsObject *unstructured.Unstructured) (*egtypes.EgressIP, error) {
+	egressIP := &egtypes.EgressIP{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, egressIP)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to EgressIP object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return egressIP, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *EgressIPBuilder) validate() (bool, error) {
+	resourceCRD := "EgressIP"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
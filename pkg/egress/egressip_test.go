@@ -0,0 +1,352 @@
+package egress
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/egress/egtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	egressIPGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    EgressIPKind,
+	}
+	defaultEgressIPName              = "egressip-test"
+	defaultEgressIPAddress           = "192.168.10.50"
+	defaultEgressIPNamespaceSelector = map[string]string{"egress": "enabled"}
+)
+
+func TestNewEgressIPBuilder(t *testing.T) {
+	testCases := []struct {
+		name              string
+		egressIPs         []string
+		namespaceSelector map[string]string
+		expectedError     string
+	}{
+		{
+			name:              defaultEgressIPName,
+			egressIPs:         []string{defaultEgressIPAddress},
+			namespaceSelector: defaultEgressIPNamespaceSelector,
+			expectedError:     "",
+		},
+		{
+			name:              "",
+			egressIPs:         []string{defaultEgressIPAddress},
+			namespaceSelector: defaultEgressIPNamespaceSelector,
+			expectedError:     "EgressIP 'name' cannot be empty",
+		},
+		{
+			name:              defaultEgressIPName,
+			egressIPs:         []string{},
+			namespaceSelector: defaultEgressIPNamespaceSelector,
+			expectedError:     "EgressIP 'egressIPs' cannot be empty",
+		},
+		{
+			name:              defaultEgressIPName,
+			egressIPs:         []string{"192.168.10"},
+			namespaceSelector: defaultEgressIPNamespaceSelector,
+			expectedError:     "EgressIP 'egressIPs' contains invalid IP address 192.168.10",
+		},
+		{
+			name:              defaultEgressIPName,
+			egressIPs:         []string{defaultEgressIPAddress},
+			namespaceSelector: map[string]string{},
+			expectedError:     "EgressIP 'namespaceSelector' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewEgressIPBuilder(testSettings, testCase.name, testCase.egressIPs, testCase.namespaceSelector)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.egressIPs, testBuilder.Definition.Spec.EgressIPs)
+			assert.Equal(t, testCase.namespaceSelector, testBuilder.Definition.Spec.NamespaceSelector.MatchLabels)
+		}
+	}
+}
+
+func TestPullEgressIP(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultEgressIPName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("EgressIP 'name' cannot be empty"),
+		},
+		{
+			name:                defaultEgressIPName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("EgressIP object %s doesn't exist", defaultEgressIPName),
+		},
+		{
+			name:                defaultEgressIPName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("EgressIP 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyEgressIP(testCase.name, nil))
+		}
+
+		if testCase.client {
+			testSettings = buildEgressIPTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullEgressIP(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestEgressIPWithPodSelector(t *testing.T) {
+	testCases := []struct {
+		podSelector   map[string]string
+		expectedError string
+	}{
+		{
+			podSelector:   map[string]string{"app": "test"},
+			expectedError: "",
+		},
+		{
+			podSelector:   map[string]string{},
+			expectedError: "EgressIP 'podSelector' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidEgressIPBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithPodSelector(testCase.podSelector)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.podSelector, testBuilder.Definition.Spec.PodSelector.MatchLabels)
+		}
+	}
+}
+
+func TestEgressIPCreate(t *testing.T) {
+	testCases := []struct {
+		egressIPs     []string
+		nodes         []runtime.Object
+		alreadyExists bool
+		expectedError error
+	}{
+		{
+			egressIPs:     []string{defaultEgressIPAddress},
+			nodes:         []runtime.Object{buildDummyEgressNode("worker-0", true, "192.168.10.10/24")},
+			alreadyExists: false,
+			expectedError: nil,
+		},
+		{
+			egressIPs:     []string{defaultEgressIPAddress},
+			nodes:         []runtime.Object{buildDummyEgressNode("worker-0", true, "192.168.10.10/24")},
+			alreadyExists: true,
+			expectedError: nil,
+		},
+		{
+			egressIPs:     []string{"10.0.0.50"},
+			nodes:         []runtime.Object{buildDummyEgressNode("worker-0", true, "192.168.10.10/24")},
+			alreadyExists: false,
+			expectedError: fmt.Errorf(
+				"egress IP 10.0.0.50 is not in the primary subnet of any node labeled with %s", EgressAssignableLabel),
+		},
+		{
+			egressIPs:     []string{defaultEgressIPAddress},
+			nodes:         []runtime.Object{buildDummyEgressNode("worker-0", false, "192.168.10.10/24")},
+			alreadyExists: false,
+			expectedError: fmt.Errorf(
+				"no nodes labeled with %s found to host EgressIP %s", EgressAssignableLabel, defaultEgressIPName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		runtimeObjects := testCase.nodes
+
+		if testCase.alreadyExists {
+			runtimeObjects = append(runtimeObjects, buildDummyEgressIP(defaultEgressIPName, nil))
+		}
+
+		testSettings := buildEgressIPTestClientWithDummyObject(runtimeObjects)
+		testBuilder := NewEgressIPBuilder(
+			testSettings, defaultEgressIPName, testCase.egressIPs, defaultEgressIPNamespaceSelector)
+
+		testBuilder, err := testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultEgressIPName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestEgressIPDelete(t *testing.T) {
+	testCases := []struct {
+		testEgressIP  *EgressIPBuilder
+		expectedError error
+	}{
+		{
+			testEgressIP:  buildValidEgressIPBuilder(buildEgressIPTestClientWithDummyObject(buildDummyEgressIPObjects())),
+			expectedError: nil,
+		},
+		{
+			testEgressIP:  buildValidEgressIPBuilder(buildEgressIPTestClientWithDummyObject(nil)),
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testEgressIP.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testEgressIP.Object)
+		}
+	}
+}
+
+func TestEgressIPGetAssignedNode(t *testing.T) {
+	testCases := []struct {
+		egressIP      string
+		statusItems   []egtypes.EgressIPStatusItem
+		expectedNode  string
+		expectedError error
+	}{
+		{
+			egressIP:      defaultEgressIPAddress,
+			statusItems:   []egtypes.EgressIPStatusItem{{Node: "worker-0", EgressIP: defaultEgressIPAddress}},
+			expectedNode:  "worker-0",
+			expectedError: nil,
+		},
+		{
+			egressIP:    defaultEgressIPAddress,
+			statusItems: nil,
+			expectedError: fmt.Errorf("egress IP %s of EgressIP %s is not assigned to any node",
+				defaultEgressIPAddress, defaultEgressIPName),
+		},
+		{
+			egressIP:      "192.168.10",
+			statusItems:   nil,
+			expectedError: fmt.Errorf("cannot get node of invalid IP address 192.168.10"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildEgressIPTestClientWithDummyObject(
+			[]runtime.Object{buildDummyEgressIP(defaultEgressIPName, testCase.statusItems)})
+		testBuilder := buildValidEgressIPBuilder(testSettings)
+
+		node, err := testBuilder.GetAssignedNode(testCase.egressIP)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedNode, node)
+	}
+}
+
+func TestEgressIPWaitUntilAssigned(t *testing.T) {
+	testCases := []struct {
+		statusItems   []egtypes.EgressIPStatusItem
+		expectedError error
+	}{
+		{
+			statusItems:   []egtypes.EgressIPStatusItem{{Node: "worker-0", EgressIP: defaultEgressIPAddress}},
+			expectedError: nil,
+		},
+		{
+			statusItems:   nil,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildEgressIPTestClientWithDummyObject(
+			[]runtime.Object{buildDummyEgressIP(defaultEgressIPName, testCase.statusItems)})
+		testBuilder := buildValidEgressIPBuilder(testSettings)
+
+		err := testBuilder.WaitUntilAssigned(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidEgressIPBuilder(apiClient *clients.Settings) *EgressIPBuilder {
+	return NewEgressIPBuilder(
+		apiClient, defaultEgressIPName, []string{defaultEgressIPAddress}, defaultEgressIPNamespaceSelector)
+}
+
+func buildDummyEgressIP(name string, statusItems []egtypes.EgressIPStatusItem) *egtypes.EgressIP {
+	return &egtypes.EgressIP{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: egtypes.EgressIPSpec{
+			EgressIPs:         []string{defaultEgressIPAddress},
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: defaultEgressIPNamespaceSelector},
+		},
+		Status: egtypes.EgressIPStatus{
+			Items: statusItems,
+		},
+	}
+}
+
+func buildDummyEgressIPObjects() []runtime.Object {
+	return []runtime.Object{buildDummyEgressIP(defaultEgressIPName, nil)}
+}
+
+func buildDummyEgressNode(name string, assignable bool, primaryIfAddr string) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      map[string]string{},
+			Annotations: map[string]string{nodePrimaryIfAddrAnnotation: fmt.Sprintf(`{"ipv4":"%s"}`, primaryIfAddr)},
+		},
+	}
+
+	if assignable {
+		node.Labels[EgressAssignableLabel] = ""
+	}
+
+	return node
+}
+
+func buildEgressIPTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{egressIPGVK},
+	})
+}
@@ -0,0 +1,372 @@
+package egress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/egress/egtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// EgressServiceBuilder provides struct for the EgressService object containing connection to the cluster and the
+// EgressService definitions.
+type EgressServiceBuilder struct {
+	// EgressService definition. Used to create the EgressService object.
+	Definition *egtypes.EgressService
+	// Created EgressService object.
+	Object *egtypes.EgressService
+	// Used in functions that define or mutate EgressService definition. errorMsg is processed before the
+	// EgressService object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewEgressServiceBuilder creates a new instance of EgressServiceBuilder. The EgressService must have the same name
+// and namespace as the LoadBalancer service it applies to.
+func NewEgressServiceBuilder(apiClient *clients.Settings, name, nsname string) *EgressServiceBuilder {
+	logging.V(100).Infof(
+		"Initializing new EgressService structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := EgressServiceBuilder{
+		apiClient: apiClient,
+		Definition: &egtypes.EgressService{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       EgressServiceKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the EgressService is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressService 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the EgressService is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressService 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullEgressService pulls existing EgressService from cluster.
+func PullEgressService(apiClient *clients.Settings, name, nsname string) (*EgressServiceBuilder, error) {
+	logging.V(100).Infof("Pulling existing EgressService name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("EgressService 'apiClient' cannot be empty")
+	}
+
+	builder := EgressServiceBuilder{
+		apiClient: apiClient,
+		Definition: &egtypes.EgressService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the EgressService is empty")
+
+		return nil, fmt.Errorf("EgressService 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the EgressService is empty")
+
+		return nil, fmt.Errorf("EgressService 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("EgressService object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithSourceIPBy sets the source IP the egress traffic of the service leaves the cluster with.
+func (builder *EgressServiceBuilder) WithSourceIPBy(sourceIPBy egtypes.SourceIPMode) *EgressServiceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting sourceIPBy %s on EgressService %s in namespace %s",
+		sourceIPBy, builder.Definition.Name, builder.Definition.Namespace)
+
+	if sourceIPBy != egtypes.SourceIPLoadBalancer && sourceIPBy != egtypes.SourceIPNetwork {
+		logging.V(100).Infof("The sourceIPBy %s of the EgressService is not supported", sourceIPBy)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"EgressService 'sourceIPBy' must be %s or %s, got %s",
+			egtypes.SourceIPLoadBalancer, egtypes.SourceIPNetwork, sourceIPBy))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SourceIPBy = sourceIPBy
+
+	return builder
+}
+
+// WithNodeSelector limits the nodes that can be selected to handle the traffic of the service.
+func (builder *EgressServiceBuilder) WithNodeSelector(nodeSelector map[string]string) *EgressServiceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting nodeSelector %v on EgressService %s in namespace %s",
+		nodeSelector, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(nodeSelector) == 0 {
+		logging.V(100).Infof("The nodeSelector of the EgressService is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressService 'nodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = metav1.LabelSelector{MatchLabels: nodeSelector}
+
+	return builder
+}
+
+// WithNetwork sets the network, typically a routing table id or name, the egress traffic of the service is sent to.
+func (builder *EgressServiceBuilder) WithNetwork(network string) *EgressServiceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting network %s on EgressService %s in namespace %s",
+		network, builder.Definition.Name, builder.Definition.Namespace)
+
+	if network == "" {
+		logging.V(100).Infof("The network of the EgressService is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("EgressService 'network' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Network = network
+
+	return builder
+}
+
+// Get returns EgressService object if found.
+func (builder *EgressServiceBuilder) Get() (*egtypes.EgressService, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting EgressService object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetEgressServiceGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("EgressService object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertEgressServiceToStructured(unsObject)
+}
+
+// Exists checks whether the given EgressService exists.
+func (builder *EgressServiceBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if EgressService %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an EgressService in the cluster and stores the created object in struct.
+func (builder *EgressServiceBuilder) Create() (*EgressServiceBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the EgressService %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredEgressService, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured EgressService to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetEgressServiceGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredEgressService}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create EgressService %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertEgressServiceToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes EgressService object from a cluster.
+func (builder *EgressServiceBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the EgressService object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetEgressServiceGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete EgressService: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetHost returns the name of the node handling the traffic of the service. The host is "ALL" when sourceIPBy is
+// Network and no nodeSelector is set.
+func (builder *EgressServiceBuilder) GetHost() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting host of EgressService %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get EgressService %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	if builder.Object.Status.Host == "" {
+		return "", fmt.Errorf("EgressService %s in namespace %s is not assigned to any host",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Host, nil
+}
+
+// WaitUntilAssigned waits for the duration of the defined timeout or until the EgressService is assigned to a host.
+func (builder *EgressServiceBuilder) WaitUntilAssigned(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until EgressService %s in namespace %s is assigned",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.GetHost()
+
+			return err == nil, nil
+		})
+}
+
+// GetEgressServiceGVR returns EgressService's GroupVersionResource which could be used for Clean function.
+func GetEgressServiceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "egressservices"}
+}
+
+// convertEgressServiceToStructured converts the unstructured object returned by the dynamic client to an
+// EgressService.
+func convertEgressServiceToStructured(unsObject *unstructured.Unstructured) (*egtypes.EgressService, error) {
+	egressService := &egtypes.EgressService{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, egressService)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to EgressService object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return egressService, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *EgressServiceBuilder) validate() (bool, error) {
+	resourceCRD := "EgressService"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,343 @@
+package egress
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/egress/egtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	egressServiceGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    EgressServiceKind,
+	}
+	defaultEgressServiceName      = "egressservice-test"
+	defaultEgressServiceNamespace = "egress-test"
+)
+
+func TestNewEgressServiceBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultEgressServiceName,
+			namespace:     defaultEgressServiceNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultEgressServiceNamespace,
+			expectedError: "EgressService 'name' cannot be empty",
+		},
+		{
+			name:          defaultEgressServiceName,
+			namespace:     "",
+			expectedError: "EgressService 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewEgressServiceBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullEgressService(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultEgressServiceName,
+			namespace:           defaultEgressServiceNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			namespace:           defaultEgressServiceNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("EgressService 'name' cannot be empty"),
+		},
+		{
+			name:                defaultEgressServiceName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("EgressService 'namespace' cannot be empty"),
+		},
+		{
+			name:                defaultEgressServiceName,
+			namespace:           defaultEgressServiceNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("EgressService object %s doesn't exist in namespace %s",
+				defaultEgressServiceName, defaultEgressServiceNamespace),
+		},
+		{
+			name:                defaultEgressServiceName,
+			namespace:           defaultEgressServiceNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("EgressService 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyEgressService(testCase.name, testCase.namespace, ""))
+		}
+
+		if testCase.client {
+			testSettings = buildEgressServiceTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullEgressService(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestEgressServiceWithSourceIPBy(t *testing.T) {
+	testCases := []struct {
+		sourceIPBy    egtypes.SourceIPMode
+		expectedError string
+	}{
+		{
+			sourceIPBy:    egtypes.SourceIPLoadBalancer,
+			expectedError: "",
+		},
+		{
+			sourceIPBy:    egtypes.SourceIPNetwork,
+			expectedError: "",
+		},
+		{
+			sourceIPBy:    "NodeIP",
+			expectedError: "EgressService 'sourceIPBy' must be LoadBalancerIP or Network, got NodeIP",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidEgressServiceBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithSourceIPBy(testCase.sourceIPBy)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.sourceIPBy, testBuilder.Definition.Spec.SourceIPBy)
+		}
+	}
+}
+
+func TestEgressServiceWithNodeSelector(t *testing.T) {
+	testCases := []struct {
+		nodeSelector  map[string]string
+		expectedError string
+	}{
+		{
+			nodeSelector:  map[string]string{"node-role.kubernetes.io/worker": ""},
+			expectedError: "",
+		},
+		{
+			nodeSelector:  map[string]string{},
+			expectedError: "EgressService 'nodeSelector' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidEgressServiceBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithNodeSelector(testCase.nodeSelector)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Spec.NodeSelector.MatchLabels)
+		}
+	}
+}
+
+func TestEgressServiceWithNetwork(t *testing.T) {
+	testCases := []struct {
+		network       string
+		expectedError string
+	}{
+		{
+			network:       "100",
+			expectedError: "",
+		},
+		{
+			network:       "",
+			expectedError: "EgressService 'network' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidEgressServiceBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithNetwork(testCase.network)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.network, testBuilder.Definition.Spec.Network)
+		}
+	}
+}
+
+func TestEgressServiceCreate(t *testing.T) {
+	testCases := []struct {
+		testEgressService *EgressServiceBuilder
+		expectedError     error
+	}{
+		{
+			testEgressService: buildValidEgressServiceBuilder(buildEgressServiceTestClientWithDummyObject(nil)),
+			expectedError:     nil,
+		},
+		{
+			testEgressService: buildValidEgressServiceBuilder(buildEgressServiceTestClientWithDummyObject(
+				[]runtime.Object{buildDummyEgressService(defaultEgressServiceName, defaultEgressServiceNamespace, "")})),
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testEgressService.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultEgressServiceName, testBuilder.Object.Name)
+			assert.Equal(t, defaultEgressServiceNamespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestEgressServiceDelete(t *testing.T) {
+	testCases := []struct {
+		testEgressService *EgressServiceBuilder
+		expectedError     error
+	}{
+		{
+			testEgressService: buildValidEgressServiceBuilder(buildEgressServiceTestClientWithDummyObject(
+				[]runtime.Object{buildDummyEgressService(defaultEgressServiceName, defaultEgressServiceNamespace, "")})),
+			expectedError: nil,
+		},
+		{
+			testEgressService: buildValidEgressServiceBuilder(buildEgressServiceTestClientWithDummyObject(nil)),
+			expectedError:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testEgressService.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testEgressService.Object)
+		}
+	}
+}
+
+func TestEgressServiceGetHost(t *testing.T) {
+	testCases := []struct {
+		host          string
+		expectedError error
+	}{
+		{
+			host:          "worker-0",
+			expectedError: nil,
+		},
+		{
+			host: "",
+			expectedError: fmt.Errorf("EgressService %s in namespace %s is not assigned to any host",
+				defaultEgressServiceName, defaultEgressServiceNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildEgressServiceTestClientWithDummyObject([]runtime.Object{
+			buildDummyEgressService(defaultEgressServiceName, defaultEgressServiceNamespace, testCase.host)})
+		testBuilder := buildValidEgressServiceBuilder(testSettings)
+
+		host, err := testBuilder.GetHost()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.host, host)
+	}
+}
+
+func TestEgressServiceWaitUntilAssigned(t *testing.T) {
+	testCases := []struct {
+		host          string
+		expectedError error
+	}{
+		{
+			host:          "worker-0",
+			expectedError: nil,
+		},
+		{
+			host:          "",
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildEgressServiceTestClientWithDummyObject([]runtime.Object{
+			buildDummyEgressService(defaultEgressServiceName, defaultEgressServiceNamespace, testCase.host)})
+		testBuilder := buildValidEgressServiceBuilder(testSettings)
+
+		err := testBuilder.WaitUntilAssigned(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidEgressServiceBuilder(apiClient *clients.Settings) *EgressServiceBuilder {
+	return NewEgressServiceBuilder(apiClient, defaultEgressServiceName, defaultEgressServiceNamespace)
+}
+
+func buildDummyEgressService(name, namespace, host string) *egtypes.EgressService {
+	return &egtypes.EgressService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: egtypes.EgressServiceSpec{
+			SourceIPBy: egtypes.SourceIPLoadBalancer,
+		},
+		Status: egtypes.EgressServiceStatus{
+			Host: host,
+		},
+	}
+}
+
+func buildEgressServiceTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{egressServiceGVK},
+	})
+}
@@ -0,0 +1,135 @@
+package egtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EgressFirewallRuleType indicates whether an EgressFirewallRule allows or denies traffic.
+type EgressFirewallRuleType string
+
+const (
+	// EgressFirewallRuleAllow allows the traffic matching the rule.
+	EgressFirewallRuleAllow EgressFirewallRuleType = "Allow"
+	// EgressFirewallRuleDeny denies the traffic matching the rule.
+	EgressFirewallRuleDeny EgressFirewallRuleType = "Deny"
+)
+
+// EgressFirewallSpec is a desired state description of EgressFirewall.
+type EgressFirewallSpec struct {
+	// a collection of egress firewall rule objects
+	Egress []EgressFirewallRule `json:"egress"`
+}
+
+// EgressFirewallRule is a single egressfirewall rule object.
+type EgressFirewallRule struct {
+	// type marks this as an "Allow" or "Deny" rule
+	Type EgressFirewallRuleType `json:"type"`
+	// ports specify what ports and protocols the rule applies to
+	// +optional
+	Ports []EgressFirewallPort `json:"ports,omitempty"`
+	// to is the target that traffic is allowed/denied to
+	To EgressFirewallDestination `json:"to"`
+}
+
+// EgressFirewallPort is the port and protocol pair of an EgressFirewallRule.
+type EgressFirewallPort struct {
+	// protocol (tcp, udp, sctp) that the traffic must match.
+	Protocol string `json:"protocol"`
+	// port that the traffic must match
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// EgressFirewallDestination is the endpoint that traffic is either allowed or denied to.
+type EgressFirewallDestination struct {
+	// cidrSelector is the CIDR range to allow/deny traffic to. If this is set, dnsName and nodeSelector must be unset.
+	CIDRSelector string `json:"cidrSelector,omitempty"`
+	// dnsName is the domain name to allow/deny traffic to. If this is set, cidrSelector and nodeSelector must be unset.
+	DNSName string `json:"dnsName,omitempty"`
+	// nodeSelector will allow/deny traffic to the Kubernetes node IP of selected nodes. If this is set,
+	// cidrSelector and DNSName must be unset.
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// EgressFirewallStatus is the status of the EgressFirewall.
+type EgressFirewallStatus struct {
+	// +optional
+	Status string `json:"status,omitempty"`
+	// +optional
+	Messages []string `json:"messages,omitempty"`
+}
+
+// EgressFirewall describes the current egress firewall for a Namespace.
+// Traffic from a pod to an IP address outside the cluster will be checked against
+// each EgressFirewallRule in the pod's namespace's EgressFirewall, in
+// order. If no rule matches (or no EgressFirewall is present) then the traffic
+// will be allowed by default.
+type EgressFirewall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of EgressFirewall.
+	Spec EgressFirewallSpec `json:"spec"`
+	// Observed status of EgressFirewall
+	// +optional
+	Status EgressFirewallStatus `json:"status,omitempty"`
+}
+
+// EgressFirewallList is the list of EgressFirewalls.
+type EgressFirewallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of EgressFirewalls.
+	Items []EgressFirewall `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFirewall.
+func (in *EgressFirewall) DeepCopy() *EgressFirewall {
+	if in == nil {
+		return nil
+	}
+
+	out := new(EgressFirewall)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.Egress != nil {
+		out.Spec.Egress = make([]EgressFirewallRule, len(in.Spec.Egress))
+
+		for index, rule := range in.Spec.Egress {
+			out.Spec.Egress[index] = EgressFirewallRule{
+				Type: rule.Type,
+				To: EgressFirewallDestination{
+					CIDRSelector: rule.To.CIDRSelector,
+					DNSName:      rule.To.DNSName,
+					NodeSelector: rule.To.NodeSelector.DeepCopy(),
+				},
+			}
+
+			if rule.Ports != nil {
+				out.Spec.Egress[index].Ports = make([]EgressFirewallPort, len(rule.Ports))
+				copy(out.Spec.Egress[index].Ports, rule.Ports)
+			}
+		}
+	}
+
+	out.Status.Status = in.Status.Status
+
+	if in.Status.Messages != nil {
+		out.Status.Messages = make([]string, len(in.Status.Messages))
+		copy(out.Status.Messages, in.Status.Messages)
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressFirewall) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package egtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EgressIPSpec is a desired state description of EgressIP.
+type EgressIPSpec struct {
+	// EgressIPs is the list of egress IP addresses requested. Can be IPv4 and/or IPv6.
+	// This field is mandatory.
+	EgressIPs []string `json:"egressIPs"`
+	// NamespaceSelector applies the egress IP only to the namespace(s) whose label
+	// matches this definition. This field is mandatory.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// PodSelector applies the egress IP only to the pods whose label
+	// matches this definition. This field is optional, and in case it is not set:
+	// results in the egress IP being applied to all pods in the namespace(s)
+	// matched by the NamespaceSelector. In case it is set: is intersected with
+	// the NamespaceSelector, thus applying the egress IP to the pods
+	// (in the namespace(s) already matched by the NamespaceSelector) which
+	// match this pod selector.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// EgressIPStatus is the status of the EgressIP assignments.
+type EgressIPStatus struct {
+	// The list of assigned egress IPs and their corresponding node assignment.
+	Items []EgressIPStatusItem `json:"items"`
+}
+
+// EgressIPStatusItem is the per node EgressIP status, for those egress IPs who have been assigned.
+type EgressIPStatusItem struct {
+	// Assigned node name
+	Node string `json:"node"`
+	// Assigned egress IP
+	EgressIP string `json:"egressIP"`
+}
+
+// EgressIP is a CRD allowing the user to define a fixed source IP for all egress traffic originating from any
+// pods which match the EgressIP resource according to its spec definition.
+type EgressIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of EgressIP.
+	Spec EgressIPSpec `json:"spec"`
+	// Observed status of EgressIP. Read-only.
+	// +optional
+	Status EgressIPStatus `json:"status,omitempty"`
+}
+
+// EgressIPList is the list of EgressIPList.
+type EgressIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of EgressIP.
+	Items []EgressIP `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIP.
+func (in *EgressIP) DeepCopy() *EgressIP {
+	if in == nil {
+		return nil
+	}
+
+	out := new(EgressIP)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.EgressIPs != nil {
+		out.Spec.EgressIPs = make([]string, len(in.Spec.EgressIPs))
+		copy(out.Spec.EgressIPs, in.Spec.EgressIPs)
+	}
+
+	in.Spec.NamespaceSelector.DeepCopyInto(&out.Spec.NamespaceSelector)
+	in.Spec.PodSelector.DeepCopyInto(&out.Spec.PodSelector)
+
+	if in.Status.Items != nil {
+		out.Status.Items = make([]EgressIPStatusItem, len(in.Status.Items))
+		copy(out.Status.Items, in.Status.Items)
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressIP) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package egtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SourceIPMode represents the source IP the traffic of an EgressService leaves the cluster with.
+type SourceIPMode string
+
+const (
+	// SourceIPLoadBalancer sets the source IP of the egress traffic to the ingress IP of the LoadBalancer service.
+	SourceIPLoadBalancer SourceIPMode = "LoadBalancerIP"
+	// SourceIPNetwork keeps the source IP of the egress traffic as the IP of the interface of the network it
+	// leaves through.
+	SourceIPNetwork SourceIPMode = "Network"
+)
+
+// EgressServiceSpec defines the desired state of EgressService.
+type EgressServiceSpec struct {
+	// Determines the source IP of egress traffic originating from the pods backing the LoadBalancer Service.
+	// When `LoadBalancerIP` the source IP is set to its LoadBalancer ingress IP.
+	// When `Network` the source IP is set according to the interface of the Network,
+	// leveraging the masquerade rules that are already in place.
+	// Typically these rules specify SNAT to the IP of the outgoing interface,
+	// which means the packet will typically leave with the IP of the node.
+	SourceIPBy SourceIPMode `json:"sourceIPBy,omitempty"`
+
+	// Allows limiting the nodes that can be selected to handle the service's traffic when sourceIPBy=LoadBalancerIP.
+	// When present only a node whose labels match the specified selectors can be selected
+	// for handling the service's traffic.
+	// When it is not specified any node in the cluster can be chosen to manage the service's traffic.
+	// +optional
+	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// The network which this service should send egress and corresponding ingress replies to.
+	// This is typically implemented as VRF mapping, representing a numeric id or string name
+	// of a routing table which by omission uses the default host routing.
+	// +optional
+	Network string `json:"network,omitempty"`
+}
+
+// EgressServiceStatus defines the observed state of EgressService.
+type EgressServiceStatus struct {
+	// The name of the node selected to handle the service's traffic.
+	// In case sourceIPBy=Network the field will be set to "ALL".
+	Host string `json:"host"`
+}
+
+// EgressService is a CRD that allows the user to request that the source
+// IP of egress packets originating from all of the pods that are endpoints
+// of the corresponding LoadBalancer Service would be its ingress IP.
+// In addition, it allows the user to request that egress packets originating from
+// all of the pods that are endpoints of the LoadBalancer service would use a different
+// network than the main one.
+type EgressService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EgressServiceSpec   `json:"spec,omitempty"`
+	Status EgressServiceStatus `json:"status,omitempty"`
+}
+
+// EgressServiceList contains a list of EgressServices.
+type EgressServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []EgressService `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressService.
+func (in *EgressService) DeepCopy() *EgressService {
+	if in == nil {
+		return nil
+	}
+
+	out := new(EgressService)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.SourceIPBy = in.Spec.SourceIPBy
+	in.Spec.NodeSelector.DeepCopyInto(&out.Spec.NodeSelector)
+	out.Spec.Network = in.Spec.Network
+	out.Status = in.Status
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressService) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
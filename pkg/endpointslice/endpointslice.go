@@ -0,0 +1,317 @@
+package endpointslice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ManagedByLabelValue is the endpointslice.kubernetes.io/managed-by value set on slices created by the builder.
+	// A value different from the one used by kube-controller-manager keeps the slice from being reconciled away.
+	ManagedByLabelValue = "eco-goinfra"
+)
+
+// Builder provides struct for the EndpointSlice object.
+type Builder struct {
+	// EndpointSlice definition. Used to create the endpointSlice object with minimum set of required elements.
+	Definition *discoveryv1.EndpointSlice
+	// Created endpointSlice object on the cluster.
+	Object *discoveryv1.EndpointSlice
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// errorMsg is processed before the endpointSlice object is created.
+	errorMsg error
+}
+
+// NewBuilder creates a new instance of Builder for an EndpointSlice backing the given service. Services without a
+// selector, headless ones included, use such slices to point at custom endpoints.
+func NewBuilder(
+	apiClient *clients.Settings, name, nsname, serviceName string, addressType discoveryv1.AddressType) *Builder {
+	logging.V(100).Infof(
+		"Initializing new endpointSlice structure with the following params: name: %s, namespace: %s, "+
+			"serviceName: %s, addressType: %s", name, nsname, serviceName, addressType)
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+				Labels: map[string]string{
+					discoveryv1.LabelServiceName: serviceName,
+					discoveryv1.LabelManagedBy:   ManagedByLabelValue,
+				},
+			},
+			AddressType: addressType,
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the endpointSlice is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("endpointSlice 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the endpointSlice is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("endpointSlice 'namespace' cannot be empty"))
+	}
+
+	if serviceName == "" {
+		logging.V(100).Infof("The serviceName of the endpointSlice is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("endpointSlice 'serviceName' cannot be empty"))
+	}
+
+	switch addressType {
+	case discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6, discoveryv1.AddressTypeFQDN:
+	default:
+		logging.V(100).Infof("The addressType %s of the endpointSlice is not supported", addressType)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"endpointSlice 'addressType' %s is not supported, must be %s, %s or %s", addressType,
+			discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6, discoveryv1.AddressTypeFQDN))
+	}
+
+	return builder
+}
+
+// Pull loads an existing endpointSlice into the Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing endpointSlice name: %s namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient of the endpointSlice is nil")
+
+		return nil, fmt.Errorf("endpointSlice 'apiClient' cannot be empty")
+	}
+
+	builder := &Builder{
+		apiClient: apiClient,
+		Definition: &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the endpointSlice is empty")
+
+		return nil, fmt.Errorf("endpointSlice 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the endpointSlice is empty")
+
+		return nil, fmt.Errorf("endpointSlice 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("endpointSlice object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// WithEndpoint appends an endpoint with the given addresses and readiness. A ready endpoint is also serving and
+// not terminating, use WithEndpointConditions to describe any other state.
+func (builder *Builder) WithEndpoint(ready bool, addresses ...string) *Builder {
+	serving := ready
+	terminating := false
+
+	return builder.WithEndpointConditions(discoveryv1.EndpointConditions{
+		Ready:       &ready,
+		Serving:     &serving,
+		Terminating: &terminating,
+	}, addresses...)
+}
+
+// WithEndpointConditions appends an endpoint with the given addresses and explicit conditions.
+func (builder *Builder) WithEndpointConditions(
+	conditions discoveryv1.EndpointConditions, addresses ...string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding endpoint %v to endpointSlice %s in namespace %s",
+		addresses, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(addresses) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("endpointSlice endpoint 'addresses' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Endpoints = append(builder.Definition.Endpoints, discoveryv1.Endpoint{
+		Addresses:  addresses,
+		Conditions: conditions,
+	})
+
+	return builder
+}
+
+// WithPort appends a port exposed by every endpoint of the slice. The name must match the service port name when
+// the service defines more than one port.
+func (builder *Builder) WithPort(name string, port int32, protocol corev1.Protocol) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding port %s %d/%s to endpointSlice %s in namespace %s",
+		name, port, protocol, builder.Definition.Name, builder.Definition.Namespace)
+
+	if port < 1 || port > 65535 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("endpointSlice port %d is out of range", port))
+
+		return builder
+	}
+
+	builder.Definition.Ports = append(builder.Definition.Ports, discoveryv1.EndpointPort{
+		Name:     &name,
+		Port:     &port,
+		Protocol: &protocol,
+	})
+
+	return builder
+}
+
+// Get returns the endpointSlice object if found.
+func (builder *Builder) Get() (*discoveryv1.EndpointSlice, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting endpointSlice object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.apiClient.K8sClient.DiscoveryV1().EndpointSlices(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+}
+
+// Exists checks whether the given endpointSlice exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if endpointSlice %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an endpointSlice in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the endpointSlice %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.K8sClient.DiscoveryV1().EndpointSlices(
+			builder.Definition.Namespace).Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing endpointSlice object with the endpointSlice definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating endpointSlice %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("endpointSlice %s in namespace %s cannot be updated because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.DiscoveryV1().EndpointSlices(
+		builder.Definition.Namespace).Update(context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the endpointSlice object from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the endpointSlice %s from namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.K8sClient.DiscoveryV1().EndpointSlices(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot delete endpointSlice: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "EndpointSlice"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
@@ -0,0 +1,247 @@
+package endpointslice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultEndpointSliceName      = "endpointslice-test"
+	defaultEndpointSliceNamespace = "endpointslice-namespace"
+	defaultServiceName            = "service-test"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		serviceName   string
+		addressType   discoveryv1.AddressType
+		expectedError string
+	}{
+		{
+			name:          defaultEndpointSliceName,
+			namespace:     defaultEndpointSliceNamespace,
+			serviceName:   defaultServiceName,
+			addressType:   discoveryv1.AddressTypeIPv4,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultEndpointSliceNamespace,
+			serviceName:   defaultServiceName,
+			addressType:   discoveryv1.AddressTypeIPv4,
+			expectedError: "endpointSlice 'name' cannot be empty",
+		},
+		{
+			name:          defaultEndpointSliceName,
+			namespace:     "",
+			serviceName:   defaultServiceName,
+			addressType:   discoveryv1.AddressTypeIPv4,
+			expectedError: "endpointSlice 'namespace' cannot be empty",
+		},
+		{
+			name:          defaultEndpointSliceName,
+			namespace:     defaultEndpointSliceNamespace,
+			serviceName:   "",
+			addressType:   discoveryv1.AddressTypeIPv4,
+			expectedError: "endpointSlice 'serviceName' cannot be empty",
+		},
+		{
+			name:          defaultEndpointSliceName,
+			namespace:     defaultEndpointSliceNamespace,
+			serviceName:   defaultServiceName,
+			addressType:   "IP",
+			expectedError: "endpointSlice 'addressType' IP is not supported, must be IPv4, IPv6 or FQDN",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewBuilder(
+			testSettings, testCase.name, testCase.namespace, testCase.serviceName, testCase.addressType)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.serviceName, testBuilder.Definition.Labels[discoveryv1.LabelServiceName])
+			assert.Equal(t, ManagedByLabelValue, testBuilder.Definition.Labels[discoveryv1.LabelManagedBy])
+		}
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultEndpointSliceName,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			expectedError:       fmt.Errorf("endpointSlice 'name' cannot be empty"),
+		},
+		{
+			name:                defaultEndpointSliceName,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf("endpointSlice object %s doesn't exist in namespace %s",
+				defaultEndpointSliceName, defaultEndpointSliceNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyEndpointSlice(defaultEndpointSliceName, defaultServiceName, nil))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := Pull(testSettings, testCase.name, defaultEndpointSliceNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestWithEndpoint(t *testing.T) {
+	testCases := []struct {
+		ready         bool
+		addresses     []string
+		expectedError string
+	}{
+		{
+			ready:         true,
+			addresses:     []string{"192.0.2.10"},
+			expectedError: "",
+		},
+		{
+			ready:         false,
+			addresses:     []string{"192.0.2.11"},
+			expectedError: "",
+		},
+		{
+			ready:         true,
+			addresses:     nil,
+			expectedError: "endpointSlice endpoint 'addresses' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidEndpointSliceBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithEndpoint(testCase.ready, testCase.addresses...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.addresses, testBuilder.Definition.Endpoints[0].Addresses)
+			assert.Equal(t, testCase.ready, *testBuilder.Definition.Endpoints[0].Conditions.Ready)
+			assert.Equal(t, testCase.ready, *testBuilder.Definition.Endpoints[0].Conditions.Serving)
+			assert.False(t, *testBuilder.Definition.Endpoints[0].Conditions.Terminating)
+		}
+	}
+}
+
+func TestWithPort(t *testing.T) {
+	testCases := []struct {
+		port          int32
+		expectedError string
+	}{
+		{
+			port:          8080,
+			expectedError: "",
+		},
+		{
+			port:          0,
+			expectedError: "endpointSlice port 0 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidEndpointSliceBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithPort("http", testCase.port, corev1.ProtocolTCP)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.port, *testBuilder.Definition.Ports[0].Port)
+			assert.Equal(t, "http", *testBuilder.Definition.Ports[0].Name)
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	testBuilder := buildValidEndpointSliceBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithEndpoint(true, "192.0.2.10").
+		WithPort("http", 8080, corev1.ProtocolTCP)
+
+	testBuilder, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.Equal(t, defaultEndpointSliceName, testBuilder.Object.Name)
+	assert.True(t, testBuilder.Exists())
+}
+
+func TestUpdate(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		buildDummyEndpointSlice(defaultEndpointSliceName, defaultServiceName, nil)}})
+	testBuilder := buildValidEndpointSliceBuilder(testSettings).WithEndpoint(true, "192.0.2.10")
+
+	testBuilder, err := testBuilder.Update()
+	assert.Nil(t, err)
+	assert.Len(t, testBuilder.Object.Endpoints, 1)
+}
+
+func TestDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyEndpointSlice(defaultEndpointSliceName, defaultServiceName, nil))
+		}
+
+		testBuilder := buildValidEndpointSliceBuilder(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}))
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+		assert.False(t, testBuilder.Exists())
+	}
+}
+
+func buildValidEndpointSliceBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultEndpointSliceName, defaultEndpointSliceNamespace,
+		defaultServiceName, discoveryv1.AddressTypeIPv4)
+}
+
+func buildDummyEndpointSlice(name, serviceName string, endpoints []discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultEndpointSliceNamespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: serviceName},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints:   endpoints,
+	}
+}
@@ -0,0 +1,128 @@
+package endpointslice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceEndpoints aggregates the endpoint addresses of every EndpointSlice of a service by condition. An address
+// may appear in more than one list, a terminating endpoint can still be serving for example.
+type ServiceEndpoints struct {
+	Ready       []string
+	Serving     []string
+	Terminating []string
+}
+
+// ListByService returns the endpointSlices backing the given service.
+func ListByService(apiClient *clients.Settings, serviceName, nsname string) ([]*Builder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("endpointSlice 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list endpointSlices, 'apiClient' parameter is empty")
+	}
+
+	if serviceName == "" {
+		logging.V(100).Infof("endpointSlice 'serviceName' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list endpointSlices, 'serviceName' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("endpointSlice 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list endpointSlices, 'nsname' parameter is empty")
+	}
+
+	logging.V(100).Infof("Listing endpointSlices of service %s in namespace %s", serviceName, nsname)
+
+	endpointSliceList, err := apiClient.K8sClient.DiscoveryV1().EndpointSlices(nsname).List(
+		context.TODO(), metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, serviceName),
+		})
+	if err != nil {
+		logging.V(100).Infof("Failed to list endpointSlices of service %s in namespace %s due to %s",
+			serviceName, nsname, err.Error())
+
+		return nil, err
+	}
+
+	var endpointSliceObjects []*Builder
+
+	for _, endpointSlice := range endpointSliceList.Items {
+		copiedEndpointSlice := endpointSlice
+		endpointSliceBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedEndpointSlice,
+			Definition: &copiedEndpointSlice,
+		}
+
+		endpointSliceObjects = append(endpointSliceObjects, endpointSliceBuilder)
+	}
+
+	return endpointSliceObjects, nil
+}
+
+// GetServiceEndpoints aggregates the ready, serving and terminating addresses across all endpointSlices of the given
+// service. Following the EndpointSlice API, a nil ready condition counts as ready, a nil serving condition falls back
+// to ready and a nil terminating condition counts as not terminating.
+func GetServiceEndpoints(apiClient *clients.Settings, serviceName, nsname string) (*ServiceEndpoints, error) {
+	endpointSlices, err := ListByService(apiClient, serviceName, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	ready := make(map[string]bool)
+	serving := make(map[string]bool)
+	terminating := make(map[string]bool)
+
+	for _, endpointSlice := range endpointSlices {
+		for _, endpoint := range endpointSlice.Object.Endpoints {
+			isReady := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+			isServing := isReady
+
+			if endpoint.Conditions.Serving != nil {
+				isServing = *endpoint.Conditions.Serving
+			}
+
+			isTerminating := endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating
+
+			for _, address := range endpoint.Addresses {
+				if isReady {
+					ready[address] = true
+				}
+
+				if isServing {
+					serving[address] = true
+				}
+
+				if isTerminating {
+					terminating[address] = true
+				}
+			}
+		}
+	}
+
+	return &ServiceEndpoints{
+		Ready:       sortedKeys(ready),
+		Serving:     sortedKeys(serving),
+		Terminating: sortedKeys(terminating),
+	}, nil
+}
+
+func sortedKeys(addresses map[string]bool) []string {
+	keys := make([]string, 0, len(addresses))
+
+	for address := range addresses {
+		keys = append(keys, address)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,80 @@
+package endpointslice
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListByService(t *testing.T) {
+	testCases := []struct {
+		serviceName   string
+		client        bool
+		expectedCount int
+		expectedError error
+	}{
+		{
+			serviceName:   defaultServiceName,
+			client:        true,
+			expectedCount: 2,
+			expectedError: nil,
+		},
+		{
+			serviceName:   "",
+			client:        true,
+			expectedError: fmt.Errorf("failed to list endpointSlices, 'serviceName' parameter is empty"),
+		},
+		{
+			serviceName:   defaultServiceName,
+			client:        false,
+			expectedError: fmt.Errorf("failed to list endpointSlices, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+				buildDummyEndpointSlice("slice-a", defaultServiceName, nil),
+				buildDummyEndpointSlice("slice-b", defaultServiceName, nil),
+				buildDummyEndpointSlice("slice-other", "other-service", nil),
+			}})
+		}
+
+		builders, err := ListByService(testSettings, testCase.serviceName, defaultEndpointSliceNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, builders, testCase.expectedCount)
+		}
+	}
+}
+
+func TestGetServiceEndpoints(t *testing.T) {
+	trueValue := true
+	falseValue := false
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		buildDummyEndpointSlice("slice-a", defaultServiceName, []discoveryv1.Endpoint{
+			{Addresses: []string{"192.0.2.2"}},
+			{Addresses: []string{"192.0.2.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &trueValue}},
+		}),
+		buildDummyEndpointSlice("slice-b", defaultServiceName, []discoveryv1.Endpoint{
+			{Addresses: []string{"192.0.2.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &trueValue}},
+			{Addresses: []string{"192.0.2.3"}, Conditions: discoveryv1.EndpointConditions{
+				Ready: &falseValue, Serving: &trueValue, Terminating: &trueValue}},
+			{Addresses: []string{"192.0.2.4"}, Conditions: discoveryv1.EndpointConditions{Ready: &falseValue}},
+		}),
+	}})
+
+	endpoints, err := GetServiceEndpoints(testSettings, defaultServiceName, defaultEndpointSliceNamespace)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"192.0.2.1", "192.0.2.2"}, endpoints.Ready)
+	assert.Equal(t, []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"}, endpoints.Serving)
+	assert.Equal(t, []string{"192.0.2.3"}, endpoints.Terminating)
+}
@@ -2,10 +2,11 @@ package events
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	k8sv1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,18 +20,18 @@ type Builder struct {
 	// apiClient opens api connection to the cluster.
 	apiClient corev1Typed.EventInterface
 	// errorMsg used in discovery function before sending api request to cluster.
-	errorMsg string
+	errorMsg error
 }
 
 // Pull pulls existing Event from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("apiClient cannot be nil")
 	}
 
-	glog.V(100).Infof("Pulling existing Event name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing Event name %s under namespace %s from cluster", name, nsname)
 
 	builder := &Builder{
 		apiClient: apiClient.Events(nsname),
@@ -43,13 +44,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the Event is empty")
+		logging.V(100).Infof("The name of the Event is empty")
 
 		return nil, fmt.Errorf("event 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the Event is empty")
+		logging.V(100).Infof("The namespace of the Event is empty")
 
 		return nil, fmt.Errorf("event 'nsname' cannot be empty")
 	}
@@ -67,7 +68,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if Event %s exists", builder.Object.Name)
+	logging.V(100).Infof("Checking if Event %s exists", builder.Object.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Get(context.TODO(),
@@ -82,21 +83,23 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Event"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
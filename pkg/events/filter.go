@@ -0,0 +1,142 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	k8sv1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Filter provides struct for the criteria used to select Events. Empty fields match every Event.
+type Filter struct {
+	// Kind of the involved object, e.g. Pod.
+	InvolvedObjectKind string
+	// Name of the involved object.
+	InvolvedObjectName string
+	// Reason of the Event, e.g. FailedScheduling.
+	Reason string
+	// Type of the Event, either Normal or Warning.
+	Type string
+	// Only Events that last occurred after this time are selected.
+	Since time.Time
+}
+
+// ListWithFilter returns the Events in the given namespace that match the filter.
+func ListWithFilter(apiClient *clients.Settings, nsname string, filter Filter) ([]*Builder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("failed to list Events, 'apiClient' parameter is empty")
+	}
+
+	logging.V(100).Infof("Listing Events in the namespace %s matching %+v", nsname, filter)
+
+	eventBuilders, err := List(apiClient, nsname, metaV1.ListOptions{FieldSelector: filter.fieldSelector()})
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingEvents []*Builder
+
+	// The field selector is applied again on the client side since not every API, e.g. the fake clientset,
+	// supports it.
+	for _, eventBuilder := range eventBuilders {
+		if filter.matches(eventBuilder.Object) {
+			matchingEvents = append(matchingEvents, eventBuilder)
+		}
+	}
+
+	return matchingEvents, nil
+}
+
+// WaitForEvent waits up to timeout until an Event that matches the filter appears in the given namespace and
+// returns it, e.g. to wait for reason FailedScheduling on a given pod.
+func WaitForEvent(
+	apiClient *clients.Settings, nsname string, filter Filter, timeout time.Duration) (*Builder, error) {
+	logging.V(100).Infof("Waiting for Event in the namespace %s matching %+v", nsname, filter)
+
+	var matchingEvent *Builder
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			matchingEvents, err := ListWithFilter(apiClient, nsname, filter)
+			if err != nil {
+				return false, err
+			}
+
+			if len(matchingEvents) == 0 {
+				return false, nil
+			}
+
+			matchingEvent = matchingEvents[0]
+
+			return true, nil
+		})
+
+	if err != nil {
+		logging.V(100).Infof("No Event matching %+v found in the namespace %s due to %s", filter, nsname, err.Error())
+
+		return nil, fmt.Errorf("no Event matching %+v found in namespace %s: %w", filter, nsname, err)
+	}
+
+	return matchingEvent, nil
+}
+
+// fieldSelector converts the filter into an Event field selector.
+func (filter Filter) fieldSelector() string {
+	var selectors []fields.Selector
+
+	for _, term := range [][2]string{
+		{"involvedObject.kind", filter.InvolvedObjectKind},
+		{"involvedObject.name", filter.InvolvedObjectName},
+		{"reason", filter.Reason},
+		{"type", filter.Type},
+	} {
+		if term[1] != "" {
+			selectors = append(selectors, fields.OneTermEqualSelector(term[0], term[1]))
+		}
+	}
+
+	return fields.AndSelectors(selectors...).String()
+}
+
+// matches checks whether the Event matches every criteria of the filter.
+func (filter Filter) matches(event *k8sv1.Event) bool {
+	if filter.InvolvedObjectKind != "" && event.InvolvedObject.Kind != filter.InvolvedObjectKind {
+		return false
+	}
+
+	if filter.InvolvedObjectName != "" && event.InvolvedObject.Name != filter.InvolvedObjectName {
+		return false
+	}
+
+	if filter.Reason != "" && event.Reason != filter.Reason {
+		return false
+	}
+
+	if filter.Type != "" && event.Type != filter.Type {
+		return false
+	}
+
+	return filter.Since.IsZero() || getLastOccurrence(event).After(filter.Since)
+}
+
+// getLastOccurrence returns the last time the Event occurred.
+func getLastOccurrence(event *k8sv1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
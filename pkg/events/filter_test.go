@@ -0,0 +1,113 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var testStartTime = time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestListWithFilter(t *testing.T) {
+	testCases := []struct {
+		filter         Filter
+		expectedEvents []string
+	}{
+		{
+			filter:         Filter{},
+			expectedEvents: []string{"pod-scheduled", "pod-failed-scheduling", "deployment-scaled"},
+		},
+		{
+			filter:         Filter{InvolvedObjectKind: "Pod"},
+			expectedEvents: []string{"pod-scheduled", "pod-failed-scheduling"},
+		},
+		{
+			filter:         Filter{InvolvedObjectKind: "Pod", InvolvedObjectName: "test-pod", Reason: "FailedScheduling"},
+			expectedEvents: []string{"pod-failed-scheduling"},
+		},
+		{
+			filter:         Filter{Type: corev1.EventTypeNormal},
+			expectedEvents: []string{"pod-scheduled", "deployment-scaled"},
+		},
+		{
+			filter:         Filter{Since: testStartTime.Add(time.Minute)},
+			expectedEvents: []string{"pod-failed-scheduling"},
+		},
+		{
+			filter: Filter{InvolvedObjectName: "other-pod"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: buildDummyEvents()})
+
+		eventBuilders, err := ListWithFilter(testSettings, "test-namespace", testCase.filter)
+		assert.Nil(t, err)
+
+		var eventNames []string
+
+		for _, eventBuilder := range eventBuilders {
+			eventNames = append(eventNames, eventBuilder.Object.Name)
+		}
+
+		assert.ElementsMatch(t, testCase.expectedEvents, eventNames)
+	}
+
+	_, err := ListWithFilter(nil, "test-namespace", Filter{})
+	assert.EqualError(t, err, "failed to list Events, 'apiClient' parameter is empty")
+}
+
+func TestWaitForEvent(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: buildDummyEvents()})
+
+	eventBuilder, err := WaitForEvent(
+		testSettings, "test-namespace", Filter{InvolvedObjectName: "test-pod", Reason: "FailedScheduling"}, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, "pod-failed-scheduling", eventBuilder.Object.Name)
+
+	_, err = WaitForEvent(testSettings, "test-namespace", Filter{Reason: "BackOff"}, time.Second)
+	assert.ErrorContains(t, err, "no Event matching")
+}
+
+func TestFilterFieldSelector(t *testing.T) {
+	assert.Equal(t, "", Filter{}.fieldSelector())
+	assert.Equal(t, "involvedObject.kind=Pod,involvedObject.name=test-pod,reason=FailedScheduling,type=Warning",
+		Filter{
+			InvolvedObjectKind: "Pod",
+			InvolvedObjectName: "test-pod",
+			Reason:             "FailedScheduling",
+			Type:               corev1.EventTypeWarning,
+		}.fieldSelector())
+}
+
+func buildDummyEvents() []runtime.Object {
+	return []runtime.Object{
+		buildDummyEvent("pod-scheduled", "Pod", "test-pod", "Scheduled", corev1.EventTypeNormal, testStartTime),
+		buildDummyEvent("pod-failed-scheduling", "Pod", "test-pod", "FailedScheduling", corev1.EventTypeWarning,
+			testStartTime.Add(2*time.Minute)),
+		buildDummyEvent("deployment-scaled", "Deployment", "test-deployment", "ScalingReplicaSet",
+			corev1.EventTypeNormal, testStartTime),
+	}
+}
+
+func buildDummyEvent(name, kind, objectName, reason, eventType string, lastTimestamp time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-namespace",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      kind,
+			Name:      objectName,
+			Namespace: "test-namespace",
+		},
+		Reason:        reason,
+		Type:          eventType,
+		LastTimestamp: metav1.NewTime(lastTimestamp),
+	}
+}
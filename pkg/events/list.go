@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -13,7 +13,7 @@ import (
 func List(
 	apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("Events 'nsname' parameter can not be empty")
+		logging.V(100).Infof("Events 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list Events, 'nsname' parameter is empty")
 	}
@@ -22,7 +22,7 @@ func List(
 	passedOptions := metaV1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -32,12 +32,12 @@ func List(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	eventList, err := apiClient.Events(nsname).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list Events in the namespace %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list Events in the namespace %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
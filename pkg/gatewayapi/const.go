@@ -0,0 +1,30 @@
+package gatewayapi
+
+const (
+	// APIGroup represents Gateway API group.
+	APIGroup = "gateway.networking.k8s.io"
+	// APIVersion represents version of Gateway API.
+	APIVersion = "v1"
+	// GatewayClassKind represents kind of GatewayClass object.
+	GatewayClassKind = "GatewayClass"
+	// GatewayKind represents kind of Gateway object.
+	GatewayKind = "Gateway"
+	// HTTPRouteKind represents kind of HTTPRoute object.
+	HTTPRouteKind = "HTTPRoute"
+	// GRPCRouteKind represents kind of GRPCRoute object.
+	GRPCRouteKind = "GRPCRoute"
+
+	// ConditionAccepted is the condition set once the resource is accepted by its controller.
+	ConditionAccepted = "Accepted"
+	// ConditionProgrammed is the condition set once the Gateway is configured in the data plane.
+	ConditionProgrammed = "Programmed"
+	// ConditionResolvedRefs is the condition set once all the references of a route are resolved.
+	ConditionResolvedRefs = "ResolvedRefs"
+
+	// PathMatchExact matches the request path exactly.
+	PathMatchExact = "Exact"
+	// PathMatchPathPrefix matches the request path by its prefix split by "/".
+	PathMatchPathPrefix = "PathPrefix"
+	// PathMatchRegularExpression matches the request path by a regular expression.
+	PathMatchRegularExpression = "RegularExpression"
+)
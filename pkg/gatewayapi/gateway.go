@@ -0,0 +1,517 @@
+package gatewayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// GatewayBuilder provides struct for the Gateway object containing connection to the cluster and the Gateway
+// definitions.
+type GatewayBuilder struct {
+	// Gateway definition. Used to create the Gateway object.
+	Definition *gwtypes.Gateway
+	// Created Gateway object.
+	Object *gwtypes.Gateway
+	// Used in functions that define or mutate Gateway definition. errorMsg is processed before the Gateway object
+	// is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewGatewayBuilder creates a new instance of GatewayBuilder of the given GatewayClass. Listeners are added with
+// WithHTTPListener and WithHTTPSListener.
+func NewGatewayBuilder(apiClient *clients.Settings, name, nsname, gatewayClassName string) *GatewayBuilder {
+	logging.V(100).Infof(
+		"Initializing new Gateway structure with the following params: name: %s, namespace: %s, gatewayClassName: %s",
+		name, nsname, gatewayClassName)
+
+	builder := GatewayBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.Gateway{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       GatewayKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: gwtypes.GatewaySpec{
+				GatewayClassName: gatewayClassName,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the Gateway is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Gateway 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the Gateway is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Gateway 'namespace' cannot be empty"))
+	}
+
+	if gatewayClassName == "" {
+		logging.V(100).Infof("The gatewayClassName of the Gateway is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Gateway 'gatewayClassName' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullGateway pulls existing Gateway from cluster.
+func PullGateway(apiClient *clients.Settings, name, nsname string) (*GatewayBuilder, error) {
+	logging.V(100).Infof("Pulling existing Gateway name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("Gateway 'apiClient' cannot be empty")
+	}
+
+	builder := GatewayBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the Gateway is empty")
+
+		return nil, fmt.Errorf("Gateway 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the Gateway is empty")
+
+		return nil, fmt.Errorf("Gateway 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("Gateway object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithHTTPListener adds a plain HTTP listener to the Gateway. An empty hostname matches all hostnames.
+func (builder *GatewayBuilder) WithHTTPListener(name string, port int32, hostname string) *GatewayBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding HTTP listener %s on port %d to Gateway %s in namespace %s",
+		name, port, builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.withListener(gwtypes.Listener{
+		Name:     name,
+		Port:     port,
+		Protocol: "HTTP",
+		Hostname: hostname,
+	})
+}
+
+// WithHTTPSListener adds an HTTPS listener terminating TLS with the certificate stored in the given secret of the
+// Gateway namespace. An empty hostname matches all hostnames.
+func (builder *GatewayBuilder) WithHTTPSListener(
+	name string, port int32, hostname, certificateSecretName string) *GatewayBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding HTTPS listener %s on port %d with certificate %s to Gateway %s in namespace %s",
+		name, port, certificateSecretName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if certificateSecretName == "" {
+		logging.V(100).Infof("The certificate secret name of the HTTPS listener is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("Gateway listener 'certificateSecretName' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.withListener(gwtypes.Listener{
+		Name:     name,
+		Port:     port,
+		Protocol: "HTTPS",
+		Hostname: hostname,
+		TLS: &gwtypes.GatewayTLSConfig{
+			Mode:            "Terminate",
+			CertificateRefs: []gwtypes.SecretObjectReference{{Name: certificateSecretName}},
+		},
+	})
+}
+
+// WithListenerAllowedNamespaces sets the namespaces routes may be attached to the listener from. from is one of
+// All, Same or Selector, namespaceSelector is only used and required with Selector.
+func (builder *GatewayBuilder) WithListenerAllowedNamespaces(
+	listenerName, from string, namespaceSelector map[string]string) *GatewayBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting allowed namespaces from %s with selector %v on listener %s of Gateway %s",
+		from, namespaceSelector, listenerName, builder.Definition.Name)
+
+	listener := builder.findListener(listenerName)
+	if listener == nil {
+		logging.V(100).Infof("The listener %s does not exist in Gateway %s", listenerName, builder.Definition.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("Gateway listener %s does not exist", listenerName))
+
+		return builder
+	}
+
+	routeNamespaces := &gwtypes.RouteNamespaces{From: from}
+
+	switch from {
+	case "All", "Same":
+	case "Selector":
+		if len(namespaceSelector) == 0 {
+			logging.V(100).Infof("The namespaceSelector of listener %s is empty", listenerName)
+
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("Gateway listener 'namespaceSelector' cannot be empty when from is Selector"))
+
+			return builder
+		}
+
+		routeNamespaces.Selector = &metav1.LabelSelector{MatchLabels: namespaceSelector}
+	default:
+		logging.V(100).Infof("The from %s of listener %s is not supported", from, listenerName)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("Gateway listener 'from' must be All, Same or Selector, got %s", from))
+
+		return builder
+	}
+
+	if listener.AllowedRoutes == nil {
+		listener.AllowedRoutes = &gwtypes.AllowedRoutes{}
+	}
+
+	listener.AllowedRoutes.Namespaces = routeNamespaces
+
+	return builder
+}
+
+// Get returns Gateway object if found.
+func (builder *GatewayBuilder) Get() (*gwtypes.Gateway, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting Gateway object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetGatewayGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Gateway object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertGatewayToStructured(unsObject)
+}
+
+// Exists checks whether the given Gateway exists.
+func (builder *GatewayBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if Gateway %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a Gateway in the cluster and stores the created object in struct.
+func (builder *GatewayBuilder) Create() (*GatewayBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the Gateway %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.Listeners) == 0 {
+		return builder, fmt.Errorf("Gateway %s in namespace %s must have at least one listener",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	unstructuredGateway, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured Gateway to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetGatewayGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredGateway}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create Gateway %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertGatewayToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing Gateway object with the Gateway definition in builder.
+func (builder *GatewayBuilder) Update() (*GatewayBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to update Gateway, object doesn't exist on cluster")
+	}
+
+	logging.V(100).Infof("Updating the Gateway object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredGateway, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured Gateway to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetGatewayGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredGateway}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertGatewayToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes Gateway object from a cluster.
+func (builder *GatewayBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the Gateway object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetGatewayGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete Gateway: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetAddresses returns the addresses bound to the Gateway.
+func (builder *GatewayBuilder) GetAddresses() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting addresses of Gateway %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Gateway %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	var addresses []string
+
+	for _, address := range builder.Object.Status.Addresses {
+		addresses = append(addresses, address.Value)
+	}
+
+	return addresses, nil
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until the Gateway is accepted by the
+// controller of its GatewayClass.
+func (builder *GatewayBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	return builder.waitUntilCondition(ConditionAccepted, timeout)
+}
+
+// WaitUntilProgrammed waits for the duration of the defined timeout or until the Gateway is configured in the data
+// plane and ready to receive traffic.
+func (builder *GatewayBuilder) WaitUntilProgrammed(timeout time.Duration) error {
+	return builder.waitUntilCondition(ConditionProgrammed, timeout)
+}
+
+// GetGatewayGVR returns Gateway's GroupVersionResource which could be used for Clean function.
+func GetGatewayGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "gateways"}
+}
+
+// waitUntilCondition waits for the duration of the defined timeout or until the condition of the Gateway is true.
+func (builder *GatewayBuilder) waitUntilCondition(conditionType string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until Gateway %s in namespace %s has condition %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			return meta.IsStatusConditionTrue(builder.Object.Status.Conditions, conditionType), nil
+		})
+}
+
+// withListener validates the listener before appending it to the Gateway definition.
+func (builder *GatewayBuilder) withListener(listener gwtypes.Listener) *GatewayBuilder {
+	if listener.Name == "" {
+		logging.V(100).Infof("The name of the Gateway listener is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Gateway listener 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.findListener(listener.Name) != nil {
+		logging.V(100).Infof("The listener %s already exists in Gateway %s", listener.Name, builder.Definition.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("Gateway listener %s already exists", listener.Name))
+
+		return builder
+	}
+
+	if listener.Port < 1 || listener.Port > 65535 {
+		logging.V(100).Infof("The port %d of the Gateway listener is out of range", listener.Port)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("Gateway listener 'port' %d is out of range", listener.Port))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Listeners = append(builder.Definition.Spec.Listeners, listener)
+
+	return builder
+}
+
+// findListener returns a pointer to the listener of the definition with the given name, or nil if not found.
+func (builder *GatewayBuilder) findListener(name string) *gwtypes.Listener {
+	for index := range builder.Definition.Spec.Listeners {
+		if builder.Definition.Spec.Listeners[index].Name == name {
+			return &builder.Definition.Spec.Listeners[index]
+		}
+	}
+
+	return nil
+}
+
+// convertGatewayToStructured converts the unstructured object returned by the dynamic client to a Gateway.
+func convertGatewayToStructured(unsObject *unstructured.Unstructured) (*gwtypes.Gateway, error) {
+	gateway := &gwtypes.Gateway{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, gateway)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to Gateway object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return gateway, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *GatewayBuilder) validate() (bool, error) {
+	resourceCRD := "Gateway"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
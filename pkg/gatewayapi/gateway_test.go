@@ -0,0 +1,412 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	gatewayGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    GatewayKind,
+	}
+	defaultGatewayName      = "gateway-test"
+	defaultGatewayNamespace = "openshift-ingress"
+)
+
+func TestNewGatewayBuilder(t *testing.T) {
+	testCases := []struct {
+		name             string
+		namespace        string
+		gatewayClassName string
+		expectedError    string
+	}{
+		{
+			name:             defaultGatewayName,
+			namespace:        defaultGatewayNamespace,
+			gatewayClassName: defaultGatewayClassName,
+			expectedError:    "",
+		},
+		{
+			name:             "",
+			namespace:        defaultGatewayNamespace,
+			gatewayClassName: defaultGatewayClassName,
+			expectedError:    "Gateway 'name' cannot be empty",
+		},
+		{
+			name:             defaultGatewayName,
+			namespace:        "",
+			gatewayClassName: defaultGatewayClassName,
+			expectedError:    "Gateway 'namespace' cannot be empty",
+		},
+		{
+			name:             defaultGatewayName,
+			namespace:        defaultGatewayNamespace,
+			gatewayClassName: "",
+			expectedError:    "Gateway 'gatewayClassName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewGatewayBuilder(testSettings, testCase.name, testCase.namespace, testCase.gatewayClassName)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, testCase.gatewayClassName, testBuilder.Definition.Spec.GatewayClassName)
+		}
+	}
+}
+
+func TestPullGateway(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultGatewayName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("Gateway 'name' cannot be empty"),
+		},
+		{
+			name:                defaultGatewayName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("Gateway 'namespace' cannot be empty"),
+		},
+		{
+			name:                defaultGatewayName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("Gateway object %s doesn't exist in namespace %s",
+				defaultGatewayName, defaultGatewayNamespace),
+		},
+		{
+			name:                defaultGatewayName,
+			namespace:           defaultGatewayNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("Gateway 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyGateway(testCase.name, testCase.namespace, gwtypes.GatewayStatus{}))
+		}
+
+		if testCase.client {
+			testSettings = buildGatewayTestClientWithDummyObject(t, runtimeObjects)
+		}
+
+		testBuilder, err := PullGateway(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestGatewayWithListeners(t *testing.T) {
+	testCases := []struct {
+		mutate            func(*GatewayBuilder) *GatewayBuilder
+		expectedListeners []gwtypes.Listener
+		expectedError     string
+	}{
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPListener("http", 80, "*.apps.example.com")
+			},
+			expectedListeners: []gwtypes.Listener{
+				{Name: "http", Port: 80, Protocol: "HTTP", Hostname: "*.apps.example.com"},
+			},
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPSListener("https", 443, "", "gateway-cert")
+			},
+			expectedListeners: []gwtypes.Listener{{
+				Name:     "https",
+				Port:     443,
+				Protocol: "HTTPS",
+				TLS: &gwtypes.GatewayTLSConfig{
+					Mode:            "Terminate",
+					CertificateRefs: []gwtypes.SecretObjectReference{{Name: "gateway-cert"}},
+				},
+			}},
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPListener("http", 80, "").
+					WithListenerAllowedNamespaces("http", "Selector", map[string]string{"gateway": "allowed"})
+			},
+			expectedListeners: []gwtypes.Listener{{
+				Name:     "http",
+				Port:     80,
+				Protocol: "HTTP",
+				AllowedRoutes: &gwtypes.AllowedRoutes{Namespaces: &gwtypes.RouteNamespaces{
+					From:     "Selector",
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"gateway": "allowed"}},
+				}},
+			}},
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPListener("", 80, "")
+			},
+			expectedError: "Gateway listener 'name' cannot be empty",
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPListener("http", 80, "").WithHTTPListener("http", 8080, "")
+			},
+			expectedError: "Gateway listener http already exists",
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPListener("http", 0, "")
+			},
+			expectedError: "Gateway listener 'port' 0 is out of range",
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPSListener("https", 443, "", "")
+			},
+			expectedError: "Gateway listener 'certificateSecretName' cannot be empty",
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithListenerAllowedNamespaces("http", "All", nil)
+			},
+			expectedError: "Gateway listener http does not exist",
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPListener("http", 80, "").WithListenerAllowedNamespaces("http", "Selector", nil)
+			},
+			expectedError: "Gateway listener 'namespaceSelector' cannot be empty when from is Selector",
+		},
+		{
+			mutate: func(builder *GatewayBuilder) *GatewayBuilder {
+				return builder.WithHTTPListener("http", 80, "").WithListenerAllowedNamespaces("http", "Other", nil)
+			},
+			expectedError: "Gateway listener 'from' must be All, Same or Selector, got Other",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testCase.mutate(testBuilder)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.expectedListeners, testBuilder.Definition.Spec.Listeners)
+		}
+	}
+}
+
+func TestGatewayCreate(t *testing.T) {
+	testCases := []struct {
+		testGateway   *GatewayBuilder
+		expectedError error
+	}{
+		{
+			testGateway: buildValidGatewayBuilder(buildGatewayTestClientWithDummyObject(t, nil)).
+				WithHTTPListener("http", 80, ""),
+			expectedError: nil,
+		},
+		{
+			testGateway: buildValidGatewayBuilder(buildGatewayTestClientWithDummyObject(t, nil)),
+			expectedError: fmt.Errorf("Gateway %s in namespace %s must have at least one listener",
+				defaultGatewayName, defaultGatewayNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testGateway.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultGatewayName, testBuilder.Object.Name)
+			assert.Equal(t, defaultGatewayNamespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestGatewayUpdate(t *testing.T) {
+	testSettings := buildGatewayTestClientWithDummyObject(t,
+		[]runtime.Object{buildDummyGateway(defaultGatewayName, defaultGatewayNamespace, gwtypes.GatewayStatus{})})
+
+	testBuilder, err := PullGateway(testSettings, defaultGatewayName, defaultGatewayNamespace)
+	assert.Nil(t, err)
+
+	testBuilder, err = testBuilder.WithHTTPSListener("https", 443, "", "gateway-cert").Update()
+	assert.Nil(t, err)
+	assert.Len(t, testBuilder.Object.Spec.Listeners, 2)
+
+	_, err = buildValidGatewayBuilder(buildGatewayTestClientWithDummyObject(t, nil)).Update()
+	assert.EqualError(t, err, "failed to update Gateway, object doesn't exist on cluster")
+}
+
+func TestGatewayDelete(t *testing.T) {
+	testCases := []struct {
+		testGateway   *GatewayBuilder
+		expectedError error
+	}{
+		{
+			testGateway: buildValidGatewayBuilder(buildGatewayTestClientWithDummyObject(t, []runtime.Object{
+				buildDummyGateway(defaultGatewayName, defaultGatewayNamespace, gwtypes.GatewayStatus{})})),
+			expectedError: nil,
+		},
+		{
+			testGateway:   buildValidGatewayBuilder(buildGatewayTestClientWithDummyObject(t, nil)),
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testGateway.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testGateway.Object)
+		}
+	}
+}
+
+func TestGatewayGetAddresses(t *testing.T) {
+	testSettings := buildGatewayTestClientWithDummyObject(t, []runtime.Object{
+		buildDummyGateway(defaultGatewayName, defaultGatewayNamespace, gwtypes.GatewayStatus{
+			Addresses: []gwtypes.GatewayAddress{{Type: "IPAddress", Value: "192.168.10.100"}},
+		})})
+
+	addresses, err := buildValidGatewayBuilder(testSettings).GetAddresses()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"192.168.10.100"}, addresses)
+
+	_, err = buildValidGatewayBuilder(buildGatewayTestClientWithDummyObject(t, nil)).GetAddresses()
+	assert.NotNil(t, err)
+}
+
+func TestGatewayWaitUntilConditions(t *testing.T) {
+	testCases := []struct {
+		conditions    []metav1.Condition
+		programmed    bool
+		expectedError error
+	}{
+		{
+			conditions:    []metav1.Condition{{Type: ConditionAccepted, Status: metav1.ConditionTrue}},
+			programmed:    false,
+			expectedError: nil,
+		},
+		{
+			conditions:    []metav1.Condition{{Type: ConditionAccepted, Status: metav1.ConditionTrue}},
+			programmed:    true,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			conditions: []metav1.Condition{
+				{Type: ConditionAccepted, Status: metav1.ConditionTrue},
+				{Type: ConditionProgrammed, Status: metav1.ConditionTrue},
+			},
+			programmed:    true,
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildGatewayTestClientWithDummyObject(t, []runtime.Object{
+			buildDummyGateway(defaultGatewayName, defaultGatewayNamespace, gwtypes.GatewayStatus{
+				Conditions: testCase.conditions,
+			})})
+		testBuilder := buildValidGatewayBuilder(testSettings)
+
+		var err error
+		if testCase.programmed {
+			err = testBuilder.WaitUntilProgrammed(time.Second)
+		} else {
+			err = testBuilder.WaitUntilAccepted(time.Second)
+		}
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidGatewayBuilder(apiClient *clients.Settings) *GatewayBuilder {
+	return NewGatewayBuilder(apiClient, defaultGatewayName, defaultGatewayNamespace, defaultGatewayClassName)
+}
+
+func buildDummyGateway(name, namespace string, status gwtypes.GatewayStatus) *gwtypes.Gateway {
+	return &gwtypes.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: gwtypes.GatewaySpec{
+			GatewayClassName: defaultGatewayClassName,
+			Listeners:        []gwtypes.Listener{{Name: "http", Port: 80, Protocol: "HTTP"}},
+		},
+		Status: status,
+	}
+}
+
+// buildGatewayTestClientWithDummyObject creates the objects through the dynamic client rather than seeding them, since
+// the fake client would otherwise store Gateways under the guessed resource gatewaies instead of gateways.
+func buildGatewayTestClientWithDummyObject(t *testing.T, objects []runtime.Object) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		GVK: []schema.GroupVersionKind{gatewayGVK},
+	})
+
+	for _, object := range objects {
+		unstructuredGateway, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		assert.Nil(t, err)
+
+		gateway := &unstructured.Unstructured{Object: unstructuredGateway}
+
+		_, err = testSettings.Resource(GetGatewayGVR()).Namespace(gateway.GetNamespace()).Create(
+			context.TODO(), gateway, metav1.CreateOptions{})
+		assert.Nil(t, err)
+	}
+
+	return testSettings
+}
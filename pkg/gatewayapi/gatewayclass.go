@@ -0,0 +1,294 @@
+package gatewayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// GatewayClassBuilder provides struct for the GatewayClass object containing connection to the cluster and the
+// GatewayClass definitions.
+type GatewayClassBuilder struct {
+	// GatewayClass definition. Used to create the GatewayClass object.
+	Definition *gwtypes.GatewayClass
+	// Created GatewayClass object.
+	Object *gwtypes.GatewayClass
+	// Used in functions that define or mutate GatewayClass definition. errorMsg is processed before the GatewayClass
+	// object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewGatewayClassBuilder creates a new instance of GatewayClassBuilder for Gateways managed by controllerName.
+func NewGatewayClassBuilder(apiClient *clients.Settings, name, controllerName string) *GatewayClassBuilder {
+	logging.V(100).Infof(
+		"Initializing new GatewayClass structure with the following params: name: %s, controllerName: %s",
+		name, controllerName)
+
+	builder := GatewayClassBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.GatewayClass{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       GatewayClassKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: gwtypes.GatewayClassSpec{
+				ControllerName: controllerName,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the GatewayClass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GatewayClass 'name' cannot be empty"))
+	}
+
+	if controllerName == "" {
+		logging.V(100).Infof("The controllerName of the GatewayClass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GatewayClass 'controllerName' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullGatewayClass pulls existing GatewayClass from cluster.
+func PullGatewayClass(apiClient *clients.Settings, name string) (*GatewayClassBuilder, error) {
+	logging.V(100).Infof("Pulling existing GatewayClass name %s from cluster", name)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("GatewayClass 'apiClient' cannot be empty")
+	}
+
+	builder := GatewayClassBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the GatewayClass is empty")
+
+		return nil, fmt.Errorf("GatewayClass 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("GatewayClass object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithDescription sets the description of the GatewayClass.
+func (builder *GatewayClassBuilder) WithDescription(description string) *GatewayClassBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting description %s on GatewayClass %s", description, builder.Definition.Name)
+
+	if description == "" {
+		logging.V(100).Infof("The description of the GatewayClass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GatewayClass 'description' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Description = description
+
+	return builder
+}
+
+// Get returns GatewayClass object if found.
+func (builder *GatewayClassBuilder) Get() (*gwtypes.GatewayClass, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting GatewayClass object %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetGatewayClassGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("GatewayClass object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	return convertGatewayClassToStructured(unsObject)
+}
+
+// Exists checks whether the given GatewayClass exists.
+func (builder *GatewayClassBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if GatewayClass %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a GatewayClass in the cluster and stores the created object in struct.
+func (builder *GatewayClassBuilder) Create() (*GatewayClassBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the GatewayClass %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredGatewayClass, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured GatewayClass to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetGatewayClassGVR()).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredGatewayClass}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create GatewayClass %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertGatewayClassToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes GatewayClass object from a cluster.
+func (builder *GatewayClassBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the GatewayClass object %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetGatewayClassGVR()).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete GatewayClass: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until the GatewayClass is accepted by its
+// controller.
+func (builder *GatewayClassBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until GatewayClass %s is accepted", builder.Definition.Name)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			return meta.IsStatusConditionTrue(builder.Object.Status.Conditions, ConditionAccepted), nil
+		})
+}
+
+// GetGatewayClassGVR returns GatewayClass's GroupVersionResource which could be used for Clean function.
+func GetGatewayClassGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "gatewayclasses"}
+}
+
+// convertGatewayClassToStructured converts the unstructured object returned by the dynamic client to a
+// GatewayClass.
+func convertGatewayClassToStructured(unsObject *unstructured.Unstructured) (*gwtypes.GatewayClass, error) {
+	gatewayClass := &gwtypes.GatewayClass{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, gatewayClass)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to GatewayClass object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return gatewayClass, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *GatewayClassBuilder) validate() (bool, error) {
+	resourceCRD := "GatewayClass"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
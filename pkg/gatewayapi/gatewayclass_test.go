@@ -0,0 +1,247 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	gatewayClassGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    GatewayClassKind,
+	}
+	defaultGatewayClassName       = "openshift-default"
+	defaultGatewayControllerName  = "openshift.io/gateway-controller/v1"
+	defaultGatewayClassConditions = []metav1.Condition{{Type: ConditionAccepted, Status: metav1.ConditionTrue}}
+)
+
+func TestNewGatewayClassBuilder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		controllerName string
+		expectedError  string
+	}{
+		{
+			name:           defaultGatewayClassName,
+			controllerName: defaultGatewayControllerName,
+			expectedError:  "",
+		},
+		{
+			name:           "",
+			controllerName: defaultGatewayControllerName,
+			expectedError:  "GatewayClass 'name' cannot be empty",
+		},
+		{
+			name:           defaultGatewayClassName,
+			controllerName: "",
+			expectedError:  "GatewayClass 'controllerName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewGatewayClassBuilder(testSettings, testCase.name, testCase.controllerName)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.controllerName, testBuilder.Definition.Spec.ControllerName)
+		}
+	}
+}
+
+func TestPullGatewayClass(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultGatewayClassName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("GatewayClass 'name' cannot be empty"),
+		},
+		{
+			name:                defaultGatewayClassName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("GatewayClass object %s doesn't exist", defaultGatewayClassName),
+		},
+		{
+			name:                defaultGatewayClassName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("GatewayClass 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyGatewayClass(testCase.name, nil))
+		}
+
+		if testCase.client {
+			testSettings = buildGatewayClassTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullGatewayClass(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestGatewayClassWithDescription(t *testing.T) {
+	testCases := []struct {
+		description   string
+		expectedError string
+	}{
+		{
+			description:   "default gateway class",
+			expectedError: "",
+		},
+		{
+			description:   "",
+			expectedError: "GatewayClass 'description' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGatewayClassBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithDescription(testCase.description)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.description, testBuilder.Definition.Spec.Description)
+		}
+	}
+}
+
+func TestGatewayClassCreate(t *testing.T) {
+	testCases := []struct {
+		testGatewayClass *GatewayClassBuilder
+		expectedError    error
+	}{
+		{
+			testGatewayClass: buildValidGatewayClassBuilder(buildGatewayClassTestClientWithDummyObject(nil)),
+			expectedError:    nil,
+		},
+		{
+			testGatewayClass: buildValidGatewayClassBuilder(buildGatewayClassTestClientWithDummyObject(
+				[]runtime.Object{buildDummyGatewayClass(defaultGatewayClassName, nil)})),
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testGatewayClass.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultGatewayClassName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestGatewayClassDelete(t *testing.T) {
+	testCases := []struct {
+		testGatewayClass *GatewayClassBuilder
+		expectedError    error
+	}{
+		{
+			testGatewayClass: buildValidGatewayClassBuilder(buildGatewayClassTestClientWithDummyObject(
+				[]runtime.Object{buildDummyGatewayClass(defaultGatewayClassName, nil)})),
+			expectedError: nil,
+		},
+		{
+			testGatewayClass: buildValidGatewayClassBuilder(buildGatewayClassTestClientWithDummyObject(nil)),
+			expectedError:    nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testGatewayClass.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testGatewayClass.Object)
+		}
+	}
+}
+
+func TestGatewayClassWaitUntilAccepted(t *testing.T) {
+	testCases := []struct {
+		conditions    []metav1.Condition
+		expectedError error
+	}{
+		{
+			conditions:    defaultGatewayClassConditions,
+			expectedError: nil,
+		},
+		{
+			conditions:    []metav1.Condition{{Type: ConditionAccepted, Status: metav1.ConditionFalse}},
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildGatewayClassTestClientWithDummyObject(
+			[]runtime.Object{buildDummyGatewayClass(defaultGatewayClassName, testCase.conditions)})
+		testBuilder := buildValidGatewayClassBuilder(testSettings)
+
+		err := testBuilder.WaitUntilAccepted(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidGatewayClassBuilder(apiClient *clients.Settings) *GatewayClassBuilder {
+	return NewGatewayClassBuilder(apiClient, defaultGatewayClassName, defaultGatewayControllerName)
+}
+
+func buildDummyGatewayClass(name string, conditions []metav1.Condition) *gwtypes.GatewayClass {
+	return &gwtypes.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: gwtypes.GatewayClassSpec{
+			ControllerName: defaultGatewayControllerName,
+		},
+		Status: gwtypes.GatewayClassStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func buildGatewayClassTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{gatewayClassGVK},
+	})
+}
@@ -0,0 +1,426 @@
+package gatewayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// GRPCRouteBuilder provides struct for the GRPCRoute object containing connection to the cluster and the GRPCRoute
+// definitions.
+type GRPCRouteBuilder struct {
+	// GRPCRoute definition. Used to create the GRPCRoute object.
+	Definition *gwtypes.GRPCRoute
+	// Created GRPCRoute object.
+	Object *gwtypes.GRPCRoute
+	// Used in functions that define or mutate GRPCRoute definition. errorMsg is processed before the GRPCRoute
+	// object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewGRPCRouteBuilder creates a new instance of GRPCRouteBuilder. The route must be attached to at least one
+// Gateway with WithParentGateway before it is created.
+func NewGRPCRouteBuilder(apiClient *clients.Settings, name, nsname string) *GRPCRouteBuilder {
+	logging.V(100).Infof(
+		"Initializing new GRPCRoute structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := GRPCRouteBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.GRPCRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       GRPCRouteKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the GRPCRoute is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GRPCRoute 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the GRPCRoute is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GRPCRoute 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullGRPCRoute pulls existing GRPCRoute from cluster.
+func PullGRPCRoute(apiClient *clients.Settings, name, nsname string) (*GRPCRouteBuilder, error) {
+	logging.V(100).Infof("Pulling existing GRPCRoute name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("GRPCRoute 'apiClient' cannot be empty")
+	}
+
+	builder := GRPCRouteBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.GRPCRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the GRPCRoute is empty")
+
+		return nil, fmt.Errorf("GRPCRoute 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the GRPCRoute is empty")
+
+		return nil, fmt.Errorf("GRPCRoute 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("GRPCRoute object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithParentGateway attaches the GRPCRoute to the Gateway. An empty gatewayNamespace refers to the namespace of the
+// route and an empty sectionName attaches the route to all the listeners of the Gateway.
+func (builder *GRPCRouteBuilder) WithParentGateway(
+	gatewayName, gatewayNamespace, sectionName string) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Attaching GRPCRoute %s in namespace %s to Gateway %s in namespace %s, section %s",
+		builder.Definition.Name, builder.Definition.Namespace, gatewayName, gatewayNamespace, sectionName)
+
+	parentRef, err := newGatewayParentRef(gatewayName, gatewayNamespace, sectionName)
+	if err != nil {
+		logging.V(100).Infof("Invalid parent Gateway for GRPCRoute %s: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GRPCRoute parentRef %w", err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ParentRefs = append(builder.Definition.Spec.ParentRefs, parentRef)
+
+	return builder
+}
+
+// WithHostname adds a hostname matched against the Host header of the gRPC requests.
+func (builder *GRPCRouteBuilder) WithHostname(hostname string) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding hostname %s to GRPCRoute %s in namespace %s",
+		hostname, builder.Definition.Name, builder.Definition.Namespace)
+
+	if hostname == "" {
+		logging.V(100).Infof("The hostname of the GRPCRoute is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GRPCRoute 'hostname' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Hostnames = append(builder.Definition.Spec.Hostnames, hostname)
+
+	return builder
+}
+
+// WithMethodRule adds a rule forwarding the requests to the gRPC service and method to the backends. An empty method
+// matches all the methods of the service.
+func (builder *GRPCRouteBuilder) WithMethodRule(
+	service, method string, backendRefs ...gwtypes.BackendRef) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding method rule %s/%s to GRPCRoute %s in namespace %s",
+		service, method, builder.Definition.Name, builder.Definition.Namespace)
+
+	if service == "" {
+		logging.V(100).Infof("The service of the GRPCRoute rule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GRPCRoute rule 'service' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.WithRule(gwtypes.GRPCRouteRule{
+		Matches: []gwtypes.GRPCRouteMatch{{
+			Method: &gwtypes.GRPCMethodMatch{Type: "Exact", Service: service, Method: method},
+		}},
+	}, backendRefs...)
+}
+
+// WithRule adds a rule with arbitrary matches forwarding the matching requests to the backends. Any backendRefs
+// already set in the rule are replaced.
+func (builder *GRPCRouteBuilder) WithRule(
+	rule gwtypes.GRPCRouteRule, backendRefs ...gwtypes.BackendRef) *GRPCRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding rule with %d matches and %d backends to GRPCRoute %s in namespace %s",
+		len(rule.Matches), len(backendRefs), builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateBackendRefs(backendRefs); err != nil {
+		logging.V(100).Infof("Invalid backends for GRPCRoute %s rule: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("GRPCRoute rule %w", err))
+
+		return builder
+	}
+
+	rule.BackendRefs = nil
+
+	for _, backendRef := range backendRefs {
+		rule.BackendRefs = append(rule.BackendRefs, gwtypes.GRPCBackendRef{BackendRef: backendRef})
+	}
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, rule)
+
+	return builder
+}
+
+// Get returns GRPCRoute object if found.
+func (builder *GRPCRouteBuilder) Get() (*gwtypes.GRPCRoute, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting GRPCRoute object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetGRPCRouteGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("GRPCRoute object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertGRPCRouteToStructured(unsObject)
+}
+
+// Exists checks whether the given GRPCRoute exists.
+func (builder *GRPCRouteBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if GRPCRoute %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a GRPCRoute in the cluster and stores the created object in struct.
+func (builder *GRPCRouteBuilder) Create() (*GRPCRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the GRPCRoute %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.ParentRefs) == 0 {
+		return builder, fmt.Errorf("GRPCRoute %s in namespace %s must be attached to at least one Gateway",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	unstructuredGRPCRoute, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured GRPCRoute to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetGRPCRouteGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredGRPCRoute}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create GRPCRoute %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertGRPCRouteToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing GRPCRoute object with the GRPCRoute definition in builder.
+func (builder *GRPCRouteBuilder) Update() (*GRPCRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to update GRPCRoute, object doesn't exist on cluster")
+	}
+
+	logging.V(100).Infof("Updating the GRPCRoute object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredGRPCRoute, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured GRPCRoute to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetGRPCRouteGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredGRPCRoute}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertGRPCRouteToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes GRPCRoute object from a cluster.
+func (builder *GRPCRouteBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the GRPCRoute object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetGRPCRouteGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete GRPCRoute: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until the GRPCRoute is accepted by every
+// Gateway it is attached to.
+func (builder *GRPCRouteBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until GRPCRoute %s in namespace %s is accepted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			return routeConditionTrueForAllParents(builder.Object.Spec.ParentRefs, builder.Object.Status,
+				builder.Object.Namespace, ConditionAccepted), nil
+		})
+}
+
+// GetGRPCRouteGVR returns GRPCRoute's GroupVersionResource which could be used for Clean function.
+func GetGRPCRouteGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "grpcroutes"}
+}
+
+// convertGRPCRouteToStructured converts the unstructured object returned by the dynamic client to a GRPCRoute.
+func convertGRPCRouteToStructured(unsObject *unstructured.Unstructured) (*gwtypes.GRPCRoute, error) {
+	grpcRoute := &gwtypes.GRPCRoute{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, grpcRoute)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to GRPCRoute object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return grpcRoute, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *GRPCRouteBuilder) validate() (bool, error) {
+	resourceCRD := "GRPCRoute"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,234 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	grpcRouteGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    GRPCRouteKind,
+	}
+	defaultGRPCRouteName      = "grpcroute-test"
+	defaultGRPCRouteNamespace = "gateway-test"
+)
+
+func TestNewGRPCRouteBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultGRPCRouteName,
+			namespace:     defaultGRPCRouteNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultGRPCRouteNamespace,
+			expectedError: "GRPCRoute 'name' cannot be empty",
+		},
+		{
+			name:          defaultGRPCRouteName,
+			namespace:     "",
+			expectedError: "GRPCRoute 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewGRPCRouteBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullGRPCRoute(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultGRPCRouteName,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultGRPCRouteName,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf("GRPCRoute object %s doesn't exist in namespace %s",
+				defaultGRPCRouteName, defaultGRPCRouteNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyGRPCRoute(testCase.name, defaultGRPCRouteNamespace, nil))
+		}
+
+		testBuilder, err := PullGRPCRoute(
+			buildGRPCRouteTestClientWithDummyObject(runtimeObjects), testCase.name, defaultGRPCRouteNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestGRPCRouteWithMethodRule(t *testing.T) {
+	testCases := []struct {
+		service       string
+		method        string
+		backendRefs   []gwtypes.BackendRef
+		expectedError string
+	}{
+		{
+			service:     "helloworld.Greeter",
+			method:      "SayHello",
+			backendRefs: []gwtypes.BackendRef{NewServiceBackendRef("grpc-app", 50051)},
+		},
+		{
+			service:     "helloworld.Greeter",
+			backendRefs: []gwtypes.BackendRef{NewServiceBackendRef("grpc-app", 50051)},
+		},
+		{
+			service:       "",
+			backendRefs:   []gwtypes.BackendRef{NewServiceBackendRef("grpc-app", 50051)},
+			expectedError: "GRPCRoute rule 'service' cannot be empty",
+		},
+		{
+			service:       "helloworld.Greeter",
+			expectedError: "GRPCRoute rule 'backendRefs' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidGRPCRouteBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithMethodRule(testCase.service, testCase.method, testCase.backendRefs...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Len(t, testBuilder.Definition.Spec.Rules, 1)
+			assert.Equal(t,
+				&gwtypes.GRPCMethodMatch{Type: "Exact", Service: testCase.service, Method: testCase.method},
+				testBuilder.Definition.Spec.Rules[0].Matches[0].Method)
+		}
+	}
+}
+
+func TestGRPCRouteCreate(t *testing.T) {
+	testCases := []struct {
+		testGRPCRoute *GRPCRouteBuilder
+		expectedError error
+	}{
+		{
+			testGRPCRoute: buildValidGRPCRouteBuilder(buildGRPCRouteTestClientWithDummyObject(nil)).
+				WithParentGateway(defaultGatewayName, defaultGatewayNamespace, "").
+				WithMethodRule("helloworld.Greeter", "", NewServiceBackendRef("grpc-app", 50051)),
+			expectedError: nil,
+		},
+		{
+			testGRPCRoute: buildValidGRPCRouteBuilder(buildGRPCRouteTestClientWithDummyObject(nil)),
+			expectedError: fmt.Errorf("GRPCRoute %s in namespace %s must be attached to at least one Gateway",
+				defaultGRPCRouteName, defaultGRPCRouteNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testGRPCRoute.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultGRPCRouteName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestGRPCRouteDelete(t *testing.T) {
+	testSettings := buildGRPCRouteTestClientWithDummyObject(
+		[]runtime.Object{buildDummyGRPCRoute(defaultGRPCRouteName, defaultGRPCRouteNamespace, nil)})
+	testBuilder := buildValidGRPCRouteBuilder(testSettings)
+
+	err := testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestGRPCRouteWaitUntilAccepted(t *testing.T) {
+	testCases := []struct {
+		parents       []gwtypes.RouteParentStatus
+		expectedError error
+	}{
+		{
+			parents: []gwtypes.RouteParentStatus{{
+				ParentRef:  defaultGatewayParentRef,
+				Conditions: []metav1.Condition{{Type: ConditionAccepted, Status: metav1.ConditionTrue}},
+			}},
+			expectedError: nil,
+		},
+		{
+			parents:       nil,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildGRPCRouteTestClientWithDummyObject([]runtime.Object{
+			buildDummyGRPCRoute(defaultGRPCRouteName, defaultGRPCRouteNamespace, testCase.parents)})
+		testBuilder := buildValidGRPCRouteBuilder(testSettings)
+
+		err := testBuilder.WaitUntilAccepted(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidGRPCRouteBuilder(apiClient *clients.Settings) *GRPCRouteBuilder {
+	return NewGRPCRouteBuilder(apiClient, defaultGRPCRouteName, defaultGRPCRouteNamespace)
+}
+
+func buildDummyGRPCRoute(name, namespace string, parents []gwtypes.RouteParentStatus) *gwtypes.GRPCRoute {
+	return &gwtypes.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: gwtypes.GRPCRouteSpec{
+			CommonRouteSpec: gwtypes.CommonRouteSpec{
+				ParentRefs: []gwtypes.ParentReference{{Name: defaultGatewayName, Namespace: defaultGatewayNamespace}},
+			},
+		},
+		Status: gwtypes.RouteStatus{
+			Parents: parents,
+		},
+	}
+}
+
+func buildGRPCRouteTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{grpcRouteGVK},
+	})
+}
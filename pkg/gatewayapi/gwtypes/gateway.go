@@ -0,0 +1,219 @@
+package gwtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GatewaySpec defines the desired state of Gateway.
+type GatewaySpec struct {
+	// GatewayClassName used for this Gateway. This is the name of a GatewayClass resource.
+	GatewayClassName string `json:"gatewayClassName"`
+	// Listeners associated with this Gateway. Listeners define logical endpoints that are bound on this Gateway's
+	// addresses. At least one Listener MUST be specified.
+	Listeners []Listener `json:"listeners"`
+	// Addresses requested for this Gateway.
+	// +optional
+	Addresses []GatewayAddress `json:"addresses,omitempty"`
+}
+
+// Listener embodies the concept of a logical endpoint where a Gateway accepts network connections.
+type Listener struct {
+	// Name is the name of the Listener. This name MUST be unique within a Gateway.
+	Name string `json:"name"`
+	// Hostname specifies the virtual hostname to match for protocol types that define this concept.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+	// Port is the network port.
+	Port int32 `json:"port"`
+	// Protocol specifies the network protocol this listener expects to receive.
+	Protocol string `json:"protocol"`
+	// TLS is the TLS configuration for the Listener. This field is required if the Protocol field is "HTTPS" or
+	// "TLS".
+	// +optional
+	TLS *GatewayTLSConfig `json:"tls,omitempty"`
+	// AllowedRoutes defines the types of routes that MAY be attached to a Listener and the trusted namespaces where
+	// those Route resources MAY be present.
+	// +optional
+	AllowedRoutes *AllowedRoutes `json:"allowedRoutes,omitempty"`
+}
+
+// GatewayTLSConfig describes a TLS configuration.
+type GatewayTLSConfig struct {
+	// Mode defines the TLS behavior for the TLS session initiated by the client, Terminate or Passthrough.
+	// +optional
+	Mode string `json:"mode,omitempty"`
+	// CertificateRefs contains a series of references to Kubernetes objects that contains TLS certificates and
+	// private keys.
+	// +optional
+	CertificateRefs []SecretObjectReference `json:"certificateRefs,omitempty"`
+}
+
+// SecretObjectReference identifies an API object including its namespace, defaulting to Secret.
+type SecretObjectReference struct {
+	// Group is the group of the referent. When unspecified or empty string, core API group is inferred.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is kind of the referent. Defaults to "Secret" when not specified.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the referent.
+	Name string `json:"name"`
+	// Namespace is the namespace of the referenced object. When unspecified, the local namespace is inferred.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AllowedRoutes defines which Routes may be attached to this Listener.
+type AllowedRoutes struct {
+	// Namespaces indicates namespaces from which Routes may be attached to this Listener.
+	// +optional
+	Namespaces *RouteNamespaces `json:"namespaces,omitempty"`
+	// Kinds specifies the groups and kinds of Routes that are allowed to bind to this Gateway Listener.
+	// +optional
+	Kinds []RouteGroupKind `json:"kinds,omitempty"`
+}
+
+// RouteNamespaces indicate which namespaces Routes should be selected from.
+type RouteNamespaces struct {
+	// From indicates where Routes will be selected for this Gateway: All, Selector or Same.
+	// +optional
+	From string `json:"from,omitempty"`
+	// Selector must be specified when From is set to "Selector".
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// RouteGroupKind indicates the group and kind of a Route resource.
+type RouteGroupKind struct {
+	// Group is the group of the Route.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the Route.
+	Kind string `json:"kind"`
+}
+
+// GatewayAddress describes an address that can be bound to a Gateway.
+type GatewayAddress struct {
+	// Type of the address.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Value of the address. The validity of the values will depend on the type and support by the controller.
+	Value string `json:"value"`
+}
+
+// GatewayStatus defines the observed state of Gateway.
+type GatewayStatus struct {
+	// Addresses lists the network addresses that have been bound to the Gateway.
+	// +optional
+	Addresses []GatewayAddress `json:"addresses,omitempty"`
+	// Conditions describe the current conditions of the Gateway.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Listeners provide status for each unique listener port defined in the Spec.
+	// +optional
+	Listeners []ListenerStatus `json:"listeners,omitempty"`
+}
+
+// ListenerStatus is the status associated with a Listener.
+type ListenerStatus struct {
+	// Name is the name of the Listener that this status corresponds to.
+	Name string `json:"name"`
+	// SupportedKinds is the list indicating the Kinds supported by this listener.
+	SupportedKinds []RouteGroupKind `json:"supportedKinds"`
+	// AttachedRoutes represents the total number of Routes that have been successfully attached to this Listener.
+	AttachedRoutes int32 `json:"attachedRoutes"`
+	// Conditions describe the current condition of this listener.
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// Gateway represents an instance of a service-traffic handling infrastructure by binding Listeners to a set of IP
+// addresses.
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of Gateway.
+	Spec GatewaySpec `json:"spec"`
+	// Status defines the current state of Gateway.
+	// +optional
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// GatewayList contains a list of Gateways.
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gateway.
+func (in *Gateway) DeepCopy() *Gateway {
+	if in == nil {
+		return nil
+	}
+
+	out := new(Gateway)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.GatewayClassName = in.Spec.GatewayClassName
+
+	if in.Spec.Listeners != nil {
+		out.Spec.Listeners = make([]Listener, len(in.Spec.Listeners))
+
+		for index, listener := range in.Spec.Listeners {
+			if listener.TLS != nil {
+				tls := *listener.TLS
+				tls.CertificateRefs = append([]SecretObjectReference{}, listener.TLS.CertificateRefs...)
+				listener.TLS = &tls
+			}
+
+			if listener.AllowedRoutes != nil {
+				allowedRoutes := *listener.AllowedRoutes
+				allowedRoutes.Kinds = append([]RouteGroupKind{}, listener.AllowedRoutes.Kinds...)
+
+				if listener.AllowedRoutes.Namespaces != nil {
+					allowedRoutes.Namespaces = &RouteNamespaces{
+						From:     listener.AllowedRoutes.Namespaces.From,
+						Selector: listener.AllowedRoutes.Namespaces.Selector.DeepCopy(),
+					}
+				}
+
+				listener.AllowedRoutes = &allowedRoutes
+			}
+
+			out.Spec.Listeners[index] = listener
+		}
+	}
+
+	if in.Spec.Addresses != nil {
+		out.Spec.Addresses = append([]GatewayAddress{}, in.Spec.Addresses...)
+	}
+
+	if in.Status.Addresses != nil {
+		out.Status.Addresses = append([]GatewayAddress{}, in.Status.Addresses...)
+	}
+
+	out.Status.Conditions = copyConditions(in.Status.Conditions)
+
+	if in.Status.Listeners != nil {
+		out.Status.Listeners = make([]ListenerStatus, len(in.Status.Listeners))
+
+		for index, listenerStatus := range in.Status.Listeners {
+			listenerStatus.SupportedKinds = append([]RouteGroupKind{}, listenerStatus.SupportedKinds...)
+			listenerStatus.Conditions = copyConditions(listenerStatus.Conditions)
+			out.Status.Listeners[index] = listenerStatus
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Gateway) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
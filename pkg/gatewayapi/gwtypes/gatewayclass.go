@@ -0,0 +1,89 @@
+package gwtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GatewayClassSpec reflects the configuration of a class of Gateways.
+type GatewayClassSpec struct {
+	// ControllerName is the name of the controller that is managing Gateways of this class.
+	ControllerName string `json:"controllerName"`
+	// ParametersRef is a reference to a resource that contains the configuration parameters corresponding to the
+	// GatewayClass.
+	// +optional
+	ParametersRef *ParametersReference `json:"parametersRef,omitempty"`
+	// Description helps describe a GatewayClass with more details.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// ParametersReference identifies an API object containing controller-specific configuration resource within the
+// cluster.
+type ParametersReference struct {
+	// Group is the group of the referent.
+	Group string `json:"group"`
+	// Kind is kind of the referent.
+	Kind string `json:"kind"`
+	// Name is the name of the referent.
+	Name string `json:"name"`
+	// Namespace is the namespace of the referent.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// GatewayClassStatus is the current status for the GatewayClass.
+type GatewayClassStatus struct {
+	// Conditions is the current status from the controller for this GatewayClass.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// GatewayClass describes a class of Gateways available to the user for creating Gateway resources.
+type GatewayClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of GatewayClass.
+	Spec GatewayClassSpec `json:"spec"`
+	// Status defines the current state of GatewayClass.
+	// +optional
+	Status GatewayClassStatus `json:"status,omitempty"`
+}
+
+// GatewayClassList contains a list of GatewayClass.
+type GatewayClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayClass `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClass.
+func (in *GatewayClass) DeepCopy() *GatewayClass {
+	if in == nil {
+		return nil
+	}
+
+	out := new(GatewayClass)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+
+	if in.Spec.ParametersRef != nil {
+		parametersRef := *in.Spec.ParametersRef
+		out.Spec.ParametersRef = &parametersRef
+	}
+
+	out.Status.Conditions = copyConditions(in.Status.Conditions)
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayClass) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
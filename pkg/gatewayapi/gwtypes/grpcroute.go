@@ -0,0 +1,149 @@
+package gwtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GRPCRouteSpec defines the desired state of GRPCRoute.
+type GRPCRouteSpec struct {
+	CommonRouteSpec `json:",inline"`
+	// Hostnames defines a set of hostnames to match against the GRPC Host header to select a GRPCRoute to process
+	// the request.
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Rules are a list of GRPC matchers, filters and actions.
+	// +optional
+	Rules []GRPCRouteRule `json:"rules,omitempty"`
+}
+
+// GRPCRouteRule defines the semantics for matching a gRPC request based on conditions (matches) and forwarding the
+// request to an API object (backendRefs).
+type GRPCRouteRule struct {
+	// Matches define conditions used for matching the rule against incoming gRPC requests.
+	// +optional
+	Matches []GRPCRouteMatch `json:"matches,omitempty"`
+	// BackendRefs defines the backend(s) where matching requests should be sent.
+	// +optional
+	BackendRefs []GRPCBackendRef `json:"backendRefs,omitempty"`
+}
+
+// GRPCRouteMatch defines the predicate used to match requests to a given action.
+type GRPCRouteMatch struct {
+	// Method specifies a gRPC request service/method matcher.
+	// +optional
+	Method *GRPCMethodMatch `json:"method,omitempty"`
+	// Headers specifies gRPC request header matchers.
+	// +optional
+	Headers []GRPCHeaderMatch `json:"headers,omitempty"`
+}
+
+// GRPCMethodMatch describes how to select a gRPC route by matching the gRPC request service and/or method.
+type GRPCMethodMatch struct {
+	// Type specifies how to match against the service and/or method: Exact or RegularExpression.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Value of the service to match against. If left empty or omitted, will match any service.
+	// +optional
+	Service string `json:"service,omitempty"`
+	// Value of the method to match against. If left empty or omitted, will match all services.
+	// +optional
+	Method string `json:"method,omitempty"`
+}
+
+// GRPCHeaderMatch describes how to select a gRPC route by matching gRPC request headers.
+type GRPCHeaderMatch struct {
+	// Type specifies how to match against the value of the header: Exact or RegularExpression.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Name is the name of the gRPC Header to be matched.
+	Name string `json:"name"`
+	// Value is the value of the gRPC Header to be matched.
+	Value string `json:"value"`
+}
+
+// GRPCBackendRef defines how a GRPCRoute forwards a gRPC request.
+type GRPCBackendRef struct {
+	BackendRef `json:",inline"`
+}
+
+// GRPCRoute provides a way to route gRPC requests.
+type GRPCRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of GRPCRoute.
+	Spec GRPCRouteSpec `json:"spec,omitempty"`
+	// Status defines the current state of GRPCRoute.
+	// +optional
+	Status RouteStatus `json:"status,omitempty"`
+}
+
+// GRPCRouteList contains a list of GRPCRoute.
+type GRPCRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCRoute `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRoute.
+func (in *GRPCRoute) DeepCopy() *GRPCRoute {
+	if in == nil {
+		return nil
+	}
+
+	out := new(GRPCRoute)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.ParentRefs != nil {
+		out.Spec.ParentRefs = append([]ParentReference{}, in.Spec.ParentRefs...)
+	}
+
+	if in.Spec.Hostnames != nil {
+		out.Spec.Hostnames = append([]string{}, in.Spec.Hostnames...)
+	}
+
+	if in.Spec.Rules != nil {
+		out.Spec.Rules = make([]GRPCRouteRule, len(in.Spec.Rules))
+
+		for index, rule := range in.Spec.Rules {
+			if rule.Matches != nil {
+				rule.Matches = make([]GRPCRouteMatch, len(in.Spec.Rules[index].Matches))
+
+				for matchIndex, match := range in.Spec.Rules[index].Matches {
+					if match.Method != nil {
+						method := *match.Method
+						match.Method = &method
+					}
+
+					match.Headers = append([]GRPCHeaderMatch{}, match.Headers...)
+					rule.Matches[matchIndex] = match
+				}
+			}
+
+			if rule.BackendRefs != nil {
+				rule.BackendRefs = make([]GRPCBackendRef, len(in.Spec.Rules[index].BackendRefs))
+
+				for refIndex, backendRef := range in.Spec.Rules[index].BackendRefs {
+					rule.BackendRefs[refIndex] = GRPCBackendRef{BackendRef: *backendRef.BackendRef.DeepCopy()}
+				}
+			}
+
+			out.Spec.Rules[index] = rule
+		}
+	}
+
+	out.Status = *in.Status.DeepCopy()
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCRoute) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
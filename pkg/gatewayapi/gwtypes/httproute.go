@@ -0,0 +1,149 @@
+package gwtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// HTTPRouteSpec defines the desired state of HTTPRoute.
+type HTTPRouteSpec struct {
+	CommonRouteSpec `json:",inline"`
+	// Hostnames defines a set of hostnames that should match against the HTTP Host header to select a HTTPRoute
+	// used to process the request.
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Rules are a list of HTTP matchers, filters and actions.
+	// +optional
+	Rules []HTTPRouteRule `json:"rules,omitempty"`
+}
+
+// HTTPRouteRule defines semantics for matching an HTTP request based on conditions (matches) and forwarding the
+// request to an API object (backendRefs).
+type HTTPRouteRule struct {
+	// Matches define conditions used for matching the rule against incoming HTTP requests.
+	// +optional
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+	// BackendRefs defines the backend(s) where matching requests should be sent.
+	// +optional
+	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+}
+
+// HTTPRouteMatch defines the predicate used to match requests to a given action.
+type HTTPRouteMatch struct {
+	// Path specifies a HTTP request path matcher.
+	// +optional
+	Path *HTTPPathMatch `json:"path,omitempty"`
+	// Headers specifies HTTP request header matchers.
+	// +optional
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// Method specifies HTTP method matcher.
+	// +optional
+	Method string `json:"method,omitempty"`
+}
+
+// HTTPPathMatch describes how to select a HTTP route by matching the HTTP request path.
+type HTTPPathMatch struct {
+	// Type specifies how to match against the path Value: Exact, PathPrefix or RegularExpression.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Value of the HTTP path to match against.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// HTTPHeaderMatch describes how to select a HTTP route by matching HTTP request headers.
+type HTTPHeaderMatch struct {
+	// Type specifies how to match against the value of the header: Exact or RegularExpression.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Name is the name of the HTTP Header to be matched.
+	Name string `json:"name"`
+	// Value is the value of HTTP Header to be matched.
+	Value string `json:"value"`
+}
+
+// HTTPBackendRef defines how a HTTPRoute forwards a HTTP request.
+type HTTPBackendRef struct {
+	BackendRef `json:",inline"`
+}
+
+// HTTPRoute provides a way to route HTTP requests.
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of HTTPRoute.
+	Spec HTTPRouteSpec `json:"spec"`
+	// Status defines the current state of HTTPRoute.
+	// +optional
+	Status RouteStatus `json:"status,omitempty"`
+}
+
+// HTTPRouteList contains a list of HTTPRoute.
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPRoute `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRoute.
+func (in *HTTPRoute) DeepCopy() *HTTPRoute {
+	if in == nil {
+		return nil
+	}
+
+	out := new(HTTPRoute)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.ParentRefs != nil {
+		out.Spec.ParentRefs = append([]ParentReference{}, in.Spec.ParentRefs...)
+	}
+
+	if in.Spec.Hostnames != nil {
+		out.Spec.Hostnames = append([]string{}, in.Spec.Hostnames...)
+	}
+
+	if in.Spec.Rules != nil {
+		out.Spec.Rules = make([]HTTPRouteRule, len(in.Spec.Rules))
+
+		for index, rule := range in.Spec.Rules {
+			if rule.Matches != nil {
+				rule.Matches = make([]HTTPRouteMatch, len(in.Spec.Rules[index].Matches))
+
+				for matchIndex, match := range in.Spec.Rules[index].Matches {
+					if match.Path != nil {
+						path := *match.Path
+						match.Path = &path
+					}
+
+					match.Headers = append([]HTTPHeaderMatch{}, match.Headers...)
+					rule.Matches[matchIndex] = match
+				}
+			}
+
+			if rule.BackendRefs != nil {
+				rule.BackendRefs = make([]HTTPBackendRef, len(in.Spec.Rules[index].BackendRefs))
+
+				for refIndex, backendRef := range in.Spec.Rules[index].BackendRefs {
+					rule.BackendRefs[refIndex] = HTTPBackendRef{BackendRef: *backendRef.BackendRef.DeepCopy()}
+				}
+			}
+
+			out.Spec.Rules[index] = rule
+		}
+	}
+
+	out.Status = *in.Status.DeepCopy()
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPRoute) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package gwtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ParentReference identifies an API object, usually a Gateway, that a route wants to be attached to.
+type ParentReference struct {
+	// Group is the group of the referent. When unspecified, "gateway.networking.k8s.io" is inferred.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is kind of the referent. When unspecified, "Gateway" is inferred.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Namespace is the namespace of the referent. When unspecified, this refers to the local namespace of the Route.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the referent.
+	Name string `json:"name"`
+	// SectionName is the name of a section within the target resource, the listener name for a Gateway.
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+	// Port is the network port this Route targets.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// CommonRouteSpec defines the common attributes that all Routes must include within their spec.
+type CommonRouteSpec struct {
+	// ParentRefs references the resources, usually Gateways, that a Route wants to be attached to.
+	// +optional
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+}
+
+// BackendObjectReference defines how an ObjectReference that is specific to BackendRef.
+type BackendObjectReference struct {
+	// Group is the group of the referent. When unspecified or empty string, core API group is inferred.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the Kubernetes resource kind of the referent. Defaults to "Service" when not specified.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the referent.
+	Name string `json:"name"`
+	// Namespace is the namespace of the backend. When unspecified, the local namespace is inferred.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Port specifies the destination port number to use for this resource. Required when the referent is a
+	// Kubernetes Service.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// BackendRef defines how a Route should forward a request to a Kubernetes resource.
+type BackendRef struct {
+	BackendObjectReference `json:",inline"`
+	// Weight specifies the proportion of requests forwarded to the referenced backend. Defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// RouteParentStatus describes the status of a route with respect to an associated Parent.
+type RouteParentStatus struct {
+	// ParentRef corresponds with a ParentRef in the spec that this RouteParentStatus struct describes the status of.
+	ParentRef ParentReference `json:"parentRef"`
+	// ControllerName is a domain/path string that indicates the name of the controller that wrote this status.
+	ControllerName string `json:"controllerName"`
+	// Conditions describes the status of the route with respect to the Gateway.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// RouteStatus defines the common attributes that all Routes must include within their status.
+type RouteStatus struct {
+	// Parents is a list of parent resources (usually Gateways) that are associated with the route, and the status
+	// of the route with respect to each parent.
+	Parents []RouteParentStatus `json:"parents"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
+func (in *RouteStatus) DeepCopy() *RouteStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := new(RouteStatus)
+
+	if in.Parents != nil {
+		out.Parents = make([]RouteParentStatus, len(in.Parents))
+
+		for index, parent := range in.Parents {
+			parent.Conditions = copyConditions(parent.Conditions)
+			out.Parents[index] = parent
+		}
+	}
+
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendRef.
+func (in *BackendRef) DeepCopy() *BackendRef {
+	if in == nil {
+		return nil
+	}
+
+	out := new(BackendRef)
+	out.BackendObjectReference = in.BackendObjectReference
+
+	if in.Weight != nil {
+		weight := *in.Weight
+		out.Weight = &weight
+	}
+
+	return out
+}
+
+// copyConditions returns a copy of the conditions preserving nil.
+func copyConditions(conditions []metav1.Condition) []metav1.Condition {
+	if conditions == nil {
+		return nil
+	}
+
+	return append([]metav1.Condition{}, conditions...)
+}
@@ -0,0 +1,445 @@
+package gatewayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// HTTPRouteBuilder provides struct for the HTTPRoute object containing connection to the cluster and the HTTPRoute
+// definitions.
+type HTTPRouteBuilder struct {
+	// HTTPRoute definition. Used to create the HTTPRoute object.
+	Definition *gwtypes.HTTPRoute
+	// Created HTTPRoute object.
+	Object *gwtypes.HTTPRoute
+	// Used in functions that define or mutate HTTPRoute definition. errorMsg is processed before the HTTPRoute
+	// object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewHTTPRouteBuilder creates a new instance of HTTPRouteBuilder. The route must be attached to at least one
+// Gateway with WithParentGateway before it is created.
+func NewHTTPRouteBuilder(apiClient *clients.Settings, name, nsname string) *HTTPRouteBuilder {
+	logging.V(100).Infof(
+		"Initializing new HTTPRoute structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := HTTPRouteBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       HTTPRouteKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the HTTPRoute is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("HTTPRoute 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the HTTPRoute is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("HTTPRoute 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullHTTPRoute pulls existing HTTPRoute from cluster.
+func PullHTTPRoute(apiClient *clients.Settings, name, nsname string) (*HTTPRouteBuilder, error) {
+	logging.V(100).Infof("Pulling existing HTTPRoute name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("HTTPRoute 'apiClient' cannot be empty")
+	}
+
+	builder := HTTPRouteBuilder{
+		apiClient: apiClient,
+		Definition: &gwtypes.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the HTTPRoute is empty")
+
+		return nil, fmt.Errorf("HTTPRoute 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the HTTPRoute is empty")
+
+		return nil, fmt.Errorf("HTTPRoute 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("HTTPRoute object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithParentGateway attaches the HTTPRoute to the Gateway. An empty gatewayNamespace refers to the namespace of the
+// route and an empty sectionName attaches the route to all the listeners of the Gateway.
+func (builder *HTTPRouteBuilder) WithParentGateway(
+	gatewayName, gatewayNamespace, sectionName string) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Attaching HTTPRoute %s in namespace %s to Gateway %s in namespace %s, section %s",
+		builder.Definition.Name, builder.Definition.Namespace, gatewayName, gatewayNamespace, sectionName)
+
+	parentRef, err := newGatewayParentRef(gatewayName, gatewayNamespace, sectionName)
+	if err != nil {
+		logging.V(100).Infof("Invalid parent Gateway for HTTPRoute %s: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("HTTPRoute parentRef %w", err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ParentRefs = append(builder.Definition.Spec.ParentRefs, parentRef)
+
+	return builder
+}
+
+// WithHostname adds a hostname matched against the Host header of the requests.
+func (builder *HTTPRouteBuilder) WithHostname(hostname string) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding hostname %s to HTTPRoute %s in namespace %s",
+		hostname, builder.Definition.Name, builder.Definition.Namespace)
+
+	if hostname == "" {
+		logging.V(100).Infof("The hostname of the HTTPRoute is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("HTTPRoute 'hostname' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Hostnames = append(builder.Definition.Spec.Hostnames, hostname)
+
+	return builder
+}
+
+// WithPathRule adds a rule forwarding the requests whose path matches to the backends. pathType is one of
+// PathMatchExact, PathMatchPathPrefix or PathMatchRegularExpression.
+func (builder *HTTPRouteBuilder) WithPathRule(
+	pathType, path string, backendRefs ...gwtypes.BackendRef) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding %s path rule %s to HTTPRoute %s in namespace %s",
+		pathType, path, builder.Definition.Name, builder.Definition.Namespace)
+
+	switch pathType {
+	case PathMatchExact, PathMatchPathPrefix:
+		if !strings.HasPrefix(path, "/") {
+			logging.V(100).Infof("The path %s of the HTTPRoute rule does not start with /", path)
+
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("HTTPRoute rule 'path' %s must start with /", path))
+
+			return builder
+		}
+	case PathMatchRegularExpression:
+		if path == "" {
+			logging.V(100).Infof("The path of the HTTPRoute rule is empty")
+
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("HTTPRoute rule 'path' cannot be empty"))
+
+			return builder
+		}
+	default:
+		logging.V(100).Infof("The path type %s of the HTTPRoute rule is not supported", pathType)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"HTTPRoute rule 'pathType' must be %s, %s or %s, got %s",
+			PathMatchExact, PathMatchPathPrefix, PathMatchRegularExpression, pathType))
+
+		return builder
+	}
+
+	return builder.WithRule(gwtypes.HTTPRouteRule{
+		Matches: []gwtypes.HTTPRouteMatch{{Path: &gwtypes.HTTPPathMatch{Type: pathType, Value: path}}},
+	}, backendRefs...)
+}
+
+// WithRule adds a rule with arbitrary matches forwarding the matching requests to the backends. Any backendRefs
+// already set in the rule are replaced.
+func (builder *HTTPRouteBuilder) WithRule(
+	rule gwtypes.HTTPRouteRule, backendRefs ...gwtypes.BackendRef) *HTTPRouteBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding rule with %d matches and %d backends to HTTPRoute %s in namespace %s",
+		len(rule.Matches), len(backendRefs), builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := validateBackendRefs(backendRefs); err != nil {
+		logging.V(100).Infof("Invalid backends for HTTPRoute %s rule: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("HTTPRoute rule %w", err))
+
+		return builder
+	}
+
+	rule.BackendRefs = nil
+
+	for _, backendRef := range backendRefs {
+		rule.BackendRefs = append(rule.BackendRefs, gwtypes.HTTPBackendRef{BackendRef: backendRef})
+	}
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, rule)
+
+	return builder
+}
+
+// Get returns HTTPRoute object if found.
+func (builder *HTTPRouteBuilder) Get() (*gwtypes.HTTPRoute, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting HTTPRoute object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetHTTPRouteGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("HTTPRoute object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertHTTPRouteToStructured(unsObject)
+}
+
+// Exists checks whether the given HTTPRoute exists.
+func (builder *HTTPRouteBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if HTTPRoute %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an HTTPRoute in the cluster and stores the created object in struct.
+func (builder *HTTPRouteBuilder) Create() (*HTTPRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the HTTPRoute %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.ParentRefs) == 0 {
+		return builder, fmt.Errorf("HTTPRoute %s in namespace %s must be attached to at least one Gateway",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	unstructuredHTTPRoute, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured HTTPRoute to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetHTTPRouteGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredHTTPRoute}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create HTTPRoute %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertHTTPRouteToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing HTTPRoute object with the HTTPRoute definition in builder.
+func (builder *HTTPRouteBuilder) Update() (*HTTPRouteBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to update HTTPRoute, object doesn't exist on cluster")
+	}
+
+	logging.V(100).Infof("Updating the HTTPRoute object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredHTTPRoute, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured HTTPRoute to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetHTTPRouteGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredHTTPRoute}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertHTTPRouteToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes HTTPRoute object from a cluster.
+func (builder *HTTPRouteBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the HTTPRoute object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetHTTPRouteGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete HTTPRoute: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilAccepted waits for the duration of the defined timeout or until the HTTPRoute is accepted by every
+// Gateway it is attached to.
+func (builder *HTTPRouteBuilder) WaitUntilAccepted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until HTTPRoute %s in namespace %s is accepted",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				return false, nil
+			}
+
+			return routeConditionTrueForAllParents(builder.Object.Spec.ParentRefs, builder.Object.Status,
+				builder.Object.Namespace, ConditionAccepted), nil
+		})
+}
+
+// GetHTTPRouteGVR returns HTTPRoute's GroupVersionResource which could be used for Clean function.
+func GetHTTPRouteGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "httproutes"}
+}
+
+// convertHTTPRouteToStructured converts the unstructured object returned by the dynamic client to an HTTPRoute.
+func convertHTTPRouteToStructured(unsObject *unstructured.Unstructured) (*gwtypes.HTTPRoute, error) {
+	httpRoute := &gwtypes.HTTPRoute{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, httpRoute)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to HTTPRoute object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return httpRoute, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *HTTPRouteBuilder) validate() (bool, error) {
+	resourceCRD := "HTTPRoute"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,393 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	httpRouteGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    HTTPRouteKind,
+	}
+	defaultHTTPRouteName      = "httproute-test"
+	defaultHTTPRouteNamespace = "gateway-test"
+	defaultGatewayParentRef   = gwtypes.ParentReference{
+		Group:     APIGroup,
+		Kind:      GatewayKind,
+		Name:      defaultGatewayName,
+		Namespace: defaultGatewayNamespace,
+	}
+)
+
+func TestNewHTTPRouteBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultHTTPRouteName,
+			namespace:     defaultHTTPRouteNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultHTTPRouteNamespace,
+			expectedError: "HTTPRoute 'name' cannot be empty",
+		},
+		{
+			name:          defaultHTTPRouteName,
+			namespace:     "",
+			expectedError: "HTTPRoute 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewHTTPRouteBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullHTTPRoute(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultHTTPRouteName,
+			namespace:           defaultHTTPRouteNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			namespace:           defaultHTTPRouteNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("HTTPRoute 'name' cannot be empty"),
+		},
+		{
+			name:                defaultHTTPRouteName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("HTTPRoute 'namespace' cannot be empty"),
+		},
+		{
+			name:                defaultHTTPRouteName,
+			namespace:           defaultHTTPRouteNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("HTTPRoute object %s doesn't exist in namespace %s",
+				defaultHTTPRouteName, defaultHTTPRouteNamespace),
+		},
+		{
+			name:                defaultHTTPRouteName,
+			namespace:           defaultHTTPRouteNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("HTTPRoute 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyHTTPRoute(testCase.name, testCase.namespace, nil))
+		}
+
+		if testCase.client {
+			testSettings = buildHTTPRouteTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullHTTPRoute(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestHTTPRouteWithParentGateway(t *testing.T) {
+	testCases := []struct {
+		gatewayName      string
+		gatewayNamespace string
+		sectionName      string
+		expectedError    string
+	}{
+		{
+			gatewayName:      defaultGatewayName,
+			gatewayNamespace: defaultGatewayNamespace,
+			sectionName:      "http",
+			expectedError:    "",
+		},
+		{
+			gatewayName:      "",
+			gatewayNamespace: defaultGatewayNamespace,
+			expectedError:    "HTTPRoute parentRef 'gatewayName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHTTPRouteBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithParentGateway(testCase.gatewayName, testCase.gatewayNamespace, testCase.sectionName)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, []gwtypes.ParentReference{{
+				Group:       APIGroup,
+				Kind:        GatewayKind,
+				Name:        testCase.gatewayName,
+				Namespace:   testCase.gatewayNamespace,
+				SectionName: testCase.sectionName,
+			}}, testBuilder.Definition.Spec.ParentRefs)
+		}
+	}
+}
+
+func TestHTTPRouteWithHostname(t *testing.T) {
+	testBuilder := buildValidHTTPRouteBuilder(clients.GetTestClients(clients.TestClientParams{}))
+	testBuilder = testBuilder.WithHostname("app.example.com")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, []string{"app.example.com"}, testBuilder.Definition.Spec.Hostnames)
+
+	testBuilder = testBuilder.WithHostname("")
+	assert.EqualError(t, testBuilder.errorMsg, "HTTPRoute 'hostname' cannot be empty")
+}
+
+func TestHTTPRouteWithPathRule(t *testing.T) {
+	testCases := []struct {
+		pathType      string
+		path          string
+		backendRefs   []gwtypes.BackendRef
+		expectedError string
+	}{
+		{
+			pathType:    PathMatchPathPrefix,
+			path:        "/api",
+			backendRefs: []gwtypes.BackendRef{NewServiceBackendRef("app", 8080)},
+		},
+		{
+			pathType: PathMatchExact,
+			path:     "/",
+			backendRefs: []gwtypes.BackendRef{
+				NewWeightedServiceBackendRef("app-v1", 8080, 90),
+				NewWeightedServiceBackendRef("app-v2", 8080, 10),
+			},
+		},
+		{
+			pathType:    PathMatchRegularExpression,
+			path:        "^/v[0-9]+/",
+			backendRefs: []gwtypes.BackendRef{NewServiceBackendRef("app", 8080)},
+		},
+		{
+			pathType:      PathMatchPathPrefix,
+			path:          "api",
+			backendRefs:   []gwtypes.BackendRef{NewServiceBackendRef("app", 8080)},
+			expectedError: "HTTPRoute rule 'path' api must start with /",
+		},
+		{
+			pathType:      "Prefix",
+			path:          "/api",
+			backendRefs:   []gwtypes.BackendRef{NewServiceBackendRef("app", 8080)},
+			expectedError: "HTTPRoute rule 'pathType' must be Exact, PathPrefix or RegularExpression, got Prefix",
+		},
+		{
+			pathType:      PathMatchPathPrefix,
+			path:          "/api",
+			expectedError: "HTTPRoute rule 'backendRefs' cannot be empty",
+		},
+		{
+			pathType:      PathMatchPathPrefix,
+			path:          "/api",
+			backendRefs:   []gwtypes.BackendRef{NewServiceBackendRef("app", 0)},
+			expectedError: "HTTPRoute rule backendRef app 'port' 0 is out of range",
+		},
+		{
+			pathType:      PathMatchPathPrefix,
+			path:          "/api",
+			backendRefs:   []gwtypes.BackendRef{NewWeightedServiceBackendRef("app", 8080, -1)},
+			expectedError: "HTTPRoute rule backendRef app 'weight' -1 cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHTTPRouteBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithPathRule(testCase.pathType, testCase.path, testCase.backendRefs...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Len(t, testBuilder.Definition.Spec.Rules, 1)
+
+			rule := testBuilder.Definition.Spec.Rules[0]
+			assert.Equal(t, &gwtypes.HTTPPathMatch{Type: testCase.pathType, Value: testCase.path}, rule.Matches[0].Path)
+			assert.Len(t, rule.BackendRefs, len(testCase.backendRefs))
+
+			for index, backendRef := range testCase.backendRefs {
+				assert.Equal(t, backendRef, rule.BackendRefs[index].BackendRef)
+			}
+		}
+	}
+}
+
+func TestHTTPRouteCreate(t *testing.T) {
+	testCases := []struct {
+		testHTTPRoute *HTTPRouteBuilder
+		expectedError error
+	}{
+		{
+			testHTTPRoute: buildValidHTTPRouteBuilder(buildHTTPRouteTestClientWithDummyObject(nil)).
+				WithParentGateway(defaultGatewayName, defaultGatewayNamespace, "").
+				WithPathRule(PathMatchPathPrefix, "/", NewServiceBackendRef("app", 8080)),
+			expectedError: nil,
+		},
+		{
+			testHTTPRoute: buildValidHTTPRouteBuilder(buildHTTPRouteTestClientWithDummyObject(nil)),
+			expectedError: fmt.Errorf("HTTPRoute %s in namespace %s must be attached to at least one Gateway",
+				defaultHTTPRouteName, defaultHTTPRouteNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testHTTPRoute.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultHTTPRouteName, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Spec.ParentRefs, testBuilder.Object.Spec.ParentRefs)
+		}
+	}
+}
+
+func TestHTTPRouteUpdate(t *testing.T) {
+	testSettings := buildHTTPRouteTestClientWithDummyObject(
+		[]runtime.Object{buildDummyHTTPRoute(defaultHTTPRouteName, defaultHTTPRouteNamespace, nil)})
+
+	testBuilder, err := PullHTTPRoute(testSettings, defaultHTTPRouteName, defaultHTTPRouteNamespace)
+	assert.Nil(t, err)
+
+	testBuilder, err = testBuilder.WithHostname("app.example.com").Update()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"app.example.com"}, testBuilder.Object.Spec.Hostnames)
+
+	_, err = buildValidHTTPRouteBuilder(buildHTTPRouteTestClientWithDummyObject(nil)).Update()
+	assert.EqualError(t, err, "failed to update HTTPRoute, object doesn't exist on cluster")
+}
+
+func TestHTTPRouteDelete(t *testing.T) {
+	testCases := []struct {
+		testHTTPRoute *HTTPRouteBuilder
+		expectedError error
+	}{
+		{
+			testHTTPRoute: buildValidHTTPRouteBuilder(buildHTTPRouteTestClientWithDummyObject(
+				[]runtime.Object{buildDummyHTTPRoute(defaultHTTPRouteName, defaultHTTPRouteNamespace, nil)})),
+			expectedError: nil,
+		},
+		{
+			testHTTPRoute: buildValidHTTPRouteBuilder(buildHTTPRouteTestClientWithDummyObject(nil)),
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testHTTPRoute.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testHTTPRoute.Object)
+		}
+	}
+}
+
+func TestHTTPRouteWaitUntilAccepted(t *testing.T) {
+	testCases := []struct {
+		parents       []gwtypes.RouteParentStatus
+		expectedError error
+	}{
+		{
+			parents: []gwtypes.RouteParentStatus{{
+				ParentRef:  defaultGatewayParentRef,
+				Conditions: []metav1.Condition{{Type: ConditionAccepted, Status: metav1.ConditionTrue}},
+			}},
+			expectedError: nil,
+		},
+		{
+			parents: []gwtypes.RouteParentStatus{{
+				ParentRef:  defaultGatewayParentRef,
+				Conditions: []metav1.Condition{{Type: ConditionAccepted, Status: metav1.ConditionFalse}},
+			}},
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+		{
+			parents:       nil,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildHTTPRouteTestClientWithDummyObject([]runtime.Object{
+			buildDummyHTTPRoute(defaultHTTPRouteName, defaultHTTPRouteNamespace, testCase.parents)})
+		testBuilder := buildValidHTTPRouteBuilder(testSettings)
+
+		err := testBuilder.WaitUntilAccepted(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidHTTPRouteBuilder(apiClient *clients.Settings) *HTTPRouteBuilder {
+	return NewHTTPRouteBuilder(apiClient, defaultHTTPRouteName, defaultHTTPRouteNamespace)
+}
+
+func buildDummyHTTPRoute(name, namespace string, parents []gwtypes.RouteParentStatus) *gwtypes.HTTPRoute {
+	return &gwtypes.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: gwtypes.HTTPRouteSpec{
+			CommonRouteSpec: gwtypes.CommonRouteSpec{
+				ParentRefs: []gwtypes.ParentReference{{Name: defaultGatewayName, Namespace: defaultGatewayNamespace}},
+			},
+		},
+		Status: gwtypes.RouteStatus{
+			Parents: parents,
+		},
+	}
+}
+
+func buildHTTPRouteTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{httpRouteGVK},
+	})
+}
@@ -0,0 +1,112 @@
+package gatewayapi
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/gatewayapi/gwtypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// NewServiceBackendRef returns a reference to the port of a Service in the namespace of the route, to be used as
+// backend of the route rules.
+func NewServiceBackendRef(serviceName string, port int32) gwtypes.BackendRef {
+	return gwtypes.BackendRef{
+		BackendObjectReference: gwtypes.BackendObjectReference{
+			Name: serviceName,
+			Port: port,
+		},
+	}
+}
+
+// NewWeightedServiceBackendRef returns a reference to the port of a Service in the namespace of the route receiving
+// weight out of the sum of the weights of all the backends of the rule.
+func NewWeightedServiceBackendRef(serviceName string, port, weight int32) gwtypes.BackendRef {
+	backendRef := NewServiceBackendRef(serviceName, port)
+	backendRef.Weight = &weight
+
+	return backendRef
+}
+
+// newGatewayParentRef returns a reference to the Gateway, optionally restricted to one of its listeners.
+func newGatewayParentRef(gatewayName, gatewayNamespace, sectionName string) (gwtypes.ParentReference, error) {
+	if gatewayName == "" {
+		return gwtypes.ParentReference{}, fmt.Errorf("'gatewayName' cannot be empty")
+	}
+
+	return gwtypes.ParentReference{
+		Group:       APIGroup,
+		Kind:        GatewayKind,
+		Name:        gatewayName,
+		Namespace:   gatewayNamespace,
+		SectionName: sectionName,
+	}, nil
+}
+
+// validateBackendRefs checks that there is at least one backend and that all of them are valid Service references.
+func validateBackendRefs(backendRefs []gwtypes.BackendRef) error {
+	if len(backendRefs) == 0 {
+		return fmt.Errorf("'backendRefs' cannot be empty")
+	}
+
+	for _, backendRef := range backendRefs {
+		if backendRef.Name == "" {
+			return fmt.Errorf("backendRef 'name' cannot be empty")
+		}
+
+		if backendRef.Port < 1 || backendRef.Port > 65535 {
+			return fmt.Errorf("backendRef %s 'port' %d is out of range", backendRef.Name, backendRef.Port)
+		}
+
+		if backendRef.Weight != nil && *backendRef.Weight < 0 {
+			return fmt.Errorf("backendRef %s 'weight' %d cannot be negative", backendRef.Name, *backendRef.Weight)
+		}
+	}
+
+	return nil
+}
+
+// routeConditionTrueForAllParents returns true if the condition is true in the status of the route for every parent
+// it references. Parents without namespace default to the namespace of the route.
+func routeConditionTrueForAllParents(
+	parentRefs []gwtypes.ParentReference, status gwtypes.RouteStatus, routeNamespace, conditionType string) bool {
+	if len(parentRefs) == 0 {
+		return false
+	}
+
+	for _, parentRef := range parentRefs {
+		found := false
+
+		for _, parentStatus := range status.Parents {
+			if !sameParentRef(parentRef, parentStatus.ParentRef, routeNamespace) {
+				continue
+			}
+
+			found = meta.IsStatusConditionTrue(parentStatus.Conditions, conditionType)
+
+			break
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameParentRef returns true if both references point to the same parent section. Empty namespaces and kinds are
+// defaulted the same way the Gateway API does.
+func sameParentRef(first, second gwtypes.ParentReference, routeNamespace string) bool {
+	return first.Name == second.Name && first.SectionName == second.SectionName &&
+		valueOrDefault(first.Namespace, routeNamespace) == valueOrDefault(second.Namespace, routeNamespace) &&
+		valueOrDefault(first.Kind, GatewayKind) == valueOrDefault(second.Kind, GatewayKind)
+}
+
+// valueOrDefault returns value unless it is empty, in which case defaultValue is returned.
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
@@ -2,10 +2,11 @@ package hive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	hiveextV1Beta1 "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
@@ -21,7 +22,7 @@ import (
 type ClusterDeploymentBuilder struct {
 	Definition *hiveV1.ClusterDeployment
 	Object     *hiveV1.ClusterDeployment
-	errorMsg   string
+	errorMsg   error
 	apiClient  *clients.Settings
 }
 
@@ -38,7 +39,7 @@ func NewABMClusterDeploymentBuilder(
 	baseDomain string,
 	clusterInstallRef string,
 	agentSelector metav1.LabelSelector) *ClusterDeploymentBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		`Initializing new agentbaremetal clusterdeployment structure with the following params: name: %s, namespace: %s,
 		  clusterName: %s, baseDomain: %s, clusterInstallRef: %s, agentSelector: %s`,
 		name, nsname, clusterName, baseDomain, clusterInstallRef, agentSelector)
@@ -69,33 +70,33 @@ func NewABMClusterDeploymentBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterdeployment is empty")
+		logging.V(100).Infof("The name of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterdeployment 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterdeployment is empty")
+		logging.V(100).Infof("The namespace of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterdeployment 'namespace' cannot be empty"))
 	}
 
 	if clusterName == "" {
-		glog.V(100).Infof("The clusterName of the clusterdeployment is empty")
+		logging.V(100).Infof("The clusterName of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'clusterName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterdeployment 'clusterName' cannot be empty"))
 	}
 
 	if baseDomain == "" {
-		glog.V(100).Infof("The baseDomain of the clusterdeployment is empty")
+		logging.V(100).Infof("The baseDomain of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'baseDomain' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterdeployment 'baseDomain' cannot be empty"))
 	}
 
 	if clusterInstallRef == "" {
-		glog.V(100).Infof("The clusterInstallRef of the clusterdeployment is empty")
+		logging.V(100).Infof("The clusterInstallRef of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'clusterInstallRef' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterdeployment 'clusterInstallRef' cannot be empty"))
 	}
 
 	return &builder
@@ -109,23 +110,24 @@ func (builder *ClusterDeploymentBuilder) WithAdditionalAgentSelectorLabels(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding agentSelectors %s to clusterdeployment %s in namespace %s",
 		agentSelector, builder.Definition.Name, builder.Definition.Namespace)
 
 	if builder.Definition.Spec.Platform.AgentBareMetal == nil {
-		glog.V(100).Infof("The clusterdeployment platform is not agentBareMetal")
+		logging.V(100).Infof("The clusterdeployment platform is not agentBareMetal")
 
-		builder.errorMsg = "clusterdeployment type must be AgentBareMetal to use agentSelector"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"clusterdeployment type must be AgentBareMetal to use agentSelector"))
 	}
 
 	if len(agentSelector) == 0 {
-		glog.V(100).Infof("The clusterdeployment agentSelector is empty")
+		logging.V(100).Infof("The clusterdeployment agentSelector is empty")
 
-		builder.errorMsg = "agentSelector cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("agentSelector cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -146,7 +148,7 @@ func (builder *ClusterDeploymentBuilder) WithPullSecret(psName string) *ClusterD
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding pull-secret ref %s to clusterdeployment %s in namespace %s",
 		psName, builder.Definition.Name, builder.Definition.Namespace)
 
@@ -161,7 +163,7 @@ func (builder *ClusterDeploymentBuilder) Get() (*hiveV1.ClusterDeployment, error
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterdeployment %s in namespace %s",
+	logging.V(100).Infof("Getting clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	clusterDeployment := &hiveV1.ClusterDeployment{}
@@ -179,7 +181,7 @@ func (builder *ClusterDeploymentBuilder) Get() (*hiveV1.ClusterDeployment, error
 
 // PullClusterDeployment pulls existing clusterdeployment from cluster.
 func PullClusterDeployment(apiClient *clients.Settings, name, nsname string) (*ClusterDeploymentBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterdeployment name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing clusterdeployment name %s under namespace %s from cluster", name, nsname)
 
 	builder := ClusterDeploymentBuilder{
 		apiClient: apiClient,
@@ -192,15 +194,15 @@ func PullClusterDeployment(apiClient *clients.Settings, name, nsname string) (*C
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterdeployment is empty")
+		logging.V(100).Infof("The name of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterdeployment 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the clusterdeployment is empty")
+		logging.V(100).Infof("The namespace of the clusterdeployment is empty")
 
-		builder.errorMsg = "clusterdeployment 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterdeployment 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -218,7 +220,7 @@ func (builder *ClusterDeploymentBuilder) Create() (*ClusterDeploymentBuilder, er
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterdeployment %s in namespace %s",
+	logging.V(100).Infof("Creating the clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -239,16 +241,16 @@ func (builder *ClusterDeploymentBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterDeployment additional options")
+	logging.V(100).Infof("Setting ClusterDeployment additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -264,20 +266,20 @@ func (builder *ClusterDeploymentBuilder) Update(force bool) (*ClusterDeploymentB
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterdeployment %s in namespace %s",
+	logging.V(100).Infof("Updating clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("clusterdeployment", builder.Definition.Name, builder.Definition.Namespace))
 
 			err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("clusterdeployment", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -300,7 +302,7 @@ func (builder *ClusterDeploymentBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the clusterdeployment %s in namespace %s",
+	logging.V(100).Infof("Deleting the clusterdeployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -324,7 +326,7 @@ func (builder *ClusterDeploymentBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterdeployment %s exists in namespace %s",
+	logging.V(100).Infof("Checking if clusterdeployment %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -339,27 +341,29 @@ func (builder *ClusterDeploymentBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterDeployment"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -18,7 +18,7 @@ func ListClusterDeploymentsInAllNamespaces(
 	logMessage := "Listing all clusterdeployments"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -28,13 +28,13 @@ func ListClusterDeploymentsInAllNamespaces(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	clusterDeployments := new(hiveV1.ClusterDeploymentList)
 	err := apiClient.List(context.TODO(), clusterDeployments, &passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all clusterDeployments due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all clusterDeployments due to %s", err.Error())
 
 		return nil, err
 	}
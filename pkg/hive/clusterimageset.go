@@ -2,10 +2,11 @@ package hive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -18,7 +19,7 @@ import (
 type ClusterImageSetBuilder struct {
 	Definition *hiveV1.ClusterImageSet
 	Object     *hiveV1.ClusterImageSet
-	errorMsg   string
+	errorMsg   error
 	apiClient  *clients.Settings
 }
 
@@ -27,7 +28,7 @@ type ClusterImageSetAdditionalOptions func(builder *ClusterImageSetBuilder) (*Cl
 
 // NewClusterImageSetBuilder creates a new instance of ClusterImageSetBuilder.
 func NewClusterImageSetBuilder(apiClient *clients.Settings, name, releaseImage string) *ClusterImageSetBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		`Initializing new clusterimageset structure with the following params: name: %s, releaseImage: %s`,
 		name, releaseImage)
 
@@ -44,21 +45,21 @@ func NewClusterImageSetBuilder(apiClient *clients.Settings, name, releaseImage s
 	}
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is nil")
+		logging.V(100).Infof("The apiClient is nil")
 
-		builder.errorMsg = "clusterimageset cannot have nil apiClient"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterimageset cannot have nil apiClient"))
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterimageset is empty")
+		logging.V(100).Infof("The name of the clusterimageset is empty")
 
-		builder.errorMsg = "clusterimageset 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterimageset 'name' cannot be empty"))
 	}
 
 	if releaseImage == "" {
-		glog.V(100).Infof("The releaseImage of the clusterimageset is empty")
+		logging.V(100).Infof("The releaseImage of the clusterimageset is empty")
 
-		builder.errorMsg = "clusterimageset 'releaseImage' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterimageset 'releaseImage' cannot be empty"))
 	}
 
 	return &builder
@@ -70,16 +71,16 @@ func (builder *ClusterImageSetBuilder) WithReleaseImage(image string) *ClusterIm
 		return builder
 	}
 
-	glog.V(100).Infof("Setting clusterimageset %s releaseImage to %s",
+	logging.V(100).Infof("Setting clusterimageset %s releaseImage to %s",
 		builder.Definition.Name, image)
 
 	if image == "" {
-		glog.V(100).Infof("The clusterimageset releaseImage is empty")
+		logging.V(100).Infof("The clusterimageset releaseImage is empty")
 
-		builder.errorMsg = "cannot set releaseImage to empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot set releaseImage to empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -95,16 +96,16 @@ func (builder *ClusterImageSetBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterImageSet additional options")
+	logging.V(100).Infof("Setting ClusterImageSet additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -116,7 +117,7 @@ func (builder *ClusterImageSetBuilder) WithOptions(
 
 // PullClusterImageSet loads an existing clusterimageset into ClusterImageSetBuilder struct.
 func PullClusterImageSet(apiClient *clients.Settings, name string) (*ClusterImageSetBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterimageset name: %s", name)
+	logging.V(100).Infof("Pulling existing clusterimageset name: %s", name)
 
 	builder := ClusterImageSetBuilder{
 		apiClient: apiClient,
@@ -128,7 +129,7 @@ func PullClusterImageSet(apiClient *clients.Settings, name string) (*ClusterImag
 	}
 
 	if name == "" {
-		builder.errorMsg = "clusterimageset 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterimageset 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -146,7 +147,7 @@ func (builder *ClusterImageSetBuilder) Get() (*hiveV1.ClusterImageSet, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterimageset %s", builder.Definition.Name)
+	logging.V(100).Infof("Getting clusterimageset %s", builder.Definition.Name)
 
 	clusterimageset := &hiveV1.ClusterImageSet{}
 	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
@@ -166,7 +167,7 @@ func (builder *ClusterImageSetBuilder) Create() (*ClusterImageSetBuilder, error)
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterimageset %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating the clusterimageset %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -185,19 +186,19 @@ func (builder *ClusterImageSetBuilder) Update(force bool) (*ClusterImageSetBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterimageset %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating clusterimageset %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("clusterimageset", builder.Definition.Name, builder.Definition.Namespace))
 
 			err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("clusterimageset", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -220,7 +221,7 @@ func (builder *ClusterImageSetBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the clusterimageset %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the clusterimageset %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("clusterimageset cannot be deleted because it does not exist")
@@ -245,7 +246,7 @@ func (builder *ClusterImageSetBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterimageset %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if clusterimageset %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
@@ -259,27 +260,29 @@ func (builder *ClusterImageSetBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterImageSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -2,10 +2,11 @@ package hive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	hiveV1 "github.com/openshift/hive/apis/hive/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -18,7 +19,7 @@ import (
 type HiveConfigBuilder struct {
 	Definition *hiveV1.HiveConfig
 	Object     *hiveV1.HiveConfig
-	errorMsg   string
+	errorMsg   error
 	apiClient  runtimeClient.Client
 }
 
@@ -27,7 +28,7 @@ type HiveConfigAdditionalOptions func(builder *HiveConfigBuilder) (*HiveConfigBu
 
 // NewHiveConfigBuilder creates a new instance of HiveConfigBuilder.
 func NewHiveConfigBuilder(apiClient *clients.Settings, name string) *HiveConfigBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		`Initializing new HiveConfig structure with the following params: name: %s`, name)
 
 	builder := HiveConfigBuilder{
@@ -41,9 +42,9 @@ func NewHiveConfigBuilder(apiClient *clients.Settings, name string) *HiveConfigB
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the HiveConfig is empty")
+		logging.V(100).Infof("The name of the HiveConfig is empty")
 
-		builder.errorMsg = "hiveconfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("hiveconfig 'name' cannot be empty"))
 	}
 
 	return &builder
@@ -56,16 +57,16 @@ func (builder *HiveConfigBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting HiveConfig additional options")
+	logging.V(100).Infof("Setting HiveConfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -77,7 +78,7 @@ func (builder *HiveConfigBuilder) WithOptions(
 
 // PullHiveConfig loads an existing HiveConfig into HiveConfigBuilder struct.
 func PullHiveConfig(apiClient *clients.Settings, name string) (*HiveConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing HiveConfig name: %s", name)
+	logging.V(100).Infof("Pulling existing HiveConfig name: %s", name)
 
 	builder := HiveConfigBuilder{
 		apiClient: apiClient.Client,
@@ -89,7 +90,7 @@ func PullHiveConfig(apiClient *clients.Settings, name string) (*HiveConfigBuilde
 	}
 
 	if name == "" {
-		builder.errorMsg = "hiveconfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("hiveconfig 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -107,7 +108,7 @@ func (builder *HiveConfigBuilder) Get() (*hiveV1.HiveConfig, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting HiveConfig %s", builder.Definition.Name)
+	logging.V(100).Infof("Getting HiveConfig %s", builder.Definition.Name)
 
 	HiveConfig := &hiveV1.HiveConfig{}
 	err := builder.apiClient.Get(context.TODO(), runtimeClient.ObjectKey{
@@ -127,7 +128,7 @@ func (builder *HiveConfigBuilder) Update() (*HiveConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating HiveConfig %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating HiveConfig %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 	builder.Object = builder.Definition
@@ -141,7 +142,7 @@ func (builder *HiveConfigBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the HiveConfig %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the HiveConfig %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("hiveconfig cannot be deleted because it does not exist")
@@ -166,7 +167,7 @@ func (builder *HiveConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if hiveconfig %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if hiveconfig %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
@@ -180,27 +181,29 @@ func (builder *HiveConfigBuilder) validate() (bool, error) {
 	resourceCRD := "HiveConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
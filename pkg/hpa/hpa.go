@@ -0,0 +1,612 @@
+package hpa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// maxPolicyPeriodSeconds is the longest period a scaling policy may hold.
+	maxPolicyPeriodSeconds = 1800
+	// maxStabilizationWindowSeconds is the longest stabilization window of a scaling direction.
+	maxStabilizationWindowSeconds = 3600
+)
+
+// Builder provides struct for horizontalpodautoscaler object containing connection to the cluster and the
+// horizontalpodautoscaler definitions.
+type Builder struct {
+	// HorizontalPodAutoscaler definition. Used to create the horizontalpodautoscaler object.
+	Definition *autoscalingv2.HorizontalPodAutoscaler
+	// Created horizontalpodautoscaler object.
+	Object *autoscalingv2.HorizontalPodAutoscaler
+	// Used in functions that define or mutate horizontalpodautoscaler definition. errorMsg is processed before the
+	// horizontalpodautoscaler object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// AdditionalOptions additional options for horizontalpodautoscaler object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder scaling the workload referenced by scaleTargetRef between
+// minReplicas and maxReplicas.
+func NewBuilder(
+	apiClient *clients.Settings,
+	name, nsname string,
+	scaleTargetRef autoscalingv2.CrossVersionObjectReference,
+	minReplicas, maxReplicas int32) *Builder {
+	logging.V(100).Infof(
+		"Initializing new horizontalpodautoscaler structure with the following params: name: %s, namespace: %s, "+
+			"scaleTargetRef: %v, minReplicas: %d, maxReplicas: %d",
+		name, nsname, scaleTargetRef, minReplicas, maxReplicas)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: scaleTargetRef,
+				MinReplicas:    &minReplicas,
+				MaxReplicas:    maxReplicas,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the horizontalpodautoscaler is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("horizontalpodautoscaler 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the horizontalpodautoscaler is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler 'namespace' cannot be empty"))
+	}
+
+	if scaleTargetRef.Kind == "" || scaleTargetRef.Name == "" {
+		logging.V(100).Infof("The scaleTargetRef of the horizontalpodautoscaler is incomplete")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler 'scaleTargetRef' must have kind and name"))
+	}
+
+	if minReplicas < 1 {
+		logging.V(100).Infof("The minReplicas of the horizontalpodautoscaler is not positive")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler 'minReplicas' must be positive"))
+	}
+
+	if maxReplicas < minReplicas {
+		logging.V(100).Infof("The maxReplicas of the horizontalpodautoscaler is lower than minReplicas")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler 'maxReplicas' cannot be lower than 'minReplicas'"))
+	}
+
+	return &builder
+}
+
+// Pull loads an existing horizontalpodautoscaler into Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing horizontalpodautoscaler name: %s under namespace: %s", name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("horizontalpodautoscaler 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler 'namespace' cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("horizontalpodautoscaler object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithCPUUtilization sets the target average CPU utilization of the pods, as a percentage of their CPU requests.
+// It replaces a CPU target set before.
+func (builder *Builder) WithCPUUtilization(utilization int32) *Builder {
+	return builder.withResourceUtilization(corev1.ResourceCPU, utilization)
+}
+
+// WithMemoryUtilization sets the target average memory utilization of the pods, as a percentage of their memory
+// requests. It replaces a memory target set before.
+func (builder *Builder) WithMemoryUtilization(utilization int32) *Builder {
+	return builder.withResourceUtilization(corev1.ResourceMemory, utilization)
+}
+
+// WithCustomMetric adds a target average value for a custom metric reported for each pod of the scale target, as
+// served by the custom metrics API. The selector is optional and narrows down the metric series.
+func (builder *Builder) WithCustomMetric(
+	metricName string, selector *metav1.LabelSelector, averageValue resource.Quantity) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding custom metric %s with average value %s to horizontalpodautoscaler %s in namespace %s",
+		metricName, averageValue.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if metricName == "" {
+		logging.V(100).Infof("The custom metric name is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler custom metric 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if averageValue.Sign() <= 0 {
+		logging.V(100).Infof("The custom metric average value is not positive")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler custom metric %s 'averageValue' must be positive", metricName))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Metrics = append(builder.Definition.Spec.Metrics, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: metricName, Selector: selector},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: &averageValue,
+			},
+		},
+	})
+
+	return builder
+}
+
+// WithExternalMetric adds a target for a metric not related to any Kubernetes object, as served by the external
+// metrics API. The target must be of type Value or AverageValue with the matching field set. The selector is
+// optional and narrows down the metric series.
+func (builder *Builder) WithExternalMetric(
+	metricName string, selector *metav1.LabelSelector, target autoscalingv2.MetricTarget) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding external metric %s with target %v to horizontalpodautoscaler %s in namespace %s",
+		metricName, target, builder.Definition.Name, builder.Definition.Namespace)
+
+	if metricName == "" {
+		logging.V(100).Infof("The external metric name is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler external metric 'name' cannot be empty"))
+
+		return builder
+	}
+
+	validTarget := (target.Type == autoscalingv2.ValueMetricType && target.Value != nil) ||
+		(target.Type == autoscalingv2.AverageValueMetricType && target.AverageValue != nil)
+
+	if !validTarget {
+		logging.V(100).Infof("The external metric target is invalid")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"horizontalpodautoscaler external metric %s 'target' must be of type Value or AverageValue with the "+
+				"matching field set", metricName))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Metrics = append(builder.Definition.Spec.Metrics, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ExternalMetricSourceType,
+		External: &autoscalingv2.ExternalMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: metricName, Selector: selector},
+			Target: target,
+		},
+	})
+
+	return builder
+}
+
+// WithScaleUpBehavior sets the scaling policies and the stabilization window used when scaling up.
+func (builder *Builder) WithScaleUpBehavior(rules autoscalingv2.HPAScalingRules) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting scale up behavior %v in horizontalpodautoscaler %s in namespace %s",
+		rules, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := validateScalingRules(rules)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("horizontalpodautoscaler scale up %w", err))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Behavior == nil {
+		builder.Definition.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{}
+	}
+
+	builder.Definition.Spec.Behavior.ScaleUp = &rules
+
+	return builder
+}
+
+// WithScaleDownBehavior sets the scaling policies and the stabilization window used when scaling down.
+func (builder *Builder) WithScaleDownBehavior(rules autoscalingv2.HPAScalingRules) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting scale down behavior %v in horizontalpodautoscaler %s in namespace %s",
+		rules, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := validateScalingRules(rules)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("horizontalpodautoscaler scale down %w", err))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Behavior == nil {
+		builder.Definition.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{}
+	}
+
+	builder.Definition.Spec.Behavior.ScaleDown = &rules
+
+	return builder
+}
+
+// WithOptions creates horizontalpodautoscaler with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting horizontalpodautoscaler additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a horizontalpodautoscaler in cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating horizontalpodautoscaler %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
+		builder.Object, err = builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+			builder.Definition.Namespace).Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for horizontalpodautoscaler %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the horizontalpodautoscaler definition against the API server and its admission webhooks with a
+// server-side dry-run request. The horizontalpodautoscaler is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the horizontalpodautoscaler %s in namespace %s in dry-run mode",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+		builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the existing horizontalpodautoscaler object with the horizontalpodautoscaler definition in
+// builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating horizontalpodautoscaler %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent horizontalpodautoscaler %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+		builder.Definition.Namespace).Update(context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Exists checks whether the given horizontalpodautoscaler exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if horizontalpodautoscaler %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+		builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the horizontalpodautoscaler from the cluster. The replicas of the scale target are left as they
+// are.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting horizontalpodautoscaler %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilDesiredReplicas waits for the duration of the defined timeout or until the horizontalpodautoscaler
+// reports the given number of desired replicas.
+func (builder *Builder) WaitUntilDesiredReplicas(replicas int32, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until horizontalpodautoscaler %s in namespace %s "+
+		"desires %d replicas", builder.Definition.Name, builder.Definition.Namespace, replicas)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for horizontalpodautoscaler desired replicas because it does not exist")
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.K8sClient.AutoscalingV2().HorizontalPodAutoscalers(
+				builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				return false, nil
+			}
+
+			return builder.Object.Status.DesiredReplicas == replicas, nil
+		})
+}
+
+// GetGVR returns horizontalpodautoscaler's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}
+}
+
+// GetGVR returns the horizontalpodautoscaler GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// withResourceUtilization sets the target average utilization of the given resource, replacing a target set
+// before for the same resource.
+func (builder *Builder) withResourceUtilization(resourceName corev1.ResourceName, utilization int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting %s utilization %d%% in horizontalpodautoscaler %s in namespace %s",
+		resourceName, utilization, builder.Definition.Name, builder.Definition.Namespace)
+
+	if utilization < 1 {
+		logging.V(100).Infof("The %s utilization is not positive", resourceName)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("horizontalpodautoscaler %s utilization must be positive", resourceName))
+
+		return builder
+	}
+
+	metric := autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: resourceName,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+
+	for index, existingMetric := range builder.Definition.Spec.Metrics {
+		if existingMetric.Resource != nil && existingMetric.Resource.Name == resourceName {
+			builder.Definition.Spec.Metrics[index] = metric
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Metrics = append(builder.Definition.Spec.Metrics, metric)
+
+	return builder
+}
+
+// validateScalingRules checks the policies and the stabilization window of a scaling direction.
+func validateScalingRules(rules autoscalingv2.HPAScalingRules) error {
+	if rules.StabilizationWindowSeconds != nil &&
+		(*rules.StabilizationWindowSeconds < 0 || *rules.StabilizationWindowSeconds > maxStabilizationWindowSeconds) {
+		return fmt.Errorf("'stabilizationWindowSeconds' must be between 0 and %d", maxStabilizationWindowSeconds)
+	}
+
+	for _, policy := range rules.Policies {
+		if policy.Type != autoscalingv2.PodsScalingPolicy && policy.Type != autoscalingv2.PercentScalingPolicy {
+			return fmt.Errorf("policy type %s is not supported", policy.Type)
+		}
+
+		if policy.Value < 1 {
+			return fmt.Errorf("policy 'value' must be positive")
+		}
+
+		if policy.PeriodSeconds < 1 || policy.PeriodSeconds > maxPolicyPeriodSeconds {
+			return fmt.Errorf("policy 'periodSeconds' must be between 1 and %d", maxPolicyPeriodSeconds)
+		}
+	}
+
+	return nil
+}
+
+// Diff compares the horizontalpodautoscaler definition with the horizontalpodautoscaler object on the cluster. Only the
+// fields set in the definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing horizontalpodautoscaler %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"horizontalpodautoscaler object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "HorizontalPodAutoscaler"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,390 @@
+package hpa
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var (
+	defaultHPAName      = "test-hpa"
+	defaultHPANamespace = "test-namespace"
+	defaultScaleTarget  = autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "test-deployment",
+	}
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		nsname         string
+		scaleTargetRef autoscalingv2.CrossVersionObjectReference
+		minReplicas    int32
+		maxReplicas    int32
+		expectedError  string
+	}{
+		{
+			name:           defaultHPAName,
+			nsname:         defaultHPANamespace,
+			scaleTargetRef: defaultScaleTarget,
+			minReplicas:    1,
+			maxReplicas:    3,
+			expectedError:  "",
+		},
+		{
+			name:           "",
+			nsname:         defaultHPANamespace,
+			scaleTargetRef: defaultScaleTarget,
+			minReplicas:    1,
+			maxReplicas:    3,
+			expectedError:  "horizontalpodautoscaler 'name' cannot be empty",
+		},
+		{
+			name:           defaultHPAName,
+			nsname:         "",
+			scaleTargetRef: defaultScaleTarget,
+			minReplicas:    1,
+			maxReplicas:    3,
+			expectedError:  "horizontalpodautoscaler 'namespace' cannot be empty",
+		},
+		{
+			name:           defaultHPAName,
+			nsname:         defaultHPANamespace,
+			scaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment"},
+			minReplicas:    1,
+			maxReplicas:    3,
+			expectedError:  "horizontalpodautoscaler 'scaleTargetRef' must have kind and name",
+		},
+		{
+			name:           defaultHPAName,
+			nsname:         defaultHPANamespace,
+			scaleTargetRef: defaultScaleTarget,
+			minReplicas:    0,
+			maxReplicas:    3,
+			expectedError:  "horizontalpodautoscaler 'minReplicas' must be positive",
+		},
+		{
+			name:           defaultHPAName,
+			nsname:         defaultHPANamespace,
+			scaleTargetRef: defaultScaleTarget,
+			minReplicas:    3,
+			maxReplicas:    2,
+			expectedError:  "horizontalpodautoscaler 'maxReplicas' cannot be lower than 'minReplicas'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			testCase.name, testCase.nsname, testCase.scaleTargetRef, testCase.minReplicas, testCase.maxReplicas)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.minReplicas, *testBuilder.Definition.Spec.MinReplicas)
+		assert.Equal(t, testCase.maxReplicas, testBuilder.Definition.Spec.MaxReplicas)
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			name:          defaultHPAName,
+			addToRuntime:  true,
+			expectedError: "",
+		},
+		{
+			name:          defaultHPAName,
+			addToRuntime:  false,
+			expectedError: "horizontalpodautoscaler object test-hpa doesn't exist in namespace test-namespace",
+		},
+		{
+			name:          "",
+			addToRuntime:  false,
+			expectedError: "horizontalpodautoscaler 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyHPA(1))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := Pull(testSettings, testCase.name, defaultHPANamespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestWithResourceUtilization(t *testing.T) {
+	testBuilder := buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithCPUUtilization(50).
+		WithMemoryUtilization(70).
+		WithCPUUtilization(80)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Len(t, testBuilder.Definition.Spec.Metrics, 2)
+	assert.Equal(t, corev1.ResourceCPU, testBuilder.Definition.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, int32(80), *testBuilder.Definition.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	assert.Equal(t, corev1.ResourceMemory, testBuilder.Definition.Spec.Metrics[1].Resource.Name)
+
+	testBuilder = buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})).WithCPUUtilization(0)
+	assert.EqualError(t, testBuilder.errorMsg, "horizontalpodautoscaler cpu utilization must be positive")
+}
+
+func TestWithCustomAndExternalMetric(t *testing.T) {
+	testCases := []struct {
+		customName     string
+		customValue    resource.Quantity
+		externalName   string
+		externalTarget autoscalingv2.MetricTarget
+		expectedError  string
+	}{
+		{
+			customName:   "requests_per_second",
+			customValue:  resource.MustParse("100"),
+			externalName: "queue_messages_ready",
+			externalTarget: autoscalingv2.MetricTarget{
+				Type: autoscalingv2.ValueMetricType, Value: ptr.To(resource.MustParse("30"))},
+			expectedError: "",
+		},
+		{
+			customName:   "",
+			customValue:  resource.MustParse("100"),
+			externalName: "queue_messages_ready",
+			externalTarget: autoscalingv2.MetricTarget{
+				Type: autoscalingv2.ValueMetricType, Value: ptr.To(resource.MustParse("30"))},
+			expectedError: "horizontalpodautoscaler custom metric 'name' cannot be empty",
+		},
+		{
+			customName:   "requests_per_second",
+			customValue:  resource.MustParse("0"),
+			externalName: "queue_messages_ready",
+			externalTarget: autoscalingv2.MetricTarget{
+				Type: autoscalingv2.ValueMetricType, Value: ptr.To(resource.MustParse("30"))},
+			expectedError: "horizontalpodautoscaler custom metric requests_per_second 'averageValue' must be positive",
+		},
+		{
+			customName:   "requests_per_second",
+			customValue:  resource.MustParse("100"),
+			externalName: "queue_messages_ready",
+			externalTarget: autoscalingv2.MetricTarget{
+				Type: autoscalingv2.AverageValueMetricType, Value: ptr.To(resource.MustParse("30"))},
+			expectedError: "horizontalpodautoscaler external metric queue_messages_ready 'target' must be of type " +
+				"Value or AverageValue with the matching field set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithCustomMetric(testCase.customName, nil, testCase.customValue).
+			WithExternalMetric(testCase.externalName, &metav1.LabelSelector{
+				MatchLabels: map[string]string{"queue": "orders"}}, testCase.externalTarget)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Len(t, testBuilder.Definition.Spec.Metrics, 2)
+		assert.Equal(t, autoscalingv2.PodsMetricSourceType, testBuilder.Definition.Spec.Metrics[0].Type)
+		assert.Equal(t, autoscalingv2.ExternalMetricSourceType, testBuilder.Definition.Spec.Metrics[1].Type)
+	}
+}
+
+func TestWithScalingBehavior(t *testing.T) {
+	testCases := []struct {
+		rules         autoscalingv2.HPAScalingRules
+		expectedError string
+	}{
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: ptr.To[int32](60),
+				Policies: []autoscalingv2.HPAScalingPolicy{
+					{Type: autoscalingv2.PercentScalingPolicy, Value: 50, PeriodSeconds: 30}},
+			},
+			expectedError: "",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: ptr.To[int32](4000),
+			},
+			expectedError: "horizontalpodautoscaler scale up 'stabilizationWindowSeconds' must be between 0 and 3600",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				Policies: []autoscalingv2.HPAScalingPolicy{{Type: "Nodes", Value: 1, PeriodSeconds: 30}},
+			},
+			expectedError: "horizontalpodautoscaler scale up policy type Nodes is not supported",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				Policies: []autoscalingv2.HPAScalingPolicy{
+					{Type: autoscalingv2.PodsScalingPolicy, Value: 0, PeriodSeconds: 30}},
+			},
+			expectedError: "horizontalpodautoscaler scale up policy 'value' must be positive",
+		},
+		{
+			rules: autoscalingv2.HPAScalingRules{
+				Policies: []autoscalingv2.HPAScalingPolicy{
+					{Type: autoscalingv2.PodsScalingPolicy, Value: 1, PeriodSeconds: 3600}},
+			},
+			expectedError: "horizontalpodautoscaler scale up policy 'periodSeconds' must be between 1 and 1800",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithScaleUpBehavior(testCase.rules).
+			WithScaleDownBehavior(autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: ptr.To[int32](300)})
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.rules, *testBuilder.Definition.Spec.Behavior.ScaleUp)
+		assert.Equal(t, int32(300), *testBuilder.Definition.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+	}
+}
+
+func TestCreateUpdateAndDelete(t *testing.T) {
+	testBuilder, err := buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithCPUUtilization(50).
+		Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	testBuilder.Definition.Spec.MaxReplicas = 5
+	testBuilder, err = testBuilder.Update()
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), testBuilder.Object.Spec.MaxReplicas)
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestWaitUntilDesiredReplicas(t *testing.T) {
+	testCases := []struct {
+		desiredReplicas int32
+		addToRuntime    bool
+		expectedError   string
+	}{
+		{
+			desiredReplicas: 2,
+			addToRuntime:    true,
+			expectedError:   "",
+		},
+		{
+			desiredReplicas: 3,
+			addToRuntime:    true,
+			expectedError:   "context deadline exceeded",
+		},
+		{
+			desiredReplicas: 2,
+			addToRuntime:    false,
+			expectedError:   "cannot wait for horizontalpodautoscaler desired replicas because it does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyHPA(2))
+		}
+
+		testBuilder := buildValidHPABuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		err := testBuilder.WaitUntilDesiredReplicas(testCase.desiredReplicas, time.Second)
+
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidHPABuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultHPAName, defaultHPANamespace, defaultScaleTarget, 1, 3)
+}
+
+func buildDummyHPA(desiredReplicas int32) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultHPAName,
+			Namespace: defaultHPANamespace,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: defaultScaleTarget,
+			MinReplicas:    ptr.To[int32](1),
+			MaxReplicas:    3,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{DesiredReplicas: desiredReplicas},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidHPABuilder(testSettings)
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("horizontalpodautoscaler object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "horizontalpodautoscalers", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidHPABuilder(testSettings).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidHPABuilder(existingSettings).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
@@ -2,10 +2,11 @@ package icsp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1alpha1 "github.com/openshift/api/operator/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -21,7 +22,7 @@ type ICSPBuilder struct {
 	// Used in functions that defines or mutates ImageContentSourcePolicy definition.
 	// errorMsg is processed before the ImageContentSourcePolicy object is created.
 	apiClient *clients.Settings
-	errorMsg  string
+	errorMsg  error
 }
 
 // AdditionalOptions additional options for ImageContentSourcePolicy object.
@@ -29,7 +30,7 @@ type AdditionalOptions func(builder *ICSPBuilder) (*ICSPBuilder, error)
 
 // NewICSPBuilder creates a new instance of ICSPBuilder.
 func NewICSPBuilder(apiClient *clients.Settings, name, source string, mirrors []string) *ICSPBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new ICSPBuilder structure with the following params: "+
 			"name: %s, source: %s, mirrors: %v\n",
 		name, source, mirrors)
@@ -52,21 +53,24 @@ func NewICSPBuilder(apiClient *clients.Settings, name, source string, mirrors []
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the ImageContentSourcePolicy is empty")
+		logging.V(100).Infof("The name of the ImageContentSourcePolicy is empty")
 
-		icspBuilder.errorMsg = "ImageContentSourcePolicy 'name' cannot be empty"
+		icspBuilder.errorMsg = errors.Join(icspBuilder.errorMsg, fmt.Errorf(
+			"ImageContentSourcePolicy 'name' cannot be empty"))
 	}
 
 	if source == "" {
-		glog.V(100).Infof("The Source of the ImageContentSourcePolicy is empty")
+		logging.V(100).Infof("The Source of the ImageContentSourcePolicy is empty")
 
-		icspBuilder.errorMsg = "ImageContentSourcePolicy 'source' cannot be empty"
+		icspBuilder.errorMsg = errors.Join(icspBuilder.errorMsg, fmt.Errorf(
+			"ImageContentSourcePolicy 'source' cannot be empty"))
 	}
 
 	if len(mirrors) == 0 {
-		glog.V(100).Infof("The mirrors of the ImageContentSourcePolicy are empty")
+		logging.V(100).Infof("The mirrors of the ImageContentSourcePolicy are empty")
 
-		icspBuilder.errorMsg = "ImageContentSourcePolicy 'mirrors' cannot be empty"
+		icspBuilder.errorMsg = errors.Join(icspBuilder.errorMsg, fmt.Errorf(
+			"ImageContentSourcePolicy 'mirrors' cannot be empty"))
 	}
 
 	return icspBuilder
@@ -78,7 +82,7 @@ func (builder *ICSPBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if ImageContentSourcePolicy %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if ImageContentSourcePolicy %s exists", builder.Definition.Name)
 
 	var err error
 
@@ -90,7 +94,7 @@ func (builder *ICSPBuilder) Exists() bool {
 
 // Pull pulls object definition from cluster to ICSPBuilder struct.
 func Pull(apiClient *clients.Settings, name string) (*ICSPBuilder, error) {
-	glog.V(100).Infof("Pulling existing ImageContentSourcePolicy: %s", name)
+	logging.V(100).Infof("Pulling existing ImageContentSourcePolicy: %s", name)
 
 	builder := ICSPBuilder{
 		apiClient: apiClient,
@@ -102,7 +106,7 @@ func Pull(apiClient *clients.Settings, name string) (*ICSPBuilder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "ImageContentSourcePolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ImageContentSourcePolicy 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -120,7 +124,7 @@ func (builder *ICSPBuilder) Create() (*ICSPBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating ImageContentPolicy %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating ImageContentPolicy %s", builder.Definition.Name)
 
 	var err error
 
@@ -138,7 +142,7 @@ func (builder *ICSPBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting ImageContentSourcePolicy %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting ImageContentSourcePolicy %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
@@ -162,7 +166,7 @@ func (builder *ICSPBuilder) Update() (*ICSPBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Updating the ImageContentSourcePolicy %s with the definition in the ICSPbuilder", builder.Definition.Name)
 
 	var err error
@@ -177,18 +181,18 @@ func (builder *ICSPBuilder) Update() (*ICSPBuilder, error) {
 // WithRepositoryDigestMirror adds new RipositoryDigestMirror.
 func (builder *ICSPBuilder) WithRepositoryDigestMirror(source string, mirrors []string) *ICSPBuilder {
 	if source == "" {
-		glog.V(100).Infof("The source is empty")
+		logging.V(100).Infof("The source is empty")
 
-		builder.errorMsg = "'source' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'source' cannot be empty"))
 	}
 
 	if len(mirrors) == 0 {
-		glog.V(100).Infof("Mirrors is empty")
+		logging.V(100).Infof("Mirrors is empty")
 
-		builder.errorMsg = "'mirrors' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'mirrors' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -204,16 +208,16 @@ func (builder *ICSPBuilder) WithOptions(options ...AdditionalOptions) *ICSPBuild
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ImageContentPolicy additional options")
+	logging.V(100).Infof("Setting ImageContentPolicy additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -229,27 +233,29 @@ func (builder *ICSPBuilder) validate() (bool, error) {
 	resourceCRD := "ImageContentSourcePolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
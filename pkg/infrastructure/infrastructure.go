@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -28,7 +28,7 @@ type Builder struct {
 
 // Pull loads an existing infrastructure into Builder struct.
 func Pull(apiClient *clients.Settings) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing infrastructure name: %s", infrastructureName)
+	logging.V(100).Infof("Pulling existing infrastructure name: %s", infrastructureName)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -54,7 +54,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if infrastructure %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if infrastructure %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.ConfigV1Interface.Infrastructures().Get(
@@ -69,19 +69,19 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Infrastructure"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
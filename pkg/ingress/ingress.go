@@ -0,0 +1,495 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	netv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// IngressBuilder provides struct for the networking.k8s.io/v1 Ingress object. It is meant for workloads exposed
+// through an ingress class rather than an OpenShift Route.
+type IngressBuilder struct {
+	// Ingress definition. Used to create the ingress object with minimum set of required elements.
+	Definition *netv1.Ingress
+	// Created ingress object on the cluster.
+	Object *netv1.Ingress
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// errorMsg is processed before the ingress object is created.
+	errorMsg error
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// NewIngressBuilder method creates new instance of IngressBuilder.
+func NewIngressBuilder(apiClient *clients.Settings, name, nsname string) *IngressBuilder {
+	logging.V(100).Infof(
+		"Initializing new IngressBuilder structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	builder := &IngressBuilder{
+		apiClient: apiClient,
+		Definition: &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the ingress is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ingress 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the ingress is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ingress 'namespace' cannot be empty"))
+	}
+
+	return builder
+}
+
+// PullIngress loads an existing ingress into the IngressBuilder struct.
+func PullIngress(apiClient *clients.Settings, name, nsname string) (*IngressBuilder, error) {
+	logging.V(100).Infof("Pulling existing ingress name: %s namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient of the ingress is nil")
+
+		return nil, fmt.Errorf("ingress 'apiClient' cannot be empty")
+	}
+
+	builder := NewIngressBuilder(apiClient, name, nsname)
+
+	if builder.errorMsg != nil {
+		return nil, fmt.Errorf("failed to pull ingress object due to the following error: %w", builder.errorMsg)
+	}
+
+	if !builder.Exists() {
+		logging.V(100).Infof("Failed to pull ingress object %s from namespace %s. Object doesn't exist",
+			name, nsname)
+
+		return nil, fmt.Errorf("ingress object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return builder, nil
+}
+
+// NewIngressPath returns an ingress path routing requests matching path to the given service port. The path type
+// must be one of Exact, Prefix or ImplementationSpecific; Exact and Prefix paths must be absolute.
+func NewIngressPath(
+	pathType netv1.PathType, path, serviceName string, servicePort int32) (netv1.HTTPIngressPath, error) {
+	logging.V(100).Infof("Creating ingress path %s of type %s to service %s port %d",
+		path, pathType, serviceName, servicePort)
+
+	switch pathType {
+	case netv1.PathTypeExact, netv1.PathTypePrefix:
+		if !strings.HasPrefix(path, "/") {
+			return netv1.HTTPIngressPath{}, fmt.Errorf("ingress path '%s' of type %s must start with '/'",
+				path, pathType)
+		}
+	case netv1.PathTypeImplementationSpecific:
+	default:
+		return netv1.HTTPIngressPath{}, fmt.Errorf(
+			"ingress 'pathType' %s is not supported, must be %s, %s or %s", pathType,
+			netv1.PathTypeExact, netv1.PathTypePrefix, netv1.PathTypeImplementationSpecific)
+	}
+
+	backend, err := newServiceBackend(serviceName, servicePort)
+	if err != nil {
+		return netv1.HTTPIngressPath{}, err
+	}
+
+	return netv1.HTTPIngressPath{
+		Path:     path,
+		PathType: &pathType,
+		Backend:  backend,
+	}, nil
+}
+
+// WithIngressClassName sets the IngressClass which should implement the ingress.
+func (builder *IngressBuilder) WithIngressClassName(className string) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting ingressClassName %s on ingress %s in namespace %s",
+		className, builder.Definition.Name, builder.Definition.Namespace)
+
+	if className == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ingress 'ingressClassName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.IngressClassName = &className
+
+	return builder
+}
+
+// WithRule appends a rule routing the given paths for host. An empty host matches all incoming requests. Paths are
+// usually built with NewIngressPath.
+func (builder *IngressBuilder) WithRule(host string, paths ...netv1.HTTPIngressPath) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding rule for host %q with %d paths to ingress %s in namespace %s",
+		host, len(paths), builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(paths) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ingress rule 'paths' cannot be empty"))
+
+		return builder
+	}
+
+	for _, path := range paths {
+		if path.PathType == nil || path.Backend.Service == nil && path.Backend.Resource == nil {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("ingress path '%s' must define a pathType and a backend", path.Path))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, netv1.IngressRule{
+		Host: host,
+		IngressRuleValue: netv1.IngressRuleValue{
+			HTTP: &netv1.HTTPIngressRuleValue{Paths: paths},
+		},
+	})
+
+	return builder
+}
+
+// WithTLS appends a TLS entry terminating the given hosts with the certificate stored in secretName. The secret
+// must live in the ingress namespace.
+func (builder *IngressBuilder) WithTLS(secretName string, hosts ...string) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding TLS secret %s for hosts %v to ingress %s in namespace %s",
+		secretName, hosts, builder.Definition.Name, builder.Definition.Namespace)
+
+	if secretName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ingress TLS 'secretName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.TLS = append(builder.Definition.Spec.TLS, netv1.IngressTLS{
+		Hosts:      hosts,
+		SecretName: secretName,
+	})
+
+	return builder
+}
+
+// WithDefaultBackend sets the service port receiving requests which do not match any rule.
+func (builder *IngressBuilder) WithDefaultBackend(serviceName string, servicePort int32) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting default backend %s:%d on ingress %s in namespace %s",
+		serviceName, servicePort, builder.Definition.Name, builder.Definition.Namespace)
+
+	backend, err := newServiceBackend(serviceName, servicePort)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.DefaultBackend = &backend
+
+	return builder
+}
+
+// Get returns the ingress object if found.
+func (builder *IngressBuilder) Get() (*netv1.Ingress, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting ingress object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.apiClient.NetworkingV1Interface.Ingresses(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+}
+
+// Exists checks whether the given ingress exists.
+func (builder *IngressBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if ingress %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an ingress in the cluster and stores the created object in struct.
+func (builder *IngressBuilder) Create() (*IngressBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the ingress %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.Rules) == 0 && builder.Definition.Spec.DefaultBackend == nil {
+		return builder, fmt.Errorf("ingress %s in namespace %s must define at least one rule or a default backend",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.dryRun {
+		return builder, builder.CreateDryRun()
+	}
+
+	var err error
+	builder.Object, err = builder.apiClient.NetworkingV1Interface.Ingresses(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{})
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *IngressBuilder) WithDryRun(dryRun bool) *IngressBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for ingress %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the ingress definition against the API server and its admission webhooks with a server-side
+// dry-run request. The ingress is not created and the builder Object is not modified.
+func (builder *IngressBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the ingress %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.NetworkingV1Interface.Ingresses(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the existing ingress object with the ingress definition in builder.
+func (builder *IngressBuilder) Update() (*IngressBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating ingress %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("ingress %s in namespace %s cannot be updated because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.NetworkingV1Interface.Ingresses(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the ingress object from the cluster.
+func (builder *IngressBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the ingress %s from namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		logging.V(100).Infof("Ingress %s in namespace %s does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		builder.Object = nil
+
+		return nil
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.NetworkingV1Interface.Ingresses(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+	if err != nil {
+		return fmt.Errorf("cannot delete ingress: %w", err)
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetLoadBalancerAddresses returns the IPs and hostnames published in the ingress load-balancer status.
+func (builder *IngressBuilder) GetLoadBalancerAddresses() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting load-balancer addresses of ingress %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	ingress, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+
+	for _, lbIngress := range ingress.Status.LoadBalancer.Ingress {
+		if lbIngress.IP != "" {
+			addresses = append(addresses, lbIngress.IP)
+		}
+
+		if lbIngress.Hostname != "" {
+			addresses = append(addresses, lbIngress.Hostname)
+		}
+	}
+
+	return addresses, nil
+}
+
+// WaitUntilLoadBalancerReady waits for the defined period until the ingress controller publishes at least one
+// load-balancer address in the ingress status.
+func (builder *IngressBuilder) WaitUntilLoadBalancerReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until ingress %s in namespace %s has a load-balancer address",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			addresses, err := builder.GetLoadBalancerAddresses()
+			if err != nil {
+				logging.V(100).Infof("Failed to get ingress %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return len(addresses) > 0, nil
+		})
+}
+
+func newServiceBackend(serviceName string, servicePort int32) (netv1.IngressBackend, error) {
+	if serviceName == "" {
+		return netv1.IngressBackend{}, fmt.Errorf("ingress backend 'serviceName' cannot be empty")
+	}
+
+	if servicePort < 1 || servicePort > 65535 {
+		return netv1.IngressBackend{}, fmt.Errorf("ingress backend 'servicePort' %d is out of range", servicePort)
+	}
+
+	return netv1.IngressBackend{
+		Service: &netv1.IngressServiceBackend{
+			Name: serviceName,
+			Port: netv1.ServiceBackendPort{Number: servicePort},
+		},
+	}, nil
+}
+
+// Diff compares the ingress definition with the ingress object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *IngressBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing ingress %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"ingress object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *IngressBuilder) validate() (bool, error) {
+	resourceCRD := "Ingress"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
@@ -1 +1,433 @@
 package ingress
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultIngressName      = "ingress-test"
+	defaultIngressNamespace = "ingress-namespace"
+)
+
+func TestNewIngressBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultIngressName,
+			namespace:     defaultIngressNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultIngressNamespace,
+			expectedError: "ingress 'name' cannot be empty",
+		},
+		{
+			name:          defaultIngressName,
+			namespace:     "",
+			expectedError: "ingress 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewIngressBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullIngress(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("ingress object %s doesn't exist in namespace %s",
+				defaultIngressName, defaultIngressNamespace),
+		},
+		{
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("ingress 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIngress(nil))
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullIngress(testSettings, defaultIngressName, defaultIngressNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultIngressName, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestNewIngressPath(t *testing.T) {
+	testCases := []struct {
+		pathType      netv1.PathType
+		path          string
+		serviceName   string
+		servicePort   int32
+		expectedError string
+	}{
+		{
+			pathType:    netv1.PathTypePrefix,
+			path:        "/app",
+			serviceName: "app",
+			servicePort: 8080,
+		},
+		{
+			pathType:    netv1.PathTypeImplementationSpecific,
+			path:        "",
+			serviceName: "app",
+			servicePort: 8080,
+		},
+		{
+			pathType:      netv1.PathTypeExact,
+			path:          "app",
+			serviceName:   "app",
+			servicePort:   8080,
+			expectedError: "ingress path 'app' of type Exact must start with '/'",
+		},
+		{
+			pathType:      "Regex",
+			path:          "/app",
+			serviceName:   "app",
+			servicePort:   8080,
+			expectedError: "ingress 'pathType' Regex is not supported, must be Exact, Prefix or ImplementationSpecific",
+		},
+		{
+			pathType:      netv1.PathTypePrefix,
+			path:          "/app",
+			serviceName:   "",
+			servicePort:   8080,
+			expectedError: "ingress backend 'serviceName' cannot be empty",
+		},
+		{
+			pathType:      netv1.PathTypePrefix,
+			path:          "/app",
+			serviceName:   "app",
+			servicePort:   0,
+			expectedError: "ingress backend 'servicePort' 0 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		path, err := NewIngressPath(testCase.pathType, testCase.path, testCase.serviceName, testCase.servicePort)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.path, path.Path)
+			assert.Equal(t, testCase.pathType, *path.PathType)
+			assert.Equal(t, testCase.serviceName, path.Backend.Service.Name)
+			assert.Equal(t, testCase.servicePort, path.Backend.Service.Port.Number)
+		}
+	}
+}
+
+func TestIngressWithRule(t *testing.T) {
+	validPath, _ := NewIngressPath(netv1.PathTypePrefix, "/", "app", 8080)
+
+	testCases := []struct {
+		paths         []netv1.HTTPIngressPath
+		expectedError string
+	}{
+		{
+			paths:         []netv1.HTTPIngressPath{validPath},
+			expectedError: "",
+		},
+		{
+			paths:         nil,
+			expectedError: "ingress rule 'paths' cannot be empty",
+		},
+		{
+			paths:         []netv1.HTTPIngressPath{{Path: "/"}},
+			expectedError: "ingress path '/' must define a pathType and a backend",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithRule("app.example.com", testCase.paths...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Len(t, testBuilder.Definition.Spec.Rules, 1)
+			assert.Equal(t, "app.example.com", testBuilder.Definition.Spec.Rules[0].Host)
+			assert.Equal(t, testCase.paths, testBuilder.Definition.Spec.Rules[0].HTTP.Paths)
+		}
+	}
+}
+
+func TestIngressWithTLS(t *testing.T) {
+	testCases := []struct {
+		secretName    string
+		hosts         []string
+		expectedError string
+	}{
+		{
+			secretName:    "app-cert",
+			hosts:         []string{"app.example.com"},
+			expectedError: "",
+		},
+		{
+			secretName:    "",
+			hosts:         []string{"app.example.com"},
+			expectedError: "ingress TLS 'secretName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithTLS(testCase.secretName, testCase.hosts...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, []netv1.IngressTLS{{Hosts: testCase.hosts, SecretName: testCase.secretName}},
+				testBuilder.Definition.Spec.TLS)
+		}
+	}
+}
+
+func TestIngressWithIngressClassName(t *testing.T) {
+	testCases := []struct {
+		className     string
+		expectedError string
+	}{
+		{
+			className:     "nginx",
+			expectedError: "",
+		},
+		{
+			className:     "",
+			expectedError: "ingress 'ingressClassName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithIngressClassName(testCase.className)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.className, *testBuilder.Definition.Spec.IngressClassName)
+		}
+	}
+}
+
+func TestIngressWithDefaultBackend(t *testing.T) {
+	testCases := []struct {
+		serviceName   string
+		servicePort   int32
+		expectedError string
+	}{
+		{
+			serviceName:   "app",
+			servicePort:   8080,
+			expectedError: "",
+		},
+		{
+			serviceName:   "app",
+			servicePort:   70000,
+			expectedError: "ingress backend 'servicePort' 70000 is out of range",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithDefaultBackend(testCase.serviceName, testCase.servicePort)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.serviceName, testBuilder.Definition.Spec.DefaultBackend.Service.Name)
+		}
+	}
+}
+
+func TestIngressCreate(t *testing.T) {
+	testCases := []struct {
+		testIngress   *IngressBuilder
+		expectedError error
+	}{
+		{
+			testIngress: buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithDefaultBackend("app", 8080),
+			expectedError: nil,
+		},
+		{
+			testIngress: buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Errorf("ingress %s in namespace %s must define at least one rule or a default backend",
+				defaultIngressName, defaultIngressNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testIngress.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultIngressName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestIngressUpdate(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyIngress(nil)}})
+	testBuilder := buildValidIngressBuilder(testSettings).WithIngressClassName("nginx")
+
+	testBuilder, err := testBuilder.Update()
+	assert.Nil(t, err)
+	assert.Equal(t, "nginx", *testBuilder.Object.Spec.IngressClassName)
+}
+
+func TestIngressDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIngress(nil))
+		}
+
+		testBuilder := buildValidIngressBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects}))
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+		assert.False(t, testBuilder.Exists())
+	}
+}
+
+func TestIngressWaitUntilLoadBalancerReady(t *testing.T) {
+	testCases := []struct {
+		lbIngress     []netv1.IngressLoadBalancerIngress
+		expectedError error
+	}{
+		{
+			lbIngress:     []netv1.IngressLoadBalancerIngress{{IP: "192.0.2.10"}},
+			expectedError: nil,
+		},
+		{
+			lbIngress:     nil,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyIngress(testCase.lbIngress)}})
+		testBuilder := buildValidIngressBuilder(testSettings)
+
+		err := testBuilder.WaitUntilLoadBalancerReady(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidIngressBuilder(apiClient *clients.Settings) *IngressBuilder {
+	return NewIngressBuilder(apiClient, defaultIngressName, defaultIngressNamespace)
+}
+
+func buildDummyIngress(lbIngress []netv1.IngressLoadBalancerIngress) *netv1.Ingress {
+	return &netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultIngressName,
+			Namespace: defaultIngressNamespace,
+		},
+		Spec: netv1.IngressSpec{
+			DefaultBackend: &netv1.IngressBackend{
+				Service: &netv1.IngressServiceBackend{Name: "app", Port: netv1.ServiceBackendPort{Number: 8080}},
+			},
+		},
+		Status: netv1.IngressStatus{
+			LoadBalancer: netv1.IngressLoadBalancerStatus{Ingress: lbIngress},
+		},
+	}
+}
+
+func TestIngressDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidIngressBuilder(testSettings).WithDefaultBackend("app", 8080)
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("ingress object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestIngressWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "ingresses", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidIngressBuilder(testSettings).WithDefaultBackend("app", 8080).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidIngressBuilder(existingSettings).WithDefaultBackend("app", 8080).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
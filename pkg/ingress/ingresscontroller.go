@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "github.com/openshift/api/operator/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +25,7 @@ type Builder struct {
 
 // Pull loads an existing ingresscontroller into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing ingresscontroller %s in namespace %s", name, nsname)
+	logging.V(100).Infof("Pulling existing ingresscontroller %s in namespace %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -52,7 +52,7 @@ func (builder *Builder) Get() (*v1.IngressController, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Fetching existing ingresscontroller with name %s under namespace %s from cluster",
+	logging.V(100).Infof("Fetching existing ingresscontroller with name %s under namespace %s from cluster",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	lvs := &v1.IngressController{}
@@ -74,7 +74,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if ingresscontroller %s exists in namespace %s",
+	logging.V(100).Infof("Checking if ingresscontroller %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -89,7 +89,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the ingresscontroller %s in namespace %s",
+	logging.V(100).Infof("Updating the ingresscontroller %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -111,7 +111,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the ingresscontroller %s in namespace %s",
+	logging.V(100).Infof("Creating the ingresscontroller %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -132,7 +132,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the ingresscontroller %s from namespace %s",
+	logging.V(100).Infof("Deleting the ingresscontroller %s from namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -156,19 +156,19 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "IngressController"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
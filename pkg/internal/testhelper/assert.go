@@ -0,0 +1,53 @@
+// Package testhelper provides assertions, fake client reactions and fake clients shared by the builder unit tests.
+package testhelper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+// AssertErrorMsg asserts that err is nil when expectedError is empty and that its message equals expectedError
+// otherwise. It returns true only when no error was expected and none was returned, so the caller can go on checking
+// the builder.
+func AssertErrorMsg(t *testing.T, expectedError string, err error) bool {
+	t.Helper()
+
+	if expectedError == "" {
+		return assert.Nil(t, err)
+	}
+
+	assert.EqualError(t, err, expectedError)
+
+	return false
+}
+
+// DryRunReaction returns a fake clientset reaction that records the verb of the dry-run requests it handles. The fake
+// clientset does not implement dry-run, so the reaction handles every create and every delete carrying the DryRun
+// option without forwarding them to the object tracker.
+func DryRunReaction(actions *[]string) k8sTesting.ReactionFunc {
+	return func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		switch typedAction := action.(type) {
+		case k8sTesting.DeleteAction:
+			if len(typedAction.GetDeleteOptions().DryRun) == 0 {
+				return false, nil, nil
+			}
+
+			*actions = append(*actions, action.GetVerb())
+
+			return true, nil, nil
+		case k8sTesting.CreateAction:
+			if action.GetVerb() != "create" {
+				return false, nil, nil
+			}
+
+			*actions = append(*actions, action.GetVerb())
+
+			return true, typedAction.GetObject(), nil
+		}
+
+		return false, nil, nil
+	}
+}
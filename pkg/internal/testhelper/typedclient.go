@@ -0,0 +1,300 @@
+package testhelper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	clientConfigV1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	clientMachineConfigV1 "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned/typed/machineconfiguration.openshift.io/v1"
+	operatorsV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	clientOlmV1alpha1 "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/typed/operators/v1alpha1"
+	pkgManifestV1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
+	clientPkgManifestV1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/client/clientset/versioned/typed/operators/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+// NewConfigV1Client returns a config.openshift.io/v1 client backed by a test server which stores the given cluster
+// scoped objects, e.g. ClusterVersions and ClusterOperators.
+func NewConfigV1Client(t *testing.T, objects ...runtime.Object) clientConfigV1.ConfigV1Interface {
+	t.Helper()
+
+	return clientConfigV1.NewForConfigOrDie(newObjectServerConfig(t, configv1.GroupVersion, objects...))
+}
+
+// NewMachineConfigV1Client returns a machineconfiguration.openshift.io/v1 client backed by a test server which stores
+// the given cluster scoped objects, e.g. MachineConfigs and MachineConfigPools.
+func NewMachineConfigV1Client(
+	t *testing.T, objects ...runtime.Object) clientMachineConfigV1.MachineconfigurationV1Interface {
+	t.Helper()
+
+	return clientMachineConfigV1.NewForConfigOrDie(newObjectServerConfig(t, mcv1.GroupVersion, objects...))
+}
+
+// NewOperatorsV1alpha1Client returns an operators.coreos.com/v1alpha1 client backed by a test server which stores the
+// given namespaced objects, e.g. Subscriptions, InstallPlans and ClusterServiceVersions.
+func NewOperatorsV1alpha1Client(
+	t *testing.T, objects ...runtime.Object) clientOlmV1alpha1.OperatorsV1alpha1Interface {
+	t.Helper()
+
+	return clientOlmV1alpha1.NewForConfigOrDie(newObjectServerConfig(t, operatorsV1alpha1.SchemeGroupVersion, objects...))
+}
+
+// NewPackageManifestV1Client returns a packages.operators.coreos.com/v1 client backed by a test server which stores the
+// given PackageManifests.
+func NewPackageManifestV1Client(t *testing.T, objects ...runtime.Object) clientPkgManifestV1.OperatorsV1Interface {
+	t.Helper()
+
+	return clientPkgManifestV1.NewForConfigOrDie(newObjectServerConfig(t, pkgManifestV1.SchemeGroupVersion, objects...))
+}
+
+// newObjectServerConfig starts a test server which stores the given objects of the group version and returns the rest
+// config of a client talking to it. The typed clientsets of the openshift and olm apis ship no fake, so the server
+// implements the Get, List, Create and Update requests of the builders. List honors label selectors.
+func newObjectServerConfig(t *testing.T, groupVersion schema.GroupVersion, objects ...runtime.Object) *rest.Config {
+	t.Helper()
+
+	server := &objectServer{
+		groupVersion: groupVersion,
+		objects:      make(map[string]map[string]json.RawMessage),
+	}
+
+	for _, object := range objects {
+		kind := reflect.TypeOf(object).Elem().Name()
+		object.GetObjectKind().SetGroupVersionKind(groupVersion.WithKind(kind))
+
+		accessor, err := meta.Accessor(object)
+		if err != nil {
+			t.Fatalf("failed to access metadata of %s: %v", kind, err)
+		}
+
+		data, err := json.Marshal(object)
+		if err != nil {
+			t.Fatalf("failed to marshal %s %s: %v", kind, accessor.GetName(), err)
+		}
+
+		resource, _ := meta.UnsafeGuessKindToResource(groupVersion.WithKind(kind))
+		server.store(resource.Resource, objectKey(accessor.GetNamespace(), accessor.GetName()), data)
+	}
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	return &rest.Config{Host: httpServer.URL}
+}
+
+// objectServer serves the objects it stores by resource and key, see objectKey.
+type objectServer struct {
+	groupVersion schema.GroupVersion
+	mutex        sync.Mutex
+	objects      map[string]map[string]json.RawMessage
+}
+
+func (server *objectServer) store(resource, key string, data json.RawMessage) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if server.objects[resource] == nil {
+		server.objects[resource] = make(map[string]json.RawMessage)
+	}
+
+	server.objects[resource][key] = data
+}
+
+// ServeHTTP handles the requests on /apis/<group>/<version>/<resource>[/<name>[/status]].
+func (server *objectServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	prefix := fmt.Sprintf("/apis/%s/", server.groupVersion.String())
+	segments := strings.Split(strings.TrimPrefix(request.URL.Path, prefix), "/")
+	namespace := ""
+
+	if len(segments) > 2 && segments[0] == "namespaces" {
+		namespace = segments[1]
+		segments = segments[2:]
+	}
+
+	resource := segments[0]
+	groupResource := server.groupVersion.WithResource(resource).GroupResource()
+
+	writer.Header().Set("Content-Type", "application/json")
+
+	if len(segments) == 1 {
+		switch request.Method {
+		case http.MethodGet:
+			server.list(writer, request, resource, namespace)
+		case http.MethodPost:
+			server.create(writer, request, groupResource, namespace)
+		default:
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}
+
+		return
+	}
+
+	name := segments[1]
+	key := objectKey(namespace, name)
+
+	switch request.Method {
+	case http.MethodGet:
+		server.mutex.Lock()
+		data, found := server.objects[resource][key]
+		server.mutex.Unlock()
+
+		if !found {
+			writeStatus(writer, k8serrors.NewNotFound(groupResource, name))
+
+			return
+		}
+
+		_, _ = writer.Write(data)
+	case http.MethodPut:
+		data, err := io.ReadAll(request.Body)
+		if err != nil {
+			writeStatus(writer, k8serrors.NewBadRequest(err.Error()))
+
+			return
+		}
+
+		server.mutex.Lock()
+		_, found := server.objects[resource][key]
+		server.mutex.Unlock()
+
+		if !found {
+			writeStatus(writer, k8serrors.NewNotFound(groupResource, name))
+
+			return
+		}
+
+		server.store(resource, key, data)
+		_, _ = writer.Write(data)
+	case http.MethodDelete:
+		server.mutex.Lock()
+		_, found := server.objects[resource][key]
+		delete(server.objects[resource], key)
+		server.mutex.Unlock()
+
+		if !found {
+			writeStatus(writer, k8serrors.NewNotFound(groupResource, name))
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	default:
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (server *objectServer) create(
+	writer http.ResponseWriter, request *http.Request, groupResource schema.GroupResource, namespace string) {
+	data, err := io.ReadAll(request.Body)
+	if err != nil {
+		writeStatus(writer, k8serrors.NewBadRequest(err.Error()))
+
+		return
+	}
+
+	var object metav1.PartialObjectMetadata
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		writeStatus(writer, k8serrors.NewBadRequest(err.Error()))
+
+		return
+	}
+
+	key := objectKey(namespace, object.Name)
+
+	server.mutex.Lock()
+	_, found := server.objects[groupResource.Resource][key]
+	server.mutex.Unlock()
+
+	if found {
+		writeStatus(writer, k8serrors.NewAlreadyExists(groupResource, object.Name))
+
+		return
+	}
+
+	server.store(groupResource.Resource, key, data)
+
+	writer.WriteHeader(http.StatusCreated)
+	_, _ = writer.Write(data)
+}
+
+func (server *objectServer) list(writer http.ResponseWriter, request *http.Request, resource, namespace string) {
+	selector, err := labels.Parse(request.URL.Query().Get("labelSelector"))
+	if err != nil {
+		writeStatus(writer, k8serrors.NewBadRequest(err.Error()))
+
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	keys := make([]string, 0, len(server.objects[resource]))
+
+	for key := range server.objects[resource] {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	items := make([]json.RawMessage, 0, len(keys))
+
+	for _, key := range keys {
+		var object metav1.PartialObjectMetadata
+
+		if err := json.Unmarshal(server.objects[resource][key], &object); err != nil {
+			continue
+		}
+
+		if namespace != "" && object.Namespace != namespace {
+			continue
+		}
+
+		if selector.Matches(labels.Set(object.Labels)) {
+			items = append(items, server.objects[resource][key])
+		}
+	}
+
+	// The kind of the list is omitted, the client decodes it into the list type it requested.
+	data, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{},
+		"items":    items,
+	})
+
+	_, _ = writer.Write(data)
+}
+
+// objectKey returns the key an object is stored with, its name if it is cluster scoped or namespace/name otherwise.
+func objectKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "/" + name
+}
+
+func writeStatus(writer http.ResponseWriter, statusErr *k8serrors.StatusError) {
+	status := statusErr.ErrStatus
+	status.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+
+	data, _ := json.Marshal(status)
+
+	writer.WriteHeader(int(status.Code))
+	_, _ = writer.Write(data)
+}
@@ -1,10 +1,11 @@
 package kmm
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -16,7 +17,7 @@ type ModuleLoaderContainerBuilder struct {
 	// ModuleLoaderContainerBuilder definition. Used to create a Module object.
 	definition *moduleV1Beta1.ModuleLoaderContainerSpec
 	// errorMsg is processed before the Module object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // ModuleLoaderContainerAdditionalOptions additional options for ModuleLoaderContainer object.
@@ -25,7 +26,7 @@ type ModuleLoaderContainerAdditionalOptions func(
 
 // NewModLoaderContainerBuilder creates a new instance of ModuleLoaderContainerBuilder.
 func NewModLoaderContainerBuilder(modName string) *ModuleLoaderContainerBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new ModuleLoaderContainerBuilder structure with following params: %s", modName)
 
 	builder := &ModuleLoaderContainerBuilder{
@@ -37,9 +38,9 @@ func NewModLoaderContainerBuilder(modName string) *ModuleLoaderContainerBuilder
 	}
 
 	if modName == "" {
-		glog.V(100).Infof("The modName of the NewModLoaderContainerBuilder is empty")
+		logging.V(100).Infof("The modName of the NewModLoaderContainerBuilder is empty")
 
-		builder.errorMsg = "'modName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'modName' cannot be empty"))
 	}
 
 	return builder
@@ -52,7 +53,7 @@ func (builder *ModuleLoaderContainerBuilder) WithModprobeSpec(dirName, fwPath st
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new ModuleLoaderContainerBuilder structure with following modprob params. "+
 			"DirName: %s, FirmwarePath: %s, Parameters: %v, ModuleLoadingOrder: %v",
 		dirName, fwPath, parameters, moduleLoadingOrder)
@@ -88,13 +89,13 @@ func (builder *ModuleLoaderContainerBuilder) WithKernelMapping(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new ModuleLoaderContainerBuilder structure with following KernelMapping %v", mapping)
 
 	if mapping == nil {
-		glog.V(100).Infof("The mapping is undefined")
+		logging.V(100).Infof("The mapping is undefined")
 
-		builder.errorMsg = "'mapping' can not be empty nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'mapping' can not be empty nil"))
 
 		return builder
 	}
@@ -110,11 +111,11 @@ func (builder *ModuleLoaderContainerBuilder) WithImagePullPolicy(policy string)
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new ModuleLoaderContainerBuilder structure with following policy %v", policy)
 
 	if policy == "" {
-		builder.errorMsg = "'policy' can not be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'policy' can not be empty"))
 
 		return builder
 	}
@@ -130,10 +131,10 @@ func (builder *ModuleLoaderContainerBuilder) WithVersion(version string) *Module
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ModuleLoaderContainer version %v", version)
+	logging.V(100).Infof("Setting ModuleLoaderContainer version %v", version)
 
 	if version == "" {
-		builder.errorMsg = "'version' can not be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'version' can not be empty"))
 
 		return builder
 	}
@@ -143,6 +144,47 @@ func (builder *ModuleLoaderContainerBuilder) WithVersion(version string) *Module
 	return builder
 }
 
+// WithContainerImage sets the default image of the kernel module, used by the kernel mappings which do not define
+// their own. The image may reference the ${KERNEL_FULL_VERSION} variable to use one image per kernel.
+func (builder *ModuleLoaderContainerBuilder) WithContainerImage(image string) *ModuleLoaderContainerBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting ModuleLoaderContainer container image %s", image)
+
+	if image == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'image' can not be empty"))
+
+		return builder
+	}
+
+	builder.definition.ContainerImage = image
+
+	return builder
+}
+
+// WithInTreeModuleToRemove sets the in-tree kernel module to unload before loading the module, for all the kernel
+// mappings which do not define their own.
+func (builder *ModuleLoaderContainerBuilder) WithInTreeModuleToRemove(
+	existingModule string) *ModuleLoaderContainerBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting ModuleLoaderContainer inTreeModuleToRemove %s", existingModule)
+
+	if existingModule == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'existingModule' can not be empty"))
+
+		return builder
+	}
+
+	builder.definition.InTreeModuleToRemove = existingModule
+
+	return builder
+}
+
 // WithOptions creates ModuleLoaderContainer with generic mutation options.
 func (builder *ModuleLoaderContainerBuilder) WithOptions(
 	options ...ModuleLoaderContainerAdditionalOptions) *ModuleLoaderContainerBuilder {
@@ -150,16 +192,16 @@ func (builder *ModuleLoaderContainerBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ModuleLoaderContainer additional options")
+	logging.V(100).Infof("Setting ModuleLoaderContainer additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -176,9 +218,16 @@ func (builder *ModuleLoaderContainerBuilder) BuildModuleLoaderContainerCfg() (
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Returning the ModuleLoaderContainerBuilder structure %v", builder.definition)
 
+	for _, mapping := range builder.definition.KernelMappings {
+		if mapping.ContainerImage == "" && builder.definition.ContainerImage == "" {
+			return nil, fmt.Errorf("kernel mapping %s has no container image and no default one is set",
+				mapping.Literal+mapping.Regexp)
+		}
+	}
+
 	return builder.definition, nil
 }
 
@@ -188,21 +237,23 @@ func (builder *ModuleLoaderContainerBuilder) validate() (bool, error) {
 	resourceCRD := "ModuleLoaderContainer"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -214,12 +265,12 @@ type DevicePluginContainerBuilder struct {
 	// DevicePluginContainerBuilder definition. Used to create a Module object.
 	definition *moduleV1Beta1.DevicePluginContainerSpec
 	// object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewDevicePluginContainerBuilder creates DevicePluginContainerSpec based on given arguments and mutation functs.
 func NewDevicePluginContainerBuilder(image string) *DevicePluginContainerBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new DevPluginContainerBuilder structure with the following params: %s", image)
 
 	builder := DevicePluginContainerBuilder{
@@ -229,9 +280,9 @@ func NewDevicePluginContainerBuilder(image string) *DevicePluginContainerBuilder
 	}
 
 	if image == "" {
-		glog.V(100).Infof("The image of NewDevicePluginContainerBuilder is empty")
+		logging.V(100).Infof("The image of NewDevicePluginContainerBuilder is empty")
 
-		builder.errorMsg = "invalid parameter 'image' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid parameter 'image' cannot be empty"))
 	}
 
 	return &builder
@@ -243,22 +294,22 @@ func (builder *DevicePluginContainerBuilder) WithEnv(name, value string) *Device
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new DevPluginContainerBuilder structure with following Env. Name: %s, Value: %s", name, value)
 
 	if name == "" {
-		glog.V(100).Infof("The name of WithEnv is empty")
+		logging.V(100).Infof("The name of WithEnv is empty")
 
-		builder.errorMsg = "'name' can not be empty for DevicePlugin Env"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'name' can not be empty for DevicePlugin Env"))
 	}
 
 	if value == "" {
-		glog.V(100).Infof("The value of WithEnv is empty")
+		logging.V(100).Infof("The value of WithEnv is empty")
 
-		builder.errorMsg = "'value' can not be empty for DevicePlugin Env"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'value' can not be empty for DevicePlugin Env"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -273,23 +324,24 @@ func (builder *DevicePluginContainerBuilder) WithVolumeMount(mountPath, name str
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new DevPluginContainerBuilder structure with mountPath Env. Name: %s, MountPath: %s",
 		name, mountPath)
 
 	if name == "" {
-		glog.V(100).Infof("The name of WithVolumeMount is empty")
+		logging.V(100).Infof("The name of WithVolumeMount is empty")
 
-		builder.errorMsg = "'name' can not be empty for DevicePlugin mountPath"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'name' can not be empty for DevicePlugin mountPath"))
 	}
 
 	if mountPath == "" {
-		glog.V(100).Infof("The mountPath of WithVolumeMount is empty")
+		logging.V(100).Infof("The mountPath of WithVolumeMount is empty")
 
-		builder.errorMsg = "'mountPath' can not be empty for DevicePlugin mountPath"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'mountPath' can not be empty for DevicePlugin mountPath"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -299,6 +351,44 @@ func (builder *DevicePluginContainerBuilder) WithVolumeMount(mountPath, name str
 	return builder
 }
 
+// WithArgs sets the arguments of the DevicePlugin Container.
+func (builder *DevicePluginContainerBuilder) WithArgs(args ...string) *DevicePluginContainerBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating new DevPluginContainerBuilder structure with args: %v", args)
+
+	if len(args) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'args' can not be empty for DevicePlugin"))
+
+		return builder
+	}
+
+	builder.definition.Args = args
+
+	return builder
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy of the DevicePlugin Container.
+func (builder *DevicePluginContainerBuilder) WithImagePullPolicy(policy string) *DevicePluginContainerBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating new DevPluginContainerBuilder structure with ImagePullPolicy: %s", policy)
+
+	if policy == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'policy' can not be empty for DevicePlugin"))
+
+		return builder
+	}
+
+	builder.definition.ImagePullPolicy = corev1.PullPolicy(policy)
+
+	return builder
+}
+
 // GetDevicePluginContainerConfig returns DevicePluginContainerSpec with needed configuration.
 func (builder *DevicePluginContainerBuilder) GetDevicePluginContainerConfig() (
 	*moduleV1Beta1.DevicePluginContainerSpec, error) {
@@ -315,21 +405,23 @@ func (builder *DevicePluginContainerBuilder) validate() (bool, error) {
 	resourceCRD := "DevicePluginContainer"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", strings.ToLower(resourceCRD))
+		logging.V(100).Infof("The %s builder is uninitialized", strings.ToLower(resourceCRD))
 
 		return false, fmt.Errorf("error: received nil %s builder", strings.ToLower(resourceCRD))
 	}
 
+	err := builder.errorMsg
+
 	if builder.definition == nil {
-		glog.V(100).Infof("The %s is undefined", strings.ToLower(resourceCRD))
+		logging.V(100).Infof("The %s is undefined", strings.ToLower(resourceCRD))
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", strings.ToLower(resourceCRD), builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", strings.ToLower(resourceCRD), err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
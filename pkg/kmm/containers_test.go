@@ -0,0 +1,159 @@
+package kmm
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	defaultModuleName     = "kmm-test"
+	defaultModuleImage    = "quay.io/kmm/kmm-test:$KERNEL_FULL_VERSION"
+	defaultDevPluginImage = "quay.io/kmm/device-plugin:latest"
+)
+
+func TestModuleLoaderContainerWithContainerImage(t *testing.T) {
+	testCases := []struct {
+		image         string
+		expectedError string
+	}{
+		{
+			image:         defaultModuleImage,
+			expectedError: "",
+		},
+		{
+			image:         "",
+			expectedError: "'image' can not be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewModLoaderContainerBuilder(defaultModuleName).WithContainerImage(testCase.image)
+
+		containerCfg, err := testBuilder.BuildModuleLoaderContainerCfg()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.image, containerCfg.ContainerImage)
+		}
+	}
+}
+
+func TestModuleLoaderContainerWithInTreeModuleToRemove(t *testing.T) {
+	testCases := []struct {
+		existingModule string
+		expectedError  string
+	}{
+		{
+			existingModule: "ice",
+			expectedError:  "",
+		},
+		{
+			existingModule: "",
+			expectedError:  "'existingModule' can not be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewModLoaderContainerBuilder(defaultModuleName).WithInTreeModuleToRemove(testCase.existingModule)
+
+		containerCfg, err := testBuilder.BuildModuleLoaderContainerCfg()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.existingModule, containerCfg.InTreeModuleToRemove)
+		}
+	}
+}
+
+func TestBuildModuleLoaderContainerCfg(t *testing.T) {
+	testCases := []struct {
+		defaultImage  string
+		mapping       moduleV1Beta1.KernelMapping
+		expectedError string
+	}{
+		{
+			defaultImage:  defaultModuleImage,
+			mapping:       moduleV1Beta1.KernelMapping{Regexp: "^.+$"},
+			expectedError: "",
+		},
+		{
+			defaultImage:  "",
+			mapping:       moduleV1Beta1.KernelMapping{Regexp: "^.+$", ContainerImage: defaultModuleImage},
+			expectedError: "",
+		},
+		{
+			defaultImage:  "",
+			mapping:       moduleV1Beta1.KernelMapping{Regexp: "^.+$"},
+			expectedError: "kernel mapping ^.+$ has no container image and no default one is set",
+		},
+		{
+			defaultImage:  "",
+			mapping:       moduleV1Beta1.KernelMapping{Literal: "5.14.0-284.el9.x86_64"},
+			expectedError: "kernel mapping 5.14.0-284.el9.x86_64 has no container image and no default one is set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewModLoaderContainerBuilder(defaultModuleName).WithKernelMapping(&testCase.mapping)
+
+		if testCase.defaultImage != "" {
+			testBuilder = testBuilder.WithContainerImage(testCase.defaultImage)
+		}
+
+		containerCfg, err := testBuilder.BuildModuleLoaderContainerCfg()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, []moduleV1Beta1.KernelMapping{testCase.mapping}, containerCfg.KernelMappings)
+			assert.Equal(t, testCase.defaultImage, containerCfg.ContainerImage)
+		}
+	}
+}
+
+func TestDevicePluginContainerWithArgs(t *testing.T) {
+	testCases := []struct {
+		args          []string
+		expectedError string
+	}{
+		{
+			args:          []string{"--config", "/etc/device-plugin/config.json"},
+			expectedError: "",
+		},
+		{
+			args:          nil,
+			expectedError: "error building DevicePluginContainerSpec config due to :'args' can not be empty for DevicePlugin",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewDevicePluginContainerBuilder(defaultDevPluginImage).WithArgs(testCase.args...)
+
+		containerCfg, err := testBuilder.GetDevicePluginContainerConfig()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.args, containerCfg.Args)
+		}
+	}
+}
+
+func TestDevicePluginContainerWithImagePullPolicy(t *testing.T) {
+	testCases := []struct {
+		policy        string
+		expectedError string
+	}{
+		{
+			policy:        string(corev1.PullAlways),
+			expectedError: "",
+		},
+		{
+			policy:        "",
+			expectedError: "error building DevicePluginContainerSpec config due to :'policy' can not be empty for DevicePlugin",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewDevicePluginContainerBuilder(defaultDevPluginImage).WithImagePullPolicy(testCase.policy)
+
+		containerCfg, err := testBuilder.GetDevicePluginContainerConfig()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, corev1.PullPolicy(testCase.policy), containerCfg.ImagePullPolicy)
+		}
+	}
+}
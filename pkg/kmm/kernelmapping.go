@@ -1,9 +1,11 @@
 package kmm
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -15,7 +17,7 @@ type KernelMappingBuilder struct {
 	definition *moduleV1Beta1.KernelMapping
 	// Used in functions that define or mutate Module definition. errorMsg is processed before the Module
 	// object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // KernelMappingAdditionalOptions additional options for KernelMapping object.
@@ -23,7 +25,7 @@ type KernelMappingAdditionalOptions func(builder *KernelMappingBuilder) (*Kernel
 
 // NewRegExKernelMappingBuilder creates new kernel mapping element based on regex.
 func NewRegExKernelMappingBuilder(regex string) *KernelMappingBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new regex KernelMapping parameter structure with the following regex param: %s", regex)
 
 	builder := KernelMappingBuilder{
@@ -33,9 +35,17 @@ func NewRegExKernelMappingBuilder(regex string) *KernelMappingBuilder {
 	}
 
 	if regex == "" {
-		glog.V(100).Infof("The regex of NewRegExKernelMappingBuilder is empty")
+		logging.V(100).Infof("The regex of NewRegExKernelMappingBuilder is empty")
 
-		builder.errorMsg = "'regex' parameter can not be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'regex' parameter can not be empty"))
+
+		return &builder
+	}
+
+	if _, err := regexp.Compile(regex); err != nil {
+		logging.V(100).Infof("The regex of NewRegExKernelMappingBuilder is invalid: %v", err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'regex' parameter %s is invalid: %w", regex, err))
 	}
 
 	return &builder
@@ -43,7 +53,7 @@ func NewRegExKernelMappingBuilder(regex string) *KernelMappingBuilder {
 
 // NewLiteralKernelMappingBuilder create new kernel mapping element based on literal.
 func NewLiteralKernelMappingBuilder(literal string) *KernelMappingBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new literal KernelMapping parameter structure with following literal param: %s", literal)
 
 	builder := KernelMappingBuilder{
@@ -53,9 +63,9 @@ func NewLiteralKernelMappingBuilder(literal string) *KernelMappingBuilder {
 	}
 
 	if literal == "" {
-		glog.V(100).Infof("The literal of NewLiteralKernelMappingBuilder is empty")
+		logging.V(100).Infof("The literal of NewLiteralKernelMappingBuilder is empty")
 
-		builder.errorMsg = "'literal' parameter can not be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'literal' parameter can not be empty"))
 	}
 
 	return &builder
@@ -67,7 +77,7 @@ func (builder *KernelMappingBuilder) BuildKernelMappingConfig() (*moduleV1Beta1.
 		return nil, fmt.Errorf("error building KernelMappingConfig config due to :%w", err)
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Returning the KernelMappingBuilder structure %v", builder.definition)
 
 	return builder.definition, nil
@@ -79,16 +89,16 @@ func (builder *KernelMappingBuilder) WithContainerImage(image string) *KernelMap
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new Module KernelMapping parameter with container image: %s", image)
 
 	if image == "" {
-		glog.V(100).Infof("The image of WithContainerImage is empty")
+		logging.V(100).Infof("The image of WithContainerImage is empty")
 
-		builder.errorMsg = "'image' parameter can not be empty for KernelMapping"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'image' parameter can not be empty for KernelMapping"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -103,22 +113,24 @@ func (builder *KernelMappingBuilder) WithBuildArg(argName, argValue string) *Ker
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new Module KernelMapping parameter with buildingArgs name: %s, value: %s", argName, argValue)
 
 	if argName == "" {
-		glog.V(100).Infof("The argName of WithBuildArg is empty")
+		logging.V(100).Infof("The argName of WithBuildArg is empty")
 
-		builder.errorMsg = "'argName' parameter can not be empty for KernelMapping BuildArg"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'argName' parameter can not be empty for KernelMapping BuildArg"))
 	}
 
 	if argValue == "" {
-		glog.V(100).Infof("The argValue of WithBuildArg is empty")
+		logging.V(100).Infof("The argValue of WithBuildArg is empty")
 
-		builder.errorMsg = "'argValue' parameter can not be empty for KernelMapping BuildArg"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'argValue' parameter can not be empty for KernelMapping BuildArg"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -136,16 +148,17 @@ func (builder *KernelMappingBuilder) WithBuildSecret(secret string) *KernelMappi
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new Module KernelMapping parameter with BuildSecret %s", secret)
 
 	if secret == "" {
-		glog.V(100).Infof("The secret of WithBuildSecret is empty")
+		logging.V(100).Infof("The secret of WithBuildSecret is empty")
 
-		builder.errorMsg = "'secret' parameter can not be empty for KernelMapping Secret"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'secret' parameter can not be empty for KernelMapping Secret"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -163,7 +176,7 @@ func (builder *KernelMappingBuilder) WithBuildImageRegistryTLS(insecure, skipTLS
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new Module KernelMapping parameter with BuildImageRegistryTLS %t, value: %t",
 		insecure, skipTLSVerify)
 
@@ -180,15 +193,16 @@ func (builder *KernelMappingBuilder) WithBuildDockerCfgFile(name string) *Kernel
 		return builder
 	}
 
-	glog.V(100).Infof("Creating new Module KernelMapping parameter with DockerCfgFile %s, ", name)
+	logging.V(100).Infof("Creating new Module KernelMapping parameter with DockerCfgFile %s, ", name)
 
 	if name == "" {
-		glog.V(100).Infof("The name of WithBuildDockerCfgFile is empty")
+		logging.V(100).Infof("The name of WithBuildDockerCfgFile is empty")
 
-		builder.errorMsg = "'name' parameter can not be empty for KernelMapping Docker file"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'name' parameter can not be empty for KernelMapping Docker file"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -204,29 +218,32 @@ func (builder *KernelMappingBuilder) WithSign(certSecret, keySecret string, file
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new Module KernelMapping parameter with Sign. CertSecret: %s, KeySecret: %s, fileToSign: %v",
 		certSecret, keySecret, fileToSign)
 
 	if certSecret == "" {
-		glog.V(100).Infof("The certSecret of WithSign is empty")
+		logging.V(100).Infof("The certSecret of WithSign is empty")
 
-		builder.errorMsg = "'certSecret' parameter can not be empty for KernelMapping Sign"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'certSecret' parameter can not be empty for KernelMapping Sign"))
 	}
 
 	if keySecret == "" {
-		glog.V(100).Infof("The keySecret of WithSign is empty")
+		logging.V(100).Infof("The keySecret of WithSign is empty")
 
-		builder.errorMsg = "'keySecret' parameter can not be empty for KernelMapping Sign"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'keySecret' parameter can not be empty for KernelMapping Sign"))
 	}
 
 	if len(fileToSign) < 1 {
-		glog.V(100).Infof("The fileToSign of WithSign is empty")
+		logging.V(100).Infof("The fileToSign of WithSign is empty")
 
-		builder.errorMsg = "'fileToSign' parameter can not be empty for KernelMapping Sign"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'fileToSign' parameter can not be empty for KernelMapping Sign"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -245,7 +262,7 @@ func (builder *KernelMappingBuilder) RegistryTLS(insecure, skipTLSVerify bool) *
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating new Module KernelMapping parameter with RegistryTLS. Insecure: %t, InsecureSkipTLSVerify: %t",
 		insecure, skipTLSVerify)
 
@@ -262,12 +279,13 @@ func (builder *KernelMappingBuilder) WithInTreeModuleToRemove(existingModule str
 		return builder
 	}
 
-	glog.V(100).Infof("Creating new Module KernelMapping with inTreeModuleToRemove: %v", existingModule)
+	logging.V(100).Infof("Creating new Module KernelMapping with inTreeModuleToRemove: %v", existingModule)
 
 	if existingModule == "" {
-		glog.V(100).Infof("The 'existingModule' is empty")
+		logging.V(100).Infof("The 'existingModule' is empty")
 
-		builder.errorMsg = "'existingModule' parameter can not be empty for KernelMapping inTreeModuleToRemove"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'existingModule' parameter can not be empty for KernelMapping inTreeModuleToRemove"))
 
 		return builder
 	}
@@ -283,16 +301,16 @@ func (builder *KernelMappingBuilder) WithOptions(options ...KernelMappingAdditio
 		return builder
 	}
 
-	glog.V(100).Infof("Setting KernelMapping additional options")
+	logging.V(100).Infof("Setting KernelMapping additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -314,21 +332,23 @@ func (builder *KernelMappingBuilder) validate() (bool, error) {
 	resourceCRD := "KernelMapping"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
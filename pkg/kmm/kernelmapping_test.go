@@ -0,0 +1,36 @@
+package kmm
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRegExKernelMappingBuilder(t *testing.T) {
+	testCases := []struct {
+		regex         string
+		expectedError string
+	}{
+		{
+			regex:         "^.+$",
+			expectedError: "",
+		},
+		{
+			regex:         "",
+			expectedError: "error building KernelMappingConfig config due to :'regex' parameter can not be empty",
+		},
+		{
+			regex: "^5.14.[0-9+$",
+			expectedError: "error building KernelMappingConfig config due to :'regex' parameter ^5.14.[0-9+$ is invalid: " +
+				"error parsing regexp: missing closing ]: `[0-9+$`",
+		},
+	}
+
+	for _, testCase := range testCases {
+		kernelMapping, err := NewRegExKernelMappingBuilder(testCase.regex).BuildKernelMappingConfig()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.regex, kernelMapping.Regexp)
+		}
+	}
+}
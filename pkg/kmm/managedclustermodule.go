@@ -2,10 +2,11 @@ package kmm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	mcmV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api-hub/v1beta1"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
@@ -19,7 +20,7 @@ import (
 type ManagedClusterModuleBuilder struct {
 	Definition *mcmV1Beta1.ManagedClusterModule
 	Object     *mcmV1Beta1.ManagedClusterModule
-	errorMsg   string
+	errorMsg   error
 	apiClient  *clients.Settings
 }
 
@@ -29,7 +30,7 @@ type ManagedClusterModuleAdditionalOptions func(builder *ManagedClusterModuleBui
 
 // NewManagedClusterModuleBuilder creates a new instance of ManagedClusterModuleBuilder.
 func NewManagedClusterModuleBuilder(apiClient *clients.Settings, name, nsname string) *ManagedClusterModuleBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new ManagedClusterModule structure with following params: %s, %s", name, nsname)
 
 	builder := ManagedClusterModuleBuilder{
@@ -43,15 +44,15 @@ func NewManagedClusterModuleBuilder(apiClient *clients.Settings, name, nsname st
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the ManagedClusterModule is empty")
+		logging.V(100).Infof("The name of the ManagedClusterModule is empty")
 
-		builder.errorMsg = "ManagedClusterModule 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ManagedClusterModule 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the ManagedClusterModule is empty")
+		logging.V(100).Infof("The namespace of the ManagedClusterModule is empty")
 
-		builder.errorMsg = "ManagedClusterModule 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ManagedClusterModule 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -77,10 +78,10 @@ func (builder *ManagedClusterModuleBuilder) WithSpokeNamespace(
 	}
 
 	if spokeNamespace == "" {
-		builder.errorMsg = "invalid 'spokeNamespace' argument cannot be nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid 'spokeNamespace' argument cannot be nil"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -97,10 +98,10 @@ func (builder *ManagedClusterModuleBuilder) WithSelector(
 	}
 
 	if len(selector) == 0 {
-		builder.errorMsg = "invalid 'selector' argument cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid 'selector' argument cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -116,16 +117,16 @@ func (builder *ManagedClusterModuleBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ManagedClusterModule additional options")
+	logging.V(100).Infof("Setting ManagedClusterModule additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -137,7 +138,7 @@ func (builder *ManagedClusterModuleBuilder) WithOptions(
 
 // PullManagedClusterModule pulls existing module from cluster.
 func PullManagedClusterModule(apiClient *clients.Settings, name, nsname string) (*ManagedClusterModuleBuilder, error) {
-	glog.V(100).Infof("Pulling existing module name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing module name %s under namespace %s from cluster", name, nsname)
 
 	builder := ManagedClusterModuleBuilder{
 		apiClient: apiClient,
@@ -150,15 +151,15 @@ func PullManagedClusterModule(apiClient *clients.Settings, name, nsname string)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the managedclustermodule is empty")
+		logging.V(100).Infof("The name of the managedclustermodule is empty")
 
-		builder.errorMsg = "managedclustermodule 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("managedclustermodule 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the managedclustermodule is empty")
+		logging.V(100).Infof("The namespace of the managedclustermodule is empty")
 
-		builder.errorMsg = "managedclustermodule 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("managedclustermodule 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -176,7 +177,7 @@ func (builder *ManagedClusterModuleBuilder) Create() (*ManagedClusterModuleBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating managedclustermodule %s in namespace %s",
+	logging.V(100).Infof("Creating managedclustermodule %s in namespace %s",
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -197,7 +198,7 @@ func (builder *ManagedClusterModuleBuilder) Update() (*ManagedClusterModuleBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating managedclustermodule %s in namespace %s",
+	logging.V(100).Infof("Updating managedclustermodule %s in namespace %s",
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -216,7 +217,7 @@ func (builder *ManagedClusterModuleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if managedclustermodule %s exists in namespace %s",
+	logging.V(100).Infof("Checking if managedclustermodule %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -231,7 +232,7 @@ func (builder *ManagedClusterModuleBuilder) Delete() (*ManagedClusterModuleBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting managedclustermodule %s in namespace %s",
+	logging.V(100).Infof("Deleting managedclustermodule %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -255,7 +256,7 @@ func (builder *ManagedClusterModuleBuilder) Get() (*mcmV1Beta1.ManagedClusterMod
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting managedclustermodule %s in namespace %s",
+	logging.V(100).Infof("Getting managedclustermodule %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	mcm := &mcmV1Beta1.ManagedClusterModule{}
@@ -278,27 +279,29 @@ func (builder *ManagedClusterModuleBuilder) validate() (bool, error) {
 	resourceCRD := "ManagedClusterModule"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
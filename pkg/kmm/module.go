@@ -2,15 +2,18 @@ package kmm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -24,7 +27,7 @@ type ModuleBuilder struct {
 	// Used in functions that define or mutate Module definition. errorMsg is processed before the Module
 	// object is created.
 	apiClient *clients.Settings
-	errorMsg  string
+	errorMsg  error
 }
 
 // ModuleAdditionalOptions additional options for module object.
@@ -33,7 +36,7 @@ type ModuleAdditionalOptions func(builder *ModuleBuilder) (*ModuleBuilder, error
 // NewModuleBuilder creates a new instance of ModuleBuilder.
 func NewModuleBuilder(
 	apiClient *clients.Settings, name, nsname string) *ModuleBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new Module structure with following params: %s, %s", name, nsname)
 
 	builder := ModuleBuilder{
@@ -47,15 +50,15 @@ func NewModuleBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the Module is empty")
+		logging.V(100).Infof("The name of the Module is empty")
 
-		builder.errorMsg = "Module 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Module 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the module is empty")
+		logging.V(100).Infof("The namespace of the module is empty")
 
-		builder.errorMsg = "Module 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Module 'namespace' cannot be empty"))
 	}
 
 	return &builder
@@ -67,17 +70,17 @@ func (builder *ModuleBuilder) WithNodeSelector(nodeSelector map[string]string) *
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating Module %s in namespace %s with this nodeSelector: %s",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("Can not redefine Module with empty nodeSelector map")
+		logging.V(100).Infof("Can not redefine Module with empty nodeSelector map")
 
-		builder.errorMsg = "Module 'nodeSelector' cannot be empty map"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Module 'nodeSelector' cannot be empty map"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -92,7 +95,7 @@ func (builder *ModuleBuilder) WithLoadServiceAccount(srvAccountName string) *Mod
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating Module %s in namespace %s with ModuleLoad ServiceAccount: %s",
 		builder.Definition.Name, builder.Definition.Namespace, srvAccountName)
 
@@ -105,7 +108,7 @@ func (builder *ModuleBuilder) WithDevicePluginServiceAccount(srvAccountName stri
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating Module %s in namespace %s with DevicePlugin ServiceAccount: %s",
 		builder.Definition.Name, builder.Definition.Namespace, srvAccountName)
 
@@ -119,10 +122,10 @@ func (builder *ModuleBuilder) WithImageRepoSecret(imageRepoSecret string) *Modul
 	}
 
 	if imageRepoSecret == "" {
-		builder.errorMsg = "can not redefine module with empty imageRepoSecret"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not redefine module with empty imageRepoSecret"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -138,14 +141,14 @@ func (builder *ModuleBuilder) WithDevicePluginVolume(name string, configMapName
 	}
 
 	if name == "" {
-		builder.errorMsg = "cannot redefine with empty volume 'name'"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot redefine with empty volume 'name'"))
 	}
 
 	if configMapName == "" {
-		builder.errorMsg = "cannot redefine with empty 'configMapName'"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot redefine with empty 'configMapName'"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -174,10 +177,10 @@ func (builder *ModuleBuilder) WithModuleLoaderContainer(
 	}
 
 	if container == nil {
-		builder.errorMsg = "invalid 'container' argument can not be nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid 'container' argument can not be nil"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -194,10 +197,10 @@ func (builder *ModuleBuilder) WithDevicePluginContainer(
 	}
 
 	if container == nil {
-		builder.errorMsg = "invalid 'container' argument can not be nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid 'container' argument can not be nil"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -216,7 +219,7 @@ func (builder *ModuleBuilder) BuildModuleSpec() (moduleV1Beta1.ModuleSpec, error
 		return moduleV1Beta1.ModuleSpec{}, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Returning the ModuleSpec structure %v", builder.Definition.Spec)
 
 	return builder.Definition.Spec, nil
@@ -228,16 +231,16 @@ func (builder *ModuleBuilder) WithOptions(options ...ModuleAdditionalOptions) *M
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Module additional options")
+	logging.V(100).Infof("Setting Module additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -249,7 +252,7 @@ func (builder *ModuleBuilder) WithOptions(options ...ModuleAdditionalOptions) *M
 
 // Pull pulls existing module from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*ModuleBuilder, error) {
-	glog.V(100).Infof("Pulling existing module name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing module name %s under namespace %s from cluster", name, nsname)
 
 	builder := ModuleBuilder{
 		apiClient: apiClient,
@@ -262,15 +265,15 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*ModuleBuilder, err
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the module is empty")
+		logging.V(100).Infof("The name of the module is empty")
 
-		builder.errorMsg = "module 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("module 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the module is empty")
+		logging.V(100).Infof("The namespace of the module is empty")
 
-		builder.errorMsg = "module 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("module 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -288,7 +291,7 @@ func (builder *ModuleBuilder) Create() (*ModuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating module %s in namespace %s",
+	logging.V(100).Infof("Creating module %s in namespace %s",
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -306,7 +309,7 @@ func (builder *ModuleBuilder) Update() (*ModuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating module %s in namespace %s",
+	logging.V(100).Infof("Updating module %s in namespace %s",
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -321,7 +324,7 @@ func (builder *ModuleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if module %s exists in namespace %s",
+	logging.V(100).Infof("Checking if module %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -336,7 +339,7 @@ func (builder *ModuleBuilder) Delete() (*ModuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting module %s in namespace %s",
+	logging.V(100).Infof("Deleting module %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -360,7 +363,7 @@ func (builder *ModuleBuilder) Get() (*moduleV1Beta1.Module, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting module %s from namespace %s",
+	logging.V(100).Infof("Getting module %s from namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	module := &moduleV1Beta1.Module{}
@@ -377,16 +380,88 @@ func (builder *ModuleBuilder) Get() (*moduleV1Beta1.Module, error) {
 	return module, err
 }
 
+// WaitUntilLoaded waits for the duration of the defined timeout or until the module loader DaemonSet is available on
+// all the nodes matching the selector of the module, i.e. the kernel module is loaded on them.
+func (builder *ModuleBuilder) WaitUntilLoaded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for module %s in namespace %s to be loaded on all selected nodes",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.waitForDaemonSetStatus("module loader", timeout,
+		func(status *moduleV1Beta1.ModuleStatus) moduleV1Beta1.DaemonSetStatus {
+			return status.ModuleLoader
+		})
+}
+
+// WaitUntilDevicePluginReady waits for the duration of the defined timeout or until the device plugin DaemonSet is
+// available on all the nodes matching the selector of the module.
+func (builder *ModuleBuilder) WaitUntilDevicePluginReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the device plugin of module %s in namespace %s to be ready on all selected nodes",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Definition.Spec.DevicePlugin == nil {
+		return fmt.Errorf("module %s in namespace %s has no device plugin",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.waitForDaemonSetStatus("device plugin", timeout,
+		func(status *moduleV1Beta1.ModuleStatus) moduleV1Beta1.DaemonSetStatus {
+			return status.DevicePlugin
+		})
+}
+
+// waitForDaemonSetStatus polls the module until the DaemonSet status returned by getStatus reports it available on
+// all the nodes matching the selector. On timeout, the last observed numbers are included in the returned error.
+func (builder *ModuleBuilder) waitForDaemonSetStatus(daemonSetName string, timeout time.Duration,
+	getStatus func(status *moduleV1Beta1.ModuleStatus) moduleV1Beta1.DaemonSetStatus) error {
+	var lastStatus moduleV1Beta1.DaemonSetStatus
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 5*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				logging.V(100).Infof("Failed to get module %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			lastStatus = getStatus(&builder.Object.Status)
+
+			logging.V(100).Infof("Module %s %s available on %d/%d nodes", builder.Definition.Name,
+				daemonSetName, lastStatus.AvailableNumber, lastStatus.NodesMatchingSelectorNumber)
+
+			return lastStatus.NodesMatchingSelectorNumber > 0 &&
+				lastStatus.AvailableNumber == lastStatus.NodesMatchingSelectorNumber, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("module %s in namespace %s %s is available on %d of %d selected nodes: %w",
+			builder.Definition.Name, builder.Definition.Namespace, daemonSetName,
+			lastStatus.AvailableNumber, lastStatus.NodesMatchingSelectorNumber, err)
+	}
+
+	return nil
+}
+
 func (builder *ModuleBuilder) withServiceAccount(srvAccountName string, accountType string) *ModuleBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
 	if srvAccountName == "" {
-		builder.errorMsg = "can not redefine module with empty ServiceAccount"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not redefine module with empty ServiceAccount"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -400,7 +475,8 @@ func (builder *ModuleBuilder) withServiceAccount(srvAccountName string, accountT
 
 		builder.Definition.Spec.DevicePlugin.ServiceAccountName = srvAccountName
 	default:
-		builder.errorMsg = "invalid account type parameter. Supported parameters are: 'module', 'device'"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid account type parameter. Supported parameters are: 'module', 'device'"))
 	}
 
 	return builder
@@ -412,27 +488,29 @@ func (builder *ModuleBuilder) validate() (bool, error) {
 	resourceCRD := "Module"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
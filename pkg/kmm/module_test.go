@@ -0,0 +1,118 @@
+package kmm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultModuleNamespace = "openshift-kmm"
+
+func TestModuleWaitUntilLoaded(t *testing.T) {
+	testCases := []struct {
+		status              moduleV1Beta1.DaemonSetStatus
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			status:              moduleV1Beta1.DaemonSetStatus{NodesMatchingSelectorNumber: 2, AvailableNumber: 2},
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			status:              moduleV1Beta1.DaemonSetStatus{NodesMatchingSelectorNumber: 2, AvailableNumber: 1},
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("module %s in namespace %s module loader is available on 1 of 2 selected "+
+				"nodes: context deadline exceeded", defaultModuleName, defaultModuleNamespace),
+		},
+		{
+			status:              moduleV1Beta1.DaemonSetStatus{},
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("module %s in namespace %s module loader is available on 0 of 0 selected "+
+				"nodes: context deadline exceeded", defaultModuleName, defaultModuleNamespace),
+		},
+		{
+			status:              moduleV1Beta1.DaemonSetStatus{NodesMatchingSelectorNumber: 2, AvailableNumber: 2},
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("module %s in namespace %s module loader is available on 0 of 0 selected "+
+				"nodes: context deadline exceeded", defaultModuleName, defaultModuleNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyModule(moduleV1Beta1.ModuleStatus{ModuleLoader: testCase.status}))
+		}
+
+		testBuilder := buildValidModuleBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		err := testBuilder.WaitUntilLoaded(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestModuleWaitUntilDevicePluginReady(t *testing.T) {
+	testCases := []struct {
+		status          moduleV1Beta1.DaemonSetStatus
+		hasDevicePlugin bool
+		expectedError   string
+	}{
+		{
+			status:          moduleV1Beta1.DaemonSetStatus{NodesMatchingSelectorNumber: 2, AvailableNumber: 2},
+			hasDevicePlugin: true,
+			expectedError:   "",
+		},
+		{
+			status:          moduleV1Beta1.DaemonSetStatus{NodesMatchingSelectorNumber: 2, AvailableNumber: 0},
+			hasDevicePlugin: true,
+			expectedError: fmt.Sprintf("module %s in namespace %s device plugin is available on 0 of 2 selected "+
+				"nodes: context deadline exceeded", defaultModuleName, defaultModuleNamespace),
+		},
+		{
+			status:          moduleV1Beta1.DaemonSetStatus{NodesMatchingSelectorNumber: 2, AvailableNumber: 2},
+			hasDevicePlugin: false,
+			expectedError: fmt.Sprintf("module %s in namespace %s has no device plugin",
+				defaultModuleName, defaultModuleNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidModuleBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyModule(moduleV1Beta1.ModuleStatus{DevicePlugin: testCase.status})},
+		}))
+
+		if testCase.hasDevicePlugin {
+			testBuilder = testBuilder.WithDevicePluginContainer(&moduleV1Beta1.DevicePluginContainerSpec{
+				Image: defaultDevPluginImage,
+			})
+		}
+
+		err := testBuilder.WaitUntilDevicePluginReady(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidModuleBuilder(apiClient *clients.Settings) *ModuleBuilder {
+	return NewModuleBuilder(apiClient, defaultModuleName, defaultModuleNamespace)
+}
+
+func buildDummyModule(status moduleV1Beta1.ModuleStatus) *moduleV1Beta1.Module {
+	return &moduleV1Beta1.Module{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultModuleName,
+			Namespace: defaultModuleNamespace,
+		},
+		Status: status,
+	}
+}
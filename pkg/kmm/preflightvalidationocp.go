@@ -2,10 +2,11 @@ package kmm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/kernel-module-management/api/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,7 +24,7 @@ type PreflightValidationOCPBuilder struct {
 	// ApiClient to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before the object is created or updated.
-	errorMsg string
+	errorMsg error
 }
 
 // PreflightValidationOCPAdditionalOptions additional options for preflightvalidationocp object.
@@ -33,7 +34,7 @@ type PreflightValidationOCPAdditionalOptions func(
 // NewPreflightValidationOCPBuilder creates a new instance of PreflightValidationOCPBuilder.
 func NewPreflightValidationOCPBuilder(
 	apiClient *clients.Settings, name, nsname string) *PreflightValidationOCPBuilder {
-	glog.V(100).Infof("Initializing new PreflightValidationOCP structure with following params: %s, %s",
+	logging.V(100).Infof("Initializing new PreflightValidationOCP structure with following params: %s, %s",
 		name, nsname)
 
 	builder := PreflightValidationOCPBuilder{
@@ -47,15 +48,15 @@ func NewPreflightValidationOCPBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the PreflightValidationOCP is empty")
+		logging.V(100).Infof("The name of the PreflightValidationOCP is empty")
 
-		builder.errorMsg = "PreflightValidationOCP 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PreflightValidationOCP 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the PreflightValidationOCP is empty")
+		logging.V(100).Infof("The namespace of the PreflightValidationOCP is empty")
 
-		builder.errorMsg = "PreflightValidationOCP 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PreflightValidationOCP 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -68,12 +69,12 @@ func (builder *PreflightValidationOCPBuilder) WithReleaseImage(image string) *Pr
 	}
 
 	if image == "" {
-		builder.errorMsg = "invald 'image' argument can not be nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invald 'image' argument can not be nil"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Creating new PreflightValidationOCP with release image: %s",
+	logging.V(100).Infof("Creating new PreflightValidationOCP with release image: %s",
 		image)
 
 	builder.Definition.Spec.ReleaseImage = image
@@ -87,7 +88,7 @@ func (builder *PreflightValidationOCPBuilder) WithUseRTKernel(flag bool) *Prefli
 		return builder
 	}
 
-	glog.V(100).Infof("Creating new PreflightValidationOCP with UseRTKernel set to: %s", flag)
+	logging.V(100).Infof("Creating new PreflightValidationOCP with UseRTKernel set to: %t", flag)
 
 	builder.Definition.Spec.UseRTKernel = flag
 
@@ -100,7 +101,7 @@ func (builder *PreflightValidationOCPBuilder) WithPushBuiltImage(push bool) *Pre
 		return builder
 	}
 
-	glog.V(100).Infof("Creating new PreflightValidaionOCP with PushBuiltImage set to: %s", push)
+	logging.V(100).Infof("Creating new PreflightValidaionOCP with PushBuiltImage set to: %t", push)
 
 	builder.Definition.Spec.PushBuiltImage = push
 
@@ -114,16 +115,16 @@ func (builder *PreflightValidationOCPBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Module additional options")
+	logging.V(100).Infof("Setting Module additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -136,7 +137,7 @@ func (builder *PreflightValidationOCPBuilder) WithOptions(
 // PullPreflightValidationOCP fetches existing PreflightValidationOCP from the cluster.
 func PullPreflightValidationOCP(apiClient *clients.Settings,
 	name, nsname string) (*PreflightValidationOCPBuilder, error) {
-	glog.V(100).Infof("Pulling existing preflightvalidationocp name % under namespace %s",
+	logging.V(100).Infof("Pulling existing preflightvalidationocp name %s under namespace %s",
 		name, nsname)
 
 	builder := PreflightValidationOCPBuilder{
@@ -150,15 +151,15 @@ func PullPreflightValidationOCP(apiClient *clients.Settings,
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the preflightvalidationocp is empty")
+		logging.V(100).Infof("The name of the preflightvalidationocp is empty")
 
-		builder.errorMsg = "preflightvalidationocp 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("preflightvalidationocp 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the preflightvalidationocp is empty")
+		logging.V(100).Infof("The namespace of the preflightvalidationocp is empty")
 
-		builder.errorMsg = "preflightvalidationocp 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("preflightvalidationocp 'nsname' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -177,7 +178,7 @@ func (builder *PreflightValidationOCPBuilder) Create() (*PreflightValidationOCPB
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating preflightvalidationocp %s in namespace %s",
+	logging.V(100).Infof("Creating preflightvalidationocp %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -194,7 +195,7 @@ func (builder *PreflightValidationOCPBuilder) Update() (*PreflightValidationOCPB
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating preflightvalidationocp %s in namespace %s",
+	logging.V(100).Infof("Updating preflightvalidationocp %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
@@ -208,7 +209,7 @@ func (builder *PreflightValidationOCPBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if preflightvalidationocp %s exists in namespace %s",
+	logging.V(100).Infof("Checking if preflightvalidationocp %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -223,7 +224,7 @@ func (builder *PreflightValidationOCPBuilder) Delete() (*PreflightValidationOCPB
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting preflightvalidationocp %s in namespace %s",
+	logging.V(100).Infof("Deleting preflightvalidationocp %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -247,7 +248,7 @@ func (builder *PreflightValidationOCPBuilder) Get() (*moduleV1Beta1.PreflightVal
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting preflightvalidationocp %s from namespace %s",
+	logging.V(100).Infof("Getting preflightvalidationocp %s from namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	preflightvalidationocp := &moduleV1Beta1.PreflightValidationOCP{}
@@ -270,27 +271,29 @@ func (builder *PreflightValidationOCPBuilder) validate() (bool, error) {
 	resourceCRD := "PreflightValidationOCP"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
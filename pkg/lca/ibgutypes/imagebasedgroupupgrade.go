@@ -0,0 +1,233 @@
+package ibgutypes
+
+import (
+	lcav1alpha1 "github.com/openshift-kni/lifecycle-agent/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Action is an action of an ImageBasedGroupUpgrade plan item, run on every selected cluster through its
+// ImageBasedUpgrade.
+type Action string
+
+const (
+	// Prep moves the ImageBasedUpgrade of the clusters to the Prep stage.
+	Prep Action = "Prep"
+	// Upgrade moves the ImageBasedUpgrade of the clusters to the Upgrade stage.
+	Upgrade Action = "Upgrade"
+	// FinalizeUpgrade moves the ImageBasedUpgrade of the upgraded clusters back to the Idle stage.
+	FinalizeUpgrade Action = "FinalizeUpgrade"
+	// Abort moves the ImageBasedUpgrade of the clusters which are not upgraded yet back to the Idle stage.
+	Abort Action = "Abort"
+	// Rollback moves the ImageBasedUpgrade of the upgraded clusters to the Rollback stage.
+	Rollback Action = "Rollback"
+	// FinalizeRollback moves the ImageBasedUpgrade of the rolled back clusters back to the Idle stage.
+	FinalizeRollback Action = "FinalizeRollback"
+)
+
+// IBUSpec defines the ImageBasedUpgrade created on every selected cluster.
+type IBUSpec struct {
+	// seedImageRef is the seed image the clusters are upgraded to.
+	SeedImageRef lcav1alpha1.SeedImageRef `json:"seedImageRef,omitempty"`
+	// additionalImages references the configmap listing the images to precache besides the seed image.
+	AdditionalImages lcav1alpha1.ConfigMapRef `json:"additionalImages,omitempty"`
+	// oadpContent references the configmaps holding the OADP backup and restore CRs.
+	OADPContent []lcav1alpha1.ConfigMapRef `json:"oadpContent,omitempty"`
+	// extraManifests references the configmaps holding the manifests applied after the upgrade.
+	ExtraManifests []lcav1alpha1.ConfigMapRef `json:"extraManifests,omitempty"`
+	// autoRollbackOnFailure configures when the clusters roll back on their own after a failed upgrade.
+	AutoRollbackOnFailure *lcav1alpha1.AutoRollbackOnFailure `json:"autoRollbackOnFailure,omitempty"`
+}
+
+// RolloutStrategy defines how the actions of a plan item are rolled out to the clusters.
+type RolloutStrategy struct {
+	// maxConcurrency is the number of clusters the actions are run on at the same time.
+	MaxConcurrency int `json:"maxConcurrency"`
+	// timeout is the number of minutes the actions are allowed to run on all the clusters.
+	Timeout int `json:"timeout,omitempty"`
+}
+
+// PlanItem defines a set of actions run together on the clusters.
+type PlanItem struct {
+	// actions run on every cluster, in order.
+	Actions []Action `json:"actions"`
+	// rolloutStrategy of the actions.
+	RolloutStrategy RolloutStrategy `json:"rolloutStrategy"`
+}
+
+// ImageBasedGroupUpgradeSpec defines the desired state of ImageBasedGroupUpgrade.
+type ImageBasedGroupUpgradeSpec struct {
+	// ibuSpec of the ImageBasedUpgrade created on every selected cluster.
+	IBUSpec IBUSpec `json:"ibuSpec"`
+	// clusterLabelSelectors select the managed clusters to upgrade.
+	ClusterLabelSelectors []metav1.LabelSelector `json:"clusterLabelSelectors"`
+	// plan items run one after the other. Items can only be appended once the ImageBasedGroupUpgrade is created.
+	Plan []PlanItem `json:"plan"`
+}
+
+// ActionMessage is an action run on a cluster and the message reported for it.
+type ActionMessage struct {
+	// action run on the cluster.
+	Action Action `json:"action"`
+	// message reported for the action, usually the reason of a failure.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterState is the progress of the plan on a cluster.
+type ClusterState struct {
+	// name of the cluster.
+	Name string `json:"name"`
+	// completedActions on the cluster.
+	CompletedActions []ActionMessage `json:"completedActions,omitempty"`
+	// failedActions on the cluster.
+	FailedActions []ActionMessage `json:"failedActions,omitempty"`
+	// currentAction running on the cluster.
+	CurrentAction *ActionMessage `json:"currentAction,omitempty"`
+}
+
+// ImageBasedGroupUpgradeStatus defines the observed state of ImageBasedGroupUpgrade.
+type ImageBasedGroupUpgradeStatus struct {
+	// observedGeneration is the generation of the spec last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// startedAt is the time the first plan item started.
+	StartedAt metav1.Time `json:"startedAt,omitempty"`
+	// completedAt is the time the last plan item completed.
+	CompletedAt metav1.Time `json:"completedAt,omitempty"`
+	// conditions of the ImageBasedGroupUpgrade.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// clusters is the progress of the plan on every selected cluster.
+	Clusters []ClusterState `json:"clusters,omitempty"`
+}
+
+// ImageBasedGroupUpgrade upgrades a group of managed clusters with the image based upgrade, by rolling out an
+// ImageBasedUpgrade to every cluster through ClusterGroupUpgrades.
+type ImageBasedGroupUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageBasedGroupUpgradeSpec   `json:"spec,omitempty"`
+	Status ImageBasedGroupUpgradeStatus `json:"status,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionMessage) DeepCopyInto(out *ActionMessage) {
+	*out = *in
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterState) DeepCopyInto(out *ClusterState) {
+	*out = *in
+
+	out.CompletedActions = copyActionMessages(in.CompletedActions)
+	out.FailedActions = copyActionMessages(in.FailedActions)
+
+	if in.CurrentAction != nil {
+		out.CurrentAction = new(ActionMessage)
+		in.CurrentAction.DeepCopyInto(out.CurrentAction)
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanItem) DeepCopyInto(out *PlanItem) {
+	*out = *in
+
+	if in.Actions != nil {
+		out.Actions = make([]Action, len(in.Actions))
+		copy(out.Actions, in.Actions)
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBUSpec) DeepCopyInto(out *IBUSpec) {
+	*out = *in
+
+	in.SeedImageRef.DeepCopyInto(&out.SeedImageRef)
+	out.OADPContent = copyConfigMapRefs(in.OADPContent)
+	out.ExtraManifests = copyConfigMapRefs(in.ExtraManifests)
+
+	if in.AutoRollbackOnFailure != nil {
+		out.AutoRollbackOnFailure = new(lcav1alpha1.AutoRollbackOnFailure)
+		in.AutoRollbackOnFailure.DeepCopyInto(out.AutoRollbackOnFailure)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageBasedGroupUpgrade.
+func (in *ImageBasedGroupUpgrade) DeepCopy() *ImageBasedGroupUpgrade {
+	if in == nil {
+		return nil
+	}
+
+	out := new(ImageBasedGroupUpgrade)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.IBUSpec.DeepCopyInto(&out.Spec.IBUSpec)
+
+	if in.Spec.ClusterLabelSelectors != nil {
+		out.Spec.ClusterLabelSelectors = make([]metav1.LabelSelector, len(in.Spec.ClusterLabelSelectors))
+
+		for index := range in.Spec.ClusterLabelSelectors {
+			in.Spec.ClusterLabelSelectors[index].DeepCopyInto(&out.Spec.ClusterLabelSelectors[index])
+		}
+	}
+
+	if in.Spec.Plan != nil {
+		out.Spec.Plan = make([]PlanItem, len(in.Spec.Plan))
+
+		for index := range in.Spec.Plan {
+			in.Spec.Plan[index].DeepCopyInto(&out.Spec.Plan[index])
+		}
+	}
+
+	out.Status.ObservedGeneration = in.Status.ObservedGeneration
+	in.Status.StartedAt.DeepCopyInto(&out.Status.StartedAt)
+	in.Status.CompletedAt.DeepCopyInto(&out.Status.CompletedAt)
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	if in.Status.Clusters != nil {
+		out.Status.Clusters = make([]ClusterState, len(in.Status.Clusters))
+
+		for index := range in.Status.Clusters {
+			in.Status.Clusters[index].DeepCopyInto(&out.Status.Clusters[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageBasedGroupUpgrade) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+func copyActionMessages(in []ActionMessage) []ActionMessage {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]ActionMessage, len(in))
+	copy(out, in)
+
+	return out
+}
+
+func copyConfigMapRefs(in []lcav1alpha1.ConfigMapRef) []lcav1alpha1.ConfigMapRef {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]lcav1alpha1.ConfigMapRef, len(in))
+	copy(out, in)
+
+	return out
+}
@@ -0,0 +1,773 @@
+package lca
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/lca/ibgutypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	lcav1alpha1 "github.com/openshift-kni/lifecycle-agent/api/v1alpha1"
+	"golang.org/x/exp/slices"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// IbguAPIGroup represents the api group of the ImageBasedGroupUpgrade.
+	IbguAPIGroup = "lcm.openshift.io"
+	// IbguAPIVersion represents the version of the ImageBasedGroupUpgrade api.
+	IbguAPIVersion = "v1alpha1"
+	// IbguKind represents the kind of the ImageBasedGroupUpgrade object.
+	IbguKind = "ImageBasedGroupUpgrade"
+)
+
+// IbguBuilder provides struct for the ImageBasedGroupUpgrade object containing connection to the cluster and the
+// ImageBasedGroupUpgrade definitions.
+type IbguBuilder struct {
+	// ImageBasedGroupUpgrade definition. Used to create the ImageBasedGroupUpgrade object.
+	Definition *ibgutypes.ImageBasedGroupUpgrade
+	// Created ImageBasedGroupUpgrade object.
+	Object *ibgutypes.ImageBasedGroupUpgrade
+	// Used in functions that define or mutate ImageBasedGroupUpgrade definition. errorMsg is processed before the
+	// ImageBasedGroupUpgrade object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Delete also removes the ClusterGroupUpgrades generated for the plan items.
+	cascadeDelete bool
+}
+
+// NewIbguBuilder creates a new instance of IbguBuilder. At least one cluster label selector, the seed image and one
+// plan item must be set before creating it.
+func NewIbguBuilder(apiClient *clients.Settings, name, nsname string) *IbguBuilder {
+	logging.V(100).Infof(
+		"Initializing new ImageBasedGroupUpgrade structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	builder := IbguBuilder{
+		apiClient: apiClient,
+		Definition: &ibgutypes.ImageBasedGroupUpgrade{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       IbguKind,
+				APIVersion: fmt.Sprintf("%s/%s", IbguAPIGroup, IbguAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the ImageBasedGroupUpgrade is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ImageBasedGroupUpgrade 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the ImageBasedGroupUpgrade is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullIbgu pulls existing ImageBasedGroupUpgrade from cluster.
+func PullIbgu(apiClient *clients.Settings, name, nsname string) (*IbguBuilder, error) {
+	logging.V(100).Infof("Pulling existing ImageBasedGroupUpgrade name %s under namespace %s from cluster",
+		name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("ImageBasedGroupUpgrade 'apiClient' cannot be empty")
+	}
+
+	builder := IbguBuilder{
+		apiClient: apiClient,
+		Definition: &ibgutypes.ImageBasedGroupUpgrade{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the ImageBasedGroupUpgrade is empty")
+
+		return nil, fmt.Errorf("ImageBasedGroupUpgrade 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the ImageBasedGroupUpgrade is empty")
+
+		return nil, fmt.Errorf("ImageBasedGroupUpgrade 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("ImageBasedGroupUpgrade object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+
+	return &builder, nil
+}
+
+// WithClusterLabelSelectors selects the managed clusters which have all the given labels.
+func (builder *IbguBuilder) WithClusterLabelSelectors(labels map[string]string) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding cluster label selector %v to ImageBasedGroupUpgrade %s in namespace %s",
+		labels, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(labels) == 0 {
+		logging.V(100).Infof("The cluster labels of the ImageBasedGroupUpgrade are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade cluster label selector cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterLabelSelectors = append(builder.Definition.Spec.ClusterLabelSelectors,
+		metav1.LabelSelector{MatchLabels: labels})
+
+	return builder
+}
+
+// WithSeedImageRef sets the seed image the clusters are upgraded to and its OpenShift version.
+func (builder *IbguBuilder) WithSeedImageRef(seedImage, seedVersion string) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting seed image %s version %s in ImageBasedGroupUpgrade %s in namespace %s",
+		seedImage, seedVersion, builder.Definition.Name, builder.Definition.Namespace)
+
+	if seedImage == "" || seedVersion == "" {
+		logging.V(100).Infof("The seed image or version of the ImageBasedGroupUpgrade is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade 'seedImage' and 'seedVersion' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.IBUSpec.SeedImageRef.Image = seedImage
+	builder.Definition.Spec.IBUSpec.SeedImageRef.Version = seedVersion
+
+	return builder
+}
+
+// WithOadpContent adds the configmap holding OADP backup and restore CRs to the ImageBasedUpgrades.
+func (builder *IbguBuilder) WithOadpContent(name, nsname string) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding oadp content configmap %s in namespace %s to ImageBasedGroupUpgrade %s",
+		name, nsname, builder.Definition.Name)
+
+	if name == "" || nsname == "" {
+		logging.V(100).Infof("The oadp content configmap name or namespace is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade oadp content 'name' and 'nsname' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.IBUSpec.OADPContent = append(builder.Definition.Spec.IBUSpec.OADPContent,
+		lcav1alpha1.ConfigMapRef{Name: name, Namespace: nsname})
+
+	return builder
+}
+
+// WithExtraManifests adds the configmap holding manifests applied after the upgrade to the ImageBasedUpgrades.
+func (builder *IbguBuilder) WithExtraManifests(name, nsname string) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding extra manifests configmap %s in namespace %s to ImageBasedGroupUpgrade %s",
+		name, nsname, builder.Definition.Name)
+
+	if name == "" || nsname == "" {
+		logging.V(100).Infof("The extra manifests configmap name or namespace is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade extra manifests 'name' and 'nsname' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.IBUSpec.ExtraManifests = append(builder.Definition.Spec.IBUSpec.ExtraManifests,
+		lcav1alpha1.ConfigMapRef{Name: name, Namespace: nsname})
+
+	return builder
+}
+
+// WithAutoRollbackOnFailure sets the timeout of the init monitor watchdog, which rolls the clusters back when the
+// upgrade does not complete in time. A timeout of 0 keeps the default of the lifecycle agent.
+func (builder *IbguBuilder) WithAutoRollbackOnFailure(initMonitorTimeoutSeconds int) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting auto rollback init monitor timeout %d seconds in ImageBasedGroupUpgrade %s",
+		initMonitorTimeoutSeconds, builder.Definition.Name)
+
+	if initMonitorTimeoutSeconds < 0 {
+		logging.V(100).Infof("The auto rollback init monitor timeout of the ImageBasedGroupUpgrade is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade 'initMonitorTimeoutSeconds' cannot be negative"))
+
+		return builder
+	}
+
+	builder.getAutoRollbackOnFailure().InitMonitorTimeoutSeconds = initMonitorTimeoutSeconds
+
+	return builder
+}
+
+// WithAutoRollbackDisabledFor disables the automatic rollback of the clusters on a failure of the post reboot
+// configuration or of the upgrade completion, and disables the init monitor watchdog.
+func (builder *IbguBuilder) WithAutoRollbackDisabledFor(
+	postRebootConfig, upgradeCompletion, initMonitor bool) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Disabling auto rollback in ImageBasedGroupUpgrade %s for post reboot config: %t, "+
+		"upgrade completion: %t, init monitor: %t", builder.Definition.Name, postRebootConfig, upgradeCompletion,
+		initMonitor)
+
+	autoRollbackOnFailure := builder.getAutoRollbackOnFailure()
+	autoRollbackOnFailure.DisabledForPostRebootConfig = postRebootConfig
+	autoRollbackOnFailure.DisabledForUpgradeCompletion = upgradeCompletion
+	autoRollbackOnFailure.DisabledInitMonitor = initMonitor
+
+	return builder
+}
+
+// WithPlanTimeout sets the timeout in minutes of the plan item at the given index, e.g. to give the Upgrade action of
+// a preset plan more time.
+func (builder *IbguBuilder) WithPlanTimeout(index, timeout int) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting timeout %d of plan item %d in ImageBasedGroupUpgrade %s",
+		timeout, index, builder.Definition.Name)
+
+	if index < 0 || index >= len(builder.Definition.Spec.Plan) {
+		logging.V(100).Infof("The ImageBasedGroupUpgrade plan item index is out of range")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"ImageBasedGroupUpgrade plan item index %d is out of range, the plan has %d items",
+			index, len(builder.Definition.Spec.Plan)))
+
+		return builder
+	}
+
+	if timeout < 0 {
+		logging.V(100).Infof("The ImageBasedGroupUpgrade plan item timeout is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade plan item 'timeout' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Plan[index].RolloutStrategy.Timeout = timeout
+
+	return builder
+}
+
+// WithPlan appends a plan item running the given actions on maxConcurrency clusters at a time. The timeout is the
+// number of minutes the actions are allowed to run on all the clusters, 0 keeps the default of the operator.
+func (builder *IbguBuilder) WithPlan(
+	actions []ibgutypes.Action, maxConcurrency, timeout int) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding plan item %v with maxConcurrency %d and timeout %d to ImageBasedGroupUpgrade %s",
+		actions, maxConcurrency, timeout, builder.Definition.Name)
+
+	if len(actions) == 0 {
+		logging.V(100).Infof("The actions of the ImageBasedGroupUpgrade plan item are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("ImageBasedGroupUpgrade plan item 'actions' cannot be empty"))
+
+		return builder
+	}
+
+	err := validatePlanItem(actions, maxConcurrency, timeout)
+	if err != nil {
+		logging.V(100).Infof("The ImageBasedGroupUpgrade plan item is invalid: %v", err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Plan = append(builder.Definition.Spec.Plan, ibgutypes.PlanItem{
+		Actions: actions,
+		RolloutStrategy: ibgutypes.RolloutStrategy{
+			MaxConcurrency: maxConcurrency,
+			Timeout:        timeout,
+		},
+	})
+
+	return builder
+}
+
+// WithUpgradePlan appends a plan item running the Prep, Upgrade and FinalizeUpgrade actions on maxConcurrency
+// clusters at a time.
+func (builder *IbguBuilder) WithUpgradePlan(maxConcurrency, timeout int) *IbguBuilder {
+	return builder.WithPlan(
+		[]ibgutypes.Action{ibgutypes.Prep, ibgutypes.Upgrade, ibgutypes.FinalizeUpgrade}, maxConcurrency, timeout)
+}
+
+// WithRollbackPlan appends a plan item running the Rollback and FinalizeRollback actions on maxConcurrency clusters at
+// a time.
+func (builder *IbguBuilder) WithRollbackPlan(maxConcurrency, timeout int) *IbguBuilder {
+	return builder.WithPlan(
+		[]ibgutypes.Action{ibgutypes.Rollback, ibgutypes.FinalizeRollback}, maxConcurrency, timeout)
+}
+
+// WithCascadeDelete makes Delete and DeleteAndWait also remove the ClusterGroupUpgrades generated for the plan
+// items, so that no ClusterGroupUpgrade outlives the ImageBasedGroupUpgrade.
+func (builder *IbguBuilder) WithCascadeDelete(cascade bool) *IbguBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting cascade delete %t in ImageBasedGroupUpgrade %s in namespace %s",
+		cascade, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.cascadeDelete = cascade
+
+	return builder
+}
+
+// Get returns ImageBasedGroupUpgrade object if found.
+func (builder *IbguBuilder) Get() (*ibgutypes.ImageBasedGroupUpgrade, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting ImageBasedGroupUpgrade object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetIbguGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("ImageBasedGroupUpgrade object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertIbguToStructured(unsObject)
+}
+
+// Exists checks whether the given ImageBasedGroupUpgrade exists.
+func (builder *IbguBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if ImageBasedGroupUpgrade %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an ImageBasedGroupUpgrade in the cluster and stores the created object in struct.
+func (builder *IbguBuilder) Create() (*IbguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the ImageBasedGroupUpgrade %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.ClusterLabelSelectors) == 0 || len(builder.Definition.Spec.Plan) == 0 {
+		return builder, fmt.Errorf(
+			"ImageBasedGroupUpgrade %s in namespace %s must have a cluster label selector and a plan item",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	unstructuredIbgu, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured ImageBasedGroupUpgrade to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetIbguGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredIbgu}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create ImageBasedGroupUpgrade %s due to %s",
+			builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertIbguToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing ImageBasedGroupUpgrade object with the definition in builder. The plan items of the
+// ImageBasedGroupUpgrade in the cluster cannot be changed or removed, only new items can be appended.
+func (builder *IbguBuilder) Update() (*IbguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the ImageBasedGroupUpgrade %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update ImageBasedGroupUpgrade, object doesn't exist on cluster")
+	}
+
+	livePlan := builder.Object.Spec.Plan
+	if len(livePlan) > len(builder.Definition.Spec.Plan) ||
+		!reflect.DeepEqual(livePlan, builder.Definition.Spec.Plan[:len(livePlan)]) {
+		return builder, fmt.Errorf(
+			"failed to update ImageBasedGroupUpgrade %s in namespace %s, plan items can only be appended",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredIbgu, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured ImageBasedGroupUpgrade to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetIbguGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredIbgu}, metav1.UpdateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to update ImageBasedGroupUpgrade %s due to %s",
+			builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertIbguToStructured(unsObject)
+
+	return builder, err
+}
+
+// AppendPlan appends a plan item to the ImageBasedGroupUpgrade in the cluster. The definition is refreshed from the
+// cluster first, so that only the new plan item is applied on top of the live object.
+func (builder *IbguBuilder) AppendPlan(
+	actions []ibgutypes.Action, maxConcurrency, timeout int) (*IbguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Appending plan item %v to ImageBasedGroupUpgrade %s in namespace %s",
+		actions, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to append plan item to ImageBasedGroupUpgrade %s in namespace %s, "+
+			"object doesn't exist on cluster", builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+
+	builder.WithPlan(actions, maxConcurrency, timeout)
+
+	if builder.errorMsg != nil {
+		// The invalid plan item is not kept, so the builder can still be used.
+		err := builder.errorMsg
+		builder.errorMsg = nil
+		builder.Definition = builder.Object.DeepCopy()
+
+		return builder, err
+	}
+
+	return builder.Update()
+}
+
+// AppendAbortPlan appends a plan item running the Abort action to the ImageBasedGroupUpgrade in the cluster, moving
+// the clusters which are not upgraded yet back to the Idle stage.
+func (builder *IbguBuilder) AppendAbortPlan(maxConcurrency, timeout int) (*IbguBuilder, error) {
+	return builder.AppendPlan([]ibgutypes.Action{ibgutypes.Abort}, maxConcurrency, timeout)
+}
+
+// AppendFinalizeUpgradePlan appends a plan item running the FinalizeUpgrade action to the ImageBasedGroupUpgrade in
+// the cluster, e.g. after a plan which stopped at the Upgrade action.
+func (builder *IbguBuilder) AppendFinalizeUpgradePlan(maxConcurrency, timeout int) (*IbguBuilder, error) {
+	return builder.AppendPlan([]ibgutypes.Action{ibgutypes.FinalizeUpgrade}, maxConcurrency, timeout)
+}
+
+// Delete removes ImageBasedGroupUpgrade object from a cluster. With WithCascadeDelete the ClusterGroupUpgrades
+// generated for the plan items are removed afterwards. Delete does not wait for the finalizers of the
+// ImageBasedGroupUpgrade, use DeleteAndWait for that.
+func (builder *IbguBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the ImageBasedGroupUpgrade object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	var (
+		childCGUs []string
+		err       error
+	)
+
+	// The children are looked up before the ImageBasedGroupUpgrade is gone, since they are matched by owner UID.
+	if builder.cascadeDelete {
+		childCGUs, err = builder.getChildCGUs(builder.Object.UID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = builder.apiClient.Resource(GetIbguGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete ImageBasedGroupUpgrade: %w", err)
+	}
+
+	for _, childCGU := range childCGUs {
+		logging.V(100).Infof("Deleting the cgu %s generated by ImageBasedGroupUpgrade %s in namespace %s",
+			childCGU, builder.Definition.Name, builder.Definition.Namespace)
+
+		err = builder.apiClient.ClientCgu.RanV1alpha1().ClusterGroupUpgrades(builder.Definition.Namespace).Delete(
+			context.TODO(), childCGU, metav1.DeleteOptions{})
+
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("can not delete cgu %s generated by ImageBasedGroupUpgrade: %w", childCGU, err)
+		}
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// DeleteAndWait deletes the ImageBasedGroupUpgrade and waits for the duration of the defined timeout until it, and
+// with WithCascadeDelete the generated ClusterGroupUpgrades, are removed from the cluster. On timeout, the
+// finalizers still set on the ImageBasedGroupUpgrade are included in the returned error.
+func (builder *IbguBuilder) DeleteAndWait(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting ImageBasedGroupUpgrade %s in namespace %s and waiting for it to be removed",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var ownerUID types.UID
+
+	if builder.Exists() {
+		ownerUID = builder.Object.UID
+	}
+
+	err := builder.Delete()
+	if err != nil {
+		return err
+	}
+
+	var lastObject *ibgutypes.ImageBasedGroupUpgrade
+
+	err = wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			ibgu, err := builder.Get()
+			if err == nil {
+				lastObject = ibgu
+
+				return false, nil
+			}
+
+			if !k8serrors.IsNotFound(err) {
+				logging.V(100).Infof("Failed to get ImageBasedGroupUpgrade %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			if !builder.cascadeDelete || ownerUID == "" {
+				return true, nil
+			}
+
+			childCGUs, err := builder.getChildCGUs(ownerUID)
+			if err != nil {
+				logging.V(100).Infof("Failed to list cgus of ImageBasedGroupUpgrade %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return len(childCGUs) == 0, nil
+		})
+
+	if err != nil && lastObject != nil {
+		return fmt.Errorf("ImageBasedGroupUpgrade %s in namespace %s was not removed, finalizers %v: %w",
+			builder.Definition.Name, builder.Definition.Namespace, lastObject.Finalizers, err)
+	}
+
+	return err
+}
+
+// GetIbguGVR returns ImageBasedGroupUpgrade's GroupVersionResource which could be used for Clean function.
+func GetIbguGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: IbguAPIGroup, Version: IbguAPIVersion, Resource: "imagebasedgroupupgrades"}
+}
+
+// getChildCGUs returns the names of the ClusterGroupUpgrades in the namespace of the ImageBasedGroupUpgrade which
+// are owned by the ImageBasedGroupUpgrade with the given UID.
+func (builder *IbguBuilder) getChildCGUs(ownerUID types.UID) ([]string, error) {
+	cguList, err := builder.apiClient.ClientCgu.RanV1alpha1().ClusterGroupUpgrades(builder.Definition.Namespace).List(
+		context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		logging.V(100).Infof("Failed to list cgus in namespace %s due to %s",
+			builder.Definition.Namespace, err.Error())
+
+		return nil, err
+	}
+
+	var childCGUs []string
+
+	for _, cgu := range cguList.Items {
+		for _, ownerReference := range cgu.OwnerReferences {
+			if ownerReference.Kind == IbguKind && ownerReference.UID == ownerUID {
+				childCGUs = append(childCGUs, cgu.Name)
+
+				break
+			}
+		}
+	}
+
+	return childCGUs, nil
+}
+
+// getAutoRollbackOnFailure returns the auto rollback settings of the definition, initializing them when unset.
+func (builder *IbguBuilder) getAutoRollbackOnFailure() *lcav1alpha1.AutoRollbackOnFailure {
+	if builder.Definition.Spec.IBUSpec.AutoRollbackOnFailure == nil {
+		builder.Definition.Spec.IBUSpec.AutoRollbackOnFailure = &lcav1alpha1.AutoRollbackOnFailure{}
+	}
+
+	return builder.Definition.Spec.IBUSpec.AutoRollbackOnFailure
+}
+
+// validatePlanItem checks that the actions of a plan item are known and not repeated, and that its rollout strategy
+// is valid.
+func validatePlanItem(actions []ibgutypes.Action, maxConcurrency, timeout int) error {
+	allowedActions := []ibgutypes.Action{
+		ibgutypes.Prep, ibgutypes.Upgrade, ibgutypes.FinalizeUpgrade,
+		ibgutypes.Abort, ibgutypes.Rollback, ibgutypes.FinalizeRollback,
+	}
+
+	for index, action := range actions {
+		if !slices.Contains(allowedActions, action) {
+			return fmt.Errorf("ImageBasedGroupUpgrade plan item action %s is invalid, allowed actions are %v",
+				action, allowedActions)
+		}
+
+		if slices.Contains(actions[:index], action) {
+			return fmt.Errorf("ImageBasedGroupUpgrade plan item action %s cannot be repeated", action)
+		}
+	}
+
+	if maxConcurrency < 1 {
+		return fmt.Errorf("ImageBasedGroupUpgrade plan item 'maxConcurrency' must be at least 1")
+	}
+
+	if timeout < 0 {
+		return fmt.Errorf("ImageBasedGroupUpgrade plan item 'timeout' cannot be negative")
+	}
+
+	return nil
+}
+
+// convertIbguToStructured converts the unstructured object returned by the dynamic client to an
+// ImageBasedGroupUpgrade.
+func convertIbguToStructured(unsObject *unstructured.Unstructured) (*ibgutypes.ImageBasedGroupUpgrade, error) {
+	ibgu := &ibgutypes.ImageBasedGroupUpgrade{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, ibgu)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to ImageBasedGroupUpgrade object %s",
+			unsObject.GetName())
+
+		return nil, err
+	}
+
+	return ibgu, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *IbguBuilder) validate() (bool, error) {
+	resourceCRD := "ImageBasedGroupUpgrade"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
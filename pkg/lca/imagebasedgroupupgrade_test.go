@@ -0,0 +1,642 @@
+package lca
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/lca/ibgutypes"
+	lcav1alpha1 "github.com/openshift-kni/lifecycle-agent/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+const (
+	defaultIbguName      = "test-ibgu"
+	defaultIbguNamespace = "test-ns"
+	defaultIbguUID       = types.UID("ibgu-uid")
+)
+
+var ibguGVK = schema.GroupVersionKind{Group: IbguAPIGroup, Version: IbguAPIVersion, Kind: IbguKind}
+
+func TestNewIbguBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultIbguName,
+			namespace:     defaultIbguNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultIbguNamespace,
+			expectedError: "ImageBasedGroupUpgrade 'name' cannot be empty",
+		},
+		{
+			name:          defaultIbguName,
+			namespace:     "",
+			expectedError: "ImageBasedGroupUpgrade 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewIbguBuilder(clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.namespace)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullIbgu(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultIbguName,
+			namespace:           defaultIbguNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			namespace:           defaultIbguNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "ImageBasedGroupUpgrade 'name' cannot be empty",
+		},
+		{
+			name:                defaultIbguName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "ImageBasedGroupUpgrade 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultIbguName,
+			namespace:           defaultIbguNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Sprintf(
+				"ImageBasedGroupUpgrade object %s doesn't exist in namespace %s", defaultIbguName, defaultIbguNamespace),
+		},
+		{
+			name:                defaultIbguName,
+			namespace:           defaultIbguNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "ImageBasedGroupUpgrade 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIbgu())
+		}
+
+		if testCase.client {
+			testSettings = buildIbguTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullIbgu(testSettings, testCase.name, testCase.namespace)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.NotSame(t, testBuilder.Object, testBuilder.Definition)
+		}
+	}
+}
+
+func TestIbguWithPlan(t *testing.T) {
+	testCases := []struct {
+		actions        []ibgutypes.Action
+		maxConcurrency int
+		timeout        int
+		expectedError  string
+	}{
+		{
+			actions:        []ibgutypes.Action{ibgutypes.Abort},
+			maxConcurrency: 2,
+			timeout:        30,
+			expectedError:  "",
+		},
+		{
+			actions:        []ibgutypes.Action{},
+			maxConcurrency: 1,
+			timeout:        0,
+			expectedError:  "ImageBasedGroupUpgrade plan item 'actions' cannot be empty",
+		},
+		{
+			actions:        []ibgutypes.Action{ibgutypes.Prep, "Upgrde"},
+			maxConcurrency: 1,
+			timeout:        0,
+			expectedError: "ImageBasedGroupUpgrade plan item action Upgrde is invalid, allowed actions are " +
+				"[Prep Upgrade FinalizeUpgrade Abort Rollback FinalizeRollback]",
+		},
+		{
+			actions:        []ibgutypes.Action{ibgutypes.Prep, ibgutypes.Prep},
+			maxConcurrency: 1,
+			timeout:        0,
+			expectedError:  "ImageBasedGroupUpgrade plan item action Prep cannot be repeated",
+		},
+		{
+			actions:        []ibgutypes.Action{ibgutypes.Prep},
+			maxConcurrency: 0,
+			timeout:        0,
+			expectedError:  "ImageBasedGroupUpgrade plan item 'maxConcurrency' must be at least 1",
+		},
+		{
+			actions:        []ibgutypes.Action{ibgutypes.Prep},
+			maxConcurrency: 1,
+			timeout:        -1,
+			expectedError:  "ImageBasedGroupUpgrade plan item 'timeout' cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewIbguBuilder(buildIbguTestClientWithDummyObject(nil), defaultIbguName, defaultIbguNamespace).
+			WithPlan(testCase.actions, testCase.maxConcurrency, testCase.timeout)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, []ibgutypes.PlanItem{{
+				Actions: testCase.actions,
+				RolloutStrategy: ibgutypes.RolloutStrategy{
+					MaxConcurrency: testCase.maxConcurrency,
+					Timeout:        testCase.timeout,
+				},
+			}}, testBuilder.Definition.Spec.Plan)
+		}
+	}
+}
+
+func TestIbguWithPresetPlans(t *testing.T) {
+	testCases := []struct {
+		withPlan        func(*IbguBuilder, int, int) *IbguBuilder
+		expectedActions []ibgutypes.Action
+	}{
+		{
+			withPlan:        (*IbguBuilder).WithUpgradePlan,
+			expectedActions: []ibgutypes.Action{ibgutypes.Prep, ibgutypes.Upgrade, ibgutypes.FinalizeUpgrade},
+		},
+		{
+			withPlan:        (*IbguBuilder).WithRollbackPlan,
+			expectedActions: []ibgutypes.Action{ibgutypes.Rollback, ibgutypes.FinalizeRollback},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.withPlan(
+			NewIbguBuilder(buildIbguTestClientWithDummyObject(nil), defaultIbguName, defaultIbguNamespace), 5, 60)
+
+		_, err := testBuilder.validate()
+		assert.Nil(t, err)
+		assert.Len(t, testBuilder.Definition.Spec.Plan, 1)
+		assert.Equal(t, testCase.expectedActions, testBuilder.Definition.Spec.Plan[0].Actions)
+		assert.Equal(t, ibgutypes.RolloutStrategy{MaxConcurrency: 5, Timeout: 60},
+			testBuilder.Definition.Spec.Plan[0].RolloutStrategy)
+	}
+}
+
+func TestIbguWithAutoRollbackOnFailure(t *testing.T) {
+	testCases := []struct {
+		initMonitorTimeoutSeconds int
+		expectedError             string
+	}{
+		{
+			initMonitorTimeoutSeconds: 1800,
+			expectedError:             "",
+		},
+		{
+			initMonitorTimeoutSeconds: 0,
+			expectedError:             "",
+		},
+		{
+			initMonitorTimeoutSeconds: -1,
+			expectedError:             "ImageBasedGroupUpgrade 'initMonitorTimeoutSeconds' cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIbguBuilder(buildIbguTestClientWithDummyObject(nil)).
+			WithAutoRollbackDisabledFor(true, false, false).
+			WithAutoRollbackOnFailure(testCase.initMonitorTimeoutSeconds)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, &lcav1alpha1.AutoRollbackOnFailure{
+				DisabledForPostRebootConfig: true,
+				InitMonitorTimeoutSeconds:   testCase.initMonitorTimeoutSeconds,
+			}, testBuilder.Definition.Spec.IBUSpec.AutoRollbackOnFailure)
+		}
+	}
+}
+
+func TestIbguWithAutoRollbackDisabledFor(t *testing.T) {
+	testCases := []struct {
+		postRebootConfig  bool
+		upgradeCompletion bool
+		initMonitor       bool
+	}{
+		{postRebootConfig: true, upgradeCompletion: false, initMonitor: false},
+		{postRebootConfig: false, upgradeCompletion: true, initMonitor: false},
+		{postRebootConfig: false, upgradeCompletion: false, initMonitor: true},
+		{postRebootConfig: false, upgradeCompletion: false, initMonitor: false},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIbguBuilder(buildIbguTestClientWithDummyObject(nil)).
+			WithAutoRollbackOnFailure(600).
+			WithAutoRollbackDisabledFor(testCase.postRebootConfig, testCase.upgradeCompletion, testCase.initMonitor)
+
+		_, err := testBuilder.validate()
+		assert.Nil(t, err)
+		assert.Equal(t, &lcav1alpha1.AutoRollbackOnFailure{
+			DisabledForPostRebootConfig:  testCase.postRebootConfig,
+			DisabledForUpgradeCompletion: testCase.upgradeCompletion,
+			DisabledInitMonitor:          testCase.initMonitor,
+			InitMonitorTimeoutSeconds:    600,
+		}, testBuilder.Definition.Spec.IBUSpec.AutoRollbackOnFailure)
+	}
+}
+
+func TestIbguWithPlanTimeout(t *testing.T) {
+	testCases := []struct {
+		index         int
+		timeout       int
+		expectedError string
+	}{
+		{
+			index:         1,
+			timeout:       120,
+			expectedError: "",
+		},
+		{
+			index:         2,
+			timeout:       120,
+			expectedError: "ImageBasedGroupUpgrade plan item index 2 is out of range, the plan has 2 items",
+		},
+		{
+			index:         -1,
+			timeout:       120,
+			expectedError: "ImageBasedGroupUpgrade plan item index -1 is out of range, the plan has 2 items",
+		},
+		{
+			index:         0,
+			timeout:       -1,
+			expectedError: "ImageBasedGroupUpgrade plan item 'timeout' cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIbguBuilder(buildIbguTestClientWithDummyObject(nil)).
+			WithRollbackPlan(1, 30).
+			WithPlanTimeout(testCase.index, testCase.timeout)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			for index, planItem := range testBuilder.Definition.Spec.Plan {
+				if index == testCase.index {
+					assert.Equal(t, testCase.timeout, planItem.RolloutStrategy.Timeout)
+				} else {
+					assert.Equal(t, 0, planItem.RolloutStrategy.Timeout)
+				}
+			}
+		}
+	}
+}
+
+func TestIbguCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *IbguBuilder
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidIbguBuilder(buildIbguTestClientWithDummyObject(nil)),
+			expectedError: "",
+		},
+		{
+			testBuilder: NewIbguBuilder(buildIbguTestClientWithDummyObject(nil), defaultIbguName, defaultIbguNamespace).
+				WithClusterLabelSelectors(map[string]string{"common": "true"}),
+			expectedError: fmt.Sprintf(
+				"ImageBasedGroupUpgrade %s in namespace %s must have a cluster label selector and a plan item",
+				defaultIbguName, defaultIbguNamespace),
+		},
+		{
+			testBuilder: buildValidIbguBuilder(buildIbguTestClientWithDummyObject(nil)).
+				WithClusterLabelSelectors(nil),
+			expectedError: "ImageBasedGroupUpgrade cluster label selector cannot be empty",
+		},
+		{
+			testBuilder: buildValidIbguBuilder(buildIbguTestClientWithDummyObject(nil)).
+				WithPlan(nil, 1, 0),
+			expectedError: "ImageBasedGroupUpgrade plan item 'actions' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Spec.Plan, testBuilder.Object.Spec.Plan)
+		}
+	}
+}
+
+func TestIbguUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		plan                []ibgutypes.PlanItem
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			plan:                buildDummyIbgu().Spec.Plan,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: true,
+			plan: append(buildDummyIbgu().Spec.Plan, ibgutypes.PlanItem{
+				Actions:         []ibgutypes.Action{ibgutypes.Upgrade},
+				RolloutStrategy: ibgutypes.RolloutStrategy{MaxConcurrency: 1},
+			}),
+			expectedError: "",
+		},
+		{
+			addToRuntimeObjects: true,
+			plan: []ibgutypes.PlanItem{{
+				Actions:         []ibgutypes.Action{ibgutypes.Upgrade},
+				RolloutStrategy: ibgutypes.RolloutStrategy{MaxConcurrency: 1},
+			}},
+			expectedError: fmt.Sprintf(
+				"failed to update ImageBasedGroupUpgrade %s in namespace %s, plan items can only be appended",
+				defaultIbguName, defaultIbguNamespace),
+		},
+		{
+			addToRuntimeObjects: true,
+			plan:                nil,
+			expectedError: fmt.Sprintf(
+				"failed to update ImageBasedGroupUpgrade %s in namespace %s, plan items can only be appended",
+				defaultIbguName, defaultIbguNamespace),
+		},
+		{
+			addToRuntimeObjects: false,
+			plan:                buildDummyIbgu().Spec.Plan,
+			expectedError:       "failed to update ImageBasedGroupUpgrade, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIbgu())
+		}
+
+		testBuilder := buildValidIbguBuilder(buildIbguTestClientWithDummyObject(runtimeObjects))
+		testBuilder.Definition.Spec.Plan = testCase.plan
+		testBuilder.Definition.Spec.IBUSpec.SeedImageRef.Version = "4.16.1"
+
+		testBuilder, err := testBuilder.Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, "4.16.1", testBuilder.Object.Spec.IBUSpec.SeedImageRef.Version)
+			assert.Equal(t, testCase.plan, testBuilder.Object.Spec.Plan)
+		}
+	}
+}
+
+func TestIbguAppendPlan(t *testing.T) {
+	testCases := []struct {
+		appendPlan          func(*IbguBuilder) (*IbguBuilder, error)
+		addToRuntimeObjects bool
+		expectedAction      ibgutypes.Action
+		expectedError       string
+	}{
+		{
+			appendPlan: func(builder *IbguBuilder) (*IbguBuilder, error) {
+				return builder.AppendAbortPlan(2, 30)
+			},
+			addToRuntimeObjects: true,
+			expectedAction:      ibgutypes.Abort,
+			expectedError:       "",
+		},
+		{
+			appendPlan: func(builder *IbguBuilder) (*IbguBuilder, error) {
+				return builder.AppendFinalizeUpgradePlan(2, 30)
+			},
+			addToRuntimeObjects: true,
+			expectedAction:      ibgutypes.FinalizeUpgrade,
+			expectedError:       "",
+		},
+		{
+			appendPlan: func(builder *IbguBuilder) (*IbguBuilder, error) {
+				return builder.AppendPlan([]ibgutypes.Action{ibgutypes.Abort}, 0, 30)
+			},
+			addToRuntimeObjects: true,
+			expectedError:       "ImageBasedGroupUpgrade plan item 'maxConcurrency' must be at least 1",
+		},
+		{
+			appendPlan: func(builder *IbguBuilder) (*IbguBuilder, error) {
+				return builder.AppendAbortPlan(2, 30)
+			},
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("failed to append plan item to ImageBasedGroupUpgrade %s in namespace %s, "+
+				"object doesn't exist on cluster", defaultIbguName, defaultIbguNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIbgu())
+		}
+
+		testSettings := buildIbguTestClientWithDummyObject(runtimeObjects)
+
+		// The builder definition is stale, the plan item must be appended to the live object.
+		testBuilder := NewIbguBuilder(testSettings, defaultIbguName, defaultIbguNamespace)
+
+		testBuilder, err := testCase.appendPlan(testBuilder)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, append(buildDummyIbgu().Spec.Plan, ibgutypes.PlanItem{
+				Actions:         []ibgutypes.Action{testCase.expectedAction},
+				RolloutStrategy: ibgutypes.RolloutStrategy{MaxConcurrency: 2, Timeout: 30},
+			}), testBuilder.Object.Spec.Plan)
+
+			continue
+		}
+
+		_, err = testBuilder.validate()
+		assert.Nil(t, err)
+
+		if testCase.addToRuntimeObjects {
+			assert.Equal(t, buildDummyIbgu().Spec.Plan, testBuilder.Definition.Spec.Plan)
+		}
+	}
+}
+
+func TestIbguDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		cascade             bool
+	}{
+		{addToRuntimeObjects: true, cascade: false},
+		{addToRuntimeObjects: true, cascade: true},
+		{addToRuntimeObjects: false, cascade: true},
+	}
+
+	for _, testCase := range testCases {
+		runtimeObjects := []runtime.Object{
+			buildDummyChildCGU("child-cgu", defaultIbguUID), buildDummyChildCGU("other-cgu", "other-uid"),
+		}
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIbgu())
+		}
+
+		testSettings := buildIbguTestClientWithDummyObject(runtimeObjects)
+		testBuilder := buildValidIbguBuilder(testSettings).WithCascadeDelete(testCase.cascade)
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+		assert.False(t, testBuilder.Exists())
+
+		assert.Equal(t, !(testCase.addToRuntimeObjects && testCase.cascade), cguExists(testSettings, "child-cgu"))
+		assert.True(t, cguExists(testSettings, "other-cgu"))
+	}
+}
+
+func TestIbguDeleteAndWait(t *testing.T) {
+	testCases := []struct {
+		cascade       bool
+		finalizer     bool
+		expectedError string
+	}{
+		{
+			cascade:       true,
+			finalizer:     false,
+			expectedError: "",
+		},
+		{
+			cascade:       false,
+			finalizer:     false,
+			expectedError: "",
+		},
+		{
+			cascade:   true,
+			finalizer: true,
+			expectedError: fmt.Sprintf(
+				"ImageBasedGroupUpgrade %s in namespace %s was not removed, finalizers [%s]: context deadline exceeded",
+				defaultIbguName, defaultIbguNamespace, "lcm.openshift.io/ibgu-finalizer"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var reactors []clients.TestReactor
+
+		ibgu := buildDummyIbgu()
+
+		if testCase.finalizer {
+			ibgu.Finalizers = []string{"lcm.openshift.io/ibgu-finalizer"}
+
+			// The fake clientset ignores finalizers, so the deletion is swallowed to keep the object around.
+			reactors = append(reactors, clients.TestReactor{
+				Verb:     "delete",
+				Resource: "imagebasedgroupupgrades",
+				Reaction: func(action k8sTesting.Action) (bool, runtime.Object, error) {
+					return true, nil, nil
+				},
+			})
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{ibgu, buildDummyChildCGU("child-cgu", defaultIbguUID)},
+			GVK:            []schema.GroupVersionKind{ibguGVK},
+			Reactors:       reactors,
+		})
+
+		err := buildValidIbguBuilder(testSettings).WithCascadeDelete(testCase.cascade).DeleteAndWait(time.Second)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, !testCase.cascade, cguExists(testSettings, "child-cgu"))
+		}
+	}
+}
+
+func buildValidIbguBuilder(apiClient *clients.Settings) *IbguBuilder {
+	return NewIbguBuilder(apiClient, defaultIbguName, defaultIbguNamespace).
+		WithClusterLabelSelectors(map[string]string{"common": "true"}).
+		WithSeedImageRef("quay.io/test/seed:4.16", "4.16.0").
+		WithPlan([]ibgutypes.Action{ibgutypes.Prep}, 1, 0)
+}
+
+func buildDummyIbgu() *ibgutypes.ImageBasedGroupUpgrade {
+	return &ibgutypes.ImageBasedGroupUpgrade{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultIbguName,
+			Namespace: defaultIbguNamespace,
+			UID:       defaultIbguUID,
+		},
+		Spec: ibgutypes.ImageBasedGroupUpgradeSpec{
+			ClusterLabelSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"common": "true"}}},
+			Plan: []ibgutypes.PlanItem{{
+				Actions:         []ibgutypes.Action{ibgutypes.Prep},
+				RolloutStrategy: ibgutypes.RolloutStrategy{MaxConcurrency: 1},
+			}},
+		},
+	}
+}
+
+func buildDummyChildCGU(name string, ownerUID types.UID) *v1alpha1.ClusterGroupUpgrade {
+	return &v1alpha1.ClusterGroupUpgrade{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultIbguNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: fmt.Sprintf("%s/%s", IbguAPIGroup, IbguAPIVersion),
+				Kind:       IbguKind,
+				Name:       defaultIbguName,
+				UID:        ownerUID,
+			}},
+		},
+	}
+}
+
+func buildIbguTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{ibguGVK},
+	})
+}
+
+func cguExists(apiClient *clients.Settings, name string) bool {
+	_, err := apiClient.ClientCgu.RanV1alpha1().ClusterGroupUpgrades(defaultIbguNamespace).Get(
+		context.TODO(), name, metav1.GetOptions{})
+
+	return !k8serrors.IsNotFound(err)
+}
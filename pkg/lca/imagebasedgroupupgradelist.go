@@ -0,0 +1,92 @@
+package lca
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ListIbgu returns ImageBasedGroupUpgrade inventory in the given namespace, e.g. the ones matching the label selector
+// of the options.
+func ListIbgu(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*IbguBuilder, error) {
+	if nsname == "" {
+		logging.V(100).Infof("ImageBasedGroupUpgrade 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list ImageBasedGroupUpgrades, 'nsname' parameter is empty")
+	}
+
+	return listIbgu(apiClient, nsname, options...)
+}
+
+// ListIbguInAllNamespaces returns a cluster-wide ImageBasedGroupUpgrade inventory, e.g. the ones matching the label
+// selector of the options.
+func ListIbguInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*IbguBuilder, error) {
+	return listIbgu(apiClient, metav1.NamespaceAll, options...)
+}
+
+// listIbgu returns the ImageBasedGroupUpgrades in the given namespace, or in all namespaces when it is empty.
+func listIbgu(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*IbguBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("ImageBasedGroupUpgrade 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list ImageBasedGroupUpgrades, 'apiClient' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := "Listing ImageBasedGroupUpgrades in all namespaces"
+
+	if nsname != metav1.NamespaceAll {
+		logMessage = fmt.Sprintf("Listing ImageBasedGroupUpgrades in the namespace %s", nsname)
+	}
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	var (
+		unsList *unstructured.UnstructuredList
+		err     error
+	)
+
+	if nsname == metav1.NamespaceAll {
+		unsList, err = apiClient.Resource(GetIbguGVR()).List(context.TODO(), passedOptions)
+	} else {
+		unsList, err = apiClient.Resource(GetIbguGVR()).Namespace(nsname).List(context.TODO(), passedOptions)
+	}
+
+	if err != nil {
+		logging.V(100).Infof("Failed to list ImageBasedGroupUpgrades due to %s", err.Error())
+
+		return nil, err
+	}
+
+	var ibguObjects []*IbguBuilder
+
+	for index := range unsList.Items {
+		ibgu, err := convertIbguToStructured(&unsList.Items[index])
+		if err != nil {
+			return nil, err
+		}
+
+		ibguObjects = append(ibguObjects, &IbguBuilder{
+			apiClient:  apiClient,
+			Object:     ibgu,
+			Definition: ibgu.DeepCopy(),
+		})
+	}
+
+	return ibguObjects, nil
+}
@@ -0,0 +1,139 @@
+package lca
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/lca/ibgutypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListIbgu(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		nsname        string
+		listOptions   []metav1.ListOptions
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			client:        true,
+			nsname:        defaultIbguNamespace,
+			expectedNames: []string{"ibgu-a", "ibgu-b"},
+			expectedError: "",
+		},
+		{
+			client:        true,
+			nsname:        defaultIbguNamespace,
+			listOptions:   []metav1.ListOptions{{LabelSelector: "run=a"}},
+			expectedNames: []string{"ibgu-a"},
+			expectedError: "",
+		},
+		{
+			client:        true,
+			nsname:        defaultIbguNamespace,
+			listOptions:   []metav1.ListOptions{{}, {}},
+			expectedError: "error: more than one ListOptions was passed",
+		},
+		{
+			client:        true,
+			nsname:        "",
+			expectedError: "failed to list ImageBasedGroupUpgrades, 'nsname' parameter is empty",
+		},
+		{
+			client:        false,
+			nsname:        defaultIbguNamespace,
+			expectedError: "failed to list ImageBasedGroupUpgrades, 'apiClient' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildIbguTestClientWithDummyObject(buildDummyIbguList())
+		}
+
+		ibguBuilders, err := ListIbgu(testSettings, testCase.nsname, testCase.listOptions...)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedNames, getIbguBuilderNames(ibguBuilders))
+		}
+	}
+}
+
+func TestListIbguInAllNamespaces(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		listOptions   []metav1.ListOptions
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			client:        true,
+			expectedNames: []string{"ibgu-a", "ibgu-b", "ibgu-c"},
+			expectedError: "",
+		},
+		{
+			client:        true,
+			listOptions:   []metav1.ListOptions{{LabelSelector: "run=a"}},
+			expectedNames: []string{"ibgu-a", "ibgu-c"},
+			expectedError: "",
+		},
+		{
+			client:        true,
+			listOptions:   []metav1.ListOptions{{}, {}},
+			expectedError: "error: more than one ListOptions was passed",
+		},
+		{
+			client:        false,
+			expectedError: "failed to list ImageBasedGroupUpgrades, 'apiClient' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildIbguTestClientWithDummyObject(buildDummyIbguList())
+		}
+
+		ibguBuilders, err := ListIbguInAllNamespaces(testSettings, testCase.listOptions...)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.ElementsMatch(t, testCase.expectedNames, getIbguBuilderNames(ibguBuilders))
+
+			for _, ibguBuilder := range ibguBuilders {
+				assert.NotSame(t, ibguBuilder.Object, ibguBuilder.Definition)
+			}
+		}
+	}
+}
+
+func buildDummyIbguList() []runtime.Object {
+	return []runtime.Object{
+		buildDummyIbguWithLabels("ibgu-a", defaultIbguNamespace, map[string]string{"run": "a"}),
+		buildDummyIbguWithLabels("ibgu-b", defaultIbguNamespace, map[string]string{"run": "b"}),
+		buildDummyIbguWithLabels("ibgu-c", "other-ns", map[string]string{"run": "a"}),
+	}
+}
+
+func buildDummyIbguWithLabels(name, nsname string, labels map[string]string) *ibgutypes.ImageBasedGroupUpgrade {
+	ibgu := buildDummyIbgu()
+	ibgu.Name = name
+	ibgu.Namespace = nsname
+	ibgu.Labels = labels
+
+	return ibgu
+}
+
+func getIbguBuilderNames(ibguBuilders []*IbguBuilder) []string {
+	var names []string
+
+	for _, ibguBuilder := range ibguBuilders {
+		names = append(names, ibguBuilder.Definition.Name)
+	}
+
+	return names
+}
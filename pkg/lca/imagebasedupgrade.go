@@ -2,19 +2,23 @@ package lca
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"time"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 
 	"fmt"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	lcav1alpha1 "github.com/openshift-kni/lifecycle-agent/api/v1alpha1"
+	"golang.org/x/exp/slices"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -23,6 +27,7 @@ const (
 	isTrue     = "True"
 	isFalse    = "False"
 	isComplete = "Completed"
+	isFailed   = "Failed"
 	ibuName    = "upgrade"
 )
 
@@ -36,7 +41,7 @@ type ImageBasedUpgradeBuilder struct {
 	Object *lcav1alpha1.ImageBasedUpgrade
 	// Used in functions that define or mutate the imagebasedupgrade definition.
 	// errorMsg is processed before the imagebasedupgrade object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient goclient.Client
 }
 
@@ -49,16 +54,16 @@ func (builder *ImageBasedUpgradeBuilder) WithOptions(options ...AdditionalOption
 		return builder
 	}
 
-	glog.V(100).Infof("Setting imagebasedupgrade additional options")
+	logging.V(100).Infof("Setting imagebasedupgrade additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -70,10 +75,10 @@ func (builder *ImageBasedUpgradeBuilder) WithOptions(options ...AdditionalOption
 
 // PullImageBasedUpgrade pulls existing imagebasedupgrade from cluster.
 func PullImageBasedUpgrade(apiClient *clients.Settings) (*ImageBasedUpgradeBuilder, error) {
-	glog.V(100).Infof("Pulling existing imagebasedupgrade name %s from cluster", ibuName)
+	logging.V(100).Infof("Pulling existing imagebasedupgrade name %s from cluster", ibuName)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient cannot be nil")
+		logging.V(100).Infof("The apiClient cannot be nil")
 
 		return nil, fmt.Errorf("the apiClient is nil")
 	}
@@ -103,18 +108,18 @@ func (builder *ImageBasedUpgradeBuilder) Update() (*ImageBasedUpgradeBuilder, er
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating imagebasedupgrade %s",
+	logging.V(100).Infof("Updating imagebasedupgrade %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("imagebasedupgrade %s does not exist",
+		logging.V(100).Infof("imagebasedupgrade %s does not exist",
 			builder.Definition.Name)
 
-		builder.errorMsg = "Unable to update non-existing imagebasedupgrade"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Unable to update non-existing imagebasedupgrade"))
 	}
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
@@ -122,7 +127,7 @@ func (builder *ImageBasedUpgradeBuilder) Update() (*ImageBasedUpgradeBuilder, er
 		// Wait for the IBU to reconcile after it is updated.
 		err = wait.PollUntilContextTimeout(
 			context.TODO(), time.Second*2, time.Second*10, true, func(ctx context.Context) (bool, error) {
-				glog.V(100).Infof("Waiting for imagebasedupgrade %s to finish reconciling",
+				logging.V(100).Infof("Waiting for imagebasedupgrade %s to finish reconciling",
 					builder.Definition.Name)
 
 				ibu, err := builder.Get()
@@ -155,7 +160,7 @@ func (builder *ImageBasedUpgradeBuilder) Delete() (*ImageBasedUpgradeBuilder, er
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the imagebasedupgrade %s",
+	logging.V(100).Infof("Deleting the imagebasedupgrade %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -179,7 +184,7 @@ func (builder *ImageBasedUpgradeBuilder) Get() (*lcav1alpha1.ImageBasedUpgrade,
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting imagebasedupgrade %s",
+	logging.V(100).Infof("Getting imagebasedupgrade %s",
 		builder.Definition.Name)
 
 	imagebasedupgrade := &lcav1alpha1.ImageBasedUpgrade{}
@@ -200,7 +205,7 @@ func (builder *ImageBasedUpgradeBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if imagebasedupgrade %s",
+	logging.V(100).Infof("Checking if imagebasedupgrade %s",
 		builder.Definition.Name)
 
 	var err error
@@ -216,7 +221,7 @@ func (builder *ImageBasedUpgradeBuilder) WithSeedImage(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting image %s in imagebasedupgrade", seedImage)
+	logging.V(100).Infof("Setting image %s in imagebasedupgrade", seedImage)
 
 	builder.Definition.Spec.SeedImageRef.Image = seedImage
 
@@ -230,7 +235,7 @@ func (builder *ImageBasedUpgradeBuilder) WithAdditionalImages(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting additionalImages configmap name %s in namespace %s in the imagebasedupgrade",
+	logging.V(100).Infof("Setting additionalImages configmap name %s in namespace %s in the imagebasedupgrade",
 		additionalImagesConfigMapName, additionalImagesConfigMapNamespace)
 
 	builder.Definition.Spec.AdditionalImages =
@@ -247,7 +252,7 @@ func (builder *ImageBasedUpgradeBuilder) WithExtraManifests(
 		return builder
 	}
 
-	glog.V(100).Infof("Appending extraManifests's configmap name %s in namespace %s to the imagebasedupgrade",
+	logging.V(100).Infof("Appending extraManifests's configmap name %s in namespace %s to the imagebasedupgrade",
 		extraManifestsConfigMapName, extraManifestsConfigMapNamespace)
 
 	builder.Definition.Spec.ExtraManifests = append(builder.Definition.Spec.ExtraManifests,
@@ -264,7 +269,7 @@ func (builder *ImageBasedUpgradeBuilder) WithOadpContent(
 		return builder
 	}
 
-	glog.V(100).Infof("Appending oadpContent's configmap name %s in namespace %s to the imagebasedupgrade",
+	logging.V(100).Infof("Appending oadpContent's configmap name %s in namespace %s to the imagebasedupgrade",
 		oadpContentConfigMapName, oadpContentConfigMapNamespace)
 
 	builder.Definition.Spec.OADPContent = append(builder.Definition.Spec.OADPContent,
@@ -282,7 +287,7 @@ func (builder *ImageBasedUpgradeBuilder) AutoRollbackOnFailureInitMonitorTimeout
 		return builder
 	}
 
-	glog.V(100).Infof("Setting timeout for InitMonitor to %d seconds in imagebasedupgrade", seconds)
+	logging.V(100).Infof("Setting timeout for InitMonitor to %d seconds in imagebasedupgrade", seconds)
 
 	builder.Definition.Spec.AutoRollbackOnFailure.InitMonitorTimeoutSeconds = int(seconds)
 
@@ -298,7 +303,7 @@ func (builder *ImageBasedUpgradeBuilder) AutoRollbackOnFailureDisabledInitMonito
 		return builder
 	}
 
-	glog.V(100).Infof("Setting the Init Monitor for Auto Rollback on failure to %b in imagebasedupgrade", !flag)
+	logging.V(100).Infof("Setting the Init Monitor for Auto Rollback on failure to %t in imagebasedupgrade", !flag)
 
 	builder.Definition.Spec.AutoRollbackOnFailure.DisabledInitMonitor = flag
 
@@ -313,7 +318,7 @@ func (builder *ImageBasedUpgradeBuilder) AutoRollbackOnFailureDisableForPostRebo
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Auto Rollback on failure for post reboot to %b in imagebasedupgrade", !flag)
+	logging.V(100).Infof("Setting Auto Rollback on failure for post reboot to %t in imagebasedupgrade", !flag)
 
 	builder.Definition.Spec.AutoRollbackOnFailure.DisabledForPostRebootConfig = flag
 
@@ -328,7 +333,7 @@ func (builder *ImageBasedUpgradeBuilder) AutoRollbackOnFailureDisableForUpgradeC
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Auto Rollback on failure for upgrade completion to %b in imagebasedupgrade", !flag)
+	logging.V(100).Infof("Setting Auto Rollback on failure for upgrade completion to %t in imagebasedupgrade", !flag)
 
 	builder.Definition.Spec.AutoRollbackOnFailure.DisabledForUpgradeCompletion = flag
 
@@ -342,7 +347,7 @@ func (builder *ImageBasedUpgradeBuilder) WithSeedImageVersion(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting seed image version %s in imagebasedupgrade", seedImageVersion)
+	logging.V(100).Infof("Setting seed image version %s in imagebasedupgrade", seedImageVersion)
 
 	builder.Definition.Spec.SeedImageRef.Version = seedImageVersion
 
@@ -357,7 +362,7 @@ func (builder *ImageBasedUpgradeBuilder) WithSeedImagePullSecretRef(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting pull-secret %s in imagebasedupgrade for pulling the seed image", pullSecretName)
+	logging.V(100).Infof("Setting pull-secret %s in imagebasedupgrade for pulling the seed image", pullSecretName)
 
 	builder.Definition.Spec.SeedImageRef.PullSecretRef = &lcav1alpha1.PullSecretRef{Name: pullSecretName}
 
@@ -367,24 +372,35 @@ func (builder *ImageBasedUpgradeBuilder) WithSeedImagePullSecretRef(
 // WaitUntilStageComplete waits the specified timeout for the imagebasedupgrade to complete
 // actions for the provided stage .
 func (builder *ImageBasedUpgradeBuilder) WaitUntilStageComplete(stage string) (*ImageBasedUpgradeBuilder, error) {
+	return builder.WaitForStageCompleted(lcav1alpha1.ImageBasedUpgradeStage(stage), time.Minute*30)
+}
+
+// WaitForStageCompleted waits the specified timeout for the imagebasedupgrade to complete actions for the provided
+// stage. It returns early with the condition message when the stage fails.
+func (builder *ImageBasedUpgradeBuilder) WaitForStageCompleted(
+	stage lcav1alpha1.ImageBasedUpgradeStage, timeout time.Duration) (*ImageBasedUpgradeBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for imagebasedupgrade %s to set stage %s",
+	logging.V(100).Infof("Waiting for imagebasedupgrade %s to set stage %s",
 		builder.Definition.Name,
 		stage)
 
+	if !isValidStage(stage) {
+		return builder, fmt.Errorf("wrong stage %s selected for imagebasedupgrade", stage)
+	}
+
 	if !builder.Exists() {
-		glog.V(100).Infof("The imagebasedupgrade does not exist on the cluster")
+		logging.V(100).Infof("The imagebasedupgrade does not exist on the cluster")
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	// Polls periodically to determine if imagebasedupgrade is in desired state.
 	var err error
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), time.Second*3, time.Minute*30, true, func(ctx context.Context) (bool, error) {
+		context.TODO(), time.Second*3, timeout, true, func(ctx context.Context) (bool, error) {
 			builder.Object, err = builder.Get()
 
 			if err != nil {
@@ -394,31 +410,23 @@ func (builder *ImageBasedUpgradeBuilder) WaitUntilStageComplete(stage string) (*
 			builder.Definition = builder.Object
 
 			for _, condition := range builder.Object.Status.Conditions {
-				switch stage {
-				case "Idle":
-					if condition.Status == isTrue && condition.Type == "Idle" {
+				if stage == lcav1alpha1.Stages.Idle {
+					if condition.Status == isTrue && condition.Type == string(lcav1alpha1.Stages.Idle) {
 						return true, nil
 					}
 
-				case "Prep":
-					if condition.Status == isFalse && condition.Type == "PrepInProgress" &&
-						condition.Message == "Prep completed" && condition.Reason == isComplete {
-						return true, nil
-					}
-				case "Upgrade":
-					if condition.Status == isFalse && condition.Type == "UpgradeInProgress" &&
-						condition.Message == "Upgrade completed" && condition.Reason == isComplete {
-						return true, nil
-					}
+					continue
+				}
 
-				case "Rollback":
-					if condition.Status == isFalse && condition.Type == "RollbackInProgress" &&
-						condition.Message == "Rollback completed" && condition.Reason == isComplete {
-						return true, nil
-					}
+				if condition.Type == fmt.Sprintf("%sCompleted", stage) && condition.Status == isFalse &&
+					condition.Reason == isFailed {
+					return false, fmt.Errorf("imagebasedupgrade %s failed stage %s: %s",
+						builder.Definition.Name, stage, condition.Message)
+				}
 
-				default:
-					return false, fmt.Errorf("wrong stage selected for imagebasedupgrade")
+				if condition.Status == isFalse && condition.Type == fmt.Sprintf("%sInProgress", stage) &&
+					condition.Message == fmt.Sprintf("%s completed", stage) && condition.Reason == isComplete {
+					return true, nil
 				}
 			}
 
@@ -432,6 +440,88 @@ func (builder *ImageBasedUpgradeBuilder) WaitUntilStageComplete(stage string) (*
 	return nil, err
 }
 
+// MoveToIdle sets the imagebasedupgrade stage to Idle, finalizing or aborting the current upgrade, and updates it
+// on the cluster.
+func (builder *ImageBasedUpgradeBuilder) MoveToIdle() (*ImageBasedUpgradeBuilder, error) {
+	return builder.moveToStage(lcav1alpha1.Stages.Idle)
+}
+
+// MoveToPrep sets the imagebasedupgrade stage to Prep, which pulls the seed image and prepares the new stateroot,
+// and updates it on the cluster.
+func (builder *ImageBasedUpgradeBuilder) MoveToPrep() (*ImageBasedUpgradeBuilder, error) {
+	return builder.moveToStage(lcav1alpha1.Stages.Prep)
+}
+
+// MoveToUpgrade sets the imagebasedupgrade stage to Upgrade, which reboots the node into the new stateroot, and
+// updates it on the cluster.
+func (builder *ImageBasedUpgradeBuilder) MoveToUpgrade() (*ImageBasedUpgradeBuilder, error) {
+	return builder.moveToStage(lcav1alpha1.Stages.Upgrade)
+}
+
+// MoveToRollback sets the imagebasedupgrade stage to Rollback, which reboots the node back into the original
+// stateroot, and updates it on the cluster.
+func (builder *ImageBasedUpgradeBuilder) MoveToRollback() (*ImageBasedUpgradeBuilder, error) {
+	return builder.moveToStage(lcav1alpha1.Stages.Rollback)
+}
+
+// GetValidNextStages returns the stages the imagebasedupgrade can currently move to.
+func (builder *ImageBasedUpgradeBuilder) GetValidNextStages() ([]lcav1alpha1.ImageBasedUpgradeStage, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting valid next stages of imagebasedupgrade %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("imagebasedupgrade object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.ValidNextStages, nil
+}
+
+// GetConditions returns the conditions of the imagebasedupgrade ordered by their last transition time, oldest
+// first, so that they can be read as the history of the upgrade.
+func (builder *ImageBasedUpgradeBuilder) GetConditions() ([]metav1.Condition, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting conditions of imagebasedupgrade %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("imagebasedupgrade object %s doesn't exist", builder.Definition.Name)
+	}
+
+	conditions := make([]metav1.Condition, len(builder.Object.Status.Conditions))
+	copy(conditions, builder.Object.Status.Conditions)
+
+	sort.SliceStable(conditions, func(i, j int) bool {
+		return conditions[i].LastTransitionTime.Before(&conditions[j].LastTransitionTime)
+	})
+
+	return conditions, nil
+}
+
+// GetCondition returns the imagebasedupgrade condition of the provided type.
+func (builder *ImageBasedUpgradeBuilder) GetCondition(conditionType string) (*metav1.Condition, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting condition %s of imagebasedupgrade %s", conditionType, builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("imagebasedupgrade object %s doesn't exist", builder.Definition.Name)
+	}
+
+	condition := meta.FindStatusCondition(builder.Object.Status.Conditions, conditionType)
+	if condition == nil {
+		return nil, fmt.Errorf("imagebasedupgrade %s has no condition %s", builder.Definition.Name, conditionType)
+	}
+
+	return condition, nil
+}
+
 // WithStage sets the stage used by the imagebasedupgrade.
 func (builder *ImageBasedUpgradeBuilder) WithStage(
 	stage string) *ImageBasedUpgradeBuilder {
@@ -439,7 +529,7 @@ func (builder *ImageBasedUpgradeBuilder) WithStage(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting stage %s in imagebasedupgrade", stage)
+	logging.V(100).Infof("Setting stage %s in imagebasedupgrade", stage)
 	builder.Definition.Spec.Stage = lcav1alpha1.ImageBasedUpgradeStage(stage)
 
 	return builder
@@ -451,28 +541,66 @@ func (builder *ImageBasedUpgradeBuilder) validate() (bool, error) {
 	resourceCRD := "ImageBasedUpgrade"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
 }
+
+// moveToStage sets the imagebasedupgrade stage after checking that the transition is allowed by the current
+// status, then updates the imagebasedupgrade on the cluster.
+func (builder *ImageBasedUpgradeBuilder) moveToStage(
+	stage lcav1alpha1.ImageBasedUpgradeStage) (*ImageBasedUpgradeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Moving imagebasedupgrade %s to stage %s", builder.Definition.Name, stage)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("imagebasedupgrade object %s doesn't exist", builder.Definition.Name)
+	}
+
+	validNextStages := builder.Object.Status.ValidNextStages
+	if len(validNextStages) > 0 && !slices.Contains(validNextStages, stage) {
+		return builder, fmt.Errorf("imagebasedupgrade %s cannot move from stage %s to %s, valid next stages are %v",
+			builder.Definition.Name, builder.Object.Spec.Stage, stage, validNextStages)
+	}
+
+	builder.Definition = builder.Object
+	builder.Definition.Spec.Stage = stage
+
+	return builder.Update()
+}
+
+// isValidStage returns true when the stage is one of the stages of an imagebasedupgrade.
+func isValidStage(stage lcav1alpha1.ImageBasedUpgradeStage) bool {
+	switch stage {
+	case lcav1alpha1.Stages.Idle, lcav1alpha1.Stages.Prep, lcav1alpha1.Stages.Upgrade, lcav1alpha1.Stages.Rollback:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,150 @@
+package lca
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	lcav1alpha1 "github.com/openshift-kni/lifecycle-agent/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestImageBasedUpgradeMoveToStage(t *testing.T) {
+	testCases := []struct {
+		validNextStages []lcav1alpha1.ImageBasedUpgradeStage
+		move            func(*ImageBasedUpgradeBuilder) (*ImageBasedUpgradeBuilder, error)
+		expectedStage   lcav1alpha1.ImageBasedUpgradeStage
+		expectedError   error
+	}{
+		{
+			validNextStages: []lcav1alpha1.ImageBasedUpgradeStage{lcav1alpha1.Stages.Prep},
+			move:            (*ImageBasedUpgradeBuilder).MoveToPrep,
+			expectedStage:   lcav1alpha1.Stages.Prep,
+			expectedError:   nil,
+		},
+		{
+			validNextStages: []lcav1alpha1.ImageBasedUpgradeStage{lcav1alpha1.Stages.Idle, lcav1alpha1.Stages.Upgrade},
+			move:            (*ImageBasedUpgradeBuilder).MoveToUpgrade,
+			expectedStage:   lcav1alpha1.Stages.Upgrade,
+			expectedError:   nil,
+		},
+		{
+			validNextStages: nil,
+			move:            (*ImageBasedUpgradeBuilder).MoveToRollback,
+			expectedStage:   lcav1alpha1.Stages.Rollback,
+			expectedError:   nil,
+		},
+		{
+			validNextStages: []lcav1alpha1.ImageBasedUpgradeStage{lcav1alpha1.Stages.Prep},
+			move:            (*ImageBasedUpgradeBuilder).MoveToIdle,
+			expectedStage:   lcav1alpha1.Stages.Idle,
+			expectedError: fmt.Errorf(
+				"imagebasedupgrade %s cannot move from stage %s to %s, valid next stages are %v",
+				ibuName, lcav1alpha1.Stages.Idle, lcav1alpha1.Stages.Idle, []lcav1alpha1.ImageBasedUpgradeStage{"Prep"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		ibu := buildDummyImageBasedUpgrade()
+		ibu.Status.ValidNextStages = testCase.validNextStages
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{ibu}})
+
+		ibuBuilder, err := PullImageBasedUpgrade(testSettings)
+		assert.Nil(t, err)
+
+		ibuBuilder, err = testCase.move(ibuBuilder)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedStage, ibuBuilder.Object.Spec.Stage)
+		}
+	}
+}
+
+func TestImageBasedUpgradeWaitForStageCompleted(t *testing.T) {
+	testCases := []struct {
+		stage         lcav1alpha1.ImageBasedUpgradeStage
+		conditions    []metav1.Condition
+		expectedError error
+	}{
+		{
+			stage: lcav1alpha1.Stages.Prep,
+			conditions: []metav1.Condition{{
+				Type: "PrepInProgress", Status: isFalse, Reason: isComplete, Message: "Prep completed",
+			}},
+			expectedError: nil,
+		},
+		{
+			stage:         lcav1alpha1.Stages.Idle,
+			conditions:    []metav1.Condition{{Type: "Idle", Status: isTrue, Reason: "Idle"}},
+			expectedError: nil,
+		},
+		{
+			stage: lcav1alpha1.Stages.Upgrade,
+			conditions: []metav1.Condition{{
+				Type: "UpgradeCompleted", Status: isFalse, Reason: isFailed, Message: "failed to reboot",
+			}},
+			expectedError: fmt.Errorf("imagebasedupgrade %s failed stage Upgrade: failed to reboot", ibuName),
+		},
+		{
+			stage:         "Unknown",
+			conditions:    nil,
+			expectedError: fmt.Errorf("wrong stage Unknown selected for imagebasedupgrade"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		ibu := buildDummyImageBasedUpgrade()
+		ibu.Status.Conditions = testCase.conditions
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{ibu}})
+
+		ibuBuilder, err := PullImageBasedUpgrade(testSettings)
+		assert.Nil(t, err)
+
+		_, err = ibuBuilder.WaitForStageCompleted(testCase.stage, time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestImageBasedUpgradeGetConditions(t *testing.T) {
+	now := time.Now()
+	ibu := buildDummyImageBasedUpgrade()
+	ibu.Status.Conditions = []metav1.Condition{
+		{Type: "PrepCompleted", Status: isTrue, LastTransitionTime: metav1.NewTime(now.Add(time.Minute))},
+		{Type: "Idle", Status: isFalse, LastTransitionTime: metav1.NewTime(now)},
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{ibu}})
+
+	ibuBuilder, err := PullImageBasedUpgrade(testSettings)
+	assert.Nil(t, err)
+
+	conditions, err := ibuBuilder.GetConditions()
+	assert.Nil(t, err)
+	assert.Len(t, conditions, 2)
+	assert.Equal(t, "Idle", conditions[0].Type)
+	assert.Equal(t, "PrepCompleted", conditions[1].Type)
+
+	condition, err := ibuBuilder.GetCondition("PrepCompleted")
+	assert.Nil(t, err)
+	assert.Equal(t, metav1.ConditionStatus(isTrue), condition.Status)
+
+	_, err = ibuBuilder.GetCondition("UpgradeCompleted")
+	assert.Equal(t, fmt.Errorf("imagebasedupgrade %s has no condition UpgradeCompleted", ibuName), err)
+}
+
+func buildDummyImageBasedUpgrade() *lcav1alpha1.ImageBasedUpgrade {
+	return &lcav1alpha1.ImageBasedUpgrade{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ibuName,
+		},
+		Spec: lcav1alpha1.ImageBasedUpgradeSpec{
+			Stage: lcav1alpha1.Stages.Idle,
+		},
+	}
+}
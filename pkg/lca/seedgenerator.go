@@ -2,14 +2,17 @@ package lca
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	lcasgv1alpha1 "github.com/openshift-kni/lifecycle-agent/api/seedgenerator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,10 +27,23 @@ type SeedGeneratorBuilder struct {
 	Object *lcasgv1alpha1.SeedGenerator
 	// Used in functions that define or mutate the seedgenerator definition.
 	// errorMsg is processed before the seedgenerator object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient goclient.Client
+	// authSecret is the seedgen secret the lifecycle agent reads the seed registry credentials from.
+	authSecret *corev1.Secret
 }
 
+const (
+	// SeedGenAuthSecretName is the name of the secret holding the seed registry credentials.
+	SeedGenAuthSecretName = "seedgen"
+	// SeedGenAuthSecretNamespace is the namespace of the secret holding the seed registry credentials.
+	SeedGenAuthSecretNamespace = "openshift-lifecycle-agent"
+	// SeedGenAuthSecretKey is the key of the seed registry credentials in the seedgen secret.
+	SeedGenAuthSecretKey = "seedAuth"
+
+	seedGenCompleted = "SeedGenCompleted"
+)
+
 // SeedGeneratorAdditionalOptions additional options for imagebasedupgrade object.
 type SeedGeneratorAdditionalOptions func(builder *SeedGeneratorBuilder) (*SeedGeneratorBuilder, error)
 
@@ -37,7 +53,7 @@ func NewSeedGeneratorBuilder(
 	name string,
 ) *SeedGeneratorBuilder {
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient cannot be nil")
+		logging.V(100).Infof("The apiClient cannot be nil")
 
 		return nil
 	}
@@ -52,9 +68,9 @@ func NewSeedGeneratorBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the seedgenerator is empty")
+		logging.V(100).Infof("The name of the seedgenerator is empty")
 
-		builder.errorMsg = "SeedGenerator name cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SeedGenerator name cannot be empty"))
 	}
 
 	return &builder
@@ -66,16 +82,16 @@ func (builder *SeedGeneratorBuilder) WithOptions(options ...SeedGeneratorAdditio
 		return builder
 	}
 
-	glog.V(100).Infof("Setting seedgenerator additional options")
+	logging.V(100).Infof("Setting seedgenerator additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -91,11 +107,18 @@ func (builder *SeedGeneratorBuilder) Create() (*SeedGeneratorBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the seedgenerator %s",
+	logging.V(100).Infof("Creating the seedgenerator %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
+		if builder.authSecret != nil {
+			err = builder.applyAuthSecret()
+			if err != nil {
+				return builder, err
+			}
+		}
+
 		err = builder.apiClient.Create(context.TODO(), builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
@@ -107,10 +130,10 @@ func (builder *SeedGeneratorBuilder) Create() (*SeedGeneratorBuilder, error) {
 
 // PullSeedGenerator pulls existing seedgenerator from cluster.
 func PullSeedGenerator(apiClient *clients.Settings, name string) (*SeedGeneratorBuilder, error) {
-	glog.V(100).Infof("Pulling existing seedgenerator name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing seedgenerator name %s from cluster", name)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient cannot be nil")
+		logging.V(100).Infof("The apiClient cannot be nil")
 
 		return nil, fmt.Errorf("the apiClient is nil")
 	}
@@ -125,9 +148,9 @@ func PullSeedGenerator(apiClient *clients.Settings, name string) (*SeedGenerator
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the seedgenerator is empty")
+		logging.V(100).Infof("The name of the seedgenerator is empty")
 
-		builder.errorMsg = "seedgenerator 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("seedgenerator 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -145,7 +168,7 @@ func (builder *SeedGeneratorBuilder) Delete() (*SeedGeneratorBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the seedgenerator %s",
+	logging.V(100).Infof("Deleting the seedgenerator %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -169,7 +192,7 @@ func (builder *SeedGeneratorBuilder) Get() (*lcasgv1alpha1.SeedGenerator, error)
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting seedgenerator %s",
+	logging.V(100).Infof("Getting seedgenerator %s",
 		builder.Definition.Name)
 
 	seedgenerator := &lcasgv1alpha1.SeedGenerator{}
@@ -190,7 +213,7 @@ func (builder *SeedGeneratorBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if seedgenerator %s exists",
+	logging.V(100).Infof("Checking if seedgenerator %s exists",
 		builder.Definition.Name)
 
 	var err error
@@ -206,7 +229,7 @@ func (builder *SeedGeneratorBuilder) WithSeedImage(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting seed image %s in seedgenerator", seedImage)
+	logging.V(100).Infof("Setting seed image %s in seedgenerator", seedImage)
 
 	builder.Definition.Spec.SeedImage = seedImage
 
@@ -220,13 +243,42 @@ func (builder *SeedGeneratorBuilder) WithRecertImage(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting recert image %s in seedgenerator", recertImage)
+	logging.V(100).Infof("Setting recert image %s in seedgenerator", recertImage)
 
 	builder.Definition.Spec.RecertImage = recertImage
 
 	return builder
 }
 
+// WithSeedAuth sets the credentials used to push the seed image to its registry. They are stored in the seedgen
+// secret, which is created or updated alongside the seedgenerator.
+func (builder *SeedGeneratorBuilder) WithSeedAuth(seedAuth string) *SeedGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting seed registry credentials of seedgenerator %s", builder.Definition.Name)
+
+	if seedAuth == "" {
+		logging.V(100).Infof("The seed registry credentials of the seedgenerator are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("seedgenerator seed auth cannot be empty"))
+
+		return builder
+	}
+
+	builder.authSecret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SeedGenAuthSecretName,
+			Namespace: SeedGenAuthSecretNamespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{SeedGenAuthSecretKey: []byte(seedAuth)},
+	}
+
+	return builder
+}
+
 // WaitUntilComplete waits the specified timeout for the seedgenerator to complete
 // actions.
 func (builder *SeedGeneratorBuilder) WaitUntilComplete(timeout time.Duration) (*SeedGeneratorBuilder, error) {
@@ -234,13 +286,13 @@ func (builder *SeedGeneratorBuilder) WaitUntilComplete(timeout time.Duration) (*
 		return builder, err
 	}
 
-	glog.V(100).Infof("Waiting for seedgenerator %s to complete actions",
+	logging.V(100).Infof("Waiting for seedgenerator %s to complete actions",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("The seedgenerator does not exist on the cluster")
+		logging.V(100).Infof("The seedgenerator does not exist on the cluster")
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	// Polls periodically to determine if seedgenerator is in desired state.
@@ -276,28 +328,105 @@ func (builder *SeedGeneratorBuilder) validate() (bool, error) {
 	resourceCRD := "SeedGenerator"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
 }
+
+// WaitUntilSeedCreated waits the specified timeout for the seedgenerator to create and push the seed image. It
+// returns early with the failure reason when the seed generation fails.
+func (builder *SeedGeneratorBuilder) WaitUntilSeedCreated(timeout time.Duration) (*SeedGeneratorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Waiting for seedgenerator %s to create the seed image", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("seedgenerator object %s doesn't exist", builder.Definition.Name)
+	}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second*3, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			condition := meta.FindStatusCondition(builder.Object.Status.Conditions, seedGenCompleted)
+			if condition == nil {
+				return false, nil
+			}
+
+			if condition.Reason == isFailed {
+				return false, fmt.Errorf("seedgenerator %s failed to create the seed image: %s",
+					builder.Definition.Name, condition.Message)
+			}
+
+			return condition.Status == isTrue && condition.Reason == isComplete, nil
+		})
+
+	return builder, err
+}
+
+// GetFailureReason returns the message of the seedgenerator conditions reporting a failure. An empty string is
+// returned when the seed generation did not fail.
+func (builder *SeedGeneratorBuilder) GetFailureReason() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting failure reason of seedgenerator %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("seedgenerator object %s doesn't exist", builder.Definition.Name)
+	}
+
+	for _, condition := range builder.Object.Status.Conditions {
+		if condition.Reason == isFailed {
+			return condition.Message, nil
+		}
+	}
+
+	return "", nil
+}
+
+// applyAuthSecret creates the seedgen secret, or updates it when it already exists.
+func (builder *SeedGeneratorBuilder) applyAuthSecret() error {
+	logging.V(100).Infof("Applying secret %s in namespace %s for seedgenerator %s",
+		builder.authSecret.Name, builder.authSecret.Namespace, builder.Definition.Name)
+
+	err := builder.apiClient.Create(context.TODO(), builder.authSecret)
+	if k8serrors.IsAlreadyExists(err) {
+		err = builder.apiClient.Update(context.TODO(), builder.authSecret)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to apply secret %s in namespace %s: %w",
+			builder.authSecret.Name, builder.authSecret.Namespace, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package lca
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	lcasgv1alpha1 "github.com/openshift-kni/lifecycle-agent/api/seedgenerator/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultSeedGeneratorName = "seedimage"
+
+func TestSeedGeneratorWithSeedAuth(t *testing.T) {
+	testCases := []struct {
+		seedAuth          string
+		expectedErrorText string
+	}{
+		{
+			seedAuth:          "eyJhdXRocyI6e319",
+			expectedErrorText: "",
+		},
+		{
+			seedAuth:          "",
+			expectedErrorText: "seedgenerator seed auth cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		seedGeneratorBuilder := NewSeedGeneratorBuilder(testSettings, defaultSeedGeneratorName).
+			WithSeedAuth(testCase.seedAuth)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, seedGeneratorBuilder.errorMsg) {
+			assert.Equal(t, []byte(testCase.seedAuth), seedGeneratorBuilder.authSecret.Data[SeedGenAuthSecretKey])
+		}
+	}
+}
+
+func TestSeedGeneratorCreateWithSeedAuth(t *testing.T) {
+	testCases := []struct {
+		secretExists bool
+	}{
+		{secretExists: false},
+		{secretExists: true},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+		if testCase.secretExists {
+			err := testSettings.Client.Create(context.TODO(), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: SeedGenAuthSecretName, Namespace: SeedGenAuthSecretNamespace},
+				Data:       map[string][]byte{SeedGenAuthSecretKey: []byte("old")},
+			})
+			assert.Nil(t, err)
+		}
+
+		seedGeneratorBuilder, err := NewSeedGeneratorBuilder(testSettings, defaultSeedGeneratorName).
+			WithSeedImage("quay.io/test/seed:latest").
+			WithSeedAuth("new").
+			Create()
+		assert.Nil(t, err)
+		assert.NotNil(t, seedGeneratorBuilder.Object)
+
+		secret := &corev1.Secret{}
+		err = testSettings.Client.Get(context.TODO(), goclient.ObjectKey{
+			Name: SeedGenAuthSecretName, Namespace: SeedGenAuthSecretNamespace}, secret)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("new"), secret.Data[SeedGenAuthSecretKey])
+	}
+}
+
+func TestSeedGeneratorWaitUntilSeedCreated(t *testing.T) {
+	testCases := []struct {
+		conditions    []metav1.Condition
+		expectedError error
+	}{
+		{
+			conditions: []metav1.Condition{{
+				Type: seedGenCompleted, Status: isTrue, Reason: isComplete, Message: "Seed Generation completed",
+			}},
+			expectedError: nil,
+		},
+		{
+			conditions: []metav1.Condition{{
+				Type: seedGenCompleted, Status: isFalse, Reason: isFailed, Message: "failed to push image",
+			}},
+			expectedError: fmt.Errorf(
+				"seedgenerator %s failed to create the seed image: failed to push image", defaultSeedGeneratorName),
+		},
+		{
+			conditions:    nil,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		seedGenerator := buildDummySeedGenerator()
+		seedGenerator.Status.Conditions = testCase.conditions
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{seedGenerator},
+		})
+
+		_, err := NewSeedGeneratorBuilder(testSettings, defaultSeedGeneratorName).WaitUntilSeedCreated(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestSeedGeneratorGetFailureReason(t *testing.T) {
+	testCases := []struct {
+		conditions     []metav1.Condition
+		expectedReason string
+	}{
+		{
+			conditions: []metav1.Condition{
+				{Type: "SeedGenInProgress", Status: isFalse, Reason: isFailed, Message: "recert failed"},
+				{Type: seedGenCompleted, Status: isFalse, Reason: isFailed, Message: "recert failed"},
+			},
+			expectedReason: "recert failed",
+		},
+		{
+			conditions: []metav1.Condition{
+				{Type: seedGenCompleted, Status: isTrue, Reason: isComplete, Message: "Seed Generation completed"},
+			},
+			expectedReason: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		seedGenerator := buildDummySeedGenerator()
+		seedGenerator.Status.Conditions = testCase.conditions
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{seedGenerator},
+		})
+
+		reason, err := NewSeedGeneratorBuilder(testSettings, defaultSeedGeneratorName).GetFailureReason()
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedReason, reason)
+	}
+}
+
+func buildDummySeedGenerator() *lcasgv1alpha1.SeedGenerator {
+	return &lcasgv1alpha1.SeedGenerator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultSeedGeneratorName,
+		},
+		Spec: lcasgv1alpha1.SeedGeneratorSpec{
+			SeedImage: "quay.io/test/seed:latest",
+		},
+	}
+}
@@ -0,0 +1,509 @@
+package limitrange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Builder provides struct for limitrange object containing connection to the cluster and the limitrange
+// definitions.
+type Builder struct {
+	// LimitRange definition. Used to create the limitrange object.
+	Definition *corev1.LimitRange
+	// Created limitrange object.
+	Object *corev1.LimitRange
+	// Used in functions that define or mutate limitrange definition. errorMsg is processed before the
+	// limitrange object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// AdditionalOptions additional options for limitrange object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	logging.V(100).Infof(
+		"Initializing new limitrange structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the limitrange is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("limitrange 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the limitrange is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("limitrange 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// Pull loads an existing limitrange into Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing limitrange name: %s under namespace: %s", name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("limitrange 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("limitrange 'namespace' cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("limitrange object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithMinMax sets the minimum and maximum of the resources consumed by a single object of the given limit type.
+// Either list may be nil, but a resource in both must have a minimum not greater than its maximum.
+func (builder *Builder) WithMinMax(limitType corev1.LimitType, minimum, maximum corev1.ResourceList) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting %s min %v and max %v in limitrange %s in namespace %s",
+		limitType, minimum, maximum, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(minimum) == 0 && len(maximum) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("limitrange 'min' and 'max' cannot be both empty"))
+
+		return builder
+	}
+
+	limit, err := builder.getOrAddLimit(limitType)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	limit.Min = mergeResources(limit.Min, minimum)
+	limit.Max = mergeResources(limit.Max, maximum)
+
+	builder.errorMsg = errors.Join(builder.errorMsg, validateLimit(*limit))
+
+	return builder
+}
+
+// WithDefaults sets the default limits and requests applied to containers which do not specify them. Defaults are
+// only supported for the Container limit type.
+func (builder *Builder) WithDefaults(defaultLimits, defaultRequests corev1.ResourceList) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting container default limits %v and requests %v in limitrange %s in namespace %s",
+		defaultLimits, defaultRequests, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(defaultLimits) == 0 && len(defaultRequests) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("limitrange 'default' and 'defaultRequest' cannot be both empty"))
+
+		return builder
+	}
+
+	limit, err := builder.getOrAddLimit(corev1.LimitTypeContainer)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	limit.Default = mergeResources(limit.Default, defaultLimits)
+	limit.DefaultRequest = mergeResources(limit.DefaultRequest, defaultRequests)
+
+	builder.errorMsg = errors.Join(builder.errorMsg, validateLimit(*limit))
+
+	return builder
+}
+
+// WithMaxLimitRequestRatio sets the maximum ratio between the limit and the request of the resources consumed by a
+// single object of the given limit type.
+func (builder *Builder) WithMaxLimitRequestRatio(limitType corev1.LimitType, ratio corev1.ResourceList) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting %s maxLimitRequestRatio %v in limitrange %s in namespace %s",
+		limitType, ratio, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(ratio) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("limitrange 'maxLimitRequestRatio' cannot be empty"))
+
+		return builder
+	}
+
+	limit, err := builder.getOrAddLimit(limitType)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	limit.MaxLimitRequestRatio = mergeResources(limit.MaxLimitRequestRatio, ratio)
+
+	builder.errorMsg = errors.Join(builder.errorMsg, validateLimit(*limit))
+
+	return builder
+}
+
+// WithOptions creates limitrange with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting limitrange additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// GetLimit returns the limits of the given type defined in the limitrange. It returns an error if the limitrange
+// does not constrain the type.
+func (builder *Builder) GetLimit(limitType corev1.LimitType) (*corev1.LimitRangeItem, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting %s limits of limitrange %s in namespace %s",
+		limitType, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("limitrange object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for index := range builder.Object.Spec.Limits {
+		if builder.Object.Spec.Limits[index].Type == limitType {
+			return &builder.Object.Spec.Limits[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("limitrange %s in namespace %s has no limits of type %s",
+		builder.Definition.Name, builder.Definition.Namespace, limitType)
+}
+
+// Create generates a limitrange in cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating limitrange %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(builder.Definition.Spec.Limits) == 0 {
+		logging.V(100).Infof("The limitrange has no limits set")
+
+		return builder, fmt.Errorf("limitrange must have at least one limit")
+	}
+
+	var err error
+	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
+		builder.Object, err = builder.apiClient.LimitRanges(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for limitrange %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the limitrange definition against the API server and its admission webhooks with a server-side
+// dry-run request. The limitrange is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the limitrange %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.LimitRanges(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the existing limitrange object with the limitrange definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating limitrange %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent limitrange %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.LimitRanges(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Exists checks whether the given limitrange exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if limitrange %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.LimitRanges(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the limitrange from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting limitrange %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.LimitRanges(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetGVR returns limitrange's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "", Version: "v1", Resource: "limitranges"}
+}
+
+// GetGVR returns the limitrange GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// getOrAddLimit returns the limit of the given type in the definition, appending an empty one if there is none.
+func (builder *Builder) getOrAddLimit(limitType corev1.LimitType) (*corev1.LimitRangeItem, error) {
+	switch limitType {
+	case corev1.LimitTypePod, corev1.LimitTypeContainer, corev1.LimitTypePersistentVolumeClaim:
+	default:
+		return nil, fmt.Errorf("limitrange limit type %s is not supported, must be %s, %s or %s", limitType,
+			corev1.LimitTypePod, corev1.LimitTypeContainer, corev1.LimitTypePersistentVolumeClaim)
+	}
+
+	for index := range builder.Definition.Spec.Limits {
+		if builder.Definition.Spec.Limits[index].Type == limitType {
+			return &builder.Definition.Spec.Limits[index], nil
+		}
+	}
+
+	builder.Definition.Spec.Limits = append(builder.Definition.Spec.Limits, corev1.LimitRangeItem{Type: limitType})
+
+	return &builder.Definition.Spec.Limits[len(builder.Definition.Spec.Limits)-1], nil
+}
+
+// mergeResources copies the quantities of update into current, overwriting the resources present in both.
+func mergeResources(current, update corev1.ResourceList) corev1.ResourceList {
+	if len(update) == 0 {
+		return current
+	}
+
+	if current == nil {
+		current = corev1.ResourceList{}
+	}
+
+	for resourceName, quantity := range update {
+		current[resourceName] = quantity.DeepCopy()
+	}
+
+	return current
+}
+
+// validateLimit checks the constraints the API server enforces between the values of a single limit.
+func validateLimit(limit corev1.LimitRangeItem) error {
+	for resourceName, minimum := range limit.Min {
+		if maximum, found := limit.Max[resourceName]; found && minimum.Cmp(maximum) > 0 {
+			return fmt.Errorf("limitrange %s min of %s %s is greater than max %s",
+				limit.Type, resourceName, minimum.String(), maximum.String())
+		}
+	}
+
+	if limit.Type != corev1.LimitTypeContainer && (len(limit.Default) != 0 || len(limit.DefaultRequest) != 0) {
+		return fmt.Errorf("limitrange defaults are only supported for the %s limit type", corev1.LimitTypeContainer)
+	}
+
+	for resourceName, defaultRequest := range limit.DefaultRequest {
+		if defaultLimit, found := limit.Default[resourceName]; found && defaultRequest.Cmp(defaultLimit) > 0 {
+			return fmt.Errorf("limitrange defaultRequest of %s %s is greater than default %s",
+				resourceName, defaultRequest.String(), defaultLimit.String())
+		}
+	}
+
+	for resourceName, ratio := range limit.MaxLimitRequestRatio {
+		if ratio.CmpInt64(1) < 0 {
+			return fmt.Errorf("limitrange %s maxLimitRequestRatio of %s cannot be less than 1", limit.Type, resourceName)
+		}
+	}
+
+	return nil
+}
+
+// Diff compares the limitrange definition with the limitrange object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing limitrange %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"limitrange object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "LimitRange"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
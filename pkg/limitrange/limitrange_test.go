@@ -0,0 +1,264 @@
+package limitrange
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultLimitRangeName      = "test-limitrange"
+	defaultLimitRangeNamespace = "test-namespace"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		expectedError string
+	}{
+		{
+			name:          defaultLimitRangeName,
+			nsname:        defaultLimitRangeNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultLimitRangeNamespace,
+			expectedError: "limitrange 'name' cannot be empty",
+		},
+		{
+			name:          defaultLimitRangeName,
+			nsname:        "",
+			expectedError: "limitrange 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.nsname)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			name:          defaultLimitRangeName,
+			addToRuntime:  true,
+			expectedError: "",
+		},
+		{
+			name:          defaultLimitRangeName,
+			addToRuntime:  false,
+			expectedError: "limitrange object test-limitrange doesn't exist in namespace test-namespace",
+		},
+		{
+			name:          "",
+			addToRuntime:  false,
+			expectedError: "limitrange 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyLimitRange())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := Pull(testSettings, testCase.name, defaultLimitRangeNamespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestWithMinMax(t *testing.T) {
+	testCases := []struct {
+		limitType     corev1.LimitType
+		minimum       corev1.ResourceList
+		maximum       corev1.ResourceList
+		expectedError string
+	}{
+		{
+			limitType: corev1.LimitTypeContainer,
+			minimum:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			maximum:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+		{
+			limitType: corev1.LimitTypePersistentVolumeClaim,
+			maximum:   corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+		},
+		{
+			limitType:     corev1.LimitTypePod,
+			minimum:       corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			maximum:       corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			expectedError: "limitrange Pod min of memory 2Gi is greater than max 1Gi",
+		},
+		{
+			limitType:     "Node",
+			minimum:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			expectedError: "limitrange limit type Node is not supported, must be Pod, Container or PersistentVolumeClaim",
+		},
+		{
+			limitType:     corev1.LimitTypeContainer,
+			expectedError: "limitrange 'min' and 'max' cannot be both empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithMinMax(testCase.limitType, testCase.minimum, testCase.maximum)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Len(t, testBuilder.Definition.Spec.Limits, 1)
+		assert.Equal(t, testCase.limitType, testBuilder.Definition.Spec.Limits[0].Type)
+		assert.Equal(t, testCase.minimum, testBuilder.Definition.Spec.Limits[0].Min)
+		assert.Equal(t, testCase.maximum, testBuilder.Definition.Spec.Limits[0].Max)
+	}
+}
+
+func TestWithDefaultsAndRatio(t *testing.T) {
+	testBuilder := buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithMinMax(corev1.LimitTypeContainer, nil, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}).
+		WithDefaults(
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}).
+		WithMaxLimitRequestRatio(corev1.LimitTypeContainer,
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")})
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Len(t, testBuilder.Definition.Spec.Limits, 1)
+	assert.Len(t, testBuilder.Definition.Spec.Limits[0].Max, 1)
+	assert.Len(t, testBuilder.Definition.Spec.Limits[0].Default, 1)
+	assert.Len(t, testBuilder.Definition.Spec.Limits[0].DefaultRequest, 1)
+	assert.Len(t, testBuilder.Definition.Spec.Limits[0].MaxLimitRequestRatio, 1)
+
+	testBuilder = buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithDefaults(
+			corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")})
+	assert.EqualError(t, testBuilder.errorMsg, "limitrange defaultRequest of memory 2Gi is greater than default 1Gi")
+
+	testBuilder = buildValidLimitRangeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithMaxLimitRequestRatio(corev1.LimitTypePod,
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")})
+	assert.EqualError(t, testBuilder.errorMsg, "limitrange Pod maxLimitRequestRatio of cpu cannot be less than 1")
+}
+
+func TestCreateUpdateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := buildValidLimitRangeBuilder(testSettings).Create()
+	assert.EqualError(t, err, "limitrange must have at least one limit")
+
+	testBuilder, err := buildValidLimitRangeBuilder(testSettings).
+		WithMinMax(corev1.LimitTypeContainer, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}, nil).
+		Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	testBuilder, err = testBuilder.WithMinMax(corev1.LimitTypePod, nil,
+		corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).Update()
+	assert.Nil(t, err)
+	assert.Len(t, testBuilder.Object.Spec.Limits, 2)
+
+	podLimit, err := testBuilder.GetLimit(corev1.LimitTypePod)
+	assert.Nil(t, err)
+	assert.Equal(t, "4", podLimit.Max.Cpu().String())
+
+	_, err = testBuilder.GetLimit(corev1.LimitTypePersistentVolumeClaim)
+	assert.EqualError(t, err,
+		"limitrange test-limitrange in namespace test-namespace has no limits of type PersistentVolumeClaim")
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func buildValidLimitRangeBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultLimitRangeName, defaultLimitRangeNamespace)
+}
+
+func buildDummyLimitRange() *corev1.LimitRange {
+	return &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultLimitRangeName,
+			Namespace: defaultLimitRangeNamespace,
+		},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{
+				Type: corev1.LimitTypeContainer,
+				Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			}},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidLimitRangeBuilder(testSettings).
+		WithMinMax(corev1.LimitTypeContainer, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}, nil)
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("limitrange object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "limitranges", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidLimitRangeBuilder(testSettings).
+		WithMinMax(corev1.LimitTypeContainer, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}, nil).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidLimitRangeBuilder(existingSettings).
+		WithMinMax(corev1.LimitTypeContainer, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}, nil).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
@@ -0,0 +1,94 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/configmap"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/namespace"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
+	"github.com/openshift-kni/eco-goinfra/pkg/service"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Load decodes every document of a YAML or JSON manifest and maps it to the builder of the matching package. The
+// returned builders keep the order of the documents and can be type asserted, e.g. to *service.Builder. The
+// Namespace, ConfigMap, Secret and Service kinds are mapped to their builder packages, all other kinds, e.g. ZTP
+// SiteConfigs or ACM Policies, are mapped to an *UnstructuredBuilder managed through the dynamic client.
+func Load(apiClient *clients.Settings, data []byte) ([]interface{}, error) {
+	logging.V(100).Infof("Loading builders from manifest")
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("failed to load builders, 'apiClient' parameter is empty")
+	}
+
+	documents, err := manifest.SplitDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var builders []interface{}
+
+	for index, document := range documents {
+		objects, err := manifest.Decode(document)
+		if err != nil {
+			return nil, err
+		}
+
+		builder, err := newBuilder(apiClient, objects[0], document)
+		if err != nil {
+			logging.V(100).Infof("Failed to load builder from manifest document %d due to %s", index, err.Error())
+
+			return nil, fmt.Errorf("failed to load builder from manifest document %d: %w", index, err)
+		}
+
+		builders = append(builders, builder)
+	}
+
+	return builders, nil
+}
+
+// LoadFile reads the manifest stored in the given file and maps every document of it to the builder of the
+// matching package.
+func LoadFile(apiClient *clients.Settings, path string) ([]interface{}, error) {
+	logging.V(100).Infof("Loading builders from manifest file %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logging.V(100).Infof("Failed to read manifest file %s due to %s", path, err.Error())
+
+		return nil, err
+	}
+
+	return Load(apiClient, data)
+}
+
+// newBuilder creates the builder that matches the type of the decoded object.
+func newBuilder(apiClient *clients.Settings, object runtime.Object, document []byte) (interface{}, error) {
+	switch object.(type) {
+	case *corev1.Namespace:
+		return namespace.NewBuilderFromYAML(apiClient, document), nil
+	case *corev1.ConfigMap:
+		return configmap.NewBuilderFromYAML(apiClient, document), nil
+	case *corev1.Secret:
+		return secret.NewBuilderFromYAML(apiClient, document), nil
+	case *corev1.Service:
+		return service.NewBuilderFromYAML(apiClient, document), nil
+	default:
+		logging.V(100).Infof("No builder package for kind %s, falling back to the unstructured builder",
+			object.GetObjectKind().GroupVersionKind().Kind)
+
+		builder := NewUnstructuredBuilderFromYAML(apiClient, document)
+		if valid, err := builder.validate(); !valid {
+			return nil, err
+		}
+
+		return builder, nil
+	}
+}
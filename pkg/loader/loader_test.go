@@ -0,0 +1,230 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/configmap"
+	"github.com/openshift-kni/eco-goinfra/pkg/namespace"
+	"github.com/openshift-kni/eco-goinfra/pkg/secret"
+	"github.com/openshift-kni/eco-goinfra/pkg/service"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const testManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: test-namespace
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-configmap
+  namespace: test-namespace
+data:
+  key: value
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: test-secret
+  namespace: test-namespace
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: test-service
+  namespace: test-namespace
+spec:
+  ports:
+  - port: 80
+`
+
+const testUnstructuredManifest = `apiVersion: ran.openshift.io/v1
+kind: SiteConfig
+metadata:
+  name: test-site
+  namespace: test-namespace
+spec:
+  baseDomain: test
+---
+apiVersion: policy.open-cluster-management.io/v1
+kind: Policy
+metadata:
+  name: test-policy
+  namespace: test-namespace
+`
+
+func TestLoad(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	builders, err := Load(testSettings, []byte(testManifest))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(builders))
+
+	namespaceBuilder, ok := builders[0].(*namespace.Builder)
+	assert.True(t, ok)
+	assert.Equal(t, "test-namespace", namespaceBuilder.Definition.Name)
+
+	configMapBuilder, ok := builders[1].(*configmap.Builder)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"key": "value"}, configMapBuilder.Definition.Data)
+
+	secretBuilder, ok := builders[2].(*secret.Builder)
+	assert.True(t, ok)
+	assert.Equal(t, "test-secret", secretBuilder.Definition.Name)
+
+	serviceBuilder, ok := builders[3].(*service.Builder)
+	assert.True(t, ok)
+	assert.Equal(t, int32(80), serviceBuilder.Definition.Spec.Ports[0].Port)
+
+	_, err = serviceBuilder.Create()
+	assert.Nil(t, err)
+	assert.True(t, serviceBuilder.Exists())
+}
+
+func TestLoadErrors(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := Load(nil, []byte(testManifest))
+	assert.EqualError(t, err, "failed to load builders, 'apiClient' parameter is empty")
+
+	_, err = Load(testSettings, []byte("apiVersion: example.com/v1\nkind: Unknown\nmetadata:\n  namespace: test\n"))
+	assert.EqualError(t, err, "failed to load builder from manifest document 0: Unknown 'name' cannot be empty")
+}
+
+func TestLoadUnstructured(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	builders, err := Load(testSettings, []byte(testUnstructuredManifest))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(builders))
+
+	siteConfigBuilder, ok := builders[0].(*UnstructuredBuilder)
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionResource{
+		Group: "ran.openshift.io", Version: "v1", Resource: "siteconfigs"}, siteConfigBuilder.GetGVR())
+
+	policyBuilder, ok := builders[1].(*UnstructuredBuilder)
+	assert.True(t, ok)
+	assert.Equal(t, schema.GroupVersionResource{
+		Group: "policy.open-cluster-management.io", Version: "v1", Resource: "policies"}, policyBuilder.GetGVR())
+
+	assert.False(t, siteConfigBuilder.Exists())
+
+	_, err = siteConfigBuilder.Create()
+	assert.Nil(t, err)
+	assert.True(t, siteConfigBuilder.Exists())
+
+	err = unstructured.SetNestedField(siteConfigBuilder.Definition.Object, "test-updated", "spec", "baseDomain")
+	assert.Nil(t, err)
+
+	_, err = siteConfigBuilder.Update()
+	assert.Nil(t, err)
+	assert.Equal(t, "test-updated", siteConfigBuilder.Object.Object["spec"].(map[string]interface{})["baseDomain"])
+
+	err = siteConfigBuilder.Delete()
+	assert.Nil(t, err)
+	assert.False(t, siteConfigBuilder.Exists())
+}
+
+func TestNewUnstructuredBuilderFromYAML(t *testing.T) {
+	testCases := []struct {
+		data             string
+		expectedName     string
+		expectedResource string
+		expectedError    string
+	}{
+		{
+			data:             "apiVersion: ran.openshift.io/v1\nkind: SiteConfig\nmetadata:\n  name: test-site\n",
+			expectedName:     "test-site",
+			expectedResource: "siteconfigs",
+			expectedError:    "",
+		},
+		{
+			data:             `{"apiVersion":"example.com/v1","kind":"Example","metadata":{"name":"test"}}`,
+			expectedName:     "test",
+			expectedResource: "examples",
+			expectedError:    "",
+		},
+		{
+			data:          "apiVersion: ran.openshift.io/v1\nkind: SiteConfig\nmetadata:\n  namespace: test\n",
+			expectedError: "SiteConfig 'name' cannot be empty",
+		},
+		{
+			data:          testUnstructuredManifest,
+			expectedError: "failed to decode unstructured manifest: expected manifest with 1 document, found 2",
+		},
+		{
+			data:          "",
+			expectedError: "failed to decode unstructured manifest: expected manifest with 1 document, found 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewUnstructuredBuilderFromYAML(clients.GetTestClients(clients.TestClientParams{}),
+			[]byte(testCase.data))
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, testCase.expectedName, testBuilder.Definition.GetName())
+			assert.Equal(t, testCase.expectedResource, testBuilder.GetGVR().Resource)
+		} else {
+			assert.EqualError(t, testBuilder.errorMsg, testCase.expectedError)
+		}
+	}
+}
+
+func TestUnstructuredBuilderWithGVR(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testCases := []struct {
+		gvr           schema.GroupVersionResource
+		expectedError string
+	}{
+		{
+			gvr:           schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "examples"},
+			expectedError: "",
+		},
+		{
+			gvr:           schema.GroupVersionResource{Group: "example.com", Resource: "examples"},
+			expectedError: "unstructured 'gvr' version and resource cannot be empty",
+		},
+		{
+			gvr:           schema.GroupVersionResource{Group: "example.com", Version: "v1"},
+			expectedError: "unstructured 'gvr' version and resource cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewUnstructuredBuilderFromYAML(
+			testSettings, []byte(`{"apiVersion":"example.com/v1","kind":"Example","metadata":{"name":"test"}}`)).
+			WithGVR(testCase.gvr)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, testCase.gvr, testBuilder.GetGVR())
+		} else {
+			assert.EqualError(t, testBuilder.errorMsg, testCase.expectedError)
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	manifestPath := filepath.Join(t.TempDir(), "manifest.yaml")
+
+	err := os.WriteFile(manifestPath, []byte(testManifest), 0600)
+	assert.Nil(t, err)
+
+	builders, err := LoadFile(testSettings, manifestPath)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(builders))
+
+	_, err = LoadFile(testSettings, filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+}
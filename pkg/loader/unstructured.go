@@ -0,0 +1,244 @@
+package loader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// UnstructuredBuilder provides struct for a manifest object whose kind has no builder package, e.g. a ZTP
+// SiteConfig or an ACM Policy, containing connection to the cluster and the object definitions. The object is
+// managed through the dynamic client.
+type UnstructuredBuilder struct {
+	// Object definition. Used to create the object.
+	Definition *unstructured.Unstructured
+	// Created object.
+	Object *unstructured.Unstructured
+	// Used in functions that define or mutate the definition. errorMsg is processed before the object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	gvr       schema.GroupVersionResource
+}
+
+// NewUnstructuredBuilderFromYAML creates a new instance of UnstructuredBuilder from a manifest with a single
+// document in YAML or JSON format. The resource of the object is guessed from its kind by lower-casing and
+// pluralizing it; kinds with an irregular plural must be set with WithGVR.
+func NewUnstructuredBuilderFromYAML(apiClient *clients.Settings, data []byte) *UnstructuredBuilder {
+	logging.V(100).Infof("Initializing new unstructured structure from manifest")
+
+	builder := UnstructuredBuilder{
+		apiClient:  apiClient,
+		Definition: &unstructured.Unstructured{},
+	}
+
+	err := decodeUnstructured(data, builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to decode unstructured manifest due to %s", err.Error())
+
+		builder.errorMsg = fmt.Errorf("failed to decode unstructured manifest: %w", err)
+
+		return &builder
+	}
+
+	builder.gvr, _ = meta.UnsafeGuessKindToResource(builder.Definition.GroupVersionKind())
+
+	if builder.Definition.GetName() == "" {
+		logging.V(100).Infof("The name of the %s is empty", builder.Definition.GetKind())
+
+		builder.errorMsg = errors.Join(
+			builder.errorMsg, fmt.Errorf("%s 'name' cannot be empty", builder.Definition.GetKind()))
+	}
+
+	return &builder
+}
+
+// WithGVR sets the GroupVersionResource used to manage the object, overriding the one guessed from its kind.
+func (builder *UnstructuredBuilder) WithGVR(gvr schema.GroupVersionResource) *UnstructuredBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting GroupVersionResource %s for %s %s", gvr.String(), builder.Definition.GetKind(),
+		builder.Definition.GetName())
+
+	if gvr.Version == "" || gvr.Resource == "" {
+		logging.V(100).Infof("The GroupVersionResource version or resource is empty")
+
+		builder.errorMsg = errors.Join(
+			builder.errorMsg, fmt.Errorf("unstructured 'gvr' version and resource cannot be empty"))
+
+		return builder
+	}
+
+	builder.gvr = gvr
+
+	return builder
+}
+
+// Get returns the object if found.
+func (builder *UnstructuredBuilder) Get() (*unstructured.Unstructured, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting %s object %s in namespace %s", builder.Definition.GetKind(),
+		builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	return builder.resource().Get(context.TODO(), builder.Definition.GetName(), metav1.GetOptions{})
+}
+
+// Exists checks whether the given object exists.
+func (builder *UnstructuredBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if %s %s exists in namespace %s", builder.Definition.GetKind(),
+		builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes the object in the cluster and stores the created object in struct.
+func (builder *UnstructuredBuilder) Create() (*UnstructuredBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the %s %s in namespace %s", builder.Definition.GetKind(),
+		builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	var err error
+	builder.Object, err = builder.resource().Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
+
+	return builder, err
+}
+
+// Update renews the object in the cluster with the definition.
+func (builder *UnstructuredBuilder) Update() (*UnstructuredBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the %s %s in namespace %s", builder.Definition.GetKind(),
+		builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("%s object %s does not exist, fail to update", builder.Definition.GetKind(),
+			builder.Definition.GetName())
+	}
+
+	builder.Definition.SetResourceVersion(builder.Object.GetResourceVersion())
+
+	var err error
+	builder.Object, err = builder.resource().Update(context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the object from the cluster.
+func (builder *UnstructuredBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the %s %s from namespace %s", builder.Definition.GetKind(),
+		builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.resource().Delete(context.TODO(), builder.Definition.GetName(), metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("can not delete %s: %w", builder.Definition.GetKind(), err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetGVR returns the GroupVersionResource used to manage the object.
+func (builder *UnstructuredBuilder) GetGVR() schema.GroupVersionResource {
+	return builder.gvr
+}
+
+// resource returns the dynamic client of the object resource, scoped to its namespace when it has one.
+func (builder *UnstructuredBuilder) resource() dynamic.ResourceInterface {
+	if builder.Definition.GetNamespace() == "" {
+		return builder.apiClient.Resource(builder.gvr)
+	}
+
+	return builder.apiClient.Resource(builder.gvr).Namespace(builder.Definition.GetNamespace())
+}
+
+// decodeUnstructured decodes a YAML or JSON manifest, which must have exactly one document, into object.
+func decodeUnstructured(data []byte, object *unstructured.Unstructured) error {
+	documents, err := manifest.SplitDocuments(data)
+	if err != nil {
+		return err
+	}
+
+	if len(documents) != 1 {
+		logging.V(100).Infof("The manifest has %d documents instead of 1", len(documents))
+
+		return fmt.Errorf("expected manifest with 1 document, found %d", len(documents))
+	}
+
+	return object.UnmarshalJSON(documents[0])
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *UnstructuredBuilder) validate() (bool, error) {
+	resourceCRD := "unstructured"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
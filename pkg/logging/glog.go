@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/glog"
+)
+
+// glogSink is a logr.LogSink that writes to glog. It keeps the message format of the former glog calls so the
+// default output of the builders does not change.
+type glogSink struct {
+	callDepth int
+	values    []interface{}
+}
+
+var _ logr.CallDepthLogSink = &glogSink{}
+
+// newGlogSink creates a new instance of glogSink.
+func newGlogSink() *glogSink {
+	return &glogSink{}
+}
+
+// NewGlogLogger returns a logr.Logger that writes to glog. It is the default logger of the package.
+func NewGlogLogger() logr.Logger {
+	return logr.New(newGlogSink())
+}
+
+// Init receives the call depth of the logr.Logger.
+func (sink *glogSink) Init(info logr.RuntimeInfo) {
+	sink.callDepth = info.CallDepth
+}
+
+// Enabled checks whether glog writes messages at the given level, i.e. whether -v is at least level.
+func (sink *glogSink) Enabled(level int) bool {
+	return bool(glog.V(glog.Level(level)))
+}
+
+// Info writes a message and its key value pairs to glog.
+func (sink *glogSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	glog.InfoDepth(sink.callDepth+1, sink.format(msg, keysAndValues))
+}
+
+// Error writes an error message and its key value pairs to glog.
+func (sink *glogSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	glog.ErrorDepth(sink.callDepth+1, sink.format(msg, append([]interface{}{"error", err}, keysAndValues...)))
+}
+
+// WithValues returns a copy of the sink that adds the key value pairs to every message.
+func (sink *glogSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	newSink := *sink
+	newSink.values = append(append([]interface{}{}, sink.values...), keysAndValues...)
+
+	return &newSink
+}
+
+// WithName returns the sink unchanged. The names are dropped to keep the former glog message format.
+func (sink *glogSink) WithName(name string) logr.LogSink {
+	return sink
+}
+
+// WithCallDepth returns a copy of the sink that skips depth more stack frames when reporting the caller.
+func (sink *glogSink) WithCallDepth(depth int) logr.LogSink {
+	newSink := *sink
+	newSink.callDepth += depth
+
+	return &newSink
+}
+
+// format appends the key value pairs to the message.
+func (sink *glogSink) format(msg string, keysAndValues []interface{}) string {
+	keysAndValues = append(append([]interface{}{}, sink.values...), keysAndValues...)
+	if len(keysAndValues) == 0 {
+		return msg
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString(msg)
+
+	for index := 0; index < len(keysAndValues); index += 2 {
+		var value interface{} = "(MISSING)"
+		if index+1 < len(keysAndValues) {
+			value = keysAndValues[index+1]
+		}
+
+		fmt.Fprintf(&builder, " %v=%v", keysAndValues[index], value)
+	}
+
+	return builder.String()
+}
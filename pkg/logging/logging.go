@@ -0,0 +1,157 @@
+package logging
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// modulePackagePrefix is the prefix of the import path of every eco-goinfra package.
+const modulePackagePrefix = "github.com/openshift-kni/eco-goinfra/pkg/"
+
+var (
+	mutex            sync.RWMutex
+	logger           = NewGlogLogger()
+	packageVerbosity = map[string]int{}
+	// namedLoggers caches the logger of every package, named after it. It is emptied when the logger changes.
+	namedLoggers = map[string]logr.Logger{}
+)
+
+// Verbose provides struct for a leveled logger returned by V. Its methods do nothing when the level is disabled.
+type Verbose struct {
+	logger  logr.Logger
+	enabled bool
+}
+
+// SetLogger routes the logs of the builders to the given logger, e.g. one backed by zap or by the ginkgo writer.
+// By default the logs are written with glog.
+func SetLogger(newLogger logr.Logger) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	logger = newLogger
+	namedLoggers = map[string]logr.Logger{}
+}
+
+// GetLogger returns the logger the builders currently write to.
+func GetLogger() logr.Logger {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return logger
+}
+
+// SetPackageVerbosity overrides the verbosity of the given package, e.g. service or metallb/mlbtypes. Messages of
+// the package with a level lower than or equal to verbosity are always written, all others are dropped. Packages
+// without an override follow the verbosity of the logger, e.g. the -v flag of glog.
+func SetPackageVerbosity(packageName string, verbosity int) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	packageVerbosity[packageName] = verbosity
+}
+
+// ResetPackageVerbosity removes the verbosity override of the given package.
+func ResetPackageVerbosity(packageName string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delete(packageVerbosity, packageName)
+}
+
+// V returns a Verbose that writes messages at the given level. The logger is named after the package of the caller.
+// When the level is disabled and no package verbosity is overridden, a no-op Verbose is returned without looking up
+// the caller.
+func V(level int) Verbose {
+	mutex.RLock()
+	disabled := len(packageVerbosity) == 0 && !logger.V(level).Enabled()
+	mutex.RUnlock()
+
+	if disabled {
+		return Verbose{}
+	}
+
+	packageName := callerPackage()
+	namedLogger := getNamedLogger(packageName)
+
+	mutex.RLock()
+	verbosity, ok := packageVerbosity[packageName]
+	mutex.RUnlock()
+
+	if !ok {
+		leveledLogger := namedLogger.V(level)
+
+		return Verbose{logger: leveledLogger, enabled: leveledLogger.Enabled()}
+	}
+
+	return Verbose{logger: namedLogger, enabled: level <= verbosity}
+}
+
+// getNamedLogger returns the cached logger of the given package, creating it on first use.
+func getNamedLogger(packageName string) logr.Logger {
+	mutex.RLock()
+	namedLogger, ok := namedLoggers[packageName]
+	mutex.RUnlock()
+
+	if ok {
+		return namedLogger
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	namedLogger, ok = namedLoggers[packageName]
+	if !ok {
+		namedLogger = logger.WithName(packageName).WithCallDepth(1)
+		namedLoggers[packageName] = namedLogger
+	}
+
+	return namedLogger
+}
+
+// Enabled checks whether messages at the level of the Verbose are written.
+func (verbose Verbose) Enabled() bool {
+	return verbose.enabled
+}
+
+// Info writes a message built from the arguments in the manner of fmt.Sprint.
+func (verbose Verbose) Info(args ...interface{}) {
+	if verbose.enabled {
+		verbose.logger.Info(fmt.Sprint(args...))
+	}
+}
+
+// Infof writes a message built from the arguments in the manner of fmt.Sprintf.
+func (verbose Verbose) Infof(format string, args ...interface{}) {
+	if verbose.enabled {
+		verbose.logger.Info(fmt.Sprintf(format, args...))
+	}
+}
+
+// callerPackage returns the package of the function that called V relative to the pkg directory of the module, e.g.
+// service. Callers outside of the module are returned with their full import path.
+func callerPackage() string {
+	programCounter, _, _, ok := runtime.Caller(2)
+	if !ok {
+		return ""
+	}
+
+	function := runtime.FuncForPC(programCounter)
+	if function == nil {
+		return ""
+	}
+
+	// Function names look like github.com/openshift-kni/eco-goinfra/pkg/service.(*Builder).Create.
+	functionName := function.Name()
+	lastSlash := strings.LastIndex(functionName, "/")
+
+	packageName := functionName
+	if dot := strings.Index(functionName[lastSlash+1:], "."); dot >= 0 {
+		packageName = functionName[:lastSlash+1+dot]
+	}
+
+	return strings.TrimPrefix(packageName, modulePackagePrefix)
+}
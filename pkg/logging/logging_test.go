@@ -0,0 +1,128 @@
+package logging
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestV(t *testing.T) {
+	testCases := []struct {
+		loggerVerbosity  int
+		packageVerbosity *int
+		level            int
+		expectedMessages []string
+	}{
+		{
+			loggerVerbosity:  100,
+			level:            100,
+			expectedMessages: []string{`logging "level"=100 "msg"="test message 1"`},
+		},
+		{
+			loggerVerbosity: 0,
+			level:           100,
+		},
+		{
+			loggerVerbosity:  0,
+			packageVerbosity: intPointer(100),
+			level:            100,
+			expectedMessages: []string{`logging "level"=0 "msg"="test message 1"`},
+		},
+		{
+			loggerVerbosity:  100,
+			packageVerbosity: intPointer(10),
+			level:            100,
+		},
+	}
+
+	defer SetLogger(NewGlogLogger())
+	defer ResetPackageVerbosity("logging")
+
+	for _, testCase := range testCases {
+		var messages []string
+
+		SetLogger(funcr.New(func(prefix, args string) {
+			messages = append(messages, prefix+" "+args)
+		}, funcr.Options{Verbosity: testCase.loggerVerbosity}))
+
+		ResetPackageVerbosity("logging")
+
+		if testCase.packageVerbosity != nil {
+			SetPackageVerbosity("logging", *testCase.packageVerbosity)
+		}
+
+		verbose := V(testCase.level)
+		assert.Equal(t, len(testCase.expectedMessages) > 0, verbose.Enabled())
+
+		verbose.Infof("test message %d", 1)
+		assert.Equal(t, testCase.expectedMessages, messages)
+	}
+}
+
+func TestVDisabled(t *testing.T) {
+	var messages []string
+
+	defer SetLogger(NewGlogLogger())
+
+	SetLogger(funcr.New(func(prefix, args string) {
+		messages = append(messages, prefix+" "+args)
+	}, funcr.Options{Verbosity: 0}))
+
+	verbose := V(100)
+	assert.Equal(t, Verbose{}, verbose)
+
+	verbose.Infof("test message %d", 1)
+	verbose.Info("test message")
+	assert.Empty(t, messages)
+}
+
+func TestGetNamedLogger(t *testing.T) {
+	defer SetLogger(NewGlogLogger())
+
+	SetLogger(logr.Discard())
+
+	_ = getNamedLogger("logging")
+	assert.Contains(t, namedLoggers, "logging")
+
+	SetLogger(logr.Discard())
+	assert.Empty(t, namedLoggers)
+}
+
+func TestInfo(t *testing.T) {
+	var messages []string
+
+	defer SetLogger(NewGlogLogger())
+
+	SetLogger(funcr.New(func(prefix, args string) {
+		messages = append(messages, prefix+" "+args)
+	}, funcr.Options{}))
+
+	V(0).Info("test ", "message")
+	assert.Equal(t, []string{`logging "level"=0 "msg"="test message"`}, messages)
+}
+
+func TestGetLogger(t *testing.T) {
+	defer SetLogger(NewGlogLogger())
+
+	SetLogger(logr.Discard())
+	assert.Equal(t, logr.Discard(), GetLogger())
+}
+
+func TestGlogSinkFormat(t *testing.T) {
+	sink := newGlogSink()
+
+	assert.Equal(t, "test message", sink.format("test message", nil))
+	assert.Equal(t, "test message key=value odd=(MISSING)",
+		sink.format("test message", []interface{}{"key", "value", "odd"}))
+
+	valueSink, ok := sink.WithValues("cluster", "hub").(*glogSink)
+	assert.True(t, ok)
+	assert.Equal(t, "test message cluster=hub key=value", valueSink.format("test message", []interface{}{"key", "value"}))
+	assert.Empty(t, sink.values)
+}
+
+func intPointer(value int) *int {
+	return &value
+}
@@ -0,0 +1,384 @@
+package lso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	lsoV1 "github.com/openshift/local-storage-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LocalVolumeBuilder provides a struct for localVolume object from the cluster and a localVolume definition.
+type LocalVolumeBuilder struct {
+	// localVolume definition, used to create the localVolume object.
+	Definition *lsoV1.LocalVolume
+	// Created localVolume object.
+	Object *lsoV1.LocalVolume
+	// Used in functions that define or mutate localVolume definition. errorMsg is processed
+	// before the localVolume object is created
+	errorMsg error
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+}
+
+// NewLocalVolumeBuilder creates new instance of LocalVolumeBuilder. Devices are added with WithStorageClassDevice
+// before creating it.
+func NewLocalVolumeBuilder(apiClient *clients.Settings, name, nsname string) *LocalVolumeBuilder {
+	logging.V(100).Infof("Initializing new %s localVolume structure in %s namespace", name, nsname)
+
+	builder := LocalVolumeBuilder{
+		apiClient: apiClient,
+		Definition: &lsoV1.LocalVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the localVolume is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolume 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The nsname of the localVolume is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolume 'nsname' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullLocalVolume retrieves an existing localVolume object from the cluster.
+func PullLocalVolume(apiClient *clients.Settings, name, nsname string) (*LocalVolumeBuilder, error) {
+	logging.V(100).Infof("Pulling localVolume object name: %s in namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("localVolume 'apiClient' cannot be empty")
+	}
+
+	builder := LocalVolumeBuilder{
+		apiClient: apiClient,
+		Definition: &lsoV1.LocalVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the localVolume is empty")
+
+		return nil, fmt.Errorf("localVolume 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the localVolume is empty")
+
+		return nil, fmt.Errorf("localVolume 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("localVolume object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithStorageClassDevice adds the given device paths, exposed as persistentvolumes of storageClassName. Filesystem
+// volumes are formatted with fsType, which is ignored for Block volumes.
+func (builder *LocalVolumeBuilder) WithStorageClassDevice(
+	storageClassName string,
+	volumeMode lsoV1.PersistentVolumeMode,
+	fsType string,
+	devicePaths ...string) *LocalVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding devices %v of storageClass %s to localVolume %s in namespace %s",
+		devicePaths, storageClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if storageClassName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolume 'storageClassName' cannot be empty"))
+
+		return builder
+	}
+
+	if len(devicePaths) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolume 'devicePaths' cannot be empty list"))
+
+		return builder
+	}
+
+	err := validateVolumeMode(volumeMode)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolume %w", err))
+
+		return builder
+	}
+
+	for _, device := range builder.Definition.Spec.StorageClassDevices {
+		if device.StorageClassName == storageClassName {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("localVolume already has devices for storageClass %s", storageClassName))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.StorageClassDevices = append(builder.Definition.Spec.StorageClassDevices,
+		lsoV1.StorageClassDevice{
+			StorageClassName: storageClassName,
+			VolumeMode:       volumeMode,
+			FSType:           fsType,
+			DevicePaths:      devicePaths,
+		})
+
+	return builder
+}
+
+// WithForceWipeDevices wipes the devices of storageClassName before creating persistentvolumes on them. All data
+// on the devices is destroyed, so it should only be used on disks reserved for tests.
+func (builder *LocalVolumeBuilder) WithForceWipeDevices(storageClassName string) *LocalVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Enabling force wipe of devices of storageClass %s in localVolume %s in namespace %s",
+		storageClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	for index := range builder.Definition.Spec.StorageClassDevices {
+		if builder.Definition.Spec.StorageClassDevices[index].StorageClassName == storageClassName {
+			builder.Definition.Spec.StorageClassDevices[index].ForceWipeDevicesAndDestroyAllData = true
+
+			return builder
+		}
+	}
+
+	builder.errorMsg = errors.Join(builder.errorMsg,
+		fmt.Errorf("localVolume has no devices for storageClass %s", storageClassName))
+
+	return builder
+}
+
+// WithNodeSelector restricts the localVolume to the nodes selected by nodeSelector.
+func (builder *LocalVolumeBuilder) WithNodeSelector(nodeSelector corev1.NodeSelector) *LocalVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting nodeSelector in localVolume %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("localVolume 'nodeSelector' must have at least one node selector term"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = &nodeSelector
+
+	return builder
+}
+
+// WithTolerations sets the tolerations of the diskmaker pods of the localVolume.
+func (builder *LocalVolumeBuilder) WithTolerations(tolerations ...corev1.Toleration) *LocalVolumeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting tolerations %v in localVolume %s in namespace %s",
+		tolerations, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(tolerations) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolume 'tolerations' cannot be empty list"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = tolerations
+
+	return builder
+}
+
+// Get fetches existing localVolume from cluster.
+func (builder *LocalVolumeBuilder) Get() (*lsoV1.LocalVolume, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Pulling existing localVolume with name %s under namespace %s from cluster",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	localVolume := &lsoV1.LocalVolume{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, localVolume)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return localVolume, nil
+}
+
+// Exists checks whether the given localVolume exists.
+func (builder *LocalVolumeBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if localVolume %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a localVolume in the cluster and stores the created object in struct.
+func (builder *LocalVolumeBuilder) Create() (*LocalVolumeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the localVolume %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.StorageClassDevices) == 0 {
+		return builder, fmt.Errorf("localVolume %s in namespace %s must have at least one storageClass device",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	if err == nil {
+		builder.Object = builder.Definition
+	}
+
+	return builder, err
+}
+
+// Update renovates a localVolume in the cluster and stores the updated object in struct.
+func (builder *LocalVolumeBuilder) Update() (*LocalVolumeBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the localVolume %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("localVolume object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err == nil {
+		builder.Object = builder.Definition
+	}
+
+	return builder, err
+}
+
+// Delete removes localVolume from a cluster.
+func (builder *LocalVolumeBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the localVolume %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	if err != nil {
+		return fmt.Errorf("can not delete localVolume: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetPersistentVolumes returns the persistentvolumes the local-storage operator created for the localVolume.
+func (builder *LocalVolumeBuilder) GetPersistentVolumes() ([]corev1.PersistentVolume, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return listOwnedPersistentVolumes(
+		builder.apiClient, localVolumeKind, builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// WaitUntilPersistentVolumesCreated waits for the duration of the defined timeout or until the local-storage
+// operator created at least expectedCount persistentvolumes from the devices of the localVolume.
+func (builder *LocalVolumeBuilder) WaitUntilPersistentVolumesCreated(expectedCount int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return waitForOwnedPersistentVolumes(builder.apiClient, localVolumeKind,
+		builder.Definition.Name, builder.Definition.Namespace, expectedCount, timeout)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *LocalVolumeBuilder) validate() (bool, error) {
+	resourceCRD := "LocalVolume"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
@@ -0,0 +1,233 @@
+package lso
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	lsoV1 "github.com/openshift/local-storage-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultLocalVolumeName      = "local-disks"
+	defaultLocalVolumeNamespace = "openshift-local-storage"
+	defaultLocalStorageClass    = "local-sc"
+)
+
+func TestNewLocalVolumeBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultLocalVolumeName,
+			namespace:     defaultLocalVolumeNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultLocalVolumeNamespace,
+			expectedError: "localVolume 'name' cannot be empty",
+		},
+		{
+			name:          defaultLocalVolumeName,
+			namespace:     "",
+			expectedError: "localVolume 'nsname' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewLocalVolumeBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestPullLocalVolume(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultLocalVolumeName,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			expectedError:       fmt.Errorf("localVolume 'name' cannot be empty"),
+		},
+		{
+			name:                defaultLocalVolumeName,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf("localVolume object %s doesn't exist in namespace %s",
+				defaultLocalVolumeName, defaultLocalVolumeNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyLocalVolume())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := PullLocalVolume(testSettings, testCase.name, defaultLocalVolumeNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultLocalStorageClass, testBuilder.Definition.Spec.StorageClassDevices[0].StorageClassName)
+		}
+	}
+}
+
+func TestLocalVolumeWithStorageClassDevice(t *testing.T) {
+	testCases := []struct {
+		storageClassName string
+		volumeMode       lsoV1.PersistentVolumeMode
+		devicePaths      []string
+		expectedError    string
+	}{
+		{
+			storageClassName: defaultLocalStorageClass,
+			volumeMode:       lsoV1.PersistentVolumeBlock,
+			devicePaths:      []string{"/dev/disk/by-id/disk-1"},
+			expectedError:    "",
+		},
+		{
+			storageClassName: "",
+			volumeMode:       lsoV1.PersistentVolumeBlock,
+			devicePaths:      []string{"/dev/disk/by-id/disk-1"},
+			expectedError:    "localVolume 'storageClassName' cannot be empty",
+		},
+		{
+			storageClassName: defaultLocalStorageClass,
+			volumeMode:       lsoV1.PersistentVolumeBlock,
+			devicePaths:      nil,
+			expectedError:    "localVolume 'devicePaths' cannot be empty list",
+		},
+		{
+			storageClassName: defaultLocalStorageClass,
+			volumeMode:       "Tape",
+			devicePaths:      []string{"/dev/disk/by-id/disk-1"},
+			expectedError:    "localVolume 'volumeMode' Tape is not supported, must be Block or Filesystem",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLocalVolumeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithStorageClassDevice(testCase.storageClassName, testCase.volumeMode, "", testCase.devicePaths...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.devicePaths, testBuilder.Definition.Spec.StorageClassDevices[0].DevicePaths)
+		}
+	}
+}
+
+func TestLocalVolumeWithForceWipeDevices(t *testing.T) {
+	testBuilder := buildValidLocalVolumeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithStorageClassDevice(defaultLocalStorageClass, lsoV1.PersistentVolumeFilesystem, "xfs", "/dev/sdb").
+		WithForceWipeDevices(defaultLocalStorageClass)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.True(t, testBuilder.Definition.Spec.StorageClassDevices[0].ForceWipeDevicesAndDestroyAllData)
+
+	testBuilder = testBuilder.WithForceWipeDevices("missing")
+	assert.EqualError(t, testBuilder.errorMsg, "localVolume has no devices for storageClass missing")
+}
+
+func TestLocalVolumeCreate(t *testing.T) {
+	testBuilder, err := buildValidLocalVolumeBuilder(clients.GetTestClients(clients.TestClientParams{})).Create()
+	assert.EqualError(t, err, fmt.Sprintf("localVolume %s in namespace %s must have at least one storageClass device",
+		defaultLocalVolumeName, defaultLocalVolumeNamespace))
+	assert.Nil(t, testBuilder.Object)
+
+	testBuilder, err = buildValidLocalVolumeBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithStorageClassDevice(defaultLocalStorageClass, lsoV1.PersistentVolumeBlock, "", "/dev/sdb").
+		Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+}
+
+func TestLocalVolumeWaitUntilPersistentVolumesCreated(t *testing.T) {
+	testCases := []struct {
+		expectedCount int
+		expectedError string
+	}{
+		{
+			expectedCount: 2,
+			expectedError: "",
+		},
+		{
+			expectedCount: 3,
+			expectedError: fmt.Sprintf("LocalVolume %s in namespace %s has 2 persistentvolumes, expected 3: "+
+				"context deadline exceeded", defaultLocalVolumeName, defaultLocalVolumeNamespace),
+		},
+		{
+			expectedCount: 0,
+			expectedError: "LocalVolume 'expectedCount' must be greater than 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+			buildDummyLocalVolume(),
+			buildDummyOwnedPV("local-pv-1", localVolumeKind, defaultLocalVolumeName),
+			buildDummyOwnedPV("local-pv-2", localVolumeKind, defaultLocalVolumeName),
+			buildDummyOwnedPV("local-pv-3", localVolumeSetKind, defaultLocalVolumeName),
+		}})
+
+		testBuilder, err := PullLocalVolume(testSettings, defaultLocalVolumeName, defaultLocalVolumeNamespace)
+		assert.Nil(t, err)
+
+		err = testBuilder.WaitUntilPersistentVolumesCreated(testCase.expectedCount, time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidLocalVolumeBuilder(apiClient *clients.Settings) *LocalVolumeBuilder {
+	return NewLocalVolumeBuilder(apiClient, defaultLocalVolumeName, defaultLocalVolumeNamespace)
+}
+
+func buildDummyLocalVolume() *lsoV1.LocalVolume {
+	return &lsoV1.LocalVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultLocalVolumeName,
+			Namespace: defaultLocalVolumeNamespace,
+		},
+		Spec: lsoV1.LocalVolumeSpec{
+			StorageClassDevices: []lsoV1.StorageClassDevice{{
+				StorageClassName: defaultLocalStorageClass,
+				VolumeMode:       lsoV1.PersistentVolumeBlock,
+				DevicePaths:      []string{"/dev/sdb"},
+			}},
+		},
+	}
+}
+
+func buildDummyOwnedPV(name, ownerKind, ownerName string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				pvOwnerKindLabel:      ownerKind,
+				pvOwnerNameLabel:      ownerName,
+				pvOwnerNamespaceLabel: defaultLocalVolumeNamespace,
+			},
+		},
+	}
+}
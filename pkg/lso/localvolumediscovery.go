@@ -2,12 +2,13 @@ package lso
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	lsoV1alpha1 "github.com/openshift/local-storage-operator/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,14 +24,14 @@ type LocalVolumeDiscoveryBuilder struct {
 	Object *lsoV1alpha1.LocalVolumeDiscovery
 	// Used in functions that define or mutate localVolumeDiscovery definition. errorMsg is processed
 	// before the localVolumeDiscovery object is created
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 }
 
 // NewLocalVolumeDiscoveryBuilder creates new instance of LocalVolumeDiscoveryBuilder.
 func NewLocalVolumeDiscoveryBuilder(apiClient *clients.Settings, name, nsname string) *LocalVolumeDiscoveryBuilder {
-	glog.V(100).Infof("Initializing new localVolumeDiscovery structure with the following params: name: "+
+	logging.V(100).Infof("Initializing new localVolumeDiscovery structure with the following params: name: "+
 		"%s, namespace: %s", name, nsname)
 
 	builder := LocalVolumeDiscoveryBuilder{
@@ -44,15 +45,15 @@ func NewLocalVolumeDiscoveryBuilder(apiClient *clients.Settings, name, nsname st
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the localVolumeDiscovery is empty")
+		logging.V(100).Infof("The name of the localVolumeDiscovery is empty")
 
-		builder.errorMsg = "localVolumeDiscovery 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeDiscovery 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the localVolumeDiscovery is empty")
+		logging.V(100).Infof("The nsname of the localVolumeDiscovery is empty")
 
-		builder.errorMsg = "localVolumeDiscovery 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeDiscovery 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -60,7 +61,7 @@ func NewLocalVolumeDiscoveryBuilder(apiClient *clients.Settings, name, nsname st
 
 // PullLocalVolumeDiscovery retrieves an existing localVolumeDiscovery object from the cluster.
 func PullLocalVolumeDiscovery(apiClient *clients.Settings, name, nsname string) (*LocalVolumeDiscoveryBuilder, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling localVolumeDiscovery object name: %s in namespace: %s", name, nsname)
 
 	builder := LocalVolumeDiscoveryBuilder{
@@ -74,15 +75,15 @@ func PullLocalVolumeDiscovery(apiClient *clients.Settings, name, nsname string)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the localVolumeDiscovery is empty")
+		logging.V(100).Infof("The name of the localVolumeDiscovery is empty")
 
-		builder.errorMsg = "localVolumeDiscovery 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeDiscovery 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the localVolumeDiscovery is empty")
+		logging.V(100).Infof("The namespace of the localVolumeDiscovery is empty")
 
-		builder.errorMsg = "localVolumeDiscovery 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeDiscovery 'nsname' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -100,7 +101,7 @@ func (builder *LocalVolumeDiscoveryBuilder) Get() (*lsoV1alpha1.LocalVolumeDisco
 		return nil, err
 	}
 
-	glog.V(100).Infof("Pulling existing localVolumeDiscovery with name %s under namespace %s from cluster",
+	logging.V(100).Infof("Pulling existing localVolumeDiscovery with name %s under namespace %s from cluster",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	lvd := &lsoV1alpha1.LocalVolumeDiscovery{}
@@ -122,7 +123,7 @@ func (builder *LocalVolumeDiscoveryBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if localVolumeDiscovery %s exists in namespace %s",
+	logging.V(100).Infof("Checking if localVolumeDiscovery %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -137,7 +138,7 @@ func (builder *LocalVolumeDiscoveryBuilder) IsDiscovering() (bool, error) {
 		return false, err
 	}
 
-	glog.V(100).Infof("Verify localVolumeDiscovery %s in namespace %s is in Discovering phase",
+	logging.V(100).Infof("Verify localVolumeDiscovery %s in namespace %s is in Discovering phase",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -166,7 +167,7 @@ func (builder *LocalVolumeDiscoveryBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the localVolumeDiscovery %s in namespace %s",
+	logging.V(100).Infof("Deleting the localVolumeDiscovery %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -190,7 +191,7 @@ func (builder *LocalVolumeDiscoveryBuilder) Create() (*LocalVolumeDiscoveryBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the localVolumeDiscovery %s in namespace %s",
+	logging.V(100).Infof("Creating the localVolumeDiscovery %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -210,7 +211,7 @@ func (builder *LocalVolumeDiscoveryBuilder) Update() (*LocalVolumeDiscoveryBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the localVolumeDiscovery %s in namespace %s",
+	logging.V(100).Infof("Updating the localVolumeDiscovery %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -232,7 +233,7 @@ func (builder *LocalVolumeDiscoveryBuilder) GetPhase() (lsoV1alpha1.DiscoveryPha
 		return "", err
 	}
 
-	glog.V(100).Infof("Get %s localVolumeDiscovery in %s namespace phase",
+	logging.V(100).Infof("Get %s localVolumeDiscovery in %s namespace phase",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -249,19 +250,19 @@ func (builder *LocalVolumeDiscoveryBuilder) validate() (bool, error) {
 	resourceCRD := "LocalVolumeDiscovery"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
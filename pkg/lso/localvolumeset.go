@@ -2,14 +2,19 @@ package lso
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	lsoV1 "github.com/openshift/local-storage-operator/api/v1"
 	lsoV1alpha1 "github.com/openshift/local-storage-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -22,14 +27,14 @@ type LocalVolumeSetBuilder struct {
 	Object *lsoV1alpha1.LocalVolumeSet
 	// Used in functions that define or mutate localVolumeSet definition. errorMsg is processed
 	// before the localVolumeSet object is created
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 }
 
 // NewLocalVolumeSetBuilder creates new instance of LocalVolumeSetBuilder.
 func NewLocalVolumeSetBuilder(apiClient *clients.Settings, name, nsname string) *LocalVolumeSetBuilder {
-	glog.V(100).Infof("Initializing new %s localVolumeSet structure in %s namespace", name, nsname)
+	logging.V(100).Infof("Initializing new %s localVolumeSet structure in %s namespace", name, nsname)
 
 	builder := LocalVolumeSetBuilder{
 		apiClient: apiClient,
@@ -42,15 +47,15 @@ func NewLocalVolumeSetBuilder(apiClient *clients.Settings, name, nsname string)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the localVolumeSet is empty")
+		logging.V(100).Infof("The name of the localVolumeSet is empty")
 
-		builder.errorMsg = "localVolumeSet 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the localVolumeSet is empty")
+		logging.V(100).Infof("The nsname of the localVolumeSet is empty")
 
-		builder.errorMsg = "localVolumeSet 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -58,7 +63,7 @@ func NewLocalVolumeSetBuilder(apiClient *clients.Settings, name, nsname string)
 
 // PullLocalVolumeSet retrieves an existing localVolumeSet object from the cluster.
 func PullLocalVolumeSet(apiClient *clients.Settings, name, nsname string) (*LocalVolumeSetBuilder, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling localVolumeSet object name: %s in namespace: %s", name, nsname)
 
 	builder := LocalVolumeSetBuilder{
@@ -72,15 +77,15 @@ func PullLocalVolumeSet(apiClient *clients.Settings, name, nsname string) (*Loca
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the localVolumeSet is empty")
+		logging.V(100).Infof("The name of the localVolumeSet is empty")
 
-		builder.errorMsg = "localVolumeSet 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the localVolumeSet is empty")
+		logging.V(100).Infof("The namespace of the localVolumeSet is empty")
 
-		builder.errorMsg = "localVolumeSet 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'nsname' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -98,7 +103,7 @@ func (builder *LocalVolumeSetBuilder) Get() (*lsoV1alpha1.LocalVolumeSet, error)
 		return nil, err
 	}
 
-	glog.V(100).Infof("Pulling existing localVolumeSet with name %s under namespace %s from cluster",
+	logging.V(100).Infof("Pulling existing localVolumeSet with name %s under namespace %s from cluster",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	lvs := &lsoV1alpha1.LocalVolumeSet{}
@@ -120,7 +125,7 @@ func (builder *LocalVolumeSetBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if localVolumeSet %s exists in namespace %s",
+	logging.V(100).Infof("Checking if localVolumeSet %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -135,7 +140,7 @@ func (builder *LocalVolumeSetBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the localVolumeSet %s in namespace %s",
+	logging.V(100).Infof("Deleting the localVolumeSet %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -159,7 +164,7 @@ func (builder *LocalVolumeSetBuilder) Create() (*LocalVolumeSetBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the LocalVolumeSetBuilder %s in namespace %s",
+	logging.V(100).Infof("Creating the LocalVolumeSetBuilder %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -179,7 +184,7 @@ func (builder *LocalVolumeSetBuilder) Update() (*LocalVolumeSetBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the LocalVolumeSetBuilder %s in namespace %s",
+	logging.V(100).Infof("Updating the LocalVolumeSetBuilder %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -195,28 +200,311 @@ func (builder *LocalVolumeSetBuilder) Update() (*LocalVolumeSetBuilder, error) {
 	return builder, err
 }
 
+// WithStorageClassName sets the storageclass of the persistentvolumes created from the discovered devices.
+func (builder *LocalVolumeSetBuilder) WithStorageClassName(storageClassName string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting storageClassName %s in localVolumeSet %s in namespace %s",
+		storageClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if storageClassName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("localVolumeSet 'storageClassName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.StorageClassName = storageClassName
+
+	return builder
+}
+
+// WithVolumeMode sets the volume mode of the created persistentvolumes. Filesystem volumes are formatted with
+// fsType, which is ignored for Block volumes.
+func (builder *LocalVolumeSetBuilder) WithVolumeMode(
+	volumeMode lsoV1.PersistentVolumeMode, fsType string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting volumeMode %s and fsType %s in localVolumeSet %s in namespace %s",
+		volumeMode, fsType, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := validateVolumeMode(volumeMode)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet %w", err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.VolumeMode = volumeMode
+	builder.Definition.Spec.FSType = fsType
+
+	return builder
+}
+
+// WithMaxDeviceCount limits the number of devices used on every node.
+func (builder *LocalVolumeSetBuilder) WithMaxDeviceCount(maxDeviceCount int32) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting maxDeviceCount %d in localVolumeSet %s in namespace %s",
+		maxDeviceCount, builder.Definition.Name, builder.Definition.Namespace)
+
+	if maxDeviceCount < 1 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("localVolumeSet 'maxDeviceCount' must be greater than 0"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxDeviceCount = &maxDeviceCount
+
+	return builder
+}
+
+// WithNodeSelector restricts the device discovery to the nodes selected by nodeSelector.
+func (builder *LocalVolumeSetBuilder) WithNodeSelector(nodeSelector corev1.NodeSelector) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting nodeSelector in localVolumeSet %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("localVolumeSet 'nodeSelector' must have at least one node selector term"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = &nodeSelector
+
+	return builder
+}
+
+// WithTolerations sets the tolerations of the diskmaker pods of the localVolumeSet.
+func (builder *LocalVolumeSetBuilder) WithTolerations(tolerations ...corev1.Toleration) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting tolerations %v in localVolumeSet %s in namespace %s",
+		tolerations, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(tolerations) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'tolerations' cannot be empty list"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = tolerations
+
+	return builder
+}
+
+// WithDeviceTypes only includes devices of the given types, e.g. disk or part.
+func (builder *LocalVolumeSetBuilder) WithDeviceTypes(deviceTypes ...lsoV1alpha1.DeviceType) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting deviceTypes %v in localVolumeSet %s in namespace %s",
+		deviceTypes, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(deviceTypes) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'deviceTypes' cannot be empty list"))
+
+		return builder
+	}
+
+	builder.getDeviceInclusionSpec().DeviceTypes = deviceTypes
+
+	return builder
+}
+
+// WithDeviceMechanicalProperties only includes rotational or non rotational devices.
+func (builder *LocalVolumeSetBuilder) WithDeviceMechanicalProperties(
+	properties ...lsoV1alpha1.DeviceMechanicalProperty) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting deviceMechanicalProperties %v in localVolumeSet %s in namespace %s",
+		properties, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(properties) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("localVolumeSet 'deviceMechanicalProperties' cannot be empty list"))
+
+		return builder
+	}
+
+	builder.getDeviceInclusionSpec().DeviceMechanicalProperties = properties
+
+	return builder
+}
+
+// WithDeviceSizeRange only includes devices whose size is within minSize and maxSize, e.g. "10Gi". An empty bound is
+// not applied.
+func (builder *LocalVolumeSetBuilder) WithDeviceSizeRange(minSize, maxSize string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting device size range [%s, %s] in localVolumeSet %s in namespace %s",
+		minSize, maxSize, builder.Definition.Name, builder.Definition.Namespace)
+
+	if minSize == "" && maxSize == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("localVolumeSet 'minSize' and 'maxSize' cannot both be empty"))
+
+		return builder
+	}
+
+	var minQuantity, maxQuantity *resource.Quantity
+
+	if minSize != "" {
+		quantity, err := resource.ParseQuantity(minSize)
+		if err != nil {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("localVolumeSet 'minSize' %s is invalid: %w", minSize, err))
+
+			return builder
+		}
+
+		minQuantity = &quantity
+	}
+
+	if maxSize != "" {
+		quantity, err := resource.ParseQuantity(maxSize)
+		if err != nil {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("localVolumeSet 'maxSize' %s is invalid: %w", maxSize, err))
+
+			return builder
+		}
+
+		maxQuantity = &quantity
+	}
+
+	if minQuantity != nil && maxQuantity != nil && minQuantity.Cmp(*maxQuantity) > 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("localVolumeSet 'minSize' %s cannot be greater than 'maxSize' %s", minSize, maxSize))
+
+		return builder
+	}
+
+	inclusionSpec := builder.getDeviceInclusionSpec()
+	inclusionSpec.MinSize = minQuantity
+	inclusionSpec.MaxSize = maxQuantity
+
+	return builder
+}
+
+// WithDeviceModels only includes devices whose model contains one of the given values.
+func (builder *LocalVolumeSetBuilder) WithDeviceModels(models ...string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting device models %v in localVolumeSet %s in namespace %s",
+		models, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(models) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'models' cannot be empty list"))
+
+		return builder
+	}
+
+	builder.getDeviceInclusionSpec().Models = models
+
+	return builder
+}
+
+// WithDeviceVendors only includes devices whose vendor contains one of the given values.
+func (builder *LocalVolumeSetBuilder) WithDeviceVendors(vendors ...string) *LocalVolumeSetBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting device vendors %v in localVolumeSet %s in namespace %s",
+		vendors, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(vendors) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("localVolumeSet 'vendors' cannot be empty list"))
+
+		return builder
+	}
+
+	builder.getDeviceInclusionSpec().Vendors = vendors
+
+	return builder
+}
+
+// GetPersistentVolumes returns the persistentvolumes the local-storage operator created for the localVolumeSet.
+func (builder *LocalVolumeSetBuilder) GetPersistentVolumes() ([]corev1.PersistentVolume, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	return listOwnedPersistentVolumes(
+		builder.apiClient, localVolumeSetKind, builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// WaitUntilPersistentVolumesCreated waits for the duration of the defined timeout or until the local-storage
+// operator created at least expectedCount persistentvolumes from the devices discovered for the localVolumeSet.
+func (builder *LocalVolumeSetBuilder) WaitUntilPersistentVolumesCreated(
+	expectedCount int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	return waitForOwnedPersistentVolumes(builder.apiClient, localVolumeSetKind,
+		builder.Definition.Name, builder.Definition.Namespace, expectedCount, timeout)
+}
+
+// getDeviceInclusionSpec returns the device inclusion spec of the definition, initializing it when unset.
+func (builder *LocalVolumeSetBuilder) getDeviceInclusionSpec() *lsoV1alpha1.DeviceInclusionSpec {
+	if builder.Definition.Spec.DeviceInclusionSpec == nil {
+		builder.Definition.Spec.DeviceInclusionSpec = &lsoV1alpha1.DeviceInclusionSpec{}
+	}
+
+	return builder.Definition.Spec.DeviceInclusionSpec
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *LocalVolumeSetBuilder) validate() (bool, error) {
 	resourceCRD := "LocalVolumeSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
 	return true, nil
 }
@@ -0,0 +1,136 @@
+package lso
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	lsoV1 "github.com/openshift/local-storage-operator/api/v1"
+	lsoV1alpha1 "github.com/openshift/local-storage-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultLocalVolumeSetName = "local-disk-set"
+
+func TestLocalVolumeSetWithSpec(t *testing.T) {
+	nodeSelector := corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+		MatchExpressions: []corev1.NodeSelectorRequirement{{
+			Key:      "cluster.ocs.openshift.io/openshift-storage",
+			Operator: corev1.NodeSelectorOpExists,
+		}},
+	}}}
+
+	testBuilder := buildValidLocalVolumeSetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithStorageClassName(defaultLocalStorageClass).
+		WithVolumeMode(lsoV1.PersistentVolumeBlock, "").
+		WithMaxDeviceCount(2).
+		WithNodeSelector(nodeSelector)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, defaultLocalStorageClass, testBuilder.Definition.Spec.StorageClassName)
+	assert.Equal(t, lsoV1.PersistentVolumeBlock, testBuilder.Definition.Spec.VolumeMode)
+	assert.Equal(t, int32(2), *testBuilder.Definition.Spec.MaxDeviceCount)
+	assert.Equal(t, &nodeSelector, testBuilder.Definition.Spec.NodeSelector)
+
+	testBuilder = testBuilder.WithMaxDeviceCount(0)
+	assert.EqualError(t, testBuilder.errorMsg, "localVolumeSet 'maxDeviceCount' must be greater than 0")
+}
+
+func TestLocalVolumeSetWithDeviceInclusionSpec(t *testing.T) {
+	testBuilder := buildValidLocalVolumeSetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithDeviceTypes(lsoV1alpha1.RawDisk, lsoV1alpha1.Partition).
+		WithDeviceMechanicalProperties(lsoV1alpha1.NonRotational).
+		WithDeviceSizeRange("100Gi", "").
+		WithDeviceModels("SAMSUNG").
+		WithDeviceVendors("ATA")
+
+	assert.Nil(t, testBuilder.errorMsg)
+
+	inclusionSpec := testBuilder.Definition.Spec.DeviceInclusionSpec
+	assert.Equal(t, []lsoV1alpha1.DeviceType{lsoV1alpha1.RawDisk, lsoV1alpha1.Partition}, inclusionSpec.DeviceTypes)
+	assert.Equal(t,
+		[]lsoV1alpha1.DeviceMechanicalProperty{lsoV1alpha1.NonRotational}, inclusionSpec.DeviceMechanicalProperties)
+	assert.Equal(t, "100Gi", inclusionSpec.MinSize.String())
+	assert.Nil(t, inclusionSpec.MaxSize)
+	assert.Equal(t, []string{"SAMSUNG"}, inclusionSpec.Models)
+	assert.Equal(t, []string{"ATA"}, inclusionSpec.Vendors)
+}
+
+func TestLocalVolumeSetWithDeviceSizeRange(t *testing.T) {
+	testCases := []struct {
+		minSize       string
+		maxSize       string
+		expectedError string
+	}{
+		{
+			minSize:       "10Gi",
+			maxSize:       "1Ti",
+			expectedError: "",
+		},
+		{
+			minSize:       "",
+			maxSize:       "",
+			expectedError: "localVolumeSet 'minSize' and 'maxSize' cannot both be empty",
+		},
+		{
+			minSize: "ten",
+			maxSize: "",
+			expectedError: "localVolumeSet 'minSize' ten is invalid: quantities must match the regular expression " +
+				"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			minSize:       "1Ti",
+			maxSize:       "10Gi",
+			expectedError: "localVolumeSet 'minSize' 1Ti cannot be greater than 'maxSize' 10Gi",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLocalVolumeSetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithDeviceSizeRange(testCase.minSize, testCase.maxSize)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.minSize, testBuilder.Definition.Spec.DeviceInclusionSpec.MinSize.String())
+			assert.Equal(t, testCase.maxSize, testBuilder.Definition.Spec.DeviceInclusionSpec.MaxSize.String())
+		}
+	}
+}
+
+func TestLocalVolumeSetWaitUntilPersistentVolumesCreated(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		buildDummyLocalVolumeSet(),
+		buildDummyOwnedPV("local-pv-1", localVolumeSetKind, defaultLocalVolumeSetName),
+		buildDummyOwnedPV("local-pv-2", localVolumeKind, defaultLocalVolumeSetName),
+	}})
+
+	testBuilder, err := PullLocalVolumeSet(testSettings, defaultLocalVolumeSetName, defaultLocalVolumeNamespace)
+	assert.Nil(t, err)
+
+	err = testBuilder.WaitUntilPersistentVolumesCreated(1, time.Second)
+	assert.Nil(t, err)
+
+	persistentVolumes, err := testBuilder.GetPersistentVolumes()
+	assert.Nil(t, err)
+	assert.Len(t, persistentVolumes, 1)
+	assert.Equal(t, "local-pv-1", persistentVolumes[0].Name)
+}
+
+func buildValidLocalVolumeSetBuilder(apiClient *clients.Settings) *LocalVolumeSetBuilder {
+	return NewLocalVolumeSetBuilder(apiClient, defaultLocalVolumeSetName, defaultLocalVolumeNamespace)
+}
+
+func buildDummyLocalVolumeSet() *lsoV1alpha1.LocalVolumeSet {
+	return &lsoV1alpha1.LocalVolumeSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultLocalVolumeSetName,
+			Namespace: defaultLocalVolumeNamespace,
+		},
+		Spec: lsoV1alpha1.LocalVolumeSetSpec{
+			StorageClassName: defaultLocalStorageClass,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package lso
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	lsoV1 "github.com/openshift/local-storage-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	localVolumeKind    = "LocalVolume"
+	localVolumeSetKind = "LocalVolumeSet"
+
+	// The local-storage operator labels every persistentvolume it creates with the object owning the device.
+	pvOwnerKindLabel      = "storage.openshift.com/owner-kind"
+	pvOwnerNameLabel      = "storage.openshift.com/owner-name"
+	pvOwnerNamespaceLabel = "storage.openshift.com/owner-namespace"
+)
+
+// listOwnedPersistentVolumes returns the persistentvolumes created by the local-storage operator for the
+// LocalVolume or LocalVolumeSet of the given kind.
+func listOwnedPersistentVolumes(
+	apiClient *clients.Settings, ownerKind, name, nsname string) ([]corev1.PersistentVolume, error) {
+	logging.V(100).Infof("Listing persistentvolumes created for %s %s in namespace %s", ownerKind, name, nsname)
+
+	selector := labels.SelectorFromSet(labels.Set{
+		pvOwnerKindLabel:      ownerKind,
+		pvOwnerNameLabel:      name,
+		pvOwnerNamespaceLabel: nsname,
+	})
+
+	pvList, err := apiClient.PersistentVolumes().List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		logging.V(100).Infof("Failed to list persistentvolumes of %s %s in namespace %s due to %s",
+			ownerKind, name, nsname, err.Error())
+
+		return nil, err
+	}
+
+	return pvList.Items, nil
+}
+
+// waitForOwnedPersistentVolumes waits until at least expectedCount persistentvolumes were created for the
+// LocalVolume or LocalVolumeSet of the given kind.
+func waitForOwnedPersistentVolumes(
+	apiClient *clients.Settings, ownerKind, name, nsname string, expectedCount int, timeout time.Duration) error {
+	logging.V(100).Infof("Waiting for %d persistentvolumes to be created for %s %s in namespace %s",
+		expectedCount, ownerKind, name, nsname)
+
+	if expectedCount < 1 {
+		return fmt.Errorf("%s 'expectedCount' must be greater than 0", ownerKind)
+	}
+
+	createdCount := 0
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			persistentVolumes, err := listOwnedPersistentVolumes(apiClient, ownerKind, name, nsname)
+			if err != nil {
+				return false, nil
+			}
+
+			createdCount = len(persistentVolumes)
+
+			return createdCount >= expectedCount, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("%s %s in namespace %s has %d persistentvolumes, expected %d: %w",
+			ownerKind, name, nsname, createdCount, expectedCount, err)
+	}
+
+	return nil
+}
+
+// validateVolumeMode checks that the volume mode is one supported by the local-storage operator.
+func validateVolumeMode(volumeMode lsoV1.PersistentVolumeMode) error {
+	if volumeMode != lsoV1.PersistentVolumeBlock && volumeMode != lsoV1.PersistentVolumeFilesystem {
+		return fmt.Errorf("'volumeMode' %s is not supported, must be %s or %s",
+			volumeMode, lsoV1.PersistentVolumeBlock, lsoV1.PersistentVolumeFilesystem)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package lvms
+
+const (
+	// APIGroup represents the LVM Storage api group.
+	APIGroup = "lvm.topolvm.io"
+	// APIVersion represents the version of the LVM Storage api.
+	APIVersion = "v1alpha1"
+	// LVMClusterKind represents kind of LVMCluster object.
+	LVMClusterKind = "LVMCluster"
+	// LVMVolumeGroupNodeStatusKind represents kind of LVMVolumeGroupNodeStatus object.
+	LVMVolumeGroupNodeStatusKind = "LVMVolumeGroupNodeStatus"
+	// LVMSNamespace represents the namespace the LVM Storage operator is installed in by default.
+	LVMSNamespace = "openshift-storage"
+)
@@ -0,0 +1,628 @@
+package lvms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/lvms/lvmstypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// LVMClusterBuilder provides struct for the LVMCluster object containing connection to the cluster and the
+// LVMCluster definitions.
+type LVMClusterBuilder struct {
+	// LVMCluster definition. Used to create the LVMCluster object.
+	Definition *lvmstypes.LVMCluster
+	// Created LVMCluster object.
+	Object *lvmstypes.LVMCluster
+	// Used in functions that define or mutate LVMCluster definition. errorMsg is processed before the LVMCluster
+	// object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewLVMClusterBuilder creates a new instance of LVMClusterBuilder. At least one device class must be added with
+// WithDeviceClass before creating it.
+func NewLVMClusterBuilder(apiClient *clients.Settings, name, nsname string) *LVMClusterBuilder {
+	logging.V(100).Infof(
+		"Initializing new LVMCluster structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := LVMClusterBuilder{
+		apiClient: apiClient,
+		Definition: &lvmstypes.LVMCluster{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       LVMClusterKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the LVMCluster is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LVMCluster 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the LVMCluster is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LVMCluster 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullLVMCluster pulls existing LVMCluster from cluster.
+func PullLVMCluster(apiClient *clients.Settings, name, nsname string) (*LVMClusterBuilder, error) {
+	logging.V(100).Infof("Pulling existing LVMCluster name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("LVMCluster 'apiClient' cannot be empty")
+	}
+
+	builder := LVMClusterBuilder{
+		apiClient: apiClient,
+		Definition: &lvmstypes.LVMCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the LVMCluster is empty")
+
+		return nil, fmt.Errorf("LVMCluster 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the LVMCluster is empty")
+
+		return nil, fmt.Errorf("LVMCluster 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("LVMCluster object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithDeviceClass adds a device class, and so a volume group and its storage class, to the LVMCluster. Only one
+// device class can be the default one. Device selection and the thin pool are configured by name with the
+// WithDevicePaths, WithOptionalDevicePaths, WithForceWipeDevices, WithThinPoolConfig and WithDeviceClassNodeSelector
+// functions.
+func (builder *LVMClusterBuilder) WithDeviceClass(
+	name string, isDefault bool, fsType lvmstypes.DeviceFilesystemType) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding device class %s to LVMCluster %s in namespace %s",
+		name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LVMCluster device class 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if fsType != "" && fsType != lvmstypes.FilesystemTypeExt4 && fsType != lvmstypes.FilesystemTypeXFS {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"LVMCluster device class 'fsType' %s is not supported, must be %s or %s",
+			fsType, lvmstypes.FilesystemTypeExt4, lvmstypes.FilesystemTypeXFS))
+
+		return builder
+	}
+
+	for _, deviceClass := range builder.Definition.Spec.Storage.DeviceClasses {
+		if deviceClass.Name == name {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("LVMCluster device class %s already exists", name))
+
+			return builder
+		}
+
+		if isDefault && deviceClass.Default {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"LVMCluster device class %s cannot be default, device class %s is already default",
+				name, deviceClass.Name))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Storage.DeviceClasses = append(builder.Definition.Spec.Storage.DeviceClasses,
+		lvmstypes.DeviceClass{Name: name, Default: isDefault, FilesystemType: fsType})
+
+	return builder
+}
+
+// WithDevicePaths sets the device paths which must be present on every node of the device class.
+func (builder *LVMClusterBuilder) WithDevicePaths(deviceClassName string, paths ...string) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting device paths %v of device class %s in LVMCluster %s in namespace %s",
+		paths, deviceClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(paths) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LVMCluster 'paths' cannot be empty list"))
+
+		return builder
+	}
+
+	deviceClass := builder.getDeviceClass(deviceClassName)
+	if deviceClass == nil {
+		return builder
+	}
+
+	if deviceClass.DeviceSelector == nil {
+		deviceClass.DeviceSelector = &lvmstypes.DeviceSelector{}
+	}
+
+	deviceClass.DeviceSelector.Paths = paths
+
+	return builder
+}
+
+// WithOptionalDevicePaths sets the device paths added to the device class volume group only on the nodes they are
+// present on.
+func (builder *LVMClusterBuilder) WithOptionalDevicePaths(
+	deviceClassName string, paths ...string) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting optional device paths %v of device class %s in LVMCluster %s in namespace %s",
+		paths, deviceClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(paths) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LVMCluster 'optionalPaths' cannot be empty list"))
+
+		return builder
+	}
+
+	deviceClass := builder.getDeviceClass(deviceClassName)
+	if deviceClass == nil {
+		return builder
+	}
+
+	if deviceClass.DeviceSelector == nil {
+		deviceClass.DeviceSelector = &lvmstypes.DeviceSelector{}
+	}
+
+	deviceClass.DeviceSelector.OptionalPaths = paths
+
+	return builder
+}
+
+// WithForceWipeDevices wipes the selected devices of the device class before adding them to the volume group. All
+// data on the devices is destroyed, so it should only be used on disks reserved for tests.
+func (builder *LVMClusterBuilder) WithForceWipeDevices(deviceClassName string) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Enabling force wipe of devices of device class %s in LVMCluster %s in namespace %s",
+		deviceClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	deviceClass := builder.getDeviceClass(deviceClassName)
+	if deviceClass == nil {
+		return builder
+	}
+
+	if deviceClass.DeviceSelector == nil || len(deviceClass.DeviceSelector.Paths)+
+		len(deviceClass.DeviceSelector.OptionalPaths) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"LVMCluster device class %s must select device paths before forcing their wipe", deviceClassName))
+
+		return builder
+	}
+
+	forceWipe := true
+	deviceClass.DeviceSelector.ForceWipeDevicesAndDestroyAllData = &forceWipe
+
+	return builder
+}
+
+// WithThinPoolConfig sets the thin pool created in the volume group of the device class.
+func (builder *LVMClusterBuilder) WithThinPoolConfig(
+	deviceClassName, poolName string, sizePercent, overprovisionRatio int) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting thin pool %s (sizePercent: %d, overprovisionRatio: %d) of device class %s "+
+		"in LVMCluster %s in namespace %s", poolName, sizePercent, overprovisionRatio, deviceClassName,
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if poolName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LVMCluster thin pool 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if sizePercent < 10 || sizePercent > 90 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"LVMCluster thin pool 'sizePercent' %d is invalid, must be between 10 and 90", sizePercent))
+
+		return builder
+	}
+
+	if overprovisionRatio < 1 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"LVMCluster thin pool 'overprovisionRatio' %d is invalid, must be at least 1", overprovisionRatio))
+
+		return builder
+	}
+
+	deviceClass := builder.getDeviceClass(deviceClassName)
+	if deviceClass == nil {
+		return builder
+	}
+
+	deviceClass.ThinPoolConfig = &lvmstypes.ThinPoolConfig{
+		Name:               poolName,
+		SizePercent:        sizePercent,
+		OverprovisionRatio: overprovisionRatio,
+	}
+
+	return builder
+}
+
+// WithDeviceClassNodeSelector restricts the volume group of the device class to the nodes selected by nodeSelector.
+func (builder *LVMClusterBuilder) WithDeviceClassNodeSelector(
+	deviceClassName string, nodeSelector corev1.NodeSelector) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting node selector of device class %s in LVMCluster %s in namespace %s",
+		deviceClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("LVMCluster 'nodeSelector' must have at least one node selector term"))
+
+		return builder
+	}
+
+	deviceClass := builder.getDeviceClass(deviceClassName)
+	if deviceClass == nil {
+		return builder
+	}
+
+	deviceClass.NodeSelector = &nodeSelector
+
+	return builder
+}
+
+// WithTolerations sets the tolerations of the vg-manager pods, which create the volume groups on the nodes.
+func (builder *LVMClusterBuilder) WithTolerations(tolerations ...corev1.Toleration) *LVMClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting tolerations %v in LVMCluster %s in namespace %s",
+		tolerations, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(tolerations) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LVMCluster 'tolerations' cannot be empty list"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Tolerations = tolerations
+
+	return builder
+}
+
+// Get returns LVMCluster object if found.
+func (builder *LVMClusterBuilder) Get() (*lvmstypes.LVMCluster, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting LVMCluster object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetLVMClusterGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("LVMCluster object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertLVMClusterToStructured(unsObject)
+}
+
+// Exists checks whether the given LVMCluster exists.
+func (builder *LVMClusterBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if LVMCluster %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an LVMCluster in the cluster and stores the created object in struct.
+func (builder *LVMClusterBuilder) Create() (*LVMClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the LVMCluster %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.Storage.DeviceClasses) == 0 {
+		return builder, fmt.Errorf("LVMCluster %s in namespace %s must have at least one device class",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	unstructuredLVMCluster, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured LVMCluster to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetLVMClusterGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredLVMCluster}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create LVMCluster %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertLVMClusterToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing LVMCluster object with the definition in builder.
+func (builder *LVMClusterBuilder) Update() (*LVMClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the LVMCluster %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update LVMCluster, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredLVMCluster, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured LVMCluster to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetLVMClusterGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredLVMCluster}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertLVMClusterToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes LVMCluster object from a cluster.
+func (builder *LVMClusterBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the LVMCluster object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetLVMClusterGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete LVMCluster: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsReady returns true when the LVMCluster reports all of its volume groups ready.
+func (builder *LVMClusterBuilder) IsReady() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if LVMCluster %s in namespace %s is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get LVMCluster %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return builder.Object.Status.State == lvmstypes.LVMStatusReady, nil
+}
+
+// WaitUntilReady waits for the duration of the defined timeout or until the LVMCluster is Ready. On timeout, the
+// last observed state is included in the returned error.
+func (builder *LVMClusterBuilder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until LVMCluster %s in namespace %s is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			ready, err := builder.IsReady()
+			if err != nil {
+				logging.V(100).Infof("Failed to check LVMCluster %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return ready, nil
+		})
+
+	if err != nil && builder.Object != nil {
+		return fmt.Errorf("LVMCluster %s in namespace %s is not ready, last state %q: %w",
+			builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.State, err)
+	}
+
+	return err
+}
+
+// GetDeviceClassNodeStatus returns the volume group status of the device class on every node, keyed by node name.
+func (builder *LVMClusterBuilder) GetDeviceClassNodeStatus(
+	deviceClassName string) (map[string]lvmstypes.VGStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting node status of device class %s in LVMCluster %s in namespace %s",
+		deviceClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("LVMCluster object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for _, deviceClassStatus := range builder.Object.Status.DeviceClassStatuses {
+		if deviceClassStatus.Name != deviceClassName {
+			continue
+		}
+
+		nodeStatuses := make(map[string]lvmstypes.VGStatus, len(deviceClassStatus.NodeStatus))
+
+		for _, nodeStatus := range deviceClassStatus.NodeStatus {
+			nodeStatuses[nodeStatus.Node] = nodeStatus.VGStatus
+		}
+
+		return nodeStatuses, nil
+	}
+
+	return nil, fmt.Errorf("LVMCluster %s in namespace %s has no status for device class %s",
+		builder.Definition.Name, builder.Definition.Namespace, deviceClassName)
+}
+
+// GetLVMClusterGVR returns LVMCluster's GroupVersionResource which could be used for Clean function.
+func GetLVMClusterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "lvmclusters"}
+}
+
+// getDeviceClass returns a pointer to the device class with the given name in the definition. If it is missing, the
+// error is recorded in the builder and nil is returned.
+func (builder *LVMClusterBuilder) getDeviceClass(deviceClassName string) *lvmstypes.DeviceClass {
+	for index := range builder.Definition.Spec.Storage.DeviceClasses {
+		if builder.Definition.Spec.Storage.DeviceClasses[index].Name == deviceClassName {
+			return &builder.Definition.Spec.Storage.DeviceClasses[index]
+		}
+	}
+
+	builder.errorMsg = errors.Join(builder.errorMsg,
+		fmt.Errorf("LVMCluster device class %s does not exist", deviceClassName))
+
+	return nil
+}
+
+// convertLVMClusterToStructured converts the unstructured object returned by the dynamic client to an LVMCluster.
+func convertLVMClusterToStructured(unsObject *unstructured.Unstructured) (*lvmstypes.LVMCluster, error) {
+	lvmCluster := &lvmstypes.LVMCluster{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, lvmCluster)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to LVMCluster object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return lvmCluster, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *LVMClusterBuilder) validate() (bool, error) {
+	resourceCRD := "LVMCluster"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
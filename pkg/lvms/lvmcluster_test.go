@@ -0,0 +1,390 @@
+package lvms
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/lvms/lvmstypes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	lvmClusterGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    LVMClusterKind,
+	}
+	defaultLVMClusterName = "test-lvmcluster"
+	defaultDeviceClass    = "vg1"
+)
+
+func TestNewLVMClusterBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultLVMClusterName,
+			namespace:     LVMSNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     LVMSNamespace,
+			expectedError: "LVMCluster 'name' cannot be empty",
+		},
+		{
+			name:          defaultLVMClusterName,
+			namespace:     "",
+			expectedError: "LVMCluster 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewLVMClusterBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullLVMCluster(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultLVMClusterName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("LVMCluster 'name' cannot be empty"),
+		},
+		{
+			name:                defaultLVMClusterName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"LVMCluster object %s doesn't exist in namespace %s", defaultLVMClusterName, LVMSNamespace),
+		},
+		{
+			name:                defaultLVMClusterName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("LVMCluster 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyLVMCluster(lvmstypes.LVMStatusReady))
+		}
+
+		if testCase.client {
+			testSettings = buildLVMClusterTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullLVMCluster(testSettings, testCase.name, LVMSNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultDeviceClass, testBuilder.Definition.Spec.Storage.DeviceClasses[0].Name)
+		}
+	}
+}
+
+func TestLVMClusterWithDeviceClass(t *testing.T) {
+	testCases := []struct {
+		deviceClasses []string
+		fsType        lvmstypes.DeviceFilesystemType
+		expectedError string
+	}{
+		{
+			deviceClasses: []string{defaultDeviceClass},
+			fsType:        lvmstypes.FilesystemTypeXFS,
+			expectedError: "",
+		},
+		{
+			deviceClasses: []string{""},
+			fsType:        lvmstypes.FilesystemTypeXFS,
+			expectedError: "LVMCluster device class 'name' cannot be empty",
+		},
+		{
+			deviceClasses: []string{defaultDeviceClass},
+			fsType:        "btrfs",
+			expectedError: "LVMCluster device class 'fsType' btrfs is not supported, must be ext4 or xfs",
+		},
+		{
+			deviceClasses: []string{defaultDeviceClass, defaultDeviceClass},
+			fsType:        lvmstypes.FilesystemTypeExt4,
+			expectedError: "LVMCluster device class vg1 already exists",
+		},
+		{
+			deviceClasses: []string{defaultDeviceClass, "vg2"},
+			fsType:        lvmstypes.FilesystemTypeExt4,
+			expectedError: "LVMCluster device class vg2 cannot be default, device class vg1 is already default",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLVMClusterBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		for _, deviceClass := range testCase.deviceClasses {
+			testBuilder = testBuilder.WithDeviceClass(deviceClass, true, testCase.fsType)
+		}
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Len(t, testBuilder.Definition.Spec.Storage.DeviceClasses, len(testCase.deviceClasses))
+		}
+	}
+}
+
+func TestLVMClusterWithDeviceSelector(t *testing.T) {
+	testBuilder := buildValidLVMClusterBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithDeviceClass(defaultDeviceClass, true, "").
+		WithDevicePaths(defaultDeviceClass, "/dev/sdb").
+		WithOptionalDevicePaths(defaultDeviceClass, "/dev/sdc", "/dev/sdd").
+		WithForceWipeDevices(defaultDeviceClass)
+
+	assert.Nil(t, testBuilder.errorMsg)
+
+	deviceSelector := testBuilder.Definition.Spec.Storage.DeviceClasses[0].DeviceSelector
+	assert.Equal(t, []string{"/dev/sdb"}, deviceSelector.Paths)
+	assert.Equal(t, []string{"/dev/sdc", "/dev/sdd"}, deviceSelector.OptionalPaths)
+	assert.True(t, *deviceSelector.ForceWipeDevicesAndDestroyAllData)
+
+	testBuilder = buildValidLVMClusterBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithDeviceClass(defaultDeviceClass, true, "").
+		WithForceWipeDevices(defaultDeviceClass)
+	assert.EqualError(t, testBuilder.errorMsg,
+		"LVMCluster device class vg1 must select device paths before forcing their wipe")
+
+	testBuilder = buildValidLVMClusterBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithDevicePaths("missing", "/dev/sdb")
+	assert.EqualError(t, testBuilder.errorMsg, "LVMCluster device class missing does not exist")
+}
+
+func TestLVMClusterWithThinPoolConfig(t *testing.T) {
+	testCases := []struct {
+		poolName           string
+		sizePercent        int
+		overprovisionRatio int
+		expectedError      string
+	}{
+		{
+			poolName:           "thin-pool-1",
+			sizePercent:        90,
+			overprovisionRatio: 10,
+			expectedError:      "",
+		},
+		{
+			poolName:           "",
+			sizePercent:        90,
+			overprovisionRatio: 10,
+			expectedError:      "LVMCluster thin pool 'name' cannot be empty",
+		},
+		{
+			poolName:           "thin-pool-1",
+			sizePercent:        95,
+			overprovisionRatio: 10,
+			expectedError:      "LVMCluster thin pool 'sizePercent' 95 is invalid, must be between 10 and 90",
+		},
+		{
+			poolName:           "thin-pool-1",
+			sizePercent:        90,
+			overprovisionRatio: 0,
+			expectedError:      "LVMCluster thin pool 'overprovisionRatio' 0 is invalid, must be at least 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLVMClusterBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithDeviceClass(defaultDeviceClass, true, "").
+			WithThinPoolConfig(defaultDeviceClass, testCase.poolName, testCase.sizePercent, testCase.overprovisionRatio)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, &lvmstypes.ThinPoolConfig{
+				Name:               testCase.poolName,
+				SizePercent:        testCase.sizePercent,
+				OverprovisionRatio: testCase.overprovisionRatio,
+			}, testBuilder.Definition.Spec.Storage.DeviceClasses[0].ThinPoolConfig)
+		}
+	}
+}
+
+func TestLVMClusterWithDeviceClassNodeSelector(t *testing.T) {
+	nodeSelector := corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+		MatchExpressions: []corev1.NodeSelectorRequirement{{
+			Key:      "kubernetes.io/hostname",
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{"worker-0"},
+		}},
+	}}}
+
+	testBuilder := buildValidLVMClusterBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithDeviceClass(defaultDeviceClass, true, "").
+		WithDeviceClassNodeSelector(defaultDeviceClass, nodeSelector)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, &nodeSelector, testBuilder.Definition.Spec.Storage.DeviceClasses[0].NodeSelector)
+
+	testBuilder = testBuilder.WithDeviceClassNodeSelector(defaultDeviceClass, corev1.NodeSelector{})
+	assert.EqualError(t, testBuilder.errorMsg, "LVMCluster 'nodeSelector' must have at least one node selector term")
+}
+
+func TestLVMClusterCreate(t *testing.T) {
+	testCases := []struct {
+		withDeviceClass bool
+		expectedError   error
+	}{
+		{
+			withDeviceClass: true,
+			expectedError:   nil,
+		},
+		{
+			withDeviceClass: false,
+			expectedError: fmt.Errorf("LVMCluster %s in namespace %s must have at least one device class",
+				defaultLVMClusterName, LVMSNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLVMClusterBuilder(buildLVMClusterTestClientWithDummyObject(nil))
+
+		if testCase.withDeviceClass {
+			testBuilder = testBuilder.WithDeviceClass(defaultDeviceClass, true, lvmstypes.FilesystemTypeXFS)
+		}
+
+		testBuilder, err := testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultLVMClusterName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestLVMClusterDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyLVMCluster(lvmstypes.LVMStatusReady))
+		}
+
+		testBuilder := buildValidLVMClusterBuilder(buildLVMClusterTestClientWithDummyObject(runtimeObjects))
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+	}
+}
+
+func TestLVMClusterWaitUntilReady(t *testing.T) {
+	testCases := []struct {
+		state         lvmstypes.LVMStateType
+		expectedError string
+	}{
+		{
+			state:         lvmstypes.LVMStatusReady,
+			expectedError: "",
+		},
+		{
+			state: lvmstypes.LVMStatusProgressing,
+			expectedError: fmt.Sprintf("LVMCluster %s in namespace %s is not ready, last state \"Progressing\": "+
+				"context deadline exceeded", defaultLVMClusterName, LVMSNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidLVMClusterBuilder(buildLVMClusterTestClientWithDummyObject(
+			[]runtime.Object{buildDummyLVMCluster(testCase.state)}))
+
+		err := testBuilder.WaitUntilReady(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestLVMClusterGetDeviceClassNodeStatus(t *testing.T) {
+	testBuilder := buildValidLVMClusterBuilder(buildLVMClusterTestClientWithDummyObject(
+		[]runtime.Object{buildDummyLVMCluster(lvmstypes.LVMStatusReady)}))
+
+	nodeStatuses, err := testBuilder.GetDeviceClassNodeStatus(defaultDeviceClass)
+	assert.Nil(t, err)
+	assert.Equal(t, lvmstypes.VGStatusReady, nodeStatuses["worker-0"].Status)
+	assert.Equal(t, []string{"/dev/sdb"}, nodeStatuses["worker-0"].Devices)
+
+	_, err = testBuilder.GetDeviceClassNodeStatus("vg2")
+	assert.EqualError(t, err, fmt.Sprintf("LVMCluster %s in namespace %s has no status for device class vg2",
+		defaultLVMClusterName, LVMSNamespace))
+}
+
+func buildValidLVMClusterBuilder(apiClient *clients.Settings) *LVMClusterBuilder {
+	return NewLVMClusterBuilder(apiClient, defaultLVMClusterName, LVMSNamespace)
+}
+
+func buildDummyLVMCluster(state lvmstypes.LVMStateType) *lvmstypes.LVMCluster {
+	return &lvmstypes.LVMCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultLVMClusterName,
+			Namespace: LVMSNamespace,
+		},
+		Spec: lvmstypes.LVMClusterSpec{
+			Storage: lvmstypes.Storage{
+				DeviceClasses: []lvmstypes.DeviceClass{{Name: defaultDeviceClass, Default: true}},
+			},
+		},
+		Status: lvmstypes.LVMClusterStatus{
+			Ready: state == lvmstypes.LVMStatusReady,
+			State: state,
+			DeviceClassStatuses: []lvmstypes.DeviceClassStatus{{
+				Name: defaultDeviceClass,
+				NodeStatus: []lvmstypes.NodeStatus{{
+					Node: "worker-0",
+					VGStatus: lvmstypes.VGStatus{
+						Name:    defaultDeviceClass,
+						Status:  lvmstypes.VGStatusReady,
+						Devices: []string{"/dev/sdb"},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+func buildLVMClusterTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{lvmClusterGVK},
+	})
+}
@@ -0,0 +1,224 @@
+package lvmstypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LVMStateType is the overall state of an LVMCluster.
+type LVMStateType string
+
+const (
+	// LVMStatusProgressing means the LVMCluster is being reconciled.
+	LVMStatusProgressing LVMStateType = "Progressing"
+	// LVMStatusDegraded means some of the volume groups of the LVMCluster are not healthy.
+	LVMStatusDegraded LVMStateType = "Degraded"
+	// LVMStatusFailed means the LVMCluster could not be reconciled.
+	LVMStatusFailed LVMStateType = "Failed"
+	// LVMStatusReady means all volume groups of the LVMCluster are ready.
+	LVMStatusReady LVMStateType = "Ready"
+	// LVMStatusUnknown means the state of the LVMCluster could not be determined.
+	LVMStatusUnknown LVMStateType = "Unknown"
+)
+
+// DeviceFilesystemType is the filesystem created on the logical volumes of a device class.
+type DeviceFilesystemType string
+
+const (
+	// FilesystemTypeExt4 formats logical volumes with ext4.
+	FilesystemTypeExt4 DeviceFilesystemType = "ext4"
+	// FilesystemTypeXFS formats logical volumes with xfs.
+	FilesystemTypeXFS DeviceFilesystemType = "xfs"
+)
+
+// DeviceSelector selects the disks used to build the volume group of a device class.
+type DeviceSelector struct {
+	// paths are the device paths which must be present on every selected node.
+	Paths []string `json:"paths,omitempty"`
+	// optionalPaths are device paths added to the volume group only when present.
+	OptionalPaths []string `json:"optionalPaths,omitempty"`
+	// forceWipeDevicesAndDestroyAllData wipes the selected devices before adding them to the volume group.
+	ForceWipeDevicesAndDestroyAllData *bool `json:"forceWipeDevicesAndDestroyAllData,omitempty"`
+}
+
+// ThinPoolConfig defines the thin pool created in the volume group of a device class.
+type ThinPoolConfig struct {
+	// name of the thin pool.
+	Name string `json:"name"`
+	// sizePercent is the percentage of the volume group used by the thin pool.
+	SizePercent int `json:"sizePercent,omitempty"`
+	// overprovisionRatio is the factor by which the thin pool can be overprovisioned.
+	OverprovisionRatio int `json:"overprovisionRatio"`
+}
+
+// DeviceClass defines a volume group and the storage class backed by it.
+type DeviceClass struct {
+	// name of the device class, also used as the volume group name.
+	Name string `json:"name,omitempty"`
+	// deviceSelector selects the disks of the volume group. All available disks are used when unset.
+	DeviceSelector *DeviceSelector `json:"deviceSelector,omitempty"`
+	// nodeSelector selects the nodes the volume group is created on.
+	NodeSelector *corev1.NodeSelector `json:"nodeSelector,omitempty"`
+	// thinPoolConfig defines the thin pool of the volume group.
+	ThinPoolConfig *ThinPoolConfig `json:"thinPoolConfig,omitempty"`
+	// default marks the storage class of the device class as the cluster default.
+	Default bool `json:"default,omitempty"`
+	// fstype is the filesystem of the logical volumes.
+	FilesystemType DeviceFilesystemType `json:"fstype,omitempty"`
+}
+
+// Storage contains the device classes of an LVMCluster.
+type Storage struct {
+	// deviceClasses are the volume groups to create on the selected nodes.
+	DeviceClasses []DeviceClass `json:"deviceClasses,omitempty"`
+}
+
+// LVMClusterSpec defines the desired state of LVMCluster.
+type LVMClusterSpec struct {
+	// tolerations applied to the vg-manager daemonset.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// storage describes the device classes to configure.
+	Storage Storage `json:"storage,omitempty"`
+}
+
+// NodeStatus is the volume group status of a device class on a node.
+type NodeStatus struct {
+	VGStatus `json:",inline"`
+	// node is the name of the node.
+	Node string `json:"node,omitempty"`
+}
+
+// DeviceClassStatus is the status of a device class across the nodes it was created on.
+type DeviceClassStatus struct {
+	// name of the device class.
+	Name string `json:"name,omitempty"`
+	// nodeStatus is the per node status of the device class.
+	NodeStatus []NodeStatus `json:"nodeStatus,omitempty"`
+}
+
+// LVMClusterStatus defines the observed state of LVMCluster.
+type LVMClusterStatus struct {
+	// ready is true once all volume groups are ready.
+	Ready bool `json:"ready,omitempty"`
+	// state is the overall state of the LVMCluster.
+	State LVMStateType `json:"state,omitempty"`
+	// deviceClassStatuses are the statuses of the device classes.
+	DeviceClassStatuses []DeviceClassStatus `json:"deviceClassStatuses,omitempty"`
+	// conditions are the conditions of the LVMCluster.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// LVMCluster is the Schema for the lvmclusters API.
+type LVMCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LVMClusterSpec   `json:"spec,omitempty"`
+	Status LVMClusterStatus `json:"status,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *DeviceClass) DeepCopyInto(out *DeviceClass) {
+	*out = *in
+
+	if in.DeviceSelector != nil {
+		out.DeviceSelector = &DeviceSelector{
+			Paths:         copyStrings(in.DeviceSelector.Paths),
+			OptionalPaths: copyStrings(in.DeviceSelector.OptionalPaths),
+		}
+
+		if in.DeviceSelector.ForceWipeDevicesAndDestroyAllData != nil {
+			forceWipe := *in.DeviceSelector.ForceWipeDevicesAndDestroyAllData
+			out.DeviceSelector.ForceWipeDevicesAndDestroyAllData = &forceWipe
+		}
+	}
+
+	if in.NodeSelector != nil {
+		out.NodeSelector = in.NodeSelector.DeepCopy()
+	}
+
+	if in.ThinPoolConfig != nil {
+		thinPoolConfig := *in.ThinPoolConfig
+		out.ThinPoolConfig = &thinPoolConfig
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LVMCluster.
+func (in *LVMCluster) DeepCopy() *LVMCluster {
+	if in == nil {
+		return nil
+	}
+
+	out := new(LVMCluster)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.Tolerations != nil {
+		out.Spec.Tolerations = make([]corev1.Toleration, len(in.Spec.Tolerations))
+
+		for index := range in.Spec.Tolerations {
+			in.Spec.Tolerations[index].DeepCopyInto(&out.Spec.Tolerations[index])
+		}
+	}
+
+	if in.Spec.Storage.DeviceClasses != nil {
+		out.Spec.Storage.DeviceClasses = make([]DeviceClass, len(in.Spec.Storage.DeviceClasses))
+
+		for index := range in.Spec.Storage.DeviceClasses {
+			in.Spec.Storage.DeviceClasses[index].DeepCopyInto(&out.Spec.Storage.DeviceClasses[index])
+		}
+	}
+
+	out.Status.Ready = in.Status.Ready
+	out.Status.State = in.Status.State
+
+	if in.Status.DeviceClassStatuses != nil {
+		out.Status.DeviceClassStatuses = make([]DeviceClassStatus, len(in.Status.DeviceClassStatuses))
+
+		for index, deviceClassStatus := range in.Status.DeviceClassStatuses {
+			out.Status.DeviceClassStatuses[index].Name = deviceClassStatus.Name
+
+			if deviceClassStatus.NodeStatus != nil {
+				out.Status.DeviceClassStatuses[index].NodeStatus = make(
+					[]NodeStatus, len(deviceClassStatus.NodeStatus))
+
+				for nodeIndex := range deviceClassStatus.NodeStatus {
+					nodeStatus := &out.Status.DeviceClassStatuses[index].NodeStatus[nodeIndex]
+					nodeStatus.Node = deviceClassStatus.NodeStatus[nodeIndex].Node
+					deviceClassStatus.NodeStatus[nodeIndex].VGStatus.DeepCopyInto(&nodeStatus.VGStatus)
+				}
+			}
+		}
+	}
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LVMCluster) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+func copyStrings(in []string) []string {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]string, len(in))
+	copy(out, in)
+
+	return out
+}
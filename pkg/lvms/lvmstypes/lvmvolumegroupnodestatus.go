@@ -0,0 +1,102 @@
+package lvmstypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// VGStatusType is the status of a volume group on a node.
+type VGStatusType string
+
+const (
+	// VGStatusProgressing means the volume group is being created or extended.
+	VGStatusProgressing VGStatusType = "Progressing"
+	// VGStatusReady means the volume group and its thin pool are ready.
+	VGStatusReady VGStatusType = "Ready"
+	// VGStatusFailed means the volume group could not be created.
+	VGStatusFailed VGStatusType = "Failed"
+	// VGStatusDegraded means the volume group is missing some of its devices.
+	VGStatusDegraded VGStatusType = "Degraded"
+)
+
+// ExcludedDevice is a device which was not added to the volume group.
+type ExcludedDevice struct {
+	// name is the device path.
+	Name string `json:"name"`
+	// reasons the device was excluded.
+	Reasons []string `json:"reasons"`
+}
+
+// VGStatus is the status of a volume group on a node.
+type VGStatus struct {
+	// name of the volume group.
+	Name string `json:"name,omitempty"`
+	// status of the volume group.
+	Status VGStatusType `json:"status,omitempty"`
+	// reason for the current status.
+	Reason string `json:"reason,omitempty"`
+	// devices are the devices making up the volume group.
+	Devices []string `json:"devices,omitempty"`
+	// excluded are the devices which were not added to the volume group.
+	Excluded []ExcludedDevice `json:"excluded,omitempty"`
+}
+
+// LVMVolumeGroupNodeStatusSpec defines the volume group statuses of a node.
+type LVMVolumeGroupNodeStatusSpec struct {
+	// nodeStatus contains the per volume group status of the node.
+	LVMVGStatus []VGStatus `json:"nodeStatus,omitempty"`
+}
+
+// LVMVolumeGroupNodeStatus is the Schema for the lvmvolumegroupnodestatuses API. It is named after the node it
+// reports on.
+type LVMVolumeGroupNodeStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LVMVolumeGroupNodeStatusSpec `json:"spec,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *VGStatus) DeepCopyInto(out *VGStatus) {
+	*out = *in
+	out.Devices = copyStrings(in.Devices)
+
+	if in.Excluded != nil {
+		out.Excluded = make([]ExcludedDevice, len(in.Excluded))
+
+		for index, excluded := range in.Excluded {
+			out.Excluded[index].Name = excluded.Name
+			out.Excluded[index].Reasons = copyStrings(excluded.Reasons)
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LVMVolumeGroupNodeStatus.
+func (in *LVMVolumeGroupNodeStatus) DeepCopy() *LVMVolumeGroupNodeStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := new(LVMVolumeGroupNodeStatus)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.LVMVGStatus != nil {
+		out.Spec.LVMVGStatus = make([]VGStatus, len(in.Spec.LVMVGStatus))
+
+		for index := range in.Spec.LVMVGStatus {
+			in.Spec.LVMVGStatus[index].DeepCopyInto(&out.Spec.LVMVGStatus[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LVMVolumeGroupNodeStatus) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
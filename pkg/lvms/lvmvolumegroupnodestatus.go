@@ -0,0 +1,244 @@
+package lvms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/lvms/lvmstypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VolumeGroupNodeStatusBuilder provides struct for the LVMVolumeGroupNodeStatus object, which is reported by the
+// vg-manager of every node and cannot be created by the user.
+type VolumeGroupNodeStatusBuilder struct {
+	// LVMVolumeGroupNodeStatus definition, only used to identify the object.
+	Definition *lvmstypes.LVMVolumeGroupNodeStatus
+	// Pulled LVMVolumeGroupNodeStatus object.
+	Object    *lvmstypes.LVMVolumeGroupNodeStatus
+	apiClient *clients.Settings
+}
+
+// PullVolumeGroupNodeStatus pulls the LVMVolumeGroupNodeStatus of the given node from cluster.
+func PullVolumeGroupNodeStatus(apiClient *clients.Settings, nodeName, nsname string) (
+	*VolumeGroupNodeStatusBuilder, error) {
+	logging.V(100).Infof("Pulling existing LVMVolumeGroupNodeStatus of node %s under namespace %s from cluster",
+		nodeName, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("LVMVolumeGroupNodeStatus 'apiClient' cannot be empty")
+	}
+
+	builder := VolumeGroupNodeStatusBuilder{
+		apiClient: apiClient,
+		Definition: &lvmstypes.LVMVolumeGroupNodeStatus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if nodeName == "" {
+		logging.V(100).Infof("The node name of the LVMVolumeGroupNodeStatus is empty")
+
+		return nil, fmt.Errorf("LVMVolumeGroupNodeStatus 'nodeName' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the LVMVolumeGroupNodeStatus is empty")
+
+		return nil, fmt.Errorf("LVMVolumeGroupNodeStatus 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("LVMVolumeGroupNodeStatus object %s doesn't exist in namespace %s", nodeName, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// ListVolumeGroupNodeStatuses returns the LVMVolumeGroupNodeStatus of every node in the given namespace.
+func ListVolumeGroupNodeStatuses(
+	apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*VolumeGroupNodeStatusBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("LVMVolumeGroupNodeStatus 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list LVMVolumeGroupNodeStatuses, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("LVMVolumeGroupNodeStatus 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list LVMVolumeGroupNodeStatuses, 'nsname' parameter is empty")
+	}
+
+	logMessage := fmt.Sprintf("Listing LVMVolumeGroupNodeStatuses in the namespace %s", nsname)
+	passedOptions := metav1.ListOptions{}
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	unsList, err := apiClient.Resource(GetVolumeGroupNodeStatusGVR()).Namespace(nsname).List(
+		context.TODO(), passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list LVMVolumeGroupNodeStatuses in the namespace %s due to %s",
+			nsname, err.Error())
+
+		return nil, err
+	}
+
+	var nodeStatusObjects []*VolumeGroupNodeStatusBuilder
+
+	for index := range unsList.Items {
+		nodeStatus, err := convertVolumeGroupNodeStatusToStructured(&unsList.Items[index])
+		if err != nil {
+			return nil, err
+		}
+
+		nodeStatusObjects = append(nodeStatusObjects, &VolumeGroupNodeStatusBuilder{
+			apiClient:  apiClient,
+			Object:     nodeStatus,
+			Definition: nodeStatus,
+		})
+	}
+
+	return nodeStatusObjects, nil
+}
+
+// Get returns LVMVolumeGroupNodeStatus object if found.
+func (builder *VolumeGroupNodeStatusBuilder) Get() (*lvmstypes.LVMVolumeGroupNodeStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting LVMVolumeGroupNodeStatus object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetVolumeGroupNodeStatusGVR()).
+		Namespace(builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("LVMVolumeGroupNodeStatus object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertVolumeGroupNodeStatusToStructured(unsObject)
+}
+
+// Exists checks whether the given LVMVolumeGroupNodeStatus exists.
+func (builder *VolumeGroupNodeStatusBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if LVMVolumeGroupNodeStatus %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetVolumeGroupStatus returns the status of the given volume group on the node. Volume groups are named after
+// their device class.
+func (builder *VolumeGroupNodeStatusBuilder) GetVolumeGroupStatus(vgName string) (*lvmstypes.VGStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting status of volume group %s from LVMVolumeGroupNodeStatus %s in namespace %s",
+		vgName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("LVMVolumeGroupNodeStatus object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	for index := range builder.Object.Spec.LVMVGStatus {
+		if builder.Object.Spec.LVMVGStatus[index].Name == vgName {
+			return &builder.Object.Spec.LVMVGStatus[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("volume group %s not found in LVMVolumeGroupNodeStatus %s in namespace %s",
+		vgName, builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// GetVolumeGroupNodeStatusGVR returns LVMVolumeGroupNodeStatus's GroupVersionResource.
+func GetVolumeGroupNodeStatusGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "lvmvolumegroupnodestatuses"}
+}
+
+// convertVolumeGroupNodeStatusToStructured converts the unstructured object returned by the dynamic client to an
+// LVMVolumeGroupNodeStatus.
+func convertVolumeGroupNodeStatusToStructured(
+	unsObject *unstructured.Unstructured) (*lvmstypes.LVMVolumeGroupNodeStatus, error) {
+	nodeStatus := &lvmstypes.LVMVolumeGroupNodeStatus{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, nodeStatus)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to LVMVolumeGroupNodeStatus object %s",
+			unsObject.GetName())
+
+		return nil, err
+	}
+
+	return nodeStatus, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *VolumeGroupNodeStatusBuilder) validate() (bool, error) {
+	resourceCRD := "LVMVolumeGroupNodeStatus"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	var err error
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,115 @@
+package lvms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/lvms/lvmstypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	lvmVolumeGroupNodeStatusGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    LVMVolumeGroupNodeStatusKind,
+	}
+	defaultNodeName = "worker-0"
+)
+
+func TestPullVolumeGroupNodeStatus(t *testing.T) {
+	testCases := []struct {
+		nodeName            string
+		namespace           string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			nodeName:            defaultNodeName,
+			namespace:           LVMSNamespace,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			nodeName:            "",
+			namespace:           LVMSNamespace,
+			addToRuntimeObjects: true,
+			expectedError:       fmt.Errorf("LVMVolumeGroupNodeStatus 'nodeName' cannot be empty"),
+		},
+		{
+			nodeName:            defaultNodeName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			expectedError:       fmt.Errorf("LVMVolumeGroupNodeStatus 'namespace' cannot be empty"),
+		},
+		{
+			nodeName:            defaultNodeName,
+			namespace:           LVMSNamespace,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf(
+				"LVMVolumeGroupNodeStatus object %s doesn't exist in namespace %s", defaultNodeName, LVMSNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyVolumeGroupNodeStatus())
+		}
+
+		testBuilder, err := PullVolumeGroupNodeStatus(
+			buildVolumeGroupNodeStatusTestClientWithDummyObject(runtimeObjects), testCase.nodeName, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.nodeName, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestVolumeGroupNodeStatusGetVolumeGroupStatus(t *testing.T) {
+	testBuilder, err := PullVolumeGroupNodeStatus(buildVolumeGroupNodeStatusTestClientWithDummyObject(
+		[]runtime.Object{buildDummyVolumeGroupNodeStatus()}), defaultNodeName, LVMSNamespace)
+	assert.Nil(t, err)
+
+	vgStatus, err := testBuilder.GetVolumeGroupStatus(defaultDeviceClass)
+	assert.Nil(t, err)
+	assert.Equal(t, lvmstypes.VGStatusReady, vgStatus.Status)
+	assert.Equal(t, "/dev/sdc", vgStatus.Excluded[0].Name)
+
+	_, err = testBuilder.GetVolumeGroupStatus("vg2")
+	assert.EqualError(t, err, fmt.Sprintf("volume group vg2 not found in LVMVolumeGroupNodeStatus %s in namespace %s",
+		defaultNodeName, LVMSNamespace))
+}
+
+func buildDummyVolumeGroupNodeStatus() *lvmstypes.LVMVolumeGroupNodeStatus {
+	return &lvmstypes.LVMVolumeGroupNodeStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultNodeName,
+			Namespace: LVMSNamespace,
+		},
+		Spec: lvmstypes.LVMVolumeGroupNodeStatusSpec{
+			LVMVGStatus: []lvmstypes.VGStatus{{
+				Name:    defaultDeviceClass,
+				Status:  lvmstypes.VGStatusReady,
+				Devices: []string{"/dev/sdb"},
+				Excluded: []lvmstypes.ExcludedDevice{{
+					Name:    "/dev/sdc",
+					Reasons: []string{"the device has a filesystem signature"},
+				}},
+			}},
+		},
+	}
+}
+
+func buildVolumeGroupNodeStatusTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{lvmVolumeGroupNodeStatusGVK},
+	})
+}
@@ -3,13 +3,14 @@ package machine
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,7 +28,7 @@ type SetBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before SetBuilder object is created.
-	errorMsg string
+	errorMsg error
 	// string to store the public cloud
 	publicCloud string
 }
@@ -48,7 +49,7 @@ func NewSetBuilderFromCopy(
 	instanceType string,
 	workerLabel string,
 	replicas int32) *SetBuilder {
-	glog.V(100).Infof("Initializing new SetBuilder structure from copied MachineSet with the following"+
+	logging.V(100).Infof("Initializing new SetBuilder structure from copied MachineSet with the following"+
 		" params: namespace: %s, instanceType: %s, workerLabel: %s, and replicas: %v", nsName, instanceType,
 		workerLabel, replicas)
 
@@ -59,9 +60,10 @@ func NewSetBuilderFromCopy(
 	newSetBuilder, err := createNewWorkerMachineSetFromCopy(apiClient, nsName, instanceType, workerLabel, replicas)
 
 	if err != nil {
-		glog.V(100).Infof("Error initializing MachineSet from copy: %s", err.Error())
+		logging.V(100).Infof("Error initializing MachineSet from copy: %s", err.Error())
 
-		builder.errorMsg = fmt.Sprintf("Error initializing MachineSet from copy: %s", err.Error())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Error initializing MachineSet from copy: %s", err.Error()))
 
 		return &builder
 	}
@@ -71,45 +73,45 @@ func NewSetBuilderFromCopy(
 	err = builder.getPublicCloudKind()
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error getting the public cloud kind: %v", err.Error())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error getting the public cloud kind: %v", err.Error()))
 	}
 
-	glog.V(100).Infof("Updating copied MachineSet provider instanceType to: %s", instanceType)
+	logging.V(100).Infof("Updating copied MachineSet provider instanceType to: %s", instanceType)
 
 	err = builder.ChangeCloudProviderInstanceType(instanceType)
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error changing the instanceType: %v", err.Error())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error changing the instanceType: %v", err.Error()))
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The Namespace of the MachineSet is empty")
+		logging.V(100).Infof("The Namespace of the MachineSet is empty")
 
-		builder.errorMsg = "MachineSet 'nsName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineSet 'nsName' cannot be empty"))
 	}
 
 	if instanceType == "" {
-		glog.V(100).Infof("The instanceType of the MachineSet is empty")
+		logging.V(100).Infof("The instanceType of the MachineSet is empty")
 
-		builder.errorMsg = "MachineSet 'instanceType' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineSet 'instanceType' cannot be empty"))
 	}
 
 	if replicas == 0 {
-		glog.V(100).Infof("The replicas of the MachineSet is zero")
+		logging.V(100).Infof("The replicas of the MachineSet is zero")
 
-		builder.errorMsg = "MachineSet 'replicas' cannot be zero"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineSet 'replicas' cannot be zero"))
 	}
 
 	if workerLabel == "" {
-		glog.V(100).Infof("The workerLabel of the MachineSet is empty")
+		logging.V(100).Infof("The workerLabel of the MachineSet is empty")
 
-		builder.errorMsg = "MachineSet 'workerLabel' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineSet 'workerLabel' cannot be empty"))
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The MachineSet object definition is nil")
+		logging.V(100).Infof("The MachineSet object definition is nil")
 
-		builder.errorMsg = "MachineSet 'Object.Definition' is nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineSet 'Object.Definition' is nil"))
 	}
 
 	return &builder
@@ -117,7 +119,7 @@ func NewSetBuilderFromCopy(
 
 // PullSet loads an existing MachineSet into Builder struct.
 func PullSet(apiClient *clients.Settings, name, namespace string) (*SetBuilder, error) {
-	glog.V(100).Infof("Pulling existing machineSet name %s in namespace %s", name, namespace)
+	logging.V(100).Infof("Pulling existing machineSet name %s in namespace %s", name, namespace)
 
 	builder := SetBuilder{
 		apiClient: apiClient,
@@ -130,11 +132,11 @@ func PullSet(apiClient *clients.Settings, name, namespace string) (*SetBuilder,
 	}
 
 	if name == "" {
-		builder.errorMsg = "MachineSet 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineSet 'name' cannot be empty"))
 	}
 
 	if namespace == "" {
-		builder.errorMsg = "MachineSet 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineSet 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -152,7 +154,7 @@ func (builder *SetBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if MachineSet %s exists in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -161,7 +163,7 @@ func (builder *SetBuilder) Exists() bool {
 		builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect MachineSet object due to %s", err.Error())
+		logging.V(100).Infof("Failed to collect MachineSet object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -173,7 +175,7 @@ func (builder *SetBuilder) Create() (*SetBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the MachineSet %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating the MachineSet %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -190,7 +192,7 @@ func (builder *SetBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the MachineSet object %s",
+	logging.V(100).Infof("Deleting the MachineSet object %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -218,21 +220,21 @@ func WaitForMachineSetReady(
 			machineSetPulled, err := PullSet(apiClient, namespace, machineSetName)
 
 			if err != nil {
-				glog.V(100).Infof("MachineSet pull from cluster error: %v\n", err)
+				logging.V(100).Infof("MachineSet pull from cluster error: %v\n", err)
 
 				return false, err
 			}
 
 			if machineSetPulled.Object.Status.ReadyReplicas > 0 &&
 				machineSetPulled.Object.Status.Replicas == machineSetPulled.Object.Status.ReadyReplicas {
-				glog.V(100).Infof("MachineSet %s has %v replicas in Ready state",
+				logging.V(100).Infof("MachineSet %s has %v replicas in Ready state",
 					machineSetPulled.Object.Name, machineSetPulled.Object.Status.ReadyReplicas)
 
 				// this exits out of the wait.PollUntilContextTimeout()
 				return true, nil
 			}
 
-			glog.V(100).Infof("MachineSet %s has %v replicas in Ready state",
+			logging.V(100).Infof("MachineSet %s has %v replicas in Ready state",
 				machineSetPulled.Object.Name, machineSetPulled.Object.Status.ReadyReplicas)
 
 			return false, err
@@ -245,11 +247,11 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		return err
 	}
 
-	glog.V(100).Infof("Updating the cloud provider instance type field")
+	logging.V(100).Infof("Updating the cloud provider instance type field")
 
 	switch builder.publicCloud {
 	case AwsCloud:
-		glog.V(100).Infof("Updating ProviderSpec InstanceType param for AWS public cloud")
+		logging.V(100).Infof("Updating ProviderSpec InstanceType param for AWS public cloud")
 
 		err := builder.AWSChangeProviderInstanceType(instanceType)
 
@@ -258,7 +260,7 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		}
 
 	case GcpCloud:
-		glog.V(100).Infof("Updating ProviderSpec MachineType and OnHostTerminate params for " +
+		logging.V(100).Infof("Updating ProviderSpec MachineType and OnHostTerminate params for " +
 			"GCP public cloud")
 
 		err := builder.GCPChangeProviderMachineType(instanceType)
@@ -268,7 +270,7 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		}
 
 	case AzureCloud:
-		glog.V(100).Infof("Updating ProviderSpec VMSize param for Azure public cloud")
+		logging.V(100).Infof("Updating ProviderSpec VMSize param for Azure public cloud")
 
 		err := builder.AzureChangeProviderVMSize(instanceType)
 
@@ -277,7 +279,7 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		}
 
 	default:
-		glog.V(100).Infof("Public cloud '%s' is not supported, must be 'aws', 'gcp' or azure'")
+		logging.V(100).Infof("Public cloud '%s' is not supported, must be 'aws', 'gcp' or 'azure'", builder.publicCloud)
 
 		return fmt.Errorf("could not find supported public cloud")
 	}
@@ -301,26 +303,26 @@ func (builder *SetBuilder) AWSChangeProviderInstanceType(instanceType string) er
 		return fmt.Errorf("error marshalling machineSet providerSpec.Value into byte array: %w", err)
 	}
 
-	glog.V(100).Infof("Updating ProviderSpec InstanceType param '%s' for AWS public cloud",
+	logging.V(100).Infof("Updating ProviderSpec InstanceType param '%s' for AWS public cloud",
 		instanceType)
 
 	var AWSProviderSpecObject *machinev1beta1.AWSMachineProviderConfig
 	err = json.Unmarshal(byteArray, &AWSProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling byte array into AWSMachineProviderConfig object: %v", err)
+		logging.V(100).Infof("error unmarshalling byte array into AWSMachineProviderConfig object: %v", err)
 
 		return fmt.Errorf("could not update InstanceType param: %w", err)
 	}
 
-	glog.V(100).Infof("Setting AWSMachineProviderConfig.InstanceType param value to: %s", instanceType)
+	logging.V(100).Infof("Setting AWSMachineProviderConfig.InstanceType param value to: %s", instanceType)
 
 	AWSProviderSpecObject.InstanceType = instanceType
 
 	byteArrayAWS, err := json.Marshal(AWSProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error marshalling AWSMachineProviderConfig object into byte array: %v", err)
+		logging.V(100).Infof("error marshalling AWSMachineProviderConfig object into byte array: %v", err)
 
 		return fmt.Errorf("could not update InstanceType param: %w", err)
 	}
@@ -328,7 +330,7 @@ func (builder *SetBuilder) AWSChangeProviderInstanceType(instanceType string) er
 	err = json.Unmarshal(byteArrayAWS, builder.Definition.Spec.Template.Spec.ProviderSpec.Value)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling AWSMachineProviderConfig byte array into "+
+		logging.V(100).Infof("error unmarshalling AWSMachineProviderConfig byte array into "+
 			"ProviderSpec.Value object: %v", err)
 
 		return fmt.Errorf("could not update InstanceType param: %w", err)
@@ -353,25 +355,25 @@ func (builder *SetBuilder) GCPChangeProviderMachineType(machineType string) erro
 		return fmt.Errorf("error marshalling machineSet providerSpec.Value into byte array: %w", err)
 	}
 
-	glog.V(100).Infof("Updating ProviderSpec MachineType param '%s' for GCP public cloud", machineType)
+	logging.V(100).Infof("Updating ProviderSpec MachineType param '%s' for GCP public cloud", machineType)
 
 	var GCPProviderSpecObject *machinev1beta1.GCPMachineProviderSpec
 	err = json.Unmarshal(byteArray, &GCPProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling byte array into GCPMachineProviderSpec object: %v", err)
+		logging.V(100).Infof("error unmarshalling byte array into GCPMachineProviderSpec object: %v", err)
 
 		return fmt.Errorf("could not update MachineType param: %w", err)
 	}
 
-	glog.V(100).Infof("Setting GCPMachineProviderConfig.MachineType param value to: %s", machineType)
+	logging.V(100).Infof("Setting GCPMachineProviderConfig.MachineType param value to: %s", machineType)
 
 	GCPProviderSpecObject.MachineType = machineType
 
 	byteArrayGCP, err := json.Marshal(GCPProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error marshalling GCPMachineProviderSpec object into byte array: %v", err)
+		logging.V(100).Infof("error marshalling GCPMachineProviderSpec object into byte array: %v", err)
 
 		return fmt.Errorf("could not update MachineType param: %w", err)
 	}
@@ -379,7 +381,7 @@ func (builder *SetBuilder) GCPChangeProviderMachineType(machineType string) erro
 	err = json.Unmarshal(byteArrayGCP, builder.Definition.Spec.Template.Spec.ProviderSpec.Value)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling ProviderSpec byte array into ProviderSpec.Value: %v", err)
+		logging.V(100).Infof("error unmarshalling ProviderSpec byte array into ProviderSpec.Value: %v", err)
 
 		return fmt.Errorf("could not update MachineType param: %w", err)
 	}
@@ -403,26 +405,26 @@ func (builder *SetBuilder) AzureChangeProviderVMSize(vmSize string) error {
 		return fmt.Errorf("error marshalling machineSet providerSpec.Value into byte array: %w", err)
 	}
 
-	glog.V(100).Infof("Updating ProviderSpec Value VMSize param '%s' for Azure public cloud",
+	logging.V(100).Infof("Updating ProviderSpec Value VMSize param '%s' for Azure public cloud",
 		vmSize)
 
 	var AzureProviderSpecObject *machinev1beta1.AzureMachineProviderSpec
 	err = json.Unmarshal(byteArray, &AzureProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling byte array into AzureMachineProviderSpec object: %v", err)
+		logging.V(100).Infof("error unmarshalling byte array into AzureMachineProviderSpec object: %v", err)
 
 		return fmt.Errorf("could not update VMSize param: %w", err)
 	}
 
-	glog.V(100).Infof("Setting AzureMachineProviderSpec.VMSize param value to: %s", vmSize)
+	logging.V(100).Infof("Setting AzureMachineProviderSpec.VMSize param value to: %s", vmSize)
 
 	AzureProviderSpecObject.VMSize = vmSize
 
 	byteArrayAzure, err := json.Marshal(AzureProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error marshalling AzureMachineProviderSpec object into byte array: %v", err)
+		logging.V(100).Infof("error marshalling AzureMachineProviderSpec object into byte array: %v", err)
 
 		return fmt.Errorf("could not update VMSize param: %w", err)
 	}
@@ -430,7 +432,7 @@ func (builder *SetBuilder) AzureChangeProviderVMSize(vmSize string) error {
 	err = json.Unmarshal(byteArrayAzure, builder.Definition.Spec.Template.Spec.ProviderSpec.Value)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling AzureMachineProviderSpec byte array into "+
+		logging.V(100).Infof("error unmarshalling AzureMachineProviderSpec byte array into "+
 			"ProviderSpec.Value object: %v", err)
 
 		return fmt.Errorf("could not update VMSize param: %w", err)
@@ -453,7 +455,7 @@ func createNewWorkerMachineSetFromCopy(
 	}
 
 	if len(workerSetBuilders) == 0 {
-		glog.V(100).Infof("The array of worker MachineSets is empty")
+		logging.V(100).Infof("The array of worker MachineSets is empty")
 
 		return nil, fmt.Errorf("no worker MachineSets were found")
 	}
@@ -461,7 +463,7 @@ func createNewWorkerMachineSetFromCopy(
 	// picking the first worker SetBuilder in array
 	baseSetBuilder := workerSetBuilders[0]
 
-	glog.V(100).Infof("Creating new SetBuilder copy of first existing worker MachineSet: %s",
+	logging.V(100).Infof("Creating new SetBuilder copy of first existing worker MachineSet: %s",
 		baseSetBuilder.Definition.Name)
 
 	copiedSetBuilder := &SetBuilder{
@@ -472,7 +474,7 @@ func createNewWorkerMachineSetFromCopy(
 		},
 	}
 
-	glog.V(100).Infof("Renaming copied SetBuilder to: %s",
+	logging.V(100).Infof("Renaming copied SetBuilder to: %s",
 		copiedSetBuilder.Definition.ObjectMeta.Name)
 
 	// replace dots in name with dashes.  Cannot have dots or underscores in machineSet name, must also be lower case
@@ -480,7 +482,7 @@ func createNewWorkerMachineSetFromCopy(
 		copiedSetBuilder.Definition.Name,
 		strings.ToLower(regexp.MustCompile(`[\.|\_]`).ReplaceAllString(instanceType, "-")))
 
-	glog.V(100).Infof("Updating copied MachineSet name in metadata, selector and template parameters")
+	logging.V(100).Infof("Updating copied MachineSet name in metadata, selector and template parameters")
 
 	copiedSetBuilder.Definition.ObjectMeta.UID = ""
 	copiedSetBuilder.Definition.ObjectMeta.ResourceVersion = ""
@@ -491,7 +493,7 @@ func createNewWorkerMachineSetFromCopy(
 	copiedSetBuilder.Definition.Spec.Template.ObjectMeta.Labels["machine.openshift.io/cluster-api-machineset"] =
 		copiedSetBuilder.Definition.ObjectMeta.Name
 
-	glog.V(100).Infof("Updating copied MachineSet replicas value to: %v", replicas)
+	logging.V(100).Infof("Updating copied MachineSet replicas value to: %v", replicas)
 	copiedSetBuilder.Definition.Spec.Replicas = &replicas
 
 	return copiedSetBuilder, nil
@@ -503,7 +505,7 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 		return err
 	}
 
-	glog.V(100).Infof("Determining the public cloud kind")
+	logging.V(100).Infof("Determining the public cloud kind")
 
 	providerSpecMap := make(map[string]interface{})
 
@@ -511,7 +513,7 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 	byteArray, err := json.Marshal(builder.Definition.Spec.Template.Spec.ProviderSpec.Value)
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error determining public cloud kind: %v", err)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error determining public cloud kind: %v", err))
 
 		return fmt.Errorf("error marshalling the providerSpec Value element into a byte array")
 	}
@@ -519,7 +521,7 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 	err = json.Unmarshal(byteArray, &providerSpecMap)
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error determining public cloud kind: %v", err)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error determining public cloud kind: %v", err))
 
 		return fmt.Errorf("error unmarshalling the byte array into a providerSpec map")
 	}
@@ -533,7 +535,7 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 		return fmt.Errorf("failed to detect public cloud kind")
 	}
 
-	glog.V(100).Infof("ProviderSpec kind param is '%s'", publicCloud)
+	logging.V(100).Infof("ProviderSpec kind param is '%s'", publicCloud)
 
 	switch publicCloud {
 	case "AWSMachineProviderConfig":
@@ -543,7 +545,8 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 	case "AzureMachineProviderSpec":
 		builder.publicCloud = AzureCloud
 	default:
-		builder.errorMsg = "unsupported cloud platform. Supported public cloud are AWS, GCP, and Azure"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"unsupported cloud platform. Supported public cloud are AWS, GCP, and Azure"))
 
 		return fmt.Errorf("unsupported cloud platform. Supported public cloud are AWS, GCP, and Azure")
 	}
@@ -557,27 +560,29 @@ func (builder *SetBuilder) validate() (bool, error) {
 	resourceCRD := "MachineSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiClient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiClient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -16,13 +16,13 @@ func ListWorkerMachineSets(
 	workerLabel string,
 	options ...metav1.ListOptions) ([]*SetBuilder, error) {
 	if namespace == "" {
-		glog.V(100).Infof("machineSet 'namespace' parameter can not be empty")
+		logging.V(100).Infof("machineSet 'namespace' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list MachineSets, 'namespace' parameter is empty")
 	}
 
 	if workerLabel == "" {
-		glog.V(100).Infof("machineSet 'workerLabel' parameter can not be empty")
+		logging.V(100).Infof("machineSet 'workerLabel' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list MachineSets, 'workerLabel' parameter is empty")
 	}
@@ -31,7 +31,7 @@ func ListWorkerMachineSets(
 	passedOptions := metav1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -41,12 +41,12 @@ func ListWorkerMachineSets(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	machineSetList, err := apiClient.MachineSets(namespace).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list MachineSets in the namespace %s due to %s",
+		logging.V(100).Infof("Failed to list MachineSets in the namespace %s due to %s",
 			namespace, err.Error())
 
 		return nil, err
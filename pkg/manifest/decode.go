@@ -0,0 +1,156 @@
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// SplitDocuments splits a multi-document YAML or JSON manifest into its documents. Documents that contain only
+// whitespace or comments are skipped. Every returned document is converted to JSON.
+func SplitDocuments(data []byte) ([][]byte, error) {
+	logging.V(100).Infof("Splitting manifest into documents")
+
+	var documents [][]byte
+
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			logging.V(100).Infof("Failed to read manifest document due to %s", err.Error())
+
+			return nil, fmt.Errorf("failed to read manifest document: %w", err)
+		}
+
+		jsonDocument, err := yaml.ToJSON(document)
+		if err != nil {
+			logging.V(100).Infof("Failed to convert manifest document to JSON due to %s", err.Error())
+
+			return nil, fmt.Errorf("failed to convert manifest document %d to JSON: %w", len(documents), err)
+		}
+
+		jsonDocument = bytes.TrimSpace(jsonDocument)
+
+		if len(jsonDocument) == 0 || bytes.Equal(jsonDocument, []byte("null")) {
+			continue
+		}
+
+		documents = append(documents, jsonDocument)
+	}
+
+	return documents, nil
+}
+
+// Decode decodes every document of a YAML or JSON manifest. Documents whose kind is known to the clients scheme are
+// returned as typed objects, e.g. *corev1.Service, all other documents are returned as *unstructured.Unstructured.
+func Decode(data []byte) ([]runtime.Object, error) {
+	documents, err := SplitDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []runtime.Object
+
+	for index, document := range documents {
+		object, err := decodeDocument(document)
+		if err != nil {
+			logging.V(100).Infof("Failed to decode manifest document %d due to %s", index, err.Error())
+
+			return nil, fmt.Errorf("failed to decode manifest document %d: %w", index, err)
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// DecodeFile reads the manifest stored in the given file and decodes every document of it.
+func DecodeFile(path string) ([]runtime.Object, error) {
+	logging.V(100).Infof("Decoding manifest file %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logging.V(100).Infof("Failed to read manifest file %s due to %s", path, err.Error())
+
+		return nil, err
+	}
+
+	return Decode(data)
+}
+
+// DecodeInto decodes a manifest with a single document into the given typed object. The apiVersion and kind of the
+// document may be omitted, in which case they are defaulted from the type of the object.
+func DecodeInto(data []byte, object runtime.Object) error {
+	if object == nil {
+		logging.V(100).Infof("The object to decode into is nil")
+
+		return fmt.Errorf("cannot decode into nil object")
+	}
+
+	documents, err := SplitDocuments(data)
+	if err != nil {
+		return err
+	}
+
+	if len(documents) != 1 {
+		logging.V(100).Infof("The manifest has %d documents instead of 1", len(documents))
+
+		return fmt.Errorf("expected manifest with 1 document, found %d", len(documents))
+	}
+
+	_, err = getScheme()
+	if err != nil {
+		return err
+	}
+
+	decodedObject, gvk, err := manifestCodecs.UniversalDeserializer().Decode(documents[0], nil, object)
+	if err != nil {
+		logging.V(100).Infof("Failed to decode manifest into %T due to %s", object, err.Error())
+
+		return err
+	}
+
+	if decodedObject != object {
+		logging.V(100).Infof("The manifest kind %s does not match object %T", gvk.Kind, object)
+
+		return fmt.Errorf("manifest kind %s does not match object %T", gvk.Kind, object)
+	}
+
+	return nil
+}
+
+// decodeDocument decodes a single JSON document into a typed object, falling back to an unstructured object when
+// its kind is not registered in the scheme.
+func decodeDocument(document []byte) (runtime.Object, error) {
+	_, err := getScheme()
+	if err != nil {
+		return nil, err
+	}
+
+	object, _, err := manifestCodecs.UniversalDeserializer().Decode(document, nil, nil)
+	if err == nil {
+		return object, nil
+	}
+
+	if !runtime.IsNotRegisteredError(err) {
+		return nil, err
+	}
+
+	object, _, err = unstructured.UnstructuredJSONScheme.Decode(document, nil, nil)
+
+	return object, err
+}
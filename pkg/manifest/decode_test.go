@@ -0,0 +1,106 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	testServiceDocument   = "apiVersion: v1\nkind: Service\nmetadata:\n  name: test-service\n  namespace: test-namespace\n"
+	testConfigMapDocument = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test-configmap\n"
+	testUnknownDocument   = "apiVersion: example.com/v1\nkind: Unknown\nmetadata:\n  name: test-unknown\n"
+)
+
+func TestSplitDocuments(t *testing.T) {
+	testCases := []struct {
+		data          string
+		expectedCount int
+	}{
+		{
+			data:          testServiceDocument,
+			expectedCount: 1,
+		},
+		{
+			data:          "# comment only\n---\n" + testServiceDocument + "---\n" + testConfigMapDocument + "---\n",
+			expectedCount: 2,
+		},
+		{
+			data:          `{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "test-service"}}`,
+			expectedCount: 1,
+		},
+		{
+			data:          "",
+			expectedCount: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		documents, err := SplitDocuments([]byte(testCase.data))
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedCount, len(documents))
+	}
+}
+
+func TestDecode(t *testing.T) {
+	objects, err := Decode([]byte(testServiceDocument + "---\n" + testUnknownDocument))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objects))
+
+	service, ok := objects[0].(*corev1.Service)
+	assert.True(t, ok)
+	assert.Equal(t, "test-service", service.Name)
+
+	unknown, ok := objects[1].(*unstructured.Unstructured)
+	assert.True(t, ok)
+	assert.Equal(t, "test-unknown", unknown.GetName())
+
+	_, err = Decode([]byte("metadata:\n  name: test-service\n"))
+	assert.ErrorContains(t, err, "failed to decode manifest document 0")
+}
+
+func TestDecodeInto(t *testing.T) {
+	testCases := []struct {
+		data          string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			data:         testServiceDocument,
+			expectedName: "test-service",
+		},
+		{
+			data:         "metadata:\n  name: test-service\n",
+			expectedName: "test-service",
+		},
+		{
+			data:          testConfigMapDocument,
+			expectedError: "manifest kind ConfigMap does not match object *v1.Service",
+		},
+		{
+			data:          testServiceDocument + "---\n" + testServiceDocument,
+			expectedError: "expected manifest with 1 document, found 2",
+		},
+		{
+			data:          "",
+			expectedError: "expected manifest with 1 document, found 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		service := &corev1.Service{}
+
+		err := DecodeInto([]byte(testCase.data), service)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedName, service.Name)
+	}
+
+	assert.EqualError(t, DecodeInto([]byte(testServiceDocument), nil), "cannot decode into nil object")
+}
@@ -0,0 +1,167 @@
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// serverPopulatedFields lists the top-level and metadata fields that are set by the API server and therefore never
+// compared.
+var serverPopulatedFields = map[string][]string{
+	"": {"apiVersion", "kind", "status"},
+	"metadata": {
+		"creationTimestamp", "deletionGracePeriodSeconds", "deletionTimestamp", "generation", "managedFields",
+		"resourceVersion", "selfLink", "uid",
+	},
+}
+
+// Difference describes a single field of the definition that does not match the live object.
+type Difference struct {
+	// Path of the field, e.g. spec.ports[0].port.
+	Path string
+	// Value of the field in the definition.
+	Expected interface{}
+	// Value of the field in the live object. Nil when the field is missing.
+	Actual interface{}
+}
+
+// Differences is the list of fields of the definition that do not match the live object.
+type Differences []Difference
+
+// String returns the differences in a human readable format, one field per line.
+func (differences Differences) String() string {
+	lines := make([]string, 0, len(differences))
+
+	for _, difference := range differences {
+		lines = append(lines, fmt.Sprintf(
+			"%s: expected %v, found %v", difference.Path, difference.Expected, difference.Actual))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Diff compares the definition of a resource with its live object. Only the fields set in the definition are
+// compared, so fields populated by the API server such as status, uid or defaulted values are ignored. Zero values in
+// the definition, such as 0, "" or false, are treated as unset because typed objects cannot tell them apart from
+// fields left for the API server to default. An empty result means that the live object matches the definition.
+func Diff(definition, object runtime.Object) (Differences, error) {
+	logging.V(100).Infof("Comparing definition %T with live object %T", definition, object)
+
+	if definition == nil || object == nil {
+		logging.V(100).Infof("The definition or the live object is nil")
+
+		return nil, fmt.Errorf("cannot compare nil definition or object")
+	}
+
+	expected, err := runtime.DefaultUnstructuredConverter.ToUnstructured(definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert definition %T due to %s", definition, err.Error())
+
+		return nil, fmt.Errorf("failed to convert definition: %w", err)
+	}
+
+	actual, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert live object %T due to %s", object, err.Error())
+
+		return nil, fmt.Errorf("failed to convert live object: %w", err)
+	}
+
+	return diffMaps("", expected, actual), nil
+}
+
+// diffMaps compares every field of expected with the same field of actual.
+func diffMaps(path string, expected, actual map[string]interface{}) Differences {
+	var differences Differences
+
+	keys := make([]string, 0, len(expected))
+
+	for key := range expected {
+		if isServerPopulated(path, key) {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+
+		differences = append(differences, diffValues(fieldPath, expected[key], actual[key])...)
+	}
+
+	return differences
+}
+
+// diffValues compares two values, descending into maps and lists of equal length.
+func diffValues(path string, expected, actual interface{}) Differences {
+	if isZero(expected) {
+		return nil
+	}
+
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		if actualValue, ok := actual.(map[string]interface{}); ok {
+			return diffMaps(path, expectedValue, actualValue)
+		}
+	case []interface{}:
+		if actualValue, ok := actual.([]interface{}); ok && len(actualValue) == len(expectedValue) {
+			var differences Differences
+
+			for index := range expectedValue {
+				differences = append(differences,
+					diffValues(fmt.Sprintf("%s[%d]", path, index), expectedValue[index], actualValue[index])...)
+			}
+
+			return differences
+		}
+	default:
+		if reflect.DeepEqual(expected, actual) {
+			return nil
+		}
+	}
+
+	return Differences{{Path: path, Expected: expected, Actual: actual}}
+}
+
+// isServerPopulated checks whether the field at the given path is populated by the API server.
+func isServerPopulated(path, key string) bool {
+	for _, field := range serverPopulatedFields[path] {
+		if field == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isZero checks whether a value converted from a typed object is unset: nil, a zero scalar, an empty list or a map
+// whose fields are all unset.
+func isZero(value interface{}) bool {
+	switch typedValue := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		for _, field := range typedValue {
+			if !isZero(field) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		return len(typedValue) == 0
+	default:
+		return reflect.ValueOf(value).IsZero()
+	}
+}
@@ -0,0 +1,109 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		definition          runtime.Object
+		object              runtime.Object
+		expectedDifferences Differences
+		expectedError       string
+	}{
+		{
+			definition: buildDummyDiffService(80, nil),
+			object:     buildLiveDiffService(80, nil),
+		},
+		{
+			definition: buildDummyDiffService(80, map[string]string{"app": "test"}),
+			object:     buildLiveDiffService(8080, nil),
+			expectedDifferences: Differences{
+				{Path: "metadata.labels", Expected: map[string]interface{}{"app": "test"}},
+				{Path: "spec.ports[0].port", Expected: int64(80), Actual: int64(8080)},
+			},
+		},
+		{
+			definition: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-service", Namespace: "test-namespace"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80}},
+				},
+			},
+			object: buildDefaultedDiffService(),
+		},
+		{
+			definition:    nil,
+			object:        buildLiveDiffService(80, nil),
+			expectedError: "cannot compare nil definition or object",
+		},
+	}
+
+	for _, testCase := range testCases {
+		differences, err := Diff(testCase.definition, testCase.object)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedDifferences, differences)
+	}
+}
+
+func TestDifferencesString(t *testing.T) {
+	differences := Differences{
+		{Path: "spec.ports[0].port", Expected: int64(80), Actual: int64(8080)},
+		{Path: "metadata.labels.app", Expected: "test"},
+	}
+
+	assert.Equal(t,
+		"spec.ports[0].port: expected 80, found 8080\nmetadata.labels.app: expected test, found <nil>", differences.String())
+	assert.Equal(t, "", Differences{}.String())
+}
+
+func buildDummyDiffService(port int32, labels map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: "test-namespace",
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: port}},
+		},
+	}
+}
+
+// buildLiveDiffService returns a service with the fields that the API server populates on creation.
+func buildLiveDiffService(port int32, labels map[string]string) *corev1.Service {
+	service := buildDummyDiffService(port, labels)
+	service.UID = types.UID("test-uid")
+	service.ResourceVersion = "1"
+	service.CreationTimestamp = metav1.Now()
+	service.Spec.ClusterIP = "10.0.0.1"
+	service.Spec.Ports[0].Protocol = corev1.ProtocolTCP
+	service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "192.168.0.1"}}
+
+	return service
+}
+
+// buildDefaultedDiffService returns a service with the fields that the API server defaults for a port 80 service.
+func buildDefaultedDiffService() *corev1.Service {
+	service := buildLiveDiffService(80, nil)
+	service.Spec.Ports[0].Name = ""
+	service.Spec.Ports[0].Protocol = corev1.ProtocolTCP
+	service.Spec.Ports[0].TargetPort = intstr.FromInt(80)
+	service.Spec.Type = corev1.ServiceTypeClusterIP
+	service.Spec.ClusterIP = "172.30.0.10"
+	service.Spec.SessionAffinity = corev1.ServiceAffinityNone
+
+	return service
+}
@@ -0,0 +1,124 @@
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+)
+
+var (
+	manifestScheme     *runtime.Scheme
+	manifestCodecs     serializer.CodecFactory
+	manifestSchemeErr  error
+	manifestSchemeOnce sync.Once
+)
+
+// ToYAML renders the given object as a YAML manifest. The object is usually the Definition or the Object of a
+// builder. The apiVersion and kind fields are populated from the clients scheme when they are not already set.
+func ToYAML(object runtime.Object) ([]byte, error) {
+	logging.V(100).Infof("Rendering object %T as YAML", object)
+
+	return encode(object, true)
+}
+
+// ToJSON renders the given object as an indented JSON manifest. The object is usually the Definition or the Object
+// of a builder. The apiVersion and kind fields are populated from the clients scheme when they are not already set.
+func ToJSON(object runtime.Object) ([]byte, error) {
+	logging.V(100).Infof("Rendering object %T as JSON", object)
+
+	return encode(object, false)
+}
+
+// GroupVersionKind returns the GroupVersionKind of the object. It is read from the object when set and looked up in
+// the clients scheme otherwise.
+func GroupVersionKind(object runtime.Object) (schema.GroupVersionKind, error) {
+	scheme, err := getScheme()
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+
+	typedObject, err := withGroupVersionKind(scheme, object)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+
+	return typedObject.GetObjectKind().GroupVersionKind(), nil
+}
+
+// encode serializes a copy of the object with its GroupVersionKind populated.
+func encode(object runtime.Object, yaml bool) ([]byte, error) {
+	scheme, err := getScheme()
+	if err != nil {
+		return nil, err
+	}
+
+	typedObject, err := withGroupVersionKind(scheme, object)
+	if err != nil {
+		return nil, err
+	}
+
+	serializer := json.NewSerializerWithOptions(
+		json.DefaultMetaFactory, scheme, scheme, json.SerializerOptions{Yaml: yaml, Pretty: !yaml})
+
+	buffer := &bytes.Buffer{}
+
+	err = serializer.Encode(typedObject, buffer)
+	if err != nil {
+		logging.V(100).Infof("Failed to encode object %T due to %s", object, err.Error())
+
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// withGroupVersionKind returns a copy of the object with apiVersion and kind set. Objects that already carry a
+// GroupVersionKind keep it.
+func withGroupVersionKind(scheme *runtime.Scheme, object runtime.Object) (runtime.Object, error) {
+	if object == nil {
+		logging.V(100).Infof("The object to render is nil")
+
+		return nil, fmt.Errorf("cannot render nil object")
+	}
+
+	copiedObject := object.DeepCopyObject()
+
+	if !copiedObject.GetObjectKind().GroupVersionKind().Empty() {
+		return copiedObject, nil
+	}
+
+	gvks, _, err := scheme.ObjectKinds(copiedObject)
+	if err != nil {
+		logging.V(100).Infof("Failed to find GroupVersionKind of object %T due to %s", object, err.Error())
+
+		return nil, fmt.Errorf("failed to find GroupVersionKind of object %T: %w", object, err)
+	}
+
+	copiedObject.GetObjectKind().SetGroupVersionKind(gvks[0])
+
+	return copiedObject, nil
+}
+
+// getScheme returns the scheme with every type known to the clients package, building it on first use.
+func getScheme() (*runtime.Scheme, error) {
+	manifestSchemeOnce.Do(func() {
+		manifestScheme = runtime.NewScheme()
+		manifestSchemeErr = clients.SetScheme(manifestScheme)
+		manifestCodecs = serializer.NewCodecFactory(manifestScheme)
+	})
+
+	if manifestSchemeErr != nil {
+		logging.V(100).Infof("Failed to build manifest scheme due to %s", manifestSchemeErr.Error())
+
+		return nil, manifestSchemeErr
+	}
+
+	return manifestScheme, nil
+}
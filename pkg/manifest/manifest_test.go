@@ -0,0 +1,62 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestToYAML(t *testing.T) {
+	testCases := []struct {
+		object        runtime.Object
+		expectedYAML  string
+		expectedError string
+	}{
+		{
+			object: buildDummyConfigMap(),
+			expectedYAML: "apiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n  creationTimestamp: null\n" +
+				"  name: test-configmap\n  namespace: test-namespace\n",
+		},
+		{
+			object:        nil,
+			expectedError: "cannot render nil object",
+		},
+	}
+
+	for _, testCase := range testCases {
+		manifest, err := ToYAML(testCase.object)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedYAML, string(manifest))
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	configMap := buildDummyConfigMap()
+
+	manifest, err := ToJSON(configMap)
+	assert.Nil(t, err)
+	assert.Contains(t, string(manifest), "\"kind\": \"ConfigMap\"")
+	assert.Contains(t, string(manifest), "\"apiVersion\": \"v1\"")
+	assert.Contains(t, string(manifest), "\"name\": \"test-configmap\"")
+
+	// The input object must not be modified by the GroupVersionKind population.
+	assert.True(t, configMap.GetObjectKind().GroupVersionKind().Empty())
+}
+
+func buildDummyConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-configmap",
+			Namespace: "test-namespace",
+		},
+		Data: map[string]string{"key": "value"},
+	}
+}
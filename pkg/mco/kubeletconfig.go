@@ -2,12 +2,13 @@ package mco
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +26,7 @@ type KubeletConfigBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before KubeletConfig object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // AdditionalOptions for kubeletconfig object.
@@ -34,7 +35,7 @@ type AdditionalOptions func(builder *KubeletConfigBuilder) (*KubeletConfigBuilde
 // NewKubeletConfigBuilder provides struct for KubeletConfig object which contains connection to cluster
 // and KubeletConfig definition.
 func NewKubeletConfigBuilder(apiClient *clients.Settings, name string) *KubeletConfigBuilder {
-	glog.V(100).Infof("Initializing new KubeletConfigBuilder structure with the name: %s", name)
+	logging.V(100).Infof("Initializing new KubeletConfigBuilder structure with the name: %s", name)
 
 	builder := KubeletConfigBuilder{
 		apiClient: apiClient,
@@ -46,9 +47,9 @@ func NewKubeletConfigBuilder(apiClient *clients.Settings, name string) *KubeletC
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the KubeletConfig is empty")
+		logging.V(100).Infof("The name of the KubeletConfig is empty")
 
-		builder.errorMsg = "KubeletConfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("KubeletConfig 'name' cannot be empty"))
 	}
 
 	return &builder
@@ -56,7 +57,7 @@ func NewKubeletConfigBuilder(apiClient *clients.Settings, name string) *KubeletC
 
 // PullKubeletConfig fetches existing kubeletconfig from cluster.
 func PullKubeletConfig(apiClient *clients.Settings, name string) (*KubeletConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing kubeletconfig name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing kubeletconfig name %s from cluster", name)
 
 	builder := KubeletConfigBuilder{
 		apiClient: apiClient,
@@ -68,9 +69,9 @@ func PullKubeletConfig(apiClient *clients.Settings, name string) (*KubeletConfig
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the kubeletconfig is empty")
+		logging.V(100).Infof("The name of the kubeletconfig is empty")
 
-		builder.errorMsg = "kubeletconfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("kubeletconfig 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -88,7 +89,7 @@ func (builder *KubeletConfigBuilder) Create() (*KubeletConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating KubeletConfig %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating KubeletConfig %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -105,7 +106,7 @@ func (builder *KubeletConfigBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the kubeletconfig object %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the kubeletconfig object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("kubeletconfig cannot be deleted because it does not exist")
@@ -129,7 +130,7 @@ func (builder *KubeletConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if the kubeletconfig object %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if the kubeletconfig object %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.KubeletConfigs().Get(
@@ -144,12 +145,12 @@ func (builder *KubeletConfigBuilder) WithMCPoolSelector(key, value string) *Kube
 		return builder
 	}
 
-	glog.V(100).Infof("Labeling the kubeletconfig %s with %s=%s", builder.Definition.Name, key, value)
+	logging.V(100).Infof("Labeling the kubeletconfig %s with %s=%s", builder.Definition.Name, key, value)
 
 	if key == "" {
-		glog.V(100).Infof("The key can't be empty")
+		logging.V(100).Infof("The key can't be empty")
 
-		builder.errorMsg = "'key' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'key' cannot be empty"))
 
 		return builder
 	}
@@ -173,22 +174,22 @@ func (builder *KubeletConfigBuilder) WithSystemReserved(cpu, memory string) *Kub
 		return builder
 	}
 
-	glog.V(100).Infof("Setting cpu=%s and memory=%s in the %s kubeletconfig definition",
+	logging.V(100).Infof("Setting cpu=%s and memory=%s in the %s kubeletconfig definition",
 		cpu, memory, builder.Definition.Name)
 
 	if cpu == "" {
-		glog.V(100).Infof("The cpu can't be empty")
+		logging.V(100).Infof("The cpu can't be empty")
 
-		builder.errorMsg = "'cpu' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'cpu' cannot be empty"))
 	}
 
 	if memory == "" {
-		glog.V(100).Infof("The memory can't be empty")
+		logging.V(100).Infof("The memory can't be empty")
 
-		builder.errorMsg = "'memory' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'memory' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -214,16 +215,16 @@ func (builder *KubeletConfigBuilder) WithOptions(options ...AdditionalOptions) *
 		return builder
 	}
 
-	glog.V(100).Infof("Setting kubeletconfig additional options")
+	logging.V(100).Infof("Setting kubeletconfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -237,27 +238,29 @@ func (builder *KubeletConfigBuilder) validate() (bool, error) {
 	resourceCRD := "KubeletConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
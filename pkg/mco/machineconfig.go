@@ -2,12 +2,18 @@ package mco
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
-	"github.com/golang/glog"
+	ign3types "github.com/coreos/ignition/v2/config/v3_2/types"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcocommon "github.com/openshift/machine-config-operator/pkg/controller/common"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -22,7 +28,7 @@ type MCBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before MachineConfig object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // MCAdditionalOptions for machineconfig object.
@@ -31,7 +37,7 @@ type MCAdditionalOptions func(builder *MCBuilder) (*MCBuilder, error)
 // NewMCBuilder provides struct for MachineConfig object which contains connection to cluster
 // and MachineConfig definition.
 func NewMCBuilder(apiClient *clients.Settings, name string) *MCBuilder {
-	glog.V(100).Infof("Initializing new MCBuilder structure with following params: %s", name)
+	logging.V(100).Infof("Initializing new MCBuilder structure with following params: %s", name)
 
 	builder := MCBuilder{
 		apiClient: apiClient,
@@ -43,9 +49,9 @@ func NewMCBuilder(apiClient *clients.Settings, name string) *MCBuilder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the MachineConfig is empty")
+		logging.V(100).Infof("The name of the MachineConfig is empty")
 
-		builder.errorMsg = "MachineConfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineConfig 'name' cannot be empty"))
 	}
 
 	return &builder
@@ -53,7 +59,7 @@ func NewMCBuilder(apiClient *clients.Settings, name string) *MCBuilder {
 
 // PullMachineConfig fetches existing machineconfig from cluster.
 func PullMachineConfig(apiClient *clients.Settings, name string) (*MCBuilder, error) {
-	glog.V(100).Infof("Pulling existing machineconfig name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing machineconfig name %s from cluster", name)
 
 	builder := MCBuilder{
 		apiClient: apiClient,
@@ -65,9 +71,9 @@ func PullMachineConfig(apiClient *clients.Settings, name string) (*MCBuilder, er
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the machineconfig is empty")
+		logging.V(100).Infof("The name of the machineconfig is empty")
 
-		builder.errorMsg = "machineconfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("machineconfig 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -85,9 +91,13 @@ func (builder *MCBuilder) Create() (*MCBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating MachineConfig %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating MachineConfig %s", builder.Definition.Name)
+
+	err := mcocommon.ValidateMachineConfig(builder.Definition.Spec)
+	if err != nil {
+		return builder, fmt.Errorf("invalid MachineConfig %s: %w", builder.Definition.Name, err)
+	}
 
-	var err error
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.MachineConfigs().Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
@@ -102,7 +112,7 @@ func (builder *MCBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the MachineConfig object %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the MachineConfig object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("MachineConfig cannot be deleted because it does not exist")
@@ -126,9 +136,13 @@ func (builder *MCBuilder) Update() (*MCBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating machineconfig %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating machineconfig %s", builder.Definition.Name)
+
+	err := mcocommon.ValidateMachineConfig(builder.Definition.Spec)
+	if err != nil {
+		return builder, fmt.Errorf("invalid machineconfig %s: %w", builder.Definition.Name, err)
+	}
 
-	var err error
 	builder.Object, err = builder.apiClient.MachineConfigs().Update(
 		context.TODO(), builder.Definition, metav1.UpdateOptions{})
 
@@ -141,7 +155,7 @@ func (builder *MCBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if the MachineConfig object %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if the MachineConfig object %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.MachineConfigs().Get(
@@ -156,12 +170,12 @@ func (builder *MCBuilder) WithLabel(key, value string) *MCBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Labeling the machineconfig %s with %s=%s", builder.Definition.Name, key, value)
+	logging.V(100).Infof("Labeling the machineconfig %s with %s=%s", builder.Definition.Name, key, value)
 
 	if key == "" {
-		glog.V(100).Infof("The key can't be empty")
+		logging.V(100).Infof("The key can't be empty")
 
-		builder.errorMsg = "'key' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'key' cannot be empty"))
 
 		return builder
 	}
@@ -181,16 +195,16 @@ func (builder *MCBuilder) WithOptions(options ...MCAdditionalOptions) *MCBuilder
 		return builder
 	}
 
-	glog.V(100).Infof("Setting machineconfig additional options")
+	logging.V(100).Infof("Setting machineconfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -207,14 +221,14 @@ func (builder *MCBuilder) WithKernelArguments(kernelArgs []string) *MCBuilder {
 	}
 
 	if len(kernelArgs) == 0 {
-		glog.V(100).Infof("The kernelArgs can't be empty")
+		logging.V(100).Infof("The kernelArgs can't be empty")
 
-		builder.errorMsg = "'kernelArgs' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'kernelArgs' cannot be empty"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Setting KernelArguments: %v", kernelArgs)
+	logging.V(100).Infof("Setting KernelArguments: %v", kernelArgs)
 
 	builder.Definition.Spec.KernelArguments = kernelArgs
 
@@ -228,14 +242,14 @@ func (builder *MCBuilder) WithExtensions(extensions []string) *MCBuilder {
 	}
 
 	if len(extensions) == 0 {
-		glog.V(100).Infof("The extensions can't be empty")
+		logging.V(100).Infof("The extensions can't be empty")
 
-		builder.errorMsg = "'extensions' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'extensions' cannot be empty"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Extensions: %v", extensions)
+	logging.V(100).Infof("Setting Extensions: %v", extensions)
 
 	builder.Definition.Spec.Extensions = extensions
 
@@ -248,7 +262,7 @@ func (builder *MCBuilder) WithFIPS(fips bool) *MCBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting FIPS: %v", fips)
+	logging.V(100).Infof("Setting FIPS: %v", fips)
 
 	builder.Definition.Spec.FIPS = fips
 
@@ -262,45 +276,275 @@ func (builder *MCBuilder) WithKernelType(kernelType string) *MCBuilder {
 	}
 
 	if kernelType == "" {
-		glog.V(100).Infof("The kernelType can't be empty")
+		logging.V(100).Infof("The kernelType can't be empty")
 
-		builder.errorMsg = "'kernelType' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'kernelType' cannot be empty"))
 
 		return builder
 	}
 
-	glog.V(100).Infof("Setting KernelType: %v", kernelType)
+	logging.V(100).Infof("Setting KernelType: %v", kernelType)
 
 	builder.Definition.Spec.KernelType = kernelType
 
 	return builder
 }
 
+// WithFile adds a file with the given contents and mode to the ignition config of the MachineConfig. A file already
+// defined at the same path is replaced.
+func (builder *MCBuilder) WithFile(path, contents string, mode int) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding file %s with mode %#o to MachineConfig %s", path, mode, builder.Definition.Name)
+
+	if !filepath.IsAbs(path) {
+		logging.V(100).Infof("The file path must be absolute")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("file 'path' %s must be absolute", path))
+
+		return builder
+	}
+
+	if mode < 0 || os.FileMode(mode) > os.ModePerm {
+		logging.V(100).Infof("The file mode is invalid")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("invalid file 'mode' %#o, must be between 0 and %#o", mode, os.ModePerm))
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		file := mcocommon.NewIgnFileBytesOverwriting(path, []byte(contents))
+		file.Mode = &mode
+
+		for index := range config.Storage.Files {
+			if config.Storage.Files[index].Path == path {
+				config.Storage.Files[index] = file
+
+				return
+			}
+		}
+
+		config.Storage.Files = append(config.Storage.Files, file)
+	})
+}
+
+// WithSystemdUnit adds a systemd unit to the ignition config of the MachineConfig. When contents is empty only the
+// enablement of an existing unit is configured. A unit already defined with the same name is replaced, keeping its
+// dropins.
+func (builder *MCBuilder) WithSystemdUnit(name, contents string, enabled bool) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding systemd unit %s (enabled: %t) to MachineConfig %s",
+		name, enabled, builder.Definition.Name)
+
+	if name == "" {
+		logging.V(100).Infof("The systemd unit name is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("systemd unit 'name' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		unit := getOrAddSystemdUnit(config, name)
+		unit.Enabled = &enabled
+		unit.Contents = nil
+
+		if contents != "" {
+			unit.Contents = &contents
+		}
+	})
+}
+
+// WithSystemdDropin adds a dropin to the given systemd unit in the ignition config of the MachineConfig. The unit is
+// added without contents if it is not defined yet. A dropin already defined with the same name is replaced.
+func (builder *MCBuilder) WithSystemdDropin(unitName, dropinName, contents string) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding dropin %s of systemd unit %s to MachineConfig %s",
+		dropinName, unitName, builder.Definition.Name)
+
+	if unitName == "" {
+		logging.V(100).Infof("The systemd unit name is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("systemd unit 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if dropinName == "" {
+		logging.V(100).Infof("The systemd dropin name is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("systemd dropin 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if contents == "" {
+		logging.V(100).Infof("The systemd dropin contents are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("systemd dropin 'contents' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.updateIgnitionConfig(func(config *ign3types.Config) {
+		unit := getOrAddSystemdUnit(config, unitName)
+		dropin := ign3types.Dropin{Name: dropinName, Contents: &contents}
+
+		for index := range unit.Dropins {
+			if unit.Dropins[index].Name == dropinName {
+				unit.Dropins[index] = dropin
+
+				return
+			}
+		}
+
+		unit.Dropins = append(unit.Dropins, dropin)
+	})
+}
+
+// WithAdditionalKernelArguments appends the specified KernelArguments to the ones already set on the MachineConfig.
+func (builder *MCBuilder) WithAdditionalKernelArguments(kernelArgs ...string) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if len(kernelArgs) == 0 {
+		logging.V(100).Infof("The kernelArgs can't be empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'kernelArgs' cannot be empty"))
+
+		return builder
+	}
+
+	logging.V(100).Infof("Appending KernelArguments: %v", kernelArgs)
+
+	builder.Definition.Spec.KernelArguments = append(builder.Definition.Spec.KernelArguments, kernelArgs...)
+
+	return builder
+}
+
+// WithAdditionalExtensions appends the specified Extensions to the ones already set on the MachineConfig.
+func (builder *MCBuilder) WithAdditionalExtensions(extensions ...string) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if len(extensions) == 0 {
+		logging.V(100).Infof("The extensions can't be empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'extensions' cannot be empty"))
+
+		return builder
+	}
+
+	logging.V(100).Infof("Appending Extensions: %v", extensions)
+
+	builder.Definition.Spec.Extensions = append(builder.Definition.Spec.Extensions, extensions...)
+
+	return builder
+}
+
+// GetIgnitionConfig returns the ignition config of the MachineConfig definition, converted to the ignition spec
+// version used by the MachineConfig operator. An empty config is returned when the definition has none.
+func (builder *MCBuilder) GetIgnitionConfig() (*ign3types.Config, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting ignition config of MachineConfig %s", builder.Definition.Name)
+
+	if len(builder.Definition.Spec.Config.Raw) == 0 {
+		config := mcocommon.NewIgnConfig()
+
+		return &config, nil
+	}
+
+	config, err := mcocommon.ParseAndConvertConfig(builder.Definition.Spec.Config.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// updateIgnitionConfig applies the given mutation to the ignition config of the MachineConfig definition and stores
+// the rendered config back in the definition.
+func (builder *MCBuilder) updateIgnitionConfig(mutate func(config *ign3types.Config)) *MCBuilder {
+	config, err := builder.GetIgnitionConfig()
+	if err != nil {
+		logging.V(100).Infof("Failed to parse the ignition config of MachineConfig %s", builder.Definition.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to parse ignition config: %w", err))
+
+		return builder
+	}
+
+	mutate(config)
+
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		logging.V(100).Infof("Failed to render the ignition config of MachineConfig %s", builder.Definition.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to render ignition config: %w", err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Config.Raw = rawConfig
+
+	return builder
+}
+
+// getOrAddSystemdUnit returns the systemd unit with the given name in the ignition config, adding it when missing.
+func getOrAddSystemdUnit(config *ign3types.Config, name string) *ign3types.Unit {
+	for index := range config.Systemd.Units {
+		if config.Systemd.Units[index].Name == name {
+			return &config.Systemd.Units[index]
+		}
+	}
+
+	config.Systemd.Units = append(config.Systemd.Units, ign3types.Unit{Name: name})
+
+	return &config.Systemd.Units[len(config.Systemd.Units)-1]
+}
+
 func (builder *MCBuilder) validate() (bool, error) {
 	resourceCRD := "MachineConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,396 @@
+package mco
+
+import (
+	"testing"
+
+	ign3types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcocommon "github.com/openshift/machine-config-operator/pkg/controller/common"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultMCName = "99-worker-test"
+
+func TestMachineConfigWithFile(t *testing.T) {
+	testCases := []struct {
+		path          string
+		contents      string
+		mode          int
+		expectedFiles int
+		expectedError string
+	}{
+		{
+			path:          "/etc/test.conf",
+			contents:      "key=value",
+			mode:          0o644,
+			expectedFiles: 1,
+			expectedError: "",
+		},
+		{
+			path:          "/etc/existing.conf",
+			contents:      "key=new",
+			mode:          0o600,
+			expectedFiles: 1,
+			expectedError: "",
+		},
+		{
+			path:          "etc/test.conf",
+			contents:      "key=value",
+			mode:          0o644,
+			expectedError: "file 'path' etc/test.conf must be absolute",
+		},
+		{
+			path:          "/etc/test.conf",
+			contents:      "key=value",
+			mode:          0o1777,
+			expectedError: "invalid file 'mode' 01777, must be between 0 and 0777",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMCBuilder(buildTestClientWithMachineConfigV1(t)).
+			WithFile("/etc/existing.conf", "key=old", 0o644).
+			WithFile(testCase.path, testCase.contents, testCase.mode)
+
+		_, err := testBuilder.validate()
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		config, err := testBuilder.GetIgnitionConfig()
+		assert.Nil(t, err)
+
+		if testCase.path != "/etc/existing.conf" {
+			testCase.expectedFiles++
+		}
+
+		assert.Len(t, config.Storage.Files, testCase.expectedFiles)
+
+		file := config.Storage.Files[len(config.Storage.Files)-1]
+		assert.Equal(t, testCase.path, file.Path)
+		assert.Equal(t, testCase.mode, *file.Mode)
+		assert.True(t, *file.Overwrite)
+
+		contents, err := mcocommon.DecodeIgnitionFileContents(file.Contents.Source, file.Contents.Compression)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.contents, string(contents))
+	}
+}
+
+func TestMachineConfigWithSystemdUnit(t *testing.T) {
+	testCases := []struct {
+		name          string
+		contents      string
+		enabled       bool
+		expectedError string
+	}{
+		{
+			name:          "test.service",
+			contents:      "[Unit]\nDescription=test",
+			enabled:       true,
+			expectedError: "",
+		},
+		{
+			name:          "kubelet.service",
+			contents:      "",
+			enabled:       false,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			contents:      "[Unit]\nDescription=test",
+			enabled:       true,
+			expectedError: "systemd unit 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMCBuilder(buildTestClientWithMachineConfigV1(t)).
+			WithSystemdUnit(testCase.name, testCase.contents, testCase.enabled)
+
+		_, err := testBuilder.validate()
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		config, err := testBuilder.GetIgnitionConfig()
+		assert.Nil(t, err)
+		assert.Len(t, config.Systemd.Units, 1)
+
+		unit := config.Systemd.Units[0]
+		assert.Equal(t, testCase.name, unit.Name)
+		assert.Equal(t, testCase.enabled, *unit.Enabled)
+
+		if testCase.contents == "" {
+			assert.Nil(t, unit.Contents)
+		} else {
+			assert.Equal(t, testCase.contents, *unit.Contents)
+		}
+	}
+}
+
+func TestMachineConfigWithSystemdDropin(t *testing.T) {
+	testCases := []struct {
+		unitName        string
+		dropinName      string
+		contents        string
+		expectedDropins []string
+		expectedError   string
+	}{
+		{
+			unitName:        "test.service",
+			dropinName:      "20-new.conf",
+			contents:        "[Service]\nRestart=always",
+			expectedDropins: []string{"10-existing.conf", "20-new.conf"},
+			expectedError:   "",
+		},
+		{
+			unitName:        "test.service",
+			dropinName:      "10-existing.conf",
+			contents:        "[Service]\nRestart=always",
+			expectedDropins: []string{"10-existing.conf"},
+			expectedError:   "",
+		},
+		{
+			unitName:      "",
+			dropinName:    "20-new.conf",
+			contents:      "[Service]\nRestart=always",
+			expectedError: "systemd unit 'name' cannot be empty",
+		},
+		{
+			unitName:      "test.service",
+			dropinName:    "",
+			contents:      "[Service]\nRestart=always",
+			expectedError: "systemd dropin 'name' cannot be empty",
+		},
+		{
+			unitName:      "test.service",
+			dropinName:    "20-new.conf",
+			contents:      "",
+			expectedError: "systemd dropin 'contents' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMCBuilder(buildTestClientWithMachineConfigV1(t)).
+			WithSystemdDropin("test.service", "10-existing.conf", "[Service]\nRestart=no").
+			WithSystemdUnit("test.service", "[Unit]\nDescription=test", true).
+			WithSystemdDropin(testCase.unitName, testCase.dropinName, testCase.contents)
+
+		_, err := testBuilder.validate()
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		config, err := testBuilder.GetIgnitionConfig()
+		assert.Nil(t, err)
+		assert.Len(t, config.Systemd.Units, 1)
+
+		// Redefining the unit keeps the dropins added before.
+		unit := config.Systemd.Units[0]
+		assert.Equal(t, "[Unit]\nDescription=test", *unit.Contents)
+
+		var dropinNames []string
+
+		for _, dropin := range unit.Dropins {
+			dropinNames = append(dropinNames, dropin.Name)
+
+			if dropin.Name == testCase.dropinName {
+				assert.Equal(t, testCase.contents, *dropin.Contents)
+			}
+		}
+
+		assert.Equal(t, testCase.expectedDropins, dropinNames)
+	}
+}
+
+func TestMachineConfigWithAdditionalKernelArguments(t *testing.T) {
+	testCases := []struct {
+		kernelArgs    []string
+		expectedArgs  []string
+		expectedError string
+	}{
+		{
+			kernelArgs:    []string{"nosmt", "skew_tick=1"},
+			expectedArgs:  []string{"quiet", "nosmt", "skew_tick=1"},
+			expectedError: "",
+		},
+		{
+			kernelArgs:    nil,
+			expectedError: "'kernelArgs' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMCBuilder(buildTestClientWithMachineConfigV1(t)).
+			WithKernelArguments([]string{"quiet"}).
+			WithAdditionalKernelArguments(testCase.kernelArgs...)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedArgs, testBuilder.Definition.Spec.KernelArguments)
+		}
+	}
+}
+
+func TestMachineConfigWithAdditionalExtensions(t *testing.T) {
+	testCases := []struct {
+		extensions         []string
+		expectedExtensions []string
+		expectedError      string
+	}{
+		{
+			extensions:         []string{"usbguard", "kerberos"},
+			expectedExtensions: []string{"wasm", "usbguard", "kerberos"},
+			expectedError:      "",
+		},
+		{
+			extensions:    nil,
+			expectedError: "'extensions' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMCBuilder(buildTestClientWithMachineConfigV1(t)).
+			WithExtensions([]string{"wasm"}).
+			WithAdditionalExtensions(testCase.extensions...)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedExtensions, testBuilder.Definition.Spec.Extensions)
+		}
+	}
+}
+
+func TestMachineConfigGetIgnitionConfig(t *testing.T) {
+	testCases := []struct {
+		rawConfig       []byte
+		expectedVersion string
+		expectedFiles   int
+		expectedError   string
+	}{
+		{
+			rawConfig:       nil,
+			expectedVersion: ign3types.MaxVersion.String(),
+			expectedFiles:   0,
+			expectedError:   "",
+		},
+		{
+			rawConfig: []byte(`{"ignition":{"version":"3.1.0"},` +
+				`"storage":{"files":[{"path":"/etc/test.conf","contents":{"source":"data:,test"}}]}}`),
+			expectedVersion: ign3types.MaxVersion.String(),
+			expectedFiles:   1,
+			expectedError:   "",
+		},
+		{
+			rawConfig:     []byte(`{"ignition":`),
+			expectedError: "failed to parse Ignition config",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMCBuilder(buildTestClientWithMachineConfigV1(t))
+		testBuilder.Definition.Spec.Config.Raw = testCase.rawConfig
+
+		config, err := testBuilder.GetIgnitionConfig()
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedVersion, config.Ignition.Version)
+		assert.Len(t, config.Storage.Files, testCase.expectedFiles)
+	}
+}
+
+func TestMachineConfigCreate(t *testing.T) {
+	testCases := []struct {
+		kernelType    string
+		expectedError string
+	}{
+		{
+			kernelType:    "realtime",
+			expectedError: "",
+		},
+		{
+			kernelType:    "custom",
+			expectedError: "invalid MachineConfig " + defaultMCName + ": kernelType=custom is invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMCBuilder(buildTestClientWithMachineConfigV1(t)).
+			WithFile("/etc/test.conf", "key=value", 0o644).
+			WithSystemdUnit("test.service", "[Unit]\nDescription=test", true)
+		testBuilder.Definition.Spec.KernelType = testCase.kernelType
+
+		testBuilder, err := testBuilder.Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.True(t, testBuilder.Exists())
+
+			config, err := testBuilder.GetIgnitionConfig()
+			assert.Nil(t, err)
+			assert.Len(t, config.Storage.Files, 1)
+			assert.Len(t, config.Systemd.Units, 1)
+		}
+	}
+}
+
+func TestMachineConfigUpdate(t *testing.T) {
+	testCases := []struct {
+		kernelType    string
+		expectedError string
+	}{
+		{
+			kernelType:    "default",
+			expectedError: "",
+		},
+		{
+			kernelType:    "custom",
+			expectedError: "invalid machineconfig " + defaultMCName + ": kernelType=custom is invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := PullMachineConfig(
+			buildTestClientWithMachineConfigV1(t, buildDummyMachineConfig()), defaultMCName)
+		assert.Nil(t, err)
+
+		testBuilder.Definition.Spec.KernelType = testCase.kernelType
+
+		testBuilder, err = testBuilder.WithFile("/etc/test.conf", "key=value", 0o644).Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.True(t, testBuilder.Exists())
+			assert.Equal(t, testCase.kernelType, testBuilder.Object.Spec.KernelType)
+			assert.NotEmpty(t, testBuilder.Object.Spec.Config.Raw)
+		}
+	}
+}
+
+func buildValidMCBuilder(apiClient *clients.Settings) *MCBuilder {
+	return NewMCBuilder(apiClient, defaultMCName)
+}
+
+func buildDummyMachineConfig() *mcv1.MachineConfig {
+	return &mcv1.MachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   defaultMCName,
+			Labels: map[string]string{"machineconfiguration.openshift.io/role": "worker"},
+		},
+	}
+}
+
+func buildTestClientWithMachineConfigV1(t *testing.T, objects ...runtime.Object) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testSettings.MachineconfigurationV1Interface = testhelper.NewMachineConfigV1Client(t, objects...)
+
+	return testSettings
+}
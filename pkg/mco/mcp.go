@@ -2,15 +2,18 @@ package mco
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	daemonconsts "github.com/openshift/machine-config-operator/pkg/daemon/constants"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -32,7 +35,7 @@ type MCPBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before MachineConfigPool object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // MCPAdditionalOptions additional options for mcp object.
@@ -40,7 +43,7 @@ type MCPAdditionalOptions func(builder *MCPBuilder) (*MCPBuilder, error)
 
 // NewMCPBuilder method creates new instance of builder.
 func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new MCPBuilder structure with the following params: %s", mcpName)
 
 	builder := &MCPBuilder{
@@ -53,9 +56,9 @@ func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
 	}
 
 	if mcpName == "" {
-		glog.V(100).Infof("The name of the MachineConfigPool is empty")
+		logging.V(100).Infof("The name of the MachineConfigPool is empty")
 
-		builder.errorMsg = "MachineConfigPool 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MachineConfigPool 'name' cannot be empty"))
 	}
 
 	return builder
@@ -63,7 +66,7 @@ func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
 
 // Pull pulls existing machineconfigpool from cluster.
 func Pull(apiClient *clients.Settings, name string) (*MCPBuilder, error) {
-	glog.V(100).Infof("Pulling existing machineconfigpool name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing machineconfigpool name %s from cluster", name)
 
 	builder := MCPBuilder{
 		apiClient: apiClient,
@@ -75,9 +78,9 @@ func Pull(apiClient *clients.Settings, name string) (*MCPBuilder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the machineconfigpool is empty")
+		logging.V(100).Infof("The name of the machineconfigpool is empty")
 
-		builder.errorMsg = "machineconfigpool 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("machineconfigpool 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -95,7 +98,7 @@ func (builder *MCPBuilder) Create() (*MCPBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the MachineConfigPool %s",
+	logging.V(100).Infof("Creating the MachineConfigPool %s",
 		builder.Definition.Name)
 
 	var err error
@@ -113,7 +116,7 @@ func (builder *MCPBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the MachineConfigPool object %s",
+	logging.V(100).Infof("Deleting the MachineConfigPool object %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
@@ -136,7 +139,7 @@ func (builder *MCPBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if the MachineConfigPool object %s exists",
+	logging.V(100).Infof("Checking if the MachineConfigPool object %s exists",
 		builder.Definition.Name)
 
 	var err error
@@ -152,14 +155,15 @@ func (builder *MCPBuilder) WithMcSelector(mcSelector map[string]string) *MCPBuil
 		return builder
 	}
 
-	glog.V(100).Infof("WithMcSelector updates builder object with "+
+	logging.V(100).Infof("WithMcSelector updates builder object with "+
 		"machineConfigSelector label: %v", mcSelector)
 
 	if len(mcSelector) == 0 {
-		builder.errorMsg = "'machineConfigSelector MatchLabels' field cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'machineConfigSelector MatchLabels' field cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -179,7 +183,7 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 		return err
 	}
 
-	glog.V(100).Infof("WaitToBeInCondition waits up to specified time duration %v until "+
+	logging.V(100).Infof("WaitToBeInCondition waits up to specified time duration %v until "+
 		"MachineConfigPool condition %v is met", timeout, conditionType)
 
 	return wait.PollUntilContextTimeout(
@@ -201,46 +205,69 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 		})
 }
 
-// WaitForUpdate waits for a MachineConfigPool to be updating and then updated.
+// Pause pauses the MachineConfigPool, preventing the machine config daemon from applying new configs to its nodes.
+func (builder *MCPBuilder) Pause() (*MCPBuilder, error) {
+	return builder.setPaused(true)
+}
+
+// Resume resumes the MachineConfigPool, allowing the machine config daemon to apply pending configs to its nodes.
+func (builder *MCPBuilder) Resume() (*MCPBuilder, error) {
+	return builder.setPaused(false)
+}
+
+// WaitForUpdate waits for timeout duration or until all machines of the MachineConfigPool are updated to the rendered
+// config. It fails early when a machine of the pool is degraded and on timeout reports the nodes which are not
+// updated yet.
 func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("WaitForUpdate waits up to specified time %v until updating"+
+	logging.V(100).Infof("WaitForUpdate waits up to specified time %v until updating"+
 		" machineConfigPool object is updated", timeout)
 
-	mcpUpdating, err := builder.apiClient.MachineConfigPools().Get(context.TODO(),
-		builder.Object.Name, metav1.GetOptions{})
+	if !builder.Exists() {
+		return fmt.Errorf("MachineConfigPool %s does not exist", builder.Definition.Name)
+	}
 
-	if err != nil {
-		return err
+	if builder.Object.Spec.Paused {
+		logging.V(100).Infof("MachineConfigPool %s is paused, its machines are not updated until it is resumed",
+			builder.Definition.Name)
 	}
 
-	for _, condition := range mcpUpdating.Status.Conditions {
-		if condition.Type == "Updating" && condition.Status == isTrue {
-			err := wait.PollUntilContextTimeout(
-				context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-					mcpUpdated, err := builder.apiClient.MachineConfigPools().Get(context.TODO(),
-						builder.Object.Name, metav1.GetOptions{})
+	var degradedErr error
 
-					if err != nil {
-						return false, nil
-					}
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
 
-					for _, condition := range mcpUpdated.Status.Conditions {
-						if condition.Type == "Updated" && condition.Status == isTrue {
-							return true, nil
-						}
-					}
+			status := builder.Object.Status
 
-					return false, nil
-				})
+			logging.V(100).Infof("MachineConfigPool %s machineCount: %d, updatedMachineCount: %d, "+
+				"degradedMachineCount: %d", builder.Definition.Name,
+				status.MachineCount, status.UpdatedMachineCount, status.DegradedMachineCount)
 
-			if err != nil {
-				return err
+			if status.DegradedMachineCount > 0 {
+				degradedErr = fmt.Errorf("MachineConfigPool %s has %d degraded machines: %s",
+					builder.Definition.Name, status.DegradedMachineCount, builder.describeStuckNodes())
+
+				return false, degradedErr
 			}
-		}
+
+			return status.ObservedGeneration == builder.Object.Generation &&
+				status.UpdatedMachineCount == status.MachineCount &&
+				mcov1.IsMachineConfigPoolConditionTrue(status.Conditions, mcov1.MachineConfigPoolUpdated), nil
+		})
+
+	if degradedErr != nil {
+		return degradedErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("MachineConfigPool %s was not updated before timeout %v, nodes not updated: %s: %w",
+			builder.Definition.Name, timeout, builder.describeStuckNodes(), err)
 	}
 
 	return nil
@@ -252,7 +279,7 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 		return err
 	}
 
-	glog.V(100).Infof("WaitToBeStableFor waits up to duration of %v for "+
+	logging.V(100).Infof("WaitToBeStableFor waits up to duration of %v for "+
 		"MachineConfigPool to be stable for %v", timeout, stableDuration)
 
 	isMcpStable := true
@@ -272,7 +299,7 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 					if builder.Object.Status.ReadyMachineCount != builder.Object.Status.MachineCount ||
 						builder.Object.Status.MachineCount != builder.Object.Status.UpdatedMachineCount ||
 						builder.Object.Status.DegradedMachineCount != 0 {
-						glog.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
+						logging.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
 							"machineCount: %v "+"vs machineCountUpdated: "+"%v vs readyMachineCount: %v and "+
 							"degradedMachineCount is : %v \n", builder.Object.ObjectMeta.Name,
 							builder.Object.Status.MachineCount, builder.Object.Status.UpdatedMachineCount,
@@ -287,14 +314,14 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 				})
 
 			if isMcpStable {
-				glog.V(100).Infof("MachineConfigPool was stable during during stableDuration: %v",
+				logging.V(100).Infof("MachineConfigPool was stable during during stableDuration: %v",
 					stableDuration)
 
 				// this will exit the outer wait.PollUntilContextTimeout block since the mcp was stable during stableDuration
 				return true, nil
 			}
 
-			glog.V(100).Infof("MachineConfigPool was not stable during stableDuration: %v, retrying ...",
+			logging.V(100).Infof("MachineConfigPool was not stable during stableDuration: %v, retrying ...",
 				stableDuration)
 
 			// keep iterating in the outer wait.PollUntilContextTimeout waiting for cluster to be stable
@@ -303,10 +330,10 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 
 	// After the timout in outer wait.PollUntilContextTimeout.
 	if err == nil {
-		glog.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
+		logging.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
 	} else {
 		// Here err is "timed out waiting for the condition"
-		glog.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
+		logging.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
 	}
 
 	return err
@@ -318,16 +345,16 @@ func (builder *MCPBuilder) WithOptions(options ...MCPAdditionalOptions) *MCPBuil
 		return builder
 	}
 
-	glog.V(100).Infof("Setting mcp additional options")
+	logging.V(100).Infof("Setting mcp additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -344,7 +371,7 @@ func (builder *MCPBuilder) IsInCondition(mcpConditionType mcov1.MachineConfigPoo
 		return false
 	}
 
-	glog.V(100).Infof("IsInCondition returns true"+
+	logging.V(100).Infof("IsInCondition returns true"+
 		" if MachineConfigPool object is in a given condition %v, otherwise false", mcpConditionType)
 
 	if builder.Exists() {
@@ -358,33 +385,102 @@ func (builder *MCPBuilder) IsInCondition(mcpConditionType mcov1.MachineConfigPoo
 	return false
 }
 
+// setPaused sets the paused field of the MachineConfigPool on the cluster.
+func (builder *MCPBuilder) setPaused(paused bool) (*MCPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Setting paused to %t on MachineConfigPool %s", paused, builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("MachineConfigPool %s does not exist", builder.Definition.Name)
+	}
+
+	builder.Definition = builder.Object.DeepCopy()
+	builder.Definition.Spec.Paused = paused
+
+	var err error
+	builder.Object, err = builder.apiClient.MachineConfigPools().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// describeStuckNodes returns the nodes of the MachineConfigPool which are not running the rendered config of the pool
+// or are not done applying it, along with the state and reason reported by the machine config daemon.
+func (builder *MCPBuilder) describeStuckNodes() string {
+	if builder.Object == nil || builder.Object.Spec.NodeSelector == nil {
+		return "unknown"
+	}
+
+	nodeSelector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.NodeSelector)
+	if err != nil {
+		return fmt.Sprintf("unknown, invalid nodeSelector: %v", err)
+	}
+
+	nodeList, err := builder.apiClient.CoreV1Interface.Nodes().List(
+		context.TODO(), metav1.ListOptions{LabelSelector: nodeSelector.String()})
+	if err != nil {
+		return fmt.Sprintf("unknown, failed to list nodes: %v", err)
+	}
+
+	var stuckNodes []string
+
+	for _, node := range nodeList.Items {
+		currentConfig := node.Annotations[daemonconsts.CurrentMachineConfigAnnotationKey]
+		state := node.Annotations[daemonconsts.MachineConfigDaemonStateAnnotationKey]
+
+		if currentConfig == builder.Object.Spec.Configuration.Name &&
+			state == daemonconsts.MachineConfigDaemonStateDone {
+			continue
+		}
+
+		description := fmt.Sprintf("%s (currentConfig: %s, state: %s", node.Name, currentConfig, state)
+
+		if reason := node.Annotations[daemonconsts.MachineConfigDaemonReasonAnnotationKey]; reason != "" {
+			description += fmt.Sprintf(", reason: %s", reason)
+		}
+
+		stuckNodes = append(stuckNodes, description+")")
+	}
+
+	if len(stuckNodes) == 0 {
+		return "none"
+	}
+
+	return strings.Join(stuckNodes, ", ")
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MCPBuilder) validate() (bool, error) {
 	resourceCRD := "MachineConfigPool"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,189 @@
+package mco
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	daemonconsts "github.com/openshift/machine-config-operator/pkg/daemon/constants"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultMCPName          = "worker-cnf"
+	defaultMCPRenderedName  = "rendered-worker-cnf-new"
+	defaultMCPPreviousName  = "rendered-worker-cnf-old"
+	defaultMCPNodeRoleLabel = "node-role.kubernetes.io/worker-cnf"
+)
+
+func TestMCPPauseResume(t *testing.T) {
+	testCases := []struct {
+		pause               bool
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			pause:               true,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			pause:               false,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			pause:               true,
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Sprintf("MachineConfigPool %s does not exist", defaultMCPName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var mcpObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			mcp := buildDummyMCP(0, 0)
+			mcp.Spec.Paused = !testCase.pause
+
+			mcpObjects = append(mcpObjects, mcp)
+		}
+
+		testBuilder := NewMCPBuilder(buildTestClientWithMCP(t, nil, mcpObjects...), defaultMCPName)
+
+		var err error
+
+		if testCase.pause {
+			testBuilder, err = testBuilder.Pause()
+		} else {
+			testBuilder, err = testBuilder.Resume()
+		}
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.True(t, testBuilder.Exists())
+			assert.Equal(t, testCase.pause, testBuilder.Object.Spec.Paused)
+		}
+	}
+}
+
+func TestMCPWaitForUpdate(t *testing.T) {
+	testCases := []struct {
+		updatedMachines     int32
+		degradedMachines    int32
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			updatedMachines:     2,
+			degradedMachines:    0,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			updatedMachines:     1,
+			degradedMachines:    0,
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("MachineConfigPool %s was not updated before timeout 1s, nodes not updated: "+
+				"worker-1 (currentConfig: %s, state: Working): context deadline exceeded",
+				defaultMCPName, defaultMCPPreviousName),
+		},
+		{
+			updatedMachines:     1,
+			degradedMachines:    1,
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("MachineConfigPool %s has 1 degraded machines: "+
+				"worker-1 (currentConfig: %s, state: Degraded, reason: failed to drain node)",
+				defaultMCPName, defaultMCPPreviousName),
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Sprintf("MachineConfigPool %s does not exist", defaultMCPName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var mcpObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			mcpObjects = append(mcpObjects, buildDummyMCP(testCase.updatedMachines, testCase.degradedMachines))
+		}
+
+		stuckState := daemonconsts.MachineConfigDaemonStateWorking
+		stuckReason := ""
+
+		if testCase.degradedMachines > 0 {
+			stuckState = daemonconsts.MachineConfigDaemonStateDegraded
+			stuckReason = "failed to drain node"
+		}
+
+		nodes := []runtime.Object{
+			buildDummyMCPNode("worker-0", defaultMCPRenderedName, daemonconsts.MachineConfigDaemonStateDone, ""),
+			buildDummyMCPNode("worker-1", defaultMCPPreviousName, stuckState, stuckReason),
+		}
+
+		testBuilder := NewMCPBuilder(buildTestClientWithMCP(t, nodes, mcpObjects...), defaultMCPName)
+
+		err := testBuilder.WaitForUpdate(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildDummyMCP(updatedMachines, degradedMachines int32) *mcov1.MachineConfigPool {
+	updatedStatus := corev1.ConditionFalse
+
+	if updatedMachines == 2 {
+		updatedStatus = corev1.ConditionTrue
+	}
+
+	return &mcov1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       defaultMCPName,
+			Generation: 2,
+		},
+		Spec: mcov1.MachineConfigPoolSpec{
+			NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{defaultMCPNodeRoleLabel: ""}},
+			Configuration: mcov1.MachineConfigPoolStatusConfiguration{
+				ObjectReference: corev1.ObjectReference{Name: defaultMCPRenderedName},
+			},
+		},
+		Status: mcov1.MachineConfigPoolStatus{
+			ObservedGeneration:   2,
+			MachineCount:         2,
+			UpdatedMachineCount:  updatedMachines,
+			DegradedMachineCount: degradedMachines,
+			Conditions: []mcov1.MachineConfigPoolCondition{{
+				Type:   mcov1.MachineConfigPoolUpdated,
+				Status: updatedStatus,
+			}},
+		},
+	}
+}
+
+func buildDummyMCPNode(name, currentConfig, state, reason string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{defaultMCPNodeRoleLabel: ""},
+			Annotations: map[string]string{
+				daemonconsts.CurrentMachineConfigAnnotationKey:      currentConfig,
+				daemonconsts.MachineConfigDaemonStateAnnotationKey:  state,
+				daemonconsts.MachineConfigDaemonReasonAnnotationKey: reason,
+			},
+		},
+	}
+}
+
+func buildTestClientWithMCP(t *testing.T, nodes []runtime.Object, mcpObjects ...runtime.Object) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: nodes})
+	testSettings.MachineconfigurationV1Interface = testhelper.NewMachineConfigV1Client(t, mcpObjects...)
+
+	return testSettings
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -18,7 +18,7 @@ func ListMCP(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*MCP
 	logMessage := "Listing all MCP resources"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -28,12 +28,12 @@ func ListMCP(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*MCP
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	mcpList, err := apiClient.MachineConfigPools().List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list MCP objects due to %s", err.Error())
+		logging.V(100).Infof("Failed to list MCP objects due to %s", err.Error())
 
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ListMCP(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*MCP
 // ListMCPByMachineConfigSelector returns a list of MachineConfigurationPoolBuilders for given selector.
 func ListMCPByMachineConfigSelector(
 	apiClient *clients.Settings, mcpLabel string, options ...metav1.ListOptions) (*MCPBuilder, error) {
-	glog.V(100).Infof("GetByLabel returns MachineConfigPool with the specified label: %v", mcpLabel)
+	logging.V(100).Infof("GetByLabel returns MachineConfigPool with the specified label: %v", mcpLabel)
 
 	mcpList, err := ListMCP(apiClient, options...)
 
@@ -87,7 +87,7 @@ func ListMCPByMachineConfigSelector(
 // ListMCPWaitToBeStableFor waits for a given MachineConfigurationPool to be stable for a given period.
 func ListMCPWaitToBeStableFor(
 	apiClient *clients.Settings, stableDuration, timeout time.Duration, options ...metav1.ListOptions) error {
-	glog.V(100).Infof("WaitForMcpListToBeStableFor waits up to duration of %v for "+
+	logging.V(100).Infof("WaitForMcpListToBeStableFor waits up to duration of %v for "+
 		"MachineConfigPoolList to be stable for %v", timeout, stableDuration)
 
 	isMcpListStable := true
@@ -115,7 +115,7 @@ func ListMCPWaitToBeStableFor(
 							mcp.Object.Status.DegradedMachineCount != 0 {
 							isMcpListStable = false
 
-							glog.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
+							logging.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
 								"machineCount: %v "+"vs machineCountUpdated: "+"%v vs readyMachineCount: %v and "+
 								"degradedMachineCount is : %v \n", mcp.Object.ObjectMeta.Name,
 								mcp.Object.Status.MachineCount, mcp.Object.Status.UpdatedMachineCount,
@@ -131,14 +131,14 @@ func ListMCPWaitToBeStableFor(
 				})
 
 			if isMcpListStable {
-				glog.V(100).Infof("MachineConfigPools were stable during during stableDuration: %v",
+				logging.V(100).Infof("MachineConfigPools were stable during during stableDuration: %v",
 					stableDuration)
 
 				// exit the outer wait.PollUntilContextTimeout block since the mcps were stable during stableDuration.
 				return true, nil
 			}
 
-			glog.V(100).Infof("MachineConfigPools were not stable during stableDuration: %v, retrying ...",
+			logging.V(100).Infof("MachineConfigPools were not stable during stableDuration: %v, retrying ...",
 				stableDuration)
 
 			// keep iterating in the outer wait.PollUntilContextTimeout waiting for cluster to be stable.
@@ -146,10 +146,10 @@ func ListMCPWaitToBeStableFor(
 		})
 
 	if err == nil {
-		glog.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
+		logging.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
 	} else {
 		// Here err is "timed out waiting for the condition"
-		glog.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
+		logging.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
 	}
 
 	return err
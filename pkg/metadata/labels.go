@@ -0,0 +1,129 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithLabels merges the given labels into the labels of the object. Labels with other keys are kept and labels with
+// the same keys are overwritten.
+func WithLabels(labels map[string]string) Mutator {
+	return func(object metav1.Object) error {
+		logging.V(100).Infof("Adding labels %v to object %s", labels, object.GetName())
+
+		if len(labels) == 0 {
+			logging.V(100).Infof("The labels are empty")
+
+			return fmt.Errorf("labels cannot be empty")
+		}
+
+		for key, value := range labels {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+			}
+
+			if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+				return fmt.Errorf("invalid value %q of label %s: %s", value, key, strings.Join(errs, "; "))
+			}
+		}
+
+		object.SetLabels(mergeMaps(object.GetLabels(), labels))
+
+		return nil
+	}
+}
+
+// WithAnnotations merges the given annotations into the annotations of the object. Annotations with other keys are
+// kept and annotations with the same keys are overwritten.
+func WithAnnotations(annotations map[string]string) Mutator {
+	return func(object metav1.Object) error {
+		logging.V(100).Infof("Adding annotations %v to object %s", annotations, object.GetName())
+
+		if len(annotations) == 0 {
+			logging.V(100).Infof("The annotations are empty")
+
+			return fmt.Errorf("annotations cannot be empty")
+		}
+
+		for key := range annotations {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, "; "))
+			}
+		}
+
+		object.SetAnnotations(mergeMaps(object.GetAnnotations(), annotations))
+
+		return nil
+	}
+}
+
+// RemoveLabel removes the label with the given key from the object on the cluster, usually the Object of a builder.
+// The object is updated with the response of the API server.
+func RemoveLabel(apiClient *clients.Settings, object runtimeClient.Object, key string) error {
+	return removeMetadataKey(apiClient, object, "labels", key)
+}
+
+// RemoveAnnotation removes the annotation with the given key from the object on the cluster, usually the Object of
+// a builder. The object is updated with the response of the API server.
+func RemoveAnnotation(apiClient *clients.Settings, object runtimeClient.Object, key string) error {
+	return removeMetadataKey(apiClient, object, "annotations", key)
+}
+
+// removeMetadataKey sends a merge patch that removes the key from the given metadata field of the object.
+func removeMetadataKey(apiClient *clients.Settings, object runtimeClient.Object, field, key string) error {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return fmt.Errorf("failed to remove %s, 'apiClient' parameter is empty", field)
+	}
+
+	if object == nil || reflectNil(object) {
+		logging.V(100).Infof("The object is nil")
+
+		return fmt.Errorf("failed to remove %s, 'object' parameter is empty", field)
+	}
+
+	if key == "" {
+		logging.V(100).Infof("The key is empty")
+
+		return fmt.Errorf("failed to remove %s, 'key' parameter is empty", field)
+	}
+
+	logging.V(100).Infof("Removing %s key %s from object %s in namespace %s",
+		field, key, object.GetName(), object.GetNamespace())
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			field: map[string]interface{}{key: nil},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return apiClient.Patch(context.TODO(), object, runtimeClient.RawPatch(types.MergePatchType, patch))
+}
+
+// mergeMaps returns a copy of base with the entries of overrides added.
+func mergeMaps(base, overrides map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(overrides))
+
+	for key, value := range base {
+		merged[key] = value
+	}
+
+	for key, value := range overrides {
+		merged[key] = value
+	}
+
+	return merged
+}
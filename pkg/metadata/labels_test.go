@@ -0,0 +1,97 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/service"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWithLabels(t *testing.T) {
+	testCases := []struct {
+		labels         map[string]string
+		expectedLabels map[string]string
+		expectedError  string
+	}{
+		{
+			labels:         map[string]string{"app": "new", "tier": "backend"},
+			expectedLabels: map[string]string{"app": "new", "tier": "backend", "existing": "label"},
+		},
+		{
+			labels:        map[string]string{},
+			expectedError: "labels cannot be empty",
+		},
+		{
+			labels:        map[string]string{"bad key": "value"},
+			expectedError: "invalid label key \"bad key\"",
+		},
+		{
+			labels:        map[string]string{"app": "bad value"},
+			expectedError: "invalid value \"bad value\" of label app",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testObject := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: "test-pod", Labels: map[string]string{"app": "old", "existing": "label"}}}
+
+		err := WithLabels(testCase.labels)(testObject)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedLabels, testObject.Labels)
+	}
+}
+
+func TestWithAnnotations(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testBuilder := service.NewBuilder(testSettings, "test-service", "test-namespace", nil, corev1.ServicePort{Port: 80}).
+		WithAnnotation(map[string]string{"existing": "annotation"}).
+		WithOptions(AsBuilderOption[*service.Builder](WithAnnotations(map[string]string{"example.com/key": "value"})))
+	assert.Equal(t,
+		map[string]string{"existing": "annotation", "example.com/key": "value"}, testBuilder.Definition.Annotations)
+
+	err := WithAnnotations(nil)(&corev1.Pod{})
+	assert.EqualError(t, err, "annotations cannot be empty")
+
+	err = WithAnnotations(map[string]string{"/bad": "value"})(&corev1.Pod{})
+	assert.ErrorContains(t, err, "invalid annotation key \"/bad\"")
+}
+
+func TestRemoveLabelAndAnnotation(t *testing.T) {
+	testRoute := &routev1.Route{ObjectMeta: metav1.ObjectMeta{
+		Name:        "test-route",
+		Namespace:   "test-namespace",
+		Labels:      map[string]string{"app": "test", "tier": "backend"},
+		Annotations: map[string]string{"example.com/key": "value"},
+	}}
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{testRoute}})
+
+	err := RemoveLabel(testSettings, testRoute, "tier")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"app": "test"}, testRoute.Labels)
+
+	err = RemoveAnnotation(testSettings, testRoute, "example.com/key")
+	assert.Nil(t, err)
+	assert.Empty(t, testRoute.Annotations)
+
+	err = RemoveLabel(nil, testRoute, "app")
+	assert.EqualError(t, err, "failed to remove labels, 'apiClient' parameter is empty")
+
+	err = RemoveLabel(testSettings, nil, "app")
+	assert.EqualError(t, err, "failed to remove labels, 'object' parameter is empty")
+
+	err = RemoveAnnotation(testSettings, testRoute, "")
+	assert.EqualError(t, err, "failed to remove annotations, 'key' parameter is empty")
+}
@@ -0,0 +1,64 @@
+package metadata
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Mutator changes the metadata of a builder definition, e.g. its labels or owner references.
+type Mutator func(object metav1.Object) error
+
+// AsBuilderOption converts the mutators into an additional option of any builder with a Definition field, so they can
+// be passed to its WithOptions method, e.g.
+//
+//	builder.WithOptions(metadata.AsBuilderOption[*service.Builder](metadata.WithOwnerReference(owner, true)))
+func AsBuilderOption[B any](mutators ...Mutator) func(builder B) (B, error) {
+	return func(builder B) (B, error) {
+		definition, err := getDefinition(builder)
+		if err != nil {
+			return builder, err
+		}
+
+		for _, mutator := range mutators {
+			if mutator == nil {
+				continue
+			}
+
+			err = mutator(definition)
+			if err != nil {
+				return builder, err
+			}
+		}
+
+		return builder, nil
+	}
+}
+
+// getDefinition returns the Definition field of the builder.
+func getDefinition(builder interface{}) (metav1.Object, error) {
+	value := reflect.ValueOf(builder)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		logging.V(100).Infof("The builder %T is not a valid pointer", builder)
+
+		return nil, fmt.Errorf("builder %T must be a non-nil pointer", builder)
+	}
+
+	field := value.Elem().FieldByName("Definition")
+	if !field.IsValid() || field.Kind() != reflect.Pointer || field.IsNil() {
+		logging.V(100).Infof("The builder %T has no Definition", builder)
+
+		return nil, fmt.Errorf("builder %T has no Definition", builder)
+	}
+
+	definition, ok := field.Interface().(metav1.Object)
+	if !ok {
+		logging.V(100).Infof("The Definition of builder %T has no object metadata", builder)
+
+		return nil, fmt.Errorf("definition of builder %T has no object metadata", builder)
+	}
+
+	return definition, nil
+}
@@ -0,0 +1,70 @@
+package metadata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/configmap"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAsBuilderOption(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	labelMutator := func(object metav1.Object) error {
+		object.SetLabels(map[string]string{"app": "test"})
+
+		return nil
+	}
+
+	testBuilder := configmap.NewBuilder(testSettings, "test-configmap", "test-namespace").
+		WithOptions(AsBuilderOption[*configmap.Builder](labelMutator, nil))
+	assert.Equal(t, map[string]string{"app": "test"}, testBuilder.Definition.Labels)
+
+	failingMutator := func(object metav1.Object) error {
+		return fmt.Errorf("test error")
+	}
+
+	_, err := AsBuilderOption[*configmap.Builder](failingMutator)(
+		configmap.NewBuilder(testSettings, "test-configmap", "test-namespace"))
+	assert.EqualError(t, err, "test error")
+}
+
+func TestGetDefinition(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testCases := []struct {
+		builder       interface{}
+		expectedError string
+	}{
+		{
+			builder: configmap.NewBuilder(testSettings, "test-configmap", "test-namespace"),
+		},
+		{
+			builder:       (*configmap.Builder)(nil),
+			expectedError: "builder *configmap.Builder must be a non-nil pointer",
+		},
+		{
+			builder:       &configmap.Builder{},
+			expectedError: "builder *configmap.Builder has no Definition",
+		},
+		{
+			builder:       &struct{ Definition *corev1.ServicePort }{Definition: &corev1.ServicePort{}},
+			expectedError: "definition of builder *struct { Definition *v1.ServicePort } has no object metadata",
+		},
+	}
+
+	for _, testCase := range testCases {
+		definition, err := getDefinition(testCase.builder)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, "test-configmap", definition.GetName())
+	}
+}
@@ -0,0 +1,150 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+)
+
+// WithOwnerReference adds an owner reference to the given owner, usually the Object of another builder. The owner
+// must exist on the cluster since the reference needs its UID. When controller is true the owner also becomes the
+// managing controller, which fails if the object already has a different controller.
+func WithOwnerReference(owner metav1.Object, controller bool) Mutator {
+	return func(object metav1.Object) error {
+		if owner == nil || reflectNil(owner) {
+			logging.V(100).Infof("The owner is nil")
+
+			return fmt.Errorf("owner cannot be nil")
+		}
+
+		logging.V(100).Infof("Adding owner reference to %s to object %s", owner.GetName(), object.GetName())
+
+		if owner.GetUID() == "" {
+			logging.V(100).Infof("The owner %s has no UID", owner.GetName())
+
+			return fmt.Errorf("owner %s has no UID, it must exist on the cluster", owner.GetName())
+		}
+
+		runtimeOwner, ok := owner.(runtime.Object)
+		if !ok {
+			return fmt.Errorf("owner %s is not a runtime object", owner.GetName())
+		}
+
+		gvk, err := manifest.GroupVersionKind(runtimeOwner)
+		if err != nil {
+			return err
+		}
+
+		ownerReference := metav1.OwnerReference{
+			APIVersion:         gvk.GroupVersion().String(),
+			Kind:               gvk.Kind,
+			Name:               owner.GetName(),
+			UID:                owner.GetUID(),
+			Controller:         ptr.To(controller),
+			BlockOwnerDeletion: ptr.To(true),
+		}
+
+		var ownerReferences []metav1.OwnerReference
+
+		for _, reference := range object.GetOwnerReferences() {
+			if reference.UID == owner.GetUID() {
+				continue
+			}
+
+			if controller && reference.Controller != nil && *reference.Controller {
+				logging.V(100).Infof("The object %s is already controlled by %s", object.GetName(), reference.Name)
+
+				return fmt.Errorf("object %s is already controlled by %s %s", object.GetName(), reference.Kind, reference.Name)
+			}
+
+			ownerReferences = append(ownerReferences, reference)
+		}
+
+		object.SetOwnerReferences(append(ownerReferences, ownerReference))
+
+		return nil
+	}
+}
+
+// ListDependents returns the objects of the given resource in the namespace that reference the owner with the given
+// UID. An empty nsname lists the dependents in all namespaces.
+func ListDependents(
+	apiClient *clients.Settings,
+	gvr schema.GroupVersionResource,
+	nsname string,
+	ownerUID types.UID) ([]unstructured.Unstructured, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("failed to list dependents, 'apiClient' parameter is empty")
+	}
+
+	if ownerUID == "" {
+		logging.V(100).Infof("The owner UID is empty")
+
+		return nil, fmt.Errorf("failed to list dependents, 'ownerUID' parameter is empty")
+	}
+
+	logging.V(100).Infof("Listing %s in namespace %s owned by %s", gvr.Resource, nsname, ownerUID)
+
+	objectList, err := apiClient.Resource(gvr).Namespace(nsname).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		logging.V(100).Infof("Failed to list %s in namespace %s due to %s", gvr.Resource, nsname, err.Error())
+
+		return nil, err
+	}
+
+	var dependents []unstructured.Unstructured
+
+	for _, object := range objectList.Items {
+		for _, reference := range object.GetOwnerReferences() {
+			if reference.UID == ownerUID {
+				dependents = append(dependents, object)
+
+				break
+			}
+		}
+	}
+
+	return dependents, nil
+}
+
+// WaitForDependentsDeleted waits up to timeout until the garbage collector removed all objects of the given resource
+// in the namespace that reference the owner with the given UID.
+func WaitForDependentsDeleted(
+	apiClient *clients.Settings,
+	gvr schema.GroupVersionResource,
+	nsname string,
+	ownerUID types.UID,
+	timeout time.Duration) error {
+	logging.V(100).Infof("Waiting for %s in namespace %s owned by %s to be deleted", gvr.Resource, nsname, ownerUID)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			dependents, err := ListDependents(apiClient, gvr, nsname, ownerUID)
+			if err != nil {
+				return false, err
+			}
+
+			return len(dependents) == 0, nil
+		})
+}
+
+// reflectNil checks whether the interface holds a nil pointer.
+func reflectNil(object metav1.Object) bool {
+	value := reflect.ValueOf(object)
+
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
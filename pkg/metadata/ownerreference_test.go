@@ -0,0 +1,127 @@
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+var testRouteGVR = schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+
+func TestWithOwnerReference(t *testing.T) {
+	testOwner := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-owner", UID: "test-uid"}}
+
+	testCases := []struct {
+		owner              metav1.Object
+		controller         bool
+		existingReferences []metav1.OwnerReference
+		expectedReferences []metav1.OwnerReference
+		expectedError      string
+	}{
+		{
+			owner:      testOwner,
+			controller: true,
+			expectedReferences: []metav1.OwnerReference{
+				buildOwnerReference("apps/v1", "Deployment", "test-owner", "test-uid", true)},
+		},
+		{
+			owner:      testOwner,
+			controller: false,
+			existingReferences: []metav1.OwnerReference{
+				buildOwnerReference("v1", "ConfigMap", "other-owner", "other-uid", true),
+				buildOwnerReference("apps/v1", "Deployment", "test-owner", "test-uid", true),
+			},
+			expectedReferences: []metav1.OwnerReference{
+				buildOwnerReference("v1", "ConfigMap", "other-owner", "other-uid", true),
+				buildOwnerReference("apps/v1", "Deployment", "test-owner", "test-uid", false),
+			},
+		},
+		{
+			owner:      testOwner,
+			controller: true,
+			existingReferences: []metav1.OwnerReference{
+				buildOwnerReference("v1", "ConfigMap", "other-owner", "other-uid", true)},
+			expectedError: "object test-object is already controlled by ConfigMap other-owner",
+		},
+		{
+			owner:         &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-owner"}},
+			expectedError: "owner test-owner has no UID, it must exist on the cluster",
+		},
+		{
+			owner:         (*appsv1.Deployment)(nil),
+			expectedError: "owner cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testObject := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-object", OwnerReferences: testCase.existingReferences}}
+
+		err := WithOwnerReference(testCase.owner, testCase.controller)(testObject)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedReferences, testObject.OwnerReferences)
+	}
+}
+
+func TestListDependents(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: buildDummyRoutes()})
+
+	dependents, err := ListDependents(testSettings, testRouteGVR, "test-namespace", "test-uid")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(dependents))
+	assert.Equal(t, "owned-route", dependents[0].GetName())
+
+	_, err = ListDependents(nil, testRouteGVR, "test-namespace", "test-uid")
+	assert.EqualError(t, err, "failed to list dependents, 'apiClient' parameter is empty")
+
+	_, err = ListDependents(testSettings, testRouteGVR, "test-namespace", "")
+	assert.EqualError(t, err, "failed to list dependents, 'ownerUID' parameter is empty")
+}
+
+func TestWaitForDependentsDeleted(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: buildDummyRoutes()})
+
+	err := WaitForDependentsDeleted(testSettings, testRouteGVR, "test-namespace", "other-uid", time.Second)
+	assert.Nil(t, err)
+
+	err = WaitForDependentsDeleted(testSettings, testRouteGVR, "test-namespace", "test-uid", time.Second)
+	assert.NotNil(t, err)
+}
+
+func buildDummyRoutes() []runtime.Object {
+	return []runtime.Object{
+		&routev1.Route{ObjectMeta: metav1.ObjectMeta{
+			Name:      "owned-route",
+			Namespace: "test-namespace",
+			OwnerReferences: []metav1.OwnerReference{
+				buildOwnerReference("apps/v1", "Deployment", "test-owner", "test-uid", true)},
+		}},
+		&routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: "other-route", Namespace: "test-namespace"}},
+	}
+}
+
+func buildOwnerReference(apiVersion, kind, name, uid string, controller bool) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion:         apiVersion,
+		Kind:               kind,
+		Name:               name,
+		UID:                types.UID(uid),
+		Controller:         ptr.To(controller),
+		BlockOwnerDeletion: ptr.To(true),
+	}
+}
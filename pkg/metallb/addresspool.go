@@ -1,11 +1,15 @@
 package metallb
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"strings"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +29,7 @@ type IPAddressPoolBuilder struct {
 	Definition *mlbtypes.IPAddressPool
 	Object     *mlbtypes.IPAddressPool
 	apiClient  *clients.Settings
-	errorMsg   string
+	errorMsg   error
 }
 
 // IPAddressPoolAdditionalOptions additional options for IPAddressPool object.
@@ -34,7 +38,7 @@ type IPAddressPoolAdditionalOptions func(builder *IPAddressPoolBuilder) (*IPAddr
 // NewIPAddressPoolBuilder creates a new instance of IPAddressPoolBuilder.
 func NewIPAddressPoolBuilder(
 	apiClient *clients.Settings, name, nsname string, addrPool []string) *IPAddressPoolBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new IPAddressPool structure with the following params: %s, %s %s",
 		name, nsname, addrPool)
 
@@ -55,21 +59,29 @@ func NewIPAddressPoolBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the IPAddressPool is empty")
+		logging.V(100).Infof("The name of the IPAddressPool is empty")
 
-		builder.errorMsg = "IPAddressPool 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("IPAddressPool 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the IPAddressPool is empty")
+		logging.V(100).Infof("The namespace of the IPAddressPool is empty")
 
-		builder.errorMsg = "IPAddressPool 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("IPAddressPool 'nsname' cannot be empty"))
 	}
 
 	if len(addrPool) < 1 {
-		glog.V(100).Infof("The addrPool of the IPAddressPool is empty list")
+		logging.V(100).Infof("The addrPool of the IPAddressPool is empty list")
 
-		builder.errorMsg = "IPAddressPool 'addrPool' cannot be empty list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list"))
+	}
+
+	for _, address := range addrPool {
+		if err := validateIPAddressPoolAddress(address); err != nil {
+			logging.V(100).Infof("The addrPool of the IPAddressPool contains invalid address %s", address)
+
+			builder.errorMsg = errors.Join(builder.errorMsg, err)
+		}
 	}
 
 	return &builder
@@ -81,7 +93,7 @@ func (builder *IPAddressPoolBuilder) Get() (*mlbtypes.IPAddressPool, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting IPAddressPool object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -90,7 +102,7 @@ func (builder *IPAddressPoolBuilder) Get() (*mlbtypes.IPAddressPool, error) {
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"IPAddressPool object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -106,7 +118,7 @@ func (builder *IPAddressPoolBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if IPAddressPool %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -118,10 +130,10 @@ func (builder *IPAddressPoolBuilder) Exists() bool {
 
 // PullAddressPool pulls existing addresspool from cluster.
 func PullAddressPool(apiClient *clients.Settings, name, nsname string) (*IPAddressPoolBuilder, error) {
-	glog.V(100).Infof("Pulling existing addresspool name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing addresspool name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("addresspool 'apiClient' cannot be empty")
 	}
@@ -137,13 +149,13 @@ func PullAddressPool(apiClient *clients.Settings, name, nsname string) (*IPAddre
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the addresspool is empty")
+		logging.V(100).Infof("The name of the addresspool is empty")
 
 		return nil, fmt.Errorf("addresspool 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the addresspool is empty")
+		logging.V(100).Infof("The namespace of the addresspool is empty")
 
 		return nil, fmt.Errorf("addresspool 'namespace' cannot be empty")
 	}
@@ -163,7 +175,7 @@ func (builder *IPAddressPoolBuilder) Create() (*IPAddressPoolBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the IPAddressPool %s in namespace %s",
+	logging.V(100).Infof("Creating the IPAddressPool %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -172,7 +184,7 @@ func (builder *IPAddressPoolBuilder) Create() (*IPAddressPoolBuilder, error) {
 		unstructuredIPAddressPool, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured IPAddressPool to unstructured object")
+			logging.V(100).Infof("Failed to convert structured IPAddressPool to unstructured object")
 
 			return nil, err
 		}
@@ -182,7 +194,7 @@ func (builder *IPAddressPoolBuilder) Create() (*IPAddressPoolBuilder, error) {
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredIPAddressPool}, metav1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create IPAddressPool")
+			logging.V(100).Infof("Failed to create IPAddressPool")
 
 			return nil, err
 		}
@@ -203,7 +215,7 @@ func (builder *IPAddressPoolBuilder) Delete() (*IPAddressPoolBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the IPAddressPool object %s in namespace %s",
+	logging.V(100).Infof("Deleting the IPAddressPool object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -230,14 +242,20 @@ func (builder *IPAddressPoolBuilder) Update(force bool) (*IPAddressPoolBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the IPAddressPool object %s in namespace %s",
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to update IPAddressPool, object doesn't exist on cluster")
+	}
+
+	logging.V(100).Infof("Updating the IPAddressPool object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
 	unstructuredIPAddressPool, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured IPAddressPool to unstructured object")
+		logging.V(100).Infof("Failed to convert structured IPAddressPool to unstructured object")
 
 		return nil, err
 	}
@@ -248,13 +266,13 @@ func (builder *IPAddressPoolBuilder) Update(force bool) (*IPAddressPoolBuilder,
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("IPAddressPool", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("IPAddressPool", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -273,7 +291,7 @@ func (builder *IPAddressPoolBuilder) WithAutoAssign(auto bool) *IPAddressPoolBui
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating IPAddressPool %s in namespace %s with this autoAssign flag: %t",
 		builder.Definition.Name, builder.Definition.Namespace, auto)
 
@@ -288,7 +306,7 @@ func (builder *IPAddressPoolBuilder) WithAvoidBuggyIPs(avoid bool) *IPAddressPoo
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating IPAddressPool %s in namespace %s with this avoidBuggyIPs flag: %t",
 		builder.Definition.Name, builder.Definition.Namespace, avoid)
 
@@ -297,21 +315,132 @@ func (builder *IPAddressPoolBuilder) WithAvoidBuggyIPs(avoid bool) *IPAddressPoo
 	return builder
 }
 
+// WithServiceAllocationPriority sets the priority of the IPAddressPool when allocating an IP to a service. The pool
+// with the lowest priority value is preferred among the pools matching the service.
+func (builder *IPAddressPoolBuilder) WithServiceAllocationPriority(priority int) *IPAddressPoolBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating IPAddressPool %s in namespace %s with this serviceAllocation priority: %d",
+		builder.Definition.Name, builder.Definition.Namespace, priority)
+
+	if priority < 0 {
+		logging.V(100).Infof("The serviceAllocation priority of the IPAddressPool is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"IPAddressPool serviceAllocation 'priority' cannot be negative"))
+
+		return builder
+	}
+
+	builder.getServiceAllocation().Priority = priority
+
+	return builder
+}
+
+// WithServiceAllocationNamespaces restricts the IPAddressPool to services in the given namespaces.
+func (builder *IPAddressPoolBuilder) WithServiceAllocationNamespaces(namespaces []string) *IPAddressPoolBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating IPAddressPool %s in namespace %s with these serviceAllocation namespaces: %v",
+		builder.Definition.Name, builder.Definition.Namespace, namespaces)
+
+	if len(namespaces) == 0 {
+		logging.V(100).Infof("The serviceAllocation namespaces of the IPAddressPool are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"IPAddressPool serviceAllocation 'namespaces' cannot be empty list"))
+
+		return builder
+	}
+
+	for _, namespace := range namespaces {
+		if namespace == "" {
+			logging.V(100).Infof("The serviceAllocation namespaces of the IPAddressPool contain an empty entry")
+
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"IPAddressPool serviceAllocation 'namespaces' cannot contain empty entry"))
+
+			return builder
+		}
+	}
+
+	builder.getServiceAllocation().Namespaces = namespaces
+
+	return builder
+}
+
+// WithServiceAllocationNamespaceSelectors restricts the IPAddressPool to services in the namespaces matching any of
+// the given selectors.
+func (builder *IPAddressPoolBuilder) WithServiceAllocationNamespaceSelectors(
+	selectors []metav1.LabelSelector) *IPAddressPoolBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating IPAddressPool %s in namespace %s with these serviceAllocation namespaceSelectors: %v",
+		builder.Definition.Name, builder.Definition.Namespace, selectors)
+
+	if err := validateLabelSelectors(selectors); err != nil {
+		logging.V(100).Infof("The serviceAllocation namespaceSelectors of the IPAddressPool are invalid")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"IPAddressPool serviceAllocation 'namespaceSelectors' are invalid: %w", err))
+
+		return builder
+	}
+
+	builder.getServiceAllocation().NamespaceSelectors = selectors
+
+	return builder
+}
+
+// WithServiceAllocationServiceSelectors restricts the IPAddressPool to services matching any of the given selectors.
+func (builder *IPAddressPoolBuilder) WithServiceAllocationServiceSelectors(
+	selectors []metav1.LabelSelector) *IPAddressPoolBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating IPAddressPool %s in namespace %s with these serviceAllocation serviceSelectors: %v",
+		builder.Definition.Name, builder.Definition.Namespace, selectors)
+
+	if err := validateLabelSelectors(selectors); err != nil {
+		logging.V(100).Infof("The serviceAllocation serviceSelectors of the IPAddressPool are invalid")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"IPAddressPool serviceAllocation 'serviceSelectors' are invalid: %w", err))
+
+		return builder
+	}
+
+	builder.getServiceAllocation().ServiceSelectors = selectors
+
+	return builder
+}
+
 // WithOptions creates IPAddressPool with generic mutation options.
 func (builder *IPAddressPoolBuilder) WithOptions(options ...IPAddressPoolAdditionalOptions) *IPAddressPoolBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting IPAddressPool additional options")
+	logging.V(100).Infof("Setting IPAddressPool additional options")
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The IPAddressPool is undefined")
+		logging.V(100).Infof("The IPAddressPool is undefined")
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString("IPAddressPool")
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("IPAddressPool")))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -320,9 +449,9 @@ func (builder *IPAddressPoolBuilder) WithOptions(options ...IPAddressPoolAdditio
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -345,40 +474,119 @@ func (builder *IPAddressPoolBuilder) validate() (bool, error) {
 	resourceCRD := "IPAddressPool"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
 }
 
+// getServiceAllocation returns the serviceAllocation of the IPAddressPool definition, initializing it if needed.
+func (builder *IPAddressPoolBuilder) getServiceAllocation() *mlbtypes.ServiceAllocation {
+	if builder.Definition.Spec.AllocateTo == nil {
+		builder.Definition.Spec.AllocateTo = &mlbtypes.ServiceAllocation{}
+	}
+
+	return builder.Definition.Spec.AllocateTo
+}
+
+// validateIPAddressPoolAddress checks that the address is either a CIDR or an explicit start-end range of IPs of the
+// same family, which are the two formats accepted by MetalLB.
+func validateIPAddressPoolAddress(address string) error {
+	if !strings.Contains(address, "-") {
+		if _, _, err := net.ParseCIDR(address); err != nil {
+			return fmt.Errorf("IPAddressPool address %s is not a valid CIDR", address)
+		}
+
+		return nil
+	}
+
+	startAddress, endAddress, _ := strings.Cut(address, "-")
+	startIP := net.ParseIP(strings.TrimSpace(startAddress))
+	endIP := net.ParseIP(strings.TrimSpace(endAddress))
+
+	if startIP == nil || endIP == nil {
+		return fmt.Errorf("IPAddressPool address %s is not a valid IP range", address)
+	}
+
+	if (startIP.To4() == nil) != (endIP.To4() == nil) {
+		return fmt.Errorf("IPAddressPool address %s mixes IPv4 and IPv6 addresses", address)
+	}
+
+	if bytes.Compare(startIP.To16(), endIP.To16()) > 0 {
+		return fmt.Errorf("IPAddressPool address %s has start IP greater than end IP", address)
+	}
+
+	return nil
+}
+
+// validateIPAddressPoolsExist checks that every one of the IPAddressPools referenced by an advertisement exists in
+// the given namespace.
+func validateIPAddressPoolsExist(apiClient *clients.Settings, nsname string, poolNames []string) error {
+	for _, poolName := range poolNames {
+		poolBuilder := &IPAddressPoolBuilder{
+			apiClient: apiClient,
+			Definition: &mlbtypes.IPAddressPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      poolName,
+					Namespace: nsname,
+				},
+			},
+		}
+
+		if !poolBuilder.Exists() {
+			return fmt.Errorf("IPAddressPool %s referenced by the advertisement doesn't exist in namespace %s",
+				poolName, nsname)
+		}
+	}
+
+	return nil
+}
+
+// validateLabelSelectors checks that the selectors list is not empty and every selector in it is valid.
+func validateLabelSelectors(selectors []metav1.LabelSelector) error {
+	if len(selectors) == 0 {
+		return fmt.Errorf("selectors cannot be empty list")
+	}
+
+	for index := range selectors {
+		if _, err := metav1.LabelSelectorAsSelector(&selectors[index]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (builder *IPAddressPoolBuilder) convertToStructured(
 	unsObject *unstructured.Unstructured) (*mlbtypes.IPAddressPool, error) {
 	ipAddressPool := &mlbtypes.IPAddressPool{}
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, ipAddressPool)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to ipAddressPool object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to ipAddressPool object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
@@ -1,10 +1,12 @@
 package metallb
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,7 +22,7 @@ var (
 	}
 	defaultIPAddressPoolName = "default-pool"
 	defaultNsName            = "test-namespace"
-	defaultIPPoolRange       = []string{"1.1.1.1", "1.1.1.20"}
+	defaultIPPoolRange       = []string{"1.1.1.1-1.1.1.20", "2.2.2.0/24"}
 )
 
 func TestPullAddressPool(t *testing.T) {
@@ -114,19 +116,19 @@ func TestNewIPAddressPoolBuilder(t *testing.T) {
 		{
 			name:          "addresspool",
 			namespace:     "test-namespace",
-			addrPool:      []string{"1.1.1.1", "1.1.1.20"},
+			addrPool:      []string{"1.1.1.1-1.1.1.20", "2.2.2.0/24"},
 			expectedError: "",
 		},
 		{
 			name:          "",
 			namespace:     "test-namespace",
-			addrPool:      []string{"1.1.1.1", "1.1.1.20"},
+			addrPool:      []string{"1.1.1.1-1.1.1.20", "2.2.2.0/24"},
 			expectedError: "IPAddressPool 'name' cannot be empty",
 		},
 		{
 			name:          "addresspool",
 			namespace:     "",
-			addrPool:      []string{"1.1.1.1", "1.1.1.20"},
+			addrPool:      []string{"1.1.1.1-1.1.1.20", "2.2.2.0/24"},
 			expectedError: "IPAddressPool 'nsname' cannot be empty",
 		},
 		{
@@ -135,6 +137,18 @@ func TestNewIPAddressPoolBuilder(t *testing.T) {
 			addrPool:      []string{},
 			expectedError: "IPAddressPool 'addrPool' cannot be empty list",
 		},
+		{
+			name:          "addresspool",
+			namespace:     "test-namespace",
+			addrPool:      []string{"1.1.1.1"},
+			expectedError: "IPAddressPool address 1.1.1.1 is not a valid CIDR",
+		},
+		{
+			name:          "addresspool",
+			namespace:     "test-namespace",
+			addrPool:      []string{"1.1.1.20-1.1.1.1"},
+			expectedError: "IPAddressPool address 1.1.1.20-1.1.1.1 has start IP greater than end IP",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -143,7 +157,7 @@ func TestNewIPAddressPoolBuilder(t *testing.T) {
 		})
 		testIPAddressPoolBuilder := generateIPAddressPoolBuilder(
 			testSettings, testCase.name, testCase.namespace, testCase.addrPool)
-		assert.Equal(t, testCase.expectedError, testIPAddressPoolBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, testIPAddressPoolBuilder.errorMsg)
 		assert.NotNil(t, testIPAddressPoolBuilder.Definition)
 
 		if testCase.expectedError == "" {
@@ -164,7 +178,7 @@ func TestIPAddressPoolGet(t *testing.T) {
 		},
 		{
 			testIPAddressPool: buildInValidIPAddressPoolBuilder(buildTestClientWithDummyObject()),
-			expectedError:     fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list"),
+			expectedError:     errors.Join(fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list")),
 		},
 	}
 
@@ -210,7 +224,7 @@ func TestIPAddressPoolCreate(t *testing.T) {
 		},
 		{
 			testIPAddressPool: buildInValidIPAddressPoolBuilder(buildTestClientWithDummyObject()),
-			expectedError:     fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list"),
+			expectedError:     errors.Join(fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list")),
 		},
 	}
 
@@ -235,7 +249,7 @@ func TestIPAddressPoolDelete(t *testing.T) {
 		},
 		{
 			testIPAddressPool: buildInValidIPAddressPoolBuilder(buildTestClientWithDummyObject()),
-			expectedError:     fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list"),
+			expectedError:     errors.Join(fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list")),
 		},
 	}
 
@@ -262,7 +276,7 @@ func TestIPAddressPoolUpdate(t *testing.T) {
 		},
 		{
 			testIPAddressPool: buildInValidIPAddressPoolBuilder(buildTestClientWithDummyObject()),
-			expectedError:     fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list"),
+			expectedError:     errors.Join(fmt.Errorf("IPAddressPool 'addrPool' cannot be empty list")),
 			autoAssign:        false,
 		},
 	}
@@ -329,12 +343,136 @@ func TestIPAddressPoolWithOptions(t *testing.T) {
 			return builder, nil
 		})
 
-	assert.Equal(t, "", testBuilder.errorMsg)
+	assert.Nil(t, testBuilder.errorMsg)
 	testBuilder = buildValidIPAddressPoolBuilder(testSettings).WithOptions(
 		func(builder *IPAddressPoolBuilder) (*IPAddressPoolBuilder, error) {
 			return builder, fmt.Errorf("error")
 		})
-	assert.Equal(t, "error", testBuilder.errorMsg)
+	assert.EqualError(t, testBuilder.errorMsg, "error")
+}
+
+func TestIPAddressPoolWithServiceAllocationPriority(t *testing.T) {
+	testCases := []struct {
+		priority      int
+		expectedError string
+	}{
+		{
+			priority:      10,
+			expectedError: "",
+		},
+		{
+			priority:      -1,
+			expectedError: "IPAddressPool serviceAllocation 'priority' cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIPAddressPoolBuilder(buildTestClientWithDummyObject()).
+			WithServiceAllocationPriority(testCase.priority)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.priority, testBuilder.Definition.Spec.AllocateTo.Priority)
+		}
+	}
+}
+
+func TestIPAddressPoolWithServiceAllocationNamespaces(t *testing.T) {
+	testCases := []struct {
+		namespaces    []string
+		expectedError string
+	}{
+		{
+			namespaces:    []string{"test-ns-1", "test-ns-2"},
+			expectedError: "",
+		},
+		{
+			namespaces:    []string{},
+			expectedError: "IPAddressPool serviceAllocation 'namespaces' cannot be empty list",
+		},
+		{
+			namespaces:    []string{"test-ns-1", ""},
+			expectedError: "IPAddressPool serviceAllocation 'namespaces' cannot contain empty entry",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIPAddressPoolBuilder(buildTestClientWithDummyObject()).
+			WithServiceAllocationPriority(5).
+			WithServiceAllocationNamespaces(testCase.namespaces)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.namespaces, testBuilder.Definition.Spec.AllocateTo.Namespaces)
+			assert.Equal(t, 5, testBuilder.Definition.Spec.AllocateTo.Priority)
+		}
+	}
+}
+
+func TestIPAddressPoolWithServiceAllocationSelectors(t *testing.T) {
+	testCases := []struct {
+		selectors     []metav1.LabelSelector
+		expectedError string
+	}{
+		{
+			selectors:     []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "test"}}},
+			expectedError: "",
+		},
+		{
+			selectors:     []metav1.LabelSelector{},
+			expectedError: "selectors cannot be empty list",
+		},
+		{
+			selectors: []metav1.LabelSelector{{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Unknown", Values: []string{"test"}}}}},
+			expectedError: "\"Unknown\" is not a valid label selector operator",
+		},
+	}
+
+	for _, testCase := range testCases {
+		namespaceSelectorBuilder := buildValidIPAddressPoolBuilder(buildTestClientWithDummyObject()).
+			WithServiceAllocationNamespaceSelectors(testCase.selectors)
+		serviceSelectorBuilder := buildValidIPAddressPoolBuilder(buildTestClientWithDummyObject()).
+			WithServiceAllocationServiceSelectors(testCase.selectors)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, namespaceSelectorBuilder.errorMsg)
+			assert.Nil(t, serviceSelectorBuilder.errorMsg)
+			assert.Equal(t, testCase.selectors, namespaceSelectorBuilder.Definition.Spec.AllocateTo.NamespaceSelectors)
+			assert.Equal(t, testCase.selectors, serviceSelectorBuilder.Definition.Spec.AllocateTo.ServiceSelectors)
+		} else {
+			assert.ErrorContains(t, namespaceSelectorBuilder.errorMsg,
+				"IPAddressPool serviceAllocation 'namespaceSelectors' are invalid: "+testCase.expectedError)
+			assert.ErrorContains(t, serviceSelectorBuilder.errorMsg,
+				"IPAddressPool serviceAllocation 'serviceSelectors' are invalid: "+testCase.expectedError)
+		}
+	}
+}
+
+func TestValidateIPAddressPoolAddress(t *testing.T) {
+	testCases := []struct {
+		address       string
+		expectedError string
+	}{
+		{address: "192.168.1.0/24", expectedError: ""},
+		{address: "2001:db8::/64", expectedError: ""},
+		{address: "192.168.1.10-192.168.1.20", expectedError: ""},
+		{address: "192.168.1.10 - 192.168.1.10", expectedError: ""},
+		{address: "2001:db8::1-2001:db8::10", expectedError: ""},
+		{address: "192.168.1.0/33", expectedError: "IPAddressPool address 192.168.1.0/33 is not a valid CIDR"},
+		{address: "192.168.1.10-", expectedError: "IPAddressPool address 192.168.1.10- is not a valid IP range"},
+		{
+			address:       "192.168.1.10-2001:db8::10",
+			expectedError: "IPAddressPool address 192.168.1.10-2001:db8::10 mixes IPv4 and IPv6 addresses",
+		},
+		{
+			address:       "192.168.1.20-192.168.1.10",
+			expectedError: "IPAddressPool address 192.168.1.20-192.168.1.10 has start IP greater than end IP",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := validateIPAddressPoolAddress(testCase.address)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
 }
 
 func TestGetIPAddressPoolGVR(t *testing.T) {
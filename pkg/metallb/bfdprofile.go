@@ -2,10 +2,11 @@ package metallb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +26,7 @@ type BFDBuilder struct {
 	Definition *mlbtypes.BFDProfile
 	Object     *mlbtypes.BFDProfile
 	apiClient  *clients.Settings
-	errorMsg   string
+	errorMsg   error
 }
 
 // BFDAdditionalOptions additional options for BFDProfile object.
@@ -33,7 +34,7 @@ type BFDAdditionalOptions func(builder *BFDBuilder) (*BFDBuilder, error)
 
 // NewBFDBuilder creates a new instance of BFDBuilder.
 func NewBFDBuilder(apiClient *clients.Settings, name, nsname string) *BFDBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new BFDBuilder structure with the following params: %s, %s",
 		name, nsname)
 
@@ -52,15 +53,15 @@ func NewBFDBuilder(apiClient *clients.Settings, name, nsname string) *BFDBuilder
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the BFDProfile is empty")
+		logging.V(100).Infof("The name of the BFDProfile is empty")
 
-		builder.errorMsg = "BFDProfile 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BFDProfile 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the BFDProfile is empty")
+		logging.V(100).Infof("The namespace of the BFDProfile is empty")
 
-		builder.errorMsg = "BFDProfile 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BFDProfile 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -72,7 +73,7 @@ func (builder *BFDBuilder) Get() (*mlbtypes.BFDProfile, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting BFDProfile object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -81,7 +82,7 @@ func (builder *BFDBuilder) Get() (*mlbtypes.BFDProfile, error) {
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"BFDProfile object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -97,7 +98,7 @@ func (builder *BFDBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if BFDProfile %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -109,10 +110,10 @@ func (builder *BFDBuilder) Exists() bool {
 
 // PullBFDProfile pulls existing bfdprofile from cluster.
 func PullBFDProfile(apiClient *clients.Settings, name, nsname string) (*BFDBuilder, error) {
-	glog.V(100).Infof("Pulling existing bfdprofile name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing bfdprofile name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("bfdprofile 'apiClient' cannot be empty")
 	}
@@ -128,13 +129,13 @@ func PullBFDProfile(apiClient *clients.Settings, name, nsname string) (*BFDBuild
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the bfdprofile is empty")
+		logging.V(100).Infof("The name of the bfdprofile is empty")
 
 		return nil, fmt.Errorf("bfdprofile 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the bfdprofile is empty")
+		logging.V(100).Infof("The namespace of the bfdprofile is empty")
 
 		return nil, fmt.Errorf("bfdprofile 'namespace' cannot be empty")
 	}
@@ -154,7 +155,7 @@ func (builder *BFDBuilder) Create() (*BFDBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the BFDProfile %s in namespace %s",
+	logging.V(100).Infof("Creating the BFDProfile %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -163,7 +164,7 @@ func (builder *BFDBuilder) Create() (*BFDBuilder, error) {
 		unstructuredBfdProfile, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured BFDProfile to unstructured object")
+			logging.V(100).Infof("Failed to convert structured BFDProfile to unstructured object")
 
 			return nil, err
 		}
@@ -173,7 +174,7 @@ func (builder *BFDBuilder) Create() (*BFDBuilder, error) {
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredBfdProfile}, metav1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create BFDProfile")
+			logging.V(100).Infof("Failed to create BFDProfile")
 
 			return nil, err
 		}
@@ -194,7 +195,7 @@ func (builder *BFDBuilder) Delete() (*BFDBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the BFDProfile object %s in namespace %s",
+	logging.V(100).Infof("Deleting the BFDProfile object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -221,14 +222,14 @@ func (builder *BFDBuilder) Update(force bool) (*BFDBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the BFDProfile object %s in namespace %s",
+	logging.V(100).Infof("Updating the BFDProfile object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	unstructuredBfdProfile, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured BFDProfile to unstructured object")
+		logging.V(100).Infof("Failed to convert structured BFDProfile to unstructured object")
 
 		return nil, err
 	}
@@ -239,13 +240,13 @@ func (builder *BFDBuilder) Update(force bool) (*BFDBuilder, error) {
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("BFDProfile", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("BFDProfile", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -279,7 +280,7 @@ func (builder *BFDBuilder) WithMultiplier(multiplier uint32) *BFDBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BFDProfile %s in namespace %s with this detectMultiplier: %d",
 		builder.Definition.Name, builder.Definition.Namespace, multiplier)
 
@@ -304,7 +305,7 @@ func (builder *BFDBuilder) WithMinimumTTL(minimumTTL uint32) *BFDBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BFDProfile %s in namespace %s with this minimumTTL: %d",
 		builder.Definition.Name, builder.Definition.Namespace, minimumTTL)
 
@@ -319,16 +320,16 @@ func (builder *BFDBuilder) WithOptions(options ...BFDAdditionalOptions) *BFDBuil
 		return builder
 	}
 
-	glog.V(100).Infof("Setting BFDProfile additional options")
+	logging.V(100).Infof("Setting BFDProfile additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -350,7 +351,7 @@ func (builder *BFDBuilder) withBoolFlagFor(flagName string, flagValue bool) *BFD
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BFDProfile %s in namespace %s with flag %s: %t",
 		builder.Definition.Name, builder.Definition.Namespace, flagName, flagValue)
 
@@ -360,7 +361,7 @@ func (builder *BFDBuilder) withBoolFlagFor(flagName string, flagValue bool) *BFD
 	case "passiveMode":
 		builder.Definition.Spec.PassiveMode = &flagValue
 	default:
-		builder.errorMsg = "invalid bool flag name parameter"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid bool flag name parameter"))
 	}
 
 	return builder
@@ -371,7 +372,7 @@ func (builder *BFDBuilder) withInterval(intervalName string, interval uint32) *B
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BFDProfile %s in namespace %s with interval %s: %d",
 		builder.Definition.Name, builder.Definition.Namespace, intervalName, interval)
 
@@ -383,7 +384,7 @@ func (builder *BFDBuilder) withInterval(intervalName string, interval uint32) *B
 	case "ecoInterval":
 		builder.Definition.Spec.EchoInterval = &interval
 	default:
-		builder.errorMsg = "invalid interval parameters"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid interval parameters"))
 	}
 
 	return builder
@@ -395,27 +396,29 @@ func (builder *BFDBuilder) validate() (bool, error) {
 	resourceCRD := "BFDProfile"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -427,8 +430,8 @@ func (builder *BFDBuilder) convertToStructured(
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, bfdProfile)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to BFDProfile object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to BFDProfile object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
@@ -1,10 +1,12 @@
 package metallb
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -136,7 +138,7 @@ func TestNewBFDBuilder(t *testing.T) {
 			GVK: []schema.GroupVersionKind{bfdProfileGVK},
 		})
 		testBFDProfileBuilder := generateBFDProfile(testSettings, testCase.name, testCase.namespace)
-		assert.Equal(t, testCase.expectedError, testBFDProfileBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, testBFDProfileBuilder.errorMsg)
 		assert.NotNil(t, testBFDProfileBuilder.Definition)
 
 		if testCase.expectedError == "" {
@@ -157,7 +159,7 @@ func TestBFDProfileGet(t *testing.T) {
 		},
 		{
 			testBFDProfile: buildInValidBFDProfileBuilder(buildTestClientWithDummyObject()),
-			expectedError:  fmt.Errorf("BFDProfile 'nsname' cannot be empty"),
+			expectedError:  errors.Join(fmt.Errorf("BFDProfile 'nsname' cannot be empty")),
 		},
 	}
 
@@ -203,7 +205,7 @@ func TestBFDProfileCreate(t *testing.T) {
 		},
 		{
 			testBFDProfile: buildInValidBFDProfileBuilder(buildBFDProfileTestClientWithDummyObject()),
-			expectedError:  fmt.Errorf("BFDProfile 'nsname' cannot be empty"),
+			expectedError:  errors.Join(fmt.Errorf("BFDProfile 'nsname' cannot be empty")),
 		},
 	}
 
@@ -228,7 +230,7 @@ func TestBFDProfileDelete(t *testing.T) {
 		},
 		{
 			testBFDProfile: buildInValidBFDProfileBuilder(buildBFDProfileTestClientWithDummyObject()),
-			expectedError:  fmt.Errorf("BFDProfile 'nsname' cannot be empty"),
+			expectedError:  errors.Join(fmt.Errorf("BFDProfile 'nsname' cannot be empty")),
 		},
 	}
 
@@ -255,7 +257,7 @@ func TestBFDProfileUpdate(t *testing.T) {
 		},
 		{
 			testBFDProfile: buildInValidBFDProfileBuilder(buildBFDProfileTestClientWithDummyObject()),
-			expectedError:  fmt.Errorf("BFDProfile 'nsname' cannot be empty"),
+			expectedError:  errors.Join(fmt.Errorf("BFDProfile 'nsname' cannot be empty")),
 			echoMode:       false,
 		},
 	}
@@ -427,13 +429,13 @@ func TestBFDProfileWithOptions(t *testing.T) {
 			return builder, nil
 		})
 
-	assert.Equal(t, "", testBuilder.errorMsg)
+	assert.Nil(t, testBuilder.errorMsg)
 	testBuilder = buildValidBFDProfileBuilder(testSettings).WithOptions(
 		func(builder *BFDBuilder) (*BFDBuilder, error) {
 			return builder, fmt.Errorf("error")
 		})
 
-	assert.Equal(t, "error", testBuilder.errorMsg)
+	assert.EqualError(t, testBuilder.errorMsg, "error")
 }
 
 func TestBFDProfileGVR(t *testing.T) {
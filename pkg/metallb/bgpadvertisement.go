@@ -2,10 +2,13 @@ package metallb
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +28,7 @@ type BGPAdvertisementBuilder struct {
 	Definition *mlbtypes.BGPAdvertisement
 	Object     *mlbtypes.BGPAdvertisement
 	apiClient  *clients.Settings
-	errorMsg   string
+	errorMsg   error
 }
 
 // BGPAdvertisementAdditionalOptions additional options for BGPAdvertisement object.
@@ -33,7 +36,7 @@ type BGPAdvertisementAdditionalOptions func(builder *BGPAdvertisementBuilder) (*
 
 // NewBGPAdvertisementBuilder creates a new instance of BGPAdvertisementBuilder.
 func NewBGPAdvertisementBuilder(apiClient *clients.Settings, name, nsname string) *BGPAdvertisementBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new BGPAdvertisement structure with the following params: %s, %s",
 		name, nsname)
 
@@ -52,15 +55,15 @@ func NewBGPAdvertisementBuilder(apiClient *clients.Settings, name, nsname string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the BGPAdvertisement is empty")
+		logging.V(100).Infof("The name of the BGPAdvertisement is empty")
 
-		builder.errorMsg = "BGPAdvertisement 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BGPAdvertisement 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the BGPAdvertisement is empty")
+		logging.V(100).Infof("The namespace of the BGPAdvertisement is empty")
 
-		builder.errorMsg = "BGPAdvertisement 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BGPAdvertisement 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -72,7 +75,7 @@ func (builder *BGPAdvertisementBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if BGPAdvertisement %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -88,7 +91,7 @@ func (builder *BGPAdvertisementBuilder) Get() (*mlbtypes.BGPAdvertisement, error
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting BGPAdvertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -97,7 +100,7 @@ func (builder *BGPAdvertisementBuilder) Get() (*mlbtypes.BGPAdvertisement, error
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"BGPAdvertisement object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -109,7 +112,7 @@ func (builder *BGPAdvertisementBuilder) Get() (*mlbtypes.BGPAdvertisement, error
 
 // PullBGPAdvertisement pulls existing bgpadvertisement from cluster.
 func PullBGPAdvertisement(apiClient *clients.Settings, name, nsname string) (*BGPAdvertisementBuilder, error) {
-	glog.V(100).Infof("Pulling existing bgpadvertisement name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing bgpadvertisement name %s under namespace %s from cluster", name, nsname)
 
 	builder := BGPAdvertisementBuilder{
 		apiClient: apiClient,
@@ -122,15 +125,15 @@ func PullBGPAdvertisement(apiClient *clients.Settings, name, nsname string) (*BG
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the bgpadvertisement is empty")
+		logging.V(100).Infof("The name of the bgpadvertisement is empty")
 
-		builder.errorMsg = "bgpadvertisement 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("bgpadvertisement 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the bgpadvertisement is empty")
+		logging.V(100).Infof("The namespace of the bgpadvertisement is empty")
 
-		builder.errorMsg = "bgpadvertisement 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("bgpadvertisement 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -148,16 +151,24 @@ func (builder *BGPAdvertisementBuilder) Create() (*BGPAdvertisementBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the BGPAdvertisement %s in namespace %s",
+	logging.V(100).Infof("Creating the BGPAdvertisement %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	var err error
 	if !builder.Exists() {
+		err = validateIPAddressPoolsExist(
+			builder.apiClient, builder.Definition.Namespace, builder.Definition.Spec.IPAddressPools)
+		if err != nil {
+			logging.V(100).Infof("BGPAdvertisement %s references missing IPAddressPool", builder.Definition.Name)
+
+			return nil, err
+		}
+
 		unstructuredBgpAdvertisement, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured BGPAdvertisement to unstructured object")
+			logging.V(100).Infof("Failed to convert structured BGPAdvertisement to unstructured object")
 
 			return nil, err
 		}
@@ -167,7 +178,7 @@ func (builder *BGPAdvertisementBuilder) Create() (*BGPAdvertisementBuilder, erro
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredBgpAdvertisement}, metav1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create BGPAdvertisement")
+			logging.V(100).Infof("Failed to create BGPAdvertisement")
 
 			return nil, err
 		}
@@ -188,7 +199,7 @@ func (builder *BGPAdvertisementBuilder) Delete() (*BGPAdvertisementBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the BGPAdvertisement object %s in namespace %s",
+	logging.V(100).Infof("Deleting the BGPAdvertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -215,12 +226,12 @@ func (builder *BGPAdvertisementBuilder) Update(force bool) (*BGPAdvertisementBui
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the BGPAdvertisement object %s in namespace %s",
+	logging.V(100).Infof("Updating the BGPAdvertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	if !builder.Exists() {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to update the BGPAdvertisement object %s in namespace %s. "+
 				"Resource doesn't exist",
 			builder.Definition.Name, builder.Definition.Namespace,
@@ -233,7 +244,7 @@ func (builder *BGPAdvertisementBuilder) Update(force bool) (*BGPAdvertisementBui
 	unstructuredBgpAdvert, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured BGPAdvertisement to unstructured object")
+		logging.V(100).Infof("Failed to convert structured BGPAdvertisement to unstructured object")
 
 		return nil, err
 	}
@@ -244,13 +255,13 @@ func (builder *BGPAdvertisementBuilder) Update(force bool) (*BGPAdvertisementBui
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("BGPAdvertisement", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("BGPAdvertisement", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -269,16 +280,17 @@ func (builder *BGPAdvertisementBuilder) WithAggregationLength4(aggregationLength
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with aggregationLength: %d",
 		builder.Definition.Name, builder.Definition.Namespace, aggregationLength)
 
 	if aggregationLength < 0 || aggregationLength > 32 {
-		builder.errorMsg = fmt.Sprintf("AggregationLength %d is invalid, the value shoud be in range 0...32",
-			aggregationLength)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"AggregationLength %d is invalid, the value shoud be in range 0...32",
+			aggregationLength))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -293,16 +305,17 @@ func (builder *BGPAdvertisementBuilder) WithAggregationLength6(aggregationLength
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with aggregationLength6: %d",
 		builder.Definition.Name, builder.Definition.Namespace, aggregationLength)
 
-	if !(aggregationLength < 0 || aggregationLength > 128) {
-		builder.errorMsg = fmt.Sprintf("AggregationLength %d is invalid, the value shoud be in range 0...128",
-			aggregationLength)
+	if aggregationLength < 0 || aggregationLength > 128 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"AggregationLength %d is invalid, the value shoud be in range 0...128",
+			aggregationLength))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -317,7 +330,7 @@ func (builder *BGPAdvertisementBuilder) WithLocalPref(localPreference uint32) *B
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with LocalPref: %d",
 		builder.Definition.Name, builder.Definition.Namespace, localPreference)
 
@@ -332,15 +345,24 @@ func (builder *BGPAdvertisementBuilder) WithCommunities(communities []string) *B
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with Communities: %s",
 		builder.Definition.Name, builder.Definition.Namespace, communities)
 
 	if len(communities) < 1 {
-		builder.errorMsg = "error: community setting is empty list, the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error: community setting is empty list, the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	for _, community := range communities {
+		if err := validateBGPCommunity(community); err != nil {
+			logging.V(100).Infof("The BGPAdvertisement community %s is invalid", community)
+
+			builder.errorMsg = errors.Join(builder.errorMsg, err)
+		}
+	}
+
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -355,15 +377,16 @@ func (builder *BGPAdvertisementBuilder) WithIPAddressPools(ipAddressPools []stri
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with IPAddressPools: %s",
 		builder.Definition.Name, builder.Definition.Namespace, ipAddressPools)
 
 	if len(ipAddressPools) < 1 {
-		builder.errorMsg = "error: IPAddressPools setting is empty list, the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error: IPAddressPools setting is empty list, the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -379,16 +402,16 @@ func (builder *BGPAdvertisementBuilder) WithIPAddressPoolsSelectors(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with IPAddressPoolSelectors: %s",
 		builder.Definition.Name, builder.Definition.Namespace, poolSelector)
 
 	if len(poolSelector) < 1 {
-		builder.errorMsg = "error: IPAddressPoolSelectors setting is empty list, " +
-			"the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error: IPAddressPoolSelectors setting is empty list, "+
+			"the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -404,15 +427,16 @@ func (builder *BGPAdvertisementBuilder) WithNodeSelector(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with WithIPAddressPools: %v",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelectors)
 
 	if len(nodeSelectors) < 1 {
-		builder.errorMsg = "error: nodeSelectors setting is empty list, the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error: nodeSelectors setting is empty list, the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -427,15 +451,16 @@ func (builder *BGPAdvertisementBuilder) WithPeers(peers []string) *BGPAdvertisem
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPAdvertisement %s in namespace %s with Peers: %v",
 		builder.Definition.Name, builder.Definition.Namespace, peers)
 
 	if len(peers) < 1 {
-		builder.errorMsg = "error: peers setting is empty list, the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error: peers setting is empty list, the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -451,16 +476,16 @@ func (builder *BGPAdvertisementBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting BGPAdvertisement additional options")
+	logging.V(100).Infof("Setting BGPAdvertisement additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -483,40 +508,76 @@ func (builder *BGPAdvertisementBuilder) validate() (bool, error) {
 	resourceCRD := "BGPAdvertisement"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
 }
 
+// validateBGPCommunity checks that the community is either a standard community in the form 1234:1234, a large
+// community in the form large:1234:1234:1234 or the name of an alias defined in a Community resource.
+func validateBGPCommunity(community string) error {
+	if community == "" {
+		return fmt.Errorf("BGPAdvertisement community cannot be empty")
+	}
+
+	if !strings.Contains(community, ":") {
+		return nil
+	}
+
+	communityParts := strings.Split(community, ":")
+	partSize := 16
+
+	if communityParts[0] == "large" {
+		communityParts = communityParts[1:]
+		partSize = 32
+
+		if len(communityParts) != 3 {
+			return fmt.Errorf("BGPAdvertisement large community %s must have the form large:1234:1234:1234", community)
+		}
+	} else if len(communityParts) != 2 {
+		return fmt.Errorf("BGPAdvertisement community %s must have the form 1234:1234", community)
+	}
+
+	for _, part := range communityParts {
+		if _, err := strconv.ParseUint(part, 10, partSize); err != nil {
+			return fmt.Errorf("BGPAdvertisement community %s has invalid value %s", community, part)
+		}
+	}
+
+	return nil
+}
+
 func (builder *BGPAdvertisementBuilder) convertToStructured(
 	unsObject *unstructured.Unstructured) (*mlbtypes.BGPAdvertisement, error) {
 	bgpAdvertisement := &mlbtypes.BGPAdvertisement{}
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, bgpAdvertisement)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to BGPAdvertisement object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to BGPAdvertisement object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
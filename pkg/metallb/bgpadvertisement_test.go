@@ -0,0 +1,108 @@
+package metallb
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+var defaultBGPAdvertisementName = "default-bgp-advertisement"
+
+func TestBGPAdvertisementWithAggregationLength6(t *testing.T) {
+	testCases := []struct {
+		aggregationLength int32
+		expectedError     string
+	}{
+		{
+			aggregationLength: 64,
+			expectedError:     "",
+		},
+		{
+			aggregationLength: 129,
+			expectedError:     "AggregationLength 129 is invalid, the value shoud be in range 0...128",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidBGPAdvertisementBuilder(buildTestClientWithDummyObject()).
+			WithAggregationLength6(testCase.aggregationLength)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.aggregationLength, *testBuilder.Definition.Spec.AggregationLengthV6)
+		}
+	}
+}
+
+func TestBGPAdvertisementWithCommunities(t *testing.T) {
+	testCases := []struct {
+		communities   []string
+		expectedError string
+	}{
+		{
+			communities:   []string{"65535:65282", "large:123:456:789", "no-advertise"},
+			expectedError: "",
+		},
+		{
+			communities: []string{},
+			expectedError: "error: community setting is empty list, " +
+				"the list should contain at least one element",
+		},
+		{
+			communities:   []string{"65536:1"},
+			expectedError: "BGPAdvertisement community 65536:1 has invalid value 65536",
+		},
+		{
+			communities:   []string{"1:2:3"},
+			expectedError: "BGPAdvertisement community 1:2:3 must have the form 1234:1234",
+		},
+		{
+			communities:   []string{"large:1:2"},
+			expectedError: "BGPAdvertisement large community large:1:2 must have the form large:1234:1234:1234",
+		},
+		{
+			communities:   []string{""},
+			expectedError: "BGPAdvertisement community cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidBGPAdvertisementBuilder(buildTestClientWithDummyObject()).
+			WithCommunities(testCase.communities)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.communities, testBuilder.Definition.Spec.Communities)
+		}
+	}
+}
+
+func TestBGPAdvertisementCreate(t *testing.T) {
+	testCases := []struct {
+		ipAddressPools []string
+		expectedError  string
+	}{
+		{
+			ipAddressPools: []string{defaultIPAddressPoolName},
+			expectedError:  "",
+		},
+		{
+			ipAddressPools: []string{defaultIPAddressPoolName, "missing-pool"},
+			expectedError: "IPAddressPool missing-pool referenced by the advertisement doesn't exist " +
+				"in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := buildValidBGPAdvertisementBuilder(buildTestClientWithDummyObject()).
+			WithIPAddressPools(testCase.ipAddressPools).Create()
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.ipAddressPools, testBuilder.Object.Spec.IPAddressPools)
+		}
+	}
+}
+
+func buildValidBGPAdvertisementBuilder(apiClient *clients.Settings) *BGPAdvertisementBuilder {
+	return NewBGPAdvertisementBuilder(apiClient, defaultBGPAdvertisementName, defaultNsName)
+}
@@ -2,23 +2,27 @@ package metallb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
-	bpgPeerKind = "BGPPeer"
+	bpgPeerKind    = "BGPPeer"
+	minBGPHoldTime = 3 * time.Second
 )
 
 // BGPPeerBuilder provides struct for the BGPPeer object containing connection to
@@ -27,7 +31,7 @@ type BGPPeerBuilder struct {
 	Definition *mlbtypes.BGPPeer
 	Object     *mlbtypes.BGPPeer
 	apiClient  *clients.Settings
-	errorMsg   string
+	errorMsg   error
 }
 
 // BGPPeerAdditionalOptions additional options for BGPPeer object.
@@ -36,7 +40,7 @@ type BGPPeerAdditionalOptions func(builder *BGPPeerBuilder) (*BGPPeerBuilder, er
 // NewBPGPeerBuilder creates a new instance of BGPPeer.
 func NewBPGPeerBuilder(
 	apiClient *clients.Settings, name, nsname, peerIP string, asn, remoteASN uint32) *BGPPeerBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new BGPPeer structure with the following params: %s, %s %s %d %d",
 		name, nsname, peerIP, asn, remoteASN)
 
@@ -59,21 +63,22 @@ func NewBPGPeerBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the BGPPeer is empty")
+		logging.V(100).Infof("The name of the BGPPeer is empty")
 
-		builder.errorMsg = "BGPPeer 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BGPPeer 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the BGPPeer is empty")
+		logging.V(100).Infof("The namespace of the BGPPeer is empty")
 
-		builder.errorMsg = "BGPPeer 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BGPPeer 'nsname' cannot be empty"))
 	}
 
 	if net.ParseIP(peerIP) == nil {
-		glog.V(100).Infof("The peerIP of the BGPPeer contains invalid ip address %s", peerIP)
+		logging.V(100).Infof("The peerIP of the BGPPeer contains invalid ip address %s", peerIP)
 
-		builder.errorMsg = "BGPPeer 'peerIP' of the BGPPeer contains invalid ip address"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"BGPPeer 'peerIP' of the BGPPeer contains invalid ip address"))
 	}
 
 	return &builder
@@ -85,7 +90,7 @@ func (builder *BGPPeerBuilder) Get() (*mlbtypes.BGPPeer, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting BGPPeer object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -94,8 +99,8 @@ func (builder *BGPPeerBuilder) Get() (*mlbtypes.BGPPeer, error) {
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to Unmarshal BGPPeer: unstructured object to structure in namespace %s",
+		logging.V(100).Infof(
+			"Failed to Unmarshal BGPPeer %s: unstructured object to structure in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
@@ -110,7 +115,7 @@ func (builder *BGPPeerBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if BGPPeer %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -122,7 +127,7 @@ func (builder *BGPPeerBuilder) Exists() bool {
 
 // PullBGPPeer pulls existing bgppeer from cluster.
 func PullBGPPeer(apiClient *clients.Settings, name, nsname string) (*BGPPeerBuilder, error) {
-	glog.V(100).Infof("Pulling existing bgppeer name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing bgppeer name %s under namespace %s from cluster", name, nsname)
 
 	builder := BGPPeerBuilder{
 		apiClient: apiClient,
@@ -135,15 +140,15 @@ func PullBGPPeer(apiClient *clients.Settings, name, nsname string) (*BGPPeerBuil
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the bgppeer is empty")
+		logging.V(100).Infof("The name of the bgppeer is empty")
 
-		builder.errorMsg = "bgppeer 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("bgppeer 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the bgppeer is empty")
+		logging.V(100).Infof("The namespace of the bgppeer is empty")
 
-		builder.errorMsg = "bgppeer 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("bgppeer 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -161,7 +166,7 @@ func (builder *BGPPeerBuilder) Create() (*BGPPeerBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the BGPPeer %s in namespace %s",
+	logging.V(100).Infof("Creating the BGPPeer %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -170,7 +175,7 @@ func (builder *BGPPeerBuilder) Create() (*BGPPeerBuilder, error) {
 		unstructuredBgpPeer, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured BGPPeer to unstructured object")
+			logging.V(100).Infof("Failed to convert structured BGPPeer to unstructured object")
 
 			return nil, err
 		}
@@ -180,7 +185,7 @@ func (builder *BGPPeerBuilder) Create() (*BGPPeerBuilder, error) {
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredBgpPeer}, metav1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create BGPPeer")
+			logging.V(100).Infof("Failed to create BGPPeer")
 
 			return nil, err
 		}
@@ -201,7 +206,7 @@ func (builder *BGPPeerBuilder) Delete() (*BGPPeerBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the BGPPeer object %s in namespace %s",
+	logging.V(100).Infof("Deleting the BGPPeer object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -228,14 +233,14 @@ func (builder *BGPPeerBuilder) Update(force bool) (*BGPPeerBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the BGPPeer object %s in namespace %s",
+	logging.V(100).Infof("Updating the BGPPeer object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	unstructuredBgpPeer, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured BGPPeer to unstructured object")
+		logging.V(100).Infof("Failed to convert structured BGPPeer to unstructured object")
 
 		return nil, err
 	}
@@ -246,13 +251,13 @@ func (builder *BGPPeerBuilder) Update(force bool) (*BGPPeerBuilder, error) {
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("BGPPeer", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("BGPPeer", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -271,18 +276,19 @@ func (builder *BGPPeerBuilder) WithRouterID(routerID string) *BGPPeerBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this routerID: %s",
 		builder.Definition.Name, builder.Definition.Namespace, routerID)
 
 	if net.ParseIP(routerID) == nil {
-		glog.V(100).Infof("The routerID of the BGPPeer contains invalid ip address %s, "+
+		logging.V(100).Infof("The routerID of the BGPPeer contains invalid ip address %s, "+
 			"routerID should be present in ip address format", routerID)
 
-		builder.errorMsg = fmt.Sprintf("the routerID of the BGPPeer contains invalid ip address %s", routerID)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the routerID of the BGPPeer contains invalid ip address %s", routerID))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -297,17 +303,17 @@ func (builder *BGPPeerBuilder) WithBFDProfile(bfdProfile string) *BGPPeerBuilder
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this bfdProfile: %s",
 		builder.Definition.Name, builder.Definition.Namespace, bfdProfile)
 
 	if bfdProfile == "" {
-		glog.V(100).Infof("The bfdProfile of the BGPPeer can not be empty string")
+		logging.V(100).Infof("The bfdProfile of the BGPPeer can not be empty string")
 
-		builder.errorMsg = "The bfdProfile is empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The bfdProfile is empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -322,18 +328,19 @@ func (builder *BGPPeerBuilder) WithSRCAddress(srcAddress string) *BGPPeerBuilder
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this srcAddress: %s",
 		builder.Definition.Name, builder.Definition.Namespace, srcAddress)
 
 	if net.ParseIP(srcAddress) == nil {
-		glog.V(100).Infof("The srcAddress of the BGPPeer contains invalid ip address %s, "+
+		logging.V(100).Infof("The srcAddress of the BGPPeer contains invalid ip address %s, "+
 			"srcAddress should be present in ip address format", srcAddress)
 
-		builder.errorMsg = fmt.Sprintf("the srcAddress of the BGPPeer contains invalid ip address %s", srcAddress)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the srcAddress of the BGPPeer contains invalid ip address %s", srcAddress))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -348,7 +355,7 @@ func (builder *BGPPeerBuilder) WithPort(port uint16) *BGPPeerBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this port: %d",
 		builder.Definition.Name, builder.Definition.Namespace, port)
 
@@ -357,31 +364,60 @@ func (builder *BGPPeerBuilder) WithPort(port uint16) *BGPPeerBuilder {
 	return builder
 }
 
-// WithHoldTime defines the holdTime placed in the BGPPeer spec.
+// WithHoldTime defines the holdTime placed in the BGPPeer spec. The holdTime must be at least 3 seconds and greater
+// than the keepalive time, per RFC4271.
 func (builder *BGPPeerBuilder) WithHoldTime(holdTime metav1.Duration) *BGPPeerBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this holdTime: %s",
 		builder.Definition.Name, builder.Definition.Namespace, holdTime)
 
+	if holdTime.Duration < minBGPHoldTime {
+		logging.V(100).Infof("The holdTime of the BGPPeer is lower than %s", minBGPHoldTime)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"BGPPeer 'holdTime' %s cannot be lower than %s", holdTime.Duration, minBGPHoldTime))
+	}
+
+	if err := validateBGPTimers(holdTime, builder.Definition.Spec.KeepaliveTime); err != nil {
+		logging.V(100).Infof("The holdTime of the BGPPeer is invalid: %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
 	builder.Definition.Spec.HoldTime = holdTime
 
 	return builder
 }
 
-// WithKeepalive defines the keepAliveTime placed in the BGPPeer spec.
+// WithKeepalive defines the keepAliveTime placed in the BGPPeer spec. The keepalive time must be lower than the
+// holdTime when the latter is set.
 func (builder *BGPPeerBuilder) WithKeepalive(keepalive metav1.Duration) *BGPPeerBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this keepalive: %s",
 		builder.Definition.Name, builder.Definition.Namespace, keepalive)
 
+	if err := validateBGPTimers(builder.Definition.Spec.HoldTime, keepalive); err != nil {
+		logging.V(100).Infof("The keepalive of the BGPPeer is invalid: %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
 	builder.Definition.Spec.KeepaliveTime = keepalive
 
 	return builder
@@ -393,17 +429,17 @@ func (builder *BGPPeerBuilder) WithNodeSelector(nodeSelector map[string]string)
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this nodeSelector: %s",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("Can not redefine BGPPeer with empty nodeSelector map")
+		logging.V(100).Infof("Can not redefine BGPPeer with empty nodeSelector map")
 
-		builder.errorMsg = "BGPPeer 'nodeSelector' cannot be empty map"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("BGPPeer 'nodeSelector' cannot be empty map"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -419,17 +455,24 @@ func (builder *BGPPeerBuilder) WithPassword(password string) *BGPPeerBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this password: %s",
 		builder.Definition.Name, builder.Definition.Namespace, password)
 
 	if password == "" {
-		glog.V(100).Infof("Can not redefine BGPPeer with empty password")
+		logging.V(100).Infof("Can not redefine BGPPeer with empty password")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("password can not be empty string"))
+	}
+
+	if builder.Definition.Spec.PasswordSecret.Name != "" {
+		logging.V(100).Infof("The BGPPeer already uses passwordSecret %s", builder.Definition.Spec.PasswordSecret.Name)
 
-		builder.errorMsg = "password can not be empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"BGPPeer 'password' cannot be used together with 'passwordSecret'"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -438,13 +481,49 @@ func (builder *BGPPeerBuilder) WithPassword(password string) *BGPPeerBuilder {
 	return builder
 }
 
+// WithPasswordSecret defines the passwordSecret placed in the BGPPeer spec. The secret must be of type
+// kubernetes.io/basic-auth and live in the namespace of the BGPPeer.
+func (builder *BGPPeerBuilder) WithPasswordSecret(secretName string) *BGPPeerBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating BGPPeer %s in namespace %s with this passwordSecret: %s",
+		builder.Definition.Name, builder.Definition.Namespace, secretName)
+
+	if secretName == "" {
+		logging.V(100).Infof("Can not redefine BGPPeer with empty passwordSecret")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("passwordSecret can not be empty string"))
+	}
+
+	if builder.Definition.Spec.Password != "" {
+		logging.V(100).Infof("The BGPPeer already uses a plain text password")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"BGPPeer 'passwordSecret' cannot be used together with 'password'"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.PasswordSecret = corev1.SecretReference{
+		Name:      secretName,
+		Namespace: builder.Definition.Namespace,
+	}
+
+	return builder
+}
+
 // WithEBGPMultiHop defines the EBGPMultiHop bool flag placed in the BGPPeer spec.
 func (builder *BGPPeerBuilder) WithEBGPMultiHop(eBGPMultiHop bool) *BGPPeerBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating BGPPeer %s in namespace %s with this eBGPMultiHop flag: %t",
 		builder.Definition.Name, builder.Definition.Namespace, eBGPMultiHop)
 
@@ -459,16 +538,16 @@ func (builder *BGPPeerBuilder) WithOptions(options ...BGPPeerAdditionalOptions)
 		return builder
 	}
 
-	glog.V(100).Infof("Setting BGPPeer additional options")
+	logging.V(100).Infof("Setting BGPPeer additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -491,39 +570,55 @@ func (builder *BGPPeerBuilder) validate() (bool, error) {
 	resourceCRD := "BGPPeer"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
 }
 
+// validateBGPTimers checks that the keepalive time is lower than the holdTime when both of them are set.
+func validateBGPTimers(holdTime, keepalive metav1.Duration) error {
+	if holdTime.Duration == 0 || keepalive.Duration == 0 {
+		return nil
+	}
+
+	if keepalive.Duration >= holdTime.Duration {
+		return fmt.Errorf("BGPPeer 'keepalive' %s must be lower than 'holdTime' %s",
+			keepalive.Duration, holdTime.Duration)
+	}
+
+	return nil
+}
+
 func (builder *BGPPeerBuilder) convertToStructured(unsObject *unstructured.Unstructured) (*mlbtypes.BGPPeer, error) {
 	bgpPeer := &mlbtypes.BGPPeer{}
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, bgpPeer)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to BGPPeer object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to BGPPeer object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
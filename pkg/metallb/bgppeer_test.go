@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -81,7 +82,7 @@ func TestNewBPGPeerBuilder(t *testing.T) {
 		})
 		testBGPPeerBuilder := generateBPGPeer(
 			testSettings, testCase.name, testCase.namespace, testCase.peerIP, testCase.asn, testCase.remoteAsn)
-		assert.Equal(t, testCase.expectedError, testBGPPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, testBGPPeerBuilder.errorMsg)
 		assert.NotNil(t, testBGPPeerBuilder.Definition)
 
 		if testCase.expectedError == "" {
@@ -120,7 +121,7 @@ func TestBGPPeerWithRouterID(t *testing.T) {
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithRouterID(testCase.routerID)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.routerID, bgpPeerBuilder.Definition.Spec.RouterID)
@@ -152,7 +153,7 @@ func TestBGPPeerWithBFDProfile(t *testing.T) {
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithBFDProfile(testCase.bdfProfile)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.bdfProfile, bgpPeerBuilder.Definition.Spec.BFDProfile)
@@ -189,7 +190,7 @@ func TestBGPPeerWithSRCAddress(t *testing.T) {
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithSRCAddress(testCase.srcAddress)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.srcAddress, bgpPeerBuilder.Definition.Spec.SrcAddress)
@@ -216,7 +217,7 @@ func TestBGPPeerWithPort(t *testing.T) {
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithPort(testCase.port)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.port, bgpPeerBuilder.Definition.Spec.Port)
@@ -243,11 +244,26 @@ func TestBGPPeerWithHoldTime(t *testing.T) {
 			},
 			expectedError: "BGPPeer 'peerIP' of the BGPPeer contains invalid ip address",
 		},
+		{
+			testBGPPeer: buildValidBGPPeerBuilder(buildBGPPeerTestClientWithDummyObject()),
+			holdTime: metav1.Duration{
+				Duration: time.Second,
+			},
+			expectedError: "BGPPeer 'holdTime' 1s cannot be lower than 3s",
+		},
+		{
+			testBGPPeer: buildValidBGPPeerBuilder(buildBGPPeerTestClientWithDummyObject()).
+				WithKeepalive(metav1.Duration{Duration: 30 * time.Second}),
+			holdTime: metav1.Duration{
+				Duration: 10 * time.Second,
+			},
+			expectedError: "BGPPeer 'keepalive' 30s must be lower than 'holdTime' 10s",
+		},
 	}
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithHoldTime(testCase.holdTime)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.holdTime, bgpPeerBuilder.Definition.Spec.HoldTime)
@@ -274,11 +290,19 @@ func TestBGPPeerWithKeepalive(t *testing.T) {
 			},
 			expectedError: "BGPPeer 'peerIP' of the BGPPeer contains invalid ip address",
 		},
+		{
+			testBGPPeer: buildValidBGPPeerBuilder(buildBGPPeerTestClientWithDummyObject()).
+				WithHoldTime(metav1.Duration{Duration: 90 * time.Second}),
+			keepalive: metav1.Duration{
+				Duration: 90 * time.Second,
+			},
+			expectedError: "BGPPeer 'keepalive' 1m30s must be lower than 'holdTime' 1m30s",
+		},
 	}
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithKeepalive(testCase.keepalive)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.keepalive, bgpPeerBuilder.Definition.Spec.KeepaliveTime)
@@ -310,7 +334,7 @@ func TestBGPPeerWithNodeSelector(t *testing.T) {
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithNodeSelector(testCase.nodeSelector)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, mlbtypes.NodeSelector{MatchLabels: testCase.nodeSelector},
@@ -343,7 +367,7 @@ func TestBGPPeerWithPassword(t *testing.T) {
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithPassword(testCase.password)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.password, bgpPeerBuilder.Definition.Spec.Password)
@@ -351,6 +375,41 @@ func TestBGPPeerWithPassword(t *testing.T) {
 	}
 }
 
+func TestBGPPeerWithPasswordSecret(t *testing.T) {
+	testCases := []struct {
+		testBGPPeer   *BGPPeerBuilder
+		secretName    string
+		expectedError string
+	}{
+		{
+			testBGPPeer: buildValidBGPPeerBuilder(buildBGPPeerTestClientWithDummyObject()),
+			secretName:  "bgp-secret",
+		},
+		{
+			testBGPPeer:   buildValidBGPPeerBuilder(buildBGPPeerTestClientWithDummyObject()),
+			secretName:    "",
+			expectedError: "passwordSecret can not be empty string",
+		},
+		{
+			testBGPPeer:   buildValidBGPPeerBuilder(buildBGPPeerTestClientWithDummyObject()).WithPassword("test"),
+			secretName:    "bgp-secret",
+			expectedError: "BGPPeer 'passwordSecret' cannot be used together with 'password'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		bgpPeerBuilder := testCase.testBGPPeer.WithPasswordSecret(testCase.secretName)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg) {
+			assert.Equal(t, testCase.secretName, bgpPeerBuilder.Definition.Spec.PasswordSecret.Name)
+			assert.Equal(t, bgpPeerBuilder.Definition.Namespace, bgpPeerBuilder.Definition.Spec.PasswordSecret.Namespace)
+		}
+	}
+
+	bgpPeerBuilder := buildValidBGPPeerBuilder(buildBGPPeerTestClientWithDummyObject()).
+		WithPasswordSecret("bgp-secret").WithPassword("test")
+	assert.EqualError(t, bgpPeerBuilder.errorMsg, "BGPPeer 'password' cannot be used together with 'passwordSecret'")
+}
+
 func TestBGPPeerWithEBGPMultiHop(t *testing.T) {
 	testCases := []struct {
 		testBGPPeer   *BGPPeerBuilder
@@ -374,7 +433,7 @@ func TestBGPPeerWithEBGPMultiHop(t *testing.T) {
 
 	for _, testCase := range testCases {
 		bgpPeerBuilder := testCase.testBGPPeer.WithEBGPMultiHop(testCase.ebgpMultiHop)
-		assert.Equal(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, bgpPeerBuilder.errorMsg)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.ebgpMultiHop, bgpPeerBuilder.Definition.Spec.EBGPMultiHop)
@@ -389,13 +448,13 @@ func TestBGPPeerWithOptions(t *testing.T) {
 			return builder, nil
 		})
 
-	assert.Equal(t, "", testBuilder.errorMsg)
+	assert.Nil(t, testBuilder.errorMsg)
 	testBuilder = buildValidBGPPeerBuilder(testSettings).WithOptions(
 		func(builder *BGPPeerBuilder) (*BGPPeerBuilder, error) {
 			return builder, fmt.Errorf("error")
 		})
 
-	assert.Equal(t, "error", testBuilder.errorMsg)
+	assert.EqualError(t, testBuilder.errorMsg, "error")
 }
 
 func TestBGPPeerGVR(t *testing.T) {
@@ -0,0 +1,158 @@
+package metallb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// FRRContainerName represents the name of the FRR container running in the MetalLB speaker and frr-k8s pods.
+	FRRContainerName = "frr"
+	// BGPStateEstablished represents the FRR state of an established BGP session.
+	BGPStateEstablished = "Established"
+	// BFDStatusUp represents the FRR status of a BFD session which is up.
+	BFDStatusUp = "up"
+)
+
+// bgpNeighbor is the subset of the FRR "show bgp neighbor json" output used to check the session state.
+type bgpNeighbor struct {
+	BGPState string `json:"bgpState"`
+}
+
+// bfdPeer is the subset of the FRR "show bfd peer json" output used to check the session status.
+type bfdPeer struct {
+	Status string `json:"status"`
+}
+
+// GetBGPSessionState returns the state of the BGP session with peerIP as reported by FRR running in frrPod.
+func GetBGPSessionState(frrPod *pod.Builder, peerIP string) (string, error) {
+	if frrPod == nil {
+		logging.V(100).Infof("The FRR pod is nil")
+
+		return "", fmt.Errorf("failed to get BGP session state, FRR pod is nil")
+	}
+
+	logging.V(100).Infof("Getting state of the BGP session with peer %s", peerIP)
+
+	output, err := frrPod.ExecCommand(
+		[]string{"vtysh", "-c", fmt.Sprintf("show bgp neighbor %s json", peerIP)}, FRRContainerName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get BGP neighbor %s from FRR: %w", peerIP, err)
+	}
+
+	return parseBGPSessionState(output.Bytes(), peerIP)
+}
+
+// WaitForBGPSessionEstablished waits for the duration of the defined timeout or until the BGP session with peerIP
+// is Established on every one of the given FRR pods.
+func WaitForBGPSessionEstablished(frrPods []*pod.Builder, peerIP string, timeout time.Duration) error {
+	logging.V(100).Infof("Waiting until the BGP session with peer %s is %s on %d pods",
+		peerIP, BGPStateEstablished, len(frrPods))
+
+	if len(frrPods) == 0 {
+		return fmt.Errorf("cannot wait for BGP session with peer %s, FRR pods list is empty", peerIP)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			for _, frrPod := range frrPods {
+				state, err := GetBGPSessionState(frrPod, peerIP)
+				if err != nil || state != BGPStateEstablished {
+					logging.V(100).Infof("BGP session with peer %s is not %s yet, current state: %s",
+						peerIP, BGPStateEstablished, state)
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+}
+
+// GetBFDSessionStatus returns the status of the BFD session with peerIP as reported by FRR running in frrPod.
+func GetBFDSessionStatus(frrPod *pod.Builder, peerIP string) (string, error) {
+	if frrPod == nil {
+		logging.V(100).Infof("The FRR pod is nil")
+
+		return "", fmt.Errorf("failed to get BFD session status, FRR pod is nil")
+	}
+
+	logging.V(100).Infof("Getting status of the BFD session with peer %s", peerIP)
+
+	output, err := frrPod.ExecCommand(
+		[]string{"vtysh", "-c", fmt.Sprintf("show bfd peer %s json", peerIP)}, FRRContainerName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get BFD peer %s from FRR: %w", peerIP, err)
+	}
+
+	return parseBFDSessionStatus(output.Bytes(), peerIP)
+}
+
+// WaitForBFDSessionUp waits for the duration of the defined timeout or until the BFD session with peerIP is up on
+// every one of the given FRR pods.
+func WaitForBFDSessionUp(frrPods []*pod.Builder, peerIP string, timeout time.Duration) error {
+	logging.V(100).Infof("Waiting until the BFD session with peer %s is %s on %d pods", peerIP, BFDStatusUp, len(frrPods))
+
+	if len(frrPods) == 0 {
+		return fmt.Errorf("cannot wait for BFD session with peer %s, FRR pods list is empty", peerIP)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			for _, frrPod := range frrPods {
+				status, err := GetBFDSessionStatus(frrPod, peerIP)
+				if err != nil || status != BFDStatusUp {
+					logging.V(100).Infof("BFD session with peer %s is not %s yet, current status: %s",
+						peerIP, BFDStatusUp, status)
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+}
+
+// parseBGPSessionState extracts the state of the BGP session with peerIP from the FRR neighbor output, which is
+// keyed by the neighbor address. FRR reports an unknown neighbor with a bgpNoSuchNeighbor key instead.
+func parseBGPSessionState(output []byte, peerIP string) (string, error) {
+	neighbors := make(map[string]json.RawMessage)
+
+	if err := json.Unmarshal(output, &neighbors); err != nil {
+		return "", fmt.Errorf("failed to parse BGP neighbor %s output: %w", peerIP, err)
+	}
+
+	rawNeighbor, found := neighbors[peerIP]
+	if !found {
+		return "", fmt.Errorf("BGP neighbor %s not found in FRR", peerIP)
+	}
+
+	neighbor := bgpNeighbor{}
+
+	if err := json.Unmarshal(rawNeighbor, &neighbor); err != nil {
+		return "", fmt.Errorf("failed to parse BGP neighbor %s output: %w", peerIP, err)
+	}
+
+	return neighbor.BGPState, nil
+}
+
+// parseBFDSessionStatus extracts the status of the BFD session with peerIP from the FRR bfd peer output.
+func parseBFDSessionStatus(output []byte, peerIP string) (string, error) {
+	peer := bfdPeer{}
+
+	if err := json.Unmarshal(output, &peer); err != nil {
+		return "", fmt.Errorf("failed to parse BFD peer %s output: %w", peerIP, err)
+	}
+
+	if peer.Status == "" {
+		return "", fmt.Errorf("BFD peer %s not found in FRR", peerIP)
+	}
+
+	return peer.Status, nil
+}
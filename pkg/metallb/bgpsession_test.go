@@ -0,0 +1,90 @@
+package metallb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBGPSessionState(t *testing.T) {
+	testCases := []struct {
+		output        string
+		peerIP        string
+		expectedState string
+		expectedError string
+	}{
+		{
+			output:        `{"10.10.10.1":{"remoteAs":64500,"bgpState":"Established"}}`,
+			peerIP:        "10.10.10.1",
+			expectedState: BGPStateEstablished,
+		},
+		{
+			output:        `{"10.10.10.1":{"remoteAs":64500,"bgpState":"Active"}}`,
+			peerIP:        "10.10.10.1",
+			expectedState: "Active",
+		},
+		{
+			output:        `{"bgpNoSuchNeighbor":true}`,
+			peerIP:        "10.10.10.2",
+			expectedError: "BGP neighbor 10.10.10.2 not found in FRR",
+		},
+		{
+			output: `% No such neighbor`,
+			peerIP: "10.10.10.1",
+			expectedError: "failed to parse BGP neighbor 10.10.10.1 output: " +
+				"invalid character '%' looking for beginning of value",
+		},
+	}
+
+	for _, testCase := range testCases {
+		state, err := parseBGPSessionState([]byte(testCase.output), testCase.peerIP)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedState, state)
+		}
+	}
+}
+
+func TestParseBFDSessionStatus(t *testing.T) {
+	testCases := []struct {
+		output         string
+		expectedStatus string
+		expectedError  string
+	}{
+		{
+			output:         `{"multihop":false,"peer":"10.10.10.1","status":"up"}`,
+			expectedStatus: BFDStatusUp,
+		},
+		{
+			output:         `{"multihop":false,"peer":"10.10.10.1","status":"down"}`,
+			expectedStatus: "down",
+		},
+		{
+			output:        `{}`,
+			expectedError: "BFD peer 10.10.10.1 not found in FRR",
+		},
+	}
+
+	for _, testCase := range testCases {
+		status, err := parseBFDSessionStatus([]byte(testCase.output), "10.10.10.1")
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedStatus, status)
+		}
+	}
+}
+
+func TestWaitForSessionWithoutPods(t *testing.T) {
+	err := WaitForBGPSessionEstablished([]*pod.Builder{}, "10.10.10.1", time.Second)
+	assert.EqualError(t, err, "cannot wait for BGP session with peer 10.10.10.1, FRR pods list is empty")
+
+	err = WaitForBFDSessionUp(nil, "10.10.10.1", time.Second)
+	assert.EqualError(t, err, "cannot wait for BFD session with peer 10.10.10.1, FRR pods list is empty")
+
+	_, err = GetBGPSessionState(nil, "10.10.10.1")
+	assert.EqualError(t, err, "failed to get BGP session state, FRR pod is nil")
+
+	_, err = GetBFDSessionStatus(nil, "10.10.10.1")
+	assert.EqualError(t, err, "failed to get BFD session status, FRR pod is nil")
+}
@@ -15,4 +15,8 @@ const (
 	BFDProfileList = "BFDProfileList"
 	// IPAddressPoolList represents kind of IPAddressPool object.
 	IPAddressPoolList = "IPAddressPoolList"
+	// FRRK8sAPIGroup represents frr-k8s api group.
+	FRRK8sAPIGroup = "frrk8s.metallb.io"
+	// FRRK8sAPIVersion represents version of frr-k8s api.
+	FRRK8sAPIVersion = "v1beta1"
 )
@@ -0,0 +1,956 @@
+package metallb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/strings/slices"
+)
+
+const (
+	frrConfigurationKind = "FRRConfiguration"
+	frrNodeStateKind     = "FRRNodeState"
+	frrResultSuccess     = "success"
+)
+
+// FRRConfigurationBuilder provides struct for the FRRConfiguration object containing connection to
+// the cluster and the FRRConfiguration definitions.
+type FRRConfigurationBuilder struct {
+	Definition *mlbtypes.FRRConfiguration
+	Object     *mlbtypes.FRRConfiguration
+	apiClient  *clients.Settings
+	errorMsg   error
+}
+
+// FRRConfigurationAdditionalOptions additional options for FRRConfiguration object.
+type FRRConfigurationAdditionalOptions func(builder *FRRConfigurationBuilder) (*FRRConfigurationBuilder, error)
+
+// NewFRRConfigurationBuilder creates a new instance of FRRConfigurationBuilder.
+func NewFRRConfigurationBuilder(apiClient *clients.Settings, name, nsname string) *FRRConfigurationBuilder {
+	logging.V(100).Infof(
+		"Initializing new FRRConfiguration structure with the following params: %s, %s", name, nsname)
+
+	builder := FRRConfigurationBuilder{
+		apiClient: apiClient,
+		Definition: &mlbtypes.FRRConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       frrConfigurationKind,
+				APIVersion: fmt.Sprintf("%s/%s", FRRK8sAPIGroup, FRRK8sAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the FRRConfiguration is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("FRRConfiguration 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the FRRConfiguration is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("FRRConfiguration 'nsname' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullFRRConfiguration pulls existing FRRConfiguration from cluster.
+func PullFRRConfiguration(apiClient *clients.Settings, name, nsname string) (*FRRConfigurationBuilder, error) {
+	logging.V(100).Infof("Pulling existing FRRConfiguration name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("FRRConfiguration 'apiClient' cannot be empty")
+	}
+
+	builder := FRRConfigurationBuilder{
+		apiClient: apiClient,
+		Definition: &mlbtypes.FRRConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the FRRConfiguration is empty")
+
+		return nil, fmt.Errorf("FRRConfiguration 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the FRRConfiguration is empty")
+
+		return nil, fmt.Errorf("FRRConfiguration 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("FRRConfiguration object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns FRRConfiguration object if found.
+func (builder *FRRConfigurationBuilder) Get() (*mlbtypes.FRRConfiguration, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Collecting FRRConfiguration object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(
+		GetFRRConfigurationGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof(
+			"FRRConfiguration object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertToFRRConfiguration(unsObject)
+}
+
+// Exists checks whether the given FRRConfiguration exists.
+func (builder *FRRConfigurationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof(
+		"Checking if FRRConfiguration %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a FRRConfiguration in the cluster and stores the created object in struct. The definition is
+// checked against the other FRRConfigurations in the namespace since frr-k8s merges all of them into a single
+// configuration and rejects the conflicting ones.
+func (builder *FRRConfigurationBuilder) Create() (*FRRConfigurationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the FRRConfiguration %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if err := builder.validateMerge(); err != nil {
+		logging.V(100).Infof("FRRConfiguration %s conflicts with existing configuration", builder.Definition.Name)
+
+		return builder, err
+	}
+
+	unstructuredFRRConfiguration, err := convertFRRConfigurationToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured FRRConfiguration to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(
+		GetFRRConfigurationGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), unstructuredFRRConfiguration, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create FRRConfiguration")
+
+		return builder, err
+	}
+
+	builder.Object, err = convertToFRRConfiguration(unsObject)
+
+	return builder, err
+}
+
+// Delete removes FRRConfiguration object from a cluster.
+func (builder *FRRConfigurationBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the FRRConfiguration object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(
+		GetFRRConfigurationGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete FRRConfiguration: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Update renovates the existing FRRConfiguration object with the FRRConfiguration definition in builder.
+func (builder *FRRConfigurationBuilder) Update() (*FRRConfigurationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to update FRRConfiguration, object doesn't exist on cluster")
+	}
+
+	logging.V(100).Infof("Updating the FRRConfiguration object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.validateMerge(); err != nil {
+		logging.V(100).Infof("FRRConfiguration %s conflicts with existing configuration", builder.Definition.Name)
+
+		return builder, err
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredFRRConfiguration, err := convertFRRConfigurationToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured FRRConfiguration to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(
+		GetFRRConfigurationGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), unstructuredFRRConfiguration, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertToFRRConfiguration(unsObject)
+
+	return builder, err
+}
+
+// WithBGPRouter appends a BGP router with the given local ASN to the FRRConfiguration. Routers are addressed by
+// their index in the order they were added, an empty vrf selects the default VRF.
+func (builder *FRRConfigurationBuilder) WithBGPRouter(asn uint32, routerID, vrf string) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating FRRConfiguration %s in namespace %s with BGP router asn: %d, id: %s, vrf: %s",
+		builder.Definition.Name, builder.Definition.Namespace, asn, routerID, vrf)
+
+	if asn == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("FRRConfiguration router 'asn' cannot be 0"))
+	}
+
+	if routerID != "" && net.ParseIP(routerID).To4() == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration router 'id' %s is not a valid IPv4 address", routerID))
+	}
+
+	for _, router := range builder.Definition.Spec.BGP.Routers {
+		if router.VRF == vrf {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"FRRConfiguration already has a router in vrf '%s'", vrf))
+		}
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.BGP.Routers = append(builder.Definition.Spec.BGP.Routers, mlbtypes.Router{
+		ASN: asn,
+		ID:  routerID,
+		VRF: vrf,
+	})
+
+	return builder
+}
+
+// WithRouterPrefixes defines the prefixes the router at routerIndex advertises.
+func (builder *FRRConfigurationBuilder) WithRouterPrefixes(
+	prefixes []string, routerIndex uint) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating FRRConfiguration %s in namespace %s with prefixes %v on router %d",
+		builder.Definition.Name, builder.Definition.Namespace, prefixes, routerIndex)
+
+	if err := builder.validateRouterIndex(routerIndex); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	if err := validateCIDRs(prefixes); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.BGP.Routers[routerIndex].Prefixes = prefixes
+
+	return builder
+}
+
+// WithBGPNeighbor appends a BGP neighbor to the router at routerIndex. Neighbors are addressed by their index in
+// the order they were added to the router.
+func (builder *FRRConfigurationBuilder) WithBGPNeighbor(
+	neighborIP string, remoteASN uint32, routerIndex uint) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Creating FRRConfiguration %s in namespace %s with neighbor %s asn %d on router %d",
+		builder.Definition.Name, builder.Definition.Namespace, neighborIP, remoteASN, routerIndex)
+
+	if err := builder.validateRouterIndex(routerIndex); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	if net.ParseIP(neighborIP) == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration neighbor address %s is not a valid IP address", neighborIP))
+	}
+
+	if remoteASN == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("FRRConfiguration neighbor 'asn' cannot be 0"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	router := &builder.Definition.Spec.BGP.Routers[routerIndex]
+
+	for _, neighbor := range router.Neighbors {
+		if neighbor.Address == neighborIP {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"FRRConfiguration router %d already has neighbor %s", routerIndex, neighborIP))
+
+			return builder
+		}
+	}
+
+	router.Neighbors = append(router.Neighbors, mlbtypes.Neighbor{
+		ASN:     remoteASN,
+		Address: neighborIP,
+	})
+
+	return builder
+}
+
+// WithNeighborTimers defines the holdTime and keepalive time of the neighbor at neighborIndex of the router at
+// routerIndex. The keepalive time must be lower than the holdTime.
+func (builder *FRRConfigurationBuilder) WithNeighborTimers(
+	holdTime, keepalive metav1.Duration, routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting holdTime %s and keepalive %s on FRRConfiguration %s neighbor %s",
+		holdTime.Duration, keepalive.Duration, builder.Definition.Name, neighbor.Address)
+
+	if holdTime.Duration < minBGPHoldTime {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration neighbor 'holdTime' %s cannot be lower than %s", holdTime.Duration, minBGPHoldTime))
+
+		return builder
+	}
+
+	if keepalive.Duration >= holdTime.Duration {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration neighbor 'keepalive' %s must be lower than 'holdTime' %s",
+			keepalive.Duration, holdTime.Duration))
+
+		return builder
+	}
+
+	neighbor.HoldTime = &holdTime
+	neighbor.KeepaliveTime = &keepalive
+
+	return builder
+}
+
+// WithNeighborEBGPMultiHop marks the neighbor at neighborIndex of the router at routerIndex as multiple hops away.
+func (builder *FRRConfigurationBuilder) WithNeighborEBGPMultiHop(
+	routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting ebgpMultiHop on FRRConfiguration %s neighbor %s",
+		builder.Definition.Name, neighbor.Address)
+
+	neighbor.EBGPMultiHop = true
+
+	return builder
+}
+
+// WithNeighborPasswordSecret defines the secret holding the password of the neighbor at neighborIndex of the router
+// at routerIndex. The secret must live in the frr-k8s namespace.
+func (builder *FRRConfigurationBuilder) WithNeighborPasswordSecret(
+	secretName string, routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting passwordSecret %s on FRRConfiguration %s neighbor %s",
+		secretName, builder.Definition.Name, neighbor.Address)
+
+	if secretName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration neighbor 'passwordSecret' cannot be empty"))
+
+		return builder
+	}
+
+	neighbor.PasswordSecret.Name = secretName
+	neighbor.PasswordSecret.Namespace = builder.Definition.Namespace
+
+	return builder
+}
+
+// WithToAdvertiseModeAll allows advertising every prefix of the router to the neighbor at neighborIndex of the
+// router at routerIndex.
+func (builder *FRRConfigurationBuilder) WithToAdvertiseModeAll(
+	routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting toAdvertise mode all on FRRConfiguration %s neighbor %s",
+		builder.Definition.Name, neighbor.Address)
+
+	neighbor.ToAdvertise.Allowed = mlbtypes.AllowedOutPrefixes{Mode: mlbtypes.AllowAll}
+
+	return builder
+}
+
+// WithToAdvertiseModeFiltered allows advertising only the given prefixes to the neighbor at neighborIndex of the
+// router at routerIndex. Every prefix must be one of the prefixes of the router.
+func (builder *FRRConfigurationBuilder) WithToAdvertiseModeFiltered(
+	prefixes []string, routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting toAdvertise prefixes %v on FRRConfiguration %s neighbor %s",
+		prefixes, builder.Definition.Name, neighbor.Address)
+
+	routerPrefixes := builder.Definition.Spec.BGP.Routers[routerIndex].Prefixes
+
+	for _, prefix := range prefixes {
+		if !slices.Contains(routerPrefixes, prefix) {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"FRRConfiguration prefix %s to advertise is not one of the prefixes of router %d", prefix, routerIndex))
+
+			return builder
+		}
+	}
+
+	neighbor.ToAdvertise.Allowed = mlbtypes.AllowedOutPrefixes{Mode: mlbtypes.AllowRestricted, Prefixes: prefixes}
+
+	return builder
+}
+
+// WithToReceiveModeAll allows receiving every prefix from the neighbor at neighborIndex of the router at
+// routerIndex.
+func (builder *FRRConfigurationBuilder) WithToReceiveModeAll(
+	routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting toReceive mode all on FRRConfiguration %s neighbor %s",
+		builder.Definition.Name, neighbor.Address)
+
+	neighbor.ToReceive.Allowed = mlbtypes.AllowedInPrefixes{Mode: mlbtypes.AllowAll}
+
+	return builder
+}
+
+// WithToReceiveModeFiltered allows receiving only the given prefixes from the neighbor at neighborIndex of the
+// router at routerIndex.
+func (builder *FRRConfigurationBuilder) WithToReceiveModeFiltered(
+	prefixes []string, routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting toReceive prefixes %v on FRRConfiguration %s neighbor %s",
+		prefixes, builder.Definition.Name, neighbor.Address)
+
+	if err := validateCIDRs(prefixes); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	prefixSelectors := []mlbtypes.PrefixSelector{}
+	for _, prefix := range prefixes {
+		prefixSelectors = append(prefixSelectors, mlbtypes.PrefixSelector{Prefix: prefix})
+	}
+
+	neighbor.ToReceive.Allowed = mlbtypes.AllowedInPrefixes{Mode: mlbtypes.AllowRestricted, Prefixes: prefixSelectors}
+
+	return builder
+}
+
+// WithBFDProfile appends a BFD profile to the FRRConfiguration so it can be referenced by the neighbors.
+func (builder *FRRConfigurationBuilder) WithBFDProfile(bfdProfile mlbtypes.FRRBFDProfile) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating FRRConfiguration %s in namespace %s with BFD profile %s",
+		builder.Definition.Name, builder.Definition.Namespace, bfdProfile.Name)
+
+	if bfdProfile.Name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration BFD profile 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.getBFDProfile(bfdProfile.Name) != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration already has BFD profile %s", bfdProfile.Name))
+
+		return builder
+	}
+
+	builder.Definition.Spec.BGP.BFDProfiles = append(builder.Definition.Spec.BGP.BFDProfiles, bfdProfile)
+
+	return builder
+}
+
+// WithNeighborBFDProfile enables BFD with the given profile on the neighbor at neighborIndex of the router at
+// routerIndex. The profile must be added with WithBFDProfile first.
+func (builder *FRRConfigurationBuilder) WithNeighborBFDProfile(
+	profileName string, routerIndex, neighborIndex uint) *FRRConfigurationBuilder {
+	neighbor := builder.getNeighbor(routerIndex, neighborIndex)
+	if neighbor == nil {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting BFD profile %s on FRRConfiguration %s neighbor %s",
+		profileName, builder.Definition.Name, neighbor.Address)
+
+	if builder.getBFDProfile(profileName) == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration BFD profile %s is not defined", profileName))
+
+		return builder
+	}
+
+	neighbor.BFDProfile = profileName
+
+	return builder
+}
+
+// WithNodeSelector limits the nodes applying the FRRConfiguration to the ones matching the given labels.
+func (builder *FRRConfigurationBuilder) WithNodeSelector(nodeSelector map[string]string) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating FRRConfiguration %s in namespace %s with nodeSelector %v",
+		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration 'nodeSelector' cannot be empty map"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = metav1.LabelSelector{MatchLabels: nodeSelector}
+
+	return builder
+}
+
+// WithOptions creates FRRConfiguration with generic mutation options.
+func (builder *FRRConfigurationBuilder) WithOptions(
+	options ...FRRConfigurationAdditionalOptions) *FRRConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting FRRConfiguration additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// WaitUntilApplied waits for the duration of the defined timeout or until the FRR instance on every node selected
+// by the FRRConfiguration reports a successful conversion and reload with all the routers of the FRRConfiguration
+// present in the running config.
+func (builder *FRRConfigurationBuilder) WaitUntilApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until FRRConfiguration %s in namespace %s is applied",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			nodeStates, err := builder.getSelectedNodeStates()
+			if err != nil || len(nodeStates) == 0 {
+				return false, nil
+			}
+
+			for _, nodeState := range nodeStates {
+				if !builder.isAppliedOn(nodeState) {
+					logging.V(100).Infof("FRRConfiguration %s is not applied yet on node %s",
+						builder.Definition.Name, nodeState.Name)
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+}
+
+// GetFRRConfigurationGVR returns frrconfiguration's GroupVersionResource, which could be used for Clean function.
+func GetFRRConfigurationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: FRRK8sAPIGroup, Version: FRRK8sAPIVersion, Resource: "frrconfigurations",
+	}
+}
+
+// GetFRRNodeStateGVR returns frrnodestate's GroupVersionResource.
+func GetFRRNodeStateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: FRRK8sAPIGroup, Version: FRRK8sAPIVersion, Resource: "frrnodestates",
+	}
+}
+
+// validateMerge checks the definition against the other FRRConfigurations in the namespace following the frr-k8s
+// merge rules: routers in the same VRF must share the ASN and router ID, neighbors of the same router must share
+// the ASN and BFD profiles with the same name must be identical.
+func (builder *FRRConfigurationBuilder) validateMerge() error {
+	unsList, err := builder.apiClient.Resource(GetFRRConfigurationGVR()).Namespace(builder.Definition.Namespace).List(
+		context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list FRRConfigurations in namespace %s: %w", builder.Definition.Namespace, err)
+	}
+
+	for index := range unsList.Items {
+		if unsList.Items[index].GetName() == builder.Definition.Name {
+			continue
+		}
+
+		existing, err := convertToFRRConfiguration(&unsList.Items[index])
+		if err != nil {
+			return err
+		}
+
+		if err := validateFRRConfigurationsMerge(builder.Definition, existing); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateFRRConfigurationsMerge returns an error describing the first conflict between the two configurations.
+func validateFRRConfigurationsMerge(config, existing *mlbtypes.FRRConfiguration) error {
+	for _, router := range config.Spec.BGP.Routers {
+		for _, existingRouter := range existing.Spec.BGP.Routers {
+			if router.VRF != existingRouter.VRF {
+				continue
+			}
+
+			if router.ASN != existingRouter.ASN {
+				return fmt.Errorf("FRRConfiguration router in vrf '%s' has asn %d conflicting with asn %d of %s",
+					router.VRF, router.ASN, existingRouter.ASN, existing.Name)
+			}
+
+			if router.ID != "" && existingRouter.ID != "" && router.ID != existingRouter.ID {
+				return fmt.Errorf("FRRConfiguration router in vrf '%s' has id %s conflicting with id %s of %s",
+					router.VRF, router.ID, existingRouter.ID, existing.Name)
+			}
+
+			for _, neighbor := range router.Neighbors {
+				for _, existingNeighbor := range existingRouter.Neighbors {
+					if neighbor.Address == existingNeighbor.Address && neighbor.ASN != existingNeighbor.ASN {
+						return fmt.Errorf("FRRConfiguration neighbor %s has asn %d conflicting with asn %d of %s",
+							neighbor.Address, neighbor.ASN, existingNeighbor.ASN, existing.Name)
+					}
+				}
+			}
+		}
+	}
+
+	for _, bfdProfile := range config.Spec.BGP.BFDProfiles {
+		for _, existingProfile := range existing.Spec.BGP.BFDProfiles {
+			if bfdProfile.Name == existingProfile.Name && !reflect.DeepEqual(bfdProfile, existingProfile) {
+				return fmt.Errorf("FRRConfiguration BFD profile %s conflicts with the one of %s",
+					bfdProfile.Name, existing.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getSelectedNodeStates returns the FRRNodeStates of the nodes selected by the FRRConfiguration.
+func (builder *FRRConfigurationBuilder) getSelectedNodeStates() ([]*mlbtypes.FRRNodeState, error) {
+	unsList, err := builder.apiClient.Resource(GetFRRNodeStateGVR()).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&builder.Definition.Spec.NodeSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeList, err := builder.apiClient.CoreV1Interface.Nodes().List(
+		context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	selectedNodes := make(map[string]bool)
+	for _, node := range nodeList.Items {
+		selectedNodes[node.Name] = true
+	}
+
+	var nodeStates []*mlbtypes.FRRNodeState
+
+	for index := range unsList.Items {
+		if !selectedNodes[unsList.Items[index].GetName()] {
+			continue
+		}
+
+		nodeState := &mlbtypes.FRRNodeState{}
+
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsList.Items[index].Object, nodeState)
+		if err != nil {
+			return nil, err
+		}
+
+		nodeStates = append(nodeStates, nodeState)
+	}
+
+	return nodeStates, nil
+}
+
+// isAppliedOn checks that the FRR instance reports success and runs every router of the FRRConfiguration.
+func (builder *FRRConfigurationBuilder) isAppliedOn(nodeState *mlbtypes.FRRNodeState) bool {
+	if nodeState.Status.LastConversionResult != frrResultSuccess ||
+		nodeState.Status.LastReloadResult != frrResultSuccess {
+		return false
+	}
+
+	for _, router := range builder.Definition.Spec.BGP.Routers {
+		routerConfig := fmt.Sprintf("router bgp %d", router.ASN)
+		if router.VRF != "" {
+			routerConfig = fmt.Sprintf("%s vrf %s", routerConfig, router.VRF)
+		}
+
+		if !strings.Contains(nodeState.Status.RunningConfig, routerConfig) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateRouterIndex checks that a router exists at routerIndex.
+func (builder *FRRConfigurationBuilder) validateRouterIndex(routerIndex uint) error {
+	if int(routerIndex) >= len(builder.Definition.Spec.BGP.Routers) {
+		return fmt.Errorf("FRRConfiguration has no router at index %d", routerIndex)
+	}
+
+	return nil
+}
+
+// getNeighbor validates the builder and returns the neighbor at neighborIndex of the router at routerIndex. It
+// returns nil and records the error in the builder if the neighbor does not exist.
+func (builder *FRRConfigurationBuilder) getNeighbor(routerIndex, neighborIndex uint) *mlbtypes.Neighbor {
+	if valid, _ := builder.validate(); !valid {
+		return nil
+	}
+
+	if err := builder.validateRouterIndex(routerIndex); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return nil
+	}
+
+	router := &builder.Definition.Spec.BGP.Routers[routerIndex]
+
+	if int(neighborIndex) >= len(router.Neighbors) {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"FRRConfiguration router %d has no neighbor at index %d", routerIndex, neighborIndex))
+
+		return nil
+	}
+
+	return &router.Neighbors[neighborIndex]
+}
+
+// getBFDProfile returns the BFD profile of the definition with the given name or nil if not found.
+func (builder *FRRConfigurationBuilder) getBFDProfile(profileName string) *mlbtypes.FRRBFDProfile {
+	for index := range builder.Definition.Spec.BGP.BFDProfiles {
+		if builder.Definition.Spec.BGP.BFDProfiles[index].Name == profileName {
+			return &builder.Definition.Spec.BGP.BFDProfiles[index]
+		}
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *FRRConfigurationBuilder) validate() (bool, error) {
+	resourceCRD := "FRRConfiguration"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateCIDRs checks that the list is not empty and every entry in it is a valid CIDR.
+func validateCIDRs(cidrs []string) error {
+	if len(cidrs) == 0 {
+		return fmt.Errorf("prefixes cannot be empty list")
+	}
+
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("prefix %s is not a valid CIDR", cidr)
+		}
+	}
+
+	return nil
+}
+
+// convertFRRConfigurationToUnstructured converts the FRRConfiguration through its JSON representation. The default
+// unstructured converter keeps the uint32 ASNs as uint64 values, which are not valid in unstructured content.
+func convertFRRConfigurationToUnstructured(
+	frrConfiguration *mlbtypes.FRRConfiguration) (*unstructured.Unstructured, error) {
+	jsonFRRConfiguration, err := json.Marshal(frrConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	unstructuredFRRConfiguration := make(map[string]interface{})
+
+	err = utiljson.Unmarshal(jsonFRRConfiguration, &unstructuredFRRConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: unstructuredFRRConfiguration}, nil
+}
+
+func convertToFRRConfiguration(unsObject *unstructured.Unstructured) (*mlbtypes.FRRConfiguration, error) {
+	frrConfiguration := &mlbtypes.FRRConfiguration{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, frrConfiguration)
+	if err != nil {
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to FRRConfiguration object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return frrConfiguration, err
+}
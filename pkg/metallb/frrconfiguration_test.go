@@ -0,0 +1,445 @@
+package metallb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	frrConfigurationGVK = schema.GroupVersionKind{
+		Group:   FRRK8sAPIGroup,
+		Version: FRRK8sAPIVersion,
+		Kind:    frrConfigurationKind,
+	}
+	defaultFRRConfigurationName = "default-frr-configuration"
+)
+
+func TestNewFRRConfigurationBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultFRRConfigurationName,
+			namespace:     defaultNsName,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultNsName,
+			expectedError: "FRRConfiguration 'name' cannot be empty",
+		},
+		{
+			name:          defaultFRRConfigurationName,
+			namespace:     "",
+			expectedError: "FRRConfiguration 'nsname' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewFRRConfigurationBuilder(
+			buildFRRConfigurationTestClient(t), testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullFRRConfiguration(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultFRRConfigurationName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("FRRConfiguration 'name' cannot be empty"),
+		},
+		{
+			name:                defaultFRRConfigurationName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("FRRConfiguration object %s doesn't exist in namespace %s",
+				defaultFRRConfigurationName, defaultNsName),
+		},
+		{
+			name:                defaultFRRConfigurationName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("FRRConfiguration 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			frrConfigurations []*mlbtypes.FRRConfiguration
+			testSettings      *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			frrConfigurations = append(frrConfigurations,
+				buildDummyFRRConfiguration(testCase.name, 64500, "10.0.0.1", 64501))
+		}
+
+		if testCase.client {
+			testSettings = buildFRRConfigurationTestClient(t, frrConfigurations...)
+		}
+
+		testBuilder, err := PullFRRConfiguration(testSettings, testCase.name, defaultNsName)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, uint32(64500), testBuilder.Definition.Spec.BGP.Routers[0].ASN)
+		}
+	}
+}
+
+func TestFRRConfigurationCreate(t *testing.T) {
+	testCases := []struct {
+		existingRouterASN   uint32
+		existingNeighborASN uint32
+		expectedError       string
+	}{
+		{
+			existingRouterASN:   64500,
+			existingNeighborASN: 64501,
+			expectedError:       "",
+		},
+		{
+			existingRouterASN:   64600,
+			existingNeighborASN: 64501,
+			expectedError: "FRRConfiguration router in vrf '' has asn 64500 conflicting with asn 64600 " +
+				"of existing-frr-configuration",
+		},
+		{
+			existingRouterASN:   64500,
+			existingNeighborASN: 64601,
+			expectedError: "FRRConfiguration neighbor 10.0.0.1 has asn 64501 conflicting with asn 64601 " +
+				"of existing-frr-configuration",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildFRRConfigurationTestClient(t, buildDummyFRRConfiguration(
+			"existing-frr-configuration", testCase.existingRouterASN, "10.0.0.1", testCase.existingNeighborASN))
+
+		testBuilder, err := buildValidFRRConfigurationBuilder(testSettings).Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+			assert.Equal(t, testBuilder.Definition.Spec.BGP.Routers, testBuilder.Object.Spec.BGP.Routers)
+		}
+	}
+}
+
+func TestFRRConfigurationDelete(t *testing.T) {
+	testSettings := buildFRRConfigurationTestClient(t,
+		buildDummyFRRConfiguration(defaultFRRConfigurationName, 64500, "10.0.0.1", 64501))
+
+	testBuilder := buildValidFRRConfigurationBuilder(testSettings)
+	assert.True(t, testBuilder.Exists())
+
+	err := testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestFRRConfigurationWithBGPRouter(t *testing.T) {
+	testCases := []struct {
+		asn           uint32
+		routerID      string
+		vrf           string
+		expectedError string
+	}{
+		{
+			asn:           64500,
+			routerID:      "10.10.10.10",
+			vrf:           "red",
+			expectedError: "",
+		},
+		{
+			asn:           0,
+			vrf:           "red",
+			expectedError: "FRRConfiguration router 'asn' cannot be 0",
+		},
+		{
+			asn:           64500,
+			routerID:      "2001::1",
+			vrf:           "red",
+			expectedError: "FRRConfiguration router 'id' 2001::1 is not a valid IPv4 address",
+		},
+		{
+			asn:           64500,
+			expectedError: "FRRConfiguration already has a router in vrf ''",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewFRRConfigurationBuilder(
+			buildFRRConfigurationTestClient(t), defaultFRRConfigurationName, defaultNsName).
+			WithBGPRouter(64500, "", "").
+			WithBGPRouter(testCase.asn, testCase.routerID, testCase.vrf)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, mlbtypes.Router{ASN: testCase.asn, ID: testCase.routerID, VRF: testCase.vrf},
+				testBuilder.Definition.Spec.BGP.Routers[1])
+		}
+	}
+}
+
+func TestFRRConfigurationWithBGPNeighbor(t *testing.T) {
+	testCases := []struct {
+		neighborIP    string
+		remoteASN     uint32
+		routerIndex   uint
+		expectedError string
+	}{
+		{
+			neighborIP:    "10.0.0.2",
+			remoteASN:     64502,
+			routerIndex:   0,
+			expectedError: "",
+		},
+		{
+			neighborIP:    "10.0.0.2",
+			remoteASN:     64502,
+			routerIndex:   1,
+			expectedError: "FRRConfiguration has no router at index 1",
+		},
+		{
+			neighborIP:    "10.0.0",
+			remoteASN:     64502,
+			routerIndex:   0,
+			expectedError: "FRRConfiguration neighbor address 10.0.0 is not a valid IP address",
+		},
+		{
+			neighborIP:    "10.0.0.1",
+			remoteASN:     64502,
+			routerIndex:   0,
+			expectedError: "FRRConfiguration router 0 already has neighbor 10.0.0.1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidFRRConfigurationBuilder(buildFRRConfigurationTestClient(t)).
+			WithBGPNeighbor(testCase.neighborIP, testCase.remoteASN, testCase.routerIndex)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Len(t, testBuilder.Definition.Spec.BGP.Routers[0].Neighbors, 2)
+			assert.Equal(t, testCase.neighborIP, testBuilder.Definition.Spec.BGP.Routers[0].Neighbors[1].Address)
+		}
+	}
+}
+
+func TestFRRConfigurationNeighborSettings(t *testing.T) {
+	testBuilder := buildValidFRRConfigurationBuilder(buildFRRConfigurationTestClient(t)).
+		WithRouterPrefixes([]string{"192.168.10.0/24", "192.168.20.0/24"}, 0).
+		WithNeighborTimers(metav1.Duration{Duration: 9 * time.Second}, metav1.Duration{Duration: 3 * time.Second}, 0, 0).
+		WithNeighborEBGPMultiHop(0, 0).
+		WithNeighborPasswordSecret("bgp-secret", 0, 0).
+		WithToAdvertiseModeFiltered([]string{"192.168.10.0/24"}, 0, 0).
+		WithToReceiveModeAll(0, 0).
+		WithBFDProfile(mlbtypes.FRRBFDProfile{Name: "bfd-profile"}).
+		WithNeighborBFDProfile("bfd-profile", 0, 0).
+		WithNodeSelector(map[string]string{"node-role.kubernetes.io/worker": ""})
+
+	assert.Nil(t, testBuilder.errorMsg)
+
+	neighbor := testBuilder.Definition.Spec.BGP.Routers[0].Neighbors[0]
+	assert.Equal(t, 9*time.Second, neighbor.HoldTime.Duration)
+	assert.Equal(t, 3*time.Second, neighbor.KeepaliveTime.Duration)
+	assert.True(t, neighbor.EBGPMultiHop)
+	assert.Equal(t, "bgp-secret", neighbor.PasswordSecret.Name)
+	assert.Equal(t, mlbtypes.AllowedOutPrefixes{Mode: mlbtypes.AllowRestricted, Prefixes: []string{"192.168.10.0/24"}},
+		neighbor.ToAdvertise.Allowed)
+	assert.Equal(t, mlbtypes.AllowAll, neighbor.ToReceive.Allowed.Mode)
+	assert.Equal(t, "bfd-profile", neighbor.BFDProfile)
+	assert.Equal(t, map[string]string{"node-role.kubernetes.io/worker": ""},
+		testBuilder.Definition.Spec.NodeSelector.MatchLabels)
+}
+
+func TestFRRConfigurationNeighborSettingsErrors(t *testing.T) {
+	testCases := []struct {
+		mutate        func(builder *FRRConfigurationBuilder) *FRRConfigurationBuilder
+		expectedError string
+	}{
+		{
+			mutate: func(builder *FRRConfigurationBuilder) *FRRConfigurationBuilder {
+				return builder.WithNeighborEBGPMultiHop(0, 1)
+			},
+			expectedError: "FRRConfiguration router 0 has no neighbor at index 1",
+		},
+		{
+			mutate: func(builder *FRRConfigurationBuilder) *FRRConfigurationBuilder {
+				return builder.WithNeighborTimers(
+					metav1.Duration{Duration: 9 * time.Second}, metav1.Duration{Duration: 9 * time.Second}, 0, 0)
+			},
+			expectedError: "FRRConfiguration neighbor 'keepalive' 9s must be lower than 'holdTime' 9s",
+		},
+		{
+			mutate: func(builder *FRRConfigurationBuilder) *FRRConfigurationBuilder {
+				return builder.WithToAdvertiseModeFiltered([]string{"192.168.30.0/24"}, 0, 0)
+			},
+			expectedError: "FRRConfiguration prefix 192.168.30.0/24 to advertise is not one of the prefixes of router 0",
+		},
+		{
+			mutate: func(builder *FRRConfigurationBuilder) *FRRConfigurationBuilder {
+				return builder.WithToReceiveModeFiltered([]string{"192.168.30.0"}, 0, 0)
+			},
+			expectedError: "prefix 192.168.30.0 is not a valid CIDR",
+		},
+		{
+			mutate: func(builder *FRRConfigurationBuilder) *FRRConfigurationBuilder {
+				return builder.WithNeighborBFDProfile("missing-profile", 0, 0)
+			},
+			expectedError: "FRRConfiguration BFD profile missing-profile is not defined",
+		},
+		{
+			mutate: func(builder *FRRConfigurationBuilder) *FRRConfigurationBuilder {
+				return builder.WithBFDProfile(mlbtypes.FRRBFDProfile{Name: "bfd"}).
+					WithBFDProfile(mlbtypes.FRRBFDProfile{Name: "bfd"})
+			},
+			expectedError: "FRRConfiguration already has BFD profile bfd",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.mutate(buildValidFRRConfigurationBuilder(buildFRRConfigurationTestClient(t)))
+		assert.EqualError(t, testBuilder.errorMsg, testCase.expectedError)
+	}
+}
+
+func TestValidateFRRConfigurationsMerge(t *testing.T) {
+	baseConfig := buildDummyFRRConfiguration("base", 64500, "10.0.0.1", 64501)
+	baseConfig.Spec.BGP.Routers[0].ID = "10.10.10.10"
+	baseConfig.Spec.BGP.BFDProfiles = []mlbtypes.FRRBFDProfile{{Name: "bfd"}}
+
+	otherVRFConfig := buildDummyFRRConfiguration("other-vrf", 64600, "10.0.0.1", 64601)
+	otherVRFConfig.Spec.BGP.Routers[0].VRF = "red"
+	assert.Nil(t, validateFRRConfigurationsMerge(baseConfig, otherVRFConfig))
+
+	routerIDConfig := buildDummyFRRConfiguration("router-id", 64500, "10.0.0.2", 64502)
+	routerIDConfig.Spec.BGP.Routers[0].ID = "10.10.10.20"
+	assert.EqualError(t, validateFRRConfigurationsMerge(baseConfig, routerIDConfig),
+		"FRRConfiguration router in vrf '' has id 10.10.10.10 conflicting with id 10.10.10.20 of router-id")
+
+	receiveInterval := uint32(100)
+	bfdConfig := buildDummyFRRConfiguration("bfd", 64500, "10.0.0.2", 64502)
+	bfdConfig.Spec.BGP.BFDProfiles = []mlbtypes.FRRBFDProfile{{Name: "bfd", ReceiveInterval: &receiveInterval}}
+	assert.EqualError(t, validateFRRConfigurationsMerge(baseConfig, bfdConfig),
+		"FRRConfiguration BFD profile bfd conflicts with the one of bfd")
+}
+
+func TestFRRConfigurationIsAppliedOn(t *testing.T) {
+	testCases := []struct {
+		status   mlbtypes.FRRNodeStateStatus
+		expected bool
+	}{
+		{
+			status: mlbtypes.FRRNodeStateStatus{
+				RunningConfig:        "router bgp 64500\n neighbor 10.0.0.1 remote-as 64501\n",
+				LastConversionResult: "success",
+				LastReloadResult:     "success",
+			},
+			expected: true,
+		},
+		{
+			status: mlbtypes.FRRNodeStateStatus{
+				RunningConfig:        "router bgp 64500\n",
+				LastConversionResult: "success",
+				LastReloadResult:     "failed to reload",
+			},
+			expected: false,
+		},
+		{
+			status: mlbtypes.FRRNodeStateStatus{
+				RunningConfig:        "router bgp 64600\n",
+				LastConversionResult: "success",
+				LastReloadResult:     "success",
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidFRRConfigurationBuilder(buildFRRConfigurationTestClient(t))
+		assert.Equal(t, testCase.expected, testBuilder.isAppliedOn(&mlbtypes.FRRNodeState{Status: testCase.status}))
+	}
+}
+
+func TestGetFRRConfigurationGVR(t *testing.T) {
+	assert.Equal(t, schema.GroupVersionResource{
+		Group: FRRK8sAPIGroup, Version: FRRK8sAPIVersion, Resource: "frrconfigurations",
+	}, GetFRRConfigurationGVR())
+}
+
+func buildValidFRRConfigurationBuilder(apiClient *clients.Settings) *FRRConfigurationBuilder {
+	return NewFRRConfigurationBuilder(apiClient, defaultFRRConfigurationName, defaultNsName).
+		WithBGPRouter(64500, "", "").
+		WithBGPNeighbor("10.0.0.1", 64501, 0)
+}
+
+func buildDummyFRRConfiguration(
+	name string, asn uint32, neighborIP string, neighborASN uint32) *mlbtypes.FRRConfiguration {
+	return &mlbtypes.FRRConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultNsName,
+		},
+		Spec: mlbtypes.FRRConfigurationSpec{
+			BGP: mlbtypes.BGPConfig{
+				Routers: []mlbtypes.Router{{
+					ASN:       asn,
+					Neighbors: []mlbtypes.Neighbor{{ASN: neighborASN, Address: neighborIP}},
+				}},
+			},
+		},
+	}
+}
+
+// buildFRRConfigurationTestClient returns a test client holding the given FRRConfigurations. The fake dynamic client
+// cannot deep copy the unsigned ASNs of typed objects, so only an empty FRRConfiguration in another namespace is used
+// to register the kind and the given ones are created from their unstructured representation.
+func buildFRRConfigurationTestClient(t *testing.T, frrConfigurations ...*mlbtypes.FRRConfiguration) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&mlbtypes.FRRConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "empty-frr-configuration", Namespace: "frr-k8s-system"},
+		}},
+		GVK: []schema.GroupVersionKind{frrConfigurationGVK},
+	})
+
+	for _, frrConfiguration := range frrConfigurations {
+		unsObject, err := convertFRRConfigurationToUnstructured(frrConfiguration)
+		assert.Nil(t, err)
+
+		_, err = testSettings.Resource(GetFRRConfigurationGVR()).Namespace(frrConfiguration.Namespace).Create(
+			context.TODO(), unsObject, metav1.CreateOptions{})
+		assert.Nil(t, err)
+	}
+
+	return testSettings
+}
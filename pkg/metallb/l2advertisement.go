@@ -2,10 +2,11 @@ package metallb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +26,7 @@ type L2AdvertisementBuilder struct {
 	Definition *mlbtypes.L2Advertisement
 	Object     *mlbtypes.L2Advertisement
 	apiClient  *clients.Settings
-	errorMsg   string
+	errorMsg   error
 }
 
 // L2AdvertisementAdditionalOptions additional options for L2Advertisement object.
@@ -33,7 +34,7 @@ type L2AdvertisementAdditionalOptions func(builder *L2AdvertisementBuilder) (*L2
 
 // NewL2AdvertisementBuilder creates a new instance of L2AdvertisementBuilder.
 func NewL2AdvertisementBuilder(apiClient *clients.Settings, name, nsname string) *L2AdvertisementBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new L2Advertisement structure with the following params: %s, %s",
 		name, nsname)
 
@@ -52,15 +53,15 @@ func NewL2AdvertisementBuilder(apiClient *clients.Settings, name, nsname string)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the L2Advertisement is empty")
+		logging.V(100).Infof("The name of the L2Advertisement is empty")
 
-		builder.errorMsg = "L2Advertisement 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("L2Advertisement 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the L2Advertisement is empty")
+		logging.V(100).Infof("The namespace of the L2Advertisement is empty")
 
-		builder.errorMsg = "L2Advertisement 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("L2Advertisement 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -68,7 +69,7 @@ func NewL2AdvertisementBuilder(apiClient *clients.Settings, name, nsname string)
 
 // PullL2Advertisement pulls existing L2Advertisement from cluster.
 func PullL2Advertisement(apiClient *clients.Settings, name, nsname string) (*L2AdvertisementBuilder, error) {
-	glog.V(100).Infof("Pulling existing L2Advertisement name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing L2Advertisement name %s under namespace %s from cluster", name, nsname)
 
 	builder := L2AdvertisementBuilder{
 		apiClient: apiClient,
@@ -81,15 +82,15 @@ func PullL2Advertisement(apiClient *clients.Settings, name, nsname string) (*L2A
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the l2advertisement is empty")
+		logging.V(100).Infof("The name of the l2advertisement is empty")
 
-		builder.errorMsg = "l2advertisement 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("l2advertisement 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the l2advertisement is empty")
+		logging.V(100).Infof("The namespace of the l2advertisement is empty")
 
-		builder.errorMsg = "l2advertisement 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("l2advertisement 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -107,7 +108,7 @@ func (builder *L2AdvertisementBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if L2Advertisement %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -123,7 +124,7 @@ func (builder *L2AdvertisementBuilder) Get() (*mlbtypes.L2Advertisement, error)
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting L2Advertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -132,7 +133,7 @@ func (builder *L2AdvertisementBuilder) Get() (*mlbtypes.L2Advertisement, error)
 		context.TODO(), builder.Definition.Name, metaV1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"L2Advertisement object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -148,16 +149,24 @@ func (builder *L2AdvertisementBuilder) Create() (*L2AdvertisementBuilder, error)
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the L2Advertisement %s in namespace %s",
+	logging.V(100).Infof("Creating the L2Advertisement %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	var err error
 	if !builder.Exists() {
+		err = validateIPAddressPoolsExist(
+			builder.apiClient, builder.Definition.Namespace, builder.Definition.Spec.IPAddressPools)
+		if err != nil {
+			logging.V(100).Infof("L2Advertisement %s references missing IPAddressPool", builder.Definition.Name)
+
+			return nil, err
+		}
+
 		unstructuredL2Advertisement, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured L2Advertisement to unstructured object")
+			logging.V(100).Infof("Failed to convert structured L2Advertisement to unstructured object")
 
 			return nil, err
 		}
@@ -167,7 +176,7 @@ func (builder *L2AdvertisementBuilder) Create() (*L2AdvertisementBuilder, error)
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredL2Advertisement}, metaV1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create L2Advertisement")
+			logging.V(100).Infof("Failed to create L2Advertisement")
 
 			return nil, err
 		}
@@ -188,7 +197,7 @@ func (builder *L2AdvertisementBuilder) Delete() (*L2AdvertisementBuilder, error)
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the L2Advertisement object %s in namespace %s",
+	logging.V(100).Infof("Deleting the L2Advertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -215,12 +224,12 @@ func (builder *L2AdvertisementBuilder) Update(force bool) (*L2AdvertisementBuild
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the L2Advertisement object %s in namespace %s",
+	logging.V(100).Infof("Updating the L2Advertisement object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
 	if !builder.Exists() {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to update the L2Advertisement object %s in namespace %s. "+
 				"Resource doesn't exist",
 			builder.Definition.Name, builder.Definition.Namespace,
@@ -233,7 +242,7 @@ func (builder *L2AdvertisementBuilder) Update(force bool) (*L2AdvertisementBuild
 	unstructuredL2Advert, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured L2Advertisement to unstructured object")
+		logging.V(100).Infof("Failed to convert structured L2Advertisement to unstructured object")
 
 		return nil, err
 	}
@@ -244,13 +253,13 @@ func (builder *L2AdvertisementBuilder) Update(force bool) (*L2AdvertisementBuild
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("L2Advertisement", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("L2Advertisement", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -269,15 +278,16 @@ func (builder *L2AdvertisementBuilder) WithNodeSelector(nodeSelectors []metaV1.L
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Appending L2Advertisement %s in namespace %s with nodeSelectors: %v",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelectors)
 
 	if len(nodeSelectors) < 1 {
-		builder.errorMsg = "error: nodeSelectors setting is empty list, the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error: nodeSelectors setting is empty list, the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -292,15 +302,16 @@ func (builder *L2AdvertisementBuilder) WithIPAddressPools(ipAddressPools []strin
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Appending L2Advertisement %s in namespace %s with IPAddressPools: %v",
 		builder.Definition.Name, builder.Definition.Namespace, ipAddressPools)
 
 	if len(ipAddressPools) < 1 {
-		builder.errorMsg = "error: IPAddressPools setting is empty list, the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error: IPAddressPools setting is empty list, the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -316,16 +327,16 @@ func (builder *L2AdvertisementBuilder) WithIPAddressPoolsSelectors(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Appending L2Advertisement %s in namespace %s with IPAddressPoolSelectors: %v",
 		builder.Definition.Name, builder.Definition.Namespace, poolSelector)
 
 	if len(poolSelector) < 1 {
-		builder.errorMsg = "error: IPAddressPoolSelectors setting is empty list, " +
-			"the list should contain at least one element"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error: IPAddressPoolSelectors setting is empty list, "+
+			"the list should contain at least one element"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -334,6 +345,39 @@ func (builder *L2AdvertisementBuilder) WithIPAddressPoolsSelectors(
 	return builder
 }
 
+// WithInterfaces restricts the L2Advertisement to announce the LoadBalancer IPs only from the specified interfaces.
+func (builder *L2AdvertisementBuilder) WithInterfaces(interfaces []string) *L2AdvertisementBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Appending L2Advertisement %s in namespace %s with interfaces: %v",
+		builder.Definition.Name, builder.Definition.Namespace, interfaces)
+
+	if len(interfaces) < 1 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error: interfaces setting is empty list, the list should contain at least one element"))
+	}
+
+	for _, interfaceName := range interfaces {
+		if interfaceName == "" {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"error: interfaces setting contains empty interface name"))
+
+			break
+		}
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Interfaces = interfaces
+
+	return builder
+}
+
 // WithOptions creates L2Advertisement with generic mutation options.
 func (builder *L2AdvertisementBuilder) WithOptions(
 	options ...L2AdvertisementAdditionalOptions) *L2AdvertisementBuilder {
@@ -341,16 +385,16 @@ func (builder *L2AdvertisementBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting L2Advertisement additional options")
+	logging.V(100).Infof("Setting L2Advertisement additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -373,27 +417,29 @@ func (builder *L2AdvertisementBuilder) validate() (bool, error) {
 	resourceCRD := "L2Advertisement"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -405,8 +451,8 @@ func (builder *L2AdvertisementBuilder) convertToStructured(
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, l2Advertisement)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to L2Advertisement object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to L2Advertisement object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
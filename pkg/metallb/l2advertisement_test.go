@@ -0,0 +1,70 @@
+package metallb
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+var defaultL2AdvertisementName = "default-l2-advertisement"
+
+func TestL2AdvertisementWithInterfaces(t *testing.T) {
+	testCases := []struct {
+		interfaces    []string
+		expectedError string
+	}{
+		{
+			interfaces:    []string{"eth0", "eth1"},
+			expectedError: "",
+		},
+		{
+			interfaces:    []string{},
+			expectedError: "error: interfaces setting is empty list, the list should contain at least one element",
+		},
+		{
+			interfaces:    []string{"eth0", ""},
+			expectedError: "error: interfaces setting contains empty interface name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidL2AdvertisementBuilder(buildTestClientWithDummyObject()).
+			WithInterfaces(testCase.interfaces)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.interfaces, testBuilder.Definition.Spec.Interfaces)
+		}
+	}
+}
+
+func TestL2AdvertisementCreate(t *testing.T) {
+	testCases := []struct {
+		ipAddressPools []string
+		expectedError  string
+	}{
+		{
+			ipAddressPools: []string{defaultIPAddressPoolName},
+			expectedError:  "",
+		},
+		{
+			ipAddressPools: []string{"missing-pool"},
+			expectedError: "IPAddressPool missing-pool referenced by the advertisement doesn't exist " +
+				"in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := buildValidL2AdvertisementBuilder(buildTestClientWithDummyObject()).
+			WithIPAddressPools(testCase.ipAddressPools).Create()
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.ipAddressPools, testBuilder.Object.Spec.IPAddressPools)
+		}
+	}
+}
+
+func buildValidL2AdvertisementBuilder(apiClient *clients.Settings) *L2AdvertisementBuilder {
+	return NewL2AdvertisementBuilder(apiClient, defaultL2AdvertisementName, defaultNsName)
+}
@@ -0,0 +1,73 @@
+package metallb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ListIPAddressPools returns IPAddressPool inventory in the given namespace.
+func ListIPAddressPools(
+	apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*IPAddressPoolBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("IPAddressPool 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list IPAddressPools, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("IPAddressPool 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list IPAddressPools, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := fmt.Sprintf("Listing IPAddressPools in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	unsList, err := apiClient.Resource(GetIPAddressPoolGVR()).Namespace(nsname).List(context.TODO(), passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list IPAddressPools in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var ipAddressPoolObjects []*IPAddressPoolBuilder
+
+	for _, unsObject := range unsList.Items {
+		ipAddressPool := &mlbtypes.IPAddressPool{}
+
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, ipAddressPool)
+		if err != nil {
+			logging.V(100).Infof("Failed to convert from unstructured to IPAddressPool object %s in namespace %s",
+				unsObject.GetName(), unsObject.GetNamespace())
+
+			return nil, err
+		}
+
+		ipAddressPoolObjects = append(ipAddressPoolObjects, &IPAddressPoolBuilder{
+			apiClient:  apiClient,
+			Object:     ipAddressPool,
+			Definition: ipAddressPool,
+		})
+	}
+
+	return ipAddressPoolObjects, nil
+}
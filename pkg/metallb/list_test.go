@@ -0,0 +1,58 @@
+package metallb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestListIPAddressPools(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		nsName        string
+		listOptions   []metav1.ListOptions
+		expectedError error
+	}{
+		{
+			client:        true,
+			nsName:        defaultNsName,
+			expectedError: nil,
+		},
+		{
+			client:        true,
+			nsName:        defaultNsName,
+			listOptions:   []metav1.ListOptions{{}, {}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+		},
+		{
+			client:        true,
+			nsName:        "",
+			expectedError: fmt.Errorf("failed to list IPAddressPools, 'nsname' parameter is empty"),
+		},
+		{
+			client:        false,
+			nsName:        defaultNsName,
+			expectedError: fmt.Errorf("failed to list IPAddressPools, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithDummyObject()
+		}
+
+		ipAddressPools, err := ListIPAddressPools(testSettings, testCase.nsName, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, ipAddressPools, 1)
+			assert.Equal(t, defaultIPAddressPoolName, ipAddressPools[0].Object.Name)
+			assert.Equal(t, defaultIPPoolRange, ipAddressPools[0].Object.Spec.Addresses)
+		}
+	}
+}
@@ -2,10 +2,11 @@ package metallb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/metallb/mlbtypes"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,7 +26,7 @@ type Builder struct {
 	Definition *mlbtypes.MetalLB
 	Object     *mlbtypes.MetalLB
 	apiClient  *clients.Settings
-	errorMsg   string
+	errorMsg   error
 }
 
 // AdditionalOptions additional options for metallb object.
@@ -33,7 +34,7 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string, label map[string]string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new metallb structure with the following params: %s, %s, %v",
 		name, nsname, label)
 
@@ -55,15 +56,15 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, label map[stri
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the metallb is empty")
+		logging.V(100).Infof("The name of the metallb is empty")
 
-		builder.errorMsg = "metallb 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("metallb 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the metallb is empty")
+		logging.V(100).Infof("The namespace of the metallb is empty")
 
-		builder.errorMsg = "metallb 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("metallb 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -71,7 +72,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, label map[stri
 
 // Pull retrieves an existing metallb.io object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling metallb.io object name:%s in namespace: %s", name, nsname)
 
 	builder := Builder{
@@ -85,15 +86,15 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the metallb is empty")
+		logging.V(100).Infof("The name of the metallb is empty")
 
-		builder.errorMsg = "metallb 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("metallb 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the metallb is empty")
+		logging.V(100).Infof("The namespace of the metallb is empty")
 
-		builder.errorMsg = "metallb 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("metallb 'nsname' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -111,7 +112,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if MetalLb %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -119,7 +120,7 @@ func (builder *Builder) Exists() bool {
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect MetalLb object due to %s", err.Error())
+		logging.V(100).Infof("Failed to collect MetalLb object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -131,7 +132,7 @@ func (builder *Builder) Get() (*mlbtypes.MetalLB, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting metallb object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -139,7 +140,7 @@ func (builder *Builder) Get() (*mlbtypes.MetalLB, error) {
 		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"metallb object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -155,7 +156,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the metallb %s in namespace %s",
+	logging.V(100).Infof("Creating the metallb %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -164,7 +165,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		unstructuredMetalLb, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured MetalLb to unstructured object")
+			logging.V(100).Infof("Failed to convert structured MetalLb to unstructured object")
 
 			return nil, err
 		}
@@ -174,7 +175,7 @@ func (builder *Builder) Create() (*Builder, error) {
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredMetalLb}, metav1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create MetalLb")
+			logging.V(100).Infof("Failed to create MetalLb")
 
 			return nil, err
 		}
@@ -195,7 +196,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the metallb object %s in namespace %s",
+	logging.V(100).Infof("Deleting the metallb object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -226,12 +227,12 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return nil, fmt.Errorf("failed to update metallb, object doesn't exist on cluster")
 	}
 
-	glog.V(100).Infof("Updating the metallb object %s in namespace %s",
+	logging.V(100).Infof("Updating the metallb object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
@@ -239,7 +240,7 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 
 	unstructuredMetalLb, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured MetalLb to unstructured object")
+		logging.V(100).Infof("Failed to convert structured MetalLb to unstructured object")
 
 		return nil, err
 	}
@@ -250,13 +251,13 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("metallb", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("metallb", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -275,14 +276,14 @@ func (builder *Builder) RemoveLabel(key string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Removing label %s from metalLbIo %s", key, builder.Definition.Name)
+	logging.V(100).Infof("Removing label %s from metalLbIo %s", key, builder.Definition.Name)
 
 	if key == "" {
-		glog.V(100).Infof("Failed to remove empty label's key from metalLbIo %s", builder.Definition.Name)
-		builder.errorMsg = "error to remove empty key from metalLbIo"
+		logging.V(100).Infof("Failed to remove empty label's key from metalLbIo %s", builder.Definition.Name)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to remove empty key from metalLbIo"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -297,15 +298,16 @@ func (builder *Builder) WithSpeakerNodeSelector(label map[string]string) *Builde
 		return builder
 	}
 
-	glog.V(100).Infof("Adding label selector %v to metallb.io object %s",
+	logging.V(100).Infof("Adding label selector %v to metallb.io object %s",
 		label, builder.Definition.Name,
 	)
 
 	if len(label) < 1 {
-		builder.errorMsg = "can not accept empty label and redefine metallb NodeSelector"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"can not accept empty label and redefine metallb NodeSelector"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -320,16 +322,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting metallb additional options")
+	logging.V(100).Infof("Setting metallb additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -352,27 +354,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "MetalLB"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -383,8 +387,8 @@ func (builder *Builder) convertToStructured(unsObject *unstructured.Unstructured
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, metalLb)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to MetalLb object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to MetalLb object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
@@ -24,6 +24,34 @@ type IPAddressPoolSpec struct {
 	// +optional
 	// +kubebuilder:default:=false
 	AvoidBuggyIPs bool `json:"avoidBuggyIPs,omitempty"`
+
+	// AllocateTo makes ip pool allocation to specific namespace and/or service.
+	// The controller will use the pool with lowest value of priority in case of
+	// multiple matches. A pool with no priority set will be used only if the
+	// pools with priority can't be used. If multiple matching IPAddressPools are
+	// available it will check for the availability of IPs sorting the matching
+	// IPAddressPools by priority, starting from the highest to the lowest. If
+	// multiple IPAddressPools have the same priority, choice will be random.
+	// +optional
+	AllocateTo *ServiceAllocation `json:"serviceAllocation,omitempty"`
+}
+
+// ServiceAllocation defines ip pool allocation to namespace and/or service.
+type ServiceAllocation struct {
+	// Priority priority given for ip pool while ip allocation on a service.
+	// +optional
+	Priority int `json:"priority,omitempty"`
+	// Namespaces list of namespace(s) on which ip pool can be attached.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// NamespaceSelectors list of label selectors to select namespace(s) for ip pool,
+	// an alternative to using namespace list.
+	// +optional
+	NamespaceSelectors []metav1.LabelSelector `json:"namespaceSelectors,omitempty"`
+	// ServiceSelectors list of label selector to select service(s) for which ip pool
+	// can be used for ip allocation.
+	// +optional
+	ServiceSelectors []metav1.LabelSelector `json:"serviceSelectors,omitempty"`
 }
 
 // IPAddressPoolStatus defines the observed state of IPAddressPool.
@@ -42,13 +70,61 @@ type IPAddressPool struct {
 	Status IPAddressPoolStatus `json:"status,omitempty"`
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetwork.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPool.
 func (pool *IPAddressPool) DeepCopy() *IPAddressPool {
 	if pool == nil {
 		return nil
 	}
 
 	out := new(IPAddressPool)
+	out.TypeMeta = pool.TypeMeta
+	pool.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = pool.Spec
+
+	if pool.Spec.Addresses != nil {
+		out.Spec.Addresses = make([]string, len(pool.Spec.Addresses))
+		copy(out.Spec.Addresses, pool.Spec.Addresses)
+	}
+
+	if pool.Spec.AutoAssign != nil {
+		autoAssign := *pool.Spec.AutoAssign
+		out.Spec.AutoAssign = &autoAssign
+	}
+
+	if pool.Spec.AllocateTo != nil {
+		out.Spec.AllocateTo = pool.Spec.AllocateTo.DeepCopy()
+	}
+
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAllocation.
+func (allocation *ServiceAllocation) DeepCopy() *ServiceAllocation {
+	if allocation == nil {
+		return nil
+	}
+
+	out := new(ServiceAllocation)
+	out.Priority = allocation.Priority
+
+	if allocation.Namespaces != nil {
+		out.Namespaces = make([]string, len(allocation.Namespaces))
+		copy(out.Namespaces, allocation.Namespaces)
+	}
+
+	if allocation.NamespaceSelectors != nil {
+		out.NamespaceSelectors = make([]metav1.LabelSelector, len(allocation.NamespaceSelectors))
+		for index := range allocation.NamespaceSelectors {
+			allocation.NamespaceSelectors[index].DeepCopyInto(&out.NamespaceSelectors[index])
+		}
+	}
+
+	if allocation.ServiceSelectors != nil {
+		out.ServiceSelectors = make([]metav1.LabelSelector, len(allocation.ServiceSelectors))
+		for index := range allocation.ServiceSelectors {
+			allocation.ServiceSelectors[index].DeepCopyInto(&out.ServiceSelectors[index])
+		}
+	}
 
 	return out
 }
@@ -1,6 +1,7 @@
 package mlbtypes
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -68,6 +69,13 @@ type BGPPeerSpec struct {
 	// +optional
 	Password string `json:"password,omitempty"`
 
+	// passwordSecret is name of the authentication secret for BGP Peer.
+	// the secret must be of type "kubernetes.io/basic-auth", and created in the
+	// same namespace as the MetalLB deployment. The password is stored in the
+	// secret as the key "password".
+	// +optional
+	PasswordSecret corev1.SecretReference `json:"passwordSecret,omitempty"`
+
 	BFDProfile string `json:"bfdProfile,omitempty"`
 
 	// EBGP peer is multi-hops away
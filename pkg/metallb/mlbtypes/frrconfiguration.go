@@ -0,0 +1,335 @@
+package mlbtypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// FRRConfigurationSpec defines the desired state of FRRConfiguration.
+type FRRConfigurationSpec struct {
+	// BGP is the configuration related to the BGP protocol.
+	// +optional
+	BGP BGPConfig `json:"bgp,omitempty"`
+
+	// Raw is a snippet of raw frr configuration that gets appended to the
+	// one rendered translating the type safe API.
+	// +optional
+	Raw RawConfig `json:"raw,omitempty"`
+
+	// NodeSelector limits the nodes that will attempt to apply this config.
+	// When specified, the configuration will be considered only on nodes
+	// whose labels match the specified selectors.
+	// When it is not specified all nodes will attempt to apply this config.
+	// +optional
+	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// RawConfig is a snippet of raw frr configuration that gets appended to the
+// rendered configuration.
+type RawConfig struct {
+	// Priority is the order with this configuration is appended to the
+	// bottom of the rendered configuration. A higher value means the
+	// raw config is appended later in the configuration file.
+	Priority int `json:"priority,omitempty"`
+
+	// Config is a raw FRR configuration to be appended to the configuration
+	// rendered via the k8s api.
+	Config string `json:"rawConfig,omitempty"`
+}
+
+// BGPConfig is the configuration related to the BGP protocol.
+type BGPConfig struct {
+	// Routers is the list of routers we want FRR to configure (one per VRF).
+	// +optional
+	Routers []Router `json:"routers"`
+	// BFDProfiles is the list of bfd profiles to be used when configuring the neighbors.
+	// +optional
+	BFDProfiles []FRRBFDProfile `json:"bfdProfiles,omitempty"`
+}
+
+// Router represent a neighbor router we want FRR to connect to.
+type Router struct {
+	// ASN is the AS number to use for the local end of the session.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	ASN uint32 `json:"asn"`
+	// ID is the BGP router ID
+	// +optional
+	ID string `json:"id,omitempty"`
+	// VRF is the host vrf used to establish sessions from this router.
+	// +optional
+	VRF string `json:"vrf,omitempty"`
+	// Neighbors is the list of neighbors we want FRR to connect to.
+	// +optional
+	Neighbors []Neighbor `json:"neighbors,omitempty"`
+	// Prefixes is the list of prefixes we want to advertise from this router instance.
+	// +optional
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// Neighbor represents a BGP Neighbor we want FRR to connect to.
+type Neighbor struct {
+	// ASN is the AS number to use for the local end of the session.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	ASN uint32 `json:"asn"`
+	// SourceAddress is the IPv4 or IPv6 source address to use for the BGP
+	// session to this neighbour, may be specified as either an IP address
+	// directly or as an interface name
+	// +optional
+	SourceAddress string `json:"sourceaddress,omitempty"`
+	// Address is the IP address to establish the session with.
+	Address string `json:"address"`
+	// Port is the port to dial when establishing the session.
+	// Defaults to 179.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16384
+	Port *uint16 `json:"port,omitempty"`
+	// Password to be used when establishing the BGP Session.
+	// Password and PasswordSecret are mutually exclusive.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// PasswordSecret is name of the authentication secret for the neighbor.
+	// the secret must be of type "kubernetes.io/basic-auth", and created in the
+	// same namespace as the frr-k8s daemon. The password is stored in the
+	// secret as the key "password".
+	// Password and PasswordSecret are mutually exclusive.
+	// +optional
+	PasswordSecret corev1.SecretReference `json:"passwordSecret,omitempty"`
+	// HoldTime is the requested BGP hold time, per RFC4271.
+	// Defaults to 180s.
+	// +optional
+	HoldTime *metav1.Duration `json:"holdTime,omitempty"`
+	// KeepaliveTime is the requested BGP keepalive time, per RFC4271.
+	// Defaults to 60s.
+	// +optional
+	KeepaliveTime *metav1.Duration `json:"keepaliveTime,omitempty"`
+	// EBGPMultiHop indicates if the BGPPeer is multi-hops away.
+	// +optional
+	EBGPMultiHop bool `json:"ebgpMultiHop,omitempty"`
+	// BFDProfile is the name of the BFD Profile to be used for the BFD session associated
+	// to the BGP session. If not set, the BFD session won't be set up.
+	// +optional
+	BFDProfile string `json:"bfdProfile,omitempty"`
+	// ToAdvertise represents the list of prefixes to advertise to the given neighbor
+	// and the associated properties.
+	// +optional
+	ToAdvertise Advertise `json:"toAdvertise,omitempty"`
+	// ToReceive represents the list of prefixes to receive from the given neighbor.
+	// +optional
+	ToReceive Receive `json:"toReceive,omitempty"`
+	// To set if we want to disable MP BGP that will separate IPv4 and IPv6 route exchanges into distinct BGP sessions.
+	// +optional
+	DisableMP bool `json:"disableMP,omitempty"`
+}
+
+// Advertise represents a list of prefixes to advertise to the given neighbor.
+type Advertise struct {
+	// Allowed is is the list of prefixes allowed to be propagated to
+	// this neighbor. They must match the prefixes defined in the router.
+	Allowed AllowedOutPrefixes `json:"allowed,omitempty"`
+}
+
+// Receive represents a list of prefixes to receive from the given neighbor.
+type Receive struct {
+	// Allowed is the list of prefixes allowed to be received from
+	// this neighbor.
+	// +optional
+	Allowed AllowedInPrefixes `json:"allowed,omitempty"`
+}
+
+// AllowedOutPrefixes represents a list of prefixes to advertise to the given neighbor.
+type AllowedOutPrefixes struct {
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Mode is the mode to use when handling the prefixes.
+	// When set to "filtered", only the prefixes in the given list will be allowed.
+	// When set to "all", all the prefixes configured on the router will be allowed.
+	// +kubebuilder:default:=filtered
+	Mode AllowMode `json:"mode,omitempty"`
+}
+
+// AllowedInPrefixes represents a list of prefixes to receive from the given neighbor.
+type AllowedInPrefixes struct {
+	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
+	// Mode is the mode to use when handling the prefixes.
+	// When set to "filtered", only the prefixes in the given list will be allowed.
+	// When set to "all", all the prefixes configured on the router will be allowed.
+	// +kubebuilder:default:=filtered
+	Mode AllowMode `json:"mode,omitempty"`
+}
+
+// PrefixSelector is a filter of prefixes to receive.
+type PrefixSelector struct {
+	// +kubebuilder:validation:Format="cidr"
+	Prefix string `json:"prefix,omitempty"`
+	// The prefix length modifier. This selector accepts any matching prefix with length
+	// less or equal the given value.
+	// +kubebuilder:validation:Maximum:=128
+	// +kubebuilder:validation:Minimum:=1
+	LE uint32 `json:"le,omitempty"`
+	// The prefix length modifier. This selector accepts any matching prefix with length
+	// greater or equal the given value.
+	// +kubebuilder:validation:Maximum:=128
+	// +kubebuilder:validation:Minimum:=1
+	GE uint32 `json:"ge,omitempty"`
+}
+
+// AllowMode defines the mode used to handle the allowed prefixes.
+type AllowMode string
+
+const (
+	// AllowAll allows all the prefixes.
+	AllowAll AllowMode = "all"
+	// AllowRestricted allows only the prefixes in the given list.
+	AllowRestricted AllowMode = "filtered"
+)
+
+// FRRBFDProfile is the configuration related to the BFD protocol associated
+// to a BGP session.
+type FRRBFDProfile struct {
+	// The name of the BFD Profile to be referenced in other parts
+	// of the configuration.
+	Name string `json:"name"`
+	// The minimum interval that this system is capable of
+	// receiving control packets in milliseconds.
+	// Defaults to 300ms.
+	// +optional
+	ReceiveInterval *uint32 `json:"receiveInterval,omitempty"`
+	// The minimum transmission interval (less jitter)
+	// that this system wants to use to send BFD control packets in
+	// milliseconds. Defaults to 300ms
+	// +optional
+	TransmitInterval *uint32 `json:"transmitInterval,omitempty"`
+	// Configures the detection multiplier to determine
+	// packet loss. The remote transmission interval will be multiplied
+	// by this value to determine the connection loss detection timer.
+	// +optional
+	DetectMultiplier *uint32 `json:"detectMultiplier,omitempty"`
+	// Configures the minimal echo receive transmission
+	// interval that this system is capable of handling in milliseconds.
+	// Defaults to 50ms
+	// +optional
+	EchoInterval *uint32 `json:"echoInterval,omitempty"`
+	// Enables or disables the echo transmission mode.
+	// This mode is disabled by default, and not supported on multi
+	// hops setups.
+	// +optional
+	EchoMode *bool `json:"echoMode,omitempty"`
+	// Mark session as passive: a passive session will not
+	// attempt to start the connection and will wait for control packets
+	// from peer before it begins replying.
+	// +optional
+	PassiveMode *bool `json:"passiveMode,omitempty"`
+	// For multi hop sessions only: configure the minimum
+	// expected TTL for an incoming BFD control packet.
+	// +optional
+	MinimumTTL *uint32 `json:"minimumTtl,omitempty"`
+}
+
+// FRRConfigurationStatus defines the observed state of FRRConfiguration.
+type FRRConfigurationStatus struct {
+}
+
+// FRRConfiguration is a piece of FRR configuration.
+type FRRConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FRRConfigurationSpec   `json:"spec,omitempty"`
+	Status FRRConfigurationStatus `json:"status,omitempty"`
+}
+
+// FRRConfigurationList contains a list of FRRConfiguration.
+type FRRConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FRRConfiguration `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FRRConfiguration.
+func (in *FRRConfiguration) DeepCopy() *FRRConfiguration {
+	if in == nil {
+		return nil
+	}
+
+	out := new(FRRConfiguration)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.NodeSelector.DeepCopyInto(&out.Spec.NodeSelector)
+	out.Spec.Raw = in.Spec.Raw
+	out.Spec.BGP.BFDProfiles = append([]FRRBFDProfile{}, in.Spec.BGP.BFDProfiles...)
+
+	if in.Spec.BGP.Routers != nil {
+		out.Spec.BGP.Routers = make([]Router, len(in.Spec.BGP.Routers))
+
+		for index, router := range in.Spec.BGP.Routers {
+			router.Prefixes = append([]string{}, router.Prefixes...)
+			router.Neighbors = append([]Neighbor{}, router.Neighbors...)
+			out.Spec.BGP.Routers[index] = router
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FRRConfiguration) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// FRRNodeStateStatus defines the observed state of FRRNodeState.
+type FRRNodeStateStatus struct {
+	// RunningConfig represents the current FRR running config, which is the configuration the FRR instance is
+	// currently running with.
+	RunningConfig string `json:"runningConfig,omitempty"`
+	// LastConversionResult is the status of the last translation between the `FRRConfiguration`s resources and FRR's
+	// configuration, contains "success" or an error.
+	LastConversionResult string `json:"lastConversionResult,omitempty"`
+	// LastReloadResult represents the status of the last configuration update operation by FRR, contains "success"
+	// or an error.
+	LastReloadResult string `json:"lastReloadResult,omitempty"`
+}
+
+// FRRNodeState exposes the status of the FRR instance running on each node.
+type FRRNodeState struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status FRRNodeStateStatus `json:"status,omitempty"`
+}
+
+// FRRNodeStateList contains a list of FRRNodeStatus.
+type FRRNodeStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FRRNodeState `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FRRNodeState.
+func (in *FRRNodeState) DeepCopy() *FRRNodeState {
+	if in == nil {
+		return nil
+	}
+
+	out := new(FRRNodeState)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Status = in.Status
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FRRNodeState) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -1,9 +1,10 @@
 package nad
 
 import (
-	"github.com/golang/glog"
+	"errors"
 	nadV1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,13 +15,16 @@ import (
 	"fmt"
 )
 
+// resourceNameAnnotation holds the device plugin resource requested by the pods attached to the NAD.
+const resourceNameAnnotation = "k8s.v1.cni.cncf.io/resourceName"
+
 // Builder provides struct for NAD object which contains connection to cluster and the NAD object itself.
 type Builder struct {
 	Definition        *nadV1.NetworkAttachmentDefinition
 	Object            *nadV1.NetworkAttachmentDefinition
 	metaPluginConfigs []Plugin
 	apiClient         *clients.Settings
-	errorMsg          string
+	errorMsg          error
 }
 
 // NewBuilder creates a new instance of NetworkAttachmentDefinition Builder.
@@ -31,7 +35,7 @@ type Builder struct {
 //
 // return value:    the created Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new NetworkAttachmentDefinition structure with the following params: "+
 			"name: %s, namespace: %s",
 		name, nsname)
@@ -47,15 +51,15 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 	}
 
 	if builder.Definition.Name == "" {
-		glog.V(100).Infof("The name of the NetworkAttachmentDefinition is empty")
+		logging.V(100).Infof("The name of the NetworkAttachmentDefinition is empty")
 
-		builder.errorMsg = "NAD name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NAD name is empty"))
 	}
 
 	if builder.Definition.Namespace == "" {
-		glog.V(100).Infof("The namespace of the NetworkAttachmentDefinition is empty")
+		logging.V(100).Infof("The namespace of the NetworkAttachmentDefinition is empty")
 
-		builder.errorMsg = "NAD namespace is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NAD namespace is empty"))
 	}
 
 	return &builder
@@ -63,7 +67,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull pulls existing networkattachmentdefinition from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing networkattachmentdefinition name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof(
+		"Pulling existing networkattachmentdefinition name %s under namespace %s from cluster", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -76,15 +81,16 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the networkattachmentdefinition is empty")
+		logging.V(100).Infof("The name of the networkattachmentdefinition is empty")
 
-		builder.errorMsg = "networkattachmentdefinition 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("networkattachmentdefinition 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the networkattachmentdefinition is empty")
+		logging.V(100).Infof("The namespace of the networkattachmentdefinition is empty")
 
-		builder.errorMsg = "networkattachmentdefinition 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"networkattachmentdefinition 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -108,7 +114,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating NetworkAttachmentDefinition %s in namespace %s",
+	logging.V(100).Infof("Creating NetworkAttachmentDefinition %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.fillConfigureString()
@@ -117,6 +123,15 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, fmt.Errorf("failed create NAD object, could not marshal configuration " + err.Error())
 	}
 
+	if builder.Definition.Spec.Config != "" {
+		err = ValidateConfig(builder.Definition.Spec.Config)
+		if err != nil {
+			logging.V(100).Infof("The NetworkAttachmentDefinition config is invalid: %s", err.Error())
+
+			return builder, fmt.Errorf("failed create NAD object, invalid configuration: %w", err)
+		}
+	}
+
 	if !builder.Exists() {
 		builder.Object, err = builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).
 			Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
@@ -136,7 +151,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting NetworkAttachmentDefinition %s in namespace %s",
+	logging.V(100).Infof("Deleting NetworkAttachmentDefinition %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -161,7 +176,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating NetworkAttachmentDefinition %s in namespace %s",
+	logging.V(100).Infof("Updating NetworkAttachmentDefinition %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -184,7 +199,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if NetworkAttachmentDefinition %s exists in namespace %s",
+	logging.V(100).Infof("Checking if NetworkAttachmentDefinition %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	_, err := builder.apiClient.NetworkAttachmentDefinitions(builder.Definition.Namespace).Get(context.TODO(),
@@ -200,7 +215,7 @@ func (builder *Builder) GetString() (string, error) {
 		return "", err
 	}
 
-	glog.V(100).Infof("Returning NetworkAttachmentDefinition resource in json format")
+	logging.V(100).Infof("Returning NetworkAttachmentDefinition resource in json format")
 
 	nadByte, err := json.MarshalIndent(builder.Definition, "", "    ")
 	if err != nil {
@@ -217,7 +232,7 @@ func (builder *Builder) fillConfigureString() error {
 		return err
 	}
 
-	glog.V(100).Infof("Adding configuration to NetworkAttachmentDefinition builder if needed")
+	logging.V(100).Infof("Adding configuration to NetworkAttachmentDefinition builder if needed")
 
 	if builder.metaPluginConfigs == nil {
 		return nil
@@ -249,18 +264,18 @@ func (builder *Builder) WithMasterPlugin(masterPlugin *MasterPlugin) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding masterPlugin %v to NAD %s", masterPlugin, builder.Definition.Name)
+	logging.V(100).Infof("Adding masterPlugin %v to NAD %s", masterPlugin, builder.Definition.Name)
 
 	emptyNadConfig := nadV1.NetworkAttachmentDefinitionSpec{}
 
 	if builder.Definition.Spec != emptyNadConfig {
-		builder.errorMsg = "error to redefine predefine NAD"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to redefine predefine NAD"))
 	}
 
 	masterPluginSting, err := json.Marshal(masterPlugin)
 
 	if err != nil {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
 	}
 
 	builder.Definition.Spec.Config = string(masterPluginSting)
@@ -274,7 +289,7 @@ func (builder *Builder) WithPlugins(name string, plugins *[]Plugin) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding plugins to NAD %s", builder.Definition.Name)
+	logging.V(100).Infof("Adding plugins to NAD %s", builder.Definition.Name)
 
 	pluginsConfig := MasterPlugin{
 		CniVersion: "0.4.0",
@@ -285,7 +300,7 @@ func (builder *Builder) WithPlugins(name string, plugins *[]Plugin) *Builder {
 	pluginsConfigString, err := json.Marshal(pluginsConfig)
 
 	if err != nil {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
 	}
 
 	builder.Definition.Spec.Config = string(pluginsConfigString)
@@ -293,6 +308,31 @@ func (builder *Builder) WithPlugins(name string, plugins *[]Plugin) *Builder {
 	return builder
 }
 
+// WithResourceName sets the device plugin resource the pods attached to the NAD request, as needed by sriov NADs.
+func (builder *Builder) WithResourceName(resourceName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding resourceName %s to NAD %s", resourceName, builder.Definition.Name)
+
+	if resourceName == "" {
+		logging.V(100).Infof("The resourceName of the NAD is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NAD resourceName is empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Annotations == nil {
+		builder.Definition.Annotations = make(map[string]string)
+	}
+
+	builder.Definition.Annotations[resourceNameAnnotation] = resourceName
+
+	return builder
+}
+
 // GetGVR returns nad's GroupVersionResource which could be used for Clean function.
 func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
@@ -300,33 +340,40 @@ func GetGVR() schema.GroupVersionResource {
 	}
 }
 
+// GetGVR returns the network attachment definition GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NetworkAttachmentDefinition"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
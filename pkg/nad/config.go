@@ -0,0 +1,90 @@
+package nad
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// ValidateConfig checks that config is a CNI network configuration or configuration list that multus can use. The
+// configuration must have a name and either a plugin type or a non-empty list of plugins, each of them with a type.
+// The addresses, routes and ranges of the IPAM blocks must be valid CIDRs.
+func ValidateConfig(config string) error {
+	var masterPlugin MasterPlugin
+
+	err := json.Unmarshal([]byte(config), &masterPlugin)
+	if err != nil {
+		return fmt.Errorf("NAD config is not valid JSON: %w", err)
+	}
+
+	if masterPlugin.Name == "" {
+		return fmt.Errorf("NAD config name is empty")
+	}
+
+	if masterPlugin.Plugins == nil {
+		if masterPlugin.Type == "" {
+			return fmt.Errorf("NAD config must have either a type or a list of plugins")
+		}
+
+		return validateIPAM(masterPlugin.Ipam)
+	}
+
+	if len(*masterPlugin.Plugins) == 0 {
+		return fmt.Errorf("NAD config list of plugins is empty")
+	}
+
+	var validationErr error
+
+	for index, plugin := range *masterPlugin.Plugins {
+		if plugin.Type == "" {
+			validationErr = errors.Join(validationErr, fmt.Errorf("NAD config plugin %d type is empty", index))
+		}
+
+		validationErr = errors.Join(validationErr, validateIPAM(plugin.Ipam))
+	}
+
+	return validationErr
+}
+
+// validateIPAM checks the CIDRs of the ipam and the fields required by its type.
+func validateIPAM(ipam *IPAM) error {
+	if ipam == nil {
+		return nil
+	}
+
+	var validationErr error
+
+	if ipam.Type == "whereabouts" && ipam.AddrRange == "" && len(ipam.IPRanges) == 0 {
+		validationErr = errors.Join(validationErr, fmt.Errorf("whereabouts ipam must have a range"))
+	}
+
+	var cidrs []string
+
+	cidrs = append(cidrs, ipam.Exclude...)
+
+	if ipam.AddrRange != "" {
+		cidrs = append(cidrs, ipam.AddrRange)
+	}
+
+	for _, ipRange := range ipam.IPRanges {
+		cidrs = append(cidrs, ipRange.Range)
+	}
+
+	for _, address := range ipam.Addresses {
+		cidrs = append(cidrs, address.Address)
+	}
+
+	for _, route := range ipam.Routes {
+		cidrs = append(cidrs, route.Dst)
+	}
+
+	for _, cidr := range cidrs {
+		_, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			validationErr = errors.Join(validationErr, fmt.Errorf("%s ipam has invalid CIDR %s", ipam.Type, cidr))
+		}
+	}
+
+	return validationErr
+}
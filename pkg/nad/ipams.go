@@ -32,3 +32,31 @@ func WhereAboutsAppendRange(ipam *IPAM, ipRange, gateway string) *IPAM {
 
 	return ipam
 }
+
+// IPAMDHCP returns dhcp ipam type. The dhcp daemon must run on the nodes to serve the leases.
+func IPAMDHCP() *IPAM {
+	return &IPAM{Type: "dhcp"}
+}
+
+// StaticAppendAddress returns static ipam type with additional address in CIDR notation.
+func StaticAppendAddress(ipam *IPAM, address, gateway string) *IPAM {
+	if ipam == nil || address == "" {
+		return nil
+	}
+
+	ipam.Addresses = append(ipam.Addresses, StaticAddress{Address: address, Gateway: gateway})
+
+	return ipam
+}
+
+// IPAMAppendRoute returns ipam with additional route to the dst network in CIDR notation. An empty gateway uses
+// the default gateway of the ipam.
+func IPAMAppendRoute(ipam *IPAM, dst, gateway string) *IPAM {
+	if ipam == nil || dst == "" {
+		return nil
+	}
+
+	ipam.Routes = append(ipam.Routes, Route{Dst: dst, Gw: gateway})
+
+	return ipam
+}
@@ -1,28 +1,31 @@
 package nad
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	"k8s.io/utils/strings/slices"
 )
 
 var (
 	// allowedMacVlanMode represents all allowed modes for macvlan plugin type.
-	allowedMacVlanMode      = []string{"bridge", "passthru", "private", "vepa"}
+	allowedMacVlanMode = []string{"bridge", "passthru", "private", "vepa"}
+	// allowedSriovLinkState represents all allowed link states for sriov plugin type.
+	allowedSriovLinkState   = []string{"auto", "enable", "disable"}
 	invalidIpamParameterMsg = "invalid ipam parameter"
 )
 
 // MasterMacVlanPlugin provides struct for NetworkAttachmentDefinition Master plugin with macvlan configuration.
 type MasterMacVlanPlugin struct {
 	masterPlugin *MasterPlugin
-	errorMsg     string
+	errorMsg     error
 }
 
 // NewMasterMacVlanPlugin creates new instance of MasterMacVlanPlugin.
 func NewMasterMacVlanPlugin(name string) *MasterMacVlanPlugin {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new MasterVlanPlugin structure with the following param: %s", name)
 
 	builder := MasterMacVlanPlugin{
@@ -34,9 +37,9 @@ func NewMasterMacVlanPlugin(name string) *MasterMacVlanPlugin {
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterMacVlanPlugin can not be empty")
+		logging.V(100).Infof("error MasterMacVlanPlugin can not be empty")
 
-		builder.errorMsg = "MasterMacVlanPlugin name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MasterMacVlanPlugin name is empty"))
 	}
 
 	return &builder
@@ -44,17 +47,17 @@ func NewMasterMacVlanPlugin(name string) *MasterMacVlanPlugin {
 
 // WithMode defines macvlan type to MasterMacVlanPlugin. Default is bridge.
 func (plugin *MasterMacVlanPlugin) WithMode(mode string) *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding macvlan mode %s to MasterMacVlanPlugin", mode)
+	logging.V(100).Infof("Adding macvlan mode %s to MasterMacVlanPlugin", mode)
 
 	if !slices.Contains(allowedMacVlanMode, mode) {
-		glog.V(100).Infof("error to add mode %s, allowed modes are %v", mode, allowedMacVlanMode)
+		logging.V(100).Infof("error to add mode %s, allowed modes are %v", mode, allowedMacVlanMode)
 
-		plugin.errorMsg = "invalid mode parameter"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("invalid mode parameter"))
 	}
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")))
 	}
 
 	plugin.masterPlugin.Mode = mode
@@ -64,17 +67,17 @@ func (plugin *MasterMacVlanPlugin) WithMode(mode string) *MasterMacVlanPlugin {
 
 // WithMasterInterface defines master interface to MasterMacVlanPlugin. Default is cn0.
 func (plugin *MasterMacVlanPlugin) WithMasterInterface(master string) *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding master interface %s to MasterMacVlanPlugin", master)
+	logging.V(100).Infof("Adding master interface %s to MasterMacVlanPlugin", master)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")))
 	}
 
 	if master == "" {
-		glog.V(100).Infof("error to add master interface, the name of interface can not be empty")
+		logging.V(100).Infof("error to add master interface, the name of interface can not be empty")
 
-		plugin.errorMsg = "invalid master parameter"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("invalid master parameter"))
 	}
 
 	plugin.masterPlugin.Master = master
@@ -84,17 +87,17 @@ func (plugin *MasterMacVlanPlugin) WithMasterInterface(master string) *MasterMac
 
 // WithIPAM defines IPAM configuration to MasterMacVlanPlugin. Default is empty.
 func (plugin *MasterMacVlanPlugin) WithIPAM(ipam *IPAM) *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding ipam configuration %v to MasterMacVlanPlugin", ipam)
+	logging.V(100).Infof("Adding ipam configuration %v to MasterMacVlanPlugin", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")))
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error to add empty ipam to MasterMacVlanPlugin")
+		logging.V(100).Infof("error to add empty ipam to MasterMacVlanPlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(invalidIpamParameterMsg))
 	}
 
 	plugin.masterPlugin.Ipam = ipam
@@ -104,11 +107,11 @@ func (plugin *MasterMacVlanPlugin) WithIPAM(ipam *IPAM) *MasterMacVlanPlugin {
 
 // WithLinkInContainer defines MasterMacVlan plugin using linkInContainer feature.
 func (plugin *MasterMacVlanPlugin) WithLinkInContainer() *MasterMacVlanPlugin {
-	glog.V(100).Infof("Adding linkInContainer configuration to MasterMacVlanPlugin")
+	logging.V(100).Infof("Adding linkInContainer configuration to MasterMacVlanPlugin")
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterMacVlanPlugin")))
 	}
 
 	plugin.masterPlugin.LinkInContainer = true
@@ -118,7 +121,7 @@ func (plugin *MasterMacVlanPlugin) WithLinkInContainer() *MasterMacVlanPlugin {
 
 // GetMasterPluginConfig returns master plugin if error is not occur.
 func (plugin *MasterMacVlanPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return nil, fmt.Errorf("error to build MaterPlugin config due to :%s", plugin.errorMsg)
 	}
 
@@ -128,12 +131,12 @@ func (plugin *MasterMacVlanPlugin) GetMasterPluginConfig() (*MasterPlugin, error
 // MasterBridgePlugin provides struct for MasterPlugin set to bridge in NetworkAttachmentDefinition.
 type MasterBridgePlugin struct {
 	masterPlugin *MasterPlugin
-	errorMsg     string
+	errorMsg     error
 }
 
 // NewMasterBridgePlugin creates new instance of MasterBridgePlugin.
 func NewMasterBridgePlugin(name, bridgeName string) *MasterBridgePlugin {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new MasterBridgePlugin structure %s, with bridge %s", name, bridgeName)
 
 	builder := MasterBridgePlugin{
@@ -146,9 +149,9 @@ func NewMasterBridgePlugin(name, bridgeName string) *MasterBridgePlugin {
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterBridgePlugin can not be empty")
+		logging.V(100).Infof("error MasterBridgePlugin can not be empty")
 
-		builder.errorMsg = "MasterBridgePlugin name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MasterBridgePlugin name is empty"))
 	}
 
 	return &builder
@@ -156,7 +159,7 @@ func NewMasterBridgePlugin(name, bridgeName string) *MasterBridgePlugin {
 
 // GetMasterPluginConfig returns master plugin if error does not occur.
 func (plugin *MasterBridgePlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return nil, fmt.Errorf("error to build MaterPlugin config due to :%s", plugin.errorMsg)
 	}
 
@@ -165,17 +168,17 @@ func (plugin *MasterBridgePlugin) GetMasterPluginConfig() (*MasterPlugin, error)
 
 // WithIPAM defines IPAM configuration to MasterBridgePlugin. Default is empty.
 func (plugin *MasterBridgePlugin) WithIPAM(ipam *IPAM) *MasterBridgePlugin {
-	glog.V(100).Infof("Adding ipam configuration %v to MasterBridgePlugin", ipam)
+	logging.V(100).Infof("Adding ipam configuration %v to MasterBridgePlugin", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBridgePlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterBridgePlugin")))
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error adding empty ipam to MasterBridgePlugin")
+		logging.V(100).Infof("error adding empty ipam to MasterBridgePlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(invalidIpamParameterMsg))
 	}
 
 	plugin.masterPlugin.Ipam = ipam
@@ -186,13 +189,13 @@ func (plugin *MasterBridgePlugin) WithIPAM(ipam *IPAM) *MasterBridgePlugin {
 // MasterVlanPlugin provides struct for MasterPlugin set to vlan in NetworkAttachmentDefinition.
 type MasterVlanPlugin struct {
 	masterPlugin *MasterPlugin
-	errorMsg     string
+	errorMsg     error
 }
 
 // NewMasterVlanPlugin creates new instance of MasterVlanPlugin.
 func NewMasterVlanPlugin(name string, vlanID uint16) *MasterVlanPlugin {
-	glog.V(100).Infof(
-		"Initializing new MasterVlanPlugin structure %s, with vlanId %s", name, vlanID)
+	logging.V(100).Infof(
+		"Initializing new MasterVlanPlugin structure %s, with vlanId %d", name, vlanID)
 
 	builder := MasterVlanPlugin{
 		masterPlugin: &MasterPlugin{
@@ -204,15 +207,15 @@ func NewMasterVlanPlugin(name string, vlanID uint16) *MasterVlanPlugin {
 	}
 
 	if vlanID > 4094 {
-		glog.V(100).Infof("error vlan id can not be greater than 4094")
+		logging.V(100).Infof("error vlan id can not be greater than 4094")
 
-		builder.errorMsg = "MasterVlanPlugin vlanID is greater than 4094"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MasterVlanPlugin vlanID is greater than 4094"))
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterVlanPlugin name can not be empty")
+		logging.V(100).Infof("error MasterVlanPlugin name can not be empty")
 
-		builder.errorMsg = "MasterVlanPlugin name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MasterVlanPlugin name is empty"))
 	}
 
 	return &builder
@@ -220,20 +223,20 @@ func NewMasterVlanPlugin(name string, vlanID uint16) *MasterVlanPlugin {
 
 // WithIPAM defines IPAM configuration to MasterVlanPlugin. Default is empty.
 func (plugin *MasterVlanPlugin) WithIPAM(ipam *IPAM) *MasterVlanPlugin {
-	glog.V(100).Infof("Adding IPAM configuration to MasterVlanPlugin: %v", ipam)
+	logging.V(100).Infof("Adding IPAM configuration to MasterVlanPlugin: %v", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterVlanPlugin")))
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error adding empty ipam to MasterVlanPlugin")
+		logging.V(100).Infof("error adding empty ipam to MasterVlanPlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(invalidIpamParameterMsg))
 	}
 
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return plugin
 	}
 
@@ -244,20 +247,20 @@ func (plugin *MasterVlanPlugin) WithIPAM(ipam *IPAM) *MasterVlanPlugin {
 
 // WithMasterInterface defines master interface to MasterVlanPlugin. Default is cn0.
 func (plugin *MasterVlanPlugin) WithMasterInterface(masterInterfaceName string) *MasterVlanPlugin {
-	glog.V(100).Infof("Adding masterInterfaceName interface %s to MasterVlanPlugin", masterInterfaceName)
+	logging.V(100).Infof("Adding masterInterfaceName interface %s to MasterVlanPlugin", masterInterfaceName)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterVlanPlugin")))
 	}
 
 	if masterInterfaceName == "" {
-		glog.V(100).Infof("error to add masterInterfaceName interface, the name of interface can not be empty")
+		logging.V(100).Infof("error to add masterInterfaceName interface, the name of interface can not be empty")
 
-		plugin.errorMsg = "invalid masterInterfaceName parameter"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("invalid masterInterfaceName parameter"))
 	}
 
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return plugin
 	}
 
@@ -269,11 +272,11 @@ func (plugin *MasterVlanPlugin) WithMasterInterface(masterInterfaceName string)
 // WithLinkInContainer defines MasterVlanPlugin using linkInContainer feature.
 func (plugin *MasterVlanPlugin) WithLinkInContainer() *MasterVlanPlugin {
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterVlanPlugin")))
 	}
 
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return plugin
 	}
 
@@ -284,7 +287,7 @@ func (plugin *MasterVlanPlugin) WithLinkInContainer() *MasterVlanPlugin {
 
 // GetMasterPluginConfig returns master plugin if error does not occur.
 func (plugin *MasterVlanPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return nil, fmt.Errorf("error to build MaterPlugin config due to :%s", plugin.errorMsg)
 	}
 
@@ -294,12 +297,12 @@ func (plugin *MasterVlanPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
 // MasterIPVlanPlugin provides struct for MasterPlugin set to IP vlan in NetworkAttachmentDefinition.
 type MasterIPVlanPlugin struct {
 	masterPlugin *MasterPlugin
-	errorMsg     string
+	errorMsg     error
 }
 
 // NewMasterIPVlanPlugin creates new instance of MasterIP VlanPlugin.
 func NewMasterIPVlanPlugin(name string) *MasterIPVlanPlugin {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new MasterIPVlanPlugin structure %s", name)
 
 	builder := MasterIPVlanPlugin{
@@ -311,9 +314,9 @@ func NewMasterIPVlanPlugin(name string) *MasterIPVlanPlugin {
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error MasterIPVlanPlugin can not be empty")
+		logging.V(100).Infof("error MasterIPVlanPlugin can not be empty")
 
-		builder.errorMsg = "MasterIPVlanPlugin name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MasterIPVlanPlugin name is empty"))
 	}
 
 	return &builder
@@ -321,20 +324,20 @@ func NewMasterIPVlanPlugin(name string) *MasterIPVlanPlugin {
 
 // WithIPAM defines IPAM configuration to MasterIPVlanPlugin. Default is empty.
 func (plugin *MasterIPVlanPlugin) WithIPAM(ipam *IPAM) *MasterIPVlanPlugin {
-	glog.V(100).Infof("Adding IPAM configuration %v to MasterIPVlanPlugin", ipam)
+	logging.V(100).Infof("Adding IPAM configuration %v to MasterIPVlanPlugin", ipam)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")))
 	}
 
 	if ipam == nil {
-		glog.V(100).Infof("error adding empty ipam to MasterIPVlanPlugin")
+		logging.V(100).Infof("error adding empty ipam to MasterIPVlanPlugin")
 
-		plugin.errorMsg = invalidIpamParameterMsg
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(invalidIpamParameterMsg))
 	}
 
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return plugin
 	}
 
@@ -345,20 +348,20 @@ func (plugin *MasterIPVlanPlugin) WithIPAM(ipam *IPAM) *MasterIPVlanPlugin {
 
 // WithMasterInterface defines master interface to MasterVlanPlugin. Default is cn0.
 func (plugin *MasterIPVlanPlugin) WithMasterInterface(masterInterfaceName string) *MasterIPVlanPlugin {
-	glog.V(100).Infof("Adding masterInterfaceName %s to MasterIPVlanPlugin", masterInterfaceName)
+	logging.V(100).Infof("Adding masterInterfaceName %s to MasterIPVlanPlugin", masterInterfaceName)
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")))
 	}
 
 	if masterInterfaceName == "" {
-		glog.V(100).Infof("error to add master interface, the name of interface can not be empty")
+		logging.V(100).Infof("error to add master interface, the name of interface can not be empty")
 
-		plugin.errorMsg = "invalid masterInterfaceName parameter"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("invalid masterInterfaceName parameter"))
 	}
 
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return plugin
 	}
 
@@ -369,14 +372,14 @@ func (plugin *MasterIPVlanPlugin) WithMasterInterface(masterInterfaceName string
 
 // WithLinkInContainer defines MasterIPVlanPlugin using linkInContainer feature.
 func (plugin *MasterIPVlanPlugin) WithLinkInContainer() *MasterIPVlanPlugin {
-	glog.V(100).Infof("Adding linkInContainer feature to MasterIPVlanPlugin")
+	logging.V(100).Infof("Adding linkInContainer feature to MasterIPVlanPlugin")
 
 	if plugin.masterPlugin == nil {
-		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
-		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")
+		logging.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin"))
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(msg.UndefinedCrdObjectErrString("MasterIPVlanPlugin")))
 	}
 
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return plugin
 	}
 
@@ -387,7 +390,7 @@ func (plugin *MasterIPVlanPlugin) WithLinkInContainer() *MasterIPVlanPlugin {
 
 // GetMasterPluginConfig returns master plugin if error does not occur.
 func (plugin *MasterIPVlanPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return nil, fmt.Errorf("error to build MaterPlugin config due to :%s", plugin.errorMsg)
 	}
 
@@ -397,12 +400,12 @@ func (plugin *MasterIPVlanPlugin) GetMasterPluginConfig() (*MasterPlugin, error)
 // MasterBondPlugin provides struct for MasterPlugin to create a NAD for bonded interfaces.
 type MasterBondPlugin struct {
 	masterPlugin *MasterPlugin
-	errorMsg     string
+	errorMsg     error
 }
 
 // NewMasterBondPlugin creates new instance of MasterBondPlugin.
 func NewMasterBondPlugin(name, mode string) *MasterBondPlugin {
-	glog.V(100).Infof("Initializing new NewMasterBondPlugin structure %s and %s", name, mode)
+	logging.V(100).Infof("Initializing new NewMasterBondPlugin structure %s and %s", name, mode)
 
 	validModes := map[string]bool{
 		"balance-rr":    true,
@@ -421,15 +424,15 @@ func NewMasterBondPlugin(name, mode string) *MasterBondPlugin {
 
 	// Check if the provided mode is valid
 	if !validModes[mode] {
-		glog.V(100).Infof("error: invalid mode type %s used for MasterBondPlugin bond interface", mode)
+		logging.V(100).Infof("error: invalid mode type %s used for MasterBondPlugin bond interface", mode)
 
-		builder.errorMsg = "Bond mode type is not valid"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Bond mode type is not valid"))
 	}
 
 	if builder.masterPlugin.Name == "" {
-		glog.V(100).Infof("error NewMasterBondPlugin name can not be empty")
+		logging.V(100).Infof("error NewMasterBondPlugin name can not be empty")
 
-		builder.errorMsg = "NewMasterBondPlugin name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NewMasterBondPlugin name is empty"))
 	}
 
 	return &builder
@@ -437,7 +440,7 @@ func NewMasterBondPlugin(name, mode string) *MasterBondPlugin {
 
 // WithLinksInContainer defines linksInContainer configuration to MasterBondPlugin.
 func (plugin *MasterBondPlugin) WithLinksInContainer(linksInContainer bool) *MasterBondPlugin {
-	glog.V(100).Infof("Adding linksInContainer %v to MasterBoundPlugin", linksInContainer)
+	logging.V(100).Infof("Adding linksInContainer %v to MasterBoundPlugin", linksInContainer)
 	plugin.masterPlugin.LinksInContainer = linksInContainer
 
 	return plugin
@@ -445,12 +448,12 @@ func (plugin *MasterBondPlugin) WithLinksInContainer(linksInContainer bool) *Mas
 
 // WithFailOverMac defines FailOverMac configuration to MasterBondPlugin.
 func (plugin *MasterBondPlugin) WithFailOverMac(failOverMac int) *MasterBondPlugin {
-	glog.V(100).Infof("Adding failOverMac %d to MasterBoundPlugin", failOverMac)
+	logging.V(100).Infof("Adding failOverMac %d to MasterBoundPlugin", failOverMac)
 
 	if failOverMac < 0 || failOverMac > 2 {
-		glog.V(100).Infof("error adding incorrect value %d for FailOverMac in MasterBondPlugin", failOverMac)
+		logging.V(100).Infof("error adding incorrect value %d for FailOverMac in MasterBondPlugin", failOverMac)
 
-		plugin.errorMsg = "error adding incorrect FailOverMac to MasterBondPlugin"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("error adding incorrect FailOverMac to MasterBondPlugin"))
 	}
 
 	plugin.masterPlugin.FailOverMac = failOverMac
@@ -460,12 +463,12 @@ func (plugin *MasterBondPlugin) WithFailOverMac(failOverMac int) *MasterBondPlug
 
 // WithMiimon defines Miimon configuration to MasterBondPlugin.
 func (plugin *MasterBondPlugin) WithMiimon(miimon int) *MasterBondPlugin {
-	glog.V(100).Infof("Adding miimon %d to MasterBoundPlugin", miimon)
+	logging.V(100).Infof("Adding miimon %d to MasterBoundPlugin", miimon)
 
 	if miimon < 0 {
-		glog.V(100).Infof("error adding incorrect miimon value %d to MasterBondPlugin", miimon)
+		logging.V(100).Infof("error adding incorrect miimon value %d to MasterBondPlugin", miimon)
 
-		plugin.errorMsg = "error adding incorrect miimon value to MasterBondPlugin"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("error adding incorrect miimon value to MasterBondPlugin"))
 	}
 
 	plugin.masterPlugin.Miimon = fmt.Sprintf("%d", miimon)
@@ -475,12 +478,12 @@ func (plugin *MasterBondPlugin) WithMiimon(miimon int) *MasterBondPlugin {
 
 // WithLinks defines Links configuration to MasterBondPlugin.
 func (plugin *MasterBondPlugin) WithLinks(links []Link) *MasterBondPlugin {
-	glog.V(100).Infof("Adding links %v to MasterBoundPlugin", links)
+	logging.V(100).Infof("Adding links %v to MasterBoundPlugin", links)
 
 	if links == nil {
-		glog.V(100).Infof("links value %v cannot be nil or empty in MasterBondPlugin", links)
+		logging.V(100).Infof("links value %v cannot be nil or empty in MasterBondPlugin", links)
 
-		plugin.errorMsg = "error adding empty links to MasterBondPlugin"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("error adding empty links to MasterBondPlugin"))
 	}
 
 	plugin.masterPlugin.Links = links
@@ -490,12 +493,12 @@ func (plugin *MasterBondPlugin) WithLinks(links []Link) *MasterBondPlugin {
 
 // WithCapabilities defines Capabilities configuration to MasterBondPlugin.
 func (plugin *MasterBondPlugin) WithCapabilities(capabilities *Capability) *MasterBondPlugin {
-	glog.V(100).Infof("Adding capabilities value %d to MasterBoundPlugin", capabilities)
+	logging.V(100).Infof("Adding capabilities value %v to MasterBoundPlugin", capabilities)
 
 	if capabilities == nil {
-		glog.V(100).Infof("error adding nil value %d capabilities to MasterBondPlugin", capabilities)
+		logging.V(100).Infof("error adding nil value %v capabilities to MasterBondPlugin", capabilities)
 
-		plugin.errorMsg = "error adding empty capabilities to MasterBondPlugin"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("error adding empty capabilities to MasterBondPlugin"))
 	}
 
 	plugin.masterPlugin.Capabilities = capabilities
@@ -505,12 +508,12 @@ func (plugin *MasterBondPlugin) WithCapabilities(capabilities *Capability) *Mast
 
 // WithIPAM defines IPAM configuration to MasterBondPlugin.
 func (plugin *MasterBondPlugin) WithIPAM(ipam *IPAM) *MasterBondPlugin {
-	glog.V(100).Infof("Adding ipam %v to MasterBoundPlugin", ipam)
+	logging.V(100).Infof("Adding ipam %v to MasterBoundPlugin", ipam)
 
 	if ipam == nil {
-		glog.V(100).Infof("error adding ipam value %d to MasterBondPlugin", ipam)
+		logging.V(100).Infof("error adding ipam value %v to MasterBondPlugin", ipam)
 
-		plugin.errorMsg = "error adding empty ipam to MasterBondPlugin"
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("error adding empty ipam to MasterBondPlugin"))
 	}
 
 	plugin.masterPlugin.Ipam = ipam
@@ -520,9 +523,109 @@ func (plugin *MasterBondPlugin) WithIPAM(ipam *IPAM) *MasterBondPlugin {
 
 // GetMasterPluginConfig returns master plugin if error is not occur.
 func (plugin *MasterBondPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
-	if plugin.errorMsg != "" {
+	if plugin.errorMsg != nil {
 		return nil, fmt.Errorf("error to build MasterPlugin config due to :%s", plugin.errorMsg)
 	}
 
 	return plugin.masterPlugin, nil
 }
+
+// MasterSriovPlugin provides struct for MasterPlugin set to sriov in NetworkAttachmentDefinition.
+type MasterSriovPlugin struct {
+	masterPlugin *MasterPlugin
+	errorMsg     error
+}
+
+// NewMasterSriovPlugin creates new instance of MasterSriovPlugin. A vlanID of 0 leaves the VF untagged.
+func NewMasterSriovPlugin(name string, vlanID uint16) *MasterSriovPlugin {
+	logging.V(100).Infof("Initializing new MasterSriovPlugin structure %s, with vlan %d", name, vlanID)
+
+	builder := MasterSriovPlugin{
+		masterPlugin: &MasterPlugin{
+			CniVersion: "0.3.1",
+			Name:       name,
+			Type:       "sriov",
+			Vlan:       vlanID,
+		},
+	}
+
+	if builder.masterPlugin.Name == "" {
+		logging.V(100).Infof("error MasterSriovPlugin name can not be empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MasterSriovPlugin name is empty"))
+	}
+
+	if vlanID > 4094 {
+		logging.V(100).Infof("error MasterSriovPlugin vlan %d is out of range", vlanID)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MasterSriovPlugin vlan must be between 0 and 4094"))
+	}
+
+	return &builder
+}
+
+// WithSpoofChk defines whether spoof checking is enabled on the VF of MasterSriovPlugin.
+func (plugin *MasterSriovPlugin) WithSpoofChk(enabled bool) *MasterSriovPlugin {
+	logging.V(100).Infof("Adding spoofchk %v to MasterSriovPlugin", enabled)
+
+	plugin.masterPlugin.SpoofChk = onOff(enabled)
+
+	return plugin
+}
+
+// WithTrust defines whether the VF of MasterSriovPlugin is trusted.
+func (plugin *MasterSriovPlugin) WithTrust(enabled bool) *MasterSriovPlugin {
+	logging.V(100).Infof("Adding trust %v to MasterSriovPlugin", enabled)
+
+	plugin.masterPlugin.Trust = onOff(enabled)
+
+	return plugin
+}
+
+// WithLinkState defines the link state of the VF of MasterSriovPlugin. Allowed states are auto, enable and disable.
+func (plugin *MasterSriovPlugin) WithLinkState(linkState string) *MasterSriovPlugin {
+	logging.V(100).Infof("Adding link_state %s to MasterSriovPlugin", linkState)
+
+	if !slices.Contains(allowedSriovLinkState, linkState) {
+		logging.V(100).Infof("error to add link_state %s, allowed states are %v", linkState, allowedSriovLinkState)
+
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf("invalid link_state parameter"))
+	}
+
+	plugin.masterPlugin.LinkState = linkState
+
+	return plugin
+}
+
+// WithIPAM defines IPAM configuration to MasterSriovPlugin. Default is empty.
+func (plugin *MasterSriovPlugin) WithIPAM(ipam *IPAM) *MasterSriovPlugin {
+	logging.V(100).Infof("Adding ipam configuration %v to MasterSriovPlugin", ipam)
+
+	if ipam == nil {
+		logging.V(100).Infof("error adding empty ipam to MasterSriovPlugin")
+
+		plugin.errorMsg = errors.Join(plugin.errorMsg, fmt.Errorf(invalidIpamParameterMsg))
+	}
+
+	plugin.masterPlugin.Ipam = ipam
+
+	return plugin
+}
+
+// GetMasterPluginConfig returns master plugin if error does not occur.
+func (plugin *MasterSriovPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
+	if plugin.errorMsg != nil {
+		return nil, fmt.Errorf("error to build MasterPlugin config due to :%s", plugin.errorMsg)
+	}
+
+	return plugin.masterPlugin, nil
+}
+
+// onOff returns the on or off value used by the sriov plugin for boolean settings.
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+
+	return "off"
+}
@@ -1 +1,168 @@
 package nad
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMasterSriovPlugin(t *testing.T) {
+	testCases := []struct {
+		name           string
+		vlanID         uint16
+		linkState      string
+		ipam           *IPAM
+		expectedConfig string
+		expectedError  string
+	}{
+		{
+			name:      "sriov-net",
+			vlanID:    100,
+			linkState: "enable",
+			ipam:      StaticAppendAddress(IPAMStatic(), "192.168.10.5/24", "192.168.10.1"),
+			expectedConfig: `{"cniVersion":"0.3.1","name":"sriov-net","type":"sriov","ipam":{"type":"static",` +
+				`"addresses":[{"address":"192.168.10.5/24","gateway":"192.168.10.1"}]},"vlan":100,"spoofchk":"off",` +
+				`"trust":"on","link_state":"enable"}`,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			vlanID:        100,
+			linkState:     "enable",
+			ipam:          IPAMDHCP(),
+			expectedError: "error to build MasterPlugin config due to :MasterSriovPlugin name is empty",
+		},
+		{
+			name:          "sriov-net",
+			vlanID:        4095,
+			linkState:     "enable",
+			ipam:          IPAMDHCP(),
+			expectedError: "error to build MasterPlugin config due to :MasterSriovPlugin vlan must be between 0 and 4094",
+		},
+		{
+			name:          "sriov-net",
+			vlanID:        100,
+			linkState:     "up",
+			ipam:          IPAMDHCP(),
+			expectedError: "error to build MasterPlugin config due to :invalid link_state parameter",
+		},
+		{
+			name:          "sriov-net",
+			vlanID:        100,
+			linkState:     "enable",
+			ipam:          nil,
+			expectedError: "error to build MasterPlugin config due to :invalid ipam parameter",
+		},
+	}
+
+	for _, testCase := range testCases {
+		masterPlugin, err := NewMasterSriovPlugin(testCase.name, testCase.vlanID).
+			WithSpoofChk(false).
+			WithTrust(true).
+			WithLinkState(testCase.linkState).
+			WithIPAM(testCase.ipam).
+			GetMasterPluginConfig()
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		config, err := json.Marshal(masterPlugin)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedConfig, string(config))
+	}
+}
+
+func TestIPAMHelpers(t *testing.T) {
+	ipam := IPAMAppendRoute(
+		StaticAppendAddress(StaticAppendAddress(IPAMStatic(), "192.168.10.5/24", ""), "fd00::5/64", ""),
+		"10.0.0.0/8", "192.168.10.254")
+
+	assert.Equal(t, &IPAM{
+		Type:      "static",
+		Addresses: []StaticAddress{{Address: "192.168.10.5/24"}, {Address: "fd00::5/64"}},
+		Routes:    []Route{{Dst: "10.0.0.0/8", Gw: "192.168.10.254"}},
+	}, ipam)
+
+	assert.Equal(t, &IPAM{Type: "dhcp"}, IPAMDHCP())
+	assert.Nil(t, StaticAppendAddress(nil, "192.168.10.5/24", ""))
+	assert.Nil(t, StaticAppendAddress(IPAMStatic(), "", ""))
+	assert.Nil(t, IPAMAppendRoute(IPAMStatic(), "", ""))
+}
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		config        string
+		expectedError string
+	}{
+		{
+			config:        `{"cniVersion":"0.3.1","name":"macvlan-net","type":"macvlan","ipam":{"type":"dhcp"}}`,
+			expectedError: "",
+		},
+		{
+			config: `{"cniVersion":"0.4.0","name":"tap-net","plugins":[{"type":"tap"},` +
+				`{"type":"tuning","capabilities":{"mac":true}}]}`,
+			expectedError: "",
+		},
+		{
+			config:        `{"name":"macvlan-net","type":"macvlan"`,
+			expectedError: "NAD config is not valid JSON: unexpected end of JSON input",
+		},
+		{
+			config:        `{"type":"macvlan"}`,
+			expectedError: "NAD config name is empty",
+		},
+		{
+			config:        `{"name":"macvlan-net"}`,
+			expectedError: "NAD config must have either a type or a list of plugins",
+		},
+		{
+			config:        `{"name":"tap-net","plugins":[]}`,
+			expectedError: "NAD config list of plugins is empty",
+		},
+		{
+			config:        `{"name":"tap-net","plugins":[{"type":"tap"},{"capabilities":{"mac":true}}]}`,
+			expectedError: "NAD config plugin 1 type is empty",
+		},
+		{
+			config:        `{"name":"bridge-net","type":"bridge","ipam":{"type":"whereabouts"}}`,
+			expectedError: "whereabouts ipam must have a range",
+		},
+		{
+			config: `{"name":"bridge-net","type":"bridge","ipam":{"type":"whereabouts",` +
+				`"ipRanges":[{"range":"192.168.0.0/24"}],"exclude":["192.168.0.1"]}}`,
+			expectedError: "whereabouts ipam has invalid CIDR 192.168.0.1",
+		},
+		{
+			config:        `{"name":"sriov-net","type":"sriov","ipam":{"type":"static","addresses":[{"address":"10.0.0.1"}]}}`,
+			expectedError: "static ipam has invalid CIDR 10.0.0.1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := ValidateConfig(testCase.config)
+
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestBuilderWithResourceNameAndInvalidConfig(t *testing.T) {
+	testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "sriov-net", "test-namespace").
+		WithResourceName("openshift.io/sriovnic")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "openshift.io/sriovnic", testBuilder.Definition.Annotations[resourceNameAnnotation])
+
+	testBuilder.Definition.Spec.Config = `{"name":"sriov-net"}`
+
+	_, err := testBuilder.Create()
+	assert.EqualError(t, err,
+		"failed create NAD object, invalid configuration: NAD config must have either a type or a list of plugins")
+
+	testBuilder = NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "sriov-net", "test-namespace").
+		WithResourceName("")
+	assert.EqualError(t, testBuilder.errorMsg, "NAD resourceName is empty")
+}
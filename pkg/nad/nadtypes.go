@@ -55,6 +55,10 @@ type (
 		LinksInContainer bool        `json:"linksInContainer,omitempty"`
 		LinkInContainer  bool        `json:"linkInContainer,omitempty"`
 		VlanID           uint16      `json:"vlanId,omitempty"`
+		Vlan             uint16      `json:"vlan,omitempty"`
+		SpoofChk         string      `json:"spoofchk,omitempty"`
+		Trust            string      `json:"trust,omitempty"`
+		LinkState        string      `json:"link_state,omitempty"`
 		FailOverMac      int         `json:"failOverMac,omitempty"`
 		Miimon           string      `json:"miimon,omitempty"`
 		Mtu              int         `json:"mtu,omitempty"`
@@ -68,14 +72,28 @@ type (
 		Gateway string `json:"gateway,omitempty"`
 	}
 
+	// StaticAddress contains an address for static IPAM plugin.
+	StaticAddress struct {
+		Address string `json:"address,omitempty"`
+		Gateway string `json:"gateway,omitempty"`
+	}
+
+	// Route contains a route added by IPAM plugin.
+	Route struct {
+		Dst string `json:"dst,omitempty"`
+		Gw  string `json:"gw,omitempty"`
+	}
+
 	// IPAM container the IPAM configuration for a NAD.
 	IPAM struct {
-		Type       string     `json:"type,omitempty"`
-		AddrRange  string     `json:"range,omitempty"`
-		RangeStart string     `json:"range_start,omitempty"`
-		RangeEnd   string     `json:"range_end,omitempty"`
-		Gateway    string     `json:"gateway,omitempty"`
-		Exclude    []string   `json:"exclude,omitempty"`
-		IPRanges   []IPRanges `json:"ipRanges,omitempty"`
+		Type       string          `json:"type,omitempty"`
+		AddrRange  string          `json:"range,omitempty"`
+		RangeStart string          `json:"range_start,omitempty"`
+		RangeEnd   string          `json:"range_end,omitempty"`
+		Gateway    string          `json:"gateway,omitempty"`
+		Exclude    []string        `json:"exclude,omitempty"`
+		IPRanges   []IPRanges      `json:"ipRanges,omitempty"`
+		Addresses  []StaticAddress `json:"addresses,omitempty"`
+		Routes     []Route         `json:"routes,omitempty"`
 	}
 )
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,7 +15,7 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 	passedOptions := v1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -25,11 +25,11 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	namespacesList, err := apiClient.CoreV1Interface.Namespaces().List(context.TODO(), passedOptions)
 	if err != nil {
-		glog.V(100).Infof("Failed to list namespaces due to %s", err.Error())
+		logging.V(100).Infof("Failed to list namespaces due to %s", err.Error())
 
 		return nil, err
 	}
@@ -2,11 +2,14 @@ package namespace
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -26,8 +29,10 @@ type Builder struct {
 	Object *corev1.Namespace
 	// Used in functions that define or mutate namespace definition. errorMsg is processed before the namespace
 	// object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // AdditionalOptions additional options for namespace object.
@@ -35,7 +40,7 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new namespace structure with the following param: %s", name)
 
 	builder := Builder{
@@ -48,26 +53,72 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the namespace is empty")
+		logging.V(100).Infof("The name of the namespace is empty")
 
-		builder.errorMsg = "namespace 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("namespace 'name' cannot be empty"))
 	}
 
 	return &builder
 }
 
+// NewBuilderFromYAML creates a new instance of Builder from a namespace manifest with a single document in YAML or
+// JSON format.
+func NewBuilderFromYAML(apiClient *clients.Settings, data []byte) *Builder {
+	logging.V(100).Infof("Initializing new namespace structure from manifest")
+
+	builder := &Builder{
+		apiClient:  apiClient,
+		Definition: &corev1.Namespace{},
+	}
+
+	err := manifest.DecodeInto(data, builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to decode the namespace manifest due to %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to decode namespace manifest: %w", err))
+
+		return builder
+	}
+
+	if builder.Definition.Name == "" {
+		logging.V(100).Infof("The name of the namespace is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("namespace 'name' cannot be empty"))
+	}
+
+	return builder
+}
+
+// NewBuilderFromFile creates a new instance of Builder from the namespace manifest stored in the given file.
+func NewBuilderFromFile(apiClient *clients.Settings, path string) *Builder {
+	logging.V(100).Infof("Initializing new namespace structure from manifest file %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logging.V(100).Infof("Failed to read the namespace manifest file %s due to %s", path, err.Error())
+
+		return &Builder{
+			apiClient:  apiClient,
+			Definition: &corev1.Namespace{},
+			errorMsg:   fmt.Errorf("failed to read namespace manifest file %s: %w", path, err),
+		}
+	}
+
+	return NewBuilderFromYAML(apiClient, data)
+}
+
 // WithLabel redefines namespace definition with the given label.
 func (builder *Builder) WithLabel(key string, value string) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Labeling the namespace %s with %s=%s", builder.Definition.Name, key, value)
+	logging.V(100).Infof("Labeling the namespace %s with %s=%s", builder.Definition.Name, key, value)
 
 	if key == "" {
-		glog.V(100).Infof("The key can't be empty")
+		logging.V(100).Infof("The key can't be empty")
 
-		builder.errorMsg = "'key' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'key' cannot be empty"))
 
 		return builder
 	}
@@ -96,16 +147,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting namespace additional options")
+	logging.V(100).Infof("Setting namespace additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -121,10 +172,14 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating namespace %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating namespace %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.Namespaces().Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -138,7 +193,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the namespace %s with the namespace definition in the builder", builder.Definition.Name)
+	logging.V(100).Infof("Updating the namespace %s with the namespace definition in the builder", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Update(
@@ -147,24 +202,62 @@ func (builder *Builder) Update() (*Builder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting dry-run to %t for namespace %s", dryRun, builder.Definition.Name)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the namespace definition against the API server and its admission webhooks with a server-side
+// dry-run request. The namespace is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Creating the namespace %s in dry-run mode", builder.Definition.Name)
+
+	_, err := builder.apiClient.Namespaces().Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Delete removes a namespace.
 func (builder *Builder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting namespace %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting namespace %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
 	}
 
-	err := builder.apiClient.Namespaces().Delete(context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.Namespaces().Delete(context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return nil
+	}
+
 	builder.Object = nil
 
 	return err
@@ -176,12 +269,16 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting namespace %s and waiting for the removal to complete", builder.Definition.Name)
+	logging.V(100).Infof("Deleting namespace %s and waiting for the removal to complete", builder.Definition.Name)
 
 	if err := builder.Delete(); err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return nil
+	}
+
 	return wait.PollUntilContextTimeout(
 		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Namespaces().Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
@@ -199,7 +296,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if namespace %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if namespace %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Get(
@@ -208,9 +305,25 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the namespace definition with the namespace object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Comparing namespace %s with its definition", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("namespace object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // Pull loads existing namespace in to Builder struct.
 func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing namespace: %s from cluster", nsname)
+	logging.V(100).Infof("Pulling existing namespace: %s from cluster", nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -222,7 +335,7 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -240,7 +353,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 		return err
 	}
 
-	glog.V(100).Infof("Clean namespace: %s", builder.Definition.Name)
+	logging.V(100).Infof("Clean namespace: %s", builder.Definition.Name)
 
 	if len(objects) == 0 {
 		return fmt.Errorf("failed to remove empty list of object from namespace %s",
@@ -253,7 +366,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 	}
 
 	for _, resource := range objects {
-		glog.V(100).Infof("Clean all resources: %s in namespace: %s",
+		logging.V(100).Infof("Clean all resources: %s in namespace: %s",
 			resource.Resource, builder.Definition.Name)
 
 		err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).DeleteCollection(
@@ -262,7 +375,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			}, metav1.ListOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to remove resources: %s in namespace: %s",
+			logging.V(100).Infof("Failed to remove resources: %s in namespace: %s",
 				resource.Resource, builder.Definition.Name)
 
 			return err
@@ -287,7 +400,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to remove resources: %s in namespace: %s",
+			logging.V(100).Infof("Failed to remove resources: %s in namespace: %s",
 				resource.Resource, builder.Definition.Name)
 
 			return err
@@ -325,33 +438,42 @@ func (builder *Builder) hasOnlyDefaultConfigMaps(objList *unstructured.Unstructu
 	return true, nil
 }
 
+// GetGVR returns the namespace GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "", Version: "v1", Resource: "namespaces",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NameSpace"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -28,7 +28,7 @@ type ConfigBuilder struct {
 
 // PullConfig loads an existing network into ConfigBuilder struct.
 func PullConfig(apiClient *clients.Settings) (*ConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing network name: %s", clusterNetworkName)
+	logging.V(100).Infof("Pulling existing network name: %s", clusterNetworkName)
 
 	builder := ConfigBuilder{
 		apiClient: apiClient,
@@ -54,7 +54,7 @@ func (builder *ConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if network %s exists",
 		builder.Definition.Name)
 
@@ -71,19 +71,19 @@ func (builder *ConfigBuilder) validate() (bool, error) {
 	resourceCRD := "Network.Config"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf(fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
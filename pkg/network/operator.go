@@ -2,11 +2,12 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	operatorV1 "github.com/openshift/api/operator/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,12 +24,12 @@ type OperatorBuilder struct {
 	Object *operatorV1.Network
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
-	errorMsg  string
+	errorMsg  error
 }
 
 // PullOperator loads an existing network.operator into OperatorBuilder struct.
 func PullOperator(apiClient *clients.Settings) (*OperatorBuilder, error) {
-	glog.V(100).Infof("Pulling existing network.operator name: %s", clusterNetworkName)
+	logging.V(100).Infof("Pulling existing network.operator name: %s", clusterNetworkName)
 
 	builder := OperatorBuilder{
 		apiClient: apiClient,
@@ -54,7 +55,7 @@ func (builder *OperatorBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if network.operator %s exists",
 		builder.Definition.Name)
 
@@ -88,7 +89,7 @@ func (builder *OperatorBuilder) Update() (*OperatorBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the network.operator object %s",
+	logging.V(100).Infof("Updating the network.operator object %s",
 		builder.Definition.Name,
 	)
 
@@ -140,7 +141,7 @@ func (builder *OperatorBuilder) SetMultiNetworkPolicy(state bool, timeout time.D
 		return builder, err
 	}
 
-	glog.V(100).Infof("Applying MultiNetworkPolicy flag %t to network.operator %s", state, builder.Definition.Name)
+	logging.V(100).Infof("Applying MultiNetworkPolicy flag %t to network.operator %s", state, builder.Definition.Name)
 
 	var err error
 
@@ -181,7 +182,7 @@ func (builder *OperatorBuilder) WaitUntilInCondition(
 		return err
 	}
 
-	glog.V(100).Infof("Wait until network.operator object %s is in condition %v",
+	logging.V(100).Infof("Wait until network.operator object %s is in condition %v",
 		builder.Definition.Name, condition)
 
 	err := wait.PollUntilContextTimeout(
@@ -208,27 +209,29 @@ func (builder *OperatorBuilder) validate() (bool, error) {
 	resourceCRD := "Network.Operator"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
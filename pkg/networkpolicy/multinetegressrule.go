@@ -1,11 +1,12 @@
 package networkpolicy
 
 import (
+	"errors"
 	"fmt"
 	"net"
 
-	"github.com/golang/glog"
 	"github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -19,12 +20,12 @@ type EgressRuleBuilder struct {
 	// EgressRule definition, used to create the EgressRule object.
 	definition *v1beta1.MultiNetworkPolicyEgressRule
 	// Used to store latest error message upon defining or mutating EgressRule definition.
-	errorMsg string
+	errorMsg error
 }
 
 // NewEgressRuleBuilder creates a new instance of EgressRuleBuilder.
 func NewEgressRuleBuilder() *EgressRuleBuilder {
-	glog.V(100).Infof("Initializing new Egress rule structure")
+	logging.V(100).Infof("Initializing new Egress rule structure")
 
 	// Empty rule allowed.
 	builder := &EgressRuleBuilder{
@@ -36,15 +37,15 @@ func NewEgressRuleBuilder() *EgressRuleBuilder {
 
 // WithPortAndProtocol adds port and protocol to Egress rule.
 func (builder *EgressRuleBuilder) WithPortAndProtocol(port uint16, protocol corev1.Protocol) *EgressRuleBuilder {
-	glog.V(100).Infof("Adding port %d and protocol %s to EgressRule", port, protocol)
+	logging.V(100).Infof("Adding port %d and protocol %s to EgressRule", port, protocol)
 
 	if port == 0 {
-		glog.V(100).Infof("Port number can not be 0")
+		logging.V(100).Infof("Port number can not be 0")
 
-		builder.errorMsg = "port number can not be 0"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("port number can not be 0"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -58,16 +59,16 @@ func (builder *EgressRuleBuilder) WithPortAndProtocol(port uint16, protocol core
 
 // WithOptions adds generic options to Egress rule.
 func (builder *EgressRuleBuilder) WithOptions(options ...EgressAdditionalOptions) *EgressRuleBuilder {
-	glog.V(100).Infof("Setting EgressRule additional options")
+	logging.V(100).Infof("Setting EgressRule additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -79,9 +80,9 @@ func (builder *EgressRuleBuilder) WithOptions(options ...EgressAdditionalOptions
 
 // WithPeerPodSelector adds pod selector to Egress rule.
 func (builder *EgressRuleBuilder) WithPeerPodSelector(podSelector metav1.LabelSelector) *EgressRuleBuilder {
-	glog.V(100).Infof("Adding peer pod selector %v to EgressRule", podSelector)
+	logging.V(100).Infof("Adding peer pod selector %v to EgressRule", podSelector)
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -93,17 +94,17 @@ func (builder *EgressRuleBuilder) WithPeerPodSelector(podSelector metav1.LabelSe
 // WithPeerPodSelectorAndCIDR adds pod selector and CIDR to Egress rule.
 func (builder *EgressRuleBuilder) WithPeerPodSelectorAndCIDR(
 	podSelector metav1.LabelSelector, cidr string, except ...[]string) *EgressRuleBuilder {
-	glog.V(100).Infof("Adding peer pod selector %v to EgressRule", podSelector)
+	logging.V(100).Infof("Adding peer pod selector %v to EgressRule", podSelector)
 
 	_, _, err := net.ParseCIDR(cidr)
 
 	if err != nil {
-		glog.V(100).Infof("Invalid CIDR %s", cidr)
+		logging.V(100).Infof("Invalid CIDR %s", cidr)
 
-		builder.errorMsg = fmt.Sprintf("Invalid CIDR argument %s", cidr)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Invalid CIDR argument %s", cidr))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -123,12 +124,12 @@ func (builder *EgressRuleBuilder) WithPeerPodSelectorAndCIDR(
 
 // GetEgressRuleCfg returns MultiNetworkPolicyEgressRule.
 func (builder *EgressRuleBuilder) GetEgressRuleCfg() (*v1beta1.MultiNetworkPolicyEgressRule, error) {
-	glog.V(100).Infof("Returning configuration for egress rule")
+	logging.V(100).Infof("Returning configuration for egress rule")
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("Failed to build Egress rule configuration due to %s", builder.errorMsg)
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("Failed to build Egress rule configuration due to %s", builder.errorMsg)
 
-		return nil, fmt.Errorf(builder.errorMsg)
+		return nil, builder.errorMsg
 	}
 
 	return builder.definition, nil
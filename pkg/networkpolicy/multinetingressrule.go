@@ -1,11 +1,12 @@
 package networkpolicy
 
 import (
+	"errors"
 	"fmt"
 	"net"
 
-	"github.com/golang/glog"
 	"github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -19,12 +20,12 @@ type IngressRuleBuilder struct {
 	// IngressRule definition, used to create the IngressRule object.
 	definition *v1beta1.MultiNetworkPolicyIngressRule
 	// Used to store latest error message upon defining or mutating IngressRule definition.
-	errorMsg string
+	errorMsg error
 }
 
 // NewIngressRuleBuilder creates a new instance of IngressRuleBuilder.
 func NewIngressRuleBuilder() *IngressRuleBuilder {
-	glog.V(100).Infof("Initializing new Ingress rule structure")
+	logging.V(100).Infof("Initializing new Ingress rule structure")
 
 	builder := &IngressRuleBuilder{
 		definition: &v1beta1.MultiNetworkPolicyIngressRule{},
@@ -35,15 +36,15 @@ func NewIngressRuleBuilder() *IngressRuleBuilder {
 
 // WithPortAndProtocol adds port and protocol to Ingress rule.
 func (builder *IngressRuleBuilder) WithPortAndProtocol(port uint16, protocol corev1.Protocol) *IngressRuleBuilder {
-	glog.V(100).Infof("Adding port %d protocol %s to IngressRule", port, protocol)
+	logging.V(100).Infof("Adding port %d protocol %s to IngressRule", port, protocol)
 
 	if port == 0 {
-		glog.V(100).Infof("Port number can not be 0")
+		logging.V(100).Infof("Port number can not be 0")
 
-		builder.errorMsg = "port number can not be 0"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("port number can not be 0"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -57,16 +58,16 @@ func (builder *IngressRuleBuilder) WithPortAndProtocol(port uint16, protocol cor
 
 // WithOptions adds generic options to Ingress rule.
 func (builder *IngressRuleBuilder) WithOptions(options ...IngressAdditionalOptions) *IngressRuleBuilder {
-	glog.V(100).Infof("Setting IngressRule additional options")
+	logging.V(100).Infof("Setting IngressRule additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -78,9 +79,9 @@ func (builder *IngressRuleBuilder) WithOptions(options ...IngressAdditionalOptio
 
 // WithPeerPodSelector adds peer pod selector to Ingress rule.
 func (builder *IngressRuleBuilder) WithPeerPodSelector(podSelector metav1.LabelSelector) *IngressRuleBuilder {
-	glog.V(100).Infof("Adding peer pod selector %v to Ingress Rule", podSelector)
+	logging.V(100).Infof("Adding peer pod selector %v to Ingress Rule", podSelector)
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -94,21 +95,21 @@ func (builder *IngressRuleBuilder) WithPeerPodSelector(podSelector metav1.LabelS
 
 // WithCIDR adds CIDR to Ingress rule.
 func (builder *IngressRuleBuilder) WithCIDR(cidr string, except ...[]string) *IngressRuleBuilder {
-	glog.V(100).Infof("Adding peer CIDR %s to Ingress Rule", cidr)
+	logging.V(100).Infof("Adding peer CIDR %s to Ingress Rule", cidr)
 
 	_, _, err := net.ParseCIDR(cidr)
 
 	if err != nil {
-		glog.V(100).Infof("Invalid CIDR %s", cidr)
+		logging.V(100).Infof("Invalid CIDR %s", cidr)
 
-		builder.errorMsg = fmt.Sprintf("Invalid CIDR argument %s", cidr)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Invalid CIDR argument %s", cidr))
 	}
 
 	if len(except) > 0 {
-		glog.V(100).Infof("Adding CIDR except %s to Ingress Rule", except[0])
+		logging.V(100).Infof("Adding CIDR except %s to Ingress Rule", except[0])
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -129,9 +130,9 @@ func (builder *IngressRuleBuilder) WithCIDR(cidr string, except ...[]string) *In
 // WithPeerPodSelectorAndCIDR adds port and protocol,CIDR to Ingress rule.
 func (builder *IngressRuleBuilder) WithPeerPodSelectorAndCIDR(
 	podSelector metav1.LabelSelector, cidr string, except ...[]string) *IngressRuleBuilder {
-	glog.V(100).Infof("Adding peer pod selector %v and CIDR %s to IngressRule", podSelector, cidr)
+	logging.V(100).Infof("Adding peer pod selector %v and CIDR %s to IngressRule", podSelector, cidr)
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -143,12 +144,12 @@ func (builder *IngressRuleBuilder) WithPeerPodSelectorAndCIDR(
 
 // GetIngressRuleCfg returns MultiNetworkPolicyIngressRule.
 func (builder *IngressRuleBuilder) GetIngressRuleCfg() (*v1beta1.MultiNetworkPolicyIngressRule, error) {
-	glog.V(100).Infof("Returning configuration for ingress rule")
+	logging.V(100).Infof("Returning configuration for ingress rule")
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("Failed to build Ingress rule configuration due to %s", builder.errorMsg)
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("Failed to build Ingress rule configuration due to %s", builder.errorMsg)
 
-		return nil, fmt.Errorf(builder.errorMsg)
+		return nil, builder.errorMsg
 	}
 
 	return builder.definition, nil
@@ -2,17 +2,22 @@ package networkpolicy
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
-	"github.com/golang/glog"
 	"github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// multiNetworkPolicyForAnnotation lists the networks a MultiNetworkPolicy applies to.
+const multiNetworkPolicyForAnnotation = "k8s.v1.cni.cncf.io/policy-for"
+
 // MultiNetworkPolicyBuilder provides struct for MultiNetworkPolicy object.
 type MultiNetworkPolicyBuilder struct {
 	// MultiNetworkPolicy definition. Used to create MultiNetworkPolicy object with minimum set of required elements.
@@ -22,12 +27,12 @@ type MultiNetworkPolicyBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before MultiNetworkPolicy object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewMultiNetworkPolicyBuilder method creates new instance of builder.
 func NewMultiNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *MultiNetworkPolicyBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new MultiNetworkPolicyBuilder structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
@@ -42,15 +47,15 @@ func NewMultiNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname stri
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the MultiNetworkPolicy is empty")
+		logging.V(100).Infof("The name of the MultiNetworkPolicy is empty")
 
-		builder.errorMsg = "The MultiNetworkPolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The MultiNetworkPolicy 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the MultiNetworkPolicy is empty")
+		logging.V(100).Infof("The namespace of the MultiNetworkPolicy is empty")
 
-		builder.errorMsg = "The MultiNetworkPolicy 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The MultiNetworkPolicy 'namespace' cannot be empty"))
 	}
 
 	return builder
@@ -62,11 +67,18 @@ func (builder *MultiNetworkPolicyBuilder) WithPodSelector(podSelector metav1.Lab
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating MultiNetworkPolicy %s in %s namespace with the podSelector defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, podSelector)
 
-	if builder.errorMsg != "" {
+	_, err := metav1.LabelSelectorAsSelector(&podSelector)
+	if err != nil {
+		logging.V(100).Infof("The podSelector is invalid: %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The podSelector is invalid: %w", err))
+	}
+
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -75,23 +87,24 @@ func (builder *MultiNetworkPolicyBuilder) WithPodSelector(podSelector metav1.Lab
 	return builder
 }
 
-// WithNetwork adds network name to the MultiNetworkPolicy.
+// WithNetwork adds network name to the MultiNetworkPolicy. The networkName is either a NetworkAttachmentDefinition
+// name or a namespace/name pair. Calling it several times applies the policy to every given network.
 func (builder *MultiNetworkPolicyBuilder) WithNetwork(networkName string) *MultiNetworkPolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating MultiNetworkPolicy %s in %s namespace with the networkName defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, networkName)
 
 	if networkName == "" {
-		glog.V(100).Infof("The networkName can not be empty string")
+		logging.V(100).Infof("The networkName can not be empty string")
 
-		builder.errorMsg = "The networkName is an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The networkName is an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -99,18 +112,38 @@ func (builder *MultiNetworkPolicyBuilder) WithNetwork(networkName string) *Multi
 		builder.Definition.Annotations = make(map[string]string)
 	}
 
-	builder.Definition.Annotations["k8s.v1.cni.cncf.io/policy-for"] = networkName
+	networks := builder.GetNetworks()
+
+	for _, network := range networks {
+		if network == networkName {
+			logging.V(100).Infof("The network %s is already defined", networkName)
+
+			return builder
+		}
+	}
+
+	builder.Definition.Annotations[multiNetworkPolicyForAnnotation] = strings.Join(append(networks, networkName), ",")
 
 	return builder
 }
 
+// GetNetworks returns the networks the MultiNetworkPolicy applies to, as listed in its policy-for annotation. Each
+// network is either a NetworkAttachmentDefinition name or a namespace/name pair.
+func (builder *MultiNetworkPolicyBuilder) GetNetworks() []string {
+	if valid, _ := builder.validate(); !valid {
+		return nil
+	}
+
+	return parsePolicyForAnnotation(builder.Definition.Annotations[multiNetworkPolicyForAnnotation])
+}
+
 // WithEmptyIngress adds empty ingress rule to the MultiNetworkPolicy. Empty ingress denies all.
 func (builder *MultiNetworkPolicyBuilder) WithEmptyIngress() *MultiNetworkPolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating MultiNetworkPolicy %s in %s namespace with the empty Ingress rule deny all",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -126,7 +159,7 @@ func (builder *MultiNetworkPolicyBuilder) WithIngressRule(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating multiNetworkPolicy %s in %s namespace with the Ingress rule defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, ingressRule)
 
@@ -142,7 +175,7 @@ func (builder *MultiNetworkPolicyBuilder) WithEgressRule(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating multiNetworkPolicy %s in %s namespace with the Egress rule defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, egressRule)
 
@@ -158,17 +191,17 @@ func (builder *MultiNetworkPolicyBuilder) WithPolicyType(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating multiNetworkPolicy %s in %s namespace with the Policy type defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, policyType)
 
 	if policyType == "" {
-		glog.V(100).Infof("The policy type can not be an empty string")
+		logging.V(100).Infof("The policy type can not be an empty string")
 
-		builder.errorMsg = "The policy Type is an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The policy Type is an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -179,7 +212,7 @@ func (builder *MultiNetworkPolicyBuilder) WithPolicyType(
 
 // PullMultiNetworkPolicy loads an existing MultiNetworkPolicy into the Builder struct.
 func PullMultiNetworkPolicy(apiClient *clients.Settings, name, nsname string) (*MultiNetworkPolicyBuilder, error) {
-	glog.V(100).Infof("Pulling existing MultiNetworkPolicy name: %s, namespace: %s", name, nsname)
+	logging.V(100).Infof("Pulling existing MultiNetworkPolicy name: %s, namespace: %s", name, nsname)
 
 	builder := MultiNetworkPolicyBuilder{
 		apiClient: apiClient,
@@ -192,24 +225,24 @@ func PullMultiNetworkPolicy(apiClient *clients.Settings, name, nsname string) (*
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the MultiNetworkPolicy is empty")
+		logging.V(100).Infof("The name of the MultiNetworkPolicy is empty")
 
-		builder.errorMsg = "MultiNetworkPolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MultiNetworkPolicy 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the MultiNetworkPolicy is empty")
+		logging.V(100).Infof("The namespace of the MultiNetworkPolicy is empty")
 
-		builder.errorMsg = "MultiNetworkPolicy 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MultiNetworkPolicy 'namespace' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return nil, fmt.Errorf("failed to pull MultiNetworkPolicy object due to the following error: %s",
 			builder.errorMsg)
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to pull MultiNetworkPolicy object %s from namespace %s. Object doesn't exist",
 			name, nsname)
 
@@ -227,7 +260,7 @@ func (builder *MultiNetworkPolicyBuilder) Create() (*MultiNetworkPolicyBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the MultiNetworkPolicy %s in %s namespace",
+	logging.V(100).Infof("Creating the MultiNetworkPolicy %s in %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -245,7 +278,7 @@ func (builder *MultiNetworkPolicyBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if MultiNetworkPolicy %s exists in namespace %s",
+	logging.V(100).Infof("Checking if MultiNetworkPolicy %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -261,7 +294,7 @@ func (builder *MultiNetworkPolicyBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the MultiNetworkPolicy object %s from %s namespace",
+	logging.V(100).Infof("Deleting the MultiNetworkPolicy object %s from %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -286,7 +319,7 @@ func (builder *MultiNetworkPolicyBuilder) Update() (*MultiNetworkPolicyBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating MultiNetworkPolicy %s in %s namespace ",
+	logging.V(100).Infof("Updating MultiNetworkPolicy %s in %s namespace ",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -301,33 +334,49 @@ func GetMultiNetworkGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "k8s.cni.cncf.io", Version: "v1beta1", Resource: "multi-networkpolicies"}
 }
 
+// parsePolicyForAnnotation splits the comma separated policy-for annotation into network names.
+func parsePolicyForAnnotation(annotation string) []string {
+	var networks []string
+
+	for _, network := range strings.Split(annotation, ",") {
+		network = strings.TrimSpace(network)
+		if network != "" {
+			networks = append(networks, network)
+		}
+	}
+
+	return networks
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MultiNetworkPolicyBuilder) validate() (bool, error) {
 	resourceCRD := "MultiNetworkPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
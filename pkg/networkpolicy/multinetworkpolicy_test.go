@@ -0,0 +1,147 @@
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMultiNetworkPolicyWithNetwork(t *testing.T) {
+	testCases := []struct {
+		networkNames       []string
+		expectedNetworks   []string
+		expectedAnnotation string
+		expectedError      string
+	}{
+		{
+			networkNames:       []string{"sriov-net"},
+			expectedNetworks:   []string{"sriov-net"},
+			expectedAnnotation: "sriov-net",
+			expectedError:      "",
+		},
+		{
+			networkNames:       []string{"sriov-net", "other-namespace/macvlan-net", "sriov-net"},
+			expectedNetworks:   []string{"sriov-net", "other-namespace/macvlan-net"},
+			expectedAnnotation: "sriov-net,other-namespace/macvlan-net",
+			expectedError:      "",
+		},
+		{
+			networkNames:  []string{""},
+			expectedError: "The networkName is an empty string",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewMultiNetworkPolicyBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), defaultNetworkPolicyName, defaultNetworkPolicyNamespace)
+
+		for _, networkName := range testCase.networkNames {
+			testBuilder = testBuilder.WithNetwork(networkName)
+		}
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedNetworks, testBuilder.GetNetworks())
+		assert.Equal(t,
+			testCase.expectedAnnotation, testBuilder.Definition.Annotations[multiNetworkPolicyForAnnotation])
+	}
+}
+
+func TestMultiNetworkPolicyWithPodSelector(t *testing.T) {
+	testCases := []struct {
+		podSelector   metav1.LabelSelector
+		expectedError string
+	}{
+		{
+			podSelector:   metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			expectedError: "",
+		},
+		{
+			podSelector:   metav1.LabelSelector{},
+			expectedError: "",
+		},
+		{
+			podSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn}}},
+			expectedError: "The podSelector is invalid: values: Invalid value: []string(nil): " +
+				"for 'in', 'notin' operators, values set can't be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewMultiNetworkPolicyBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), defaultNetworkPolicyName, defaultNetworkPolicyNamespace).
+			WithPodSelector(testCase.podSelector)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.podSelector, testBuilder.Definition.Spec.PodSelector)
+	}
+}
+
+func TestListMultiNetworkPoliciesByNetwork(t *testing.T) {
+	_, err := ListMultiNetworkPoliciesByNetwork(nil, defaultNetworkPolicyNamespace, "sriov-net")
+	assert.EqualError(t, err, "failed to list MultiNetworkPolicies, 'apiClient' parameter is empty")
+
+	_, err = ListMultiNetworkPoliciesByNetwork(
+		clients.GetTestClients(clients.TestClientParams{}), "", "sriov-net")
+	assert.EqualError(t, err, "failed to list MultiNetworkPolicies, 'nsname' parameter is empty")
+
+	_, err = ListMultiNetworkPoliciesByNetwork(
+		clients.GetTestClients(clients.TestClientParams{}), defaultNetworkPolicyNamespace, "")
+	assert.EqualError(t, err, "failed to list MultiNetworkPolicies, 'networkName' parameter is empty")
+}
+
+func TestPolicyAppliesToNetwork(t *testing.T) {
+	testCases := []struct {
+		annotation  string
+		networkName string
+		expected    bool
+	}{
+		{
+			annotation:  "sriov-net",
+			networkName: "sriov-net",
+			expected:    true,
+		},
+		{
+			annotation:  "sriov-net",
+			networkName: "test-namespace/sriov-net",
+			expected:    true,
+		},
+		{
+			annotation:  "macvlan-net, test-namespace/sriov-net",
+			networkName: "sriov-net",
+			expected:    true,
+		},
+		{
+			annotation:  "other-namespace/sriov-net",
+			networkName: "sriov-net",
+			expected:    false,
+		},
+		{
+			annotation:  "",
+			networkName: "sriov-net",
+			expected:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		policy := &v1beta1.MultiNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        defaultNetworkPolicyName,
+				Namespace:   defaultNetworkPolicyNamespace,
+				Annotations: map[string]string{multiNetworkPolicyForAnnotation: testCase.annotation},
+			},
+		}
+
+		assert.Equal(t, testCase.expected, policyAppliesToNetwork(policy, testCase.networkName))
+	}
+}
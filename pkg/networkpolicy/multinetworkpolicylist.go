@@ -0,0 +1,119 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListMultiNetworkPolicies returns MultiNetworkPolicy inventory in the given namespace.
+func ListMultiNetworkPolicies(
+	apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*MultiNetworkPolicyBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("MultiNetworkPolicy 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list MultiNetworkPolicies, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("MultiNetworkPolicy 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list MultiNetworkPolicies, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := fmt.Sprintf("Listing MultiNetworkPolicies in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	policyList, err := apiClient.MultiNetworkPolicies(nsname).List(context.TODO(), passedOptions)
+
+	if err != nil {
+		logging.V(100).Infof("Failed to list MultiNetworkPolicies in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var policyObjects []*MultiNetworkPolicyBuilder
+
+	for _, runningPolicy := range policyList.Items {
+		copiedPolicy := runningPolicy
+		policyBuilder := &MultiNetworkPolicyBuilder{
+			apiClient:  apiClient,
+			Object:     &copiedPolicy,
+			Definition: &copiedPolicy,
+		}
+
+		policyObjects = append(policyObjects, policyBuilder)
+	}
+
+	return policyObjects, nil
+}
+
+// ListMultiNetworkPoliciesByNetwork returns the MultiNetworkPolicies in the given namespace that apply to the
+// network. The networkName is either a NetworkAttachmentDefinition name, resolved in namespace nsname, or a
+// namespace/name pair.
+func ListMultiNetworkPoliciesByNetwork(
+	apiClient *clients.Settings, nsname, networkName string) ([]*MultiNetworkPolicyBuilder, error) {
+	logging.V(100).Infof("Listing MultiNetworkPolicies for network %s in the namespace %s", networkName, nsname)
+
+	if networkName == "" {
+		logging.V(100).Infof("MultiNetworkPolicy 'networkName' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list MultiNetworkPolicies, 'networkName' parameter is empty")
+	}
+
+	policies, err := ListMultiNetworkPolicies(apiClient, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingPolicies []*MultiNetworkPolicyBuilder
+
+	for _, policy := range policies {
+		if policyAppliesToNetwork(policy.Object, networkName) {
+			matchingPolicies = append(matchingPolicies, policy)
+		}
+	}
+
+	return matchingPolicies, nil
+}
+
+// policyAppliesToNetwork checks whether the policy-for annotation of the policy lists the network. Networks without
+// a namespace are relative to the namespace of the policy.
+func policyAppliesToNetwork(policy *v1beta1.MultiNetworkPolicy, networkName string) bool {
+	qualifiedName := qualifyNetworkName(networkName, policy.Namespace)
+
+	for _, network := range parsePolicyForAnnotation(policy.Annotations[multiNetworkPolicyForAnnotation]) {
+		if qualifyNetworkName(network, policy.Namespace) == qualifiedName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// qualifyNetworkName returns the network name in namespace/name form.
+func qualifyNetworkName(networkName, nsname string) string {
+	if strings.Contains(networkName, "/") {
+		return networkName
+	}
+
+	return nsname + "/" + networkName
+}
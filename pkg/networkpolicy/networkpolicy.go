@@ -2,11 +2,14 @@ package networkpolicy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -21,12 +24,15 @@ type NetworkPolicyBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before NetworkPolicy object is created.
-	errorMsg string
+	errorMsg error
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // NewNetworkPolicyBuilder method creates new instance of builder.
 func NewNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *NetworkPolicyBuilder {
-	glog.V(100).Infof("Initializing new NetworkPolicyBuilder structure with the following params: name: %s, namespace: %s",
+	logging.V(100).Infof(
+		"Initializing new NetworkPolicyBuilder structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
 	builder := &NetworkPolicyBuilder{
@@ -40,20 +46,55 @@ func NewNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the networkPolicy is empty")
+		logging.V(100).Infof("The name of the networkPolicy is empty")
 
-		builder.errorMsg = "The networkPolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The networkPolicy 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the networkPolicy is empty")
+		logging.V(100).Infof("The namespace of the networkPolicy is empty")
 
-		builder.errorMsg = "The networkPolicy 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The networkPolicy 'namespace' cannot be empty"))
 	}
 
 	return builder
 }
 
+// NewDenyAllNetworkPolicyBuilder creates new instance of builder for a networkPolicy that selects every pod of the
+// namespace and denies all of their ingress and egress traffic.
+func NewDenyAllNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *NetworkPolicyBuilder {
+	logging.V(100).Infof("Initializing deny-all networkPolicy %s in namespace %s", name, nsname)
+
+	return NewNetworkPolicyBuilder(apiClient, name, nsname).
+		WithPolicyType(netv1.PolicyTypeIngress).
+		WithPolicyType(netv1.PolicyTypeEgress)
+}
+
+// NewAllowDNSNetworkPolicyBuilder creates new instance of builder for a networkPolicy that allows every pod of the
+// namespace to reach DNS servers in any namespace over UDP and TCP. Port 5353 is included because policies match the
+// pod port after the service translation and the OpenShift DNS pods listen on it.
+func NewAllowDNSNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *NetworkPolicyBuilder {
+	logging.V(100).Infof("Initializing allow-dns networkPolicy %s in namespace %s", name, nsname)
+
+	egressRule, err := NewNetworkPolicyEgressRuleBuilder().
+		WithPortAndProtocol(53, corev1.ProtocolUDP).
+		WithPortAndProtocol(53, corev1.ProtocolTCP).
+		WithPortAndProtocol(5353, corev1.ProtocolUDP).
+		WithPortAndProtocol(5353, corev1.ProtocolTCP).
+		WithPeerNamespaceSelector(metav1.LabelSelector{}).
+		GetEgressRuleCfg()
+
+	builder := NewNetworkPolicyBuilder(apiClient, name, nsname)
+
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	return builder.WithEgressRule(*egressRule).WithPolicyType(netv1.PolicyTypeEgress)
+}
+
 // WithNamespaceIngressRule applies ingress rule for the networkPolicy.
 func (builder *NetworkPolicyBuilder) WithNamespaceIngressRule(
 	namespaceIngressMatchLabels map[string]string,
@@ -62,24 +103,25 @@ func (builder *NetworkPolicyBuilder) WithNamespaceIngressRule(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Applying Ingress rule to networkPolicy %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if len(namespaceIngressMatchLabels) == 0 && len(podIngressMatchLabels) == 0 {
-		glog.V(100).Infof("At least one type of the selector for NetworkPolicy ingress rule should be defined")
+		logging.V(100).Infof("At least one type of the selector for NetworkPolicy ingress rule should be defined")
 
-		builder.errorMsg = "Both namespaceIngressMatchLabels and podIngressMatchLabels parameters are empty maps"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Both namespaceIngressMatchLabels and podIngressMatchLabels parameters are empty maps"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
 	var peerRule netv1.NetworkPolicyPeer
 
 	if len(namespaceIngressMatchLabels) != 0 {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Applying Ingress rule with namespaceIngressMatchLabels %v parameter to networkPolicy %s in namespace %s",
 			namespaceIngressMatchLabels, builder.Definition.Name, builder.Definition.Namespace)
 
@@ -89,7 +131,7 @@ func (builder *NetworkPolicyBuilder) WithNamespaceIngressRule(
 	}
 
 	if len(podIngressMatchLabels) != 0 {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Applying Ingress rule with podIngressMatchLabels %v parameter to networkPolicy %s in namespace %s",
 			podIngressMatchLabels, builder.Definition.Name, builder.Definition.Namespace)
 
@@ -110,26 +152,55 @@ func (builder *NetworkPolicyBuilder) WithNamespaceIngressRule(
 	return builder
 }
 
+// WithEgressRule adds Egress rule to the networkPolicy. Empty rule is allowed and works as allow all traffic.
+func (builder *NetworkPolicyBuilder) WithEgressRule(egressRule netv1.NetworkPolicyEgressRule) *NetworkPolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Applying Egress rule %v to networkPolicy %s in namespace %s",
+		egressRule, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.Egress = append(builder.Definition.Spec.Egress, egressRule)
+
+	return builder
+}
+
 // WithPolicyType add policyType to the networkPolicy.
 func (builder *NetworkPolicyBuilder) WithPolicyType(policyType netv1.PolicyType) *NetworkPolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating networkPolicy %s in %s namespace with the policyType defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, policyType)
 
 	if policyType == "" {
-		glog.V(100).Infof("The policyType value has to be provided")
+		logging.V(100).Infof("The policyType value has to be provided")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The policyType is an empty string"))
+	} else if policyType != netv1.PolicyTypeIngress && policyType != netv1.PolicyTypeEgress {
+		logging.V(100).Infof("The policyType %s is not supported", policyType)
 
-		builder.errorMsg = "The policyType is an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"The policyType %s is not supported, must be %s or %s",
+			policyType, netv1.PolicyTypeIngress, netv1.PolicyTypeEgress))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
+	for _, definedPolicyType := range builder.Definition.Spec.PolicyTypes {
+		if definedPolicyType == policyType {
+			logging.V(100).Infof("The policyType %s is already defined", policyType)
+
+			return builder
+		}
+	}
+
 	if builder.Definition.Spec.PolicyTypes == nil {
 		builder.Definition.Spec.PolicyTypes = []netv1.PolicyType{policyType}
 
@@ -147,17 +218,17 @@ func (builder *NetworkPolicyBuilder) WithPodSelector(podSelectorMatchLabels map[
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating networkPolicy %s in %s namespace with podSelector defined: %v",
 		builder.Definition.Name, builder.Definition.Namespace, podSelectorMatchLabels)
 
 	if len(podSelectorMatchLabels) == 0 {
-		glog.V(100).Infof("The podSelector could not be empty")
+		logging.V(100).Infof("The podSelector could not be empty")
 
-		builder.errorMsg = "The podSelector is an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The podSelector is an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -169,7 +240,7 @@ func (builder *NetworkPolicyBuilder) WithPodSelector(podSelectorMatchLabels map[
 
 // Pull loads an existing networkPolicy into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*NetworkPolicyBuilder, error) {
-	glog.V(100).Infof("Pulling existing networkPolicy name: %s namespace:%s", name, nsname)
+	logging.V(100).Infof("Pulling existing networkPolicy name: %s namespace:%s", name, nsname)
 
 	builder := NetworkPolicyBuilder{
 		apiClient: apiClient,
@@ -182,24 +253,24 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*NetworkPolicyBuild
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the networkPolicy is empty")
+		logging.V(100).Infof("The name of the networkPolicy is empty")
 
-		builder.errorMsg = "networkPolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("networkPolicy 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the networkPolicy is empty")
+		logging.V(100).Infof("The namespace of the networkPolicy is empty")
 
-		builder.errorMsg = "networkPolicy 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("networkPolicy 'namespace' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return nil, fmt.Errorf("failed to pull networkPolicy object due to the following error: %s",
 			builder.errorMsg)
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Failed to pull networkPolicy object %s from namespace %s. Object doesn't exist",
+		logging.V(100).Infof("Failed to pull networkPolicy object %s from namespace %s. Object doesn't exist",
 			name, nsname)
 
 		return nil, fmt.Errorf("networkPolicy object %s doesn't exist in namespace %s", name, nsname)
@@ -216,11 +287,15 @@ func (builder *NetworkPolicyBuilder) Create() (*NetworkPolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the networkPolicy %s in %s namespace",
+	logging.V(100).Infof("Creating the networkPolicy %s in %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.NetworkPolicies(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -228,13 +303,46 @@ func (builder *NetworkPolicyBuilder) Create() (*NetworkPolicyBuilder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *NetworkPolicyBuilder) WithDryRun(dryRun bool) *NetworkPolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for networkpolicy %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the networkpolicy definition against the API server and its admission webhooks with a server-side
+// dry-run request. The networkpolicy is not created and the builder Object is not modified.
+func (builder *NetworkPolicyBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the networkpolicy %s in namespace %s in dry-run mode",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.NetworkPolicies(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Exists checks whether the given NetworkPolicy exists.
 func (builder *NetworkPolicyBuilder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if networkPolicy %s exists in namespace %s",
+	logging.V(100).Infof("Checking if networkPolicy %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -250,20 +358,29 @@ func (builder *NetworkPolicyBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the networkPolicy object %s from %s namespace",
+	logging.V(100).Infof("Deleting the networkPolicy object %s from %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return fmt.Errorf("networkPolicy cannot be deleted because it does not exist")
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.NetworkPolicies(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Definition.Name, deleteOptions)
 
 	if err != nil {
 		return fmt.Errorf("cannot delete MachineConfig: %w", err)
 	}
 
+	if builder.dryRun {
+		return err
+	}
+
 	builder.Object = nil
 
 	return err
@@ -275,7 +392,7 @@ func (builder *NetworkPolicyBuilder) Update() (*NetworkPolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating networkPolicy %s in %s namespace ",
+	logging.V(100).Infof("Updating networkPolicy %s in %s namespace ",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -285,33 +402,55 @@ func (builder *NetworkPolicyBuilder) Update() (*NetworkPolicyBuilder, error) {
 	return builder, err
 }
 
+// Diff compares the networkpolicy definition with the networkpolicy object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *NetworkPolicyBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing networkpolicy %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"networkpolicy object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *NetworkPolicyBuilder) validate() (bool, error) {
 	resourceCRD := "NetworkPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
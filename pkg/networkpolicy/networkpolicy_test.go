@@ -1 +1,152 @@
 package networkpolicy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultNetworkPolicyName      = "test-policy"
+	defaultNetworkPolicyNamespace = "test-namespace"
+)
+
+func TestNetworkPolicyWithPolicyType(t *testing.T) {
+	testCases := []struct {
+		policyTypes   []netv1.PolicyType
+		expectedTypes []netv1.PolicyType
+		expectedError string
+	}{
+		{
+			policyTypes:   []netv1.PolicyType{netv1.PolicyTypeIngress, netv1.PolicyTypeEgress},
+			expectedTypes: []netv1.PolicyType{netv1.PolicyTypeIngress, netv1.PolicyTypeEgress},
+			expectedError: "",
+		},
+		{
+			policyTypes:   []netv1.PolicyType{netv1.PolicyTypeEgress, netv1.PolicyTypeEgress},
+			expectedTypes: []netv1.PolicyType{netv1.PolicyTypeEgress},
+			expectedError: "",
+		},
+		{
+			policyTypes:   []netv1.PolicyType{""},
+			expectedError: "The policyType is an empty string",
+		},
+		{
+			policyTypes:   []netv1.PolicyType{"Both"},
+			expectedError: "The policyType Both is not supported, must be Ingress or Egress",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewNetworkPolicyBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), defaultNetworkPolicyName, defaultNetworkPolicyNamespace)
+
+		for _, policyType := range testCase.policyTypes {
+			testBuilder = testBuilder.WithPolicyType(policyType)
+		}
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedTypes, testBuilder.Definition.Spec.PolicyTypes)
+	}
+}
+
+func TestNetworkPolicyWithEgressRule(t *testing.T) {
+	egressRule, err := NewNetworkPolicyEgressRuleBuilder().
+		WithPortRangeAndProtocol(30000, 32767, corev1.ProtocolTCP).
+		WithCIDR("10.0.0.0/8", []string{"10.1.0.0/16"}).
+		GetEgressRuleCfg()
+	assert.Nil(t, err)
+
+	testBuilder := NewNetworkPolicyBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), defaultNetworkPolicyName, defaultNetworkPolicyNamespace).
+		WithEgressRule(*egressRule).
+		WithEgressRule(netv1.NetworkPolicyEgressRule{})
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, []netv1.NetworkPolicyEgressRule{*egressRule, {}}, testBuilder.Definition.Spec.Egress)
+}
+
+func TestNewDenyAllNetworkPolicyBuilder(t *testing.T) {
+	testBuilder := NewDenyAllNetworkPolicyBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), defaultNetworkPolicyName, defaultNetworkPolicyNamespace)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Empty(t, testBuilder.Definition.Spec.PodSelector.MatchLabels)
+	assert.Empty(t, testBuilder.Definition.Spec.Ingress)
+	assert.Empty(t, testBuilder.Definition.Spec.Egress)
+	assert.Equal(t,
+		[]netv1.PolicyType{netv1.PolicyTypeIngress, netv1.PolicyTypeEgress}, testBuilder.Definition.Spec.PolicyTypes)
+
+	testBuilder, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+}
+
+func TestNewAllowDNSNetworkPolicyBuilder(t *testing.T) {
+	testBuilder := NewAllowDNSNetworkPolicyBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), defaultNetworkPolicyName, defaultNetworkPolicyNamespace)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, []netv1.PolicyType{netv1.PolicyTypeEgress}, testBuilder.Definition.Spec.PolicyTypes)
+	assert.Len(t, testBuilder.Definition.Spec.Egress, 1)
+	assert.Len(t, testBuilder.Definition.Spec.Egress[0].Ports, 4)
+	assert.Len(t, testBuilder.Definition.Spec.Egress[0].To, 1)
+	assert.NotNil(t, testBuilder.Definition.Spec.Egress[0].To[0].NamespaceSelector)
+
+	testBuilder = NewAllowDNSNetworkPolicyBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), "", defaultNetworkPolicyNamespace)
+	assert.EqualError(t, testBuilder.errorMsg, "The networkPolicy 'name' cannot be empty")
+}
+
+func TestNetworkPolicyDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := NewNetworkPolicyBuilder(testSettings, "test-policy", "test-namespace")
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("networkpolicy object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestNetworkPolicyWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "networkpolicies", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := NewNetworkPolicyBuilder(testSettings, "test-policy", "test-namespace").
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := NewNetworkPolicyBuilder(existingSettings, "test-policy", "test-namespace").
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
@@ -0,0 +1,182 @@
+package networkpolicy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// NetworkPolicyEgressRuleBuilder provides a struct for NetworkPolicyEgressRule's object definition.
+type NetworkPolicyEgressRuleBuilder struct {
+	// EgressRule definition, used to create the EgressRule object.
+	definition *netv1.NetworkPolicyEgressRule
+	// Used to store latest error message upon defining or mutating EgressRule definition.
+	errorMsg error
+}
+
+// NewNetworkPolicyEgressRuleBuilder creates a new instance of NetworkPolicyEgressRuleBuilder.
+func NewNetworkPolicyEgressRuleBuilder() *NetworkPolicyEgressRuleBuilder {
+	logging.V(100).Infof("Initializing new NetworkPolicy Egress rule structure")
+
+	builder := &NetworkPolicyEgressRuleBuilder{
+		definition: &netv1.NetworkPolicyEgressRule{},
+	}
+
+	return builder
+}
+
+// WithPortAndProtocol adds port and protocol to Egress rule.
+func (builder *NetworkPolicyEgressRuleBuilder) WithPortAndProtocol(
+	port uint16, protocol corev1.Protocol) *NetworkPolicyEgressRuleBuilder {
+	logging.V(100).Infof("Adding port %d protocol %s to NetworkPolicy EgressRule", port, protocol)
+
+	if port == 0 {
+		logging.V(100).Infof("Port number can not be 0")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("port number can not be 0"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	formattedPort := intstr.FromInt(int(port))
+
+	builder.definition.Ports = append(
+		builder.definition.Ports, netv1.NetworkPolicyPort{Port: &formattedPort, Protocol: &protocol})
+
+	return builder
+}
+
+// WithPortRangeAndProtocol adds the inclusive range of ports from port to endPort and the protocol to Egress rule.
+func (builder *NetworkPolicyEgressRuleBuilder) WithPortRangeAndProtocol(
+	port, endPort uint16, protocol corev1.Protocol) *NetworkPolicyEgressRuleBuilder {
+	logging.V(100).Infof("Adding port range %d-%d protocol %s to NetworkPolicy EgressRule", port, endPort, protocol)
+
+	if port == 0 {
+		logging.V(100).Infof("Port number can not be 0")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("port number can not be 0"))
+	}
+
+	if endPort < port {
+		logging.V(100).Infof("End port %d is lower than port %d", endPort, port)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("end port %d can not be lower than port %d", endPort, port))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	formattedPort := intstr.FromInt(int(port))
+	formattedEndPort := int32(endPort)
+
+	builder.definition.Ports = append(builder.definition.Ports, netv1.NetworkPolicyPort{
+		Port: &formattedPort, EndPort: &formattedEndPort, Protocol: &protocol})
+
+	return builder
+}
+
+// WithPeerPodSelector adds peer pod selector to Egress rule.
+func (builder *NetworkPolicyEgressRuleBuilder) WithPeerPodSelector(
+	podSelector metav1.LabelSelector) *NetworkPolicyEgressRuleBuilder {
+	logging.V(100).Infof("Adding peer pod selector %v to NetworkPolicy EgressRule", podSelector)
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.definition.To = append(builder.definition.To, netv1.NetworkPolicyPeer{PodSelector: &podSelector})
+
+	return builder
+}
+
+// WithPeerNamespaceSelector adds peer namespace selector to Egress rule. An empty selector matches every namespace.
+func (builder *NetworkPolicyEgressRuleBuilder) WithPeerNamespaceSelector(
+	namespaceSelector metav1.LabelSelector) *NetworkPolicyEgressRuleBuilder {
+	logging.V(100).Infof("Adding peer namespace selector %v to NetworkPolicy EgressRule", namespaceSelector)
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.definition.To = append(
+		builder.definition.To, netv1.NetworkPolicyPeer{NamespaceSelector: &namespaceSelector})
+
+	return builder
+}
+
+// WithCIDR adds CIDR to Egress rule. Every except CIDR must be contained in cidr.
+func (builder *NetworkPolicyEgressRuleBuilder) WithCIDR(
+	cidr string, except ...[]string) *NetworkPolicyEgressRuleBuilder {
+	logging.V(100).Infof("Adding peer CIDR %s to NetworkPolicy EgressRule", cidr)
+
+	ipBlock, err := buildIPBlock(cidr, except...)
+	if err != nil {
+		logging.V(100).Infof("Invalid IPBlock: %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.definition.To = append(builder.definition.To, netv1.NetworkPolicyPeer{IPBlock: ipBlock})
+
+	return builder
+}
+
+// GetEgressRuleCfg returns NetworkPolicyEgressRule.
+func (builder *NetworkPolicyEgressRuleBuilder) GetEgressRuleCfg() (*netv1.NetworkPolicyEgressRule, error) {
+	logging.V(100).Infof("Returning configuration for NetworkPolicy egress rule")
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("Failed to build NetworkPolicy Egress rule configuration due to %s", builder.errorMsg)
+
+		return nil, builder.errorMsg
+	}
+
+	return builder.definition, nil
+}
+
+// buildIPBlock returns an IPBlock for cidr after checking that every except CIDR is valid and contained in cidr.
+func buildIPBlock(cidr string, except ...[]string) (*netv1.IPBlock, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid CIDR argument %s", cidr)
+	}
+
+	ipBlock := &netv1.IPBlock{CIDR: cidr}
+
+	if len(except) == 0 {
+		return ipBlock, nil
+	}
+
+	networkPrefix, _ := network.Mask.Size()
+
+	for _, exceptCIDR := range except[0] {
+		exceptIP, exceptNetwork, err := net.ParseCIDR(exceptCIDR)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid except CIDR argument %s", exceptCIDR)
+		}
+
+		exceptPrefix, _ := exceptNetwork.Mask.Size()
+
+		if !network.Contains(exceptIP) || exceptPrefix <= networkPrefix {
+			return nil, fmt.Errorf("except CIDR %s is not a subnet of CIDR %s", exceptCIDR, cidr)
+		}
+	}
+
+	ipBlock.Except = except[0]
+
+	return ipBlock, nil
+}
@@ -0,0 +1,112 @@
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetworkPolicyEgressRuleWithPortRangeAndProtocol(t *testing.T) {
+	testCases := []struct {
+		port          uint16
+		endPort       uint16
+		expectedError string
+	}{
+		{
+			port:          8000,
+			endPort:       8080,
+			expectedError: "",
+		},
+		{
+			port:          8080,
+			endPort:       8080,
+			expectedError: "",
+		},
+		{
+			port:          0,
+			endPort:       8080,
+			expectedError: "port number can not be 0",
+		},
+		{
+			port:          8080,
+			endPort:       8000,
+			expectedError: "end port 8000 can not be lower than port 8080",
+		},
+	}
+
+	for _, testCase := range testCases {
+		egressRule, err := NewNetworkPolicyEgressRuleBuilder().
+			WithPortRangeAndProtocol(testCase.port, testCase.endPort, corev1.ProtocolTCP).
+			GetEgressRuleCfg()
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, int(testCase.port), egressRule.Ports[0].Port.IntValue())
+		assert.Equal(t, int32(testCase.endPort), *egressRule.Ports[0].EndPort)
+	}
+}
+
+func TestNetworkPolicyEgressRuleWithCIDR(t *testing.T) {
+	testCases := []struct {
+		cidr          string
+		except        [][]string
+		expectedError string
+	}{
+		{
+			cidr:          "192.168.0.0/16",
+			expectedError: "",
+		},
+		{
+			cidr:          "192.168.0.0/16",
+			except:        [][]string{{"192.168.10.0/24", "192.168.20.0/24"}},
+			expectedError: "",
+		},
+		{
+			cidr:          "fd00::/64",
+			except:        [][]string{{"fd00::/96"}},
+			expectedError: "",
+		},
+		{
+			cidr:          "192.168.0.0/33",
+			expectedError: "Invalid CIDR argument 192.168.0.0/33",
+		},
+		{
+			cidr:          "192.168.0.0/16",
+			except:        [][]string{{"192.168.10.0"}},
+			expectedError: "Invalid except CIDR argument 192.168.10.0",
+		},
+		{
+			cidr:          "192.168.0.0/16",
+			except:        [][]string{{"10.0.0.0/24"}},
+			expectedError: "except CIDR 10.0.0.0/24 is not a subnet of CIDR 192.168.0.0/16",
+		},
+		{
+			cidr:          "192.168.0.0/16",
+			except:        [][]string{{"192.168.0.0/16"}},
+			expectedError: "except CIDR 192.168.0.0/16 is not a subnet of CIDR 192.168.0.0/16",
+		},
+	}
+
+	for _, testCase := range testCases {
+		egressRule, err := NewNetworkPolicyEgressRuleBuilder().
+			WithPeerPodSelector(metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}).
+			WithCIDR(testCase.cidr, testCase.except...).
+			GetEgressRuleCfg()
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Len(t, egressRule.To, 2)
+		assert.Equal(t, testCase.cidr, egressRule.To[1].IPBlock.CIDR)
+
+		if len(testCase.except) > 0 {
+			assert.Equal(t, testCase.except[0], egressRule.To[1].IPBlock.Except)
+		}
+	}
+}
@@ -2,10 +2,11 @@ package nfd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,17 +26,17 @@ type Builder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before Builder object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewBuilderFromObjectString creates a Builder object from CSV alm-examples.
 func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new Builder structure from almExample string")
 
 	nodeFeatureDiscovery, err := getNodeFeatureDiscoveryFromAlmExample(almExample)
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing Builder definition to NodeFeatureDiscovery object")
 
 	builder := Builder{
@@ -44,17 +45,18 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	}
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Error initializing NodeFeatureDiscovery from alm-examples: %s", err.Error())
 
-		builder.errorMsg = fmt.Sprintf("Error initializing NodeFeatureDiscovery from alm-examples: %s",
-			err.Error())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Error initializing NodeFeatureDiscovery from alm-examples: %s",
+			err.Error()))
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The NodeFeatureDiscovery object definition is nil")
+		logging.V(100).Infof("The NodeFeatureDiscovery object definition is nil")
 
-		builder.errorMsg = "NodeFeatureDiscovery definition is nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NodeFeatureDiscovery definition is nil"))
 	}
 
 	return &builder
@@ -66,7 +68,7 @@ func (builder *Builder) Get() (*nfdv1.NodeFeatureDiscovery, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting NodeFeatureDiscovery object %s in namespace %s",
+	logging.V(100).Infof("Collecting NodeFeatureDiscovery object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	nodeFeatureDiscovery := &nfdv1.NodeFeatureDiscovery{}
@@ -76,7 +78,7 @@ func (builder *Builder) Get() (*nfdv1.NodeFeatureDiscovery, error) {
 	}, nodeFeatureDiscovery)
 
 	if err != nil {
-		glog.V(100).Infof("NodeFeatureDiscovery object %s doesn't exist in namespace %s",
+		logging.V(100).Infof("NodeFeatureDiscovery object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
@@ -87,7 +89,7 @@ func (builder *Builder) Get() (*nfdv1.NodeFeatureDiscovery, error) {
 
 // Pull loads an existing NodeFeatureDiscovery into Builder struct.
 func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing nodeFeatureDiscovery name: %s in namespace: %s", name, namespace)
+	logging.V(100).Infof("Pulling existing nodeFeatureDiscovery name: %s in namespace: %s", name, namespace)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -100,15 +102,15 @@ func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error)
 	}
 
 	if name == "" {
-		glog.V(100).Infof("NodeFeatureDiscovery name is empty")
+		logging.V(100).Infof("NodeFeatureDiscovery name is empty")
 
-		builder.errorMsg = "NodeFeatureDiscovery 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NodeFeatureDiscovery 'name' cannot be empty"))
 	}
 
 	if namespace == "" {
-		glog.V(100).Infof("NodeFeatureDiscovery namespace is empty")
+		logging.V(100).Infof("NodeFeatureDiscovery namespace is empty")
 
-		builder.errorMsg = "NodeFeatureDiscovery 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NodeFeatureDiscovery 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -126,7 +128,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if NodeFeatureDiscovery %s exists in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -134,7 +136,7 @@ func (builder *Builder) Exists() bool {
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect NodeFeatureDiscovery object due to %s", err.Error())
+		logging.V(100).Infof("Failed to collect NodeFeatureDiscovery object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -146,7 +148,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -170,7 +172,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Creating the NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	var err error
@@ -191,20 +193,20 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the NodeFeatureDiscovery object named: %s in namespace: %s",
+	logging.V(100).Infof("Updating the NodeFeatureDiscovery object named: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("NodeFeatureDiscovery", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("NodeFeatureDiscovery", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -244,19 +246,19 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NodeFeatureDiscovery"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf(fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
 	}
@@ -2,9 +2,10 @@ package nmstate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
 
@@ -26,12 +27,12 @@ type Builder struct {
 	// API client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before NMState object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewBuilder creates a new instance of nmstate Builder.
 func NewBuilder(apiClient *clients.Settings, name string) *Builder {
-	glog.V(100).Infof("Initializing new NMState structure with the name: %s", name)
+	logging.V(100).Infof("Initializing new NMState structure with the name: %s", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -43,9 +44,9 @@ func NewBuilder(apiClient *clients.Settings, name string) *Builder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NMState is empty")
+		logging.V(100).Infof("The name of the NMState is empty")
 
-		builder.errorMsg = "NMState 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NMState 'name' cannot be empty"))
 	}
 
 	return &builder
@@ -57,13 +58,13 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if NMState %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if NMState %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect NMState object due to %s", err.Error())
+		logging.V(100).Infof("Failed to collect NMState object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -75,13 +76,13 @@ func (builder *Builder) Get() (*nmstateV1.NMState, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting NMState object %s", builder.Definition.Name)
+	logging.V(100).Infof("Collecting NMState object %s", builder.Definition.Name)
 
 	nmstate := &nmstateV1.NMState{}
 	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{Name: builder.Definition.Name}, nmstate)
 
 	if err != nil {
-		glog.V(100).Infof("NMState object %s doesn't exist", builder.Definition.Name)
+		logging.V(100).Infof("NMState object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
 	}
@@ -95,7 +96,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the NMState %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating the NMState %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -114,7 +115,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the NMState object %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the NMState object %s", builder.Definition.Name)
 
 	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
 
@@ -133,19 +134,19 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the NMState object", builder.Definition.Name)
+	logging.V(100).Infof("Updating the NMState object %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("NMState", builder.Definition.Name))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("NMState", builder.Definition.Name))
 
 				return nil, err
@@ -160,7 +161,7 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 
 // PullNMstate retrieves an existing NMState object from the cluster.
 func PullNMstate(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling NMState object name: %s", name)
+	logging.V(100).Infof("Pulling NMState object name: %s", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -172,9 +173,9 @@ func PullNMstate(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NMState is empty")
+		logging.V(100).Infof("The name of the NMState is empty")
 
-		builder.errorMsg = "NMState 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NMState 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -192,27 +193,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "NMState"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
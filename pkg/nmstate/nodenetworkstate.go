@@ -2,11 +2,12 @@ package nmstate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gopkg.in/yaml.v2"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"golang.org/x/exp/slices"
 
 	nmstateV1alpha1 "github.com/nmstate/kubernetes-nmstate/api/v1alpha1"
@@ -32,7 +33,7 @@ type StateBuilder struct {
 	// API client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before NodeNetworkState object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // Exists checks whether the given NodeNetworkState exists.
@@ -41,13 +42,13 @@ func (builder *StateBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if NodeNetworkState %s exists", builder.Object.Name)
+	logging.V(100).Infof("Checking if NodeNetworkState %s exists", builder.Object.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect NodeNetworkState object due to %s", err.Error())
+		logging.V(100).Infof("Failed to collect NodeNetworkState object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -59,7 +60,7 @@ func (builder *StateBuilder) Get() (*nmstateV1alpha1.NodeNetworkState, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collecting NodeNetworkState object %s", builder.Object.Name)
+	logging.V(100).Infof("Collecting NodeNetworkState object %s", builder.Object.Name)
 
 	nodeNetworkState := &nmstateV1alpha1.NodeNetworkState{}
 	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
@@ -67,7 +68,7 @@ func (builder *StateBuilder) Get() (*nmstateV1alpha1.NodeNetworkState, error) {
 	}, nodeNetworkState)
 
 	if err != nil {
-		glog.V(100).Infof("NodeNetworkState object %s doesn't exist", builder.Object.Name)
+		logging.V(100).Infof("NodeNetworkState object %s doesn't exist", builder.Object.Name)
 
 		return nil, err
 	}
@@ -81,12 +82,12 @@ func (builder *StateBuilder) GetTotalVFs(sriovInterfaceName string) (int, error)
 		return 0, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Getting total-vfs under interface %s from NodeNetworkState %s",
 		sriovInterfaceName, builder.Object.Name)
 
 	if sriovInterfaceName == "" {
-		glog.V(100).Infof("The sriovInterfaceName can not be empty string")
+		logging.V(100).Infof("The sriovInterfaceName can not be empty string")
 
 		return 0, fmt.Errorf("the sriovInterfaceName is empty sting")
 	}
@@ -113,18 +114,18 @@ func (builder *StateBuilder) GetInterfaceType(interfaceName, interfaceType strin
 		return NetworkInterface{}, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Getting interface %s with type %s from NodeNetworkState %s",
 		interfaceName, interfaceType, builder.Object.Name)
 
 	if interfaceName == "" {
-		glog.V(100).Infof("The interfaceName can not be empty string")
+		logging.V(100).Infof("The interfaceName can not be empty string")
 
 		return NetworkInterface{}, fmt.Errorf("the interfaceName is empty sting")
 	}
 
 	if !slices.Contains(allowedInterfaceTypes, interfaceType) {
-		glog.V(100).Infof("error to add type %s, allowed types are %v", interfaceType, allowedInterfaceTypes)
+		logging.V(100).Infof("error to add type %s, allowed types are %v", interfaceType, allowedInterfaceTypes)
 
 		return NetworkInterface{}, fmt.Errorf("invalid interfaceType parameter")
 	}
@@ -152,12 +153,12 @@ func (builder *StateBuilder) GetSriovVfs(sriovInterfaceName string) ([]Vf, error
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Getting all configured VFs under interface %s from NodeNetworkState %s",
 		sriovInterfaceName, builder.Object.Name)
 
 	if sriovInterfaceName == "" {
-		glog.V(100).Infof("The sriovInterfaceName can not be empty string")
+		logging.V(100).Infof("The sriovInterfaceName can not be empty string")
 
 		return nil, fmt.Errorf("the sriovInterfaceName is empty sting")
 	}
@@ -179,9 +180,90 @@ func (builder *StateBuilder) GetSriovVfs(sriovInterfaceName string) ([]Vf, error
 		"or SR-IOV VFs are not configured on it", sriovInterfaceName)
 }
 
+// GetInterfaceByName returns the interface with the given name from the NodeNetworkState current state.
+func (builder *StateBuilder) GetInterfaceByName(interfaceName string) (NetworkInterface, error) {
+	if valid, err := builder.validate(); !valid {
+		return NetworkInterface{}, err
+	}
+
+	logging.V(100).Infof(
+		"Getting interface %s from NodeNetworkState %s", interfaceName, builder.Object.Name)
+
+	if interfaceName == "" {
+		logging.V(100).Infof("The interfaceName can not be empty string")
+
+		return NetworkInterface{}, fmt.Errorf("the interfaceName is empty sting")
+	}
+
+	currentState, err := builder.getCurrentState()
+	if err != nil {
+		return NetworkInterface{}, err
+	}
+
+	for _, interf := range currentState.Interfaces {
+		if interf.Name == interfaceName {
+			return interf, nil
+		}
+	}
+
+	return NetworkInterface{}, fmt.Errorf("failed to find interface %s", interfaceName)
+}
+
+// ListBondSlaves returns the ports of the given bond interface from the NodeNetworkState current state.
+func (builder *StateBuilder) ListBondSlaves(bondName string) ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting ports of bond %s from NodeNetworkState %s", bondName, builder.Object.Name)
+
+	bondInterface, err := builder.GetInterfaceType(bondName, "bond")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bondInterface.LinkAggregation.Port) > 0 {
+		return bondInterface.LinkAggregation.Port, nil
+	}
+
+	return bondInterface.LinkAggregation.Slaves, nil
+}
+
+// GetRoutes returns the running routes from the NodeNetworkState current state.
+func (builder *StateBuilder) GetRoutes() ([]Route, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting running routes from NodeNetworkState %s", builder.Object.Name)
+
+	currentState, err := builder.getCurrentState()
+	if err != nil {
+		return nil, err
+	}
+
+	return currentState.Routes.Running, nil
+}
+
+// GetDNSResolver returns the running DNS resolver configuration from the NodeNetworkState current state.
+func (builder *StateBuilder) GetDNSResolver() (DNSConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return DNSConfig{}, err
+	}
+
+	logging.V(100).Infof("Getting running DNS resolver from NodeNetworkState %s", builder.Object.Name)
+
+	currentState, err := builder.getCurrentState()
+	if err != nil {
+		return DNSConfig{}, err
+	}
+
+	return currentState.DNSResolver.Running, nil
+}
+
 // PullNodeNetworkState retrieves an existing NodeNetworkState object from the cluster.
 func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuilder, error) {
-	glog.V(100).Infof("Pulling NodeNetworkState object name:%s", name)
+	logging.V(100).Infof("Pulling NodeNetworkState object name:%s", name)
 
 	stateBuilder := StateBuilder{
 		apiClient: apiClient,
@@ -193,9 +275,9 @@ func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuild
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NodeNetworkState is empty")
+		logging.V(100).Infof("The name of the NodeNetworkState is empty")
 
-		stateBuilder.errorMsg = "NodeNetworkState 'name' cannot be empty"
+		stateBuilder.errorMsg = errors.Join(stateBuilder.errorMsg, fmt.Errorf("NodeNetworkState 'name' cannot be empty"))
 	}
 
 	if !stateBuilder.Exists() {
@@ -205,33 +287,47 @@ func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuild
 	return &stateBuilder, nil
 }
 
+// getCurrentState unmarshals the current state of the NodeNetworkState.
+func (builder *StateBuilder) getCurrentState() (*DesiredState, error) {
+	var currentState DesiredState
+
+	err := yaml.Unmarshal(builder.Object.Status.CurrentState.Raw, &currentState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Unmarshal NMState state")
+	}
+
+	return &currentState, nil
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *StateBuilder) validate() (bool, error) {
 	resourceCRD := "NodeNetworkState"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Object == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
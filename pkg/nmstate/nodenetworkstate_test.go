@@ -0,0 +1,199 @@
+package nmstate
+
+import (
+	"fmt"
+	"testing"
+
+	nmstateShared "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstateV1alpha1 "github.com/nmstate/kubernetes-nmstate/api/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultNodeNetworkStateName = "worker-0"
+	defaultNodeCurrentState     = `
+interfaces:
+- name: eth1
+  type: ethernet
+  state: up
+- name: bond0
+  type: bond
+  state: up
+  link-aggregation:
+    mode: active-backup
+    port:
+    - eth2
+    - eth3
+- name: bond1
+  type: bond
+  state: up
+  link-aggregation:
+    mode: 802.3ad
+    slaves:
+    - eth4
+routes:
+  running:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.168.1.1
+    next-hop-interface: eth1
+    metric: 100
+    table-id: 254
+dns-resolver:
+  running:
+    search:
+    - example.com
+    server:
+    - 10.10.10.10
+`
+)
+
+func TestPullNodeNetworkState(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultNodeNetworkStateName,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultNodeNetworkStateName,
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Errorf("NodeNetworkState object %s doesn't exist", defaultNodeNetworkStateName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNodeNetworkState())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := PullNodeNetworkState(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestNodeNetworkStateGetInterfaceByName(t *testing.T) {
+	testCases := []struct {
+		interfaceName     string
+		expectedType      string
+		expectedErrorText string
+	}{
+		{
+			interfaceName:     "eth1",
+			expectedType:      "ethernet",
+			expectedErrorText: "",
+		},
+		{
+			interfaceName:     "bond0",
+			expectedType:      "bond",
+			expectedErrorText: "",
+		},
+		{
+			interfaceName:     "eth5",
+			expectedErrorText: "failed to find interface eth5",
+		},
+		{
+			interfaceName:     "",
+			expectedErrorText: "the interfaceName is empty sting",
+		},
+	}
+
+	for _, testCase := range testCases {
+		networkInterface, err := buildValidStateTestBuilder(t).GetInterfaceByName(testCase.interfaceName)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, err) {
+			assert.Equal(t, testCase.expectedType, networkInterface.Type)
+		}
+	}
+}
+
+func TestNodeNetworkStateListBondSlaves(t *testing.T) {
+	testCases := []struct {
+		bondName          string
+		expectedSlaves    []string
+		expectedErrorText string
+	}{
+		{
+			bondName:          "bond0",
+			expectedSlaves:    []string{"eth2", "eth3"},
+			expectedErrorText: "",
+		},
+		{
+			bondName:          "bond1",
+			expectedSlaves:    []string{"eth4"},
+			expectedErrorText: "",
+		},
+		{
+			bondName:          "eth1",
+			expectedErrorText: "failed to find interface eth1 or it is not a bond type",
+		},
+	}
+
+	for _, testCase := range testCases {
+		slaves, err := buildValidStateTestBuilder(t).ListBondSlaves(testCase.bondName)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, err) {
+			assert.Equal(t, testCase.expectedSlaves, slaves)
+		}
+	}
+}
+
+func TestNodeNetworkStateGetRoutes(t *testing.T) {
+	metric := 100
+	tableID := 254
+
+	routes, err := buildValidStateTestBuilder(t).GetRoutes()
+	assert.Nil(t, err)
+	assert.Equal(t, []Route{{
+		Destination:      "0.0.0.0/0",
+		NextHopAddress:   "192.168.1.1",
+		NextHopInterface: "eth1",
+		Metric:           &metric,
+		TableID:          &tableID,
+	}}, routes)
+}
+
+func TestNodeNetworkStateGetDNSResolver(t *testing.T) {
+	dnsResolver, err := buildValidStateTestBuilder(t).GetDNSResolver()
+	assert.Nil(t, err)
+	assert.Equal(t, DNSConfig{Search: []string{"example.com"}, Server: []string{"10.10.10.10"}}, dnsResolver)
+}
+
+func buildValidStateTestBuilder(t *testing.T) *StateBuilder {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyNodeNetworkState()},
+	})
+
+	testBuilder, err := PullNodeNetworkState(testSettings, defaultNodeNetworkStateName)
+	assert.Nil(t, err)
+
+	return testBuilder
+}
+
+func buildDummyNodeNetworkState() *nmstateV1alpha1.NodeNetworkState {
+	return &nmstateV1alpha1.NodeNetworkState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultNodeNetworkStateName,
+		},
+		Status: nmstateShared.NodeNetworkStateStatus{
+			CurrentState: nmstateShared.NewState(defaultNodeCurrentState),
+		},
+	}
+}
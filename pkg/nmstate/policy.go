@@ -2,15 +2,19 @@ package nmstate
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v2"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 
 	nmstateShared "github.com/nmstate/kubernetes-nmstate/api/shared"
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
+	nmstateV1alpha1 "github.com/nmstate/kubernetes-nmstate/api/v1alpha1"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
@@ -41,12 +45,12 @@ type PolicyBuilder struct {
 	// apiClient opens API connection to the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before the srIovPolicy object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewPolicyBuilder creates a new instance of PolicyBuilder.
 func NewPolicyBuilder(apiClient *clients.Settings, name string, nodeSelector map[string]string) *PolicyBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new NodeNetworkConfigurationPolicy structure with the following params: %s", name)
 
 	builder := PolicyBuilder{
@@ -61,15 +65,16 @@ func NewPolicyBuilder(apiClient *clients.Settings, name string, nodeSelector map
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the NodeNetworkConfigurationPolicy is empty")
+		logging.V(100).Infof("The name of the NodeNetworkConfigurationPolicy is empty")
 
-		builder.errorMsg = "NodeNetworkConfigurationPolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NodeNetworkConfigurationPolicy 'name' cannot be empty"))
 	}
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("The nodeSelector of the NodeNetworkConfigurationPolicy is empty")
+		logging.V(100).Infof("The nodeSelector of the NodeNetworkConfigurationPolicy is empty")
 
-		builder.errorMsg = "NodeNetworkConfigurationPolicy 'nodeSelector' cannot be empty map"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"NodeNetworkConfigurationPolicy 'nodeSelector' cannot be empty map"))
 	}
 
 	return &builder
@@ -81,7 +86,7 @@ func (builder *PolicyBuilder) Get() (*nmstateV1.NodeNetworkConfigurationPolicy,
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting NodeNetworkConfigurationPolicy object %s", builder.Definition.Name)
 
 	nmstatePolicy := &nmstateV1.NodeNetworkConfigurationPolicy{}
@@ -91,7 +96,7 @@ func (builder *PolicyBuilder) Get() (*nmstateV1.NodeNetworkConfigurationPolicy,
 	}, nmstatePolicy)
 
 	if err != nil {
-		glog.V(100).Infof("NodeNetworkConfigurationPolicy object %s doesn't exist", builder.Definition.Name)
+		logging.V(100).Infof("NodeNetworkConfigurationPolicy object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
 	}
@@ -105,7 +110,7 @@ func (builder *PolicyBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if NodeNetworkConfigurationPolicy %s exists",
 		builder.Definition.Name)
 
@@ -121,7 +126,7 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the NodeNetworkConfigurationPolicy %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating the NodeNetworkConfigurationPolicy %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -140,7 +145,7 @@ func (builder *PolicyBuilder) Delete() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the NodeNetworkConfigurationPolicy object %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the NodeNetworkConfigurationPolicy object %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("NodeNetworkConfigurationPolicy cannot be deleted because it does not exist")
@@ -164,7 +169,7 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the NodeNetworkConfigurationPolicy object %s",
+	logging.V(100).Infof("Updating the NodeNetworkConfigurationPolicy object %s",
 		builder.Definition.Name,
 	)
 
@@ -172,13 +177,13 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("NodeNetworkConfigurationPolicy", builder.Definition.Name))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("NodeNetworkConfigurationPolicy", builder.Definition.Name))
 
 				return nil, err
@@ -194,19 +199,19 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 // WithInterfaceAndVFs adds SR-IOV VF configuration to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) WithInterfaceAndVFs(sriovInterface string, numberOfVF uint8) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating NodeNetworkConfigurationPolicy %s with SR-IOV VF configuration: %d",
 		builder.Definition.Name, numberOfVF)
 
 	if sriovInterface == "" {
-		glog.V(100).Infof("The sriovInterface  can not be empty string")
+		logging.V(100).Infof("The sriovInterface  can not be empty string")
 
-		builder.errorMsg = "The sriovInterface is empty sting"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The sriovInterface is empty sting"))
 
 		return builder
 	}
@@ -227,27 +232,27 @@ func (builder *PolicyBuilder) WithInterfaceAndVFs(sriovInterface string, numberO
 // WithBondInterface adds Bond interface configuration to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) WithBondInterface(slavePorts []string, bondName, mode string) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 		return builder
 	}
 
-	glog.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with Bond interface configuration:"+
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with Bond interface configuration:"+
 		" BondName %s, Mode %s, SlavePorts %v", builder.Definition.Name, bondName, mode, slavePorts)
 
 	if !slices.Contains(allowedBondModes, mode) {
-		glog.V(100).Infof("error to add Bond mode %s, allowed modes are %v", mode, allowedBondModes)
+		logging.V(100).Infof("error to add Bond mode %s, allowed modes are %v", mode, allowedBondModes)
 
-		builder.errorMsg = "invalid Bond mode parameter"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid Bond mode parameter"))
 	}
 
 	if bondName == "" {
-		glog.V(100).Infof("The bondName can not be empty string")
+		logging.V(100).Infof("The bondName can not be empty string")
 
-		builder.errorMsg = "The bondName is empty sting"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The bondName is empty sting"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -270,20 +275,22 @@ func (builder *PolicyBuilder) WithVlanInterface(baseInterface string, vlanID uin
 		return builder
 	}
 
-	glog.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with VLAN interface %s and vlanID %d",
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with VLAN interface %s and vlanID %d",
 		builder.Definition.Name, baseInterface, vlanID)
 
 	if baseInterface == "" {
-		glog.V(100).Infof("The baseInterface can not be empty string")
+		logging.V(100).Infof("The baseInterface can not be empty string")
 
-		builder.errorMsg = "nodenetworkconfigurationpolicy 'baseInterface' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy 'baseInterface' cannot be empty"))
 	}
 
 	if vlanID > 4094 {
-		builder.errorMsg = "invalid vlanID, allowed vlanID values are between 0-4094"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid vlanID, allowed vlanID values are between 0-4094"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -306,16 +313,17 @@ func (builder *PolicyBuilder) WithAbsentInterface(interfaceName string) *PolicyB
 		return builder
 	}
 
-	glog.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with absent interface configuration:"+
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with absent interface configuration:"+
 		" interface %s", builder.Definition.Name, interfaceName)
 
 	if interfaceName == "" {
-		glog.V(100).Infof("The interfaceName can not be empty string")
+		logging.V(100).Infof("The interfaceName can not be empty string")
 
-		builder.errorMsg = "nodenetworkconfigurationpolicy 'interfaceName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy 'interfaceName' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -327,22 +335,199 @@ func (builder *PolicyBuilder) WithAbsentInterface(interfaceName string) *PolicyB
 	return builder.withInterface(newInterface)
 }
 
+// WithBondInterfaceOptions adds Bond interface configuration with the given link aggregation options to the
+// NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) WithBondInterfaceOptions(
+	slavePorts []string, bondName, mode string, options OptionsLinkAggregation) *PolicyBuilder {
+	builder = builder.WithBondInterface(slavePorts, bondName, mode)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting options %v on Bond interface %s of NodeNetworkConfigurationPolicy %s",
+		options, bondName, builder.Definition.Name)
+
+	return builder.updateDesiredState(func(desiredState *DesiredState) error {
+		networkInterface, err := findInterface(desiredState, bondName)
+		if err != nil {
+			return err
+		}
+
+		networkInterface.LinkAggregation.Options = options
+
+		return nil
+	})
+}
+
+// WithBridgeInterface adds linux-bridge interface configuration with the given ports to the
+// NodeNetworkConfigurationPolicy. STP is disabled on the bridge.
+func (builder *PolicyBuilder) WithBridgeInterface(bridgeName string, ports []string) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with bridge interface %s and ports %v",
+		builder.Definition.Name, bridgeName, ports)
+
+	if bridgeName == "" {
+		logging.V(100).Infof("The bridgeName can not be empty string")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy 'bridgeName' cannot be empty"))
+
+		return builder
+	}
+
+	var bridgePorts []map[string]string
+	for _, port := range ports {
+		bridgePorts = append(bridgePorts, map[string]string{"name": port})
+	}
+
+	newInterface := NetworkInterface{
+		Name:  bridgeName,
+		Type:  "linux-bridge",
+		State: "up",
+		Bridge: Bridge{
+			Options: BridgeOptions{Stp: Stp{Enabled: false}},
+			Port:    bridgePorts,
+		},
+	}
+
+	return builder.withInterface(newInterface)
+}
+
+// WithEthtoolFeatures adds ethernet interface configuration with the given ethtool features to the
+// NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) WithEthtoolFeatures(interfaceName string, features map[string]bool) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with ethtool features %v on interface %s",
+		builder.Definition.Name, features, interfaceName)
+
+	if interfaceName == "" {
+		logging.V(100).Infof("The interfaceName can not be empty string")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy 'interfaceName' cannot be empty"))
+	}
+
+	if len(features) == 0 {
+		logging.V(100).Infof("The ethtool features can not be empty map")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy 'features' cannot be empty map"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	newInterface := NetworkInterface{
+		Name:    interfaceName,
+		Type:    "ethernet",
+		State:   "up",
+		Ethtool: Ethtool{Feature: features},
+	}
+
+	return builder.withInterface(newInterface)
+}
+
+// WithStaticRoute adds a static route to the NodeNetworkConfigurationPolicy. The destination must be a CIDR
+// and the nextHopAddress an IP address of the same family.
+func (builder *PolicyBuilder) WithStaticRoute(destination, nextHopAddress, nextHopInterface string) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with static route to %s via %s dev %s",
+		builder.Definition.Name, destination, nextHopAddress, nextHopInterface)
+
+	_, destinationNet, err := net.ParseCIDR(destination)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy route destination %s is not a valid CIDR", destination))
+
+		return builder
+	}
+
+	nextHop := net.ParseIP(nextHopAddress)
+	if nextHop == nil || (nextHop.To4() == nil) != (destinationNet.IP.To4() == nil) {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy route next hop %s is not a valid IP address of the destination family",
+			nextHopAddress))
+
+		return builder
+	}
+
+	if nextHopInterface == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy 'nextHopInterface' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.updateDesiredState(func(desiredState *DesiredState) error {
+		desiredState.Routes.Config = append(desiredState.Routes.Config, Route{
+			Destination:      destination,
+			NextHopAddress:   nextHopAddress,
+			NextHopInterface: nextHopInterface,
+		})
+
+		return nil
+	})
+}
+
+// WithDNSResolver sets the DNS servers and search domains of the NodeNetworkConfigurationPolicy.
+func (builder *PolicyBuilder) WithDNSResolver(servers, searchDomains []string) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with DNS servers %v and search domains %v",
+		builder.Definition.Name, servers, searchDomains)
+
+	if len(servers) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"nodenetworkconfigurationpolicy 'servers' cannot be empty list"))
+
+		return builder
+	}
+
+	for _, server := range servers {
+		if net.ParseIP(server) == nil {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"nodenetworkconfigurationpolicy DNS server %s is not a valid IP address", server))
+
+			return builder
+		}
+	}
+
+	return builder.updateDesiredState(func(desiredState *DesiredState) error {
+		desiredState.DNSResolver.Config = DNSConfig{Server: servers, Search: searchDomains}
+
+		return nil
+	})
+}
+
 // WithOptions creates pod with generic mutation options.
 func (builder *PolicyBuilder) WithOptions(options ...AdditionalOptions) *PolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting pod additional options")
+	logging.V(100).Infof("Setting pod additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -359,7 +544,7 @@ func (builder *PolicyBuilder) WaitUntilCondition(condition nmstateShared.Conditi
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until NodeNetworkConfigurationPolicy %s has condition %v",
+	logging.V(100).Infof("Waiting for the defined period until NodeNetworkConfigurationPolicy %s has condition %v",
 		builder.Definition.Name, condition)
 
 	if !builder.Exists() {
@@ -387,33 +572,79 @@ func (builder *PolicyBuilder) WaitUntilCondition(condition nmstateShared.Conditi
 		})
 }
 
+// WaitUntilConfigured waits for the duration of the defined timeout or until the NodeNetworkConfigurationPolicy
+// is Available on every node it selects. The NodeNetworkConfigurationEnactments of the policy are inspected per
+// node and the wait stops early with the failure reason when one of them fails or is aborted.
+func (builder *PolicyBuilder) WaitUntilConfigured(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until NodeNetworkConfigurationPolicy %s is configured",
+		builder.Definition.Name)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for NodeNetworkConfigurationPolicy %s to be configured because it does not exist",
+			builder.Definition.Name)
+	}
+
+	var enactmentErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			enactments := &nmstateV1alpha1.NodeNetworkConfigurationEnactmentList{}
+
+			err := builder.apiClient.List(ctx, enactments,
+				goclient.MatchingLabels{nmstateShared.EnactmentPolicyLabel: builder.Definition.Name})
+			if err != nil {
+				logging.V(100).Infof("Failed to list NodeNetworkConfigurationEnactments: %v", err)
+
+				return false, nil
+			}
+
+			var configured bool
+
+			configured, enactmentErr = enactmentsConfigured(enactments.Items)
+
+			return configured || enactmentErr != nil, nil
+		})
+
+	if enactmentErr != nil {
+		return enactmentErr
+	}
+
+	return err
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicyBuilder) validate() (bool, error) {
 	resourceCRD := "NodeNetworkConfigurationPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -422,34 +653,48 @@ func (builder *PolicyBuilder) validate() (bool, error) {
 // withInterface adds given network interface to the NodeNetworkConfigurationPolicy.
 func (builder *PolicyBuilder) withInterface(networkInterface NetworkInterface) *PolicyBuilder {
 	if valid, err := builder.validate(); !valid {
-		builder.errorMsg = err.Error()
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 		return builder
 	}
 
-	glog.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with network interface %s",
+	logging.V(100).Infof("Creating NodeNetworkConfigurationPolicy %s with network interface %s",
 		builder.Definition.Name, networkInterface.Name)
 
+	return builder.updateDesiredState(func(desiredState *DesiredState) error {
+		desiredState.Interfaces = append(desiredState.Interfaces, networkInterface)
+
+		return nil
+	})
+}
+
+// updateDesiredState unmarshals the desiredState of the NodeNetworkConfigurationPolicy, applies the given mutation
+// to it and marshals it back into the definition.
+func (builder *PolicyBuilder) updateDesiredState(mutate func(desiredState *DesiredState) error) *PolicyBuilder {
 	var CurrentState DesiredState
 
 	err := yaml.Unmarshal(builder.Definition.Spec.DesiredState.Raw, &CurrentState)
 
 	if err != nil {
-		glog.V(100).Infof("Failed Unmarshal DesiredState")
+		logging.V(100).Infof("Failed Unmarshal DesiredState")
 
-		builder.errorMsg = "Failed Unmarshal DesiredState"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Failed Unmarshal DesiredState"))
 
 		return builder
 	}
 
-	CurrentState.Interfaces = append(CurrentState.Interfaces, networkInterface)
+	if err := mutate(&CurrentState); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
 
 	desiredStateYaml, err := yaml.Marshal(CurrentState)
 
 	if err != nil {
-		glog.V(100).Infof("Failed Marshal DesiredState")
+		logging.V(100).Infof("Failed Marshal DesiredState")
 
-		builder.errorMsg = "failed to Marshal a new Desired state"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to Marshal a new Desired state"))
 
 		return builder
 	}
@@ -458,3 +703,68 @@ func (builder *PolicyBuilder) withInterface(networkInterface NetworkInterface) *
 
 	return builder
 }
+
+// findInterface returns the interface with the given name from the desired state.
+func findInterface(desiredState *DesiredState, interfaceName string) (*NetworkInterface, error) {
+	for index := range desiredState.Interfaces {
+		if desiredState.Interfaces[index].Name == interfaceName {
+			return &desiredState.Interfaces[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find interface %s in desiredState", interfaceName)
+}
+
+// enactmentsConfigured returns true once every enactment is Available. An error describing the node and the
+// failure reason is returned as soon as one of the enactments is Failing or Aborted.
+func enactmentsConfigured(enactments []nmstateV1alpha1.NodeNetworkConfigurationEnactment) (bool, error) {
+	if len(enactments) == 0 {
+		return false, nil
+	}
+
+	configured := true
+
+	for _, enactment := range enactments {
+		nodeName := enactment.Labels[nmstateShared.EnactmentNodeLabel]
+		conditions := enactment.Status.Conditions
+
+		for _, conditionType := range []nmstateShared.ConditionType{
+			nmstateShared.NodeNetworkConfigurationEnactmentConditionFailing,
+			nmstateShared.NodeNetworkConfigurationEnactmentConditionAborted,
+		} {
+			condition := conditions.Find(conditionType)
+			if condition != nil && condition.Status == corev1.ConditionTrue {
+				return false, fmt.Errorf("NodeNetworkConfigurationEnactment %s on node %s is %s: %s",
+					enactment.Name, nodeName, conditionType, extractEnactmentFailureReason(condition))
+			}
+		}
+
+		available := conditions.Find(nmstateShared.NodeNetworkConfigurationEnactmentConditionAvailable)
+		if available == nil || available.Status != corev1.ConditionTrue {
+			logging.V(100).Infof("NodeNetworkConfigurationEnactment %s on node %s is not Available yet",
+				enactment.Name, nodeName)
+
+			configured = false
+		}
+	}
+
+	return configured, nil
+}
+
+// extractEnactmentFailureReason returns the most relevant line of the enactment condition message. The nmstate
+// handler reports the whole nmstatectl output, in which the last line mentioning an error carries the reason.
+func extractEnactmentFailureReason(condition *nmstateShared.Condition) string {
+	lines := strings.Split(strings.TrimSpace(condition.Message), "\n")
+
+	for index := len(lines) - 1; index >= 0; index-- {
+		if strings.Contains(strings.ToLower(lines[index]), "error") {
+			return strings.TrimSpace(lines[index])
+		}
+	}
+
+	if condition.Message == "" {
+		return string(condition.Reason)
+	}
+
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,301 @@
+package nmstate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nmstateShared "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
+	nmstateV1alpha1 "github.com/nmstate/kubernetes-nmstate/api/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultPolicyName         = "nncp-test"
+	defaultPolicyNodeSelector = map[string]string{"node-role.kubernetes.io/worker": ""}
+)
+
+func TestPolicyWithBondInterfaceOptions(t *testing.T) {
+	testCases := []struct {
+		bondName          string
+		mode              string
+		expectedErrorText string
+	}{
+		{
+			bondName:          "bond0",
+			mode:              "active-backup",
+			expectedErrorText: "",
+		},
+		{
+			bondName:          "bond0",
+			mode:              "invalid",
+			expectedErrorText: "invalid Bond mode parameter",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithBondInterfaceOptions([]string{"eth1", "eth2"}, testCase.bondName, testCase.mode,
+				OptionsLinkAggregation{Primary: "eth1", Miimon: 100})
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testBuilder.errorMsg) {
+			continue
+		}
+
+		desiredState := getTestDesiredState(t, testBuilder)
+		assert.Len(t, desiredState.Interfaces, 1)
+		assert.Equal(t, LinkAggregation{
+			Mode:    testCase.mode,
+			Options: OptionsLinkAggregation{Primary: "eth1", Miimon: 100},
+			Port:    []string{"eth1", "eth2"},
+		}, desiredState.Interfaces[0].LinkAggregation)
+	}
+}
+
+func TestPolicyWithBridgeInterface(t *testing.T) {
+	testCases := []struct {
+		bridgeName        string
+		expectedErrorText string
+	}{
+		{
+			bridgeName:        "br1",
+			expectedErrorText: "",
+		},
+		{
+			bridgeName:        "",
+			expectedErrorText: "nodenetworkconfigurationpolicy 'bridgeName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithBridgeInterface(testCase.bridgeName, []string{"eth1"})
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testBuilder.errorMsg) {
+			continue
+		}
+
+		desiredState := getTestDesiredState(t, testBuilder)
+		assert.Equal(t, "linux-bridge", desiredState.Interfaces[0].Type)
+		assert.Equal(t, []map[string]string{{"name": "eth1"}}, desiredState.Interfaces[0].Bridge.Port)
+	}
+}
+
+func TestPolicyWithEthtoolFeatures(t *testing.T) {
+	testCases := []struct {
+		interfaceName     string
+		features          map[string]bool
+		expectedErrorText string
+	}{
+		{
+			interfaceName:     "eth1",
+			features:          map[string]bool{"rx-checksum": false},
+			expectedErrorText: "",
+		},
+		{
+			interfaceName:     "",
+			features:          map[string]bool{"rx-checksum": false},
+			expectedErrorText: "nodenetworkconfigurationpolicy 'interfaceName' cannot be empty",
+		},
+		{
+			interfaceName:     "eth1",
+			features:          map[string]bool{},
+			expectedErrorText: "nodenetworkconfigurationpolicy 'features' cannot be empty map",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithEthtoolFeatures(testCase.interfaceName, testCase.features)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.features, getTestDesiredState(t, testBuilder).Interfaces[0].Ethtool.Feature)
+	}
+}
+
+func TestPolicyWithStaticRoute(t *testing.T) {
+	testCases := []struct {
+		destination       string
+		nextHopAddress    string
+		nextHopInterface  string
+		expectedErrorText string
+	}{
+		{
+			destination:       "192.168.100.0/24",
+			nextHopAddress:    "192.168.1.1",
+			nextHopInterface:  "eth1",
+			expectedErrorText: "",
+		},
+		{
+			destination:       "2001:db8::/64",
+			nextHopAddress:    "2001:db9::1",
+			nextHopInterface:  "eth1",
+			expectedErrorText: "",
+		},
+		{
+			destination:       "192.168.100.0",
+			nextHopAddress:    "192.168.1.1",
+			nextHopInterface:  "eth1",
+			expectedErrorText: "nodenetworkconfigurationpolicy route destination 192.168.100.0 is not a valid CIDR",
+		},
+		{
+			destination:      "192.168.100.0/24",
+			nextHopAddress:   "2001:db9::1",
+			nextHopInterface: "eth1",
+			expectedErrorText: "nodenetworkconfigurationpolicy route next hop 2001:db9::1 is not a valid IP address " +
+				"of the destination family",
+		},
+		{
+			destination:       "192.168.100.0/24",
+			nextHopAddress:    "192.168.1.1",
+			nextHopInterface:  "",
+			expectedErrorText: "nodenetworkconfigurationpolicy 'nextHopInterface' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithStaticRoute(testCase.destination, testCase.nextHopAddress, testCase.nextHopInterface)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, []Route{{
+			Destination:      testCase.destination,
+			NextHopAddress:   testCase.nextHopAddress,
+			NextHopInterface: testCase.nextHopInterface,
+		}}, getTestDesiredState(t, testBuilder).Routes.Config)
+	}
+}
+
+func TestPolicyWithDNSResolver(t *testing.T) {
+	testCases := []struct {
+		servers           []string
+		expectedErrorText string
+	}{
+		{
+			servers:           []string{"10.10.10.10", "2001:db8::10"},
+			expectedErrorText: "",
+		},
+		{
+			servers:           []string{},
+			expectedErrorText: "nodenetworkconfigurationpolicy 'servers' cannot be empty list",
+		},
+		{
+			servers:           []string{"dns.example.com"},
+			expectedErrorText: "nodenetworkconfigurationpolicy DNS server dns.example.com is not a valid IP address",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithVlanInterface("eth1", 100).
+			WithDNSResolver(testCase.servers, []string{"example.com"})
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testBuilder.errorMsg) {
+			continue
+		}
+
+		desiredState := getTestDesiredState(t, testBuilder)
+		assert.Len(t, desiredState.Interfaces, 1)
+		assert.Equal(t, DNSConfig{Server: testCase.servers, Search: []string{"example.com"}},
+			desiredState.DNSResolver.Config)
+	}
+}
+
+func TestPolicyWaitUntilConfigured(t *testing.T) {
+	testCases := []struct {
+		enactments        []runtime.Object
+		expectedErrorText string
+	}{
+		{
+			enactments: []runtime.Object{
+				buildDummyEnactment("worker-0", nmstateShared.NodeNetworkConfigurationEnactmentConditionAvailable, ""),
+				buildDummyEnactment("worker-1", nmstateShared.NodeNetworkConfigurationEnactmentConditionAvailable, ""),
+			},
+			expectedErrorText: "",
+		},
+		{
+			enactments: []runtime.Object{
+				buildDummyEnactment("worker-0", nmstateShared.NodeNetworkConfigurationEnactmentConditionAvailable, ""),
+				buildDummyEnactment("worker-1", nmstateShared.NodeNetworkConfigurationEnactmentConditionFailing,
+					"error reconciling NodeNetworkConfigurationPolicy\n"+
+						"libnmstate.error.NmstateVerificationError: desired bond0 does not match current\n"+
+						"current: ..."),
+			},
+			expectedErrorText: "NodeNetworkConfigurationEnactment worker-1.nncp-test on node worker-1 is Failing: " +
+				"libnmstate.error.NmstateVerificationError: desired bond0 does not match current",
+		},
+		{
+			enactments: []runtime.Object{
+				buildDummyEnactment("worker-0", nmstateShared.NodeNetworkConfigurationEnactmentConditionProgressing, ""),
+			},
+			expectedErrorText: context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: append(testCase.enactments, buildDummyPolicy()),
+		})
+
+		err := buildValidPolicyTestBuilder(testSettings).WaitUntilConfigured(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, err)
+	}
+}
+
+func buildValidPolicyTestBuilder(apiClient *clients.Settings) *PolicyBuilder {
+	return NewPolicyBuilder(apiClient, defaultPolicyName, defaultPolicyNodeSelector)
+}
+
+func buildDummyPolicy() *nmstateV1.NodeNetworkConfigurationPolicy {
+	return &nmstateV1.NodeNetworkConfigurationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultPolicyName,
+		},
+	}
+}
+
+func buildDummyEnactment(
+	nodeName string,
+	conditionType nmstateShared.ConditionType,
+	message string) *nmstateV1alpha1.NodeNetworkConfigurationEnactment {
+	return &nmstateV1alpha1.NodeNetworkConfigurationEnactment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nmstateShared.EnactmentKey(nodeName, defaultPolicyName).Name,
+			Labels: map[string]string{
+				nmstateShared.EnactmentPolicyLabel: defaultPolicyName,
+				nmstateShared.EnactmentNodeLabel:   nodeName,
+			},
+		},
+		Status: nmstateShared.NodeNetworkConfigurationEnactmentStatus{
+			Conditions: nmstateShared.ConditionList{{
+				Type:    conditionType,
+				Status:  corev1.ConditionTrue,
+				Message: message,
+			}},
+		},
+	}
+}
+
+func getTestDesiredState(t *testing.T, builder *PolicyBuilder) DesiredState {
+	t.Helper()
+
+	var desiredState DesiredState
+
+	err := yaml.Unmarshal(builder.Definition.Spec.DesiredState.Raw, &desiredState)
+	assert.Nil(t, err)
+
+	return desiredState
+}
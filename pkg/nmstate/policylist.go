@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -16,7 +16,7 @@ func ListPolicy(apiClient *clients.Settings, options ...goclient.ListOptions) ([
 	logMessage := "Listing NodeNetworkConfigurationPolicy"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -26,13 +26,13 @@ func ListPolicy(apiClient *clients.Settings, options ...goclient.ListOptions) ([
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	policyList := &nmstateV1.NodeNetworkConfigurationPolicyList{}
 	err := apiClient.Client.List(context.TODO(), policyList, &passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list NodeNetworkConfigurationPolicy due to %s", err.Error())
+		logging.V(100).Infof("Failed to list NodeNetworkConfigurationPolicy due to %s", err.Error())
 
 		return nil, err
 	}
@@ -54,11 +54,11 @@ func ListPolicy(apiClient *clients.Settings, options ...goclient.ListOptions) ([
 
 // CleanAllNMStatePolicies removes all NodeNetworkConfigurationPolicies.
 func CleanAllNMStatePolicies(apiClient *clients.Settings, options ...goclient.ListOptions) error {
-	glog.V(100).Infof("Cleaning up NodeNetworkConfigurationPolicies")
+	logging.V(100).Infof("Cleaning up NodeNetworkConfigurationPolicies")
 
 	nncpList, err := ListPolicy(apiClient, options...)
 	if err != nil {
-		glog.V(100).Infof("Failed to list NodeNetworkConfigurationPolicies")
+		logging.V(100).Infof("Failed to list NodeNetworkConfigurationPolicies")
 
 		return err
 	}
@@ -66,7 +66,7 @@ func CleanAllNMStatePolicies(apiClient *clients.Settings, options ...goclient.Li
 	for _, nncpPolicy := range nncpList {
 		_, err = nncpPolicy.Delete()
 		if err != nil {
-			glog.V(100).Infof("Failed to delete NodeNetworkConfigurationPolicy: %s", nncpPolicy.Object.Name)
+			logging.V(100).Infof("Failed to delete NodeNetworkConfigurationPolicy: %s", nncpPolicy.Object.Name)
 
 			return err
 		}
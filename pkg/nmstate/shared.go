@@ -2,7 +2,9 @@ package nmstate
 
 // DesiredState provides struct for the NMState desired state object containing all NMState configuration.
 type DesiredState struct {
-	Interfaces []NetworkInterface `yaml:"interfaces,omitempty"`
+	Interfaces  []NetworkInterface `yaml:"interfaces,omitempty"`
+	Routes      Routes             `yaml:"routes,omitempty"`
+	DNSResolver DNSResolver        `yaml:"dns-resolver,omitempty"`
 }
 
 // NetworkInterface provides struct for the NMState interface state object containing interface information.
@@ -14,6 +16,7 @@ type NetworkInterface struct {
 	Bridge          Bridge          `yaml:"bridge,omitempty"`
 	LinkAggregation LinkAggregation `yaml:"link-aggregation,omitempty"`
 	Vlan            Vlan            `yaml:"vlan,omitempty"`
+	Ethtool         Ethtool         `yaml:"ethtool,omitempty"`
 }
 
 // Ethernet provides struct for the NMState Interface Ethernet state object containing interface Ethernet information.
@@ -43,7 +46,19 @@ type Vf struct {
 // Bridge provides struct for the NMState Interface Ethernet Bridge state object
 // containing interface Bridge information.
 type Bridge struct {
-	Port []map[string]string `yaml:"port,omitempty"`
+	Options BridgeOptions       `yaml:"options,omitempty"`
+	Port    []map[string]string `yaml:"port,omitempty"`
+}
+
+// BridgeOptions provides struct for the NMState Interface Bridge Options state object
+// containing interface Bridge options information.
+type BridgeOptions struct {
+	Stp Stp `yaml:"stp,omitempty"`
+}
+
+// Stp provides struct for the NMState Interface Bridge STP state object containing interface Bridge STP information.
+type Stp struct {
+	Enabled bool `yaml:"enabled"`
 }
 
 // LinkAggregation provides struct for the NMState Interface Ethernet LinkAggregation state object
@@ -52,6 +67,8 @@ type LinkAggregation struct {
 	Mode    string                 `yaml:"mode"`
 	Options OptionsLinkAggregation `yaml:"options,omitempty"`
 	Port    []string               `yaml:"port,omitempty"`
+	// Slaves is reported instead of Port by the NMState releases older than 2.0.
+	Slaves []string `yaml:"slaves,omitempty"`
 }
 
 // OptionsLinkAggregation provides struct for the NMState Interface Ethernet LinkAggregation Options state object
@@ -68,3 +85,45 @@ type Vlan struct {
 	BaseIface string `yaml:"base-iface"`
 	ID        int    `yaml:"id"`
 }
+
+// Ethtool provides struct for the NMState Interface Ethtool state object containing interface Ethtool information.
+type Ethtool struct {
+	Feature map[string]bool `yaml:"feature,omitempty"`
+	Ring    EthtoolRing     `yaml:"ring,omitempty"`
+}
+
+// EthtoolRing provides struct for the NMState Interface Ethtool Ring state object
+// containing interface ring buffer sizes.
+type EthtoolRing struct {
+	Rx *int `yaml:"rx,omitempty"`
+	Tx *int `yaml:"tx,omitempty"`
+}
+
+// Routes provides struct for the NMState routes state object containing routes information.
+type Routes struct {
+	Config  []Route `yaml:"config,omitempty"`
+	Running []Route `yaml:"running,omitempty"`
+}
+
+// Route provides struct for the NMState route state object containing route information.
+type Route struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address,omitempty"`
+	NextHopInterface string `yaml:"next-hop-interface,omitempty"`
+	Metric           *int   `yaml:"metric,omitempty"`
+	TableID          *int   `yaml:"table-id,omitempty"`
+	State            string `yaml:"state,omitempty"`
+}
+
+// DNSResolver provides struct for the NMState DNS resolver state object containing DNS resolver information.
+type DNSResolver struct {
+	Config  DNSConfig `yaml:"config,omitempty"`
+	Running DNSConfig `yaml:"running,omitempty"`
+}
+
+// DNSConfig provides struct for the NMState DNS resolver config state object containing DNS servers and
+// search domains.
+type DNSConfig struct {
+	Search []string `yaml:"search,omitempty"`
+	Server []string `yaml:"server,omitempty"`
+}
@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/strings/slices"
 )
@@ -22,7 +23,7 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 	logMessage := "Listing all node resources"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -32,11 +33,11 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	nodeList, err := apiClient.CoreV1Interface.Nodes().List(context.TODO(), passedOptions)
 	if err != nil {
-		glog.V(100).Infof("Failed to list nodes due to %s", err.Error())
+		logging.V(100).Infof("Failed to list nodes due to %s", err.Error())
 
 		return nil, err
 	}
@@ -57,9 +58,22 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 	return nodeObjects, nil
 }
 
+// ListByLabelSelector returns the nodes matching all the given labels.
+func ListByLabelSelector(apiClient *clients.Settings, nodeLabels map[string]string) ([]*Builder, error) {
+	logging.V(100).Infof("Listing nodes with labels %v", nodeLabels)
+
+	if len(nodeLabels) == 0 {
+		logging.V(100).Infof("The nodeLabels are empty")
+
+		return nil, fmt.Errorf("'nodeLabels' cannot be empty")
+	}
+
+	return List(apiClient, v1.ListOptions{LabelSelector: labels.SelectorFromSet(nodeLabels).String()})
+}
+
 // ListExternalIPv4Networks returns a list of node's external ipv4 addresses.
 func ListExternalIPv4Networks(apiClient *clients.Settings, options ...v1.ListOptions) ([]string, error) {
-	glog.V(100).Infof("Collecting node's external ipv4 addresses")
+	logging.V(100).Infof("Collecting node's external ipv4 addresses")
 
 	var ipV4ExternalAddresses []string
 
@@ -71,7 +85,7 @@ func ListExternalIPv4Networks(apiClient *clients.Settings, options ...v1.ListOpt
 	for _, node := range nodeBuilders {
 		extNodeNetwork, err := node.ExternalIPv4Network()
 		if err != nil {
-			glog.V(100).Infof("Failed to collect external ip address from node %s", node.Object.Name)
+			logging.V(100).Infof("Failed to collect external ip address from node %s", node.Object.Name)
 
 			return nil, fmt.Errorf(
 				"error getting external IPv4 address from node %s due to %w", node.Definition.Name, err)
@@ -86,12 +100,12 @@ func ListExternalIPv4Networks(apiClient *clients.Settings, options ...v1.ListOpt
 func WaitForAllNodesAreReady(apiClient *clients.Settings,
 	timeout time.Duration,
 	options ...v1.ListOptions) (bool, error) {
-	glog.V(100).Infof("Waiting for all nodes to be in the Ready state for up to a duration of %v",
+	logging.V(100).Infof("Waiting for all nodes to be in the Ready state for up to a duration of %v",
 		timeout)
 
 	nodesList, err := List(apiClient, options...)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all nodes due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all nodes due to %s", err.Error())
 
 		return false, err
 	}
@@ -101,13 +115,13 @@ func WaitForAllNodesAreReady(apiClient *clients.Settings,
 			for _, node := range nodesList {
 				ready, err := node.IsReady()
 				if err != nil {
-					glog.V(100).Infof("Node %v has error %w", node.Object.Name, err)
+					logging.V(100).Infof("Node %v has error %v", node.Object.Name, err)
 
 					return false, err
 				}
 
 				if !ready {
-					glog.V(100).Infof("Node %s not Ready", node.Object.Name)
+					logging.V(100).Infof("Node %s not Ready", node.Object.Name)
 
 					return false, nil
 				}
@@ -117,14 +131,14 @@ func WaitForAllNodesAreReady(apiClient *clients.Settings,
 		})
 
 	if err == nil {
-		glog.V(100).Infof("All nodes were found in the Ready State during availableDuration: %v",
+		logging.V(100).Infof("All nodes were found in the Ready State during availableDuration: %v",
 			timeout)
 
 		return true, nil
 	}
 
 	// Here err is "timed out waiting for the condition"
-	glog.V(100).Infof("Not all nodes were found in the Ready State during availableDuration: %v",
+	logging.V(100).Infof("Not all nodes were found in the Ready State during availableDuration: %v",
 		err)
 
 	return false, err
@@ -134,11 +148,11 @@ func WaitForAllNodesAreReady(apiClient *clients.Settings,
 func WaitForAllNodesToReboot(apiClient *clients.Settings,
 	globalRebootTimeout time.Duration,
 	options ...v1.ListOptions) (bool, error) {
-	glog.V(100).Infof("Waiting for all nodes in the list to reboot and return to the Ready condition")
+	logging.V(100).Infof("Waiting for all nodes in the list to reboot and return to the Ready condition")
 
 	nodesList, err := List(apiClient, options...)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all nodes due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all nodes due to %s", err.Error())
 
 		return false, err
 	}
@@ -157,14 +171,14 @@ func WaitForAllNodesToReboot(apiClient *clients.Settings,
 
 					rebooted := slices.Contains(rebootedNodes, node.Object.Name)
 					if !ready && !rebooted {
-						glog.V(100).Infof("Node %s was rebooted and is starting to recover", node.Object.Name)
+						logging.V(100).Infof("Node %s was rebooted and is starting to recover", node.Object.Name)
 
 						rebootedNodes = append(rebootedNodes, node.Object.Name)
 					}
 
 					if ready && rebooted {
-						glog.V(100).Infof("Node %s was successfully rebooted after: %v",
-							time.Now().Unix()-globalStartTime)
+						logging.V(100).Infof("Node %s was successfully rebooted after: %v",
+							node.Object.Name, time.Now().Unix()-globalStartTime)
 
 						readyNodes = append(readyNodes, node.Object.Name)
 					}
@@ -176,12 +190,12 @@ func WaitForAllNodesToReboot(apiClient *clients.Settings,
 
 	if err == nil {
 		globalRebootDuration := time.Now().Unix() - globalStartTime
-		glog.V(100).Infof("All nodes were successfully rebooted during: %v", globalRebootDuration)
+		logging.V(100).Infof("All nodes were successfully rebooted during: %v", globalRebootDuration)
 
 		return true, nil
 	}
 
-	glog.V(100).Infof("Not all nodes were rebooted, timeout %v reached: %v", globalRebootTimeout, err)
+	logging.V(100).Infof("Not all nodes were rebooted, timeout %v reached: %v", globalRebootTimeout, err)
 
 	return false, err
 }
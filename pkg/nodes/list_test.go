@@ -0,0 +1,71 @@
+package nodes
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNodeListByLabelSelector(t *testing.T) {
+	testCases := []struct {
+		nodeLabels    map[string]string
+		expectedNodes []string
+		expectedError string
+	}{
+		{
+			nodeLabels:    map[string]string{"node-role.kubernetes.io/worker": ""},
+			expectedNodes: []string{"worker-0", "worker-1"},
+			expectedError: "",
+		},
+		{
+			nodeLabels:    map[string]string{"node-role.kubernetes.io/worker": "", "feature.node.kubernetes.io/sriov": "true"},
+			expectedNodes: []string{"worker-1"},
+			expectedError: "",
+		},
+		{
+			nodeLabels:    map[string]string{"node-role.kubernetes.io/infra": ""},
+			expectedNodes: nil,
+			expectedError: "",
+		},
+		{
+			nodeLabels:    nil,
+			expectedError: "'nodeLabels' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{
+				buildDummyListNode("master-0", map[string]string{"node-role.kubernetes.io/master": ""}),
+				buildDummyListNode("worker-0", map[string]string{"node-role.kubernetes.io/worker": ""}),
+				buildDummyListNode("worker-1", map[string]string{
+					"node-role.kubernetes.io/worker": "", "feature.node.kubernetes.io/sriov": "true"}),
+			},
+		})
+
+		nodeBuilders, err := ListByLabelSelector(testSettings, testCase.nodeLabels)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			var nodeNames []string
+
+			for _, nodeBuilder := range nodeBuilders {
+				nodeNames = append(nodeNames, nodeBuilder.Definition.Name)
+			}
+
+			assert.ElementsMatch(t, testCase.expectedNodes, nodeNames)
+		}
+	}
+}
+
+func buildDummyListNode(name string, labels map[string]string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
@@ -3,19 +3,22 @@ package nodes
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubectl/pkg/drain"
 )
@@ -29,7 +32,7 @@ type Builder struct {
 	Definition  *corev1.Node
 	Object      *corev1.Node
 	apiClient   kubernetes.Interface
-	errorMsg    string
+	errorMsg    error
 	drainHelper *drain.Helper
 }
 
@@ -42,7 +45,7 @@ func (builder *Builder) SetDrainHelper(
 	skipWaitForDeleteTimeoutSeconds int,
 	timeout time.Duration,
 ) {
-	glog.V(100).Infof("Creating new DrainOptions config")
+	logging.V(100).Infof("Creating new DrainOptions config")
 
 	msg := fmt.Sprintf("Node draining configuration: 'force': %v,", force)
 	msg += fmt.Sprintf(" 'gracePeriod': %d seconds,", gracePeriod)
@@ -51,7 +54,7 @@ func (builder *Builder) SetDrainHelper(
 	msg += fmt.Sprintf(" 'timeout': %v,", timeout)
 	msg += fmt.Sprintf(" 'deleteEmptyDir': %v", deleteLocalData)
 
-	glog.V(100).Infof(msg)
+	logging.V(100).Infof(msg)
 
 	builder.drainHelper = &drain.Helper{
 		Ctx:    context.TODO(),
@@ -74,6 +77,19 @@ func (builder *Builder) SetDrainHelper(
 	}
 }
 
+// SetDrainEviction defines whether drain evicts pods through the eviction API, honoring PodDisruptionBudgets, or
+// deletes them directly.
+func (builder *Builder) SetDrainEviction(useEviction bool) {
+	if valid, _ := builder.validate(); !valid {
+		return
+	}
+
+	builder.ensureDrainHelperIsSet()
+	logging.V(100).Infof("Setting drain of node %s to use eviction: %v", builder.Definition.Name, useEviction)
+
+	builder.drainHelper.DisableEviction = !useEviction
+}
+
 // Drain evicts or deletes all pods.
 func (builder *Builder) Drain() error {
 	if valid, err := builder.validate(); !valid {
@@ -81,7 +97,7 @@ func (builder *Builder) Drain() error {
 	}
 
 	builder.ensureDrainHelperIsSet()
-	glog.V(100).Infof("Draining node %s", builder.Definition.Name)
+	logging.V(100).Infof("Draining node %s", builder.Definition.Name)
 
 	return drain.RunNodeDrain(builder.drainHelper, builder.Definition.Name)
 }
@@ -93,7 +109,7 @@ func (builder *Builder) Cordon() error {
 	}
 
 	builder.ensureDrainHelperIsSet()
-	glog.V(100).Infof("Cordoning node %s", builder.Definition.Name)
+	logging.V(100).Infof("Cordoning node %s", builder.Definition.Name)
 
 	return drain.RunCordonOrUncordon(builder.drainHelper, builder.Definition, true)
 }
@@ -105,7 +121,7 @@ func (builder *Builder) Uncordon() error {
 	}
 
 	builder.ensureDrainHelperIsSet()
-	glog.V(100).Infof("Uncordoning node %s", builder.Definition.Name)
+	logging.V(100).Infof("Uncordoning node %s", builder.Definition.Name)
 
 	return drain.RunCordonOrUncordon(builder.drainHelper, builder.Definition, false)
 }
@@ -115,7 +131,7 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // Pull gathers existing node from cluster.
 func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing node object: %s", nodeName)
+	logging.V(100).Infof("Pulling existing node object: %s", nodeName)
 
 	builder := Builder{
 		apiClient: apiClient.K8sClient,
@@ -141,7 +157,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating configuration of node %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating configuration of node %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil, fmt.Errorf("node %s object doesn't exist", builder.Definition.Name)
@@ -163,7 +179,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if node %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if node %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.CoreV1().Nodes().Get(
@@ -178,7 +194,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the node %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the node %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return fmt.Errorf("node cannot be deleted because it does not exist")
@@ -204,14 +220,14 @@ func (builder *Builder) WithNewLabel(key, value string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding label %s=%s to node %s ", key, value, builder.Definition.Name)
+	logging.V(100).Infof("Adding label %s=%s to node %s ", key, value, builder.Definition.Name)
 
 	if key == "" {
-		glog.V(100).Infof("Failed to apply label with an empty key to node %s", builder.Definition.Name)
-		builder.errorMsg = "error to set empty key to node"
+		logging.V(100).Infof("Failed to apply label with an empty key to node %s", builder.Definition.Name)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to set empty key to node"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -222,7 +238,7 @@ func (builder *Builder) WithNewLabel(key, value string) *Builder {
 		if !labelExist {
 			builder.Definition.Labels[key] = value
 		} else {
-			builder.errorMsg = fmt.Sprintf("cannot overwrite existing node label: %s", key)
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot overwrite existing node label: %s", key))
 		}
 	}
 
@@ -235,16 +251,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting node additional options")
+	logging.V(100).Infof("Setting node additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -260,14 +276,14 @@ func (builder *Builder) RemoveLabel(key, value string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Removing label %s=%s from node %s", key, value, builder.Definition.Name)
+	logging.V(100).Infof("Removing label %s=%s from node %s", key, value, builder.Definition.Name)
 
 	if key == "" {
-		glog.V(100).Infof("Failed to remove empty label's key from node %s", builder.Definition.Name)
-		builder.errorMsg = "error to remove empty key from node"
+		logging.V(100).Infof("Failed to remove empty label's key from node %s", builder.Definition.Name)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to remove empty key from node"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -276,20 +292,89 @@ func (builder *Builder) RemoveLabel(key, value string) *Builder {
 	return builder
 }
 
+// WithNewTaint adds the given taint to the Node spec. A taint with the same key and effect cannot be overwritten.
+func (builder *Builder) WithNewTaint(taint corev1.Taint) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding taint %s to node %s", taint.ToString(), builder.Definition.Name)
+
+	if taint.Key == "" {
+		logging.V(100).Infof("Failed to apply taint with an empty key to node %s", builder.Definition.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to set taint with empty key to node"))
+
+		return builder
+	}
+
+	if taint.Effect == "" {
+		logging.V(100).Infof("Failed to apply taint with an empty effect to node %s", builder.Definition.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to set taint with empty effect to node"))
+
+		return builder
+	}
+
+	for _, existingTaint := range builder.Definition.Spec.Taints {
+		if existingTaint.MatchTaint(&taint) {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("cannot overwrite existing node taint: %s", existingTaint.ToString()))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Taints = append(builder.Definition.Spec.Taints, taint)
+
+	return builder
+}
+
+// RemoveTaint removes the taint with the given key and effect from the Node spec.
+func (builder *Builder) RemoveTaint(key string, effect corev1.TaintEffect) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Removing taint %s:%s from node %s", key, effect, builder.Definition.Name)
+
+	if key == "" {
+		logging.V(100).Infof("Failed to remove taint with an empty key from node %s", builder.Definition.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to remove taint with empty key from node"))
+
+		return builder
+	}
+
+	taintToRemove := corev1.Taint{Key: key, Effect: effect}
+
+	var taints []corev1.Taint
+
+	for _, taint := range builder.Definition.Spec.Taints {
+		if !taint.MatchTaint(&taintToRemove) {
+			taints = append(taints, taint)
+		}
+	}
+
+	builder.Definition.Spec.Taints = taints
+
+	return builder
+}
+
 // ExternalIPv4Network returns nodes external ip address.
 func (builder *Builder) ExternalIPv4Network() (string, error) {
 	if valid, err := builder.validate(); !valid {
 		return "", err
 	}
 
-	glog.V(100).Infof("Collecting node's external ipv4 addresses")
+	logging.V(100).Infof("Collecting node's external ipv4 addresses")
 
 	if builder.Object == nil {
-		builder.errorMsg = "error to collect external networks from node"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("error to collect external networks from node"))
 	}
 
-	if builder.errorMsg != "" {
-		return "", fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return "", builder.errorMsg
 	}
 
 	var extNetwork ExternalNetworks
@@ -309,7 +394,7 @@ func (builder *Builder) IsReady() (bool, error) {
 		return false, err
 	}
 
-	glog.V(100).Infof("Verify %s node availability", builder.Definition.Name)
+	logging.V(100).Infof("Verify %s node availability", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return false, fmt.Errorf("%s node object doesn't exist", builder.Definition.Name)
@@ -396,33 +481,178 @@ func (builder *Builder) WaitUntilNotReady(timeout time.Duration) error {
 	return builder.WaitUntilConditionUnknown(corev1.NodeReady, timeout)
 }
 
+// GetReservedResources returns, per resource, the difference between the capacity and the allocatable amount of the
+// node, i.e. the amount reserved for the system and kubelet.
+func (builder *Builder) GetReservedResources() (corev1.ResourceList, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting reserved resources of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("%s node object doesn't exist", builder.Definition.Name)
+	}
+
+	reserved := corev1.ResourceList{}
+
+	for resourceName, capacity := range builder.Object.Status.Capacity {
+		delta := capacity.DeepCopy()
+
+		if allocatable, ok := builder.Object.Status.Allocatable[resourceName]; ok {
+			delta.Sub(allocatable)
+		}
+
+		reserved[resourceName] = delta
+	}
+
+	return reserved, nil
+}
+
+// GetHugePages returns the allocatable hugepages of the node keyed by page size, e.g. 1Gi or 2Mi. The node status
+// only reports the totals of the node, the split per NUMA node is exposed by its NodeResourceTopology.
+func (builder *Builder) GetHugePages() (map[string]resource.Quantity, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting hugepages of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("%s node object doesn't exist", builder.Definition.Name)
+	}
+
+	hugePages := make(map[string]resource.Quantity)
+
+	for resourceName, quantity := range builder.Object.Status.Allocatable {
+		if pageSize, found := strings.CutPrefix(string(resourceName), corev1.ResourceHugePagesPrefix); found {
+			hugePages[pageSize] = quantity
+		}
+	}
+
+	return hugePages, nil
+}
+
+// GetCPUArchitecture returns the CPU architecture reported by the node, e.g. amd64 or arm64.
+func (builder *Builder) GetCPUArchitecture() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting CPU architecture of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("%s node object doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.NodeInfo.Architecture, nil
+}
+
+// GetKernelVersion returns the kernel version reported by the node.
+func (builder *Builder) GetKernelVersion() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting kernel version of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("%s node object doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.NodeInfo.KernelVersion, nil
+}
+
+// GetBootID returns the boot ID the node currently reports. It changes every time the node reboots.
+func (builder *Builder) GetBootID() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting boot ID of node %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("%s node object doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.NodeInfo.BootID, nil
+}
+
+// WaitUntilRebooted waits for timeout duration or until the node reports a boot ID different from the given one and
+// is Ready again. The previous boot ID should be retrieved with GetBootID before triggering the reboot.
+func (builder *Builder) WaitUntilRebooted(previousBootID string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for node %s to reboot from boot ID %s", builder.Definition.Name, previousBootID)
+
+	if previousBootID == "" {
+		return fmt.Errorf("cannot wait for node %s to reboot with an empty previous boot ID", builder.Definition.Name)
+	}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 5*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			bootID, err := builder.GetBootID()
+			if err != nil {
+				logging.V(100).Infof("Failed to get boot ID of node %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			if bootID == previousBootID {
+				return false, nil
+			}
+
+			ready, err := builder.IsReady()
+			if err != nil {
+				logging.V(100).Infof("Failed to get readiness of node %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return ready, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("node %s did not reboot and become Ready before timeout %v: %w",
+			builder.Definition.Name, timeout, err)
+	}
+
+	logging.V(100).Infof("Node %s rebooted and is Ready", builder.Definition.Name)
+
+	return nil
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Node"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -431,7 +661,7 @@ func (builder *Builder) validate() (bool, error) {
 // ensureDrainHelperIsSet ensures that drainHelper is always set.
 func (builder *Builder) ensureDrainHelperIsSet() {
 	if builder.drainHelper == nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"DrainHelper is not initialized for node %s. Init DrainHelper with defaul parameters",
 			builder.Definition.Name)
 		builder.SetDrainHelper(true, true, true, 300, 180, 10*time.Minute)
@@ -0,0 +1,376 @@
+package nodes
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultNodeName   = "worker-0"
+	defaultNodeBootID = "8a6d7f4e-0000-4000-8000-000000000001"
+)
+
+var defaultNodeTaint = corev1.Taint{Key: "node-role.kubernetes.io/infra", Effect: corev1.TaintEffectNoSchedule}
+
+func TestNodeWithNewTaint(t *testing.T) {
+	testCases := []struct {
+		taint          corev1.Taint
+		expectedTaints []corev1.Taint
+		expectedError  string
+	}{
+		{
+			taint: corev1.Taint{Key: "dedicated", Value: "cnf", Effect: corev1.TaintEffectNoExecute},
+			expectedTaints: []corev1.Taint{
+				defaultNodeTaint, {Key: "dedicated", Value: "cnf", Effect: corev1.TaintEffectNoExecute}},
+			expectedError: "",
+		},
+		{
+			taint: corev1.Taint{Key: defaultNodeTaint.Key, Effect: corev1.TaintEffectPreferNoSchedule},
+			expectedTaints: []corev1.Taint{
+				defaultNodeTaint, {Key: defaultNodeTaint.Key, Effect: corev1.TaintEffectPreferNoSchedule}},
+			expectedError: "",
+		},
+		{
+			taint:         corev1.Taint{Effect: corev1.TaintEffectNoSchedule},
+			expectedError: "error to set taint with empty key to node",
+		},
+		{
+			taint:         corev1.Taint{Key: "dedicated"},
+			expectedError: "error to set taint with empty effect to node",
+		},
+		{
+			taint:         corev1.Taint{Key: defaultNodeTaint.Key, Value: "other", Effect: defaultNodeTaint.Effect},
+			expectedError: "cannot overwrite existing node taint: " + defaultNodeTaint.ToString(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNodeBuilder(buildTestClientWithDummyNode()).WithNewTaint(testCase.taint)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedTaints, testBuilder.Definition.Spec.Taints)
+		}
+	}
+}
+
+func TestNodeRemoveTaint(t *testing.T) {
+	testCases := []struct {
+		key            string
+		effect         corev1.TaintEffect
+		expectedTaints []corev1.Taint
+		expectedError  string
+	}{
+		{
+			key:            defaultNodeTaint.Key,
+			effect:         defaultNodeTaint.Effect,
+			expectedTaints: nil,
+			expectedError:  "",
+		},
+		{
+			key:            defaultNodeTaint.Key,
+			effect:         corev1.TaintEffectNoExecute,
+			expectedTaints: []corev1.Taint{defaultNodeTaint},
+			expectedError:  "",
+		},
+		{
+			key:           "",
+			effect:        defaultNodeTaint.Effect,
+			expectedError: "error to remove taint with empty key from node",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNodeBuilder(buildTestClientWithDummyNode()).
+			RemoveTaint(testCase.key, testCase.effect)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedTaints, testBuilder.Definition.Spec.Taints)
+		}
+	}
+}
+
+func TestNodeTaintUpdate(t *testing.T) {
+	testBuilder, err := Pull(buildTestClientWithDummyNode(), defaultNodeName)
+	assert.Nil(t, err)
+
+	taint := corev1.Taint{Key: "dedicated", Value: "cnf", Effect: corev1.TaintEffectNoExecute}
+
+	testBuilder, err = testBuilder.RemoveTaint(defaultNodeTaint.Key, defaultNodeTaint.Effect).
+		WithNewTaint(taint).Update()
+	assert.Nil(t, err)
+
+	assert.True(t, testBuilder.Exists())
+	assert.Equal(t, []corev1.Taint{taint}, testBuilder.Object.Spec.Taints)
+}
+
+func TestNodeSetDrainEviction(t *testing.T) {
+	testCases := []struct {
+		useEviction bool
+	}{
+		{
+			useEviction: true,
+		},
+		{
+			useEviction: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNodeBuilder(buildTestClientWithDummyNode())
+		testBuilder.SetDrainEviction(testCase.useEviction)
+
+		assert.NotNil(t, testBuilder.drainHelper)
+		assert.Equal(t, !testCase.useEviction, testBuilder.drainHelper.DisableEviction)
+	}
+}
+
+func TestNodeGetBootID(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedBootID      string
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedBootID:      defaultNodeBootID,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Sprintf("%s node object doesn't exist", defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNode(defaultNodeBootID, corev1.ConditionTrue))
+		}
+
+		testBuilder := buildValidNodeBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		bootID, err := testBuilder.GetBootID()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedBootID, bootID)
+		}
+	}
+}
+
+func TestNodeWaitUntilRebooted(t *testing.T) {
+	testCases := []struct {
+		previousBootID string
+		currentBootID  string
+		ready          corev1.ConditionStatus
+		expectedError  string
+	}{
+		{
+			previousBootID: "8a6d7f4e-0000-4000-8000-000000000000",
+			currentBootID:  defaultNodeBootID,
+			ready:          corev1.ConditionTrue,
+			expectedError:  "",
+		},
+		{
+			previousBootID: defaultNodeBootID,
+			currentBootID:  defaultNodeBootID,
+			ready:          corev1.ConditionTrue,
+			expectedError: fmt.Sprintf("node %s did not reboot and become Ready before timeout 1s: "+
+				"context deadline exceeded", defaultNodeName),
+		},
+		{
+			previousBootID: "8a6d7f4e-0000-4000-8000-000000000000",
+			currentBootID:  defaultNodeBootID,
+			ready:          corev1.ConditionUnknown,
+			expectedError: fmt.Sprintf("node %s did not reboot and become Ready before timeout 1s: "+
+				"context deadline exceeded", defaultNodeName),
+		},
+		{
+			previousBootID: "",
+			currentBootID:  defaultNodeBootID,
+			ready:          corev1.ConditionTrue,
+			expectedError: fmt.Sprintf("cannot wait for node %s to reboot with an empty previous boot ID",
+				defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNodeBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyNode(testCase.currentBootID, testCase.ready)},
+		}))
+
+		err := testBuilder.WaitUntilRebooted(testCase.previousBootID, time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestNodeGetReservedResources(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedReserved    corev1.ResourceList
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedReserved: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+				"hugepages-1Gi":       resource.MustParse("0"),
+			},
+			expectedError: "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Sprintf("%s node object doesn't exist", defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNodeBuilder(buildTestClientWithNodeResources(testCase.addToRuntimeObjects))
+
+		reserved, err := testBuilder.GetReservedResources()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, reserved, len(testCase.expectedReserved))
+
+			for resourceName, quantity := range testCase.expectedReserved {
+				assert.Zero(t, quantity.Cmp(reserved[resourceName]), "unexpected reserved %s", resourceName)
+			}
+		}
+	}
+}
+
+func TestNodeGetHugePages(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedHugePages   map[string]resource.Quantity
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedHugePages:   map[string]resource.Quantity{"1Gi": resource.MustParse("4Gi")},
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Sprintf("%s node object doesn't exist", defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNodeBuilder(buildTestClientWithNodeResources(testCase.addToRuntimeObjects))
+
+		hugePages, err := testBuilder.GetHugePages()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedHugePages, hugePages)
+		}
+	}
+}
+
+func TestNodeGetNodeInfo(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects  bool
+		expectedArchitecture string
+		expectedKernel       string
+		expectedError        string
+	}{
+		{
+			addToRuntimeObjects:  true,
+			expectedArchitecture: "amd64",
+			expectedKernel:       "5.14.0-427.el9.x86_64",
+			expectedError:        "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Sprintf("%s node object doesn't exist", defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNodeBuilder(buildTestClientWithNodeResources(testCase.addToRuntimeObjects))
+
+		architecture, err := testBuilder.GetCPUArchitecture()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedArchitecture, architecture)
+		}
+
+		kernelVersion, err := testBuilder.GetKernelVersion()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedKernel, kernelVersion)
+		}
+	}
+}
+
+func buildValidNodeBuilder(apiClient *clients.Settings) *Builder {
+	return &Builder{
+		apiClient: apiClient.K8sClient,
+		Definition: &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: defaultNodeName,
+			},
+			Spec: corev1.NodeSpec{
+				Taints: []corev1.Taint{defaultNodeTaint},
+			},
+		},
+	}
+}
+
+func buildDummyNode(bootID string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   defaultNodeName,
+			Labels: map[string]string{"node-role.kubernetes.io/worker": ""},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{defaultNodeTaint},
+		},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{BootID: bootID},
+			Conditions: []corev1.NodeCondition{{
+				Type:   corev1.NodeReady,
+				Status: ready,
+			}},
+		},
+	}
+}
+
+func buildTestClientWithDummyNode() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyNode(defaultNodeBootID, corev1.ConditionTrue)},
+	})
+}
+
+func buildTestClientWithNodeResources(addToRuntimeObjects bool) *clients.Settings {
+	var runtimeObjects []runtime.Object
+
+	if addToRuntimeObjects {
+		node := buildDummyNode(defaultNodeBootID, corev1.ConditionTrue)
+		node.Status.NodeInfo.Architecture = "amd64"
+		node.Status.NodeInfo.KernelVersion = "5.14.0-427.el9.x86_64"
+		node.Status.Capacity = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("8"),
+			corev1.ResourceMemory: resource.MustParse("32Gi"),
+			"hugepages-1Gi":       resource.MustParse("4Gi"),
+		}
+		node.Status.Allocatable = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("7500m"),
+			corev1.ResourceMemory: resource.MustParse("31Gi"),
+			"hugepages-1Gi":       resource.MustParse("4Gi"),
+		}
+
+		runtimeObjects = append(runtimeObjects, node)
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+}
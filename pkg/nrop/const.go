@@ -0,0 +1,22 @@
+package nrop
+
+const (
+	// APIGroup represents the numaresources operator api group.
+	APIGroup = "nodetopology.openshift.io"
+	// APIVersion represents the version of the numaresources operator api.
+	APIVersion = "v1"
+	// NUMAResourcesOperatorKind represents kind of NUMAResourcesOperator object.
+	NUMAResourcesOperatorKind = "NUMAResourcesOperator"
+	// NUMAResourcesSchedulerKind represents kind of NUMAResourcesScheduler object.
+	NUMAResourcesSchedulerKind = "NUMAResourcesScheduler"
+	// NRTAPIGroup represents the NodeResourceTopology api group.
+	NRTAPIGroup = "topology.node.k8s.io"
+	// NRTAPIVersion represents the version of the NodeResourceTopology api.
+	NRTAPIVersion = "v1alpha2"
+	// NodeResourceTopologyKind represents kind of NodeResourceTopology object.
+	NodeResourceTopologyKind = "NodeResourceTopology"
+	// ConditionAvailable is the condition the operator sets once the resources of a CR are deployed and available.
+	ConditionAvailable = "Available"
+	// ConditionDegraded is the condition the operator sets when it fails to reconcile a CR.
+	ConditionDegraded = "Degraded"
+)
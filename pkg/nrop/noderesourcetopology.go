@@ -0,0 +1,251 @@
+package nrop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/nrop/nroptypes"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NRTBuilder provides struct for the NodeResourceTopology object containing connection to the cluster and the
+// NodeResourceTopology definitions. NodeResourceTopology objects are published by the resource topology exporter and
+// are read only.
+type NRTBuilder struct {
+	// NodeResourceTopology definition.
+	Definition *nroptypes.NodeResourceTopology
+	// Found NodeResourceTopology object.
+	Object    *nroptypes.NodeResourceTopology
+	apiClient *clients.Settings
+}
+
+// PullNRT pulls the NodeResourceTopology of the given node from cluster.
+func PullNRT(apiClient *clients.Settings, nodeName string) (*NRTBuilder, error) {
+	logging.V(100).Infof("Pulling existing NodeResourceTopology of node %s from cluster", nodeName)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("NodeResourceTopology 'apiClient' cannot be empty")
+	}
+
+	builder := NRTBuilder{
+		apiClient: apiClient,
+		Definition: &nroptypes.NodeResourceTopology{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeName,
+			},
+		},
+	}
+
+	if nodeName == "" {
+		logging.V(100).Infof("The nodeName of the NodeResourceTopology is empty")
+
+		return nil, fmt.Errorf("NodeResourceTopology 'nodeName' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("NodeResourceTopology object %s doesn't exist", nodeName)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// ListNRT returns the NodeResourceTopology objects of all the nodes running the resource topology exporter.
+func ListNRT(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*NRTBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("NodeResourceTopology 'apiClient' cannot be empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := "Listing all NodeResourceTopology objects"
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	unsList, err := apiClient.Resource(GetNodeResourceTopologyGVR()).List(context.TODO(), passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list NodeResourceTopology objects due to %s", err.Error())
+
+		return nil, err
+	}
+
+	var nrtBuilders []*NRTBuilder
+
+	for index := range unsList.Items {
+		nrt, err := convertNRTToStructured(&unsList.Items[index])
+		if err != nil {
+			return nil, err
+		}
+
+		nrtBuilders = append(nrtBuilders, &NRTBuilder{
+			apiClient:  apiClient,
+			Definition: nrt,
+			Object:     nrt,
+		})
+	}
+
+	return nrtBuilders, nil
+}
+
+// Get returns NodeResourceTopology object if found.
+func (builder *NRTBuilder) Get() (*nroptypes.NodeResourceTopology, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting NodeResourceTopology object %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetNodeResourceTopologyGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("NodeResourceTopology object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	return convertNRTToStructured(unsObject)
+}
+
+// Exists checks whether the given NodeResourceTopology exists.
+func (builder *NRTBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if NodeResourceTopology %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetZones returns the topology zones of the node, typically one per NUMA node.
+func (builder *NRTBuilder) GetZones() ([]nroptypes.Zone, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting zones of NodeResourceTopology %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("NodeResourceTopology object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Zones, nil
+}
+
+// GetAvailableResources returns the resources not allocated to pods yet, keyed by zone name.
+func (builder *NRTBuilder) GetAvailableResources() (map[string]corev1.ResourceList, error) {
+	zones, err := builder.GetZones()
+	if err != nil {
+		return nil, err
+	}
+
+	availableResources := make(map[string]corev1.ResourceList)
+
+	for _, zone := range zones {
+		zoneResources := corev1.ResourceList{}
+
+		for _, resourceInfo := range zone.Resources {
+			zoneResources[corev1.ResourceName(resourceInfo.Name)] = resourceInfo.Available
+		}
+
+		availableResources[zone.Name] = zoneResources
+	}
+
+	return availableResources, nil
+}
+
+// GetZoneAvailableResource returns the available amount of the given resource in the given zone.
+func (builder *NRTBuilder) GetZoneAvailableResource(
+	zoneName string, resourceName corev1.ResourceName) (resource.Quantity, error) {
+	availableResources, err := builder.GetAvailableResources()
+	if err != nil {
+		return resource.Quantity{}, err
+	}
+
+	zoneResources, found := availableResources[zoneName]
+	if !found {
+		return resource.Quantity{}, fmt.Errorf("zone %s not found in NodeResourceTopology %s",
+			zoneName, builder.Definition.Name)
+	}
+
+	quantity, found := zoneResources[resourceName]
+	if !found {
+		return resource.Quantity{}, fmt.Errorf("resource %s not found in zone %s of NodeResourceTopology %s",
+			resourceName, zoneName, builder.Definition.Name)
+	}
+
+	return quantity, nil
+}
+
+// GetNodeResourceTopologyGVR returns NodeResourceTopology's GroupVersionResource.
+func GetNodeResourceTopologyGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: NRTAPIGroup, Version: NRTAPIVersion, Resource: "noderesourcetopologies"}
+}
+
+// convertNRTToStructured converts the unstructured object returned by the dynamic client to a NodeResourceTopology.
+func convertNRTToStructured(unsObject *unstructured.Unstructured) (*nroptypes.NodeResourceTopology, error) {
+	nrt := &nroptypes.NodeResourceTopology{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, nrt)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to NodeResourceTopology object")
+
+		return nil, err
+	}
+
+	return nrt, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NRTBuilder) validate() (bool, error) {
+	resourceCRD := "NodeResourceTopology"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,172 @@
+package nrop
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/nrop/nroptypes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	nrtGVK = schema.GroupVersionKind{
+		Group:   NRTAPIGroup,
+		Version: NRTAPIVersion,
+		Kind:    NodeResourceTopologyKind,
+	}
+	defaultNRTNodeName = "worker-0"
+)
+
+func TestPullNRT(t *testing.T) {
+	testCases := []struct {
+		nodeName            string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			nodeName:            defaultNRTNodeName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			nodeName:            "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("NodeResourceTopology 'nodeName' cannot be empty"),
+		},
+		{
+			nodeName:            defaultNRTNodeName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("NodeResourceTopology object %s doesn't exist", defaultNRTNodeName),
+		},
+		{
+			nodeName:            defaultNRTNodeName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("NodeResourceTopology 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNRT(defaultNRTNodeName))
+		}
+
+		if testCase.client {
+			testSettings = buildNRTTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullNRT(testSettings, testCase.nodeName)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, testBuilder.Definition.Zones, 2)
+		}
+	}
+}
+
+func TestListNRT(t *testing.T) {
+	testBuilders, err := ListNRT(buildNRTTestClientWithDummyObject(
+		[]runtime.Object{buildDummyNRT(defaultNRTNodeName), buildDummyNRT("worker-1")}))
+	assert.Nil(t, err)
+	assert.Len(t, testBuilders, 2)
+
+	_, err = ListNRT(nil)
+	assert.EqualError(t, err, "NodeResourceTopology 'apiClient' cannot be empty")
+}
+
+func TestNRTGetZoneAvailableResource(t *testing.T) {
+	testCases := []struct {
+		zoneName         string
+		resourceName     corev1.ResourceName
+		expectedQuantity resource.Quantity
+		expectedError    string
+	}{
+		{
+			zoneName:         "node-0",
+			resourceName:     corev1.ResourceCPU,
+			expectedQuantity: resource.MustParse("10"),
+			expectedError:    "",
+		},
+		{
+			zoneName:         "node-1",
+			resourceName:     "hugepages-1Gi",
+			expectedQuantity: resource.MustParse("4Gi"),
+			expectedError:    "",
+		},
+		{
+			zoneName:      "node-2",
+			resourceName:  corev1.ResourceCPU,
+			expectedError: fmt.Sprintf("zone node-2 not found in NodeResourceTopology %s", defaultNRTNodeName),
+		},
+		{
+			zoneName:     "node-0",
+			resourceName: "hugepages-2Mi",
+			expectedError: fmt.Sprintf(
+				"resource hugepages-2Mi not found in zone node-0 of NodeResourceTopology %s", defaultNRTNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := PullNRT(
+			buildNRTTestClientWithDummyObject([]runtime.Object{buildDummyNRT(defaultNRTNodeName)}), defaultNRTNodeName)
+		assert.Nil(t, err)
+
+		quantity, err := testBuilder.GetZoneAvailableResource(testCase.zoneName, testCase.resourceName)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.True(t, testCase.expectedQuantity.Equal(quantity))
+		}
+	}
+}
+
+func buildDummyNRT(nodeName string) *nroptypes.NodeResourceTopology {
+	return &nroptypes.NodeResourceTopology{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+		},
+		Zones: []nroptypes.Zone{
+			{
+				Name: "node-0",
+				Type: "Node",
+				Resources: []nroptypes.ResourceInfo{{
+					Name:        "cpu",
+					Capacity:    resource.MustParse("16"),
+					Allocatable: resource.MustParse("14"),
+					Available:   resource.MustParse("10"),
+				}},
+			},
+			{
+				Name: "node-1",
+				Type: "Node",
+				Resources: []nroptypes.ResourceInfo{{
+					Name:        "hugepages-1Gi",
+					Capacity:    resource.MustParse("8Gi"),
+					Allocatable: resource.MustParse("8Gi"),
+					Available:   resource.MustParse("4Gi"),
+				}},
+			},
+		},
+	}
+}
+
+func buildNRTTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{nrtGVK},
+	})
+}
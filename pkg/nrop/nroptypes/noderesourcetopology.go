@@ -0,0 +1,116 @@
+package nroptypes
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ResourceInfo contains the amounts of a resource in a zone.
+type ResourceInfo struct {
+	// name of the resource, e.g. cpu or hugepages-1Gi.
+	Name string `json:"name"`
+	// allocatable is the amount of the resource which can be allocated to pods.
+	Allocatable resource.Quantity `json:"allocatable"`
+	// capacity is the total amount of the resource.
+	Capacity resource.Quantity `json:"capacity"`
+	// available is the amount of the resource not allocated to pods yet.
+	Available resource.Quantity `json:"available"`
+}
+
+// CostInfo is the cost of accessing a zone from another one.
+type CostInfo struct {
+	// name of the other zone.
+	Name string `json:"name"`
+	// value of the cost.
+	Value int64 `json:"value"`
+}
+
+// AttributeInfo is a generic attribute of a node or zone.
+type AttributeInfo struct {
+	// name of the attribute.
+	Name string `json:"name"`
+	// value of the attribute.
+	Value string `json:"value"`
+}
+
+// Zone is a topology zone of a node, typically a NUMA node.
+type Zone struct {
+	// name of the zone, e.g. node-0.
+	Name string `json:"name"`
+	// type of the zone, e.g. Node.
+	Type string `json:"type"`
+	// parent zone, if any.
+	Parent string `json:"parent,omitempty"`
+	// costs of accessing the other zones.
+	Costs []CostInfo `json:"costs,omitempty"`
+	// attributes of the zone.
+	Attributes []AttributeInfo `json:"attributes,omitempty"`
+	// resources of the zone.
+	Resources []ResourceInfo `json:"resources,omitempty"`
+}
+
+// NodeResourceTopology describes the resources of a node split per topology zone. It is named after the node.
+type NodeResourceTopology struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// topologyPolicies are the topology manager policies of the node. Deprecated in favor of attributes.
+	TopologyPolicies []string `json:"topologyPolicies,omitempty"`
+	// zones of the node.
+	Zones []Zone `json:"zones"`
+	// attributes of the node.
+	Attributes []AttributeInfo `json:"attributes,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *Zone) DeepCopyInto(out *Zone) {
+	*out = *in
+	out.Costs = append([]CostInfo(nil), in.Costs...)
+	out.Attributes = append([]AttributeInfo(nil), in.Attributes...)
+
+	if in.Resources != nil {
+		out.Resources = make([]ResourceInfo, len(in.Resources))
+
+		for index, resourceInfo := range in.Resources {
+			out.Resources[index] = ResourceInfo{
+				Name:        resourceInfo.Name,
+				Allocatable: resourceInfo.Allocatable.DeepCopy(),
+				Capacity:    resourceInfo.Capacity.DeepCopy(),
+				Available:   resourceInfo.Available.DeepCopy(),
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeResourceTopology.
+func (in *NodeResourceTopology) DeepCopy() *NodeResourceTopology {
+	if in == nil {
+		return nil
+	}
+
+	out := new(NodeResourceTopology)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.TopologyPolicies = append([]string(nil), in.TopologyPolicies...)
+	out.Attributes = append([]AttributeInfo(nil), in.Attributes...)
+
+	if in.Zones != nil {
+		out.Zones = make([]Zone, len(in.Zones))
+
+		for index := range in.Zones {
+			in.Zones[index].DeepCopyInto(&out.Zones[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeResourceTopology) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
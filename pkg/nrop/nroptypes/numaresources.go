@@ -0,0 +1,261 @@
+package nroptypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PodsFingerprintingMode defines how the resource topology exporter fingerprints the pods running on a node.
+type PodsFingerprintingMode string
+
+const (
+	// PodsFingerprintingDisabled disables the pods fingerprinting.
+	PodsFingerprintingDisabled PodsFingerprintingMode = "Disabled"
+	// PodsFingerprintingEnabled enables the pods fingerprinting.
+	PodsFingerprintingEnabled PodsFingerprintingMode = "Enabled"
+	// PodsFingerprintingEnabledExclusiveResources enables the pods fingerprinting considering only the pods with
+	// exclusive resources.
+	PodsFingerprintingEnabledExclusiveResources PodsFingerprintingMode = "EnabledExclusiveResources"
+)
+
+// InfoRefreshMode defines when the resource topology exporter refreshes the NodeResourceTopology objects.
+type InfoRefreshMode string
+
+const (
+	// InfoRefreshPeriodic refreshes the NodeResourceTopology objects periodically.
+	InfoRefreshPeriodic InfoRefreshMode = "Periodic"
+	// InfoRefreshEvents refreshes the NodeResourceTopology objects on kubelet events.
+	InfoRefreshEvents InfoRefreshMode = "Events"
+	// InfoRefreshPeriodicAndEvents refreshes the NodeResourceTopology objects periodically and on kubelet events.
+	InfoRefreshPeriodicAndEvents InfoRefreshMode = "PeriodicAndEvents"
+)
+
+// NamespacedName is the namespace and name of an object.
+type NamespacedName struct {
+	// namespace of the object.
+	Namespace string `json:"namespace,omitempty"`
+	// name of the object.
+	Name string `json:"name,omitempty"`
+}
+
+// NodeGroupConfig defines the configuration of the resource topology exporter on a group of nodes.
+type NodeGroupConfig struct {
+	// podsFingerprinting defines how the pods running on the nodes are fingerprinted.
+	PodsFingerprinting *PodsFingerprintingMode `json:"podsFingerprinting,omitempty"`
+	// infoRefreshMode defines when the NodeResourceTopology objects are refreshed.
+	InfoRefreshMode *InfoRefreshMode `json:"infoRefreshMode,omitempty"`
+	// infoRefreshPeriod is the period of the periodic refresh of the NodeResourceTopology objects.
+	InfoRefreshPeriod *metav1.Duration `json:"infoRefreshPeriod,omitempty"`
+	// tolerations of the resource topology exporter pods.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// NodeGroup defines a group of nodes, selected through their MachineConfigPools, the resource topology exporter
+// runs on.
+type NodeGroup struct {
+	// machineConfigPoolSelector selects the MachineConfigPools of the nodes.
+	MachineConfigPoolSelector *metav1.LabelSelector `json:"machineConfigPoolSelector,omitempty"`
+	// config of the resource topology exporter on the nodes.
+	Config *NodeGroupConfig `json:"config,omitempty"`
+}
+
+// MachineConfigPool is the status of the operator on a selected MachineConfigPool.
+type MachineConfigPool struct {
+	// name of the MachineConfigPool.
+	Name string `json:"name"`
+	// config is the resource topology exporter configuration applied on the MachineConfigPool.
+	Config *NodeGroupConfig `json:"config,omitempty"`
+}
+
+// NUMAResourcesOperatorSpec defines the desired state of NUMAResourcesOperator.
+type NUMAResourcesOperatorSpec struct {
+	// nodeGroups are the groups of nodes the resource topology exporter runs on.
+	NodeGroups []NodeGroup `json:"nodeGroups,omitempty"`
+	// imageSpec overrides the resource topology exporter image.
+	ExporterImage string `json:"imageSpec,omitempty"`
+	// logLevel of the resource topology exporter.
+	LogLevel string `json:"logLevel,omitempty"`
+	// podExcludes are the pods ignored when computing the resources of the nodes.
+	PodExcludes []NamespacedName `json:"podExcludes,omitempty"`
+}
+
+// NUMAResourcesOperatorStatus defines the observed state of NUMAResourcesOperator.
+type NUMAResourcesOperatorStatus struct {
+	// daemonsets are the resource topology exporter daemonsets deployed by the operator.
+	DaemonSets []NamespacedName `json:"daemonsets,omitempty"`
+	// machineconfigpools are the MachineConfigPools selected by the node groups.
+	MachineConfigPools []MachineConfigPool `json:"machineconfigpools,omitempty"`
+	// conditions describe the state of the NUMAResourcesOperator.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NUMAResourcesOperator is the Schema for the numaresourcesoperators API. It deploys the resource topology exporter
+// on the selected nodes.
+type NUMAResourcesOperator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NUMAResourcesOperatorSpec   `json:"spec,omitempty"`
+	Status NUMAResourcesOperatorStatus `json:"status,omitempty"`
+}
+
+// NUMAResourcesSchedulerSpec defines the desired state of NUMAResourcesScheduler.
+type NUMAResourcesSchedulerSpec struct {
+	// imageSpec is the image of the topology aware scheduler.
+	SchedulerImage string `json:"imageSpec"`
+	// schedulerName is the name pods refer to in order to be scheduled by the topology aware scheduler.
+	SchedulerName string `json:"schedulerName,omitempty"`
+	// logLevel of the topology aware scheduler.
+	LogLevel string `json:"logLevel,omitempty"`
+	// cacheResyncPeriod is the period the scheduler resynchronizes its cache of the NodeResourceTopology objects.
+	CacheResyncPeriod *metav1.Duration `json:"cacheResyncPeriod,omitempty"`
+}
+
+// NUMAResourcesSchedulerStatus defines the observed state of NUMAResourcesScheduler.
+type NUMAResourcesSchedulerStatus struct {
+	// deployment of the topology aware scheduler.
+	Deployment NamespacedName `json:"deployment,omitempty"`
+	// schedulerName is the name of the topology aware scheduler in use.
+	SchedulerName string `json:"schedulerName,omitempty"`
+	// conditions describe the state of the NUMAResourcesScheduler.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NUMAResourcesScheduler is the Schema for the numaresourcesschedulers API. It deploys the topology aware scheduler.
+type NUMAResourcesScheduler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NUMAResourcesSchedulerSpec   `json:"spec,omitempty"`
+	Status NUMAResourcesSchedulerStatus `json:"status,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *NodeGroupConfig) DeepCopyInto(out *NodeGroupConfig) {
+	*out = *in
+
+	if in.PodsFingerprinting != nil {
+		out.PodsFingerprinting = new(PodsFingerprintingMode)
+		*out.PodsFingerprinting = *in.PodsFingerprinting
+	}
+
+	if in.InfoRefreshMode != nil {
+		out.InfoRefreshMode = new(InfoRefreshMode)
+		*out.InfoRefreshMode = *in.InfoRefreshMode
+	}
+
+	if in.InfoRefreshPeriod != nil {
+		out.InfoRefreshPeriod = new(metav1.Duration)
+		*out.InfoRefreshPeriod = *in.InfoRefreshPeriod
+	}
+
+	if in.Tolerations != nil {
+		out.Tolerations = make([]corev1.Toleration, len(in.Tolerations))
+
+		for index := range in.Tolerations {
+			in.Tolerations[index].DeepCopyInto(&out.Tolerations[index])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAResourcesOperator.
+func (in *NUMAResourcesOperator) DeepCopy() *NUMAResourcesOperator {
+	if in == nil {
+		return nil
+	}
+
+	out := new(NUMAResourcesOperator)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.ExporterImage = in.Spec.ExporterImage
+	out.Spec.LogLevel = in.Spec.LogLevel
+	out.Spec.PodExcludes = append([]NamespacedName(nil), in.Spec.PodExcludes...)
+
+	if in.Spec.NodeGroups != nil {
+		out.Spec.NodeGroups = make([]NodeGroup, len(in.Spec.NodeGroups))
+
+		for index, nodeGroup := range in.Spec.NodeGroups {
+			out.Spec.NodeGroups[index].MachineConfigPoolSelector = nodeGroup.MachineConfigPoolSelector.DeepCopy()
+
+			if nodeGroup.Config != nil {
+				out.Spec.NodeGroups[index].Config = new(NodeGroupConfig)
+				nodeGroup.Config.DeepCopyInto(out.Spec.NodeGroups[index].Config)
+			}
+		}
+	}
+
+	out.Status.DaemonSets = append([]NamespacedName(nil), in.Status.DaemonSets...)
+
+	if in.Status.MachineConfigPools != nil {
+		out.Status.MachineConfigPools = make([]MachineConfigPool, len(in.Status.MachineConfigPools))
+
+		for index, pool := range in.Status.MachineConfigPools {
+			out.Status.MachineConfigPools[index].Name = pool.Name
+
+			if pool.Config != nil {
+				out.Status.MachineConfigPools[index].Config = new(NodeGroupConfig)
+				pool.Config.DeepCopyInto(out.Status.MachineConfigPools[index].Config)
+			}
+		}
+	}
+
+	out.Status.Conditions = deepCopyConditions(in.Status.Conditions)
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NUMAResourcesOperator) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAResourcesScheduler.
+func (in *NUMAResourcesScheduler) DeepCopy() *NUMAResourcesScheduler {
+	if in == nil {
+		return nil
+	}
+
+	out := new(NUMAResourcesScheduler)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+
+	if in.Spec.CacheResyncPeriod != nil {
+		out.Spec.CacheResyncPeriod = new(metav1.Duration)
+		*out.Spec.CacheResyncPeriod = *in.Spec.CacheResyncPeriod
+	}
+
+	out.Status.Deployment = in.Status.Deployment
+	out.Status.SchedulerName = in.Status.SchedulerName
+	out.Status.Conditions = deepCopyConditions(in.Status.Conditions)
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NUMAResourcesScheduler) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+func deepCopyConditions(in []metav1.Condition) []metav1.Condition {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]metav1.Condition, len(in))
+
+	for index := range in {
+		in[index].DeepCopyInto(&out[index])
+	}
+
+	return out
+}
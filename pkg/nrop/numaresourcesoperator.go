@@ -0,0 +1,374 @@
+package nrop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/nrop/nroptypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// NROPBuilder provides struct for the NUMAResourcesOperator object containing connection to the cluster and the
+// NUMAResourcesOperator definitions.
+type NROPBuilder struct {
+	// NUMAResourcesOperator definition. Used to create the NUMAResourcesOperator object.
+	Definition *nroptypes.NUMAResourcesOperator
+	// Created NUMAResourcesOperator object.
+	Object *nroptypes.NUMAResourcesOperator
+	// Used in functions that define or mutate NUMAResourcesOperator definition. errorMsg is processed before the
+	// NUMAResourcesOperator object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewNROPBuilder creates a new instance of NROPBuilder. At least one node group must be added with WithMCPSelector
+// or WithNodeGroup before creating it.
+func NewNROPBuilder(apiClient *clients.Settings, name string) *NROPBuilder {
+	logging.V(100).Infof("Initializing new NUMAResourcesOperator structure with the following params: name: %s", name)
+
+	builder := NROPBuilder{
+		apiClient: apiClient,
+		Definition: &nroptypes.NUMAResourcesOperator{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       NUMAResourcesOperatorKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the NUMAResourcesOperator is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NUMAResourcesOperator 'name' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullNROP pulls existing NUMAResourcesOperator from cluster.
+func PullNROP(apiClient *clients.Settings, name string) (*NROPBuilder, error) {
+	logging.V(100).Infof("Pulling existing NUMAResourcesOperator name %s from cluster", name)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("NUMAResourcesOperator 'apiClient' cannot be empty")
+	}
+
+	builder := NROPBuilder{
+		apiClient: apiClient,
+		Definition: &nroptypes.NUMAResourcesOperator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the NUMAResourcesOperator is empty")
+
+		return nil, fmt.Errorf("NUMAResourcesOperator 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("NUMAResourcesOperator object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithNodeGroup adds a node group the resource topology exporter runs on. Node groups selecting the same
+// MachineConfigPools cannot be added twice.
+func (builder *NROPBuilder) WithNodeGroup(nodeGroup nroptypes.NodeGroup) *NROPBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding node group %v to NUMAResourcesOperator %s", nodeGroup, builder.Definition.Name)
+
+	if nodeGroup.MachineConfigPoolSelector == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("NUMAResourcesOperator node group 'machineConfigPoolSelector' cannot be empty"))
+
+		return builder
+	}
+
+	for _, existingGroup := range builder.Definition.Spec.NodeGroups {
+		if reflect.DeepEqual(existingGroup.MachineConfigPoolSelector, nodeGroup.MachineConfigPoolSelector) {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"NUMAResourcesOperator node group with machineConfigPoolSelector %v already exists",
+				nodeGroup.MachineConfigPoolSelector))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.NodeGroups = append(builder.Definition.Spec.NodeGroups, nodeGroup)
+
+	return builder
+}
+
+// WithMCPSelector adds a node group selecting the MachineConfigPools with the given labels.
+func (builder *NROPBuilder) WithMCPSelector(mcpSelector map[string]string) *NROPBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	if len(mcpSelector) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("NUMAResourcesOperator 'mcpSelector' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.WithNodeGroup(nroptypes.NodeGroup{
+		MachineConfigPoolSelector: &metav1.LabelSelector{MatchLabels: mcpSelector},
+	})
+}
+
+// WithExporterImage overrides the image of the resource topology exporter.
+func (builder *NROPBuilder) WithExporterImage(image string) *NROPBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting exporter image %s in NUMAResourcesOperator %s", image, builder.Definition.Name)
+
+	if image == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NUMAResourcesOperator 'image' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExporterImage = image
+
+	return builder
+}
+
+// Get returns NUMAResourcesOperator object if found.
+func (builder *NROPBuilder) Get() (*nroptypes.NUMAResourcesOperator, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting NUMAResourcesOperator object %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetNUMAResourcesOperatorGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("NUMAResourcesOperator object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	nrop := &nroptypes.NUMAResourcesOperator{}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, nrop)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to NUMAResourcesOperator object")
+
+		return nil, err
+	}
+
+	return nrop, nil
+}
+
+// Exists checks whether the given NUMAResourcesOperator exists.
+func (builder *NROPBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if NUMAResourcesOperator %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a NUMAResourcesOperator in the cluster and stores the created object in struct.
+func (builder *NROPBuilder) Create() (*NROPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the NUMAResourcesOperator %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.NodeGroups) == 0 {
+		return builder, fmt.Errorf("NUMAResourcesOperator %s must have at least one node group",
+			builder.Definition.Name)
+	}
+
+	unstructuredNROP, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured NUMAResourcesOperator to unstructured object")
+
+		return builder, err
+	}
+
+	_, err = builder.apiClient.Resource(GetNUMAResourcesOperatorGVR()).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredNROP}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create NUMAResourcesOperator %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = builder.Get()
+
+	return builder, err
+}
+
+// Update renovates the existing NUMAResourcesOperator object with the definition in builder.
+func (builder *NROPBuilder) Update() (*NROPBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the NUMAResourcesOperator %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update NUMAResourcesOperator, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredNROP, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured NUMAResourcesOperator to unstructured object")
+
+		return builder, err
+	}
+
+	_, err = builder.apiClient.Resource(GetNUMAResourcesOperatorGVR()).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredNROP}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = builder.Get()
+
+	return builder, err
+}
+
+// Delete removes NUMAResourcesOperator object from a cluster.
+func (builder *NROPBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the NUMAResourcesOperator object %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetNUMAResourcesOperatorGVR()).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete NUMAResourcesOperator: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilAvailable waits for the duration of the defined timeout or until the resource topology exporter is
+// deployed and available on all node groups. It fails early once the NUMAResourcesOperator is degraded.
+func (builder *NROPBuilder) WaitUntilAvailable(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until NUMAResourcesOperator %s is available",
+		builder.Definition.Name)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				logging.V(100).Infof("Failed to get NUMAResourcesOperator %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			degraded := meta.FindStatusCondition(builder.Object.Status.Conditions, ConditionDegraded)
+			if degraded != nil && degraded.Status == metav1.ConditionTrue {
+				return false, fmt.Errorf("NUMAResourcesOperator %s is degraded, reason %s: %s",
+					builder.Definition.Name, degraded.Reason, degraded.Message)
+			}
+
+			return meta.IsStatusConditionTrue(builder.Object.Status.Conditions, ConditionAvailable), nil
+		})
+
+	return err
+}
+
+// GetNUMAResourcesOperatorGVR returns NUMAResourcesOperator's GroupVersionResource which could be used for Clean
+// function.
+func GetNUMAResourcesOperatorGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "numaresourcesoperators"}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NROPBuilder) validate() (bool, error) {
+	resourceCRD := "NUMAResourcesOperator"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
@@ -0,0 +1,257 @@
+package nrop
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/nrop/nroptypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	nropGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    NUMAResourcesOperatorKind,
+	}
+	defaultNROPName    = "numaresourcesoperator"
+	defaultMCPSelector = map[string]string{"pools.operator.machineconfiguration.openshift.io/worker-cnf": ""}
+)
+
+func TestNewNROPBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expectedError string
+	}{
+		{
+			name:          defaultNROPName,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			expectedError: "NUMAResourcesOperator 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewNROPBuilder(testSettings, testCase.name)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestPullNROP(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultNROPName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("NUMAResourcesOperator 'name' cannot be empty"),
+		},
+		{
+			name:                defaultNROPName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("NUMAResourcesOperator object %s doesn't exist", defaultNROPName),
+		},
+		{
+			name:                defaultNROPName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("NUMAResourcesOperator 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNROP(ConditionAvailable, metav1.ConditionTrue, ""))
+		}
+
+		if testCase.client {
+			testSettings = buildNROPTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullNROP(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultMCPSelector,
+				testBuilder.Definition.Spec.NodeGroups[0].MachineConfigPoolSelector.MatchLabels)
+		}
+	}
+}
+
+func TestNROPWithNodeGroup(t *testing.T) {
+	refreshMode := nroptypes.InfoRefreshPeriodicAndEvents
+	testBuilder := buildValidNROPBuilder(buildNROPTestClientWithDummyObject(nil)).
+		WithMCPSelector(defaultMCPSelector).
+		WithNodeGroup(nroptypes.NodeGroup{
+			MachineConfigPoolSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "ht"}},
+			Config:                    &nroptypes.NodeGroupConfig{InfoRefreshMode: &refreshMode},
+		})
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Len(t, testBuilder.Definition.Spec.NodeGroups, 2)
+	assert.Equal(t, &refreshMode, testBuilder.Definition.Spec.NodeGroups[1].Config.InfoRefreshMode)
+
+	testBuilder = buildValidNROPBuilder(buildNROPTestClientWithDummyObject(nil)).
+		WithMCPSelector(defaultMCPSelector).
+		WithMCPSelector(defaultMCPSelector)
+	assert.EqualError(t, testBuilder.errorMsg, fmt.Sprintf(
+		"NUMAResourcesOperator node group with machineConfigPoolSelector %v already exists",
+		&metav1.LabelSelector{MatchLabels: defaultMCPSelector}))
+
+	testBuilder = buildValidNROPBuilder(buildNROPTestClientWithDummyObject(nil)).WithMCPSelector(nil)
+	assert.EqualError(t, testBuilder.errorMsg, "NUMAResourcesOperator 'mcpSelector' cannot be empty")
+
+	testBuilder = buildValidNROPBuilder(buildNROPTestClientWithDummyObject(nil)).WithNodeGroup(nroptypes.NodeGroup{})
+	assert.EqualError(t, testBuilder.errorMsg,
+		"NUMAResourcesOperator node group 'machineConfigPoolSelector' cannot be empty")
+}
+
+func TestNROPCreate(t *testing.T) {
+	testCases := []struct {
+		withNodeGroup bool
+		expectedError error
+	}{
+		{
+			withNodeGroup: true,
+			expectedError: nil,
+		},
+		{
+			withNodeGroup: false,
+			expectedError: fmt.Errorf("NUMAResourcesOperator %s must have at least one node group", defaultNROPName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNROPBuilder(buildNROPTestClientWithDummyObject(nil))
+
+		if testCase.withNodeGroup {
+			testBuilder = testBuilder.WithMCPSelector(defaultMCPSelector)
+		}
+
+		testBuilder, err := testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultNROPName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestNROPDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNROP(ConditionAvailable, metav1.ConditionTrue, ""))
+		}
+
+		testBuilder := buildValidNROPBuilder(buildNROPTestClientWithDummyObject(runtimeObjects))
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+	}
+}
+
+func TestNROPWaitUntilAvailable(t *testing.T) {
+	testCases := []struct {
+		conditionType string
+		status        metav1.ConditionStatus
+		message       string
+		expectedError string
+	}{
+		{
+			conditionType: ConditionAvailable,
+			status:        metav1.ConditionTrue,
+			expectedError: "",
+		},
+		{
+			conditionType: ConditionAvailable,
+			status:        metav1.ConditionFalse,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			conditionType: ConditionDegraded,
+			status:        metav1.ConditionTrue,
+			message:       "failed to update MachineConfig",
+			expectedError: fmt.Sprintf(
+				"NUMAResourcesOperator %s is degraded, reason Failed: failed to update MachineConfig", defaultNROPName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNROPBuilder(buildNROPTestClientWithDummyObject(
+			[]runtime.Object{buildDummyNROP(testCase.conditionType, testCase.status, testCase.message)}))
+
+		err := testBuilder.WaitUntilAvailable(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidNROPBuilder(apiClient *clients.Settings) *NROPBuilder {
+	return NewNROPBuilder(apiClient, defaultNROPName)
+}
+
+func buildDummyNROP(
+	conditionType string, status metav1.ConditionStatus, message string) *nroptypes.NUMAResourcesOperator {
+	return &nroptypes.NUMAResourcesOperator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultNROPName,
+		},
+		Spec: nroptypes.NUMAResourcesOperatorSpec{
+			NodeGroups: []nroptypes.NodeGroup{{
+				MachineConfigPoolSelector: &metav1.LabelSelector{MatchLabels: defaultMCPSelector},
+			}},
+		},
+		Status: nroptypes.NUMAResourcesOperatorStatus{
+			Conditions: []metav1.Condition{{
+				Type:    conditionType,
+				Status:  status,
+				Reason:  "Failed",
+				Message: message,
+			}},
+		},
+	}
+}
+
+func buildNROPTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{nropGVK},
+	})
+}
@@ -0,0 +1,360 @@
+package nrop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/nrop/nroptypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// SchedulerBuilder provides struct for the NUMAResourcesScheduler object containing connection to the cluster and
+// the NUMAResourcesScheduler definitions.
+type SchedulerBuilder struct {
+	// NUMAResourcesScheduler definition. Used to create the NUMAResourcesScheduler object.
+	Definition *nroptypes.NUMAResourcesScheduler
+	// Created NUMAResourcesScheduler object.
+	Object *nroptypes.NUMAResourcesScheduler
+	// Used in functions that define or mutate NUMAResourcesScheduler definition. errorMsg is processed before the
+	// NUMAResourcesScheduler object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewSchedulerBuilder creates a new instance of SchedulerBuilder deploying the topology aware scheduler from the given
+// image.
+func NewSchedulerBuilder(apiClient *clients.Settings, name, schedulerImage string) *SchedulerBuilder {
+	logging.V(100).Infof(
+		"Initializing new NUMAResourcesScheduler structure with the following params: name: %s, schedulerImage: %s",
+		name, schedulerImage)
+
+	builder := SchedulerBuilder{
+		apiClient: apiClient,
+		Definition: &nroptypes.NUMAResourcesScheduler{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       NUMAResourcesSchedulerKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: nroptypes.NUMAResourcesSchedulerSpec{
+				SchedulerImage: schedulerImage,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the NUMAResourcesScheduler is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("NUMAResourcesScheduler 'name' cannot be empty"))
+	}
+
+	if schedulerImage == "" {
+		logging.V(100).Infof("The schedulerImage of the NUMAResourcesScheduler is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("NUMAResourcesScheduler 'schedulerImage' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullScheduler pulls existing NUMAResourcesScheduler from cluster.
+func PullScheduler(apiClient *clients.Settings, name string) (*SchedulerBuilder, error) {
+	logging.V(100).Infof("Pulling existing NUMAResourcesScheduler name %s from cluster", name)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("NUMAResourcesScheduler 'apiClient' cannot be empty")
+	}
+
+	builder := SchedulerBuilder{
+		apiClient: apiClient,
+		Definition: &nroptypes.NUMAResourcesScheduler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the NUMAResourcesScheduler is empty")
+
+		return nil, fmt.Errorf("NUMAResourcesScheduler 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("NUMAResourcesScheduler object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithSchedulerName sets the name pods refer to in order to be scheduled by the topology aware scheduler.
+func (builder *SchedulerBuilder) WithSchedulerName(schedulerName string) *SchedulerBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting scheduler name %s in NUMAResourcesScheduler %s",
+		schedulerName, builder.Definition.Name)
+
+	if schedulerName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("NUMAResourcesScheduler 'schedulerName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SchedulerName = schedulerName
+
+	return builder
+}
+
+// WithCacheResyncPeriod sets the period the scheduler resynchronizes its cache of the NodeResourceTopology objects.
+func (builder *SchedulerBuilder) WithCacheResyncPeriod(period time.Duration) *SchedulerBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting cache resync period %v in NUMAResourcesScheduler %s", period, builder.Definition.Name)
+
+	if period <= 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("NUMAResourcesScheduler 'cacheResyncPeriod' must be positive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.CacheResyncPeriod = &metav1.Duration{Duration: period}
+
+	return builder
+}
+
+// Get returns NUMAResourcesScheduler object if found.
+func (builder *SchedulerBuilder) Get() (*nroptypes.NUMAResourcesScheduler, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting NUMAResourcesScheduler object %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetNUMAResourcesSchedulerGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("NUMAResourcesScheduler object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	scheduler := &nroptypes.NUMAResourcesScheduler{}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, scheduler)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to NUMAResourcesScheduler object")
+
+		return nil, err
+	}
+
+	return scheduler, nil
+}
+
+// Exists checks whether the given NUMAResourcesScheduler exists.
+func (builder *SchedulerBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if NUMAResourcesScheduler %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a NUMAResourcesScheduler in the cluster and stores the created object in struct.
+func (builder *SchedulerBuilder) Create() (*SchedulerBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the NUMAResourcesScheduler %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredScheduler, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured NUMAResourcesScheduler to unstructured object")
+
+		return builder, err
+	}
+
+	_, err = builder.apiClient.Resource(GetNUMAResourcesSchedulerGVR()).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredScheduler}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create NUMAResourcesScheduler %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = builder.Get()
+
+	return builder, err
+}
+
+// Update renovates the existing NUMAResourcesScheduler object with the definition in builder.
+func (builder *SchedulerBuilder) Update() (*SchedulerBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the NUMAResourcesScheduler %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update NUMAResourcesScheduler, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredScheduler, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured NUMAResourcesScheduler to unstructured object")
+
+		return builder, err
+	}
+
+	_, err = builder.apiClient.Resource(GetNUMAResourcesSchedulerGVR()).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredScheduler}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = builder.Get()
+
+	return builder, err
+}
+
+// Delete removes NUMAResourcesScheduler object from a cluster.
+func (builder *SchedulerBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the NUMAResourcesScheduler object %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetNUMAResourcesSchedulerGVR()).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete NUMAResourcesScheduler: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilAvailable waits for the duration of the defined timeout or until the topology aware scheduler is deployed
+// and available.
+func (builder *SchedulerBuilder) WaitUntilAvailable(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until NUMAResourcesScheduler %s is available",
+		builder.Definition.Name)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				logging.V(100).Infof("Failed to get NUMAResourcesScheduler %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return meta.IsStatusConditionTrue(builder.Object.Status.Conditions, ConditionAvailable), nil
+		})
+}
+
+// GetSchedulerName returns the name of the topology aware scheduler in use, as reported in the status.
+func (builder *SchedulerBuilder) GetSchedulerName() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting scheduler name of NUMAResourcesScheduler %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("NUMAResourcesScheduler object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return builder.Object.Status.SchedulerName, nil
+}
+
+// GetNUMAResourcesSchedulerGVR returns NUMAResourcesScheduler's GroupVersionResource which could be used for Clean
+// function.
+func GetNUMAResourcesSchedulerGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "numaresourcesschedulers"}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *SchedulerBuilder) validate() (bool, error) {
+	resourceCRD := "NUMAResourcesScheduler"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
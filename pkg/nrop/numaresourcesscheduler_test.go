@@ -0,0 +1,200 @@
+package nrop
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/nrop/nroptypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	schedulerGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    NUMAResourcesSchedulerKind,
+	}
+	defaultSchedulerName  = "numaresourcesscheduler"
+	defaultSchedulerImage = "quay.io/openshift-kni/scheduler-plugins:latest"
+)
+
+func TestNewSchedulerBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		image         string
+		expectedError string
+	}{
+		{
+			name:          defaultSchedulerName,
+			image:         defaultSchedulerImage,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			image:         defaultSchedulerImage,
+			expectedError: "NUMAResourcesScheduler 'name' cannot be empty",
+		},
+		{
+			name:          defaultSchedulerName,
+			image:         "",
+			expectedError: "NUMAResourcesScheduler 'schedulerImage' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewSchedulerBuilder(testSettings, testCase.name, testCase.image)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.image, testBuilder.Definition.Spec.SchedulerImage)
+		}
+	}
+}
+
+func TestPullScheduler(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultSchedulerName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("NUMAResourcesScheduler 'name' cannot be empty"),
+		},
+		{
+			name:                defaultSchedulerName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("NUMAResourcesScheduler object %s doesn't exist", defaultSchedulerName),
+		},
+		{
+			name:                defaultSchedulerName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("NUMAResourcesScheduler 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyScheduler(metav1.ConditionTrue))
+		}
+
+		if testCase.client {
+			testSettings = buildSchedulerTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullScheduler(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultSchedulerImage, testBuilder.Definition.Spec.SchedulerImage)
+		}
+	}
+}
+
+func TestSchedulerWithOptions(t *testing.T) {
+	testBuilder := buildValidSchedulerBuilder(buildSchedulerTestClientWithDummyObject(nil)).
+		WithSchedulerName("topo-aware-scheduler").
+		WithCacheResyncPeriod(5 * time.Second)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "topo-aware-scheduler", testBuilder.Definition.Spec.SchedulerName)
+	assert.Equal(t, &metav1.Duration{Duration: 5 * time.Second}, testBuilder.Definition.Spec.CacheResyncPeriod)
+
+	testBuilder = buildValidSchedulerBuilder(buildSchedulerTestClientWithDummyObject(nil)).WithSchedulerName("")
+	assert.EqualError(t, testBuilder.errorMsg, "NUMAResourcesScheduler 'schedulerName' cannot be empty")
+
+	testBuilder = buildValidSchedulerBuilder(buildSchedulerTestClientWithDummyObject(nil)).WithCacheResyncPeriod(0)
+	assert.EqualError(t, testBuilder.errorMsg, "NUMAResourcesScheduler 'cacheResyncPeriod' must be positive")
+}
+
+func TestSchedulerCreate(t *testing.T) {
+	testBuilder, err := buildValidSchedulerBuilder(buildSchedulerTestClientWithDummyObject(nil)).Create()
+	assert.Nil(t, err)
+	assert.Equal(t, defaultSchedulerName, testBuilder.Object.Name)
+}
+
+func TestSchedulerWaitUntilAvailable(t *testing.T) {
+	testCases := []struct {
+		status        metav1.ConditionStatus
+		expectedError string
+	}{
+		{
+			status:        metav1.ConditionTrue,
+			expectedError: "",
+		},
+		{
+			status:        metav1.ConditionFalse,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidSchedulerBuilder(buildSchedulerTestClientWithDummyObject(
+			[]runtime.Object{buildDummyScheduler(testCase.status)}))
+
+		err := testBuilder.WaitUntilAvailable(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestSchedulerGetSchedulerName(t *testing.T) {
+	testBuilder := buildValidSchedulerBuilder(buildSchedulerTestClientWithDummyObject(
+		[]runtime.Object{buildDummyScheduler(metav1.ConditionTrue)}))
+
+	schedulerName, err := testBuilder.GetSchedulerName()
+	assert.Nil(t, err)
+	assert.Equal(t, "topo-aware-scheduler", schedulerName)
+}
+
+func buildValidSchedulerBuilder(apiClient *clients.Settings) *SchedulerBuilder {
+	return NewSchedulerBuilder(apiClient, defaultSchedulerName, defaultSchedulerImage)
+}
+
+func buildDummyScheduler(status metav1.ConditionStatus) *nroptypes.NUMAResourcesScheduler {
+	return &nroptypes.NUMAResourcesScheduler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultSchedulerName,
+		},
+		Spec: nroptypes.NUMAResourcesSchedulerSpec{
+			SchedulerImage: defaultSchedulerImage,
+		},
+		Status: nroptypes.NUMAResourcesSchedulerStatus{
+			SchedulerName: "topo-aware-scheduler",
+			Conditions: []metav1.Condition{{
+				Type:   ConditionAvailable,
+				Status: status,
+				Reason: "AsExpected",
+			}},
+		},
+	}
+}
+
+func buildSchedulerTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{schedulerGVK},
+	})
+}
@@ -0,0 +1,14 @@
+package nto //nolint:misspell
+
+const (
+	// NTONamespace represents the namespace the node tuning operator and its Tuned and Profile objects live in.
+	NTONamespace = "openshift-cluster-node-tuning-operator"
+	// TunedAPIGroup represents the tuned api group.
+	TunedAPIGroup = "tuned.openshift.io"
+	// TunedAPIVersion represents the version of the tuned api.
+	TunedAPIVersion = "v1"
+	// TunedKind represents kind of Tuned object.
+	TunedKind = "Tuned"
+	// TunedProfileKind represents kind of tuned Profile object.
+	TunedProfileKind = "Profile"
+)
@@ -2,19 +2,32 @@ package nto //nolint:misspell
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"k8s.io/utils/cpuset"
 	"k8s.io/utils/strings/slices"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/mco"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	mcpRoleLabel        = "machineconfiguration.openshift.io/role"
+)
+
+var allowedHugePageSizes = []string{"2M", "1G"}
+
 // Builder provides a struct for PerformanceProfile object from the cluster and a PerformanceProfile definition.
 type Builder struct {
 	// PerformanceProfile definition, used to create the PerformanceProfile object.
@@ -22,7 +35,7 @@ type Builder struct {
 	// Created PerformanceProfile object.
 	Object *v2.PerformanceProfile
 	// Used to store latest error message upon defining or mutating PerformanceProfile definition.
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 }
@@ -30,7 +43,7 @@ type Builder struct {
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, cpuIsolated, cpuReserved string, nodeSelector map[string]string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new PerformanceProfile structure with the following params: "+
 			"name: %s, cpu isolated: %s, cpu reserved %s, nodeSelector %v", name, cpuIsolated, cpuReserved, nodeSelector)
 
@@ -54,27 +67,31 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the PerformanceProfile is empty")
+		logging.V(100).Infof("The name of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile's name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PerformanceProfile's name is empty"))
 	}
 
 	if cpuIsolated == "" {
-		glog.V(100).Infof("Isolated CPU of the PerformanceProfile is empty")
+		logging.V(100).Infof("Isolated CPU of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile's 'cpuIsolated' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PerformanceProfile's 'cpuIsolated' is empty"))
 	}
 
 	if cpuReserved == "" {
-		glog.V(100).Infof("Reserved CPU of the PerformanceProfile is empty")
+		logging.V(100).Infof("Reserved CPU of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile's 'cpuReserved' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PerformanceProfile's 'cpuReserved' is empty"))
 	}
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof("NodeSelector of the PerformanceProfile is empty")
+		logging.V(100).Infof("NodeSelector of the PerformanceProfile is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PerformanceProfile's 'nodeSelector' is empty"))
+	}
 
-		builder.errorMsg = "PerformanceProfile's 'nodeSelector' is empty"
+	if cpuIsolated != "" && cpuReserved != "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, validateCPUSets(cpuIsolated, cpuReserved))
 	}
 
 	return builder
@@ -82,7 +99,7 @@ func NewBuilder(
 
 // Pull pulls existing PerformanceProfile from cluster.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing PerformanceProfile name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing PerformanceProfile name %s from cluster", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -94,9 +111,9 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the PerformanceProfile is empty")
+		logging.V(100).Infof("The name of the PerformanceProfile is empty")
 
-		builder.errorMsg = "PerformanceProfile 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PerformanceProfile 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -110,28 +127,28 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 
 // WithHugePages defines the HugePages in the PerformanceProfile. hugePageSize allowed values are 2M, 1G.
 func (builder *Builder) WithHugePages(hugePageSize string, hugePages []v2.HugePage) *Builder {
-	glog.V(100).Infof("Adding hugePages to PerformanceProfile %s, size %s, hugePages %v",
+	logging.V(100).Infof("Adding hugePages to PerformanceProfile %s, size %s, hugePages %v",
 		builder.Definition.Name, hugePageSize, hugePages)
 
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	allowedHugePageSize := []string{"2M", "1G"}
-	if !slices.Contains(allowedHugePageSize, hugePageSize) {
-		glog.V(100).Infof("'hugePageSize' has invalid parameter %s. Allowed parameters %v",
-			hugePageSize, allowedHugePageSize)
+	if !slices.Contains(allowedHugePageSizes, hugePageSize) {
+		logging.V(100).Infof("'hugePageSize' has invalid parameter %s. Allowed parameters %v",
+			hugePageSize, allowedHugePageSizes)
 
-		builder.errorMsg = fmt.Sprintf("'hugePageSize' argument is not in allowed list %v", allowedHugePageSize)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'hugePageSize' argument is not in allowed list %v", allowedHugePageSizes))
 	}
 
 	if len(hugePages) == 0 {
-		glog.V(100).Infof("'hugePages' argument cannot be empty")
+		logging.V(100).Infof("'hugePages' argument cannot be empty")
 
-		builder.errorMsg = "'hugePageSize' argument cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'hugePageSize' argument cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -152,9 +169,152 @@ func (builder *Builder) WithHugePages(hugePageSize string, hugePages []v2.HugePa
 	return builder
 }
 
+// WithHugePage adds hugePages of the given size to the PerformanceProfile. hugePageSize allowed values are 2M, 1G.
+// When numaNodes are given, count pages are allocated on each of them, otherwise they are split equally between all
+// NUMA nodes.
+func (builder *Builder) WithHugePage(hugePageSize string, count int32, numaNodes ...int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding %d hugePages of size %s on NUMA nodes %v to PerformanceProfile %s",
+		count, hugePageSize, numaNodes, builder.Definition.Name)
+
+	if !slices.Contains(allowedHugePageSizes, hugePageSize) {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'hugePageSize' argument is not in allowed list %v", allowedHugePageSizes))
+
+		return builder
+	}
+
+	if count <= 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'count' argument must be positive"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.HugePages == nil {
+		builder.Definition.Spec.HugePages = &v2.HugePages{}
+	}
+
+	var newPages []v2.HugePage
+
+	if len(numaNodes) == 0 {
+		newPages = append(newPages, v2.HugePage{Size: v2.HugePageSize(hugePageSize), Count: count})
+	}
+
+	for _, numaNode := range numaNodes {
+		node := numaNode
+		newPages = append(newPages, v2.HugePage{Size: v2.HugePageSize(hugePageSize), Count: count, Node: &node})
+	}
+
+	for _, newPage := range newPages {
+		for _, page := range builder.Definition.Spec.HugePages.Pages {
+			if page.Size == newPage.Size && equalNUMANode(page.Node, newPage.Node) {
+				builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+					"hugePages of size %s are already defined for the same NUMA node", hugePageSize))
+
+				return builder
+			}
+		}
+	}
+
+	builder.Definition.Spec.HugePages.Pages = append(builder.Definition.Spec.HugePages.Pages, newPages...)
+
+	return builder
+}
+
+// WithIsolatedCPUs redefines the isolated CPU set of the PerformanceProfile. It cannot overlap the reserved CPU set.
+func (builder *Builder) WithIsolatedCPUs(cpuIsolated string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting isolated CPUs %s in PerformanceProfile %s", cpuIsolated, builder.Definition.Name)
+
+	if cpuIsolated == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PerformanceProfile's 'cpuIsolated' is empty"))
+
+		return builder
+	}
+
+	cpu := builder.getCPU()
+
+	if cpu.Reserved != nil {
+		if err := validateCPUSets(cpuIsolated, string(*cpu.Reserved)); err != nil {
+			builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+			return builder
+		}
+	}
+
+	isolatedCPUSet := v2.CPUSet(cpuIsolated)
+	cpu.Isolated = &isolatedCPUSet
+
+	return builder
+}
+
+// WithReservedCPUs redefines the reserved CPU set of the PerformanceProfile. It cannot overlap the isolated CPU set.
+func (builder *Builder) WithReservedCPUs(cpuReserved string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting reserved CPUs %s in PerformanceProfile %s", cpuReserved, builder.Definition.Name)
+
+	if cpuReserved == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PerformanceProfile's 'cpuReserved' is empty"))
+
+		return builder
+	}
+
+	cpu := builder.getCPU()
+
+	if cpu.Isolated != nil {
+		if err := validateCPUSets(string(*cpu.Isolated), cpuReserved); err != nil {
+			builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+			return builder
+		}
+	}
+
+	reservedCPUSet := v2.CPUSet(cpuReserved)
+	cpu.Reserved = &reservedCPUSet
+
+	return builder
+}
+
+// WithUserLevelNetworking enables user level networking in the PerformanceProfile, setting the queue count of the
+// given network devices to the amount of reserved CPUs. When no device is given, all devices are tuned.
+func (builder *Builder) WithUserLevelNetworking(devices ...v2.Device) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Enabling user level networking on devices %v in PerformanceProfile %s",
+		devices, builder.Definition.Name)
+
+	for _, device := range devices {
+		if device.InterfaceName == nil && device.VendorID == nil && device.DeviceID == nil {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"net device must define at least one of 'interfaceName', 'vendorID' or 'deviceID'"))
+
+			return builder
+		}
+	}
+
+	enabled := true
+	builder.Definition.Spec.Net = &v2.Net{
+		UserLevelNetworking: &enabled,
+		Devices:             devices,
+	}
+
+	return builder
+}
+
 // WithMachineConfigPoolSelector defines the MachineConfigPoolSelector in the PerformanceProfile.
 func (builder *Builder) WithMachineConfigPoolSelector(machineConfigPoolSelector map[string]string) *Builder {
-	glog.V(100).Infof("Adding MachineConfigPoolSelector %v to PerformanceProfile %s",
+	logging.V(100).Infof("Adding MachineConfigPoolSelector %v to PerformanceProfile %s",
 		machineConfigPoolSelector, builder.Definition.Name)
 
 	if valid, _ := builder.validate(); !valid {
@@ -162,12 +322,12 @@ func (builder *Builder) WithMachineConfigPoolSelector(machineConfigPoolSelector
 	}
 
 	if len(machineConfigPoolSelector) == 0 {
-		glog.V(100).Infof("'machineConfigPoolSelector' argument cannot be empty")
+		logging.V(100).Infof("'machineConfigPoolSelector' argument cannot be empty")
 
-		builder.errorMsg = "'machineConfigPoolSelector' argument cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'machineConfigPoolSelector' argument cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -178,7 +338,7 @@ func (builder *Builder) WithMachineConfigPoolSelector(machineConfigPoolSelector
 
 // WithNumaTopology defines the NumaTopologyPolicy in the PerformanceProfile.
 func (builder *Builder) WithNumaTopology(topologyPolicy string) *Builder {
-	glog.V(100).Infof("Adding NumaTopologyPolicy %s to PerformanceProfile %s",
+	logging.V(100).Infof("Adding NumaTopologyPolicy %s to PerformanceProfile %s",
 		topologyPolicy, builder.Definition.Name)
 
 	if valid, _ := builder.validate(); !valid {
@@ -187,17 +347,22 @@ func (builder *Builder) WithNumaTopology(topologyPolicy string) *Builder {
 
 	allowedTopologyPolicies := []string{"best-effort", "restricted", "single-numa-node"}
 	if !slices.Contains(allowedTopologyPolicies, topologyPolicy) {
-		glog.V(100).Infof("'allowedTopologyPolicies' has invalid parameter %s. Allowed parameters %v",
+		logging.V(100).Infof("'allowedTopologyPolicies' has invalid parameter %s. Allowed parameters %v",
 			topologyPolicy, allowedTopologyPolicies)
 
-		builder.errorMsg = fmt.Sprintf("'allowedTopologyPolicies' argument is not in allowed list %v",
-			allowedTopologyPolicies)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"'allowedTopologyPolicies' argument is not in allowed list %v",
+			allowedTopologyPolicies))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
+	if builder.Definition.Spec.NUMA == nil {
+		builder.Definition.Spec.NUMA = &v2.NUMA{}
+	}
+
 	builder.Definition.Spec.NUMA.TopologyPolicy = &topologyPolicy
 
 	return builder
@@ -205,7 +370,7 @@ func (builder *Builder) WithNumaTopology(topologyPolicy string) *Builder {
 
 // WithRTKernel defines the Real Time Kernel in the PerformanceProfile.
 func (builder *Builder) WithRTKernel() *Builder {
-	glog.V(100).Infof("Adding RTKernel flag to PerformanceProfile %s", builder.Definition.Name)
+	logging.V(100).Infof("Adding RTKernel flag to PerformanceProfile %s", builder.Definition.Name)
 
 	if valid, _ := builder.validate(); !valid {
 		return builder
@@ -219,7 +384,7 @@ func (builder *Builder) WithRTKernel() *Builder {
 
 // WithWorkloadHints defines the Workload Hints in the PerformanceProfile.
 func (builder *Builder) WithWorkloadHints(rtHint, perPodPowerMgmtHint, highPowerHint bool) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding WorkloadHints flags: RealTime=%t, PerPodPowerManagement=%t, HighPowerConsumption=%t to PerformanceProfile %s",
 		rtHint, perPodPowerMgmtHint, highPowerHint, builder.Definition.Name)
 
@@ -250,7 +415,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating PerformanceProfile %s ", builder.Definition.Name)
+	logging.V(100).Infof("Creating PerformanceProfile %s ", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -272,7 +437,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if PerformanceProfile %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if PerformanceProfile %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
@@ -286,7 +451,7 @@ func (builder *Builder) Get() (*v2.PerformanceProfile, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting PerformanceProfile %s", builder.Definition.Name)
+	logging.V(100).Infof("Getting PerformanceProfile %s", builder.Definition.Name)
 
 	module := &v2.PerformanceProfile{}
 
@@ -307,7 +472,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting PerformanceProfile %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting PerformanceProfile %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("PerformanceProfile cannot be deleted because it does not exist")
@@ -330,20 +495,20 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the PerformanceProfile object: %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating the PerformanceProfile object: %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				"Failed to update the PerformanceProfile object %s. "+
 					"Note: Force flag set, executed delete/create methods instead", builder.Definition.Name)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					"Failed to update the PerformanceProfile object %s, "+
 						"due to error in delete function", builder.Definition.Name)
 
@@ -361,33 +526,117 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 	return builder, err
 }
 
+// WaitForMCPUpdate waits for timeout duration or until the MachineConfigPool the PerformanceProfile is applied to is
+// updated, i.e. the rendered configuration has been rolled out to all of its nodes.
+func (builder *Builder) WaitForMCPUpdate(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the MachineConfigPool of PerformanceProfile %s to be updated",
+		builder.Definition.Name)
+
+	mcpSelector := builder.getMCPSelector()
+	if len(mcpSelector) == 0 {
+		return fmt.Errorf("cannot determine the MachineConfigPool of PerformanceProfile %s", builder.Definition.Name)
+	}
+
+	mcpList, err := mco.ListMCP(builder.apiClient,
+		metav1.ListOptions{LabelSelector: labels.SelectorFromSet(mcpSelector).String()})
+	if err != nil {
+		return err
+	}
+
+	if len(mcpList) != 1 {
+		return fmt.Errorf("expected exactly one MachineConfigPool with labels %v for PerformanceProfile %s, found %d",
+			mcpSelector, builder.Definition.Name, len(mcpList))
+	}
+
+	return mcpList[0].WaitForUpdate(timeout)
+}
+
+// getCPU returns the CPU section of the definition, initializing it if needed.
+func (builder *Builder) getCPU() *v2.CPU {
+	if builder.Definition.Spec.CPU == nil {
+		builder.Definition.Spec.CPU = &v2.CPU{}
+	}
+
+	return builder.Definition.Spec.CPU
+}
+
+// getMCPSelector returns the labels of the MachineConfigPool the PerformanceProfile applies to. Like the operator, it
+// falls back to the role of the node selector when no MachineConfigPool selector is defined.
+func (builder *Builder) getMCPSelector() map[string]string {
+	if len(builder.Definition.Spec.MachineConfigPoolSelector) > 0 {
+		return builder.Definition.Spec.MachineConfigPoolSelector
+	}
+
+	for key := range builder.Definition.Spec.NodeSelector {
+		if role, found := strings.CutPrefix(key, nodeRoleLabelPrefix); found {
+			return map[string]string{mcpRoleLabel: role}
+		}
+	}
+
+	return nil
+}
+
+// validateCPUSets checks that the isolated and reserved CPU sets are valid and do not overlap.
+func validateCPUSets(cpuIsolated, cpuReserved string) error {
+	isolated, err := cpuset.Parse(cpuIsolated)
+	if err != nil {
+		return fmt.Errorf("PerformanceProfile's 'cpuIsolated' %s is invalid: %w", cpuIsolated, err)
+	}
+
+	reserved, err := cpuset.Parse(cpuReserved)
+	if err != nil {
+		return fmt.Errorf("PerformanceProfile's 'cpuReserved' %s is invalid: %w", cpuReserved, err)
+	}
+
+	if overlap := isolated.Intersection(reserved); !overlap.IsEmpty() {
+		return fmt.Errorf("PerformanceProfile's isolated and reserved CPUs overlap: %s", overlap.String())
+	}
+
+	return nil
+}
+
+// equalNUMANode returns true when both hugePages NUMA nodes are unset or set to the same node.
+func equalNUMANode(node, otherNode *int32) bool {
+	if node == nil || otherNode == nil {
+		return node == otherNode
+	}
+
+	return *node == *otherNode
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "PerformanceProfile"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
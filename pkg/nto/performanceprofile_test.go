@@ -0,0 +1,383 @@
+package nto //nolint:misspell
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
+	mcov1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultProfileName     = "performance"
+	defaultProfileIsolated = "2-15"
+	defaultProfileReserved = "0-1"
+)
+
+var defaultProfileNodeSelector = map[string]string{"node-role.kubernetes.io/worker-cnf": ""}
+
+func TestNewPerformanceProfileBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cpuIsolated   string
+		cpuReserved   string
+		nodeSelector  map[string]string
+		expectedError string
+	}{
+		{
+			name:          defaultProfileName,
+			cpuIsolated:   defaultProfileIsolated,
+			cpuReserved:   defaultProfileReserved,
+			nodeSelector:  defaultProfileNodeSelector,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			cpuIsolated:   defaultProfileIsolated,
+			cpuReserved:   defaultProfileReserved,
+			nodeSelector:  defaultProfileNodeSelector,
+			expectedError: "PerformanceProfile's name is empty",
+		},
+		{
+			name:          defaultProfileName,
+			cpuIsolated:   "",
+			cpuReserved:   defaultProfileReserved,
+			nodeSelector:  defaultProfileNodeSelector,
+			expectedError: "PerformanceProfile's 'cpuIsolated' is empty",
+		},
+		{
+			name:          defaultProfileName,
+			cpuIsolated:   defaultProfileIsolated,
+			cpuReserved:   "",
+			nodeSelector:  defaultProfileNodeSelector,
+			expectedError: "PerformanceProfile's 'cpuReserved' is empty",
+		},
+		{
+			name:          defaultProfileName,
+			cpuIsolated:   defaultProfileIsolated,
+			cpuReserved:   defaultProfileReserved,
+			nodeSelector:  nil,
+			expectedError: "PerformanceProfile's 'nodeSelector' is empty",
+		},
+		{
+			name:          defaultProfileName,
+			cpuIsolated:   "1-15",
+			cpuReserved:   defaultProfileReserved,
+			nodeSelector:  defaultProfileNodeSelector,
+			expectedError: "PerformanceProfile's isolated and reserved CPUs overlap: 1",
+		},
+		{
+			name:         defaultProfileName,
+			cpuIsolated:  "2-a",
+			cpuReserved:  defaultProfileReserved,
+			nodeSelector: defaultProfileNodeSelector,
+			expectedError: "PerformanceProfile's 'cpuIsolated' 2-a is invalid: " +
+				"strconv.Atoi: parsing \"a\": invalid syntax",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			testCase.name, testCase.cpuIsolated, testCase.cpuReserved, testCase.nodeSelector)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, v2.CPUSet(testCase.cpuIsolated), *testBuilder.Definition.Spec.CPU.Isolated)
+			assert.Equal(t, v2.CPUSet(testCase.cpuReserved), *testBuilder.Definition.Spec.CPU.Reserved)
+			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Spec.NodeSelector)
+		}
+	}
+}
+
+func TestPerformanceProfileWithIsolatedCPUs(t *testing.T) {
+	testCases := []struct {
+		cpuIsolated   string
+		expectedError string
+	}{
+		{
+			cpuIsolated:   "4-15",
+			expectedError: "",
+		},
+		{
+			cpuIsolated:   "",
+			expectedError: "PerformanceProfile's 'cpuIsolated' is empty",
+		},
+		{
+			cpuIsolated:   "0-15",
+			expectedError: "PerformanceProfile's isolated and reserved CPUs overlap: 0-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPerformanceProfileBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithIsolatedCPUs(testCase.cpuIsolated)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, v2.CPUSet(testCase.cpuIsolated), *testBuilder.Definition.Spec.CPU.Isolated)
+		}
+	}
+}
+
+func TestPerformanceProfileWithReservedCPUs(t *testing.T) {
+	testCases := []struct {
+		cpuReserved   string
+		expectedError string
+	}{
+		{
+			cpuReserved:   "0",
+			expectedError: "",
+		},
+		{
+			cpuReserved:   "",
+			expectedError: "PerformanceProfile's 'cpuReserved' is empty",
+		},
+		{
+			cpuReserved:   "0-3",
+			expectedError: "PerformanceProfile's isolated and reserved CPUs overlap: 2-3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPerformanceProfileBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithReservedCPUs(testCase.cpuReserved)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, v2.CPUSet(testCase.cpuReserved), *testBuilder.Definition.Spec.CPU.Reserved)
+		}
+	}
+}
+
+func TestPerformanceProfileWithHugePage(t *testing.T) {
+	numaNode0 := int32(0)
+	numaNode1 := int32(1)
+
+	testCases := []struct {
+		existingPages []v2.HugePage
+		hugePageSize  string
+		count         int32
+		numaNodes     []int32
+		expectedPages []v2.HugePage
+		expectedError string
+	}{
+		{
+			hugePageSize:  "1G",
+			count:         4,
+			expectedPages: []v2.HugePage{{Size: "1G", Count: 4}},
+			expectedError: "",
+		},
+		{
+			hugePageSize: "2M",
+			count:        128,
+			numaNodes:    []int32{0, 1},
+			expectedPages: []v2.HugePage{
+				{Size: "2M", Count: 128, Node: &numaNode0}, {Size: "2M", Count: 128, Node: &numaNode1}},
+			expectedError: "",
+		},
+		{
+			existingPages: []v2.HugePage{{Size: "1G", Count: 2, Node: &numaNode0}},
+			hugePageSize:  "1G",
+			count:         2,
+			numaNodes:     []int32{1},
+			expectedPages: []v2.HugePage{
+				{Size: "1G", Count: 2, Node: &numaNode0}, {Size: "1G", Count: 2, Node: &numaNode1}},
+			expectedError: "",
+		},
+		{
+			existingPages: []v2.HugePage{{Size: "1G", Count: 2, Node: &numaNode0}},
+			hugePageSize:  "1G",
+			count:         4,
+			numaNodes:     []int32{0},
+			expectedError: "hugePages of size 1G are already defined for the same NUMA node",
+		},
+		{
+			hugePageSize:  "1Gi",
+			count:         4,
+			expectedError: "'hugePageSize' argument is not in allowed list [2M 1G]",
+		},
+		{
+			hugePageSize:  "1G",
+			count:         0,
+			expectedError: "'count' argument must be positive",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPerformanceProfileBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.existingPages != nil {
+			testBuilder.Definition.Spec.HugePages = &v2.HugePages{Pages: testCase.existingPages}
+		}
+
+		testBuilder = testBuilder.WithHugePage(testCase.hugePageSize, testCase.count, testCase.numaNodes...)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedPages, testBuilder.Definition.Spec.HugePages.Pages)
+		}
+	}
+}
+
+func TestPerformanceProfileWithUserLevelNetworking(t *testing.T) {
+	interfaceName := "ens1f0"
+	vendorID := "0x8086"
+
+	testCases := []struct {
+		devices       []v2.Device
+		expectedError string
+	}{
+		{
+			devices:       nil,
+			expectedError: "",
+		},
+		{
+			devices:       []v2.Device{{InterfaceName: &interfaceName}, {VendorID: &vendorID}},
+			expectedError: "",
+		},
+		{
+			devices:       []v2.Device{{InterfaceName: &interfaceName}, {}},
+			expectedError: "net device must define at least one of 'interfaceName', 'vendorID' or 'deviceID'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPerformanceProfileBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithUserLevelNetworking(testCase.devices...)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.NotNil(t, testBuilder.Definition.Spec.Net)
+			assert.True(t, *testBuilder.Definition.Spec.Net.UserLevelNetworking)
+			assert.Equal(t, testCase.devices, testBuilder.Definition.Spec.Net.Devices)
+		}
+	}
+}
+
+func TestPerformanceProfileWaitForMCPUpdate(t *testing.T) {
+	testCases := []struct {
+		nodeSelector  map[string]string
+		mcpSelector   map[string]string
+		mcpLabels     map[string]string
+		expectedError string
+	}{
+		{
+			nodeSelector:  defaultProfileNodeSelector,
+			mcpLabels:     map[string]string{mcpRoleLabel: "worker-cnf"},
+			expectedError: "",
+		},
+		{
+			nodeSelector:  defaultProfileNodeSelector,
+			mcpSelector:   map[string]string{"pools.operator.machineconfiguration.openshift.io/cnf": ""},
+			mcpLabels:     map[string]string{"pools.operator.machineconfiguration.openshift.io/cnf": ""},
+			expectedError: "",
+		},
+		{
+			nodeSelector: defaultProfileNodeSelector,
+			mcpLabels:    map[string]string{mcpRoleLabel: "worker"},
+			expectedError: fmt.Sprintf("expected exactly one MachineConfigPool with labels map[%s:worker-cnf] "+
+				"for PerformanceProfile %s, found 0", mcpRoleLabel, defaultProfileName),
+		},
+		{
+			nodeSelector: map[string]string{"kubernetes.io/hostname": "worker-0"},
+			mcpLabels:    map[string]string{mcpRoleLabel: "worker-cnf"},
+			expectedError: fmt.Sprintf("cannot determine the MachineConfigPool of PerformanceProfile %s",
+				defaultProfileName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testSettings.MachineconfigurationV1Interface = testhelper.NewMachineConfigV1Client(t,
+			buildDummyUpdatedMCP(testCase.mcpLabels))
+
+		testBuilder := buildValidPerformanceProfileBuilder(testSettings)
+		testBuilder.Definition.Spec.NodeSelector = testCase.nodeSelector
+		testBuilder.Definition.Spec.MachineConfigPoolSelector = testCase.mcpSelector
+
+		err := testBuilder.WaitForMCPUpdate(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestPerformanceProfileCreate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPerformanceProfile())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := buildValidPerformanceProfileBuilder(testSettings).WithHugePage("1G", 4, 0).Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.True(t, testBuilder.Exists())
+
+			pulledBuilder, err := Pull(testSettings, defaultProfileName)
+			assert.Nil(t, err)
+			assert.Equal(t, defaultProfileName, pulledBuilder.Definition.Name)
+		}
+	}
+}
+
+func buildValidPerformanceProfileBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(
+		apiClient, defaultProfileName, defaultProfileIsolated, defaultProfileReserved, defaultProfileNodeSelector)
+}
+
+func buildDummyPerformanceProfile() *v2.PerformanceProfile {
+	isolatedCPUSet := v2.CPUSet(defaultProfileIsolated)
+	reservedCPUSet := v2.CPUSet(defaultProfileReserved)
+
+	return &v2.PerformanceProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultProfileName,
+		},
+		Spec: v2.PerformanceProfileSpec{
+			CPU: &v2.CPU{
+				Isolated: &isolatedCPUSet,
+				Reserved: &reservedCPUSet,
+			},
+			NodeSelector: defaultProfileNodeSelector,
+		},
+	}
+}
+
+func buildDummyUpdatedMCP(mcpLabels map[string]string) *mcov1.MachineConfigPool {
+	return &mcov1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "worker-cnf",
+			Labels: mcpLabels,
+		},
+		Status: mcov1.MachineConfigPoolStatus{
+			MachineCount:        1,
+			UpdatedMachineCount: 1,
+			Conditions: []mcov1.MachineConfigPoolCondition{{
+				Type:   mcov1.MachineConfigPoolUpdated,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+}
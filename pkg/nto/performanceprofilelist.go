@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	v2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -16,7 +16,7 @@ func ListProfiles(apiClient *clients.Settings, options ...goclient.ListOptions)
 	logMessage := "Listing PerformanceProfiles on cluster"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -26,13 +26,13 @@ func ListProfiles(apiClient *clients.Settings, options ...goclient.ListOptions)
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	var performanceProfiles v2.PerformanceProfileList
 	err := apiClient.List(context.TODO(), &performanceProfiles, &passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list PerformanceProfiles due to %s", err.Error())
+		logging.V(100).Infof("Failed to list PerformanceProfiles due to %s", err.Error())
 
 		return nil, err
 	}
@@ -55,12 +55,12 @@ func ListProfiles(apiClient *clients.Settings, options ...goclient.ListOptions)
 
 // CleanAllPerformanceProfiles removes all PerformanceProfiles installed on a cluster.
 func CleanAllPerformanceProfiles(apiClient *clients.Settings, options ...goclient.ListOptions) error {
-	glog.V(100).Infof("Cleaning up PerformanceProfiles")
+	logging.V(100).Infof("Cleaning up PerformanceProfiles")
 
 	policies, err := ListProfiles(apiClient, options...)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list PerformanceProfiles")
+		logging.V(100).Infof("Failed to list PerformanceProfiles")
 
 		return err
 	}
@@ -69,7 +69,7 @@ func CleanAllPerformanceProfiles(apiClient *clients.Settings, options ...goclien
 		_, err = policy.Delete()
 
 		if err != nil {
-			glog.V(100).Infof("Failed to delete PerformanceProfiles: %s", policy.Object.Name)
+			logging.V(100).Infof("Failed to delete PerformanceProfiles: %s", policy.Object.Name)
 
 			return err
 		}
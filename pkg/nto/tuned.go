@@ -0,0 +1,391 @@
+package nto //nolint:misspell
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/nto/tunedtypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TunedBuilder provides struct for the Tuned object containing connection to the cluster and the Tuned definitions.
+type TunedBuilder struct {
+	// Tuned definition. Used to create the Tuned object.
+	Definition *tunedtypes.Tuned
+	// Created Tuned object.
+	Object *tunedtypes.Tuned
+	// Used in functions that define or mutate Tuned definition. errorMsg is processed before the Tuned object is
+	// created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewTunedBuilder creates a new instance of TunedBuilder. Tuned objects are only reconciled by the operator in the
+// NTONamespace namespace.
+func NewTunedBuilder(apiClient *clients.Settings, name, nsname string) *TunedBuilder {
+	logging.V(100).Infof(
+		"Initializing new Tuned structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := TunedBuilder{
+		apiClient: apiClient,
+		Definition: &tunedtypes.Tuned{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       TunedKind,
+				APIVersion: fmt.Sprintf("%s/%s", TunedAPIGroup, TunedAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the Tuned is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the Tuned is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullTuned pulls existing Tuned from cluster.
+func PullTuned(apiClient *clients.Settings, name, nsname string) (*TunedBuilder, error) {
+	logging.V(100).Infof("Pulling existing Tuned name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("Tuned 'apiClient' cannot be empty")
+	}
+
+	builder := TunedBuilder{
+		apiClient: apiClient,
+		Definition: &tunedtypes.Tuned{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the Tuned is empty")
+
+		return nil, fmt.Errorf("Tuned 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the Tuned is empty")
+
+		return nil, fmt.Errorf("Tuned 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("Tuned object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithProfile adds a tuned profile to the Tuned. data is the content of the profile in the tuned configuration
+// format, usually starting with a [main] section including the profile it inherits from.
+func (builder *TunedBuilder) WithProfile(name, data string) *TunedBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding profile %s to Tuned %s in namespace %s",
+		name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned profile 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if data == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned profile 'data' cannot be empty"))
+
+		return builder
+	}
+
+	for _, profile := range builder.Definition.Spec.Profile {
+		if profile.Name != nil && *profile.Name == name {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned profile %s already exists", name))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Profile = append(builder.Definition.Spec.Profile, tunedtypes.TunedProfile{
+		Name: &name,
+		Data: &data,
+	})
+
+	return builder
+}
+
+// WithRecommend adds a rule recommending the given profile on the nodes matching all the given rules, or on all nodes
+// when none is given. Among the matching recommendations, the one with the lowest priority value wins.
+func (builder *TunedBuilder) WithRecommend(
+	profileName string, priority uint64, matches ...tunedtypes.TunedMatch) *TunedBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding recommend for profile %s with priority %d to Tuned %s in namespace %s",
+		profileName, priority, builder.Definition.Name, builder.Definition.Namespace)
+
+	if profileName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned recommend 'profileName' cannot be empty"))
+
+		return builder
+	}
+
+	for _, match := range matches {
+		if match.Label == nil || *match.Label == "" {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned recommend match 'label' cannot be empty"))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Recommend = append(builder.Definition.Spec.Recommend, tunedtypes.TunedRecommend{
+		Profile:  &profileName,
+		Priority: &priority,
+		Match:    matches,
+	})
+
+	return builder
+}
+
+// WithMachineConfigRecommend adds a rule recommending the given profile on the nodes of the MachineConfigPools
+// matching mcpLabels. Unlike node label matches, this also allows the kernel arguments of the profile to be applied
+// through a MachineConfig.
+func (builder *TunedBuilder) WithMachineConfigRecommend(
+	profileName string, priority uint64, mcpLabels map[string]string) *TunedBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding recommend for profile %s on MachineConfigPools %v to Tuned %s in namespace %s",
+		profileName, mcpLabels, builder.Definition.Name, builder.Definition.Namespace)
+
+	if profileName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned recommend 'profileName' cannot be empty"))
+
+		return builder
+	}
+
+	if len(mcpLabels) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Tuned recommend 'mcpLabels' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Recommend = append(builder.Definition.Spec.Recommend, tunedtypes.TunedRecommend{
+		Profile:             &profileName,
+		Priority:            &priority,
+		MachineConfigLabels: mcpLabels,
+	})
+
+	return builder
+}
+
+// Get returns Tuned object if found.
+func (builder *TunedBuilder) Get() (*tunedtypes.Tuned, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting Tuned object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetTunedGVR()).
+		Namespace(builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Tuned object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertTunedToStructured(unsObject)
+}
+
+// Exists checks whether the given Tuned exists.
+func (builder *TunedBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if Tuned %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a Tuned in the cluster and stores the created object in struct.
+func (builder *TunedBuilder) Create() (*TunedBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the Tuned %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredTuned, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured Tuned to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetTunedGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredTuned}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create Tuned %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertTunedToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing Tuned object with the definition in builder.
+func (builder *TunedBuilder) Update() (*TunedBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the Tuned %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update Tuned, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredTuned, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured Tuned to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetTunedGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredTuned}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertTunedToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes Tuned object from a cluster.
+func (builder *TunedBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the Tuned object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetTunedGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete Tuned: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetTunedGVR returns Tuned's GroupVersionResource which could be used for Clean function.
+func GetTunedGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: TunedAPIGroup, Version: TunedAPIVersion, Resource: "tuneds"}
+}
+
+// convertTunedToStructured converts the unstructured object returned by the dynamic client to a Tuned.
+func convertTunedToStructured(unsObject *unstructured.Unstructured) (*tunedtypes.Tuned, error) {
+	tuned := &tunedtypes.Tuned{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, tuned)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to Tuned object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return tuned, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *TunedBuilder) validate() (bool, error) {
+	resourceCRD := "Tuned"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
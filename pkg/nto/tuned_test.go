@@ -0,0 +1,380 @@
+package nto //nolint:misspell
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/nto/tunedtypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	defaultTunedName      = "openshift-cnf"
+	defaultTunedNamespace = "openshift-cluster-node-tuning-operator"
+	defaultTunedProfile   = "openshift-cnf-profile"
+	defaultTunedData      = "[main]\nsummary=CNF tuning\ninclude=openshift-node\n[sysctl]\nnet.core.busy_read=50\n"
+)
+
+var tunedGVK = schema.GroupVersionKind{
+	Group:   TunedAPIGroup,
+	Version: TunedAPIVersion,
+	Kind:    TunedKind,
+}
+
+func TestNewTunedBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultTunedName,
+			namespace:     defaultTunedNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultTunedNamespace,
+			expectedError: "Tuned 'name' cannot be empty",
+		},
+		{
+			name:          defaultTunedName,
+			namespace:     "",
+			expectedError: "Tuned 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewTunedBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, TunedKind, testBuilder.Definition.Kind)
+		}
+	}
+}
+
+func TestPullTuned(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultTunedName,
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "Tuned 'name' cannot be empty",
+		},
+		{
+			name:                defaultTunedName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "Tuned 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultTunedName,
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Sprintf(
+				"Tuned object %s doesn't exist in namespace %s", defaultTunedName, defaultTunedNamespace),
+		},
+		{
+			name:                defaultTunedName,
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "Tuned 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyTuned())
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: runtimeObjects,
+				GVK:            []schema.GroupVersionKind{tunedGVK},
+			})
+		}
+
+		testBuilder, err := PullTuned(testSettings, testCase.name, testCase.namespace)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Len(t, testBuilder.Definition.Spec.Profile, 1)
+		}
+	}
+}
+
+func TestTunedWithProfile(t *testing.T) {
+	testCases := []struct {
+		name          string
+		data          string
+		expectedError string
+	}{
+		{
+			name:          "openshift-cnf-rt",
+			data:          defaultTunedData,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			data:          defaultTunedData,
+			expectedError: "Tuned profile 'name' cannot be empty",
+		},
+		{
+			name:          "openshift-cnf-rt",
+			data:          "",
+			expectedError: "Tuned profile 'data' cannot be empty",
+		},
+		{
+			name:          defaultTunedProfile,
+			data:          defaultTunedData,
+			expectedError: fmt.Sprintf("Tuned profile %s already exists", defaultTunedProfile),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTunedBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithProfile(testCase.name, testCase.data)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, testBuilder.Definition.Spec.Profile, 2)
+			assert.Equal(t, testCase.name, *testBuilder.Definition.Spec.Profile[1].Name)
+			assert.Equal(t, testCase.data, *testBuilder.Definition.Spec.Profile[1].Data)
+		}
+	}
+}
+
+func TestTunedWithRecommend(t *testing.T) {
+	nodeLabel := "node-role.kubernetes.io/worker-cnf"
+	emptyLabel := ""
+
+	testCases := []struct {
+		profileName   string
+		matches       []tunedtypes.TunedMatch
+		expectedError string
+	}{
+		{
+			profileName:   defaultTunedProfile,
+			matches:       []tunedtypes.TunedMatch{{Label: &nodeLabel}},
+			expectedError: "",
+		},
+		{
+			profileName:   defaultTunedProfile,
+			matches:       nil,
+			expectedError: "",
+		},
+		{
+			profileName:   "",
+			matches:       []tunedtypes.TunedMatch{{Label: &nodeLabel}},
+			expectedError: "Tuned recommend 'profileName' cannot be empty",
+		},
+		{
+			profileName:   defaultTunedProfile,
+			matches:       []tunedtypes.TunedMatch{{Label: &emptyLabel}},
+			expectedError: "Tuned recommend match 'label' cannot be empty",
+		},
+		{
+			profileName:   defaultTunedProfile,
+			matches:       []tunedtypes.TunedMatch{{}},
+			expectedError: "Tuned recommend match 'label' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTunedBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithRecommend(testCase.profileName, 20, testCase.matches...)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, testBuilder.Definition.Spec.Recommend, 1)
+			assert.Equal(t, testCase.profileName, *testBuilder.Definition.Spec.Recommend[0].Profile)
+			assert.Equal(t, uint64(20), *testBuilder.Definition.Spec.Recommend[0].Priority)
+			assert.Equal(t, testCase.matches, testBuilder.Definition.Spec.Recommend[0].Match)
+		}
+	}
+}
+
+func TestTunedWithMachineConfigRecommend(t *testing.T) {
+	testCases := []struct {
+		profileName   string
+		mcpLabels     map[string]string
+		expectedError string
+	}{
+		{
+			profileName:   defaultTunedProfile,
+			mcpLabels:     map[string]string{"machineconfiguration.openshift.io/role": "worker-cnf"},
+			expectedError: "",
+		},
+		{
+			profileName:   "",
+			mcpLabels:     map[string]string{"machineconfiguration.openshift.io/role": "worker-cnf"},
+			expectedError: "Tuned recommend 'profileName' cannot be empty",
+		},
+		{
+			profileName:   defaultTunedProfile,
+			mcpLabels:     nil,
+			expectedError: "Tuned recommend 'mcpLabels' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTunedBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithMachineConfigRecommend(testCase.profileName, 10, testCase.mcpLabels)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, testBuilder.Definition.Spec.Recommend, 1)
+			assert.Equal(t, testCase.profileName, *testBuilder.Definition.Spec.Recommend[0].Profile)
+			assert.Equal(t, testCase.mcpLabels, testBuilder.Definition.Spec.Recommend[0].MachineConfigLabels)
+		}
+	}
+}
+
+func TestTunedCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *TunedBuilder
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidTunedBuilder(buildTestClientWithDummyTuned()),
+			expectedError: "",
+		},
+		{
+			testBuilder: buildValidTunedBuilder(clients.GetTestClients(clients.TestClientParams{
+				GVK: []schema.GroupVersionKind{tunedGVK},
+			})),
+			expectedError: "",
+		},
+		{
+			testBuilder: buildValidTunedBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithProfile("", defaultTunedData),
+			expectedError: "Tuned profile 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, defaultTunedName, testBuilder.Object.Name)
+			assert.Equal(t, defaultTunedNamespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestTunedUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "failed to update Tuned, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyTuned())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+			GVK:            []schema.GroupVersionKind{tunedGVK},
+		})
+
+		testBuilder, err := buildValidTunedBuilder(testSettings).
+			WithProfile("openshift-cnf-rt", defaultTunedData).Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, testBuilder.Object.Spec.Profile, 2)
+		}
+	}
+}
+
+func TestTunedDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *TunedBuilder
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidTunedBuilder(buildTestClientWithDummyTuned()),
+			expectedError: "",
+		},
+		{
+			testBuilder: buildValidTunedBuilder(clients.GetTestClients(clients.TestClientParams{
+				GVK: []schema.GroupVersionKind{tunedGVK},
+			})),
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Nil(t, testCase.testBuilder.Object)
+			assert.False(t, testCase.testBuilder.Exists())
+		}
+	}
+}
+
+func buildValidTunedBuilder(apiClient *clients.Settings) *TunedBuilder {
+	return NewTunedBuilder(apiClient, defaultTunedName, defaultTunedNamespace).
+		WithProfile(defaultTunedProfile, defaultTunedData)
+}
+
+func buildDummyTuned() *tunedtypes.Tuned {
+	profileName := defaultTunedProfile
+	profileData := defaultTunedData
+
+	return &tunedtypes.Tuned{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultTunedName,
+			Namespace: defaultTunedNamespace,
+		},
+		Spec: tunedtypes.TunedSpec{
+			Profile: []tunedtypes.TunedProfile{{Name: &profileName, Data: &profileData}},
+		},
+	}
+}
+
+func buildTestClientWithDummyTuned() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyTuned()},
+		GVK:            []schema.GroupVersionKind{tunedGVK},
+	})
+}
@@ -0,0 +1,304 @@
+package nto //nolint:misspell
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/nto/tunedtypes"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// TunedProfileBuilder provides struct for the tuned Profile object containing connection to the cluster and the
+// Profile definitions. Profiles are created by the operator, one per node and named after it, and are read only.
+type TunedProfileBuilder struct {
+	// Profile definition.
+	Definition *tunedtypes.Profile
+	// Found Profile object.
+	Object    *tunedtypes.Profile
+	apiClient *clients.Settings
+}
+
+// PullTunedProfile pulls the tuned Profile of the given node from cluster.
+func PullTunedProfile(apiClient *clients.Settings, nodeName, nsname string) (*TunedProfileBuilder, error) {
+	logging.V(100).Infof("Pulling existing tuned Profile of node %s under namespace %s from cluster", nodeName, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("tuned Profile 'apiClient' cannot be empty")
+	}
+
+	builder := TunedProfileBuilder{
+		apiClient: apiClient,
+		Definition: &tunedtypes.Profile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if nodeName == "" {
+		logging.V(100).Infof("The nodeName of the tuned Profile is empty")
+
+		return nil, fmt.Errorf("tuned Profile 'nodeName' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the tuned Profile is empty")
+
+		return nil, fmt.Errorf("tuned Profile 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("tuned Profile object %s doesn't exist in namespace %s", nodeName, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// ListTunedProfiles returns the tuned Profiles of all nodes in the given namespace.
+func ListTunedProfiles(
+	apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*TunedProfileBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("tuned Profile 'apiClient' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("tuned Profile 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list tuned Profiles, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := fmt.Sprintf("Listing tuned Profiles in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	unsList, err := apiClient.Resource(GetTunedProfileGVR()).Namespace(nsname).List(context.TODO(), passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list tuned Profiles in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var profileBuilders []*TunedProfileBuilder
+
+	for index := range unsList.Items {
+		profile, err := convertTunedProfileToStructured(&unsList.Items[index])
+		if err != nil {
+			return nil, err
+		}
+
+		profileBuilders = append(profileBuilders, &TunedProfileBuilder{
+			apiClient:  apiClient,
+			Definition: profile,
+			Object:     profile,
+		})
+	}
+
+	return profileBuilders, nil
+}
+
+// Get returns tuned Profile object if found.
+func (builder *TunedProfileBuilder) Get() (*tunedtypes.Profile, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting tuned Profile object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetTunedProfileGVR()).
+		Namespace(builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("tuned Profile object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertTunedProfileToStructured(unsObject)
+}
+
+// Exists checks whether the given tuned Profile exists.
+func (builder *TunedProfileBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if tuned Profile %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetAppliedProfile returns the name of the tuned profile the tuned daemon reports as applied on the node.
+func (builder *TunedProfileBuilder) GetAppliedProfile() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting the applied profile of tuned Profile %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("tuned Profile object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.TunedProfile, nil
+}
+
+// IsApplied returns true when the given tuned profile is selected for the node and the tuned daemon reports it as
+// successfully applied.
+func (builder *TunedProfileBuilder) IsApplied(profileName string) (bool, error) {
+	appliedProfile, err := builder.GetAppliedProfile()
+	if err != nil {
+		return false, err
+	}
+
+	return appliedProfile == profileName &&
+		builder.Object.Spec.Config.TunedProfile == profileName &&
+		builder.Object.Status.ObservedGeneration >= builder.Object.Generation &&
+		builder.isConditionTrue(tunedtypes.TunedProfileApplied), nil
+}
+
+// WaitForApplied waits for the duration of the defined timeout or until the given tuned profile is applied on the
+// node of the Profile. On timeout, the currently applied profile and the Applied and Degraded conditions are included
+// in the returned error.
+func (builder *TunedProfileBuilder) WaitForApplied(profileName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until tuned profile %s is applied on node %s",
+		profileName, builder.Definition.Name)
+
+	if profileName == "" {
+		return fmt.Errorf("tuned 'profileName' cannot be empty")
+	}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			applied, err := builder.IsApplied(profileName)
+			if err != nil {
+				logging.V(100).Infof("Failed to check tuned Profile %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return applied, nil
+		})
+
+	if err != nil && builder.Object != nil {
+		return fmt.Errorf("tuned profile %s is not applied on node %s, current profile %s, conditions %s: %w",
+			profileName, builder.Definition.Name, builder.Object.Status.TunedProfile, builder.describeConditions(), err)
+	}
+
+	return err
+}
+
+// GetTunedProfileGVR returns tuned Profile's GroupVersionResource.
+func GetTunedProfileGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: TunedAPIGroup, Version: TunedAPIVersion, Resource: "profiles"}
+}
+
+// isConditionTrue returns true when the given condition of the last observed object is true.
+func (builder *TunedProfileBuilder) isConditionTrue(conditionType tunedtypes.ProfileConditionType) bool {
+	for _, condition := range builder.Object.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// describeConditions returns the Applied and Degraded conditions of the last observed object in a readable form.
+func (builder *TunedProfileBuilder) describeConditions() string {
+	var description string
+
+	for _, condition := range builder.Object.Status.Conditions {
+		if condition.Type != tunedtypes.TunedProfileApplied && condition.Type != tunedtypes.TunedDegraded {
+			continue
+		}
+
+		if description != "" {
+			description += ", "
+		}
+
+		description += fmt.Sprintf("%s=%s (%s: %s)", condition.Type, condition.Status, condition.Reason, condition.Message)
+	}
+
+	return description
+}
+
+// convertTunedProfileToStructured converts the unstructured object returned by the dynamic client to a Profile.
+func convertTunedProfileToStructured(unsObject *unstructured.Unstructured) (*tunedtypes.Profile, error) {
+	profile := &tunedtypes.Profile{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, profile)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to tuned Profile object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *TunedProfileBuilder) validate() (bool, error) {
+	resourceCRD := "tuned Profile"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,311 @@
+package nto //nolint:misspell
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/nto/tunedtypes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const defaultTunedProfileNode = "worker-0"
+
+var tunedProfileGVK = schema.GroupVersionKind{
+	Group:   TunedAPIGroup,
+	Version: TunedAPIVersion,
+	Kind:    TunedProfileKind,
+}
+
+func TestPullTunedProfile(t *testing.T) {
+	testCases := []struct {
+		nodeName            string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			nodeName:            defaultTunedProfileNode,
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			nodeName:            "",
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "tuned Profile 'nodeName' cannot be empty",
+		},
+		{
+			nodeName:            defaultTunedProfileNode,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "tuned Profile 'namespace' cannot be empty",
+		},
+		{
+			nodeName:            defaultTunedProfileNode,
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Sprintf("tuned Profile object %s doesn't exist in namespace %s",
+				defaultTunedProfileNode, defaultTunedNamespace),
+		},
+		{
+			nodeName:            defaultTunedProfileNode,
+			namespace:           defaultTunedNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "tuned Profile 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyTunedProfile(defaultTunedProfileNode, corev1.ConditionTrue))
+		}
+
+		if testCase.client {
+			testSettings = buildTestClientWithTunedProfiles(runtimeObjects...)
+		}
+
+		testBuilder, err := PullTunedProfile(testSettings, testCase.nodeName, testCase.namespace)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.nodeName, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestListTunedProfiles(t *testing.T) {
+	testCases := []struct {
+		namespace     string
+		options       []metav1.ListOptions
+		client        bool
+		expectedCount int
+		expectedError string
+	}{
+		{
+			namespace:     defaultTunedNamespace,
+			client:        true,
+			expectedCount: 2,
+			expectedError: "",
+		},
+		{
+			namespace:     "",
+			client:        true,
+			expectedError: "failed to list tuned Profiles, 'nsname' parameter is empty",
+		},
+		{
+			namespace:     defaultTunedNamespace,
+			options:       []metav1.ListOptions{{}, {}},
+			client:        true,
+			expectedError: "error: more than one ListOptions was passed",
+		},
+		{
+			namespace:     defaultTunedNamespace,
+			client:        false,
+			expectedError: "tuned Profile 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithTunedProfiles(
+				buildDummyTunedProfile("worker-0", corev1.ConditionTrue),
+				buildDummyTunedProfile("worker-1", corev1.ConditionTrue))
+		}
+
+		profileBuilders, err := ListTunedProfiles(testSettings, testCase.namespace, testCase.options...)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, profileBuilders, testCase.expectedCount)
+		}
+	}
+}
+
+func TestTunedProfileIsApplied(t *testing.T) {
+	testCases := []struct {
+		profileName         string
+		appliedStatus       corev1.ConditionStatus
+		addToRuntimeObjects bool
+		expectedApplied     bool
+		expectedError       string
+	}{
+		{
+			profileName:         defaultTunedProfile,
+			appliedStatus:       corev1.ConditionTrue,
+			addToRuntimeObjects: true,
+			expectedApplied:     true,
+			expectedError:       "",
+		},
+		{
+			profileName:         defaultTunedProfile,
+			appliedStatus:       corev1.ConditionFalse,
+			addToRuntimeObjects: true,
+			expectedApplied:     false,
+			expectedError:       "",
+		},
+		{
+			profileName:         "openshift-node",
+			appliedStatus:       corev1.ConditionTrue,
+			addToRuntimeObjects: true,
+			expectedApplied:     false,
+			expectedError:       "",
+		},
+		{
+			profileName:         defaultTunedProfile,
+			appliedStatus:       corev1.ConditionTrue,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("tuned Profile object %s doesn't exist in namespace %s",
+				defaultTunedProfileNode, defaultTunedNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyTunedProfile(defaultTunedProfileNode, testCase.appliedStatus))
+		}
+
+		testBuilder := buildValidTunedProfileBuilder(buildTestClientWithTunedProfiles(runtimeObjects...))
+
+		appliedProfile, err := testBuilder.GetAppliedProfile()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, defaultTunedProfile, appliedProfile)
+		}
+
+		applied, err := testBuilder.IsApplied(testCase.profileName)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedApplied, applied)
+		}
+	}
+}
+
+func TestTunedProfileWaitForApplied(t *testing.T) {
+	testCases := []struct {
+		profileName         string
+		appliedStatus       corev1.ConditionStatus
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			profileName:         defaultTunedProfile,
+			appliedStatus:       corev1.ConditionTrue,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			profileName:         defaultTunedProfile,
+			appliedStatus:       corev1.ConditionFalse,
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("tuned profile %s is not applied on node %s, current profile %s, conditions "+
+				"Applied=False (TunedError: sysctl failed), Degraded=True (TunedError: sysctl failed): "+
+				"context deadline exceeded", defaultTunedProfile, defaultTunedProfileNode, defaultTunedProfile),
+		},
+		{
+			profileName:         "",
+			appliedStatus:       corev1.ConditionTrue,
+			addToRuntimeObjects: true,
+			expectedError:       "tuned 'profileName' cannot be empty",
+		},
+		{
+			profileName:         defaultTunedProfile,
+			appliedStatus:       corev1.ConditionTrue,
+			addToRuntimeObjects: false,
+			expectedError:       "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyTunedProfile(defaultTunedProfileNode, testCase.appliedStatus))
+		}
+
+		testBuilder := buildValidTunedProfileBuilder(buildTestClientWithTunedProfiles(runtimeObjects...))
+
+		err := testBuilder.WaitForApplied(testCase.profileName, time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidTunedProfileBuilder(apiClient *clients.Settings) *TunedProfileBuilder {
+	return &TunedProfileBuilder{
+		apiClient: apiClient,
+		Definition: &tunedtypes.Profile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      defaultTunedProfileNode,
+				Namespace: defaultTunedNamespace,
+			},
+		},
+	}
+}
+
+// buildDummyTunedProfile returns a Profile selecting defaultTunedProfile with the given Applied condition status.
+// Unless the profile is applied, the Degraded condition is true.
+func buildDummyTunedProfile(nodeName string, appliedStatus corev1.ConditionStatus) *tunedtypes.Profile {
+	degradedStatus := corev1.ConditionFalse
+	reason := "AsExpected"
+	message := "profile applied"
+
+	if appliedStatus != corev1.ConditionTrue {
+		degradedStatus = corev1.ConditionTrue
+		reason = "TunedError"
+		message = "sysctl failed"
+	}
+
+	return &tunedtypes.Profile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeName,
+			Namespace: defaultTunedNamespace,
+		},
+		Spec: tunedtypes.ProfileSpec{
+			Config: tunedtypes.ProfileConfig{TunedProfile: defaultTunedProfile},
+		},
+		Status: tunedtypes.ProfileStatus{
+			TunedProfile: defaultTunedProfile,
+			Conditions: []tunedtypes.ProfileStatusCondition{
+				{
+					Type:    tunedtypes.TunedProfileApplied,
+					Status:  appliedStatus,
+					Reason:  reason,
+					Message: message,
+				},
+				{
+					Type:    tunedtypes.TunedDegraded,
+					Status:  degradedStatus,
+					Reason:  reason,
+					Message: message,
+				},
+			},
+		},
+	}
+}
+
+func buildTestClientWithTunedProfiles(profiles ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: profiles,
+		GVK:            []schema.GroupVersionKind{tunedProfileGVK},
+	})
+}
@@ -0,0 +1,258 @@
+package tunedtypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ProfileConditionType is the type of a condition reported in the status of a tuned Profile.
+type ProfileConditionType string
+
+const (
+	// TunedProfileApplied is true when the tuned daemon applied the profile on the node.
+	TunedProfileApplied ProfileConditionType = "Applied"
+	// TunedDegraded is true when the tuned daemon reported errors or warnings while applying the profile.
+	TunedDegraded ProfileConditionType = "Degraded"
+)
+
+// TunedProfile is a named tuned profile and its content in the tuned configuration format.
+type TunedProfile struct {
+	// name of the tuned profile.
+	Name *string `json:"name"`
+	// data is the content of the tuned profile.
+	Data *string `json:"data"`
+}
+
+// TunedMatch is a rule matching nodes, or pods scheduled on nodes, by label.
+type TunedMatch struct {
+	// label is the node or pod label name to match.
+	Label *string `json:"label"`
+	// value of the label. When unset, any value matches.
+	Value *string `json:"value,omitempty"`
+	// type of the label, either node or pod. Defaults to node.
+	Type *string `json:"type,omitempty"`
+	// match are additional rules which must match as well.
+	Match []TunedMatch `json:"match,omitempty"`
+}
+
+// TunedRecommend selects the profile to apply on the nodes matching its rules.
+type TunedRecommend struct {
+	// profile is the name of the tuned profile to recommend.
+	Profile *string `json:"profile"`
+	// priority of the recommendation, the lower the value the higher the priority.
+	Priority *uint64 `json:"priority"`
+	// match are the rules selecting the nodes. When empty, all nodes match.
+	Match []TunedMatch `json:"match,omitempty"`
+	// machineConfigLabels selects the MachineConfigPools to apply the kernel parameters of the profile to.
+	MachineConfigLabels map[string]string `json:"machineConfigLabels,omitempty"`
+}
+
+// TunedSpec defines the desired state of Tuned.
+type TunedSpec struct {
+	// managementState of the operand.
+	ManagementState string `json:"managementState,omitempty"`
+	// profile is the list of tuned profiles.
+	Profile []TunedProfile `json:"profile,omitempty"`
+	// recommend is the list of rules selecting the profile applied on each node.
+	Recommend []TunedRecommend `json:"recommend,omitempty"`
+}
+
+// Tuned is the Schema for the tuneds API.
+type Tuned struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TunedSpec `json:"spec,omitempty"`
+}
+
+// ProfileConfig is the tuned profile selected by the operator for a node.
+type ProfileConfig struct {
+	// tunedProfile is the name of the selected tuned profile.
+	TunedProfile string `json:"tunedProfile"`
+	// debug enables the debug logs of the tuned daemon.
+	Debug bool `json:"debug,omitempty"`
+}
+
+// ProfileSpec defines the desired state of Profile.
+type ProfileSpec struct {
+	// config of the tuned daemon on the node.
+	Config ProfileConfig `json:"config"`
+	// profile is the list of tuned profiles available on the node.
+	Profile []TunedProfile `json:"profile,omitempty"`
+}
+
+// ProfileStatusCondition is a condition reported by the tuned daemon of a node.
+type ProfileStatusCondition struct {
+	// type of the condition.
+	Type ProfileConditionType `json:"type"`
+	// status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// lastTransitionTime is the time the condition last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// reason is a machine readable reason for the last transition.
+	Reason string `json:"reason,omitempty"`
+	// message is a human readable description of the last transition.
+	Message string `json:"message,omitempty"`
+}
+
+// ProfileStatus defines the observed state of Profile.
+type ProfileStatus struct {
+	// bootcmdline is the kernel command line the profile requires.
+	Bootcmdline string `json:"bootcmdline,omitempty"`
+	// tunedProfile is the name of the tuned profile applied on the node.
+	TunedProfile string `json:"tunedProfile,omitempty"`
+	// conditions reported by the tuned daemon.
+	Conditions []ProfileStatusCondition `json:"conditions,omitempty"`
+	// observedGeneration is the generation of the Profile the status refers to.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// Profile is the Schema for the profiles API. The operator creates one Profile per node, named after the node.
+type Profile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProfileSpec   `json:"spec,omitempty"`
+	Status ProfileStatus `json:"status,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *TunedProfile) DeepCopyInto(out *TunedProfile) {
+	*out = *in
+	out.Name = copyString(in.Name)
+	out.Data = copyString(in.Data)
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *TunedMatch) DeepCopyInto(out *TunedMatch) {
+	*out = *in
+	out.Label = copyString(in.Label)
+	out.Value = copyString(in.Value)
+	out.Type = copyString(in.Type)
+	out.Match = copyMatches(in.Match)
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *TunedRecommend) DeepCopyInto(out *TunedRecommend) {
+	*out = *in
+	out.Profile = copyString(in.Profile)
+	out.Match = copyMatches(in.Match)
+
+	if in.Priority != nil {
+		priority := *in.Priority
+		out.Priority = &priority
+	}
+
+	if in.MachineConfigLabels != nil {
+		out.MachineConfigLabels = make(map[string]string, len(in.MachineConfigLabels))
+
+		for key, value := range in.MachineConfigLabels {
+			out.MachineConfigLabels[key] = value
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tuned.
+func (in *Tuned) DeepCopy() *Tuned {
+	if in == nil {
+		return nil
+	}
+
+	out := new(Tuned)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.ManagementState = in.Spec.ManagementState
+	out.Spec.Profile = copyProfiles(in.Spec.Profile)
+
+	if in.Spec.Recommend != nil {
+		out.Spec.Recommend = make([]TunedRecommend, len(in.Spec.Recommend))
+
+		for index := range in.Spec.Recommend {
+			in.Spec.Recommend[index].DeepCopyInto(&out.Spec.Recommend[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tuned) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Profile.
+func (in *Profile) DeepCopy() *Profile {
+	if in == nil {
+		return nil
+	}
+
+	out := new(Profile)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.Config = in.Spec.Config
+	out.Spec.Profile = copyProfiles(in.Spec.Profile)
+	out.Status = in.Status
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]ProfileStatusCondition, len(in.Status.Conditions))
+
+		for index, condition := range in.Status.Conditions {
+			out.Status.Conditions[index] = condition
+			condition.LastTransitionTime.DeepCopyInto(&out.Status.Conditions[index].LastTransitionTime)
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Profile) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+func copyString(in *string) *string {
+	if in == nil {
+		return nil
+	}
+
+	out := *in
+
+	return &out
+}
+
+func copyProfiles(in []TunedProfile) []TunedProfile {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]TunedProfile, len(in))
+
+	for index := range in {
+		in[index].DeepCopyInto(&out[index])
+	}
+
+	return out
+}
+
+func copyMatches(in []TunedMatch) []TunedMatch {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]TunedMatch, len(in))
+
+	for index := range in {
+		in[index].DeepCopyInto(&out[index])
+	}
+
+	return out
+}
@@ -2,11 +2,12 @@ package nvidiagpu
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/v1"
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,17 +26,17 @@ type Builder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before Builder object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewBuilderFromObjectString creates a Builder object from CSV alm-examples.
 func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new Builder structure from almExample string")
 
 	clusterPolicy, err := getClusterPolicyFromAlmExample(almExample)
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new Builder structure from almExample string with clusterPolicy name: %s",
 		clusterPolicy.Name)
 
@@ -45,16 +46,17 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	}
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Error initializing ClusterPolicy from alm-examples: %s", err.Error())
 
-		builder.errorMsg = fmt.Sprintf("Error initializing ClusterPolicy from alm-examples: %s", err.Error())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Error initializing ClusterPolicy from alm-examples: %s", err.Error()))
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The ClusterPolicy object definition is nil")
+		logging.V(100).Infof("The ClusterPolicy object definition is nil")
 
-		builder.errorMsg = "ClusterPolicy 'Object.Definition' is nil"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ClusterPolicy 'Object.Definition' is nil"))
 	}
 
 	return &builder
@@ -66,7 +68,7 @@ func (builder *Builder) Get() (*nvidiagpuv1.ClusterPolicy, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting ClusterPolicy object %s", builder.Definition.Name)
 
 	clusterPolicy := &nvidiagpuv1.ClusterPolicy{}
@@ -75,7 +77,7 @@ func (builder *Builder) Get() (*nvidiagpuv1.ClusterPolicy, error) {
 	}, clusterPolicy)
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"ClusterPolicy object %s doesn't exist", builder.Definition.Name)
 
 		return nil, err
@@ -86,7 +88,7 @@ func (builder *Builder) Get() (*nvidiagpuv1.ClusterPolicy, error) {
 
 // Pull loads an existing clusterPolicy into Builder struct.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing clusterPolicy name: %s", name)
+	logging.V(100).Infof("Pulling existing clusterPolicy name: %s", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -98,9 +100,9 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("ClusterPolicy name is empty")
+		logging.V(100).Infof("ClusterPolicy name is empty")
 
-		builder.errorMsg = "ClusterPolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ClusterPolicy 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -118,14 +120,14 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if ClusterPolicy %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect ClusterPolicy object due to %s", err.Error())
+		logging.V(100).Infof("Failed to collect ClusterPolicy object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -137,7 +139,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting ClusterPolicy %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting ClusterPolicy %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("clusterpolicy cannot be deleted because it does not exist")
@@ -160,7 +162,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the ClusterPolicy %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating the ClusterPolicy %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -180,18 +182,18 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the ClusterPolicy object named:  %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating the ClusterPolicy object named:  %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(msg.FailToUpdateNotification("clusterpolicy", builder.Definition.Name))
+			logging.V(100).Infof(msg.FailToUpdateNotification("clusterpolicy", builder.Definition.Name))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("clusterpolicy", builder.Definition.Name))
 
 				return nil, err
@@ -231,27 +233,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,14 @@
+package oadp
+
+const (
+	// APIGroup represents the OADP operator api group.
+	APIGroup = "oadp.openshift.io"
+	// APIVersion represents the version of the OADP operator api.
+	APIVersion = "v1alpha1"
+	// DataProtectionApplicationKind represents kind of DataProtectionApplication object.
+	DataProtectionApplicationKind = "DataProtectionApplication"
+	// OADPNamespace represents the namespace the OADP operator is installed in by default.
+	OADPNamespace = "openshift-adp"
+	// ConditionReconciled is the condition the operator sets once the DataProtectionApplication is reconciled.
+	ConditionReconciled = "Reconciled"
+)
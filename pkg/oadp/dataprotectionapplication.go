@@ -0,0 +1,642 @@
+package oadp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/oadp/oadptypes"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var supportedDefaultPlugins = []oadptypes.DefaultPlugin{
+	oadptypes.DefaultPluginAWS,
+	oadptypes.DefaultPluginGCP,
+	oadptypes.DefaultPluginMicrosoftAzure,
+	oadptypes.DefaultPluginCSI,
+	oadptypes.DefaultPluginOpenShift,
+	oadptypes.DefaultPluginKubeVirt,
+}
+
+// DPABuilder provides struct for the DataProtectionApplication object containing connection to the cluster and the
+// DataProtectionApplication definitions.
+type DPABuilder struct {
+	// DataProtectionApplication definition. Used to create the DataProtectionApplication object.
+	Definition *oadptypes.DataProtectionApplication
+	// Created DataProtectionApplication object.
+	Object *oadptypes.DataProtectionApplication
+	// Used in functions that define or mutate DataProtectionApplication definition. errorMsg is processed before the
+	// DataProtectionApplication object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewDPABuilder creates a new instance of DPABuilder. At least one velero plugin must be added with
+// WithDefaultPlugins or WithCustomPlugin before creating it.
+func NewDPABuilder(apiClient *clients.Settings, name, nsname string) *DPABuilder {
+	logging.V(100).Infof(
+		"Initializing new DataProtectionApplication structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	builder := DPABuilder{
+		apiClient: apiClient,
+		Definition: &oadptypes.DataProtectionApplication{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       DataProtectionApplicationKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: oadptypes.DataProtectionApplicationSpec{
+				Configuration: &oadptypes.ApplicationConfig{
+					Velero: &oadptypes.VeleroConfig{},
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the DataProtectionApplication is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("DataProtectionApplication 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the DataProtectionApplication is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullDPA pulls existing DataProtectionApplication from cluster.
+func PullDPA(apiClient *clients.Settings, name, nsname string) (*DPABuilder, error) {
+	logging.V(100).Infof("Pulling existing DataProtectionApplication name %s under namespace %s from cluster",
+		name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("DataProtectionApplication 'apiClient' cannot be empty")
+	}
+
+	builder := DPABuilder{
+		apiClient: apiClient,
+		Definition: &oadptypes.DataProtectionApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the DataProtectionApplication is empty")
+
+		return nil, fmt.Errorf("DataProtectionApplication 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the DataProtectionApplication is empty")
+
+		return nil, fmt.Errorf("DataProtectionApplication 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("DataProtectionApplication object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithDefaultPlugins adds velero plugins shipped with the OADP operator. Plugins already present are skipped.
+func (builder *DPABuilder) WithDefaultPlugins(plugins ...oadptypes.DefaultPlugin) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding default plugins %v to DataProtectionApplication %s in namespace %s",
+		plugins, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(plugins) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication 'plugins' cannot be empty list"))
+
+		return builder
+	}
+
+	for _, plugin := range plugins {
+		if !slices.Contains(supportedDefaultPlugins, plugin) {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"DataProtectionApplication default plugin %s is not supported, must be one of %v",
+				plugin, supportedDefaultPlugins))
+
+			return builder
+		}
+	}
+
+	veleroConfig := builder.getVeleroConfig()
+
+	for _, plugin := range plugins {
+		if !slices.Contains(veleroConfig.DefaultPlugins, plugin) {
+			veleroConfig.DefaultPlugins = append(veleroConfig.DefaultPlugins, plugin)
+		}
+	}
+
+	return builder
+}
+
+// WithCustomPlugin adds a velero plugin from an image not shipped with the OADP operator.
+func (builder *DPABuilder) WithCustomPlugin(name, image string) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding custom plugin %s with image %s to DataProtectionApplication %s in namespace %s",
+		name, image, builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication custom plugin 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if image == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication custom plugin 'image' cannot be empty"))
+
+		return builder
+	}
+
+	veleroConfig := builder.getVeleroConfig()
+
+	for _, plugin := range veleroConfig.CustomPlugins {
+		if plugin.Name == name {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("DataProtectionApplication custom plugin %s already exists", name))
+
+			return builder
+		}
+	}
+
+	veleroConfig.CustomPlugins = append(veleroConfig.CustomPlugins, oadptypes.CustomPlugin{Name: name, Image: image})
+
+	return builder
+}
+
+// WithBackupLocation adds a BackupStorageLocation storing backups in the prefix of an object storage bucket. The
+// credential selects the key of the secret holding the object storage credentials. Provider specific settings, such
+// as the region or the s3Url, are passed in config which may be nil.
+func (builder *DPABuilder) WithBackupLocation(
+	name, provider, bucket, prefix string, credential corev1.SecretKeySelector, config map[string]string) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding backup location %s with provider %s and bucket %s to DataProtectionApplication %s "+
+		"in namespace %s", name, provider, bucket, builder.Definition.Name, builder.Definition.Namespace)
+
+	if provider == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication backup location 'provider' cannot be empty"))
+
+		return builder
+	}
+
+	if bucket == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication backup location 'bucket' cannot be empty"))
+
+		return builder
+	}
+
+	if credential.Name == "" || credential.Key == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication backup location 'credential' must set the secret name and key"))
+
+		return builder
+	}
+
+	if name != "" && builder.getBackupLocation(name) != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication backup location %s already exists", name))
+
+		return builder
+	}
+
+	builder.Definition.Spec.BackupLocations = append(builder.Definition.Spec.BackupLocations,
+		oadptypes.BackupLocation{
+			Name: name,
+			Velero: &velerov1.BackupStorageLocationSpec{
+				Provider:   provider,
+				Config:     config,
+				Credential: &credential,
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{
+						Bucket: bucket,
+						Prefix: prefix,
+					},
+				},
+			},
+		})
+
+	return builder
+}
+
+// WithDefaultBackupLocation makes the named backup location the one used by backups not selecting a storage
+// location. Any other backup location is no longer the default.
+func (builder *DPABuilder) WithDefaultBackupLocation(name string) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting default backup location %s of DataProtectionApplication %s in namespace %s",
+		name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication backup location 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.getBackupLocation(name) == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication backup location %s does not exist", name))
+
+		return builder
+	}
+
+	for _, location := range builder.Definition.Spec.BackupLocations {
+		if location.Velero != nil {
+			location.Velero.Default = location.Name == name
+		}
+	}
+
+	return builder
+}
+
+// WithSnapshotLocation adds a VolumeSnapshotLocation used for native volume snapshots of the provider. The
+// credential may be nil when the provider uses the credentials of the cloud plugin.
+func (builder *DPABuilder) WithSnapshotLocation(
+	name, provider string, credential *corev1.SecretKeySelector, config map[string]string) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding snapshot location %s with provider %s to DataProtectionApplication %s "+
+		"in namespace %s", name, provider, builder.Definition.Name, builder.Definition.Namespace)
+
+	if provider == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("DataProtectionApplication snapshot location 'provider' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SnapshotLocations = append(builder.Definition.Spec.SnapshotLocations,
+		oadptypes.SnapshotLocation{
+			Name: name,
+			Velero: &velerov1.VolumeSnapshotLocationSpec{
+				Provider:   provider,
+				Config:     config,
+				Credential: credential,
+			},
+		})
+
+	return builder
+}
+
+// WithNodeAgent deploys the node agent, which backs up pod volumes with the given uploader. The node agent pods are
+// scheduled on the nodes matching nodeSelector, or on every node when it is empty.
+func (builder *DPABuilder) WithNodeAgent(
+	uploaderType oadptypes.UploaderType, nodeSelector map[string]string) *DPABuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Enabling node agent with uploader %s in DataProtectionApplication %s in namespace %s",
+		uploaderType, builder.Definition.Name, builder.Definition.Namespace)
+
+	if uploaderType != oadptypes.UploaderTypeKopia && uploaderType != oadptypes.UploaderTypeRestic {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"DataProtectionApplication 'uploaderType' %s is not supported, must be %s or %s",
+			uploaderType, oadptypes.UploaderTypeKopia, oadptypes.UploaderTypeRestic))
+
+		return builder
+	}
+
+	enable := true
+	nodeAgent := &oadptypes.NodeAgentConfig{
+		Enable:       &enable,
+		UploaderType: uploaderType,
+	}
+
+	if len(nodeSelector) > 0 {
+		nodeAgent.PodConfig = &oadptypes.PodConfig{NodeSelector: nodeSelector}
+	}
+
+	if builder.Definition.Spec.Configuration == nil {
+		builder.Definition.Spec.Configuration = &oadptypes.ApplicationConfig{}
+	}
+
+	builder.Definition.Spec.Configuration.NodeAgent = nodeAgent
+
+	return builder
+}
+
+// Get returns DataProtectionApplication object if found.
+func (builder *DPABuilder) Get() (*oadptypes.DataProtectionApplication, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting DataProtectionApplication object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetDataProtectionApplicationGVR()).
+		Namespace(builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("DataProtectionApplication object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertDPAToStructured(unsObject)
+}
+
+// Exists checks whether the given DataProtectionApplication exists.
+func (builder *DPABuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if DataProtectionApplication %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a DataProtectionApplication in the cluster and stores the created object in struct.
+func (builder *DPABuilder) Create() (*DPABuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the DataProtectionApplication %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	veleroConfig := builder.getVeleroConfig()
+	if len(veleroConfig.DefaultPlugins)+len(veleroConfig.CustomPlugins) == 0 {
+		return builder, fmt.Errorf("DataProtectionApplication %s in namespace %s must have at least one velero plugin",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	unstructuredDPA, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured DataProtectionApplication to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetDataProtectionApplicationGVR()).
+		Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredDPA}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create DataProtectionApplication %s due to %s",
+			builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertDPAToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing DataProtectionApplication object with the definition in builder.
+func (builder *DPABuilder) Update() (*DPABuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the DataProtectionApplication %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update DataProtectionApplication, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredDPA, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured DataProtectionApplication to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetDataProtectionApplicationGVR()).
+		Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredDPA}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertDPAToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes DataProtectionApplication object from a cluster.
+func (builder *DPABuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the DataProtectionApplication object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetDataProtectionApplicationGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete DataProtectionApplication: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsReconciled returns true when the operator reports the DataProtectionApplication as successfully reconciled.
+func (builder *DPABuilder) IsReconciled() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if DataProtectionApplication %s in namespace %s is reconciled",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get DataProtectionApplication %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return meta.IsStatusConditionTrue(builder.Object.Status.Conditions, ConditionReconciled), nil
+}
+
+// WaitUntilReconciled waits for the duration of the defined timeout or until the DataProtectionApplication is
+// reconciled. On timeout, the reason and message of the last observed Reconciled condition are included in the
+// returned error, since they usually describe an invalid backup location or credential.
+func (builder *DPABuilder) WaitUntilReconciled(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until DataProtectionApplication %s in namespace %s "+
+		"is reconciled", builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			reconciled, err := builder.IsReconciled()
+			if err != nil {
+				logging.V(100).Infof("Failed to check DataProtectionApplication %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return reconciled, nil
+		})
+
+	if err != nil && builder.Object != nil {
+		condition := meta.FindStatusCondition(builder.Object.Status.Conditions, ConditionReconciled)
+		if condition != nil {
+			return fmt.Errorf("DataProtectionApplication %s in namespace %s is not reconciled, last reason %s: %s: %w",
+				builder.Definition.Name, builder.Definition.Namespace, condition.Reason, condition.Message, err)
+		}
+	}
+
+	return err
+}
+
+// GetDataProtectionApplicationGVR returns DataProtectionApplication's GroupVersionResource which could be used for
+// Clean function.
+func GetDataProtectionApplicationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "dataprotectionapplications"}
+}
+
+// getVeleroConfig returns a pointer to the velero configuration of the definition, initializing it if needed.
+func (builder *DPABuilder) getVeleroConfig() *oadptypes.VeleroConfig {
+	if builder.Definition.Spec.Configuration == nil {
+		builder.Definition.Spec.Configuration = &oadptypes.ApplicationConfig{}
+	}
+
+	if builder.Definition.Spec.Configuration.Velero == nil {
+		builder.Definition.Spec.Configuration.Velero = &oadptypes.VeleroConfig{}
+	}
+
+	return builder.Definition.Spec.Configuration.Velero
+}
+
+// getBackupLocation returns a pointer to the backup location with the given name in the definition or nil if it is
+// missing.
+func (builder *DPABuilder) getBackupLocation(name string) *oadptypes.BackupLocation {
+	for index := range builder.Definition.Spec.BackupLocations {
+		if builder.Definition.Spec.BackupLocations[index].Name == name {
+			return &builder.Definition.Spec.BackupLocations[index]
+		}
+	}
+
+	return nil
+}
+
+// convertDPAToStructured converts the unstructured object returned by the dynamic client to a
+// DataProtectionApplication.
+func convertDPAToStructured(unsObject *unstructured.Unstructured) (*oadptypes.DataProtectionApplication, error) {
+	dataProtectionApplication := &oadptypes.DataProtectionApplication{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, dataProtectionApplication)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to DataProtectionApplication object %s",
+			unsObject.GetName())
+
+		return nil, err
+	}
+
+	return dataProtectionApplication, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *DPABuilder) validate() (bool, error) {
+	resourceCRD := "DataProtectionApplication"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,353 @@
+package oadp
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/oadp/oadptypes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	dpaGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    DataProtectionApplicationKind,
+	}
+	defaultDPAName        = "dataprotectionapplication"
+	defaultBackupLocation = "default"
+	defaultCredential     = corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"},
+		Key:                  "cloud",
+	}
+)
+
+func TestNewDPABuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultDPAName,
+			namespace:     OADPNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     OADPNamespace,
+			expectedError: "DataProtectionApplication 'name' cannot be empty",
+		},
+		{
+			name:          defaultDPAName,
+			namespace:     "",
+			expectedError: "DataProtectionApplication 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewDPABuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullDPA(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultDPAName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("DataProtectionApplication 'name' cannot be empty"),
+		},
+		{
+			name:                defaultDPAName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"DataProtectionApplication object %s doesn't exist in namespace %s", defaultDPAName, OADPNamespace),
+		},
+		{
+			name:                defaultDPAName,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("DataProtectionApplication 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDPA(metav1.ConditionTrue, "Complete", ""))
+		}
+
+		if testCase.client {
+			testSettings = buildDPATestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullDPA(testSettings, testCase.name, OADPNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, []oadptypes.DefaultPlugin{oadptypes.DefaultPluginOpenShift},
+				testBuilder.Definition.Spec.Configuration.Velero.DefaultPlugins)
+		}
+	}
+}
+
+func TestDPAWithPlugins(t *testing.T) {
+	testBuilder := buildValidDPABuilder(buildDPATestClientWithDummyObject(nil)).
+		WithDefaultPlugins(oadptypes.DefaultPluginOpenShift, oadptypes.DefaultPluginAWS).
+		WithDefaultPlugins(oadptypes.DefaultPluginAWS, oadptypes.DefaultPluginCSI).
+		WithCustomPlugin("custom", "quay.io/example/velero-plugin:latest")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, []oadptypes.DefaultPlugin{
+		oadptypes.DefaultPluginOpenShift, oadptypes.DefaultPluginAWS, oadptypes.DefaultPluginCSI},
+		testBuilder.Definition.Spec.Configuration.Velero.DefaultPlugins)
+	assert.Equal(t, []oadptypes.CustomPlugin{{Name: "custom", Image: "quay.io/example/velero-plugin:latest"}},
+		testBuilder.Definition.Spec.Configuration.Velero.CustomPlugins)
+
+	testBuilder = buildValidDPABuilder(buildDPATestClientWithDummyObject(nil)).WithDefaultPlugins("vsphere")
+	assert.EqualError(t, testBuilder.errorMsg, "DataProtectionApplication default plugin vsphere is not supported, "+
+		"must be one of [aws gcp azure csi openshift kubevirt]")
+
+	testBuilder = buildValidDPABuilder(buildDPATestClientWithDummyObject(nil)).
+		WithCustomPlugin("custom", "image").
+		WithCustomPlugin("custom", "image")
+	assert.EqualError(t, testBuilder.errorMsg, "DataProtectionApplication custom plugin custom already exists")
+}
+
+func TestDPAWithBackupLocation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		provider      string
+		bucket        string
+		credential    corev1.SecretKeySelector
+		expectedError string
+	}{
+		{
+			name:          defaultBackupLocation,
+			provider:      "aws",
+			bucket:        "backups",
+			credential:    defaultCredential,
+			expectedError: "",
+		},
+		{
+			name:          defaultBackupLocation,
+			provider:      "",
+			bucket:        "backups",
+			credential:    defaultCredential,
+			expectedError: "DataProtectionApplication backup location 'provider' cannot be empty",
+		},
+		{
+			name:          defaultBackupLocation,
+			provider:      "aws",
+			bucket:        "",
+			credential:    defaultCredential,
+			expectedError: "DataProtectionApplication backup location 'bucket' cannot be empty",
+		},
+		{
+			name:          defaultBackupLocation,
+			provider:      "aws",
+			bucket:        "backups",
+			credential:    corev1.SecretKeySelector{Key: "cloud"},
+			expectedError: "DataProtectionApplication backup location 'credential' must set the secret name and key",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDPABuilder(buildDPATestClientWithDummyObject(nil)).WithBackupLocation(
+			testCase.name, testCase.provider, testCase.bucket, "ibu", testCase.credential,
+			map[string]string{"region": "us-east-1"})
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+
+			location := testBuilder.Definition.Spec.BackupLocations[0]
+			assert.Equal(t, testCase.name, location.Name)
+			assert.Equal(t, testCase.bucket, location.Velero.ObjectStorage.Bucket)
+			assert.Equal(t, "ibu", location.Velero.ObjectStorage.Prefix)
+			assert.Equal(t, &testCase.credential, location.Velero.Credential)
+		}
+	}
+}
+
+func TestDPAWithDefaultBackupLocation(t *testing.T) {
+	testBuilder := buildValidDPABuilder(buildDPATestClientWithDummyObject(nil)).
+		WithBackupLocation(defaultBackupLocation, "aws", "backups", "", defaultCredential, nil).
+		WithBackupLocation("secondary", "aws", "backups-secondary", "", defaultCredential, nil).
+		WithDefaultBackupLocation("secondary")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.False(t, testBuilder.Definition.Spec.BackupLocations[0].Velero.Default)
+	assert.True(t, testBuilder.Definition.Spec.BackupLocations[1].Velero.Default)
+
+	testBuilder = testBuilder.WithDefaultBackupLocation("missing")
+	assert.EqualError(t, testBuilder.errorMsg, "DataProtectionApplication backup location missing does not exist")
+}
+
+func TestDPAWithSnapshotLocationAndNodeAgent(t *testing.T) {
+	testBuilder := buildValidDPABuilder(buildDPATestClientWithDummyObject(nil)).
+		WithSnapshotLocation("snapshots", "aws", nil, map[string]string{"region": "us-east-1"}).
+		WithNodeAgent(oadptypes.UploaderTypeKopia, map[string]string{"node-role.kubernetes.io/worker": ""})
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "aws", testBuilder.Definition.Spec.SnapshotLocations[0].Velero.Provider)
+
+	nodeAgent := testBuilder.Definition.Spec.Configuration.NodeAgent
+	assert.True(t, *nodeAgent.Enable)
+	assert.Equal(t, oadptypes.UploaderTypeKopia, nodeAgent.UploaderType)
+	assert.Equal(t, map[string]string{"node-role.kubernetes.io/worker": ""}, nodeAgent.PodConfig.NodeSelector)
+
+	testBuilder = testBuilder.WithNodeAgent("rsync", nil)
+	assert.EqualError(t, testBuilder.errorMsg,
+		"DataProtectionApplication 'uploaderType' rsync is not supported, must be kopia or restic")
+}
+
+func TestDPACreate(t *testing.T) {
+	testCases := []struct {
+		withPlugin    bool
+		expectedError error
+	}{
+		{
+			withPlugin:    true,
+			expectedError: nil,
+		},
+		{
+			withPlugin: false,
+			expectedError: fmt.Errorf("DataProtectionApplication %s in namespace %s must have at least one velero plugin",
+				defaultDPAName, OADPNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDPABuilder(buildDPATestClientWithDummyObject(nil)).
+			WithBackupLocation(defaultBackupLocation, "aws", "backups", "", defaultCredential, nil)
+
+		if testCase.withPlugin {
+			testBuilder = testBuilder.WithDefaultPlugins(oadptypes.DefaultPluginOpenShift, oadptypes.DefaultPluginAWS)
+		}
+
+		testBuilder, err := testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultDPAName, testBuilder.Object.Name)
+			assert.Equal(t, "backups", testBuilder.Object.Spec.BackupLocations[0].Velero.ObjectStorage.Bucket)
+		}
+	}
+}
+
+func TestDPADelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyDPA(metav1.ConditionTrue, "Complete", ""))
+		}
+
+		testBuilder := buildValidDPABuilder(buildDPATestClientWithDummyObject(runtimeObjects))
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+	}
+}
+
+func TestDPAWaitUntilReconciled(t *testing.T) {
+	testCases := []struct {
+		status        metav1.ConditionStatus
+		reason        string
+		message       string
+		expectedError string
+	}{
+		{
+			status:        metav1.ConditionTrue,
+			reason:        "Complete",
+			expectedError: "",
+		},
+		{
+			status:  metav1.ConditionFalse,
+			reason:  "Error",
+			message: "secret cloud-credentials not found",
+			expectedError: fmt.Sprintf("DataProtectionApplication %s in namespace %s is not reconciled, last reason "+
+				"Error: secret cloud-credentials not found: context deadline exceeded", defaultDPAName, OADPNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidDPABuilder(buildDPATestClientWithDummyObject(
+			[]runtime.Object{buildDummyDPA(testCase.status, testCase.reason, testCase.message)}))
+
+		err := testBuilder.WaitUntilReconciled(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidDPABuilder(apiClient *clients.Settings) *DPABuilder {
+	return NewDPABuilder(apiClient, defaultDPAName, OADPNamespace)
+}
+
+func buildDummyDPA(status metav1.ConditionStatus, reason, message string) *oadptypes.DataProtectionApplication {
+	return &oadptypes.DataProtectionApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultDPAName,
+			Namespace: OADPNamespace,
+		},
+		Spec: oadptypes.DataProtectionApplicationSpec{
+			Configuration: &oadptypes.ApplicationConfig{
+				Velero: &oadptypes.VeleroConfig{
+					DefaultPlugins: []oadptypes.DefaultPlugin{oadptypes.DefaultPluginOpenShift},
+				},
+			},
+		},
+		Status: oadptypes.DataProtectionApplicationStatus{
+			Conditions: []metav1.Condition{{
+				Type:    ConditionReconciled,
+				Status:  status,
+				Reason:  reason,
+				Message: message,
+			}},
+		},
+	}
+}
+
+func buildDPATestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{dpaGVK},
+	})
+}
@@ -0,0 +1,235 @@
+package oadptypes
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DefaultPlugin is a velero plugin shipped with the OADP operator.
+type DefaultPlugin string
+
+const (
+	// DefaultPluginAWS is the plugin for AWS and S3 compatible object storage.
+	DefaultPluginAWS DefaultPlugin = "aws"
+	// DefaultPluginGCP is the plugin for Google Cloud Platform.
+	DefaultPluginGCP DefaultPlugin = "gcp"
+	// DefaultPluginMicrosoftAzure is the plugin for Microsoft Azure.
+	DefaultPluginMicrosoftAzure DefaultPlugin = "azure"
+	// DefaultPluginCSI is the plugin taking CSI snapshots of persistent volumes.
+	DefaultPluginCSI DefaultPlugin = "csi"
+	// DefaultPluginOpenShift is the plugin backing up OpenShift specific resources.
+	DefaultPluginOpenShift DefaultPlugin = "openshift"
+	// DefaultPluginKubeVirt is the plugin backing up virtual machines.
+	DefaultPluginKubeVirt DefaultPlugin = "kubevirt"
+)
+
+// UploaderType is the file system backup tool used by the node agent.
+type UploaderType string
+
+const (
+	// UploaderTypeKopia uses kopia to back up pod volumes.
+	UploaderTypeKopia UploaderType = "kopia"
+	// UploaderTypeRestic uses restic to back up pod volumes.
+	UploaderTypeRestic UploaderType = "restic"
+)
+
+// CustomPlugin is a velero plugin image not shipped with the OADP operator.
+type CustomPlugin struct {
+	// name of the plugin.
+	Name string `json:"name"`
+	// image of the plugin.
+	Image string `json:"image"`
+}
+
+// PodConfig defines the scheduling of the velero and node agent pods.
+type PodConfig struct {
+	// nodeSelector restricts the nodes the pods are scheduled on.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// tolerations of the pods.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// VeleroConfig defines the configuration of the velero server.
+type VeleroConfig struct {
+	// featureFlags enabled on the velero server.
+	FeatureFlags []string `json:"featureFlags,omitempty"`
+	// defaultPlugins are the plugins shipped with the operator which are installed.
+	DefaultPlugins []DefaultPlugin `json:"defaultPlugins,omitempty"`
+	// customPlugins are additional plugin images which are installed.
+	CustomPlugins []CustomPlugin `json:"customPlugins,omitempty"`
+	// podConfig defines the scheduling of the velero pod.
+	PodConfig *PodConfig `json:"podConfig,omitempty"`
+	// logLevel of the velero server.
+	LogLevel string `json:"logLevel,omitempty"`
+}
+
+// NodeAgentConfig defines the configuration of the node agent daemonset used for file system backups.
+type NodeAgentConfig struct {
+	// enable deploys the node agent.
+	Enable *bool `json:"enable,omitempty"`
+	// uploaderType is the tool used to back up pod volumes.
+	UploaderType UploaderType `json:"uploaderType"`
+	// timeout of the file system backups and restores.
+	Timeout string `json:"timeout,omitempty"`
+	// podConfig defines the scheduling of the node agent pods.
+	PodConfig *PodConfig `json:"podConfig,omitempty"`
+}
+
+// ApplicationConfig defines the configuration of the applications deployed by the operator.
+type ApplicationConfig struct {
+	// velero configuration.
+	Velero *VeleroConfig `json:"velero,omitempty"`
+	// nodeAgent configuration.
+	NodeAgent *NodeAgentConfig `json:"nodeAgent,omitempty"`
+}
+
+// BackupLocation defines a velero BackupStorageLocation created by the operator.
+type BackupLocation struct {
+	// name of the BackupStorageLocation, defaults to the DataProtectionApplication name suffixed by an index.
+	Name string `json:"name,omitempty"`
+	// velero is the spec of the BackupStorageLocation.
+	Velero *velerov1.BackupStorageLocationSpec `json:"velero,omitempty"`
+}
+
+// SnapshotLocation defines a velero VolumeSnapshotLocation created by the operator.
+type SnapshotLocation struct {
+	// name of the VolumeSnapshotLocation, defaults to the DataProtectionApplication name suffixed by an index.
+	Name string `json:"name,omitempty"`
+	// velero is the spec of the VolumeSnapshotLocation.
+	Velero *velerov1.VolumeSnapshotLocationSpec `json:"velero,omitempty"`
+}
+
+// DataProtectionApplicationSpec defines the desired state of DataProtectionApplication.
+type DataProtectionApplicationSpec struct {
+	// backupLocations are the locations backups are stored in.
+	BackupLocations []BackupLocation `json:"backupLocations,omitempty"`
+	// snapshotLocations are the locations volume snapshots are stored in.
+	SnapshotLocations []SnapshotLocation `json:"snapshotLocations,omitempty"`
+	// configuration of velero and the node agent.
+	Configuration *ApplicationConfig `json:"configuration"`
+}
+
+// DataProtectionApplicationStatus defines the observed state of DataProtectionApplication.
+type DataProtectionApplicationStatus struct {
+	// conditions describe the state of the DataProtectionApplication.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// DataProtectionApplication is the Schema for the dataprotectionapplications API. It deploys velero and configures
+// where backups are stored.
+type DataProtectionApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataProtectionApplicationSpec   `json:"spec,omitempty"`
+	Status DataProtectionApplicationStatus `json:"status,omitempty"`
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *PodConfig) DeepCopyInto(out *PodConfig) {
+	*out = *in
+
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string, len(in.NodeSelector))
+
+		for key, value := range in.NodeSelector {
+			out.NodeSelector[key] = value
+		}
+	}
+
+	if in.Tolerations != nil {
+		out.Tolerations = make([]corev1.Toleration, len(in.Tolerations))
+
+		for index := range in.Tolerations {
+			in.Tolerations[index].DeepCopyInto(&out.Tolerations[index])
+		}
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *ApplicationConfig) DeepCopyInto(out *ApplicationConfig) {
+	*out = *in
+
+	if in.Velero != nil {
+		out.Velero = new(VeleroConfig)
+		*out.Velero = *in.Velero
+		out.Velero.FeatureFlags = append([]string(nil), in.Velero.FeatureFlags...)
+		out.Velero.DefaultPlugins = append([]DefaultPlugin(nil), in.Velero.DefaultPlugins...)
+		out.Velero.CustomPlugins = append([]CustomPlugin(nil), in.Velero.CustomPlugins...)
+
+		if in.Velero.PodConfig != nil {
+			out.Velero.PodConfig = new(PodConfig)
+			in.Velero.PodConfig.DeepCopyInto(out.Velero.PodConfig)
+		}
+	}
+
+	if in.NodeAgent != nil {
+		out.NodeAgent = new(NodeAgentConfig)
+		*out.NodeAgent = *in.NodeAgent
+
+		if in.NodeAgent.Enable != nil {
+			out.NodeAgent.Enable = new(bool)
+			*out.NodeAgent.Enable = *in.NodeAgent.Enable
+		}
+
+		if in.NodeAgent.PodConfig != nil {
+			out.NodeAgent.PodConfig = new(PodConfig)
+			in.NodeAgent.PodConfig.DeepCopyInto(out.NodeAgent.PodConfig)
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataProtectionApplication.
+func (in *DataProtectionApplication) DeepCopy() *DataProtectionApplication {
+	if in == nil {
+		return nil
+	}
+
+	out := new(DataProtectionApplication)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.BackupLocations != nil {
+		out.Spec.BackupLocations = make([]BackupLocation, len(in.Spec.BackupLocations))
+
+		for index, location := range in.Spec.BackupLocations {
+			out.Spec.BackupLocations[index].Name = location.Name
+			out.Spec.BackupLocations[index].Velero = location.Velero.DeepCopy()
+		}
+	}
+
+	if in.Spec.SnapshotLocations != nil {
+		out.Spec.SnapshotLocations = make([]SnapshotLocation, len(in.Spec.SnapshotLocations))
+
+		for index, location := range in.Spec.SnapshotLocations {
+			out.Spec.SnapshotLocations[index].Name = location.Name
+			out.Spec.SnapshotLocations[index].Velero = location.Velero.DeepCopy()
+		}
+	}
+
+	if in.Spec.Configuration != nil {
+		out.Spec.Configuration = new(ApplicationConfig)
+		in.Spec.Configuration.DeepCopyInto(out.Spec.Configuration)
+	}
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataProtectionApplication) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
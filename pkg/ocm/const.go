@@ -0,0 +1,28 @@
+package ocm
+
+const (
+	// ClusterAPIGroup represents the open cluster management cluster api group.
+	ClusterAPIGroup = "cluster.open-cluster-management.io"
+	// ClusterAPIVersion represents the version of the open cluster management cluster api.
+	ClusterAPIVersion = "v1"
+	// ManagedClusterKind represents kind of ManagedCluster object.
+	ManagedClusterKind = "ManagedCluster"
+	// AddonAPIGroup represents the open cluster management addon api group.
+	AddonAPIGroup = "addon.open-cluster-management.io"
+	// AddonAPIVersion represents the version of the open cluster management addon api.
+	AddonAPIVersion = "v1alpha1"
+	// ManagedClusterAddOnKind represents kind of ManagedClusterAddOn object.
+	ManagedClusterAddOnKind = "ManagedClusterAddOn"
+	// ConditionManagedClusterAvailable is the condition set once the klusterlet agent of the cluster reports in.
+	ConditionManagedClusterAvailable = "ManagedClusterConditionAvailable"
+	// PolicyAPIGroup represents the open cluster management policy api group.
+	PolicyAPIGroup = "policy.open-cluster-management.io"
+	// PolicyAPIVersion represents the version of the open cluster management policy api.
+	PolicyAPIVersion = "v1"
+	// ConfigurationPolicyKind represents kind of ConfigurationPolicy object.
+	ConfigurationPolicyKind = "ConfigurationPolicy"
+	// PlacementRuleAPIGroup represents the api group of PlacementRule objects.
+	PlacementRuleAPIGroup = "apps.open-cluster-management.io"
+	// ConditionAddonAvailable is the condition set once the agent of an addon is available on the cluster.
+	ConditionAddonAvailable = "Available"
+)
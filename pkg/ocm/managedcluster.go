@@ -0,0 +1,464 @@
+package ocm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/ocm/ocmtypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ManagedClusterBuilder provides struct for the managedCluster object containing connection to
+// the cluster and the managedCluster definitions.
+type ManagedClusterBuilder struct {
+	// managedCluster Definition, used to create the managedCluster object.
+	Definition *ocmtypes.ManagedCluster
+	// created managedCluster object.
+	Object *ocmtypes.ManagedCluster
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// used to store latest error message upon defining or mutating managedCluster definition.
+	errorMsg error
+}
+
+// NewManagedClusterBuilder creates a new instance of ManagedClusterBuilder. The hub accepts the client of the
+// cluster by default.
+func NewManagedClusterBuilder(apiClient *clients.Settings, name string) *ManagedClusterBuilder {
+	logging.V(100).Infof("Initializing new managedCluster structure with the following params: name: %s", name)
+
+	builder := ManagedClusterBuilder{
+		apiClient: apiClient,
+		Definition: &ocmtypes.ManagedCluster{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       ManagedClusterKind,
+				APIVersion: fmt.Sprintf("%s/%s", ClusterAPIGroup, ClusterAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: ocmtypes.ManagedClusterSpec{
+				HubAcceptsClient: true,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the managedCluster is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("managedCluster's 'name' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullManagedCluster pulls existing managedCluster into Builder struct.
+func PullManagedCluster(apiClient *clients.Settings, name string) (*ManagedClusterBuilder, error) {
+	logging.V(100).Infof("Pulling existing managedCluster name %s from cluster", name)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("managedCluster's 'apiClient' cannot be empty")
+	}
+
+	builder := ManagedClusterBuilder{
+		apiClient: apiClient,
+		Definition: &ocmtypes.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the managedCluster is empty")
+
+		return nil, fmt.Errorf("managedCluster's 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("managedCluster object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithHubAcceptsClient sets whether the hub accepts the registration of the managedCluster.
+func (builder *ManagedClusterBuilder) WithHubAcceptsClient(accept bool) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting hubAcceptsClient to %t in managedCluster %s", accept, builder.Definition.Name)
+
+	builder.Definition.Spec.HubAcceptsClient = accept
+
+	return builder
+}
+
+// WithTaint appends a taint to the managedCluster, keeping placements which do not tolerate it from selecting the
+// cluster.
+func (builder *ManagedClusterBuilder) WithTaint(key, value string, effect ocmtypes.TaintEffect) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding taint %s=%s:%s to managedCluster %s", key, value, effect, builder.Definition.Name)
+
+	if key == "" {
+		logging.V(100).Infof("The key of the managedCluster taint is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("managedCluster taint 'key' cannot be empty"))
+
+		return builder
+	}
+
+	switch effect {
+	case ocmtypes.TaintEffectNoSelect, ocmtypes.TaintEffectPreferNoSelect, ocmtypes.TaintEffectNoSelectIfNew:
+	default:
+		logging.V(100).Infof("The effect %s of the managedCluster taint is not supported", effect)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("managedCluster taint 'effect' %s is not supported", effect))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Taints = append(builder.Definition.Spec.Taints, ocmtypes.Taint{
+		Key:       key,
+		Value:     value,
+		Effect:    effect,
+		TimeAdded: metav1.Now(),
+	})
+
+	return builder
+}
+
+// WithClientConfig appends an api server endpoint the hub uses to connect to the managedCluster.
+func (builder *ManagedClusterBuilder) WithClientConfig(url string, caBundle []byte) *ManagedClusterBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding client config with url %s to managedCluster %s", url, builder.Definition.Name)
+
+	if url == "" {
+		logging.V(100).Infof("The url of the managedCluster client config is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("managedCluster client config 'url' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ManagedClusterClientConfigs = append(builder.Definition.Spec.ManagedClusterClientConfigs,
+		ocmtypes.ClientConfig{URL: url, CABundle: caBundle})
+
+	return builder
+}
+
+// Exists checks whether the given managedCluster exists.
+func (builder *ManagedClusterBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if managedCluster %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Get returns a managedCluster object if found.
+func (builder *ManagedClusterBuilder) Get() (*ocmtypes.ManagedCluster, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting managedCluster %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetManagedClusterGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("managedCluster object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	return convertManagedClusterToStructured(unsObject)
+}
+
+// Create makes a managedCluster in the cluster and stores the created object in struct.
+func (builder *ManagedClusterBuilder) Create() (*ManagedClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the managedCluster %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredManagedCluster, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured managedCluster to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetManagedClusterGVR()).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredManagedCluster}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create managedCluster %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertManagedClusterToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing managedCluster object with the managedCluster definition in builder.
+func (builder *ManagedClusterBuilder) Update() (*ManagedClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the managedCluster object: %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update managedCluster, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredManagedCluster, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured managedCluster to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetManagedClusterGVR()).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredManagedCluster}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertManagedClusterToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes a managedCluster from a cluster, which detaches the cluster from the hub.
+func (builder *ManagedClusterBuilder) Delete() (*ManagedClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Deleting the managedCluster %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("managedCluster cannot be deleted because it does not exist")
+	}
+
+	err := builder.apiClient.Resource(GetManagedClusterGVR()).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("can not delete managedCluster: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
+
+// IsAvailable returns true when the klusterlet agent of the managedCluster reports the cluster as available.
+func (builder *ManagedClusterBuilder) IsAvailable() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if managedCluster %s is available", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get managedCluster %s: %w", builder.Definition.Name, err)
+	}
+
+	return meta.IsStatusConditionTrue(builder.Object.Status.Conditions, ConditionManagedClusterAvailable), nil
+}
+
+// WaitUntilAvailable waits for the duration of the defined timeout or until the managedCluster is available. On
+// timeout, the reason and message of the last observed availability condition are included in the returned error.
+func (builder *ManagedClusterBuilder) WaitUntilAvailable(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until managedCluster %s is available", builder.Definition.Name)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			available, err := builder.IsAvailable()
+			if err != nil {
+				logging.V(100).Infof("Failed to check managedCluster %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return available, nil
+		})
+
+	if err != nil && builder.Object != nil {
+		condition := meta.FindStatusCondition(builder.Object.Status.Conditions, ConditionManagedClusterAvailable)
+		if condition != nil {
+			return fmt.Errorf("managedCluster %s is not available, last reason %s: %s: %w",
+				builder.Definition.Name, condition.Reason, condition.Message, err)
+		}
+	}
+
+	return err
+}
+
+// GetClusterClaims returns the cluster claims reported by the managedCluster, keyed by claim name.
+func (builder *ManagedClusterBuilder) GetClusterClaims() (map[string]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting cluster claims of managedCluster %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("managedCluster object %s doesn't exist", builder.Definition.Name)
+	}
+
+	claims := make(map[string]string, len(builder.Object.Status.ClusterClaims))
+
+	for _, claim := range builder.Object.Status.ClusterClaims {
+		claims[claim.Name] = claim.Value
+	}
+
+	return claims, nil
+}
+
+// ListAddonsStatus returns the status of the Available condition of every addon installed on the managedCluster,
+// keyed by addon name. Addons which have not reported availability yet are Unknown.
+func (builder *ManagedClusterBuilder) ListAddonsStatus() (map[string]metav1.ConditionStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Listing addons status of managedCluster %s", builder.Definition.Name)
+
+	unsList, err := builder.apiClient.Resource(GetManagedClusterAddOnGVR()).Namespace(builder.Definition.Name).List(
+		context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addons of managedCluster %s: %w", builder.Definition.Name, err)
+	}
+
+	addonsStatus := make(map[string]metav1.ConditionStatus, len(unsList.Items))
+
+	for _, unsObject := range unsList.Items {
+		addon := &ocmtypes.ManagedClusterAddOn{}
+
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, addon)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert addon %s of managedCluster %s: %w",
+				unsObject.GetName(), builder.Definition.Name, err)
+		}
+
+		addonsStatus[addon.Name] = metav1.ConditionUnknown
+
+		condition := meta.FindStatusCondition(addon.Status.Conditions, ConditionAddonAvailable)
+		if condition != nil {
+			addonsStatus[addon.Name] = condition.Status
+		}
+	}
+
+	return addonsStatus, nil
+}
+
+// GetManagedClusterGVR returns managedCluster's GroupVersionResource which could be used for Clean function.
+func GetManagedClusterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: ClusterAPIGroup, Version: ClusterAPIVersion, Resource: "managedclusters"}
+}
+
+// GetManagedClusterAddOnGVR returns managedClusterAddOn's GroupVersionResource which could be used for Clean
+// function.
+func GetManagedClusterAddOnGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: AddonAPIGroup, Version: AddonAPIVersion, Resource: "managedclusteraddons"}
+}
+
+// convertManagedClusterToStructured converts the unstructured object returned by the dynamic client to a
+// managedCluster.
+func convertManagedClusterToStructured(unsObject *unstructured.Unstructured) (*ocmtypes.ManagedCluster, error) {
+	managedCluster := &ocmtypes.ManagedCluster{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, managedCluster)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to managedCluster object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return managedCluster, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ManagedClusterBuilder) validate() (bool, error) {
+	resourceCRD := "managedCluster"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,290 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/ocm/ocmtypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	managedClusterGVK = schema.GroupVersionKind{
+		Group:   ClusterAPIGroup,
+		Version: ClusterAPIVersion,
+		Kind:    ManagedClusterKind,
+	}
+	managedClusterAddOnGVK = schema.GroupVersionKind{
+		Group:   AddonAPIGroup,
+		Version: AddonAPIVersion,
+		Kind:    ManagedClusterAddOnKind,
+	}
+	defaultManagedClusterName = "spoke1"
+)
+
+func TestNewManagedClusterBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expectedError string
+	}{
+		{
+			name:          defaultManagedClusterName,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			expectedError: "managedCluster's 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewManagedClusterBuilder(testSettings, testCase.name)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.True(t, testBuilder.Definition.Spec.HubAcceptsClient)
+		}
+	}
+}
+
+func TestPullManagedCluster(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultManagedClusterName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultManagedClusterName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("managedCluster object %s doesn't exist", defaultManagedClusterName),
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("managedCluster's 'name' cannot be empty"),
+		},
+		{
+			name:                defaultManagedClusterName,
+			addToRuntimeObjects: false,
+			client:              false,
+			expectedError:       fmt.Errorf("managedCluster's 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyManagedCluster(nil))
+		}
+
+		if testCase.client {
+			testSettings = buildManagedClusterTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullManagedCluster(testSettings, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestManagedClusterWithTaint(t *testing.T) {
+	testCases := []struct {
+		key           string
+		effect        ocmtypes.TaintEffect
+		expectedError string
+	}{
+		{
+			key:           "maintenance",
+			effect:        ocmtypes.TaintEffectNoSelect,
+			expectedError: "",
+		},
+		{
+			key:           "",
+			effect:        ocmtypes.TaintEffectNoSelect,
+			expectedError: "managedCluster taint 'key' cannot be empty",
+		},
+		{
+			key:           "maintenance",
+			effect:        "NoSchedule",
+			expectedError: "managedCluster taint 'effect' NoSchedule is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewManagedClusterBuilder(testSettings, defaultManagedClusterName).
+			WithTaint(testCase.key, "true", testCase.effect)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Len(t, testBuilder.Definition.Spec.Taints, 1)
+			assert.Equal(t, testCase.key, testBuilder.Definition.Spec.Taints[0].Key)
+			assert.Equal(t, testCase.effect, testBuilder.Definition.Spec.Taints[0].Effect)
+		}
+	}
+}
+
+func TestManagedClusterWithClientConfig(t *testing.T) {
+	testCases := []struct {
+		url           string
+		expectedError string
+	}{
+		{
+			url:           "https://api.spoke1.example.com:6443",
+			expectedError: "",
+		},
+		{
+			url:           "",
+			expectedError: "managedCluster client config 'url' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewManagedClusterBuilder(testSettings, defaultManagedClusterName).
+			WithHubAcceptsClient(false).
+			WithClientConfig(testCase.url, []byte("ca"))
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.False(t, testBuilder.Definition.Spec.HubAcceptsClient)
+			assert.Equal(t, []ocmtypes.ClientConfig{{URL: testCase.url, CABundle: []byte("ca")}},
+				testBuilder.Definition.Spec.ManagedClusterClientConfigs)
+		}
+	}
+}
+
+func TestManagedClusterCreateUpdateDelete(t *testing.T) {
+	testSettings := buildManagedClusterTestClientWithDummyObject(nil)
+
+	testBuilder, err := NewManagedClusterBuilder(testSettings, defaultManagedClusterName).Create()
+	assert.Nil(t, err)
+	assert.Equal(t, defaultManagedClusterName, testBuilder.Object.Name)
+
+	testBuilder, err = testBuilder.WithTaint("maintenance", "", ocmtypes.TaintEffectNoSelectIfNew).Update()
+	assert.Nil(t, err)
+	assert.Len(t, testBuilder.Object.Spec.Taints, 1)
+
+	testBuilder, err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+}
+
+func TestManagedClusterWaitUntilAvailable(t *testing.T) {
+	testCases := []struct {
+		conditions    []metav1.Condition
+		expectedError error
+	}{
+		{
+			conditions: []metav1.Condition{{
+				Type: ConditionManagedClusterAvailable, Status: metav1.ConditionTrue, Reason: "ManagedClusterAvailable",
+			}},
+			expectedError: nil,
+		},
+		{
+			conditions: []metav1.Condition{{
+				Type:    ConditionManagedClusterAvailable,
+				Status:  metav1.ConditionUnknown,
+				Reason:  "ManagedClusterLeaseUpdateStopped",
+				Message: "Registration agent stopped updating its lease.",
+			}},
+			expectedError: fmt.Errorf("managedCluster %s is not available, last reason %s: %s: %w",
+				defaultManagedClusterName, "ManagedClusterLeaseUpdateStopped",
+				"Registration agent stopped updating its lease.", context.DeadlineExceeded),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildManagedClusterTestClientWithDummyObject(
+			[]runtime.Object{buildDummyManagedCluster(testCase.conditions)})
+
+		err := NewManagedClusterBuilder(testSettings, defaultManagedClusterName).WaitUntilAvailable(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestManagedClusterGetClusterClaims(t *testing.T) {
+	managedCluster := buildDummyManagedCluster(nil)
+	managedCluster.Status.ClusterClaims = []ocmtypes.ManagedClusterClaim{
+		{Name: "id.k8s.io", Value: "1234"},
+		{Name: "version.openshift.io", Value: "4.16.0"},
+	}
+
+	testSettings := buildManagedClusterTestClientWithDummyObject([]runtime.Object{managedCluster})
+
+	claims, err := NewManagedClusterBuilder(testSettings, defaultManagedClusterName).GetClusterClaims()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"id.k8s.io": "1234", "version.openshift.io": "4.16.0"}, claims)
+}
+
+func TestManagedClusterListAddonsStatus(t *testing.T) {
+	testSettings := buildManagedClusterTestClientWithDummyObject([]runtime.Object{
+		buildDummyManagedCluster(nil),
+		buildDummyManagedClusterAddOn("config-policy-controller", []metav1.Condition{{
+			Type: ConditionAddonAvailable, Status: metav1.ConditionTrue, Reason: "ManagedClusterAddOnLeaseUpdated",
+		}}),
+		buildDummyManagedClusterAddOn("work-manager", nil),
+	})
+
+	addonsStatus, err := NewManagedClusterBuilder(testSettings, defaultManagedClusterName).ListAddonsStatus()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]metav1.ConditionStatus{
+		"config-policy-controller": metav1.ConditionTrue,
+		"work-manager":             metav1.ConditionUnknown,
+	}, addonsStatus)
+}
+
+func buildDummyManagedCluster(conditions []metav1.Condition) *ocmtypes.ManagedCluster {
+	return &ocmtypes.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultManagedClusterName,
+		},
+		Spec: ocmtypes.ManagedClusterSpec{
+			HubAcceptsClient: true,
+		},
+		Status: ocmtypes.ManagedClusterStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func buildDummyManagedClusterAddOn(name string, conditions []metav1.Condition) *ocmtypes.ManagedClusterAddOn {
+	return &ocmtypes.ManagedClusterAddOn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultManagedClusterName,
+		},
+		Status: ocmtypes.ManagedClusterAddOnStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func buildManagedClusterTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{managedClusterGVK, managedClusterAddOnGVK},
+	})
+}
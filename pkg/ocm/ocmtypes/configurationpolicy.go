@@ -0,0 +1,55 @@
+package ocmtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ComplianceType defines how the objects of a ConfigurationPolicy are compared to the objects on the cluster.
+type ComplianceType string
+
+const (
+	// ComplianceTypeMustHave requires the object to exist with at least the fields of the template.
+	ComplianceTypeMustHave ComplianceType = "musthave"
+	// ComplianceTypeMustNotHave requires the object not to exist.
+	ComplianceTypeMustNotHave ComplianceType = "mustnothave"
+	// ComplianceTypeMustOnlyHave requires the object to exist with exactly the fields of the template.
+	ComplianceTypeMustOnlyHave ComplianceType = "mustonlyhave"
+)
+
+// Target selects the namespaces namespaced objects of a ConfigurationPolicy are checked in.
+type Target struct {
+	// include are the namespaces, or globs of namespaces, which are checked.
+	Include []string `json:"include,omitempty"`
+	// exclude are the namespaces, or globs of namespaces, which are skipped.
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// ObjectTemplate is an object checked, and optionally enforced, by a ConfigurationPolicy.
+type ObjectTemplate struct {
+	// complianceType defines how the object is compared to the object on the cluster.
+	ComplianceType ComplianceType `json:"complianceType"`
+	// objectDefinition is the object to compare.
+	ObjectDefinition runtime.RawExtension `json:"objectDefinition"`
+}
+
+// ConfigurationPolicySpec defines the desired state of ConfigurationPolicy.
+type ConfigurationPolicySpec struct {
+	// remediationAction is either inform or enforce.
+	RemediationAction string `json:"remediationAction,omitempty"`
+	// severity of a violation of the policy.
+	Severity string `json:"severity,omitempty"`
+	// namespaceSelector selects the namespaces of namespaced objects without a namespace.
+	NamespaceSelector Target `json:"namespaceSelector,omitempty"`
+	// objectTemplates are the objects checked by the policy.
+	ObjectTemplates []*ObjectTemplate `json:"object-templates,omitempty"`
+}
+
+// ConfigurationPolicy checks, and optionally enforces, the state of objects on the managed clusters. It is never
+// created directly but wrapped into the templates of a Policy.
+type ConfigurationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ConfigurationPolicySpec `json:"spec,omitempty"`
+}
@@ -0,0 +1,188 @@
+package ocmtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TaintEffect defines the effect of a taint on the placement of workloads on a ManagedCluster.
+type TaintEffect string
+
+const (
+	// TaintEffectNoSelect means placements must not select the cluster unless they tolerate the taint.
+	TaintEffectNoSelect TaintEffect = "NoSelect"
+	// TaintEffectPreferNoSelect means placements should avoid selecting the cluster unless they tolerate the taint.
+	TaintEffectPreferNoSelect TaintEffect = "PreferNoSelect"
+	// TaintEffectNoSelectIfNew means placements must not newly select the cluster unless they tolerate the taint,
+	// clusters already selected are kept.
+	TaintEffectNoSelectIfNew TaintEffect = "NoSelectIfNew"
+)
+
+// ClientConfig defines how the hub connects to the api server of a ManagedCluster.
+type ClientConfig struct {
+	// url of the api server of the managed cluster.
+	URL string `json:"url"`
+	// caBundle is the ca bundle used to verify the certificate of the api server.
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// Taint is applied to a ManagedCluster to keep placements from selecting it.
+type Taint struct {
+	// key of the taint.
+	Key string `json:"key"`
+	// value of the taint.
+	Value string `json:"value,omitempty"`
+	// effect of the taint on placements which do not tolerate it.
+	Effect TaintEffect `json:"effect"`
+	// timeAdded is the time the taint was added.
+	TimeAdded metav1.Time `json:"timeAdded"`
+}
+
+// ManagedClusterSpec defines the desired state of ManagedCluster.
+type ManagedClusterSpec struct {
+	// managedClusterClientConfigs are the api server endpoints of the managed cluster.
+	ManagedClusterClientConfigs []ClientConfig `json:"managedClusterClientConfigs,omitempty"`
+	// hubAcceptsClient defines whether the hub accepts the registration of the managed cluster.
+	HubAcceptsClient bool `json:"hubAcceptsClient"`
+	// leaseDurationSeconds is the interval the klusterlet agent updates its lease with.
+	LeaseDurationSeconds int32 `json:"leaseDurationSeconds,omitempty"`
+	// taints of the managed cluster.
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// ManagedClusterClaim is a cluster property reported by the klusterlet agent through a ClusterClaim.
+type ManagedClusterClaim struct {
+	// name of the claim.
+	Name string `json:"name,omitempty"`
+	// value of the claim.
+	Value string `json:"value,omitempty"`
+}
+
+// ManagedClusterVersion represents the version of the managed cluster.
+type ManagedClusterVersion struct {
+	// kubernetes version of the managed cluster.
+	Kubernetes string `json:"kubernetes,omitempty"`
+}
+
+// ManagedClusterStatus defines the observed state of ManagedCluster.
+type ManagedClusterStatus struct {
+	// conditions describe the state of the managed cluster.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// version of the managed cluster.
+	Version ManagedClusterVersion `json:"version,omitempty"`
+	// clusterClaims reported by the managed cluster.
+	ClusterClaims []ManagedClusterClaim `json:"clusterClaims,omitempty"`
+}
+
+// ManagedCluster represents a cluster registered to the hub.
+type ManagedCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedClusterSpec   `json:"spec"`
+	Status ManagedClusterStatus `json:"status,omitempty"`
+}
+
+// ManagedClusterAddOnSpec defines the desired state of ManagedClusterAddOn.
+type ManagedClusterAddOnSpec struct {
+	// installNamespace is the namespace on the managed cluster the addon agent is installed in.
+	InstallNamespace string `json:"installNamespace,omitempty"`
+}
+
+// ManagedClusterAddOnStatus defines the observed state of ManagedClusterAddOn.
+type ManagedClusterAddOnStatus struct {
+	// conditions describe the state of the addon.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ManagedClusterAddOn is an addon installed on a managed cluster. It lives in the namespace named after the cluster.
+type ManagedClusterAddOn struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedClusterAddOnSpec   `json:"spec"`
+	Status ManagedClusterAddOnStatus `json:"status,omitempty"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedCluster.
+func (in *ManagedCluster) DeepCopy() *ManagedCluster {
+	if in == nil {
+		return nil
+	}
+
+	out := new(ManagedCluster)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status.Version = in.Status.Version
+
+	if in.Spec.ManagedClusterClientConfigs != nil {
+		out.Spec.ManagedClusterClientConfigs = make([]ClientConfig, len(in.Spec.ManagedClusterClientConfigs))
+
+		for index, config := range in.Spec.ManagedClusterClientConfigs {
+			out.Spec.ManagedClusterClientConfigs[index].URL = config.URL
+			out.Spec.ManagedClusterClientConfigs[index].CABundle = append([]byte(nil), config.CABundle...)
+		}
+	}
+
+	if in.Spec.Taints != nil {
+		out.Spec.Taints = make([]Taint, len(in.Spec.Taints))
+
+		for index := range in.Spec.Taints {
+			out.Spec.Taints[index] = in.Spec.Taints[index]
+			in.Spec.Taints[index].TimeAdded.DeepCopyInto(&out.Spec.Taints[index].TimeAdded)
+		}
+	}
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	out.Status.ClusterClaims = append([]ManagedClusterClaim(nil), in.Status.ClusterClaims...)
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedCluster) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAddOn.
+func (in *ManagedClusterAddOn) DeepCopy() *ManagedClusterAddOn {
+	if in == nil {
+		return nil
+	}
+
+	out := new(ManagedClusterAddOn)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedClusterAddOn) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -2,10 +2,11 @@ package ocm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,12 +23,62 @@ type PlacementBindingBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// used to store latest error message upon defining or mutating placementBinding definition.
-	errorMsg string
+	errorMsg error
+}
+
+// NewPlacementBindingBuilder creates a new instance of PlacementBindingBuilder binding the subject to the placement.
+func NewPlacementBindingBuilder(
+	apiClient *clients.Settings,
+	name, nsname string,
+	placementRef policiesv1.PlacementSubject,
+	subject policiesv1.Subject) *PlacementBindingBuilder {
+	logging.V(100).Infof(
+		"Initializing new placementBinding structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := PlacementBindingBuilder{
+		apiClient: apiClient,
+		Definition: &policiesv1.PlacementBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			PlacementRef: placementRef,
+			Subjects:     []policiesv1.Subject{subject},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the placementBinding is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementBinding's 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the placementBinding is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementBinding's 'namespace' cannot be empty"))
+	}
+
+	if placementRef.Name == "" || placementRef.Kind == "" {
+		logging.V(100).Infof("The placementRef of the placementBinding is incomplete")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("placementBinding's 'placementRef' must have a name and a kind"))
+	}
+
+	if subject.Name == "" || subject.Kind == "" {
+		logging.V(100).Infof("The subject of the placementBinding is incomplete")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("placementBinding's 'subject' must have a name and a kind"))
+	}
+
+	return &builder
 }
 
 // PullPlacementBinding pulls existing placementBinding into Builder struct.
 func PullPlacementBinding(apiClient *clients.Settings, name, nsname string) (*PlacementBindingBuilder, error) {
-	glog.V(100).Infof("Pulling existing placementBinding name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing placementBinding name %s under namespace %s from cluster", name, nsname)
 
 	builder := PlacementBindingBuilder{
 		apiClient: apiClient,
@@ -40,15 +91,15 @@ func PullPlacementBinding(apiClient *clients.Settings, name, nsname string) (*Pl
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the placementBinding is empty")
+		logging.V(100).Infof("The name of the placementBinding is empty")
 
-		builder.errorMsg = "placementBinding's 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementBinding's 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the placementBinding is empty")
+		logging.V(100).Infof("The namespace of the placementBinding is empty")
 
-		builder.errorMsg = "placementBinding's 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementBinding's 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -66,7 +117,7 @@ func (builder *PlacementBindingBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if placementBinding %s exists in namespace %s",
+	logging.V(100).Infof("Checking if placementBinding %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -81,7 +132,7 @@ func (builder *PlacementBindingBuilder) Get() (*policiesv1.PlacementBinding, err
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting placementBinding %s in namespace %s",
+	logging.V(100).Infof("Getting placementBinding %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	placementBinding := &policiesv1.PlacementBinding{}
@@ -104,7 +155,7 @@ func (builder *PlacementBindingBuilder) Create() (*PlacementBindingBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the placementBinding %s in namespace %s",
+	logging.V(100).Infof("Creating the placementBinding %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -124,7 +175,7 @@ func (builder *PlacementBindingBuilder) Delete() (*PlacementBindingBuilder, erro
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the placementBinding %s in namespace %s",
+	logging.V(100).Infof("Deleting the placementBinding %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -148,21 +199,21 @@ func (builder *PlacementBindingBuilder) Update(force bool) (*PlacementBindingBui
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the placementBinding object: %s in namespace: %s",
+	logging.V(100).Infof("Updating the placementBinding object: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				"Failed to update the placementBinding object %s. "+
 					"Note: Force flag set, executed delete/create methods instead", builder.Definition.Name)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					"Failed to update the placementBinding object %s, "+
 						"due to error in delete function", builder.Definition.Name)
 
@@ -186,27 +237,29 @@ func (builder *PlacementBindingBuilder) validate() (bool, error) {
 	resourceCRD := "PlacementBinding"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -2,10 +2,11 @@ package ocm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,12 +24,49 @@ type PlacementRuleBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// used to store latest error message upon defining or mutating PlacementRule definition.
-	errorMsg string
+	errorMsg error
+}
+
+// NewPlacementRuleBuilder creates a new instance of PlacementRuleBuilder selecting the clusters which match the
+// given selector.
+func NewPlacementRuleBuilder(
+	apiClient *clients.Settings, name, nsname string, clusterSelector metav1.LabelSelector) *PlacementRuleBuilder {
+	logging.V(100).Infof(
+		"Initializing new placementrule structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := PlacementRuleBuilder{
+		apiClient: apiClient,
+		Definition: &placementrulev1.PlacementRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: placementrulev1.PlacementRuleSpec{
+				GenericPlacementFields: placementrulev1.GenericPlacementFields{
+					ClusterSelector: &clusterSelector,
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the placementrule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementrule's 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the placementrule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementrule's 'namespace' cannot be empty"))
+	}
+
+	return &builder
 }
 
 // PullPlacementRule pulls existing placementrule into Builder struct.
 func PullPlacementRule(apiClient *clients.Settings, name, nsname string) (*PlacementRuleBuilder, error) {
-	glog.V(100).Infof("Pulling existing placementrule name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing placementrule name %s under namespace %s from cluster", name, nsname)
 
 	builder := PlacementRuleBuilder{
 		apiClient: apiClient,
@@ -41,15 +79,15 @@ func PullPlacementRule(apiClient *clients.Settings, name, nsname string) (*Place
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the placementrule is empty")
+		logging.V(100).Infof("The name of the placementrule is empty")
 
-		builder.errorMsg = "placementrule's 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementrule's 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the placementrule is empty")
+		logging.V(100).Infof("The namespace of the placementrule is empty")
 
-		builder.errorMsg = "placementrule's 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("placementrule's 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -67,7 +105,7 @@ func (builder *PlacementRuleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if placementrule %s exists in namespace %s",
+	logging.V(100).Infof("Checking if placementrule %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -82,7 +120,7 @@ func (builder *PlacementRuleBuilder) Get() (*placementrulev1.PlacementRule, erro
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting placementrule %s in namespace %s",
+	logging.V(100).Infof("Getting placementrule %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	placementRule := &placementrulev1.PlacementRule{}
@@ -105,7 +143,7 @@ func (builder *PlacementRuleBuilder) Create() (*PlacementRuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the placementrule %s in namespace %s",
+	logging.V(100).Infof("Creating the placementrule %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -125,7 +163,7 @@ func (builder *PlacementRuleBuilder) Delete() (*PlacementRuleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the placementrule %s in namespace %s",
+	logging.V(100).Infof("Deleting the placementrule %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -149,21 +187,21 @@ func (builder *PlacementRuleBuilder) Update(force bool) (*PlacementRuleBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the placementrule object: %s in namespace: %s",
+	logging.V(100).Infof("Updating the placementrule object: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				"Failed to update the placementrule object %s. "+
 					"Note: Force flag set, executed delete/create methods instead", builder.Definition.Name)
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					"Failed to update the placementrule object %s, "+
 						"due to error in delete function", builder.Definition.Name)
 
@@ -187,27 +225,29 @@ func (builder *PlacementRuleBuilder) validate() (bool, error) {
 	resourceCRD := "placementRule"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
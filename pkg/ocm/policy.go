@@ -2,10 +2,11 @@ package ocm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,12 +24,52 @@ type PolicyBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// used to store latest error message upon defining or mutating application definition.
-	errorMsg string
+	errorMsg error
+}
+
+// NewPolicyBuilder creates a new instance of PolicyBuilder wrapping the given policy template.
+func NewPolicyBuilder(
+	apiClient *clients.Settings, name, nsname string, template *policiesv1.PolicyTemplate) *PolicyBuilder {
+	logging.V(100).Infof(
+		"Initializing new policy structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := PolicyBuilder{
+		apiClient: apiClient,
+		Definition: &policiesv1.Policy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: policiesv1.PolicySpec{
+				PolicyTemplates: []*policiesv1.PolicyTemplate{template},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the policy is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policy's 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the policy is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policy's 'namespace' cannot be empty"))
+	}
+
+	if template == nil {
+		logging.V(100).Infof("The template of the policy is nil")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policy's 'template' cannot be nil"))
+	}
+
+	return &builder
 }
 
 // PullPolicy pulls existing policy into Builder struct.
 func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilder, error) {
-	glog.V(100).Infof("Pulling existing policy name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing policy name %s under namespace %s from cluster", name, nsname)
 
 	builder := PolicyBuilder{
 		apiClient: apiClient,
@@ -41,15 +82,15 @@ func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilde
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the policy is empty")
+		logging.V(100).Infof("The name of the policy is empty")
 
-		builder.errorMsg = "policy's 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policy's 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the policy is empty")
+		logging.V(100).Infof("The namespace of the policy is empty")
 
-		builder.errorMsg = "policy's 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policy's 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -67,7 +108,7 @@ func (builder *PolicyBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if policy %s exists in namespace %s",
+	logging.V(100).Infof("Checking if policy %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -82,7 +123,7 @@ func (builder *PolicyBuilder) Get() (*policiesv1.Policy, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting policy %s in namespace %s",
+	logging.V(100).Infof("Getting policy %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	policy := &policiesv1.Policy{}
@@ -105,7 +146,7 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the policy %s in namespace %s",
+	logging.V(100).Infof("Creating the policy %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -125,7 +166,7 @@ func (builder *PolicyBuilder) Delete() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the policy %s in namespace %s",
+	logging.V(100).Infof("Deleting the policy %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -149,20 +190,20 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the policy object: %s in namespace: %s",
+	logging.V(100).Infof("Updating the policy object: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("policy", builder.Definition.Name))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("policy", builder.Definition.Name))
 
 				return nil, err
@@ -185,27 +226,29 @@ func (builder *PolicyBuilder) validate() (bool, error) {
 	resourceCRD := "policy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
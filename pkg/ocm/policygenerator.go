@@ -0,0 +1,342 @@
+package ocm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/ocm/ocmtypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+)
+
+// PolicyGeneratorBuilder provides struct for a ConfigurationPolicy wrapped into a policy, which is placed on the
+// clusters matching a selector through a placementrule and a placementBinding. The three objects are created and
+// deleted together.
+type PolicyGeneratorBuilder struct {
+	// ConfigurationPolicy wrapped into the policy template.
+	ConfigurationPolicy *ocmtypes.ConfigurationPolicy
+	// Policy builder of the generated policy.
+	Policy *PolicyBuilder
+	// PlacementRule builder of the generated placementrule.
+	PlacementRule *PlacementRuleBuilder
+	// PlacementBinding builder of the generated placementBinding.
+	PlacementBinding *PlacementBindingBuilder
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// used to store latest error message upon defining or mutating the generated definitions.
+	errorMsg error
+}
+
+// NewPolicyGeneratorBuilder creates a new instance of PolicyGeneratorBuilder. The policy and ConfigurationPolicy are
+// named after name, the placementrule and placementBinding get the -placementrule and -placementbinding suffixes.
+func NewPolicyGeneratorBuilder(
+	apiClient *clients.Settings, name, nsname string, clusterSelector metav1.LabelSelector) *PolicyGeneratorBuilder {
+	logging.V(100).Infof(
+		"Initializing new policy generator structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	placementRuleName := fmt.Sprintf("%s-placementrule", name)
+
+	builder := PolicyGeneratorBuilder{
+		apiClient: apiClient,
+		ConfigurationPolicy: &ocmtypes.ConfigurationPolicy{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       ConfigurationPolicyKind,
+				APIVersion: fmt.Sprintf("%s/%s", PolicyAPIGroup, PolicyAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: ocmtypes.ConfigurationPolicySpec{
+				RemediationAction: strings.ToLower(string(policiesv1.Inform)),
+				Severity:          "low",
+			},
+		},
+		Policy:        NewPolicyBuilder(apiClient, name, nsname, &policiesv1.PolicyTemplate{}),
+		PlacementRule: NewPlacementRuleBuilder(apiClient, placementRuleName, nsname, clusterSelector),
+		PlacementBinding: NewPlacementBindingBuilder(apiClient, fmt.Sprintf("%s-placementbinding", name), nsname,
+			policiesv1.PlacementSubject{APIGroup: PlacementRuleAPIGroup, Kind: "PlacementRule", Name: placementRuleName},
+			policiesv1.Subject{APIGroup: PolicyAPIGroup, Kind: policiesv1.Kind, Name: name}),
+	}
+
+	builder.Policy.Definition.Spec.RemediationAction = policiesv1.Inform
+
+	builder.errorMsg = errors.Join(
+		builder.Policy.errorMsg, builder.PlacementRule.errorMsg, builder.PlacementBinding.errorMsg)
+
+	return &builder
+}
+
+// WithObjectTemplate appends an object to the ConfigurationPolicy. The object must have its kind and apiVersion set.
+func (builder *PolicyGeneratorBuilder) WithObjectTemplate(
+	complianceType ocmtypes.ComplianceType, objectDefinition runtime.Object) *PolicyGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding %s object template to policy generator %s",
+		complianceType, builder.ConfigurationPolicy.Name)
+
+	switch complianceType {
+	case ocmtypes.ComplianceTypeMustHave, ocmtypes.ComplianceTypeMustNotHave, ocmtypes.ComplianceTypeMustOnlyHave:
+	default:
+		logging.V(100).Infof("The complianceType %s of the object template is not supported", complianceType)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("object template 'complianceType' %s is not supported", complianceType))
+
+		return builder
+	}
+
+	if objectDefinition == nil || objectDefinition.GetObjectKind().GroupVersionKind().Kind == "" {
+		logging.V(100).Infof("The object definition of the object template has no kind")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("object template 'objectDefinition' must have its kind and apiVersion set"))
+
+		return builder
+	}
+
+	rawObject, err := json.Marshal(objectDefinition)
+	if err != nil {
+		logging.V(100).Infof("Failed to marshal the object definition of the object template")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to marshal object template: %w", err))
+
+		return builder
+	}
+
+	builder.ConfigurationPolicy.Spec.ObjectTemplates = append(builder.ConfigurationPolicy.Spec.ObjectTemplates,
+		&ocmtypes.ObjectTemplate{ComplianceType: complianceType, ObjectDefinition: runtime.RawExtension{Raw: rawObject}})
+
+	return builder
+}
+
+// WithRemediationAction sets whether the policy only reports violations, Inform, or fixes them, Enforce.
+func (builder *PolicyGeneratorBuilder) WithRemediationAction(
+	remediationAction policiesv1.RemediationAction) *PolicyGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting remediationAction %s in policy generator %s",
+		remediationAction, builder.ConfigurationPolicy.Name)
+
+	if remediationAction != policiesv1.Inform && remediationAction != policiesv1.Enforce {
+		logging.V(100).Infof("The remediationAction %s of the policy is not supported", remediationAction)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("policy 'remediationAction' %s is not supported", remediationAction))
+
+		return builder
+	}
+
+	builder.Policy.Definition.Spec.RemediationAction = remediationAction
+	builder.ConfigurationPolicy.Spec.RemediationAction = strings.ToLower(string(remediationAction))
+
+	return builder
+}
+
+// WithSeverity sets the severity of a violation of the ConfigurationPolicy.
+func (builder *PolicyGeneratorBuilder) WithSeverity(severity string) *PolicyGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting severity %s in policy generator %s", severity, builder.ConfigurationPolicy.Name)
+
+	switch severity {
+	case "low", "medium", "high", "critical":
+	default:
+		logging.V(100).Infof("The severity %s of the policy is not supported", severity)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policy 'severity' %s is not supported", severity))
+
+		return builder
+	}
+
+	builder.ConfigurationPolicy.Spec.Severity = severity
+
+	return builder
+}
+
+// Create wraps the ConfigurationPolicy into the policy, then creates the policy, placementrule and placementBinding
+// in the cluster.
+func (builder *PolicyGeneratorBuilder) Create() (*PolicyGeneratorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the generated policy %s in namespace %s",
+		builder.ConfigurationPolicy.Name, builder.Policy.Definition.Namespace)
+
+	if len(builder.ConfigurationPolicy.Spec.ObjectTemplates) == 0 {
+		return builder, fmt.Errorf("policy generator %s must have at least one object template",
+			builder.ConfigurationPolicy.Name)
+	}
+
+	rawConfigurationPolicy, err := json.Marshal(builder.ConfigurationPolicy)
+	if err != nil {
+		return builder, fmt.Errorf("failed to marshal ConfigurationPolicy %s: %w", builder.ConfigurationPolicy.Name, err)
+	}
+
+	builder.Policy.Definition.Spec.PolicyTemplates = []*policiesv1.PolicyTemplate{{
+		ObjectDefinition: runtime.RawExtension{Raw: rawConfigurationPolicy},
+	}}
+
+	if _, err := builder.Policy.Create(); err != nil {
+		return builder, fmt.Errorf("failed to create policy %s: %w", builder.Policy.Definition.Name, err)
+	}
+
+	if _, err := builder.PlacementRule.Create(); err != nil {
+		return builder, fmt.Errorf("failed to create placementrule %s: %w", builder.PlacementRule.Definition.Name, err)
+	}
+
+	if _, err := builder.PlacementBinding.Create(); err != nil {
+		return builder, fmt.Errorf("failed to create placementBinding %s: %w",
+			builder.PlacementBinding.Definition.Name, err)
+	}
+
+	return builder, nil
+}
+
+// Delete removes the placementBinding, placementrule and policy from the cluster. Objects which do not exist are
+// skipped.
+func (builder *PolicyGeneratorBuilder) Delete() (*PolicyGeneratorBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Deleting the generated policy %s in namespace %s",
+		builder.ConfigurationPolicy.Name, builder.Policy.Definition.Namespace)
+
+	if builder.PlacementBinding.Exists() {
+		if _, err := builder.PlacementBinding.Delete(); err != nil {
+			return builder, err
+		}
+	}
+
+	if builder.PlacementRule.Exists() {
+		if _, err := builder.PlacementRule.Delete(); err != nil {
+			return builder, err
+		}
+	}
+
+	if builder.Policy.Exists() {
+		if _, err := builder.Policy.Delete(); err != nil {
+			return builder, err
+		}
+	}
+
+	return builder, nil
+}
+
+// GetClusterComplianceState returns the compliance state of the policy on the given cluster.
+func (builder *PolicyGeneratorBuilder) GetClusterComplianceState(
+	clusterName string) (policiesv1.ComplianceState, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting compliance state of policy %s on cluster %s",
+		builder.Policy.Definition.Name, clusterName)
+
+	policy, err := builder.Policy.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to get policy %s: %w", builder.Policy.Definition.Name, err)
+	}
+
+	for _, clusterStatus := range policy.Status.Status {
+		if clusterStatus != nil && clusterStatus.ClusterName == clusterName {
+			return clusterStatus.ComplianceState, nil
+		}
+	}
+
+	return "", fmt.Errorf("policy %s has no compliance state for cluster %s", builder.Policy.Definition.Name, clusterName)
+}
+
+// WaitUntilClusterComplianceState waits for the duration of the defined timeout or until the policy reaches the given
+// compliance state on every given cluster.
+func (builder *PolicyGeneratorBuilder) WaitUntilClusterComplianceState(
+	state policiesv1.ComplianceState, timeout time.Duration, clusterNames ...string) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for policy %s to be %s on clusters %v",
+		builder.Policy.Definition.Name, state, clusterNames)
+
+	if len(clusterNames) == 0 {
+		return fmt.Errorf("at least one cluster must be provided to wait for policy %s", builder.Policy.Definition.Name)
+	}
+
+	var lastStates map[string]policiesv1.ComplianceState
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			lastStates = make(map[string]policiesv1.ComplianceState, len(clusterNames))
+			done := true
+
+			for _, clusterName := range clusterNames {
+				clusterState, err := builder.GetClusterComplianceState(clusterName)
+				if err != nil {
+					logging.V(100).Infof("Failed to get compliance state on cluster %s: %v", clusterName, err)
+				}
+
+				lastStates[clusterName] = clusterState
+				done = done && clusterState == state
+			}
+
+			return done, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("policy %s did not become %s on all clusters, last states %v: %w",
+			builder.Policy.Definition.Name, state, lastStates, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PolicyGeneratorBuilder) validate() (bool, error) {
+	resourceCRD := "policy generator"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.ConfigurationPolicy == nil || builder.Policy == nil ||
+		builder.PlacementRule == nil || builder.PlacementBinding == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,190 @@
+package ocm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/ocm/ocmtypes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+)
+
+var (
+	defaultPolicyGeneratorName   = "test-policy"
+	defaultPolicyGeneratorNsName = "test-policies"
+	defaultClusterSelector       = metav1.LabelSelector{MatchLabels: map[string]string{"common": "true"}}
+)
+
+func TestNewPolicyGeneratorBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		expectedError string
+	}{
+		{
+			name:          defaultPolicyGeneratorName,
+			nsname:        defaultPolicyGeneratorNsName,
+			expectedError: "",
+		},
+		{
+			name:   defaultPolicyGeneratorName,
+			nsname: "",
+			expectedError: "policy's 'namespace' cannot be empty\nplacementrule's 'namespace' cannot be empty\n" +
+				"placementBinding's 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewPolicyGeneratorBuilder(testSettings, testCase.name, testCase.nsname, defaultClusterSelector)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, "test-policy-placementrule", testBuilder.PlacementRule.Definition.Name)
+			assert.Equal(t, "test-policy-placementrule", testBuilder.PlacementBinding.Definition.PlacementRef.Name)
+			assert.Equal(t, testCase.name, testBuilder.PlacementBinding.Definition.Subjects[0].Name)
+		}
+	}
+}
+
+func TestPolicyGeneratorWithObjectTemplate(t *testing.T) {
+	testCases := []struct {
+		complianceType   ocmtypes.ComplianceType
+		objectDefinition runtime.Object
+		expectedError    string
+	}{
+		{
+			complianceType:   ocmtypes.ComplianceTypeMustHave,
+			objectDefinition: buildDummyPolicyNamespace(),
+			expectedError:    "",
+		},
+		{
+			complianceType:   "shouldhave",
+			objectDefinition: buildDummyPolicyNamespace(),
+			expectedError:    "object template 'complianceType' shouldhave is not supported",
+		},
+		{
+			complianceType:   ocmtypes.ComplianceTypeMustNotHave,
+			objectDefinition: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			expectedError:    "object template 'objectDefinition' must have its kind and apiVersion set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := buildValidPolicyGeneratorBuilder(testSettings).
+			WithObjectTemplate(testCase.complianceType, testCase.objectDefinition)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Len(t, testBuilder.ConfigurationPolicy.Spec.ObjectTemplates, 1)
+			assert.Equal(t, testCase.complianceType, testBuilder.ConfigurationPolicy.Spec.ObjectTemplates[0].ComplianceType)
+		}
+	}
+}
+
+func TestPolicyGeneratorWithRemediationActionAndSeverity(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidPolicyGeneratorBuilder(testSettings).
+		WithRemediationAction(policiesv1.Enforce).
+		WithSeverity("high")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, policiesv1.Enforce, testBuilder.Policy.Definition.Spec.RemediationAction)
+	assert.Equal(t, "enforce", testBuilder.ConfigurationPolicy.Spec.RemediationAction)
+	assert.Equal(t, "high", testBuilder.ConfigurationPolicy.Spec.Severity)
+
+	testBuilder = buildValidPolicyGeneratorBuilder(testSettings).WithRemediationAction("Remediate")
+	assert.EqualError(t, testBuilder.errorMsg, "policy 'remediationAction' Remediate is not supported")
+
+	testBuilder = buildValidPolicyGeneratorBuilder(testSettings).WithSeverity("urgent")
+	assert.EqualError(t, testBuilder.errorMsg, "policy 'severity' urgent is not supported")
+}
+
+func TestPolicyGeneratorCreateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := buildValidPolicyGeneratorBuilder(testSettings).Create()
+	assert.Equal(t, fmt.Errorf("policy generator %s must have at least one object template",
+		defaultPolicyGeneratorName), err)
+
+	testBuilder, err := buildValidPolicyGeneratorBuilder(testSettings).
+		WithObjectTemplate(ocmtypes.ComplianceTypeMustHave, buildDummyPolicyNamespace()).
+		Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Policy.Exists())
+	assert.True(t, testBuilder.PlacementRule.Exists())
+	assert.True(t, testBuilder.PlacementBinding.Exists())
+
+	configurationPolicy := &ocmtypes.ConfigurationPolicy{}
+	err = json.Unmarshal(testBuilder.Policy.Object.Spec.PolicyTemplates[0].ObjectDefinition.Raw, configurationPolicy)
+	assert.Nil(t, err)
+	assert.Equal(t, ConfigurationPolicyKind, configurationPolicy.Kind)
+	assert.Len(t, configurationPolicy.Spec.ObjectTemplates, 1)
+
+	_, err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Policy.Exists())
+	assert.False(t, testBuilder.PlacementRule.Exists())
+	assert.False(t, testBuilder.PlacementBinding.Exists())
+}
+
+func TestPolicyGeneratorWaitUntilClusterComplianceState(t *testing.T) {
+	testCases := []struct {
+		clusterNames  []string
+		expectedError error
+	}{
+		{
+			clusterNames:  []string{"spoke1"},
+			expectedError: nil,
+		},
+		{
+			clusterNames: []string{"spoke1", "spoke2"},
+			expectedError: fmt.Errorf("policy %s did not become %s on all clusters, last states %v: %w",
+				defaultPolicyGeneratorName, policiesv1.Compliant,
+				map[string]policiesv1.ComplianceState{"spoke1": policiesv1.Compliant, "spoke2": policiesv1.NonCompliant},
+				context.DeadlineExceeded),
+		},
+		{
+			clusterNames: nil,
+			expectedError: fmt.Errorf(
+				"at least one cluster must be provided to wait for policy %s", defaultPolicyGeneratorName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		policy := &policiesv1.Policy{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultPolicyGeneratorName, Namespace: defaultPolicyGeneratorNsName},
+			Status: policiesv1.PolicyStatus{
+				Status: []*policiesv1.CompliancePerClusterStatus{
+					{ClusterName: "spoke1", ClusterNamespace: "spoke1", ComplianceState: policiesv1.Compliant},
+					{ClusterName: "spoke2", ClusterNamespace: "spoke2", ComplianceState: policiesv1.NonCompliant},
+				},
+			},
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{policy}})
+
+		err := buildValidPolicyGeneratorBuilder(testSettings).
+			WaitUntilClusterComplianceState(policiesv1.Compliant, time.Second, testCase.clusterNames...)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidPolicyGeneratorBuilder(apiClient *clients.Settings) *PolicyGeneratorBuilder {
+	return NewPolicyGeneratorBuilder(
+		apiClient, defaultPolicyGeneratorName, defaultPolicyGeneratorNsName, defaultClusterSelector)
+}
+
+func buildDummyPolicyNamespace() *corev1.Namespace {
+	return &corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{Kind: "Namespace", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+	}
+}
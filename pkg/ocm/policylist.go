@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -18,7 +18,7 @@ func ListPoliciesInAllNamespaces(apiClient *clients.Settings,
 	passedOptions := runtimeclient.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -28,14 +28,14 @@ func ListPoliciesInAllNamespaces(apiClient *clients.Settings,
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	policyList := &policiesv1.PolicyList{}
 
 	err := apiClient.Client.List(context.TODO(), policyList, &passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all policies in all namespaces due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all policies in all namespaces due to %s", err.Error())
 
 		return nil, err
 	}
@@ -2,10 +2,11 @@ package ocm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,12 +24,12 @@ type PolicySetBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// used to store latest error message upon defining or mutating policySet definition.
-	errorMsg string
+	errorMsg error
 }
 
 // PullPolicySet pulls existing policySet into Builder struct.
 func PullPolicySet(apiClient *clients.Settings, name, nsname string) (*PolicySetBuilder, error) {
-	glog.V(100).Infof("Pulling existing policySet name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing policySet name %s under namespace %s from cluster", name, nsname)
 
 	builder := PolicySetBuilder{
 		apiClient: apiClient,
@@ -41,15 +42,15 @@ func PullPolicySet(apiClient *clients.Settings, name, nsname string) (*PolicySet
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the policyset is empty")
+		logging.V(100).Infof("The name of the policyset is empty")
 
-		builder.errorMsg = "policyset's 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policyset's 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the policyset is empty")
+		logging.V(100).Infof("The namespace of the policyset is empty")
 
-		builder.errorMsg = "policyset's 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("policyset's 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -67,7 +68,7 @@ func (builder *PolicySetBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if policySet %s exists in namespace %s",
+	logging.V(100).Infof("Checking if policySet %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -82,7 +83,7 @@ func (builder *PolicySetBuilder) Get() (*policiesv1beta1.PolicySet, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting policySet %s in namespace %s",
+	logging.V(100).Infof("Getting policySet %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	policySet := &policiesv1beta1.PolicySet{}
@@ -105,7 +106,7 @@ func (builder *PolicySetBuilder) Create() (*PolicySetBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the policySet %s in namespace %s",
+	logging.V(100).Infof("Creating the policySet %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -125,7 +126,7 @@ func (builder *PolicySetBuilder) Delete() (*PolicySetBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the policySet %s in namespace %s",
+	logging.V(100).Infof("Deleting the policySet %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -149,20 +150,20 @@ func (builder *PolicySetBuilder) Update(force bool) (*PolicySetBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the policySet object: %s in namespace: %s",
+	logging.V(100).Infof("Updating the policySet object: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("policySet", builder.Definition.Name))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("policySet", builder.Definition.Name))
 
 				return nil, err
@@ -185,27 +186,29 @@ func (builder *PolicySetBuilder) validate() (bool, error) {
 	resourceCRD := "policySet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,285 @@
+package odf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/odf/odftypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// CephClusterBuilder provides struct for the CephCluster object, used to inspect the health of the ceph cluster
+// backing an ODF deployment.
+type CephClusterBuilder struct {
+	// CephCluster definition, only used to identify the object.
+	Definition *odftypes.CephCluster
+	// Pulled CephCluster object.
+	Object    *odftypes.CephCluster
+	apiClient *clients.Settings
+}
+
+// PullCephCluster pulls existing CephCluster from cluster.
+func PullCephCluster(apiClient *clients.Settings, name, nsname string) (*CephClusterBuilder, error) {
+	logging.V(100).Infof("Pulling existing CephCluster name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("CephCluster 'apiClient' cannot be empty")
+	}
+
+	builder := CephClusterBuilder{
+		apiClient: apiClient,
+		Definition: &odftypes.CephCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the CephCluster is empty")
+
+		return nil, fmt.Errorf("CephCluster 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the CephCluster is empty")
+
+		return nil, fmt.Errorf("CephCluster 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("CephCluster object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns CephCluster object if found.
+func (builder *CephClusterBuilder) Get() (*odftypes.CephCluster, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting CephCluster object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetCephClusterGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("CephCluster object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	cephCluster := &odftypes.CephCluster{}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, cephCluster)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to CephCluster object")
+
+		return nil, err
+	}
+
+	return cephCluster, nil
+}
+
+// Exists checks whether the given CephCluster exists.
+func (builder *CephClusterBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if CephCluster %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetHealth returns the ceph health, one of HEALTH_OK, HEALTH_WARN or HEALTH_ERR.
+func (builder *CephClusterBuilder) GetHealth() (string, error) {
+	cephStatus, err := builder.getCephStatus()
+	if err != nil {
+		return "", err
+	}
+
+	return cephStatus.Health, nil
+}
+
+// GetHealthDetails returns the failing ceph health checks, keyed by check name.
+func (builder *CephClusterBuilder) GetHealthDetails() (map[string]odftypes.CephHealthMessage, error) {
+	cephStatus, err := builder.getCephStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return cephStatus.Details, nil
+}
+
+// GetOSDCount returns the number of running OSDs of the ceph cluster.
+func (builder *CephClusterBuilder) GetOSDCount() (int, error) {
+	cephStatus, err := builder.getCephStatus()
+	if err != nil {
+		return 0, err
+	}
+
+	if cephStatus.Versions == nil {
+		return 0, nil
+	}
+
+	osdCount := 0
+
+	for _, count := range cephStatus.Versions.Osd {
+		osdCount += count
+	}
+
+	return osdCount, nil
+}
+
+// GetCapacity returns the raw capacity of the ceph cluster.
+func (builder *CephClusterBuilder) GetCapacity() (*odftypes.Capacity, error) {
+	cephStatus, err := builder.getCephStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := cephStatus.Capacity
+
+	return &capacity, nil
+}
+
+// WaitUntilHealthOK waits for the duration of the defined timeout or until the ceph health is HEALTH_OK. On timeout,
+// the last health and failing checks are included in the returned error.
+func (builder *CephClusterBuilder) WaitUntilHealthOK(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until CephCluster %s in namespace %s is %s",
+		builder.Definition.Name, builder.Definition.Namespace, CephHealthOK)
+
+	var lastStatus *odftypes.CephStatus
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			cephStatus, err := builder.getCephStatus()
+			if err != nil {
+				logging.V(100).Infof("Failed to get ceph status of CephCluster %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			lastStatus = cephStatus
+
+			return cephStatus.Health == CephHealthOK, nil
+		})
+
+	if err != nil && lastStatus != nil {
+		return fmt.Errorf("CephCluster %s in namespace %s is not %s, last health %s%s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, CephHealthOK, lastStatus.Health,
+			formatHealthDetails(lastStatus.Details), err)
+	}
+
+	return err
+}
+
+// GetCephClusterGVR returns CephCluster's GroupVersionResource.
+func GetCephClusterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: CephClusterAPIGroup, Version: CephClusterAPIVersion, Resource: "cephclusters",
+	}
+}
+
+// getCephStatus refreshes the CephCluster object and returns its ceph status.
+func (builder *CephClusterBuilder) getCephStatus() (*odftypes.CephStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting ceph status of CephCluster %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CephCluster %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	if builder.Object.Status.CephStatus == nil {
+		return nil, fmt.Errorf("CephCluster %s in namespace %s has no ceph status",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.CephStatus, nil
+}
+
+// formatHealthDetails returns the failing health checks sorted by name for use in error messages.
+func formatHealthDetails(details map[string]odftypes.CephHealthMessage) string {
+	if len(details) == 0 {
+		return ""
+	}
+
+	checks := make([]string, 0, len(details))
+
+	for name, detail := range details {
+		checks = append(checks, fmt.Sprintf("%s: %s", name, detail.Message))
+	}
+
+	sort.Strings(checks)
+
+	return fmt.Sprintf(" (%s)", strings.Join(checks, "; "))
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *CephClusterBuilder) validate() (bool, error) {
+	resourceCRD := "CephCluster"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	var err error
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,172 @@
+package odf
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/odf/odftypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	cephClusterGVK = schema.GroupVersionKind{
+		Group:   CephClusterAPIGroup,
+		Version: CephClusterAPIVersion,
+		Kind:    CephClusterKind,
+	}
+	defaultCephClusterName = defaultStorageClusterName + cephClusterNameSuffix
+)
+
+func TestPullCephCluster(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultCephClusterName,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			expectedError:       fmt.Errorf("CephCluster 'name' cannot be empty"),
+		},
+		{
+			name:                defaultCephClusterName,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf(
+				"CephCluster object %s doesn't exist in namespace %s", defaultCephClusterName, ODFNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyCephCluster(buildDummyCephStatus(CephHealthOK, nil)))
+		}
+
+		testBuilder, err := PullCephCluster(
+			buildCephClusterTestClientWithDummyObject(runtimeObjects), testCase.name, ODFNamespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestCephClusterHealthQueries(t *testing.T) {
+	details := map[string]odftypes.CephHealthMessage{
+		"OSD_DOWN": {Severity: CephHealthWarn, Message: "1 osds down"},
+	}
+
+	testBuilder, err := PullCephCluster(buildCephClusterTestClientWithDummyObject(
+		[]runtime.Object{buildDummyCephCluster(buildDummyCephStatus(CephHealthWarn, details))}),
+		defaultCephClusterName, ODFNamespace)
+	assert.Nil(t, err)
+
+	health, err := testBuilder.GetHealth()
+	assert.Nil(t, err)
+	assert.Equal(t, CephHealthWarn, health)
+
+	healthDetails, err := testBuilder.GetHealthDetails()
+	assert.Nil(t, err)
+	assert.Equal(t, details, healthDetails)
+
+	osdCount, err := testBuilder.GetOSDCount()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, osdCount)
+
+	capacity, err := testBuilder.GetCapacity()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(300), capacity.TotalBytes)
+	assert.Equal(t, int64(100), capacity.UsedBytes)
+}
+
+func TestCephClusterMissingCephStatus(t *testing.T) {
+	testBuilder, err := PullCephCluster(buildCephClusterTestClientWithDummyObject(
+		[]runtime.Object{buildDummyCephCluster(nil)}), defaultCephClusterName, ODFNamespace)
+	assert.Nil(t, err)
+
+	_, err = testBuilder.GetHealth()
+	assert.EqualError(t, err, fmt.Sprintf("CephCluster %s in namespace %s has no ceph status",
+		defaultCephClusterName, ODFNamespace))
+}
+
+func TestCephClusterWaitUntilHealthOK(t *testing.T) {
+	testCases := []struct {
+		cephStatus    *odftypes.CephStatus
+		expectedError string
+	}{
+		{
+			cephStatus:    buildDummyCephStatus(CephHealthOK, nil),
+			expectedError: "",
+		},
+		{
+			cephStatus: buildDummyCephStatus(CephHealthErr, map[string]odftypes.CephHealthMessage{
+				"PG_DEGRADED":  {Severity: CephHealthWarn, Message: "Degraded data redundancy"},
+				"MON_DISK_LOW": {Severity: CephHealthErr, Message: "mon a is low on available space"},
+			}),
+			expectedError: fmt.Sprintf("CephCluster %s in namespace %s is not HEALTH_OK, last health HEALTH_ERR "+
+				"(MON_DISK_LOW: mon a is low on available space; PG_DEGRADED: Degraded data redundancy): "+
+				"context deadline exceeded", defaultCephClusterName, ODFNamespace),
+		},
+		{
+			cephStatus:    nil,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := PullCephCluster(buildCephClusterTestClientWithDummyObject(
+			[]runtime.Object{buildDummyCephCluster(testCase.cephStatus)}), defaultCephClusterName, ODFNamespace)
+		assert.Nil(t, err)
+
+		err = testBuilder.WaitUntilHealthOK(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildDummyCephCluster(cephStatus *odftypes.CephStatus) *odftypes.CephCluster {
+	return &odftypes.CephCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultCephClusterName,
+			Namespace: ODFNamespace,
+		},
+		Status: odftypes.CephClusterStatus{
+			Phase:      "Ready",
+			CephStatus: cephStatus,
+		},
+	}
+}
+
+func buildDummyCephStatus(health string, details map[string]odftypes.CephHealthMessage) *odftypes.CephStatus {
+	return &odftypes.CephStatus{
+		Health:  health,
+		Details: details,
+		Capacity: odftypes.Capacity{
+			TotalBytes:     300,
+			UsedBytes:      100,
+			AvailableBytes: 200,
+		},
+		Versions: &odftypes.CephDaemonsVersions{
+			Osd: map[string]int{"ceph version 18.2.1": 3},
+		},
+	}
+}
+
+func buildCephClusterTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{cephClusterGVK},
+	})
+}
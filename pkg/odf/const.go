@@ -0,0 +1,29 @@
+package odf
+
+const (
+	// StorageClusterAPIGroup represents the ocs operator api group of StorageCluster.
+	StorageClusterAPIGroup = "ocs.openshift.io"
+	// StorageClusterAPIVersion represents the version of the ocs operator api.
+	StorageClusterAPIVersion = "v1"
+	// StorageClusterKind represents kind of StorageCluster object.
+	StorageClusterKind = "StorageCluster"
+	// CephClusterAPIGroup represents the rook api group of CephCluster.
+	CephClusterAPIGroup = "ceph.rook.io"
+	// CephClusterAPIVersion represents the version of the rook api.
+	CephClusterAPIVersion = "v1"
+	// CephClusterKind represents kind of CephCluster object.
+	CephClusterKind = "CephCluster"
+	// ODFNamespace represents the namespace ODF is installed in by default.
+	ODFNamespace = "openshift-storage"
+	// StorageClusterPhaseReady represents the phase of a StorageCluster once all its components are available.
+	StorageClusterPhaseReady = "Ready"
+	// CephHealthOK represents the health of a ceph cluster without any failing health check.
+	CephHealthOK = "HEALTH_OK"
+	// CephHealthWarn represents the health of a ceph cluster with at least one warning health check.
+	CephHealthWarn = "HEALTH_WARN"
+	// CephHealthErr represents the health of a ceph cluster with at least one error health check.
+	CephHealthErr = "HEALTH_ERR"
+
+	// cephClusterNameSuffix is appended by the ocs operator to the StorageCluster name to name its CephCluster.
+	cephClusterNameSuffix = "-cephcluster"
+)
@@ -0,0 +1,150 @@
+package odftypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CephHealthMessage is a single ceph health check reported by the cluster.
+type CephHealthMessage struct {
+	// severity of the check, HEALTH_WARN or HEALTH_ERR.
+	Severity string `json:"severity"`
+	// message describing the check.
+	Message string `json:"message"`
+}
+
+// Capacity is the raw capacity of the ceph cluster.
+type Capacity struct {
+	// bytesTotal is the raw capacity of all OSDs.
+	TotalBytes int64 `json:"bytesTotal,omitempty"`
+	// bytesUsed is the raw capacity in use.
+	UsedBytes int64 `json:"bytesUsed,omitempty"`
+	// bytesAvailable is the raw capacity available.
+	AvailableBytes int64 `json:"bytesAvailable,omitempty"`
+	// lastUpdated is the time the capacity was collected.
+	LastUpdated string `json:"lastUpdated,omitempty"`
+}
+
+// CephDaemonsVersions counts the running ceph daemons by version.
+type CephDaemonsVersions struct {
+	// mon counts the running monitors by version.
+	Mon map[string]int `json:"mon,omitempty"`
+	// mgr counts the running managers by version.
+	Mgr map[string]int `json:"mgr,omitempty"`
+	// osd counts the running OSDs by version.
+	Osd map[string]int `json:"osd,omitempty"`
+	// overall counts all running daemons by version.
+	Overall map[string]int `json:"overall,omitempty"`
+}
+
+// CephStatus is the status of the ceph cluster as reported by the rook operator.
+type CephStatus struct {
+	// health is the overall ceph health, HEALTH_OK, HEALTH_WARN or HEALTH_ERR.
+	Health string `json:"health,omitempty"`
+	// details are the ceph health checks which are not ok, keyed by check name.
+	Details map[string]CephHealthMessage `json:"details,omitempty"`
+	// lastChecked is the time the health was collected.
+	LastChecked string `json:"lastChecked,omitempty"`
+	// capacity is the raw capacity of the ceph cluster.
+	Capacity Capacity `json:"capacity,omitempty"`
+	// versions counts the running ceph daemons by version.
+	Versions *CephDaemonsVersions `json:"versions,omitempty"`
+	// fsid is the ceph cluster id.
+	FSID string `json:"fsid,omitempty"`
+}
+
+// CephClusterStatus defines the observed state of CephCluster.
+type CephClusterStatus struct {
+	// phase of the CephCluster, for example Progressing or Ready.
+	Phase string `json:"phase,omitempty"`
+	// state of the CephCluster, for example Created or Error.
+	State string `json:"state,omitempty"`
+	// message describing the phase.
+	Message string `json:"message,omitempty"`
+	// ceph is the status of the ceph cluster.
+	CephStatus *CephStatus `json:"ceph,omitempty"`
+	// conditions describe the state of the CephCluster.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// CephCluster is a ceph storage cluster managed by the rook operator. The spec is not defined since it is only
+// inspected.
+type CephCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status CephClusterStatus `json:"status,omitempty"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephCluster.
+func (in *CephCluster) DeepCopy() *CephCluster {
+	if in == nil {
+		return nil
+	}
+
+	out := new(CephCluster)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Status.Phase = in.Status.Phase
+	out.Status.State = in.Status.State
+	out.Status.Message = in.Status.Message
+
+	if in.Status.CephStatus != nil {
+		out.Status.CephStatus = &CephStatus{
+			Health:      in.Status.CephStatus.Health,
+			LastChecked: in.Status.CephStatus.LastChecked,
+			Capacity:    in.Status.CephStatus.Capacity,
+			FSID:        in.Status.CephStatus.FSID,
+		}
+
+		if in.Status.CephStatus.Details != nil {
+			out.Status.CephStatus.Details = make(map[string]CephHealthMessage, len(in.Status.CephStatus.Details))
+
+			for key, value := range in.Status.CephStatus.Details {
+				out.Status.CephStatus.Details[key] = value
+			}
+		}
+
+		if in.Status.CephStatus.Versions != nil {
+			out.Status.CephStatus.Versions = &CephDaemonsVersions{
+				Mon:     copyCounts(in.Status.CephStatus.Versions.Mon),
+				Mgr:     copyCounts(in.Status.CephStatus.Versions.Mgr),
+				Osd:     copyCounts(in.Status.CephStatus.Versions.Osd),
+				Overall: copyCounts(in.Status.CephStatus.Versions.Overall),
+			}
+		}
+	}
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephCluster) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+func copyCounts(in map[string]int) map[string]int {
+	if in == nil {
+		return nil
+	}
+
+	out := make(map[string]int, len(in))
+
+	for key, value := range in {
+		out[key] = value
+	}
+
+	return out
+}
@@ -0,0 +1,83 @@
+package odftypes
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// StorageDeviceSet defines a set of OSDs backed by PVCs of the same storage class.
+type StorageDeviceSet struct {
+	// name of the device set.
+	Name string `json:"name"`
+	// count is the number of OSD groups in the device set.
+	Count int `json:"count"`
+	// replica is the number of OSDs in every group.
+	Replica int `json:"replica,omitempty"`
+	// deviceClass is the ceph device class of the OSDs.
+	DeviceClass string `json:"deviceClass,omitempty"`
+}
+
+// StorageClusterSpec defines the desired state of StorageCluster. Only the fields used to inspect the cluster are
+// defined.
+type StorageClusterSpec struct {
+	// storageDeviceSets are the device sets backing the ceph OSDs.
+	StorageDeviceSets []StorageDeviceSet `json:"storageDeviceSets,omitempty"`
+}
+
+// StorageClusterStatus defines the observed state of StorageCluster.
+type StorageClusterStatus struct {
+	// phase describes the phase of the StorageCluster, for example Progressing or Ready.
+	Phase string `json:"phase,omitempty"`
+	// conditions describe the state of the StorageCluster.
+	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+	// failureDomain is the failure domain used for placing the OSDs.
+	FailureDomain string `json:"failureDomain,omitempty"`
+}
+
+// StorageCluster represents a cluster including ceph and the noobaa object storage.
+type StorageCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StorageClusterSpec   `json:"spec,omitempty"`
+	Status StorageClusterStatus `json:"status,omitempty"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageCluster.
+func (in *StorageCluster) DeepCopy() *StorageCluster {
+	if in == nil {
+		return nil
+	}
+
+	out := new(StorageCluster)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.StorageDeviceSets != nil {
+		out.Spec.StorageDeviceSets = make([]StorageDeviceSet, len(in.Spec.StorageDeviceSets))
+		copy(out.Spec.StorageDeviceSets, in.Spec.StorageDeviceSets)
+	}
+
+	out.Status.Phase = in.Status.Phase
+	out.Status.FailureDomain = in.Status.FailureDomain
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]conditionsv1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageCluster) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package odf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/odf/odftypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// StorageClusterBuilder provides struct for the StorageCluster object, used to inspect the state of an ODF
+// deployment.
+type StorageClusterBuilder struct {
+	// StorageCluster definition, only used to identify the object.
+	Definition *odftypes.StorageCluster
+	// Pulled StorageCluster object.
+	Object    *odftypes.StorageCluster
+	apiClient *clients.Settings
+}
+
+// PullStorageCluster pulls existing StorageCluster from cluster.
+func PullStorageCluster(apiClient *clients.Settings, name, nsname string) (*StorageClusterBuilder, error) {
+	logging.V(100).Infof("Pulling existing StorageCluster name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("StorageCluster 'apiClient' cannot be empty")
+	}
+
+	builder := StorageClusterBuilder{
+		apiClient: apiClient,
+		Definition: &odftypes.StorageCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the StorageCluster is empty")
+
+		return nil, fmt.Errorf("StorageCluster 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the StorageCluster is empty")
+
+		return nil, fmt.Errorf("StorageCluster 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("StorageCluster object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns StorageCluster object if found.
+func (builder *StorageClusterBuilder) Get() (*odftypes.StorageCluster, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting StorageCluster object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetStorageClusterGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("StorageCluster object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	storageCluster := &odftypes.StorageCluster{}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, storageCluster)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to StorageCluster object")
+
+		return nil, err
+	}
+
+	return storageCluster, nil
+}
+
+// Exists checks whether the given StorageCluster exists.
+func (builder *StorageClusterBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if StorageCluster %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// IsReady returns true when the StorageCluster reached the Ready phase.
+func (builder *StorageClusterBuilder) IsReady() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if StorageCluster %s in namespace %s is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get StorageCluster %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return builder.Object.Status.Phase == StorageClusterPhaseReady, nil
+}
+
+// WaitUntilReady waits for the duration of the defined timeout or until the StorageCluster is Ready.
+func (builder *StorageClusterBuilder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until StorageCluster %s in namespace %s is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			ready, err := builder.IsReady()
+			if err != nil {
+				logging.V(100).Infof("Failed to check StorageCluster %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return ready, nil
+		})
+
+	if err != nil && builder.Object != nil {
+		return fmt.Errorf("StorageCluster %s in namespace %s is not ready, last phase %q: %w",
+			builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.Phase, err)
+	}
+
+	return err
+}
+
+// GetExpectedOSDCount returns the number of OSDs requested by the storage device sets of the StorageCluster. A
+// device set without replica contributes one OSD per count.
+func (builder *StorageClusterBuilder) GetExpectedOSDCount() (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.V(100).Infof("Getting expected OSD count of StorageCluster %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("StorageCluster object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	osdCount := 0
+
+	for _, deviceSet := range builder.Object.Spec.StorageDeviceSets {
+		replica := deviceSet.Replica
+		if replica == 0 {
+			replica = 1
+		}
+
+		osdCount += deviceSet.Count * replica
+	}
+
+	return osdCount, nil
+}
+
+// PullCephCluster pulls the CephCluster the ocs operator created for the StorageCluster.
+func (builder *StorageClusterBuilder) PullCephCluster() (*CephClusterBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Pulling CephCluster of StorageCluster %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return PullCephCluster(
+		builder.apiClient, builder.Definition.Name+cephClusterNameSuffix, builder.Definition.Namespace)
+}
+
+// GetStorageClusterGVR returns StorageCluster's GroupVersionResource.
+func GetStorageClusterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: StorageClusterAPIGroup, Version: StorageClusterAPIVersion, Resource: "storageclusters",
+	}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *StorageClusterBuilder) validate() (bool, error) {
+	resourceCRD := "StorageCluster"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	var err error
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,154 @@
+package odf
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/odf/odftypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	storageClusterGVK = schema.GroupVersionKind{
+		Group:   StorageClusterAPIGroup,
+		Version: StorageClusterAPIVersion,
+		Kind:    StorageClusterKind,
+	}
+	defaultStorageClusterName = "ocs-storagecluster"
+)
+
+func TestPullStorageCluster(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultStorageClusterName,
+			namespace:           ODFNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			namespace:           ODFNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("StorageCluster 'name' cannot be empty"),
+		},
+		{
+			name:                defaultStorageClusterName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("StorageCluster 'namespace' cannot be empty"),
+		},
+		{
+			name:                defaultStorageClusterName,
+			namespace:           ODFNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"StorageCluster object %s doesn't exist in namespace %s", defaultStorageClusterName, ODFNamespace),
+		},
+		{
+			name:                defaultStorageClusterName,
+			namespace:           ODFNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("StorageCluster 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyStorageCluster(StorageClusterPhaseReady))
+		}
+
+		if testCase.client {
+			testSettings = buildStorageClusterTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullStorageCluster(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestStorageClusterWaitUntilReady(t *testing.T) {
+	testCases := []struct {
+		phase         string
+		expectedError string
+	}{
+		{
+			phase:         StorageClusterPhaseReady,
+			expectedError: "",
+		},
+		{
+			phase: "Progressing",
+			expectedError: fmt.Sprintf("StorageCluster %s in namespace %s is not ready, last phase \"Progressing\": "+
+				"context deadline exceeded", defaultStorageClusterName, ODFNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := PullStorageCluster(buildStorageClusterTestClientWithDummyObject(
+			[]runtime.Object{buildDummyStorageCluster(testCase.phase)}), defaultStorageClusterName, ODFNamespace)
+		assert.Nil(t, err)
+
+		err = testBuilder.WaitUntilReady(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestStorageClusterGetExpectedOSDCount(t *testing.T) {
+	testBuilder, err := PullStorageCluster(buildStorageClusterTestClientWithDummyObject(
+		[]runtime.Object{buildDummyStorageCluster(StorageClusterPhaseReady)}), defaultStorageClusterName, ODFNamespace)
+	assert.Nil(t, err)
+
+	osdCount, err := testBuilder.GetExpectedOSDCount()
+	assert.Nil(t, err)
+	assert.Equal(t, 7, osdCount)
+}
+
+func buildDummyStorageCluster(phase string) *odftypes.StorageCluster {
+	return &odftypes.StorageCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultStorageClusterName,
+			Namespace: ODFNamespace,
+		},
+		Spec: odftypes.StorageClusterSpec{
+			StorageDeviceSets: []odftypes.StorageDeviceSet{
+				{Name: "ocs-deviceset", Count: 2, Replica: 3},
+				{Name: "ocs-deviceset-extra", Count: 1},
+			},
+		},
+		Status: odftypes.StorageClusterStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func buildStorageClusterTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{storageClusterGVK},
+	})
+}
@@ -2,14 +2,22 @@ package olm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// catalogSourceReadyState is the gRPC connection state reported by a catalogsource serving its registry.
+	catalogSourceReadyState = "READY"
 )
 
 // CatalogSourceBuilder provides a struct for catalogsource object
@@ -23,12 +31,12 @@ type CatalogSourceBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before CatalogSourceBuilder object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewCatalogSourceBuilder creates new instance of CatalogSourceBuilder.
 func NewCatalogSourceBuilder(apiClient *clients.Settings, name, nsname string) *CatalogSourceBuilder {
-	glog.V(100).Infof("Initializing new %s catalogsource structure", name)
+	logging.V(100).Infof("Initializing new %s catalogsource structure", name)
 
 	builder := CatalogSourceBuilder{
 		apiClient: apiClient,
@@ -41,24 +49,136 @@ func NewCatalogSourceBuilder(apiClient *clients.Settings, name, nsname string) *
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the catalogsource is empty")
+		logging.V(100).Infof("The name of the catalogsource is empty")
 
-		builder.errorMsg = "catalogsource 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the catalogsource is empty")
+		logging.V(100).Infof("The nsname of the catalogsource is empty")
 
-		builder.errorMsg = "catalogsource 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'nsname' cannot be empty"))
 	}
 
 	return &builder
 }
 
+// WithGRPCImage sets the catalogsource to serve the given operator-registry index image over gRPC. The image may
+// point to a mirror registry in disconnected environments.
+func (builder *CatalogSourceBuilder) WithGRPCImage(image string) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting catalogsource %s in namespace %s grpc image %s",
+		builder.Definition.Name, builder.Definition.Namespace, image)
+
+	if image == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'image' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SourceType = oplmV1alpha1.SourceTypeGrpc
+	builder.Definition.Spec.Image = image
+
+	return builder
+}
+
+// WithPullSecrets sets the secrets used to pull the index image and the bundle images of the catalogsource.
+func (builder *CatalogSourceBuilder) WithPullSecrets(secrets ...string) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting catalogsource %s in namespace %s pull secrets %v",
+		builder.Definition.Name, builder.Definition.Namespace, secrets)
+
+	if len(secrets) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'secrets' cannot be empty"))
+
+		return builder
+	}
+
+	for _, secret := range secrets {
+		if secret == "" {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource secret name cannot be empty"))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Secrets = secrets
+
+	return builder
+}
+
+// WithRegistryPollInterval sets the interval at which the catalog operator polls the registry for a newer index
+// image.
+func (builder *CatalogSourceBuilder) WithRegistryPollInterval(interval time.Duration) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting catalogsource %s in namespace %s registry poll interval %s",
+		builder.Definition.Name, builder.Definition.Namespace, interval)
+
+	if interval <= 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'interval' must be positive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.UpdateStrategy = &oplmV1alpha1.UpdateStrategy{
+		RegistryPoll: &oplmV1alpha1.RegistryPoll{
+			RawInterval: interval.String(),
+			Interval:    &metav1.Duration{Duration: interval},
+		},
+	}
+
+	return builder
+}
+
+// WithPriority sets the priority of the catalogsource, used by the resolver when several catalogs provide the
+// same package. Higher values have higher priority.
+func (builder *CatalogSourceBuilder) WithPriority(priority int) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting catalogsource %s in namespace %s priority %d",
+		builder.Definition.Name, builder.Definition.Namespace, priority)
+
+	builder.Definition.Spec.Priority = priority
+
+	return builder
+}
+
+// WithDisplayName sets the display name and the publisher of the catalogsource.
+func (builder *CatalogSourceBuilder) WithDisplayName(displayName, publisher string) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting catalogsource %s in namespace %s displayName %s and publisher %s",
+		builder.Definition.Name, builder.Definition.Namespace, displayName, publisher)
+
+	if displayName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'displayName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.DisplayName = displayName
+	builder.Definition.Spec.Publisher = publisher
+
+	return builder
+}
+
 // PullCatalogSource loads an existing catalogsource into Builder struct.
 func PullCatalogSource(apiClient *clients.Settings, name, nsname string) (*CatalogSourceBuilder,
 	error) {
-	glog.V(100).Infof("Pulling existing catalogsource name %s in namespace %s", name, nsname)
+	logging.V(100).Infof("Pulling existing catalogsource name %s in namespace %s", name, nsname)
 
 	builder := CatalogSourceBuilder{
 		apiClient: apiClient,
@@ -71,11 +191,11 @@ func PullCatalogSource(apiClient *clients.Settings, name, nsname string) (*Catal
 	}
 
 	if name == "" {
-		builder.errorMsg = "catalogsource 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "catalogsource 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("catalogsource 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -93,7 +213,7 @@ func (builder *CatalogSourceBuilder) Create() (*CatalogSourceBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the catalogsource %s in namespace %s",
+	logging.V(100).Infof("Creating the catalogsource %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -111,7 +231,7 @@ func (builder *CatalogSourceBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if catalogSource %s exists",
 		builder.Definition.Name)
 
@@ -129,7 +249,7 @@ func (builder *CatalogSourceBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting catalogsource %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting catalogsource %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -148,33 +268,150 @@ func (builder *CatalogSourceBuilder) Delete() error {
 	return err
 }
 
+// Update modifies the existing catalogsource with the catalogsource definition in CatalogSourceBuilder.
+func (builder *CatalogSourceBuilder) Update() (*CatalogSourceBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating catalogsource %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return builder, fmt.Errorf("catalogsource named %s in namespace %s doesn't exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.CatalogSources(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// IsReady checks if the registry of the catalogsource is serving, i.e. its gRPC connection state is READY.
+func (builder *CatalogSourceBuilder) IsReady() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if catalogsource %s in namespace %s is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return false
+	}
+
+	connectionState := builder.Object.Status.GRPCConnectionState
+
+	return connectionState != nil && connectionState.LastObservedState == catalogSourceReadyState
+}
+
+// WaitUntilReady waits for the duration of the defined timeout or until the catalogsource registry pod is serving
+// and the gRPC connection state is READY.
+func (builder *CatalogSourceBuilder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for catalogsource %s in namespace %s to be ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastState string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if builder.IsReady() {
+				return true, nil
+			}
+
+			if builder.Object != nil && builder.Object.Status.GRPCConnectionState != nil {
+				lastState = builder.Object.Status.GRPCConnectionState.LastObservedState
+			}
+
+			return false, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("catalogsource %s in namespace %s is not ready, last connection state %q: %w",
+			builder.Definition.Name, builder.Definition.Namespace, lastState, err)
+	}
+
+	return nil
+}
+
+// GetPackageChannels returns the channels of every package served by the catalogsource, keyed by package name,
+// as exposed by the packagemanifest API.
+func (builder *CatalogSourceBuilder) GetPackageChannels() (map[string][]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Listing packages and channels of catalogsource %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	packageManifests, err := ListPackageManifest(builder.apiClient, builder.Definition.Namespace, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("catalog=%s", builder.Definition.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	packageChannels := make(map[string][]string)
+
+	for _, packageManifest := range packageManifests {
+		if packageManifest.Object.Status.CatalogSourceNamespace != builder.Definition.Namespace {
+			continue
+		}
+
+		channels := []string{}
+
+		for _, channel := range packageManifest.Object.Status.Channels {
+			channels = append(channels, channel.Name)
+		}
+
+		packageChannels[packageManifest.Object.Name] = channels
+	}
+
+	if len(packageChannels) == 0 {
+		return nil, fmt.Errorf("no packages found for catalogsource %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return packageChannels, nil
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *CatalogSourceBuilder) validate() (bool, error) {
 	resourceCRD := "catalogsource"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
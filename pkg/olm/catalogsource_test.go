@@ -0,0 +1,323 @@
+package olm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	pkgManifestV1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultCatalogSourceName      = "redhat-operators-disconnected"
+	defaultCatalogSourceNamespace = "openshift-marketplace"
+	defaultCatalogSourceImage     = "registry.example.com:5000/olm/redhat-operator-index:v4.16"
+)
+
+func TestCatalogSourceWithGRPCImage(t *testing.T) {
+	testCases := []struct {
+		image         string
+		expectedError string
+	}{
+		{
+			image:         defaultCatalogSourceImage,
+			expectedError: "",
+		},
+		{
+			image:         "",
+			expectedError: "catalogsource 'image' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidCatalogSourceBuilder(buildTestClientWithOlmObjects(t)).WithGRPCImage(testCase.image)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, oplmV1alpha1.SourceTypeGrpc, testBuilder.Definition.Spec.SourceType)
+			assert.Equal(t, testCase.image, testBuilder.Definition.Spec.Image)
+		}
+	}
+}
+
+func TestCatalogSourceWithPullSecrets(t *testing.T) {
+	testCases := []struct {
+		secrets       []string
+		expectedError string
+	}{
+		{
+			secrets:       []string{"mirror-pull-secret", "quay-pull-secret"},
+			expectedError: "",
+		},
+		{
+			secrets:       nil,
+			expectedError: "catalogsource 'secrets' cannot be empty",
+		},
+		{
+			secrets:       []string{"mirror-pull-secret", ""},
+			expectedError: "catalogsource secret name cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidCatalogSourceBuilder(buildTestClientWithOlmObjects(t)).
+			WithPullSecrets(testCase.secrets...)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.secrets, testBuilder.Definition.Spec.Secrets)
+		}
+	}
+}
+
+func TestCatalogSourceWithRegistryPollInterval(t *testing.T) {
+	testCases := []struct {
+		interval      time.Duration
+		expectedError string
+	}{
+		{
+			interval:      10 * time.Minute,
+			expectedError: "",
+		},
+		{
+			interval:      0,
+			expectedError: "catalogsource 'interval' must be positive",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidCatalogSourceBuilder(buildTestClientWithOlmObjects(t)).
+			WithRegistryPollInterval(testCase.interval)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			registryPoll := testBuilder.Definition.Spec.UpdateStrategy.RegistryPoll
+			assert.Equal(t, testCase.interval.String(), registryPoll.RawInterval)
+			assert.Equal(t, testCase.interval, registryPoll.Interval.Duration)
+		}
+	}
+}
+
+func TestCatalogSourceWithPriorityAndDisplayName(t *testing.T) {
+	testCases := []struct {
+		priority      int
+		displayName   string
+		publisher     string
+		expectedError string
+	}{
+		{
+			priority:      -100,
+			displayName:   "Red Hat Operators (disconnected)",
+			publisher:     "Red Hat",
+			expectedError: "",
+		},
+		{
+			priority:      10,
+			displayName:   "Red Hat Operators (disconnected)",
+			publisher:     "",
+			expectedError: "",
+		},
+		{
+			priority:      10,
+			displayName:   "",
+			publisher:     "Red Hat",
+			expectedError: "catalogsource 'displayName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidCatalogSourceBuilder(buildTestClientWithOlmObjects(t)).
+			WithPriority(testCase.priority).WithDisplayName(testCase.displayName, testCase.publisher)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.priority, testBuilder.Definition.Spec.Priority)
+			assert.Equal(t, testCase.displayName, testBuilder.Definition.Spec.DisplayName)
+			assert.Equal(t, testCase.publisher, testBuilder.Definition.Spec.Publisher)
+		}
+	}
+}
+
+func TestCatalogSourceUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("catalogsource named %s in namespace %s doesn't exist",
+				defaultCatalogSourceName, defaultCatalogSourceNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyCatalogSource(""))
+		}
+
+		testSettings := buildTestClientWithOlmObjects(t, runtimeObjects...)
+
+		_, err := buildValidCatalogSourceBuilder(testSettings).WithGRPCImage(defaultCatalogSourceImage).
+			WithPriority(10).Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			pulledBuilder, err := PullCatalogSource(testSettings, defaultCatalogSourceName, defaultCatalogSourceNamespace)
+			assert.Nil(t, err)
+			assert.Equal(t, defaultCatalogSourceImage, pulledBuilder.Object.Spec.Image)
+			assert.Equal(t, 10, pulledBuilder.Object.Spec.Priority)
+		}
+	}
+}
+
+func TestCatalogSourceWaitUntilReady(t *testing.T) {
+	testCases := []struct {
+		connectionState     string
+		addToRuntimeObjects bool
+		expectedReady       bool
+		expectedError       string
+	}{
+		{
+			connectionState:     catalogSourceReadyState,
+			addToRuntimeObjects: true,
+			expectedReady:       true,
+			expectedError:       "",
+		},
+		{
+			connectionState:     "TRANSIENT_FAILURE",
+			addToRuntimeObjects: true,
+			expectedReady:       false,
+			expectedError: fmt.Sprintf("catalogsource %s in namespace %s is not ready, last connection state "+
+				"\"TRANSIENT_FAILURE\": context deadline exceeded", defaultCatalogSourceName, defaultCatalogSourceNamespace),
+		},
+		{
+			connectionState:     "",
+			addToRuntimeObjects: true,
+			expectedReady:       false,
+			expectedError: fmt.Sprintf("catalogsource %s in namespace %s is not ready, last connection state "+
+				"\"\": context deadline exceeded", defaultCatalogSourceName, defaultCatalogSourceNamespace),
+		},
+		{
+			connectionState:     catalogSourceReadyState,
+			addToRuntimeObjects: false,
+			expectedReady:       false,
+			expectedError: fmt.Sprintf("catalogsource %s in namespace %s is not ready, last connection state "+
+				"\"\": context deadline exceeded", defaultCatalogSourceName, defaultCatalogSourceNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyCatalogSource(testCase.connectionState))
+		}
+
+		testBuilder := buildValidCatalogSourceBuilder(buildTestClientWithOlmObjects(t, runtimeObjects...))
+		assert.Equal(t, testCase.expectedReady, testBuilder.IsReady())
+
+		err := testBuilder.WaitUntilReady(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestCatalogSourceGetPackageChannels(t *testing.T) {
+	testCases := []struct {
+		packageManifests []runtime.Object
+		expectedChannels map[string][]string
+		expectedError    string
+	}{
+		{
+			packageManifests: []runtime.Object{
+				buildDummyPackageManifest("sriov-network-operator", defaultCatalogSourceName,
+					defaultCatalogSourceNamespace, "stable"),
+				buildDummyPackageManifest("ptp-operator", defaultCatalogSourceName,
+					defaultCatalogSourceNamespace, "stable", "4.16"),
+				buildDummyPackageManifest("local-storage-operator", "redhat-operators",
+					defaultCatalogSourceNamespace, "stable"),
+			},
+			expectedChannels: map[string][]string{
+				"sriov-network-operator": {"stable"},
+				"ptp-operator":           {"stable", "4.16"},
+			},
+			expectedError: "",
+		},
+		{
+			packageManifests: []runtime.Object{
+				buildDummyPackageManifest("local-storage-operator", "redhat-operators",
+					defaultCatalogSourceNamespace, "stable"),
+			},
+			expectedError: fmt.Sprintf("no packages found for catalogsource %s in namespace %s",
+				defaultCatalogSourceName, defaultCatalogSourceNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithOlmObjects(t)
+		testSettings.PackageManifestInterface = testhelper.NewPackageManifestV1Client(t, testCase.packageManifests...)
+
+		packageChannels, err := buildValidCatalogSourceBuilder(testSettings).GetPackageChannels()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedChannels, packageChannels)
+		}
+	}
+}
+
+func buildValidCatalogSourceBuilder(apiClient *clients.Settings) *CatalogSourceBuilder {
+	return NewCatalogSourceBuilder(apiClient, defaultCatalogSourceName, defaultCatalogSourceNamespace)
+}
+
+// buildDummyCatalogSource returns a catalogsource whose gRPC connection reports the given state. No connection state
+// is set when the state is empty.
+func buildDummyCatalogSource(connectionState string) *oplmV1alpha1.CatalogSource {
+	catalogSource := &oplmV1alpha1.CatalogSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultCatalogSourceName,
+			Namespace: defaultCatalogSourceNamespace,
+		},
+		Spec: oplmV1alpha1.CatalogSourceSpec{
+			SourceType: oplmV1alpha1.SourceTypeGrpc,
+			Image:      "registry.redhat.io/redhat/redhat-operator-index:v4.16",
+		},
+	}
+
+	if connectionState != "" {
+		catalogSource.Status.GRPCConnectionState = &oplmV1alpha1.GRPCConnectionState{
+			LastObservedState: connectionState,
+		}
+	}
+
+	return catalogSource
+}
+
+func buildDummyPackageManifest(
+	name, catalogSource, catalogSourceNamespace string, channels ...string) *pkgManifestV1.PackageManifest {
+	packageManifest := &pkgManifestV1.PackageManifest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultCatalogSourceNamespace,
+			Labels:    map[string]string{"catalog": catalogSource},
+		},
+		Status: pkgManifestV1.PackageManifestStatus{
+			CatalogSource:          catalogSource,
+			CatalogSourceNamespace: catalogSourceNamespace,
+		},
+	}
+
+	for _, channel := range channels {
+		packageManifest.Status.Channels = append(packageManifest.Status.Channels,
+			pkgManifestV1.PackageChannel{Name: channel})
+	}
+
+	return packageManifest
+}
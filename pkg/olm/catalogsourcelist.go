@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,7 +15,7 @@ func ListCatalogSources(
 	nsname string,
 	options ...metav1.ListOptions) ([]*CatalogSourceBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("catalogsource 'namespace' parameter can not be empty")
+		logging.V(100).Infof("catalogsource 'namespace' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list catalogsource, 'namespace' parameter is empty")
 	}
@@ -24,7 +24,7 @@ func ListCatalogSources(
 	logMessage := fmt.Sprintf("Listing catalogsource in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -34,13 +34,13 @@ func ListCatalogSources(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	catalogSourceList, err := apiClient.OperatorsV1alpha1Interface.CatalogSources(nsname).List(
 		context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list catalogsources in the namespace %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list catalogsources in the namespace %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
@@ -2,12 +2,13 @@ package olm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,13 +25,13 @@ type ClusterServiceVersionBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before ClusterServiceVersionBuilder object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // PullClusterServiceVersion loads an existing clusterserviceversion into Builder struct.
 func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace string) (*ClusterServiceVersionBuilder,
 	error) {
-	glog.V(100).Infof("Pulling existing clusterserviceversion name %s in namespace %s", name, namespace)
+	logging.V(100).Infof("Pulling existing clusterserviceversion name %s in namespace %s", name, namespace)
 
 	builder := ClusterServiceVersionBuilder{
 		apiClient: apiClient,
@@ -43,11 +44,11 @@ func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace stri
 	}
 
 	if name == "" {
-		builder.errorMsg = "clusterserviceversion 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterserviceversion 'name' cannot be empty"))
 	}
 
 	if namespace == "" {
-		builder.errorMsg = "clusterserviceversion 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterserviceversion 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -65,7 +66,7 @@ func (builder *ClusterServiceVersionBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if clusterserviceversion %s exists",
 		builder.Definition.Name)
 
@@ -83,7 +84,7 @@ func (builder *ClusterServiceVersionBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting clusterserviceversion %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting clusterserviceversion %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -108,7 +109,7 @@ func (builder *ClusterServiceVersionBuilder) GetAlmExamples() (string, error) {
 		return "", err
 	}
 
-	glog.V(100).Infof("Extracting the 'alm-examples' section from clusterserviceversion %s in "+
+	logging.V(100).Infof("Extracting the 'alm-examples' section from clusterserviceversion %s in "+
 		"namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	almExamples := "alm-examples"
@@ -131,7 +132,7 @@ func (builder *ClusterServiceVersionBuilder) IsSuccessful() (bool, error) {
 		return false, err
 	}
 
-	glog.V(100).Infof("Verify clusterserviceversion %s in namespace %s is Successful",
+	logging.V(100).Infof("Verify clusterserviceversion %s in namespace %s is Successful",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	phase, err := builder.GetPhase()
@@ -150,7 +151,7 @@ func (builder *ClusterServiceVersionBuilder) GetPhase() (oplmV1alpha1.ClusterSer
 		return "", err
 	}
 
-	glog.V(100).Infof("Get clusterserviceversion %s phase in namespace %s",
+	logging.V(100).Infof("Get clusterserviceversion %s phase in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -167,27 +168,29 @@ func (builder *ClusterServiceVersionBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterServiceVersion"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -16,7 +16,7 @@ func ListClusterServiceVersion(
 	nsname string,
 	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("clusterserviceversion 'nsname' parameter can not be empty")
+		logging.V(100).Infof("clusterserviceversion 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list clusterserviceversion, 'nsname' parameter is empty")
 	}
@@ -25,7 +25,7 @@ func ListClusterServiceVersion(
 	logMessage := fmt.Sprintf("Listing clusterserviceversion in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -35,13 +35,13 @@ func ListClusterServiceVersion(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	csvList, err := apiClient.OperatorsV1alpha1Interface.ClusterServiceVersions(nsname).List(
 		context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list clusterserviceversion in the nsname %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list clusterserviceversion in the nsname %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
@@ -70,20 +70,20 @@ func ListClusterServiceVersionWithNamePattern(
 	nsname string,
 	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
 	if namePattern == "" {
-		glog.V(100).Info(
+		logging.V(100).Info(
 			"The namePattern field to filter out all relevant clusterserviceversion cannot be empty")
 
 		return nil, fmt.Errorf(
 			"the namePattern field to filter out all relevant clusterserviceversion cannot be empty")
 	}
 
-	glog.V(100).Infof("Listing clusterserviceversion filtered by the name pattern %s in %s namespace",
+	logging.V(100).Infof("Listing clusterserviceversion filtered by the name pattern %s in %s namespace",
 		namePattern, nsname)
 
 	notFilteredCsvList, err := ListClusterServiceVersion(apiClient, nsname, options...)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all clusterserviceversions in namespace %s due to %s",
+		logging.V(100).Infof("Failed to list all clusterserviceversions in namespace %s due to %s",
 			nsname, err.Error())
 
 		return nil, err
@@ -108,7 +108,7 @@ func ListClusterServiceVersionInAllNamespaces(
 	logMessage := "Listing CSVs in all namespaces"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -118,12 +118,12 @@ func ListClusterServiceVersionInAllNamespaces(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	csvList, err := apiClient.ClusterServiceVersions("").List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list CSVs in all namespaces due to %s", err.Error())
+		logging.V(100).Infof("Failed to list CSVs in all namespaces due to %s", err.Error())
 
 		return nil, err
 	}
@@ -2,13 +2,14 @@ package olm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -21,14 +22,14 @@ type InstallPlanBuilder struct {
 	Object *v1alpha1.InstallPlan
 	// Used in functions that define or mutate installplan definition. errorMsg is processed
 	// before the installplan object is created
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 }
 
 // NewInstallPlanBuilder creates new instance of InstallPlanBuilder.
 func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *InstallPlanBuilder {
-	glog.V(100).Infof("Initializing new %s installplan structure", name)
+	logging.V(100).Infof("Initializing new %s installplan structure", name)
 
 	builder := InstallPlanBuilder{
 		apiClient: apiClient,
@@ -41,15 +42,15 @@ func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *In
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the installplan is empty")
+		logging.V(100).Infof("The name of the installplan is empty")
 
-		builder.errorMsg = "installplan 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("installplan 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the installplan is empty")
+		logging.V(100).Infof("The nsname of the installplan is empty")
 
-		builder.errorMsg = "installplan 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("installplan 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -61,7 +62,7 @@ func (builder *InstallPlanBuilder) Create() (*InstallPlanBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the InstallPlan %s in namespace %s",
+	logging.V(100).Infof("Creating the InstallPlan %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -79,7 +80,7 @@ func (builder *InstallPlanBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if installplan %s exists in namespace %s",
+	logging.V(100).Infof("Checking if installplan %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -95,7 +96,7 @@ func (builder *InstallPlanBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting installplan %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting installplan %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -120,7 +121,7 @@ func (builder *InstallPlanBuilder) Update() (*InstallPlanBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating installPlan %s in namespace %s",
+	logging.V(100).Infof("Updating installPlan %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -136,27 +137,29 @@ func (builder *InstallPlanBuilder) validate() (bool, error) {
 	resourceCRD := "installplan"
 
 	if builder == nil {
-		glog.V(100).Infof("The builder %s is uninitialized", resourceCRD)
+		logging.V(100).Infof("The builder %s is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The builder %s apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The builder %s apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The builder %s has error message: %w", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The builder %s has error message: %v", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
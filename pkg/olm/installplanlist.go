@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -13,7 +13,7 @@ import (
 func ListInstallPlan(
 	apiClient *clients.Settings, nsname string, options ...v1.ListOptions) ([]*InstallPlanBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Info("The nsname of the installplan is empty")
+		logging.V(100).Info("The nsname of the installplan is empty")
 
 		return nil, fmt.Errorf("the nsname of the installplan is empty")
 	}
@@ -22,7 +22,7 @@ func ListInstallPlan(
 	logMessage := fmt.Sprintf("Listing InstallPlans in namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -32,12 +32,12 @@ func ListInstallPlan(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	installPlanList, err := apiClient.InstallPlans(nsname).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all installplan in namespace %s due to %s",
+		logging.V(100).Infof("Failed to list all installplan in namespace %s due to %s",
 			nsname, err.Error())
 
 		return nil, err
@@ -2,9 +2,10 @@ package olm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
@@ -24,12 +25,12 @@ type OperatorGroupBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before OperatorGroup object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewOperatorGroupBuilder returns an OperatorGroupBuilder struct.
 func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName string) *OperatorGroupBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new OperatorGroupBuilder structure with the following params: %s, %s", groupName, nsName)
 
 	builder := &OperatorGroupBuilder{
@@ -47,15 +48,15 @@ func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName stri
 	}
 
 	if groupName == "" {
-		glog.V(100).Infof("The Name of the OperatorGroup is empty")
+		logging.V(100).Infof("The Name of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'groupName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("OperatorGroup 'groupName' cannot be empty"))
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The Namespace of the OperatorGroup is empty")
+		logging.V(100).Infof("The Namespace of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("OperatorGroup 'Namespace' cannot be empty"))
 	}
 
 	return builder
@@ -67,7 +68,7 @@ func (builder *OperatorGroupBuilder) Create() (*OperatorGroupBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the OperatorGroup %s",
+	logging.V(100).Infof("Creating the OperatorGroup %s",
 		builder.Definition.Name)
 
 	var err error
@@ -85,7 +86,7 @@ func (builder *OperatorGroupBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if OperatorGroup %s exists in namespace %s",
+	logging.V(100).Infof("Checking if OperatorGroup %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -102,7 +103,7 @@ func (builder *OperatorGroupBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting OperatorGroup %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting OperatorGroup %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -127,7 +128,7 @@ func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating OperatorGroup %s in namespace %s",
+	logging.V(100).Infof("Updating OperatorGroup %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -139,7 +140,7 @@ func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
 
 // PullOperatorGroup loads existing OperatorGroup from cluster into the OperatorGroupBuilder struct.
 func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*OperatorGroupBuilder, error) {
-	glog.V(100).Infof("Pulling existing OperatorGroup %s from cluster in namespace %s",
+	logging.V(100).Infof("Pulling existing OperatorGroup %s from cluster in namespace %s",
 		groupName, nsName)
 
 	builder := &OperatorGroupBuilder{
@@ -154,15 +155,15 @@ func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*
 	}
 
 	if groupName == "" {
-		glog.V(100).Infof("The name of the OperatorGroup is empty")
+		logging.V(100).Infof("The name of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'Name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("OperatorGroup 'Name' cannot be empty"))
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The namespace of the OperatorGroup is empty")
+		logging.V(100).Infof("The namespace of the OperatorGroup is empty")
 
-		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("OperatorGroup 'Namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -180,27 +181,29 @@ func (builder *OperatorGroupBuilder) validate() (bool, error) {
 	resourceCRD := "OperatorGroup"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
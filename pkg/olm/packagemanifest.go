@@ -2,10 +2,11 @@ package olm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	pkgManifestV1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,12 +24,12 @@ type PackageManifestBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before PackageManifest object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // PullPackageManifest loads an existing PackageManifest into Builder struct.
 func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s", name, nsname)
+	logging.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s", name, nsname)
 
 	builder := &PackageManifestBuilder{
 		apiClient: apiClient,
@@ -41,15 +42,15 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The Name of the PackageManifest is empty")
+		logging.V(100).Infof("The Name of the PackageManifest is empty")
 
-		builder.errorMsg = "PackageManifest 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PackageManifest 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The Namespace of the PackageManifest is empty")
+		logging.V(100).Infof("The Namespace of the PackageManifest is empty")
 
-		builder.errorMsg = "PackageManifest 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PackageManifest 'nsname' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -64,7 +65,7 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 // PullPackageManifestByCatalog loads an existing PackageManifest from specified catalog into Builder struct.
 func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 	catalog string) (*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s and from catalog %s",
+	logging.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s and from catalog %s",
 		name, nsname, catalog)
 
 	packageManifests, err := ListPackageManifest(apiClient, nsname, metav1.ListOptions{
@@ -73,20 +74,20 @@ func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 	})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests with name %s in namespace %s from catalog"+
+		logging.V(100).Infof("Failed to list PackageManifests with name %s in namespace %s from catalog"+
 			" %s due to %s", name, nsname, catalog, err.Error())
 
 		return nil, err
 	}
 
 	if len(packageManifests) == 0 {
-		glog.V(100).Infof("The list of matching PackageManifests is empty")
+		logging.V(100).Infof("The list of matching PackageManifests is empty")
 
 		return nil, fmt.Errorf("no matching PackageManifests were found")
 	}
 
 	if len(packageManifests) > 1 {
-		glog.V(100).Infof("More than one matching PackageManifests were found")
+		logging.V(100).Infof("More than one matching PackageManifests were found")
 
 		return nil, fmt.Errorf("more than one matching PackageManifests were found")
 	}
@@ -100,7 +101,7 @@ func (builder *PackageManifestBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if PackageManifest %s exists", builder.Definition.Name)
 
 	var err error
@@ -116,7 +117,7 @@ func (builder *PackageManifestBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting PackageManifest %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting PackageManifest %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -141,27 +142,29 @@ func (builder *PackageManifestBuilder) validate() (bool, error) {
 	resourceCRD := "PackageManifest"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
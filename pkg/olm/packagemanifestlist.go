@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,7 +15,7 @@ func ListPackageManifest(
 	nsname string,
 	options ...metav1.ListOptions) ([]*PackageManifestBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
+		logging.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list packagemanifests, 'nsname' parameter is empty")
 	}
@@ -24,7 +24,7 @@ func ListPackageManifest(
 	logMessage := fmt.Sprintf("Listing PackageManifests in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -34,13 +34,13 @@ func ListPackageManifest(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	pkgManifestList, err := apiClient.PackageManifestInterface.PackageManifests(nsname).List(context.TODO(),
 		passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
+		logging.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
 			nsname, err.Error())
 
 		return nil, err
@@ -2,14 +2,17 @@ package olm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	operatorsV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // SubscriptionBuilder provides a struct for Subscription object containing connection to the
@@ -22,13 +25,13 @@ type SubscriptionBuilder struct {
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 	// errorMsg is processed before Subscription object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // NewSubscriptionBuilder returns a SubscriptionBuilder.
 func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace, catalogSource, catalogSourceNamespace,
 	packageName string) *SubscriptionBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new SubscriptionBuilder structure with the following params, subName: %s, "+
 			"subNamespace: %s, catalogSource: %s, catalogSourceNamespace: %s, packageName: %s ",
 		subName, subNamespace, catalogSource, catalogSourceNamespace, packageName)
@@ -49,33 +52,33 @@ func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace,
 	}
 
 	if subName == "" {
-		glog.V(100).Infof("The Name of the Subscription is empty")
+		logging.V(100).Infof("The Name of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Subscription 'subName' cannot be empty"))
 	}
 
 	if subNamespace == "" {
-		glog.V(100).Infof("The Namespace of the Subscription is empty")
+		logging.V(100).Infof("The Namespace of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Subscription 'subNamespace' cannot be empty"))
 	}
 
 	if catalogSource == "" {
-		glog.V(100).Infof("The Catalogsource of the Subscription is empty")
+		logging.V(100).Infof("The Catalogsource of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'catalogSource' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Subscription 'catalogSource' cannot be empty"))
 	}
 
 	if catalogSourceNamespace == "" {
-		glog.V(100).Infof("The Catalogsource namespace of the Subscription is empty")
+		logging.V(100).Infof("The Catalogsource namespace of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'catalogSourceNamespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Subscription 'catalogSourceNamespace' cannot be empty"))
 	}
 
 	if packageName == "" {
-		glog.V(100).Infof("The Package name of the Subscription is empty")
+		logging.V(100).Infof("The Package name of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'packageName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Subscription 'packageName' cannot be empty"))
 	}
 
 	return builder
@@ -87,13 +90,13 @@ func (builder *SubscriptionBuilder) WithChannel(channel string) *SubscriptionBui
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with channel: %s", channel)
+	logging.V(100).Infof("Defining Subscription builder object with channel: %s", channel)
 
 	if channel == "" {
-		builder.errorMsg = "can not redefine subscription with empty channel"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not redefine subscription with empty channel"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -108,14 +111,14 @@ func (builder *SubscriptionBuilder) WithStartingCSV(startingCSV string) *Subscri
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with startingCSV: %s",
+	logging.V(100).Infof("Defining Subscription builder object with startingCSV: %s",
 		startingCSV)
 
 	if startingCSV == "" {
-		builder.errorMsg = "can not redefine subscription with empty startingCSV"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not redefine subscription with empty startingCSV"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -131,17 +134,19 @@ func (builder *SubscriptionBuilder) WithInstallPlanApproval(
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with "+
+	logging.V(100).Infof("Defining Subscription builder object with "+
 		"installPlanApproval: %s", installPlanApproval)
 
-	if !(installPlanApproval == "Automatic" || installPlanApproval == "Manual") {
-		glog.V(100).Infof("The InstallPlanApproval of the Subscription must be either \"Automatic\" " +
+	if !(installPlanApproval == operatorsV1alpha1.ApprovalAutomatic ||
+		installPlanApproval == operatorsV1alpha1.ApprovalManual) {
+		logging.V(100).Infof("The InstallPlanApproval of the Subscription must be either \"Automatic\" " +
 			"or \"Manual\"")
 
-		builder.errorMsg = "Subscription 'installPlanApproval' must be either \"Automatic\" or \"Manual\""
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Subscription 'installPlanApproval' must be either \"Automatic\" or \"Manual\""))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -156,7 +161,7 @@ func (builder *SubscriptionBuilder) Create() (*SubscriptionBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the Subscription %s in namespace %s",
+	logging.V(100).Infof("Creating the Subscription %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -174,7 +179,7 @@ func (builder *SubscriptionBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if Subscription %s exists in namespace %s",
+	logging.V(100).Infof("Checking if Subscription %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -191,7 +196,7 @@ func (builder *SubscriptionBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting Subscription %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Deleting Subscription %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -216,7 +221,7 @@ func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating Subscription %s in namespace %s",
+	logging.V(100).Infof("Updating Subscription %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -232,9 +237,118 @@ func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
 	return builder, err
 }
 
+// GetInstalledCSV returns the name of the clusterserviceversion currently installed by the Subscription.
+func (builder *SubscriptionBuilder) GetInstalledCSV() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting the installed clusterserviceversion of Subscription %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return "", fmt.Errorf("subscription named %s in namespace %s doesn't exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status.InstalledCSV == "" {
+		return "", fmt.Errorf("subscription named %s in namespace %s has no installed clusterserviceversion",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.InstalledCSV, nil
+}
+
+// ApprovePendingInstallPlan approves the installplan the Subscription is waiting for when its
+// installPlanApproval is Manual. It is a no-op when the installplan is already approved.
+func (builder *SubscriptionBuilder) ApprovePendingInstallPlan() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Approving the pending installplan of Subscription %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return fmt.Errorf("subscription named %s in namespace %s doesn't exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status.InstallPlanRef == nil {
+		return fmt.Errorf("subscription named %s in namespace %s has no pending installplan",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	installPlan := NewInstallPlanBuilder(builder.apiClient,
+		builder.Object.Status.InstallPlanRef.Name, builder.Object.Status.InstallPlanRef.Namespace)
+
+	if !installPlan.Exists() || installPlan.Object == nil {
+		return fmt.Errorf("installplan %s of subscription %s doesn't exist in namespace %s",
+			installPlan.Definition.Name, builder.Definition.Name, installPlan.Definition.Namespace)
+	}
+
+	if installPlan.Object.Spec.Approved {
+		logging.V(100).Infof("The installplan %s is already approved", installPlan.Definition.Name)
+
+		return nil
+	}
+
+	installPlan.Definition = installPlan.Object
+	installPlan.Definition.Spec.Approved = true
+
+	_, err := installPlan.Update()
+
+	return err
+}
+
+// WaitForCSVSucceeded waits for the duration of the defined timeout or until the clusterserviceversion the
+// Subscription resolved to is installed and in the Succeeded phase.
+func (builder *SubscriptionBuilder) WaitForCSVSucceeded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the clusterserviceversion of Subscription %s in namespace %s to succeed",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() || builder.Object == nil {
+				logging.V(100).Infof("Subscription %s doesn't exist yet", builder.Definition.Name)
+
+				return false, nil
+			}
+
+			status := builder.Object.Status
+
+			if status.InstalledCSV == "" || status.InstalledCSV != status.CurrentCSV {
+				logging.V(100).Infof("Subscription %s installed csv %q does not match current csv %q yet",
+					builder.Definition.Name, status.InstalledCSV, status.CurrentCSV)
+
+				return false, nil
+			}
+
+			csv, err := PullClusterServiceVersion(builder.apiClient, status.InstalledCSV, builder.Definition.Namespace)
+			if err != nil {
+				logging.V(100).Infof("Failed to pull clusterserviceversion %s: %v", status.InstalledCSV, err)
+
+				return false, nil
+			}
+
+			succeeded, err := csv.IsSuccessful()
+			if err != nil {
+				logging.V(100).Infof("Failed to get phase of clusterserviceversion %s: %v", status.InstalledCSV, err)
+
+				return false, nil
+			}
+
+			return succeeded, nil
+		})
+}
+
 // PullSubscription loads existing Subscription from cluster into the SubscriptionBuilder struct.
 func PullSubscription(apiClient *clients.Settings, subName, subNamespace string) (*SubscriptionBuilder, error) {
-	glog.V(100).Infof("Pulling existing Subscription %s from cluster in namespace %s",
+	logging.V(100).Infof("Pulling existing Subscription %s from cluster in namespace %s",
 		subName, subNamespace)
 
 	builder := &SubscriptionBuilder{
@@ -248,15 +362,15 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	}
 
 	if subName == "" {
-		glog.V(100).Infof("The name of the Subscription is empty")
+		logging.V(100).Infof("The name of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Subscription 'subName' cannot be empty"))
 	}
 
 	if subNamespace == "" {
-		glog.V(100).Infof("The namespace of the Subscription is empty")
+		logging.V(100).Infof("The namespace of the Subscription is empty")
 
-		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Subscription 'subNamespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -274,27 +388,29 @@ func (builder *SubscriptionBuilder) validate() (bool, error) {
 	resourceCRD := "Subscription"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,260 @@
+package olm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	operatorsV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultSubscriptionName      = "sriov-network-operator-subscription"
+	defaultSubscriptionNamespace = "openshift-sriov-network-operator"
+	defaultSubscriptionCSV       = "sriov-network-operator.v4.16.0"
+	defaultInstallPlanName       = "install-abcde"
+)
+
+func TestSubscriptionWithInstallPlanApproval(t *testing.T) {
+	testCases := []struct {
+		installPlanApproval operatorsV1alpha1.Approval
+		expectedError       string
+	}{
+		{
+			installPlanApproval: operatorsV1alpha1.ApprovalAutomatic,
+			expectedError:       "",
+		},
+		{
+			installPlanApproval: operatorsV1alpha1.ApprovalManual,
+			expectedError:       "",
+		},
+		{
+			installPlanApproval: "Scheduled",
+			expectedError:       "Subscription 'installPlanApproval' must be either \"Automatic\" or \"Manual\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidSubscriptionBuilder(buildTestClientWithOlmObjects(t)).
+			WithInstallPlanApproval(testCase.installPlanApproval)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.installPlanApproval, testBuilder.Definition.Spec.InstallPlanApproval)
+		}
+	}
+}
+
+func TestSubscriptionGetInstalledCSV(t *testing.T) {
+	testCases := []struct {
+		installedCSV        string
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			installedCSV:        defaultSubscriptionCSV,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			installedCSV:        "",
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("subscription named %s in namespace %s has no installed clusterserviceversion",
+				defaultSubscriptionName, defaultSubscriptionNamespace),
+		},
+		{
+			installedCSV:        defaultSubscriptionCSV,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("subscription named %s in namespace %s doesn't exist",
+				defaultSubscriptionName, defaultSubscriptionNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummySubscription(operatorsV1alpha1.SubscriptionStatus{
+				InstalledCSV: testCase.installedCSV,
+				CurrentCSV:   testCase.installedCSV,
+			}))
+		}
+
+		testBuilder := buildValidSubscriptionBuilder(buildTestClientWithOlmObjects(t, runtimeObjects...))
+
+		installedCSV, err := testBuilder.GetInstalledCSV()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.installedCSV, installedCSV)
+		}
+	}
+}
+
+func TestSubscriptionApprovePendingInstallPlan(t *testing.T) {
+	testCases := []struct {
+		installPlanRef     bool
+		installPlanExists  bool
+		installPlanApprove bool
+		expectedError      string
+	}{
+		{
+			installPlanRef:     true,
+			installPlanExists:  true,
+			installPlanApprove: false,
+			expectedError:      "",
+		},
+		{
+			installPlanRef:     true,
+			installPlanExists:  true,
+			installPlanApprove: true,
+			expectedError:      "",
+		},
+		{
+			installPlanRef:    false,
+			installPlanExists: true,
+			expectedError: fmt.Sprintf("subscription named %s in namespace %s has no pending installplan",
+				defaultSubscriptionName, defaultSubscriptionNamespace),
+		},
+		{
+			installPlanRef:    true,
+			installPlanExists: false,
+			expectedError: fmt.Sprintf("installplan %s of subscription %s doesn't exist in namespace %s",
+				defaultInstallPlanName, defaultSubscriptionName, defaultSubscriptionNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		status := operatorsV1alpha1.SubscriptionStatus{CurrentCSV: defaultSubscriptionCSV}
+
+		if testCase.installPlanRef {
+			status.InstallPlanRef = &corev1.ObjectReference{
+				Name:      defaultInstallPlanName,
+				Namespace: defaultSubscriptionNamespace,
+			}
+		}
+
+		runtimeObjects := []runtime.Object{buildDummySubscription(status)}
+
+		if testCase.installPlanExists {
+			runtimeObjects = append(runtimeObjects, &operatorsV1alpha1.InstallPlan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      defaultInstallPlanName,
+					Namespace: defaultSubscriptionNamespace,
+				},
+				Spec: operatorsV1alpha1.InstallPlanSpec{
+					ClusterServiceVersionNames: []string{defaultSubscriptionCSV},
+					Approval:                   operatorsV1alpha1.ApprovalManual,
+					Approved:                   testCase.installPlanApprove,
+				},
+			})
+		}
+
+		testSettings := buildTestClientWithOlmObjects(t, runtimeObjects...)
+
+		err := buildValidSubscriptionBuilder(testSettings).ApprovePendingInstallPlan()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			installPlan := NewInstallPlanBuilder(testSettings, defaultInstallPlanName, defaultSubscriptionNamespace)
+			assert.True(t, installPlan.Exists())
+			assert.True(t, installPlan.Object.Spec.Approved)
+		}
+	}
+}
+
+func TestSubscriptionWaitForCSVSucceeded(t *testing.T) {
+	testCases := []struct {
+		installedCSV  string
+		currentCSV    string
+		csvPhase      operatorsV1alpha1.ClusterServiceVersionPhase
+		csvExists     bool
+		expectedError string
+	}{
+		{
+			installedCSV:  defaultSubscriptionCSV,
+			currentCSV:    defaultSubscriptionCSV,
+			csvPhase:      operatorsV1alpha1.CSVPhaseSucceeded,
+			csvExists:     true,
+			expectedError: "",
+		},
+		{
+			installedCSV:  defaultSubscriptionCSV,
+			currentCSV:    defaultSubscriptionCSV,
+			csvPhase:      operatorsV1alpha1.CSVPhaseInstalling,
+			csvExists:     true,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			installedCSV:  "sriov-network-operator.v4.15.0",
+			currentCSV:    defaultSubscriptionCSV,
+			csvPhase:      operatorsV1alpha1.CSVPhaseSucceeded,
+			csvExists:     true,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			installedCSV:  defaultSubscriptionCSV,
+			currentCSV:    defaultSubscriptionCSV,
+			csvPhase:      operatorsV1alpha1.CSVPhaseSucceeded,
+			csvExists:     false,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		runtimeObjects := []runtime.Object{buildDummySubscription(operatorsV1alpha1.SubscriptionStatus{
+			InstalledCSV: testCase.installedCSV,
+			CurrentCSV:   testCase.currentCSV,
+		})}
+
+		if testCase.csvExists {
+			runtimeObjects = append(runtimeObjects, &operatorsV1alpha1.ClusterServiceVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testCase.installedCSV,
+					Namespace: defaultSubscriptionNamespace,
+				},
+				Status: operatorsV1alpha1.ClusterServiceVersionStatus{
+					Phase: testCase.csvPhase,
+				},
+			})
+		}
+
+		testBuilder := buildValidSubscriptionBuilder(buildTestClientWithOlmObjects(t, runtimeObjects...))
+
+		err := testBuilder.WaitForCSVSucceeded(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidSubscriptionBuilder(apiClient *clients.Settings) *SubscriptionBuilder {
+	return NewSubscriptionBuilder(apiClient, defaultSubscriptionName, defaultSubscriptionNamespace,
+		"redhat-operators", "openshift-marketplace", "sriov-network-operator")
+}
+
+func buildDummySubscription(status operatorsV1alpha1.SubscriptionStatus) *operatorsV1alpha1.Subscription {
+	return &operatorsV1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultSubscriptionName,
+			Namespace: defaultSubscriptionNamespace,
+		},
+		Spec: &operatorsV1alpha1.SubscriptionSpec{
+			CatalogSource:          "redhat-operators",
+			CatalogSourceNamespace: "openshift-marketplace",
+			Package:                "sriov-network-operator",
+		},
+		Status: status,
+	}
+}
+
+// buildTestClientWithOlmObjects returns test clients whose operators.coreos.com/v1alpha1 client serves the given
+// Subscriptions, InstallPlans, ClusterServiceVersions and CatalogSources.
+func buildTestClientWithOlmObjects(t *testing.T, objects ...runtime.Object) *clients.Settings {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testSettings.OperatorsV1alpha1Interface = testhelper.NewOperatorsV1alpha1Client(t, objects...)
+
+	return testSettings
+}
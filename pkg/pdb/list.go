@@ -0,0 +1,64 @@
+package pdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns poddisruptionbudget inventory in the given namespace.
+func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("poddisruptionbudget 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list poddisruptionbudgets, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("poddisruptionbudget 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list poddisruptionbudgets, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := fmt.Sprintf("Listing poddisruptionbudgets in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	pdbList, err := apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(nsname).List(context.TODO(), passedOptions)
+
+	if err != nil {
+		logging.V(100).Infof("Failed to list poddisruptionbudgets in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var pdbObjects []*Builder
+
+	for _, runningPDB := range pdbList.Items {
+		copiedPDB := runningPDB
+		pdbBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedPDB,
+			Definition: &copiedPDB,
+		}
+
+		pdbObjects = append(pdbObjects, pdbBuilder)
+	}
+
+	return pdbObjects, nil
+}
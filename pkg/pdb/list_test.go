@@ -0,0 +1,66 @@
+package pdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestList(t *testing.T) {
+	testCases := []struct {
+		nsName        string
+		listOptions   []metav1.ListOptions
+		expectedCount int
+		expectedError error
+		client        bool
+	}{
+		{
+			nsName:        defaultPDBNamespace,
+			expectedCount: 2,
+			client:        true,
+		},
+		{
+			nsName:        defaultPDBNamespace,
+			listOptions:   []metav1.ListOptions{{LabelSelector: "app=test-pdb"}},
+			expectedCount: 1,
+			client:        true,
+		},
+		{
+			nsName:        "",
+			expectedError: fmt.Errorf("failed to list poddisruptionbudgets, 'nsname' parameter is empty"),
+			client:        true,
+		},
+		{
+			nsName:        defaultPDBNamespace,
+			listOptions:   []metav1.ListOptions{{LabelSelector: "test"}, {Limit: 1}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+			client:        true,
+		},
+		{
+			nsName:        defaultPDBNamespace,
+			expectedError: fmt.Errorf("failed to list poddisruptionbudgets, 'apiClient' parameter is empty"),
+			client:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{buildDummyPDB("test-pdb", 1, 1), buildDummyPDB("other-pdb", 1, 0)},
+			})
+		}
+
+		pdbBuilders, err := List(testSettings, testCase.nsName, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedCount, len(pdbBuilders))
+		}
+	}
+}
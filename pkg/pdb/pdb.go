@@ -0,0 +1,458 @@
+package pdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Builder provides struct for poddisruptionbudget object containing connection to the cluster and the
+// poddisruptionbudget definitions.
+type Builder struct {
+	// PodDisruptionBudget definition. Used to create the poddisruptionbudget object.
+	Definition *policyv1.PodDisruptionBudget
+	// Created poddisruptionbudget object.
+	Object *policyv1.PodDisruptionBudget
+	// Used in functions that define or mutate poddisruptionbudget definition. errorMsg is processed before the
+	// poddisruptionbudget object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// AdditionalOptions additional options for poddisruptionbudget object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder. The budget applies to the pods matching every label of selector.
+func NewBuilder(apiClient *clients.Settings, name, nsname string, selector map[string]string) *Builder {
+	logging.V(100).Infof(
+		"Initializing new poddisruptionbudget structure with the following params: name: %s, namespace: %s, "+
+			"selector: %v", name, nsname, selector)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: selector},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the poddisruptionbudget is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the poddisruptionbudget is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'namespace' cannot be empty"))
+	}
+
+	if len(selector) == 0 {
+		logging.V(100).Infof("The selector of the poddisruptionbudget is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'selector' cannot be empty"))
+
+		return &builder
+	}
+
+	_, err := metav1.LabelSelectorAsSelector(builder.Definition.Spec.Selector)
+	if err != nil {
+		logging.V(100).Infof("The selector of the poddisruptionbudget is invalid: %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'selector' is invalid: %w", err))
+	}
+
+	return &builder
+}
+
+// Pull loads an existing poddisruptionbudget into Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing poddisruptionbudget name: %s under namespace: %s", name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'namespace' cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("poddisruptionbudget object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithMinAvailable sets the number or percentage of selected pods that must stay available during a voluntary
+// disruption. It cannot be combined with maxUnavailable.
+func (builder *Builder) WithMinAvailable(minAvailable intstr.IntOrString) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting minAvailable %s in poddisruptionbudget %s in namespace %s",
+		minAvailable.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Definition.Spec.MaxUnavailable != nil {
+		logging.V(100).Infof("The poddisruptionbudget already has maxUnavailable set")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("poddisruptionbudget cannot have both 'minAvailable' and 'maxUnavailable'"))
+
+		return builder
+	}
+
+	err := validateIntOrPercent(minAvailable)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'minAvailable' %w", err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MinAvailable = &minAvailable
+
+	return builder
+}
+
+// WithMaxUnavailable sets the number or percentage of selected pods that may be unavailable during a voluntary
+// disruption. It cannot be combined with minAvailable.
+func (builder *Builder) WithMaxUnavailable(maxUnavailable intstr.IntOrString) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting maxUnavailable %s in poddisruptionbudget %s in namespace %s",
+		maxUnavailable.String(), builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Definition.Spec.MinAvailable != nil {
+		logging.V(100).Infof("The poddisruptionbudget already has minAvailable set")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("poddisruptionbudget cannot have both 'minAvailable' and 'maxUnavailable'"))
+
+		return builder
+	}
+
+	err := validateIntOrPercent(maxUnavailable)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("poddisruptionbudget 'maxUnavailable' %w", err))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxUnavailable = &maxUnavailable
+
+	return builder
+}
+
+// WithOptions creates poddisruptionbudget with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting poddisruptionbudget additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a poddisruptionbudget in cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Definition.Spec.MinAvailable == nil && builder.Definition.Spec.MaxUnavailable == nil {
+		logging.V(100).Infof("The poddisruptionbudget has neither minAvailable nor maxUnavailable set")
+
+		return builder, fmt.Errorf("poddisruptionbudget must have either 'minAvailable' or 'maxUnavailable'")
+	}
+
+	var err error
+	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
+		builder.Object, err = builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(
+			builder.Definition.Namespace).Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for poddisruptionbudget %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the poddisruptionbudget definition against the API server and its admission webhooks with a
+// server-side dry-run request. The poddisruptionbudget is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the poddisruptionbudget %s in namespace %s in dry-run mode",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(
+		builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the existing poddisruptionbudget object with the poddisruptionbudget definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent poddisruptionbudget %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(
+		builder.Definition.Namespace).Update(context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Exists checks whether the given poddisruptionbudget exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if poddisruptionbudget %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(
+		builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the poddisruptionbudget from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.K8sClient.PolicyV1().PodDisruptionBudgets(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetDisruptionsAllowed returns the number of pod disruptions currently allowed by the poddisruptionbudget as
+// computed by the disruption controller. It returns an error when the controller has not yet observed the latest
+// generation of the poddisruptionbudget, since the status is stale in that case.
+func (builder *Builder) GetDisruptionsAllowed() (int32, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.V(100).Infof("Getting disruptions allowed by poddisruptionbudget %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("poddisruptionbudget object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status.ObservedGeneration < builder.Object.Generation {
+		logging.V(100).Infof("The status of poddisruptionbudget %s is not observed yet", builder.Definition.Name)
+
+		return 0, fmt.Errorf("status of poddisruptionbudget %s in namespace %s is not observed yet",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.DisruptionsAllowed, nil
+}
+
+// GetGVR returns poddisruptionbudget's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}
+}
+
+// GetGVR returns the poddisruptionbudget GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// validateIntOrPercent checks that value is a non-negative integer or a percentage between 0% and 100%.
+func validateIntOrPercent(value intstr.IntOrString) error {
+	if value.Type == intstr.Int {
+		if value.IntVal < 0 {
+			return fmt.Errorf("cannot be negative")
+		}
+
+		return nil
+	}
+
+	percent, found := strings.CutSuffix(value.StrVal, "%")
+	if !found {
+		return fmt.Errorf("must be an integer or a percentage, got %s", value.StrVal)
+	}
+
+	percentValue, err := strconv.Atoi(percent)
+	if err != nil || percentValue < 0 || percentValue > 100 {
+		return fmt.Errorf("must be a percentage between 0%% and 100%%, got %s", value.StrVal)
+	}
+
+	return nil
+}
+
+// Diff compares the poddisruptionbudget definition with the poddisruptionbudget object on the cluster. Only the fields
+// set in the definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing poddisruptionbudget %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"poddisruptionbudget object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "PodDisruptionBudget"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
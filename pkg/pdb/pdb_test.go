@@ -0,0 +1,305 @@
+package pdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var (
+	defaultPDBName      = "test-pdb"
+	defaultPDBNamespace = "test-namespace"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		selector      map[string]string
+		expectedError string
+	}{
+		{
+			name:          defaultPDBName,
+			nsname:        defaultPDBNamespace,
+			selector:      map[string]string{"app": "test"},
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultPDBNamespace,
+			selector:      map[string]string{"app": "test"},
+			expectedError: "poddisruptionbudget 'name' cannot be empty",
+		},
+		{
+			name:          defaultPDBName,
+			nsname:        "",
+			selector:      map[string]string{"app": "test"},
+			expectedError: "poddisruptionbudget 'namespace' cannot be empty",
+		},
+		{
+			name:          defaultPDBName,
+			nsname:        defaultPDBNamespace,
+			selector:      nil,
+			expectedError: "poddisruptionbudget 'selector' cannot be empty",
+		},
+		{
+			name:          defaultPDBName,
+			nsname:        defaultPDBNamespace,
+			selector:      map[string]string{"app": "not a valid value"},
+			expectedError: "poddisruptionbudget 'selector' is invalid: values[0][app]: Invalid value",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.nsname, testCase.selector)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, testCase.selector, testBuilder.Definition.Spec.Selector.MatchLabels)
+		} else {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedError)
+		}
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			name:          defaultPDBName,
+			nsname:        defaultPDBNamespace,
+			addToRuntime:  true,
+			expectedError: "",
+		},
+		{
+			name:          defaultPDBName,
+			nsname:        defaultPDBNamespace,
+			addToRuntime:  false,
+			expectedError: "poddisruptionbudget object test-pdb doesn't exist in namespace test-namespace",
+		},
+		{
+			name:          "",
+			nsname:        defaultPDBNamespace,
+			addToRuntime:  false,
+			expectedError: "poddisruptionbudget 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyPDB(defaultPDBName, 1, 1))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := Pull(testSettings, testCase.name, testCase.nsname)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestWithMinAvailableAndMaxUnavailable(t *testing.T) {
+	testCases := []struct {
+		minAvailable   *intstr.IntOrString
+		maxUnavailable *intstr.IntOrString
+		expectedError  string
+	}{
+		{
+			minAvailable:  &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+			expectedError: "",
+		},
+		{
+			maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+			expectedError:  "",
+		},
+		{
+			minAvailable:  &intstr.IntOrString{Type: intstr.Int, IntVal: -1},
+			expectedError: "poddisruptionbudget 'minAvailable' cannot be negative",
+		},
+		{
+			maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "150%"},
+			expectedError:  "poddisruptionbudget 'maxUnavailable' must be a percentage between 0% and 100%, got 150%",
+		},
+		{
+			maxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "two"},
+			expectedError:  "poddisruptionbudget 'maxUnavailable' must be an integer or a percentage, got two",
+		},
+		{
+			minAvailable:   &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+			maxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+			expectedError:  "poddisruptionbudget cannot have both 'minAvailable' and 'maxUnavailable'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPDBBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.minAvailable != nil {
+			testBuilder = testBuilder.WithMinAvailable(*testCase.minAvailable)
+		}
+
+		if testCase.maxUnavailable != nil {
+			testBuilder = testBuilder.WithMaxUnavailable(*testCase.maxUnavailable)
+		}
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.minAvailable, testBuilder.Definition.Spec.MinAvailable)
+		assert.Equal(t, testCase.maxUnavailable, testBuilder.Definition.Spec.MaxUnavailable)
+	}
+}
+
+func TestCreateUpdateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := buildValidPDBBuilder(testSettings).Create()
+	assert.EqualError(t, err, "poddisruptionbudget must have either 'minAvailable' or 'maxUnavailable'")
+
+	testBuilder, err := buildValidPDBBuilder(testSettings).
+		WithMinAvailable(intstr.FromInt(1)).
+		Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	testBuilder.Definition.Spec.MinAvailable = nil
+	testBuilder, err = testBuilder.WithMaxUnavailable(intstr.FromString("50%")).Update()
+	assert.Nil(t, err)
+	assert.Equal(t, "50%", testBuilder.Object.Spec.MaxUnavailable.StrVal)
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestGetDisruptionsAllowed(t *testing.T) {
+	testCases := []struct {
+		observedGeneration int64
+		addToRuntime       bool
+		expectedAllowed    int32
+		expectedError      string
+	}{
+		{
+			observedGeneration: 1,
+			addToRuntime:       true,
+			expectedAllowed:    2,
+			expectedError:      "",
+		},
+		{
+			observedGeneration: 0,
+			addToRuntime:       true,
+			expectedError:      "status of poddisruptionbudget test-pdb in namespace test-namespace is not observed yet",
+		},
+		{
+			addToRuntime:  false,
+			expectedError: "poddisruptionbudget object test-pdb doesn't exist in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyPDB(defaultPDBName, testCase.observedGeneration, 2))
+		}
+
+		testBuilder := buildValidPDBBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		disruptionsAllowed, err := testBuilder.GetDisruptionsAllowed()
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedAllowed, disruptionsAllowed)
+	}
+}
+
+func buildValidPDBBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultPDBName, defaultPDBNamespace, map[string]string{"app": "test"})
+}
+
+func buildDummyPDB(name string, observedGeneration int64, disruptionsAllowed int32) *policyv1.PodDisruptionBudget {
+	minAvailable := intstr.FromInt(1)
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  defaultPDBNamespace,
+			Generation: 1,
+			Labels:     map[string]string{"app": name},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			ObservedGeneration: observedGeneration,
+			DisruptionsAllowed: disruptionsAllowed,
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidPDBBuilder(testSettings).WithMinAvailable(intstr.FromInt(1))
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("poddisruptionbudget object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "poddisruptionbudgets", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidPDBBuilder(testSettings).WithMinAvailable(intstr.FromInt(1)).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidPDBBuilder(existingSettings).WithMinAvailable(intstr.FromInt(1)).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
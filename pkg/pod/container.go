@@ -1,11 +1,12 @@
 package pod
 
 import (
+	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/strings/slices"
 )
@@ -35,12 +36,12 @@ type ContainerBuilder struct {
 	// Container definition, used to create the Container object.
 	definition *corev1.Container
 	// Used to store latest error message upon defining or mutating container definition.
-	errorMsg string
+	errorMsg error
 }
 
 // NewContainerBuilder creates a new instance of ContainerBuilder.
 func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
-	glog.V(100).Infof("Initializing new container structure with the following params: "+
+	logging.V(100).Infof("Initializing new container structure with the following params: "+
 		"name: %s, image: %s, cmd: %v", name, image, cmd)
 
 	builder := &ContainerBuilder{
@@ -53,21 +54,21 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the container is empty")
+		logging.V(100).Infof("The name of the container is empty")
 
-		builder.errorMsg = "container's name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's name is empty"))
 	}
 
 	if image == "" {
-		glog.V(100).Infof("Container's image is empty")
+		logging.V(100).Infof("Container's image is empty")
 
-		builder.errorMsg = "container's image is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's image is empty"))
 	}
 
 	if len(cmd) < 1 {
-		glog.V(100).Infof("Container's cmd is empty")
+		logging.V(100).Infof("Container's cmd is empty")
 
-		builder.errorMsg = "container's cmd is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's cmd is empty"))
 	}
 
 	return builder
@@ -75,27 +76,28 @@ func NewContainerBuilder(name, image string, cmd []string) *ContainerBuilder {
 
 // WithSecurityCapabilities applies SecurityCapabilities to the container definition.
 func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string, redefine bool) *ContainerBuilder {
-	glog.V(100).Infof("Applying a list of SecurityCapabilities %v to container %s",
+	logging.V(100).Infof("Applying a list of SecurityCapabilities %v to container %s",
 		sCapabilities, builder.definition.Name)
 
 	if builder.definition.SecurityContext != nil {
 		if !redefine {
-			glog.V(100).Infof("Cannot modify pre-existing SecurityContext")
+			logging.V(100).Infof("Cannot modify pre-existing SecurityContext")
 
-			builder.errorMsg = "can not modify pre-existing security context"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not modify pre-existing security context"))
 		}
 
 		builder.definition.SecurityContext = nil
 	}
 
 	if !areCapabilitiesValid(sCapabilities) {
-		glog.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
+		logging.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
 			sCapabilities, AllowedSCList)
 
-		builder.errorMsg = "one of the give securityCapabilities is invalid. Please extend allowed list or fix parameter"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"one of the give securityCapabilities is invalid. Please extend allowed list or fix parameter"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -115,15 +117,15 @@ func (builder *ContainerBuilder) WithSecurityCapabilities(sCapabilities []string
 
 // WithDropSecurityCapabilities drops SecurityCapabilities from the container definition.
 func (builder *ContainerBuilder) WithDropSecurityCapabilities(sCapabilities []string, redefine bool) *ContainerBuilder {
-	glog.V(100).Infof("Dropping a list of SecurityCapabilities %v from container %s",
+	logging.V(100).Infof("Dropping a list of SecurityCapabilities %v from container %s",
 		sCapabilities, builder.definition.Name)
 
 	if !areCapabilitiesValid(sCapabilities) {
-		glog.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
+		logging.V(100).Infof("Given SecurityCapabilities %v are not valid. Valid list %s",
 			sCapabilities, AllowedSCList)
 
-		builder.errorMsg = "one of the provided securityCapabilities is invalid. " +
-			"Please extend the allowed list or fix parameter"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("one of the provided securityCapabilities is invalid. "+
+			"Please extend the allowed list or fix parameter"))
 
 		return builder
 	}
@@ -135,54 +137,54 @@ func (builder *ContainerBuilder) WithDropSecurityCapabilities(sCapabilities []st
 
 	// filter possible duplicated capabilities from user's input
 	sCapabilitiesList = uniqueCapabilities(sCapabilitiesList)
-	glog.V(100).Infof("Filtered user input: %v", sCapabilitiesList)
+	logging.V(100).Infof("Filtered user input: %v", sCapabilitiesList)
 
 	// filter conflicting capabilities between ADD and DROP
 	if builder.definition.SecurityContext != nil &&
 		builder.definition.SecurityContext.Capabilities != nil &&
 		builder.definition.SecurityContext.Capabilities.Add != nil {
-		glog.V(100).Infof("Filtering conflicting options between ADD and DROP capabilities")
+		logging.V(100).Infof("Filtering conflicting options between ADD and DROP capabilities")
 
 		confCapabilitiesList := capabilitiesIntersection(
 			builder.definition.SecurityContext.Capabilities.Add,
 			sCapabilitiesList)
 
 		if len(confCapabilitiesList) > 0 {
-			glog.V(100).Infof("Conflicting ADD and DROP capabilities")
+			logging.V(100).Infof("Conflicting ADD and DROP capabilities")
 
 			for _, mcap := range confCapabilitiesList {
-				glog.V(100).Infof("SecurityCapability %q already present in the Capabilities.Add list", mcap)
+				logging.V(100).Infof("SecurityCapability %q already present in the Capabilities.Add list", mcap)
 			}
 
-			builder.errorMsg = "Conflicting ADD and DROP SecurityCapabilities"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Conflicting ADD and DROP SecurityCapabilities"))
 
 			return builder
 		}
 	}
 
 	if builder.definition.SecurityContext == nil {
-		glog.V(100).Infof("SecurityContext is nil. Initializing one")
+		logging.V(100).Infof("SecurityContext is nil. Initializing one")
 
 		builder.definition.SecurityContext = new(corev1.SecurityContext)
 	}
 
 	if builder.definition.SecurityContext.Capabilities == nil {
-		glog.V(100).Infof("Capabilities are nil. Initializing one")
+		logging.V(100).Infof("Capabilities are nil. Initializing one")
 
 		builder.definition.SecurityContext.Capabilities = new(corev1.Capabilities)
 	}
 
 	if !redefine {
-		glog.V(100).Infof("SecurityContext.Capabilities will not be redefined")
-		glog.V(100).Infof("Filtering duplicated DROP capabilities - %v", sCapabilitiesList)
+		logging.V(100).Infof("SecurityContext.Capabilities will not be redefined")
+		logging.V(100).Infof("Filtering duplicated DROP capabilities - %v", sCapabilitiesList)
 		sCapabilitiesList = capabilitiesDifference(builder.definition.SecurityContext.Capabilities.Drop,
 			sCapabilitiesList)
 
-		glog.V(100).Infof("Updating existing SecurityContext.Capabilities.Drop list with %v", sCapabilitiesList)
+		logging.V(100).Infof("Updating existing SecurityContext.Capabilities.Drop list with %v", sCapabilitiesList)
 		builder.definition.SecurityContext.Capabilities.Drop = append(builder.definition.SecurityContext.Capabilities.Drop,
 			sCapabilitiesList...)
 	} else {
-		glog.V(100).Infof("Redefining existing SecurityContext.Capabilities.Drop list with %v", sCapabilitiesList)
+		logging.V(100).Infof("Redefining existing SecurityContext.Capabilities.Drop list with %v", sCapabilitiesList)
 		builder.definition.SecurityContext.Capabilities.Drop = sCapabilitiesList
 	}
 
@@ -191,15 +193,16 @@ func (builder *ContainerBuilder) WithDropSecurityCapabilities(sCapabilities []st
 
 // WithSecurityContext applies security Context on container.
 func (builder *ContainerBuilder) WithSecurityContext(securityContext *corev1.SecurityContext) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom securityContext %v", securityContext)
+	logging.V(100).Infof("Applying custom securityContext %v", securityContext)
 
 	if securityContext == nil {
-		glog.V(100).Infof("Cannot add empty securityContext to container structure")
+		logging.V(100).Infof("Cannot add empty securityContext to container structure")
 
-		builder.errorMsg = "can not modify container config with empty securityContext"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"can not modify container config with empty securityContext"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -210,28 +213,28 @@ func (builder *ContainerBuilder) WithSecurityContext(securityContext *corev1.Sec
 
 // WithResourceLimit applies resource limit on container.
 func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu int64) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom resource limit to container: hugePages: %s memory: %s cpu: %d",
+	logging.V(100).Infof("Applying custom resource limit to container: hugePages: %s memory: %s cpu: %d",
 		hugePages, memory, cpu)
 
 	if hugePages == "" {
-		glog.V(100).Infof("Container's resource limit hugePages is empty")
+		logging.V(100).Infof("Container's resource limit hugePages is empty")
 
-		builder.errorMsg = "container's resource limit 'hugePages' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's resource limit 'hugePages' is empty"))
 	}
 
 	if memory == "" {
-		glog.V(100).Infof("Container's resource limit memory is empty")
+		logging.V(100).Infof("Container's resource limit memory is empty")
 
-		builder.errorMsg = "container's resource limit 'memory' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's resource limit 'memory' is empty"))
 	}
 
 	if cpu <= 0 {
-		glog.V(100).Infof("Container's resource limit cpu can not be zero or negative number.")
+		logging.V(100).Infof("Container's resource limit cpu can not be zero or negative number.")
 
-		builder.errorMsg = "container's resource limit 'cpu' is invalid"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's resource limit 'cpu' is invalid"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -246,28 +249,28 @@ func (builder *ContainerBuilder) WithResourceLimit(hugePages, memory string, cpu
 
 // WithResourceRequest applies resource request on container.
 func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, cpu int64) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom resource request to container: hugePages: %s memory: %s cpu: %d",
+	logging.V(100).Infof("Applying custom resource request to container: hugePages: %s memory: %s cpu: %d",
 		hugePages, memory, cpu)
 
 	if hugePages == "" {
-		glog.V(100).Infof("Container's resource request hugePages is empty")
+		logging.V(100).Infof("Container's resource request hugePages is empty")
 
-		builder.errorMsg = "container's resource request 'hugePages' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's resource request 'hugePages' is empty"))
 	}
 
 	if memory == "" {
-		glog.V(100).Infof("Container's resource request memory is empty")
+		logging.V(100).Infof("Container's resource request memory is empty")
 
-		builder.errorMsg = "container's resource request 'memory' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's resource request 'memory' is empty"))
 	}
 
 	if cpu <= 0 {
-		glog.V(100).Infof("Container's resource request cpu can not be zero or negative number.")
+		logging.V(100).Infof("Container's resource request cpu can not be zero or negative number.")
 
-		builder.errorMsg = "container's resource request 'cpu' is invalid"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's resource request 'cpu' is invalid"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -282,15 +285,15 @@ func (builder *ContainerBuilder) WithResourceRequest(hugePages, memory string, c
 
 // WithCustomResourcesLimits applies custom resource limit struct on container.
 func (builder *ContainerBuilder) WithCustomResourcesLimits(resourceList corev1.ResourceList) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom resource limit to container: %v", resourceList)
+	logging.V(100).Infof("Applying custom resource limit to container: %v", resourceList)
 
 	if len(resourceList) == 0 {
-		glog.V(100).Infof("Container's resource limit var 'resourceList' is empty")
+		logging.V(100).Infof("Container's resource limit var 'resourceList' is empty")
 
-		builder.errorMsg = "container's resource limit var 'resourceList' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's resource limit var 'resourceList' is empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -301,15 +304,15 @@ func (builder *ContainerBuilder) WithCustomResourcesLimits(resourceList corev1.R
 
 // WithImagePullPolicy applies specific image pull policy on container.
 func (builder *ContainerBuilder) WithImagePullPolicy(pullPolicy corev1.PullPolicy) *ContainerBuilder {
-	glog.V(100).Infof("Applying image pull policy to container: %s", pullPolicy)
+	logging.V(100).Infof("Applying image pull policy to container: %s", pullPolicy)
 
 	if len(pullPolicy) == 0 {
-		glog.V(100).Infof("Container's image pull policy 'pullPolicy' is empty")
+		logging.V(100).Infof("Container's image pull policy 'pullPolicy' is empty")
 
-		builder.errorMsg = "container's pull policy var 'pullPolicy' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's pull policy var 'pullPolicy' is empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -320,21 +323,21 @@ func (builder *ContainerBuilder) WithImagePullPolicy(pullPolicy corev1.PullPolic
 
 // WithEnvVar adds environment variables to container.
 func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilder {
-	glog.V(100).Infof("Applying custom environment variables to container: name %s, value: %s", name, value)
+	logging.V(100).Infof("Applying custom environment variables to container: name %s, value: %s", name, value)
 
 	if name == "" {
-		glog.V(100).Infof("Container's environment var 'name' is empty")
+		logging.V(100).Infof("Container's environment var 'name' is empty")
 
-		builder.errorMsg = "container's environment var 'name' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's environment var 'name' is empty"))
 	}
 
 	if value == "" {
-		glog.V(100).Infof("Container's environment var 'value' is empty")
+		logging.V(100).Infof("Container's environment var 'value' is empty")
 
-		builder.errorMsg = "container's environment var 'value' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's environment var 'value' is empty"))
 	}
 
-	if builder.errorMsg != name {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -351,25 +354,25 @@ func (builder *ContainerBuilder) WithEnvVar(name, value string) *ContainerBuilde
 
 // WithVolumeMount adds a pod volume mount inside the container.
 func (builder *ContainerBuilder) WithVolumeMount(volMount corev1.VolumeMount) *ContainerBuilder {
-	glog.V(100).Infof("Adding VolumeMount to the %s container's definition", builder.definition.Name)
+	logging.V(100).Infof("Adding VolumeMount to the %s container's definition", builder.definition.Name)
 
 	if volMount.Name == "" {
-		glog.V(100).Infof("Container's VolumeMount name cannot be empty")
+		logging.V(100).Infof("Container's VolumeMount name cannot be empty")
 
-		builder.errorMsg = "container's volume mount name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's volume mount name is empty"))
 	}
 
 	if volMount.MountPath == "" {
-		glog.V(100).Infof("Container's VolumeMount mount path cannot be empty")
+		logging.V(100).Infof("Container's VolumeMount mount path cannot be empty")
 
-		builder.errorMsg = "container's volume mount path is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("container's volume mount path is empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
-	glog.V(100).Infof("VolumeMount %s will be mounted at %s", volMount.Name, volMount.MountPath)
+	logging.V(100).Infof("VolumeMount %s will be mounted at %s", volMount.Name, volMount.MountPath)
 	builder.definition.VolumeMounts = append(builder.definition.VolumeMounts, volMount)
 
 	return builder
@@ -377,12 +380,12 @@ func (builder *ContainerBuilder) WithVolumeMount(volMount corev1.VolumeMount) *C
 
 // GetContainerCfg returns Container struct.
 func (builder *ContainerBuilder) GetContainerCfg() (*corev1.Container, error) {
-	glog.V(100).Infof("Returning configuration for container %s", builder.definition.Name)
+	logging.V(100).Infof("Returning configuration for container %s", builder.definition.Name)
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("Failed to build container configuration due to %s", builder.errorMsg)
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("Failed to build container configuration due to %s", builder.errorMsg)
 
-		return nil, fmt.Errorf(builder.errorMsg)
+		return nil, builder.errorMsg
 	}
 
 	return builder.definition, nil
@@ -0,0 +1,141 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DeleteRandomPod deletes one pod picked at random among the pods matching labelSelector in namespace nsname and
+// returns its builder. Pods that are already terminating are not picked. The Definition of the returned builder still
+// holds the deleted pod and can be passed to WaitForReplacementPods.
+func DeleteRandomPod(apiClient *clients.Settings, nsname, labelSelector string) (*Builder, error) {
+	logging.V(100).Infof("Deleting random pod matching %s in namespace %s", labelSelector, nsname)
+
+	pods, err := List(apiClient, nsname, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*Builder
+
+	for _, podBuilder := range pods {
+		if podBuilder.Object.DeletionTimestamp == nil {
+			candidates = append(candidates, podBuilder)
+		}
+	}
+
+	if len(candidates) == 0 {
+		logging.V(100).Infof("There are no pods matching %s in namespace %s", labelSelector, nsname)
+
+		return nil, fmt.Errorf("no pods matching %s found in namespace %s", labelSelector, nsname)
+	}
+
+	victim := candidates[rand.Intn(len(candidates))]
+
+	logging.V(100).Infof("Picked pod %s in namespace %s for deletion", victim.Definition.Name, nsname)
+
+	_, err = victim.Delete()
+	if err != nil {
+		return nil, err
+	}
+
+	return victim, nil
+}
+
+// Evict removes the pod through the eviction API so that PodDisruptionBudgets are honored. An eviction blocked by a
+// PodDisruptionBudget returns an error matched by k8serrors.IsTooManyRequests.
+func (builder *Builder) Evict() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Evicting pod %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	err := builder.apiClient.Pods(builder.Definition.Namespace).EvictV1(context.TODO(), &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.Definition.Name,
+			Namespace: builder.Definition.Namespace,
+		},
+	})
+
+	if err == nil {
+		return nil
+	}
+
+	if k8serrors.IsTooManyRequests(err) {
+		logging.V(100).Infof("The eviction of pod %s is blocked by a disruption budget", builder.Definition.Name)
+	}
+
+	return fmt.Errorf("failed to evict pod %s in namespace %s: %w",
+		builder.Definition.Name, builder.Definition.Namespace, err)
+}
+
+// WaitForReplacementPods waits for the duration of the defined timeout or until none of the replaced pods is left
+// and at least expectedReady pods matching labelSelector in namespace nsname are running and ready. Pods are told
+// apart by the UID of their Definition, as set by Pull, List or DeleteRandomPod, so a statefulset pod recreated with
+// the same name counts as a replacement.
+func WaitForReplacementPods(
+	apiClient *clients.Settings,
+	nsname, labelSelector string,
+	replacedPods []*Builder,
+	expectedReady int,
+	timeout time.Duration) error {
+	logging.V(100).Infof("Waiting for %d ready pods matching %s in namespace %s to replace %d pods",
+		expectedReady, labelSelector, nsname, len(replacedPods))
+
+	replacedUIDs := make(map[types.UID]bool)
+
+	for _, replacedPod := range replacedPods {
+		if replacedPod != nil && replacedPod.Definition != nil && replacedPod.Definition.UID != "" {
+			replacedUIDs[replacedPod.Definition.UID] = true
+		}
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			pods, err := List(apiClient, nsname, metav1.ListOptions{LabelSelector: labelSelector})
+			if err != nil {
+				return false, nil
+			}
+
+			readyPods := 0
+
+			for _, podBuilder := range pods {
+				if replacedUIDs[podBuilder.Object.UID] {
+					return false, nil
+				}
+
+				if isRunningAndReady(podBuilder.Object) {
+					readyPods++
+				}
+			}
+
+			return readyPods >= expectedReady, nil
+		})
+}
+
+// isRunningAndReady checks whether the pod is running, not terminating and has the Ready condition.
+func isRunningAndReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+		return false
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
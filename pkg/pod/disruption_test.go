@@ -0,0 +1,153 @@
+package pod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDeleteRandomPod(t *testing.T) {
+	testCases := []struct {
+		labelSelector string
+		expectedError string
+	}{
+		{
+			labelSelector: "app=test",
+			expectedError: "",
+		},
+		{
+			labelSelector: "app=missing",
+			expectedError: "no pods matching app=missing found in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+			buildDisruptionTestPod("test-pod-a", "uid-a", true),
+			buildDisruptionTestPod("test-pod-b", "uid-b", true),
+		}})
+
+		deletedPod, err := DeleteRandomPod(testSettings, "test-namespace", testCase.labelSelector)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.False(t, deletedPod.Exists())
+
+		remainingPods, err := List(testSettings, "test-namespace")
+		assert.Nil(t, err)
+		assert.Len(t, remainingPods, 1)
+		assert.NotEqual(t, deletedPod.Definition.Name, remainingPods[0].Definition.Name)
+	}
+}
+
+func TestPodEvict(t *testing.T) {
+	testCases := []struct {
+		reactors      []clients.TestReactor
+		expectedError string
+	}{
+		{
+			expectedError: "",
+		},
+		{
+			reactors: []clients.TestReactor{clients.NewErrorReactor(
+				"create", "pods", k8serrors.NewTooManyRequests("Cannot evict pod as it would violate the budget", 0))},
+			expectedError: "failed to evict pod test-pod-a in namespace test-namespace: " +
+				"Cannot evict pod as it would violate the budget",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDisruptionTestPod("test-pod-a", "uid-a", true)},
+			Reactors:       testCase.reactors,
+		})
+
+		testBuilder, err := Pull(testSettings, "test-pod-a", "test-namespace")
+		assert.Nil(t, err)
+
+		err = testBuilder.Evict()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.True(t, k8serrors.IsTooManyRequests(err))
+
+			continue
+		}
+
+		assert.Nil(t, err)
+	}
+}
+
+func TestWaitForReplacementPods(t *testing.T) {
+	replacedPod := &Builder{Definition: buildDisruptionTestPod("test-pod-a", "uid-a", true)}
+
+	testCases := []struct {
+		pods          []runtime.Object
+		expectedReady int
+		expectedError string
+	}{
+		{
+			pods: []runtime.Object{
+				buildDisruptionTestPod("test-pod-a", "uid-a-new", true),
+				buildDisruptionTestPod("test-pod-b", "uid-b", true),
+			},
+			expectedReady: 2,
+			expectedError: "",
+		},
+		{
+			pods: []runtime.Object{
+				buildDisruptionTestPod("test-pod-a", "uid-a", true),
+				buildDisruptionTestPod("test-pod-b", "uid-b", true),
+			},
+			expectedReady: 1,
+			expectedError: "context deadline exceeded",
+		},
+		{
+			pods: []runtime.Object{
+				buildDisruptionTestPod("test-pod-c", "uid-c", false),
+				buildDisruptionTestPod("test-pod-b", "uid-b", true),
+			},
+			expectedReady: 2,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: testCase.pods})
+
+		err := WaitForReplacementPods(
+			testSettings, "test-namespace", "app=test", []*Builder{replacedPod}, testCase.expectedReady, time.Second)
+
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildDisruptionTestPod(name string, uid types.UID, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-namespace",
+			UID:       uid,
+			Labels:    map[string]string{"app": "test"},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+		},
+	}
+}
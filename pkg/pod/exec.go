@@ -0,0 +1,330 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecCommandWithStreams runs command in the given container of the pod without a TTY. The stdin reader, when not
+// nil, is streamed to the command. The stdout and stderr of the command are returned separately. An empty
+// containerName selects the first container of the pod.
+func (builder *Builder) ExecCommandWithStreams(
+	command []string, containerName string, stdin io.Reader) (bytes.Buffer, bytes.Buffer, error) {
+	var stdout, stderr bytes.Buffer
+
+	containerName, err := builder.execTarget(containerName)
+	if err != nil {
+		return stdout, stderr, err
+	}
+
+	logging.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s",
+		command, builder.Definition.Name, containerName, builder.Definition.Namespace)
+
+	if len(command) == 0 {
+		logging.V(100).Infof("The command to execute in pod %s is empty", builder.Definition.Name)
+
+		return stdout, stderr, fmt.Errorf("command to execute in pod %s cannot be empty", builder.Definition.Name)
+	}
+
+	err = builder.stream(command, containerName, stdin, &stdout, &stderr)
+	if err != nil {
+		logging.V(100).Infof("Failed to execute command %v in pod %s due to %s: %s",
+			command, builder.Definition.Name, err.Error(), stderr.String())
+
+		return stdout, stderr, fmt.Errorf(
+			"failed to execute command %v in pod %s: %w", command, builder.Definition.Name, err)
+	}
+
+	return stdout, stderr, nil
+}
+
+// CopyToPod copies the local file or directory at srcPath into the given container of the pod as destPath. The
+// container image must provide the tar binary. An empty containerName selects the first container of the pod.
+func (builder *Builder) CopyToPod(srcPath, destPath, containerName string) error {
+	containerName, err := builder.execTarget(containerName)
+	if err != nil {
+		return err
+	}
+
+	logging.V(100).Infof("Copying %s to %s in the pod %s container %s in namespace %s",
+		srcPath, destPath, builder.Definition.Name, containerName, builder.Definition.Namespace)
+
+	if srcPath == "" || destPath == "" {
+		logging.V(100).Infof("The source or destination path of the copy is empty")
+
+		return fmt.Errorf("copy source and destination paths cannot be empty")
+	}
+
+	archive := &bytes.Buffer{}
+
+	err = tarPath(srcPath, path.Base(destPath), archive)
+	if err != nil {
+		return err
+	}
+
+	command := []string{"tar", "xf", "-", "-C", path.Dir(destPath)}
+
+	var stderr bytes.Buffer
+
+	err = builder.stream(command, containerName, archive, io.Discard, &stderr)
+	if err != nil {
+		logging.V(100).Infof("Failed to copy %s to pod %s due to %s: %s",
+			srcPath, builder.Definition.Name, err.Error(), stderr.String())
+
+		return fmt.Errorf("failed to copy %s to pod %s: %w", srcPath, builder.Definition.Name, err)
+	}
+
+	return nil
+}
+
+// CopyFromPod copies the file or directory at srcPath in the given container of the pod to the local destPath. The
+// container image must provide the tar binary. An empty containerName selects the first container of the pod.
+func (builder *Builder) CopyFromPod(srcPath, destPath, containerName string) error {
+	containerName, err := builder.execTarget(containerName)
+	if err != nil {
+		return err
+	}
+
+	logging.V(100).Infof("Copying %s from the pod %s container %s in namespace %s to %s",
+		srcPath, builder.Definition.Name, containerName, builder.Definition.Namespace, destPath)
+
+	if srcPath == "" || destPath == "" {
+		logging.V(100).Infof("The source or destination path of the copy is empty")
+
+		return fmt.Errorf("copy source and destination paths cannot be empty")
+	}
+
+	command := []string{"tar", "cf", "-", "-C", path.Dir(srcPath), path.Base(srcPath)}
+
+	var archive, stderr bytes.Buffer
+
+	err = builder.stream(command, containerName, nil, &archive, &stderr)
+	if err != nil {
+		logging.V(100).Infof("Failed to copy %s from pod %s due to %s: %s",
+			srcPath, builder.Definition.Name, err.Error(), stderr.String())
+
+		return fmt.Errorf("failed to copy %s from pod %s: %w", srcPath, builder.Definition.Name, err)
+	}
+
+	return untarPath(&archive, path.Base(srcPath), destPath)
+}
+
+// execTarget validates the builder, makes sure the pod exists and returns the container to run commands in.
+func (builder *Builder) execTarget(containerName string) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	if !builder.Exists() {
+		logging.V(100).Infof("The pod %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return "", fmt.Errorf("pod object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if containerName != "" {
+		return containerName, nil
+	}
+
+	if len(builder.Object.Spec.Containers) == 0 {
+		logging.V(100).Infof("The pod %s has no containers", builder.Definition.Name)
+
+		return "", fmt.Errorf("pod %s has no containers", builder.Definition.Name)
+	}
+
+	return builder.Object.Spec.Containers[0].Name, nil
+}
+
+// stream runs command in the container through the exec subresource and wires up the given streams.
+func (builder *Builder) stream(
+	command []string, containerName string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if builder.apiClient.Config == nil {
+		logging.V(100).Infof("The Pod builder apiclient has no rest config")
+
+		return fmt.Errorf("cannot exec into pod %s without rest config", builder.Definition.Name)
+	}
+
+	req := builder.apiClient.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(builder.Definition.Namespace).
+		Resource("pods").
+		Name(builder.Definition.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+			TTY:       false,
+		}, scheme.ParameterCodec)
+
+	exec, err := newSPDYExecutor(builder.apiClient.Config, req.URL())
+	if err != nil {
+		return err
+	}
+
+	return exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+		Tty:    false,
+	})
+}
+
+// newSPDYExecutor returns an executor that streams the exec request at url over SPDY with the ping period disabled.
+func newSPDYExecutor(config *rest.Config, url *url.URL) (remotecommand.Executor, error) {
+	tlsConfig, err := rest.TLSConfigFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if config.Proxy != nil {
+		proxy = config.Proxy
+	}
+
+	// More verbose setup of remotecommand executor required in order to tweak PingPeriod.
+	// By default many large files are not copied in their entirety without disabling PingPeriod during the copy.
+	// https://github.com/kubernetes/kubernetes/issues/60140#issuecomment-1411477275
+	upgradeRoundTripper := spdy.NewRoundTripperWithConfig(spdy.RoundTripperConfig{
+		TLS:        tlsConfig,
+		Proxier:    proxy,
+		PingPeriod: 0,
+	})
+
+	wrapper, err := rest.HTTPWrappersForConfig(config, upgradeRoundTripper)
+	if err != nil {
+		return nil, err
+	}
+
+	return remotecommand.NewSPDYExecutorForTransports(wrapper, upgradeRoundTripper, "POST", url)
+}
+
+// tarPath writes a tar archive of the local file or directory at srcPath to writer. The archive root is renamed to
+// rootName.
+func tarPath(srcPath, rootName string, writer io.Writer) error {
+	tarWriter := tar.NewWriter(writer)
+
+	err := filepath.Walk(srcPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(srcPath, filePath)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+
+		header.Name = path.Join(rootName, filepath.ToSlash(relPath))
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		_, err = io.Copy(tarWriter, file)
+
+		return err
+	})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to archive %s due to %s", srcPath, err.Error())
+
+		return fmt.Errorf("failed to archive %s: %w", srcPath, err)
+	}
+
+	return tarWriter.Close()
+}
+
+// untarPath extracts the tar archive read from reader into destPath. The entry named rootName becomes destPath and
+// entries escaping it are rejected.
+func untarPath(reader io.Reader, rootName, destPath string) error {
+	tarReader := tar.NewReader(reader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		relPath, err := filepath.Rel(rootName, filepath.FromSlash(header.Name))
+		if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			logging.V(100).Infof("The archive entry %s is outside of %s", header.Name, rootName)
+
+			return fmt.Errorf("archive entry %s is outside of %s", header.Name, rootName)
+		}
+
+		targetPath := filepath.Join(destPath, relPath)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(targetPath, os.FileMode(header.Mode).Perm())
+		case tar.TypeReg:
+			err = writeFile(targetPath, tarReader, os.FileMode(header.Mode).Perm())
+		default:
+			logging.V(100).Infof("Skipping archive entry %s of type %c", header.Name, header.Typeflag)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to extract %s: %w", header.Name, err)
+		}
+	}
+}
+
+// writeFile writes the content of reader to filePath, creating the parent directories if needed.
+func writeFile(filePath string, reader io.Reader, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+
+	return err
+}
@@ -0,0 +1,118 @@
+package pod
+
+import (
+	"bytes"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+func TestPodExecCommandWithStreams(t *testing.T) {
+	testCases := []struct {
+		podExists     bool
+		command       []string
+		expectedError string
+	}{
+		{
+			podExists:     true,
+			command:       []string{"ls"},
+			expectedError: "failed to execute command [ls] in pod test-pod: cannot exec into pod test-pod without rest config",
+		},
+		{
+			podExists:     true,
+			command:       []string{},
+			expectedError: "command to execute in pod test-pod cannot be empty",
+		},
+		{
+			podExists:     false,
+			command:       []string{"ls"},
+			expectedError: "pod object test-pod doesn't exist in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestPodBuilder(testCase.podExists)
+
+		_, _, err := testBuilder.ExecCommandWithStreams(testCase.command, "", nil)
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+func TestPodCopyPathValidation(t *testing.T) {
+	testBuilder := buildTestPodBuilder(true)
+
+	err := testBuilder.CopyToPod("", "/tmp/file", "")
+	assert.EqualError(t, err, "copy source and destination paths cannot be empty")
+
+	err = testBuilder.CopyFromPod("/tmp/file", "", "")
+	assert.EqualError(t, err, "copy source and destination paths cannot be empty")
+}
+
+func TestNewSPDYExecutor(t *testing.T) {
+	execURL := &url.URL{Scheme: "https", Host: "api.test.example.com:6443", Path: "/api/v1/namespaces/test/pods/test/exec"}
+
+	executor, err := newSPDYExecutor(&rest.Config{Host: "https://api.test.example.com:6443"}, execURL)
+	assert.Nil(t, err)
+	assert.NotNil(t, executor)
+
+	_, err = newSPDYExecutor(&rest.Config{
+		Host: "https://api.test.example.com:6443", TLSClientConfig: rest.TLSClientConfig{CAFile: "/missing/ca.crt"}},
+		execURL)
+	assert.NotNil(t, err)
+}
+
+func TestPodTarRoundTrip(t *testing.T) {
+	srcDir := filepath.Join(t.TempDir(), "src")
+
+	assert.Nil(t, os.MkdirAll(filepath.Join(srcDir, "nested"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(srcDir, "top.txt"), []byte("top"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(srcDir, "nested", "inner.txt"), []byte("inner"), 0600))
+
+	archive := &bytes.Buffer{}
+	assert.Nil(t, tarPath(srcDir, "renamed", archive))
+
+	destDir := filepath.Join(t.TempDir(), "dest")
+	assert.Nil(t, untarPath(archive, "renamed", destDir))
+
+	content, err := os.ReadFile(filepath.Join(destDir, "top.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "top", string(content))
+
+	content, err = os.ReadFile(filepath.Join(destDir, "nested", "inner.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "inner", string(content))
+}
+
+func TestPodUntarPathRejectsEscapingEntries(t *testing.T) {
+	srcFile := filepath.Join(t.TempDir(), "file.txt")
+	assert.Nil(t, os.WriteFile(srcFile, []byte("content"), 0644))
+
+	archive := &bytes.Buffer{}
+	assert.Nil(t, tarPath(srcFile, "../escape.txt", archive))
+
+	err := untarPath(archive, "file.txt", t.TempDir())
+	assert.EqualError(t, err, "archive entry ../escape.txt is outside of file.txt")
+}
+
+func buildTestPodBuilder(podExists bool) *Builder {
+	var runtimeObjects []runtime.Object
+
+	if podExists {
+		runtimeObjects = append(runtimeObjects, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-namespace"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "test-container"}}},
+		})
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+	return NewBuilder(testSettings, "test-pod", "test-namespace", "test-image")
+}
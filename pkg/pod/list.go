@@ -6,15 +6,15 @@ import (
 	"strings"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // List returns pod inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...v1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("pod 'nsname' parameter can not be empty")
+		logging.V(100).Infof("pod 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
@@ -26,17 +26,17 @@ func List(apiClient *clients.Settings, nsname string, options ...v1.ListOptions)
 		passedOptions = options[0]
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	} else if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	podList, err := apiClient.Pods(nsname).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list pods in the nsname %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list pods in the nsname %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...v1.ListOptions)
 	passedOptions := v1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -73,12 +73,12 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...v1.ListOptions)
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	podList, err := apiClient.Pods("").List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all pods due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all pods due to %s", err.Error())
 
 		return nil, err
 	}
@@ -101,10 +101,10 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...v1.ListOptions)
 
 // ListByNamePattern returns pod inventory in the given namespace filtered by name pattern.
 func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string) ([]*Builder, error) {
-	glog.V(100).Infof("Listing pods in the nsname %s filtered by the name pattern %s", nsname, namePattern)
+	logging.V(100).Infof("Listing pods in the nsname %s filtered by the name pattern %s", nsname, namePattern)
 
 	if nsname == "" {
-		glog.V(100).Infof("pod 'nsname' parameter can not be empty")
+		logging.V(100).Infof("pod 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
@@ -112,7 +112,7 @@ func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string)
 	podList, err := apiClient.Pods(nsname).List(context.TODO(), v1.ListOptions{})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list pods filtered by the name pattern %s in the nsname %s due to %s",
+		logging.V(100).Infof("Failed to list pods filtered by the name pattern %s in the nsname %s due to %s",
 			namePattern, nsname, err.Error())
 
 		return nil, err
@@ -143,7 +143,7 @@ func WaitForAllPodsInNamespaceRunning(
 	timeout time.Duration,
 	options ...v1.ListOptions) (bool, error) {
 	if nsname == "" {
-		glog.V(100).Infof("'nsname' parameter can not be empty")
+		logging.V(100).Infof("'nsname' parameter can not be empty")
 
 		return false, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
@@ -152,7 +152,7 @@ func WaitForAllPodsInNamespaceRunning(
 	passedOptions := v1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return false, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -162,11 +162,11 @@ func WaitForAllPodsInNamespaceRunning(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage + " are in running state")
+	logging.V(100).Infof(logMessage + " are in running state")
 
 	podList, err := List(apiClient, nsname, passedOptions)
 	if err != nil {
-		glog.V(100).Infof("Failed to list all pods due to %s", err.Error())
+		logging.V(100).Infof("Failed to list all pods due to %s", err.Error())
 
 		return false, err
 	}
@@ -174,7 +174,7 @@ func WaitForAllPodsInNamespaceRunning(
 	for _, podObj := range podList {
 		err = podObj.WaitUntilRunning(timeout)
 		if err != nil {
-			glog.V(100).Infof("Timout was reached while waiting for all pods in running state: %s", err.Error())
+			logging.V(100).Infof("Timout was reached while waiting for all pods in running state: %s", err.Error())
 
 			return false, err
 		}
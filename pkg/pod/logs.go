@@ -0,0 +1,155 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetLogs fetches the log of the given container of the pod. A zero sinceTime returns the log since the container
+// started and a tailLines value of zero or less returns every line. The containerName may be left empty for pods with
+// a single container.
+func (builder *Builder) GetLogs(containerName string, sinceTime time.Time, tailLines int64) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting log of container %s in pod %s in namespace %s since %s with %d tail lines",
+		containerName, builder.Definition.Name, builder.Definition.Namespace, sinceTime, tailLines)
+
+	logOptions := &corev1.PodLogOptions{Container: containerName}
+
+	if !sinceTime.IsZero() {
+		logOptions.SinceTime = &metav1.Time{Time: sinceTime}
+	}
+
+	if tailLines > 0 {
+		logOptions.TailLines = &tailLines
+	}
+
+	logBuffer := new(bytes.Buffer)
+
+	err := builder.copyLogs(context.TODO(), logBuffer, logOptions)
+	if err != nil {
+		return "", err
+	}
+
+	return logBuffer.String(), nil
+}
+
+// StreamLogs follows the log of the given container of the pod and writes it to writer until the context is done or
+// the container exits. The containerName may be left empty for pods with a single container.
+func (builder *Builder) StreamLogs(ctx context.Context, writer io.Writer, containerName string) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Streaming log of container %s in pod %s in namespace %s",
+		containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if writer == nil {
+		logging.V(100).Infof("The writer to stream the pod log to is nil")
+
+		return fmt.Errorf("cannot stream log of pod %s to nil writer", builder.Definition.Name)
+	}
+
+	err := builder.copyLogs(ctx, writer, &corev1.PodLogOptions{Container: containerName, Follow: true})
+	if err != nil && ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+// DumpLogs writes the log of every container of the pods matching labelSelector in namespace nsname to dir. Each
+// log is stored in a file named <pod>_<container>.log. Failures to fetch a single log do not stop the collection and
+// are returned together.
+func DumpLogs(apiClient *clients.Settings, nsname, labelSelector, dir string) error {
+	logging.V(100).Infof("Dumping logs of pods matching %s in namespace %s to %s", labelSelector, nsname, dir)
+
+	if dir == "" {
+		logging.V(100).Infof("The directory to dump pod logs to is empty")
+
+		return fmt.Errorf("pod logs directory cannot be empty")
+	}
+
+	pods, err := List(apiClient, nsname, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		logging.V(100).Infof("Failed to create pod logs directory %s due to %s", dir, err.Error())
+
+		return fmt.Errorf("failed to create pod logs directory %s: %w", dir, err)
+	}
+
+	var dumpErr error
+
+	for _, podBuilder := range pods {
+		var containers []corev1.Container
+
+		containers = append(containers, podBuilder.Object.Spec.InitContainers...)
+		containers = append(containers, podBuilder.Object.Spec.Containers...)
+
+		for _, container := range containers {
+			err = podBuilder.dumpContainerLog(container.Name, dir)
+			if err != nil {
+				dumpErr = errors.Join(dumpErr, err)
+			}
+		}
+	}
+
+	return dumpErr
+}
+
+// dumpContainerLog writes the full log of the container to a file in dir.
+func (builder *Builder) dumpContainerLog(containerName, dir string) error {
+	logPath := filepath.Join(dir, fmt.Sprintf("%s_%s.log", builder.Definition.Name, containerName))
+
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		logging.V(100).Infof("Failed to create log file %s due to %s", logPath, err.Error())
+
+		return fmt.Errorf("failed to create log file %s: %w", logPath, err)
+	}
+
+	defer logFile.Close()
+
+	return builder.copyLogs(context.TODO(), logFile, &corev1.PodLogOptions{Container: containerName})
+}
+
+// copyLogs opens the log stream of the pod with the given options and copies it to writer.
+func (builder *Builder) copyLogs(ctx context.Context, writer io.Writer, logOptions *corev1.PodLogOptions) error {
+	logStream, err := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(
+		builder.Definition.Name, logOptions).Stream(ctx)
+	if err != nil {
+		logging.V(100).Infof("Failed to open log stream of pod %s due to %s", builder.Definition.Name, err.Error())
+
+		return fmt.Errorf("failed to get log of pod %s: %w", builder.Definition.Name, err)
+	}
+
+	defer func() {
+		_ = logStream.Close()
+	}()
+
+	_, err = io.Copy(writer, logStream)
+	if err != nil {
+		logging.V(100).Infof("Failed to read log stream of pod %s due to %s", builder.Definition.Name, err.Error())
+
+		return fmt.Errorf("failed to read log of pod %s: %w", builder.Definition.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPodGetLogs(t *testing.T) {
+	testCases := []struct {
+		sinceTime time.Time
+		tailLines int64
+	}{
+		{sinceTime: time.Time{}, tailLines: 0},
+		{sinceTime: time.Now().Add(-time.Minute), tailLines: 10},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestPodBuilder(true)
+
+		logs, err := testBuilder.GetLogs("test-container", testCase.sinceTime, testCase.tailLines)
+		assert.Nil(t, err)
+		assert.Equal(t, "fake logs", logs)
+	}
+}
+
+func TestPodStreamLogs(t *testing.T) {
+	testBuilder := buildTestPodBuilder(true)
+
+	err := testBuilder.StreamLogs(context.TODO(), nil, "test-container")
+	assert.EqualError(t, err, "cannot stream log of pod test-pod to nil writer")
+
+	logBuffer := &bytes.Buffer{}
+
+	err = testBuilder.StreamLogs(context.TODO(), logBuffer, "test-container")
+	assert.Nil(t, err)
+	assert.Equal(t, "fake logs", logBuffer.String())
+}
+
+func TestPodDumpLogs(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pod-one", Namespace: "test-namespace", Labels: map[string]string{"app": "test"}},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init"}},
+				Containers:     []corev1.Container{{Name: "main"}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pod-two", Namespace: "test-namespace", Labels: map[string]string{"app": "other"}},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}},
+		},
+	}})
+
+	err := DumpLogs(testSettings, "test-namespace", "app=test", "")
+	assert.EqualError(t, err, "pod logs directory cannot be empty")
+
+	logDir := filepath.Join(t.TempDir(), "logs")
+
+	err = DumpLogs(testSettings, "test-namespace", "app=test", logDir)
+	assert.Nil(t, err)
+
+	entries, err := os.ReadDir(logDir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+
+	content, err := os.ReadFile(filepath.Join(logDir, "pod-one_main.log"))
+	assert.Nil(t, err)
+	assert.Equal(t, "fake logs", string(content))
+
+	_, err = os.Stat(filepath.Join(logDir, "pod-one_init.log"))
+	assert.Nil(t, err)
+}
@@ -3,7 +3,7 @@ package pod
 import (
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 )
 
@@ -87,7 +87,7 @@ func StaticIPBondAnnotationWithInterface(
 // StaticIPMultiNetDualStackAnnotation defines network annotation for multiple interfaces with dual stack addresses.
 func StaticIPMultiNetDualStackAnnotation(sriovNets, ipAddr []string) ([]*multus.NetworkSelectionElement, error) {
 	if len(sriovNets) == 0 {
-		glog.V(100).Infof("sriovNets cannot be empty")
+		logging.V(100).Infof("sriovNets cannot be empty")
 
 		return nil, fmt.Errorf("sriovNets []string cannot be empty")
 	}
@@ -96,7 +96,7 @@ func StaticIPMultiNetDualStackAnnotation(sriovNets, ipAddr []string) ([]*multus.
 
 	// Verify ipAddr has an even number of IP addresses and not empty.
 	if len(ipAddr) == 0 || len(ipAddr)%2 != 0 {
-		glog.V(100).Infof("ipAddr needs to contain an even number of IP addresses")
+		logging.V(100).Infof("ipAddr needs to contain an even number of IP addresses")
 
 		return nil, fmt.Errorf("ipAddr []string cannot be empy or an odd number")
 	}
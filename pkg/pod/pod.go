@@ -4,23 +4,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
-	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/utils/ptr"
 
@@ -35,7 +33,7 @@ type Builder struct {
 	// Created pod object.
 	Object *corev1.Pod
 	// Used to store latest error message upon defining or mutating pod definition.
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 }
@@ -45,7 +43,7 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new pod structure with the following params: "+
 			"name: %s, namespace: %s, image: %s",
 		name, nsname, image)
@@ -56,29 +54,29 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the pod is empty")
+		logging.V(100).Infof("The name of the pod is empty")
 
-		builder.errorMsg = "pod's name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("pod's name is empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the pod is empty")
+		logging.V(100).Infof("The namespace of the pod is empty")
 
-		builder.errorMsg = "namespace's name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("namespace's name is empty"))
 	}
 
 	if image == "" {
-		glog.V(100).Infof("The image of the pod is empty")
+		logging.V(100).Infof("The image of the pod is empty")
 
-		builder.errorMsg = "pod's image is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("pod's image is empty"))
 	}
 
 	defaultContainer, err := NewContainerBuilder("test", image, []string{"/bin/bash", "-c", "sleep INF"}).GetContainerCfg()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to define the default container settings")
+		logging.V(100).Infof("Failed to define the default container settings")
 
-		builder.errorMsg = err.Error()
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
 	}
 
 	builder.Definition.Spec.Containers = append(builder.Definition.Spec.Containers, *defaultContainer)
@@ -88,7 +86,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 
 // Pull loads an existing pod into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing pod name: %s namespace:%s", name, nsname)
+	logging.V(100).Infof("Pulling existing pod name: %s namespace:%s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -101,23 +99,23 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the pod is empty")
+		logging.V(100).Infof("The name of the pod is empty")
 
-		builder.errorMsg = "pod 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("pod 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the pod is empty")
+		logging.V(100).Infof("The namespace of the pod is empty")
 
-		builder.errorMsg = "pod 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("pod 'namespace' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return nil, fmt.Errorf("faield to pull pod object due to the following error: %s", builder.errorMsg)
 	}
 
 	if !builder.Exists() {
-		glog.V(100).Infof("Failed to pull pod object %s from namespace %s. Object doesn't exist",
+		logging.V(100).Infof("Failed to pull pod object %s from namespace %s. Object doesn't exist",
 			name, nsname)
 
 		return nil, fmt.Errorf("pod object %s doesn't exist in namespace %s", name, nsname)
@@ -134,23 +132,23 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding nodeName %s to the definition of pod %s in namespace %s",
+	logging.V(100).Infof("Adding nodeName %s to the definition of pod %s in namespace %s",
 		nodeName, builder.Definition.Name, builder.Definition.Namespace)
 
 	if builder.Object != nil {
-		glog.V(100).Infof("The pod is already running on node %s", builder.Object.Spec.NodeName)
+		logging.V(100).Infof("The pod is already running on node %s", builder.Object.Spec.NodeName)
 
-		builder.errorMsg = fmt.Sprintf(
-			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName))
 	}
 
 	if nodeName == "" {
-		glog.V(100).Infof("The node name is empty")
+		logging.V(100).Infof("The node name is empty")
 
-		builder.errorMsg = "can not define pod on empty node"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not define pod on empty node"))
 	}
 
-	if builder.errorMsg == "" {
+	if builder.errorMsg == nil {
 		builder.Definition.Spec.NodeName = nodeName
 	}
 
@@ -163,7 +161,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating pod %s in namespace %s",
+	logging.V(100).Infof("Creating pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -181,7 +179,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting pod %s in namespace %s",
+	logging.V(100).Infof("Deleting pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -206,7 +204,7 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting pod %s in namespace %s and waiting for the defined period until it's removed",
+	logging.V(100).Infof("Deleting pod %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder, err := builder.Delete()
@@ -229,7 +227,7 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating pod %s in namespace %s and waiting for the defined period until it's ready",
+	logging.V(100).Infof("Creating pod %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder, err := builder.Create()
@@ -252,7 +250,7 @@ func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is running",
+	logging.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is running",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	return builder.WaitUntilInStatus(corev1.PodRunning, timeout)
@@ -264,7 +262,7 @@ func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.D
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has status %v",
+	logging.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has status %v",
 		builder.Definition.Name, builder.Definition.Namespace, status)
 
 	return wait.PollUntilContextTimeout(
@@ -285,7 +283,7 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is deleted",
+	logging.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is deleted",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := wait.PollUntilContextTimeout(
@@ -293,18 +291,18 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 			_, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
 				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
 			if err == nil {
-				glog.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
+				logging.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
 
 				return false, nil
 			}
 
 			if k8serrors.IsNotFound(err) {
-				glog.V(100).Infof("pod %s/%s is gone", builder.Definition.Namespace, builder.Definition.Name)
+				logging.V(100).Infof("pod %s/%s is gone", builder.Definition.Namespace, builder.Definition.Name)
 
 				return true, nil
 			}
 
-			glog.V(100).Infof("failed to get pod %s/%s: %v", builder.Definition.Namespace, builder.Definition.Name, err)
+			logging.V(100).Infof("failed to get pod %s/%s: %v", builder.Definition.Namespace, builder.Definition.Name, err)
 
 			return false, err
 		})
@@ -318,7 +316,7 @@ func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is Ready",
+	logging.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is Ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	return builder.WaitUntilCondition(corev1.PodReady, timeout)
@@ -330,7 +328,7 @@ func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, ti
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has condition %v",
+	logging.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
 	return wait.PollUntilContextTimeout(
@@ -368,7 +366,7 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		cName = builder.Definition.Spec.Containers[0].Name
 	}
 
-	glog.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s",
+	logging.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s",
 		command, builder.Object.Name, cName, builder.Object.Namespace)
 
 	req := builder.apiClient.CoreV1Interface.RESTClient().
@@ -413,7 +411,7 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 		return bytes.Buffer{}, err
 	}
 
-	glog.V(100).Infof("Copying %s from %s in the pod",
+	logging.V(100).Infof("Copying %s from %s in the pod",
 		path, containerName)
 
 	var command []string
@@ -448,31 +446,7 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 			TTY:       false,
 		}, scheme.ParameterCodec)
 
-	tlsConfig, err := rest.TLSConfigFor(builder.apiClient.Config)
-	if err != nil {
-		return bytes.Buffer{}, err
-	}
-
-	proxy := http.ProxyFromEnvironment
-	if builder.apiClient.Config.Proxy != nil {
-		proxy = builder.apiClient.Config.Proxy
-	}
-
-	// More verbose setup of remotecommand executor required in order to tweak PingPeriod.
-	// By default many large files are not copied in their entirety without disabling PingPeriod during the copy.
-	// https://github.com/kubernetes/kubernetes/issues/60140#issuecomment-1411477275
-	upgradeRoundTripper := spdy.NewRoundTripperWithConfig(spdy.RoundTripperConfig{
-		TLS:        tlsConfig,
-		Proxier:    proxy,
-		PingPeriod: 0,
-	})
-
-	wrapper, err := rest.HTTPWrappersForConfig(builder.apiClient.Config, upgradeRoundTripper)
-	if err != nil {
-		return bytes.Buffer{}, err
-	}
-
-	exec, err := remotecommand.NewSPDYExecutorForTransports(wrapper, upgradeRoundTripper, "POST", req.URL())
+	exec, err := newSPDYExecutor(builder.apiClient.Config, req.URL())
 
 	if err != nil {
 		return buffer, err
@@ -498,7 +472,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if pod %s exists in namespace %s",
+	logging.V(100).Infof("Checking if pod %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -514,11 +488,11 @@ func (builder *Builder) RedefineDefaultCMD(command []string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining default pod's container cmd with the new %v", command)
+	logging.V(100).Infof("Redefining default pod's container cmd with the new %v", command)
 
 	builder.isMutationAllowed("cmd")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -533,19 +507,19 @@ func (builder *Builder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *B
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining pod's RestartPolicy to %v", restartPolicy)
+	logging.V(100).Infof("Redefining pod's RestartPolicy to %v", restartPolicy)
 
 	builder.isMutationAllowed("RestartPolicy")
 
 	if restartPolicy == "" {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to set RestartPolicy on pod %s in namespace %s. RestartPolicy can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "can not define pod with empty restart policy"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not define pod with empty restart policy"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -560,11 +534,11 @@ func (builder *Builder) WithTolerationToMaster() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Appending pod's %s with toleration to master node", builder.Definition.Name)
+	logging.V(100).Infof("Appending pod's %s with toleration to master node", builder.Definition.Name)
 
 	builder.isMutationAllowed("toleration to master node")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -584,11 +558,11 @@ func (builder *Builder) WithTolerationToControlPlane() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Appending pod's %s with toleration to control plane node", builder.Definition.Name)
+	logging.V(100).Infof("Appending pod's %s with toleration to control plane node", builder.Definition.Name)
 
 	builder.isMutationAllowed("toleration to control plane node")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -608,11 +582,11 @@ func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Updating pod %s with toleration %v", builder.Definition.Name, toleration)
+	logging.V(100).Infof("Updating pod %s with toleration %v", builder.Definition.Name, toleration)
 
 	builder.isMutationAllowed("custom toleration")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -627,20 +601,20 @@ func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builde
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining pod %s in namespace %s with nodeSelector %v",
+	logging.V(100).Infof("Redefining pod %s in namespace %s with nodeSelector %v",
 		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
 
 	builder.isMutationAllowed("nodeSelector")
 
 	if len(nodeSelector) == 0 {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to set nodeSelector on pod %s in namespace %s. nodeSelector can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "can not define pod with empty nodeSelector"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not define pod with empty nodeSelector"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -655,11 +629,11 @@ func (builder *Builder) WithPrivilegedFlag() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying privileged flag to all pod's: %s containers", builder.Definition.Name)
+	logging.V(100).Infof("Applying privileged flag to all pod's: %s containers", builder.Definition.Name)
 
 	builder.isMutationAllowed("privileged container flag")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -679,16 +653,16 @@ func (builder *Builder) WithVolume(volume corev1.Volume) *Builder {
 	}
 
 	if volume.Name == "" {
-		glog.V(100).Infof("The volume's Name cannot be empty")
+		logging.V(100).Infof("The volume's Name cannot be empty")
 
-		builder.errorMsg = "The volume's Name cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The volume's Name cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding volume %s to pod %s in namespace %s",
+	logging.V(100).Infof("Adding volume %s to pod %s in namespace %s",
 		volume.Name, builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Definition.Spec.Volumes = append(builder.Definition.Spec.Volumes, volume)
@@ -702,28 +676,28 @@ func (builder *Builder) WithLocalVolume(volumeName, mountPath string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Configuring volume %s for all pod's: %s containers. MountPath %s",
+	logging.V(100).Infof("Configuring volume %s for all pod's: %s containers. MountPath %s",
 		volumeName, builder.Definition.Name, mountPath)
 
 	builder.isMutationAllowed("LocalVolume")
 
 	if volumeName == "" {
-		glog.V(100).Infof("The 'volumeName' of the pod is empty")
+		logging.V(100).Infof("The 'volumeName' of the pod is empty")
 
-		builder.errorMsg = "'volumeName' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'volumeName' parameter is empty"))
 	}
 
 	if mountPath == "" {
-		glog.V(100).Infof("The 'mountPath' of the pod is empty")
+		logging.V(100).Infof("The 'mountPath' of the pod is empty")
 
-		builder.errorMsg = "'mountPath' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'mountPath' parameter is empty"))
 	}
 
 	mountConfig := corev1.VolumeMount{Name: volumeName, MountPath: mountPath, ReadOnly: false}
 
 	builder.isMountAlreadyInUseInPod(mountConfig)
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -757,14 +731,14 @@ func (builder *Builder) WithAdditionalContainer(container *corev1.Container) *Bu
 		return builder
 	}
 
-	glog.V(100).Infof("Adding new container %v to pod %s", container, builder.Definition.Name)
+	logging.V(100).Infof("Adding new container %v to pod %s", container, builder.Definition.Name)
 	builder.isMutationAllowed("additional container")
 
 	if container == nil {
-		builder.errorMsg = "'container' parameter cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'container' parameter cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -779,17 +753,17 @@ func (builder *Builder) WithAdditionalInitContainer(container *corev1.Container)
 		return builder
 	}
 
-	glog.V(100).Infof("Adding new container %v to pod %s in namespace %s",
+	logging.V(100).Infof("Adding new container %v to pod %s in namespace %s",
 		container, builder.Definition.Name, builder.Definition.Namespace)
 	builder.isMutationAllowed("additional container")
 
 	if container == nil {
-		glog.V(100).Infof("The 'container' parameter of the pod is empty")
+		logging.V(100).Infof("The 'container' parameter of the pod is empty")
 
-		builder.errorMsg = "'container' parameter cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'container' parameter cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -804,21 +778,22 @@ func (builder *Builder) WithSecondaryNetwork(network []*multus.NetworkSelectionE
 		return builder
 	}
 
-	glog.V(100).Infof("Applying secondary network %v to pod %s", network, builder.Definition.Name)
+	logging.V(100).Infof("Applying secondary network %v to pod %s", network, builder.Definition.Name)
 
 	builder.isMutationAllowed("secondary network")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
 	netAnnotation, err := json.Marshal(network)
 
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error to unmarshal network annotation due to: %s", err.Error())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"error to unmarshal network annotation due to: %s", err.Error()))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -833,11 +808,11 @@ func (builder *Builder) WithHostNetwork() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying HostNetwork flag to pod's %s configuration", builder.Definition.Name)
+	logging.V(100).Infof("Applying HostNetwork flag to pod's %s configuration", builder.Definition.Name)
 
 	builder.isMutationAllowed("HostNetwork")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -852,12 +827,12 @@ func (builder *Builder) WithHostPid(hostPid bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying HostPID flag to the configuration of pod: %s in namespace: %s",
+	logging.V(100).Infof("Applying HostPID flag to the configuration of pod: %s in namespace: %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.isMutationAllowed("HostPID")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -872,7 +847,7 @@ func (builder *Builder) RedefineDefaultContainer(container corev1.Container) *Bu
 		return builder
 	}
 
-	glog.V(100).Infof("Redefining default pod %s container in namespace %s using new container %v",
+	logging.V(100).Infof("Redefining default pod %s container in namespace %s using new container %v",
 		builder.Definition.Name, builder.Definition.Namespace, container)
 
 	builder.isMutationAllowed("default container")
@@ -888,7 +863,7 @@ func (builder *Builder) WithHugePages() *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Applying hugePages configuration to all containers in pod: %s", builder.Definition.Name)
+	logging.V(100).Infof("Applying hugePages configuration to all containers in pod: %s", builder.Definition.Name)
 
 	builder.isMutationAllowed("hugepages")
 
@@ -927,16 +902,16 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 		return builder
 	}
 
-	glog.V(100).Infof("Applying SecurityContext configuration on pod %s in namespace %s",
+	logging.V(100).Infof("Applying SecurityContext configuration on pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if securityContext == nil {
-		glog.V(100).Infof("The 'securityContext' of the pod is empty")
+		logging.V(100).Infof("The 'securityContext' of the pod is empty")
 
-		builder.errorMsg = "'securityContext' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'securityContext' parameter is empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -953,7 +928,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		return err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling container image %s to node: %s", builder.Definition.Spec.Containers[0].Image,
 		builder.Definition.Spec.NodeName)
 
@@ -962,7 +937,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 	_, err := builder.Create()
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to create pod %s in namespace %s and pull image %s to node: %s",
 			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
 			builder.Definition.Spec.NodeName)
@@ -973,7 +948,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 	statusErr := builder.WaitUntilInStatus(corev1.PodSucceeded, timeout)
 
 	if statusErr != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Pod status timeout %s. Pod is not in status Succeeded in namespace %s. "+
 				"Fail to confirm that image %s was pulled to node: %s",
 			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
@@ -982,7 +957,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		_, err = builder.Delete()
 
 		if err != nil {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				"Failed to remove pod %s in namespace %s from node: %s",
 				builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.NodeName)
 
@@ -1003,15 +978,15 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof(fmt.Sprintf("Defining pod's label to %s:%s", labelKey, labelValue))
+	logging.V(100).Infof(fmt.Sprintf("Defining pod's label to %s:%s", labelKey, labelValue))
 
 	builder.isMutationAllowed("Labels")
 
 	if labelKey == "" {
-		builder.errorMsg = "can not apply empty labelKey"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not apply empty labelKey"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -1027,18 +1002,18 @@ func (builder *Builder) WithLabels(labels map[string]string) *Builder {
 	}
 
 	if len(labels) == 0 {
-		builder.errorMsg = "can not apply empty set of labels to pod's definition"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("can not apply empty set of labels to pod's definition"))
 
 		return builder
 	}
 
 	builder.isMutationAllowed("Labels")
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
-	glog.V(100).Infof(fmt.Sprintf("Defining pod labels: %q", labels))
+	logging.V(100).Infof(fmt.Sprintf("Defining pod labels: %q", labels))
 
 	builder.Definition.Labels = labels
 
@@ -1051,16 +1026,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting pod additional options")
+	logging.V(100).Infof("Setting pod additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -1131,6 +1106,11 @@ func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
 }
 
+// GetGVR returns the pod GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
 func getDefinition(name, nsName string) *corev1.Pod {
 	return &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1146,12 +1126,12 @@ func (builder *Builder) isMutationAllowed(configToMutate string) {
 	_, _ = builder.validate()
 
 	if builder.Object != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to redefine %s for running pod %s in namespace %s",
 			builder.Definition.Name, configToMutate, builder.Definition.Namespace)
 
-		builder.errorMsg = fmt.Sprintf(
-			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName))
 	}
 }
 
@@ -1160,8 +1140,8 @@ func (builder *Builder) isMountAlreadyInUseInPod(newMount corev1.VolumeMount) {
 		for index := range builder.Definition.Spec.Containers {
 			if builder.Definition.Spec.Containers[index].VolumeMounts != nil {
 				if isMountInUse(builder.Definition.Spec.Containers[index].VolumeMounts, newMount) {
-					builder.errorMsg = fmt.Sprintf("given mount %v already mounted to pod's container %s",
-						newMount.Name, builder.Definition.Spec.Containers[index].Name)
+					builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("given mount %v already mounted to pod's container %s",
+						newMount.Name, builder.Definition.Spec.Containers[index].Name))
 				}
 			}
 		}
@@ -1184,27 +1164,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Pod"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
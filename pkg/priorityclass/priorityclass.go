@@ -0,0 +1,405 @@
+package priorityclass
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// HighestUserDefinablePriority is the highest value a user-defined priorityclass may have. Higher values are
+	// reserved for the system priorityclasses.
+	HighestUserDefinablePriority int32 = 1000000000
+	// systemPriorityClassPrefix is the name prefix reserved for the system priorityclasses.
+	systemPriorityClassPrefix = "system-"
+)
+
+// Builder provides struct for priorityclass object containing connection to the cluster and the priorityclass
+// definitions.
+type Builder struct {
+	// PriorityClass definition. Used to create the priorityclass object.
+	Definition *schedulingv1.PriorityClass
+	// Created priorityclass object.
+	Object *schedulingv1.PriorityClass
+	// Used in functions that define or mutate priorityclass definition. errorMsg is processed before the
+	// priorityclass object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// AdditionalOptions additional options for priorityclass object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder. The value must not be greater than HighestUserDefinablePriority and
+// the name must not use the prefix reserved for system priorityclasses.
+func NewBuilder(apiClient *clients.Settings, name string, value int32) *Builder {
+	logging.V(100).Infof(
+		"Initializing new priorityclass structure with the following params: name: %s, value: %d", name, value)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Value: value,
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the priorityclass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("priorityclass 'name' cannot be empty"))
+	}
+
+	if strings.HasPrefix(name, systemPriorityClassPrefix) {
+		logging.V(100).Infof("The name of the priorityclass uses the reserved system prefix")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("priorityclass 'name' cannot start with reserved prefix %s", systemPriorityClassPrefix))
+	}
+
+	if value > HighestUserDefinablePriority {
+		logging.V(100).Infof("The value of the priorityclass is reserved for system priorityclasses")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("priorityclass 'value' cannot be greater than %d", HighestUserDefinablePriority))
+	}
+
+	return &builder
+}
+
+// Pull loads an existing priorityclass into Builder struct.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing priorityclass name: %s", name)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("priorityclass 'name' cannot be empty"))
+
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("priorityclass object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithPreemptionPolicy sets whether pods of the priorityclass may preempt pods of lower priority. The policy must be
+// PreemptLowerPriority or Never.
+func (builder *Builder) WithPreemptionPolicy(policy corev1.PreemptionPolicy) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting preemptionPolicy %s in priorityclass %s", policy, builder.Definition.Name)
+
+	if policy != corev1.PreemptLowerPriority && policy != corev1.PreemptNever {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"priorityclass 'preemptionPolicy' %s is not supported, must be %s or %s",
+			policy, corev1.PreemptLowerPriority, corev1.PreemptNever))
+
+		return builder
+	}
+
+	builder.Definition.PreemptionPolicy = &policy
+
+	return builder
+}
+
+// WithGlobalDefault makes the priorityclass the default one for pods without a priorityClassName. Only one
+// priorityclass in the cluster may be the global default, which is checked when it is created or updated.
+func (builder *Builder) WithGlobalDefault() *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting globalDefault in priorityclass %s", builder.Definition.Name)
+
+	builder.Definition.GlobalDefault = true
+
+	return builder
+}
+
+// WithDescription sets the description of when the priorityclass should be used.
+func (builder *Builder) WithDescription(description string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting description in priorityclass %s", builder.Definition.Name)
+
+	builder.Definition.Description = description
+
+	return builder
+}
+
+// WithOptions creates priorityclass with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting priorityclass additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a priorityclass in cluster and stores the created object in struct. It fails if the
+// priorityclass is a global default while another priorityclass already is.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating priorityclass %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	err := builder.checkGlobalDefault()
+	if err != nil {
+		return builder, err
+	}
+
+	if builder.dryRun {
+		return builder, builder.CreateDryRun()
+	}
+
+	builder.Object, err = builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{})
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting dry-run to %t for priorityclass %s", dryRun, builder.Definition.Name)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the priorityclass definition against the API server and its admission webhooks with a server-side
+// dry-run request. The priorityclass is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Creating the priorityclass %s in dry-run mode", builder.Definition.Name)
+
+	_, err := builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the existing priorityclass object with the priorityclass definition in builder. The value and
+// preemptionPolicy of a priorityclass are immutable.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating priorityclass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent priorityclass %s", builder.Definition.Name)
+	}
+
+	err := builder.checkGlobalDefault()
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	builder.Object, err = builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Exists checks whether the given priorityclass exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if priorityclass %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the priorityclass from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting priorityclass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetGVR returns priorityclass's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}
+}
+
+// GetGVR returns the priorityclass GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// checkGlobalDefault returns an error if the definition is a global default and another priorityclass in the
+// cluster already is one, since the API server does not allow more than one.
+func (builder *Builder) checkGlobalDefault() error {
+	if !builder.Definition.GlobalDefault {
+		return nil
+	}
+
+	priorityClasses, err := builder.apiClient.K8sClient.SchedulingV1().PriorityClasses().List(
+		context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list priorityclasses: %w", err)
+	}
+
+	for _, priorityClass := range priorityClasses.Items {
+		if priorityClass.GlobalDefault && priorityClass.Name != builder.Definition.Name {
+			logging.V(100).Infof("The priorityclass %s is already the global default", priorityClass.Name)
+
+			return fmt.Errorf("priorityclass %s cannot be the global default since %s already is",
+				builder.Definition.Name, priorityClass.Name)
+		}
+	}
+
+	return nil
+}
+
+// Diff compares the priorityclass definition with the priorityclass object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing priorityclass %s with its definition", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"priorityclass object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "PriorityClass"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
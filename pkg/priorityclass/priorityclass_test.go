@@ -0,0 +1,245 @@
+package priorityclass
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultPriorityClassName = "test-priority"
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         int32
+		expectedError string
+	}{
+		{
+			name:          defaultPriorityClassName,
+			value:         1000,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			value:         1000,
+			expectedError: "priorityclass 'name' cannot be empty",
+		},
+		{
+			name:          "system-test",
+			value:         1000,
+			expectedError: "priorityclass 'name' cannot start with reserved prefix system-",
+		},
+		{
+			name:          defaultPriorityClassName,
+			value:         HighestUserDefinablePriority + 1,
+			expectedError: "priorityclass 'value' cannot be greater than 1000000000",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.value)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.value, testBuilder.Definition.Value)
+		}
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			name:          defaultPriorityClassName,
+			addToRuntime:  true,
+			expectedError: "",
+		},
+		{
+			name:          defaultPriorityClassName,
+			addToRuntime:  false,
+			expectedError: "priorityclass object test-priority doesn't exist",
+		},
+		{
+			name:          "",
+			addToRuntime:  false,
+			expectedError: "priorityclass 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyPriorityClass(defaultPriorityClassName, false))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := Pull(testSettings, testCase.name)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestWithPreemptionPolicy(t *testing.T) {
+	testCases := []struct {
+		policy        corev1.PreemptionPolicy
+		expectedError string
+	}{
+		{
+			policy:        corev1.PreemptNever,
+			expectedError: "",
+		},
+		{
+			policy:        corev1.PreemptLowerPriority,
+			expectedError: "",
+		},
+		{
+			policy:        "Always",
+			expectedError: "priorityclass 'preemptionPolicy' Always is not supported, must be PreemptLowerPriority or Never",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPriorityClassBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithPreemptionPolicy(testCase.policy)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.policy, *testBuilder.Definition.PreemptionPolicy)
+	}
+}
+
+func TestCreateGlobalDefault(t *testing.T) {
+	testCases := []struct {
+		existingDefault string
+		expectedError   string
+	}{
+		{
+			existingDefault: "",
+			expectedError:   "",
+		},
+		{
+			existingDefault: defaultPriorityClassName,
+			expectedError:   "",
+		},
+		{
+			existingDefault: "other-default",
+			expectedError:   "priorityclass test-priority cannot be the global default since other-default already is",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.existingDefault != "" {
+			runtimeObjects = append(runtimeObjects, buildDummyPriorityClass(testCase.existingDefault, true))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := buildValidPriorityClassBuilder(testSettings).
+			WithGlobalDefault().
+			WithDescription("default priority").
+			Create()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.False(t, testBuilder.Exists())
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.True(t, testBuilder.Object.GlobalDefault)
+	}
+}
+
+func TestUpdateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := buildValidPriorityClassBuilder(testSettings).Update()
+	assert.EqualError(t, err, "cannot update non-existent priorityclass test-priority")
+
+	testBuilder, err := buildValidPriorityClassBuilder(testSettings).Create()
+	assert.Nil(t, err)
+
+	testBuilder, err = testBuilder.WithDescription("updated").Update()
+	assert.Nil(t, err)
+	assert.Equal(t, "updated", testBuilder.Object.Description)
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func buildValidPriorityClassBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultPriorityClassName, 1000)
+}
+
+func buildDummyPriorityClass(name string, globalDefault bool) *schedulingv1.PriorityClass {
+	return &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Value:         1000,
+		GlobalDefault: globalDefault,
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidPriorityClassBuilder(testSettings)
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("priorityclass object %s doesn't exist", testBuilder.Definition.Name))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "priorityclasses", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidPriorityClassBuilder(testSettings).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidPriorityClassBuilder(existingSettings).
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
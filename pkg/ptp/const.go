@@ -0,0 +1,10 @@
+package ptp
+
+const (
+	// PtpNamespace represents the namespace the ptp operator and its PtpConfig and NodePtpDevice objects live in.
+	PtpNamespace = "openshift-ptp"
+	// PtpOperatorConfigName represents the name of the PtpOperatorConfig created by the operator.
+	PtpOperatorConfigName = "default"
+	// E810PluginName represents the name of the plugin configuring Intel E810 Westport Channel NICs.
+	E810PluginName = "e810"
+)
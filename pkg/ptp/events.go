@@ -0,0 +1,378 @@
+package ptp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// EventResource is the suffix of the address of a PTP fast event resource, following the node part of the address.
+type EventResource string
+
+const (
+	// EventResourceLockState is the resource of the PTP lock state of the node.
+	EventResourceLockState EventResource = "/sync/ptp-status/lock-state"
+	// EventResourceClockClass is the resource of the PTP clock class of the node.
+	EventResourceClockClass EventResource = "/sync/ptp-status/clock-class"
+	// EventResourceOsClockSyncState is the resource of the synchronization state of the system clock of the node.
+	EventResourceOsClockSyncState EventResource = "/sync/sync-status/os-clock-sync-state"
+	// EventResourceSyncState is the resource of the overall synchronization state of the node.
+	EventResourceSyncState EventResource = "/sync/sync-status/sync-state"
+	// EventResourceGnssSyncStatus is the resource of the GNSS synchronization state of a grandmaster node.
+	EventResourceGnssSyncStatus EventResource = "/sync/gnss-status/gnss-sync-status"
+)
+
+const (
+	// EventSyncStateLocked is the state of a clock synchronized to its source.
+	EventSyncStateLocked = "LOCKED"
+	// EventSyncStateHoldover is the state of a clock which lost its source and is within its holdover period.
+	EventSyncStateHoldover = "HOLDOVER"
+	// EventSyncStateFreerun is the state of a clock not synchronized to any source.
+	EventSyncStateFreerun = "FREERUN"
+	// EventAPIBaseURL is the base URL of the REST API of the cloud-event-proxy sidecar, as seen from the containers
+	// of the consumer pod.
+	EventAPIBaseURL = "http://localhost:9085/api/ocloudNotifications/v1"
+
+	eventSubscriptionsPath = "/subscriptions"
+)
+
+// EventSubscription is a subscription of a consumer to an event resource.
+type EventSubscription struct {
+	// ID of the subscription, assigned by the publisher.
+	ID string `json:"id,omitempty"`
+	// EndpointURI is the address the events are delivered to.
+	EndpointURI string `json:"endpointUri"`
+	// URILocation is the address of the subscription in the REST API.
+	URILocation string `json:"uriLocation,omitempty"`
+	// Resource is the address of the subscribed event resource.
+	Resource string `json:"resource"`
+}
+
+// EventDataValue is a value reported by an event.
+type EventDataValue struct {
+	// Resource is the address of the resource the value refers to.
+	Resource string `json:"resource"`
+	// DataType is either notification, for state values, or metric.
+	DataType string `json:"dataType"`
+	// ValueType is either enumeration, for state values, or decimal64.3.
+	ValueType string `json:"valueType"`
+	// Value is the state or measurement.
+	Value any `json:"value"`
+}
+
+// EventData is the payload of an event.
+type EventData struct {
+	// Version of the event data format.
+	Version string `json:"version"`
+	// Values reported by the event.
+	Values []EventDataValue `json:"values"`
+}
+
+// Event is a PTP fast event, following the cloud events format.
+type Event struct {
+	// ID of the event.
+	ID string `json:"id"`
+	// Type of the event, e.g. event.sync.ptp-status.ptp-state-change.
+	Type string `json:"type"`
+	// Source is the address of the resource which emitted the event.
+	Source string `json:"source"`
+	// DataContentType of the data.
+	DataContentType string `json:"dataContentType,omitempty"`
+	// Time the event was emitted at.
+	Time string `json:"time"`
+	// Data is the payload of the event.
+	Data EventData `json:"data"`
+}
+
+// GetState returns the state reported by the event, i.e. the value of its notification data, and whether it has one.
+func (event *Event) GetState() (string, bool) {
+	for _, value := range event.Data.Values {
+		if value.DataType == "notification" {
+			return fmt.Sprint(value.Value), true
+		}
+	}
+
+	return "", false
+}
+
+// GetEventResourceAddress returns the full address of the given event resource on the given node.
+func GetEventResourceAddress(nodeName string, resource EventResource) string {
+	return fmt.Sprintf("/cluster/node/%s%s", nodeName, resource)
+}
+
+// EventConsumer accesses the REST API of the cloud-event-proxy sidecar of a PTP fast events consumer pod.
+type EventConsumer struct {
+	consumerPod       *pod.Builder
+	sidecarContainer  string
+	consumerContainer string
+}
+
+// NewEventConsumer creates a new instance of EventConsumer. sidecarContainer is the container the REST API is reached
+// from and consumerContainer the container logging the received events.
+func NewEventConsumer(consumerPod *pod.Builder, sidecarContainer, consumerContainer string) (*EventConsumer, error) {
+	logging.V(100).Infof("Initializing new EventConsumer with sidecar container %s and consumer container %s",
+		sidecarContainer, consumerContainer)
+
+	if consumerPod == nil || consumerPod.Object == nil {
+		return nil, fmt.Errorf("EventConsumer 'consumerPod' must be an existing pod")
+	}
+
+	if sidecarContainer == "" {
+		return nil, fmt.Errorf("EventConsumer 'sidecarContainer' cannot be empty")
+	}
+
+	if consumerContainer == "" {
+		return nil, fmt.Errorf("EventConsumer 'consumerContainer' cannot be empty")
+	}
+
+	return &EventConsumer{
+		consumerPod:       consumerPod,
+		sidecarContainer:  sidecarContainer,
+		consumerContainer: consumerContainer,
+	}, nil
+}
+
+// CheckHealth returns an error if the REST API of the sidecar is not healthy.
+func (consumer *EventConsumer) CheckHealth() error {
+	logging.V(100).Infof("Checking health of the event API of pod %s", consumer.consumerPod.Object.Name)
+
+	_, err := consumer.request("GET", "/health", nil)
+
+	return err
+}
+
+// Subscribe subscribes the consumer to the given event resource, delivering the events to endpointURI.
+func (consumer *EventConsumer) Subscribe(resource, endpointURI string) (*EventSubscription, error) {
+	logging.V(100).Infof("Subscribing pod %s to event resource %s with endpoint %s",
+		consumer.consumerPod.Object.Name, resource, endpointURI)
+
+	if resource == "" {
+		return nil, fmt.Errorf("event subscription 'resource' cannot be empty")
+	}
+
+	if endpointURI == "" {
+		return nil, fmt.Errorf("event subscription 'endpointURI' cannot be empty")
+	}
+
+	output, err := consumer.request("POST", eventSubscriptionsPath,
+		&EventSubscription{Resource: resource, EndpointURI: endpointURI})
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &EventSubscription{}
+
+	err = json.Unmarshal(output, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event subscription %s: %w", string(output), err)
+	}
+
+	return subscription, nil
+}
+
+// ListSubscriptions returns the subscriptions of the consumer.
+func (consumer *EventConsumer) ListSubscriptions() ([]EventSubscription, error) {
+	logging.V(100).Infof("Listing event subscriptions of pod %s", consumer.consumerPod.Object.Name)
+
+	output, err := consumer.request("GET", eventSubscriptionsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []EventSubscription
+
+	err = json.Unmarshal(output, &subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event subscriptions %s: %w", string(output), err)
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteSubscriptions removes all the subscriptions of the consumer.
+func (consumer *EventConsumer) DeleteSubscriptions() error {
+	logging.V(100).Infof("Deleting event subscriptions of pod %s", consumer.consumerPod.Object.Name)
+
+	_, err := consumer.request("DELETE", eventSubscriptionsPath, nil)
+
+	return err
+}
+
+// GetCurrentState returns the event describing the current state of the given event resource.
+func (consumer *EventConsumer) GetCurrentState(resource string) (*Event, error) {
+	logging.V(100).Infof("Getting current state of event resource %s from pod %s",
+		resource, consumer.consumerPod.Object.Name)
+
+	if resource == "" {
+		return nil, fmt.Errorf("event 'resource' cannot be empty")
+	}
+
+	output, err := consumer.request("GET", resource+"/CurrentState", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{}
+
+	err = json.Unmarshal(output, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event %s: %w", string(output), err)
+	}
+
+	return event, nil
+}
+
+// WaitForState waits for the duration of the defined timeout or until the current state of the given event resource
+// is the expected one, e.g. EventSyncStateLocked.
+func (consumer *EventConsumer) WaitForState(resource, expectedState string, timeout time.Duration) error {
+	logging.V(100).Infof("Waiting for event resource %s to reach state %s", resource, expectedState)
+
+	var lastState string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 5*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			event, err := consumer.GetCurrentState(resource)
+			if err != nil {
+				logging.V(100).Infof("Failed to get current state of event resource %s: %v", resource, err)
+
+				return false, nil
+			}
+
+			lastState, _ = event.GetState()
+
+			return lastState == expectedState, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("event resource %s did not reach state %s, last state %q: %w",
+			resource, expectedState, lastState, err)
+	}
+
+	return nil
+}
+
+// GetReceivedEvents returns the events of the given type, or of any type when eventType is empty, the consumer
+// container logged during the given period.
+func (consumer *EventConsumer) GetReceivedEvents(eventType string, since time.Duration) ([]Event, error) {
+	logging.V(100).Infof("Getting events of type %q received by pod %s in the last %v",
+		eventType, consumer.consumerPod.Object.Name, since)
+
+	log, err := consumer.consumerPod.GetLog(since, consumer.consumerContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseEvents(log, eventType), nil
+}
+
+// WaitForEvent waits for the duration of the defined timeout or until the consumer receives an event of the given
+// type from the given resource reporting the expected state. Events received before the wait started are ignored.
+func (consumer *EventConsumer) WaitForEvent(eventType, resource, expectedState string, timeout time.Duration) error {
+	logging.V(100).Infof("Waiting for event of type %s from resource %s with state %s",
+		eventType, resource, expectedState)
+
+	startTime := time.Now()
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 5*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			events, err := consumer.GetReceivedEvents(eventType, time.Since(startTime)+time.Second)
+			if err != nil {
+				logging.V(100).Infof("Failed to get received events: %v", err)
+
+				return false, nil
+			}
+
+			for _, event := range events {
+				state, _ := event.GetState()
+				if strings.HasSuffix(event.Source, resource) && (expectedState == "" || state == expectedState) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		})
+
+	if err != nil {
+		return fmt.Errorf("no event of type %s from resource %s with state %q received: %w",
+			eventType, resource, expectedState, err)
+	}
+
+	return nil
+}
+
+// request sends a request to the REST API of the sidecar through curl and returns the response body.
+func (consumer *EventConsumer) request(method, path string, body any) ([]byte, error) {
+	command := []string{"curl", "-s", "-X", method, "-w", "\n%{http_code}"}
+
+	if body != nil {
+		rawBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal event API request body: %w", err)
+		}
+
+		command = append(command, "-H", "Content-Type: application/json", "-d", string(rawBody))
+	}
+
+	command = append(command, EventAPIBaseURL+path)
+
+	buffer, err := consumer.consumerPod.ExecCommand(command, consumer.sidecarContainer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s %s to the event API: %w", method, path, err)
+	}
+
+	return parseEventAPIResponse(method, path, buffer.String())
+}
+
+// parseEventAPIResponse splits the output of curl into the response body and the status written after it, returning
+// an error if the status is not 2xx.
+func parseEventAPIResponse(method, path, rawOutput string) ([]byte, error) {
+	output := strings.TrimSpace(strings.ReplaceAll(rawOutput, "\r", ""))
+	separator := strings.LastIndex(output, "\n")
+	responseBody, rawStatus := "", output
+
+	if separator >= 0 {
+		responseBody, rawStatus = output[:separator], output[separator+1:]
+	}
+
+	status, err := strconv.Atoi(rawStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse status of %s %s to the event API: %s", method, path, output)
+	}
+
+	if status < 200 || status >= 300 {
+		return nil, fmt.Errorf("%s %s to the event API returned status %d: %s", method, path, status, responseBody)
+	}
+
+	return []byte(responseBody), nil
+}
+
+// parseEvents returns the events of the given type, or of any type when eventType is empty, found in the log of the
+// consumer container. Each event is logged as JSON at the end of a line, lines without one are skipped.
+func parseEvents(log, eventType string) []Event {
+	var events []Event
+
+	for _, line := range strings.Split(log, "\n") {
+		start := strings.Index(line, "{")
+		if start < 0 {
+			continue
+		}
+
+		event := Event{}
+		if json.Unmarshal([]byte(line[start:]), &event) != nil || event.Type == "" {
+			continue
+		}
+
+		if eventType == "" || event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,237 @@
+package ptp
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultEventNodeName      = "worker-0"
+	defaultEventConsumerName  = "cloud-event-consumer"
+	defaultEventSidecarName   = "cloud-event-proxy-sidecar"
+	defaultEventLockStateType = "event.sync.ptp-status.ptp-state-change"
+)
+
+func TestGetEventResourceAddress(t *testing.T) {
+	testCases := []struct {
+		resource        EventResource
+		expectedAddress string
+	}{
+		{
+			resource:        EventResourceLockState,
+			expectedAddress: "/cluster/node/worker-0/sync/ptp-status/lock-state",
+		},
+		{
+			resource:        EventResourceOsClockSyncState,
+			expectedAddress: "/cluster/node/worker-0/sync/sync-status/os-clock-sync-state",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedAddress, GetEventResourceAddress(defaultEventNodeName, testCase.resource))
+	}
+}
+
+func TestEventGetState(t *testing.T) {
+	testCases := []struct {
+		values        []EventDataValue
+		expectedState string
+		expectedFound bool
+	}{
+		{
+			values: []EventDataValue{
+				{DataType: "notification", ValueType: "enumeration", Value: EventSyncStateLocked},
+				{DataType: "metric", ValueType: "decimal64.3", Value: -2.0},
+			},
+			expectedState: EventSyncStateLocked,
+			expectedFound: true,
+		},
+		{
+			values:        []EventDataValue{{DataType: "metric", ValueType: "decimal64.3", Value: 6.0}},
+			expectedState: "",
+			expectedFound: false,
+		},
+		{
+			values:        nil,
+			expectedState: "",
+			expectedFound: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		event := &Event{Data: EventData{Values: testCase.values}}
+
+		state, found := event.GetState()
+		assert.Equal(t, testCase.expectedState, state)
+		assert.Equal(t, testCase.expectedFound, found)
+	}
+}
+
+func TestNewEventConsumer(t *testing.T) {
+	testCases := []struct {
+		podExists         bool
+		sidecarContainer  string
+		consumerContainer string
+		expectedError     string
+	}{
+		{
+			podExists:         true,
+			sidecarContainer:  defaultEventSidecarName,
+			consumerContainer: defaultEventConsumerName,
+			expectedError:     "",
+		},
+		{
+			podExists:         false,
+			sidecarContainer:  defaultEventSidecarName,
+			consumerContainer: defaultEventConsumerName,
+			expectedError:     "EventConsumer 'consumerPod' must be an existing pod",
+		},
+		{
+			podExists:         true,
+			sidecarContainer:  "",
+			consumerContainer: defaultEventConsumerName,
+			expectedError:     "EventConsumer 'sidecarContainer' cannot be empty",
+		},
+		{
+			podExists:         true,
+			sidecarContainer:  defaultEventSidecarName,
+			consumerContainer: "",
+			expectedError:     "EventConsumer 'consumerContainer' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var consumerPod *pod.Builder
+
+		if testCase.podExists {
+			consumerPod = buildTestEventConsumerPod(t)
+		}
+
+		consumer, err := NewEventConsumer(consumerPod, testCase.sidecarContainer, testCase.consumerContainer)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, consumerPod, consumer.consumerPod)
+			assert.Equal(t, testCase.sidecarContainer, consumer.sidecarContainer)
+			assert.Equal(t, testCase.consumerContainer, consumer.consumerContainer)
+		}
+	}
+}
+
+func TestParseEventAPIResponse(t *testing.T) {
+	testCases := []struct {
+		output        string
+		expectedBody  string
+		expectedError string
+	}{
+		{
+			output:        "[{\"id\":\"1\",\"resource\":\"/cluster/node/worker-0/sync/ptp-status/lock-state\"}]\r\n200",
+			expectedBody:  "[{\"id\":\"1\",\"resource\":\"/cluster/node/worker-0/sync/ptp-status/lock-state\"}]",
+			expectedError: "",
+		},
+		{
+			output:        "\n204\n",
+			expectedBody:  "",
+			expectedError: "",
+		},
+		{
+			output:        "OK\n200",
+			expectedBody:  "OK",
+			expectedError: "",
+		},
+		{
+			output:        "subscription not found\n404",
+			expectedError: "GET /subscriptions to the event API returned status 404: subscription not found",
+		},
+		{
+			output:        "curl: (7) Failed to connect",
+			expectedError: "failed to parse status of GET /subscriptions to the event API: curl: (7) Failed to connect",
+		},
+	}
+
+	for _, testCase := range testCases {
+		body, err := parseEventAPIResponse("GET", eventSubscriptionsPath, testCase.output)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedBody, string(body))
+		}
+	}
+}
+
+func TestParseEvents(t *testing.T) {
+	lockStateEvent := `{"id":"1","type":"event.sync.ptp-status.ptp-state-change",` +
+		`"source":"/cluster/node/worker-0/sync/ptp-status/lock-state","time":"2024-05-01T10:00:00Z",` +
+		`"data":{"version":"1.0","values":[{"resource":"/cluster/node/worker-0/ens1f0",` +
+		`"dataType":"notification","valueType":"enumeration","value":"LOCKED"}]}}`
+	osClockEvent := `{"id":"2","type":"event.sync.sync-status.os-clock-sync-state-change",` +
+		`"source":"/cluster/node/worker-0/sync/sync-status/os-clock-sync-state","time":"2024-05-01T10:00:01Z",` +
+		`"data":{"version":"1.0","values":[{"resource":"/cluster/node/worker-0/CLOCK_REALTIME",` +
+		`"dataType":"notification","valueType":"enumeration","value":"FREERUN"}]}}`
+	log := "time=\"2024-05-01T10:00:00Z\" level=info msg=\"starting consumer\"\n" +
+		"time=\"2024-05-01T10:00:00Z\" level=info msg=\"received event " + lockStateEvent + "\n" +
+		"time=\"2024-05-01T10:00:00Z\" level=info msg=\"received event {malformed\"\n" +
+		"time=\"2024-05-01T10:00:01Z\" level=info msg=\"received event " + osClockEvent + "\n"
+
+	testCases := []struct {
+		eventType       string
+		expectedSources []string
+	}{
+		{
+			eventType: "",
+			expectedSources: []string{
+				GetEventResourceAddress(defaultEventNodeName, EventResourceLockState),
+				GetEventResourceAddress(defaultEventNodeName, EventResourceOsClockSyncState),
+			},
+		},
+		{
+			eventType:       defaultEventLockStateType,
+			expectedSources: []string{GetEventResourceAddress(defaultEventNodeName, EventResourceLockState)},
+		},
+		{
+			eventType:       "event.sync.gnss-status.gnss-state-change",
+			expectedSources: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var sources []string
+
+		for _, event := range parseEvents(log, testCase.eventType) {
+			sources = append(sources, event.Source)
+		}
+
+		assert.Equal(t, testCase.expectedSources, sources)
+	}
+
+	events := parseEvents(log, defaultEventLockStateType)
+	assert.Len(t, events, 1)
+
+	state, found := events[0].GetState()
+	assert.True(t, found)
+	assert.Equal(t, EventSyncStateLocked, state)
+}
+
+func buildTestEventConsumerPod(t *testing.T) *pod.Builder {
+	t.Helper()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cloud-consumer",
+				Namespace: "cloud-events",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: defaultEventConsumerName}, {Name: defaultEventSidecarName}},
+			},
+		}},
+	})
+
+	consumerPod, err := pod.Pull(testSettings, "cloud-consumer", "cloud-events")
+	assert.Nil(t, err)
+
+	return consumerPod
+}
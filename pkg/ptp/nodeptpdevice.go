@@ -0,0 +1,221 @@
+package ptp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodePtpDeviceBuilder provides struct for the NodePtpDevice object containing connection to the cluster and the
+// NodePtpDevice definitions. NodePtpDevices are created by the operator, one per node and named after it, and list
+// the PTP capable interfaces discovered on the node.
+type NodePtpDeviceBuilder struct {
+	// NodePtpDevice definition.
+	Definition *ptpv1.NodePtpDevice
+	// Found NodePtpDevice object.
+	Object    *ptpv1.NodePtpDevice
+	apiClient *clients.Settings
+}
+
+// PullNodePtpDevice pulls the NodePtpDevice of the given node from cluster.
+func PullNodePtpDevice(apiClient *clients.Settings, nodeName, nsname string) (*NodePtpDeviceBuilder, error) {
+	logging.V(100).Infof("Pulling existing NodePtpDevice of node %s under namespace %s from cluster", nodeName, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("NodePtpDevice 'apiClient' cannot be empty")
+	}
+
+	builder := NodePtpDeviceBuilder{
+		apiClient: apiClient,
+		Definition: &ptpv1.NodePtpDevice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nodeName,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if nodeName == "" {
+		logging.V(100).Infof("The nodeName of the NodePtpDevice is empty")
+
+		return nil, fmt.Errorf("NodePtpDevice 'nodeName' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the NodePtpDevice is empty")
+
+		return nil, fmt.Errorf("NodePtpDevice 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("NodePtpDevice object %s doesn't exist in namespace %s", nodeName, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// ListNodePtpDevices returns the NodePtpDevices of all nodes in the given namespace.
+func ListNodePtpDevices(
+	apiClient *clients.Settings, nsname string, options ...goclient.ListOptions) ([]*NodePtpDeviceBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("NodePtpDevice 'apiClient' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("NodePtpDevice 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list NodePtpDevices, 'nsname' parameter is empty")
+	}
+
+	passedOptions := goclient.ListOptions{}
+	logMessage := fmt.Sprintf("Listing NodePtpDevices in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	passedOptions.Namespace = nsname
+
+	logging.V(100).Infof(logMessage)
+
+	nodePtpDeviceList := &ptpv1.NodePtpDeviceList{}
+
+	err := apiClient.List(context.TODO(), nodePtpDeviceList, &passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list NodePtpDevices in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var nodePtpDeviceBuilders []*NodePtpDeviceBuilder
+
+	for _, nodePtpDevice := range nodePtpDeviceList.Items {
+		copiedNodePtpDevice := nodePtpDevice
+		nodePtpDeviceBuilders = append(nodePtpDeviceBuilders, &NodePtpDeviceBuilder{
+			apiClient:  apiClient,
+			Definition: &copiedNodePtpDevice,
+			Object:     &copiedNodePtpDevice,
+		})
+	}
+
+	return nodePtpDeviceBuilders, nil
+}
+
+// Get returns NodePtpDevice object if found.
+func (builder *NodePtpDeviceBuilder) Get() (*ptpv1.NodePtpDevice, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting NodePtpDevice object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	nodePtpDevice := &ptpv1.NodePtpDevice{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, nodePtpDevice)
+
+	if err != nil {
+		logging.V(100).Infof("NodePtpDevice object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return nodePtpDevice, nil
+}
+
+// Exists checks whether the given NodePtpDevice exists.
+func (builder *NodePtpDeviceBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if NodePtpDevice %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// GetDevices returns the PTP capable devices discovered on the node.
+func (builder *NodePtpDeviceBuilder) GetDevices() ([]ptpv1.PtpDevice, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting devices of NodePtpDevice %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("NodePtpDevice object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Devices, nil
+}
+
+// GetInterfaceNames returns the names of the PTP capable interfaces discovered on the node.
+func (builder *NodePtpDeviceBuilder) GetInterfaceNames() ([]string, error) {
+	devices, err := builder.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	var interfaceNames []string
+
+	for _, device := range devices {
+		interfaceNames = append(interfaceNames, device.Name)
+	}
+
+	return interfaceNames, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NodePtpDeviceBuilder) validate() (bool, error) {
+	resourceCRD := "NodePtpDevice"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,213 @@
+package ptp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultNodePtpDeviceName = "worker-0"
+
+func TestPullNodePtpDevice(t *testing.T) {
+	testCases := []struct {
+		nodeName            string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			nodeName:            defaultNodePtpDeviceName,
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			nodeName:            "",
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "NodePtpDevice 'nodeName' cannot be empty",
+		},
+		{
+			nodeName:            defaultNodePtpDeviceName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "NodePtpDevice 'namespace' cannot be empty",
+		},
+		{
+			nodeName:            defaultNodePtpDeviceName,
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Sprintf(
+				"NodePtpDevice object %s doesn't exist in namespace %s", defaultNodePtpDeviceName, PtpNamespace),
+		},
+		{
+			nodeName:            defaultNodePtpDeviceName,
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "NodePtpDevice 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyNodePtpDevice(defaultNodePtpDeviceName, "ens1f0", "ens1f1"))
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullNodePtpDevice(testSettings, testCase.nodeName, testCase.namespace)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.nodeName, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestListNodePtpDevices(t *testing.T) {
+	testCases := []struct {
+		namespace     string
+		options       []goclient.ListOptions
+		client        bool
+		expectedCount int
+		expectedError string
+	}{
+		{
+			namespace:     PtpNamespace,
+			client:        true,
+			expectedCount: 2,
+			expectedError: "",
+		},
+		{
+			namespace:     "default",
+			client:        true,
+			expectedCount: 0,
+			expectedError: "",
+		},
+		{
+			namespace:     "",
+			client:        true,
+			expectedError: "failed to list NodePtpDevices, 'nsname' parameter is empty",
+		},
+		{
+			namespace:     PtpNamespace,
+			options:       []goclient.ListOptions{{}, {}},
+			client:        true,
+			expectedError: "error: more than one ListOptions was passed",
+		},
+		{
+			namespace:     PtpNamespace,
+			client:        false,
+			expectedError: "NodePtpDevice 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{
+					buildDummyNodePtpDevice("worker-0", "ens1f0"),
+					buildDummyNodePtpDevice("worker-1", "ens2f0"),
+				},
+			})
+		}
+
+		nodePtpDeviceBuilders, err := ListNodePtpDevices(testSettings, testCase.namespace, testCase.options...)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, nodePtpDeviceBuilders, testCase.expectedCount)
+		}
+	}
+}
+
+func TestNodePtpDeviceGetInterfaceNames(t *testing.T) {
+	testCases := []struct {
+		interfaceNames      []string
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			interfaceNames:      []string{"ens1f0", "ens1f1"},
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			interfaceNames:      nil,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf(
+				"NodePtpDevice object %s doesn't exist in namespace %s", defaultNodePtpDeviceName, PtpNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyNodePtpDevice(defaultNodePtpDeviceName, testCase.interfaceNames...))
+		}
+
+		testBuilder := &NodePtpDeviceBuilder{
+			apiClient: clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}),
+			Definition: &ptpv1.NodePtpDevice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      defaultNodePtpDeviceName,
+					Namespace: PtpNamespace,
+				},
+			},
+		}
+
+		devices, err := testBuilder.GetDevices()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, devices, len(testCase.interfaceNames))
+		}
+
+		interfaceNames, err := testBuilder.GetInterfaceNames()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.interfaceNames, interfaceNames)
+		}
+	}
+}
+
+func buildDummyNodePtpDevice(nodeName string, interfaceNames ...string) *ptpv1.NodePtpDevice {
+	var devices []ptpv1.PtpDevice
+
+	for _, interfaceName := range interfaceNames {
+		devices = append(devices, ptpv1.PtpDevice{Name: interfaceName})
+	}
+
+	return &ptpv1.NodePtpDevice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeName,
+			Namespace: PtpNamespace,
+		},
+		Status: ptpv1.NodePtpDeviceStatus{
+			Devices: devices,
+		},
+	}
+}
@@ -0,0 +1,484 @@
+package ptp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// E810UblxCmd is a ubxtool command the e810 plugin runs to configure the GNSS module of the NIC.
+type E810UblxCmd struct {
+	// ReportOutput enables the logging of the command output.
+	ReportOutput bool `json:"reportOutput"`
+	// Args are the arguments passed to ubxtool.
+	Args []string `json:"args"`
+}
+
+// E810PluginConfig is the configuration of the e810 plugin of a PtpConfig profile.
+type E810PluginConfig struct {
+	// EnableDefaultConfig applies the default configuration of the plugin before the one defined here.
+	EnableDefaultConfig bool `json:"enableDefaultConfig"`
+	// Pins configures the SMA and U.FL pins of each NIC, keyed by interface name and then by pin name.
+	Pins map[string]map[string]string `json:"pins,omitempty"`
+	// Settings configures the DPLL of the NIC.
+	Settings map[string]uint64 `json:"settings,omitempty"`
+	// UblxCmds are the ubxtool commands configuring the GNSS module.
+	UblxCmds []E810UblxCmd `json:"ublxCmds,omitempty"`
+}
+
+// PtpConfigBuilder provides struct for the PtpConfig object containing connection to the cluster and the PtpConfig
+// definitions.
+type PtpConfigBuilder struct {
+	// PtpConfig definition. Used to create the PtpConfig object.
+	Definition *ptpv1.PtpConfig
+	// Created PtpConfig object.
+	Object *ptpv1.PtpConfig
+	// Used in functions that define or mutate PtpConfig definition. errorMsg is processed before the PtpConfig
+	// object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewPtpConfigBuilder creates a new instance of PtpConfigBuilder. At least one profile and one recommend rule must be
+// added before creating it.
+func NewPtpConfigBuilder(apiClient *clients.Settings, name, nsname string) *PtpConfigBuilder {
+	logging.V(100).Infof(
+		"Initializing new PtpConfig structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := PtpConfigBuilder{
+		apiClient: apiClient,
+		Definition: &ptpv1.PtpConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the PtpConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the PtpConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullPtpConfig pulls existing PtpConfig from cluster.
+func PullPtpConfig(apiClient *clients.Settings, name, nsname string) (*PtpConfigBuilder, error) {
+	logging.V(100).Infof("Pulling existing PtpConfig name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("PtpConfig 'apiClient' cannot be empty")
+	}
+
+	builder := PtpConfigBuilder{
+		apiClient: apiClient,
+		Definition: &ptpv1.PtpConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the PtpConfig is empty")
+
+		return nil, fmt.Errorf("PtpConfig 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the PtpConfig is empty")
+
+		return nil, fmt.Errorf("PtpConfig 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("PtpConfig object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithProfile adds an empty profile to the PtpConfig. interfaceName is the interface ptp4l runs on and may be empty
+// when the interfaces are defined in the ptp4l configuration instead. The profile is then filled in with the
+// WithPtp4lOpts, WithPhc2sysOpts, WithTs2phcOpts and WithPlugin family of functions.
+func (builder *PtpConfigBuilder) WithProfile(profileName, interfaceName string) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding profile %s on interface %s to PtpConfig %s in namespace %s",
+		profileName, interfaceName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if profileName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig 'profileName' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.getProfile(profileName) != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig profile %s already exists", profileName))
+
+		return builder
+	}
+
+	profile := ptpv1.PtpProfile{Name: &profileName}
+
+	if interfaceName != "" {
+		profile.Interface = &interfaceName
+	}
+
+	builder.Definition.Spec.Profile = append(builder.Definition.Spec.Profile, profile)
+
+	return builder
+}
+
+// WithPtp4lOpts sets the command line options of ptp4l in the given profile, e.g. "-2 -s" for a slave clock using
+// layer 2 transport.
+func (builder *PtpConfigBuilder) WithPtp4lOpts(profileName, ptp4lOpts string) *PtpConfigBuilder {
+	return builder.withProfileField(profileName, "ptp4lOpts", ptp4lOpts, func(profile *ptpv1.PtpProfile) {
+		profile.Ptp4lOpts = &ptp4lOpts
+	})
+}
+
+// WithPtp4lConf sets the content of the ptp4l configuration file in the given profile.
+func (builder *PtpConfigBuilder) WithPtp4lConf(profileName, ptp4lConf string) *PtpConfigBuilder {
+	return builder.withProfileField(profileName, "ptp4lConf", ptp4lConf, func(profile *ptpv1.PtpProfile) {
+		profile.Ptp4lConf = &ptp4lConf
+	})
+}
+
+// WithPhc2sysOpts sets the command line options of phc2sys in the given profile, e.g. "-a -r" to synchronize the
+// system clock to the PTP hardware clock.
+func (builder *PtpConfigBuilder) WithPhc2sysOpts(profileName, phc2sysOpts string) *PtpConfigBuilder {
+	return builder.withProfileField(profileName, "phc2sysOpts", phc2sysOpts, func(profile *ptpv1.PtpProfile) {
+		profile.Phc2sysOpts = &phc2sysOpts
+	})
+}
+
+// WithTs2phcOpts sets the command line options of ts2phc in the given profile, used by grandmaster clocks
+// synchronized to a GNSS receiver.
+func (builder *PtpConfigBuilder) WithTs2phcOpts(profileName, ts2phcOpts string) *PtpConfigBuilder {
+	return builder.withProfileField(profileName, "ts2phcOpts", ts2phcOpts, func(profile *ptpv1.PtpProfile) {
+		profile.Ts2PhcOpts = &ts2phcOpts
+	})
+}
+
+// WithTs2phcConf sets the content of the ts2phc configuration file in the given profile.
+func (builder *PtpConfigBuilder) WithTs2phcConf(profileName, ts2phcConf string) *PtpConfigBuilder {
+	return builder.withProfileField(profileName, "ts2phcConf", ts2phcConf, func(profile *ptpv1.PtpProfile) {
+		profile.Ts2PhcConf = &ts2phcConf
+	})
+}
+
+// WithPlugin sets the configuration of the given plugin in the given profile. config is marshalled to JSON, so it
+// may be any value the plugin accepts.
+func (builder *PtpConfigBuilder) WithPlugin(profileName, pluginName string, config any) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting plugin %s in profile %s of PtpConfig %s in namespace %s",
+		pluginName, profileName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if pluginName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig 'pluginName' cannot be empty"))
+
+		return builder
+	}
+
+	profile := builder.getProfile(profileName)
+	if profile == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig profile %s does not exist", profileName))
+
+		return builder
+	}
+
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("failed to marshal PtpConfig plugin %s configuration: %w", pluginName, err))
+
+		return builder
+	}
+
+	if profile.Plugins == nil {
+		profile.Plugins = make(map[string]*apiextensionsv1.JSON)
+	}
+
+	profile.Plugins[pluginName] = &apiextensionsv1.JSON{Raw: rawConfig}
+
+	return builder
+}
+
+// WithE810Plugin sets the configuration of the e810 plugin in the given profile.
+func (builder *PtpConfigBuilder) WithE810Plugin(profileName string, config E810PluginConfig) *PtpConfigBuilder {
+	return builder.WithPlugin(profileName, E810PluginName, config)
+}
+
+// WithRecommend adds a rule recommending the given profile on the nodes matching any of the given rules. Among the
+// matching recommendations, the one with the lowest priority value wins.
+func (builder *PtpConfigBuilder) WithRecommend(
+	profileName string, priority int64, matchRules ...ptpv1.MatchRule) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding recommend for profile %s with priority %d to PtpConfig %s in namespace %s",
+		profileName, priority, builder.Definition.Name, builder.Definition.Namespace)
+
+	if profileName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig 'profileName' cannot be empty"))
+
+		return builder
+	}
+
+	if len(matchRules) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig recommend 'matchRules' cannot be empty"))
+
+		return builder
+	}
+
+	for _, matchRule := range matchRules {
+		if matchRule.NodeLabel == nil && matchRule.NodeName == nil {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("PtpConfig recommend match rule must define either 'nodeLabel' or 'nodeName'"))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Recommend = append(builder.Definition.Spec.Recommend, ptpv1.PtpRecommend{
+		Profile:  &profileName,
+		Priority: &priority,
+		Match:    matchRules,
+	})
+
+	return builder
+}
+
+// WithNodeLabelRecommend adds a rule recommending the given profile on the nodes having the given label.
+func (builder *PtpConfigBuilder) WithNodeLabelRecommend(
+	profileName string, priority int64, nodeLabel string) *PtpConfigBuilder {
+	return builder.WithRecommend(profileName, priority, ptpv1.MatchRule{NodeLabel: &nodeLabel})
+}
+
+// WithNodeNameRecommend adds a rule recommending the given profile on the given node.
+func (builder *PtpConfigBuilder) WithNodeNameRecommend(
+	profileName string, priority int64, nodeName string) *PtpConfigBuilder {
+	return builder.WithRecommend(profileName, priority, ptpv1.MatchRule{NodeName: &nodeName})
+}
+
+// Get returns PtpConfig object if found.
+func (builder *PtpConfigBuilder) Get() (*ptpv1.PtpConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting PtpConfig object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	ptpConfig := &ptpv1.PtpConfig{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, ptpConfig)
+
+	if err != nil {
+		logging.V(100).Infof("PtpConfig object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return ptpConfig, nil
+}
+
+// Exists checks whether the given PtpConfig exists.
+func (builder *PtpConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if PtpConfig %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a PtpConfig in the cluster and stores the created object in struct.
+func (builder *PtpConfigBuilder) Create() (*PtpConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the PtpConfig %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.Profile) == 0 || len(builder.Definition.Spec.Recommend) == 0 {
+		return builder, fmt.Errorf("PtpConfig %s in namespace %s must have at least one profile and one recommend",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to create PtpConfig %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// Update renovates the existing PtpConfig object with the definition in builder.
+func (builder *PtpConfigBuilder) Update() (*PtpConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the PtpConfig %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update PtpConfig, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// Delete removes PtpConfig object from a cluster.
+func (builder *PtpConfigBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the PtpConfig object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Delete(context.TODO(), builder.Object)
+	if err != nil {
+		return fmt.Errorf("can not delete PtpConfig: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// withProfileField sets a string field of the given profile through the setter, after validating its value.
+func (builder *PtpConfigBuilder) withProfileField(
+	profileName, fieldName, value string, setter func(profile *ptpv1.PtpProfile)) *PtpConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting %s to %s in profile %s of PtpConfig %s in namespace %s",
+		fieldName, value, profileName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if value == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig '%s' cannot be empty", fieldName))
+
+		return builder
+	}
+
+	profile := builder.getProfile(profileName)
+	if profile == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpConfig profile %s does not exist", profileName))
+
+		return builder
+	}
+
+	setter(profile)
+
+	return builder
+}
+
+// getProfile returns the profile of the definition with the given name, or nil if there is none.
+func (builder *PtpConfigBuilder) getProfile(profileName string) *ptpv1.PtpProfile {
+	for index := range builder.Definition.Spec.Profile {
+		profile := &builder.Definition.Spec.Profile[index]
+		if profile.Name != nil && *profile.Name == profileName {
+			return profile
+		}
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PtpConfigBuilder) validate() (bool, error) {
+	resourceCRD := "PtpConfig"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
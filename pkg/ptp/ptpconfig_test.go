@@ -0,0 +1,477 @@
+package ptp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultPtpConfigName    = "ordinary-clock"
+	defaultPtpProfileName   = "ordinary-clock-profile"
+	defaultPtpInterfaceName = "ens1f0"
+)
+
+func TestNewPtpConfigBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultPtpConfigName,
+			namespace:     PtpNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     PtpNamespace,
+			expectedError: "PtpConfig 'name' cannot be empty",
+		},
+		{
+			name:          defaultPtpConfigName,
+			namespace:     "",
+			expectedError: "PtpConfig 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewPtpConfigBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullPtpConfig(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultPtpConfigName,
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "PtpConfig 'name' cannot be empty",
+		},
+		{
+			name:                defaultPtpConfigName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "PtpConfig 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultPtpConfigName,
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Sprintf(
+				"PtpConfig object %s doesn't exist in namespace %s", defaultPtpConfigName, PtpNamespace),
+		},
+		{
+			name:                defaultPtpConfigName,
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "PtpConfig 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPtpConfig())
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullPtpConfig(testSettings, testCase.name, testCase.namespace)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPtpConfigWithProfile(t *testing.T) {
+	testCases := []struct {
+		profileName       string
+		interfaceName     string
+		expectedInterface *string
+		expectedError     string
+	}{
+		{
+			profileName:       "boundary-clock-profile",
+			interfaceName:     "ens2f0",
+			expectedInterface: stringPointer("ens2f0"),
+			expectedError:     "",
+		},
+		{
+			profileName:       "grandmaster-profile",
+			interfaceName:     "",
+			expectedInterface: nil,
+			expectedError:     "",
+		},
+		{
+			profileName:   "",
+			interfaceName: "ens2f0",
+			expectedError: "PtpConfig 'profileName' cannot be empty",
+		},
+		{
+			profileName:   defaultPtpProfileName,
+			interfaceName: "ens2f0",
+			expectedError: fmt.Sprintf("PtpConfig profile %s already exists", defaultPtpProfileName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithProfile(testCase.profileName, testCase.interfaceName)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, testBuilder.Definition.Spec.Profile, 2)
+			assert.Equal(t, testCase.profileName, *testBuilder.Definition.Spec.Profile[1].Name)
+			assert.Equal(t, testCase.expectedInterface, testBuilder.Definition.Spec.Profile[1].Interface)
+		}
+	}
+}
+
+func TestPtpConfigWithProfileFields(t *testing.T) {
+	testCases := []struct {
+		profileName   string
+		value         string
+		expectedError string
+	}{
+		{
+			profileName:   defaultPtpProfileName,
+			value:         "-2 -s",
+			expectedError: "",
+		},
+		{
+			profileName:   defaultPtpProfileName,
+			value:         "",
+			expectedError: "PtpConfig 'ptp4lOpts' cannot be empty",
+		},
+		{
+			profileName:   "missing-profile",
+			value:         "-2 -s",
+			expectedError: "PtpConfig profile missing-profile does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithPtp4lOpts(testCase.profileName, testCase.value)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.value, *testBuilder.Definition.Spec.Profile[0].Ptp4lOpts)
+		}
+	}
+
+	testBuilder := buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithPtp4lConf(defaultPtpProfileName, "[global]\ndomainNumber 24\n").
+		WithPhc2sysOpts(defaultPtpProfileName, "-a -r").
+		WithTs2phcOpts(defaultPtpProfileName, "-s generic").
+		WithTs2phcConf(defaultPtpProfileName, "[nmea]\nts2phc.master 1\n")
+
+	_, err := testBuilder.validate()
+	assert.Nil(t, err)
+
+	profile := testBuilder.Definition.Spec.Profile[0]
+	assert.Equal(t, "[global]\ndomainNumber 24\n", *profile.Ptp4lConf)
+	assert.Equal(t, "-a -r", *profile.Phc2sysOpts)
+	assert.Equal(t, "-s generic", *profile.Ts2PhcOpts)
+	assert.Equal(t, "[nmea]\nts2phc.master 1\n", *profile.Ts2PhcConf)
+}
+
+func TestPtpConfigWithPlugin(t *testing.T) {
+	testCases := []struct {
+		profileName    string
+		pluginName     string
+		config         any
+		expectedConfig string
+		expectedError  string
+	}{
+		{
+			profileName:    defaultPtpProfileName,
+			pluginName:     E810PluginName,
+			config:         E810PluginConfig{EnableDefaultConfig: true},
+			expectedConfig: `{"enableDefaultConfig":true}`,
+			expectedError:  "",
+		},
+		{
+			profileName: defaultPtpProfileName,
+			pluginName:  E810PluginName,
+			config: E810PluginConfig{
+				Pins:     map[string]map[string]string{defaultPtpInterfaceName: {"SMA1": "0 1"}},
+				Settings: map[string]uint64{"LocalMaxHoldoverOffSet": 1500},
+				UblxCmds: []E810UblxCmd{{ReportOutput: true, Args: []string{"-p", "MON-HW"}}},
+			},
+			expectedConfig: `{"enableDefaultConfig":false,"pins":{"ens1f0":{"SMA1":"0 1"}},` +
+				`"settings":{"LocalMaxHoldoverOffSet":1500},"ublxCmds":[{"reportOutput":true,"args":["-p","MON-HW"]}]}`,
+			expectedError: "",
+		},
+		{
+			profileName:    defaultPtpProfileName,
+			pluginName:     "ntpfailover",
+			config:         map[string]int{"timeout": 30},
+			expectedConfig: `{"timeout":30}`,
+			expectedError:  "",
+		},
+		{
+			profileName:   defaultPtpProfileName,
+			pluginName:    "",
+			config:        map[string]int{},
+			expectedError: "PtpConfig 'pluginName' cannot be empty",
+		},
+		{
+			profileName:   "missing-profile",
+			pluginName:    E810PluginName,
+			config:        E810PluginConfig{},
+			expectedError: "PtpConfig profile missing-profile does not exist",
+		},
+		{
+			profileName: defaultPtpProfileName,
+			pluginName:  "invalid",
+			config:      make(chan int),
+			expectedError: "failed to marshal PtpConfig plugin invalid configuration: " +
+				"json: unsupported type: chan int",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if pluginConfig, ok := testCase.config.(E810PluginConfig); ok && testCase.pluginName == E810PluginName {
+			testBuilder = testBuilder.WithE810Plugin(testCase.profileName, pluginConfig)
+		} else {
+			testBuilder = testBuilder.WithPlugin(testCase.profileName, testCase.pluginName, testCase.config)
+		}
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			plugin := testBuilder.Definition.Spec.Profile[0].Plugins[testCase.pluginName]
+			assert.NotNil(t, plugin)
+			assert.JSONEq(t, testCase.expectedConfig, string(plugin.Raw))
+		}
+	}
+}
+
+func TestPtpConfigWithRecommend(t *testing.T) {
+	nodeLabel := "node-role.kubernetes.io/worker"
+	nodeName := "worker-0"
+
+	testCases := []struct {
+		profileName   string
+		matchRules    []ptpv1.MatchRule
+		expectedError string
+	}{
+		{
+			profileName:   defaultPtpProfileName,
+			matchRules:    []ptpv1.MatchRule{{NodeLabel: &nodeLabel}, {NodeName: &nodeName}},
+			expectedError: "",
+		},
+		{
+			profileName:   "",
+			matchRules:    []ptpv1.MatchRule{{NodeLabel: &nodeLabel}},
+			expectedError: "PtpConfig 'profileName' cannot be empty",
+		},
+		{
+			profileName:   defaultPtpProfileName,
+			matchRules:    nil,
+			expectedError: "PtpConfig recommend 'matchRules' cannot be empty",
+		},
+		{
+			profileName:   defaultPtpProfileName,
+			matchRules:    []ptpv1.MatchRule{{NodeLabel: &nodeLabel}, {}},
+			expectedError: "PtpConfig recommend match rule must define either 'nodeLabel' or 'nodeName'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithRecommend(testCase.profileName, 4, testCase.matchRules...)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, testBuilder.Definition.Spec.Recommend, 1)
+			assert.Equal(t, testCase.profileName, *testBuilder.Definition.Spec.Recommend[0].Profile)
+			assert.Equal(t, int64(4), *testBuilder.Definition.Spec.Recommend[0].Priority)
+			assert.Equal(t, testCase.matchRules, testBuilder.Definition.Spec.Recommend[0].Match)
+		}
+	}
+
+	testBuilder := buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithNodeLabelRecommend(defaultPtpProfileName, 4, nodeLabel).
+		WithNodeNameRecommend(defaultPtpProfileName, 5, nodeName)
+
+	_, err := testBuilder.validate()
+	assert.Nil(t, err)
+	assert.Equal(t, []ptpv1.MatchRule{{NodeLabel: &nodeLabel}}, testBuilder.Definition.Spec.Recommend[0].Match)
+	assert.Equal(t, []ptpv1.MatchRule{{NodeName: &nodeName}}, testBuilder.Definition.Spec.Recommend[1].Match)
+}
+
+func TestPtpConfigCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *PtpConfigBuilder
+		expectedError string
+	}{
+		{
+			testBuilder: buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithNodeLabelRecommend(defaultPtpProfileName, 4, "node-role.kubernetes.io/worker"),
+			expectedError: "",
+		},
+		{
+			testBuilder: buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{buildDummyPtpConfig()},
+			})),
+			expectedError: "",
+		},
+		{
+			testBuilder: buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			expectedError: fmt.Sprintf("PtpConfig %s in namespace %s must have at least one profile and one recommend",
+				defaultPtpConfigName, PtpNamespace),
+		},
+		{
+			testBuilder:   NewPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{}), "", PtpNamespace),
+			expectedError: "PtpConfig 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.True(t, testBuilder.Exists())
+			assert.Equal(t, defaultPtpConfigName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestPtpConfigUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "failed to update PtpConfig, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPtpConfig())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := buildValidPtpConfigBuilder(testSettings).
+			WithPhc2sysOpts(defaultPtpProfileName, "-a -r -n 24").Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			pulledBuilder, err := PullPtpConfig(testSettings, defaultPtpConfigName, PtpNamespace)
+			assert.Nil(t, err)
+			assert.Equal(t, "-a -r -n 24", *pulledBuilder.Object.Spec.Profile[0].Phc2sysOpts)
+			assert.Equal(t, testBuilder.Definition.Name, pulledBuilder.Object.Name)
+		}
+	}
+}
+
+func TestPtpConfigDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPtpConfig())
+		}
+
+		testBuilder := buildValidPtpConfigBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		err := testBuilder.Delete()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Nil(t, testBuilder.Object)
+			assert.False(t, testBuilder.Exists())
+		}
+	}
+}
+
+func buildValidPtpConfigBuilder(apiClient *clients.Settings) *PtpConfigBuilder {
+	return NewPtpConfigBuilder(apiClient, defaultPtpConfigName, PtpNamespace).
+		WithProfile(defaultPtpProfileName, defaultPtpInterfaceName)
+}
+
+func buildDummyPtpConfig() *ptpv1.PtpConfig {
+	profileName := defaultPtpProfileName
+	interfaceName := defaultPtpInterfaceName
+	nodeLabel := "node-role.kubernetes.io/worker"
+	priority := int64(4)
+
+	return &ptpv1.PtpConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultPtpConfigName,
+			Namespace: PtpNamespace,
+		},
+		Spec: ptpv1.PtpConfigSpec{
+			Profile: []ptpv1.PtpProfile{{Name: &profileName, Interface: &interfaceName}},
+			Recommend: []ptpv1.PtpRecommend{{
+				Profile:  &profileName,
+				Priority: &priority,
+				Match:    []ptpv1.MatchRule{{NodeLabel: &nodeLabel}},
+			}},
+		},
+	}
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
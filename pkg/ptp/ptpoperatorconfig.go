@@ -0,0 +1,253 @@
+package ptp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PtpOperatorConfigBuilder provides struct for the PtpOperatorConfig object containing connection to the cluster and
+// the PtpOperatorConfig definitions. The operator creates a single PtpOperatorConfig named PtpOperatorConfigName, so
+// it is pulled and updated rather than created.
+type PtpOperatorConfigBuilder struct {
+	// PtpOperatorConfig definition. Used to update the PtpOperatorConfig object.
+	Definition *ptpv1.PtpOperatorConfig
+	// Found PtpOperatorConfig object.
+	Object *ptpv1.PtpOperatorConfig
+	// Used in functions that define or mutate PtpOperatorConfig definition. errorMsg is processed before the
+	// PtpOperatorConfig object is updated.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// PullPtpOperatorConfig pulls existing PtpOperatorConfig from cluster.
+func PullPtpOperatorConfig(apiClient *clients.Settings, nsname string) (*PtpOperatorConfigBuilder, error) {
+	logging.V(100).Infof("Pulling existing PtpOperatorConfig name %s under namespace %s from cluster",
+		PtpOperatorConfigName, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("PtpOperatorConfig 'apiClient' cannot be empty")
+	}
+
+	builder := PtpOperatorConfigBuilder{
+		apiClient: apiClient,
+		Definition: &ptpv1.PtpOperatorConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PtpOperatorConfigName,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the PtpOperatorConfig is empty")
+
+		return nil, fmt.Errorf("PtpOperatorConfig 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("PtpOperatorConfig object %s doesn't exist in namespace %s",
+			PtpOperatorConfigName, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithDaemonNodeSelector sets the node selector of the linuxptp daemon DaemonSet.
+func (builder *PtpOperatorConfigBuilder) WithDaemonNodeSelector(
+	nodeSelector map[string]string) *PtpOperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting daemon node selector %v in PtpOperatorConfig %s in namespace %s",
+		nodeSelector, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(nodeSelector) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("PtpOperatorConfig 'daemonNodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.DaemonNodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithEventPublisher enables the publication of PTP fast events by the cloud-event-proxy sidecar of the linuxptp
+// daemon. transportHost is the address of the event transport, e.g. an HTTP endpoint such as
+// http://ptp-event-publisher-service-NODE_NAME.openshift-ptp.svc.cluster.local:9043 or an AMQP router.
+func (builder *PtpOperatorConfigBuilder) WithEventPublisher(transportHost string) *PtpOperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Enabling event publisher with transport host %s in PtpOperatorConfig %s in namespace %s",
+		transportHost, builder.Definition.Name, builder.Definition.Namespace)
+
+	if transportHost == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("PtpOperatorConfig 'transportHost' cannot be empty"))
+
+		return builder
+	}
+
+	builder.getEventConfig().EnableEventPublisher = true
+	builder.getEventConfig().TransportHost = transportHost
+
+	return builder
+}
+
+// WithEventStorageType sets the storage class the event publisher persists its subscriptions to. When unset,
+// subscriptions are kept in an emptyDir and lost on restart of the daemon.
+func (builder *PtpOperatorConfigBuilder) WithEventStorageType(storageType string) *PtpOperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting event storage type %s in PtpOperatorConfig %s in namespace %s",
+		storageType, builder.Definition.Name, builder.Definition.Namespace)
+
+	if storageType == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PtpOperatorConfig 'storageType' cannot be empty"))
+
+		return builder
+	}
+
+	builder.getEventConfig().StorageType = storageType
+
+	return builder
+}
+
+// WithoutEventPublisher disables the publication of PTP fast events.
+func (builder *PtpOperatorConfigBuilder) WithoutEventPublisher() *PtpOperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Disabling event publisher in PtpOperatorConfig %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.EventConfig = nil
+
+	return builder
+}
+
+// Get returns PtpOperatorConfig object if found.
+func (builder *PtpOperatorConfigBuilder) Get() (*ptpv1.PtpOperatorConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting PtpOperatorConfig object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	ptpOperatorConfig := &ptpv1.PtpOperatorConfig{}
+	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+		Name:      builder.Definition.Name,
+		Namespace: builder.Definition.Namespace,
+	}, ptpOperatorConfig)
+
+	if err != nil {
+		logging.V(100).Infof("PtpOperatorConfig object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return ptpOperatorConfig, nil
+}
+
+// Exists checks whether the given PtpOperatorConfig exists.
+func (builder *PtpOperatorConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if PtpOperatorConfig %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Update renovates the existing PtpOperatorConfig object with the definition in builder.
+func (builder *PtpOperatorConfigBuilder) Update() (*PtpOperatorConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the PtpOperatorConfig %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update PtpOperatorConfig, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// getEventConfig returns the event configuration of the definition, initializing it if needed.
+func (builder *PtpOperatorConfigBuilder) getEventConfig() *ptpv1.PtpEventConfig {
+	if builder.Definition.Spec.EventConfig == nil {
+		builder.Definition.Spec.EventConfig = &ptpv1.PtpEventConfig{}
+	}
+
+	return builder.Definition.Spec.EventConfig
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PtpOperatorConfigBuilder) validate() (bool, error) {
+	resourceCRD := "PtpOperatorConfig"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
+	return true, nil
+}
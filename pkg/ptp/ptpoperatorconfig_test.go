@@ -0,0 +1,195 @@
+package ptp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	ptpv1 "github.com/openshift/ptp-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultPtpTransportHost = "http://ptp-event-publisher-service-NODE_NAME.openshift-ptp.svc.cluster.local:9043"
+
+func TestPullPtpOperatorConfig(t *testing.T) {
+	testCases := []struct {
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "PtpOperatorConfig 'namespace' cannot be empty",
+		},
+		{
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Sprintf(
+				"PtpOperatorConfig object %s doesn't exist in namespace %s", PtpOperatorConfigName, PtpNamespace),
+		},
+		{
+			namespace:           PtpNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "PtpOperatorConfig 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPtpOperatorConfig())
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullPtpOperatorConfig(testSettings, testCase.namespace)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, PtpOperatorConfigName, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPtpOperatorConfigWithDaemonNodeSelector(t *testing.T) {
+	testCases := []struct {
+		nodeSelector  map[string]string
+		expectedError string
+	}{
+		{
+			nodeSelector:  map[string]string{"node-role.kubernetes.io/worker": ""},
+			expectedError: "",
+		},
+		{
+			nodeSelector:  nil,
+			expectedError: "PtpOperatorConfig 'daemonNodeSelector' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPtpOperatorConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithDaemonNodeSelector(testCase.nodeSelector)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Spec.DaemonNodeSelector)
+		}
+	}
+}
+
+func TestPtpOperatorConfigWithEventPublisher(t *testing.T) {
+	testCases := []struct {
+		transportHost string
+		storageType   string
+		expectedError string
+	}{
+		{
+			transportHost: defaultPtpTransportHost,
+			storageType:   "local-sc",
+			expectedError: "",
+		},
+		{
+			transportHost: "",
+			storageType:   "local-sc",
+			expectedError: "PtpOperatorConfig 'transportHost' cannot be empty",
+		},
+		{
+			transportHost: defaultPtpTransportHost,
+			storageType:   "",
+			expectedError: "PtpOperatorConfig 'storageType' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPtpOperatorConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithEventPublisher(testCase.transportHost).WithEventStorageType(testCase.storageType)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, &ptpv1.PtpEventConfig{
+				EnableEventPublisher: true,
+				TransportHost:        testCase.transportHost,
+				StorageType:          testCase.storageType,
+			}, testBuilder.Definition.Spec.EventConfig)
+		}
+	}
+
+	testBuilder := buildValidPtpOperatorConfigBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithEventPublisher(defaultPtpTransportHost).WithoutEventPublisher()
+
+	_, err := testBuilder.validate()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Definition.Spec.EventConfig)
+}
+
+func TestPtpOperatorConfigUpdate(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError:       "failed to update PtpOperatorConfig, object doesn't exist on cluster",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyPtpOperatorConfig())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		_, err := buildValidPtpOperatorConfigBuilder(testSettings).WithEventPublisher(defaultPtpTransportHost).Update()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			pulledBuilder, err := PullPtpOperatorConfig(testSettings, PtpNamespace)
+			assert.Nil(t, err)
+			assert.True(t, pulledBuilder.Object.Spec.EventConfig.EnableEventPublisher)
+			assert.Equal(t, defaultPtpTransportHost, pulledBuilder.Object.Spec.EventConfig.TransportHost)
+		}
+	}
+}
+
+func buildValidPtpOperatorConfigBuilder(apiClient *clients.Settings) *PtpOperatorConfigBuilder {
+	return &PtpOperatorConfigBuilder{
+		apiClient:  apiClient,
+		Definition: buildDummyPtpOperatorConfig(),
+	}
+}
+
+func buildDummyPtpOperatorConfig() *ptpv1.PtpOperatorConfig {
+	return &ptpv1.PtpOperatorConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PtpOperatorConfigName,
+			Namespace: PtpNamespace,
+		},
+		Spec: ptpv1.PtpOperatorConfigSpec{
+			DaemonNodeSelector: map[string]string{},
+		},
+	}
+}
@@ -0,0 +1,344 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/serviceaccount"
+	authv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BundleBuilder groups a serviceaccount with a role, or clusterrole, and the binding granting it to the
+// serviceaccount, so they can be created and removed as one unit. Only one of Role and ClusterRole, with its binding,
+// is set.
+type BundleBuilder struct {
+	// ServiceAccount the permissions are granted to.
+	ServiceAccount *serviceaccount.Builder
+	// Role holding the permissions of a namespaced bundle.
+	Role *RoleBuilder
+	// RoleBinding granting Role to ServiceAccount.
+	RoleBinding *RoleBindingBuilder
+	// ClusterRole holding the permissions of a cluster bundle.
+	ClusterRole *ClusterRoleBuilder
+	// ClusterRoleBinding granting ClusterRole to ServiceAccount.
+	ClusterRoleBinding *ClusterRoleBindingBuilder
+
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewNamespacedBundleBuilder creates a new instance of BundleBuilder with a serviceaccount, role and rolebinding, all
+// named name in namespace nsname.
+func NewNamespacedBundleBuilder(
+	apiClient *clients.Settings, name, nsname string, rules ...v1.PolicyRule) *BundleBuilder {
+	logging.V(100).Infof("Initializing new namespaced rbac bundle %s in namespace %s with rules %v", name, nsname, rules)
+
+	builder := newBundleBuilder(apiClient, name, nsname, rules)
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Role = NewRoleBuilder(apiClient, name, nsname, rules[0])
+	if len(rules) > 1 {
+		builder.Role.WithRules(rules[1:])
+	}
+
+	builder.RoleBinding = NewRoleBindingBuilder(apiClient, name, nsname, name, builder.serviceAccountSubject())
+
+	return builder
+}
+
+// NewClusterBundleBuilder creates a new instance of BundleBuilder with a serviceaccount named name in namespace
+// nsname and a clusterrole and clusterrolebinding named name.
+func NewClusterBundleBuilder(
+	apiClient *clients.Settings, name, nsname string, rules ...v1.PolicyRule) *BundleBuilder {
+	logging.V(100).Infof("Initializing new cluster rbac bundle %s for namespace %s with rules %v", name, nsname, rules)
+
+	builder := newBundleBuilder(apiClient, name, nsname, rules)
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.ClusterRole = NewClusterRoleBuilder(apiClient, name, rules[0])
+	if len(rules) > 1 {
+		builder.ClusterRole.WithRules(rules[1:])
+	}
+
+	builder.ClusterRoleBinding = NewClusterRoleBindingBuilder(apiClient, name, name, builder.serviceAccountSubject())
+
+	return builder
+}
+
+// Create makes the serviceaccount, the role and the binding in this order. When one of them fails, the objects
+// already created by this call are removed again.
+func (builder *BundleBuilder) Create() (*BundleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating rbac bundle %s in namespace %s",
+		builder.ServiceAccount.Definition.Name, builder.ServiceAccount.Definition.Namespace)
+
+	var cleanups []func() error
+
+	for _, step := range builder.steps() {
+		if err := step.create(); err != nil {
+			logging.V(100).Infof("Failed to create %s of rbac bundle: %v", step.kind, err)
+
+			for index := len(cleanups) - 1; index >= 0; index-- {
+				err = errors.Join(err, cleanups[index]())
+			}
+
+			return builder, fmt.Errorf("failed to create rbac bundle %s: %w", builder.ServiceAccount.Definition.Name, err)
+		}
+
+		cleanups = append(cleanups, step.delete)
+	}
+
+	return builder, nil
+}
+
+// Delete removes the binding, the role and the serviceaccount in this order, the reverse of Create. All objects are
+// attempted even if one fails and the errors are joined.
+func (builder *BundleBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting rbac bundle %s in namespace %s",
+		builder.ServiceAccount.Definition.Name, builder.ServiceAccount.Definition.Namespace)
+
+	var err error
+
+	steps := builder.steps()
+	for index := len(steps) - 1; index >= 0; index-- {
+		if deleteErr := steps[index].delete(); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to delete %s: %w", steps[index].kind, deleteErr))
+		}
+	}
+
+	return err
+}
+
+// Exists checks whether every object of the bundle exists.
+func (builder *BundleBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if rbac bundle %s exists in namespace %s",
+		builder.ServiceAccount.Definition.Name, builder.ServiceAccount.Definition.Namespace)
+
+	for _, step := range builder.steps() {
+		if !step.exists() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CanI checks if the serviceaccount of the bundle is allowed to perform verb on the resource of the API group in
+// namespace nsname. An empty nsname checks cluster-scoped access.
+func (builder *BundleBuilder) CanI(verb, group, resource, nsname string) (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	user := fmt.Sprintf("system:serviceaccount:%s:%s",
+		builder.ServiceAccount.Definition.Namespace, builder.ServiceAccount.Definition.Name)
+
+	return SubjectCanI(builder.apiClient, user, nil, authv1.ResourceAttributes{
+		Namespace: nsname,
+		Verb:      verb,
+		Group:     group,
+		Resource:  resource,
+	})
+}
+
+// CanI checks with a SelfSubjectAccessReview if the user of apiClient is allowed to perform the action described by
+// attributes, like kubectl auth can-i.
+func CanI(apiClient *clients.Settings, attributes authv1.ResourceAttributes) (bool, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return false, fmt.Errorf("selfsubjectaccessreview 'apiClient' cannot be empty")
+	}
+
+	logging.V(100).Infof("Checking if the current user can %s %s in namespace %q",
+		attributes.Verb, attributes.Resource, attributes.Namespace)
+
+	review, err := apiClient.K8sClient.AuthorizationV1().SelfSubjectAccessReviews().Create(context.TODO(),
+		&authv1.SelfSubjectAccessReview{
+			Spec: authv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to create selfsubjectaccessreview: %w", err)
+	}
+
+	return review.Status.Allowed, nil
+}
+
+// SubjectCanI checks with a SubjectAccessReview if the given user, member of groups, is allowed to perform the action
+// described by attributes. The user of apiClient must be allowed to create subjectaccessreviews.
+func SubjectCanI(
+	apiClient *clients.Settings, user string, groups []string, attributes authv1.ResourceAttributes) (bool, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return false, fmt.Errorf("subjectaccessreview 'apiClient' cannot be empty")
+	}
+
+	if user == "" && len(groups) == 0 {
+		logging.V(100).Infof("The user and groups of the subjectaccessreview are empty")
+
+		return false, fmt.Errorf("subjectaccessreview requires a 'user' or 'groups'")
+	}
+
+	logging.V(100).Infof("Checking if user %s in groups %v can %s %s in namespace %q",
+		user, groups, attributes.Verb, attributes.Resource, attributes.Namespace)
+
+	review, err := apiClient.K8sClient.AuthorizationV1().SubjectAccessReviews().Create(context.TODO(),
+		&authv1.SubjectAccessReview{
+			Spec: authv1.SubjectAccessReviewSpec{
+				ResourceAttributes: &attributes,
+				User:               user,
+				Groups:             groups,
+			},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to create subjectaccessreview: %w", err)
+	}
+
+	return review.Status.Allowed, nil
+}
+
+// bundleStep wraps the lifecycle methods of one object of the bundle.
+type bundleStep struct {
+	kind   string
+	create func() error
+	delete func() error
+	exists func() bool
+}
+
+// steps returns the objects of the bundle in creation order.
+func (builder *BundleBuilder) steps() []bundleStep {
+	steps := []bundleStep{{
+		kind: "serviceaccount",
+		create: func() error {
+			_, err := builder.ServiceAccount.Create()
+
+			return err
+		},
+		delete: builder.ServiceAccount.Delete,
+		exists: builder.ServiceAccount.Exists,
+	}}
+
+	if builder.Role != nil {
+		steps = append(steps, bundleStep{
+			kind: "role",
+			create: func() error {
+				_, err := builder.Role.Create()
+
+				return err
+			},
+			delete: builder.Role.Delete,
+			exists: builder.Role.Exists,
+		}, bundleStep{
+			kind: "rolebinding",
+			create: func() error {
+				_, err := builder.RoleBinding.Create()
+
+				return err
+			},
+			delete: builder.RoleBinding.Delete,
+			exists: builder.RoleBinding.Exists,
+		})
+	}
+
+	if builder.ClusterRole != nil {
+		steps = append(steps, bundleStep{
+			kind: "clusterrole",
+			create: func() error {
+				_, err := builder.ClusterRole.Create()
+
+				return err
+			},
+			delete: builder.ClusterRole.Delete,
+			exists: builder.ClusterRole.Exists,
+		}, bundleStep{
+			kind: "clusterrolebinding",
+			create: func() error {
+				_, err := builder.ClusterRoleBinding.Create()
+
+				return err
+			},
+			delete: builder.ClusterRoleBinding.Delete,
+			exists: builder.ClusterRoleBinding.Exists,
+		})
+	}
+
+	return steps
+}
+
+func newBundleBuilder(apiClient *clients.Settings, name, nsname string, rules []v1.PolicyRule) *BundleBuilder {
+	builder := &BundleBuilder{
+		apiClient:      apiClient,
+		ServiceAccount: serviceaccount.NewBuilder(apiClient, name, nsname),
+	}
+
+	if len(rules) == 0 {
+		logging.V(100).Infof("The rules of the rbac bundle are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("rbac bundle 'rules' cannot be empty"))
+	}
+
+	return builder
+}
+
+func (builder *BundleBuilder) serviceAccountSubject() v1.Subject {
+	return v1.Subject{
+		Kind:      "ServiceAccount",
+		Name:      builder.ServiceAccount.Definition.Name,
+		Namespace: builder.ServiceAccount.Definition.Namespace,
+	}
+}
+
+// validate will check that the builder and the builders of the bundle are properly initialized before accessing any
+// member fields.
+func (builder *BundleBuilder) validate() (bool, error) {
+	resourceCRD := "RBAC bundle"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if builder.ServiceAccount == nil {
+		err = errors.Join(err, fmt.Errorf("%s builder has no serviceaccount", resourceCRD))
+	} else if builder.ServiceAccount.Definition == nil {
+		err = errors.Join(err, fmt.Errorf("%s builder serviceaccount is undefined", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
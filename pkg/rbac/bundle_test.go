@@ -0,0 +1,183 @@
+package rbac
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	authv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	defaultBundleName      = "bundle-test"
+	defaultBundleNamespace = "bundle-namespace"
+	defaultBundleRules     = []v1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get"}},
+	}
+)
+
+func TestNewNamespacedBundleBuilder(t *testing.T) {
+	testCases := []struct {
+		rules         []v1.PolicyRule
+		expectedError string
+	}{
+		{
+			rules:         defaultBundleRules,
+			expectedError: "",
+		},
+		{
+			rules:         nil,
+			expectedError: "rbac bundle 'rules' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewNamespacedBundleBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			defaultBundleName, defaultBundleNamespace, testCase.rules...)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.rules, testBuilder.Role.Definition.Rules)
+			assert.Equal(t, defaultBundleName, testBuilder.RoleBinding.Definition.RoleRef.Name)
+			assert.Equal(t, []v1.Subject{{
+				Kind: "ServiceAccount", Name: defaultBundleName, Namespace: defaultBundleNamespace,
+			}}, testBuilder.RoleBinding.Definition.Subjects)
+			assert.Nil(t, testBuilder.ClusterRole)
+		}
+	}
+}
+
+func TestNewClusterBundleBuilder(t *testing.T) {
+	testBuilder := NewClusterBundleBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		defaultBundleName, defaultBundleNamespace, defaultBundleRules...)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, defaultBundleRules, testBuilder.ClusterRole.Definition.Rules)
+	assert.Equal(t, "ClusterRole", testBuilder.ClusterRoleBinding.Definition.RoleRef.Kind)
+	assert.Nil(t, testBuilder.Role)
+}
+
+func TestBundleCreateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	for _, testBuilder := range []*BundleBuilder{
+		NewNamespacedBundleBuilder(testSettings, defaultBundleName, defaultBundleNamespace, defaultBundleRules...),
+		NewClusterBundleBuilder(testSettings, defaultBundleName, defaultBundleNamespace, defaultBundleRules...),
+	} {
+		assert.False(t, testBuilder.Exists())
+
+		_, err := testBuilder.Create()
+		assert.Nil(t, err)
+		assert.True(t, testBuilder.Exists())
+
+		err = testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.False(t, testBuilder.ServiceAccount.Exists())
+	}
+}
+
+func TestBundleCreateRollback(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		Reactors: []clients.TestReactor{
+			clients.NewErrorReactor("create", "rolebindings", fmt.Errorf("rolebinding create failed")),
+		},
+	})
+
+	testBuilder := NewNamespacedBundleBuilder(
+		testSettings, defaultBundleName, defaultBundleNamespace, defaultBundleRules...)
+
+	_, err := testBuilder.Create()
+	assert.EqualError(t, err,
+		fmt.Sprintf("failed to create rbac bundle %s: rolebinding create failed", defaultBundleName))
+	assert.False(t, testBuilder.ServiceAccount.Exists())
+	assert.False(t, testBuilder.Role.Exists())
+}
+
+func TestCanI(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		allowed       bool
+		expectedError error
+	}{
+		{
+			client:        true,
+			allowed:       true,
+			expectedError: nil,
+		},
+		{
+			client:        true,
+			allowed:       false,
+			expectedError: nil,
+		},
+		{
+			client:        false,
+			expectedError: fmt.Errorf("selfsubjectaccessreview 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				Reactors: []clients.TestReactor{buildAccessReviewReactor("selfsubjectaccessreviews", testCase.allowed)},
+			})
+		}
+
+		allowed, err := CanI(testSettings, authv1.ResourceAttributes{Verb: "list", Resource: "pods"})
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.allowed, allowed)
+	}
+}
+
+func TestBundleCanI(t *testing.T) {
+	var reviewedUser string
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		Reactors: []clients.TestReactor{{
+			Verb:     "create",
+			Resource: "subjectaccessreviews",
+			Reaction: func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review, _ := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				reviewedUser = review.Spec.User
+				review.Status.Allowed = true
+
+				return true, review, nil
+			},
+		}},
+	})
+
+	testBuilder := NewNamespacedBundleBuilder(
+		testSettings, defaultBundleName, defaultBundleNamespace, defaultBundleRules...)
+
+	allowed, err := testBuilder.CanI("get", "", "pods", defaultBundleNamespace)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, fmt.Sprintf("system:serviceaccount:%s:%s", defaultBundleNamespace, defaultBundleName),
+		reviewedUser)
+}
+
+func TestSubjectCanI(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := SubjectCanI(testSettings, "", nil, authv1.ResourceAttributes{Verb: "get", Resource: "pods"})
+	assert.EqualError(t, err, "subjectaccessreview requires a 'user' or 'groups'")
+}
+
+func buildAccessReviewReactor(resource string, allowed bool) clients.TestReactor {
+	return clients.TestReactor{
+		Verb:     "create",
+		Resource: resource,
+		Reaction: func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review, _ := action.(k8stesting.CreateAction).GetObject().(*authv1.SelfSubjectAccessReview)
+			review.Status.Allowed = allowed
+
+			return true, review, nil
+		},
+	}
+}
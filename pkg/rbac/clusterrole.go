@@ -2,10 +2,12 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -24,8 +26,10 @@ type ClusterRoleBuilder struct {
 	Object *v1.ClusterRole
 	// Used in functions that define or mutate clusterrole definition. errorMsg is processed before clusterrole
 	// object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // ClusterRoleAdditionalOptions additional options for ClusterRole object.
@@ -33,7 +37,7 @@ type ClusterRoleAdditionalOptions func(builder *ClusterRoleBuilder) (*ClusterRol
 
 // NewClusterRoleBuilder creates new instance of ClusterRoleBuilder.
 func NewClusterRoleBuilder(apiClient *clients.Settings, name string, rule v1.PolicyRule) *ClusterRoleBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new clusterrole structure with the following params: "+
 			"name: %s, policy rule: %v",
 		name, rule)
@@ -44,14 +48,13 @@ func NewClusterRoleBuilder(apiClient *clients.Settings, name string, rule v1.Pol
 			ObjectMeta: metav1.ObjectMeta{
 				Name: name,
 			},
-			Rules: []v1.PolicyRule{rule},
 		},
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrole is empty")
+		logging.V(100).Infof("The name of the clusterrole is empty")
 
-		builder.errorMsg = "clusterrole 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterrole 'name' cannot be empty"))
 	}
 
 	builder.WithRules([]v1.PolicyRule{rule})
@@ -65,27 +68,27 @@ func (builder *ClusterRoleBuilder) WithRules(rules []v1.PolicyRule) *ClusterRole
 		return builder
 	}
 
-	glog.V(100).Infof("Appending to the definition of clusterrole %s these additional rules %v",
+	logging.V(100).Infof("Appending to the definition of clusterrole %s these additional rules %v",
 		builder.Definition.Name, rules)
 
 	if len(rules) == 0 {
-		glog.V(100).Infof("The list of rules is empty")
+		logging.V(100).Infof("The list of rules is empty")
 
-		builder.errorMsg = "cannot accept nil or empty slice as rules"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot accept nil or empty slice as rules"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
 	for _, rule := range rules {
 		if len(rule.Verbs) == 0 {
-			glog.V(100).Infof("The clusterrole rule must contain at least one Verb entry")
+			logging.V(100).Infof("The clusterrole rule must contain at least one Verb entry")
 
-			builder.errorMsg = "clusterrole rule must contain at least one Verb entry"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterrole rule must contain at least one Verb entry"))
 		}
 
-		if builder.errorMsg != "" {
+		if builder.errorMsg != nil {
 			return builder
 		}
 	}
@@ -107,16 +110,16 @@ func (builder *ClusterRoleBuilder) WithOptions(options ...ClusterRoleAdditionalO
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterRole additional options")
+	logging.V(100).Infof("Setting ClusterRole additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -128,7 +131,7 @@ func (builder *ClusterRoleBuilder) WithOptions(options ...ClusterRoleAdditionalO
 
 // PullClusterRole pulls existing clusterrole from cluster.
 func PullClusterRole(apiClient *clients.Settings, name string) (*ClusterRoleBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterrole name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing clusterrole name %s from cluster", name)
 
 	builder := ClusterRoleBuilder{
 		apiClient: apiClient,
@@ -140,9 +143,9 @@ func PullClusterRole(apiClient *clients.Settings, name string) (*ClusterRoleBuil
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrole is empty")
+		logging.V(100).Infof("The name of the clusterrole is empty")
 
-		builder.errorMsg = "clusterrole 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterrole 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -160,11 +163,15 @@ func (builder *ClusterRoleBuilder) Create() (*ClusterRoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating clusterrole %s",
+	logging.V(100).Infof("Creating clusterrole %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.ClusterRoles().Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -172,26 +179,64 @@ func (builder *ClusterRoleBuilder) Create() (*ClusterRoleBuilder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *ClusterRoleBuilder) WithDryRun(dryRun bool) *ClusterRoleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting dry-run to %t for clusterrole %s", dryRun, builder.Definition.Name)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the clusterrole definition against the API server and its admission webhooks with a server-side
+// dry-run request. The clusterrole is not created and the builder Object is not modified.
+func (builder *ClusterRoleBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Creating the clusterrole %s in dry-run mode", builder.Definition.Name)
+
+	_, err := builder.apiClient.ClusterRoles().Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Delete removes a clusterrole from the cluster.
 func (builder *ClusterRoleBuilder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Removing clusterrole %s",
+	logging.V(100).Infof("Removing clusterrole %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.ClusterRoles().Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return err
+	}
+
 	builder.Object = nil
 
 	return err
@@ -203,7 +248,7 @@ func (builder *ClusterRoleBuilder) Update() (*ClusterRoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterrole %s",
+	logging.V(100).Infof("Updating clusterrole %s",
 		builder.Definition.Name)
 
 	var err error
@@ -219,7 +264,7 @@ func (builder *ClusterRoleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterrole %s exists",
+	logging.V(100).Infof("Checking if clusterrole %s exists",
 		builder.Definition.Name)
 
 	var err error
@@ -229,33 +274,53 @@ func (builder *ClusterRoleBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the clusterrole definition with the clusterrole object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *ClusterRoleBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing clusterrole %s with its definition", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"clusterrole object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterRoleBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterRole"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
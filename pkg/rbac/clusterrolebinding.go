@@ -2,10 +2,12 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/rbac/v1"
@@ -22,8 +24,10 @@ type ClusterRoleBindingBuilder struct {
 	Object *v1.ClusterRoleBinding
 	// Used in functions that define or mutate clusterrolebinding definition.
 	// errorMsg is processed before the clusterrolebinding object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // ClusterRoleBindingAdditionalOptions additional options for ClusterRoleBinding object.
@@ -32,7 +36,7 @@ type ClusterRoleBindingAdditionalOptions func(builder *ClusterRoleBindingBuilder
 // NewClusterRoleBindingBuilder creates a new instance of ClusterRoleBindingBuilder.
 func NewClusterRoleBindingBuilder(
 	apiClient *clients.Settings, name, clusterRole string, subject v1.Subject) *ClusterRoleBindingBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new clusterrolebinding structure with the following params: "+
 			"name: %s, clusterrole: %s, subject %v",
 		name, clusterRole, subject)
@@ -54,9 +58,9 @@ func NewClusterRoleBindingBuilder(
 	builder.WithSubjects([]v1.Subject{subject})
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrolebinding is empty")
+		logging.V(100).Infof("The name of the clusterrolebinding is empty")
 
-		builder.errorMsg = "clusterrolebinding 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterrolebinding 'name' cannot be empty"))
 	}
 
 	return &builder
@@ -68,33 +72,34 @@ func (builder *ClusterRoleBindingBuilder) WithSubjects(subjects []v1.Subject) *C
 		return builder
 	}
 
-	glog.V(100).Infof("Appending to the definition of clusterrolebinding %s these additional subjects %v",
+	logging.V(100).Infof("Appending to the definition of clusterrolebinding %s these additional subjects %v",
 		builder.Definition.Name, subjects)
 
 	if len(subjects) == 0 {
-		glog.V(100).Infof("The list of subjects is empty")
+		logging.V(100).Infof("The list of subjects is empty")
 
-		builder.errorMsg = "cannot accept nil or empty slice as subjects"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot accept nil or empty slice as subjects"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
 	for _, subject := range subjects {
 		if !slices.Contains(allowedSubjectKinds(), subject.Kind) {
-			glog.V(100).Infof("The clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
+			logging.V(100).Infof("The clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
 
-			builder.errorMsg = "clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"clusterrolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'"))
 		}
 
 		if subject.Name == "" {
-			glog.V(100).Infof("The clusterrolebinding subject name cannot be empty")
+			logging.V(100).Infof("The clusterrolebinding subject name cannot be empty")
 
-			builder.errorMsg = "clusterrolebinding subject name cannot be empty"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterrolebinding subject name cannot be empty"))
 		}
 
-		if builder.errorMsg != "" {
+		if builder.errorMsg != nil {
 			return builder
 		}
 	}
@@ -111,16 +116,16 @@ func (builder *ClusterRoleBindingBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting ClusterRoleBinding additional options")
+	logging.V(100).Infof("Setting ClusterRoleBinding additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -132,7 +137,7 @@ func (builder *ClusterRoleBindingBuilder) WithOptions(
 
 // PullClusterRoleBinding pulls existing clusterrolebinding from cluster.
 func PullClusterRoleBinding(apiClient *clients.Settings, name string) (*ClusterRoleBindingBuilder, error) {
-	glog.V(100).Infof("Pulling existing clusterrolebinding name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing clusterrolebinding name %s from cluster", name)
 
 	builder := ClusterRoleBindingBuilder{
 		apiClient: apiClient,
@@ -144,9 +149,9 @@ func PullClusterRoleBinding(apiClient *clients.Settings, name string) (*ClusterR
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterrolebinding is empty")
+		logging.V(100).Infof("The name of the clusterrolebinding is empty")
 
-		builder.errorMsg = "clusterrolebinding 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterrolebinding 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -164,11 +169,15 @@ func (builder *ClusterRoleBindingBuilder) Create() (*ClusterRoleBindingBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating clusterrolebinding %s",
+	logging.V(100).Infof("Creating clusterrolebinding %s",
 		builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.ClusterRoleBindings().Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -176,26 +185,64 @@ func (builder *ClusterRoleBindingBuilder) Create() (*ClusterRoleBindingBuilder,
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *ClusterRoleBindingBuilder) WithDryRun(dryRun bool) *ClusterRoleBindingBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting dry-run to %t for clusterrolebinding %s", dryRun, builder.Definition.Name)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the clusterrolebinding definition against the API server and its admission webhooks with a
+// server-side dry-run request. The clusterrolebinding is not created and the builder Object is not modified.
+func (builder *ClusterRoleBindingBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Creating the clusterrolebinding %s in dry-run mode", builder.Definition.Name)
+
+	_, err := builder.apiClient.ClusterRoleBindings().Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Delete removes a clusterrolebinding from the cluster.
 func (builder *ClusterRoleBindingBuilder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Removing clusterrolebinding %s",
+	logging.V(100).Infof("Removing clusterrolebinding %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.ClusterRoleBindings().Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return err
+	}
+
 	builder.Object = nil
 
 	return err
@@ -207,7 +254,7 @@ func (builder *ClusterRoleBindingBuilder) Update() (*ClusterRoleBindingBuilder,
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating clusterrolebinding %s",
+	logging.V(100).Infof("Updating clusterrolebinding %s",
 		builder.Definition.Name)
 
 	var err error
@@ -223,7 +270,7 @@ func (builder *ClusterRoleBindingBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterrolebinding %s exists",
+	logging.V(100).Infof("Checking if clusterrolebinding %s exists",
 		builder.Definition.Name)
 
 	var err error
@@ -233,33 +280,53 @@ func (builder *ClusterRoleBindingBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the clusterrolebinding definition with the clusterrolebinding object on the cluster. Only the fields
+// set in the definition are compared, so fields populated by the API server are ignored.
+func (builder *ClusterRoleBindingBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing clusterrolebinding %s with its definition", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"clusterrolebinding object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterRoleBindingBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterRoleBinding"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -2,10 +2,12 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	v1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -21,8 +23,10 @@ type RoleBuilder struct {
 
 	// Used in functions that define or mutate role definition. errorMsg is processed
 	// before the role object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // RoleAdditionalOptions additional options for Role object.
@@ -30,7 +34,7 @@ type RoleAdditionalOptions func(builder *RoleBuilder) (*RoleBuilder, error)
 
 // NewRoleBuilder create a new instance of RoleBuilder.
 func NewRoleBuilder(apiClient *clients.Settings, name, nsname string, rule v1.PolicyRule) *RoleBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new role structure with the following params: "+
 			"name: %s, namespace: %s, rule %v", name, nsname, rule)
 
@@ -45,15 +49,15 @@ func NewRoleBuilder(apiClient *clients.Settings, name, nsname string, rule v1.Po
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the role is empty")
+		logging.V(100).Infof("The name of the role is empty")
 
-		builder.errorMsg = "Role 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Role 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the role is empty")
+		logging.V(100).Infof("The namespace of the role is empty")
 
-		builder.errorMsg = "Role 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Role 'nsname' cannot be empty"))
 	}
 
 	builder.WithRules([]v1.PolicyRule{rule})
@@ -67,39 +71,39 @@ func (builder *RoleBuilder) WithRules(rules []v1.PolicyRule) *RoleBuilder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding to role %s the following rules: %v",
+	logging.V(100).Infof("Adding to role %s the following rules: %v",
 		builder.Definition.Name, rules)
 
 	if len(rules) == 0 {
-		glog.V(100).Infof("The list of rules is empty")
+		logging.V(100).Infof("The list of rules is empty")
 
-		builder.errorMsg = "cannot create role with empty rule"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot create role with empty rule"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
 	for _, rule := range rules {
 		if len(rule.Verbs) == 0 {
-			glog.V(100).Infof("The role has no verbs")
+			logging.V(100).Infof("The role has no verbs")
 
-			builder.errorMsg = "role must contain at least one Verb"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("role must contain at least one Verb"))
 		}
 
 		if len(rule.Resources) == 0 {
-			glog.V(100).Infof("The role has no resources")
+			logging.V(100).Infof("The role has no resources")
 
-			builder.errorMsg = "role must contain at least one Resource"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("role must contain at least one Resource"))
 		}
 
 		if len(rule.APIGroups) == 0 {
-			glog.V(100).Infof("The role has no apigroups")
+			logging.V(100).Infof("The role has no apigroups")
 
-			builder.errorMsg = "role must contain at least one APIGroup"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("role must contain at least one APIGroup"))
 		}
 
-		if builder.errorMsg != "" {
+		if builder.errorMsg != nil {
 			return builder
 		}
 	}
@@ -120,16 +124,16 @@ func (builder *RoleBuilder) WithOptions(
 		return builder
 	}
 
-	glog.V(100).Infof("Setting Role additional options")
+	logging.V(100).Infof("Setting Role additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -141,7 +145,7 @@ func (builder *RoleBuilder) WithOptions(
 
 // PullRole pulls existing role from cluster.
 func PullRole(apiClient *clients.Settings, name, nsname string) (*RoleBuilder, error) {
-	glog.V(100).Infof("Pulling existing role name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing role name %s under namespace %s from cluster", name, nsname)
 
 	builder := RoleBuilder{
 		apiClient: apiClient,
@@ -154,15 +158,15 @@ func PullRole(apiClient *clients.Settings, name, nsname string) (*RoleBuilder, e
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the role is empty")
+		logging.V(100).Infof("The name of the role is empty")
 
-		builder.errorMsg = "role 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("role 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the role is empty")
+		logging.V(100).Infof("The namespace of the role is empty")
 
-		builder.errorMsg = "role 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("role 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -180,11 +184,15 @@ func (builder *RoleBuilder) Create() (*RoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating role %s under namespace %s",
+	logging.V(100).Infof("Creating role %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.Roles(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -192,26 +200,67 @@ func (builder *RoleBuilder) Create() (*RoleBuilder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *RoleBuilder) WithDryRun(dryRun bool) *RoleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for role %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the role definition against the API server and its admission webhooks with a server-side
+// dry-run request. The role is not created and the builder Object is not modified.
+func (builder *RoleBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the role %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.Roles(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Delete removes a Role.
 func (builder *RoleBuilder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Removing role %s under namespace %s",
+	logging.V(100).Infof("Removing role %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.Roles(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return err
+	}
+
 	builder.Object = nil
 
 	return err
@@ -223,7 +272,7 @@ func (builder *RoleBuilder) Update() (*RoleBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating role %s under namespace %s",
+	logging.V(100).Infof("Updating role %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -239,7 +288,7 @@ func (builder *RoleBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if role %s exists under namespace %s",
+	logging.V(100).Infof("Checking if role %s exists under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -249,33 +298,55 @@ func (builder *RoleBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the role definition with the role object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *RoleBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing role %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"role object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *RoleBuilder) validate() (bool, error) {
 	resourceCRD := "Role"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
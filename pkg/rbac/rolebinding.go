@@ -2,10 +2,12 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/rbac/v1"
@@ -23,8 +25,10 @@ type RoleBindingBuilder struct {
 
 	// Used in functions that define or mutate rolebinding definition. errorMsg is processed
 	// before the rolebinding object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // RoleBindingAdditionalOptions additional options for RoleBinding object.
@@ -34,7 +38,7 @@ type RoleBindingAdditionalOptions func(builder *RoleBindingBuilder) (*RoleBindin
 func NewRoleBindingBuilder(apiClient *clients.Settings,
 	name, nsname, role string,
 	subject v1.Subject) *RoleBindingBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new rolebinding structure with the following params: "+
 			"name: %s, namespace: %s, role: %s, subject %v", name, nsname, role, subject)
 
@@ -54,15 +58,15 @@ func NewRoleBindingBuilder(apiClient *clients.Settings,
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the rolebinding is empty")
+		logging.V(100).Infof("The name of the rolebinding is empty")
 
-		builder.errorMsg = "RoleBinding 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("RoleBinding 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the rolebinding is empty")
+		logging.V(100).Infof("The namespace of the rolebinding is empty")
 
-		builder.errorMsg = "RoleBinding 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("RoleBinding 'nsname' cannot be empty"))
 	}
 
 	builder.WithSubjects([]v1.Subject{subject})
@@ -76,33 +80,34 @@ func (builder *RoleBindingBuilder) WithSubjects(subjects []v1.Subject) *RoleBind
 		return builder
 	}
 
-	glog.V(100).Infof("Adding to the rolebinding %s these specified subjects: %v",
+	logging.V(100).Infof("Adding to the rolebinding %s these specified subjects: %v",
 		builder.Definition.Name, subjects)
 
 	if len(subjects) == 0 {
-		glog.V(100).Infof("The list of subjects is empty")
+		logging.V(100).Infof("The list of subjects is empty")
 
-		builder.errorMsg = "cannot create rolebinding with empty subject"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot create rolebinding with empty subject"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
 	for _, subject := range subjects {
 		if !slices.Contains(allowedSubjectKinds(), subject.Kind) {
-			glog.V(100).Infof("The rolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
+			logging.V(100).Infof("The rolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'")
 
-			builder.errorMsg = "rolebinding subject kind must be one of 'ServiceAccount', 'User', 'Group'"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"rolebinding subject kind must be one of 'ServiceAccount', 'User', 'Group'"))
 		}
 
 		if subject.Name == "" {
-			glog.V(100).Infof("The rolebinding subject name cannot be empty")
+			logging.V(100).Infof("The rolebinding subject name cannot be empty")
 
-			builder.errorMsg = "rolebinding subject name cannot be empty"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("rolebinding subject name cannot be empty"))
 		}
 
-		if builder.errorMsg != "" {
+		if builder.errorMsg != nil {
 			return builder
 		}
 	}
@@ -117,16 +122,16 @@ func (builder *RoleBindingBuilder) WithOptions(options ...RoleBindingAdditionalO
 		return builder
 	}
 
-	glog.V(100).Infof("Setting RoleBinding additional options")
+	logging.V(100).Infof("Setting RoleBinding additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -138,7 +143,7 @@ func (builder *RoleBindingBuilder) WithOptions(options ...RoleBindingAdditionalO
 
 // PullRoleBinding pulls existing rolebinding from cluster.
 func PullRoleBinding(apiClient *clients.Settings, name, nsname string) (*RoleBindingBuilder, error) {
-	glog.V(100).Infof("Pulling existing rolebinding name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing rolebinding name %s under namespace %s from cluster", name, nsname)
 
 	builder := RoleBindingBuilder{
 		apiClient: apiClient,
@@ -151,15 +156,15 @@ func PullRoleBinding(apiClient *clients.Settings, name, nsname string) (*RoleBin
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the rolebinding is empty")
+		logging.V(100).Infof("The name of the rolebinding is empty")
 
-		builder.errorMsg = "rolebinding 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("rolebinding 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the rolebinding is empty")
+		logging.V(100).Infof("The namespace of the rolebinding is empty")
 
-		builder.errorMsg = "rolebinding 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("rolebinding 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -177,11 +182,15 @@ func (builder *RoleBindingBuilder) Create() (*RoleBindingBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating rolebinding %s under namespace %s",
+	logging.V(100).Infof("Creating rolebinding %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.RoleBindings(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -189,21 +198,62 @@ func (builder *RoleBindingBuilder) Create() (*RoleBindingBuilder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *RoleBindingBuilder) WithDryRun(dryRun bool) *RoleBindingBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for rolebinding %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the rolebinding definition against the API server and its admission webhooks with a server-side
+// dry-run request. The rolebinding is not created and the builder Object is not modified.
+func (builder *RoleBindingBuilder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the rolebinding %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.RoleBindings(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Delete removes a RoleBinding.
 func (builder *RoleBindingBuilder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Removing rolebinding %s under namespace %s",
+	logging.V(100).Infof("Removing rolebinding %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.RoleBindings(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
+
+	if builder.dryRun {
+		return err
+	}
 
 	builder.Object = nil
 
@@ -216,7 +266,7 @@ func (builder *RoleBindingBuilder) Update() (*RoleBindingBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating rolebinding %s under namespace %s",
+	logging.V(100).Infof("Updating rolebinding %s under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -232,7 +282,7 @@ func (builder *RoleBindingBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if rolebinding %s exists under namespace %s",
+	logging.V(100).Infof("Checking if rolebinding %s exists under namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -242,33 +292,55 @@ func (builder *RoleBindingBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the rolebinding definition with the rolebinding object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *RoleBindingBuilder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing rolebinding %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"rolebinding object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *RoleBindingBuilder) validate() (bool, error) {
 	resourceCRD := "RoleBinding"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
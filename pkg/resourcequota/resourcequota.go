@@ -0,0 +1,583 @@
+package resourcequota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Builder provides struct for resourcequota object containing connection to the cluster and the resourcequota
+// definitions.
+type Builder struct {
+	// ResourceQuota definition. Used to create the resourcequota object.
+	Definition *corev1.ResourceQuota
+	// Created resourcequota object.
+	Object *corev1.ResourceQuota
+	// Used in functions that define or mutate resourcequota definition. errorMsg is processed before the
+	// resourcequota object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// AdditionalOptions additional options for resourcequota object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	logging.V(100).Infof(
+		"Initializing new resourcequota structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the resourcequota is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the resourcequota is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// Pull loads an existing resourcequota into Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing resourcequota name: %s under namespace: %s", name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota 'namespace' cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("resourcequota object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithHardLimit sets the hard limit of a single resource, e.g. "requests.cpu" to "2" or "pods" to "10". A limit
+// already set for the resource is overwritten.
+func (builder *Builder) WithHardLimit(resourceName corev1.ResourceName, quantity string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting hard limit %s=%s in resourcequota %s in namespace %s",
+		resourceName, quantity, builder.Definition.Name, builder.Definition.Namespace)
+
+	if resourceName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota 'resourceName' cannot be empty"))
+
+		return builder
+	}
+
+	parsedQuantity, err := resource.ParseQuantity(quantity)
+	if err != nil {
+		logging.V(100).Infof("The quantity %s of the resourcequota is invalid: %s", quantity, err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("resourcequota hard limit of %s is invalid: %w", resourceName, err))
+
+		return builder
+	}
+
+	if parsedQuantity.Sign() < 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("resourcequota hard limit of %s cannot be negative", resourceName))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Hard == nil {
+		builder.Definition.Spec.Hard = corev1.ResourceList{}
+	}
+
+	builder.Definition.Spec.Hard[resourceName] = parsedQuantity
+
+	return builder
+}
+
+// WithHardLimits sets the hard limits of all resources in the list, overwriting the limits already set for them.
+func (builder *Builder) WithHardLimits(hard corev1.ResourceList) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting hard limits %v in resourcequota %s in namespace %s",
+		hard, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(hard) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota 'hard' cannot be empty"))
+
+		return builder
+	}
+
+	for resourceName, quantity := range hard {
+		if quantity.Sign() < 0 {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("resourcequota hard limit of %s cannot be negative", resourceName))
+
+			return builder
+		}
+	}
+
+	if builder.Definition.Spec.Hard == nil {
+		builder.Definition.Spec.Hard = corev1.ResourceList{}
+	}
+
+	for resourceName, quantity := range hard {
+		builder.Definition.Spec.Hard[resourceName] = quantity.DeepCopy()
+	}
+
+	return builder
+}
+
+// WithScopes restricts the resourcequota to the objects matching every given scope, e.g. Terminating or BestEffort.
+func (builder *Builder) WithScopes(scopes ...corev1.ResourceQuotaScope) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting scopes %v in resourcequota %s in namespace %s",
+		scopes, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(scopes) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota 'scopes' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Scopes = append(builder.Definition.Spec.Scopes, scopes...)
+
+	return builder
+}
+
+// WithScopeSelector appends a scope selector expression to the resourcequota. The In and NotIn operators require
+// values, e.g. the names of the PriorityClasses matched by the PriorityClass scope, while Exists and DoesNotExist
+// must not have any.
+func (builder *Builder) WithScopeSelector(
+	scope corev1.ResourceQuotaScope, operator corev1.ScopeSelectorOperator, values ...string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding scope selector %s %s %v to resourcequota %s in namespace %s",
+		scope, operator, values, builder.Definition.Name, builder.Definition.Namespace)
+
+	if scope == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("resourcequota scope selector 'scope' cannot be empty"))
+
+		return builder
+	}
+
+	switch operator {
+	case corev1.ScopeSelectorOpIn, corev1.ScopeSelectorOpNotIn:
+		if len(values) == 0 {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("resourcequota scope selector operator %s requires values", operator))
+
+			return builder
+		}
+	case corev1.ScopeSelectorOpExists, corev1.ScopeSelectorOpDoesNotExist:
+		if len(values) != 0 {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("resourcequota scope selector operator %s cannot have values", operator))
+
+			return builder
+		}
+	default:
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("resourcequota scope selector operator %s is not supported", operator))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.ScopeSelector == nil {
+		builder.Definition.Spec.ScopeSelector = &corev1.ScopeSelector{}
+	}
+
+	builder.Definition.Spec.ScopeSelector.MatchExpressions = append(
+		builder.Definition.Spec.ScopeSelector.MatchExpressions, corev1.ScopedResourceSelectorRequirement{
+			ScopeName: scope,
+			Operator:  operator,
+			Values:    values,
+		})
+
+	return builder
+}
+
+// WithOptions creates resourcequota with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting resourcequota additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a resourcequota in cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(builder.Definition.Spec.Hard) == 0 {
+		logging.V(100).Infof("The resourcequota has no hard limits set")
+
+		return builder, fmt.Errorf("resourcequota must have at least one hard limit")
+	}
+
+	var err error
+	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
+		builder.Object, err = builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for resourcequota %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the resourcequota definition against the API server and its admission webhooks with a server-side
+// dry-run request. The resourcequota is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the resourcequota %s in namespace %s in dry-run mode",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the existing resourcequota object with the resourcequota definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent resourcequota %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Exists checks whether the given resourcequota exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if resourcequota %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the resourcequota from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.ResourceQuotas(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetUsed returns the usage of every quota-tracked resource as reported in the resourcequota status.
+func (builder *Builder) GetUsed() (corev1.ResourceList, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting used resources of resourcequota %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("resourcequota object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Used, nil
+}
+
+// GetUsage returns the used and hard quantities of a single resource as reported in the resourcequota status. It
+// returns an error when the quota controller does not track the resource yet.
+func (builder *Builder) GetUsage(resourceName corev1.ResourceName) (resource.Quantity, resource.Quantity, error) {
+	if valid, err := builder.validate(); !valid {
+		return resource.Quantity{}, resource.Quantity{}, err
+	}
+
+	logging.V(100).Infof("Getting usage of %s in resourcequota %s in namespace %s",
+		resourceName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return resource.Quantity{}, resource.Quantity{}, fmt.Errorf(
+			"resourcequota object %s doesn't exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	hard, found := builder.Object.Status.Hard[resourceName]
+	if !found {
+		return resource.Quantity{}, resource.Quantity{}, fmt.Errorf(
+			"resource %s is not tracked in the status of resourcequota %s in namespace %s",
+			resourceName, builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Used[resourceName], hard, nil
+}
+
+// WaitUntilStatusSynced waits for the defined period until the quota controller reports in the status the hard
+// limits of the resourcequota spec, meaning that the usage has been calculated against the current limits.
+func (builder *Builder) WaitUntilStatusSynced(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until status of resourcequota %s in namespace %s is synced",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			if len(builder.Object.Status.Hard) != len(builder.Object.Spec.Hard) {
+				return false, nil
+			}
+
+			for resourceName, quantity := range builder.Object.Spec.Hard {
+				statusQuantity, found := builder.Object.Status.Hard[resourceName]
+				if !found || statusQuantity.Cmp(quantity) != 0 {
+					return false, nil
+				}
+
+				if _, found := builder.Object.Status.Used[resourceName]; !found {
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+}
+
+// WaitUntilUsed waits for the defined period until the status of the resourcequota reports the given usage of the
+// resource, e.g. after creating or deleting the objects it accounts for.
+func (builder *Builder) WaitUntilUsed(
+	resourceName corev1.ResourceName, quantity string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until resourcequota %s in namespace %s reports %s=%s used",
+		builder.Definition.Name, builder.Definition.Namespace, resourceName, quantity)
+
+	expected, err := resource.ParseQuantity(quantity)
+	if err != nil {
+		return fmt.Errorf("resourcequota used quantity of %s is invalid: %w", resourceName, err)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			used, found := builder.Object.Status.Used[resourceName]
+
+			return found && used.Cmp(expected) == 0, nil
+		})
+}
+
+// GetGVR returns resourcequota's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "", Version: "v1", Resource: "resourcequotas"}
+}
+
+// GetGVR returns the resourcequota GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// Diff compares the resourcequota definition with the resourcequota object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing resourcequota %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"resourcequota object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "ResourceQuota"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,368 @@
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultQuotaName      = "test-quota"
+	defaultQuotaNamespace = "test-namespace"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		expectedError string
+	}{
+		{
+			name:          defaultQuotaName,
+			nsname:        defaultQuotaNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultQuotaNamespace,
+			expectedError: "resourcequota 'name' cannot be empty",
+		},
+		{
+			name:          defaultQuotaName,
+			nsname:        "",
+			expectedError: "resourcequota 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.nsname)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			name:          defaultQuotaName,
+			addToRuntime:  true,
+			expectedError: "",
+		},
+		{
+			name:          defaultQuotaName,
+			addToRuntime:  false,
+			expectedError: "resourcequota object test-quota doesn't exist in namespace test-namespace",
+		},
+		{
+			name:          "",
+			addToRuntime:  false,
+			expectedError: "resourcequota 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyQuota(nil, nil))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := Pull(testSettings, testCase.name, defaultQuotaNamespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestWithHardLimit(t *testing.T) {
+	testCases := []struct {
+		resourceName  corev1.ResourceName
+		quantity      string
+		expectedError string
+	}{
+		{
+			resourceName:  corev1.ResourceRequestsCPU,
+			quantity:      "500m",
+			expectedError: "",
+		},
+		{
+			resourceName:  "",
+			quantity:      "1",
+			expectedError: "resourcequota 'resourceName' cannot be empty",
+		},
+		{
+			resourceName:  corev1.ResourcePods,
+			quantity:      "ten",
+			expectedError: "resourcequota hard limit of pods is invalid: quantities must match the regular expression",
+		},
+		{
+			resourceName:  corev1.ResourcePods,
+			quantity:      "-1",
+			expectedError: "resourcequota hard limit of pods cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidQuotaBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithHardLimit(testCase.resourceName, testCase.quantity)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, testBuilder.errorMsg)
+		assert.Equal(t, resource.MustParse(testCase.quantity), testBuilder.Definition.Spec.Hard[testCase.resourceName])
+	}
+}
+
+func TestWithScopeSelector(t *testing.T) {
+	testCases := []struct {
+		scope         corev1.ResourceQuotaScope
+		operator      corev1.ScopeSelectorOperator
+		values        []string
+		expectedError string
+	}{
+		{
+			scope:    corev1.ResourceQuotaScopePriorityClass,
+			operator: corev1.ScopeSelectorOpIn,
+			values:   []string{"high-priority"},
+		},
+		{
+			scope:    corev1.ResourceQuotaScopeBestEffort,
+			operator: corev1.ScopeSelectorOpExists,
+		},
+		{
+			scope:         corev1.ResourceQuotaScopePriorityClass,
+			operator:      corev1.ScopeSelectorOpNotIn,
+			expectedError: "resourcequota scope selector operator NotIn requires values",
+		},
+		{
+			scope:         corev1.ResourceQuotaScopeTerminating,
+			operator:      corev1.ScopeSelectorOpDoesNotExist,
+			values:        []string{"value"},
+			expectedError: "resourcequota scope selector operator DoesNotExist cannot have values",
+		},
+		{
+			scope:         corev1.ResourceQuotaScopeTerminating,
+			operator:      "Equals",
+			expectedError: "resourcequota scope selector operator Equals is not supported",
+		},
+		{
+			scope:         "",
+			operator:      corev1.ScopeSelectorOpExists,
+			expectedError: "resourcequota scope selector 'scope' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidQuotaBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithScopeSelector(testCase.scope, testCase.operator, testCase.values...)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, []corev1.ScopedResourceSelectorRequirement{{
+			ScopeName: testCase.scope,
+			Operator:  testCase.operator,
+			Values:    testCase.values,
+		}}, testBuilder.Definition.Spec.ScopeSelector.MatchExpressions)
+	}
+}
+
+func TestCreateUpdateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := buildValidQuotaBuilder(testSettings).Create()
+	assert.EqualError(t, err, "resourcequota must have at least one hard limit")
+
+	testBuilder, err := buildValidQuotaBuilder(testSettings).
+		WithHardLimit(corev1.ResourcePods, "2").
+		WithScopes(corev1.ResourceQuotaScopeNotTerminating).
+		Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	testBuilder, err = testBuilder.WithHardLimits(corev1.ResourceList{
+		corev1.ResourceLimitsMemory: resource.MustParse("1Gi"),
+	}).Update()
+	assert.Nil(t, err)
+	assert.Len(t, testBuilder.Object.Spec.Hard, 2)
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestGetUsage(t *testing.T) {
+	testCases := []struct {
+		resourceName  corev1.ResourceName
+		addToRuntime  bool
+		expectedUsed  string
+		expectedHard  string
+		expectedError string
+	}{
+		{
+			resourceName: corev1.ResourcePods,
+			addToRuntime: true,
+			expectedUsed: "1",
+			expectedHard: "2",
+		},
+		{
+			resourceName: corev1.ResourceServices,
+			addToRuntime: true,
+			expectedError: "resource services is not tracked in the status of resourcequota test-quota in namespace " +
+				"test-namespace",
+		},
+		{
+			resourceName:  corev1.ResourcePods,
+			addToRuntime:  false,
+			expectedError: "resourcequota object test-quota doesn't exist in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyQuota(
+				corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+				corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")}))
+		}
+
+		testBuilder := buildValidQuotaBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		used, hard, err := testBuilder.GetUsage(testCase.resourceName)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, 0, used.Cmp(resource.MustParse(testCase.expectedUsed)))
+		assert.Equal(t, 0, hard.Cmp(resource.MustParse(testCase.expectedHard)))
+	}
+}
+
+func TestWaiters(t *testing.T) {
+	testCases := []struct {
+		statusHard    corev1.ResourceList
+		used          string
+		expectedError error
+	}{
+		{
+			statusHard:    corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+			used:          "1",
+			expectedError: nil,
+		},
+		{
+			statusHard:    nil,
+			used:          "1",
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var used corev1.ResourceList
+
+		if testCase.statusHard != nil {
+			used = corev1.ResourceList{corev1.ResourcePods: resource.MustParse(testCase.used)}
+		}
+
+		testBuilder := buildValidQuotaBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyQuota(testCase.statusHard, used)},
+		}))
+
+		err := testBuilder.WaitUntilStatusSynced(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+
+		err = testBuilder.WaitUntilUsed(corev1.ResourcePods, testCase.used, time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidQuotaBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultQuotaName, defaultQuotaNamespace)
+}
+
+func buildDummyQuota(statusHard, used corev1.ResourceList) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultQuotaName,
+			Namespace: defaultQuotaNamespace,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: statusHard,
+			Used: used,
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidQuotaBuilder(testSettings).WithHardLimit(corev1.ResourcePods, "2")
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("resourcequota object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "resourcequotas", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidQuotaBuilder(testSettings).WithHardLimit(corev1.ResourcePods, "2").
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := buildValidQuotaBuilder(existingSettings).WithHardLimit(corev1.ResourcePods, "2").
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
@@ -2,20 +2,32 @@ package route
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/strings/slices"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	maxBackendWeight     = 256
+	maxAlternateBackends = 3
+)
+
 // Builder provides struct for route object containing connection to the cluster and the route definitions.
 type Builder struct {
 	// Route definition. Used to create a route object
@@ -24,13 +36,13 @@ type Builder struct {
 	Object *routev1.Route
 	// Used in functions that define or mutate the route definition.
 	// errorMsg is processed before the route object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
 }
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname, serviceName string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new route structure with the following params: name: %s, namespace: %s, serviceName: %s",
 		name, nsname, serviceName)
 
@@ -51,21 +63,21 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, serviceName string) *
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the route is empty")
+		logging.V(100).Infof("The name of the route is empty")
 
-		builder.errorMsg = "route 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("route 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the route is empty")
+		logging.V(100).Infof("The namespace of the route is empty")
 
-		builder.errorMsg = "route 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("route 'nsname' cannot be empty"))
 	}
 
 	if serviceName == "" {
-		glog.V(100).Infof("The serviceName of the route is empty")
+		logging.V(100).Infof("The serviceName of the route is empty")
 
-		builder.errorMsg = "route 'serviceName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("route 'serviceName' cannot be empty"))
 	}
 
 	return &builder
@@ -73,7 +85,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, serviceName string) *
 
 // Pull loads existing route from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing route name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing route name %s under namespace %s from cluster", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -86,13 +98,13 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the route is empty")
+		logging.V(100).Infof("The name of the route is empty")
 
 		return nil, fmt.Errorf("route 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the route is empty")
+		logging.V(100).Infof("The namespace of the route is empty")
 
 		return nil, fmt.Errorf("route 'namespace' cannot be empty")
 	}
@@ -112,7 +124,7 @@ func (builder *Builder) WithTargetPortNumber(port int32) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding target port %d to route %s in namespace %s",
+	logging.V(100).Infof("Adding target port %d to route %s in namespace %s",
 		port, builder.Definition.Name, builder.Definition.Namespace)
 
 	if builder.Definition.Spec.Port == nil {
@@ -130,16 +142,16 @@ func (builder *Builder) WithTargetPortName(portName string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding target port %s to route %s in namespace %s",
+	logging.V(100).Infof("Adding target port %s to route %s in namespace %s",
 		portName, builder.Definition.Name, builder.Definition.Namespace)
 
 	if portName == "" {
-		glog.V(100).Infof("Received empty route portName")
+		logging.V(100).Infof("Received empty route portName")
 
-		builder.errorMsg = "route target port name cannot be empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("route target port name cannot be empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -158,17 +170,18 @@ func (builder *Builder) WithWildCardPolicy(wildcardPolicy string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Adding wildcardPolicy %s to route %s in namespace %s",
+	logging.V(100).Infof("Adding wildcardPolicy %s to route %s in namespace %s",
 		wildcardPolicy, builder.Definition.Name, builder.Definition.Namespace)
 
 	if !slices.Contains(supportedWildCardPolicies(), wildcardPolicy) {
-		glog.V(100).Infof("Received unsupported route wildcardPolicy, supported policies: %v", supportedWildCardPolicies())
+		logging.V(100).Infof("Received unsupported route wildcardPolicy, supported policies: %v", supportedWildCardPolicies())
 
-		builder.errorMsg = fmt.Sprintf("received unsupported route wildcardPolicy: supported policies %v",
-			supportedWildCardPolicies())
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"received unsupported route wildcardPolicy: supported policies %v",
+			supportedWildCardPolicies()))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -177,13 +190,99 @@ func (builder *Builder) WithWildCardPolicy(wildcardPolicy string) *Builder {
 	return builder
 }
 
+// WithPath restricts the route to requests whose path starts with the given prefix.
+func (builder *Builder) WithPath(path string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding path %s to route %s in namespace %s",
+		path, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !strings.HasPrefix(path, "/") {
+		logging.V(100).Infof("Received route path %s which is not absolute", path)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("route path '%s' must start with '/'", path))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Path = path
+
+	return builder
+}
+
+// WithAlternateBackends splits the route traffic between services according to the given weights. The key is the
+// service name and the value its relative weight between 0 and 256. A weight for the route primary service sets the
+// weight of spec.to, every other service becomes one of up to 3 alternate backends replacing any previously set.
+func (builder *Builder) WithAlternateBackends(weights map[string]int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting backend weights %v on route %s in namespace %s",
+		weights, builder.Definition.Name, builder.Definition.Namespace)
+
+	serviceNames := make([]string, 0, len(weights))
+
+	for serviceName, weight := range weights {
+		if serviceName == "" {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("route backend service name cannot be empty"))
+
+			continue
+		}
+
+		if weight < 0 || weight > maxBackendWeight {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"route backend %s weight %d is out of range, must be between 0 and %d",
+				serviceName, weight, maxBackendWeight))
+		}
+
+		if serviceName != builder.Definition.Spec.To.Name {
+			serviceNames = append(serviceNames, serviceName)
+		}
+	}
+
+	if len(serviceNames) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("route requires at least one alternate backend"))
+	}
+
+	if len(serviceNames) > maxAlternateBackends {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"route supports at most %d alternate backends, received %d", maxAlternateBackends, len(serviceNames)))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	if weight, ok := weights[builder.Definition.Spec.To.Name]; ok {
+		builder.Definition.Spec.To.Weight = &weight
+	}
+
+	// Map iteration order is random, sorting keeps the definition stable between calls.
+	sort.Strings(serviceNames)
+
+	builder.Definition.Spec.AlternateBackends = nil
+
+	for _, serviceName := range serviceNames {
+		weight := weights[serviceName]
+		builder.Definition.Spec.AlternateBackends = append(builder.Definition.Spec.AlternateBackends,
+			routev1.RouteTargetReference{Kind: "Service", Name: serviceName, Weight: &weight})
+	}
+
+	return builder
+}
+
 // Exists checks whether the given route exists.
 func (builder *Builder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if route %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -199,7 +298,7 @@ func (builder *Builder) Get() (*routev1.Route, error) {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Getting route %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -223,7 +322,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the route %s in namespace %s",
+	logging.V(100).Infof("Creating the route %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -243,7 +342,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the route %s in namespace %s",
+	logging.V(100).Infof("Deleting the route %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -261,33 +360,115 @@ func (builder *Builder) Delete() (*Builder, error) {
 	return builder, nil
 }
 
+// Update renovates the existing route object with the route definition in builder.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the route %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("route %s in namespace %s cannot be updated because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	if err == nil {
+		builder.Object = builder.Definition
+	}
+
+	return builder, err
+}
+
+// WaitUntilAdmitted waits for the defined period until the route is admitted. When routerName is set only the
+// ingress status reported by that router shard is considered, otherwise every shard that picked up the route must
+// admit it. A shard explicitly rejecting the route, for example because the host is already claimed, fails the wait
+// immediately.
+func (builder *Builder) WaitUntilAdmitted(routerName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until route %s in namespace %s is admitted by router %q",
+		builder.Definition.Name, builder.Definition.Namespace, routerName)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			route, err := builder.Get()
+			if err != nil {
+				logging.V(100).Infof("Failed to get route %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			admittedShards := 0
+
+			for _, ingress := range route.Status.Ingress {
+				if routerName != "" && ingress.RouterName != routerName {
+					continue
+				}
+
+				condition := getAdmittedCondition(ingress)
+				if condition == nil {
+					return false, nil
+				}
+
+				if condition.Status == corev1.ConditionFalse {
+					return false, fmt.Errorf("route %s in namespace %s was rejected by router %s: %s: %s",
+						route.Name, route.Namespace, ingress.RouterName, condition.Reason, condition.Message)
+				}
+
+				if condition.Status != corev1.ConditionTrue {
+					return false, nil
+				}
+
+				admittedShards++
+			}
+
+			return admittedShards > 0, nil
+		})
+}
+
+// GetGVR returns the route GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "route.openshift.io", Version: "v1", Resource: "routes",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Route"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -299,3 +480,14 @@ func supportedWildCardPolicies() []string {
 		"None",
 	}
 }
+
+// getAdmittedCondition returns the Admitted condition of the router ingress or nil if it is not reported yet.
+func getAdmittedCondition(ingress routev1.RouteIngress) *routev1.RouteIngressCondition {
+	for index := range ingress.Conditions {
+		if ingress.Conditions[index].Type == routev1.RouteAdmitted {
+			return &ingress.Conditions[index]
+		}
+	}
+
+	return nil
+}
@@ -3,13 +3,16 @@ package route
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	routev1 "github.com/openshift/api/route/v1"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 )
 
 func TestNewBuilder(t *testing.T) {
@@ -47,7 +50,7 @@ func TestNewBuilder(t *testing.T) {
 
 	for _, test := range testcases {
 		testBuilder := NewBuilder(nil, test.name, test.namespace, test.targetService)
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrMsg, testBuilder.errorMsg)
 	}
 }
 
@@ -172,7 +175,7 @@ func TestWithTargetPortName(t *testing.T) {
 		testBuilder := buildValidTestBuilder()
 		testBuilder.WithTargetPortName(test.name)
 
-		assert.Equal(t, test.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrMsg, testBuilder.errorMsg)
 	}
 }
 
@@ -203,6 +206,129 @@ func TestWithWildCardPolicy(t *testing.T) {
 		testBuilder := buildValidTestBuilder()
 		testBuilder.WithWildCardPolicy(test.policy)
 
-		assert.Equal(t, test.expectedErrMsg, testBuilder.errorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrMsg, testBuilder.errorMsg)
+	}
+}
+
+func TestWithPath(t *testing.T) {
+	testCases := []struct {
+		path           string
+		expectedErrMsg string
+	}{
+		{
+			"/api",
+			"",
+		},
+		{
+			"api",
+			"route path 'api' must start with '/'",
+		},
+	}
+
+	for _, test := range testCases {
+		testBuilder := buildValidTestBuilder()
+		testBuilder.WithPath(test.path)
+
+		if testhelper.AssertErrorMsg(t, test.expectedErrMsg, testBuilder.errorMsg) {
+			assert.Equal(t, test.path, testBuilder.Definition.Spec.Path)
+		}
+	}
+}
+
+func TestWithAlternateBackends(t *testing.T) {
+	testCases := []struct {
+		weights        map[string]int32
+		expectedErrMsg string
+	}{
+		{
+			map[string]int32{"route-test-service": 80, "canary-b": 10, "canary-a": 10},
+			"",
+		},
+		{
+			map[string]int32{"route-test-service": 100},
+			"route requires at least one alternate backend",
+		},
+		{
+			map[string]int32{"canary": 300},
+			"route backend canary weight 300 is out of range, must be between 0 and 256",
+		},
+		{
+			map[string]int32{"a": 1, "b": 1, "c": 1, "d": 1},
+			"route supports at most 3 alternate backends, received 4",
+		},
+	}
+
+	for _, test := range testCases {
+		testBuilder := buildValidTestBuilder()
+		testBuilder.WithAlternateBackends(test.weights)
+
+		if testhelper.AssertErrorMsg(t, test.expectedErrMsg, testBuilder.errorMsg) {
+			assert.Equal(t, int32(80), *testBuilder.Definition.Spec.To.Weight)
+			assert.Len(t, testBuilder.Definition.Spec.AlternateBackends, 2)
+			assert.Equal(t, "canary-a", testBuilder.Definition.Spec.AlternateBackends[0].Name)
+			assert.Equal(t, "canary-b", testBuilder.Definition.Spec.AlternateBackends[1].Name)
+		}
+	}
+}
+
+func TestWaitUntilAdmitted(t *testing.T) {
+	testCases := []struct {
+		routerName     string
+		ingress        []routev1.RouteIngress
+		expectedErrMsg string
+	}{
+		{
+			routerName: "default",
+			ingress: []routev1.RouteIngress{
+				buildRouteIngress("default", corev1.ConditionTrue),
+				buildRouteIngress("sharded", corev1.ConditionUnknown),
+			},
+			expectedErrMsg: "",
+		},
+		{
+			routerName: "",
+			ingress: []routev1.RouteIngress{
+				buildRouteIngress("default", corev1.ConditionTrue),
+				buildRouteIngress("sharded", corev1.ConditionUnknown),
+			},
+			expectedErrMsg: "context deadline exceeded",
+		},
+		{
+			routerName: "default",
+			ingress:    []routev1.RouteIngress{buildRouteIngress("default", corev1.ConditionFalse)},
+			expectedErrMsg: "route route-test-name in namespace route-test-namespace was rejected by router default: " +
+				"HostAlreadyClaimed: host claimed by another route",
+		},
+		{
+			routerName:     "",
+			ingress:        nil,
+			expectedErrMsg: "context deadline exceeded",
+		},
+	}
+
+	for _, test := range testCases {
+		testRoute := buildValidTestBuilder().Definition
+		testRoute.Status.Ingress = test.ingress
+
+		testBuilder := buildValidTestBuilder()
+		testBuilder.apiClient = clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{testRoute},
+		})
+
+		err := testBuilder.WaitUntilAdmitted(test.routerName, time.Second)
+
+		testhelper.AssertErrorMsg(t, test.expectedErrMsg, err)
+	}
+}
+
+func buildRouteIngress(routerName string, status corev1.ConditionStatus) routev1.RouteIngress {
+	return routev1.RouteIngress{
+		RouterName: routerName,
+		Conditions: []routev1.RouteIngressCondition{{
+			Type:    routev1.RouteAdmitted,
+			Status:  status,
+			Reason:  "HostAlreadyClaimed",
+			Message: "host claimed by another route",
+		}},
 	}
 }
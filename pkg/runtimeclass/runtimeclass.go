@@ -0,0 +1,368 @@
+package runtimeclass
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Builder provides struct for runtimeclass object containing connection to the cluster and the runtimeclass
+// definitions.
+type Builder struct {
+	// RuntimeClass definition. Used to create the runtimeclass object.
+	Definition *nodev1.RuntimeClass
+	// Created runtimeclass object.
+	Object *nodev1.RuntimeClass
+	// Used in functions that define or mutate runtimeclass definition. errorMsg is processed before the
+	// runtimeclass object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
+}
+
+// AdditionalOptions additional options for runtimeclass object.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// NewBuilder creates a new instance of Builder. The handler is the name of the CRI runtime configuration running
+// the pods of the runtimeclass, e.g. "high-performance" for the runtimeclass created by the performance profile.
+func NewBuilder(apiClient *clients.Settings, name, handler string) *Builder {
+	logging.V(100).Infof(
+		"Initializing new runtimeclass structure with the following params: name: %s, handler: %s", name, handler)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &nodev1.RuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Handler: handler,
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the runtimeclass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("runtimeclass 'name' cannot be empty"))
+	}
+
+	if handler == "" {
+		logging.V(100).Infof("The handler of the runtimeclass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("runtimeclass 'handler' cannot be empty"))
+
+		return &builder
+	}
+
+	if errs := validation.IsDNS1123Label(handler); len(errs) != 0 {
+		logging.V(100).Infof("The handler of the runtimeclass is invalid: %v", errs)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("runtimeclass 'handler' is invalid: %s", strings.Join(errs, "; ")))
+	}
+
+	return &builder
+}
+
+// Pull loads an existing runtimeclass into Builder struct.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing runtimeclass name: %s", name)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &nodev1.RuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("runtimeclass 'name' cannot be empty"))
+
+		return nil, builder.errorMsg
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("runtimeclass object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithOverhead sets the fixed resources consumed by the runtime itself for each pod of the runtimeclass. They are
+// added to the pod requests by the scheduler and the quota.
+func (builder *Builder) WithOverhead(podFixed corev1.ResourceList) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting overhead %v in runtimeclass %s", podFixed, builder.Definition.Name)
+
+	if len(podFixed) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("runtimeclass 'podFixed' overhead cannot be empty"))
+
+		return builder
+	}
+
+	for resourceName, quantity := range podFixed {
+		if quantity.Sign() < 0 {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("runtimeclass overhead of %s cannot be negative", resourceName))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Overhead = &nodev1.Overhead{PodFixed: podFixed}
+
+	return builder
+}
+
+// WithScheduling restricts the pods of the runtimeclass to the nodes matching nodeSelector and adds the tolerations
+// to them, so that they land on the nodes supporting the runtime handler.
+func (builder *Builder) WithScheduling(nodeSelector map[string]string, tolerations ...corev1.Toleration) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting scheduling with nodeSelector %v and tolerations %v in runtimeclass %s",
+		nodeSelector, tolerations, builder.Definition.Name)
+
+	if len(nodeSelector) == 0 && len(tolerations) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("runtimeclass 'nodeSelector' and 'tolerations' cannot be both empty"))
+
+		return builder
+	}
+
+	builder.Definition.Scheduling = &nodev1.Scheduling{
+		NodeSelector: nodeSelector,
+		Tolerations:  tolerations,
+	}
+
+	return builder
+}
+
+// WithOptions creates runtimeclass with generic mutation options.
+func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting runtimeclass additional options")
+
+	for _, option := range options {
+		if option != nil {
+			builder, err := option(builder)
+
+			if err != nil {
+				logging.V(100).Infof("Error occurred in mutation function")
+
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+				return builder
+			}
+		}
+	}
+
+	return builder
+}
+
+// Create generates a runtimeclass in cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating runtimeclass %s", builder.Definition.Name)
+
+	var err error
+	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
+		builder.Object, err = builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting dry-run to %t for runtimeclass %s", dryRun, builder.Definition.Name)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the runtimeclass definition against the API server and its admission webhooks with a server-side
+// dry-run request. The runtimeclass is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Creating the runtimeclass %s in dry-run mode", builder.Definition.Name)
+
+	_, err := builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
+// Update renovates the existing runtimeclass object with the runtimeclass definition in builder. The handler of a
+// runtimeclass is immutable.
+func (builder *Builder) Update() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating runtimeclass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent runtimeclass %s", builder.Definition.Name)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Exists checks whether the given runtimeclass exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if runtimeclass %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes the runtimeclass from the cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting runtimeclass %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	err := builder.apiClient.K8sClient.NodeV1().RuntimeClasses().Delete(
+		context.TODO(), builder.Definition.Name, deleteOptions)
+
+	if err != nil {
+		return err
+	}
+
+	if builder.dryRun {
+		return nil
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetGVR returns runtimeclass's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "node.k8s.io", Version: "v1", Resource: "runtimeclasses"}
+}
+
+// GetGVR returns the runtimeclass GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// Diff compares the runtimeclass definition with the runtimeclass object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing runtimeclass %s with its definition", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"runtimeclass object %s doesn't exist", builder.Definition.Name)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "RuntimeClass"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,207 @@
+package runtimeclass
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultRuntimeClassName    = "test-runtimeclass"
+	defaultRuntimeClassHandler = "high-performance"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		handler       string
+		expectedError string
+	}{
+		{
+			name:          defaultRuntimeClassName,
+			handler:       defaultRuntimeClassHandler,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			handler:       defaultRuntimeClassHandler,
+			expectedError: "runtimeclass 'name' cannot be empty",
+		},
+		{
+			name:          defaultRuntimeClassName,
+			handler:       "",
+			expectedError: "runtimeclass 'handler' cannot be empty",
+		},
+		{
+			name:          defaultRuntimeClassName,
+			handler:       "High_Performance",
+			expectedError: "runtimeclass 'handler' is invalid: a lowercase RFC 1123 label must consist of",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.handler)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, testCase.handler, testBuilder.Definition.Handler)
+		} else {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedError)
+		}
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			name:          defaultRuntimeClassName,
+			addToRuntime:  true,
+			expectedError: "",
+		},
+		{
+			name:          defaultRuntimeClassName,
+			addToRuntime:  false,
+			expectedError: "runtimeclass object test-runtimeclass doesn't exist",
+		},
+		{
+			name:          "",
+			addToRuntime:  false,
+			expectedError: "runtimeclass 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, &nodev1.RuntimeClass{
+				ObjectMeta: metav1.ObjectMeta{Name: defaultRuntimeClassName},
+				Handler:    defaultRuntimeClassHandler,
+			})
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := Pull(testSettings, testCase.name)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, defaultRuntimeClassHandler, testBuilder.Definition.Handler)
+		}
+	}
+}
+
+func TestWithOverheadAndScheduling(t *testing.T) {
+	testCases := []struct {
+		overhead      corev1.ResourceList
+		nodeSelector  map[string]string
+		tolerations   []corev1.Toleration
+		expectedError string
+	}{
+		{
+			overhead:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+			nodeSelector: map[string]string{"node-role.kubernetes.io/worker-cnf": ""},
+			tolerations:  []corev1.Toleration{{Key: "cnf", Operator: corev1.TolerationOpExists}},
+		},
+		{
+			overhead:      corev1.ResourceList{},
+			nodeSelector:  map[string]string{"node-role.kubernetes.io/worker-cnf": ""},
+			expectedError: "runtimeclass 'podFixed' overhead cannot be empty",
+		},
+		{
+			overhead:      corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Mi")},
+			nodeSelector:  map[string]string{"node-role.kubernetes.io/worker-cnf": ""},
+			expectedError: "runtimeclass overhead of memory cannot be negative",
+		},
+		{
+			overhead:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+			expectedError: "runtimeclass 'nodeSelector' and 'tolerations' cannot be both empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			defaultRuntimeClassName, defaultRuntimeClassHandler).
+			WithOverhead(testCase.overhead).
+			WithScheduling(testCase.nodeSelector, testCase.tolerations...)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.overhead, testBuilder.Definition.Overhead.PodFixed)
+		assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Scheduling.NodeSelector)
+		assert.Equal(t, testCase.tolerations, testBuilder.Definition.Scheduling.Tolerations)
+	}
+}
+
+func TestCreateUpdateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testBuilder, err := NewBuilder(testSettings, defaultRuntimeClassName, defaultRuntimeClassHandler).Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	testBuilder, err = testBuilder.WithScheduling(map[string]string{"kubernetes.io/os": "linux"}).Update()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"kubernetes.io/os": "linux"}, testBuilder.Object.Scheduling.NodeSelector)
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := NewBuilder(testSettings, "test-runtimeclass", "runc")
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("runtimeclass object %s doesn't exist", testBuilder.Definition.Name))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "runtimeclasses", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := NewBuilder(testSettings, "test-runtimeclass", "runc").
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	existingSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{testBuilder.Definition},
+		Reactors:       []clients.TestReactor{dryRunReactor},
+	})
+	existingBuilder := NewBuilder(existingSettings, "test-runtimeclass", "runc").
+		WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "delete"}, dryRunActions)
+}
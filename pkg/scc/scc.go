@@ -2,10 +2,11 @@ package scc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	securityV1 "github.com/openshift/api/security/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -13,7 +14,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const redefiningMsg = "Redefining SecurityContextConstraints"
+const (
+	redefiningMsg = "Redefining SecurityContextConstraints"
+	// PodAnnotation is the annotation set by the SCC admission plugin on every pod with the name of the
+	// SecurityContextConstraints the pod was admitted under.
+	PodAnnotation = "openshift.io/scc"
+)
 
 // Builder provides struct for SecurityContextConstraints object containing connection
 // to the cluster SecurityContextConstraints definition.
@@ -24,7 +30,7 @@ type Builder struct {
 	Object *securityV1.SecurityContextConstraints
 	// Used in functions that define or mutate SecurityContextConstraints definition. errorMsg is processed
 	// before the SecurityContextConstraints object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
 }
 
@@ -33,7 +39,7 @@ type SecurityContextConstraintsAdditionalOptions func(builder *Builder) (*Builde
 
 // NewBuilder creates new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext string) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new SecurityContextConstraints structure with the following params: "+
 			"name: %s, runAsUser type: %s, selinuxContext type: %s", name, runAsUser, selinuxContext)
 
@@ -53,21 +59,22 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
+		logging.V(100).Infof("The name of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SecurityContextConstraints 'name' cannot be empty"))
 	}
 
 	if runAsUser == "" {
-		glog.V(100).Infof("The runAsUser of the SecurityContextConstraints is empty")
+		logging.V(100).Infof("The runAsUser of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'runAsUser' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SecurityContextConstraints 'runAsUser' cannot be empty"))
 	}
 
 	if selinuxContext == "" {
-		glog.V(100).Infof("The selinuxContext of the SecurityContextConstraints is empty")
+		logging.V(100).Infof("The selinuxContext of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'selinuxContext' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'selinuxContext' cannot be empty"))
 	}
 
 	return &builder
@@ -75,7 +82,7 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 
 // Pull pulls existing SecurityContextConstraints from cluster.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing SecurityContextConstraints object name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing SecurityContextConstraints object name %s from cluster", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -87,9 +94,9 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
+		logging.V(100).Infof("The name of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SecurityContextConstraints 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -107,7 +114,7 @@ func (builder *Builder) WithPrivilegedContainer(allowPrivileged bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with AllowPrivilegedContainer: %t flag",
+	logging.V(100).Infof("%s %s with AllowPrivilegedContainer: %t flag",
 		redefiningMsg, builder.Definition.Name, allowPrivileged)
 
 	builder.Definition.AllowPrivilegedContainer = allowPrivileged
@@ -121,7 +128,7 @@ func (builder *Builder) WithPrivilegedEscalation(allowPrivilegedEscalation bool)
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with allowPrivilegedEscalation: %t flag",
+	logging.V(100).Infof("%s %s with allowPrivilegedEscalation: %t flag",
 		redefiningMsg, builder.Definition.Name, allowPrivilegedEscalation)
 
 	builder.Definition.DefaultAllowPrivilegeEscalation = &allowPrivilegedEscalation
@@ -135,7 +142,7 @@ func (builder *Builder) WithHostDirVolumePlugin(allowPlugin bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with allowHostDirVolumePlugin: %t flag",
+	logging.V(100).Infof("%s %s with allowHostDirVolumePlugin: %t flag",
 		redefiningMsg, builder.Definition.Name, allowPlugin)
 
 	builder.Definition.AllowHostDirVolumePlugin = allowPlugin
@@ -149,7 +156,7 @@ func (builder *Builder) WithHostIPC(allowHostIPC bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with allowHostIPC: %t flag",
+	logging.V(100).Infof("%s %s with allowHostIPC: %t flag",
 		redefiningMsg, builder.Definition.Name, allowHostIPC)
 
 	builder.Definition.AllowHostIPC = allowHostIPC
@@ -163,7 +170,7 @@ func (builder *Builder) WithHostNetwork(allowHostNetwork bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with allowHostNetwork: %t flag",
+	logging.V(100).Infof("%s %s with allowHostNetwork: %t flag",
 		redefiningMsg, builder.Definition.Name, allowHostNetwork)
 
 	builder.Definition.AllowHostNetwork = allowHostNetwork
@@ -177,7 +184,7 @@ func (builder *Builder) WithHostPID(allowHostPID bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with allowHostPID: %t flag", redefiningMsg, builder.Definition.Name, allowHostPID)
+	logging.V(100).Infof("%s %s with allowHostPID: %t flag", redefiningMsg, builder.Definition.Name, allowHostPID)
 
 	builder.Definition.AllowHostPID = allowHostPID
 
@@ -190,7 +197,7 @@ func (builder *Builder) WithHostPorts(allowHostPorts bool) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with allowHostPorts: %t flag",
+	logging.V(100).Infof("%s %s with allowHostPorts: %t flag",
 		redefiningMsg, builder.Definition.Name, allowHostPorts)
 
 	builder.Definition.AllowHostPorts = allowHostPorts
@@ -204,7 +211,7 @@ func (builder *Builder) WithReadOnlyRootFilesystem(readOnlyRootFilesystem bool)
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with readOnlyRootFilesystem: %t flag",
+	logging.V(100).Infof("%s %s with readOnlyRootFilesystem: %t flag",
 		redefiningMsg, builder.Definition.Name, readOnlyRootFilesystem)
 
 	builder.Definition.ReadOnlyRootFilesystem = readOnlyRootFilesystem
@@ -218,13 +225,14 @@ func (builder *Builder) WithDropCapabilities(requiredDropCapabilities []corev1.C
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with requiredDropCapabilities: %v",
+	logging.V(100).Infof("%s %s with requiredDropCapabilities: %v",
 		redefiningMsg, builder.Definition.Name, requiredDropCapabilities)
 
 	if len(requiredDropCapabilities) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'requiredDropCapabilities' argument cannot be empty")
+		logging.V(100).Infof("SecurityContextConstraints 'requiredDropCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'requiredDropCapabilities' cannot be empty list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'requiredDropCapabilities' cannot be empty list"))
 
 		return builder
 	}
@@ -247,13 +255,14 @@ func (builder *Builder) WithAllowCapabilities(allowCapabilities []corev1.Capabil
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with allowCapabilities: %v",
+	logging.V(100).Infof("%s %s with allowCapabilities: %v",
 		redefiningMsg, builder.Definition.Name, allowCapabilities)
 
 	if len(allowCapabilities) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'allowCapabilities' argument cannot be empty")
+		logging.V(100).Infof("SecurityContextConstraints 'allowCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'allowCapabilities' cannot be empty list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'allowCapabilities' cannot be empty list"))
 
 		return builder
 	}
@@ -275,13 +284,14 @@ func (builder *Builder) WithDefaultAddCapabilities(defaultAddCapabilities []core
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with defaultAddCapabilities: %v",
+	logging.V(100).Infof("%s %s with defaultAddCapabilities: %v",
 		redefiningMsg, builder.Definition.Name, defaultAddCapabilities)
 
 	if len(defaultAddCapabilities) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'defaultAddCapabilities' argument cannot be empty")
+		logging.V(100).Infof("SecurityContextConstraints 'defaultAddCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'defaultAddCapabilities' cannot be empty list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'defaultAddCapabilities' cannot be empty list"))
 
 		return builder
 	}
@@ -304,7 +314,7 @@ func (builder *Builder) WithPriority(priority *int32) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with priority: %v", redefiningMsg, builder.Definition.Name, priority)
+	logging.V(100).Infof("%s %s with priority: %v", redefiningMsg, builder.Definition.Name, priority)
 
 	builder.Definition.Priority = priority
 
@@ -317,12 +327,13 @@ func (builder *Builder) WithFSGroup(fsGroup string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with fsGroup: %s", redefiningMsg, builder.Definition.Name, fsGroup)
+	logging.V(100).Infof("%s %s with fsGroup: %s", redefiningMsg, builder.Definition.Name, fsGroup)
 
 	if fsGroup == "" {
-		glog.V(100).Infof("SecurityContextConstraints 'fsGroup' argument cannot be empty")
+		logging.V(100).Infof("SecurityContextConstraints 'fsGroup' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'fsGroup' cannot be empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'fsGroup' cannot be empty string"))
 
 		return builder
 	}
@@ -338,13 +349,14 @@ func (builder *Builder) WithFSGroupRange(fsGroupMin, fsGroupMax int64) *Builder
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with fsGroupRange: fsGroupMin: %d, fsGroupMax: %d",
+	logging.V(100).Infof("%s %s with fsGroupRange: fsGroupMin: %d, fsGroupMax: %d",
 		redefiningMsg, builder.Definition.Name, fsGroupMin, fsGroupMax)
 
 	if fsGroupMin > fsGroupMax {
-		glog.V(100).Infof("SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax")
+		logging.V(100).Infof("SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax")
 
-		builder.errorMsg = "SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax"))
 
 		return builder
 	}
@@ -361,29 +373,25 @@ func (builder *Builder) WithFSGroupRange(fsGroupMin, fsGroupMax int64) *Builder
 	return builder
 }
 
-// WithGroups adds groups to SecurityContextConstraints.
+// WithGroups appends groups to SecurityContextConstraints. Groups already granted the SecurityContextConstraints
+// are kept and not duplicated, so it is safe to use on a pulled object.
 func (builder *Builder) WithGroups(groups []string) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with groups: %v", redefiningMsg, builder.Definition.Name, groups)
+	logging.V(100).Infof("%s %s with groups: %v", redefiningMsg, builder.Definition.Name, groups)
 
 	if len(groups) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'groups' argument cannot be empty")
-
-		builder.errorMsg = "SecurityContextConstraints 'fsGroupType' cannot be empty string"
-
-		return builder
-	}
+		logging.V(100).Infof("SecurityContextConstraints 'groups' argument cannot be empty")
 
-	if builder.Definition.Groups == nil {
-		builder.Definition.Groups = groups
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'groups' cannot be empty list"))
 
 		return builder
 	}
 
-	builder.Definition.Groups = append(builder.Definition.Groups, groups...)
+	builder.Definition.Groups = appendUnique(builder.Definition.Groups, groups)
 
 	return builder
 }
@@ -394,13 +402,14 @@ func (builder *Builder) WithSeccompProfiles(seccompProfiles []string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with SeccompProfiles: %v",
+	logging.V(100).Infof("%s %s with SeccompProfiles: %v",
 		redefiningMsg, builder.Definition.Name, seccompProfiles)
 
 	if len(seccompProfiles) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'seccompProfiles' argument cannot be empty")
+		logging.V(100).Infof("SecurityContextConstraints 'seccompProfiles' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'seccompProfiles' cannot be empty list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'seccompProfiles' cannot be empty list"))
 
 		return builder
 	}
@@ -422,13 +431,14 @@ func (builder *Builder) WithSupplementalGroups(supplementalGroupsType string) *B
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with supplementalGroupsType: %s",
+	logging.V(100).Infof("%s %s with supplementalGroupsType: %s",
 		redefiningMsg, builder.Definition.Name, supplementalGroupsType)
 
 	if supplementalGroupsType == "" {
-		glog.V(100).Infof("SecurityContextConstraints 'SupplementalGroups' argument cannot be empty")
+		logging.V(100).Infof("SecurityContextConstraints 'SupplementalGroups' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'SupplementalGroups' cannot be empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'SupplementalGroups' cannot be empty string"))
 
 		return builder
 	}
@@ -438,29 +448,25 @@ func (builder *Builder) WithSupplementalGroups(supplementalGroupsType string) *B
 	return builder
 }
 
-// WithUsers adds users to SecurityContextConstraints.
+// WithUsers appends users to SecurityContextConstraints. Users already granted the SecurityContextConstraints
+// are kept and not duplicated, so it is safe to use on a pulled object, e.g. "system:serviceaccount:<ns>:<name>".
 func (builder *Builder) WithUsers(users []string) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with users: %v", redefiningMsg, builder.Definition.Name, users)
+	logging.V(100).Infof("%s %s with users: %v", redefiningMsg, builder.Definition.Name, users)
 
 	if len(users) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'users' argument cannot be empty")
-
-		builder.errorMsg = "SecurityContextConstraints 'users' cannot be empty list"
-
-		return builder
-	}
+		logging.V(100).Infof("SecurityContextConstraints 'users' argument cannot be empty")
 
-	if builder.Definition.Users == nil {
-		builder.Definition.Users = users
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'users' cannot be empty list"))
 
 		return builder
 	}
 
-	builder.Definition.Users = append(builder.Definition.Users, users...)
+	builder.Definition.Users = appendUnique(builder.Definition.Users, users)
 
 	return builder
 }
@@ -471,12 +477,13 @@ func (builder *Builder) WithVolumes(volumes []securityV1.FSType) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("%s %s with volumes: %v", redefiningMsg, builder.Definition.Name, volumes)
+	logging.V(100).Infof("%s %s with volumes: %v", redefiningMsg, builder.Definition.Name, volumes)
 
 	if len(volumes) == 0 {
-		glog.V(100).Infof("SecurityContextConstraints 'volumes' argument cannot be empty")
+		logging.V(100).Infof("SecurityContextConstraints 'volumes' argument cannot be empty")
 
-		builder.errorMsg = "SecurityContextConstraints 'volumes' cannot be empty list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SecurityContextConstraints 'volumes' cannot be empty list"))
 
 		return builder
 	}
@@ -498,7 +505,7 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating SecurityContextConstraints %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating SecurityContextConstraints %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -515,7 +522,7 @@ func (builder *Builder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Removing SecurityContextConstraints %s", builder.Definition.Name)
+	logging.V(100).Infof("Removing SecurityContextConstraints %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
@@ -535,7 +542,7 @@ func (builder *Builder) Update() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating SecurityContextConstraints %s ", builder.Definition.Name)
+	logging.V(100).Infof("Updating SecurityContextConstraints %s ", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.SecurityContextConstraints().Update(
@@ -550,7 +557,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if SecurityContextConstraints %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if SecurityContextConstraints %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.SecurityContextConstraints().Get(
@@ -559,33 +566,87 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetPodSCCName returns the name of the SecurityContextConstraints the given running pod was admitted under, as
+// recorded by the SCC admission plugin in the pod annotations.
+func GetPodSCCName(apiClient *clients.Settings, podName, nsname string) (string, error) {
+	logging.V(100).Infof("Getting SecurityContextConstraints of pod %s in namespace %s", podName, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return "", fmt.Errorf("SecurityContextConstraints 'apiClient' cannot be empty")
+	}
+
+	if podName == "" {
+		return "", fmt.Errorf("pod 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		return "", fmt.Errorf("pod 'namespace' cannot be empty")
+	}
+
+	pod, err := apiClient.Pods(nsname).Get(context.TODO(), podName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pod %s in namespace %s: %w", podName, nsname, err)
+	}
+
+	sccName, ok := pod.Annotations[PodAnnotation]
+	if !ok || sccName == "" {
+		return "", fmt.Errorf("pod %s in namespace %s has no %s annotation", podName, nsname, PodAnnotation)
+	}
+
+	return sccName, nil
+}
+
+// appendUnique appends the values which are not yet present in list, preserving the order of both.
+func appendUnique(list, values []string) []string {
+	present := make(map[string]bool, len(list)+len(values))
+
+	for _, value := range list {
+		present[value] = true
+	}
+
+	for _, value := range values {
+		if present[value] {
+			continue
+		}
+
+		present[value] = true
+		list = append(list, value)
+	}
+
+	return list
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "SecurityContextConstraints"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
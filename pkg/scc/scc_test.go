@@ -1 +1,117 @@
 package scc
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSCCWithUsersAndGroups(t *testing.T) {
+	testCases := []struct {
+		users          []string
+		groups         []string
+		expectedUsers  []string
+		expectedGroups []string
+		expectedError  string
+	}{
+		{
+			users:          []string{"system:serviceaccount:test:sa", "system:admin"},
+			groups:         []string{"system:authenticated", "system:cluster-admins"},
+			expectedUsers:  []string{"system:serviceaccount:test:existing", "system:serviceaccount:test:sa", "system:admin"},
+			expectedGroups: []string{"system:cluster-admins", "system:authenticated"},
+		},
+		{
+			users:         []string{},
+			groups:        []string{"system:authenticated"},
+			expectedError: "SecurityContextConstraints 'users' cannot be empty list",
+		},
+		{
+			users:         []string{"system:admin"},
+			groups:        []string{},
+			expectedError: "SecurityContextConstraints 'groups' cannot be empty list",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "test", "RunAsAny", "MustRunAs")
+		testBuilder.Definition.Users = []string{"system:serviceaccount:test:existing"}
+		testBuilder.Definition.Groups = []string{"system:cluster-admins"}
+
+		testBuilder = testBuilder.WithUsers(testCase.users).WithGroups(testCase.groups)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.errorMsg, testCase.expectedError)
+		} else {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, testCase.expectedUsers, testBuilder.Definition.Users)
+			assert.Equal(t, testCase.expectedGroups, testBuilder.Definition.Groups)
+		}
+	}
+}
+
+func TestGetPodSCCName(t *testing.T) {
+	testCases := []struct {
+		podName       string
+		annotations   map[string]string
+		client        bool
+		expectedSCC   string
+		expectedError string
+	}{
+		{
+			podName:     "test-pod",
+			annotations: map[string]string{PodAnnotation: "restricted-v2"},
+			client:      true,
+			expectedSCC: "restricted-v2",
+		},
+		{
+			podName:       "test-pod",
+			client:        true,
+			expectedError: "pod test-pod in namespace test-ns has no openshift.io/scc annotation",
+		},
+		{
+			podName:       "missing-pod",
+			annotations:   map[string]string{PodAnnotation: "restricted-v2"},
+			client:        true,
+			expectedError: "failed to get pod missing-pod in namespace test-ns",
+		},
+		{
+			podName:       "",
+			client:        true,
+			expectedError: "pod 'name' cannot be empty",
+		},
+		{
+			podName:       "test-pod",
+			client:        false,
+			expectedError: "SecurityContextConstraints 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test-pod",
+						Namespace:   "test-ns",
+						Annotations: testCase.annotations,
+					},
+				}},
+			})
+		}
+
+		sccName, err := GetPodSCCName(testSettings, testCase.podName, "test-ns")
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedSCC, sccName)
+		} else {
+			assert.ErrorContains(t, err, testCase.expectedError)
+		}
+	}
+}
@@ -2,14 +2,18 @@ package secret
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // Builder provides struct for secret object containing connection to the cluster and the secret definitions.
@@ -20,8 +24,10 @@ type Builder struct {
 	Object *corev1.Secret
 	// Used in functions that define or mutate secret definitions. errorMsg is processed before the secret
 	// object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // AdditionalOptions additional options for Secret object.
@@ -29,7 +35,7 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType corev1.SecretType) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new secret structure with the following params: %s, %s, %s",
 		name, nsname, string(secretType))
 
@@ -45,23 +51,75 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType cor
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the secret is empty")
+		logging.V(100).Infof("The name of the secret is empty")
 
-		builder.errorMsg = "secret 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("secret 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the secret is empty")
+		logging.V(100).Infof("The namespace of the secret is empty")
 
-		builder.errorMsg = "secret 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("secret 'nsname' cannot be empty"))
 	}
 
 	return &builder
 }
 
+// NewBuilderFromYAML creates a new instance of Builder from a secret manifest with a single document in YAML or
+// JSON format.
+func NewBuilderFromYAML(apiClient *clients.Settings, data []byte) *Builder {
+	logging.V(100).Infof("Initializing new secret structure from manifest")
+
+	builder := &Builder{
+		apiClient:  apiClient,
+		Definition: &corev1.Secret{},
+	}
+
+	err := manifest.DecodeInto(data, builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to decode the secret manifest due to %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to decode secret manifest: %w", err))
+
+		return builder
+	}
+
+	if builder.Definition.Name == "" {
+		logging.V(100).Infof("The name of the secret is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("secret 'name' cannot be empty"))
+	}
+
+	if builder.Definition.Namespace == "" {
+		logging.V(100).Infof("The namespace of the secret is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("secret 'nsname' cannot be empty"))
+	}
+
+	return builder
+}
+
+// NewBuilderFromFile creates a new instance of Builder from the secret manifest stored in the given file.
+func NewBuilderFromFile(apiClient *clients.Settings, path string) *Builder {
+	logging.V(100).Infof("Initializing new secret structure from manifest file %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logging.V(100).Infof("Failed to read the secret manifest file %s due to %s", path, err.Error())
+
+		return &Builder{
+			apiClient:  apiClient,
+			Definition: &corev1.Secret{},
+			errorMsg:   fmt.Errorf("failed to read secret manifest file %s: %w", path, err),
+		}
+	}
+
+	return NewBuilderFromYAML(apiClient, data)
+}
+
 // Pull loads an existing secret into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing secret name: %s under namespace: %s", name, nsname)
+	logging.V(100).Infof("Pulling existing secret name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -74,11 +132,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "secret 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("secret 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "secret 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("secret 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -96,10 +154,14 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the secret %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Creating the secret %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -107,25 +169,66 @@ func (builder *Builder) Create() (*Builder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for secret %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the secret definition against the API server and its admission webhooks with a server-side
+// dry-run request. The secret is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the secret %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.Secrets(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Delete removes a secret from the cluster.
 func (builder *Builder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the secret %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Deleting the secret %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.Secrets(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return nil
+	}
+
 	builder.Object = nil
 
 	return err
@@ -137,7 +240,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if secret %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -148,13 +251,33 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the secret definition with the secret object on the cluster. Only the fields set in the definition
+// are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing secret %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"secret object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // Update modifies the existing secret in the cluster.
 func (builder *Builder) Update() (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating secret %s in namespace %s",
+	logging.V(100).Infof("Updating secret %s in namespace %s",
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -171,17 +294,17 @@ func (builder *Builder) WithData(data map[string][]byte) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Defining secret %s in namespace %s with this data: %s",
 		builder.Definition.Name, builder.Definition.Namespace, data)
 
 	if len(data) == 0 {
-		glog.V(100).Infof("The data of the secret is empty")
+		logging.V(100).Infof("The data of the secret is empty")
 
-		builder.errorMsg = "'data' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'data' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -196,16 +319,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting secret additional options")
+	logging.V(100).Infof("Setting secret additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -215,33 +338,42 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	return builder
 }
 
+// GetGVR returns the secret GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "", Version: "v1", Resource: "secrets",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Secret"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,227 @@
+package secret
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// dockerConfigJSON is the content of the .dockerconfigjson key of a kubernetes.io/dockerconfigjson secret.
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+// dockerConfigEntry holds the credentials of a single registry.
+type dockerConfigEntry struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// NewDockerRegistrySecret creates a new instance of Builder for a kubernetes.io/dockerconfigjson secret holding the
+// credentials of the given registry server, equivalent to kubectl create secret docker-registry.
+func NewDockerRegistrySecret(apiClient *clients.Settings, name, nsname, server, username, password string) *Builder {
+	logging.V(100).Infof("Initializing new docker-registry secret %s in namespace %s for server %s",
+		name, nsname, server)
+
+	builder := NewBuilder(apiClient, name, nsname, corev1.SecretTypeDockerConfigJson)
+
+	if server == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("docker-registry secret 'server' cannot be empty"))
+	}
+
+	if username == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("docker-registry secret 'username' cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	dockerConfig, err := json.Marshal(dockerConfigJSON{
+		Auths: map[string]dockerConfigEntry{
+			server: {
+				Username: username,
+				Password: password,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	})
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to marshal docker config: %w", err))
+
+		return builder
+	}
+
+	return builder.WithData(map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig})
+}
+
+// NewTLSSecret creates a new instance of Builder for a kubernetes.io/tls secret from a PEM encoded certificate chain
+// and its private key. The pair is checked to match before it is stored.
+func NewTLSSecret(apiClient *clients.Settings, name, nsname string, certPEM, keyPEM []byte) *Builder {
+	logging.V(100).Infof("Initializing new TLS secret %s in namespace %s", name, nsname)
+
+	builder := NewBuilder(apiClient, name, nsname, corev1.SecretTypeTLS)
+
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		logging.V(100).Infof("The TLS secret certificate and key are invalid: %v", err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid TLS secret certificate or key: %w", err))
+
+		return builder
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	return builder.WithData(map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+	})
+}
+
+// NewBasicAuthSecret creates a new instance of Builder for a kubernetes.io/basic-auth secret.
+func NewBasicAuthSecret(apiClient *clients.Settings, name, nsname, username, password string) *Builder {
+	logging.V(100).Infof("Initializing new basic-auth secret %s in namespace %s", name, nsname)
+
+	builder := NewBuilder(apiClient, name, nsname, corev1.SecretTypeBasicAuth)
+
+	if username == "" && password == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("basic-auth secret requires at least one of 'username' or 'password'"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	data := make(map[string][]byte)
+
+	if username != "" {
+		data[corev1.BasicAuthUsernameKey] = []byte(username)
+	}
+
+	if password != "" {
+		data[corev1.BasicAuthPasswordKey] = []byte(password)
+	}
+
+	return builder.WithData(data)
+}
+
+// NewSSHAuthSecret creates a new instance of Builder for a kubernetes.io/ssh-auth secret from a PEM encoded private
+// key, either in OpenSSH or PKCS format.
+func NewSSHAuthSecret(apiClient *clients.Settings, name, nsname string, privateKey []byte) *Builder {
+	logging.V(100).Infof("Initializing new ssh-auth secret %s in namespace %s", name, nsname)
+
+	builder := NewBuilder(apiClient, name, nsname, corev1.SecretTypeSSHAuth)
+
+	if block, _ := pem.Decode(privateKey); block == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ssh-auth secret 'privateKey' is not PEM encoded"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	return builder.WithData(map[string][]byte{corev1.SSHAuthPrivateKey: privateKey})
+}
+
+// WithBase64Data decodes the base64 encoded values and adds them to the secret data, as found in the data field of a
+// secret manifest.
+func (builder *Builder) WithBase64Data(data map[string]string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding %d base64 encoded keys to secret %s in namespace %s",
+		len(data), builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(data) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("'data' cannot be empty"))
+
+		return builder
+	}
+
+	decoded := make(map[string][]byte, len(data))
+
+	for key, value := range data {
+		decodedValue, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("secret key '%s' is not base64 encoded: %w", key, err))
+
+			return builder
+		}
+
+		decoded[key] = decodedValue
+	}
+
+	if builder.Definition.Data == nil {
+		builder.Definition.Data = make(map[string][]byte, len(decoded))
+	}
+
+	for key, value := range decoded {
+		builder.Definition.Data[key] = value
+	}
+
+	return builder
+}
+
+// WithImmutable sets whether the secret data can be updated after creation. The flag cannot be unset on the cluster
+// once the secret is created immutable.
+func (builder *Builder) WithImmutable(immutable bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting immutable to %t for secret %s in namespace %s",
+		immutable, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Immutable = &immutable
+
+	return builder
+}
+
+// WaitUntilPopulated waits for the defined period until the secret exists and every given key holds a non-empty
+// value. Without keys any data is enough. It is meant for secrets filled by an external controller such as
+// cert-manager or the service CA operator.
+func (builder *Builder) WaitUntilPopulated(timeout time.Duration, keys ...string) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until secret %s in namespace %s is populated with keys %v",
+		builder.Definition.Name, builder.Definition.Namespace, keys)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() || builder.Object == nil {
+				return false, nil
+			}
+
+			if len(keys) == 0 {
+				return len(builder.Object.Data) > 0, nil
+			}
+
+			for _, key := range keys {
+				if len(builder.Object.Data[key]) == 0 {
+					logging.V(100).Infof("Secret %s key %s is not populated yet", builder.Definition.Name, key)
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+}
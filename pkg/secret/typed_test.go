@@ -0,0 +1,273 @@
+package secret
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultSecretName      = "secret-test"
+	defaultSecretNamespace = "secret-namespace"
+)
+
+func TestNewDockerRegistrySecret(t *testing.T) {
+	testCases := []struct {
+		server      string
+		username    string
+		expectedErr string
+	}{
+		{
+			server:   "registry.example.com:5000",
+			username: "user",
+		},
+		{
+			server:      "",
+			username:    "user",
+			expectedErr: "docker-registry secret 'server' cannot be empty",
+		},
+		{
+			server:      "registry.example.com:5000",
+			username:    "",
+			expectedErr: "docker-registry secret 'username' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewDockerRegistrySecret(clients.GetTestClients(clients.TestClientParams{}),
+			defaultSecretName, defaultSecretNamespace, testCase.server, testCase.username, "pass")
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErr, testBuilder.errorMsg) {
+			assert.Equal(t, corev1.SecretTypeDockerConfigJson, testBuilder.Definition.Type)
+
+			var dockerConfig dockerConfigJSON
+
+			err := json.Unmarshal(testBuilder.Definition.Data[corev1.DockerConfigJsonKey], &dockerConfig)
+			assert.Nil(t, err)
+			assert.Equal(t, dockerConfigEntry{Username: "user", Password: "pass", Auth: "dXNlcjpwYXNz"},
+				dockerConfig.Auths[testCase.server])
+		}
+	}
+}
+
+func TestNewTLSSecret(t *testing.T) {
+	certPEM, keyPEM := generateTestCertificate(t)
+
+	testCases := []struct {
+		certPEM     []byte
+		keyPEM      []byte
+		expectedErr string
+	}{
+		{
+			certPEM: certPEM,
+			keyPEM:  keyPEM,
+		},
+		{
+			certPEM:     certPEM,
+			keyPEM:      []byte("not a key"),
+			expectedErr: "invalid TLS secret certificate or key",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewTLSSecret(clients.GetTestClients(clients.TestClientParams{}),
+			defaultSecretName, defaultSecretNamespace, testCase.certPEM, testCase.keyPEM)
+
+		if testCase.expectedErr == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, corev1.SecretTypeTLS, testBuilder.Definition.Type)
+			assert.Equal(t, testCase.certPEM, testBuilder.Definition.Data[corev1.TLSCertKey])
+			assert.Equal(t, testCase.keyPEM, testBuilder.Definition.Data[corev1.TLSPrivateKeyKey])
+		} else {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedErr)
+		}
+	}
+}
+
+func TestNewBasicAuthSecret(t *testing.T) {
+	testCases := []struct {
+		username     string
+		password     string
+		expectedData map[string][]byte
+		expectedErr  string
+	}{
+		{
+			username: "user",
+			password: "pass",
+			expectedData: map[string][]byte{
+				corev1.BasicAuthUsernameKey: []byte("user"),
+				corev1.BasicAuthPasswordKey: []byte("pass"),
+			},
+		},
+		{
+			username:     "",
+			password:     "token",
+			expectedData: map[string][]byte{corev1.BasicAuthPasswordKey: []byte("token")},
+		},
+		{
+			expectedErr: "basic-auth secret requires at least one of 'username' or 'password'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBasicAuthSecret(clients.GetTestClients(clients.TestClientParams{}),
+			defaultSecretName, defaultSecretNamespace, testCase.username, testCase.password)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErr, testBuilder.errorMsg) {
+			assert.Equal(t, corev1.SecretTypeBasicAuth, testBuilder.Definition.Type)
+			assert.Equal(t, testCase.expectedData, testBuilder.Definition.Data)
+		}
+	}
+}
+
+func TestNewSSHAuthSecret(t *testing.T) {
+	_, keyPEM := generateTestCertificate(t)
+
+	testCases := []struct {
+		privateKey  []byte
+		expectedErr string
+	}{
+		{
+			privateKey: keyPEM,
+		},
+		{
+			privateKey:  []byte("ssh-ed25519 AAAA"),
+			expectedErr: "ssh-auth secret 'privateKey' is not PEM encoded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewSSHAuthSecret(clients.GetTestClients(clients.TestClientParams{}),
+			defaultSecretName, defaultSecretNamespace, testCase.privateKey)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErr, testBuilder.errorMsg) {
+			assert.Equal(t, corev1.SecretTypeSSHAuth, testBuilder.Definition.Type)
+			assert.Equal(t, testCase.privateKey, testBuilder.Definition.Data[corev1.SSHAuthPrivateKey])
+		}
+	}
+}
+
+func TestWithBase64Data(t *testing.T) {
+	testCases := []struct {
+		data        map[string]string
+		expectedErr string
+	}{
+		{
+			data: map[string]string{"key": "dmFsdWU="},
+		},
+		{
+			data:        map[string]string{"key": "not base64!"},
+			expectedErr: "secret key 'key' is not base64 encoded",
+		},
+		{
+			data:        map[string]string{},
+			expectedErr: "'data' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidSecretBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder.WithBase64Data(testCase.data)
+
+		if testCase.expectedErr == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, []byte("value"), testBuilder.Definition.Data["key"])
+		} else {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedErr)
+		}
+	}
+}
+
+func TestWithImmutable(t *testing.T) {
+	testBuilder := buildValidSecretBuilder(clients.GetTestClients(clients.TestClientParams{})).WithImmutable(true)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.True(t, *testBuilder.Definition.Immutable)
+}
+
+func TestWaitUntilPopulated(t *testing.T) {
+	testCases := []struct {
+		data        map[string][]byte
+		exists      bool
+		keys        []string
+		expectedErr string
+	}{
+		{
+			data:   map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+			exists: true,
+			keys:   []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey},
+		},
+		{
+			data:   map[string][]byte{"any": []byte("value")},
+			exists: true,
+		},
+		{
+			data:        map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: {}},
+			exists:      true,
+			keys:        []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey},
+			expectedErr: "context deadline exceeded",
+		},
+		{
+			exists:      false,
+			expectedErr: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: defaultSecretName, Namespace: defaultSecretNamespace},
+				Data:       testCase.data,
+			})
+		}
+
+		testBuilder := buildValidSecretBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects}))
+
+		err := testBuilder.WaitUntilPopulated(time.Second, testCase.keys...)
+		testhelper.AssertErrorMsg(t, testCase.expectedErr, err)
+	}
+}
+
+func buildValidSecretBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultSecretName, defaultSecretNamespace, corev1.SecretTypeOpaque)
+}
+
+func generateTestCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	assert.Nil(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
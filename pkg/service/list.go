@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns service inventory in the given namespace.
+func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("service 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list services, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("service 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list services, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := fmt.Sprintf("Listing services in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	serviceList, err := apiClient.Services(nsname).List(context.TODO(), passedOptions)
+
+	if err != nil {
+		logging.V(100).Infof("Failed to list services in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var serviceObjects []*Builder
+
+	for _, runningService := range serviceList.Items {
+		copiedService := runningService
+		serviceBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedService,
+			Definition: &copiedService,
+		}
+
+		serviceObjects = append(serviceObjects, serviceBuilder)
+	}
+
+	return serviceObjects, nil
+}
+
+// ListInAllNamespaces returns a cluster-wide service inventory.
+func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("service 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list services, 'apiClient' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+	logMessage := "Listing services in all namespaces"
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be either empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	serviceList, err := apiClient.Services("").List(context.TODO(), passedOptions)
+
+	if err != nil {
+		logging.V(100).Infof("Failed to list services in all namespaces due to %s", err.Error())
+
+		return nil, err
+	}
+
+	var serviceObjects []*Builder
+
+	for _, runningService := range serviceList.Items {
+		copiedService := runningService
+		serviceBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedService,
+			Definition: &copiedService,
+		}
+
+		serviceObjects = append(serviceObjects, serviceBuilder)
+	}
+
+	return serviceObjects, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestList(t *testing.T) {
+	testCases := []struct {
+		nsName        string
+		listOptions   []metav1.ListOptions
+		expectedCount int
+		expectedError error
+		client        bool
+	}{
+		{
+			nsName:        "test-namespace",
+			expectedCount: 2,
+			client:        true,
+		},
+		{
+			nsName:        "test-namespace",
+			listOptions:   []metav1.ListOptions{{LabelSelector: "app=metallb"}},
+			expectedCount: 1,
+			client:        true,
+		},
+		{
+			nsName:        "",
+			expectedError: fmt.Errorf("failed to list services, 'nsname' parameter is empty"),
+			client:        true,
+		},
+		{
+			nsName:        "test-namespace",
+			listOptions:   []metav1.ListOptions{{LabelSelector: "test"}, {Limit: 1}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+			client:        true,
+		},
+		{
+			nsName:        "test-namespace",
+			expectedError: fmt.Errorf("failed to list services, 'apiClient' parameter is empty"),
+			client:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: buildDummyServiceObjects(),
+			})
+		}
+
+		serviceBuilders, err := List(testSettings, testCase.nsName, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedCount, len(serviceBuilders))
+		}
+	}
+}
+
+func TestListInAllNamespaces(t *testing.T) {
+	testCases := []struct {
+		listOptions   []metav1.ListOptions
+		expectedCount int
+		expectedError error
+		client        bool
+	}{
+		{
+			expectedCount: 3,
+			client:        true,
+		},
+		{
+			listOptions:   []metav1.ListOptions{{LabelSelector: "app=metallb"}},
+			expectedCount: 2,
+			client:        true,
+		},
+		{
+			listOptions:   []metav1.ListOptions{{LabelSelector: "test"}, {Limit: 1}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+			client:        true,
+		},
+		{
+			expectedError: fmt.Errorf("failed to list services, 'apiClient' parameter is empty"),
+			client:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: buildDummyServiceObjects(),
+			})
+		}
+
+		serviceBuilders, err := ListInAllNamespaces(testSettings, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedCount, len(serviceBuilders))
+		}
+	}
+}
+
+func buildDummyServiceObjects() []runtime.Object {
+	return []runtime.Object{
+		buildDummyService("metallb-service", "test-namespace", map[string]string{"app": "metallb"}),
+		buildDummyService("other-service", "test-namespace", nil),
+		buildDummyService("metallb-service", "other-namespace", map[string]string{"app": "metallb"}),
+	}
+}
+
+func buildDummyService(name, nsname string, labels map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: nsname,
+			Labels:    labels,
+		},
+	}
+}
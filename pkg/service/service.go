@@ -2,19 +2,31 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
 )
 
+// maxClientIPAffinitySeconds is the maximum session sticky time allowed by the API for ClientIP affinity.
+const maxClientIPAffinitySeconds int32 = 86400
+
 // Builder provides struct for service object containing connection to the cluster and the service definitions.
 type Builder struct {
 	// Service definition. Used to create a service object
@@ -23,8 +35,10 @@ type Builder struct {
 	Object *corev1.Service
 	// Used in functions that define or mutate the service definition.
 	// errorMsg is processed before the service object is created
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // AdditionalOptions additional options for service object.
@@ -39,7 +53,7 @@ func NewBuilder(
 	nsname string,
 	labels map[string]string,
 	servicePort corev1.ServicePort) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new service structure with the following params: %s, %s", name, nsname)
 
 	builder := Builder{
@@ -57,42 +71,193 @@ func NewBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the service is empty")
+		logging.V(100).Infof("The name of the service is empty")
 
-		builder.errorMsg = "Service 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Service 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the service is empty")
+		logging.V(100).Infof("The namespace of the service is empty")
 
-		builder.errorMsg = "Namespace 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Namespace 'nsname' cannot be empty"))
 	}
 
 	return &builder
 }
 
+// NewHeadlessBuilder creates a new instance of Builder for a headless service.
+// Headless services have no cluster IP and require a selector so that DNS records point at the selected pods.
+func NewHeadlessBuilder(
+	apiClient *clients.Settings,
+	name string,
+	nsname string,
+	labels map[string]string,
+	servicePort corev1.ServicePort) *Builder {
+	logging.V(100).Infof(
+		"Initializing new headless service structure with the following params: %s, %s", name, nsname)
+
+	builder := NewBuilder(apiClient, name, nsname, labels, servicePort)
+
+	if len(labels) == 0 {
+		logging.V(100).Infof("The selector of the headless service is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("headless service 'labels' cannot be empty"))
+	}
+
+	builder.Definition.Spec.ClusterIP = corev1.ClusterIPNone
+
+	return builder
+}
+
+// NewBuilderFromYAML creates a new instance of Builder from a service manifest with a single document in YAML or
+// JSON format.
+func NewBuilderFromYAML(apiClient *clients.Settings, data []byte) *Builder {
+	logging.V(100).Infof("Initializing new service structure from manifest")
+
+	builder := &Builder{
+		apiClient:  apiClient,
+		Definition: &corev1.Service{},
+	}
+
+	err := manifest.DecodeInto(data, builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to decode the service manifest due to %s", err.Error())
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to decode service manifest: %w", err))
+
+		return builder
+	}
+
+	if builder.Definition.Name == "" {
+		logging.V(100).Infof("The name of the service is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Service 'name' cannot be empty"))
+	}
+
+	if builder.Definition.Namespace == "" {
+		logging.V(100).Infof("The namespace of the service is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Namespace 'nsname' cannot be empty"))
+	}
+
+	return builder
+}
+
+// NewBuilderFromFile creates a new instance of Builder from the service manifest stored in the given file.
+func NewBuilderFromFile(apiClient *clients.Settings, path string) *Builder {
+	logging.V(100).Infof("Initializing new service structure from manifest file %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logging.V(100).Infof("Failed to read the service manifest file %s due to %s", path, err.Error())
+
+		return &Builder{
+			apiClient:  apiClient,
+			Definition: &corev1.Service{},
+			errorMsg:   fmt.Errorf("failed to read service manifest file %s: %w", path, err),
+		}
+	}
+
+	return NewBuilderFromYAML(apiClient, data)
+}
+
 // WithNodePort redefines the service with NodePort service type.
-func (builder *Builder) WithNodePort() *Builder {
+// The node port is set to the service port on every port of the service or only on the ports
+// with the given names when portNames are provided.
+func (builder *Builder) WithNodePort(portNames ...string) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
+	logging.V(100).Infof("Defining service's NodePort for ports: %v", portNames)
+
 	builder.Definition.Spec.Type = "NodePort"
 
 	if len(builder.Definition.Spec.Ports) < 1 {
-		builder.errorMsg = "service does not have the available ports"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("service does not have the available ports"))
 
 		return builder
 	}
 
-	builder.Definition.Spec.Ports[0].NodePort = builder.Definition.Spec.Ports[0].Port
+	if len(portNames) == 0 {
+		for index := range builder.Definition.Spec.Ports {
+			builder.Definition.Spec.Ports[index].NodePort = builder.Definition.Spec.Ports[index].Port
+		}
+
+		return builder
+	}
+
+	for _, portName := range portNames {
+		portIndex := builder.getPortIndexByName(portName)
+
+		if portIndex < 0 {
+			logging.V(100).Infof("The port %s is not defined on service %s in namespace %s",
+				portName, builder.Definition.Name, builder.Definition.Namespace)
+
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("service does not have port named %s", portName))
+
+			continue
+		}
+
+		builder.Definition.Spec.Ports[portIndex].NodePort = builder.Definition.Spec.Ports[portIndex].Port
+	}
+
+	return builder
+}
+
+// WithAdditionalPorts appends the given ports to the service definition.
+// Ports of a multi-port service must have unique, non-empty names.
+func (builder *Builder) WithAdditionalPorts(servicePorts []corev1.ServicePort) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Appending ports %v to service %s in namespace %s",
+		servicePorts, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(servicePorts) == 0 {
+		logging.V(100).Infof("The service ports are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot accept empty list as service ports"))
+
+		return builder
+	}
+
+	allPorts := append(append([]corev1.ServicePort{}, builder.Definition.Spec.Ports...), servicePorts...)
+	portNames := make(map[string]bool)
+
+	for _, servicePort := range allPorts {
+		if servicePort.Name == "" {
+			logging.V(100).Infof("Service port %d has no name", servicePort.Port)
+
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"service port %d must have a name when the service has multiple ports", servicePort.Port))
+
+			continue
+		}
+
+		if portNames[servicePort.Name] {
+			logging.V(100).Infof("Service port name %s is duplicated", servicePort.Name)
+
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"service port name %s is not unique", servicePort.Name))
+		}
+
+		portNames[servicePort.Name] = true
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Ports = allPorts
 
 	return builder
 }
 
 // Pull loads an existing service into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing service name: %s under namespace: %s", name, nsname)
+	logging.V(100).Infof("Pulling existing service name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -105,11 +270,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "service 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("service 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "service 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("service 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -127,10 +292,14 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the service %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Creating the service %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.Services(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -144,7 +313,7 @@ func (builder *Builder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if service %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -155,25 +324,87 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// Diff compares the service definition with the service object on the cluster. Only the fields set in the definition
+// are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing service %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"service object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for service %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the service definition against the API server and its admission webhooks with a server-side
+// dry-run request. The service is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the service %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.Services(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Delete a service.
 func (builder *Builder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the service %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof(
+		"Deleting the service %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		return nil
 	}
 
+	deleteOptions := metav1.DeleteOptions{}
+	if builder.dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
 	err := builder.apiClient.Services(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		context.TODO(), builder.Object.Name, deleteOptions)
 
 	if err != nil {
 		return err
 	}
 
+	if builder.dryRun {
+		return nil
+	}
+
 	builder.Object = nil
 
 	return err
@@ -185,16 +416,16 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting service additional options")
+	logging.V(100).Infof("Setting service additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -210,19 +441,19 @@ func (builder *Builder) WithExternalTrafficPolicy(policyType corev1.ServiceExter
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Defining service's with ExternalTrafficPolicy: %v", policyType)
 
 	if policyType == "" {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to set ExternalTrafficPolicy on service %s in namespace %s. "+
 				"policyType can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "ExternalTrafficPolicy can not be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ExternalTrafficPolicy can not be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -238,18 +469,18 @@ func (builder *Builder) WithAnnotation(annotation map[string]string) *Builder {
 		return builder
 	}
 
-	glog.V(100).Infof("Defining service's Annotation to %v", annotation)
+	logging.V(100).Infof("Defining service's Annotation to %v", annotation)
 
 	if annotation == nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"Failed to set Annotation on service %s in namespace %s. "+
 				"Service Annotation can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "Annotation can not be empty map"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Annotation can not be empty map"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -264,23 +495,23 @@ func (builder *Builder) WithIPFamily(ipFamily []corev1.IPFamily, ipStackPolicy c
 		return builder
 	}
 
-	glog.V(100).Infof("Defining service's IPFamily: %v and IPFamilyPolicy: %v", ipFamily, ipStackPolicy)
+	logging.V(100).Infof("Defining service's IPFamily: %v and IPFamilyPolicy: %v", ipFamily, ipStackPolicy)
 
 	if ipFamily == nil {
-		glog.V(100).Infof("Failed to set empty ipFamily on service %s in namespace %s",
+		logging.V(100).Infof("Failed to set empty ipFamily on service %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "failed to set empty ipFamily"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to set empty ipFamily"))
 	}
 
 	if ipStackPolicy == "" {
-		glog.V(100).Infof("Failed to set empty ipStackPolicy on service %s in namespace %s",
+		logging.V(100).Infof("Failed to set empty ipStackPolicy on service %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "failed to set empty ipStackPolicy"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to set empty ipStackPolicy"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -290,26 +521,312 @@ func (builder *Builder) WithIPFamily(ipFamily []corev1.IPFamily, ipStackPolicy c
 	return builder
 }
 
+// WithSessionAffinity redefines the service with the given SessionAffinity. When ClientIP affinity is used,
+// timeoutSeconds sets the maximum session sticky time and must be in the range of 1 to 86400 seconds.
+func (builder *Builder) WithSessionAffinity(affinity corev1.ServiceAffinity, timeoutSeconds int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Defining service's SessionAffinity: %v with timeout %d seconds", affinity, timeoutSeconds)
+
+	switch affinity {
+	case corev1.ServiceAffinityNone:
+		builder.Definition.Spec.SessionAffinity = affinity
+		builder.Definition.Spec.SessionAffinityConfig = nil
+
+		return builder
+	case corev1.ServiceAffinityClientIP:
+	default:
+		logging.V(100).Infof("Failed to set SessionAffinity %v on service %s in namespace %s",
+			affinity, builder.Definition.Name, builder.Definition.Namespace)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid SessionAffinity %q, allowed values are %q and %q",
+			affinity, corev1.ServiceAffinityClientIP, corev1.ServiceAffinityNone))
+
+		return builder
+	}
+
+	if timeoutSeconds < 1 || timeoutSeconds > maxClientIPAffinitySeconds {
+		logging.V(100).Infof("Failed to set SessionAffinity timeout %d on service %s in namespace %s",
+			timeoutSeconds, builder.Definition.Name, builder.Definition.Namespace)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SessionAffinity timeoutSeconds must be between 1 and %d, got %d",
+			maxClientIPAffinitySeconds, timeoutSeconds))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SessionAffinity = affinity
+	builder.Definition.Spec.SessionAffinityConfig = &corev1.SessionAffinityConfig{
+		ClientIP: &corev1.ClientIPConfig{TimeoutSeconds: &timeoutSeconds},
+	}
+
+	return builder
+}
+
+// WithInternalTrafficPolicy redefines the service with the given InternalTrafficPolicy.
+func (builder *Builder) WithInternalTrafficPolicy(policyType corev1.ServiceInternalTrafficPolicyType) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Defining service's InternalTrafficPolicy: %v", policyType)
+
+	if policyType != corev1.ServiceInternalTrafficPolicyCluster && policyType != corev1.ServiceInternalTrafficPolicyLocal {
+		logging.V(100).Infof("Failed to set InternalTrafficPolicy %v on service %s in namespace %s",
+			policyType, builder.Definition.Name, builder.Definition.Namespace)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid InternalTrafficPolicy %q, allowed values are %q and %q",
+			policyType, corev1.ServiceInternalTrafficPolicyCluster, corev1.ServiceInternalTrafficPolicyLocal))
+
+		return builder
+	}
+
+	builder.Definition.Spec.InternalTrafficPolicy = &policyType
+
+	return builder
+}
+
+// WithLoadBalancerClass redefines the service with LoadBalancer type and the given LoadBalancerClass,
+// selecting the load balancer implementation that handles the service, e.g. "metallb.universe.tf/metallb".
+func (builder *Builder) WithLoadBalancerClass(loadBalancerClass string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Defining service's LoadBalancerClass: %s", loadBalancerClass)
+
+	if loadBalancerClass == "" {
+		logging.V(100).Infof("Failed to set empty LoadBalancerClass on service %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("LoadBalancerClass can not be empty"))
+
+		return builder
+	}
+
+	if errs := validation.IsQualifiedName(loadBalancerClass); len(errs) > 0 {
+		logging.V(100).Infof("Failed to set LoadBalancerClass %s on service %s in namespace %s",
+			loadBalancerClass, builder.Definition.Name, builder.Definition.Namespace)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid LoadBalancerClass %s: %s", loadBalancerClass, strings.Join(errs, "; ")))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Type = corev1.ServiceTypeLoadBalancer
+	builder.Definition.Spec.LoadBalancerClass = &loadBalancerClass
+
+	return builder
+}
+
+// WithAllocateLoadBalancerNodePorts redefines the service with LoadBalancer type and sets whether node ports
+// are automatically allocated for it.
+func (builder *Builder) WithAllocateLoadBalancerNodePorts(allocate bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Defining service's AllocateLoadBalancerNodePorts: %t", allocate)
+
+	builder.Definition.Spec.Type = corev1.ServiceTypeLoadBalancer
+	builder.Definition.Spec.AllocateLoadBalancerNodePorts = &allocate
+
+	return builder
+}
+
+// WaitUntilEndpointsReady waits until the service's EndpointSlices have at least readyAddresses distinct ready
+// addresses. An address only counts once it is ready for every port declared by the service, so a pod serving one of
+// several ports, or one pod serving all of them, does not satisfy a wait for more addresses.
+func (builder *Builder) WaitUntilEndpointsReady(readyAddresses int, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for service %s in namespace %s to have %d ready endpoint addresses",
+		builder.Definition.Name, builder.Definition.Namespace, readyAddresses)
+
+	if readyAddresses < 1 {
+		logging.V(100).Infof("The number of ready addresses must be positive")
+
+		return fmt.Errorf("readyAddresses must be greater than zero, got %d", readyAddresses)
+	}
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for endpoints of service %s in namespace %s because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	servicePorts := builder.Object.Spec.Ports
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			endpointSlices, err := builder.apiClient.K8sClient.DiscoveryV1().EndpointSlices(
+				builder.Definition.Namespace).List(ctx, metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, builder.Definition.Name),
+			})
+
+			if err != nil {
+				logging.V(100).Infof("Failed to list EndpointSlices of service %s due to %s",
+					builder.Definition.Name, err.Error())
+
+				return false, nil
+			}
+
+			// Ready addresses mapped to the names of the ports they are ready for.
+			servedPorts := make(map[string]map[string]bool)
+
+			for _, endpointSlice := range endpointSlices.Items {
+				for _, endpoint := range endpointSlice.Endpoints {
+					// A nil ready condition should be interpreted as ready.
+					if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+						continue
+					}
+
+					for _, address := range endpoint.Addresses {
+						if servedPorts[address] == nil {
+							servedPorts[address] = make(map[string]bool)
+						}
+
+						for _, port := range endpointSlice.Ports {
+							servedPorts[address][ptr.Deref(port.Name, "")] = true
+						}
+					}
+				}
+			}
+
+			ready := 0
+
+			for _, ports := range servedPorts {
+				if servesAllPorts(ports, servicePorts) {
+					ready++
+				}
+			}
+
+			logging.V(100).Infof("Service %s in namespace %s has %d ready endpoint addresses",
+				builder.Definition.Name, builder.Definition.Namespace, ready)
+
+			return ready >= readyAddresses, nil
+		})
+}
+
+// GetLoadBalancerIngress returns the IP addresses and hostnames assigned to the LoadBalancer service
+// in status.loadBalancer.ingress.
+func (builder *Builder) GetLoadBalancerIngress() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting LoadBalancer ingress of service %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("service object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		logging.V(100).Infof("The service %s in namespace %s is of type %s",
+			builder.Definition.Name, builder.Definition.Namespace, builder.Object.Spec.Type)
+
+		return nil, fmt.Errorf("service %s in namespace %s is not of type LoadBalancer",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var ingressAddresses []string
+
+	for _, ingress := range builder.Object.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			ingressAddresses = append(ingressAddresses, ingress.IP)
+		}
+
+		if ingress.Hostname != "" {
+			ingressAddresses = append(ingressAddresses, ingress.Hostname)
+		}
+	}
+
+	return ingressAddresses, nil
+}
+
+// WaitUntilExternalIPAssigned waits until the LoadBalancer service has an ingress IP address or hostname
+// assigned and returns the first one.
+func (builder *Builder) WaitUntilExternalIPAssigned(timeout time.Duration) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Waiting for service %s in namespace %s to get an external IP",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var externalIP string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			ingressAddresses, err := builder.GetLoadBalancerIngress()
+			if err != nil {
+				return false, err
+			}
+
+			if len(ingressAddresses) == 0 {
+				return false, nil
+			}
+
+			externalIP = ingressAddresses[0]
+
+			return true, nil
+		})
+
+	if err != nil {
+		return "", err
+	}
+
+	return externalIP, nil
+}
+
 // DefineServicePort helper for creating a Service with a ServicePort.
 func DefineServicePort(port, targetPort int32, protocol corev1.Protocol) (*corev1.ServicePort, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Defining ServicePort with port %d and targetport %d", port, targetPort)
 
 	if !isValidPort(port) {
-		return nil, fmt.Errorf("invalid port number")
+		return nil, fmt.Errorf("invalid port number %d", port)
 	}
 
 	if !isValidPort(targetPort) {
-		return nil, fmt.Errorf("invalid target port number")
+		return nil, fmt.Errorf("invalid target port number %d", targetPort)
 	}
 
 	return &corev1.ServicePort{
-		Protocol: protocol,
-		Port:     port,
-		TargetPort: intstr.IntOrString{
-			Type:   intstr.Int,
-			IntVal: targetPort,
-		},
+		Protocol:   protocol,
+		Port:       port,
+		TargetPort: intstr.FromInt32(targetPort),
+	}, nil
+}
+
+// DefineServicePortWithNamedTarget helper for creating a Service with a ServicePort
+// that references a named container port as its target.
+func DefineServicePortWithNamedTarget(
+	port int32, targetPort string, protocol corev1.Protocol) (*corev1.ServicePort, error) {
+	logging.V(100).Infof(
+		"Defining ServicePort with port %d and named targetport %s", port, targetPort)
+
+	if !isValidPort(port) {
+		return nil, fmt.Errorf("invalid port number %d", port)
+	}
+
+	if errs := validation.IsValidPortName(targetPort); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid target port name %q: %s", targetPort, strings.Join(errs, "; "))
+	}
+
+	return &corev1.ServicePort{
+		Protocol:   protocol,
+		Port:       port,
+		TargetPort: intstr.FromString(targetPort),
 	}, nil
 }
 
@@ -320,13 +837,36 @@ func GetServiceGVR() schema.GroupVersionResource {
 	}
 }
 
+// getPortIndexByName returns the index of the service port with the given name or -1 if it is not found.
+func (builder *Builder) getPortIndexByName(portName string) int {
+	for index, servicePort := range builder.Definition.Spec.Ports {
+		if servicePort.Name == portName {
+			return index
+		}
+	}
+
+	return -1
+}
+
 // isValidPort checks if a port is valid.
 func isValidPort(port int32) bool {
-	if (port > 0) || (port < 65535) {
-		return true
+	return len(validation.IsValidPortNum(int(port))) == 0
+}
+
+// servesAllPorts checks whether the EndpointSlice port names an address is ready for cover every service port.
+func servesAllPorts(portNames map[string]bool, servicePorts []corev1.ServicePort) bool {
+	for _, servicePort := range servicePorts {
+		if !portNames[servicePort.Name] {
+			return false
+		}
 	}
 
-	return false
+	return true
+}
+
+// GetGVR returns the service GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetServiceGVR()
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -335,27 +875,29 @@ func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "Service"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -1 +1,629 @@
 package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+func TestNewBuilderFromYAML(t *testing.T) {
+	testCases := []struct {
+		manifest      string
+		expectedError string
+	}{
+		{
+			manifest: "apiVersion: v1\nkind: Service\nmetadata:\n  name: test-service\n  namespace: test-namespace\n" +
+				"spec:\n  ports:\n  - name: http\n    port: 80\n",
+		},
+		{
+			manifest:      "metadata:\n  namespace: test-namespace\n",
+			expectedError: "Service 'name' cannot be empty",
+		},
+		{
+			manifest:      "",
+			expectedError: "failed to decode service manifest: expected manifest with 1 document, found 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+		testBuilder := NewBuilderFromYAML(testSettings, []byte(testCase.manifest))
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, "test-service", testBuilder.Definition.Name)
+		assert.Equal(t, "http", testBuilder.Definition.Spec.Ports[0].Name)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	testBuilder := NewBuilder(
+		testSettings, "test-service", "test-namespace", map[string]string{"app": "test"}, corev1.ServicePort{Port: 80})
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, "service object test-service doesn't exist in namespace test-namespace")
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	// The fake clientset does not default the service, so set the values the API server would.
+	testBuilder.Object.Spec.Ports[0].Protocol = corev1.ProtocolTCP
+	testBuilder.Object.Spec.Ports[0].TargetPort = intstr.FromInt(80)
+	testBuilder.Object.Spec.ClusterIP = "172.30.0.10"
+	testBuilder.Object.Spec.SessionAffinity = corev1.ServiceAffinityNone
+	_, err = testSettings.Services("test-namespace").Update(context.TODO(), testBuilder.Object, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+
+	testBuilder.Object.Spec.Ports[0].Port = 8080
+	_, err = testSettings.Services("test-namespace").Update(context.TODO(), testBuilder.Object, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+
+	differences, err = testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Equal(t, "spec.ports[0].port: expected 80, found 8080", differences.String())
+}
+
+func TestWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyService("existing-service", "test-namespace", nil)},
+		Reactors: []clients.TestReactor{{
+			Verb:     "*",
+			Resource: "services",
+			Reaction: func(action k8sTesting.Action) (bool, runtime.Object, error) {
+				if deleteAction, ok := action.(k8sTesting.DeleteAction); ok &&
+					len(deleteAction.GetDeleteOptions().DryRun) > 0 {
+					dryRunActions = append(dryRunActions, action.GetVerb())
+
+					return true, nil, nil
+				}
+
+				// The fake clientset does not implement dry-run, so creates are not forwarded to the tracker.
+				if action.GetVerb() == "create" {
+					dryRunActions = append(dryRunActions, action.GetVerb())
+
+					return true, &corev1.Service{}, nil
+				}
+
+				return false, nil, nil
+			},
+		}},
+	})
+
+	testBuilder := NewBuilder(
+		testSettings, "test-service", "test-namespace", map[string]string{"app": "test"}, corev1.ServicePort{Port: 80}).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+
+	err = testBuilder.CreateDryRun()
+	assert.Nil(t, err)
+
+	existingBuilder := NewBuilder(
+		testSettings, "existing-service", "test-namespace", nil, corev1.ServicePort{Port: 80}).WithDryRun(true)
+
+	err = existingBuilder.Delete()
+	assert.Nil(t, err)
+	assert.True(t, existingBuilder.Exists())
+	assert.Equal(t, []string{"create", "create", "delete"}, dryRunActions)
+}
+
+func TestWithNodePort(t *testing.T) {
+	testCases := []struct {
+		portNames         []string
+		expectedNodePorts []int32
+		expectedErrMsg    string
+	}{
+		{
+			portNames:         nil,
+			expectedNodePorts: []int32{80, 9090},
+		},
+		{
+			portNames:         []string{"metrics"},
+			expectedNodePorts: []int32{0, 9090},
+		},
+		{
+			portNames:      []string{"missing"},
+			expectedErrMsg: "service does not have port named missing",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithAdditionalPorts(
+			[]corev1.ServicePort{{Name: "metrics", Port: 9090}})
+
+		testBuilder.WithNodePort(testCase.portNames...)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, corev1.ServiceTypeNodePort, testBuilder.Definition.Spec.Type)
+
+		for index, nodePort := range testCase.expectedNodePorts {
+			assert.Equal(t, nodePort, testBuilder.Definition.Spec.Ports[index].NodePort)
+		}
+	}
+}
+
+func TestWithAdditionalPorts(t *testing.T) {
+	testCases := []struct {
+		servicePorts   []corev1.ServicePort
+		expectedPorts  int
+		expectedErrMsg string
+	}{
+		{
+			servicePorts:  []corev1.ServicePort{{Name: "metrics", Port: 9090}, {Name: "data", Port: 8080}},
+			expectedPorts: 3,
+		},
+		{
+			servicePorts:   []corev1.ServicePort{},
+			expectedErrMsg: "cannot accept empty list as service ports",
+		},
+		{
+			servicePorts:   []corev1.ServicePort{{Port: 9090}},
+			expectedErrMsg: "service port 9090 must have a name when the service has multiple ports",
+		},
+		{
+			servicePorts:   []corev1.ServicePort{{Name: "http", Port: 9090}},
+			expectedErrMsg: "service port name http is not unique",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithAdditionalPorts(testCase.servicePorts)
+
+		if testCase.expectedErrMsg != "" {
+			assert.EqualError(t, testBuilder.errorMsg, testCase.expectedErrMsg)
+			assert.Len(t, testBuilder.Definition.Spec.Ports, 1)
+
+			continue
+		}
+
+		assert.Nil(t, testBuilder.errorMsg)
+		assert.Len(t, testBuilder.Definition.Spec.Ports, testCase.expectedPorts)
+	}
+}
+
+func TestNewHeadlessBuilder(t *testing.T) {
+	testCases := []struct {
+		labels         map[string]string
+		expectedErrMsg string
+	}{
+		{
+			labels: map[string]string{"app": "test"},
+		},
+		{
+			labels:         map[string]string{},
+			expectedErrMsg: "headless service 'labels' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewHeadlessBuilder(
+			clients.GetTestClients(clients.TestClientParams{}),
+			"test-service",
+			"test-namespace",
+			testCase.labels,
+			corev1.ServicePort{Name: "http", Port: 80})
+
+		assert.Equal(t, corev1.ClusterIPNone, testBuilder.Definition.Spec.ClusterIP)
+
+		if testCase.expectedErrMsg != "" {
+			assert.EqualError(t, testBuilder.errorMsg, testCase.expectedErrMsg)
+		} else {
+			assert.Nil(t, testBuilder.errorMsg)
+		}
+	}
+}
+
+func TestWithSessionAffinity(t *testing.T) {
+	testCases := []struct {
+		affinity       corev1.ServiceAffinity
+		timeoutSeconds int32
+		expectedErrMsg string
+	}{
+		{
+			affinity:       corev1.ServiceAffinityClientIP,
+			timeoutSeconds: 600,
+		},
+		{
+			affinity: corev1.ServiceAffinityNone,
+		},
+		{
+			affinity:       corev1.ServiceAffinityClientIP,
+			timeoutSeconds: 0,
+			expectedErrMsg: "SessionAffinity timeoutSeconds must be between 1 and 86400, got 0",
+		},
+		{
+			affinity:       "Cookie",
+			timeoutSeconds: 600,
+			expectedErrMsg: "invalid SessionAffinity \"Cookie\", allowed values are \"ClientIP\" and \"None\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithSessionAffinity(testCase.affinity, testCase.timeoutSeconds)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.affinity, testBuilder.Definition.Spec.SessionAffinity)
+
+		if testCase.affinity == corev1.ServiceAffinityClientIP {
+			assert.Equal(t, testCase.timeoutSeconds, *testBuilder.Definition.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds)
+		} else {
+			assert.Nil(t, testBuilder.Definition.Spec.SessionAffinityConfig)
+		}
+	}
+}
+
+func TestWithInternalTrafficPolicy(t *testing.T) {
+	testCases := []struct {
+		policyType     corev1.ServiceInternalTrafficPolicyType
+		expectedErrMsg string
+	}{
+		{
+			policyType: corev1.ServiceInternalTrafficPolicyLocal,
+		},
+		{
+			policyType: corev1.ServiceInternalTrafficPolicyCluster,
+		},
+		{
+			policyType:     "",
+			expectedErrMsg: "invalid InternalTrafficPolicy \"\", allowed values are \"Cluster\" and \"Local\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithInternalTrafficPolicy(testCase.policyType)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.policyType, *testBuilder.Definition.Spec.InternalTrafficPolicy)
+	}
+}
+
+func TestWithLoadBalancerClass(t *testing.T) {
+	testCases := []struct {
+		loadBalancerClass string
+		expectedErrMsg    string
+	}{
+		{
+			loadBalancerClass: "metallb.universe.tf/metallb",
+		},
+		{
+			loadBalancerClass: "",
+			expectedErrMsg:    "LoadBalancerClass can not be empty",
+		},
+		{
+			loadBalancerClass: "bad/class/name",
+			expectedErrMsg:    "invalid LoadBalancerClass bad/class/name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithLoadBalancerClass(testCase.loadBalancerClass)
+
+		if testCase.expectedErrMsg != "" {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedErrMsg)
+			assert.Nil(t, testBuilder.Definition.Spec.LoadBalancerClass)
+
+			continue
+		}
+
+		assert.Nil(t, testBuilder.errorMsg)
+		assert.Equal(t, corev1.ServiceTypeLoadBalancer, testBuilder.Definition.Spec.Type)
+		assert.Equal(t, testCase.loadBalancerClass, *testBuilder.Definition.Spec.LoadBalancerClass)
+	}
+}
+
+func TestWithAllocateLoadBalancerNodePorts(t *testing.T) {
+	testBuilder := buildValidTestBuilder().WithAllocateLoadBalancerNodePorts(false)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, testBuilder.Definition.Spec.Type)
+	assert.False(t, *testBuilder.Definition.Spec.AllocateLoadBalancerNodePorts)
+}
+
+func TestWaitUntilEndpointsReady(t *testing.T) {
+	httpPort := buildDummyEndpointPort("http", 80)
+	metricsPort := buildDummyEndpointPort("metrics", 9090)
+	twoPorts := []corev1.ServicePort{{Name: "http", Port: 80}, {Name: "metrics", Port: 9090}}
+
+	testCases := []struct {
+		readyAddresses int
+		servicePorts   []corev1.ServicePort
+		endpointSlices []*discoveryv1.EndpointSlice
+		expectedErrMsg string
+	}{
+		{
+			readyAddresses: 2,
+			endpointSlices: []*discoveryv1.EndpointSlice{buildDummyPortEndpointSlice("", nil, "10.0.0.1", "10.0.0.2")},
+		},
+		{
+			readyAddresses: 2,
+			servicePorts:   []corev1.ServicePort{{Name: "http", Port: 80}},
+			endpointSlices: []*discoveryv1.EndpointSlice{
+				buildDummyPortEndpointSlice("", []discoveryv1.EndpointPort{httpPort}, "10.0.0.1", "10.0.0.2")},
+		},
+		{
+			readyAddresses: 2,
+			servicePorts:   twoPorts,
+			endpointSlices: []*discoveryv1.EndpointSlice{
+				buildDummyPortEndpointSlice("", []discoveryv1.EndpointPort{httpPort, metricsPort}, "10.0.0.1", "10.0.0.2")},
+		},
+		{
+			readyAddresses: 2,
+			servicePorts:   twoPorts,
+			endpointSlices: []*discoveryv1.EndpointSlice{
+				buildDummyPortEndpointSlice("", []discoveryv1.EndpointPort{httpPort, metricsPort}, "10.0.0.1")},
+			expectedErrMsg: "context deadline exceeded",
+		},
+		{
+			readyAddresses: 1,
+			servicePorts:   twoPorts,
+			endpointSlices: []*discoveryv1.EndpointSlice{
+				buildDummyPortEndpointSlice("", []discoveryv1.EndpointPort{httpPort, metricsPort}, "10.0.0.1")},
+		},
+		{
+			readyAddresses: 2,
+			servicePorts:   twoPorts,
+			endpointSlices: []*discoveryv1.EndpointSlice{
+				buildDummyPortEndpointSlice("-http", []discoveryv1.EndpointPort{httpPort}, "10.0.0.1", "10.0.0.2"),
+				buildDummyPortEndpointSlice("-metrics", []discoveryv1.EndpointPort{metricsPort}, "10.0.0.1")},
+			expectedErrMsg: "context deadline exceeded",
+		},
+		{
+			readyAddresses: 1,
+			servicePorts:   twoPorts,
+			endpointSlices: []*discoveryv1.EndpointSlice{
+				buildDummyPortEndpointSlice("-http", []discoveryv1.EndpointPort{httpPort}, "10.0.0.1", "10.0.0.2"),
+				buildDummyPortEndpointSlice("-metrics", []discoveryv1.EndpointPort{metricsPort}, "10.0.0.1")},
+		},
+		{
+			readyAddresses: 3,
+			endpointSlices: []*discoveryv1.EndpointSlice{buildDummyPortEndpointSlice("", nil, "10.0.0.1", "10.0.0.2")},
+			expectedErrMsg: "context deadline exceeded",
+		},
+		{
+			readyAddresses: 1,
+			endpointSlices: []*discoveryv1.EndpointSlice{
+				buildDummyEndpointSlice("test-service", "test-namespace", false, "10.0.0.1", "10.0.0.2")},
+			expectedErrMsg: "context deadline exceeded",
+		},
+		{
+			readyAddresses: 0,
+			expectedErrMsg: "readyAddresses must be greater than zero, got 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testService := buildDummyService("test-service", "test-namespace", nil)
+		testService.Spec.Ports = testCase.servicePorts
+
+		runtimeObjects := []runtime.Object{testService}
+
+		for _, endpointSlice := range testCase.endpointSlices {
+			runtimeObjects = append(runtimeObjects, endpointSlice)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder := NewBuilder(testSettings, "test-service", "test-namespace",
+			map[string]string{"app": "test"}, corev1.ServicePort{Name: "http", Port: 80})
+
+		err := testBuilder.WaitUntilEndpointsReady(testCase.readyAddresses, 2*time.Second)
+
+		if testCase.expectedErrMsg != "" {
+			assert.EqualError(t, err, testCase.expectedErrMsg)
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func TestGetLoadBalancerIngress(t *testing.T) {
+	testCases := []struct {
+		serviceType       corev1.ServiceType
+		ingress           []corev1.LoadBalancerIngress
+		expectedAddresses []string
+		expectedErrMsg    string
+	}{
+		{
+			serviceType:       corev1.ServiceTypeLoadBalancer,
+			ingress:           []corev1.LoadBalancerIngress{{IP: "192.168.1.10"}, {Hostname: "lb.example.com"}},
+			expectedAddresses: []string{"192.168.1.10", "lb.example.com"},
+		},
+		{
+			serviceType:       corev1.ServiceTypeLoadBalancer,
+			expectedAddresses: nil,
+		},
+		{
+			serviceType:    corev1.ServiceTypeClusterIP,
+			expectedErrMsg: "service test-service in namespace test-namespace is not of type LoadBalancer",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testService := buildDummyService("test-service", "test-namespace", nil)
+		testService.Spec.Type = testCase.serviceType
+		testService.Status.LoadBalancer.Ingress = testCase.ingress
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{testService}})
+		testBuilder := NewBuilder(testSettings, "test-service", "test-namespace",
+			map[string]string{"app": "test"}, corev1.ServicePort{Name: "http", Port: 80})
+
+		ingressAddresses, err := testBuilder.GetLoadBalancerIngress()
+
+		if testCase.expectedErrMsg != "" {
+			assert.EqualError(t, err, testCase.expectedErrMsg)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedAddresses, ingressAddresses)
+		}
+	}
+}
+
+func TestWaitUntilExternalIPAssigned(t *testing.T) {
+	testService := buildDummyService("test-service", "test-namespace", nil)
+	testService.Spec.Type = corev1.ServiceTypeLoadBalancer
+	testService.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "192.168.1.10"}}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{testService}})
+	testBuilder := NewBuilder(testSettings, "test-service", "test-namespace",
+		map[string]string{"app": "test"}, corev1.ServicePort{Name: "http", Port: 80})
+
+	externalIP, err := testBuilder.WaitUntilExternalIPAssigned(2 * time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, "192.168.1.10", externalIP)
+}
+
+func TestDefineServicePort(t *testing.T) {
+	testCases := []struct {
+		port           int32
+		targetPort     int32
+		expectedErrMsg string
+	}{
+		{
+			port:       80,
+			targetPort: 8080,
+		},
+		{
+			port:           0,
+			targetPort:     8080,
+			expectedErrMsg: "invalid port number 0",
+		},
+		{
+			port:           80,
+			targetPort:     65536,
+			expectedErrMsg: "invalid target port number 65536",
+		},
+	}
+
+	for _, testCase := range testCases {
+		servicePort, err := DefineServicePort(testCase.port, testCase.targetPort, corev1.ProtocolTCP)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrMsg, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.port, servicePort.Port)
+		assert.Equal(t, intstr.FromInt32(testCase.targetPort), servicePort.TargetPort)
+	}
+}
+
+func TestDefineServicePortWithNamedTarget(t *testing.T) {
+	testCases := []struct {
+		port           int32
+		targetPort     string
+		expectedErrMsg string
+	}{
+		{
+			port:       80,
+			targetPort: "http",
+		},
+		{
+			port:           70000,
+			targetPort:     "http",
+			expectedErrMsg: "invalid port number 70000",
+		},
+		{
+			port:           80,
+			targetPort:     "",
+			expectedErrMsg: "invalid target port name \"\"",
+		},
+		{
+			port:           80,
+			targetPort:     "Invalid_Name",
+			expectedErrMsg: "invalid target port name \"Invalid_Name\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		servicePort, err := DefineServicePortWithNamedTarget(testCase.port, testCase.targetPort, corev1.ProtocolTCP)
+
+		if testCase.expectedErrMsg != "" {
+			assert.ErrorContains(t, err, testCase.expectedErrMsg)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.port, servicePort.Port)
+		assert.Equal(t, intstr.FromString(testCase.targetPort), servicePort.TargetPort)
+	}
+}
+
+// buildValidTestBuilder returns a valid Builder for testing purposes.
+func buildValidTestBuilder() *Builder {
+	return NewBuilder(
+		clients.GetTestClients(clients.TestClientParams{}),
+		"test-service",
+		"test-namespace",
+		map[string]string{"app": "test"},
+		corev1.ServicePort{Name: "http", Port: 80})
+}
+
+func buildDummyEndpointPort(name string, port int32) discoveryv1.EndpointPort {
+	protocol := corev1.ProtocolTCP
+
+	return discoveryv1.EndpointPort{Name: &name, Port: &port, Protocol: &protocol}
+}
+
+func buildDummyEndpointSlice(serviceName, nsname string, ready bool, addresses ...string) *discoveryv1.EndpointSlice {
+	endpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName + "-abcde",
+			Namespace: nsname,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: serviceName},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+
+	for _, address := range addresses {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+		})
+	}
+
+	return endpointSlice
+}
+
+// buildDummyPortEndpointSlice returns a ready EndpointSlice of test-service serving the given ports, named after the
+// service with the given suffix so several slices can back the same service.
+func buildDummyPortEndpointSlice(
+	suffix string, ports []discoveryv1.EndpointPort, addresses ...string) *discoveryv1.EndpointSlice {
+	endpointSlice := buildDummyEndpointSlice("test-service", "test-namespace", true, addresses...)
+	endpointSlice.Name += suffix
+	endpointSlice.Ports = ports
+
+	return endpointSlice
+}
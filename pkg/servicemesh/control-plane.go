@@ -2,10 +2,11 @@ package servicemesh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,14 +23,14 @@ type ControlPlaneBuilder struct {
 	Object *istioV2.ServiceMeshControlPlane
 	// Used in functions that define or mutate serviceMeshControlPlane definition. errorMsg is processed
 	// before the serviceMeshControlPlane object is created.
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 }
 
 // NewControlPlaneBuilder method creates new instance of builder.
 func NewControlPlaneBuilder(apiClient *clients.Settings, name, nsname string) *ControlPlaneBuilder {
-	glog.V(100).Infof("Initializing new ControlPlaneBuilder structure with the following "+
+	logging.V(100).Infof("Initializing new ControlPlaneBuilder structure with the following "+
 		"params: name: %s, namespace: %s", name, nsname)
 
 	builder := &ControlPlaneBuilder{
@@ -43,15 +44,16 @@ func NewControlPlaneBuilder(apiClient *clients.Settings, name, nsname string) *C
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the serviceMeshControlPlane is empty")
+		logging.V(100).Infof("The name of the serviceMeshControlPlane is empty")
 
-		builder.errorMsg = "the serviceMeshControlPlane 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the serviceMeshControlPlane 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the serviceMeshControlPlane is empty")
+		logging.V(100).Infof("The namespace of the serviceMeshControlPlane is empty")
 
-		builder.errorMsg = "the serviceMeshControlPlane 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"the serviceMeshControlPlane 'namespace' cannot be empty"))
 	}
 
 	return builder
@@ -65,7 +67,7 @@ func (builder *ControlPlaneBuilder) WithAllAddonsDisabled() *ControlPlaneBuilder
 
 	enablement := false
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating serviceMeshControlPlane %s in namespace %s with the all addons disabled",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -98,26 +100,28 @@ func (builder *ControlPlaneBuilder) WithGrafanaAddon(
 		return builder
 	}
 
-	glog.V(100).Infof(
-		"Creating serviceMeshControlPlane %s in namespace %s with the Grafana addons defined: enablement %s, "+
+	logging.V(100).Infof(
+		"Creating serviceMeshControlPlane %s in namespace %s with the Grafana addons defined: enablement %t, "+
 			"grafanaInstallConfig %v, address %s", builder.Definition.Name, builder.Definition.Namespace,
 		enablement, grafanaInstallConfig, address)
 
 	if enablement {
 		if grafanaInstallConfig == nil {
-			glog.V(100).Infof("The grafanaInstallConfig of the Grafana addon is empty")
+			logging.V(100).Infof("The grafanaInstallConfig of the Grafana addon is empty")
 
-			builder.errorMsg = "the Grafana addon 'grafanaInstallConfig' cannot be empty when Grafana addon is enabled"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"the Grafana addon 'grafanaInstallConfig' cannot be empty when Grafana addon is enabled"))
 		}
 
 		if address == "" {
-			glog.V(100).Infof("The address of the Grafana addon is empty")
+			logging.V(100).Infof("The address of the Grafana addon is empty")
 
-			builder.errorMsg = "the Grafana addon 'address' cannot be empty when Grafana addon is enabled"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"the Grafana addon 'address' cannot be empty when Grafana addon is enabled"))
 		}
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -146,23 +150,23 @@ func (builder *ControlPlaneBuilder) WithJaegerAddon(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating serviceMeshControlPlane %s in namespace %s with the JaegerAddonConfig defined",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if name == "" {
-		glog.V(100).Infof("The name of the Jaeger addon is empty")
+		logging.V(100).Infof("The name of the Jaeger addon is empty")
 
-		builder.errorMsg = "the Jaeger addon 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the Jaeger addon 'name' cannot be empty"))
 	}
 
 	if jaegerInstallConfig == nil {
-		glog.V(100).Infof("The jaegerInstallConfig of the Jaeger addon is empty")
+		logging.V(100).Infof("The jaegerInstallConfig of the Jaeger addon is empty")
 
-		builder.errorMsg = "the Jaeger addon 'jaegerInstallConfig' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the Jaeger addon 'jaegerInstallConfig' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -189,25 +193,27 @@ func (builder *ControlPlaneBuilder) WithKialiAddon(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating serviceMeshControlPlane %s in namespace %s with the KialiAddonConfig defined",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if enablement {
 		if kialiInstallConfig == nil {
-			glog.V(100).Infof("The kialiInstallConfig of the Kiali addon is empty")
+			logging.V(100).Infof("The kialiInstallConfig of the Kiali addon is empty")
 
-			builder.errorMsg = "the Kiali addon 'kialiInstallConfig' cannot be empty when Kiali addon is enabled"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"the Kiali addon 'kialiInstallConfig' cannot be empty when Kiali addon is enabled"))
 		}
 
 		if name == "" {
-			glog.V(100).Infof("The name of the Kiali addon is empty")
+			logging.V(100).Infof("The name of the Kiali addon is empty")
 
-			builder.errorMsg = "the Kiali addon 'name' cannot be empty when Kiali addon is enabled"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"the Kiali addon 'name' cannot be empty when Kiali addon is enabled"))
 		}
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -239,33 +245,34 @@ func (builder *ControlPlaneBuilder) WithPrometheusAddon(
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating serviceMeshControlPlane %s in namespace %s with the PrometheusAddonConfig defined",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if enablement {
 		if prometheusInstallConfig == nil {
-			glog.V(100).Info("The prometheusInstallConfig of the Prometheus addon is empty")
+			logging.V(100).Info("The prometheusInstallConfig of the Prometheus addon is empty")
 
-			builder.errorMsg = "the Prometheus addon 'prometheusInstallConfig' cannot " +
-				"be empty when Prometheus addon is enabled"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the Prometheus addon 'prometheusInstallConfig' cannot "+
+				"be empty when Prometheus addon is enabled"))
 		}
 
 		if metricsExpiryDuration == "" {
-			glog.V(100).Info("The metricsExpiryDuration of the Prometheus addon is empty")
+			logging.V(100).Info("The metricsExpiryDuration of the Prometheus addon is empty")
 
-			builder.errorMsg = "the Prometheus addon 'metricsExpiryDuration' cannot " +
-				"be empty when Prometheus addon is enabled"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the Prometheus addon 'metricsExpiryDuration' cannot "+
+				"be empty when Prometheus addon is enabled"))
 		}
 
 		if address == "" {
-			glog.V(100).Info("The address of the Prometheus addon is empty")
+			logging.V(100).Info("The address of the Prometheus addon is empty")
 
-			builder.errorMsg = "the Prometheus addon 'address' cannot be empty when Prometheus addon is enabled"
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"the Prometheus addon 'address' cannot be empty when Prometheus addon is enabled"))
 		}
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -294,7 +301,7 @@ func (builder *ControlPlaneBuilder) WithGatewaysEnablement(enablement bool) *Con
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating serviceMeshControlPlane %s in namespace %s with enabled Gateways",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -311,7 +318,7 @@ func (builder *ControlPlaneBuilder) WithGatewaysEnablement(enablement bool) *Con
 
 // PullControlPlane retrieves an existing serviceMeshControlPlane object from the cluster.
 func PullControlPlane(apiClient *clients.Settings, name, nsname string) (*ControlPlaneBuilder, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling serviceMeshControlPlane object name %s in namespace: %s", name, nsname)
 
 	builder := ControlPlaneBuilder{
@@ -325,18 +332,18 @@ func PullControlPlane(apiClient *clients.Settings, name, nsname string) (*Contro
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the serviceMeshControlPlane is empty")
+		logging.V(100).Infof("The name of the serviceMeshControlPlane is empty")
 
-		builder.errorMsg = "serviceMeshControlPlane 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("serviceMeshControlPlane 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the serviceMeshControlPlane is empty")
+		logging.V(100).Infof("The namespace of the serviceMeshControlPlane is empty")
 
-		builder.errorMsg = "serviceMeshControlPlane 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("serviceMeshControlPlane 'nsname' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return &builder, nil
 	}
 
@@ -355,7 +362,7 @@ func (builder *ControlPlaneBuilder) Get() (*istioV2.ServiceMeshControlPlane, err
 		return nil, err
 	}
 
-	glog.V(100).Infof("Pulling existing serviceMeshControlPlane with name %s in namespace %s from cluster",
+	logging.V(100).Infof("Pulling existing serviceMeshControlPlane with name %s in namespace %s from cluster",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	servicemeshcontrolplane := &istioV2.ServiceMeshControlPlane{}
@@ -379,7 +386,7 @@ func (builder *ControlPlaneBuilder) Create() (*ControlPlaneBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the serviceMeshControlPlane %s in namespace %s",
+	logging.V(100).Infof("Creating the serviceMeshControlPlane %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -399,7 +406,7 @@ func (builder *ControlPlaneBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the serviceMeshControlPlane %s in namespace %s",
+	logging.V(100).Infof("Deleting the serviceMeshControlPlane %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -425,20 +432,20 @@ func (builder *ControlPlaneBuilder) Update(force bool) (*ControlPlaneBuilder, er
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating serviceMeshControlPlane %s in namespace %s",
+	logging.V(100).Infof("Updating serviceMeshControlPlane %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("serviceMeshControlPlane", builder.Definition.Name, builder.Definition.Namespace))
 
 			err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("serviceMeshControlPlane", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -461,7 +468,7 @@ func (builder *ControlPlaneBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if serviceMeshControlPlane %s exists in namespace %s",
+	logging.V(100).Infof("Checking if serviceMeshControlPlane %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -476,27 +483,29 @@ func (builder *ControlPlaneBuilder) validate() (bool, error) {
 	resourceCRD := "ServiceMeshControlPlane"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The builder %s has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The builder %s has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
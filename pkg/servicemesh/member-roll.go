@@ -2,12 +2,13 @@ package servicemesh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	istioV1 "maistra.io/api/core/v1"
@@ -23,14 +24,14 @@ type MemberRollBuilder struct {
 	Object *istioV1.ServiceMeshMemberRoll
 	// Used in functions that define or mutate serviceMeshMemberRoll definition. errorMsg is processed
 	// before the serviceMeshMemberRoll object is created.
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient *clients.Settings
 }
 
 // NewMemberRollBuilder method creates new instance of builder.
 func NewMemberRollBuilder(apiClient *clients.Settings, name, nsname string) *MemberRollBuilder {
-	glog.V(100).Infof("Initializing new serviceMeshMemberRollBuilder structure with the following "+
+	logging.V(100).Infof("Initializing new serviceMeshMemberRollBuilder structure with the following "+
 		"params: name: %s, namespace: %s", name, nsname)
 
 	builder := &MemberRollBuilder{
@@ -44,15 +45,15 @@ func NewMemberRollBuilder(apiClient *clients.Settings, name, nsname string) *Mem
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the serviceMeshMemberRoll is empty")
+		logging.V(100).Infof("The name of the serviceMeshMemberRoll is empty")
 
-		builder.errorMsg = "The serviceMeshMemberRoll 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The serviceMeshMemberRoll 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the serviceMeshMemberRoll is empty")
+		logging.V(100).Infof("The namespace of the serviceMeshMemberRoll is empty")
 
-		builder.errorMsg = "The serviceMeshMemberRoll 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("The serviceMeshMemberRoll 'namespace' cannot be empty"))
 	}
 
 	return builder
@@ -60,7 +61,7 @@ func NewMemberRollBuilder(apiClient *clients.Settings, name, nsname string) *Mem
 
 // PullMemberRole retrieves an existing serviceMeshMemberRoll object from the cluster.
 func PullMemberRole(apiClient *clients.Settings, name, nsname string) (*MemberRollBuilder, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling serviceMeshMemberRoll object name: %s in namespace: %s", name, nsname)
 
 	builder := MemberRollBuilder{
@@ -74,15 +75,15 @@ func PullMemberRole(apiClient *clients.Settings, name, nsname string) (*MemberRo
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the serviceMeshMemberRoll is empty")
+		logging.V(100).Infof("The name of the serviceMeshMemberRoll is empty")
 
-		builder.errorMsg = "serviceMeshMemberRoll 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("serviceMeshMemberRoll 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the serviceMeshMemberRoll is empty")
+		logging.V(100).Infof("The namespace of the serviceMeshMemberRoll is empty")
 
-		builder.errorMsg = "serviceMeshMemberRoll 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("serviceMeshMemberRoll 'nsname' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -100,7 +101,7 @@ func (builder *MemberRollBuilder) Get() (*istioV1.ServiceMeshMemberRoll, error)
 		return nil, err
 	}
 
-	glog.V(100).Infof("Fetching existing serviceMeshMemberRoll with name %s under namespace %s from cluster",
+	logging.V(100).Infof("Fetching existing serviceMeshMemberRoll with name %s under namespace %s from cluster",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	servicemeshmemberroll := &istioV1.ServiceMeshMemberRoll{}
@@ -122,7 +123,7 @@ func (builder *MemberRollBuilder) Create() (*MemberRollBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the serviceMeshMemberRoll %s in namespace %s",
+	logging.V(100).Infof("Creating the serviceMeshMemberRoll %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -142,7 +143,7 @@ func (builder *MemberRollBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the serviceMeshMemberRoll %s in namespace %s",
+	logging.V(100).Infof("Deleting the serviceMeshMemberRoll %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -168,20 +169,20 @@ func (builder *MemberRollBuilder) Update(force bool) (*MemberRollBuilder, error)
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating serviceMeshMemberRoll %s in namespace %s",
+	logging.V(100).Infof("Updating serviceMeshMemberRoll %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("serviceMeshMemberRoll", builder.Definition.Name, builder.Definition.Namespace))
 
 			err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("serviceMeshMemberRoll", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -204,7 +205,7 @@ func (builder *MemberRollBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if serviceMeshMemberRoll %s exists in namespace %s",
+	logging.V(100).Infof("Checking if serviceMeshMemberRoll %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -215,15 +216,16 @@ func (builder *MemberRollBuilder) Exists() bool {
 
 // WithMembersList adds member list section to the ServiceMeshMemberRoleBuilder.
 func (builder *MemberRollBuilder) WithMembersList(membersList []string) *MemberRollBuilder {
-	glog.V(100).Infof("Adding member list %v section to the MemberRollBuilder", membersList)
+	logging.V(100).Infof("Adding member list %v section to the MemberRollBuilder", membersList)
 
 	if len(membersList) == 0 {
-		glog.V(100).Infof("Cannot add empty membersList to the memberRoll structure")
+		logging.V(100).Infof("Cannot add empty membersList to the memberRoll structure")
 
-		builder.errorMsg = "can not modify memberRoll config with empty membersList"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"can not modify memberRoll config with empty membersList"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -242,27 +244,29 @@ func (builder *MemberRollBuilder) validate() (bool, error) {
 	resourceCRD := "ServiceMeshMemberRoll"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,633 @@
+package siteconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/siteconfig/siteconfigtypes"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ClusterInstanceBuilder provides struct for the clusterInstance object containing connection to
+// the cluster and the clusterInstance definitions.
+type ClusterInstanceBuilder struct {
+	// clusterInstance Definition, used to create the clusterInstance object.
+	Definition *siteconfigtypes.ClusterInstance
+	// created clusterInstance object.
+	Object *siteconfigtypes.ClusterInstance
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// used to store latest error message upon defining or mutating clusterInstance definition.
+	errorMsg error
+}
+
+// NewClusterInstanceBuilder creates a new instance of ClusterInstanceBuilder. The installed cluster is named after
+// the clusterInstance. At least one cluster template and one node must be added before creating it.
+func NewClusterInstanceBuilder(
+	apiClient *clients.Settings,
+	name, nsname, baseDomain, clusterImageSetName, pullSecretName string) *ClusterInstanceBuilder {
+	logging.V(100).Infof(
+		"Initializing new clusterInstance structure with the following params: name: %s, namespace: %s, "+
+			"baseDomain: %s, clusterImageSetName: %s, pullSecretName: %s",
+		name, nsname, baseDomain, clusterImageSetName, pullSecretName)
+
+	builder := ClusterInstanceBuilder{
+		apiClient: apiClient,
+		Definition: &siteconfigtypes.ClusterInstance{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       ClusterInstanceKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: siteconfigtypes.ClusterInstanceSpec{
+				ClusterName:            name,
+				BaseDomain:             baseDomain,
+				ClusterImageSetNameRef: clusterImageSetName,
+				PullSecretRef:          corev1.LocalObjectReference{Name: pullSecretName},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the clusterInstance is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterInstance's 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the clusterInstance is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterInstance's 'nsname' cannot be empty"))
+	}
+
+	if baseDomain == "" {
+		logging.V(100).Infof("The baseDomain of the clusterInstance is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterInstance's 'baseDomain' cannot be empty"))
+	}
+
+	if clusterImageSetName == "" {
+		logging.V(100).Infof("The clusterImageSetName of the clusterInstance is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance's 'clusterImageSetName' cannot be empty"))
+	}
+
+	if pullSecretName == "" {
+		logging.V(100).Infof("The pullSecretName of the clusterInstance is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance's 'pullSecretName' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullClusterInstance pulls existing clusterInstance into Builder struct.
+func PullClusterInstance(apiClient *clients.Settings, name, nsname string) (*ClusterInstanceBuilder, error) {
+	logging.V(100).Infof("Pulling existing clusterInstance name %s in namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("clusterInstance's 'apiClient' cannot be empty")
+	}
+
+	builder := ClusterInstanceBuilder{
+		apiClient: apiClient,
+		Definition: &siteconfigtypes.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the clusterInstance is empty")
+
+		return nil, fmt.Errorf("clusterInstance's 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the clusterInstance is empty")
+
+		return nil, fmt.Errorf("clusterInstance's 'nsname' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("clusterInstance object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithClusterType sets the topology of the installed cluster.
+func (builder *ClusterInstanceBuilder) WithClusterType(
+	clusterType siteconfigtypes.ClusterType) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting clusterType %s in clusterInstance %s", clusterType, builder.Definition.Name)
+
+	if clusterType != siteconfigtypes.ClusterTypeSNO && clusterType != siteconfigtypes.ClusterTypeHighlyAvailable {
+		logging.V(100).Infof("The clusterType %s of the clusterInstance is not supported", clusterType)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance's 'clusterType' %s is not supported", clusterType))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterType = clusterType
+
+	return builder
+}
+
+// WithSSHPublicKey sets the ssh public key authorized on the nodes.
+func (builder *ClusterInstanceBuilder) WithSSHPublicKey(sshPublicKey string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting sshPublicKey in clusterInstance %s", builder.Definition.Name)
+
+	if sshPublicKey == "" {
+		logging.V(100).Infof("The sshPublicKey of the clusterInstance is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance's 'sshPublicKey' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SSHPublicKey = sshPublicKey
+
+	return builder
+}
+
+// WithHoldInstallation sets whether the installation waits once the manifests are applied.
+func (builder *ClusterInstanceBuilder) WithHoldInstallation(hold bool) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting holdInstallation to %t in clusterInstance %s", hold, builder.Definition.Name)
+
+	builder.Definition.Spec.HoldInstallation = hold
+
+	return builder
+}
+
+// WithClusterTemplateRef adds a ConfigMap of templates rendered into the manifests of the cluster.
+func (builder *ClusterInstanceBuilder) WithClusterTemplateRef(name, nsname string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding cluster template %s in namespace %s to clusterInstance %s",
+		name, nsname, builder.Definition.Name)
+
+	if name == "" || nsname == "" {
+		logging.V(100).Infof("The name or namespace of the clusterInstance template is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance template 'name' and 'nsname' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.TemplateRefs = append(builder.Definition.Spec.TemplateRefs,
+		siteconfigtypes.TemplateRef{Name: name, Namespace: nsname})
+
+	return builder
+}
+
+// WithExtraLabels adds labels to the rendered manifests of the given kind, such as ManagedCluster.
+func (builder *ClusterInstanceBuilder) WithExtraLabels(
+	kind string, labels map[string]string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding extra labels %v of kind %s to clusterInstance %s",
+		labels, kind, builder.Definition.Name)
+
+	if kind == "" {
+		logging.V(100).Infof("The kind of the clusterInstance extra labels is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance extra labels 'kind' cannot be empty"))
+
+		return builder
+	}
+
+	if len(labels) == 0 {
+		logging.V(100).Infof("The clusterInstance extra labels are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance extra 'labels' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.ExtraLabels == nil {
+		builder.Definition.Spec.ExtraLabels = make(map[string]map[string]string)
+	}
+
+	if builder.Definition.Spec.ExtraLabels[kind] == nil {
+		builder.Definition.Spec.ExtraLabels[kind] = make(map[string]string, len(labels))
+	}
+
+	for key, value := range labels {
+		builder.Definition.Spec.ExtraLabels[kind][key] = value
+	}
+
+	return builder
+}
+
+// WithExtraManifestsRef adds a ConfigMap, in the namespace of the clusterInstance, holding manifests applied at
+// install time.
+func (builder *ClusterInstanceBuilder) WithExtraManifestsRef(configMapName string) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding extra manifests configMap %s to clusterInstance %s",
+		configMapName, builder.Definition.Name)
+
+	if configMapName == "" {
+		logging.V(100).Infof("The extra manifests configMap of the clusterInstance is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance extra manifests 'configMapName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExtraManifestsRefs = append(builder.Definition.Spec.ExtraManifestsRefs,
+		corev1.LocalObjectReference{Name: configMapName})
+
+	return builder
+}
+
+// WithNode adds the given node to the cluster. A node must have at least one template.
+func (builder *ClusterInstanceBuilder) WithNode(node siteconfigtypes.NodeSpec) *ClusterInstanceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding node %s to clusterInstance %s", node.HostName, builder.Definition.Name)
+
+	if node.HostName == "" {
+		logging.V(100).Infof("The hostName of the clusterInstance node is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("clusterInstance node 'hostName' cannot be empty"))
+
+		return builder
+	}
+
+	if node.BmcAddress == "" || node.BmcCredentialsName.Name == "" || node.BootMACAddress == "" {
+		logging.V(100).Infof("The bmc or boot settings of clusterInstance node %s are empty", node.HostName)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"clusterInstance node %s 'bmcAddress', 'bmcCredentialsName' and 'bootMACAddress' cannot be empty",
+			node.HostName))
+
+		return builder
+	}
+
+	if len(node.TemplateRefs) == 0 {
+		logging.V(100).Infof("The clusterInstance node %s has no template", node.HostName)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("clusterInstance node %s must have at least one template", node.HostName))
+
+		return builder
+	}
+
+	for _, existingNode := range builder.Definition.Spec.Nodes {
+		if existingNode.HostName == node.HostName {
+			logging.V(100).Infof("The clusterInstance already has node %s", node.HostName)
+
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("clusterInstance already has node %s", node.HostName))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Nodes = append(builder.Definition.Spec.Nodes, node)
+
+	return builder
+}
+
+// Exists checks whether the given clusterInstance exists.
+func (builder *ClusterInstanceBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if clusterInstance %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Get returns a clusterInstance object if found.
+func (builder *ClusterInstanceBuilder) Get() (*siteconfigtypes.ClusterInstance, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting clusterInstance %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetClusterInstanceGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("clusterInstance object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertClusterInstanceToStructured(unsObject)
+}
+
+// Create makes a clusterInstance in the cluster and stores the created object in struct.
+func (builder *ClusterInstanceBuilder) Create() (*ClusterInstanceBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the clusterInstance %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if len(builder.Definition.Spec.TemplateRefs) == 0 || len(builder.Definition.Spec.Nodes) == 0 {
+		return builder, fmt.Errorf("clusterInstance %s must have at least one cluster template and one node",
+			builder.Definition.Name)
+	}
+
+	unstructuredClusterInstance, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured clusterInstance to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetClusterInstanceGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredClusterInstance}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create clusterInstance %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertClusterInstanceToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing clusterInstance object with the clusterInstance definition in builder.
+func (builder *ClusterInstanceBuilder) Update() (*ClusterInstanceBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the clusterInstance object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update clusterInstance, object doesn't exist on cluster")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredClusterInstance, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured clusterInstance to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetClusterInstanceGVR()).Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredClusterInstance}, metav1.UpdateOptions{})
+
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object, err = convertClusterInstanceToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes a clusterInstance from the hub. The siteconfig operator removes the rendered manifests with it.
+func (builder *ClusterInstanceBuilder) Delete() (*ClusterInstanceBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Deleting the clusterInstance %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("clusterInstance cannot be deleted because it does not exist")
+	}
+
+	err := builder.apiClient.Resource(GetClusterInstanceGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("can not delete clusterInstance: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
+
+// WaitForCondition waits for the duration of the defined timeout or until the given condition of the
+// clusterInstance reports the given status.
+func (builder *ClusterInstanceBuilder) WaitForCondition(
+	conditionType string, status metav1.ConditionStatus, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until condition %s of clusterInstance %s in namespace %s is %s",
+		conditionType, builder.Definition.Name, builder.Definition.Namespace, status)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				logging.V(100).Infof("Failed to get clusterInstance %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			condition := meta.FindStatusCondition(builder.Object.Status.Conditions, conditionType)
+
+			return condition != nil && condition.Status == status, nil
+		})
+}
+
+// WaitUntilManifestsApplied waits for the duration of the defined timeout or until the rendered manifests of the
+// clusterInstance are applied on the hub. It fails early when a manifest fails to render, validate or apply.
+func (builder *ClusterInstanceBuilder) WaitUntilManifestsApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until the manifests of clusterInstance %s in namespace %s are applied",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				logging.V(100).Infof("Failed to get clusterInstance %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			var failures []string
+
+			for _, manifest := range builder.Object.Status.ManifestsRendered {
+				if manifest.Status == siteconfigtypes.ManifestFailed {
+					failures = append(failures, fmt.Sprintf("%s %s: %s", manifest.Kind, manifest.Name, manifest.Message))
+				}
+			}
+
+			if len(failures) > 0 {
+				return false, fmt.Errorf("clusterInstance %s failed to apply manifests: %s",
+					builder.Definition.Name, strings.Join(failures, "; "))
+			}
+
+			return meta.IsStatusConditionTrue(builder.Object.Status.Conditions, ConditionRenderedTemplatesApplied), nil
+		})
+}
+
+// GetRenderedManifests returns the manifests the siteconfig operator rendered from the templates of the
+// clusterInstance.
+func (builder *ClusterInstanceBuilder) GetRenderedManifests() ([]siteconfigtypes.ManifestReference, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting rendered manifests of clusterInstance %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("clusterInstance object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.ManifestsRendered, nil
+}
+
+// GetRenderedManifest returns the rendered manifest of the clusterInstance with the given kind and name.
+func (builder *ClusterInstanceBuilder) GetRenderedManifest(
+	kind, name string) (*siteconfigtypes.ManifestReference, error) {
+	manifests, err := builder.GetRenderedManifests()
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range manifests {
+		if manifests[index].Kind == kind && manifests[index].Name == name {
+			return &manifests[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("clusterInstance %s has no rendered manifest %s %s", builder.Definition.Name, kind, name)
+}
+
+// GetClusterInstanceGVR returns clusterInstance's GroupVersionResource which could be used for Clean function.
+func GetClusterInstanceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "clusterinstances"}
+}
+
+// convertClusterInstanceToStructured converts the unstructured object returned by the dynamic client to a
+// clusterInstance.
+func convertClusterInstanceToStructured(
+	unsObject *unstructured.Unstructured) (*siteconfigtypes.ClusterInstance, error) {
+	clusterInstance := &siteconfigtypes.ClusterInstance{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, clusterInstance)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to clusterInstance object %s", unsObject.GetName())
+
+		return nil, err
+	}
+
+	return clusterInstance, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ClusterInstanceBuilder) validate() (bool, error) {
+	resourceCRD := "clusterInstance"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
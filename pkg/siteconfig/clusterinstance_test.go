@@ -0,0 +1,343 @@
+package siteconfig
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/siteconfig/siteconfigtypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	clusterInstanceGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    ClusterInstanceKind,
+	}
+	defaultClusterInstanceName   = "sno-1"
+	defaultClusterInstanceNsName = "sno-1"
+	defaultClusterInstanceNode   = siteconfigtypes.NodeSpec{
+		HostName:           "sno-1.example.com",
+		Role:               "master",
+		BmcAddress:         "redfish-virtualmedia://10.1.1.1/redfish/v1/Systems/1",
+		BmcCredentialsName: siteconfigtypes.BmcCredentialsName{Name: "sno-1-bmc-secret"},
+		BootMACAddress:     "00:00:00:01:20:30",
+		TemplateRefs:       []siteconfigtypes.TemplateRef{{Name: "ai-node-templates-v1", Namespace: "siteconfig"}},
+	}
+)
+
+func TestNewClusterInstanceBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		baseDomain    string
+		expectedError string
+	}{
+		{
+			name:          defaultClusterInstanceName,
+			nsname:        defaultClusterInstanceNsName,
+			baseDomain:    "example.com",
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultClusterInstanceNsName,
+			baseDomain:    "example.com",
+			expectedError: "clusterInstance's 'name' cannot be empty",
+		},
+		{
+			name:          defaultClusterInstanceName,
+			nsname:        "",
+			baseDomain:    "example.com",
+			expectedError: "clusterInstance's 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultClusterInstanceName,
+			nsname:        defaultClusterInstanceNsName,
+			baseDomain:    "",
+			expectedError: "clusterInstance's 'baseDomain' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewClusterInstanceBuilder(testSettings, testCase.name, testCase.nsname,
+			testCase.baseDomain, "openshift-4.16", "pull-secret")
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Spec.ClusterName)
+			assert.Equal(t, "pull-secret", testBuilder.Definition.Spec.PullSecretRef.Name)
+		}
+	}
+}
+
+func TestPullClusterInstance(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultClusterInstanceName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultClusterInstanceName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("clusterInstance object %s doesn't exist in namespace %s",
+				defaultClusterInstanceName, defaultClusterInstanceNsName),
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("clusterInstance's 'name' cannot be empty"),
+		},
+		{
+			name:                defaultClusterInstanceName,
+			addToRuntimeObjects: false,
+			client:              false,
+			expectedError:       fmt.Errorf("clusterInstance's 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterInstance(nil, nil))
+		}
+
+		if testCase.client {
+			testSettings = buildClusterInstanceTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullClusterInstance(testSettings, testCase.name, defaultClusterInstanceNsName)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestClusterInstanceWithClusterConfig(t *testing.T) {
+	testBuilder := buildValidClusterInstanceBuilder(buildClusterInstanceTestClientWithDummyObject(nil)).
+		WithClusterType(siteconfigtypes.ClusterTypeSNO).
+		WithSSHPublicKey("ssh-rsa AAAA").
+		WithHoldInstallation(true).
+		WithExtraLabels("ManagedCluster", map[string]string{"du-profile": "latest"}).
+		WithExtraManifestsRef("extra-manifests")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, siteconfigtypes.ClusterTypeSNO, testBuilder.Definition.Spec.ClusterType)
+	assert.Equal(t, "ssh-rsa AAAA", testBuilder.Definition.Spec.SSHPublicKey)
+	assert.True(t, testBuilder.Definition.Spec.HoldInstallation)
+	assert.Equal(t, map[string]map[string]string{"ManagedCluster": {"du-profile": "latest"}},
+		testBuilder.Definition.Spec.ExtraLabels)
+	assert.Equal(t, "extra-manifests", testBuilder.Definition.Spec.ExtraManifestsRefs[0].Name)
+
+	testBuilder = buildValidClusterInstanceBuilder(buildClusterInstanceTestClientWithDummyObject(nil)).
+		WithClusterType("Compact")
+	assert.EqualError(t, testBuilder.errorMsg, "clusterInstance's 'clusterType' Compact is not supported")
+
+	testBuilder = buildValidClusterInstanceBuilder(buildClusterInstanceTestClientWithDummyObject(nil)).
+		WithExtraManifestsRef("")
+	assert.EqualError(t, testBuilder.errorMsg, "clusterInstance extra manifests 'configMapName' cannot be empty")
+}
+
+func TestClusterInstanceWithNode(t *testing.T) {
+	noTemplateNode := defaultClusterInstanceNode
+	noTemplateNode.TemplateRefs = nil
+
+	noBmcNode := defaultClusterInstanceNode
+	noBmcNode.BmcAddress = ""
+
+	testCases := []struct {
+		nodes         []siteconfigtypes.NodeSpec
+		expectedError string
+	}{
+		{
+			nodes:         []siteconfigtypes.NodeSpec{defaultClusterInstanceNode},
+			expectedError: "",
+		},
+		{
+			nodes:         []siteconfigtypes.NodeSpec{noTemplateNode},
+			expectedError: "clusterInstance node sno-1.example.com must have at least one template",
+		},
+		{
+			nodes: []siteconfigtypes.NodeSpec{noBmcNode},
+			expectedError: "clusterInstance node sno-1.example.com 'bmcAddress', 'bmcCredentialsName' and " +
+				"'bootMACAddress' cannot be empty",
+		},
+		{
+			nodes:         []siteconfigtypes.NodeSpec{defaultClusterInstanceNode, defaultClusterInstanceNode},
+			expectedError: "clusterInstance already has node sno-1.example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterInstanceBuilder(buildClusterInstanceTestClientWithDummyObject(nil))
+
+		for _, node := range testCase.nodes {
+			testBuilder = testBuilder.WithNode(node)
+		}
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.nodes, testBuilder.Definition.Spec.Nodes)
+		}
+	}
+}
+
+func TestClusterInstanceCreateUpdateDelete(t *testing.T) {
+	testSettings := buildClusterInstanceTestClientWithDummyObject(nil)
+
+	_, err := buildValidClusterInstanceBuilder(testSettings).Create()
+	assert.Equal(t, fmt.Errorf("clusterInstance %s must have at least one cluster template and one node",
+		defaultClusterInstanceName), err)
+
+	testBuilder, err := buildValidClusterInstanceBuilder(testSettings).
+		WithClusterTemplateRef("ai-cluster-templates-v1", "siteconfig").
+		WithNode(defaultClusterInstanceNode).
+		Create()
+	assert.Nil(t, err)
+	assert.Equal(t, defaultClusterInstanceName, testBuilder.Object.Name)
+
+	testBuilder, err = testBuilder.WithHoldInstallation(true).Update()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Object.Spec.HoldInstallation)
+
+	testBuilder, err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+}
+
+func TestClusterInstanceWaitForCondition(t *testing.T) {
+	testCases := []struct {
+		conditions    []metav1.Condition
+		expectedError bool
+	}{
+		{
+			conditions: []metav1.Condition{{
+				Type: ConditionProvisioned, Status: metav1.ConditionTrue, Reason: "Completed",
+			}},
+			expectedError: false,
+		},
+		{
+			conditions: []metav1.Condition{{
+				Type: ConditionProvisioned, Status: metav1.ConditionFalse, Reason: "InProgress",
+			}},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildClusterInstanceTestClientWithDummyObject(
+			[]runtime.Object{buildDummyClusterInstance(testCase.conditions, nil)})
+
+		err := buildValidClusterInstanceBuilder(testSettings).
+			WaitForCondition(ConditionProvisioned, metav1.ConditionTrue, time.Second)
+		assert.Equal(t, testCase.expectedError, err != nil)
+	}
+}
+
+func TestClusterInstanceWaitUntilManifestsApplied(t *testing.T) {
+	testCases := []struct {
+		conditions    []metav1.Condition
+		manifests     []siteconfigtypes.ManifestReference
+		expectedError string
+	}{
+		{
+			conditions: []metav1.Condition{{
+				Type: ConditionRenderedTemplatesApplied, Status: metav1.ConditionTrue, Reason: "Completed",
+			}},
+			manifests: []siteconfigtypes.ManifestReference{{
+				Kind: "ClusterDeployment", Name: defaultClusterInstanceName, Status: siteconfigtypes.ManifestRendered,
+			}},
+			expectedError: "",
+		},
+		{
+			manifests: []siteconfigtypes.ManifestReference{{
+				Kind:    "BareMetalHost",
+				Name:    "sno-1.example.com",
+				Status:  siteconfigtypes.ManifestFailed,
+				Message: "bmc secret not found",
+			}},
+			expectedError: "clusterInstance sno-1 failed to apply manifests: " +
+				"BareMetalHost sno-1.example.com: bmc secret not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildClusterInstanceTestClientWithDummyObject(
+			[]runtime.Object{buildDummyClusterInstance(testCase.conditions, testCase.manifests)})
+
+		err := buildValidClusterInstanceBuilder(testSettings).WaitUntilManifestsApplied(time.Second)
+
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestClusterInstanceGetRenderedManifest(t *testing.T) {
+	testSettings := buildClusterInstanceTestClientWithDummyObject([]runtime.Object{
+		buildDummyClusterInstance(nil, []siteconfigtypes.ManifestReference{
+			{Kind: "ClusterDeployment", Name: defaultClusterInstanceName, Status: siteconfigtypes.ManifestRendered},
+			{Kind: "ManagedCluster", Name: defaultClusterInstanceName, Status: siteconfigtypes.ManifestValidated},
+		}),
+	})
+
+	testBuilder := buildValidClusterInstanceBuilder(testSettings)
+
+	manifests, err := testBuilder.GetRenderedManifests()
+	assert.Nil(t, err)
+	assert.Len(t, manifests, 2)
+
+	manifest, err := testBuilder.GetRenderedManifest("ManagedCluster", defaultClusterInstanceName)
+	assert.Nil(t, err)
+	assert.Equal(t, siteconfigtypes.ManifestValidated, manifest.Status)
+
+	_, err = testBuilder.GetRenderedManifest("BareMetalHost", defaultClusterInstanceName)
+	assert.EqualError(t, err, "clusterInstance sno-1 has no rendered manifest BareMetalHost sno-1")
+}
+
+func buildValidClusterInstanceBuilder(apiClient *clients.Settings) *ClusterInstanceBuilder {
+	return NewClusterInstanceBuilder(apiClient, defaultClusterInstanceName, defaultClusterInstanceNsName,
+		"example.com", "openshift-4.16", "pull-secret")
+}
+
+func buildDummyClusterInstance(
+	conditions []metav1.Condition, manifests []siteconfigtypes.ManifestReference) *siteconfigtypes.ClusterInstance {
+	return &siteconfigtypes.ClusterInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultClusterInstanceName,
+			Namespace: defaultClusterInstanceNsName,
+		},
+		Spec: siteconfigtypes.ClusterInstanceSpec{
+			ClusterName: defaultClusterInstanceName,
+		},
+		Status: siteconfigtypes.ClusterInstanceStatus{
+			Conditions:        conditions,
+			ManifestsRendered: manifests,
+		},
+	}
+}
+
+func buildClusterInstanceTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{clusterInstanceGVK},
+	})
+}
@@ -0,0 +1,20 @@
+package siteconfig
+
+const (
+	// APIGroup represents the siteconfig operator api group.
+	APIGroup = "siteconfig.open-cluster-management.io"
+	// APIVersion represents the version of the siteconfig operator api.
+	APIVersion = "v1alpha1"
+	// ClusterInstanceKind represents kind of ClusterInstance object.
+	ClusterInstanceKind = "ClusterInstance"
+	// ConditionClusterInstanceValidated is set once the ClusterInstance spec is validated.
+	ConditionClusterInstanceValidated = "ClusterInstanceValidated"
+	// ConditionRenderedTemplates is set once the templates of the ClusterInstance are rendered.
+	ConditionRenderedTemplates = "RenderedTemplates"
+	// ConditionRenderedTemplatesValidated is set once the rendered manifests are validated by a dry run.
+	ConditionRenderedTemplatesValidated = "RenderedTemplatesValidated"
+	// ConditionRenderedTemplatesApplied is set once the rendered manifests are applied on the hub.
+	ConditionRenderedTemplatesApplied = "RenderedTemplatesApplied"
+	// ConditionProvisioned is set once the cluster described by the ClusterInstance is installed.
+	ConditionProvisioned = "Provisioned"
+)
@@ -0,0 +1,228 @@
+package siteconfigtypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ClusterType is the topology of the cluster described by the ClusterInstance.
+type ClusterType string
+
+const (
+	// ClusterTypeSNO is a single node cluster.
+	ClusterTypeSNO ClusterType = "SNO"
+	// ClusterTypeHighlyAvailable is a multi node cluster.
+	ClusterTypeHighlyAvailable ClusterType = "HighlyAvailable"
+)
+
+// ManifestStatus is the state of a manifest rendered from the templates of the ClusterInstance.
+type ManifestStatus string
+
+const (
+	// ManifestRendered is set once the manifest is rendered and applied.
+	ManifestRendered ManifestStatus = "rendered"
+	// ManifestValidated is set once the manifest passed the dry run validation.
+	ManifestValidated ManifestStatus = "validated"
+	// ManifestFailed is set when the manifest could not be rendered, validated or applied.
+	ManifestFailed ManifestStatus = "failed"
+	// ManifestSuppressed is set when the manifest is suppressed by the ClusterInstance.
+	ManifestSuppressed ManifestStatus = "suppressed"
+)
+
+// TemplateRef references a ConfigMap holding the templates rendered into the installation manifests.
+type TemplateRef struct {
+	// name of the ConfigMap.
+	Name string `json:"name"`
+	// namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+}
+
+// BmcCredentialsName references the secret holding the credentials of the baseboard management controller.
+type BmcCredentialsName struct {
+	// name of the secret.
+	Name string `json:"name"`
+}
+
+// MachineNetworkEntry is a block of node ip addresses.
+type MachineNetworkEntry struct {
+	// cidr of the block.
+	CIDR string `json:"cidr"`
+}
+
+// NodeSpec defines a host of the cluster described by the ClusterInstance.
+type NodeSpec struct {
+	// hostName of the node.
+	HostName string `json:"hostName"`
+	// role of the node, master or worker.
+	Role string `json:"role,omitempty"`
+	// bmcAddress is the address of the baseboard management controller of the node.
+	BmcAddress string `json:"bmcAddress"`
+	// bmcCredentialsName references the secret holding the credentials of the baseboard management controller.
+	BmcCredentialsName BmcCredentialsName `json:"bmcCredentialsName"`
+	// bootMACAddress is the mac address of the nic the node boots from.
+	BootMACAddress string `json:"bootMACAddress"`
+	// bootMode of the node, UEFI, UEFISecureBoot or legacy.
+	BootMode string `json:"bootMode,omitempty"`
+	// installerArgs are the arguments passed to coreos-installer, as a JSON list.
+	InstallerArgs string `json:"installerArgs,omitempty"`
+	// ignitionConfigOverride overrides the ignition config of the node, as JSON.
+	IgnitionConfigOverride string `json:"ignitionConfigOverride,omitempty"`
+	// templateRefs are the templates rendered into the manifests of the node.
+	TemplateRefs []TemplateRef `json:"templateRefs"`
+}
+
+// ClusterInstanceSpec defines the desired state of ClusterInstance.
+type ClusterInstanceSpec struct {
+	// clusterName of the installed cluster.
+	ClusterName string `json:"clusterName"`
+	// baseDomain of the cluster.
+	BaseDomain string `json:"baseDomain"`
+	// pullSecretRef references the pull secret of the cluster.
+	PullSecretRef corev1.LocalObjectReference `json:"pullSecretRef"`
+	// clusterImageSetNameRef is the ClusterImageSet the cluster is installed with.
+	ClusterImageSetNameRef string `json:"clusterImageSetNameRef"`
+	// sshPublicKey authorized on the nodes.
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
+	// clusterType is the topology of the cluster.
+	ClusterType ClusterType `json:"clusterType,omitempty"`
+	// networkType of the cluster, OVNKubernetes by default.
+	NetworkType string `json:"networkType,omitempty"`
+	// machineNetwork are the node networks of the cluster.
+	MachineNetwork []MachineNetworkEntry `json:"machineNetwork,omitempty"`
+	// apiVIPs are the virtual ips of the api of a multi node cluster.
+	APIVIPs []string `json:"apiVIPs,omitempty"`
+	// ingressVIPs are the virtual ips of the ingress of a multi node cluster.
+	IngressVIPs []string `json:"ingressVIPs,omitempty"`
+	// holdInstallation keeps the installation from starting once the manifests are applied.
+	HoldInstallation bool `json:"holdInstallation,omitempty"`
+	// extraLabels are added to the rendered manifests, keyed by manifest kind.
+	ExtraLabels map[string]map[string]string `json:"extraLabels,omitempty"`
+	// extraManifestsRefs reference the ConfigMaps holding the manifests applied at install time.
+	ExtraManifestsRefs []corev1.LocalObjectReference `json:"extraManifestsRefs,omitempty"`
+	// templateRefs are the templates rendered into the manifests of the cluster.
+	TemplateRefs []TemplateRef `json:"templateRefs"`
+	// nodes of the cluster.
+	Nodes []NodeSpec `json:"nodes"`
+}
+
+// ManifestReference describes a manifest rendered from the templates of the ClusterInstance.
+type ManifestReference struct {
+	// apiGroup of the manifest.
+	APIGroup *string `json:"apiGroup"`
+	// kind of the manifest.
+	Kind string `json:"kind"`
+	// name of the manifest.
+	Name string `json:"name"`
+	// namespace of the manifest.
+	Namespace string `json:"namespace,omitempty"`
+	// syncWave ordering the application of the manifest.
+	SyncWave int `json:"syncWave,omitempty"`
+	// status of the manifest.
+	Status ManifestStatus `json:"status"`
+	// lastAppliedTime is when the manifest was last applied.
+	LastAppliedTime metav1.Time `json:"lastAppliedTime,omitempty"`
+	// message explaining the status of the manifest.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterInstanceStatus defines the observed state of ClusterInstance.
+type ClusterInstanceStatus struct {
+	// conditions describe the state of the ClusterInstance.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// clusterDeploymentRef references the ClusterDeployment rendered from the ClusterInstance.
+	ClusterDeploymentRef *corev1.LocalObjectReference `json:"clusterDeploymentRef,omitempty"`
+	// manifestsRendered are the manifests rendered from the templates of the ClusterInstance.
+	ManifestsRendered []ManifestReference `json:"manifestsRendered,omitempty"`
+	// observedGeneration is the generation of the ClusterInstance last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// ClusterInstance is the Schema for the clusterinstances API. It renders the installation manifests of a cluster from
+// templates and applies them on the hub.
+type ClusterInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterInstanceSpec   `json:"spec,omitempty"`
+	Status ClusterInstanceStatus `json:"status,omitempty"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInstance.
+func (in *ClusterInstance) DeepCopy() *ClusterInstance {
+	if in == nil {
+		return nil
+	}
+
+	out := new(ClusterInstance)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	out.Spec = in.Spec
+	out.Spec.MachineNetwork = append([]MachineNetworkEntry(nil), in.Spec.MachineNetwork...)
+	out.Spec.APIVIPs = append([]string(nil), in.Spec.APIVIPs...)
+	out.Spec.IngressVIPs = append([]string(nil), in.Spec.IngressVIPs...)
+	out.Spec.ExtraManifestsRefs = append([]corev1.LocalObjectReference(nil), in.Spec.ExtraManifestsRefs...)
+	out.Spec.TemplateRefs = append([]TemplateRef(nil), in.Spec.TemplateRefs...)
+
+	if in.Spec.ExtraLabels != nil {
+		out.Spec.ExtraLabels = make(map[string]map[string]string, len(in.Spec.ExtraLabels))
+
+		for kind, labels := range in.Spec.ExtraLabels {
+			out.Spec.ExtraLabels[kind] = make(map[string]string, len(labels))
+
+			for key, value := range labels {
+				out.Spec.ExtraLabels[kind][key] = value
+			}
+		}
+	}
+
+	if in.Spec.Nodes != nil {
+		out.Spec.Nodes = make([]NodeSpec, len(in.Spec.Nodes))
+
+		for index, node := range in.Spec.Nodes {
+			out.Spec.Nodes[index] = node
+			out.Spec.Nodes[index].TemplateRefs = append([]TemplateRef(nil), node.TemplateRefs...)
+		}
+	}
+
+	out.Status = in.Status
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	if in.Status.ClusterDeploymentRef != nil {
+		out.Status.ClusterDeploymentRef = new(corev1.LocalObjectReference)
+		*out.Status.ClusterDeploymentRef = *in.Status.ClusterDeploymentRef
+	}
+
+	if in.Status.ManifestsRendered != nil {
+		out.Status.ManifestsRendered = make([]ManifestReference, len(in.Status.ManifestsRendered))
+
+		for index, manifest := range in.Status.ManifestsRendered {
+			out.Status.ManifestsRendered[index] = manifest
+			manifest.LastAppliedTime.DeepCopyInto(&out.Status.ManifestsRendered[index].LastAppliedTime)
+
+			if manifest.APIGroup != nil {
+				apiGroup := *manifest.APIGroup
+				out.Status.ManifestsRendered[index].APIGroup = &apiGroup
+			}
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterInstance) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
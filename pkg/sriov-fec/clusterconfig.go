@@ -0,0 +1,663 @@
+package sriovfec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/sriov-fec/sriovfectypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	sriovfecclusterconfig = "SriovFecClusterConfig"
+	// maxQueueGroups is the number of queue groups an accelerator provides, shared by all the operations.
+	maxQueueGroups = 16
+)
+
+// QueueGroup is an operation the queue groups of an accelerator are allocated to.
+type QueueGroup string
+
+const (
+	// Uplink4G is the LTE uplink operation.
+	Uplink4G QueueGroup = "uplink4G"
+	// Downlink4G is the LTE downlink operation.
+	Downlink4G QueueGroup = "downlink4G"
+	// Uplink5G is the 5GNR uplink operation.
+	Uplink5G QueueGroup = "uplink5G"
+	// Downlink5G is the 5GNR downlink operation.
+	Downlink5G QueueGroup = "downlink5G"
+	// QFFT is the FFT operation, only available on ACC200.
+	QFFT QueueGroup = "qfft"
+)
+
+// ClusterConfigBuilder provides struct for the SriovFecClusterConfig object containing connection to
+// the cluster and the SriovFecClusterConfig definitions.
+type ClusterConfigBuilder struct {
+	// SriovFecClusterConfig definition. Used to create SriovFecClusterConfig object.
+	Definition *sriovfectypes.SriovFecClusterConfig
+	// Created SriovFecClusterConfig object.
+	Object *sriovfectypes.SriovFecClusterConfig
+	// apiClient opens a connection to the cluster.
+	apiClient *clients.Settings
+	// Used in functions that define SriovFecClusterConfig definitions. errorMsg is processed before
+	// SriovFecClusterConfig object is created.
+	errorMsg error
+}
+
+// NewClusterConfigBuilder creates a new instance of ClusterConfigBuilder configuring vfAmount VFs bound to vfDriver
+// on the accelerators bound to pfDriver. The accelerator specific queue configuration is added with WithACC100 or
+// WithACC200 and WithQueueGroup.
+func NewClusterConfigBuilder(
+	apiClient *clients.Settings,
+	name, nsname, pfDriver, vfDriver string,
+	vfAmount int) *ClusterConfigBuilder {
+	logging.V(100).Infof(
+		"Initializing new SriovFecClusterConfig structure with the following params: %s, %s, %s, %s, %d",
+		name, nsname, pfDriver, vfDriver, vfAmount)
+
+	builder := ClusterConfigBuilder{
+		apiClient: apiClient,
+		Definition: &sriovfectypes.SriovFecClusterConfig{
+			TypeMeta: metaV1.TypeMeta{
+				Kind:       sriovfecclusterconfig,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: sriovfectypes.SriovFecClusterConfigSpec{
+				PhysicalFunction: sriovfectypes.PhysicalFunctionConfig{
+					PFDriver: pfDriver,
+					VFDriver: vfDriver,
+					VFAmount: vfAmount,
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovFecClusterConfig 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovFecClusterConfig 'nsname' cannot be empty"))
+	}
+
+	if pfDriver == "" {
+		logging.V(100).Infof("The pfDriver of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovFecClusterConfig 'pfDriver' cannot be empty"))
+	}
+
+	if vfDriver == "" {
+		logging.V(100).Infof("The vfDriver of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovFecClusterConfig 'vfDriver' cannot be empty"))
+	}
+
+	if vfAmount <= 0 {
+		logging.V(100).Infof("The vfAmount of the SriovFecClusterConfig is not positive")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovFecClusterConfig 'vfAmount' must be positive"))
+	}
+
+	return &builder
+}
+
+// PullClusterConfig retrieves an existing SriovFecClusterConfig object from the cluster.
+func PullClusterConfig(apiClient *clients.Settings, name, nsname string) (*ClusterConfigBuilder, error) {
+	logging.V(100).Infof(
+		"Pulling SriovFecClusterConfig object name: %s in namespace: %s", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("SriovFecClusterConfig 'apiClient' cannot be empty")
+	}
+
+	builder := ClusterConfigBuilder{
+		apiClient: apiClient,
+		Definition: &sriovfectypes.SriovFecClusterConfig{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("the name of the SriovFecClusterConfig is empty")
+	}
+
+	if nsname == "" {
+		return nil, fmt.Errorf("the namespace of the SriovFecClusterConfig is empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("SriovFecClusterConfig object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithNodeSelector sets the nodes the SriovFecClusterConfig applies to.
+func (builder *ClusterConfigBuilder) WithNodeSelector(nodeSelector map[string]string) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting SriovFecClusterConfig %s node selector to %v", builder.Definition.Name, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("SriovFecClusterConfig 'nodeSelector' cannot be empty map"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithAcceleratorSelector sets the accelerators the SriovFecClusterConfig applies to.
+func (builder *ClusterConfigBuilder) WithAcceleratorSelector(
+	acceleratorSelector sriovfectypes.AcceleratorSelector) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting SriovFecClusterConfig %s accelerator selector to %v",
+		builder.Definition.Name, acceleratorSelector)
+
+	if acceleratorSelector == (sriovfectypes.AcceleratorSelector{}) {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("SriovFecClusterConfig 'acceleratorSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.AcceleratorSelector = acceleratorSelector
+
+	return builder
+}
+
+// WithPriority sets the priority of the SriovFecClusterConfig. Higher priority configurations override lower ones.
+func (builder *ClusterConfigBuilder) WithPriority(priority int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting SriovFecClusterConfig %s priority to %d", builder.Definition.Name, priority)
+
+	if priority < 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("SriovFecClusterConfig 'priority' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Priority = priority
+
+	return builder
+}
+
+// WithDrainSkip sets whether the nodes are drained before configuring their accelerators. Drain should be skipped on
+// single node clusters.
+func (builder *ClusterConfigBuilder) WithDrainSkip(drainSkip bool) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting SriovFecClusterConfig %s drainSkip to %t", builder.Definition.Name, drainSkip)
+
+	builder.Definition.Spec.DrainSkip = drainSkip
+
+	return builder
+}
+
+// WithACC100 configures the ACC100 accelerators with numVfBundles VF bundles, which must match the amount of VFs.
+// Queue groups default to none and are allocated with WithQueueGroup.
+func (builder *ClusterConfigBuilder) WithACC100(numVfBundles int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting SriovFecClusterConfig %s ACC100 config with %d VF bundles",
+		builder.Definition.Name, numVfBundles)
+
+	if err := validateNumVfBundles(numVfBundles); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.BBDevConfig = sriovfectypes.BBDevConfig{
+		ACC100: &sriovfectypes.ACC100BBDevConfig{
+			NumVfBundles: numVfBundles,
+			MaxQueueSize: 1024,
+		},
+	}
+
+	return builder
+}
+
+// WithACC200 configures the ACC200 accelerators with numVfBundles VF bundles, which must match the amount of VFs.
+// Queue groups default to none and are allocated with WithQueueGroup.
+func (builder *ClusterConfigBuilder) WithACC200(numVfBundles int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting SriovFecClusterConfig %s ACC200 config with %d VF bundles",
+		builder.Definition.Name, numVfBundles)
+
+	if err := validateNumVfBundles(numVfBundles); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.BBDevConfig = sriovfectypes.BBDevConfig{
+		ACC200: &sriovfectypes.ACC200BBDevConfig{
+			ACC100BBDevConfig: sriovfectypes.ACC100BBDevConfig{
+				NumVfBundles: numVfBundles,
+				MaxQueueSize: 1024,
+			},
+		},
+	}
+
+	return builder
+}
+
+// WithQueueGroup allocates numQueueGroups queue groups of numAqsPerGroups atomic queues of 2^aqDepthLog2 entries to
+// the given operation of the ACC100 or ACC200 configuration. At most 16 queue groups can be allocated in total.
+func (builder *ClusterConfigBuilder) WithQueueGroup(
+	queueGroup QueueGroup, numQueueGroups, numAqsPerGroups, aqDepthLog2 int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting SriovFecClusterConfig %s %s queue groups: %d groups of %d queues of depth 2^%d",
+		builder.Definition.Name, queueGroup, numQueueGroups, numAqsPerGroups, aqDepthLog2)
+
+	if numQueueGroups < 0 || numQueueGroups > maxQueueGroups || numAqsPerGroups < 1 || numAqsPerGroups > 16 ||
+		aqDepthLog2 < 1 || aqDepthLog2 > 12 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SriovFecClusterConfig queue group %s is out of range: numQueueGroups must be in [0, %d], "+
+				"numAqsPerGroups in [1, 16] and aqDepthLog2 in [1, 12]", queueGroup, maxQueueGroups))
+
+		return builder
+	}
+
+	queueGroupConfig := builder.getQueueGroupConfig(queueGroup)
+	if queueGroupConfig == nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SriovFecClusterConfig queue group %s requires a matching ACC100 or ACC200 config", queueGroup))
+
+		return builder
+	}
+
+	previousNumQueueGroups := queueGroupConfig.NumQueueGroups
+	queueGroupConfig.NumQueueGroups = numQueueGroups
+
+	if totalQueueGroups := builder.getTotalQueueGroups(); totalQueueGroups > maxQueueGroups {
+		queueGroupConfig.NumQueueGroups = previousNumQueueGroups
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SriovFecClusterConfig allocates %d queue groups, at most %d are available", totalQueueGroups, maxQueueGroups))
+
+		return builder
+	}
+
+	queueGroupConfig.NumAqsPerGroups = numAqsPerGroups
+	queueGroupConfig.AqDepthLog2 = aqDepthLog2
+
+	return builder
+}
+
+// Exists checks whether the given SriovFecClusterConfig exists.
+func (builder *ClusterConfigBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof(
+		"Checking if SriovFecClusterConfig %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		logging.V(100).Infof("Failed to collect SriovFecClusterConfig object due to %s", err.Error())
+	}
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Get returns SriovFecClusterConfig object if found.
+func (builder *ClusterConfigBuilder) Get() (*sriovfectypes.SriovFecClusterConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Collecting SriovFecClusterConfig object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetSriovFecClusterConfigGVR()).
+		Namespace(builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metaV1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof(
+			"SriovFecClusterConfig object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return builder.convertToStructured(unsObject)
+}
+
+// Create makes a SriovFecClusterConfig in the cluster and stores the created object in struct.
+func (builder *ClusterConfigBuilder) Create() (*ClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the SriovFecClusterConfig %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if err := builder.validateVfBundles(); err != nil {
+		return builder, err
+	}
+
+	unstructuredClusterConfig, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured SriovFecClusterConfig to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetSriovFecClusterConfigGVR()).
+		Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredClusterConfig}, metaV1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create SriovFecClusterConfig")
+
+		return builder, err
+	}
+
+	builder.Object, err = builder.convertToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes SriovFecClusterConfig object from a cluster.
+func (builder *ClusterConfigBuilder) Delete() (*ClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Deleting the SriovFecClusterConfig object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return builder, nil
+	}
+
+	err := builder.apiClient.Resource(GetSriovFecClusterConfigGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metaV1.DeleteOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("can not delete SriovFecClusterConfig: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
+
+// Update renovates the existing SriovFecClusterConfig object with the SriovFecClusterConfig definition in builder.
+func (builder *ClusterConfigBuilder) Update(force bool) (*ClusterConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the SriovFecClusterConfig object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("failed to update SriovFecClusterConfig, object doesn't exist on cluster")
+	}
+
+	if err := builder.validateVfBundles(); err != nil {
+		return builder, err
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredClusterConfig, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured SriovFecClusterConfig to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetSriovFecClusterConfigGVR()).
+		Namespace(builder.Definition.Namespace).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredClusterConfig}, metaV1.UpdateOptions{})
+
+	if err != nil {
+		if force {
+			logging.V(100).Infof(
+				msg.FailToUpdateNotification("SriovFecClusterConfig", builder.Definition.Name, builder.Definition.Namespace))
+
+			builder, err := builder.Delete()
+
+			if err != nil {
+				logging.V(100).Infof(
+					msg.FailToUpdateError("SriovFecClusterConfig", builder.Definition.Name, builder.Definition.Namespace))
+
+				return builder, err
+			}
+
+			return builder.Create()
+		}
+
+		return builder, err
+	}
+
+	builder.Object, err = builder.convertToStructured(unsObject)
+
+	return builder, err
+}
+
+// WaitUntilSucceeded waits for the duration of the defined timeout or until the operator reports the
+// SriovFecClusterConfig as successfully synchronized. It fails early when the synchronization failed.
+func (builder *ClusterConfigBuilder) WaitUntilSucceeded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until SriovFecClusterConfig %s in namespace %s "+
+		"is synchronized", builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.Get()
+
+			if err != nil {
+				logging.V(100).Infof("Failed to get SriovFecClusterConfig %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			switch builder.Object.Status.SyncStatus {
+			case sriovfectypes.SucceededSync:
+				return true, nil
+			case sriovfectypes.FailedSync:
+				return false, fmt.Errorf("SriovFecClusterConfig %s in namespace %s failed to synchronize: %s",
+					builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.LastSyncError)
+			default:
+				return false, nil
+			}
+		})
+}
+
+// GetSriovFecClusterConfigGVR returns SriovFecClusterConfig's GroupVersionResource which could be used for Clean
+// function.
+func GetSriovFecClusterConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: APIGroup, Version: APIVersion, Resource: "sriovfecclusterconfigs",
+	}
+}
+
+// getACC100Config returns the queue configuration shared by ACC100 and ACC200, or nil if neither is configured.
+func (builder *ClusterConfigBuilder) getACC100Config() *sriovfectypes.ACC100BBDevConfig {
+	bbDevConfig := &builder.Definition.Spec.PhysicalFunction.BBDevConfig
+
+	if bbDevConfig.ACC200 != nil {
+		return &bbDevConfig.ACC200.ACC100BBDevConfig
+	}
+
+	return bbDevConfig.ACC100
+}
+
+// getQueueGroupConfig returns the configuration of the given queue group, or nil if it is not available with the
+// current accelerator configuration.
+func (builder *ClusterConfigBuilder) getQueueGroupConfig(queueGroup QueueGroup) *sriovfectypes.QueueGroupConfig {
+	accConfig := builder.getACC100Config()
+	if accConfig == nil {
+		return nil
+	}
+
+	switch queueGroup {
+	case Uplink4G:
+		return &accConfig.Uplink4G
+	case Downlink4G:
+		return &accConfig.Downlink4G
+	case Uplink5G:
+		return &accConfig.Uplink5G
+	case Downlink5G:
+		return &accConfig.Downlink5G
+	case QFFT:
+		if acc200Config := builder.Definition.Spec.PhysicalFunction.BBDevConfig.ACC200; acc200Config != nil {
+			return &acc200Config.QFFT
+		}
+	}
+
+	return nil
+}
+
+// getTotalQueueGroups returns the number of queue groups allocated to all the operations.
+func (builder *ClusterConfigBuilder) getTotalQueueGroups() int {
+	var totalQueueGroups int
+
+	for _, queueGroup := range []QueueGroup{Uplink4G, Downlink4G, Uplink5G, Downlink5G, QFFT} {
+		if queueGroupConfig := builder.getQueueGroupConfig(queueGroup); queueGroupConfig != nil {
+			totalQueueGroups += queueGroupConfig.NumQueueGroups
+		}
+	}
+
+	return totalQueueGroups
+}
+
+// validateVfBundles checks that the amount of VF bundles of the accelerator configuration matches the amount of VFs.
+func (builder *ClusterConfigBuilder) validateVfBundles() error {
+	accConfig := builder.getACC100Config()
+	if accConfig == nil {
+		return nil
+	}
+
+	if accConfig.NumVfBundles != builder.Definition.Spec.PhysicalFunction.VFAmount {
+		return fmt.Errorf("SriovFecClusterConfig %s numVfBundles %d must match vfAmount %d",
+			builder.Definition.Name, accConfig.NumVfBundles, builder.Definition.Spec.PhysicalFunction.VFAmount)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ClusterConfigBuilder) validate() (bool, error) {
+	resourceCRD := "SriovFecClusterConfig"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (builder *ClusterConfigBuilder) convertToStructured(unsObject *unstructured.Unstructured) (
+	*sriovfectypes.SriovFecClusterConfig, error) {
+	clusterConfig := &sriovfectypes.SriovFecClusterConfig{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, clusterConfig)
+	if err != nil {
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to SriovFecClusterConfig object %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return clusterConfig, err
+}
+
+// validateNumVfBundles checks that the amount of VF bundles is supported by the accelerators.
+func validateNumVfBundles(numVfBundles int) error {
+	if numVfBundles < 1 || numVfBundles > 16 {
+		return fmt.Errorf("SriovFecClusterConfig 'numVfBundles' must be in [1, 16]")
+	}
+
+	return nil
+}
@@ -0,0 +1,581 @@
+package sriovfec
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/sriov-fec/sriovfectypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	defaultClusterConfigName = "config"
+	defaultSriovFecNamespace = "vran-acceleration-operators"
+	defaultPFDriver          = "vfio-pci"
+	defaultVFDriver          = "vfio-pci"
+	defaultVFAmount          = 2
+)
+
+var clusterConfigGVK = schema.GroupVersionKind{
+	Group:   APIGroup,
+	Version: APIVersion,
+	Kind:    sriovfecclusterconfig,
+}
+
+type queueGroupParams struct {
+	queueGroup      QueueGroup
+	numQueueGroups  int
+	numAqsPerGroups int
+	aqDepthLog2     int
+}
+
+func TestNewClusterConfigBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		pfDriver      string
+		vfDriver      string
+		vfAmount      int
+		expectedError string
+	}{
+		{
+			name:          defaultClusterConfigName,
+			namespace:     defaultSriovFecNamespace,
+			pfDriver:      defaultPFDriver,
+			vfDriver:      defaultVFDriver,
+			vfAmount:      defaultVFAmount,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultSriovFecNamespace,
+			pfDriver:      defaultPFDriver,
+			vfDriver:      defaultVFDriver,
+			vfAmount:      defaultVFAmount,
+			expectedError: "SriovFecClusterConfig 'name' cannot be empty",
+		},
+		{
+			name:          defaultClusterConfigName,
+			namespace:     "",
+			pfDriver:      defaultPFDriver,
+			vfDriver:      defaultVFDriver,
+			vfAmount:      defaultVFAmount,
+			expectedError: "SriovFecClusterConfig 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultClusterConfigName,
+			namespace:     defaultSriovFecNamespace,
+			pfDriver:      "",
+			vfDriver:      defaultVFDriver,
+			vfAmount:      defaultVFAmount,
+			expectedError: "SriovFecClusterConfig 'pfDriver' cannot be empty",
+		},
+		{
+			name:          defaultClusterConfigName,
+			namespace:     defaultSriovFecNamespace,
+			pfDriver:      defaultPFDriver,
+			vfDriver:      "",
+			vfAmount:      defaultVFAmount,
+			expectedError: "SriovFecClusterConfig 'vfDriver' cannot be empty",
+		},
+		{
+			name:          defaultClusterConfigName,
+			namespace:     defaultSriovFecNamespace,
+			pfDriver:      defaultPFDriver,
+			vfDriver:      defaultVFDriver,
+			vfAmount:      0,
+			expectedError: "SriovFecClusterConfig 'vfAmount' must be positive",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewClusterConfigBuilder(buildTestClientWithClusterConfigs(), testCase.name,
+			testCase.namespace, testCase.pfDriver, testCase.vfDriver, testCase.vfAmount)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, sriovfectypes.PhysicalFunctionConfig{
+				PFDriver: testCase.pfDriver,
+				VFDriver: testCase.vfDriver,
+				VFAmount: testCase.vfAmount,
+			}, testBuilder.Definition.Spec.PhysicalFunction)
+		}
+	}
+}
+
+func TestPullClusterConfig(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       string
+	}{
+		{
+			name:                defaultClusterConfigName,
+			namespace:           defaultSriovFecNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			namespace:           defaultSriovFecNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "the name of the SriovFecClusterConfig is empty",
+		},
+		{
+			name:                defaultClusterConfigName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       "the namespace of the SriovFecClusterConfig is empty",
+		},
+		{
+			name:                defaultClusterConfigName,
+			namespace:           defaultSriovFecNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Sprintf("SriovFecClusterConfig object %s doesn't exist in namespace %s",
+				defaultClusterConfigName, defaultSriovFecNamespace),
+		},
+		{
+			name:                defaultClusterConfigName,
+			namespace:           defaultSriovFecNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       "SriovFecClusterConfig 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterConfig(sriovfectypes.SucceededSync))
+		}
+
+		if testCase.client {
+			testSettings = buildTestClientWithClusterConfigs(runtimeObjects...)
+		}
+
+		testBuilder, err := PullClusterConfig(testSettings, testCase.name, testCase.namespace)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestClusterConfigWithSelectorsAndPriority(t *testing.T) {
+	testCases := []struct {
+		nodeSelector        map[string]string
+		acceleratorSelector sriovfectypes.AcceleratorSelector
+		priority            int
+		expectedError       string
+	}{
+		{
+			nodeSelector:        map[string]string{"kubernetes.io/hostname": "worker-0"},
+			acceleratorSelector: sriovfectypes.AcceleratorSelector{VendorID: "8086", DeviceID: "57c0"},
+			priority:            1,
+			expectedError:       "",
+		},
+		{
+			nodeSelector:        nil,
+			acceleratorSelector: sriovfectypes.AcceleratorSelector{VendorID: "8086", DeviceID: "57c0"},
+			priority:            1,
+			expectedError:       "SriovFecClusterConfig 'nodeSelector' cannot be empty map",
+		},
+		{
+			nodeSelector:        map[string]string{"kubernetes.io/hostname": "worker-0"},
+			acceleratorSelector: sriovfectypes.AcceleratorSelector{},
+			priority:            1,
+			expectedError:       "SriovFecClusterConfig 'acceleratorSelector' cannot be empty",
+		},
+		{
+			nodeSelector:        map[string]string{"kubernetes.io/hostname": "worker-0"},
+			acceleratorSelector: sriovfectypes.AcceleratorSelector{VendorID: "8086", DeviceID: "57c0"},
+			priority:            -1,
+			expectedError:       "SriovFecClusterConfig 'priority' cannot be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithClusterConfigs()).
+			WithNodeSelector(testCase.nodeSelector).
+			WithAcceleratorSelector(testCase.acceleratorSelector).
+			WithPriority(testCase.priority).
+			WithDrainSkip(true)
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Spec.NodeSelector)
+			assert.Equal(t, testCase.acceleratorSelector, testBuilder.Definition.Spec.AcceleratorSelector)
+			assert.Equal(t, testCase.priority, testBuilder.Definition.Spec.Priority)
+			assert.True(t, testBuilder.Definition.Spec.DrainSkip)
+		}
+	}
+}
+
+func TestClusterConfigWithAccelerator(t *testing.T) {
+	testCases := []struct {
+		acc200        bool
+		numVfBundles  int
+		expectedError string
+	}{
+		{
+			acc200:        false,
+			numVfBundles:  defaultVFAmount,
+			expectedError: "",
+		},
+		{
+			acc200:        true,
+			numVfBundles:  defaultVFAmount,
+			expectedError: "",
+		},
+		{
+			acc200:        false,
+			numVfBundles:  0,
+			expectedError: "SriovFecClusterConfig 'numVfBundles' must be in [1, 16]",
+		},
+		{
+			acc200:        true,
+			numVfBundles:  17,
+			expectedError: "SriovFecClusterConfig 'numVfBundles' must be in [1, 16]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithClusterConfigs())
+
+		if testCase.acc200 {
+			testBuilder = testBuilder.WithACC200(testCase.numVfBundles)
+		} else {
+			testBuilder = testBuilder.WithACC100(testCase.numVfBundles)
+		}
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			bbDevConfig := testBuilder.Definition.Spec.PhysicalFunction.BBDevConfig
+			assert.Equal(t, testCase.acc200, bbDevConfig.ACC200 != nil)
+			assert.Equal(t, !testCase.acc200, bbDevConfig.ACC100 != nil)
+			assert.Equal(t, testCase.numVfBundles, testBuilder.getACC100Config().NumVfBundles)
+			assert.Equal(t, 1024, testBuilder.getACC100Config().MaxQueueSize)
+		}
+	}
+}
+
+func TestClusterConfigWithQueueGroup(t *testing.T) {
+	testCases := []struct {
+		accelerator         string
+		queueGroups         []queueGroupParams
+		expectedError       string
+		expectedTotalGroups int
+	}{
+		{
+			accelerator: "acc100",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Uplink5G, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 4},
+				{queueGroup: Downlink5G, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 4},
+			},
+			expectedError:       "",
+			expectedTotalGroups: 8,
+		},
+		{
+			accelerator: "acc200",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Uplink5G, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 4},
+				{queueGroup: Downlink5G, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 4},
+				{queueGroup: QFFT, numQueueGroups: 8, numAqsPerGroups: 16, aqDepthLog2: 4},
+			},
+			expectedError:       "",
+			expectedTotalGroups: 16,
+		},
+		{
+			accelerator: "acc100",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Uplink4G, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 4},
+				{queueGroup: Uplink4G, numQueueGroups: 2, numAqsPerGroups: 8, aqDepthLog2: 2},
+			},
+			expectedError:       "",
+			expectedTotalGroups: 2,
+		},
+		{
+			accelerator: "acc100",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Uplink5G, numQueueGroups: 17, numAqsPerGroups: 16, aqDepthLog2: 4},
+			},
+			expectedError: "SriovFecClusterConfig queue group uplink5G is out of range: numQueueGroups must be in " +
+				"[0, 16], numAqsPerGroups in [1, 16] and aqDepthLog2 in [1, 12]",
+		},
+		{
+			accelerator: "acc100",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Downlink4G, numQueueGroups: 4, numAqsPerGroups: 0, aqDepthLog2: 4},
+			},
+			expectedError: "SriovFecClusterConfig queue group downlink4G is out of range: numQueueGroups must be in " +
+				"[0, 16], numAqsPerGroups in [1, 16] and aqDepthLog2 in [1, 12]",
+		},
+		{
+			accelerator: "acc100",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Downlink5G, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 13},
+			},
+			expectedError: "SriovFecClusterConfig queue group downlink5G is out of range: numQueueGroups must be in " +
+				"[0, 16], numAqsPerGroups in [1, 16] and aqDepthLog2 in [1, 12]",
+		},
+		{
+			accelerator: "",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Uplink5G, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 4},
+			},
+			expectedError: "SriovFecClusterConfig queue group uplink5G requires a matching ACC100 or ACC200 config",
+		},
+		{
+			accelerator: "acc100",
+			queueGroups: []queueGroupParams{
+				{queueGroup: QFFT, numQueueGroups: 4, numAqsPerGroups: 16, aqDepthLog2: 4},
+			},
+			expectedError: "SriovFecClusterConfig queue group qfft requires a matching ACC100 or ACC200 config",
+		},
+		{
+			accelerator: "acc200",
+			queueGroups: []queueGroupParams{
+				{queueGroup: Uplink5G, numQueueGroups: 10, numAqsPerGroups: 16, aqDepthLog2: 4},
+				{queueGroup: Downlink5G, numQueueGroups: 10, numAqsPerGroups: 16, aqDepthLog2: 4},
+			},
+			expectedError: "SriovFecClusterConfig allocates 20 queue groups, at most 16 are available",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithClusterConfigs())
+
+		switch testCase.accelerator {
+		case "acc100":
+			testBuilder = testBuilder.WithACC100(defaultVFAmount)
+		case "acc200":
+			testBuilder = testBuilder.WithACC200(defaultVFAmount)
+		}
+
+		for _, params := range testCase.queueGroups {
+			testBuilder = testBuilder.WithQueueGroup(
+				params.queueGroup, params.numQueueGroups, params.numAqsPerGroups, params.aqDepthLog2)
+		}
+
+		_, err := testBuilder.validate()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, testCase.expectedTotalGroups, testBuilder.getTotalQueueGroups())
+
+			lastParams := testCase.queueGroups[len(testCase.queueGroups)-1]
+			assert.Equal(t, sriovfectypes.QueueGroupConfig{
+				NumQueueGroups:  lastParams.numQueueGroups,
+				NumAqsPerGroups: lastParams.numAqsPerGroups,
+				AqDepthLog2:     lastParams.aqDepthLog2,
+			}, *testBuilder.getQueueGroupConfig(lastParams.queueGroup))
+		}
+	}
+
+	testBuilder := buildValidClusterConfigBuilder(buildTestClientWithClusterConfigs()).WithACC200(defaultVFAmount).
+		WithQueueGroup(Uplink5G, 10, 16, 4).WithQueueGroup(Downlink5G, 10, 16, 4)
+	assert.Equal(t, 10, testBuilder.getTotalQueueGroups())
+}
+
+func TestClusterConfigCreate(t *testing.T) {
+	testCases := []struct {
+		numVfBundles        int
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			numVfBundles:        defaultVFAmount,
+			addToRuntimeObjects: false,
+			expectedError:       "",
+		},
+		{
+			numVfBundles:        defaultVFAmount,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			numVfBundles:        defaultVFAmount + 1,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("SriovFecClusterConfig %s numVfBundles %d must match vfAmount %d",
+				defaultClusterConfigName, defaultVFAmount+1, defaultVFAmount),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterConfig(sriovfectypes.SucceededSync))
+		}
+
+		testBuilder, err := buildValidClusterConfigBuilder(buildTestClientWithClusterConfigs(runtimeObjects...)).
+			WithACC100(testCase.numVfBundles).Create()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.NotNil(t, testBuilder.Object)
+			assert.Equal(t, defaultClusterConfigName, testBuilder.Object.Name)
+			assert.Equal(t, defaultSriovFecNamespace, testBuilder.Object.Namespace)
+		}
+	}
+}
+
+func TestClusterConfigUpdate(t *testing.T) {
+	testCases := []struct {
+		numVfBundles        int
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			numVfBundles:        defaultVFAmount,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			numVfBundles:        defaultVFAmount,
+			addToRuntimeObjects: false,
+			expectedError:       "failed to update SriovFecClusterConfig, object doesn't exist on cluster",
+		},
+		{
+			numVfBundles:        defaultVFAmount + 1,
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("SriovFecClusterConfig %s numVfBundles %d must match vfAmount %d",
+				defaultClusterConfigName, defaultVFAmount+1, defaultVFAmount),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterConfig(sriovfectypes.SucceededSync))
+		}
+
+		testSettings := buildTestClientWithClusterConfigs(runtimeObjects...)
+
+		_, err := buildValidClusterConfigBuilder(testSettings).WithACC200(testCase.numVfBundles).
+			WithQueueGroup(QFFT, 16, 16, 4).Update(false)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			pulledBuilder, err := PullClusterConfig(testSettings, defaultClusterConfigName, defaultSriovFecNamespace)
+			assert.Nil(t, err)
+			assert.NotNil(t, pulledBuilder.Object.Spec.PhysicalFunction.BBDevConfig.ACC200)
+			assert.Equal(t, 16, pulledBuilder.Object.Spec.PhysicalFunction.BBDevConfig.ACC200.QFFT.NumQueueGroups)
+		}
+	}
+}
+
+func TestClusterConfigDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterConfig(sriovfectypes.SucceededSync))
+		}
+
+		testBuilder, err := buildValidClusterConfigBuilder(buildTestClientWithClusterConfigs(runtimeObjects...)).Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+		assert.False(t, testBuilder.Exists())
+	}
+}
+
+func TestClusterConfigWaitUntilSucceeded(t *testing.T) {
+	testCases := []struct {
+		syncStatus          sriovfectypes.SyncStatus
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			syncStatus:          sriovfectypes.SucceededSync,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			syncStatus:          sriovfectypes.FailedSync,
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("SriovFecClusterConfig %s in namespace %s failed to synchronize: "+
+				"numVfBundles mismatch", defaultClusterConfigName, defaultSriovFecNamespace),
+		},
+		{
+			syncStatus:          sriovfectypes.InProgressSync,
+			addToRuntimeObjects: true,
+			expectedError:       "context deadline exceeded",
+		},
+		{
+			syncStatus:          sriovfectypes.SucceededSync,
+			addToRuntimeObjects: false,
+			expectedError:       "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterConfig(testCase.syncStatus))
+		}
+
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithClusterConfigs(runtimeObjects...))
+
+		err := testBuilder.WaitUntilSucceeded(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidClusterConfigBuilder(apiClient *clients.Settings) *ClusterConfigBuilder {
+	return NewClusterConfigBuilder(
+		apiClient, defaultClusterConfigName, defaultSriovFecNamespace, defaultPFDriver, defaultVFDriver, defaultVFAmount)
+}
+
+func buildDummyClusterConfig(syncStatus sriovfectypes.SyncStatus) *sriovfectypes.SriovFecClusterConfig {
+	clusterConfig := &sriovfectypes.SriovFecClusterConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultClusterConfigName,
+			Namespace: defaultSriovFecNamespace,
+		},
+		Spec: sriovfectypes.SriovFecClusterConfigSpec{
+			PhysicalFunction: sriovfectypes.PhysicalFunctionConfig{
+				PFDriver: defaultPFDriver,
+				VFDriver: defaultVFDriver,
+				VFAmount: defaultVFAmount,
+			},
+		},
+		Status: sriovfectypes.SriovFecClusterConfigStatus{
+			SyncStatus: syncStatus,
+		},
+	}
+
+	if syncStatus == sriovfectypes.FailedSync {
+		clusterConfig.Status.LastSyncError = "numVfBundles mismatch"
+	}
+
+	return clusterConfig
+}
+
+func buildTestClientWithClusterConfigs(clusterConfigs ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: clusterConfigs,
+		GVK:            []schema.GroupVersionKind{clusterConfigGVK},
+	})
+}
@@ -5,4 +5,10 @@ const (
 	APIGroup = "sriovfec.intel.com"
 	// APIVersion represents version of sriovfecnodeconfig api.
 	APIVersion = "v2"
+	// ConditionConfigured is the SriovFecNodeConfig condition reporting the configuration of the node accelerators.
+	ConditionConfigured = "Configured"
+	// ConfiguredReasonSucceeded is the reason of the Configured condition once the accelerators are configured.
+	ConfiguredReasonSucceeded = "Succeeded"
+	// ConfiguredReasonFailed is the reason of the Configured condition when the configuration failed.
+	ConfiguredReasonFailed = "Failed"
 )
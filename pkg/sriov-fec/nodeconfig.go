@@ -1,15 +1,20 @@
 package sriovfec
 
 import (
+	"errors"
+	"time"
+
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	"github.com/openshift-kni/eco-goinfra/pkg/sriov-fec/sriovfectypes"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,7 +36,7 @@ type NodeConfigBuilder struct {
 	apiClient *clients.Settings
 	// Used in functions that define SriovFecNodeConfig definitions. errorMsg is processed before SriovFecNodeConfig
 	// object is created.
-	errorMsg string
+	errorMsg error
 }
 
 // AdditionalOptions additional options for sriovfecnodeconfig object.
@@ -42,7 +47,7 @@ func NewNodeConfigBuilder(
 	apiClient *clients.Settings,
 	name, nsname string,
 	label map[string]string) *NodeConfigBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new SriovFecNodeConfig structure with the following params: %s, %s, %v",
 		name, nsname, label)
 
@@ -61,15 +66,15 @@ func NewNodeConfigBuilder(
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the SriovFecNodeConfig is empty")
+		logging.V(100).Infof("The name of the SriovFecNodeConfig is empty")
 
-		builder.errorMsg = "SriovFecNodeConfig 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovFecNodeConfig 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the SriovFecNodeConfig is empty")
+		logging.V(100).Infof("The namespace of the SriovFecNodeConfig is empty")
 
-		builder.errorMsg = "SriovFecNodeConfig 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovFecNodeConfig 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -77,7 +82,7 @@ func NewNodeConfigBuilder(
 
 // Pull retrieves an existing SriovFecNodeConfig.io object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*NodeConfigBuilder, error) {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Pulling SriovFecNodeConfig.io object name: %s in namespace: %s", name, nsname)
 
 	builder := NodeConfigBuilder{
@@ -113,7 +118,7 @@ func (builder *NodeConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if SriovFecNodeConfig %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -121,7 +126,7 @@ func (builder *NodeConfigBuilder) Exists() bool {
 	builder.Object, err = builder.Get()
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect SriovFecNodeConfig object due to %s", err.Error())
+		logging.V(100).Infof("Failed to collect SriovFecNodeConfig object due to %s", err.Error())
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -133,7 +138,7 @@ func (builder *NodeConfigBuilder) Create() (*NodeConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the sriovfecnodeconfig %s in namespace %s",
+	logging.V(100).Infof("Creating the sriovfecnodeconfig %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -142,7 +147,7 @@ func (builder *NodeConfigBuilder) Create() (*NodeConfigBuilder, error) {
 		unstructuredSriovFecNodeConfig, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to convert structured SriovFecNodeConfig to unstructured object")
+			logging.V(100).Infof("Failed to convert structured SriovFecNodeConfig to unstructured object")
 
 			return nil, err
 		}
@@ -152,7 +157,7 @@ func (builder *NodeConfigBuilder) Create() (*NodeConfigBuilder, error) {
 			context.TODO(), &unstructured.Unstructured{Object: unstructuredSriovFecNodeConfig}, metaV1.CreateOptions{})
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create SriovFecNodeConfig")
+			logging.V(100).Infof("Failed to create SriovFecNodeConfig")
 
 			return nil, err
 		}
@@ -173,7 +178,7 @@ func (builder *NodeConfigBuilder) Get() (*sriovfectypes.SriovFecNodeConfig, erro
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting SriovFecNodeConfig object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -181,7 +186,7 @@ func (builder *NodeConfigBuilder) Get() (*sriovfectypes.SriovFecNodeConfig, erro
 		context.TODO(), builder.Definition.Name, metaV1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof(
+		logging.V(100).Infof(
 			"SriovFecNodeConfig object %s doesn't exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -197,7 +202,7 @@ func (builder *NodeConfigBuilder) Delete() (*NodeConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the SriovFecNodeConfig object %s in namespace %s",
+	logging.V(100).Infof("Deleting the SriovFecNodeConfig object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -228,12 +233,12 @@ func (builder *NodeConfigBuilder) Update(force bool) (*NodeConfigBuilder, error)
 		return nil, fmt.Errorf("failed to update SriovFecNodeConfig, object doesn't exist on cluster")
 	}
 
-	glog.V(100).Infof("Updating the SriovFecNodeConfig object %s in namespace %s",
+	logging.V(100).Infof("Updating the SriovFecNodeConfig object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
@@ -241,7 +246,7 @@ func (builder *NodeConfigBuilder) Update(force bool) (*NodeConfigBuilder, error)
 
 	unstructuredSriovFecNodeConfig, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
 	if err != nil {
-		glog.V(100).Infof("Failed to convert structured SriovFecNodeConfig to unstructured object")
+		logging.V(100).Infof("Failed to convert structured SriovFecNodeConfig to unstructured object")
 
 		return nil, err
 	}
@@ -252,13 +257,13 @@ func (builder *NodeConfigBuilder) Update(force bool) (*NodeConfigBuilder, error)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("SriovFecNodeConfig", builder.Definition.Name, builder.Definition.Namespace))
 
 			builder, err := builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("SriovFecNodeConfig", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -277,16 +282,16 @@ func (builder *NodeConfigBuilder) WithOptions(options ...AdditionalOptions) *Nod
 		return builder
 	}
 
-	glog.V(100).Infof("Setting SriovFecNodeConfig additional options")
+	logging.V(100).Infof("Setting SriovFecNodeConfig additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -296,6 +301,85 @@ func (builder *NodeConfigBuilder) WithOptions(options ...AdditionalOptions) *Nod
 	return builder
 }
 
+// GetAccelerators returns the accelerators discovered on the node and their VFs.
+func (builder *NodeConfigBuilder) GetAccelerators() ([]sriovfectypes.SriovAccelerator, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting accelerators of SriovFecNodeConfig %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("SriovFecNodeConfig object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Inventory.SriovAccelerators, nil
+}
+
+// IsConfigured returns true when the operator reports the accelerators of the node as configured according to the
+// current spec. An error is returned when the configuration failed.
+func (builder *NodeConfigBuilder) IsConfigured() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if SriovFecNodeConfig %s in namespace %s is configured",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get SriovFecNodeConfig %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	condition := meta.FindStatusCondition(builder.Object.Status.Conditions, ConditionConfigured)
+	if condition == nil || condition.ObservedGeneration != builder.Object.Generation {
+		return false, nil
+	}
+
+	if condition.Reason == ConfiguredReasonFailed {
+		return false, fmt.Errorf("SriovFecNodeConfig %s in namespace %s failed to configure: %s",
+			builder.Definition.Name, builder.Definition.Namespace, condition.Message)
+	}
+
+	return condition.Reason == ConfiguredReasonSucceeded, nil
+}
+
+// WaitUntilConfigured waits for the duration of the defined timeout or until the accelerators of the node are
+// configured. It fails early when the configuration failed.
+func (builder *NodeConfigBuilder) WaitUntilConfigured(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until SriovFecNodeConfig %s in namespace %s is configured",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			configured, err := builder.IsConfigured()
+			if err != nil && builder.Object != nil {
+				return false, err
+			}
+
+			lastErr = err
+
+			return configured, nil
+		})
+
+	if err != nil && lastErr != nil {
+		return errors.Join(err, lastErr)
+	}
+
+	return err
+}
+
 // GetSriovFecNodeConfigIoGVR returns SriovFecNodeConfig's GroupVersionResource which could be used for Clean function.
 func GetSriovFecNodeConfigIoGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
@@ -309,27 +393,29 @@ func (builder *NodeConfigBuilder) validate() (bool, error) {
 	resourceCRD := "SriovFecNodeConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -341,8 +427,8 @@ func (builder *NodeConfigBuilder) convertToStructured(unsObject *unstructured.Un
 
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, SriovFecNodeConfig)
 	if err != nil {
-		glog.V(100).Infof(
-			"Failed to convert from unstructured to SriovFecNodeConfig object in namespace %s",
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to SriovFecNodeConfig object %s in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil, err
@@ -0,0 +1,214 @@
+package sriovfec
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/sriov-fec/sriovfectypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const defaultNodeConfigName = "worker-0"
+
+var nodeConfigGVK = schema.GroupVersionKind{
+	Group:   APIGroup,
+	Version: APIVersion,
+	Kind:    sriovfecnodeconfig,
+}
+
+func TestNodeConfigGetAccelerators(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("SriovFecNodeConfig object %s doesn't exist in namespace %s",
+				defaultNodeConfigName, defaultSriovFecNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNodeConfig(defaultNodeConfigName, ConfiguredReasonSucceeded))
+		}
+
+		testBuilder := buildValidNodeConfigBuilder(buildTestClientWithNodeConfigs(runtimeObjects...))
+
+		accelerators, err := testBuilder.GetAccelerators()
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, accelerators, 1)
+			assert.Equal(t, "0000:f7:00.0", accelerators[0].PCIAddress)
+			assert.Len(t, accelerators[0].VFs, defaultVFAmount)
+		}
+	}
+}
+
+func TestNodeConfigIsConfigured(t *testing.T) {
+	testCases := []struct {
+		reason              string
+		addToRuntimeObjects bool
+		expectedConfigured  bool
+		expectedError       string
+	}{
+		{
+			reason:              ConfiguredReasonSucceeded,
+			addToRuntimeObjects: true,
+			expectedConfigured:  true,
+			expectedError:       "",
+		},
+		{
+			reason:              "InProgress",
+			addToRuntimeObjects: true,
+			expectedConfigured:  false,
+			expectedError:       "",
+		},
+		{
+			reason:              "",
+			addToRuntimeObjects: true,
+			expectedConfigured:  false,
+			expectedError:       "",
+		},
+		{
+			reason:              ConfiguredReasonFailed,
+			addToRuntimeObjects: true,
+			expectedConfigured:  false,
+			expectedError: fmt.Sprintf("SriovFecNodeConfig %s in namespace %s failed to configure: "+
+				"failed to bind VFs", defaultNodeConfigName, defaultSriovFecNamespace),
+		},
+		{
+			reason:              ConfiguredReasonSucceeded,
+			addToRuntimeObjects: false,
+			expectedConfigured:  false,
+			expectedError: fmt.Sprintf("failed to get SriovFecNodeConfig %s in namespace %s: "+
+				"sriovfecnodeconfigs.sriovfec.intel.com \"%s\" not found",
+				defaultNodeConfigName, defaultSriovFecNamespace, defaultNodeConfigName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNodeConfig(defaultNodeConfigName, testCase.reason))
+		}
+
+		testBuilder := buildValidNodeConfigBuilder(buildTestClientWithNodeConfigs(runtimeObjects...))
+
+		configured, err := testBuilder.IsConfigured()
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedConfigured, configured)
+	}
+}
+
+func TestNodeConfigWaitUntilConfigured(t *testing.T) {
+	testCases := []struct {
+		reason              string
+		addToRuntimeObjects bool
+		expectedError       string
+	}{
+		{
+			reason:              ConfiguredReasonSucceeded,
+			addToRuntimeObjects: true,
+			expectedError:       "",
+		},
+		{
+			reason:              "InProgress",
+			addToRuntimeObjects: true,
+			expectedError:       "context deadline exceeded",
+		},
+		{
+			reason:              ConfiguredReasonFailed,
+			addToRuntimeObjects: true,
+			expectedError: fmt.Sprintf("SriovFecNodeConfig %s in namespace %s failed to configure: "+
+				"failed to bind VFs", defaultNodeConfigName, defaultSriovFecNamespace),
+		},
+		{
+			reason:              ConfiguredReasonSucceeded,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Sprintf("context deadline exceeded\nfailed to get SriovFecNodeConfig %s in namespace %s: "+
+				"sriovfecnodeconfigs.sriovfec.intel.com \"%s\" not found",
+				defaultNodeConfigName, defaultSriovFecNamespace, defaultNodeConfigName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyNodeConfig(defaultNodeConfigName, testCase.reason))
+		}
+
+		testBuilder := buildValidNodeConfigBuilder(buildTestClientWithNodeConfigs(runtimeObjects...))
+
+		err := testBuilder.WaitUntilConfigured(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func buildValidNodeConfigBuilder(apiClient *clients.Settings) *NodeConfigBuilder {
+	return NewNodeConfigBuilder(apiClient, defaultNodeConfigName, defaultSriovFecNamespace, nil)
+}
+
+// buildDummyNodeConfig returns a SriovFecNodeConfig with one accelerator and a Configured condition with the given
+// reason. No condition is set when the reason is empty.
+func buildDummyNodeConfig(name, reason string) *sriovfectypes.SriovFecNodeConfig {
+	nodeConfig := &sriovfectypes.SriovFecNodeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultSriovFecNamespace,
+		},
+		Status: sriovfectypes.SriovFecNodeConfigStatus{
+			Inventory: sriovfectypes.NodeInventory{
+				SriovAccelerators: []sriovfectypes.SriovAccelerator{{
+					VendorID:   "8086",
+					DeviceID:   "57c0",
+					PCIAddress: "0000:f7:00.0",
+					PFDriver:   defaultPFDriver,
+					MaxVFs:     16,
+					VFs: []sriovfectypes.VF{
+						{PCIAddress: "0000:f7:00.1", Driver: defaultVFDriver, DeviceID: "57c1"},
+						{PCIAddress: "0000:f7:00.2", Driver: defaultVFDriver, DeviceID: "57c1"},
+					},
+				}},
+			},
+		},
+	}
+
+	if reason == "" {
+		return nodeConfig
+	}
+
+	message := "Configured successfully"
+	if reason == ConfiguredReasonFailed {
+		message = "failed to bind VFs"
+	}
+
+	nodeConfig.Status.Conditions = []metav1.Condition{{
+		Type:    ConditionConfigured,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	}}
+
+	return nodeConfig
+}
+
+func buildTestClientWithNodeConfigs(nodeConfigs ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: nodeConfigs,
+		GVK:            []schema.GroupVersionKind{nodeConfigGVK},
+	})
+}
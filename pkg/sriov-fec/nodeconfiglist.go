@@ -0,0 +1,107 @@
+package sriovfec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/sriov-fec/sriovfectypes"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ListNodeConfig returns the SriovFecNodeConfigs of all nodes in the given namespace.
+func ListNodeConfig(
+	apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) ([]*NodeConfigBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("SriovFecNodeConfig 'apiClient' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("SriovFecNodeConfig 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list SriovFecNodeConfigs, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metaV1.ListOptions{}
+	logMessage := fmt.Sprintf("Listing SriovFecNodeConfigs in the namespace %s", nsname)
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	unsList, err := apiClient.Resource(GetSriovFecNodeConfigIoGVR()).Namespace(nsname).List(
+		context.TODO(), passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list SriovFecNodeConfigs in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var nodeConfigBuilders []*NodeConfigBuilder
+
+	for _, unsObject := range unsList.Items {
+		nodeConfig := &sriovfectypes.SriovFecNodeConfig{}
+
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, nodeConfig)
+		if err != nil {
+			logging.V(100).Infof("Failed to convert from unstructured to SriovFecNodeConfig object %s in namespace %s",
+				unsObject.GetName(), nsname)
+
+			return nil, err
+		}
+
+		nodeConfigBuilders = append(nodeConfigBuilders, &NodeConfigBuilder{
+			apiClient:  apiClient,
+			Definition: nodeConfig,
+			Object:     nodeConfig,
+		})
+	}
+
+	return nodeConfigBuilders, nil
+}
+
+// WaitForAllNodeConfigsConfigured waits for the duration of the defined timeout or until the accelerators of all the
+// nodes in the given namespace are configured.
+func WaitForAllNodeConfigsConfigured(
+	apiClient *clients.Settings, nsname string, timeout time.Duration, options ...metaV1.ListOptions) error {
+	logging.V(100).Infof("Waiting for all SriovFecNodeConfigs in namespace %s to be configured", nsname)
+
+	nodeConfigs, err := ListNodeConfig(apiClient, nsname, options...)
+	if err != nil {
+		return err
+	}
+
+	if len(nodeConfigs) == 0 {
+		return fmt.Errorf("no SriovFecNodeConfig found in namespace %s", nsname)
+	}
+
+	startTime := time.Now()
+
+	for _, nodeConfig := range nodeConfigs {
+		remaining := timeout - time.Since(startTime)
+		if remaining <= 0 {
+			remaining = time.Second
+		}
+
+		err := nodeConfig.WaitUntilConfigured(remaining)
+		if err != nil {
+			return fmt.Errorf("SriovFecNodeConfig %s is not configured: %w", nodeConfig.Definition.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package sriovfec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListNodeConfig(t *testing.T) {
+	testCases := []struct {
+		namespace     string
+		options       []metav1.ListOptions
+		client        bool
+		expectedCount int
+		expectedError string
+	}{
+		{
+			namespace:     defaultSriovFecNamespace,
+			client:        true,
+			expectedCount: 2,
+			expectedError: "",
+		},
+		{
+			namespace:     "default",
+			client:        true,
+			expectedCount: 0,
+			expectedError: "",
+		},
+		{
+			namespace:     "",
+			client:        true,
+			expectedError: "failed to list SriovFecNodeConfigs, 'nsname' parameter is empty",
+		},
+		{
+			namespace:     defaultSriovFecNamespace,
+			options:       []metav1.ListOptions{{}, {}},
+			client:        true,
+			expectedError: "error: more than one ListOptions was passed",
+		},
+		{
+			namespace:     defaultSriovFecNamespace,
+			client:        false,
+			expectedError: "SriovFecNodeConfig 'apiClient' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithNodeConfigs(
+				buildDummyNodeConfig("worker-0", ConfiguredReasonSucceeded),
+				buildDummyNodeConfig("worker-1", ConfiguredReasonSucceeded))
+		}
+
+		nodeConfigBuilders, err := ListNodeConfig(testSettings, testCase.namespace, testCase.options...)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Len(t, nodeConfigBuilders, testCase.expectedCount)
+
+			for _, nodeConfigBuilder := range nodeConfigBuilders {
+				assert.Equal(t, testCase.namespace, nodeConfigBuilder.Definition.Namespace)
+				assert.Equal(t, nodeConfigBuilder.Definition, nodeConfigBuilder.Object)
+			}
+		}
+	}
+}
+
+func TestWaitForAllNodeConfigsConfigured(t *testing.T) {
+	testCases := []struct {
+		namespace     string
+		nodeConfigs   []runtime.Object
+		expectedError string
+	}{
+		{
+			namespace: defaultSriovFecNamespace,
+			nodeConfigs: []runtime.Object{
+				buildDummyNodeConfig("worker-0", ConfiguredReasonSucceeded),
+				buildDummyNodeConfig("worker-1", ConfiguredReasonSucceeded),
+			},
+			expectedError: "",
+		},
+		{
+			namespace: defaultSriovFecNamespace,
+			nodeConfigs: []runtime.Object{
+				buildDummyNodeConfig("worker-0", ConfiguredReasonSucceeded),
+				buildDummyNodeConfig("worker-1", ConfiguredReasonFailed),
+			},
+			expectedError: "SriovFecNodeConfig worker-1 is not configured: SriovFecNodeConfig worker-1 in namespace " +
+				defaultSriovFecNamespace + " failed to configure: failed to bind VFs",
+		},
+		{
+			namespace:     "default",
+			nodeConfigs:   []runtime.Object{buildDummyNodeConfig("worker-0", ConfiguredReasonSucceeded)},
+			expectedError: "no SriovFecNodeConfig found in namespace default",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := WaitForAllNodeConfigsConfigured(
+			buildTestClientWithNodeConfigs(testCase.nodeConfigs...), testCase.namespace, time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
@@ -5,6 +5,7 @@ package sriovfectypes
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // SyncStatus type.
@@ -230,3 +231,54 @@ type SriovFecClusterConfigList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []SriovFecClusterConfig `json:"items"`
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out.
+func (in *BBDevConfig) DeepCopyInto(out *BBDevConfig) {
+	*out = *in
+
+	if in.N3000 != nil {
+		n3000 := *in.N3000
+		out.N3000 = &n3000
+	}
+
+	if in.ACC100 != nil {
+		acc100 := *in.ACC100
+		out.ACC100 = &acc100
+	}
+
+	if in.ACC200 != nil {
+		acc200 := *in.ACC200
+		out.ACC200 = &acc200
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovFecClusterConfig.
+func (in *SriovFecClusterConfig) DeepCopy() *SriovFecClusterConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := new(SriovFecClusterConfig)
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.PhysicalFunction.BBDevConfig.DeepCopyInto(&out.Spec.PhysicalFunction.BBDevConfig)
+
+	if in.Spec.NodeSelector != nil {
+		out.Spec.NodeSelector = make(map[string]string, len(in.Spec.NodeSelector))
+
+		for key, value := range in.Spec.NodeSelector {
+			out.Spec.NodeSelector[key] = value
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SriovFecClusterConfig) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
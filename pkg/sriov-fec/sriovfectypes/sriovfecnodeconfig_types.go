@@ -5,6 +5,7 @@ package sriovfectypes
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // VF struct.
@@ -72,3 +73,54 @@ type SriovFecNodeConfigList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []SriovFecNodeConfig `json:"items"`
 }
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovFecNodeConfig.
+func (in *SriovFecNodeConfig) DeepCopy() *SriovFecNodeConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := new(SriovFecNodeConfig)
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	if in.Spec.PhysicalFunctions != nil {
+		out.Spec.PhysicalFunctions = make([]PhysicalFunctionConfigExt, len(in.Spec.PhysicalFunctions))
+
+		for index := range in.Spec.PhysicalFunctions {
+			out.Spec.PhysicalFunctions[index] = in.Spec.PhysicalFunctions[index]
+			in.Spec.PhysicalFunctions[index].BBDevConfig.DeepCopyInto(&out.Spec.PhysicalFunctions[index].BBDevConfig)
+		}
+	}
+
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+
+		for index := range in.Status.Conditions {
+			in.Status.Conditions[index].DeepCopyInto(&out.Status.Conditions[index])
+		}
+	}
+
+	if in.Status.Inventory.SriovAccelerators != nil {
+		out.Status.Inventory.SriovAccelerators = make([]SriovAccelerator, len(in.Status.Inventory.SriovAccelerators))
+
+		for index, accelerator := range in.Status.Inventory.SriovAccelerators {
+			out.Status.Inventory.SriovAccelerators[index] = accelerator
+
+			if accelerator.VFs != nil {
+				out.Status.Inventory.SriovAccelerators[index].VFs = append([]VF(nil), accelerator.VFs...)
+			}
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SriovFecNodeConfig) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
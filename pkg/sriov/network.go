@@ -2,10 +2,11 @@ package sriov
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	clientSrIov "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/client/clientset/versioned"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,7 +28,7 @@ type NetworkBuilder struct {
 	Object *srIovV1.SriovNetwork
 	// Used in functions that define or mutate srIovNetwork definitions. errorMsg is processed before srIovNetwork
 	// object is created.
-	errorMsg string
+	errorMsg error
 	// apiClient opens api connection to the cluster.
 	apiClient clientSrIov.Interface
 }
@@ -53,19 +54,19 @@ func NewNetworkBuilder(
 	}
 
 	if name == "" {
-		builder.errorMsg = "SrIovNetwork 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SrIovNetwork 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "SrIovNetwork 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SrIovNetwork 'nsname' cannot be empty"))
 	}
 
 	if targetNsname == "" {
-		builder.errorMsg = "SrIovNetwork 'targetNsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SrIovNetwork 'targetNsname' cannot be empty"))
 	}
 
 	if resName == "" {
-		builder.errorMsg = "SrIovNetwork 'resName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SrIovNetwork 'resName' cannot be empty"))
 	}
 
 	return &builder
@@ -78,10 +79,11 @@ func (builder *NetworkBuilder) WithVLAN(vlanID uint16) *NetworkBuilder {
 	}
 
 	if vlanID > 4094 {
-		builder.errorMsg = "invalid vlanID, allowed vlanID values are between 0-4094"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid vlanID, allowed vlanID values are between 0-4094"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -113,7 +115,7 @@ func (builder *NetworkBuilder) WithMetaPluginAllMultiFlag(allMultiFlag bool) *Ne
 
 	builder.Definition.Spec.MetaPluginsConfig = fmt.Sprintf(`{ "type": "tuning", "allmulti": %t }`, allMultiFlag)
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -129,10 +131,10 @@ func (builder *NetworkBuilder) WithLinkState(linkState string) *NetworkBuilder {
 	allowedLinkStates := []string{"enable", "disable", "auto"}
 
 	if !slices.Contains(allowedLinkStates, linkState) {
-		builder.errorMsg = "invalid 'linkState' parameters"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid 'linkState' parameters"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -188,10 +190,11 @@ func (builder *NetworkBuilder) WithVlanQoS(qoSClass uint16) *NetworkBuilder {
 	}
 
 	if qoSClass > 7 {
-		builder.errorMsg = "Invalid QoS class. Supported vlan QoS class values are between 0...7"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Invalid QoS class. Supported vlan QoS class values are between 0...7"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -223,16 +226,16 @@ func (builder *NetworkBuilder) WithOptions(options ...NetworkAdditionalOptions)
 		return builder
 	}
 
-	glog.V(100).Infof("Setting SriovNetwork additional options")
+	logging.V(100).Infof("Setting SriovNetwork additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -244,10 +247,10 @@ func (builder *NetworkBuilder) WithOptions(options ...NetworkAdditionalOptions)
 
 // PullNetwork pulls existing sriovnetwork from cluster.
 func PullNetwork(apiClient *clients.Settings, name, nsname string) (*NetworkBuilder, error) {
-	glog.V(100).Infof("Pulling existing sriovnetwork name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing sriovnetwork name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("sriovnetwork 'apiClient' cannot be empty")
 	}
@@ -263,13 +266,13 @@ func PullNetwork(apiClient *clients.Settings, name, nsname string) (*NetworkBuil
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the sriovnetwork is empty")
+		logging.V(100).Infof("The name of the sriovnetwork is empty")
 
 		return nil, fmt.Errorf("sriovnetwork 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the sriovnetwork is empty")
+		logging.V(100).Infof("The namespace of the sriovnetwork is empty")
 
 		return nil, fmt.Errorf("sriovnetwork 'namespace' cannot be empty")
 	}
@@ -344,7 +347,7 @@ func (builder *NetworkBuilder) Update(force bool) (*NetworkBuilder, error) {
 		return builder, nil
 	}
 
-	glog.V(100).Infof("Updating the SrIovNetwork object %s in namespace %s",
+	logging.V(100).Infof("Updating the SrIovNetwork object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -354,13 +357,13 @@ func (builder *NetworkBuilder) Update(force bool) (*NetworkBuilder, error) {
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("SrIovNetwork", builder.Definition.Name, builder.Definition.Namespace))
 
 			err = builder.Delete()
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					msg.FailToUpdateError("SrIovNetwork", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
@@ -396,12 +399,13 @@ func (builder *NetworkBuilder) withIpam(ipamType string) *NetworkBuilder {
 	}
 
 	if ipamType == "" {
-		glog.V(100).Infof("sriov network 'ipamType' parameter can not be empty")
+		logging.V(100).Infof("sriov network 'ipamType' parameter can not be empty")
 
-		builder.errorMsg = "failed to configure IPAM, 'ipamType' parameter is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"failed to configure IPAM, 'ipamType' parameter is empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -416,27 +420,29 @@ func (builder *NetworkBuilder) validate() (bool, error) {
 	resourceCRD := "SriovNetwork"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
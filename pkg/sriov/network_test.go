@@ -1,6 +1,7 @@
 package sriov
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -176,7 +178,7 @@ func TestNewNetworkBuilder(t *testing.T) {
 		assert.NotNil(t, testNetworkStructure)
 
 		if len(testCase.expectedErrorText) > 0 {
-			assert.Equal(t, testNetworkStructure.errorMsg, testCase.expectedErrorText)
+			assert.EqualError(t, testNetworkStructure.errorMsg, testCase.expectedErrorText)
 		}
 	}
 }
@@ -199,7 +201,7 @@ func TestWithVlan(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyObject()
 		netBuilder := buildValidSriovNetworkTestBuilder(testSettings).WithVLAN(testCase.vlanID)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, netBuilder.Definition.Spec.Vlan, int(testCase.vlanID))
@@ -277,7 +279,7 @@ func TestWithLinkState(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyObject()
 		netBuilder := buildValidSriovNetworkTestBuilder(testSettings).WithLinkState(testCase.linkState)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorOutput)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorOutput, netBuilder.errorMsg)
 
 		if len(testCase.expectedErrorOutput) == 0 {
 			assert.Equal(t, netBuilder.Definition.Spec.LinkState, testCase.linkState)
@@ -368,7 +370,7 @@ func TestWithVlanQoS(t *testing.T) {
 		testSettings := buildTestClientWithDummyObject()
 		netBuilder := buildValidSriovNetworkTestBuilder(testSettings).WithVlanQoS(testCase.vlanQoS)
 
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorMsg, netBuilder.errorMsg)
 
 		if testCase.expectedErrorMsg == "" {
 			assert.Equal(t, uint16(netBuilder.Definition.Spec.VlanQoS), testCase.vlanQoS)
@@ -401,12 +403,12 @@ func TestWithOptions(t *testing.T) {
 			return builder, nil
 		})
 
-	assert.Equal(t, "", testBuilder.errorMsg)
+	assert.Nil(t, testBuilder.errorMsg)
 	testBuilder = buildValidSriovNetworkTestBuilder(testSettings).WithOptions(
 		func(builder *NetworkBuilder) (*NetworkBuilder, error) {
 			return builder, fmt.Errorf("error")
 		})
-	assert.Equal(t, "error", testBuilder.errorMsg)
+	assert.EqualError(t, testBuilder.errorMsg, "error")
 }
 
 func TestCreate(t *testing.T) {
@@ -420,7 +422,7 @@ func TestCreate(t *testing.T) {
 		},
 		{
 			testNetwork:   buildInvalidSrIovNetworkTestBuilder(buildTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("SrIovNetwork 'resName' cannot be empty"),
+			expectedError: errors.Join(fmt.Errorf("SrIovNetwork 'resName' cannot be empty")),
 		},
 	}
 
@@ -445,7 +447,7 @@ func TestDelete(t *testing.T) {
 		},
 		{
 			testNetwork:   buildInvalidSrIovNetworkTestBuilder(buildTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("SrIovNetwork 'resName' cannot be empty"),
+			expectedError: errors.Join(fmt.Errorf("SrIovNetwork 'resName' cannot be empty")),
 		},
 	}
 
@@ -4,21 +4,21 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // List returns sriov networks in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*NetworkBuilder, error) {
 	if apiClient == nil {
-		glog.V(100).Infof("sriov network 'apiClient' parameter can not be empty")
+		logging.V(100).Infof("sriov network 'apiClient' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list sriov networks, 'apiClient' parameter is empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("sriov network 'nsname' parameter can not be empty")
+		logging.V(100).Infof("sriov network 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list sriov networks, 'nsname' parameter is empty")
 	}
@@ -27,7 +27,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 	logMessage := fmt.Sprintf("Listing sriov networks in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -37,13 +37,13 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	networkList, err := apiClient.ClientSrIov.SriovnetworkV1().
 		SriovNetworks(nsname).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list sriov networks in the namespace %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list sriov networks in the namespace %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
@@ -70,17 +70,17 @@ func CleanAllNetworksByTargetNamespace(
 	operatornsname string,
 	targetnsname string,
 	options ...metav1.ListOptions) error {
-	glog.V(100).Infof("Cleaning up sriov networks in the %s namespace with %s NetworkNamespace spec",
+	logging.V(100).Infof("Cleaning up sriov networks in the %s namespace with %s NetworkNamespace spec",
 		operatornsname, targetnsname)
 
 	if operatornsname == "" {
-		glog.V(100).Infof("'operatornsname' parameter can not be empty")
+		logging.V(100).Infof("'operatornsname' parameter can not be empty")
 
 		return fmt.Errorf("failed to clean up sriov networks, 'operatornsname' parameter is empty")
 	}
 
 	if targetnsname == "" {
-		glog.V(100).Infof("'targetnsname' parameter can not be empty")
+		logging.V(100).Infof("'targetnsname' parameter can not be empty")
 
 		return fmt.Errorf("failed to clean up sriov networks, 'targetnsname' parameter is empty")
 	}
@@ -88,7 +88,7 @@ func CleanAllNetworksByTargetNamespace(
 	networks, err := List(apiClient, operatornsname, options...)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list sriov networks in namespace: %s", operatornsname)
+		logging.V(100).Infof("Failed to list sriov networks in namespace: %s", operatornsname)
 
 		return err
 	}
@@ -97,7 +97,7 @@ func CleanAllNetworksByTargetNamespace(
 		if network.Object.Spec.NetworkNamespace == targetnsname {
 			err = network.Delete()
 			if err != nil {
-				glog.V(100).Infof("Failed to delete sriov networks: %s", network.Object.Name)
+				logging.V(100).Infof("Failed to delete sriov networks: %s", network.Object.Name)
 
 				return err
 			}
@@ -2,17 +2,27 @@ package sriov
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	clientSrIov "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/client/clientset/versioned"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+const (
+	// SyncStatusSucceeded represents the SriovNetworkNodeState syncStatus once the node configuration is applied.
+	SyncStatusSucceeded = "Succeeded"
+	// SyncStatusInProgress represents the SriovNetworkNodeState syncStatus while the node is being configured.
+	SyncStatusInProgress = "InProgress"
+	// SyncStatusFailed represents the SriovNetworkNodeState syncStatus when the node configuration failed.
+	SyncStatusFailed = "Failed"
+)
+
 // NetworkNodeStateBuilder provides struct for SriovNetworkNodeState object which contains connection to cluster and
 // SriovNetworkNodeState definitions.
 type NetworkNodeStateBuilder struct {
@@ -25,12 +35,12 @@ type NetworkNodeStateBuilder struct {
 	// nsName defines SrIov operator namespace.
 	nsName string
 	// errorMsg used in discovery function before sending api request to cluster.
-	errorMsg string
+	errorMsg error
 }
 
 // NewNetworkNodeStateBuilder creates new instance of NetworkNodeStateBuilder.
 func NewNetworkNodeStateBuilder(apiClient *clients.Settings, nodeName, nsname string) *NetworkNodeStateBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new NetworkNodeStateBuilder structure with the following params: %s, %s",
 		nodeName, nsname)
 
@@ -41,15 +51,15 @@ func NewNetworkNodeStateBuilder(apiClient *clients.Settings, nodeName, nsname st
 	}
 
 	if nodeName == "" {
-		glog.V(100).Infof("The name of the nodeName is empty")
+		logging.V(100).Infof("The name of the nodeName is empty")
 
-		builder.errorMsg = "SriovNetworkNodeState 'nodeName' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkNodeState 'nodeName' is empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the SriovNetworkNodeState is empty")
+		logging.V(100).Infof("The namespace of the SriovNetworkNodeState is empty")
 
-		builder.errorMsg = "SriovNetworkNodeState 'nsname' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkNodeState 'nsname' is empty"))
 	}
 
 	return builder
@@ -61,7 +71,7 @@ func (builder *NetworkNodeStateBuilder) Discover() error {
 		return err
 	}
 
-	glog.V(100).Infof("Getting the SriovNetworkNodeState object in namespace %s for node %s",
+	logging.V(100).Infof("Getting the SriovNetworkNodeState object in namespace %s for node %s",
 		builder.nsName, builder.nodeName)
 
 	var err error
@@ -77,11 +87,11 @@ func (builder *NetworkNodeStateBuilder) GetUpNICs() (srIovV1.InterfaceExts, erro
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collection of sriov interfaces in UP state for node %s", builder.nodeName)
+	logging.V(100).Infof("Collection of sriov interfaces in UP state for node %s", builder.nodeName)
 	sriovNics, err := builder.GetNICs()
 
 	if err != nil {
-		glog.V(100).Infof("Error to discover sriov interfaces for node %s", builder.nodeName)
+		logging.V(100).Infof("Error to discover sriov interfaces for node %s", builder.nodeName)
 
 		return nil, err
 	}
@@ -90,12 +100,12 @@ func (builder *NetworkNodeStateBuilder) GetUpNICs() (srIovV1.InterfaceExts, erro
 
 	for _, nic := range sriovNics {
 		if nic.LinkSpeed != "" && nic.LinkSpeed != "-1 Mb/s" {
-			glog.V(100).Infof("Interface %s is UP on node %s. Append to list", nic.Name, builder.nodeName)
+			logging.V(100).Infof("Interface %s is UP on node %s. Append to list", nic.Name, builder.nodeName)
 			sriovNicsUp = append(sriovNicsUp, nic)
 		}
 	}
 
-	glog.V(100).Infof("Collected sriov UP interfaces list %v for node %s",
+	logging.V(100).Infof("Collected sriov UP interfaces list %v for node %s",
 		builder.Objects.Status.Interfaces, builder.nodeName)
 
 	return sriovNicsUp, nil
@@ -108,12 +118,12 @@ func (builder *NetworkNodeStateBuilder) GetNICs() (srIovV1.InterfaceExts, error)
 	}
 
 	if err := builder.Discover(); err != nil {
-		glog.V(100).Infof("Error to discover sriov interfaces for node %s", builder.nodeName)
+		logging.V(100).Infof("Error to discover sriov interfaces for node %s", builder.nodeName)
 
 		return nil, err
 	}
 
-	glog.V(100).Infof("Collected sriov interfaces list %v for node %s",
+	logging.V(100).Infof("Collected sriov interfaces list %v for node %s",
 		builder.Objects.Status.Interfaces, builder.nodeName)
 
 	return builder.Objects.Status.Interfaces, nil
@@ -126,11 +136,11 @@ func (builder *NetworkNodeStateBuilder) WaitUntilSyncStatus(syncStatus string, t
 		return err
 	}
 
-	glog.V(100).Infof("Waiting for the defined period until SriovNetworkNodeState %s has syncStatus %s",
-		builder.Objects.Name, syncStatus)
+	logging.V(100).Infof("Waiting for the defined period until SriovNetworkNodeState %s has syncStatus %s",
+		builder.nodeName, syncStatus)
 
 	if syncStatus == "" {
-		glog.V(100).Infof("The syncStatus parameter is empty")
+		logging.V(100).Infof("The syncStatus parameter is empty")
 
 		return fmt.Errorf("syncStatus can't be empty")
 	}
@@ -148,9 +158,92 @@ func (builder *NetworkNodeStateBuilder) WaitUntilSyncStatus(syncStatus string, t
 		})
 }
 
+// WaitUntilSyncSucceeded waits for the duration of the defined timeout or until the
+// SriovNetworkNodeState gets to the Succeeded syncStatus.
+func (builder *NetworkNodeStateBuilder) WaitUntilSyncSucceeded(timeout time.Duration) error {
+	return builder.WaitUntilSyncStatus(SyncStatusSucceeded, timeout)
+}
+
+// GetNICsByVendor returns a list of SrIov interfaces with the given vendor. The deviceID is optional and narrows
+// the list down to the interfaces of the given device when set.
+func (builder *NetworkNodeStateBuilder) GetNICsByVendor(vendor, deviceID string) (srIovV1.InterfaceExts, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting sriov interfaces with vendor %s and deviceID %s for node %s",
+		vendor, deviceID, builder.nodeName)
+
+	if vendor == "" {
+		logging.V(100).Infof("The vendor can not be empty string")
+
+		return nil, fmt.Errorf("the vendor is an empty string")
+	}
+
+	sriovNics, err := builder.GetNICs()
+	if err != nil {
+		return nil, err
+	}
+
+	var vendorNics srIovV1.InterfaceExts
+
+	for _, nic := range sriovNics {
+		if nic.Vendor == vendor && (deviceID == "" || nic.DeviceID == deviceID) {
+			vendorNics = append(vendorNics, nic)
+		}
+	}
+
+	return vendorNics, nil
+}
+
+// GetVFs returns the list of VFs configured under the given interface.
+func (builder *NetworkNodeStateBuilder) GetVFs(sriovInterfaceName string) ([]srIovV1.VirtualFunction, error) {
+	logging.V(100).Infof("Getting VFs under interface %s from SriovNetworkNodeState %s",
+		sriovInterfaceName, builder.nodeName)
+
+	interf, err := builder.findInterfaceByName(sriovInterfaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return interf.VFs, nil
+}
+
+// GetConfiguredVFsCount returns the number of VFs configured under the given interface.
+func (builder *NetworkNodeStateBuilder) GetConfiguredVFsCount(sriovInterfaceName string) (int, error) {
+	logging.V(100).Infof("Counting configured VFs under interface %s from SriovNetworkNodeState %s",
+		sriovInterfaceName, builder.nodeName)
+
+	vfs, err := builder.GetVFs(sriovInterfaceName)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(vfs), nil
+}
+
+// GetVFDriverName returns the driver name of the VF with the given vfID under the given interface.
+func (builder *NetworkNodeStateBuilder) GetVFDriverName(sriovInterfaceName string, vfID int) (string, error) {
+	logging.V(100).Infof("Getting driver name for VF %d under interface %s from SriovNetworkNodeState %s",
+		vfID, sriovInterfaceName, builder.nodeName)
+
+	vfs, err := builder.GetVFs(sriovInterfaceName)
+	if err != nil {
+		return "", err
+	}
+
+	for _, vf := range vfs {
+		if vf.VfID == vfID {
+			return vf.Driver, nil
+		}
+	}
+
+	return "", fmt.Errorf("VF %d was not found under interface %s", vfID, sriovInterfaceName)
+}
+
 // GetNumVFs returns num-vfs under the given interface.
 func (builder *NetworkNodeStateBuilder) GetNumVFs(sriovInterfaceName string) (int, error) {
-	glog.V(100).Infof("Getting num-vfs under interface %s from SriovNetworkNodeState %s",
+	logging.V(100).Infof("Getting num-vfs under interface %s from SriovNetworkNodeState %s",
 		sriovInterfaceName, builder.nodeName)
 
 	interf, err := builder.findInterfaceByName(sriovInterfaceName)
@@ -163,7 +256,7 @@ func (builder *NetworkNodeStateBuilder) GetNumVFs(sriovInterfaceName string) (in
 
 // GetDriverName returns driver name under the given interface.
 func (builder *NetworkNodeStateBuilder) GetDriverName(sriovInterfaceName string) (string, error) {
-	glog.V(100).Infof("Getting driver name for interface %s from SriovNetworkNodeState %s",
+	logging.V(100).Infof("Getting driver name for interface %s from SriovNetworkNodeState %s",
 		sriovInterfaceName, builder.nodeName)
 
 	interf, err := builder.findInterfaceByName(sriovInterfaceName)
@@ -176,7 +269,7 @@ func (builder *NetworkNodeStateBuilder) GetDriverName(sriovInterfaceName string)
 
 // GetPciAddress returns PciAddress under the given interface.
 func (builder *NetworkNodeStateBuilder) GetPciAddress(sriovInterfaceName string) (string, error) {
-	glog.V(100).Infof("Getting PCI address for interface %s from SriovNetworkNodeState %s",
+	logging.V(100).Infof("Getting PCI address for interface %s from SriovNetworkNodeState %s",
 		sriovInterfaceName, builder.nodeName)
 
 	interf, err := builder.findInterfaceByName(sriovInterfaceName)
@@ -193,19 +286,19 @@ func (builder *NetworkNodeStateBuilder) findInterfaceByName(sriovInterfaceName s
 	}
 
 	if err := builder.Discover(); err != nil {
-		glog.V(100).Infof("Error to discover sriov network node state for node %s", builder.nodeName)
+		logging.V(100).Infof("Error to discover sriov network node state for node %s", builder.nodeName)
 
-		builder.errorMsg = "failed to discover sriov network node state"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to discover sriov network node state"))
 	}
 
 	if sriovInterfaceName == "" {
-		glog.V(100).Infof("The sriovInterface can not be empty string")
+		logging.V(100).Infof("The sriovInterface can not be empty string")
 
-		builder.errorMsg = "the sriovInterface is an empty sting"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("the sriovInterface is an empty sting"))
 	}
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	for _, interf := range builder.Objects.Status.Interfaces {
@@ -223,21 +316,23 @@ func (builder *NetworkNodeStateBuilder) validate() (bool, error) {
 	resourceCRD := "SriovNetworkNodeState"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
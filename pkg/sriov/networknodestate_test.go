@@ -1,12 +1,15 @@
 package sriov
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,7 +55,7 @@ func TestNewNetworkNodeStateBuilder(t *testing.T) {
 		assert.NotNil(t, testNetworkStructure)
 
 		if len(testCase.expectedErrorText) > 0 {
-			assert.Equal(t, testNetworkStructure.errorMsg, testCase.expectedErrorText)
+			assert.EqualError(t, testNetworkStructure.errorMsg, testCase.expectedErrorText)
 		}
 	}
 }
@@ -82,13 +85,13 @@ func TestNetworkNodeStateDiscovery(t *testing.T) {
 		{
 			nodeName:            "",
 			nsName:              defaultNodeNsName,
-			expectedError:       fmt.Errorf("SriovNetworkNodeState 'nodeName' is empty"),
+			expectedError:       errors.Join(fmt.Errorf("SriovNetworkNodeState 'nodeName' is empty")),
 			addToRuntimeObjects: true,
 		},
 		{
 			nodeName:            defaultNodeName,
 			nsName:              "",
-			expectedError:       fmt.Errorf("SriovNetworkNodeState 'nsname' is empty"),
+			expectedError:       errors.Join(fmt.Errorf("SriovNetworkNodeState 'nsname' is empty")),
 			addToRuntimeObjects: true,
 		},
 		{
@@ -150,7 +153,7 @@ func TestNetworkNodeStateGetNICs(t *testing.T) {
 		},
 		{
 			netInterface:  []srIovV1.InterfaceExt{},
-			expectedError: fmt.Errorf("SriovNetworkNodeState 'nodeName' is empty"),
+			expectedError: errors.Join(fmt.Errorf("SriovNetworkNodeState 'nodeName' is empty")),
 		},
 	}
 
@@ -234,7 +237,7 @@ func TestNetworkNodeStateGetUpNICs(t *testing.T) {
 			netInterface: []srIovV1.InterfaceExt{
 				{Name: "eth1", LinkSpeed: "1000Mb"},
 			},
-			expectedError: fmt.Errorf("SriovNetworkNodeState 'nodeName' is empty"),
+			expectedError: errors.Join(fmt.Errorf("SriovNetworkNodeState 'nodeName' is empty")),
 		},
 	}
 
@@ -288,6 +291,33 @@ func TestNetworkNodeStateWaitUntilSyncStatus(t *testing.T) {
 	}
 }
 
+func TestNetworkNodeStateWaitUntilSyncSucceeded(t *testing.T) {
+	testCases := []struct {
+		syncStatus    string
+		expectedError error
+	}{
+		{
+			syncStatus:    SyncStatusSucceeded,
+			expectedError: nil,
+		},
+		{
+			syncStatus:    SyncStatusInProgress,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		networkNodeState := buildNodeNetworkStateSyncStatus(defaultNodeName, defaultNodeNsName, testCase.syncStatus)
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{networkNodeState},
+		})
+
+		err := NewNetworkNodeStateBuilder(testSettings, defaultNodeName, defaultNodeNsName).
+			WaitUntilSyncSucceeded(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 func TestNetworkNodeStateGetNumVFs(t *testing.T) {
 	testCases := []struct {
 		netInterface srIovV1.InterfaceExts
@@ -393,6 +423,98 @@ func TestNetworkNodeStateGetPciAddress(t *testing.T) {
 	}
 }
 
+func TestNetworkNodeStateGetNICsByVendor(t *testing.T) {
+	testCases := []struct {
+		vendor            string
+		deviceID          string
+		expectedNICs      []string
+		expectedErrorText string
+	}{
+		{
+			vendor:       "8086",
+			deviceID:     "",
+			expectedNICs: []string{"eth1", "eth2"},
+		},
+		{
+			vendor:       "8086",
+			deviceID:     "158b",
+			expectedNICs: []string{"eth2"},
+		},
+		{
+			vendor:       "15b3",
+			deviceID:     "",
+			expectedNICs: []string{"eth3"},
+		},
+		{
+			vendor:       "14e4",
+			deviceID:     "",
+			expectedNICs: nil,
+		},
+		{
+			vendor:            "",
+			deviceID:          "158b",
+			expectedErrorText: "the vendor is an empty string",
+		},
+	}
+
+	for _, testCase := range testCases {
+		networkNodeState := buildNodeNetworkStateWithNics(srIovV1.InterfaceExts{
+			{Name: "eth1", Vendor: "8086", DeviceID: "1593"},
+			{Name: "eth2", Vendor: "8086", DeviceID: "158b"},
+			{Name: "eth3", Vendor: "15b3", DeviceID: "1017"},
+		})
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{networkNodeState},
+		})
+
+		nics, err := NewNetworkNodeStateBuilder(testSettings, defaultNodeName, defaultNodeNsName).
+			GetNICsByVendor(testCase.vendor, testCase.deviceID)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedErrorText, err) {
+			continue
+		}
+
+		var nicNames []string
+		for _, nic := range nics {
+			nicNames = append(nicNames, nic.Name)
+		}
+
+		assert.Equal(t, testCase.expectedNICs, nicNames)
+	}
+}
+
+func TestNetworkNodeStateGetVFs(t *testing.T) {
+	networkNodeState := buildNodeNetworkStateWithNics(srIovV1.InterfaceExts{
+		{Name: "eth1", NumVfs: 2, VFs: []srIovV1.VirtualFunction{
+			{VfID: 0, Driver: "iavf"},
+			{VfID: 1, Driver: "vfio-pci"},
+		}},
+		{Name: "eth2"},
+	})
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{networkNodeState},
+	})
+	networkNodeStateBuilder := NewNetworkNodeStateBuilder(testSettings, defaultNodeName, defaultNodeNsName)
+
+	vfsCount, err := networkNodeStateBuilder.GetConfiguredVFsCount("eth1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, vfsCount)
+
+	vfsCount, err = networkNodeStateBuilder.GetConfiguredVFsCount("eth2")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, vfsCount)
+
+	driver, err := networkNodeStateBuilder.GetVFDriverName("eth1", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "vfio-pci", driver)
+
+	_, err = networkNodeStateBuilder.GetVFDriverName("eth1", 2)
+	assert.EqualError(t, err, "VF 2 was not found under interface eth1")
+
+	_, err = networkNodeStateBuilder.GetVFs("eth3")
+	assert.EqualError(t, err, "interface eth3 was not found")
+}
+
 func buildNodeNetworkState(name, nsName string) *srIovV1.SriovNetworkNodeState {
 	return &srIovV1.SriovNetworkNodeState{
 		ObjectMeta: metav1.ObjectMeta{
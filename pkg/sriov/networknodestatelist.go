@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -13,13 +13,13 @@ import (
 func ListNetworkNodeState(
 	apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*NetworkNodeStateBuilder, error) {
 	if apiClient == nil {
-		glog.V(100).Infof("SriovNetworkNodeStates 'apiClient' parameter can not be empty")
+		logging.V(100).Infof("SriovNetworkNodeStates 'apiClient' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list SriovNetworkNodeStates, 'apiClient' parameter is empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("SriovNetworkNodeStates 'nsname' parameter can not be empty")
+		logging.V(100).Infof("SriovNetworkNodeStates 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list SriovNetworkNodeStates, 'nsname' parameter is empty")
 	}
@@ -28,7 +28,7 @@ func ListNetworkNodeState(
 	passedOptions := metav1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -38,13 +38,13 @@ func ListNetworkNodeState(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	networkNodeStateList, err := apiClient.ClientSrIov.SriovnetworkV1().
 		SriovNetworkNodeStates(nsname).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkNodeStates in the namespace %s due to %s", nsname, err.Error())
+		logging.V(100).Infof("Failed to list SriovNetworkNodeStates in the namespace %s due to %s", nsname, err.Error())
 
 		return nil, err
 	}
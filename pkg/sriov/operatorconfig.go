@@ -2,9 +2,10 @@ package sriov
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
@@ -27,12 +28,12 @@ type OperatorConfigBuilder struct {
 	Object *srIovV1.SriovOperatorConfig
 	// api client to interact with the cluster.
 	apiClient clientSrIov.Interface
-	errorMsg  string
+	errorMsg  error
 }
 
 // NewOperatorConfigBuilder creates new instance of OperatorConfigBuilder.
 func NewOperatorConfigBuilder(apiClient *clients.Settings, nsname string) *OperatorConfigBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new OperatorConfigBuilder structure with the following params: namespace: %s", nsname)
 
 	builder := &OperatorConfigBuilder{
@@ -46,9 +47,9 @@ func NewOperatorConfigBuilder(apiClient *clients.Settings, nsname string) *Opera
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the SriovOperatorConfig is empty")
+		logging.V(100).Infof("The namespace of the SriovOperatorConfig is empty")
 
-		builder.errorMsg = "SriovOperatorConfig 'nsname' is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovOperatorConfig 'nsname' is empty"))
 	}
 
 	return builder
@@ -60,7 +61,7 @@ func (builder *OperatorConfigBuilder) Create() (*OperatorConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the SriovOperatorConfig in namespace %s", builder.Definition.Namespace)
+	logging.V(100).Infof("Creating the SriovOperatorConfig in namespace %s", builder.Definition.Namespace)
 
 	if !builder.Exists() {
 		var err error
@@ -69,7 +70,7 @@ func (builder *OperatorConfigBuilder) Create() (*OperatorConfigBuilder, error) {
 		)
 
 		if err != nil {
-			glog.V(100).Infof("Failed to create the SriovOperatorConfig")
+			logging.V(100).Infof("Failed to create the SriovOperatorConfig")
 
 			return nil, err
 		}
@@ -80,10 +81,10 @@ func (builder *OperatorConfigBuilder) Create() (*OperatorConfigBuilder, error) {
 
 // PullOperatorConfig loads an existing SriovOperatorConfig into OperatorConfigBuilder struct.
 func PullOperatorConfig(apiClient *clients.Settings, nsname string) (*OperatorConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing default SriovOperatorConfig: %s", sriovOperatorConfigName)
+	logging.V(100).Infof("Pulling existing default SriovOperatorConfig: %s", sriovOperatorConfigName)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("SriovOperatorConfig 'apiClient' cannot be empty")
 	}
@@ -99,7 +100,7 @@ func PullOperatorConfig(apiClient *clients.Settings, nsname string) (*OperatorCo
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the SriovOperatorConfig is empty")
+		logging.V(100).Infof("The namespace of the SriovOperatorConfig is empty")
 
 		return nil, fmt.Errorf("SriovOperatorConfig 'nsname' cannot be empty")
 	}
@@ -120,7 +121,7 @@ func (builder *OperatorConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Checking if SriovOperatorConfig %s exists", builder.Definition.Name)
 
 	var err error
@@ -136,7 +137,7 @@ func (builder *OperatorConfigBuilder) WithInjector(enable bool) *OperatorConfigB
 		return builder
 	}
 
-	glog.V(100).Infof("Configuring enableInjector %t to SriovOperatorConfig object %s",
+	logging.V(100).Infof("Configuring enableInjector %t to SriovOperatorConfig object %s",
 		enable, builder.Definition.Name,
 	)
 
@@ -151,7 +152,7 @@ func (builder *OperatorConfigBuilder) WithOperatorWebhook(enable bool) *Operator
 		return builder
 	}
 
-	glog.V(100).Infof("Configuring WithOperatorWebhook %t to SriovOperatorConfig object %s",
+	logging.V(100).Infof("Configuring WithOperatorWebhook %t to SriovOperatorConfig object %s",
 		enable, builder.Definition.Name,
 	)
 
@@ -160,16 +161,60 @@ func (builder *OperatorConfigBuilder) WithOperatorWebhook(enable bool) *Operator
 	return builder
 }
 
+// WithDisableDrain configures disableDrain in the SriovOperatorConfig.
+func (builder *OperatorConfigBuilder) WithDisableDrain(disable bool) *OperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Configuring disableDrain %t to SriovOperatorConfig object %s",
+		disable, builder.Definition.Name,
+	)
+
+	builder.Definition.Spec.DisableDrain = disable
+
+	return builder
+}
+
+// WithConfigDaemonNodeSelector configures configDaemonNodeSelector in the SriovOperatorConfig.
+func (builder *OperatorConfigBuilder) WithConfigDaemonNodeSelector(
+	nodeSelector map[string]string) *OperatorConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Configuring configDaemonNodeSelector %v to SriovOperatorConfig object %s",
+		nodeSelector, builder.Definition.Name,
+	)
+
+	if len(nodeSelector) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SriovOperatorConfig 'configDaemonNodeSelector' cannot be empty map"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ConfigDaemonNodeSelector = nodeSelector
+
+	return builder
+}
+
 // Update renovates the existing SriovOperatorConfig object with the new definition in builder.
 func (builder *OperatorConfigBuilder) Update() (*OperatorConfigBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the SriovOperatorConfig object %s",
+	logging.V(100).Infof("Updating the SriovOperatorConfig object %s",
 		builder.Definition.Name,
 	)
 
+	if !builder.Exists() {
+		return builder, fmt.Errorf("SriovOperatorConfig cannot be updated because it does not exist")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
 	var err error
 	builder.Object, err = builder.apiClient.SriovnetworkV1().SriovOperatorConfigs(builder.Definition.Namespace).
 		Update(context.TODO(), builder.Definition, metaV1.UpdateOptions{})
@@ -183,7 +228,7 @@ func (builder *OperatorConfigBuilder) Delete() (*OperatorConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the SriovOperatorConfig object %s in namespace %s",
+	logging.V(100).Infof("Deleting the SriovOperatorConfig object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
@@ -208,27 +253,29 @@ func (builder *OperatorConfigBuilder) validate() (bool, error) {
 	resourceCRD := "SriovOperatorConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		return false, fmt.Errorf(fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD))
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
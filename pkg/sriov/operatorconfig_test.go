@@ -1,11 +1,13 @@
 package sriov
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -119,7 +121,7 @@ func TestNewOperatorConfigBuilder(t *testing.T) {
 		testSettings := clients.GetTestClients(clients.TestClientParams{})
 		testPolicyStructure := generatePolicyBuilder(testSettings, testCase.operatorConfigNamespace)
 		assert.NotNil(t, testPolicyStructure)
-		assert.Equal(t, testPolicyStructure.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testPolicyStructure.errorMsg)
 	}
 }
 
@@ -136,7 +138,7 @@ func TestOperatorConfigCreate(t *testing.T) {
 		},
 		{
 			testOperatorConfig: NewOperatorConfigBuilder(buildTestClientWithDummyOperatorConfigObject(), ""),
-			expectedError:      fmt.Errorf("SriovOperatorConfig 'nsname' is empty"),
+			expectedError:      errors.Join(fmt.Errorf("SriovOperatorConfig 'nsname' is empty")),
 		},
 	}
 
@@ -190,7 +192,7 @@ func TestOperatorConfigWithInjector(t *testing.T) {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		operatorConfigBuilder := NewOperatorConfigBuilder(testSettings, "testnamespace").
 			WithInjector(testCase.enableInjector)
-		assert.Equal(t, operatorConfigBuilder.errorMsg, "")
+		assert.Nil(t, operatorConfigBuilder.errorMsg)
 		assert.Equal(t, testCase.enableInjector, operatorConfigBuilder.Definition.Spec.EnableInjector)
 	}
 }
@@ -211,11 +213,65 @@ func TestOperatorConfigWithOperatorWebhook(t *testing.T) {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		operatorConfigBuilder := NewOperatorConfigBuilder(testSettings, "testnamespace").
 			WithOperatorWebhook(testCase.webhook)
-		assert.Equal(t, operatorConfigBuilder.errorMsg, "")
+		assert.Nil(t, operatorConfigBuilder.errorMsg)
 		assert.Equal(t, testCase.webhook, operatorConfigBuilder.Definition.Spec.EnableOperatorWebhook)
 	}
 }
 
+func TestOperatorConfigWithDisableDrain(t *testing.T) {
+	testCases := []struct {
+		disableDrain bool
+	}{
+		{
+			disableDrain: true,
+		},
+		{
+			disableDrain: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyOperatorConfigObject()
+		operatorConfigBuilder := NewOperatorConfigBuilder(testSettings, defaultOperatorConfigNsName).
+			WithDisableDrain(testCase.disableDrain)
+		assert.Nil(t, operatorConfigBuilder.errorMsg)
+		assert.Equal(t, testCase.disableDrain, operatorConfigBuilder.Definition.Spec.DisableDrain)
+	}
+}
+
+func TestOperatorConfigWithConfigDaemonNodeSelector(t *testing.T) {
+	testCases := []struct {
+		nodeSelector      map[string]string
+		expectedErrorText string
+	}{
+		{
+			nodeSelector:      map[string]string{"node-role.kubernetes.io/worker": ""},
+			expectedErrorText: "",
+		},
+		{
+			nodeSelector:      map[string]string{},
+			expectedErrorText: "SriovOperatorConfig 'configDaemonNodeSelector' cannot be empty map",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyOperatorConfigObject()
+		operatorConfigBuilder := NewOperatorConfigBuilder(testSettings, defaultOperatorConfigNsName).
+			WithConfigDaemonNodeSelector(testCase.nodeSelector)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, operatorConfigBuilder.errorMsg) {
+			assert.Equal(t, testCase.nodeSelector, operatorConfigBuilder.Definition.Spec.ConfigDaemonNodeSelector)
+		}
+	}
+}
+
+func TestOperatorConfigUpdateNonExistent(t *testing.T) {
+	operatorConfigBuilder, err := NewOperatorConfigBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), defaultOperatorConfigNsName).WithDisableDrain(true).Update()
+	assert.EqualError(t, err, "SriovOperatorConfig cannot be updated because it does not exist")
+	assert.Nil(t, operatorConfigBuilder.Object)
+}
+
 func TestOperatorConfigUpdate(t *testing.T) {
 	testCases := []struct {
 		testOperatorConfig *OperatorConfigBuilder
@@ -265,7 +321,7 @@ func TestOperatorConfigDelete(t *testing.T) {
 			testOperatorConfig: NewOperatorConfigBuilder(
 				buildTestClientWithDummyOperatorConfigObject(),
 				""),
-			expectedError: fmt.Errorf("SriovOperatorConfig 'nsname' is empty"),
+			expectedError: errors.Join(fmt.Errorf("SriovOperatorConfig 'nsname' is empty")),
 		},
 	}
 	for _, testCase := range testCases {
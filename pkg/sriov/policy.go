@@ -2,9 +2,12 @@ package sriov
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
@@ -13,6 +16,7 @@ import (
 	"golang.org/x/exp/slices"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // PolicyBuilder provides struct for srIovPolicy object containing connection to the cluster and the srIovPolicy
@@ -24,7 +28,7 @@ type PolicyBuilder struct {
 	Object *srIovV1.SriovNetworkNodePolicy
 	// Used in functions that define or mutate srIovPolicy definition. errorMsg is processed before the srIovPolicy
 	// object is created.
-	errorMsg string
+	errorMsg error
 	// apiClient opens api connection to the cluster.
 	apiClient clientSrIov.Interface
 }
@@ -61,27 +65,29 @@ func NewPolicyBuilder(
 	}
 
 	if name == "" {
-		builder.errorMsg = "SriovNetworkNodePolicy 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkNodePolicy 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nsname' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkNodePolicy 'nsname' cannot be empty"))
 	}
 
 	if resName == "" {
-		builder.errorMsg = "SriovNetworkNodePolicy 'resName' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkNodePolicy 'resName' cannot be empty"))
 	}
 
 	if len(nicNames) == 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nicNames' cannot be empty list"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkNodePolicy 'nicNames' cannot be empty list"))
 	}
 
 	if len(nodeSelector) == 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'nodeSelector' cannot be empty map"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SriovNetworkNodePolicy 'nodeSelector' cannot be empty map"))
 	}
 
 	if vfsNumber <= 0 {
-		builder.errorMsg = "SriovNetworkNodePolicy 'vfsNumber' cannot be zero of negative"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SriovNetworkNodePolicy 'vfsNumber' cannot be zero of negative"))
 	}
 
 	return &builder
@@ -96,7 +102,8 @@ func (builder *PolicyBuilder) WithDevType(devType string) *PolicyBuilder {
 	allowedDevTypes := []string{"vfio-pci", "netdevice"}
 
 	if !slices.Contains(allowedDevTypes, devType) {
-		builder.errorMsg = "invalid device type, allowed devType values are: vfio-pci or netdevice"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid device type, allowed devType values are: vfio-pci or netdevice"))
 
 		return builder
 	}
@@ -113,24 +120,25 @@ func (builder *PolicyBuilder) WithVFRange(firstVF, lastVF int) *PolicyBuilder {
 	}
 
 	if firstVF < 0 || lastVF < 0 {
-		builder.errorMsg = "firstPF or lastVF can not be less than 0"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("firstPF or lastVF can not be less than 0"))
 	}
 
 	if firstVF > lastVF {
-		builder.errorMsg = "firstPF argument can not be greater than lastPF"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("firstPF argument can not be greater than lastPF"))
 	}
 
 	if lastVF > 63 {
-		builder.errorMsg = "lastVF can not be greater than 63"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("lastVF can not be greater than 63"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
 	var partitionedPFs []string
 	for _, pf := range builder.Definition.Spec.NicSelector.PfNames {
-		partitionedPFs = append(partitionedPFs, fmt.Sprintf("%s#%d-%d", pf, firstVF, lastVF))
+		pfName, _, _ := strings.Cut(pf, "#")
+		partitionedPFs = append(partitionedPFs, fmt.Sprintf("%s#%d-%d", pfName, firstVF, lastVF))
 	}
 
 	builder.Definition.Spec.NicSelector.PfNames = partitionedPFs
@@ -145,10 +153,11 @@ func (builder *PolicyBuilder) WithMTU(mtu int) *PolicyBuilder {
 	}
 
 	if 1 > mtu || mtu > 9192 {
-		builder.errorMsg = fmt.Sprintf("invalid mtu size %d allowed mtu should be in range 1...9192", mtu)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid mtu size %d allowed mtu should be in range 1...9192", mtu))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -170,7 +179,7 @@ func (builder *PolicyBuilder) WithRDMA(rdma bool) *PolicyBuilder {
 
 // WithVhostNet sets Vhost mode in in SriovNetworkNodePolicy object.
 func (builder *PolicyBuilder) WithVhostNet(vhost bool) *PolicyBuilder {
-	glog.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
+	logging.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
 		" NeedVhostNet: %t", builder.Definition.Name, vhost)
 
 	if valid, _ := builder.validate(); !valid {
@@ -184,7 +193,7 @@ func (builder *PolicyBuilder) WithVhostNet(vhost bool) *PolicyBuilder {
 
 // WithExternallyManaged sets ExternallyManaged option in SriovNetworkNodePolicy object.
 func (builder *PolicyBuilder) WithExternallyManaged(externallyManaged bool) *PolicyBuilder {
-	glog.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
+	logging.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
 		" externallyManaged: %t", builder.Definition.Name, externallyManaged)
 
 	if valid, _ := builder.validate(); !valid {
@@ -196,22 +205,69 @@ func (builder *PolicyBuilder) WithExternallyManaged(externallyManaged bool) *Pol
 	return builder
 }
 
+// WithESwitchMode sets eSwitchMode in SriovNetworkNodePolicy object. Allowed modes are legacy and switchdev.
+func (builder *PolicyBuilder) WithESwitchMode(eSwitchMode string) *PolicyBuilder {
+	logging.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
+		" eSwitchMode: %s", builder.Definition.Name, eSwitchMode)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	allowedESwitchModes := []string{srIovV1.ESwithModeLegacy, srIovV1.ESwithModeSwitchDev}
+
+	if !slices.Contains(allowedESwitchModes, eSwitchMode) {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid eSwitchMode, allowed eSwitchMode values are: legacy or switchdev"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.EswitchMode = eSwitchMode
+
+	return builder
+}
+
+// WithVdpaType sets vdpaType in SriovNetworkNodePolicy object. Allowed types are virtio and vhost. The vdpa
+// devices require the switchdev eSwitchMode and the netdevice device type, which is checked on Create.
+func (builder *PolicyBuilder) WithVdpaType(vdpaType string) *PolicyBuilder {
+	logging.V(100).Infof("Redefining SriovNetworkNodePolicy %s with"+
+		" vdpaType: %s", builder.Definition.Name, vdpaType)
+
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	allowedVdpaTypes := []string{"virtio", "vhost"}
+
+	if !slices.Contains(allowedVdpaTypes, vdpaType) {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid vdpaType, allowed vdpaType values are: virtio or vhost"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.VdpaType = vdpaType
+
+	return builder
+}
+
 // WithOptions creates SriovNetworkNodePolicy with generic mutation options.
 func (builder *PolicyBuilder) WithOptions(options ...PolicyAdditionalOptions) *PolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting SriovNetworkNodePolicy additional options")
+	logging.V(100).Infof("Setting SriovNetworkNodePolicy additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -223,10 +279,10 @@ func (builder *PolicyBuilder) WithOptions(options ...PolicyAdditionalOptions) *P
 
 // PullPolicy pulls existing sriovnetworknodepolicy from cluster.
 func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilder, error) {
-	glog.V(100).Infof("Pulling existing sriovnetworknodepolicy name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing sriovnetworknodepolicy name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("sriovnetworknodepolicy 'apiClient' cannot be empty")
 	}
@@ -242,13 +298,13 @@ func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilde
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the sriovnetworknodepolicy is empty")
+		logging.V(100).Infof("The name of the sriovnetworknodepolicy is empty")
 
 		return nil, fmt.Errorf("sriovnetworknodepolicy 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the sriovnetworknodepolicy is empty")
+		logging.V(100).Infof("The namespace of the sriovnetworknodepolicy is empty")
 
 		return nil, fmt.Errorf("sriovnetworknodepolicy 'namespace' cannot be empty")
 	}
@@ -268,6 +324,10 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
+	if err := builder.validateSpec(); err != nil {
+		return builder, err
+	}
+
 	if !builder.Exists() {
 		var err error
 		builder.Object, err = builder.apiClient.SriovnetworkV1().
@@ -317,33 +377,129 @@ func (builder *PolicyBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// WaitUntilApplied waits for the duration of the defined timeout or until every SriovNetworkNodeState in the
+// policy namespace that references the policy has syncStatus Succeeded.
+func (builder *PolicyBuilder) WaitUntilApplied(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until SriovNetworkNodePolicy %s in namespace %s is applied",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			nodeStates, err := builder.apiClient.SriovnetworkV1().SriovNetworkNodeStates(
+				builder.Definition.Namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				logging.V(100).Infof("Failed to list SriovNetworkNodeStates: %v", err)
+
+				return false, nil
+			}
+
+			applied := false
+
+			for index := range nodeStates.Items {
+				nodeState := &nodeStates.Items[index]
+
+				if !nodeStateReferencesPolicy(nodeState, builder.Definition.Name) {
+					continue
+				}
+
+				if nodeState.Status.SyncStatus != SyncStatusSucceeded {
+					logging.V(100).Infof("SriovNetworkNodeState %s has syncStatus %s: %s",
+						nodeState.Name, nodeState.Status.SyncStatus, nodeState.Status.LastSyncError)
+
+					return false, nil
+				}
+
+				applied = true
+			}
+
+			return applied, nil
+		})
+}
+
+// validateSpec checks the combinations of SriovNetworkNodePolicy fields which are rejected by the operator.
+func (builder *PolicyBuilder) validateSpec() error {
+	spec := builder.Definition.Spec
+
+	for _, pf := range spec.NicSelector.PfNames {
+		_, vfRange, partitioned := strings.Cut(pf, "#")
+		if !partitioned {
+			continue
+		}
+
+		var firstVF, lastVF int
+
+		if _, err := fmt.Sscanf(vfRange, "%d-%d", &firstVF, &lastVF); err != nil {
+			return fmt.Errorf("invalid VF range in pfName %s", pf)
+		}
+
+		if lastVF >= spec.NumVfs {
+			return fmt.Errorf("lastVF %d in pfName %s must be lower than numVfs %d", lastVF, pf, spec.NumVfs)
+		}
+	}
+
+	if spec.VdpaType != "" {
+		if spec.EswitchMode != srIovV1.ESwithModeSwitchDev {
+			return fmt.Errorf("vdpaType %s requires switchdev eSwitchMode", spec.VdpaType)
+		}
+
+		if spec.DeviceType != "" && spec.DeviceType != "netdevice" {
+			return fmt.Errorf("vdpaType %s requires netdevice devType", spec.VdpaType)
+		}
+	}
+
+	if spec.ExternallyManaged && spec.EswitchMode == srIovV1.ESwithModeSwitchDev {
+		return fmt.Errorf("externallyManaged is not supported with switchdev eSwitchMode")
+	}
+
+	return nil
+}
+
+// nodeStateReferencesPolicy returns true if one of the VF groups of the SriovNetworkNodeState belongs to the policy.
+func nodeStateReferencesPolicy(nodeState *srIovV1.SriovNetworkNodeState, policyName string) bool {
+	for _, iface := range nodeState.Spec.Interfaces {
+		for _, vfGroup := range iface.VfGroups {
+			if vfGroup.PolicyName == policyName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicyBuilder) validate() (bool, error) {
 	resourceCRD := "SriovNetworkNodePolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
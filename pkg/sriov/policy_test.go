@@ -1,11 +1,15 @@
 package sriov
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -206,7 +210,7 @@ func TestNewPolicyBuilder(t *testing.T) {
 			testCase.nicNames,
 			testCase.nodeSelector)
 		assert.NotNil(t, testPolicyStructure)
-		assert.Equal(t, testPolicyStructure.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testPolicyStructure.errorMsg)
 	}
 }
 
@@ -236,7 +240,7 @@ func TestPolicyWithDevType(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithDevType(testCase.devType)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, netBuilder.Definition.Spec.DeviceType, testCase.devType)
@@ -275,7 +279,7 @@ func TestPolicyVFRange(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithVFRange(testCase.firstVF, testCase.lastVF)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, fmt.Sprintf("%s#%d-%d", "eth1", testCase.firstVF, testCase.lastVF),
@@ -310,7 +314,7 @@ func TestPolicyWithMTU(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithMTU(testCase.mtu)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, testCase.mtu, netBuilder.Definition.Spec.Mtu)
@@ -334,7 +338,7 @@ func TestPolicyWithRDMA(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithRDMA(testCase.rdma)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg)
 		assert.Equal(t, testCase.rdma, netBuilder.Definition.Spec.IsRdma)
 	}
 }
@@ -355,7 +359,7 @@ func TestPolicyVhostNet(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithVhostNet(testCase.vhostNet)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg)
 		assert.Equal(t, testCase.vhostNet, netBuilder.Definition.Spec.NeedVhostNet)
 	}
 }
@@ -376,11 +380,158 @@ func TestPolicyWithExternallyManaged(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestClientWithDummyPolicyObject()
 		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithExternallyManaged(testCase.externallyManaged)
-		assert.Equal(t, netBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg)
 		assert.Equal(t, testCase.externallyManaged, netBuilder.Definition.Spec.ExternallyManaged)
 	}
 }
 
+func TestPolicyWithESwitchMode(t *testing.T) {
+	testCases := []struct {
+		eSwitchMode       string
+		expectedErrorText string
+	}{
+		{
+			eSwitchMode:       "legacy",
+			expectedErrorText: "",
+		},
+		{
+			eSwitchMode:       "switchdev",
+			expectedErrorText: "",
+		},
+		{
+			eSwitchMode:       "invalid",
+			expectedErrorText: "invalid eSwitchMode, allowed eSwitchMode values are: legacy or switchdev",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyPolicyObject()
+		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithESwitchMode(testCase.eSwitchMode)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg) {
+			assert.Equal(t, testCase.eSwitchMode, netBuilder.Definition.Spec.EswitchMode)
+		}
+	}
+}
+
+func TestPolicyWithVdpaType(t *testing.T) {
+	testCases := []struct {
+		vdpaType          string
+		expectedErrorText string
+	}{
+		{
+			vdpaType:          "virtio",
+			expectedErrorText: "",
+		},
+		{
+			vdpaType:          "vhost",
+			expectedErrorText: "",
+		},
+		{
+			vdpaType:          "invalid",
+			expectedErrorText: "invalid vdpaType, allowed vdpaType values are: virtio or vhost",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyPolicyObject()
+		netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithVdpaType(testCase.vdpaType)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, netBuilder.errorMsg) {
+			assert.Equal(t, testCase.vdpaType, netBuilder.Definition.Spec.VdpaType)
+		}
+	}
+}
+
+func TestPolicyVFRangeRedefined(t *testing.T) {
+	testSettings := buildTestClientWithDummyPolicyObject()
+	netBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithVFRange(0, 3).WithVFRange(4, 7)
+
+	assert.Nil(t, netBuilder.errorMsg)
+	assert.Equal(t, []string{"eth1#4-7"}, netBuilder.Definition.Spec.NicSelector.PfNames)
+}
+
+func TestPolicyCreateInvalidSpec(t *testing.T) {
+	testCases := []struct {
+		testPolicy        *PolicyBuilder
+		expectedErrorText string
+	}{
+		{
+			testPolicy: NewPolicyBuilder(clients.GetTestClients(clients.TestClientParams{}), defaultPolicyName,
+				defaultPolicyNsName, defaultPolicyResName, 8, defaultPolicyNICs, defaultPolicyNodeSelector).WithVFRange(0, 7),
+			expectedErrorText: "",
+		},
+		{
+			testPolicy: NewPolicyBuilder(clients.GetTestClients(clients.TestClientParams{}), defaultPolicyName,
+				defaultPolicyNsName, defaultPolicyResName, 8, defaultPolicyNICs, defaultPolicyNodeSelector).WithVFRange(4, 8),
+			expectedErrorText: "lastVF 8 in pfName eth1#4-8 must be lower than numVfs 8",
+		},
+		{
+			testPolicy: buildValidSriovPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithESwitchMode("switchdev").WithVdpaType("virtio"),
+			expectedErrorText: "",
+		},
+		{
+			testPolicy: buildValidSriovPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithVdpaType("virtio"),
+			expectedErrorText: "vdpaType virtio requires switchdev eSwitchMode",
+		},
+		{
+			testPolicy: buildValidSriovPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithESwitchMode("switchdev").WithVdpaType("vhost").WithDevType("vfio-pci"),
+			expectedErrorText: "vdpaType vhost requires netdevice devType",
+		},
+		{
+			testPolicy: buildValidSriovPolicyTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithESwitchMode("switchdev").WithExternallyManaged(true),
+			expectedErrorText: "externallyManaged is not supported with switchdev eSwitchMode",
+		},
+	}
+
+	for _, testCase := range testCases {
+		netBuilder, err := testCase.testPolicy.Create()
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedErrorText, err) {
+			assert.Equal(t, netBuilder.Definition, netBuilder.Object)
+		}
+	}
+}
+
+func TestPolicyWaitUntilApplied(t *testing.T) {
+	testCases := []struct {
+		nodeStates    []runtime.Object
+		expectedError error
+	}{
+		{
+			nodeStates: []runtime.Object{
+				buildDummyPolicyNodeState("node-1", defaultPolicyName, SyncStatusSucceeded),
+				buildDummyPolicyNodeState("node-2", "other-policy", SyncStatusInProgress),
+			},
+			expectedError: nil,
+		},
+		{
+			nodeStates: []runtime.Object{
+				buildDummyPolicyNodeState("node-1", defaultPolicyName, SyncStatusSucceeded),
+				buildDummyPolicyNodeState("node-2", defaultPolicyName, SyncStatusInProgress),
+			},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			nodeStates: []runtime.Object{
+				buildDummyPolicyNodeState("node-1", "other-policy", SyncStatusSucceeded),
+			},
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: testCase.nodeStates})
+
+		err := buildValidSriovPolicyTestBuilder(testSettings).WaitUntilApplied(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 func TestPolicyWithOptions(t *testing.T) {
 	testSettings := buildTestClientWithDummyObject()
 	testBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithOptions(
@@ -388,12 +539,12 @@ func TestPolicyWithOptions(t *testing.T) {
 			return builder, nil
 		})
 
-	assert.Equal(t, "", testBuilder.errorMsg)
+	assert.Nil(t, testBuilder.errorMsg)
 	testBuilder = buildValidSriovPolicyTestBuilder(testSettings).WithOptions(
 		func(builder *PolicyBuilder) (*PolicyBuilder, error) {
 			return builder, fmt.Errorf("error")
 		})
-	assert.Equal(t, "error", testBuilder.errorMsg)
+	assert.EqualError(t, testBuilder.errorMsg, "error")
 }
 
 func TestPolicyCreate(t *testing.T) {
@@ -407,7 +558,7 @@ func TestPolicyCreate(t *testing.T) {
 		},
 		{
 			testPolicy:    buildInvalidSriovPolicyTestBuilder(buildTestClientWithDummyPolicyObject()),
-			expectedError: fmt.Errorf("SriovNetworkNodePolicy 'nsname' cannot be empty"),
+			expectedError: errors.Join(fmt.Errorf("SriovNetworkNodePolicy 'nsname' cannot be empty")),
 		},
 	}
 
@@ -432,7 +583,7 @@ func TestPolicyDelete(t *testing.T) {
 		},
 		{
 			testPolicy:    buildInvalidSriovPolicyTestBuilder(buildTestClientWithDummyPolicyObject()),
-			expectedError: fmt.Errorf("SriovNetworkNodePolicy 'nsname' cannot be empty"),
+			expectedError: errors.Join(fmt.Errorf("SriovNetworkNodePolicy 'nsname' cannot be empty")),
 		},
 	}
 
@@ -491,6 +642,25 @@ func buildInvalidSriovPolicyTestBuilder(apiClient *clients.Settings) *PolicyBuil
 		defaultPolicyNodeSelector)
 }
 
+func buildDummyPolicyNodeState(nodeName, policyName, syncStatus string) *srIovV1.SriovNetworkNodeState {
+	return &srIovV1.SriovNetworkNodeState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeName,
+			Namespace: defaultPolicyNsName,
+		},
+		Spec: srIovV1.SriovNetworkNodeStateSpec{
+			Interfaces: srIovV1.Interfaces{{
+				Name:     defaultPolicyNICs[0],
+				NumVfs:   defaultPolicyVFNum,
+				VfGroups: []srIovV1.VfGroup{{PolicyName: policyName, ResourceName: defaultPolicyResName}},
+			}},
+		},
+		Status: srIovV1.SriovNetworkNodeStateStatus{
+			SyncStatus: syncStatus,
+		},
+	}
+}
+
 func buildTestClientWithDummyPolicyObject() *clients.Settings {
 	return clients.GetTestClients(clients.TestClientParams{
 		K8sMockObjects: buildDummySrIovPolicyObject(),
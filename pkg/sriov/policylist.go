@@ -4,21 +4,21 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ListPolicy returns SriovNetworkNodePolicies inventory in the given namespace.
 func ListPolicy(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*PolicyBuilder, error) {
 	if apiClient == nil {
-		glog.V(100).Infof("SriovNetworkNodePolicies 'apiClient' parameter can not be empty")
+		logging.V(100).Infof("SriovNetworkNodePolicies 'apiClient' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list SriovNetworkNodePolicies, 'apiClient' parameter is empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("SriovNetworkNodePolicies 'nsname' parameter can not be empty")
+		logging.V(100).Infof("SriovNetworkNodePolicies 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list SriovNetworkNodePolicies, 'nsname' parameter is empty")
 	}
@@ -27,7 +27,7 @@ func ListPolicy(apiClient *clients.Settings, nsname string, options ...metav1.Li
 	logMessage := fmt.Sprintf("Listing SriovNetworkNodePolicies in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -37,13 +37,13 @@ func ListPolicy(apiClient *clients.Settings, nsname string, options ...metav1.Li
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logging.V(100).Infof(logMessage)
 
 	networkNodePoliciesList, err := apiClient.ClientSrIov.SriovnetworkV1().
 		SriovNetworkNodePolicies(nsname).List(context.TODO(), passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkNodePolicies in the namespace %s due to %s",
+		logging.V(100).Infof("Failed to list SriovNetworkNodePolicies in the namespace %s due to %s",
 			nsname, err.Error())
 
 		return nil, err
@@ -67,10 +67,10 @@ func ListPolicy(apiClient *clients.Settings, nsname string, options ...metav1.Li
 // CleanAllNetworkNodePolicies removes all SriovNetworkNodePolicies that are not set as default.
 func CleanAllNetworkNodePolicies(
 	apiClient *clients.Settings, operatornsname string, options ...metav1.ListOptions) error {
-	glog.V(100).Infof("Cleaning up SriovNetworkNodePolicies in the %s namespace", operatornsname)
+	logging.V(100).Infof("Cleaning up SriovNetworkNodePolicies in the %s namespace", operatornsname)
 
 	if operatornsname == "" {
-		glog.V(100).Infof("'operatornsname' parameter can not be empty")
+		logging.V(100).Infof("'operatornsname' parameter can not be empty")
 
 		return fmt.Errorf("failed to clean up SriovNetworkNodePolicies, 'operatornsname' parameter is empty")
 	}
@@ -78,7 +78,7 @@ func CleanAllNetworkNodePolicies(
 	policies, err := ListPolicy(apiClient, operatornsname, options...)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkNodePolicies in namespace: %s", operatornsname)
+		logging.V(100).Infof("Failed to list SriovNetworkNodePolicies in namespace: %s", operatornsname)
 
 		return err
 	}
@@ -89,7 +89,7 @@ func CleanAllNetworkNodePolicies(
 			err = policy.Delete()
 
 			if err != nil {
-				glog.V(100).Infof("Failed to delete SriovNetworkNodePolicy: %s", policy.Object.Name)
+				logging.V(100).Infof("Failed to delete SriovNetworkNodePolicy: %s", policy.Object.Name)
 
 				return err
 			}
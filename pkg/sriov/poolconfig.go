@@ -7,7 +7,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
@@ -27,14 +27,14 @@ type PoolConfigBuilder struct {
 	Object *srIovV1.SriovNetworkPoolConfig
 	// Used in functions that define or mutate SriovNetworkPoolConfig definition.
 	// errorMsg is processed before the SriovNetworkPoolConfig object is created.
-	errorMsg string
+	errorMsg error
 	// apiClient opens api connection to the cluster.
 	apiClient *clients.Settings
 }
 
 // NewPoolConfigBuilder creates a new instance of PoolConfigBuilder.
 func NewPoolConfigBuilder(apiClient *clients.Settings, name, nsname string) *PoolConfigBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new SriovNetworkPoolConfig structure with the name %s in the namespace %s", name, nsname)
 
 	builder := PoolConfigBuilder{
@@ -47,15 +47,11 @@ func NewPoolConfigBuilder(apiClient *clients.Settings, name, nsname string) *Poo
 		}}
 
 	if name == "" {
-		builder.errorMsg = "SriovNetworkPoolConfig 'name' cannot be empty"
-
-		return &builder
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkPoolConfig 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "SriovNetworkPoolConfig 'nsname' cannot be empty"
-
-		return &builder
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SriovNetworkPoolConfig 'nsname' cannot be empty"))
 	}
 
 	return &builder
@@ -67,7 +63,7 @@ func (builder *PoolConfigBuilder) Create() (*PoolConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating the SriovNetworkPoolConfig %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
@@ -90,7 +86,7 @@ func (builder *PoolConfigBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the SriovNetworkPoolConfig object %s in namespace %s",
+	logging.V(100).Infof("Deleting the SriovNetworkPoolConfig object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -114,7 +110,7 @@ func (builder *PoolConfigBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if SriovNetworkPoolConfig %s exists in namespace %s",
+	logging.V(100).Infof("Checking if SriovNetworkPoolConfig %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -129,7 +125,7 @@ func (builder *PoolConfigBuilder) Get() (*srIovV1.SriovNetworkPoolConfig, error)
 		return nil, err
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Collecting SriovNetworkPoolConfig object %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
@@ -140,7 +136,7 @@ func (builder *PoolConfigBuilder) Get() (*srIovV1.SriovNetworkPoolConfig, error)
 	}, poolConfig)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to get SriovNetworkPoolConfig %s in namespace %s", builder.Definition.Name,
+		logging.V(100).Infof("Failed to get SriovNetworkPoolConfig %s in namespace %s", builder.Definition.Name,
 			builder.Definition.Namespace)
 
 		return nil, err
@@ -155,13 +151,19 @@ func (builder *PoolConfigBuilder) Update() (*PoolConfigBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the SriovNetworkPoolConfig object %s in namespace %s", builder.Definition.Name,
+	logging.V(100).Infof("Updating the SriovNetworkPoolConfig object %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
+	if !builder.Exists() {
+		return nil, fmt.Errorf("SriovNetworkPoolConfig cannot be updated because it does not exist")
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to update SriovNetworkPoolConfig %s in namespace %s", builder.Definition.Name,
+		logging.V(100).Infof("Failed to update SriovNetworkPoolConfig %s in namespace %s", builder.Definition.Name,
 			builder.Definition.Namespace)
 
 		return nil, err
@@ -178,12 +180,13 @@ func (builder *PoolConfigBuilder) WithNodeSelector(nodeSelector map[string]strin
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating SriovNetworkPoolConfig %s in namespace %s with Node selector: %v", builder.Definition.Name,
 		builder.Definition.Namespace, nodeSelector)
 
 	if len(nodeSelector) == 0 {
-		builder.errorMsg = "SriovNetworkPoolConfig 'nodeSelector' cannot be empty map"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"SriovNetworkPoolConfig 'nodeSelector' cannot be empty map"))
 
 		return builder
 	}
@@ -199,7 +202,7 @@ func (builder *PoolConfigBuilder) WithMaxUnavailable(maxUnavailable intstrutil.I
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Creating SriovNetworkPoolConfig %s in namespace %s with MaxUnavailable: %v", builder.Definition.Name,
 		builder.Definition.Namespace, maxUnavailable)
 
@@ -209,24 +212,26 @@ func (builder *PoolConfigBuilder) WithMaxUnavailable(maxUnavailable intstrutil.I
 
 			value, err := strconv.Atoi(i)
 			if err != nil {
-				builder.errorMsg = fmt.Sprintf("invalid value %q: %v", maxUnavailable.StrVal, err)
+				builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("invalid value %q: %v", maxUnavailable.StrVal, err))
 
 				return builder
 			}
 
 			if value > 100 || value < 1 {
-				builder.errorMsg = fmt.Sprintf("invalid value: percentage needs to be between 1 and 100: %v", maxUnavailable)
+				builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+					"invalid value: percentage needs to be between 1 and 100: %v", maxUnavailable))
 
 				return builder
 			}
 		} else {
-			builder.errorMsg = fmt.Sprintf("invalid type: strings needs to be a percentage: %v", maxUnavailable)
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"invalid type: strings needs to be a percentage: %v", maxUnavailable))
 
 			return builder
 		}
 	} else {
 		if maxUnavailable.IntValue() < 0 {
-			builder.errorMsg = fmt.Sprintf("negative number is not allowed: %v", maxUnavailable)
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("negative number is not allowed: %v", maxUnavailable))
 
 			return builder
 		}
@@ -239,10 +244,10 @@ func (builder *PoolConfigBuilder) WithMaxUnavailable(maxUnavailable intstrutil.I
 
 // PullPoolConfig pulls existing SriovNetworkPoolConfig from cluster.
 func PullPoolConfig(apiClient *clients.Settings, name, nsname string) (*PoolConfigBuilder, error) {
-	glog.V(100).Infof("Pulling existing SriovNetworkPoolConfig name %s under namespace %s from cluster", name, nsname)
+	logging.V(100).Infof("Pulling existing SriovNetworkPoolConfig name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is empty")
+		logging.V(100).Infof("The apiClient is empty")
 
 		return nil, fmt.Errorf("SriovNetworkPoolConfig 'apiClient' cannot be empty")
 	}
@@ -258,13 +263,13 @@ func PullPoolConfig(apiClient *clients.Settings, name, nsname string) (*PoolConf
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the SriovNetworkPoolConfig is empty")
+		logging.V(100).Infof("The name of the SriovNetworkPoolConfig is empty")
 
 		return nil, errors.New("SriovNetworkPoolConfig 'name' cannot be empty")
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the SriovNetworkPoolConfig is empty")
+		logging.V(100).Infof("The namespace of the SriovNetworkPoolConfig is empty")
 
 		return nil, errors.New("SriovNetworkPoolConfig 'namespace' cannot be empty")
 	}
@@ -284,27 +289,29 @@ func (builder *PoolConfigBuilder) validate() (bool, error) {
 	resourceCRD := "SriovNetworkPoolConfig"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
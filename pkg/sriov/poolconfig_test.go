@@ -1,10 +1,12 @@
 package sriov
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
@@ -50,7 +52,7 @@ func TestNewPoolConfigBuilder(t *testing.T) {
 		assert.NotNil(t, testPoolconfigStructure)
 
 		if len(testCase.expectedErrorText) > 0 {
-			assert.Equal(t, testPoolconfigStructure.errorMsg, testCase.expectedErrorText)
+			assert.EqualError(t, testPoolconfigStructure.errorMsg, testCase.expectedErrorText)
 		}
 	}
 }
@@ -66,7 +68,7 @@ func TestPooConfigCreate(t *testing.T) {
 		},
 		{
 			testPoolConfig: buildInvalidPoolConfigTestBuilder(buildTestPoolConfigClientWithDummyObject()),
-			expectedError:  fmt.Errorf("SriovNetworkPoolConfig 'nsname' cannot be empty"),
+			expectedError:  errors.Join(fmt.Errorf("SriovNetworkPoolConfig 'nsname' cannot be empty")),
 		},
 	}
 
@@ -91,7 +93,7 @@ func TestPooConfigDelete(t *testing.T) {
 		},
 		{
 			testPoolConfig: buildInvalidPoolConfigTestBuilder(buildTestPoolConfigClientWithDummyObject()),
-			expectedError:  fmt.Errorf("SriovNetworkPoolConfig 'nsname' cannot be empty"),
+			expectedError:  errors.Join(fmt.Errorf("SriovNetworkPoolConfig 'nsname' cannot be empty")),
 		},
 	}
 
@@ -137,7 +139,7 @@ func TestGet(t *testing.T) {
 		},
 		{
 			testPoolConfig: buildInvalidPoolConfigTestBuilder(buildTestPoolConfigClientWithDummyObject()),
-			expectedError:  fmt.Errorf("SriovNetworkPoolConfig 'nsname' cannot be empty"),
+			expectedError:  errors.Join(fmt.Errorf("SriovNetworkPoolConfig 'nsname' cannot be empty")),
 		},
 	}
 
@@ -175,6 +177,13 @@ func TestPoolConfigUpdate(t *testing.T) {
 	}
 }
 
+func TestPoolConfigUpdateNonExistent(t *testing.T) {
+	poolConfigBuilder, err := buildValidPoolConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithMaxUnavailable(intstr.FromInt32(1)).Update()
+	assert.EqualError(t, err, "SriovNetworkPoolConfig cannot be updated because it does not exist")
+	assert.Nil(t, poolConfigBuilder)
+}
+
 func TestWithNodeSelector(t *testing.T) {
 	testCases := []struct {
 		nodeSelector      map[string]string
@@ -193,7 +202,7 @@ func TestWithNodeSelector(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestPoolConfigClientWithDummyObject()
 		testBuilder := buildValidPoolConfigTestBuilder(testSettings).WithNodeSelector(testCase.nodeSelector)
-		assert.Equal(t, testBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, testBuilder.Definition.Spec.NodeSelector.MatchLabels, testCase.nodeSelector)
@@ -231,7 +240,7 @@ func TestWithMaxUnavailable(t *testing.T) {
 	for _, testCase := range testCases {
 		testSettings := buildTestPoolConfigClientWithDummyObject()
 		testBuilder := buildValidPoolConfigTestBuilder(testSettings).WithMaxUnavailable(testCase.maxUnavailable)
-		assert.Equal(t, testBuilder.errorMsg, testCase.expectedErrorText)
+		testhelper.AssertErrorMsg(t, testCase.expectedErrorText, testBuilder.errorMsg)
 
 		if testCase.expectedErrorText == "" {
 			assert.Equal(t, testBuilder.Definition.Spec.MaxUnavailable.IntVal, testCase.maxUnavailable.IntVal)
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -15,7 +15,7 @@ func ListPoolConfigs(apiClient *clients.Settings, namespace string) ([]*PoolConf
 	sriovNetworkPoolConfigList := &srIovV1.SriovNetworkPoolConfigList{}
 
 	if namespace == "" {
-		glog.V(100).Infof("sriovNetworkPoolConfigs 'namespace' parameter can not be empty")
+		logging.V(100).Infof("sriovNetworkPoolConfigs 'namespace' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list sriovNetworkPoolConfigs, 'namespace' parameter is empty")
 	}
@@ -23,7 +23,7 @@ func ListPoolConfigs(apiClient *clients.Settings, namespace string) ([]*PoolConf
 	err := apiClient.List(context.TODO(), sriovNetworkPoolConfigList, &client.ListOptions{Namespace: namespace})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkPoolConfigs in namespace: %s due to %s",
+		logging.V(100).Infof("Failed to list SriovNetworkPoolConfigs in namespace: %s due to %s",
 			namespace, err.Error())
 
 		return nil, err
@@ -48,10 +48,10 @@ func ListPoolConfigs(apiClient *clients.Settings, namespace string) ([]*PoolConf
 // CleanAllNonDefaultPoolConfigs removes all sriovNetworkPoolConfigs that are not set as default.
 func CleanAllNonDefaultPoolConfigs(
 	apiClient *clients.Settings, operatornsname string) error {
-	glog.V(100).Infof("Cleaning up SriovNetworkPoolConfigs in the %s namespace", operatornsname)
+	logging.V(100).Infof("Cleaning up SriovNetworkPoolConfigs in the %s namespace", operatornsname)
 
 	if operatornsname == "" {
-		glog.V(100).Infof("'operatornsname' parameter can not be empty")
+		logging.V(100).Infof("'operatornsname' parameter can not be empty")
 
 		return fmt.Errorf("failed to clean up SriovNetworkPoolConfigs, 'operatornsname' parameter is empty")
 	}
@@ -59,7 +59,7 @@ func CleanAllNonDefaultPoolConfigs(
 	poolConfigs, err := ListPoolConfigs(apiClient, operatornsname)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list SriovNetworkPoolConfigs in namespace: %s", operatornsname)
+		logging.V(100).Infof("Failed to list SriovNetworkPoolConfigs in namespace: %s", operatornsname)
 
 		return err
 	}
@@ -70,7 +70,7 @@ func CleanAllNonDefaultPoolConfigs(
 			err = poolConfig.Delete()
 
 			if err != nil {
-				glog.V(100).Infof("Failed to delete SriovNetworkPoolConfigs: %s", poolConfig.Object.Name)
+				logging.V(100).Infof("Failed to delete SriovNetworkPoolConfigs: %s", poolConfig.Object.Name)
 
 				return err
 			}
@@ -2,11 +2,13 @@ package statefulset
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -24,8 +26,10 @@ type Builder struct {
 	Object *appsv1.StatefulSet
 	// Used in functions that define or mutate statefulset definition. errorMsg is processed before the statefulset
 	// object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
+	// Whether Create and Delete are sent as server-side dry-run requests.
+	dryRun bool
 }
 
 // AdditionalOptions additional options for StatefulSet object.
@@ -38,7 +42,7 @@ func NewBuilder(
 	nsname string,
 	labels map[string]string,
 	containerSpec *corev1.Container) *Builder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new statefulset structure with the following params: "+
 			"name: %s, namespace: %s, labels: %s, containerSpec %v",
 		name, nsname, labels, containerSpec)
@@ -66,21 +70,21 @@ func NewBuilder(
 	builder.WithAdditionalContainerSpecs([]corev1.Container{*containerSpec})
 
 	if name == "" {
-		glog.V(100).Infof("The name of the statefulset is empty")
+		logging.V(100).Infof("The name of the statefulset is empty")
 
-		builder.errorMsg = "statefulset 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("statefulset 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the statefulset is empty")
+		logging.V(100).Infof("The namespace of the statefulset is empty")
 
-		builder.errorMsg = "statefulset 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("statefulset 'namespace' cannot be empty"))
 	}
 
 	if labels == nil {
-		glog.V(100).Infof("There are no labels for the statefulset")
+		logging.V(100).Infof("There are no labels for the statefulset")
 
-		builder.errorMsg = "statefulset 'labels' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("statefulset 'labels' cannot be empty"))
 	}
 
 	return &builder
@@ -92,16 +96,16 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 		return builder
 	}
 
-	glog.V(100).Infof("Appending a list of container specs %v to statefulset %s in namespace %s",
+	logging.V(100).Infof("Appending a list of container specs %v to statefulset %s in namespace %s",
 		specs, builder.Definition.Name, builder.Definition.Namespace)
 
 	if specs == nil {
-		glog.V(100).Infof("The container specs are empty")
+		logging.V(100).Infof("The container specs are empty")
 
-		builder.errorMsg = "cannot accept nil or empty list as container specs"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("cannot accept nil or empty list as container specs"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -116,22 +120,158 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 	return builder
 }
 
+// WithReplicas sets the desired number of replicas in the statefulset definition.
+func (builder *Builder) WithReplicas(replicas int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting %d replicas in statefulset %s in namespace %s",
+		replicas, builder.Definition.Name, builder.Definition.Namespace)
+
+	if replicas < 0 {
+		logging.V(100).Infof("The number of statefulset replicas is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("statefulset 'replicas' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Replicas = &replicas
+
+	return builder
+}
+
+// WithVolumeClaimTemplate appends a PersistentVolumeClaim template to the statefulset definition. Every pod of the
+// statefulset gets its own claim created from the template.
+func (builder *Builder) WithVolumeClaimTemplate(claimTemplate corev1.PersistentVolumeClaim) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding volumeClaimTemplate %s to statefulset %s in namespace %s",
+		claimTemplate.Name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if claimTemplate.Name == "" {
+		logging.V(100).Infof("The name of the volumeClaimTemplate is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("volumeClaimTemplate 'name' cannot be empty"))
+
+		return builder
+	}
+
+	for _, existingTemplate := range builder.Definition.Spec.VolumeClaimTemplates {
+		if existingTemplate.Name == claimTemplate.Name {
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"volumeClaimTemplate %s is already defined in statefulset", claimTemplate.Name))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.VolumeClaimTemplates = append(builder.Definition.Spec.VolumeClaimTemplates, claimTemplate)
+
+	return builder
+}
+
+// WithPodManagementPolicy sets whether the statefulset pods are created and deleted one by one or all at once.
+func (builder *Builder) WithPodManagementPolicy(policy appsv1.PodManagementPolicyType) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting podManagementPolicy %s in statefulset %s in namespace %s",
+		policy, builder.Definition.Name, builder.Definition.Namespace)
+
+	if policy != appsv1.OrderedReadyPodManagement && policy != appsv1.ParallelPodManagement {
+		logging.V(100).Infof("The podManagementPolicy %s is not supported", policy)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"invalid podManagementPolicy %s, supported values are %s and %s",
+			policy, appsv1.OrderedReadyPodManagement, appsv1.ParallelPodManagement))
+
+		return builder
+	}
+
+	builder.Definition.Spec.PodManagementPolicy = policy
+
+	return builder
+}
+
+// WithPVCRetentionPolicy sets what happens to the claims created from the volumeClaimTemplates when the statefulset
+// is deleted and when it is scaled down.
+func (builder *Builder) WithPVCRetentionPolicy(
+	whenDeleted, whenScaled appsv1.PersistentVolumeClaimRetentionPolicyType) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting PVC retention policy whenDeleted %s whenScaled %s in statefulset %s in namespace %s",
+		whenDeleted, whenScaled, builder.Definition.Name, builder.Definition.Namespace)
+
+	for _, policy := range []appsv1.PersistentVolumeClaimRetentionPolicyType{whenDeleted, whenScaled} {
+		if policy != appsv1.RetainPersistentVolumeClaimRetentionPolicyType &&
+			policy != appsv1.DeletePersistentVolumeClaimRetentionPolicyType {
+			logging.V(100).Infof("The PVC retention policy %s is not supported", policy)
+
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+				"invalid PVC retention policy %s, supported values are %s and %s", policy,
+				appsv1.RetainPersistentVolumeClaimRetentionPolicyType, appsv1.DeletePersistentVolumeClaimRetentionPolicyType))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: whenDeleted,
+		WhenScaled:  whenScaled,
+	}
+
+	return builder
+}
+
+// WithRollingUpdatePartition sets the partition of the statefulset rolling update. Only pods with an ordinal greater
+// than or equal to the partition are updated when the pod template changes.
+func (builder *Builder) WithRollingUpdatePartition(partition int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting rolling update partition %d in statefulset %s in namespace %s",
+		partition, builder.Definition.Name, builder.Definition.Namespace)
+
+	if partition < 0 {
+		logging.V(100).Infof("The rolling update partition is negative")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("rolling update 'partition' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+	}
+
+	return builder
+}
+
 // WithOptions creates StatefulSet with generic mutation options.
 func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting StatefulSet additional options")
+	logging.V(100).Infof("Setting StatefulSet additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -143,7 +283,7 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // Pull loads an existing statefulset into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing statefulset name: %s under namespace: %s", name, nsname)
+	logging.V(100).Infof("Pulling existing statefulset name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -156,11 +296,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	if name == "" {
-		builder.errorMsg = "statefulset 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("statefulset 'name' cannot be empty"))
 	}
 
 	if nsname == "" {
-		builder.errorMsg = "statefulset 'namespace' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("statefulset 'namespace' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -178,10 +318,14 @@ func (builder *Builder) Create() (*Builder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating statefulset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Creating statefulset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	if !builder.Exists() {
+		if builder.dryRun {
+			return builder, builder.CreateDryRun()
+		}
+
 		builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Create(
 			context.TODO(), builder.Definition, metav1.CreateOptions{})
 	}
@@ -189,13 +333,45 @@ func (builder *Builder) Create() (*Builder, error) {
 	return builder, err
 }
 
+// WithDryRun sets whether Create and Delete are sent as server-side dry-run requests. Dry-run requests are checked by
+// the API server and the admission webhooks but are never persisted.
+func (builder *Builder) WithDryRun(dryRun bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting dry-run to %t for statefulset %s in namespace %s",
+		dryRun, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.dryRun = dryRun
+
+	return builder
+}
+
+// CreateDryRun checks the statefulset definition against the API server and its admission webhooks with a server-side
+// dry-run request. The statefulset is not created and the builder Object is not modified.
+func (builder *Builder) CreateDryRun() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof(
+		"Creating the statefulset %s in namespace %s in dry-run mode", builder.Definition.Name, builder.Definition.Namespace)
+
+	_, err := builder.apiClient.StatefulSets(builder.Definition.Namespace).Create(
+		context.TODO(), builder.Definition, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+
+	return err
+}
+
 // Exists checks whether the given statefulset exists.
 func (builder *Builder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if statefulset %s exists in namespace %s",
+	logging.V(100).Infof("Checking if statefulset %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -211,7 +387,7 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 		return false
 	}
 
-	glog.V(100).Infof("Running periodic check until statefulset %s in namespace %s is ready",
+	logging.V(100).Infof("Running periodic check until statefulset %s in namespace %s is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -238,38 +414,164 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 	return err == nil
 }
 
+// WaitUntilReady waits for the duration of the defined timeout or until the latest generation of the statefulset is
+// observed, every replica is ready and the rolling update is complete. With a partitioned rolling update only the
+// pods with an ordinal greater than or equal to the partition need to be updated.
+func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until statefulset %s in namespace %s is ready",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return fmt.Errorf("cannot wait for statefulset to be ready because it does not exist")
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+			builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Get(
+				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+			if err != nil {
+				return false, nil
+			}
+
+			return isReady(builder.Object), nil
+		})
+}
+
+// Scale sets the desired number of replicas of the statefulset on the cluster. When waitForReady is true it also
+// waits for the duration of the defined timeout or until the statefulset is ready with the new number of replicas.
+// Pods are added and removed in ordinal order unless the podManagementPolicy is Parallel.
+func (builder *Builder) Scale(replicas int32, waitForReady bool, timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Scaling statefulset %s in namespace %s to %d replicas",
+		builder.Definition.Name, builder.Definition.Namespace, replicas)
+
+	if replicas < 0 {
+		return builder, fmt.Errorf("cannot scale statefulset to negative number of replicas %d", replicas)
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot scale statefulset %s in namespace %s because it does not exist",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Object.Spec.Replicas = &replicas
+
+	var err error
+	builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Object, metav1.UpdateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to scale statefulset %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Definition = builder.Object
+
+	if !waitForReady {
+		return builder, nil
+	}
+
+	return builder, builder.WaitUntilReady(timeout)
+}
+
 // GetGVR returns pod's GroupVersionResource which could be used for Clean function.
 func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}
 }
 
+// GetGVR returns the statefulset GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// isReady checks the statefulset status the same way kubectl rollout status does.
+func isReady(statefulSet *appsv1.StatefulSet) bool {
+	if statefulSet.Generation > statefulSet.Status.ObservedGeneration {
+		return false
+	}
+
+	desiredReplicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		desiredReplicas = *statefulSet.Spec.Replicas
+	}
+
+	status := statefulSet.Status
+
+	if status.ReadyReplicas != desiredReplicas || status.Replicas != desiredReplicas {
+		return false
+	}
+
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return true
+	}
+
+	rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate != nil && rollingUpdate.Partition != nil && *rollingUpdate.Partition > 0 {
+		return status.UpdatedReplicas >= desiredReplicas-*rollingUpdate.Partition
+	}
+
+	return status.UpdateRevision == status.CurrentRevision
+}
+
+// Diff compares the statefulset definition with the statefulset object on the cluster. Only the fields set in the
+// definition are compared, so fields populated by the API server are ignored.
+func (builder *Builder) Diff() (manifest.Differences, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof(
+		"Comparing statefulset %s in namespace %s with its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf(
+			"statefulset object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return manifest.Diff(builder.Definition, builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "StatefulSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
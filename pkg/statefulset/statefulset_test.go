@@ -1 +1,280 @@
 package statefulset
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var (
+	defaultStatefulSetName      = "test-statefulset"
+	defaultStatefulSetNamespace = "test-namespace"
+)
+
+func TestStatefulSetWithVolumeClaimTemplate(t *testing.T) {
+	testCases := []struct {
+		claimNames    []string
+		expectedError string
+	}{
+		{
+			claimNames:    []string{"data"},
+			expectedError: "",
+		},
+		{
+			claimNames:    []string{"data", "logs"},
+			expectedError: "",
+		},
+		{
+			claimNames:    []string{""},
+			expectedError: "volumeClaimTemplate 'name' cannot be empty",
+		},
+		{
+			claimNames:    []string{"data", "data"},
+			expectedError: "volumeClaimTemplate data is already defined in statefulset",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidStatefulSetBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		for _, claimName := range testCase.claimNames {
+			testBuilder = testBuilder.WithVolumeClaimTemplate(
+				corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: claimName}})
+		}
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Len(t, testBuilder.Definition.Spec.VolumeClaimTemplates, len(testCase.claimNames))
+	}
+}
+
+func TestStatefulSetWithPodManagementPolicy(t *testing.T) {
+	testCases := []struct {
+		policy        appsv1.PodManagementPolicyType
+		expectedError string
+	}{
+		{
+			policy:        appsv1.ParallelPodManagement,
+			expectedError: "",
+		},
+		{
+			policy:        appsv1.OrderedReadyPodManagement,
+			expectedError: "",
+		},
+		{
+			policy:        "Random",
+			expectedError: "invalid podManagementPolicy Random, supported values are OrderedReady and Parallel",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidStatefulSetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithPodManagementPolicy(testCase.policy)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.policy, testBuilder.Definition.Spec.PodManagementPolicy)
+	}
+}
+
+func TestStatefulSetWithPVCRetentionPolicy(t *testing.T) {
+	testCases := []struct {
+		whenDeleted   appsv1.PersistentVolumeClaimRetentionPolicyType
+		whenScaled    appsv1.PersistentVolumeClaimRetentionPolicyType
+		expectedError string
+	}{
+		{
+			whenDeleted:   appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			whenScaled:    appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+			expectedError: "",
+		},
+		{
+			whenDeleted:   "Keep",
+			whenScaled:    appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+			expectedError: "invalid PVC retention policy Keep, supported values are Retain and Delete",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidStatefulSetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithPVCRetentionPolicy(testCase.whenDeleted, testCase.whenScaled)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.Equal(t, testCase.whenDeleted,
+			testBuilder.Definition.Spec.PersistentVolumeClaimRetentionPolicy.WhenDeleted)
+		assert.Equal(t, testCase.whenScaled,
+			testBuilder.Definition.Spec.PersistentVolumeClaimRetentionPolicy.WhenScaled)
+	}
+}
+
+func TestStatefulSetWithReplicasAndPartition(t *testing.T) {
+	testBuilder := buildValidStatefulSetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithReplicas(3).
+		WithRollingUpdatePartition(2)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, int32(3), *testBuilder.Definition.Spec.Replicas)
+	assert.Equal(t, int32(2), *testBuilder.Definition.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	testBuilder = buildValidStatefulSetBuilder(clients.GetTestClients(clients.TestClientParams{})).WithReplicas(-1)
+	assert.EqualError(t, testBuilder.errorMsg, "statefulset 'replicas' cannot be negative")
+
+	testBuilder = buildValidStatefulSetBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithRollingUpdatePartition(-1)
+	assert.EqualError(t, testBuilder.errorMsg, "rolling update 'partition' cannot be negative")
+}
+
+func TestStatefulSetWaitUntilReady(t *testing.T) {
+	testCases := []struct {
+		partition     *int32
+		status        appsv1.StatefulSetStatus
+		expectedError error
+	}{
+		{
+			status: appsv1.StatefulSetStatus{
+				Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 3, CurrentRevision: "rev-2", UpdateRevision: "rev-2"},
+			expectedError: nil,
+		},
+		{
+			status: appsv1.StatefulSetStatus{
+				Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 1, CurrentRevision: "rev-1", UpdateRevision: "rev-2"},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			partition: ptr.To[int32](2),
+			status: appsv1.StatefulSetStatus{
+				Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 1, CurrentRevision: "rev-1", UpdateRevision: "rev-2"},
+			expectedError: nil,
+		},
+		{
+			status:        appsv1.StatefulSetStatus{Replicas: 3, ReadyReplicas: 2},
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testStatefulSet := buildDummyStatefulSet(3)
+		testStatefulSet.Status = testCase.status
+
+		if testCase.partition != nil {
+			testStatefulSet.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{
+				Partition: testCase.partition}
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{testStatefulSet}})
+
+		err := buildValidStatefulSetBuilder(testSettings).WaitUntilReady(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestStatefulSetScale(t *testing.T) {
+	testCases := []struct {
+		replicas      int32
+		exists        bool
+		expectedError string
+	}{
+		{
+			replicas:      5,
+			exists:        true,
+			expectedError: "",
+		},
+		{
+			replicas:      -1,
+			exists:        true,
+			expectedError: "cannot scale statefulset to negative number of replicas -1",
+		},
+		{
+			replicas: 5,
+			exists:   false,
+			expectedError: "cannot scale statefulset test-statefulset in namespace test-namespace " +
+				"because it does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyStatefulSet(3))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := buildValidStatefulSetBuilder(testSettings).Scale(testCase.replicas, false, time.Second)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testCase.replicas, *testBuilder.Object.Spec.Replicas)
+	}
+}
+
+func buildValidStatefulSetBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultStatefulSetName, defaultStatefulSetNamespace,
+		map[string]string{"app": "test"}, &corev1.Container{Name: "test-container"})
+}
+
+func buildDummyStatefulSet(replicas int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultStatefulSetName,
+			Namespace: defaultStatefulSetNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+		},
+	}
+}
+
+func TestStatefulSetDiff(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testBuilder := buildValidStatefulSetBuilder(testSettings)
+
+	_, err := testBuilder.Diff()
+	assert.EqualError(t, err, fmt.Sprintf("statefulset object %s doesn't exist in namespace %s",
+		testBuilder.Definition.Name, testBuilder.Definition.Namespace))
+
+	testBuilder, err = testBuilder.Create()
+	assert.Nil(t, err)
+
+	differences, err := testBuilder.Diff()
+	assert.Nil(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestStatefulSetWithDryRun(t *testing.T) {
+	var dryRunActions []string
+
+	dryRunReactor := clients.TestReactor{
+		Verb: "*", Resource: "statefulsets", Reaction: testhelper.DryRunReaction(&dryRunActions)}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: []clients.TestReactor{dryRunReactor}})
+	testBuilder := buildValidStatefulSetBuilder(testSettings).
+		WithDryRun(true)
+	assert.True(t, testBuilder.dryRun)
+
+	_, err := testBuilder.Create()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Exists())
+	assert.Equal(t, []string{"create"}, dryRunActions)
+}
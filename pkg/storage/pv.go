@@ -2,14 +2,18 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // PVBuilder provides struct for persistentvolume object containing connection
@@ -19,13 +23,359 @@ type PVBuilder struct {
 	Definition *corev1.PersistentVolume
 	// Created persistentvolume object
 	Object *corev1.PersistentVolume
-
+	// Used in functions that define or mutate persistentvolume definition. errorMsg is processed before the
+	// persistentvolume object is created.
+	errorMsg  error
 	apiClient *clients.Settings
 }
 
+// NewPVBuilder creates a new instance of PVBuilder for a statically provisioned persistentvolume of the given
+// capacity, e.g. "10Gi". A volume source must be set with one of the With*Source methods before creating it.
+func NewPVBuilder(apiClient *clients.Settings, name, capacity string) *PVBuilder {
+	logging.V(100).Infof(
+		"Initializing new persistentvolume structure with the following params: name: %s, capacity: %s",
+		name, capacity)
+
+	builder := PVBuilder{
+		apiClient: apiClient,
+		Definition: &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the persistentvolume is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("persistentvolume 'name' cannot be empty"))
+	}
+
+	quantity, err := resource.ParseQuantity(capacity)
+	if err != nil {
+		logging.V(100).Infof("The capacity %s of the persistentvolume is invalid: %v", capacity, err)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("persistentvolume 'capacity' %s is invalid: %w", capacity, err))
+
+		return &builder
+	}
+
+	builder.Definition.Spec.Capacity = corev1.ResourceList{corev1.ResourceStorage: quantity}
+
+	return &builder
+}
+
+// WithAccessModes appends access modes to the persistentvolume definition.
+func (builder *PVBuilder) WithAccessModes(accessModes ...corev1.PersistentVolumeAccessMode) *PVBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding accessModes %v to persistentvolume %s", accessModes, builder.Definition.Name)
+
+	if len(accessModes) == 0 {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("persistentvolume 'accessModes' cannot be empty"))
+
+		return builder
+	}
+
+	for _, accessMode := range accessModes {
+		if !validatePVCAccessMode(string(accessMode)) {
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("persistentvolume accessMode %s is not supported", accessMode))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.AccessModes = append(builder.Definition.Spec.AccessModes, accessModes...)
+
+	return builder
+}
+
+// WithStorageClass sets the storageclass the persistentvolume belongs to, so that only claims requesting it bind.
+func (builder *PVBuilder) WithStorageClass(storageClass string) *PVBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting storageClass %s in persistentvolume %s", storageClass, builder.Definition.Name)
+
+	if storageClass == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("persistentvolume 'storageClass' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.StorageClassName = storageClass
+
+	return builder
+}
+
+// WithReclaimPolicy sets what happens to the persistentvolume once released from its claim.
+func (builder *PVBuilder) WithReclaimPolicy(reclaimPolicy corev1.PersistentVolumeReclaimPolicy) *PVBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting reclaimPolicy %s in persistentvolume %s", reclaimPolicy, builder.Definition.Name)
+
+	err := validateReclaimPolicy(reclaimPolicy)
+	if err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.PersistentVolumeReclaimPolicy = reclaimPolicy
+
+	return builder
+}
+
+// WithVolumeMode sets whether the persistentvolume is consumed as a Filesystem or a raw Block device.
+func (builder *PVBuilder) WithVolumeMode(volumeMode corev1.PersistentVolumeMode) *PVBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting volumeMode %s in persistentvolume %s", volumeMode, builder.Definition.Name)
+
+	if !validateVolumeMode(string(volumeMode)) {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("persistentvolume volumeMode %s is not supported", volumeMode))
+
+		return builder
+	}
+
+	builder.Definition.Spec.VolumeMode = &volumeMode
+
+	return builder
+}
+
+// WithLocalSource backs the persistentvolume by a local disk or directory of the given node. The persistentvolume
+// gets a node affinity pinning its consumers to the node.
+func (builder *PVBuilder) WithLocalSource(path, nodeName string) *PVBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting local source %s on node %s in persistentvolume %s",
+		path, nodeName, builder.Definition.Name)
+
+	if path == "" || nodeName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("persistentvolume local source 'path' and 'nodeName' cannot be empty"))
+
+		return builder
+	}
+
+	if !builder.setVolumeSource(corev1.PersistentVolumeSource{Local: &corev1.LocalVolumeSource{Path: path}}) {
+		return builder
+	}
+
+	builder.Definition.Spec.NodeAffinity = &corev1.VolumeNodeAffinity{
+		Required: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key:      corev1.LabelHostname,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{nodeName},
+				}},
+			}},
+		},
+	}
+
+	return builder
+}
+
+// WithNFSSource backs the persistentvolume by the given export of an NFS server.
+func (builder *PVBuilder) WithNFSSource(server, path string, readOnly bool) *PVBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting NFS source %s:%s in persistentvolume %s", server, path, builder.Definition.Name)
+
+	if server == "" || path == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("persistentvolume NFS source 'server' and 'path' cannot be empty"))
+
+		return builder
+	}
+
+	builder.setVolumeSource(corev1.PersistentVolumeSource{
+		NFS: &corev1.NFSVolumeSource{Server: server, Path: path, ReadOnly: readOnly},
+	})
+
+	return builder
+}
+
+// WithHostPathSource backs the persistentvolume by a path on the host of the pod consuming it. It is only suitable
+// for single node clusters and tests.
+func (builder *PVBuilder) WithHostPathSource(path string, pathType corev1.HostPathType) *PVBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting hostPath source %s in persistentvolume %s", path, builder.Definition.Name)
+
+	if path == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("persistentvolume hostPath source 'path' cannot be empty"))
+
+		return builder
+	}
+
+	builder.setVolumeSource(corev1.PersistentVolumeSource{
+		HostPath: &corev1.HostPathVolumeSource{Path: path, Type: &pathType},
+	})
+
+	return builder
+}
+
+// Create generates a persistentvolume in cluster and stores the created object in struct.
+func (builder *PVBuilder) Create() (*PVBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating persistentvolume %s", builder.Definition.Name)
+
+	if builder.Definition.Spec.PersistentVolumeSource == (corev1.PersistentVolumeSource{}) {
+		return builder, fmt.Errorf("persistentvolume %s must have a volume source", builder.Definition.Name)
+	}
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.PersistentVolumes().Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing persistentvolume object with the persistentvolume definition in builder.
+func (builder *PVBuilder) Update() (*PVBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating persistentvolume %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent persistentvolume %s", builder.Definition.Name)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	var err error
+	builder.Object, err = builder.apiClient.PersistentVolumes().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the persistentvolume from the cluster.
+func (builder *PVBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting persistentvolume %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.PersistentVolumes().Delete(context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// SetReclaimPolicy changes the reclaim policy of the existing persistentvolume on the cluster, e.g. to Retain a
+// dynamically provisioned volume before deleting its claim.
+func (builder *PVBuilder) SetReclaimPolicy(reclaimPolicy corev1.PersistentVolumeReclaimPolicy) (*PVBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Setting reclaimPolicy of persistentvolume %s to %s", builder.Definition.Name, reclaimPolicy)
+
+	err := validateReclaimPolicy(reclaimPolicy)
+	if err != nil {
+		return builder, err
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("persistentvolume object %s doesn't exist", builder.Definition.Name)
+	}
+
+	builder.Definition = builder.Object
+	builder.Definition.Spec.PersistentVolumeReclaimPolicy = reclaimPolicy
+
+	builder.Object, err = builder.apiClient.PersistentVolumes().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// MakeAvailable removes the reference to the deleted claim from a Released persistentvolume, so that it becomes
+// Available again and can be bound by a new claim. Use WaitUntilPhase to wait for the transition.
+func (builder *PVBuilder) MakeAvailable() (*PVBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Making persistentvolume %s available", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("persistentvolume object %s doesn't exist", builder.Definition.Name)
+	}
+
+	if builder.Object.Status.Phase != corev1.VolumeReleased {
+		return builder, fmt.Errorf("persistentvolume %s cannot be made available from phase %s, must be %s",
+			builder.Definition.Name, builder.Object.Status.Phase, corev1.VolumeReleased)
+	}
+
+	builder.Definition = builder.Object
+	builder.Definition.Spec.ClaimRef = nil
+
+	var err error
+	builder.Object, err = builder.apiClient.PersistentVolumes().Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// WaitUntilPhase waits for the defined period until the persistentvolume reaches the given phase.
+func (builder *PVBuilder) WaitUntilPhase(phase corev1.PersistentVolumePhase, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until persistentvolume %s is in phase %s", builder.Definition.Name, phase)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			return builder.Object.Status.Phase == phase, nil
+		})
+}
+
 // PullPersistentVolume gets an existing PersistentVolume from the cluster.
 func PullPersistentVolume(apiClient *clients.Settings, persistentVolume string) (*PVBuilder, error) {
-	glog.V(100).Infof("Pulling existing PersistentVolume object: %s", persistentVolume)
+	logging.V(100).Infof("Pulling existing PersistentVolume object: %s", persistentVolume)
 
 	builder := PVBuilder{
 		apiClient: apiClient,
@@ -51,7 +401,7 @@ func (builder *PVBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if PersistentVolume %s exists", builder.Definition.Name)
+	logging.V(100).Infof("Checking if PersistentVolume %s exists", builder.Definition.Name)
 
 	var err error
 	builder.Object, err = builder.apiClient.PersistentVolumes().Get(
@@ -66,22 +416,56 @@ func (builder *PVBuilder) validate() (bool, error) {
 	resourceCRD := "PersistentVolume"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
+	if builder.errorMsg != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, builder.errorMsg
+	}
+
 	return true, nil
 }
+
+// setVolumeSource sets the source of the persistentvolume, failing if another source was already set since a
+// persistentvolume must have exactly one.
+func (builder *PVBuilder) setVolumeSource(source corev1.PersistentVolumeSource) bool {
+	if builder.Definition.Spec.PersistentVolumeSource != (corev1.PersistentVolumeSource{}) {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("persistentvolume %s already has a volume source", builder.Definition.Name))
+
+		return false
+	}
+
+	builder.Definition.Spec.PersistentVolumeSource = source
+
+	return true
+}
+
+// validateReclaimPolicy checks that the reclaim policy is one accepted by the API server.
+func validateReclaimPolicy(reclaimPolicy corev1.PersistentVolumeReclaimPolicy) error {
+	switch reclaimPolicy {
+	case corev1.PersistentVolumeReclaimRetain, corev1.PersistentVolumeReclaimDelete,
+		corev1.PersistentVolumeReclaimRecycle:
+		return nil
+	default:
+		return fmt.Errorf("persistentvolume reclaimPolicy %s is not supported, must be %s, %s or %s", reclaimPolicy,
+			corev1.PersistentVolumeReclaimRetain, corev1.PersistentVolumeReclaimDelete,
+			corev1.PersistentVolumeReclaimRecycle)
+	}
+}
@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultPVName = "test-pv"
+
+func TestNewPVBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		capacity      string
+		expectedError string
+	}{
+		{
+			name:          defaultPVName,
+			capacity:      "10Gi",
+			expectedError: "",
+		},
+		{
+			name:          "",
+			capacity:      "10Gi",
+			expectedError: "persistentvolume 'name' cannot be empty",
+		},
+		{
+			name:          defaultPVName,
+			capacity:      "ten",
+			expectedError: "persistentvolume 'capacity' ten is invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewPVBuilder(clients.GetTestClients(clients.TestClientParams{}), testCase.name, testCase.capacity)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, testBuilder.errorMsg)
+			assert.Equal(t, testCase.capacity, testBuilder.Definition.Spec.Capacity.Storage().String())
+		} else {
+			assert.ErrorContains(t, testBuilder.errorMsg, testCase.expectedError)
+		}
+	}
+}
+
+func TestPVWithSources(t *testing.T) {
+	testBuilder := buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithLocalSource("/mnt/local-storage/disk1", "worker-0")
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "/mnt/local-storage/disk1", testBuilder.Definition.Spec.Local.Path)
+	assert.Equal(t, []string{"worker-0"},
+		testBuilder.Definition.Spec.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions[0].Values)
+
+	testBuilder = buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithNFSSource("nfs.example.com", "/exports/test", false)
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "nfs.example.com", testBuilder.Definition.Spec.NFS.Server)
+
+	testBuilder = buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithHostPathSource("/var/test", corev1.HostPathDirectoryOrCreate).
+		WithNFSSource("nfs.example.com", "/exports/test", false)
+	assert.EqualError(t, testBuilder.errorMsg, "persistentvolume test-pv already has a volume source")
+
+	testBuilder = buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithLocalSource("/mnt/local-storage/disk1", "")
+	assert.EqualError(t, testBuilder.errorMsg, "persistentvolume local source 'path' and 'nodeName' cannot be empty")
+}
+
+func TestPVWithSpecMutators(t *testing.T) {
+	testBuilder := buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithAccessModes(corev1.ReadWriteOnce).
+		WithStorageClass("local").
+		WithReclaimPolicy(corev1.PersistentVolumeReclaimRetain).
+		WithVolumeMode(corev1.PersistentVolumeBlock)
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, testBuilder.Definition.Spec.AccessModes)
+	assert.Equal(t, "local", testBuilder.Definition.Spec.StorageClassName)
+	assert.Equal(t, corev1.PersistentVolumeReclaimRetain, testBuilder.Definition.Spec.PersistentVolumeReclaimPolicy)
+	assert.Equal(t, corev1.PersistentVolumeBlock, *testBuilder.Definition.Spec.VolumeMode)
+
+	testBuilder = buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{})).WithReclaimPolicy("Keep")
+	assert.EqualError(t, testBuilder.errorMsg,
+		"persistentvolume reclaimPolicy Keep is not supported, must be Retain, Delete or Recycle")
+
+	testBuilder = buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{})).WithAccessModes("WriteMany")
+	assert.EqualError(t, testBuilder.errorMsg, "persistentvolume accessMode WriteMany is not supported")
+}
+
+func TestPVCreateAndDelete(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	_, err := buildValidPVBuilder(testSettings).Create()
+	assert.EqualError(t, err, "persistentvolume test-pv must have a volume source")
+
+	testBuilder, err := buildValidPVBuilder(testSettings).WithNFSSource("nfs.example.com", "/exports", false).Create()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Exists())
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+	assert.False(t, testBuilder.Exists())
+}
+
+func TestPVReclaimPolicyAndMakeAvailable(t *testing.T) {
+	testCases := []struct {
+		phase         corev1.PersistentVolumePhase
+		expectedError string
+	}{
+		{
+			phase:         corev1.VolumeReleased,
+			expectedError: "",
+		},
+		{
+			phase:         corev1.VolumeBound,
+			expectedError: "persistentvolume test-pv cannot be made available from phase Bound, must be Released",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyPV(testCase.phase)},
+		}))
+
+		testBuilder, err := testBuilder.SetReclaimPolicy(corev1.PersistentVolumeReclaimRetain)
+		assert.Nil(t, err)
+		assert.Equal(t, corev1.PersistentVolumeReclaimRetain, testBuilder.Object.Spec.PersistentVolumeReclaimPolicy)
+
+		testBuilder, err = testBuilder.MakeAvailable()
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Nil(t, testBuilder.Object.Spec.ClaimRef)
+	}
+}
+
+func TestPVWaitUntilPhase(t *testing.T) {
+	testBuilder := buildValidPVBuilder(clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyPV(corev1.VolumeAvailable)},
+	}))
+
+	err := testBuilder.WaitUntilPhase(corev1.VolumeAvailable, time.Second)
+	assert.Nil(t, err)
+
+	err = testBuilder.WaitUntilPhase(corev1.VolumeBound, time.Second)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func buildValidPVBuilder(apiClient *clients.Settings) *PVBuilder {
+	return NewPVBuilder(apiClient, defaultPVName, "10Gi")
+}
+
+func buildDummyPV(phase corev1.PersistentVolumePhase) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultPVName,
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			ClaimRef:                      &corev1.ObjectReference{Name: defaultPVCName, Namespace: defaultPVCNamespace},
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: phase,
+		},
+	}
+}
@@ -2,13 +2,14 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"golang.org/x/exp/slices"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,6 +18,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+const snapshotAPIGroup = "snapshot.storage.k8s.io"
+
 var validPVCModesMap = map[string]string{
 	"ReadWriteOnce":    "ReadWriteOnce",
 	"ReadOnlyMany":     "ReadOnlyMany",
@@ -32,13 +35,13 @@ type PVCBuilder struct {
 	// Created persistentvolumeclaim object
 	Object *corev1.PersistentVolumeClaim
 
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
 }
 
 // NewPVCBuilder creates a new structure for persistentvolumeclaim.
 func NewPVCBuilder(apiClient *clients.Settings, name, nsname string) *PVCBuilder {
-	glog.V(100).Infof("Creating PersistentVolumeClaim %s in namespace %s",
+	logging.V(100).Infof("Creating PersistentVolumeClaim %s in namespace %s",
 		name, nsname)
 
 	builder := PVCBuilder{
@@ -54,15 +57,15 @@ func NewPVCBuilder(apiClient *clients.Settings, name, nsname string) *PVCBuilder
 	builder.apiClient = apiClient
 
 	if name == "" {
-		glog.V(100).Infof("PVC name is empty")
+		logging.V(100).Infof("PVC name is empty")
 
-		builder.errorMsg = "PVC name is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PVC name is empty"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("PVC namespace is empty")
+		logging.V(100).Infof("PVC namespace is empty")
 
-		builder.errorMsg = "PVC namespace is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PVC namespace is empty"))
 	}
 
 	return &builder
@@ -70,20 +73,20 @@ func NewPVCBuilder(apiClient *clients.Settings, name, nsname string) *PVCBuilder
 
 // WithPVCAccessMode configure access mode for the PV.
 func (builder *PVCBuilder) WithPVCAccessMode(accessMode string) (*PVCBuilder, error) {
-	glog.V(100).Infof("Set PVC accessMode: %s", accessMode)
+	logging.V(100).Infof("Set PVC accessMode: %s", accessMode)
 
 	if accessMode == "" {
-		glog.V(100).Infof("Empty accessMode for PVC %s", builder.Definition.Name)
-		builder.errorMsg = "Empty accessMode for PVC requested"
+		logging.V(100).Infof("Empty accessMode for PVC %s", builder.Definition.Name)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Empty accessMode for PVC requested"))
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	if !validatePVCAccessMode(accessMode) {
-		glog.V(100).Infof("Invalid accessMode for PVC %s", accessMode)
-		builder.errorMsg = fmt.Sprintf("Invalid accessMode for PVC %s", accessMode)
+		logging.V(100).Infof("Invalid accessMode for PVC %s", accessMode)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Invalid accessMode for PVC %s", accessMode))
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	if builder.Definition.Spec.AccessModes != nil {
@@ -99,7 +102,7 @@ func (builder *PVCBuilder) WithPVCAccessMode(accessMode string) (*PVCBuilder, er
 
 // validatePVCAccessMode validates if requested mode is valid for PVC.
 func validatePVCAccessMode(accessMode string) bool {
-	glog.V(100).Info("Validating accessMode %s", accessMode)
+	logging.V(100).Infof("Validating accessMode %s", accessMode)
 
 	_, ok := validPVCModesMap[accessMode]
 
@@ -109,17 +112,17 @@ func validatePVCAccessMode(accessMode string) bool {
 // WithPVCCapacity configures the minimum resources the volume should have.
 func (builder *PVCBuilder) WithPVCCapacity(capacity string) (*PVCBuilder, error) {
 	if capacity == "" {
-		glog.V(100).Infof("Capacity of the PersistentVolumeClaim is empty")
+		logging.V(100).Infof("Capacity of the PersistentVolumeClaim is empty")
 
-		builder.errorMsg = "Capacity of the PersistentVolumeClaim is empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Capacity of the PersistentVolumeClaim is empty"))
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	defer func() (*PVCBuilder, error) {
 		if r := recover(); r != nil {
-			glog.V(100).Infof("Failed to parse %v", capacity)
-			builder.errorMsg = fmt.Sprintf("Failed to parse: %v", capacity)
+			logging.V(100).Infof("Failed to parse %v", capacity)
+			builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Failed to parse: %v", capacity))
 
 			return builder, fmt.Errorf(fmt.Sprintf("Failed to parse: %v", capacity))
 		}
@@ -137,15 +140,16 @@ func (builder *PVCBuilder) WithPVCCapacity(capacity string) (*PVCBuilder, error)
 
 // WithStorageClass configures storageClass required by the claim.
 func (builder *PVCBuilder) WithStorageClass(storageClass string) (*PVCBuilder, error) {
-	glog.V(100).Infof("Set storage class %s for the PersistentVolumeClaim", storageClass)
+	logging.V(100).Infof("Set storage class %s for the PersistentVolumeClaim", storageClass)
 
 	if storageClass == "" {
-		glog.V(100).Infof("Empty storageClass requested for the PersistentVolumeClaim", storageClass)
+		logging.V(100).Infof("Empty storageClass requested for the PersistentVolumeClaim")
 
-		builder.errorMsg = fmt.Sprintf("Empty storageClass requested for the PersistentVolumeClaim %s",
-			builder.Definition.Name)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Empty storageClass requested for the PersistentVolumeClaim %s",
+			builder.Definition.Name))
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	builder.Definition.Spec.StorageClassName = &storageClass
@@ -155,25 +159,27 @@ func (builder *PVCBuilder) WithStorageClass(storageClass string) (*PVCBuilder, e
 
 // WithVolumeMode configures what type of volume is required by the claim.
 func (builder *PVCBuilder) WithVolumeMode(volumeMode string) (*PVCBuilder, error) {
-	glog.V(100).Infof("Set VolumeMode %s for the PersistentVolumeClaim", volumeMode)
+	logging.V(100).Infof("Set VolumeMode %s for the PersistentVolumeClaim", volumeMode)
 
 	if volumeMode == "" {
-		glog.V(100).Infof(fmt.Sprintf("Empty volumeMode requested for the PersistentVolumeClaim %s in %s namespace",
+		logging.V(100).Infof(fmt.Sprintf("Empty volumeMode requested for the PersistentVolumeClaim %s in %s namespace",
 			builder.Definition.Name, builder.Definition.Namespace))
 
-		builder.errorMsg = fmt.Sprintf("Empty volumeMode requested for the PersistentVolumeClaim %s in %s namespace",
-			builder.Definition.Name, builder.Definition.Namespace)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Empty volumeMode requested for the PersistentVolumeClaim %s in %s namespace",
+			builder.Definition.Name, builder.Definition.Namespace))
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	if !validateVolumeMode(volumeMode) {
-		glog.V(100).Infof(fmt.Sprintf("Unsupported VolumeMode: %s", volumeMode))
+		logging.V(100).Infof(fmt.Sprintf("Unsupported VolumeMode: %s", volumeMode))
 
-		builder.errorMsg = fmt.Sprintf("Unsupported VolumeMode %q requested for %s PersistentVolumeClaim in %s namespace",
-			volumeMode, builder.Definition.Name, builder.Definition.Name)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Unsupported VolumeMode %q requested for %s PersistentVolumeClaim in %s namespace",
+			volumeMode, builder.Definition.Name, builder.Definition.Name))
 
-		return builder, fmt.Errorf(builder.errorMsg)
+		return builder, builder.errorMsg
 	}
 
 	// volumeMode is string while Spec.VolumeMode requires pointer to corev1.PersistentVolumeMode,
@@ -191,7 +197,7 @@ func (builder *PVCBuilder) Create() (*PVCBuilder, error) {
 		return builder, fmt.Errorf("invalid builder")
 	}
 
-	glog.V(100).Infof("Creating persistentVolumeClaim %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating persistentVolumeClaim %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -200,9 +206,9 @@ func (builder *PVCBuilder) Create() (*PVCBuilder, error) {
 	}
 
 	if err != nil {
-		glog.V(100).Infof("Error creating persistentVolumeClaim %s - %v", builder.Definition.Name, err)
+		logging.V(100).Infof("Error creating persistentVolumeClaim %s - %v", builder.Definition.Name, err)
 
-		builder.errorMsg = fmt.Sprintf("failed to create PVC: %v", err)
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("failed to create PVC: %v", err))
 
 		return builder, err
 	}
@@ -213,17 +219,17 @@ func (builder *PVCBuilder) Create() (*PVCBuilder, error) {
 // Delete removes PVC from cluster.
 func (builder *PVCBuilder) Delete() error {
 	if valid, err := builder.validate(); !valid {
-		glog.V(100).Infof("PersistentVolumeClaim %s in %s namespace is invalid: %v",
+		logging.V(100).Infof("PersistentVolumeClaim %s in %s namespace is invalid: %v",
 			builder.Definition.Name, builder.Definition.Namespace, err)
 
 		return err
 	}
 
-	glog.V(100).Infof("Delete PersistentVolumeClaim %s from %s namespace",
+	logging.V(100).Infof("Delete PersistentVolumeClaim %s from %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("PersistentVolumeClaim %s not found in %s namespace",
+		logging.V(100).Infof("PersistentVolumeClaim %s not found in %s namespace",
 			builder.Definition.Name, builder.Definition.Namespace)
 
 		return nil
@@ -233,14 +239,14 @@ func (builder *PVCBuilder) Delete() error {
 		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to delete PersistentVolumeClaim %s from %s namespace",
+		logging.V(100).Infof("Failed to delete PersistentVolumeClaim %s from %s namespace",
 			builder.Definition.Name, builder.Definition.Namespace)
-		glog.V(100).Infof("PersistenteVolumeClaim deletion error: %v", err)
+		logging.V(100).Infof("PersistenteVolumeClaim deletion error: %v", err)
 
 		return err
 	}
 
-	glog.V(100).Infof("Deleted PersistentVolumeClaim %s from %s namespace",
+	logging.V(100).Infof("Deleted PersistentVolumeClaim %s from %s namespace",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	builder.Object = nil
@@ -251,19 +257,19 @@ func (builder *PVCBuilder) Delete() error {
 // DeleteAndWait deletes PersistentVolumeClaim and waits until it's removed from the cluster.
 func (builder *PVCBuilder) DeleteAndWait(timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
-		glog.V(100).Infof("PersistentVolumeClaim %s in %s namespace is invalid: %v",
+		logging.V(100).Infof("PersistentVolumeClaim %s in %s namespace is invalid: %v",
 			builder.Definition.Name, builder.Definition.Namespace, err)
 
 		return err
 	}
 
-	glog.V(100).Infof("Deleting PersistenVolumeClaim %s from %s namespace and waiting for the removal to complete",
+	logging.V(100).Infof("Deleting PersistenVolumeClaim %s from %s namespace and waiting for the removal to complete",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if err := builder.Delete(); err != nil {
-		glog.V(100).Infof("Failed to delete PersistentVolumeClaim %s from %s namespace",
+		logging.V(100).Infof("Failed to delete PersistentVolumeClaim %s from %s namespace",
 			builder.Definition.Name, builder.Definition.Namespace)
-		glog.V(100).Infof("PersistenteVolumeClaim deletion error: %v", err)
+		logging.V(100).Infof("PersistenteVolumeClaim deletion error: %v", err)
 
 		return err
 	}
@@ -280,12 +286,206 @@ func (builder *PVCBuilder) DeleteAndWait(timeout time.Duration) error {
 		})
 }
 
+// WithVolumeSnapshotSource configures the claim to be populated from the given VolumeSnapshot in the same
+// namespace.
+func (builder *PVCBuilder) WithVolumeSnapshotSource(snapshotName string) (*PVCBuilder, error) {
+	logging.V(100).Infof("Set VolumeSnapshot %s as data source of the PersistentVolumeClaim", snapshotName)
+
+	if snapshotName == "" {
+		logging.V(100).Infof("Empty VolumeSnapshot name requested for the PersistentVolumeClaim")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Empty VolumeSnapshot name requested for the PersistentVolumeClaim %s", builder.Definition.Name))
+
+		return builder, builder.errorMsg
+	}
+
+	apiGroup := snapshotAPIGroup
+
+	builder.Definition.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     "VolumeSnapshot",
+		Name:     snapshotName,
+	}
+
+	return builder, nil
+}
+
+// WithPVCCloneSource configures the claim to be cloned from the given PersistentVolumeClaim in the same namespace.
+// The source claim must use the same storage class and volume mode.
+func (builder *PVCBuilder) WithPVCCloneSource(sourcePVCName string) (*PVCBuilder, error) {
+	logging.V(100).Infof("Set PersistentVolumeClaim %s as data source of the PersistentVolumeClaim", sourcePVCName)
+
+	if sourcePVCName == "" {
+		logging.V(100).Infof("Empty source PersistentVolumeClaim name requested for the PersistentVolumeClaim")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"Empty source PersistentVolumeClaim name requested for the PersistentVolumeClaim %s",
+			builder.Definition.Name))
+
+		return builder, builder.errorMsg
+	}
+
+	if sourcePVCName == builder.Definition.Name {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"PersistentVolumeClaim %s cannot be cloned from itself", builder.Definition.Name))
+
+		return builder, builder.errorMsg
+	}
+
+	builder.Definition.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		Kind: "PersistentVolumeClaim",
+		Name: sourcePVCName,
+	}
+
+	return builder, nil
+}
+
+// WaitUntilBound waits for the defined period until the PersistentVolumeClaim is bound to a PersistentVolume.
+func (builder *PVCBuilder) WaitUntilBound(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until PersistentVolumeClaim %s in %s namespace is bound",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			return builder.Object.Status.Phase == corev1.ClaimBound, nil
+		})
+}
+
+// GetBoundPVName returns the name of the PersistentVolume the PersistentVolumeClaim is bound to.
+func (builder *PVCBuilder) GetBoundPVName() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting PersistentVolume bound to PersistentVolumeClaim %s in %s namespace",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("PersistentVolumeClaim object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status.Phase != corev1.ClaimBound || builder.Object.Spec.VolumeName == "" {
+		return "", fmt.Errorf("PersistentVolumeClaim %s in namespace %s is not bound, phase is %s",
+			builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.Phase)
+	}
+
+	return builder.Object.Spec.VolumeName, nil
+}
+
+// Expand requests a new storage capacity for the bound PersistentVolumeClaim. The capacity must be greater than the
+// currently requested one and the storage class must allow volume expansion. Use WaitUntilResized to wait for the
+// expansion to complete.
+func (builder *PVCBuilder) Expand(capacity string) (*PVCBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Expanding PersistentVolumeClaim %s in %s namespace to %s",
+		builder.Definition.Name, builder.Definition.Namespace, capacity)
+
+	newCapacity, err := resource.ParseQuantity(capacity)
+	if err != nil {
+		return builder, fmt.Errorf("failed to parse capacity %s: %w", capacity, err)
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("PersistentVolumeClaim object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	currentCapacity := builder.Object.Spec.Resources.Requests[corev1.ResourceStorage]
+	if newCapacity.Cmp(currentCapacity) <= 0 {
+		return builder, fmt.Errorf("PersistentVolumeClaim %s capacity %s must be greater than the current %s",
+			builder.Definition.Name, capacity, currentCapacity.String())
+	}
+
+	builder.Definition = builder.Object
+	if builder.Definition.Spec.Resources.Requests == nil {
+		builder.Definition.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+
+	builder.Definition.Spec.Resources.Requests[corev1.ResourceStorage] = newCapacity
+
+	builder.Object, err = builder.apiClient.PersistentVolumeClaims(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// WaitUntilConditionTrue waits for the defined period until the PersistentVolumeClaim has the given condition set
+// to true, e.g. FileSystemResizePending when the volume of an unmounted claim was expanded.
+func (builder *PVCBuilder) WaitUntilConditionTrue(
+	conditionType corev1.PersistentVolumeClaimConditionType, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until PersistentVolumeClaim %s in %s namespace has condition %s",
+		builder.Definition.Name, builder.Definition.Namespace, conditionType)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			for _, condition := range builder.Object.Status.Conditions {
+				if condition.Type == conditionType {
+					return condition.Status == corev1.ConditionTrue, nil
+				}
+			}
+
+			return false, nil
+		})
+}
+
+// WaitUntilResized waits for the defined period until the capacity of the PersistentVolumeClaim reaches the
+// requested one and neither the controller nor the file system resize is still in progress.
+func (builder *PVCBuilder) WaitUntilResized(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting until PersistentVolumeClaim %s in %s namespace is resized",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			for _, condition := range builder.Object.Status.Conditions {
+				if (condition.Type == corev1.PersistentVolumeClaimResizing ||
+					condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending) &&
+					condition.Status == corev1.ConditionTrue {
+					return false, nil
+				}
+			}
+
+			requested := builder.Object.Spec.Resources.Requests[corev1.ResourceStorage]
+			capacity, found := builder.Object.Status.Capacity[corev1.ResourceStorage]
+
+			return found && capacity.Cmp(requested) >= 0, nil
+		})
+}
+
 // PullPersistentVolumeClaim gets an existing PersistentVolumeClaim
 // from the cluster.
 func PullPersistentVolumeClaim(
 	apiClient *clients.Settings, persistentVolumeClaim string, nsname string) (
 	*PVCBuilder, error) {
-	glog.V(100).Infof("Pulling existing PersistentVolumeClaim object: %s from namespace %s",
+	logging.V(100).Infof("Pulling existing PersistentVolumeClaim object: %s from namespace %s",
 		persistentVolumeClaim, nsname)
 
 	builder := PVCBuilder{
@@ -314,7 +514,7 @@ func (builder *PVCBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if PersistentVolumeClaim %s exists in namespace %s",
+	logging.V(100).Infof("Checking if PersistentVolumeClaim %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -330,19 +530,19 @@ func (builder *PVCBuilder) validate() (bool, error) {
 	resourceCRD := "PersistentVolumeClaim"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
@@ -352,7 +552,7 @@ func (builder *PVCBuilder) validate() (bool, error) {
 
 // validateVolumeMode validates if requested volume mode is valid for PVC.
 func validateVolumeMode(volumeMode string) bool {
-	glog.V(100).Info("Validating volumeMode %s", volumeMode)
+	logging.V(100).Infof("Validating volumeMode %s", volumeMode)
 
 	var validVolumeModes = []string{
 		"Block",
@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultPVCName      = "test-pvc"
+	defaultPVCNamespace = "test-namespace"
+)
+
+func TestPVCWithDataSource(t *testing.T) {
+	testBuilder, err := NewPVCBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		defaultPVCName, defaultPVCNamespace).WithVolumeSnapshotSource("test-snapshot")
+	assert.Nil(t, err)
+	assert.Equal(t, "VolumeSnapshot", testBuilder.Definition.Spec.DataSource.Kind)
+	assert.Equal(t, "snapshot.storage.k8s.io", *testBuilder.Definition.Spec.DataSource.APIGroup)
+	assert.Equal(t, "test-snapshot", testBuilder.Definition.Spec.DataSource.Name)
+
+	testBuilder, err = NewPVCBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		defaultPVCName, defaultPVCNamespace).WithPVCCloneSource("source-pvc")
+	assert.Nil(t, err)
+	assert.Equal(t, "PersistentVolumeClaim", testBuilder.Definition.Spec.DataSource.Kind)
+	assert.Nil(t, testBuilder.Definition.Spec.DataSource.APIGroup)
+
+	_, err = NewPVCBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		defaultPVCName, defaultPVCNamespace).WithVolumeSnapshotSource("")
+	assert.EqualError(t, err, "Empty VolumeSnapshot name requested for the PersistentVolumeClaim test-pvc")
+
+	_, err = NewPVCBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		defaultPVCName, defaultPVCNamespace).WithPVCCloneSource(defaultPVCName)
+	assert.EqualError(t, err, "PersistentVolumeClaim test-pvc cannot be cloned from itself")
+}
+
+func TestPVCWaitUntilBoundAndGetBoundPVName(t *testing.T) {
+	testCases := []struct {
+		phase         corev1.PersistentVolumeClaimPhase
+		expectedWait  error
+		expectedError string
+	}{
+		{
+			phase:        corev1.ClaimBound,
+			expectedWait: nil,
+		},
+		{
+			phase:         corev1.ClaimPending,
+			expectedWait:  context.DeadlineExceeded,
+			expectedError: "PersistentVolumeClaim test-pvc in namespace test-namespace is not bound, phase is Pending",
+		},
+	}
+
+	for _, testCase := range testCases {
+		dummyPVC := buildDummyPVC("1Gi")
+		dummyPVC.Status.Phase = testCase.phase
+
+		if testCase.phase == corev1.ClaimBound {
+			dummyPVC.Spec.VolumeName = "test-pv"
+		}
+
+		testBuilder := NewPVCBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{dummyPVC},
+		}), defaultPVCName, defaultPVCNamespace)
+
+		err := testBuilder.WaitUntilBound(time.Second)
+		assert.Equal(t, testCase.expectedWait, err)
+
+		pvName, err := testBuilder.GetBoundPVName()
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, "test-pv", pvName)
+	}
+}
+
+func TestPVCExpand(t *testing.T) {
+	testCases := []struct {
+		capacity      string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			capacity:     "2Gi",
+			addToRuntime: true,
+		},
+		{
+			capacity:      "512Mi",
+			addToRuntime:  true,
+			expectedError: "PersistentVolumeClaim test-pvc capacity 512Mi must be greater than the current 1Gi",
+		},
+		{
+			capacity:      "two",
+			addToRuntime:  true,
+			expectedError: "failed to parse capacity two: quantities must match the regular expression",
+		},
+		{
+			capacity:      "2Gi",
+			addToRuntime:  false,
+			expectedError: "PersistentVolumeClaim object test-pvc doesn't exist in namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, buildDummyPVC("1Gi"))
+		}
+
+		testBuilder, err := NewPVCBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}), defaultPVCName, defaultPVCNamespace).Expand(testCase.capacity)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, resource.MustParse(testCase.capacity),
+			testBuilder.Object.Spec.Resources.Requests[corev1.ResourceStorage])
+	}
+}
+
+func TestPVCWaitUntilResized(t *testing.T) {
+	testCases := []struct {
+		capacity      string
+		conditionType corev1.PersistentVolumeClaimConditionType
+		expectedError error
+	}{
+		{
+			capacity:      "2Gi",
+			expectedError: nil,
+		},
+		{
+			capacity:      "1Gi",
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			capacity:      "2Gi",
+			conditionType: corev1.PersistentVolumeClaimFileSystemResizePending,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		dummyPVC := buildDummyPVC("2Gi")
+		dummyPVC.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(testCase.capacity)}
+
+		if testCase.conditionType != "" {
+			dummyPVC.Status.Conditions = []corev1.PersistentVolumeClaimCondition{{
+				Type:   testCase.conditionType,
+				Status: corev1.ConditionTrue,
+			}}
+		}
+
+		testBuilder := NewPVCBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{dummyPVC},
+		}), defaultPVCName, defaultPVCNamespace)
+
+		err := testBuilder.WaitUntilResized(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.conditionType != "" {
+			err = testBuilder.WaitUntilConditionTrue(testCase.conditionType, time.Second)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func buildDummyPVC(capacity string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultPVCName,
+			Namespace: defaultPVCNamespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+			},
+		},
+	}
+}
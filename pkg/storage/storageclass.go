@@ -2,10 +2,11 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	storageV1 "k8s.io/api/storage/v1"
@@ -22,7 +23,7 @@ type ClassBuilder struct {
 	Object *storageV1.StorageClass
 	// Used in functions that define or mutate storageclass definition. errorMsg is processed before the storageclass
 	// object is created.
-	errorMsg  string
+	errorMsg  error
 	apiClient *clients.Settings
 }
 
@@ -31,7 +32,7 @@ type AdditionalOptions func(builder *ClassBuilder) (*ClassBuilder, error)
 
 // NewClassBuilder creates a new instance of ClassBuilder.
 func NewClassBuilder(apiClient *clients.Settings, name, provisioner string) *ClassBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new storageclass structure with the following params: "+
 			"name: %s, provisioner: %s", name, provisioner)
 
@@ -46,20 +47,48 @@ func NewClassBuilder(apiClient *clients.Settings, name, provisioner string) *Cla
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the storageclass is empty")
+		logging.V(100).Infof("The name of the storageclass is empty")
 
-		builder.errorMsg = "storageclass 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("storageclass 'name' cannot be empty"))
 	}
 
 	if provisioner == "" {
-		glog.V(100).Infof("The provisioner of the storageclass is empty")
+		logging.V(100).Infof("The provisioner of the storageclass is empty")
 
-		builder.errorMsg = "storageclass 'provisioner' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("storageclass 'provisioner' cannot be empty"))
 	}
 
 	return &builder
 }
 
+// PullClass loads an existing storageclass into ClassBuilder struct.
+func PullClass(apiClient *clients.Settings, name string) (*ClassBuilder, error) {
+	logging.V(100).Infof("Pulling existing storageclass name: %s", name)
+
+	builder := ClassBuilder{
+		apiClient: apiClient,
+		Definition: &storageV1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the storageclass is empty")
+
+		return nil, fmt.Errorf("storageclass 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("storageclass object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
 // WithReclaimPolicy adds a reclaimPolicy to the storageclass definition.
 func (builder *ClassBuilder) WithReclaimPolicy(
 	reclaimPolicy corev1.PersistentVolumeReclaimPolicy) *ClassBuilder {
@@ -68,12 +97,12 @@ func (builder *ClassBuilder) WithReclaimPolicy(
 	}
 
 	if reclaimPolicy == "" {
-		glog.V(100).Infof("The reclaimPolicy of the storageclass is empty")
+		logging.V(100).Infof("The reclaimPolicy of the storageclass is empty")
 
-		builder.errorMsg = "storageclass 'reclaimPolicy' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("storageclass 'reclaimPolicy' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -90,12 +119,12 @@ func (builder *ClassBuilder) WithVolumeBindingMode(
 	}
 
 	if bindingMode == "" {
-		glog.V(100).Infof("The bindingMode of the storageclass is empty")
+		logging.V(100).Infof("The bindingMode of the storageclass is empty")
 
-		builder.errorMsg = "storageclass 'bindingMode' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("storageclass 'bindingMode' cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -111,18 +140,18 @@ func (builder *ClassBuilder) WithParameter(parameterKey, parameterValue string)
 	}
 
 	if parameterKey == "" {
-		glog.V(100).Infof("The parameter key of the storageclass is empty")
+		logging.V(100).Infof("The parameter key of the storageclass is empty")
 
-		builder.errorMsg = "storageclass parameter key cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("storageclass parameter key cannot be empty"))
 	}
 
 	if parameterValue == "" {
-		glog.V(100).Infof("The parameter value of the storageclass is empty")
+		logging.V(100).Infof("The parameter value of the storageclass is empty")
 
-		builder.errorMsg = "storageclass parameter value cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("storageclass parameter value cannot be empty"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -135,22 +164,69 @@ func (builder *ClassBuilder) WithParameter(parameterKey, parameterValue string)
 	return builder
 }
 
+// WithAllowedTopology restricts the topology domains where volumes of the storageclass may be provisioned to the
+// ones with a label key matching one of values, e.g. "topology.kubernetes.io/zone". Each call appends a term which
+// is ORed with the previous ones.
+func (builder *ClassBuilder) WithAllowedTopology(key string, values ...string) *ClassBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding allowed topology %s in %v to storageclass %s", key, values, builder.Definition.Name)
+
+	if key == "" {
+		logging.V(100).Infof("The allowed topology key of the storageclass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("storageclass allowed topology key cannot be empty"))
+
+		return builder
+	}
+
+	if len(values) == 0 {
+		logging.V(100).Infof("The allowed topology values of the storageclass are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("storageclass allowed topology values cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.AllowedTopologies = append(builder.Definition.AllowedTopologies, corev1.TopologySelectorTerm{
+		MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{{Key: key, Values: values}},
+	})
+
+	return builder
+}
+
+// WithAllowVolumeExpansion sets whether claims of the storageclass may be resized.
+func (builder *ClassBuilder) WithAllowVolumeExpansion(allow bool) *ClassBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting allowVolumeExpansion %t in storageclass %s", allow, builder.Definition.Name)
+
+	builder.Definition.AllowVolumeExpansion = &allow
+
+	return builder
+}
+
 // WithOptions creates a storageclass with generic mutation options.
 func (builder *ClassBuilder) WithOptions(options ...AdditionalOptions) *ClassBuilder {
 	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	glog.V(100).Infof("Setting storageclass additional options")
+	logging.V(100).Infof("Setting storageclass additional options")
 
 	for _, option := range options {
 		if option != nil {
 			builder, err := option(builder)
 
 			if err != nil {
-				glog.V(100).Infof("Error occurred in mutation function")
+				logging.V(100).Infof("Error occurred in mutation function")
 
-				builder.errorMsg = err.Error()
+				builder.errorMsg = errors.Join(builder.errorMsg, err)
 
 				return builder
 			}
@@ -166,7 +242,7 @@ func (builder *ClassBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if storageclass %s exists",
+	logging.V(100).Infof("Checking if storageclass %s exists",
 		builder.Definition.Name)
 
 	var err error
@@ -182,7 +258,7 @@ func (builder *ClassBuilder) Create() (*ClassBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating storageclass %s", builder.Definition.Name)
+	logging.V(100).Infof("Creating storageclass %s", builder.Definition.Name)
 
 	var err error
 	if !builder.Exists() {
@@ -199,7 +275,7 @@ func (builder *ClassBuilder) Delete() error {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting storageclass %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting storageclass %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return nil
@@ -223,32 +299,32 @@ func (builder *ClassBuilder) Update(force bool) (*ClassBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating storageclass %s",
+	logging.V(100).Infof("Updating storageclass %s",
 		builder.Definition.Name)
 
 	if !builder.Exists() {
-		glog.V(100).Infof("storageclass %s does not exist",
+		logging.V(100).Infof("storageclass %s does not exist",
 			builder.Definition.Name)
 
-		builder.errorMsg = "Cannot update non-existent storageclass"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("Cannot update non-existent storageclass"))
 	}
 
-	if builder.errorMsg != "" {
-		return nil, fmt.Errorf(builder.errorMsg)
+	if builder.errorMsg != nil {
+		return nil, builder.errorMsg
 	}
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(
+			logging.V(100).Infof(
 				msg.FailToUpdateNotification("storageclass", builder.Definition.Name))
 
 			err = builder.Delete()
 			builder.Definition.ResourceVersion = ""
 
 			if err != nil {
-				glog.V(100).Infof(
+				logging.V(100).Infof(
 					"Failed to update the storageclass object %s, "+
 						"due to error in delete function",
 					builder.Definition.Name,
@@ -274,27 +350,29 @@ func (builder *ClassBuilder) validate() (bool, error) {
 	resourceCRD := "StorageClass"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	storageV1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultStorageClassName = "test-storageclass"
+
+func TestPullClass(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addToRuntime  bool
+		expectedError string
+	}{
+		{
+			name:          defaultStorageClassName,
+			addToRuntime:  true,
+			expectedError: "",
+		},
+		{
+			name:          defaultStorageClassName,
+			addToRuntime:  false,
+			expectedError: "storageclass object test-storageclass doesn't exist",
+		},
+		{
+			name:          "",
+			addToRuntime:  false,
+			expectedError: "storageclass 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntime {
+			runtimeObjects = append(runtimeObjects, &storageV1.StorageClass{
+				ObjectMeta:  metav1.ObjectMeta{Name: defaultStorageClassName},
+				Provisioner: "kubernetes.io/no-provisioner",
+			})
+		}
+
+		testBuilder, err := PullClass(
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}), testCase.name)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Equal(t, "kubernetes.io/no-provisioner", testBuilder.Definition.Provisioner)
+		}
+	}
+}
+
+func TestClassWithAllowedTopology(t *testing.T) {
+	testCases := []struct {
+		key           string
+		values        []string
+		expectedError string
+	}{
+		{
+			key:           corev1.LabelTopologyZone,
+			values:        []string{"zone-a", "zone-b"},
+			expectedError: "",
+		},
+		{
+			key:           "",
+			values:        []string{"zone-a"},
+			expectedError: "storageclass allowed topology key cannot be empty",
+		},
+		{
+			key:           corev1.LabelTopologyZone,
+			expectedError: "storageclass allowed topology values cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewClassBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			defaultStorageClassName, "kubernetes.io/no-provisioner").
+			WithAllowedTopology(testCase.key, testCase.values...).
+			WithAllowVolumeExpansion(true)
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		assert.True(t, *testBuilder.Definition.AllowVolumeExpansion)
+		assert.Equal(t, []corev1.TopologySelectorTerm{{
+			MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{{Key: testCase.key, Values: testCase.values}},
+		}}, testBuilder.Definition.AllowedTopologies)
+	}
+}
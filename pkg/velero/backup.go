@@ -2,18 +2,29 @@ package velero
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	veleroClient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"golang.org/x/exp/slices"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+var terminalBackupPhases = []velerov1.BackupPhase{
+	velerov1.BackupPhaseCompleted,
+	velerov1.BackupPhasePartiallyFailed,
+	velerov1.BackupPhaseFailed,
+	velerov1.BackupPhaseFailedValidation,
+}
+
 // BackupBuilder provides a struct for backup object from the cluster and a backup definition.
 type BackupBuilder struct {
 	// Backup definition, used to create the backup object.
@@ -21,14 +32,14 @@ type BackupBuilder struct {
 	// Created backup object.
 	Object *velerov1.Backup
 	// Used to store latest error message upon defining or mutating backup definition.
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient veleroClient.Interface
 }
 
 // NewBackupBuilder creates a new instance of BackupBuilder.
 func NewBackupBuilder(apiClient *clients.Settings, name, nsname string) *BackupBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new backup structure with the following params: "+
 			"name: %s, namespace: %s", name, nsname)
 
@@ -43,15 +54,15 @@ func NewBackupBuilder(apiClient *clients.Settings, name, nsname string) *BackupB
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the backup is empty")
+		logging.V(100).Infof("The name of the backup is empty")
 
-		builder.errorMsg = "backup name cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup name cannot be an empty string"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the backup is empty")
+		logging.V(100).Infof("The namespace of the backup is empty")
 
-		builder.errorMsg = "backup namespace cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup namespace cannot be an empty string"))
 	}
 
 	return builder
@@ -59,7 +70,7 @@ func NewBackupBuilder(apiClient *clients.Settings, name, nsname string) *BackupB
 
 // PullBackup loads an existing backup into BackupBuilder struct.
 func PullBackup(apiClient *clients.Settings, name, nsname string) (*BackupBuilder, error) {
-	glog.V(100).Infof("Pulling existing backup name: %s under namespace: %s", name, nsname)
+	logging.V(100).Infof("Pulling existing backup name: %s under namespace: %s", name, nsname)
 
 	builder := BackupBuilder{
 		apiClient: apiClient.VeleroClient,
@@ -94,17 +105,17 @@ func (builder *BackupBuilder) WithStorageLocation(location string) *BackupBuilde
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding storage location %s to backup %s in namespace %s",
 		location, builder.Definition.Name, builder.Definition.Namespace)
 
 	if location == "" {
-		glog.V(100).Infof("Backup storage location is empty")
+		logging.V(100).Infof("Backup storage location is empty")
 
-		builder.errorMsg = "backup storage location cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup storage location cannot be an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -123,17 +134,17 @@ func (builder *BackupBuilder) WithIncludedNamespace(namespace string) *BackupBui
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding namespace %s to backup %s in namespace %s includedNamespaces field",
 		namespace, builder.Definition.Name, builder.Definition.Namespace)
 
 	if namespace == "" {
-		glog.V(100).Infof("Backup includedNamespace is empty")
+		logging.V(100).Infof("Backup includedNamespace is empty")
 
-		builder.errorMsg = "backup includedNamespace cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup includedNamespace cannot be an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -148,17 +159,18 @@ func (builder *BackupBuilder) WithIncludedClusterScopedResource(crd string) *Bac
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding custom resource %s to backup %s in namespace %s includedClusterScopedResources field",
 		crd, builder.Definition.Name, builder.Definition.Namespace)
 
 	if crd == "" {
-		glog.V(100).Infof("Backup includedClusterScopedResource is empty")
+		logging.V(100).Infof("Backup includedClusterScopedResource is empty")
 
-		builder.errorMsg = "backup includedClusterScopedResource cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"backup includedClusterScopedResource cannot be an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -174,17 +186,18 @@ func (builder *BackupBuilder) WithIncludedNamespaceScopedResource(crd string) *B
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding custom resource %s to backup %s in namespace %s includedNamespaceScopedResources field",
 		crd, builder.Definition.Name, builder.Definition.Namespace)
 
 	if crd == "" {
-		glog.V(100).Infof("Backup includedNamespaceScopedResource is empty")
+		logging.V(100).Infof("Backup includedNamespaceScopedResource is empty")
 
-		builder.errorMsg = "backup includedNamespaceScopedResource cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"backup includedNamespaceScopedResource cannot be an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -200,17 +213,18 @@ func (builder *BackupBuilder) WithExcludedClusterScopedResource(crd string) *Bac
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding custom resource %s to backup %s in namespace %s excludedClusterScopedResources field",
 		crd, builder.Definition.Name, builder.Definition.Namespace)
 
 	if crd == "" {
-		glog.V(100).Infof("Backup excludedClusterScopedResource is empty")
+		logging.V(100).Infof("Backup excludedClusterScopedResource is empty")
 
-		builder.errorMsg = "backup excludedClusterScopedResource cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"backup excludedClusterScopedResource cannot be an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -226,17 +240,18 @@ func (builder *BackupBuilder) WithExcludedNamespaceScopedResources(crd string) *
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding custom resource %s to backup %s in namespace %s excludedNamespaceScopedResources field",
 		crd, builder.Definition.Name, builder.Definition.Namespace)
 
 	if crd == "" {
-		glog.V(100).Infof("Backup excludedNamespaceScopedResource is empty")
+		logging.V(100).Infof("Backup excludedNamespaceScopedResource is empty")
 
-		builder.errorMsg = "backup excludedNamespaceScopedResource cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"backup excludedNamespaceScopedResource cannot be an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -246,13 +261,169 @@ func (builder *BackupBuilder) WithExcludedNamespaceScopedResources(crd string) *
 	return builder
 }
 
+// WithExcludedNamespace adds the specified namespace for exclusion when performing a backup.
+func (builder *BackupBuilder) WithExcludedNamespace(namespace string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding namespace %s to backup %s in namespace %s excludedNamespaces field",
+		namespace, builder.Definition.Name, builder.Definition.Namespace)
+
+	if namespace == "" {
+		logging.V(100).Infof("Backup excludedNamespace is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup excludedNamespace cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedNamespaces = append(builder.Definition.Spec.ExcludedNamespaces, namespace)
+
+	return builder
+}
+
+// WithIncludedResource adds the specified resource for inclusion when performing a backup. It cannot be combined
+// with the cluster-scoped and namespace-scoped resource filters.
+func (builder *BackupBuilder) WithIncludedResource(resource string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding resource %s to backup %s in namespace %s includedResources field",
+		resource, builder.Definition.Name, builder.Definition.Namespace)
+
+	if resource == "" {
+		logging.V(100).Infof("Backup includedResource is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup includedResource cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.IncludedResources = append(builder.Definition.Spec.IncludedResources, resource)
+
+	return builder
+}
+
+// WithExcludedResource adds the specified resource for exclusion when performing a backup. It cannot be combined
+// with the cluster-scoped and namespace-scoped resource filters.
+func (builder *BackupBuilder) WithExcludedResource(resource string) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding resource %s to backup %s in namespace %s excludedResources field",
+		resource, builder.Definition.Name, builder.Definition.Namespace)
+
+	if resource == "" {
+		logging.V(100).Infof("Backup excludedResource is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup excludedResource cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedResources = append(builder.Definition.Spec.ExcludedResources, resource)
+
+	return builder
+}
+
+// WithLabelSelector sets the label selector of the backup. Only resources matching it are backed up.
+func (builder *BackupBuilder) WithLabelSelector(selector metav1.LabelSelector) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting label selector %v of backup %s in namespace %s",
+		selector, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		logging.V(100).Infof("Backup labelSelector is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup labelSelector cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.LabelSelector = &selector
+
+	return builder
+}
+
+// WithTTL sets how long the backup is kept before velero garbage collects it.
+func (builder *BackupBuilder) WithTTL(ttl time.Duration) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting ttl %s of backup %s in namespace %s", ttl, builder.Definition.Name, builder.Definition.Namespace)
+
+	if ttl <= 0 {
+		logging.V(100).Infof("Backup ttl is not positive")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup ttl must be greater than 0"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.TTL = metav1.Duration{Duration: ttl}
+
+	return builder
+}
+
+// WithHook adds a hook running commands in the containers of the selected pods before or after they are backed up.
+func (builder *BackupBuilder) WithHook(hook velerov1.BackupResourceHookSpec) *BackupBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding hook %s to backup %s in namespace %s", hook.Name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if hook.Name == "" {
+		logging.V(100).Infof("Backup hook name is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup hook name cannot be an empty string"))
+	}
+
+	if len(hook.PreHooks)+len(hook.PostHooks) == 0 {
+		logging.V(100).Infof("Backup hook %s has no pre or post hooks", hook.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backup hook must have at least one pre or post hook"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Hooks.Resources = append(builder.Definition.Spec.Hooks.Resources, hook)
+
+	return builder
+}
+
 // Exists checks whether the given backup exists.
 func (builder *BackupBuilder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if backup %s exists in namespace %s",
+	logging.V(100).Infof("Checking if backup %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -268,7 +439,7 @@ func (builder *BackupBuilder) Create() (*BackupBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating backup %s in namespace %s",
+	logging.V(100).Infof("Creating backup %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -286,7 +457,7 @@ func (builder *BackupBuilder) Update() (*BackupBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating backup %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Updating backup %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.VeleroV1().Backups(builder.Definition.Namespace).Update(
@@ -301,7 +472,7 @@ func (builder *BackupBuilder) Delete() (*BackupBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting backup %s in namespace %s",
+	logging.V(100).Infof("Deleting backup %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -320,33 +491,115 @@ func (builder *BackupBuilder) Delete() (*BackupBuilder, error) {
 	return builder, nil
 }
 
+// WaitForPhase waits for the duration of the defined timeout or until the backup reaches the given phase, usually
+// Completed or PartiallyFailed. It returns early when the backup reaches another terminal phase, since it can no
+// longer reach the expected one.
+func (builder *BackupBuilder) WaitForPhase(phase velerov1.BackupPhase, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for backup %s in namespace %s to reach phase %s",
+		builder.Definition.Name, builder.Definition.Namespace, phase)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				logging.V(100).Infof("Backup %s does not exist yet", builder.Definition.Name)
+
+				return false, nil
+			}
+
+			if builder.Object.Status.Phase == phase {
+				return true, nil
+			}
+
+			if slices.Contains(terminalBackupPhases, builder.Object.Status.Phase) {
+				return false, fmt.Errorf("backup %s in namespace %s reached phase %s instead of %s",
+					builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.Phase, phase)
+			}
+
+			return false, nil
+		})
+}
+
+// GetErrorsCount returns the number of errors velero encountered while performing the backup.
+func (builder *BackupBuilder) GetErrorsCount() (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.V(100).Infof("Getting errors count of backup %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("backup object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Errors, nil
+}
+
+// GetWarningsCount returns the number of warnings velero reported while performing the backup.
+func (builder *BackupBuilder) GetWarningsCount() (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.V(100).Infof("Getting warnings count of backup %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("backup object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Warnings, nil
+}
+
+// GetLogs retrieves the logs velero uploaded to the backup storage location while performing the backup. The
+// timeout bounds how long velero may take to provide the download url.
+func (builder *BackupBuilder) GetLogs(timeout time.Duration) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting logs of backup %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return getLogs(builder.apiClient, builder.Definition.Name, builder.Definition.Namespace,
+		velerov1.DownloadTargetKindBackupLog, timeout)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BackupBuilder) validate() (bool, error) {
 	resourceCRD := "Backup"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -2,8 +2,10 @@ package velero
 
 import (
 	"testing"
+	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -38,7 +40,7 @@ func TestNewBackupBuilder(t *testing.T) {
 
 	for _, test := range testcases {
 		testBuilder := NewBackupBuilder(&clients.Settings{VeleroClient: &veleroClient.Clientset{}}, test.name, test.namespace)
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrMsg, testBuilder.errorMsg)
 	}
 }
 
@@ -149,7 +151,7 @@ func TestWithStorageLocation(t *testing.T) {
 
 		testBuilder.WithStorageLocation(test.location)
 
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg)
 	}
 }
 
@@ -175,7 +177,7 @@ func TestWithIncludedNamespace(t *testing.T) {
 			testBuilder.WithIncludedNamespace(namespace)
 		}
 
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg)
 
 		if test.expectedErrorMsg == "" {
 			assert.Len(t, testBuilder.Definition.Spec.IncludedNamespaces, len(test.namespaces))
@@ -205,7 +207,7 @@ func TestWithIncludedClusterScopedResource(t *testing.T) {
 			testBuilder.WithIncludedClusterScopedResource(clusterScopedResources)
 		}
 
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg)
 
 		if test.expectedErrorMsg == "" {
 			assert.Len(t, testBuilder.Definition.Spec.IncludedClusterScopedResources, len(test.clusterScopedResources))
@@ -235,7 +237,7 @@ func TestWithIncludedNamespaceScopedResource(t *testing.T) {
 			testBuilder.WithIncludedNamespaceScopedResource(namespace)
 		}
 
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg)
 
 		if test.expectedErrorMsg == "" {
 			assert.Len(t, testBuilder.Definition.Spec.IncludedNamespaceScopedResources, len(test.namespaceResources))
@@ -265,7 +267,7 @@ func TestWithExcludedClusterScopedResources(t *testing.T) {
 			testBuilder.WithExcludedClusterScopedResource(clusterScopedResources)
 		}
 
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg)
 
 		if test.expectedErrorMsg == "" {
 			assert.Len(t, testBuilder.Definition.Spec.ExcludedClusterScopedResources, len(test.clusterScopedResources))
@@ -295,10 +297,145 @@ func TestWithExcludedNamespaceScopedResources(t *testing.T) {
 			testBuilder.WithExcludedNamespaceScopedResources(namespaceResources)
 		}
 
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg)
 
 		if test.expectedErrorMsg == "" {
 			assert.Len(t, testBuilder.Definition.Spec.ExcludedNamespaceScopedResources, len(test.namespaceResources))
 		}
 	}
 }
+
+func TestBackupWithFilters(t *testing.T) {
+	selector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}
+	hook := velerov1.BackupResourceHookSpec{
+		Name: "freeze",
+		PreHooks: []velerov1.BackupResourceHook{{
+			Exec: &velerov1.ExecHook{Command: []string{"fsfreeze", "--freeze", "/data"}},
+		}},
+	}
+
+	testBuilder := buildValidBackupTestBuilder().
+		WithExcludedNamespace("excludeme").
+		WithIncludedResource("deployments").
+		WithExcludedResource("secrets").
+		WithLabelSelector(selector).
+		WithTTL(time.Hour).
+		WithHook(hook)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, []string{"excludeme"}, testBuilder.Definition.Spec.ExcludedNamespaces)
+	assert.Equal(t, []string{"deployments"}, testBuilder.Definition.Spec.IncludedResources)
+	assert.Equal(t, []string{"secrets"}, testBuilder.Definition.Spec.ExcludedResources)
+	assert.Equal(t, &selector, testBuilder.Definition.Spec.LabelSelector)
+	assert.Equal(t, time.Hour, testBuilder.Definition.Spec.TTL.Duration)
+	assert.Equal(t, []velerov1.BackupResourceHookSpec{hook}, testBuilder.Definition.Spec.Hooks.Resources)
+}
+
+func TestBackupWithFiltersErrors(t *testing.T) {
+	testCases := []struct {
+		mutate           func(builder *BackupBuilder) *BackupBuilder
+		expectedErrorMsg string
+	}{
+		{
+			mutate:           func(builder *BackupBuilder) *BackupBuilder { return builder.WithExcludedNamespace("") },
+			expectedErrorMsg: "backup excludedNamespace cannot be an empty string",
+		},
+		{
+			mutate:           func(builder *BackupBuilder) *BackupBuilder { return builder.WithIncludedResource("") },
+			expectedErrorMsg: "backup includedResource cannot be an empty string",
+		},
+		{
+			mutate:           func(builder *BackupBuilder) *BackupBuilder { return builder.WithExcludedResource("") },
+			expectedErrorMsg: "backup excludedResource cannot be an empty string",
+		},
+		{
+			mutate: func(builder *BackupBuilder) *BackupBuilder {
+				return builder.WithLabelSelector(metav1.LabelSelector{})
+			},
+			expectedErrorMsg: "backup labelSelector cannot be empty",
+		},
+		{
+			mutate:           func(builder *BackupBuilder) *BackupBuilder { return builder.WithTTL(0) },
+			expectedErrorMsg: "backup ttl must be greater than 0",
+		},
+		{
+			mutate: func(builder *BackupBuilder) *BackupBuilder {
+				return builder.WithHook(velerov1.BackupResourceHookSpec{Name: "empty"})
+			},
+			expectedErrorMsg: "backup hook must have at least one pre or post hook",
+		},
+	}
+
+	for _, test := range testCases {
+		testBuilder := test.mutate(buildValidBackupTestBuilder())
+		assert.EqualError(t, testBuilder.errorMsg, test.expectedErrorMsg)
+	}
+}
+
+func TestBackupWaitForPhase(t *testing.T) {
+	testCases := []struct {
+		phase            velerov1.BackupPhase
+		expectedErrorMsg string
+	}{
+		{
+			phase:            velerov1.BackupPhaseCompleted,
+			expectedErrorMsg: "",
+		},
+		{
+			phase: velerov1.BackupPhaseFailed,
+			expectedErrorMsg: "backup backup-test-name in namespace backup-test-namespace reached phase Failed " +
+				"instead of Completed",
+		},
+		{
+			phase:            velerov1.BackupPhaseInProgress,
+			expectedErrorMsg: "context deadline exceeded",
+		},
+	}
+
+	for _, test := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyBackupWithStatus(test.phase)},
+		})
+
+		testBuilder := NewBackupBuilder(testSettings, "backup-test-name", "backup-test-namespace")
+
+		err := testBuilder.WaitForPhase(velerov1.BackupPhaseCompleted, time.Second)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, err)
+	}
+}
+
+func TestBackupGetErrorsAndWarningsCount(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyBackupWithStatus(velerov1.BackupPhasePartiallyFailed)},
+	})
+
+	testBuilder := NewBackupBuilder(testSettings, "backup-test-name", "backup-test-namespace")
+
+	errorsCount, err := testBuilder.GetErrorsCount()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, errorsCount)
+
+	warningsCount, err := testBuilder.GetWarningsCount()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, warningsCount)
+
+	testBuilder = NewBackupBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		"backup-test-name", "backup-test-namespace")
+
+	_, err = testBuilder.GetErrorsCount()
+	assert.EqualError(t, err, "backup object backup-test-name doesn't exist in namespace backup-test-namespace")
+}
+
+func buildDummyBackupWithStatus(phase velerov1.BackupPhase) *velerov1.Backup {
+	return &velerov1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup-test-name",
+			Namespace: "backup-test-namespace",
+		},
+		Status: velerov1.BackupStatus{
+			Phase:    phase,
+			Errors:   2,
+			Warnings: 3,
+		},
+	}
+}
@@ -0,0 +1,379 @@
+package velero
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	veleroClient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// BackupStorageLocationBuilder provides a struct for backupstoragelocation object from the cluster and a
+// backupstoragelocation definition.
+type BackupStorageLocationBuilder struct {
+	// BackupStorageLocation definition, used to create the backupstoragelocation object.
+	Definition *velerov1.BackupStorageLocation
+	// Created backupstoragelocation object.
+	Object *velerov1.BackupStorageLocation
+	// Used to store latest error message upon defining or mutating backupstoragelocation definition.
+	errorMsg error
+	// api client to interact with the cluster.
+	apiClient veleroClient.Interface
+}
+
+// NewBackupStorageLocationBuilder creates a new instance of BackupStorageLocationBuilder storing backups in the
+// bucket of the given object storage provider.
+func NewBackupStorageLocationBuilder(
+	apiClient *clients.Settings, name, nsname, provider, bucket string) *BackupStorageLocationBuilder {
+	logging.V(100).Infof(
+		"Initializing new backupstoragelocation structure with the following params: "+
+			"name: %s, namespace: %s, provider: %s, bucket: %s", name, nsname, provider, bucket)
+
+	builder := &BackupStorageLocationBuilder{
+		apiClient: apiClient.VeleroClient,
+		Definition: &velerov1.BackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: velerov1.BackupStorageLocationSpec{
+				Provider: provider,
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{
+						Bucket: bucket,
+					},
+				},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the backupstoragelocation is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backupstoragelocation name cannot be an empty string"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the backupstoragelocation is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("backupstoragelocation namespace cannot be an empty string"))
+	}
+
+	if provider == "" {
+		logging.V(100).Infof("The provider of the backupstoragelocation is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("backupstoragelocation provider cannot be an empty string"))
+	}
+
+	if bucket == "" {
+		logging.V(100).Infof("The bucket of the backupstoragelocation is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backupstoragelocation bucket cannot be an empty string"))
+	}
+
+	return builder
+}
+
+// PullBackupStorageLocation loads an existing backupstoragelocation into BackupStorageLocationBuilder struct.
+func PullBackupStorageLocation(
+	apiClient *clients.Settings, name, nsname string) (*BackupStorageLocationBuilder, error) {
+	logging.V(100).Infof("Pulling existing backupstoragelocation name: %s under namespace: %s", name, nsname)
+
+	builder := BackupStorageLocationBuilder{
+		apiClient: apiClient.VeleroClient,
+		Definition: &velerov1.BackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("backupstoragelocation name cannot be empty")
+	}
+
+	if nsname == "" {
+		return nil, fmt.Errorf("backupstoragelocation namespace cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("backupstoragelocation object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithPrefix sets the prefix of the bucket under which backups are stored.
+func (builder *BackupStorageLocationBuilder) WithPrefix(prefix string) *BackupStorageLocationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting prefix %s of backupstoragelocation %s in namespace %s",
+		prefix, builder.Definition.Name, builder.Definition.Namespace)
+
+	if prefix == "" {
+		logging.V(100).Infof("Backupstoragelocation prefix is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("backupstoragelocation prefix cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	if builder.Definition.Spec.ObjectStorage == nil {
+		builder.Definition.Spec.ObjectStorage = &velerov1.ObjectStorageLocation{}
+	}
+
+	builder.Definition.Spec.ObjectStorage.Prefix = prefix
+
+	return builder
+}
+
+// WithCredential sets the key of the secret holding the credentials of the object storage.
+func (builder *BackupStorageLocationBuilder) WithCredential(
+	credential corev1.SecretKeySelector) *BackupStorageLocationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting credential %s/%s of backupstoragelocation %s in namespace %s",
+		credential.Name, credential.Key, builder.Definition.Name, builder.Definition.Namespace)
+
+	if credential.Name == "" || credential.Key == "" {
+		logging.V(100).Infof("Backupstoragelocation credential secret name or key is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("backupstoragelocation credential must set the secret name and key"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Credential = &credential
+
+	return builder
+}
+
+// WithConfig sets a provider specific setting of the backupstoragelocation, such as the region or the s3Url.
+func (builder *BackupStorageLocationBuilder) WithConfig(key, value string) *BackupStorageLocationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting config %s to %s of backupstoragelocation %s in namespace %s",
+		key, value, builder.Definition.Name, builder.Definition.Namespace)
+
+	if key == "" {
+		logging.V(100).Infof("Backupstoragelocation config key is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("backupstoragelocation config key cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	if builder.Definition.Spec.Config == nil {
+		builder.Definition.Spec.Config = make(map[string]string)
+	}
+
+	builder.Definition.Spec.Config[key] = value
+
+	return builder
+}
+
+// WithDefault makes the backupstoragelocation the one used by backups not selecting a storage location.
+func (builder *BackupStorageLocationBuilder) WithDefault() *BackupStorageLocationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting backupstoragelocation %s in namespace %s as default",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.Default = true
+
+	return builder
+}
+
+// Exists checks whether the given backupstoragelocation exists.
+func (builder *BackupStorageLocationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if backupstoragelocation %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.VeleroV1().BackupStorageLocations(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a backupstoragelocation according to the backupstoragelocation definition and stores the created
+// object in the backupstoragelocation builder.
+func (builder *BackupStorageLocationBuilder) Create() (*BackupStorageLocationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating backupstoragelocation %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.VeleroV1().BackupStorageLocations(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing backupstoragelocation object with the backupstoragelocation definition in builder.
+func (builder *BackupStorageLocationBuilder) Update() (*BackupStorageLocationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating backupstoragelocation %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.VeleroV1().BackupStorageLocations(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the backupstoragelocation object and resets the builder object. Backups stored in the bucket are
+// kept.
+func (builder *BackupStorageLocationBuilder) Delete() (*BackupStorageLocationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Deleting backupstoragelocation %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("backupstoragelocation cannot be deleted because it does not exist")
+	}
+
+	err := builder.apiClient.VeleroV1().BackupStorageLocations(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("can not delete backupstoragelocation: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
+
+// IsAvailable returns true when velero validated that the backupstoragelocation bucket is reachable.
+func (builder *BackupStorageLocationBuilder) IsAvailable() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if backupstoragelocation %s in namespace %s is available",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return false, fmt.Errorf("backupstoragelocation object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Phase == velerov1.BackupStorageLocationPhaseAvailable, nil
+}
+
+// WaitUntilAvailable waits for the duration of the defined timeout or until the backupstoragelocation is available.
+// On timeout, the last observed phase and validation message are included in the returned error.
+func (builder *BackupStorageLocationBuilder) WaitUntilAvailable(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for backupstoragelocation %s in namespace %s to be available",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			available, err := builder.IsAvailable()
+			if err != nil {
+				logging.V(100).Infof("Failed to check backupstoragelocation %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return available, nil
+		})
+
+	if err != nil && builder.Object != nil {
+		return fmt.Errorf("backupstoragelocation %s in namespace %s is not available, last phase %q message %q: %w",
+			builder.Definition.Name, builder.Definition.Namespace,
+			builder.Object.Status.Phase, builder.Object.Status.Message, err)
+	}
+
+	return err
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *BackupStorageLocationBuilder) validate() (bool, error) {
+	resourceCRD := "BackupStorageLocation"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
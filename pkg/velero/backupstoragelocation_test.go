@@ -0,0 +1,144 @@
+package velero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestNewBackupStorageLocationBuilder(t *testing.T) {
+	testCases := []struct {
+		name             string
+		provider         string
+		bucket           string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "bsl-test-name",
+			provider:         "aws",
+			bucket:           "backups",
+			expectedErrorMsg: "",
+		},
+		{
+			name:             "",
+			provider:         "aws",
+			bucket:           "backups",
+			expectedErrorMsg: "backupstoragelocation name cannot be an empty string",
+		},
+		{
+			name:             "bsl-test-name",
+			provider:         "",
+			bucket:           "backups",
+			expectedErrorMsg: "backupstoragelocation provider cannot be an empty string",
+		},
+		{
+			name:             "bsl-test-name",
+			provider:         "aws",
+			bucket:           "",
+			expectedErrorMsg: "backupstoragelocation bucket cannot be an empty string",
+		},
+	}
+
+	for _, test := range testCases {
+		testBuilder := NewBackupStorageLocationBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			test.name, "bsl-test-namespace", test.provider, test.bucket)
+
+		if testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg) {
+			assert.Equal(t, test.bucket, testBuilder.Definition.Spec.ObjectStorage.Bucket)
+		}
+	}
+}
+
+func TestBackupStorageLocationWithSpec(t *testing.T) {
+	credential := corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"},
+		Key:                  "cloud",
+	}
+
+	testBuilder := buildValidBackupStorageLocationTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithPrefix("velero").
+		WithCredential(credential).
+		WithConfig("region", "us-east-1").
+		WithDefault()
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "velero", testBuilder.Definition.Spec.ObjectStorage.Prefix)
+	assert.Equal(t, &credential, testBuilder.Definition.Spec.Credential)
+	assert.Equal(t, map[string]string{"region": "us-east-1"}, testBuilder.Definition.Spec.Config)
+	assert.True(t, testBuilder.Definition.Spec.Default)
+
+	testBuilder = testBuilder.WithCredential(corev1.SecretKeySelector{Key: "cloud"})
+	assert.EqualError(t, testBuilder.errorMsg, "backupstoragelocation credential must set the secret name and key")
+}
+
+func TestBackupStorageLocationCreate(t *testing.T) {
+	testBuilder, err := buildValidBackupStorageLocationTestBuilder(
+		clients.GetTestClients(clients.TestClientParams{})).Create()
+	assert.Nil(t, err)
+	assert.Equal(t, "bsl-test-name", testBuilder.Object.Name)
+
+	_, err = PullBackupStorageLocation(clients.GetTestClients(clients.TestClientParams{}),
+		"bsl-test-name", "bsl-test-namespace")
+	assert.EqualError(t, err, "backupstoragelocation object bsl-test-name doesn't exist in namespace bsl-test-namespace")
+}
+
+func TestBackupStorageLocationWaitUntilAvailable(t *testing.T) {
+	testCases := []struct {
+		phase            velerov1.BackupStorageLocationPhase
+		message          string
+		expectedErrorMsg string
+	}{
+		{
+			phase:            velerov1.BackupStorageLocationPhaseAvailable,
+			expectedErrorMsg: "",
+		},
+		{
+			phase:   velerov1.BackupStorageLocationPhaseUnavailable,
+			message: "BackupStorageLocation \"bsl-test-name\" is unavailable: NoSuchBucket",
+			expectedErrorMsg: "backupstoragelocation bsl-test-name in namespace bsl-test-namespace is not available, " +
+				"last phase \"Unavailable\" message \"BackupStorageLocation \\\"bsl-test-name\\\" is unavailable: " +
+				"NoSuchBucket\": context deadline exceeded",
+		},
+	}
+
+	for _, test := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyBackupStorageLocation(test.phase, test.message)},
+		})
+
+		err := buildValidBackupStorageLocationTestBuilder(testSettings).WaitUntilAvailable(time.Second)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, err)
+	}
+}
+
+func buildValidBackupStorageLocationTestBuilder(apiClient *clients.Settings) *BackupStorageLocationBuilder {
+	return NewBackupStorageLocationBuilder(apiClient, "bsl-test-name", "bsl-test-namespace", "aws", "backups")
+}
+
+func buildDummyBackupStorageLocation(
+	phase velerov1.BackupStorageLocationPhase, message string) *velerov1.BackupStorageLocation {
+	return &velerov1.BackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bsl-test-name",
+			Namespace: "bsl-test-namespace",
+		},
+		Spec: velerov1.BackupStorageLocationSpec{
+			Provider: "aws",
+			StorageType: velerov1.StorageType{
+				ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "backups"},
+			},
+		},
+		Status: velerov1.BackupStorageLocationStatus{
+			Phase:   phase,
+			Message: message,
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package velero
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	veleroClient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// getLogs asks velero for a download url of the logs of the given backup or restore through a DownloadRequest, then
+// downloads and decompresses them. The DownloadRequest is removed once the url is retrieved.
+func getLogs(apiClient veleroClient.Interface,
+	name, nsname string, kind velerov1.DownloadTargetKind, timeout time.Duration) (string, error) {
+	downloadRequest := &velerov1.DownloadRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", name, time.Now().Unix()),
+			Namespace: nsname,
+		},
+		Spec: velerov1.DownloadRequestSpec{
+			Target: velerov1.DownloadTarget{Kind: kind, Name: name},
+		},
+	}
+
+	logging.V(100).Infof("Creating download request %s for %s %s in namespace %s",
+		downloadRequest.Name, kind, name, nsname)
+
+	downloadRequest, err := apiClient.VeleroV1().DownloadRequests(nsname).Create(
+		context.TODO(), downloadRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to create download request for %s %s in namespace %s: %w", kind, name, nsname, err)
+	}
+
+	defer func() {
+		err := apiClient.VeleroV1().DownloadRequests(nsname).Delete(
+			context.TODO(), downloadRequest.Name, metav1.DeleteOptions{})
+		if err != nil {
+			logging.V(100).Infof("Failed to delete download request %s: %v", downloadRequest.Name, err)
+		}
+	}()
+
+	var downloadURL string
+
+	err = wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			processedRequest, err := apiClient.VeleroV1().DownloadRequests(nsname).Get(
+				context.TODO(), downloadRequest.Name, metav1.GetOptions{})
+			if err != nil {
+				logging.V(100).Infof("Failed to get download request %s: %v", downloadRequest.Name, err)
+
+				return false, nil
+			}
+
+			downloadURL = processedRequest.Status.DownloadURL
+
+			return processedRequest.Status.Phase == velerov1.DownloadRequestPhaseProcessed && downloadURL != "", nil
+		})
+
+	if err != nil {
+		return "", fmt.Errorf("velero did not provide the download url of %s %s in namespace %s: %w",
+			kind, name, nsname, err)
+	}
+
+	return downloadGzippedContent(downloadURL)
+}
+
+// downloadGzippedContent downloads the gzipped content at the given url and returns it decompressed.
+func downloadGzippedContent(downloadURL string) (string, error) {
+	request, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to download content: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download content: unexpected status %s", response.Status)
+	}
+
+	gzipReader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to decompress content: %w", err)
+	}
+
+	defer gzipReader.Close()
+
+	content, err := io.ReadAll(gzipReader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read content: %w", err)
+	}
+
+	return string(content), nil
+}
@@ -0,0 +1,72 @@
+package velero
+
+import (
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+func TestGetLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		gzipWriter := gzip.NewWriter(writer)
+		_, _ = gzipWriter.Write([]byte("level=info msg=\"Backup completed\""))
+		_ = gzipWriter.Close()
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		reactors         []clients.TestReactor
+		expectedLogs     string
+		expectedErrorMsg string
+	}{
+		{
+			reactors:     []clients.TestReactor{buildProcessedDownloadRequestReactor(server.URL)},
+			expectedLogs: "level=info msg=\"Backup completed\"",
+		},
+		{
+			reactors: nil,
+			expectedErrorMsg: "velero did not provide the download url of BackupLog backup-test-name in namespace " +
+				"backup-test-namespace: context deadline exceeded",
+		},
+	}
+
+	for _, test := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{Reactors: test.reactors})
+		testBuilder := NewBackupBuilder(testSettings, "backup-test-name", "backup-test-namespace")
+
+		logs, err := testBuilder.GetLogs(time.Second)
+		if testhelper.AssertErrorMsg(t, test.expectedErrorMsg, err) {
+			assert.Equal(t, test.expectedLogs, logs)
+		}
+	}
+}
+
+// buildProcessedDownloadRequestReactor returns a reactor answering every DownloadRequest Get as velero would once
+// it processed the request.
+func buildProcessedDownloadRequestReactor(downloadURL string) clients.TestReactor {
+	return clients.TestReactor{
+		Verb:     "get",
+		Resource: "downloadrequests",
+		Reaction: func(action k8sTesting.Action) (bool, runtime.Object, error) {
+			getAction, _ := action.(k8sTesting.GetAction)
+
+			return true, &velerov1.DownloadRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: getAction.GetName(), Namespace: getAction.GetNamespace()},
+				Status: velerov1.DownloadRequestStatus{
+					Phase:       velerov1.DownloadRequestPhaseProcessed,
+					DownloadURL: downloadURL,
+				},
+			}, nil
+		},
+	}
+}
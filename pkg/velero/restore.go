@@ -2,17 +2,28 @@ package velero
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	veleroClient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"golang.org/x/exp/slices"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+var terminalRestorePhases = []velerov1.RestorePhase{
+	velerov1.RestorePhaseCompleted,
+	velerov1.RestorePhasePartiallyFailed,
+	velerov1.RestorePhaseFailed,
+	velerov1.RestorePhaseFailedValidation,
+}
+
 // RestoreBuilder provides a struct for restore object from the cluster and a restore definition.
 type RestoreBuilder struct {
 	// Restore definition, used to create the restore object.
@@ -20,14 +31,14 @@ type RestoreBuilder struct {
 	// Created restore object.
 	Object *velerov1.Restore
 	// Used to store latest error message upon defining or mutating restore definition.
-	errorMsg string
+	errorMsg error
 	// api client to interact with the cluster.
 	apiClient veleroClient.Interface
 }
 
 // NewRestoreBuilder creates a new instance of RestoreBuilder.
 func NewRestoreBuilder(apiClient *clients.Settings, name, nsname, backupName string) *RestoreBuilder {
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Initializing new restore structure with the following params: "+
 			"name: %s, namespace: %s, restoreName: %s", name, nsname, backupName)
 
@@ -45,21 +56,21 @@ func NewRestoreBuilder(apiClient *clients.Settings, name, nsname, backupName str
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the restore is empty")
+		logging.V(100).Infof("The name of the restore is empty")
 
-		builder.errorMsg = "restore name cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore name cannot be an empty string"))
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The namespace of the restore is empty")
+		logging.V(100).Infof("The namespace of the restore is empty")
 
-		builder.errorMsg = "restore namespace cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore namespace cannot be an empty string"))
 	}
 
 	if backupName == "" {
-		glog.V(100).Infof("The backupName of the restore is empty")
+		logging.V(100).Infof("The backupName of the restore is empty")
 
-		builder.errorMsg = "restore backupName cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore backupName cannot be an empty string"))
 	}
 
 	return builder
@@ -67,7 +78,7 @@ func NewRestoreBuilder(apiClient *clients.Settings, name, nsname, backupName str
 
 // PullRestore loads an existing restore into RestoreBuilder struct.
 func PullRestore(apiClient *clients.Settings, name, nsname string) (*RestoreBuilder, error) {
-	glog.V(100).Infof("Pulling existing restore name: %s under namespace: %s", name, nsname)
+	logging.V(100).Infof("Pulling existing restore name: %s under namespace: %s", name, nsname)
 
 	builder := RestoreBuilder{
 		apiClient: apiClient.VeleroClient,
@@ -102,17 +113,17 @@ func (builder *RestoreBuilder) WithStorageLocation(location string) *RestoreBuil
 		return builder
 	}
 
-	glog.V(100).Infof(
+	logging.V(100).Infof(
 		"Adding storage location %s to restore %s in namespace %s",
 		location, builder.Definition.Name, builder.Definition.Namespace)
 
 	if location == "" {
-		glog.V(100).Infof("Backup storage location is empty")
+		logging.V(100).Infof("Backup storage location is empty")
 
-		builder.errorMsg = "restore storage location cannot be an empty string"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore storage location cannot be an empty string"))
 	}
 
-	if builder.errorMsg != "" {
+	if builder.errorMsg != nil {
 		return builder
 	}
 
@@ -125,13 +136,198 @@ func (builder *RestoreBuilder) WithStorageLocation(location string) *RestoreBuil
 	return builder
 }
 
+// WithIncludedNamespace adds the specified namespace of the backup for inclusion when performing a restore.
+func (builder *RestoreBuilder) WithIncludedNamespace(namespace string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding namespace %s to restore %s in namespace %s includedNamespaces field",
+		namespace, builder.Definition.Name, builder.Definition.Namespace)
+
+	if namespace == "" {
+		logging.V(100).Infof("Restore includedNamespace is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore includedNamespace cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.IncludedNamespaces = append(builder.Definition.Spec.IncludedNamespaces, namespace)
+
+	return builder
+}
+
+// WithExcludedNamespace adds the specified namespace of the backup for exclusion when performing a restore.
+func (builder *RestoreBuilder) WithExcludedNamespace(namespace string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding namespace %s to restore %s in namespace %s excludedNamespaces field",
+		namespace, builder.Definition.Name, builder.Definition.Namespace)
+
+	if namespace == "" {
+		logging.V(100).Infof("Restore excludedNamespace is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore excludedNamespace cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedNamespaces = append(builder.Definition.Spec.ExcludedNamespaces, namespace)
+
+	return builder
+}
+
+// WithIncludedResource adds the specified resource of the backup for inclusion when performing a restore.
+func (builder *RestoreBuilder) WithIncludedResource(resource string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding resource %s to restore %s in namespace %s includedResources field",
+		resource, builder.Definition.Name, builder.Definition.Namespace)
+
+	if resource == "" {
+		logging.V(100).Infof("Restore includedResource is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore includedResource cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.IncludedResources = append(builder.Definition.Spec.IncludedResources, resource)
+
+	return builder
+}
+
+// WithExcludedResource adds the specified resource of the backup for exclusion when performing a restore.
+func (builder *RestoreBuilder) WithExcludedResource(resource string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding resource %s to restore %s in namespace %s excludedResources field",
+		resource, builder.Definition.Name, builder.Definition.Namespace)
+
+	if resource == "" {
+		logging.V(100).Infof("Restore excludedResource is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore excludedResource cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.ExcludedResources = append(builder.Definition.Spec.ExcludedResources, resource)
+
+	return builder
+}
+
+// WithLabelSelector sets the label selector of the restore. Only resources of the backup matching it are restored.
+func (builder *RestoreBuilder) WithLabelSelector(selector metav1.LabelSelector) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting label selector %v of restore %s in namespace %s",
+		selector, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		logging.V(100).Infof("Restore labelSelector is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore labelSelector cannot be empty"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.LabelSelector = &selector
+
+	return builder
+}
+
+// WithNamespaceMapping restores the resources of the source namespace of the backup into the target namespace.
+func (builder *RestoreBuilder) WithNamespaceMapping(source, target string) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Mapping namespace %s to %s in restore %s in namespace %s",
+		source, target, builder.Definition.Name, builder.Definition.Namespace)
+
+	if source == "" || target == "" {
+		logging.V(100).Infof("Restore namespaceMapping source or target is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("restore namespaceMapping source and target cannot be empty strings"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	if builder.Definition.Spec.NamespaceMapping == nil {
+		builder.Definition.Spec.NamespaceMapping = make(map[string]string)
+	}
+
+	builder.Definition.Spec.NamespaceMapping[source] = target
+
+	return builder
+}
+
+// WithHook adds a hook running init containers or commands in the selected pods after they are restored.
+func (builder *RestoreBuilder) WithHook(hook velerov1.RestoreResourceHookSpec) *RestoreBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding hook %s to restore %s in namespace %s", hook.Name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if hook.Name == "" {
+		logging.V(100).Infof("Restore hook name is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore hook name cannot be an empty string"))
+	}
+
+	if len(hook.PostHooks) == 0 {
+		logging.V(100).Infof("Restore hook %s has no post hooks", hook.Name)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("restore hook must have at least one post hook"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Hooks.Resources = append(builder.Definition.Spec.Hooks.Resources, hook)
+
+	return builder
+}
+
 // Exists checks whether the given restore object exists.
 func (builder *RestoreBuilder) Exists() bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if restore %s exists in namespace %s",
+	logging.V(100).Infof("Checking if restore %s exists in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -147,7 +343,7 @@ func (builder *RestoreBuilder) Create() (*RestoreBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating restore %s in namespace %s",
+	logging.V(100).Infof("Creating restore %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
@@ -165,7 +361,7 @@ func (builder *RestoreBuilder) Update() (*RestoreBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating restore %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	logging.V(100).Infof("Updating restore %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
 	builder.Object, err = builder.apiClient.VeleroV1().Restores(builder.Definition.Namespace).Update(
@@ -180,7 +376,7 @@ func (builder *RestoreBuilder) Delete() (*RestoreBuilder, error) {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting restore %s in namespace %s",
+	logging.V(100).Infof("Deleting restore %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	if !builder.Exists() {
@@ -199,33 +395,115 @@ func (builder *RestoreBuilder) Delete() (*RestoreBuilder, error) {
 	return builder, nil
 }
 
+// WaitForPhase waits for the duration of the defined timeout or until the restore reaches the given phase, usually
+// Completed or PartiallyFailed. It returns early when the restore reaches another terminal phase, since it can no
+// longer reach the expected one.
+func (builder *RestoreBuilder) WaitForPhase(phase velerov1.RestorePhase, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for restore %s in namespace %s to reach phase %s",
+		builder.Definition.Name, builder.Definition.Namespace, phase)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				logging.V(100).Infof("Restore %s does not exist yet", builder.Definition.Name)
+
+				return false, nil
+			}
+
+			if builder.Object.Status.Phase == phase {
+				return true, nil
+			}
+
+			if slices.Contains(terminalRestorePhases, builder.Object.Status.Phase) {
+				return false, fmt.Errorf("restore %s in namespace %s reached phase %s instead of %s",
+					builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.Phase, phase)
+			}
+
+			return false, nil
+		})
+}
+
+// GetErrorsCount returns the number of errors velero encountered while performing the restore.
+func (builder *RestoreBuilder) GetErrorsCount() (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.V(100).Infof("Getting errors count of restore %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("restore object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Errors, nil
+}
+
+// GetWarningsCount returns the number of warnings velero reported while performing the restore.
+func (builder *RestoreBuilder) GetWarningsCount() (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	logging.V(100).Infof("Getting warnings count of restore %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("restore object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder.Object.Status.Warnings, nil
+}
+
+// GetLogs retrieves the logs velero uploaded to the backup storage location while performing the restore. The
+// timeout bounds how long velero may take to provide the download url.
+func (builder *RestoreBuilder) GetLogs(timeout time.Duration) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting logs of restore %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return getLogs(builder.apiClient, builder.Definition.Name, builder.Definition.Namespace,
+		velerov1.DownloadTargetKindRestoreLog, timeout)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *RestoreBuilder) validate() (bool, error) {
 	resourceCRD := "Restore"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
 
-		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
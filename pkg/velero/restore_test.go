@@ -2,8 +2,10 @@ package velero
 
 import (
 	"testing"
+	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -48,7 +50,7 @@ func TestNewRestoreBuilder(t *testing.T) {
 	for _, test := range testcases {
 		testBuilder := NewRestoreBuilder(&clients.Settings{VeleroClient: &veleroClient.Clientset{}},
 			test.name, test.namespace, test.backupName)
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrMsg, testBuilder.errorMsg)
 	}
 }
 
@@ -159,6 +161,132 @@ func TestRestoreWithStorageLocation(t *testing.T) {
 
 		testBuilder.WithStorageLocation(test.location)
 
-		assert.Equal(t, testBuilder.errorMsg, test.expectedErrorMsg)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg)
+	}
+}
+
+func TestRestoreWithFilters(t *testing.T) {
+	selector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}
+	hook := velerov1.RestoreResourceHookSpec{
+		Name: "migrate",
+		PostHooks: []velerov1.RestoreResourceHook{{
+			Exec: &velerov1.ExecRestoreHook{Command: []string{"/migrate.sh"}},
+		}},
+	}
+
+	testBuilder := buildValidRestoreTestBuilder().
+		WithIncludedNamespace("includeme").
+		WithExcludedNamespace("excludeme").
+		WithIncludedResource("deployments").
+		WithExcludedResource("secrets").
+		WithLabelSelector(selector).
+		WithNamespaceMapping("includeme", "restored").
+		WithHook(hook)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, []string{"includeme"}, testBuilder.Definition.Spec.IncludedNamespaces)
+	assert.Equal(t, []string{"excludeme"}, testBuilder.Definition.Spec.ExcludedNamespaces)
+	assert.Equal(t, []string{"deployments"}, testBuilder.Definition.Spec.IncludedResources)
+	assert.Equal(t, []string{"secrets"}, testBuilder.Definition.Spec.ExcludedResources)
+	assert.Equal(t, &selector, testBuilder.Definition.Spec.LabelSelector)
+	assert.Equal(t, map[string]string{"includeme": "restored"}, testBuilder.Definition.Spec.NamespaceMapping)
+	assert.Equal(t, []velerov1.RestoreResourceHookSpec{hook}, testBuilder.Definition.Spec.Hooks.Resources)
+}
+
+func TestRestoreWithFiltersErrors(t *testing.T) {
+	testCases := []struct {
+		mutate           func(builder *RestoreBuilder) *RestoreBuilder
+		expectedErrorMsg string
+	}{
+		{
+			mutate:           func(builder *RestoreBuilder) *RestoreBuilder { return builder.WithIncludedNamespace("") },
+			expectedErrorMsg: "restore includedNamespace cannot be an empty string",
+		},
+		{
+			mutate:           func(builder *RestoreBuilder) *RestoreBuilder { return builder.WithExcludedResource("") },
+			expectedErrorMsg: "restore excludedResource cannot be an empty string",
+		},
+		{
+			mutate: func(builder *RestoreBuilder) *RestoreBuilder {
+				return builder.WithNamespaceMapping("source", "")
+			},
+			expectedErrorMsg: "restore namespaceMapping source and target cannot be empty strings",
+		},
+		{
+			mutate: func(builder *RestoreBuilder) *RestoreBuilder {
+				return builder.WithHook(velerov1.RestoreResourceHookSpec{Name: "empty"})
+			},
+			expectedErrorMsg: "restore hook must have at least one post hook",
+		},
+	}
+
+	for _, test := range testCases {
+		testBuilder := test.mutate(buildValidRestoreTestBuilder())
+		assert.EqualError(t, testBuilder.errorMsg, test.expectedErrorMsg)
+	}
+}
+
+func TestRestoreWaitForPhase(t *testing.T) {
+	testCases := []struct {
+		phase            velerov1.RestorePhase
+		expectedErrorMsg string
+	}{
+		{
+			phase:            velerov1.RestorePhasePartiallyFailed,
+			expectedErrorMsg: "",
+		},
+		{
+			phase: velerov1.RestorePhaseCompleted,
+			expectedErrorMsg: "restore restore-test-name in namespace restore-test-namespace reached phase Completed " +
+				"instead of PartiallyFailed",
+		},
+		{
+			phase:            velerov1.RestorePhaseInProgress,
+			expectedErrorMsg: "context deadline exceeded",
+		},
+	}
+
+	for _, test := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyRestoreWithStatus(test.phase)},
+		})
+
+		testBuilder := NewRestoreBuilder(testSettings, "restore-test-name", "restore-test-namespace", "backup")
+
+		err := testBuilder.WaitForPhase(velerov1.RestorePhasePartiallyFailed, time.Second)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, err)
+	}
+}
+
+func TestRestoreGetErrorsAndWarningsCount(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyRestoreWithStatus(velerov1.RestorePhasePartiallyFailed)},
+	})
+
+	testBuilder := NewRestoreBuilder(testSettings, "restore-test-name", "restore-test-namespace", "backup")
+
+	errorsCount, err := testBuilder.GetErrorsCount()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, errorsCount)
+
+	warningsCount, err := testBuilder.GetWarningsCount()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, warningsCount)
+}
+
+func buildDummyRestoreWithStatus(phase velerov1.RestorePhase) *velerov1.Restore {
+	return &velerov1.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "restore-test-name",
+			Namespace: "restore-test-namespace",
+		},
+		Spec: velerov1.RestoreSpec{
+			BackupName: "backup",
+		},
+		Status: velerov1.RestoreStatus{
+			Phase:    phase,
+			Errors:   1,
+			Warnings: 4,
+		},
 	}
 }
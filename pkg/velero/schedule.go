@@ -0,0 +1,358 @@
+package velero
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/robfig/cron"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	veleroClient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ScheduleBuilder provides a struct for schedule object from the cluster and a schedule definition.
+type ScheduleBuilder struct {
+	// Schedule definition, used to create the schedule object.
+	Definition *velerov1.Schedule
+	// Created schedule object.
+	Object *velerov1.Schedule
+	// Used to store latest error message upon defining or mutating schedule definition.
+	errorMsg error
+	// api client to interact with the cluster.
+	apiClient veleroClient.Interface
+}
+
+// NewScheduleBuilder creates a new instance of ScheduleBuilder. The schedule is a standard 5 field cron expression
+// or a descriptor such as @daily, as accepted by velero.
+func NewScheduleBuilder(apiClient *clients.Settings, name, nsname, schedule string) *ScheduleBuilder {
+	logging.V(100).Infof(
+		"Initializing new schedule structure with the following params: "+
+			"name: %s, namespace: %s, schedule: %s", name, nsname, schedule)
+
+	builder := &ScheduleBuilder{
+		apiClient: apiClient.VeleroClient,
+		Definition: &velerov1.Schedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: velerov1.ScheduleSpec{
+				Schedule: schedule,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the schedule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("schedule name cannot be an empty string"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the schedule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("schedule namespace cannot be an empty string"))
+	}
+
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		logging.V(100).Infof("The cron expression %s of the schedule is invalid", schedule)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("schedule cron expression '%s' is invalid: %w", schedule, err))
+	}
+
+	return builder
+}
+
+// PullSchedule loads an existing schedule into ScheduleBuilder struct.
+func PullSchedule(apiClient *clients.Settings, name, nsname string) (*ScheduleBuilder, error) {
+	logging.V(100).Infof("Pulling existing schedule name: %s under namespace: %s", name, nsname)
+
+	builder := ScheduleBuilder{
+		apiClient: apiClient.VeleroClient,
+		Definition: &velerov1.Schedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("schedule name cannot be empty")
+	}
+
+	if nsname == "" {
+		return nil, fmt.Errorf("schedule namespace cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("schedule object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithStorageLocation sets the storage location of the backups created by the schedule.
+func (builder *ScheduleBuilder) WithStorageLocation(location string) *ScheduleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting storage location %s of schedule %s in namespace %s",
+		location, builder.Definition.Name, builder.Definition.Namespace)
+
+	if location == "" {
+		logging.V(100).Infof("Schedule storage location is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("schedule storage location cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Template.StorageLocation = location
+
+	return builder
+}
+
+// WithIncludedNamespace adds the specified namespace for inclusion in the backups created by the schedule.
+func (builder *ScheduleBuilder) WithIncludedNamespace(namespace string) *ScheduleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Adding namespace %s to schedule %s in namespace %s includedNamespaces field",
+		namespace, builder.Definition.Name, builder.Definition.Namespace)
+
+	if namespace == "" {
+		logging.V(100).Infof("Schedule includedNamespace is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("schedule includedNamespace cannot be an empty string"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Template.IncludedNamespaces = append(
+		builder.Definition.Spec.Template.IncludedNamespaces, namespace)
+
+	return builder
+}
+
+// WithTTL sets how long the backups created by the schedule are kept before velero garbage collects them.
+func (builder *ScheduleBuilder) WithTTL(ttl time.Duration) *ScheduleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting ttl %s of schedule %s in namespace %s", ttl, builder.Definition.Name, builder.Definition.Namespace)
+
+	if ttl <= 0 {
+		logging.V(100).Infof("Schedule ttl is not positive")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("schedule ttl must be greater than 0"))
+	}
+
+	if builder.errorMsg != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Template.TTL = metav1.Duration{Duration: ttl}
+
+	return builder
+}
+
+// WithPaused sets whether the schedule is created paused, in which case no backup is created until it is unpaused.
+func (builder *ScheduleBuilder) WithPaused(paused bool) *ScheduleBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof(
+		"Setting paused %t of schedule %s in namespace %s", paused, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.Paused = paused
+
+	return builder
+}
+
+// Exists checks whether the given schedule exists.
+func (builder *ScheduleBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if schedule %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.VeleroV1().Schedules(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a schedule according to the schedule definition and stores the created object in the schedule builder.
+func (builder *ScheduleBuilder) Create() (*ScheduleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating schedule %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	if !builder.Exists() {
+		builder.Object, err = builder.apiClient.VeleroV1().Schedules(builder.Definition.Namespace).Create(
+			context.TODO(), builder.Definition, metav1.CreateOptions{})
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing schedule object with the schedule definition in builder.
+func (builder *ScheduleBuilder) Update() (*ScheduleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating schedule %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.apiClient.VeleroV1().Schedules(builder.Definition.Namespace).Update(
+		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+
+	return builder, err
+}
+
+// Delete removes the schedule object and resets the builder object. Backups created by the schedule are kept.
+func (builder *ScheduleBuilder) Delete() (*ScheduleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Deleting schedule %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("schedule cannot be deleted because it does not exist")
+	}
+
+	err := builder.apiClient.VeleroV1().Schedules(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return builder, fmt.Errorf("can not delete schedule: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
+
+// Pause stops the existing schedule from creating backups.
+func (builder *ScheduleBuilder) Pause() (*ScheduleBuilder, error) {
+	return builder.setPaused(true)
+}
+
+// Unpause resumes the creation of backups by the existing schedule.
+func (builder *ScheduleBuilder) Unpause() (*ScheduleBuilder, error) {
+	return builder.setPaused(false)
+}
+
+// WaitUntilEnabled waits for the duration of the defined timeout or until velero validated and enabled the schedule.
+// It returns early with the validation errors when velero rejects the schedule.
+func (builder *ScheduleBuilder) WaitUntilEnabled(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for schedule %s in namespace %s to be enabled",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				logging.V(100).Infof("Schedule %s does not exist yet", builder.Definition.Name)
+
+				return false, nil
+			}
+
+			if builder.Object.Status.Phase == velerov1.SchedulePhaseFailedValidation {
+				return false, fmt.Errorf("schedule %s in namespace %s failed validation: %v",
+					builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.ValidationErrors)
+			}
+
+			return builder.Object.Status.Phase == velerov1.SchedulePhaseEnabled, nil
+		})
+}
+
+// setPaused updates the paused field of the existing schedule.
+func (builder *ScheduleBuilder) setPaused(paused bool) (*ScheduleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Setting paused %t of schedule %s in namespace %s",
+		paused, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("schedule object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	builder.Definition = builder.Object
+	builder.Definition.Spec.Paused = paused
+
+	return builder.Update()
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ScheduleBuilder) validate() (bool, error) {
+	resourceCRD := "Schedule"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,191 @@
+package velero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestNewScheduleBuilder(t *testing.T) {
+	testCases := []struct {
+		name             string
+		namespace        string
+		schedule         string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "schedule-test-name",
+			namespace:        "schedule-test-namespace",
+			schedule:         "0 */6 * * *",
+			expectedErrorMsg: "",
+		},
+		{
+			name:             "schedule-test-name",
+			namespace:        "schedule-test-namespace",
+			schedule:         "@daily",
+			expectedErrorMsg: "",
+		},
+		{
+			name:             "",
+			namespace:        "schedule-test-namespace",
+			schedule:         "@daily",
+			expectedErrorMsg: "schedule name cannot be an empty string",
+		},
+		{
+			name:             "schedule-test-name",
+			namespace:        "",
+			schedule:         "@daily",
+			expectedErrorMsg: "schedule namespace cannot be an empty string",
+		},
+		{
+			name:             "schedule-test-name",
+			namespace:        "schedule-test-namespace",
+			schedule:         "0 25 * * *",
+			expectedErrorMsg: "schedule cron expression '0 25 * * *' is invalid: End of range (25) above maximum (23): 25",
+		},
+	}
+
+	for _, test := range testCases {
+		testBuilder := NewScheduleBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), test.name, test.namespace, test.schedule)
+
+		if testhelper.AssertErrorMsg(t, test.expectedErrorMsg, testBuilder.errorMsg) {
+			assert.Equal(t, test.schedule, testBuilder.Definition.Spec.Schedule)
+		}
+	}
+}
+
+func TestPullSchedule(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		expectedErrorMsg    string
+	}{
+		{
+			name:                "schedule-test-name",
+			addToRuntimeObjects: true,
+			expectedErrorMsg:    "",
+		},
+		{
+			name:                "schedule-test-name",
+			addToRuntimeObjects: false,
+			expectedErrorMsg:    "schedule object schedule-test-name doesn't exist in namespace schedule-test-namespace",
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: false,
+			expectedErrorMsg:    "schedule name cannot be empty",
+		},
+	}
+
+	for _, test := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if test.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummySchedule(velerov1.SchedulePhaseEnabled))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		testBuilder, err := PullSchedule(testSettings, test.name, "schedule-test-namespace")
+		if testhelper.AssertErrorMsg(t, test.expectedErrorMsg, err) {
+			assert.Equal(t, "@daily", testBuilder.Definition.Spec.Schedule)
+		}
+	}
+}
+
+func TestScheduleWithTemplate(t *testing.T) {
+	testBuilder := buildValidScheduleTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithStorageLocation("default").
+		WithIncludedNamespace("includeme").
+		WithTTL(24 * time.Hour).
+		WithPaused(true)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "default", testBuilder.Definition.Spec.Template.StorageLocation)
+	assert.Equal(t, []string{"includeme"}, testBuilder.Definition.Spec.Template.IncludedNamespaces)
+	assert.Equal(t, 24*time.Hour, testBuilder.Definition.Spec.Template.TTL.Duration)
+	assert.True(t, testBuilder.Definition.Spec.Paused)
+
+	testBuilder = testBuilder.WithTTL(-time.Hour)
+	assert.EqualError(t, testBuilder.errorMsg, "schedule ttl must be greater than 0")
+}
+
+func TestSchedulePauseUnpause(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummySchedule(velerov1.SchedulePhaseEnabled)},
+	})
+
+	testBuilder, err := buildValidScheduleTestBuilder(testSettings).Pause()
+	assert.Nil(t, err)
+	assert.True(t, testBuilder.Object.Spec.Paused)
+
+	testBuilder, err = testBuilder.Unpause()
+	assert.Nil(t, err)
+	assert.False(t, testBuilder.Object.Spec.Paused)
+
+	_, err = buildValidScheduleTestBuilder(clients.GetTestClients(clients.TestClientParams{})).Pause()
+	assert.EqualError(t, err, "schedule object schedule-test-name doesn't exist in namespace schedule-test-namespace")
+}
+
+func TestScheduleWaitUntilEnabled(t *testing.T) {
+	testCases := []struct {
+		phase            velerov1.SchedulePhase
+		expectedErrorMsg string
+	}{
+		{
+			phase:            velerov1.SchedulePhaseEnabled,
+			expectedErrorMsg: "",
+		},
+		{
+			phase: velerov1.SchedulePhaseFailedValidation,
+			expectedErrorMsg: "schedule schedule-test-name in namespace schedule-test-namespace failed validation: " +
+				"[invalid schedule]",
+		},
+		{
+			phase:            velerov1.SchedulePhaseNew,
+			expectedErrorMsg: "context deadline exceeded",
+		},
+	}
+
+	for _, test := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummySchedule(test.phase)},
+		})
+
+		err := buildValidScheduleTestBuilder(testSettings).WaitUntilEnabled(time.Second)
+		testhelper.AssertErrorMsg(t, test.expectedErrorMsg, err)
+	}
+}
+
+func buildValidScheduleTestBuilder(apiClient *clients.Settings) *ScheduleBuilder {
+	return NewScheduleBuilder(apiClient, "schedule-test-name", "schedule-test-namespace", "@daily")
+}
+
+func buildDummySchedule(phase velerov1.SchedulePhase) *velerov1.Schedule {
+	schedule := &velerov1.Schedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "schedule-test-name",
+			Namespace: "schedule-test-namespace",
+		},
+		Spec: velerov1.ScheduleSpec{
+			Schedule: "@daily",
+		},
+		Status: velerov1.ScheduleStatus{
+			Phase: phase,
+		},
+	}
+
+	if phase == velerov1.SchedulePhaseFailedValidation {
+		schedule.Status.ValidationErrors = []string{"invalid schedule"}
+	}
+
+	return schedule
+}
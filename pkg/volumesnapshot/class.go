@@ -0,0 +1,305 @@
+package volumesnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/volumesnapshot/snapshottypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClassBuilder provides struct for the VolumeSnapshotClass object containing connection to the cluster and the
+// VolumeSnapshotClass definitions.
+type ClassBuilder struct {
+	// VolumeSnapshotClass definition. Used to create the VolumeSnapshotClass object.
+	Definition *snapshottypes.VolumeSnapshotClass
+	// Created VolumeSnapshotClass object.
+	Object *snapshottypes.VolumeSnapshotClass
+	// Used in functions that define or mutate VolumeSnapshotClass definition. errorMsg is processed before the
+	// VolumeSnapshotClass object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewClassBuilder creates a new instance of ClassBuilder for the given CSI driver.
+func NewClassBuilder(
+	apiClient *clients.Settings, name, driver string, deletionPolicy snapshottypes.DeletionPolicy) *ClassBuilder {
+	logging.V(100).Infof(
+		"Initializing new VolumeSnapshotClass structure with the following params: name: %s, driver: %s, "+
+			"deletionPolicy: %s", name, driver, deletionPolicy)
+
+	builder := ClassBuilder{
+		apiClient: apiClient,
+		Definition: &snapshottypes.VolumeSnapshotClass{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       VolumeSnapshotClassKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Driver:         driver,
+			DeletionPolicy: deletionPolicy,
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the VolumeSnapshotClass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshotClass 'name' cannot be empty"))
+	}
+
+	if driver == "" {
+		logging.V(100).Infof("The driver of the VolumeSnapshotClass is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshotClass 'driver' cannot be empty"))
+	}
+
+	err := validateDeletionPolicy(deletionPolicy)
+	if err != nil {
+		logging.V(100).Infof("The deletionPolicy of the VolumeSnapshotClass is invalid")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshotClass %w", err))
+	}
+
+	return &builder
+}
+
+// PullClass pulls existing VolumeSnapshotClass from cluster.
+func PullClass(apiClient *clients.Settings, name string) (*ClassBuilder, error) {
+	logging.V(100).Infof("Pulling existing VolumeSnapshotClass name %s from cluster", name)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("VolumeSnapshotClass 'apiClient' cannot be empty")
+	}
+
+	builder := ClassBuilder{
+		apiClient: apiClient,
+		Definition: &snapshottypes.VolumeSnapshotClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the VolumeSnapshotClass is empty")
+
+		return nil, fmt.Errorf("VolumeSnapshotClass 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("VolumeSnapshotClass object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithParameter adds a driver specific parameter to the VolumeSnapshotClass.
+func (builder *ClassBuilder) WithParameter(key, value string) *ClassBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding parameter %s=%s to VolumeSnapshotClass %s", key, value, builder.Definition.Name)
+
+	if key == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshotClass parameter key cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Parameters == nil {
+		builder.Definition.Parameters = make(map[string]string)
+	}
+
+	builder.Definition.Parameters[key] = value
+
+	return builder
+}
+
+// WithDefault marks the VolumeSnapshotClass as the default one of its driver.
+func (builder *ClassBuilder) WithDefault() *ClassBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting VolumeSnapshotClass %s as default", builder.Definition.Name)
+
+	if builder.Definition.Annotations == nil {
+		builder.Definition.Annotations = make(map[string]string)
+	}
+
+	builder.Definition.Annotations[IsDefaultClassAnnotation] = "true"
+
+	return builder
+}
+
+// Get returns VolumeSnapshotClass object if found.
+func (builder *ClassBuilder) Get() (*snapshottypes.VolumeSnapshotClass, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting VolumeSnapshotClass object %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetClassGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("VolumeSnapshotClass object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	volumeSnapshotClass := &snapshottypes.VolumeSnapshotClass{}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, volumeSnapshotClass)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to VolumeSnapshotClass object")
+
+		return nil, err
+	}
+
+	return volumeSnapshotClass, nil
+}
+
+// Exists checks whether the given VolumeSnapshotClass exists.
+func (builder *ClassBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if VolumeSnapshotClass %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a VolumeSnapshotClass in the cluster and stores the created object in struct.
+func (builder *ClassBuilder) Create() (*ClassBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the VolumeSnapshotClass %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredClass, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured VolumeSnapshotClass to unstructured object")
+
+		return builder, err
+	}
+
+	_, err = builder.apiClient.Resource(GetClassGVR()).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredClass}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create VolumeSnapshotClass %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = builder.Get()
+
+	return builder, err
+}
+
+// Delete removes VolumeSnapshotClass object from a cluster.
+func (builder *ClassBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the VolumeSnapshotClass object %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetClassGVR()).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete VolumeSnapshotClass: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetClassGVR returns VolumeSnapshotClass's GroupVersionResource which could be used for Clean function.
+func GetClassGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "volumesnapshotclasses"}
+}
+
+// GetGVR returns the volumesnapshotclass GroupVersionResource, used to register the builder with the cleaner.
+func (builder *ClassBuilder) GetGVR() schema.GroupVersionResource {
+	return GetClassGVR()
+}
+
+// validateDeletionPolicy checks that the deletion policy is one supported by the snapshot controller.
+func validateDeletionPolicy(deletionPolicy snapshottypes.DeletionPolicy) error {
+	switch deletionPolicy {
+	case snapshottypes.VolumeSnapshotContentDelete, snapshottypes.VolumeSnapshotContentRetain:
+		return nil
+	default:
+		return fmt.Errorf("'deletionPolicy' %s is not supported, must be %s or %s", deletionPolicy,
+			snapshottypes.VolumeSnapshotContentDelete, snapshottypes.VolumeSnapshotContentRetain)
+	}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ClassBuilder) validate() (bool, error) {
+	resourceCRD := "VolumeSnapshotClass"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
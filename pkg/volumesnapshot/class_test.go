@@ -0,0 +1,155 @@
+package volumesnapshot
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/volumesnapshot/snapshottypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	volumeSnapshotClassGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    VolumeSnapshotClassKind,
+	}
+	defaultVolumeSnapshotClassName = "test-snapshot-class"
+	defaultVolumeSnapshotDriver    = "csi.example.com"
+)
+
+func TestNewClassBuilder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		driver         string
+		deletionPolicy snapshottypes.DeletionPolicy
+		expectedError  string
+	}{
+		{
+			name:           defaultVolumeSnapshotClassName,
+			driver:         defaultVolumeSnapshotDriver,
+			deletionPolicy: snapshottypes.VolumeSnapshotContentDelete,
+			expectedError:  "",
+		},
+		{
+			name:           "",
+			driver:         defaultVolumeSnapshotDriver,
+			deletionPolicy: snapshottypes.VolumeSnapshotContentDelete,
+			expectedError:  "VolumeSnapshotClass 'name' cannot be empty",
+		},
+		{
+			name:           defaultVolumeSnapshotClassName,
+			driver:         "",
+			deletionPolicy: snapshottypes.VolumeSnapshotContentRetain,
+			expectedError:  "VolumeSnapshotClass 'driver' cannot be empty",
+		},
+		{
+			name:           defaultVolumeSnapshotClassName,
+			driver:         defaultVolumeSnapshotDriver,
+			deletionPolicy: "Recycle",
+			expectedError: "VolumeSnapshotClass 'deletionPolicy' Recycle is not supported, " +
+				"must be Delete or Retain",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewClassBuilder(testSettings, testCase.name, testCase.driver, testCase.deletionPolicy)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.driver, testBuilder.Definition.Driver)
+			assert.Equal(t, testCase.deletionPolicy, testBuilder.Definition.DeletionPolicy)
+		}
+	}
+}
+
+func TestPullClass(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultVolumeSnapshotClassName,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: true,
+			expectedError:       fmt.Errorf("VolumeSnapshotClass 'name' cannot be empty"),
+		},
+		{
+			name:                defaultVolumeSnapshotClassName,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf(
+				"VolumeSnapshotClass object %s doesn't exist", defaultVolumeSnapshotClassName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyVolumeSnapshotClass())
+		}
+
+		testBuilder, err := PullClass(buildVolumeSnapshotClassTestClientWithDummyObject(runtimeObjects), testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultVolumeSnapshotDriver, testBuilder.Definition.Driver)
+		}
+	}
+}
+
+func TestClassWithParameterAndDefault(t *testing.T) {
+	testBuilder := buildValidVolumeSnapshotClassBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithParameter("csi.storage.k8s.io/snapshotter-secret-name", "secret").
+		WithDefault()
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "secret", testBuilder.Definition.Parameters["csi.storage.k8s.io/snapshotter-secret-name"])
+	assert.Equal(t, "true", testBuilder.Definition.Annotations[IsDefaultClassAnnotation])
+
+	testBuilder = testBuilder.WithParameter("", "value")
+	assert.EqualError(t, testBuilder.errorMsg, "VolumeSnapshotClass parameter key cannot be empty")
+}
+
+func TestClassCreateAndDelete(t *testing.T) {
+	testBuilder, err := buildValidVolumeSnapshotClassBuilder(
+		buildVolumeSnapshotClassTestClientWithDummyObject(nil)).Create()
+	assert.Nil(t, err)
+	assert.Equal(t, defaultVolumeSnapshotClassName, testBuilder.Object.Name)
+
+	err = testBuilder.Delete()
+	assert.Nil(t, err)
+	assert.Nil(t, testBuilder.Object)
+}
+
+func buildValidVolumeSnapshotClassBuilder(apiClient *clients.Settings) *ClassBuilder {
+	return NewClassBuilder(
+		apiClient, defaultVolumeSnapshotClassName, defaultVolumeSnapshotDriver, snapshottypes.VolumeSnapshotContentDelete)
+}
+
+func buildDummyVolumeSnapshotClass() *snapshottypes.VolumeSnapshotClass {
+	return &snapshottypes.VolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultVolumeSnapshotClassName,
+		},
+		Driver:         defaultVolumeSnapshotDriver,
+		DeletionPolicy: snapshottypes.VolumeSnapshotContentDelete,
+	}
+}
+
+func buildVolumeSnapshotClassTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{volumeSnapshotClassGVK},
+	})
+}
@@ -0,0 +1,16 @@
+package volumesnapshot
+
+const (
+	// APIGroup represents the external-snapshotter api group.
+	APIGroup = "snapshot.storage.k8s.io"
+	// APIVersion represents the version of the external-snapshotter api.
+	APIVersion = "v1"
+	// VolumeSnapshotKind represents kind of VolumeSnapshot object.
+	VolumeSnapshotKind = "VolumeSnapshot"
+	// VolumeSnapshotClassKind represents kind of VolumeSnapshotClass object.
+	VolumeSnapshotClassKind = "VolumeSnapshotClass"
+	// VolumeSnapshotContentKind represents kind of VolumeSnapshotContent object.
+	VolumeSnapshotContentKind = "VolumeSnapshotContent"
+	// IsDefaultClassAnnotation marks the VolumeSnapshotClass used by snapshots which do not request one.
+	IsDefaultClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
@@ -0,0 +1,420 @@
+package volumesnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/volumesnapshot/snapshottypes"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ContentBuilder provides struct for the VolumeSnapshotContent object containing connection to the cluster and the
+// VolumeSnapshotContent definitions.
+type ContentBuilder struct {
+	// VolumeSnapshotContent definition. Used to create the VolumeSnapshotContent object.
+	Definition *snapshottypes.VolumeSnapshotContent
+	// Created VolumeSnapshotContent object.
+	Object *snapshottypes.VolumeSnapshotContent
+	// Used in functions that define or mutate VolumeSnapshotContent definition. errorMsg is processed before the
+	// VolumeSnapshotContent object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewContentBuilder creates a new instance of ContentBuilder for a pre-provisioned snapshot identified by its CSI
+// snapshotHandle. The content is bound to the VolumeSnapshot snapshotName in namespace snapshotNamespace, which must
+// reference the content with WithContentSource.
+func NewContentBuilder(
+	apiClient *clients.Settings,
+	name, driver, snapshotHandle, snapshotName, snapshotNamespace string,
+	deletionPolicy snapshottypes.DeletionPolicy) *ContentBuilder {
+	logging.V(100).Infof(
+		"Initializing new VolumeSnapshotContent structure with the following params: name: %s, driver: %s, "+
+			"snapshotHandle: %s, snapshot: %s/%s, deletionPolicy: %s",
+		name, driver, snapshotHandle, snapshotNamespace, snapshotName, deletionPolicy)
+
+	builder := ContentBuilder{
+		apiClient: apiClient,
+		Definition: &snapshottypes.VolumeSnapshotContent{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       VolumeSnapshotContentKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: snapshottypes.VolumeSnapshotContentSpec{
+				VolumeSnapshotRef: corev1.ObjectReference{Name: snapshotName, Namespace: snapshotNamespace},
+				DeletionPolicy:    deletionPolicy,
+				Driver:            driver,
+				Source:            snapshottypes.VolumeSnapshotContentSource{SnapshotHandle: &snapshotHandle},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the VolumeSnapshotContent is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshotContent 'name' cannot be empty"))
+	}
+
+	if driver == "" {
+		logging.V(100).Infof("The driver of the VolumeSnapshotContent is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshotContent 'driver' cannot be empty"))
+	}
+
+	if snapshotHandle == "" {
+		logging.V(100).Infof("The snapshotHandle of the VolumeSnapshotContent is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("VolumeSnapshotContent 'snapshotHandle' cannot be empty"))
+	}
+
+	if snapshotName == "" || snapshotNamespace == "" {
+		logging.V(100).Infof("The VolumeSnapshot reference of the VolumeSnapshotContent is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("VolumeSnapshotContent 'snapshotName' and 'snapshotNamespace' cannot be empty"))
+	}
+
+	err := validateDeletionPolicy(deletionPolicy)
+	if err != nil {
+		logging.V(100).Infof("The deletionPolicy of the VolumeSnapshotContent is invalid")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshotContent %w", err))
+	}
+
+	return &builder
+}
+
+// PullContent pulls existing VolumeSnapshotContent from cluster.
+func PullContent(apiClient *clients.Settings, name string) (*ContentBuilder, error) {
+	logging.V(100).Infof("Pulling existing VolumeSnapshotContent name %s from cluster", name)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("VolumeSnapshotContent 'apiClient' cannot be empty")
+	}
+
+	builder := ContentBuilder{
+		apiClient: apiClient,
+		Definition: &snapshottypes.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the VolumeSnapshotContent is empty")
+
+		return nil, fmt.Errorf("VolumeSnapshotContent 'name' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("VolumeSnapshotContent object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithSnapshotClass sets the VolumeSnapshotClass of the VolumeSnapshotContent.
+func (builder *ContentBuilder) WithSnapshotClass(className string) *ContentBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting VolumeSnapshotClass %s in VolumeSnapshotContent %s",
+		className, builder.Definition.Name)
+
+	if className == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("VolumeSnapshotContent 'className' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.VolumeSnapshotClassName = &className
+
+	return builder
+}
+
+// WithSourceVolumeMode sets the mode of the volume the snapshot was taken from, preventing restores to a volume of
+// a different mode.
+func (builder *ContentBuilder) WithSourceVolumeMode(volumeMode corev1.PersistentVolumeMode) *ContentBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting sourceVolumeMode %s in VolumeSnapshotContent %s",
+		volumeMode, builder.Definition.Name)
+
+	if volumeMode != corev1.PersistentVolumeBlock && volumeMode != corev1.PersistentVolumeFilesystem {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf(
+			"VolumeSnapshotContent 'sourceVolumeMode' %s is not supported, must be %s or %s",
+			volumeMode, corev1.PersistentVolumeBlock, corev1.PersistentVolumeFilesystem))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SourceVolumeMode = &volumeMode
+
+	return builder
+}
+
+// Get returns VolumeSnapshotContent object if found.
+func (builder *ContentBuilder) Get() (*snapshottypes.VolumeSnapshotContent, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting VolumeSnapshotContent object %s", builder.Definition.Name)
+
+	unsObject, err := builder.apiClient.Resource(GetContentGVR()).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("VolumeSnapshotContent object %s doesn't exist", builder.Definition.Name)
+
+		return nil, err
+	}
+
+	return convertContentToStructured(unsObject)
+}
+
+// Exists checks whether the given VolumeSnapshotContent exists.
+func (builder *ContentBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if VolumeSnapshotContent %s exists", builder.Definition.Name)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a VolumeSnapshotContent in the cluster and stores the created object in struct.
+func (builder *ContentBuilder) Create() (*ContentBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the VolumeSnapshotContent %s", builder.Definition.Name)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured VolumeSnapshotContent to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetContentGVR()).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredContent}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create VolumeSnapshotContent %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertContentToStructured(unsObject)
+
+	return builder, err
+}
+
+// Update renovates the existing VolumeSnapshotContent object with the definition in builder.
+func (builder *ContentBuilder) Update() (*ContentBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Updating the VolumeSnapshotContent %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("cannot update non-existent VolumeSnapshotContent %s", builder.Definition.Name)
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	unstructuredContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured VolumeSnapshotContent to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetContentGVR()).Update(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredContent}, metav1.UpdateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to update VolumeSnapshotContent %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertContentToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes VolumeSnapshotContent object from a cluster.
+func (builder *ContentBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the VolumeSnapshotContent object %s", builder.Definition.Name)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetContentGVR()).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete VolumeSnapshotContent: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// Rebind points an existing VolumeSnapshotContent with the Retain deletion policy, whose VolumeSnapshot was deleted,
+// to a new VolumeSnapshot so that the physical snapshot can be used again. The new VolumeSnapshot must reference the
+// content with WithContentSource.
+func (builder *ContentBuilder) Rebind(snapshotName, snapshotNamespace string) (*ContentBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Rebinding VolumeSnapshotContent %s to VolumeSnapshot %s in namespace %s",
+		builder.Definition.Name, snapshotName, snapshotNamespace)
+
+	if snapshotName == "" || snapshotNamespace == "" {
+		return builder, fmt.Errorf("VolumeSnapshotContent 'snapshotName' and 'snapshotNamespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return builder, fmt.Errorf("VolumeSnapshotContent object %s doesn't exist", builder.Definition.Name)
+	}
+
+	if builder.Object.Spec.DeletionPolicy != snapshottypes.VolumeSnapshotContentRetain {
+		return builder, fmt.Errorf("VolumeSnapshotContent %s cannot be rebound with deletionPolicy %s, must be %s",
+			builder.Definition.Name, builder.Object.Spec.DeletionPolicy, snapshottypes.VolumeSnapshotContentRetain)
+	}
+
+	builder.Definition = builder.Object
+	builder.Definition.Spec.VolumeSnapshotRef = corev1.ObjectReference{
+		Name:      snapshotName,
+		Namespace: snapshotNamespace,
+	}
+
+	return builder.Update()
+}
+
+// WaitUntilReadyToUse waits for the duration of the defined timeout or until the VolumeSnapshotContent is ready to
+// use.
+func (builder *ContentBuilder) WaitUntilReadyToUse(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until VolumeSnapshotContent %s is ready to use",
+		builder.Definition.Name)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.Exists() {
+				return false, nil
+			}
+
+			return builder.Object.Status != nil && builder.Object.Status.ReadyToUse != nil &&
+				*builder.Object.Status.ReadyToUse, nil
+		})
+}
+
+// GetContentGVR returns VolumeSnapshotContent's GroupVersionResource which could be used for Clean function.
+func GetContentGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "volumesnapshotcontents"}
+}
+
+// GetGVR returns the volumesnapshotcontent GroupVersionResource, used to register the builder with the cleaner.
+func (builder *ContentBuilder) GetGVR() schema.GroupVersionResource {
+	return GetContentGVR()
+}
+
+// convertContentToStructured converts the unstructured object returned by the dynamic client to a
+// VolumeSnapshotContent.
+func convertContentToStructured(unsObject *unstructured.Unstructured) (*snapshottypes.VolumeSnapshotContent, error) {
+	content := &snapshottypes.VolumeSnapshotContent{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, content)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to VolumeSnapshotContent object %s",
+			unsObject.GetName())
+
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ContentBuilder) validate() (bool, error) {
+	resourceCRD := "VolumeSnapshotContent"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,203 @@
+package volumesnapshot
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/volumesnapshot/snapshottypes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	volumeSnapshotContentGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    VolumeSnapshotContentKind,
+	}
+	defaultVolumeSnapshotHandle = "snap-0123456789"
+)
+
+func TestNewContentBuilder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		driver         string
+		snapshotHandle string
+		snapshotName   string
+		deletionPolicy snapshottypes.DeletionPolicy
+		expectedError  string
+	}{
+		{
+			name:           defaultVolumeSnapshotContent,
+			driver:         defaultVolumeSnapshotDriver,
+			snapshotHandle: defaultVolumeSnapshotHandle,
+			snapshotName:   defaultVolumeSnapshotName,
+			deletionPolicy: snapshottypes.VolumeSnapshotContentRetain,
+			expectedError:  "",
+		},
+		{
+			name:           "",
+			driver:         defaultVolumeSnapshotDriver,
+			snapshotHandle: defaultVolumeSnapshotHandle,
+			snapshotName:   defaultVolumeSnapshotName,
+			deletionPolicy: snapshottypes.VolumeSnapshotContentRetain,
+			expectedError:  "VolumeSnapshotContent 'name' cannot be empty",
+		},
+		{
+			name:           defaultVolumeSnapshotContent,
+			driver:         defaultVolumeSnapshotDriver,
+			snapshotHandle: "",
+			snapshotName:   defaultVolumeSnapshotName,
+			deletionPolicy: snapshottypes.VolumeSnapshotContentRetain,
+			expectedError:  "VolumeSnapshotContent 'snapshotHandle' cannot be empty",
+		},
+		{
+			name:           defaultVolumeSnapshotContent,
+			driver:         defaultVolumeSnapshotDriver,
+			snapshotHandle: defaultVolumeSnapshotHandle,
+			snapshotName:   "",
+			deletionPolicy: snapshottypes.VolumeSnapshotContentRetain,
+			expectedError:  "VolumeSnapshotContent 'snapshotName' and 'snapshotNamespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewContentBuilder(testSettings, testCase.name, testCase.driver, testCase.snapshotHandle,
+			testCase.snapshotName, defaultVolumeSnapshotNamespace, testCase.deletionPolicy)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.snapshotHandle, *testBuilder.Definition.Spec.Source.SnapshotHandle)
+			assert.Equal(t, testCase.snapshotName, testBuilder.Definition.Spec.VolumeSnapshotRef.Name)
+		}
+	}
+}
+
+func TestContentWithSourceVolumeMode(t *testing.T) {
+	testCases := []struct {
+		volumeMode    corev1.PersistentVolumeMode
+		expectedError string
+	}{
+		{
+			volumeMode:    corev1.PersistentVolumeBlock,
+			expectedError: "",
+		},
+		{
+			volumeMode:    "Tape",
+			expectedError: "VolumeSnapshotContent 'sourceVolumeMode' Tape is not supported, must be Block or Filesystem",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidVolumeSnapshotContentBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithSourceVolumeMode(testCase.volumeMode)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.volumeMode, *testBuilder.Definition.Spec.SourceVolumeMode)
+		}
+	}
+}
+
+func TestContentRebind(t *testing.T) {
+	testCases := []struct {
+		deletionPolicy snapshottypes.DeletionPolicy
+		expectedError  error
+	}{
+		{
+			deletionPolicy: snapshottypes.VolumeSnapshotContentRetain,
+			expectedError:  nil,
+		},
+		{
+			deletionPolicy: snapshottypes.VolumeSnapshotContentDelete,
+			expectedError: fmt.Errorf(
+				"VolumeSnapshotContent %s cannot be rebound with deletionPolicy Delete, must be Retain",
+				defaultVolumeSnapshotContent),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidVolumeSnapshotContentBuilder(buildVolumeSnapshotContentTestClientWithDummyObject(
+			[]runtime.Object{buildDummyVolumeSnapshotContent(testCase.deletionPolicy, nil)}))
+
+		testBuilder, err := testBuilder.Rebind("new-snapshot", "new-namespace")
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, "new-snapshot", testBuilder.Object.Spec.VolumeSnapshotRef.Name)
+			assert.Equal(t, "new-namespace", testBuilder.Object.Spec.VolumeSnapshotRef.Namespace)
+			assert.Empty(t, testBuilder.Object.Spec.VolumeSnapshotRef.UID)
+		}
+	}
+}
+
+func TestContentWaitUntilReadyToUse(t *testing.T) {
+	testCases := []struct {
+		readyToUse    bool
+		expectedError error
+	}{
+		{
+			readyToUse:    true,
+			expectedError: nil,
+		},
+		{
+			readyToUse:    false,
+			expectedError: fmt.Errorf("context deadline exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		readyToUse := testCase.readyToUse
+		testBuilder := buildValidVolumeSnapshotContentBuilder(buildVolumeSnapshotContentTestClientWithDummyObject(
+			[]runtime.Object{buildDummyVolumeSnapshotContent(snapshottypes.VolumeSnapshotContentRetain,
+				&snapshottypes.VolumeSnapshotContentStatus{ReadyToUse: &readyToUse})}))
+
+		err := testBuilder.WaitUntilReadyToUse(time.Second)
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func buildValidVolumeSnapshotContentBuilder(apiClient *clients.Settings) *ContentBuilder {
+	return NewContentBuilder(apiClient, defaultVolumeSnapshotContent, defaultVolumeSnapshotDriver,
+		defaultVolumeSnapshotHandle, defaultVolumeSnapshotName, defaultVolumeSnapshotNamespace,
+		snapshottypes.VolumeSnapshotContentRetain)
+}
+
+func buildDummyVolumeSnapshotContent(
+	deletionPolicy snapshottypes.DeletionPolicy,
+	status *snapshottypes.VolumeSnapshotContentStatus) *snapshottypes.VolumeSnapshotContent {
+	snapshotHandle := defaultVolumeSnapshotHandle
+
+	return &snapshottypes.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultVolumeSnapshotContent,
+		},
+		Spec: snapshottypes.VolumeSnapshotContentSpec{
+			VolumeSnapshotRef: corev1.ObjectReference{
+				Name:      defaultVolumeSnapshotName,
+				Namespace: defaultVolumeSnapshotNamespace,
+				UID:       "old-uid",
+			},
+			DeletionPolicy: deletionPolicy,
+			Driver:         defaultVolumeSnapshotDriver,
+			Source:         snapshottypes.VolumeSnapshotContentSource{SnapshotHandle: &snapshotHandle},
+		},
+		Status: status,
+	}
+}
+
+func buildVolumeSnapshotContentTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{volumeSnapshotContentGVK},
+	})
+}
@@ -0,0 +1,290 @@
+package snapshottypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeletionPolicy describes what happens to the physical snapshot and the VolumeSnapshotContent when the bound
+// VolumeSnapshot is deleted.
+type DeletionPolicy string
+
+const (
+	// VolumeSnapshotContentDelete deletes the VolumeSnapshotContent and the physical snapshot with the VolumeSnapshot.
+	VolumeSnapshotContentDelete DeletionPolicy = "Delete"
+	// VolumeSnapshotContentRetain keeps the VolumeSnapshotContent and the physical snapshot when the VolumeSnapshot
+	// is deleted, so that they can be bound again.
+	VolumeSnapshotContentRetain DeletionPolicy = "Retain"
+)
+
+// VolumeSnapshotSource specifies whether the snapshot should be dynamically taken from a PersistentVolumeClaim or
+// bound to a pre-existing VolumeSnapshotContent. Exactly one of the members must be set.
+type VolumeSnapshotSource struct {
+	// persistentVolumeClaimName specifies the name of the PersistentVolumeClaim object representing the volume from
+	// which a snapshot should be created.
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+	// volumeSnapshotContentName specifies the name of a pre-existing VolumeSnapshotContent object representing an
+	// existing volume snapshot.
+	VolumeSnapshotContentName *string `json:"volumeSnapshotContentName,omitempty"`
+}
+
+// VolumeSnapshotSpec describes the common attributes of a volume snapshot.
+type VolumeSnapshotSpec struct {
+	// source specifies where a snapshot will be created from.
+	Source VolumeSnapshotSource `json:"source"`
+	// VolumeSnapshotClassName is the name of the VolumeSnapshotClass requested by the VolumeSnapshot.
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// VolumeSnapshotError describes an error encountered during snapshot creation.
+type VolumeSnapshotError struct {
+	// time is the timestamp when the error was encountered.
+	Time *metav1.Time `json:"time,omitempty"`
+	// message is a string detailing the encountered error during snapshot creation if specified.
+	Message *string `json:"message,omitempty"`
+}
+
+// VolumeSnapshotStatus is the status of the VolumeSnapshot.
+type VolumeSnapshotStatus struct {
+	// boundVolumeSnapshotContentName is the name of the VolumeSnapshotContent object to which this VolumeSnapshot
+	// object intends to bind to.
+	BoundVolumeSnapshotContentName *string `json:"boundVolumeSnapshotContentName,omitempty"`
+	// creationTime is the timestamp when the point-in-time snapshot is taken by the underlying storage system.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+	// readyToUse indicates if the snapshot is ready to be used to restore a volume.
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+	// restoreSize represents the minimum size of volume required to create a volume from this snapshot.
+	RestoreSize *resource.Quantity `json:"restoreSize,omitempty"`
+	// error is the last observed error during snapshot creation, if any.
+	Error *VolumeSnapshotError `json:"error,omitempty"`
+}
+
+// VolumeSnapshot is a user's request for either creating a point-in-time snapshot of a persistent volume, or binding
+// to a pre-existing snapshot.
+type VolumeSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSnapshotSpec    `json:"spec"`
+	Status *VolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+// VolumeSnapshotClass specifies parameters that an underlying storage system uses when creating a volume snapshot.
+type VolumeSnapshotClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// driver is the name of the storage driver that handles this VolumeSnapshotClass.
+	Driver string `json:"driver"`
+	// parameters is a key-value map with storage driver specific parameters for creating snapshots.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// deletionPolicy determines whether a VolumeSnapshotContent created through the VolumeSnapshotClass should be
+	// deleted when its bound VolumeSnapshot is deleted.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy"`
+}
+
+// VolumeSnapshotContentSource represents the CSI source of a snapshot. Exactly one of its members must be set.
+type VolumeSnapshotContentSource struct {
+	// volumeHandle specifies the CSI "volume_id" of the volume from which a snapshot should be dynamically taken.
+	VolumeHandle *string `json:"volumeHandle,omitempty"`
+	// snapshotHandle specifies the CSI "snapshot_id" of a pre-existing snapshot on the underlying storage system.
+	SnapshotHandle *string `json:"snapshotHandle,omitempty"`
+}
+
+// VolumeSnapshotContentSpec is the specification of a VolumeSnapshotContent.
+type VolumeSnapshotContentSpec struct {
+	// volumeSnapshotRef specifies the VolumeSnapshot object to which this VolumeSnapshotContent object is bound.
+	VolumeSnapshotRef corev1.ObjectReference `json:"volumeSnapshotRef"`
+	// deletionPolicy determines whether this VolumeSnapshotContent and its physical snapshot on the underlying
+	// storage system should be deleted when its bound VolumeSnapshot is deleted.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy"`
+	// driver is the name of the CSI driver used to create the physical snapshot on the underlying storage system.
+	Driver string `json:"driver"`
+	// name of the VolumeSnapshotClass from which this snapshot was (or will be) created.
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+	// source specifies whether the snapshot is (or should be) dynamically provisioned or already exists.
+	Source VolumeSnapshotContentSource `json:"source"`
+	// SourceVolumeMode is the mode of the volume whose snapshot is taken.
+	SourceVolumeMode *corev1.PersistentVolumeMode `json:"sourceVolumeMode,omitempty"`
+}
+
+// VolumeSnapshotContentStatus is the status of a VolumeSnapshotContent.
+type VolumeSnapshotContentStatus struct {
+	// snapshotHandle is the CSI "snapshot_id" of a snapshot on the underlying storage system.
+	SnapshotHandle *string `json:"snapshotHandle,omitempty"`
+	// creationTime is the timestamp in nanoseconds when the point-in-time snapshot is taken.
+	CreationTime *int64 `json:"creationTime,omitempty"`
+	// restoreSize represents the complete size of the snapshot in bytes.
+	RestoreSize *int64 `json:"restoreSize,omitempty"`
+	// readyToUse indicates if a snapshot is ready to be used to restore a volume.
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+	// error is the last observed error during snapshot creation, if any.
+	Error *VolumeSnapshotError `json:"error,omitempty"`
+}
+
+// VolumeSnapshotContent represents the actual "on-disk" snapshot object in the underlying storage system.
+type VolumeSnapshotContent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSnapshotContentSpec    `json:"spec"`
+	Status *VolumeSnapshotContentStatus `json:"status,omitempty"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshot.
+func (in *VolumeSnapshot) DeepCopy() *VolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+
+	out := new(VolumeSnapshot)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.Source.PersistentVolumeClaimName = copyString(in.Spec.Source.PersistentVolumeClaimName)
+	out.Spec.Source.VolumeSnapshotContentName = copyString(in.Spec.Source.VolumeSnapshotContentName)
+	out.Spec.VolumeSnapshotClassName = copyString(in.Spec.VolumeSnapshotClassName)
+
+	if in.Status != nil {
+		out.Status = &VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: copyString(in.Status.BoundVolumeSnapshotContentName),
+			CreationTime:                   in.Status.CreationTime.DeepCopy(),
+			ReadyToUse:                     copyBool(in.Status.ReadyToUse),
+			Error:                          in.Status.Error.DeepCopy(),
+		}
+
+		if in.Status.RestoreSize != nil {
+			restoreSize := in.Status.RestoreSize.DeepCopy()
+			out.Status.RestoreSize = &restoreSize
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshot) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotClass.
+func (in *VolumeSnapshotClass) DeepCopy() *VolumeSnapshotClass {
+	if in == nil {
+		return nil
+	}
+
+	out := new(VolumeSnapshotClass)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Driver = in.Driver
+	out.DeletionPolicy = in.DeletionPolicy
+
+	if in.Parameters != nil {
+		out.Parameters = make(map[string]string, len(in.Parameters))
+
+		for key, value := range in.Parameters {
+			out.Parameters[key] = value
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotClass) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotContent.
+func (in *VolumeSnapshotContent) DeepCopy() *VolumeSnapshotContent {
+	if in == nil {
+		return nil
+	}
+
+	out := new(VolumeSnapshotContent)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.VolumeSnapshotRef = in.Spec.VolumeSnapshotRef
+	out.Spec.DeletionPolicy = in.Spec.DeletionPolicy
+	out.Spec.Driver = in.Spec.Driver
+	out.Spec.VolumeSnapshotClassName = copyString(in.Spec.VolumeSnapshotClassName)
+	out.Spec.Source.VolumeHandle = copyString(in.Spec.Source.VolumeHandle)
+	out.Spec.Source.SnapshotHandle = copyString(in.Spec.Source.SnapshotHandle)
+
+	if in.Spec.SourceVolumeMode != nil {
+		sourceVolumeMode := *in.Spec.SourceVolumeMode
+		out.Spec.SourceVolumeMode = &sourceVolumeMode
+	}
+
+	if in.Status != nil {
+		out.Status = &VolumeSnapshotContentStatus{
+			SnapshotHandle: copyString(in.Status.SnapshotHandle),
+			CreationTime:   copyInt64(in.Status.CreationTime),
+			RestoreSize:    copyInt64(in.Status.RestoreSize),
+			ReadyToUse:     copyBool(in.Status.ReadyToUse),
+			Error:          in.Status.Error.DeepCopy(),
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotContent) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotError.
+func (in *VolumeSnapshotError) DeepCopy() *VolumeSnapshotError {
+	if in == nil {
+		return nil
+	}
+
+	return &VolumeSnapshotError{
+		Time:    in.Time.DeepCopy(),
+		Message: copyString(in.Message),
+	}
+}
+
+func copyString(in *string) *string {
+	if in == nil {
+		return nil
+	}
+
+	out := *in
+
+	return &out
+}
+
+func copyBool(in *bool) *bool {
+	if in == nil {
+		return nil
+	}
+
+	out := *in
+
+	return &out
+}
+
+func copyInt64(in *int64) *int64 {
+	if in == nil {
+		return nil
+	}
+
+	out := *in
+
+	return &out
+}
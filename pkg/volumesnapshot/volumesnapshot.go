@@ -0,0 +1,456 @@
+package volumesnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/storage"
+	"github.com/openshift-kni/eco-goinfra/pkg/volumesnapshot/snapshottypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Builder provides struct for the VolumeSnapshot object containing connection to the cluster and the
+// VolumeSnapshot definitions.
+type Builder struct {
+	// VolumeSnapshot definition. Used to create the VolumeSnapshot object.
+	Definition *snapshottypes.VolumeSnapshot
+	// Created VolumeSnapshot object.
+	Object *snapshottypes.VolumeSnapshot
+	// Used in functions that define or mutate VolumeSnapshot definition. errorMsg is processed before the
+	// VolumeSnapshot object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewBuilder creates a new instance of Builder. The source of the snapshot must be set with WithPVCSource or
+// WithContentSource before creating it.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	logging.V(100).Infof(
+		"Initializing new VolumeSnapshot structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &snapshottypes.VolumeSnapshot{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       VolumeSnapshotKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the VolumeSnapshot is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshot 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the VolumeSnapshot is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshot 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// Pull pulls existing VolumeSnapshot from cluster.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	logging.V(100).Infof("Pulling existing VolumeSnapshot name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("VolumeSnapshot 'apiClient' cannot be empty")
+	}
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &snapshottypes.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the VolumeSnapshot is empty")
+
+		return nil, fmt.Errorf("VolumeSnapshot 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the VolumeSnapshot is empty")
+
+		return nil, fmt.Errorf("VolumeSnapshot 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("VolumeSnapshot object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// WithPVCSource dynamically snapshots the given PersistentVolumeClaim of the VolumeSnapshot namespace.
+func (builder *Builder) WithPVCSource(pvcName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting PVC source %s in VolumeSnapshot %s in namespace %s",
+		pvcName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if pvcName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshot 'pvcName' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Source.VolumeSnapshotContentName != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("VolumeSnapshot cannot have both a PVC and a VolumeSnapshotContent source"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Source.PersistentVolumeClaimName = &pvcName
+
+	return builder
+}
+
+// WithContentSource binds the VolumeSnapshot to the given pre-provisioned VolumeSnapshotContent. The content must
+// reference the VolumeSnapshot in its volumeSnapshotRef for the binding to complete.
+func (builder *Builder) WithContentSource(contentName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting VolumeSnapshotContent source %s in VolumeSnapshot %s in namespace %s",
+		contentName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if contentName == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshot 'contentName' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Source.PersistentVolumeClaimName != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("VolumeSnapshot cannot have both a PVC and a VolumeSnapshotContent source"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Source.VolumeSnapshotContentName = &contentName
+
+	return builder
+}
+
+// WithSnapshotClass sets the VolumeSnapshotClass of the VolumeSnapshot. Without it the default class of the CSI
+// driver is used.
+func (builder *Builder) WithSnapshotClass(className string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting VolumeSnapshotClass %s in VolumeSnapshot %s in namespace %s",
+		className, builder.Definition.Name, builder.Definition.Namespace)
+
+	if className == "" {
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("VolumeSnapshot 'className' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.VolumeSnapshotClassName = &className
+
+	return builder
+}
+
+// Get returns VolumeSnapshot object if found.
+func (builder *Builder) Get() (*snapshottypes.VolumeSnapshot, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting VolumeSnapshot object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("VolumeSnapshot object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	volumeSnapshot := &snapshottypes.VolumeSnapshot{}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, volumeSnapshot)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to VolumeSnapshot object")
+
+		return nil, err
+	}
+
+	return volumeSnapshot, nil
+}
+
+// Exists checks whether the given VolumeSnapshot exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if VolumeSnapshot %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a VolumeSnapshot in the cluster and stores the created object in struct.
+func (builder *Builder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the VolumeSnapshot %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	if builder.Definition.Spec.Source.PersistentVolumeClaimName == nil &&
+		builder.Definition.Spec.Source.VolumeSnapshotContentName == nil {
+		return builder, fmt.Errorf("VolumeSnapshot %s in namespace %s must have a PVC or a VolumeSnapshotContent source",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	unstructuredSnapshot, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured VolumeSnapshot to unstructured object")
+
+		return builder, err
+	}
+
+	_, err = builder.apiClient.Resource(GetGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredSnapshot}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create VolumeSnapshot %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = builder.Get()
+
+	return builder, err
+}
+
+// Delete removes VolumeSnapshot object from a cluster.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the VolumeSnapshot object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete VolumeSnapshot: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsReadyToUse returns true when the snapshot was taken and can be used to restore a volume.
+func (builder *Builder) IsReadyToUse() (bool, error) {
+	if valid, err := builder.validate(); !valid {
+		return false, err
+	}
+
+	logging.V(100).Infof("Checking if VolumeSnapshot %s in namespace %s is ready to use",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get VolumeSnapshot %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return builder.Object.Status != nil && builder.Object.Status.ReadyToUse != nil &&
+		*builder.Object.Status.ReadyToUse, nil
+}
+
+// WaitUntilReadyToUse waits for the duration of the defined timeout or until the VolumeSnapshot is ready to use. On
+// timeout, the last error reported by the snapshot controller is included in the returned error.
+func (builder *Builder) WaitUntilReadyToUse(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until VolumeSnapshot %s in namespace %s is ready to use",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			ready, err := builder.IsReadyToUse()
+			if err != nil {
+				logging.V(100).Infof("Failed to check VolumeSnapshot %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return ready, nil
+		})
+
+	if err != nil && builder.Object != nil && builder.Object.Status != nil && builder.Object.Status.Error != nil &&
+		builder.Object.Status.Error.Message != nil {
+		return fmt.Errorf("VolumeSnapshot %s in namespace %s is not ready to use: %w, last error: %s",
+			builder.Definition.Name, builder.Definition.Namespace, err, *builder.Object.Status.Error.Message)
+	}
+
+	return err
+}
+
+// GetBoundContentName returns the name of the VolumeSnapshotContent the VolumeSnapshot is bound to.
+func (builder *Builder) GetBoundContentName() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logging.V(100).Infof("Getting VolumeSnapshotContent bound to VolumeSnapshot %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return "", fmt.Errorf("VolumeSnapshot object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status == nil || builder.Object.Status.BoundVolumeSnapshotContentName == nil {
+		return "", fmt.Errorf("VolumeSnapshot %s in namespace %s is not bound to a VolumeSnapshotContent",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return *builder.Object.Status.BoundVolumeSnapshotContentName, nil
+}
+
+// NewRestorePVCBuilder returns a PVCBuilder for a claim in the VolumeSnapshot namespace restored from the snapshot,
+// requesting its restore size from the given storageclass. The VolumeSnapshot must be ready to use. Access modes and
+// other settings are left to the caller before creating the claim.
+func (builder *Builder) NewRestorePVCBuilder(pvcName, storageClass string) (*storage.PVCBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Creating PVC %s restored from VolumeSnapshot %s in namespace %s",
+		pvcName, builder.Definition.Name, builder.Definition.Namespace)
+
+	ready, err := builder.IsReadyToUse()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ready {
+		return nil, fmt.Errorf("VolumeSnapshot %s in namespace %s is not ready to use",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Status.RestoreSize == nil {
+		return nil, fmt.Errorf("VolumeSnapshot %s in namespace %s has no restore size",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	pvcBuilder, err := storage.NewPVCBuilder(builder.apiClient, pvcName, builder.Definition.Namespace).
+		WithVolumeSnapshotSource(builder.Definition.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pvcBuilder, err = pvcBuilder.WithPVCCapacity(builder.Object.Status.RestoreSize.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return pvcBuilder.WithStorageClass(storageClass)
+}
+
+// GetGVR returns VolumeSnapshot's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "volumesnapshots"}
+}
+
+// GetGVR returns the volumesnapshot GroupVersionResource, used to register the builder with the cleaner.
+func (builder *Builder) GetGVR() schema.GroupVersionResource {
+	return GetGVR()
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "VolumeSnapshot"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,339 @@
+package volumesnapshot
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/volumesnapshot/snapshottypes"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	volumeSnapshotGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    VolumeSnapshotKind,
+	}
+	defaultVolumeSnapshotName      = "test-snapshot"
+	defaultVolumeSnapshotNamespace = "test-namespace"
+	defaultVolumeSnapshotPVC       = "test-pvc"
+	defaultVolumeSnapshotContent   = "test-content"
+)
+
+func TestNewBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		expectedError string
+	}{
+		{
+			name:          defaultVolumeSnapshotName,
+			namespace:     defaultVolumeSnapshotNamespace,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultVolumeSnapshotNamespace,
+			expectedError: "VolumeSnapshot 'name' cannot be empty",
+		},
+		{
+			name:          defaultVolumeSnapshotName,
+			namespace:     "",
+			expectedError: "VolumeSnapshot 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewBuilder(testSettings, testCase.name, testCase.namespace)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPull(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultVolumeSnapshotName,
+			namespace:           defaultVolumeSnapshotNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			namespace:           defaultVolumeSnapshotNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("VolumeSnapshot 'name' cannot be empty"),
+		},
+		{
+			name:                defaultVolumeSnapshotName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("VolumeSnapshot 'namespace' cannot be empty"),
+		},
+		{
+			name:                defaultVolumeSnapshotName,
+			namespace:           defaultVolumeSnapshotNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"VolumeSnapshot object %s doesn't exist in namespace %s",
+				defaultVolumeSnapshotName, defaultVolumeSnapshotNamespace),
+		},
+		{
+			name:                defaultVolumeSnapshotName,
+			namespace:           defaultVolumeSnapshotNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("VolumeSnapshot 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyVolumeSnapshot(nil))
+		}
+
+		if testCase.client {
+			testSettings = buildVolumeSnapshotTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := Pull(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestVolumeSnapshotWithSources(t *testing.T) {
+	testCases := []struct {
+		pvcName       string
+		contentName   string
+		expectedError string
+	}{
+		{
+			pvcName:       defaultVolumeSnapshotPVC,
+			expectedError: "",
+		},
+		{
+			contentName:   defaultVolumeSnapshotContent,
+			expectedError: "",
+		},
+		{
+			pvcName:       defaultVolumeSnapshotPVC,
+			contentName:   defaultVolumeSnapshotContent,
+			expectedError: "VolumeSnapshot cannot have both a PVC and a VolumeSnapshotContent source",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidVolumeSnapshotBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.pvcName != "" {
+			testBuilder = testBuilder.WithPVCSource(testCase.pvcName)
+		}
+
+		if testCase.contentName != "" {
+			testBuilder = testBuilder.WithContentSource(testCase.contentName)
+		}
+
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			continue
+		}
+
+		if testCase.pvcName != "" {
+			assert.Equal(t, testCase.pvcName, *testBuilder.Definition.Spec.Source.PersistentVolumeClaimName)
+		} else {
+			assert.Equal(t, testCase.contentName, *testBuilder.Definition.Spec.Source.VolumeSnapshotContentName)
+		}
+	}
+}
+
+func TestVolumeSnapshotCreate(t *testing.T) {
+	testCases := []struct {
+		withSource    bool
+		expectedError error
+	}{
+		{
+			withSource:    true,
+			expectedError: nil,
+		},
+		{
+			withSource: false,
+			expectedError: fmt.Errorf(
+				"VolumeSnapshot %s in namespace %s must have a PVC or a VolumeSnapshotContent source",
+				defaultVolumeSnapshotName, defaultVolumeSnapshotNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidVolumeSnapshotBuilder(buildVolumeSnapshotTestClientWithDummyObject(nil))
+
+		if testCase.withSource {
+			testBuilder = testBuilder.WithPVCSource(defaultVolumeSnapshotPVC)
+		}
+
+		testBuilder, err := testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, defaultVolumeSnapshotName, testBuilder.Object.Name)
+		}
+	}
+}
+
+func TestVolumeSnapshotDelete(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+	}{
+		{addToRuntimeObjects: true},
+		{addToRuntimeObjects: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyVolumeSnapshot(nil))
+		}
+
+		testBuilder := buildValidVolumeSnapshotBuilder(buildVolumeSnapshotTestClientWithDummyObject(runtimeObjects))
+
+		err := testBuilder.Delete()
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+	}
+}
+
+func TestVolumeSnapshotWaitUntilReadyToUse(t *testing.T) {
+	errorMessage := "failed to take snapshot"
+
+	testCases := []struct {
+		status        *snapshottypes.VolumeSnapshotStatus
+		expectedError string
+	}{
+		{
+			status:        buildDummyVolumeSnapshotStatus(true, "1Gi"),
+			expectedError: "",
+		},
+		{
+			status:        buildDummyVolumeSnapshotStatus(false, ""),
+			expectedError: "context deadline exceeded",
+		},
+		{
+			status: &snapshottypes.VolumeSnapshotStatus{
+				Error: &snapshottypes.VolumeSnapshotError{Message: &errorMessage},
+			},
+			expectedError: fmt.Sprintf("VolumeSnapshot %s in namespace %s is not ready to use: "+
+				"context deadline exceeded, last error: %s",
+				defaultVolumeSnapshotName, defaultVolumeSnapshotNamespace, errorMessage),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidVolumeSnapshotBuilder(buildVolumeSnapshotTestClientWithDummyObject(
+			[]runtime.Object{buildDummyVolumeSnapshot(testCase.status)}))
+
+		err := testBuilder.WaitUntilReadyToUse(time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestVolumeSnapshotNewRestorePVCBuilder(t *testing.T) {
+	testCases := []struct {
+		status        *snapshottypes.VolumeSnapshotStatus
+		expectedError error
+	}{
+		{
+			status:        buildDummyVolumeSnapshotStatus(true, "5Gi"),
+			expectedError: nil,
+		},
+		{
+			status: buildDummyVolumeSnapshotStatus(false, "5Gi"),
+			expectedError: fmt.Errorf("VolumeSnapshot %s in namespace %s is not ready to use",
+				defaultVolumeSnapshotName, defaultVolumeSnapshotNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidVolumeSnapshotBuilder(buildVolumeSnapshotTestClientWithDummyObject(
+			[]runtime.Object{buildDummyVolumeSnapshot(testCase.status)}))
+
+		pvcBuilder, err := testBuilder.NewRestorePVCBuilder("restored-pvc", "test-class")
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, "restored-pvc", pvcBuilder.Definition.Name)
+			assert.Equal(t, defaultVolumeSnapshotNamespace, pvcBuilder.Definition.Namespace)
+			assert.Equal(t, defaultVolumeSnapshotName, pvcBuilder.Definition.Spec.DataSource.Name)
+			assert.Equal(t, "test-class", *pvcBuilder.Definition.Spec.StorageClassName)
+
+			capacity := pvcBuilder.Definition.Spec.Resources.Requests["storage"]
+			assert.Equal(t, "5Gi", capacity.String())
+		}
+	}
+}
+
+func buildValidVolumeSnapshotBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultVolumeSnapshotName, defaultVolumeSnapshotNamespace)
+}
+
+func buildDummyVolumeSnapshot(status *snapshottypes.VolumeSnapshotStatus) *snapshottypes.VolumeSnapshot {
+	pvcName := defaultVolumeSnapshotPVC
+
+	return &snapshottypes.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultVolumeSnapshotName,
+			Namespace: defaultVolumeSnapshotNamespace,
+		},
+		Spec: snapshottypes.VolumeSnapshotSpec{
+			Source: snapshottypes.VolumeSnapshotSource{PersistentVolumeClaimName: &pvcName},
+		},
+		Status: status,
+	}
+}
+
+func buildDummyVolumeSnapshotStatus(readyToUse bool, restoreSize string) *snapshottypes.VolumeSnapshotStatus {
+	status := &snapshottypes.VolumeSnapshotStatus{ReadyToUse: &readyToUse}
+
+	if restoreSize != "" {
+		quantity := resource.MustParse(restoreSize)
+		status.RestoreSize = &quantity
+	}
+
+	return status
+}
+
+func buildVolumeSnapshotTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{volumeSnapshotGVK},
+	})
+}
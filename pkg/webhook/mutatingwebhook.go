@@ -1,10 +1,11 @@
 package webhook
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"golang.org/x/net/context"
 
 	admregv1 "k8s.io/api/admissionregistration/v1"
@@ -22,14 +23,14 @@ type MutatingConfigurationBuilder struct {
 	Object *admregv1.MutatingWebhookConfiguration
 	// Used in functions that define or mutate MutatingWebhookConfiguration definitions.
 	// errorMsg is processed before MutatingWebhookConfiguration object is created.
-	errorMsg string
+	errorMsg error
 	// apiClient opens api connection to the cluster.
 	apiClient *clients.Settings
 }
 
 // PullMutatingConfiguration pulls existing MutatingWebhookConfiguration from cluster.
 func PullMutatingConfiguration(apiClient *clients.Settings, name string) (*MutatingConfigurationBuilder, error) {
-	glog.V(100).Infof("Pulling existing MutatingWebhookConfiguration name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing MutatingWebhookConfiguration name %s from cluster", name)
 
 	builder := MutatingConfigurationBuilder{
 		apiClient: apiClient,
@@ -41,9 +42,9 @@ func PullMutatingConfiguration(apiClient *clients.Settings, name string) (*Mutat
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the MutatingWebhookConfiguration is empty")
+		logging.V(100).Infof("The name of the MutatingWebhookConfiguration is empty")
 
-		builder.errorMsg = "MutatingWebhookConfiguration 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("MutatingWebhookConfiguration 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -79,7 +80,7 @@ func (builder *MutatingConfigurationBuilder) Get() (*admregv1.MutatingWebhookCon
 	}, mutatingWebhookConfiguration)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to get MutatingConfigurationBuilder %s: %v", builder.Definition.Name, err)
+		logging.V(100).Infof("Failed to get MutatingConfigurationBuilder %s: %v", builder.Definition.Name, err)
 
 		return &admregv1.MutatingWebhookConfiguration{}, err
 	}
@@ -93,7 +94,7 @@ func (builder *MutatingConfigurationBuilder) Delete() (*MutatingConfigurationBui
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the MutatingWebhookConfiguration %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the MutatingWebhookConfiguration %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("MutatingWebhookConfiguration cannot be deleted because it does not exist")
@@ -117,7 +118,7 @@ func (builder *MutatingConfigurationBuilder) Update() (*MutatingConfigurationBui
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating MutatingWebhookConfiguration %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating MutatingWebhookConfiguration %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 	if err == nil {
@@ -133,21 +134,23 @@ func (builder *MutatingConfigurationBuilder) validate() (bool, error) {
 	resourceCRD := "MutatingWebhookConfiguration"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -1,10 +1,11 @@
 package webhook
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
 	"golang.org/x/net/context"
 
 	admregv1 "k8s.io/api/admissionregistration/v1"
@@ -22,7 +23,7 @@ type ValidatingConfigurationBuilder struct {
 	Object *admregv1.ValidatingWebhookConfiguration
 	// Used in functions that define or mutate ValidatingWebhookConfiguration definitions.
 	// errorMsg is processed before ValidatingWebhookConfiguration object is created.
-	errorMsg string
+	errorMsg error
 	// apiClient opens api connection to the cluster.
 	apiClient *clients.Settings
 }
@@ -30,7 +31,7 @@ type ValidatingConfigurationBuilder struct {
 // PullValidatingConfiguration pulls existing ValidatingWebhookConfiguration from cluster.
 func PullValidatingConfiguration(apiClient *clients.Settings, name string) (
 	*ValidatingConfigurationBuilder, error) {
-	glog.V(100).Infof("Pulling existing ValidatingWebhookConfiguration name %s from cluster", name)
+	logging.V(100).Infof("Pulling existing ValidatingWebhookConfiguration name %s from cluster", name)
 
 	builder := ValidatingConfigurationBuilder{
 		apiClient: apiClient,
@@ -42,9 +43,9 @@ func PullValidatingConfiguration(apiClient *clients.Settings, name string) (
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the ValidatingWebhookConfiguration is empty")
+		logging.V(100).Infof("The name of the ValidatingWebhookConfiguration is empty")
 
-		builder.errorMsg = "ValidatingWebhookConfiguration 'name' cannot be empty"
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("ValidatingWebhookConfiguration 'name' cannot be empty"))
 	}
 
 	if !builder.Exists() {
@@ -80,7 +81,7 @@ func (builder *ValidatingConfigurationBuilder) Get() (*admregv1.ValidatingWebhoo
 	}, validatingWebhookConfiguration)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to get ValidatingWebhookConfiguration %s: %v", builder.Definition.Name, err)
+		logging.V(100).Infof("Failed to get ValidatingWebhookConfiguration %s: %v", builder.Definition.Name, err)
 
 		return &admregv1.ValidatingWebhookConfiguration{}, err
 	}
@@ -94,7 +95,7 @@ func (builder *ValidatingConfigurationBuilder) Delete() (*ValidatingConfiguratio
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting the ValidatingWebhookConfiguration %s", builder.Definition.Name)
+	logging.V(100).Infof("Deleting the ValidatingWebhookConfiguration %s", builder.Definition.Name)
 
 	if !builder.Exists() {
 		return builder, fmt.Errorf("ValidatingWebhookConfiguration cannot be deleted because it does not exist")
@@ -118,7 +119,7 @@ func (builder *ValidatingConfigurationBuilder) Update() (*ValidatingConfiguratio
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating ValidatingWebhookConfiguration %s", builder.Definition.Name)
+	logging.V(100).Infof("Updating ValidatingWebhookConfiguration %s", builder.Definition.Name)
 
 	err := builder.apiClient.Update(context.TODO(), builder.Definition)
 	if err == nil {
@@ -134,21 +135,23 @@ func (builder *ValidatingConfigurationBuilder) validate() (bool, error) {
 	resourceCRD := "ValidatingWebhookConfiguration"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
+	err := builder.errorMsg
+
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
 
-		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
 	}
 
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
 
-		return false, fmt.Errorf(builder.errorMsg)
+		return false, err
 	}
 
 	return true, nil
@@ -0,0 +1,12 @@
+package whereabouts
+
+const (
+	// APIGroup represents whereabouts api group.
+	APIGroup = "whereabouts.cni.cncf.io"
+	// APIVersion represents version of whereabouts api.
+	APIVersion = "v1alpha1"
+	// IPPoolKind represents kind of IPPool object.
+	IPPoolKind = "IPPool"
+	// OverlappingRangeIPReservationKind represents kind of OverlappingRangeIPReservation object.
+	OverlappingRangeIPReservationKind = "OverlappingRangeIPReservation"
+)
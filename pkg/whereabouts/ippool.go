@@ -0,0 +1,356 @@
+package whereabouts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/whereabouts/wbtypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// IPPoolBuilder provides struct for the IPPool object containing connection to the cluster and the IPPool
+// definitions.
+type IPPoolBuilder struct {
+	// IPPool definition. Used to create the IPPool object.
+	Definition *wbtypes.IPPool
+	// Created IPPool object.
+	Object *wbtypes.IPPool
+	// Used in functions that define or mutate IPPool definition. errorMsg is processed before the IPPool object is
+	// created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewIPPoolBuilder creates a new instance of IPPoolBuilder for the given range in CIDR notation. The whereabouts
+// plugin creates the pools it needs on its own, the builder is mostly useful to pre-create or reset a pool.
+func NewIPPoolBuilder(apiClient *clients.Settings, name, nsname, ipRange string) *IPPoolBuilder {
+	logging.V(100).Infof(
+		"Initializing new IPPool structure with the following params: name: %s, namespace: %s, range: %s",
+		name, nsname, ipRange)
+
+	builder := IPPoolBuilder{
+		apiClient: apiClient,
+		Definition: &wbtypes.IPPool{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       IPPoolKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: wbtypes.IPPoolSpec{
+				Range:       ipRange,
+				Allocations: map[string]wbtypes.IPAllocation{},
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the IPPool is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("IPPool 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the IPPool is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("IPPool 'namespace' cannot be empty"))
+	}
+
+	if _, _, err := net.ParseCIDR(ipRange); err != nil {
+		logging.V(100).Infof("The range of the IPPool is not a valid CIDR: %s", ipRange)
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("IPPool 'range' %s is not a valid CIDR", ipRange))
+	}
+
+	return &builder
+}
+
+// PullIPPool pulls existing IPPool from cluster.
+func PullIPPool(apiClient *clients.Settings, name, nsname string) (*IPPoolBuilder, error) {
+	logging.V(100).Infof("Pulling existing IPPool name %s under namespace %s from cluster", name, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("IPPool 'apiClient' cannot be empty")
+	}
+
+	builder := IPPoolBuilder{
+		apiClient: apiClient,
+		Definition: &wbtypes.IPPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the IPPool is empty")
+
+		return nil, fmt.Errorf("IPPool 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the IPPool is empty")
+
+		return nil, fmt.Errorf("IPPool 'namespace' cannot be empty")
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("IPPool object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns IPPool object if found.
+func (builder *IPPoolBuilder) Get() (*wbtypes.IPPool, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting IPPool object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetIPPoolGVR()).Namespace(builder.Definition.Namespace).Get(
+		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("IPPool object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertIPPoolToStructured(unsObject)
+}
+
+// Exists checks whether the given IPPool exists.
+func (builder *IPPoolBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if IPPool %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an IPPool in the cluster and stores the created object in struct.
+func (builder *IPPoolBuilder) Create() (*IPPoolBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the IPPool %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredIPPool, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured IPPool to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetIPPoolGVR()).Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredIPPool}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create IPPool %s due to %s", builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertIPPoolToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes IPPool object from a cluster. Whereabouts recreates the pool on the next allocation in its range.
+func (builder *IPPoolBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the IPPool object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetIPPoolGVR()).Namespace(builder.Definition.Namespace).Delete(
+		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete IPPool: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// GetAllocations returns the current allocations of the IPPool keyed by the allocated IP address.
+func (builder *IPPoolBuilder) GetAllocations() (map[string]wbtypes.IPAllocation, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Getting allocations of IPPool %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IPPool %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return allocationsByIP(builder.Object)
+}
+
+// WaitUntilIPReleased waits for the duration of the defined timeout or until the IP address is no longer allocated
+// in the IPPool.
+func (builder *IPPoolBuilder) WaitUntilIPReleased(ipAddress string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until IP %s is released from IPPool %s in namespace %s",
+		ipAddress, builder.Definition.Name, builder.Definition.Namespace)
+
+	parsedIP := net.ParseIP(ipAddress)
+	if parsedIP == nil {
+		return fmt.Errorf("cannot wait for release of invalid IP address %s", ipAddress)
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			allocations, err := builder.GetAllocations()
+			if err != nil {
+				return false, nil
+			}
+
+			_, allocated := allocations[parsedIP.String()]
+
+			return !allocated, nil
+		})
+}
+
+// GetIPPoolGVR returns IPPool's GroupVersionResource which could be used for Clean function.
+func GetIPPoolGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: APIGroup, Version: APIVersion, Resource: "ippools"}
+}
+
+// IPPoolNameFromRange returns the name whereabouts gives to the IPPool of the range in CIDR notation.
+func IPPoolNameFromRange(ipRange string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(ipRange, ":", "-"), "/", "-")
+}
+
+// allocationsByIP maps the allocations of the pool, which are keyed by their offset in the range, to IP addresses.
+func allocationsByIP(pool *wbtypes.IPPool) (map[string]wbtypes.IPAllocation, error) {
+	_, network, err := net.ParseCIDR(pool.Spec.Range)
+	if err != nil {
+		return nil, fmt.Errorf("IPPool %s has invalid range %s", pool.Name, pool.Spec.Range)
+	}
+
+	firstIP := network.IP.To16()
+	if network.IP.To4() != nil {
+		firstIP = network.IP.To4()
+	}
+
+	allocations := make(map[string]wbtypes.IPAllocation, len(pool.Spec.Allocations))
+
+	for offset, allocation := range pool.Spec.Allocations {
+		offsetValue, valid := new(big.Int).SetString(offset, 10)
+		if !valid {
+			return nil, fmt.Errorf("IPPool %s has invalid allocation offset %s", pool.Name, offset)
+		}
+
+		ipValue := new(big.Int).Add(new(big.Int).SetBytes(firstIP), offsetValue)
+		ipBytes := ipValue.FillBytes(make([]byte, len(firstIP)))
+
+		allocations[net.IP(ipBytes).String()] = allocation
+	}
+
+	return allocations, nil
+}
+
+// convertIPPoolToStructured converts the unstructured object returned by the dynamic client to an IPPool.
+func convertIPPoolToStructured(unsObject *unstructured.Unstructured) (*wbtypes.IPPool, error) {
+	ipPool := &wbtypes.IPPool{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, ipPool)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert from unstructured to IPPool object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return ipPool, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *IPPoolBuilder) validate() (bool, error) {
+	resourceCRD := "IPPool"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,370 @@
+package whereabouts
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/whereabouts/wbtypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	ipPoolGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    IPPoolKind,
+	}
+	defaultIPPoolName      = "192.168.10.0-24"
+	defaultIPPoolNamespace = "openshift-multus"
+	defaultIPPoolRange     = "192.168.10.0/24"
+)
+
+func TestNewIPPoolBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		namespace     string
+		ipRange       string
+		expectedError string
+	}{
+		{
+			name:          defaultIPPoolName,
+			namespace:     defaultIPPoolNamespace,
+			ipRange:       defaultIPPoolRange,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			namespace:     defaultIPPoolNamespace,
+			ipRange:       defaultIPPoolRange,
+			expectedError: "IPPool 'name' cannot be empty",
+		},
+		{
+			name:          defaultIPPoolName,
+			namespace:     "",
+			ipRange:       defaultIPPoolRange,
+			expectedError: "IPPool 'namespace' cannot be empty",
+		},
+		{
+			name:          defaultIPPoolName,
+			namespace:     defaultIPPoolNamespace,
+			ipRange:       "192.168.10.0",
+			expectedError: "IPPool 'range' 192.168.10.0 is not a valid CIDR",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewIPPoolBuilder(testSettings, testCase.name, testCase.namespace, testCase.ipRange)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+			assert.Equal(t, testCase.ipRange, testBuilder.Definition.Spec.Range)
+		}
+	}
+}
+
+func TestPullIPPool(t *testing.T) {
+	testCases := []struct {
+		name                string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultIPPoolName,
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                "",
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("IPPool 'name' cannot be empty"),
+		},
+		{
+			name:                defaultIPPoolName,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("IPPool 'namespace' cannot be empty"),
+		},
+		{
+			name:                defaultIPPoolName,
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf(
+				"IPPool object %s doesn't exist in namespace %s", defaultIPPoolName, defaultIPPoolNamespace),
+		},
+		{
+			name:                defaultIPPoolName,
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("IPPool 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyIPPool(testCase.name, testCase.namespace, nil))
+		}
+
+		if testCase.client {
+			testSettings = buildIPPoolTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullIPPool(testSettings, testCase.name, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.namespace, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestIPPoolCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *IPPoolBuilder
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidIPPoolBuilder(buildIPPoolTestClientWithDummyObject(nil)),
+			expectedError: "",
+		},
+		{
+			testBuilder: NewIPPoolBuilder(
+				buildIPPoolTestClientWithDummyObject(nil), defaultIPPoolName, defaultIPPoolNamespace, ""),
+			expectedError: "IPPool 'range'  is not a valid CIDR",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+		assert.Equal(t, testBuilder.Definition.Spec.Range, testBuilder.Object.Spec.Range)
+	}
+}
+
+func TestIPPoolDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *IPPoolBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidIPPoolBuilder(buildIPPoolTestClientWithDummyObject(
+				[]runtime.Object{buildDummyIPPool(defaultIPPoolName, defaultIPPoolNamespace, nil)})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidIPPoolBuilder(buildIPPoolTestClientWithDummyObject(nil)),
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+		}
+	}
+}
+
+func TestIPPoolGetAllocations(t *testing.T) {
+	testCases := []struct {
+		ipRange             string
+		allocations         map[string]wbtypes.IPAllocation
+		addToRuntimeObjects bool
+		expectedAllocations map[string]string
+		expectedError       string
+	}{
+		{
+			ipRange: defaultIPPoolRange,
+			allocations: map[string]wbtypes.IPAllocation{
+				"1":   {PodRef: "test/pod-1"},
+				"254": {PodRef: "test/pod-2"},
+			},
+			addToRuntimeObjects: true,
+			expectedAllocations: map[string]string{"192.168.10.1": "test/pod-1", "192.168.10.254": "test/pod-2"},
+			expectedError:       "",
+		},
+		{
+			ipRange: "2001:db8::/64",
+			allocations: map[string]wbtypes.IPAllocation{
+				"10": {PodRef: "test/pod-1"},
+			},
+			addToRuntimeObjects: true,
+			expectedAllocations: map[string]string{"2001:db8::a": "test/pod-1"},
+			expectedError:       "",
+		},
+		{
+			ipRange: defaultIPPoolRange,
+			allocations: map[string]wbtypes.IPAllocation{
+				"first": {PodRef: "test/pod-1"},
+			},
+			addToRuntimeObjects: true,
+			expectedError:       "IPPool 192.168.10.0-24 has invalid allocation offset first",
+		},
+		{
+			ipRange:             defaultIPPoolRange,
+			addToRuntimeObjects: false,
+			expectedError: "failed to get IPPool 192.168.10.0-24 in namespace openshift-multus: " +
+				"ippools.whereabouts.cni.cncf.io \"192.168.10.0-24\" not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			dummyIPPool := buildDummyIPPool(defaultIPPoolName, defaultIPPoolNamespace, testCase.allocations)
+			dummyIPPool.Spec.Range = testCase.ipRange
+			runtimeObjects = append(runtimeObjects, dummyIPPool)
+		}
+
+		testBuilder := buildValidIPPoolBuilder(buildIPPoolTestClientWithDummyObject(runtimeObjects))
+
+		allocations, err := testBuilder.GetAllocations()
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Len(t, allocations, len(testCase.expectedAllocations))
+
+		for ipAddress, podRef := range testCase.expectedAllocations {
+			assert.Equal(t, podRef, allocations[ipAddress].PodRef)
+		}
+	}
+}
+
+func TestIPPoolWaitUntilIPReleased(t *testing.T) {
+	testCases := []struct {
+		ipAddress     string
+		expectedError string
+	}{
+		{
+			ipAddress:     "192.168.10.2",
+			expectedError: "",
+		},
+		{
+			ipAddress:     "192.168.10.1",
+			expectedError: "context deadline exceeded",
+		},
+		{
+			ipAddress:     "192.168.10",
+			expectedError: "cannot wait for release of invalid IP address 192.168.10",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidIPPoolBuilder(buildIPPoolTestClientWithDummyObject([]runtime.Object{
+			buildDummyIPPool(defaultIPPoolName, defaultIPPoolNamespace, map[string]wbtypes.IPAllocation{
+				"1": {PodRef: "test/pod-1"},
+			}),
+		}))
+
+		err := testBuilder.WaitUntilIPReleased(testCase.ipAddress, time.Second)
+		testhelper.AssertErrorMsg(t, testCase.expectedError, err)
+	}
+}
+
+func TestListIPPools(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		namespace     string
+		listOptions   []metav1.ListOptions
+		expectedError error
+	}{
+		{
+			client:        true,
+			namespace:     defaultIPPoolNamespace,
+			expectedError: nil,
+		},
+		{
+			client:        true,
+			namespace:     defaultIPPoolNamespace,
+			listOptions:   []metav1.ListOptions{{}, {}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+		},
+		{
+			client:        true,
+			namespace:     "",
+			expectedError: fmt.Errorf("failed to list IPPools, 'nsname' parameter is empty"),
+		},
+		{
+			client:        false,
+			namespace:     defaultIPPoolNamespace,
+			expectedError: fmt.Errorf("failed to list IPPools, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildIPPoolTestClientWithDummyObject([]runtime.Object{
+				buildDummyIPPool(defaultIPPoolName, defaultIPPoolNamespace, nil),
+			})
+		}
+
+		ipPools, err := ListIPPools(testSettings, testCase.namespace, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, ipPools, 1)
+			assert.Equal(t, defaultIPPoolName, ipPools[0].Object.Name)
+		}
+	}
+}
+
+func TestIPPoolNameFromRange(t *testing.T) {
+	assert.Equal(t, "192.168.10.0-24", IPPoolNameFromRange("192.168.10.0/24"))
+	assert.Equal(t, "2001-db8---64", IPPoolNameFromRange("2001:db8::/64"))
+}
+
+func buildValidIPPoolBuilder(apiClient *clients.Settings) *IPPoolBuilder {
+	return NewIPPoolBuilder(apiClient, defaultIPPoolName, defaultIPPoolNamespace, defaultIPPoolRange)
+}
+
+func buildDummyIPPool(name, namespace string, allocations map[string]wbtypes.IPAllocation) *wbtypes.IPPool {
+	return &wbtypes.IPPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: wbtypes.IPPoolSpec{
+			Range:       defaultIPPoolRange,
+			Allocations: allocations,
+		},
+	}
+}
+
+func buildIPPoolTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{ipPoolGVK},
+	})
+}
@@ -0,0 +1,124 @@
+package whereabouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListIPPools returns IPPool inventory in the given namespace.
+func ListIPPools(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*IPPoolBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("IPPool 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list IPPools, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("IPPool 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list IPPools, 'nsname' parameter is empty")
+	}
+
+	passedOptions, err := getListOptions(options, fmt.Sprintf("Listing IPPools in the namespace %s", nsname))
+	if err != nil {
+		return nil, err
+	}
+
+	unsList, err := apiClient.Resource(GetIPPoolGVR()).Namespace(nsname).List(context.TODO(), passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list IPPools in the namespace %s due to %s", nsname, err.Error())
+
+		return nil, err
+	}
+
+	var ipPoolObjects []*IPPoolBuilder
+
+	for index := range unsList.Items {
+		ipPool, err := convertIPPoolToStructured(&unsList.Items[index])
+		if err != nil {
+			return nil, err
+		}
+
+		ipPoolObjects = append(ipPoolObjects, &IPPoolBuilder{
+			apiClient:  apiClient,
+			Object:     ipPool,
+			Definition: ipPool,
+		})
+	}
+
+	return ipPoolObjects, nil
+}
+
+// ListOverlappingRangeIPReservations returns OverlappingRangeIPReservation inventory in the given namespace.
+func ListOverlappingRangeIPReservations(
+	apiClient *clients.Settings,
+	nsname string,
+	options ...metav1.ListOptions) ([]*OverlappingRangeIPReservationBuilder, error) {
+	if apiClient == nil {
+		logging.V(100).Infof("OverlappingRangeIPReservation 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list OverlappingRangeIPReservations, 'apiClient' parameter is empty")
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("OverlappingRangeIPReservation 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list OverlappingRangeIPReservations, 'nsname' parameter is empty")
+	}
+
+	passedOptions, err := getListOptions(
+		options, fmt.Sprintf("Listing OverlappingRangeIPReservations in the namespace %s", nsname))
+	if err != nil {
+		return nil, err
+	}
+
+	unsList, err := apiClient.Resource(GetOverlappingRangeIPReservationGVR()).Namespace(nsname).List(
+		context.TODO(), passedOptions)
+	if err != nil {
+		logging.V(100).Infof("Failed to list OverlappingRangeIPReservations in the namespace %s due to %s",
+			nsname, err.Error())
+
+		return nil, err
+	}
+
+	var reservationObjects []*OverlappingRangeIPReservationBuilder
+
+	for index := range unsList.Items {
+		reservation, err := convertReservationToStructured(&unsList.Items[index])
+		if err != nil {
+			return nil, err
+		}
+
+		reservationObjects = append(reservationObjects, &OverlappingRangeIPReservationBuilder{
+			apiClient:  apiClient,
+			Object:     reservation,
+			Definition: reservation,
+		})
+	}
+
+	return reservationObjects, nil
+}
+
+// getListOptions checks that at most one ListOptions was passed and logs the list message with the used options.
+func getListOptions(options []metav1.ListOptions, logMessage string) (metav1.ListOptions, error) {
+	passedOptions := metav1.ListOptions{}
+
+	if len(options) > 1 {
+		logging.V(100).Infof("'options' parameter must be empty or single-valued")
+
+		return passedOptions, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
+	}
+
+	logging.V(100).Infof(logMessage)
+
+	return passedOptions, nil
+}
@@ -0,0 +1,329 @@
+package whereabouts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/whereabouts/wbtypes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// OverlappingRangeIPReservationBuilder provides struct for the OverlappingRangeIPReservation object containing
+// connection to the cluster and the OverlappingRangeIPReservation definitions.
+type OverlappingRangeIPReservationBuilder struct {
+	// OverlappingRangeIPReservation definition. Used to create the OverlappingRangeIPReservation object.
+	Definition *wbtypes.OverlappingRangeIPReservation
+	// Created OverlappingRangeIPReservation object.
+	Object *wbtypes.OverlappingRangeIPReservation
+	// Used in functions that define or mutate OverlappingRangeIPReservation definition. errorMsg is processed before
+	// the OverlappingRangeIPReservation object is created.
+	errorMsg  error
+	apiClient *clients.Settings
+}
+
+// NewOverlappingRangeIPReservationBuilder creates a new instance of OverlappingRangeIPReservationBuilder reserving
+// ipAddress for the pod referenced as namespace/name in podRef.
+func NewOverlappingRangeIPReservationBuilder(
+	apiClient *clients.Settings, ipAddress, nsname, podRef string) *OverlappingRangeIPReservationBuilder {
+	logging.V(100).Infof(
+		"Initializing new OverlappingRangeIPReservation structure with the following params: "+
+			"ip: %s, namespace: %s, podRef: %s", ipAddress, nsname, podRef)
+
+	builder := OverlappingRangeIPReservationBuilder{
+		apiClient: apiClient,
+		Definition: &wbtypes.OverlappingRangeIPReservation{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       OverlappingRangeIPReservationKind,
+				APIVersion: fmt.Sprintf("%s/%s", APIGroup, APIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ReservationNameFromIP(ipAddress),
+				Namespace: nsname,
+			},
+			Spec: wbtypes.OverlappingRangeIPReservationSpec{
+				PodRef: podRef,
+			},
+		},
+	}
+
+	if net.ParseIP(ipAddress) == nil {
+		logging.V(100).Infof("The ip of the OverlappingRangeIPReservation is invalid: %s", ipAddress)
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("OverlappingRangeIPReservation 'ip' %s is not a valid IP address", ipAddress))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the OverlappingRangeIPReservation is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("OverlappingRangeIPReservation 'namespace' cannot be empty"))
+	}
+
+	if podRef == "" {
+		logging.V(100).Infof("The podRef of the OverlappingRangeIPReservation is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("OverlappingRangeIPReservation 'podRef' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// PullOverlappingRangeIPReservation pulls the existing OverlappingRangeIPReservation of ipAddress from cluster.
+func PullOverlappingRangeIPReservation(
+	apiClient *clients.Settings, ipAddress, nsname string) (*OverlappingRangeIPReservationBuilder, error) {
+	logging.V(100).Infof("Pulling existing OverlappingRangeIPReservation of ip %s under namespace %s from cluster",
+		ipAddress, nsname)
+
+	if apiClient == nil {
+		logging.V(100).Infof("The apiClient is empty")
+
+		return nil, fmt.Errorf("OverlappingRangeIPReservation 'apiClient' cannot be empty")
+	}
+
+	if net.ParseIP(ipAddress) == nil {
+		logging.V(100).Infof("The ip of the OverlappingRangeIPReservation is invalid: %s", ipAddress)
+
+		return nil, fmt.Errorf("OverlappingRangeIPReservation 'ip' %s is not a valid IP address", ipAddress)
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the OverlappingRangeIPReservation is empty")
+
+		return nil, fmt.Errorf("OverlappingRangeIPReservation 'namespace' cannot be empty")
+	}
+
+	builder := OverlappingRangeIPReservationBuilder{
+		apiClient: apiClient,
+		Definition: &wbtypes.OverlappingRangeIPReservation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ReservationNameFromIP(ipAddress),
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("OverlappingRangeIPReservation object %s doesn't exist in namespace %s",
+			builder.Definition.Name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns OverlappingRangeIPReservation object if found.
+func (builder *OverlappingRangeIPReservationBuilder) Get() (*wbtypes.OverlappingRangeIPReservation, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Collecting OverlappingRangeIPReservation object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	unsObject, err := builder.apiClient.Resource(GetOverlappingRangeIPReservationGVR()).
+		Namespace(builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("OverlappingRangeIPReservation object %s doesn't exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, err
+	}
+
+	return convertReservationToStructured(unsObject)
+}
+
+// Exists checks whether the given OverlappingRangeIPReservation exists.
+func (builder *OverlappingRangeIPReservationBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	logging.V(100).Infof("Checking if OverlappingRangeIPReservation %s exists in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var err error
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes an OverlappingRangeIPReservation in the cluster and stores the created object in struct.
+func (builder *OverlappingRangeIPReservationBuilder) Create() (*OverlappingRangeIPReservationBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	logging.V(100).Infof("Creating the OverlappingRangeIPReservation %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	unstructuredReservation, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.Definition)
+	if err != nil {
+		logging.V(100).Infof("Failed to convert structured OverlappingRangeIPReservation to unstructured object")
+
+		return builder, err
+	}
+
+	unsObject, err := builder.apiClient.Resource(GetOverlappingRangeIPReservationGVR()).
+		Namespace(builder.Definition.Namespace).Create(
+		context.TODO(), &unstructured.Unstructured{Object: unstructuredReservation}, metav1.CreateOptions{})
+
+	if err != nil {
+		logging.V(100).Infof("Failed to create OverlappingRangeIPReservation %s due to %s",
+			builder.Definition.Name, err.Error())
+
+		return builder, err
+	}
+
+	builder.Object, err = convertReservationToStructured(unsObject)
+
+	return builder, err
+}
+
+// Delete removes OverlappingRangeIPReservation object from a cluster, releasing a leaked reservation.
+func (builder *OverlappingRangeIPReservationBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Deleting the OverlappingRangeIPReservation object %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Resource(GetOverlappingRangeIPReservationGVR()).
+		Namespace(builder.Definition.Namespace).Delete(context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return fmt.Errorf("can not delete OverlappingRangeIPReservation: %w", err)
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// WaitUntilDeleted waits for the duration of the defined timeout or until the OverlappingRangeIPReservation is
+// removed, meaning the reserved IP was released.
+func (builder *OverlappingRangeIPReservationBuilder) WaitUntilDeleted(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	logging.V(100).Infof("Waiting for the defined period until OverlappingRangeIPReservation %s in namespace %s "+
+		"is deleted", builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.Get()
+			if err == nil {
+				return false, nil
+			}
+
+			if k8serrors.IsNotFound(err) {
+				builder.Object = nil
+
+				return true, nil
+			}
+
+			return false, nil
+		})
+}
+
+// GetOverlappingRangeIPReservationGVR returns OverlappingRangeIPReservation's GroupVersionResource which could be
+// used for Clean function.
+func GetOverlappingRangeIPReservationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: APIGroup, Version: APIVersion, Resource: "overlappingrangeipreservations",
+	}
+}
+
+// ReservationNameFromIP returns the name whereabouts gives to the OverlappingRangeIPReservation of the IP address.
+func ReservationNameFromIP(ipAddress string) string {
+	parsedIP := net.ParseIP(ipAddress)
+	if parsedIP == nil {
+		return ipAddress
+	}
+
+	name := parsedIP.String()
+	if strings.HasSuffix(name, ":") {
+		name += "0"
+	}
+
+	return strings.ReplaceAll(name, ":", "-")
+}
+
+// convertReservationToStructured converts the unstructured object returned by the dynamic client to an
+// OverlappingRangeIPReservation.
+func convertReservationToStructured(
+	unsObject *unstructured.Unstructured) (*wbtypes.OverlappingRangeIPReservation, error) {
+	reservation := &wbtypes.OverlappingRangeIPReservation{}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unsObject.Object, reservation)
+	if err != nil {
+		logging.V(100).Infof(
+			"Failed to convert from unstructured to OverlappingRangeIPReservation object %s in namespace %s",
+			unsObject.GetName(), unsObject.GetNamespace())
+
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *OverlappingRangeIPReservationBuilder) validate() (bool, error) {
+	resourceCRD := "OverlappingRangeIPReservation"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if builder.apiClient == nil {
+		logging.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
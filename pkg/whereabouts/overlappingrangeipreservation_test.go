@@ -0,0 +1,306 @@
+package whereabouts
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/whereabouts/wbtypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	reservationGVK = schema.GroupVersionKind{
+		Group:   APIGroup,
+		Version: APIVersion,
+		Kind:    OverlappingRangeIPReservationKind,
+	}
+	defaultReservationIP     = "192.168.10.1"
+	defaultReservationPodRef = "test/pod-1"
+)
+
+func TestNewOverlappingRangeIPReservationBuilder(t *testing.T) {
+	testCases := []struct {
+		ipAddress     string
+		namespace     string
+		podRef        string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			ipAddress:     defaultReservationIP,
+			namespace:     defaultIPPoolNamespace,
+			podRef:        defaultReservationPodRef,
+			expectedName:  defaultReservationIP,
+			expectedError: "",
+		},
+		{
+			ipAddress:     "2001:db8::",
+			namespace:     defaultIPPoolNamespace,
+			podRef:        defaultReservationPodRef,
+			expectedName:  "2001-db8--0",
+			expectedError: "",
+		},
+		{
+			ipAddress:     "192.168.10",
+			namespace:     defaultIPPoolNamespace,
+			podRef:        defaultReservationPodRef,
+			expectedError: "OverlappingRangeIPReservation 'ip' 192.168.10 is not a valid IP address",
+		},
+		{
+			ipAddress:     defaultReservationIP,
+			namespace:     "",
+			podRef:        defaultReservationPodRef,
+			expectedError: "OverlappingRangeIPReservation 'namespace' cannot be empty",
+		},
+		{
+			ipAddress:     defaultReservationIP,
+			namespace:     defaultIPPoolNamespace,
+			podRef:        "",
+			expectedError: "OverlappingRangeIPReservation 'podRef' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{})
+		testBuilder := NewOverlappingRangeIPReservationBuilder(
+			testSettings, testCase.ipAddress, testCase.namespace, testCase.podRef)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, testCase.expectedName, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.podRef, testBuilder.Definition.Spec.PodRef)
+		}
+	}
+}
+
+func TestPullOverlappingRangeIPReservation(t *testing.T) {
+	testCases := []struct {
+		ipAddress           string
+		namespace           string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			ipAddress:           defaultReservationIP,
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			ipAddress:           "",
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("OverlappingRangeIPReservation 'ip'  is not a valid IP address"),
+		},
+		{
+			ipAddress:           defaultReservationIP,
+			namespace:           "",
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       fmt.Errorf("OverlappingRangeIPReservation 'namespace' cannot be empty"),
+		},
+		{
+			ipAddress:           defaultReservationIP,
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError: fmt.Errorf("OverlappingRangeIPReservation object %s doesn't exist in namespace %s",
+				defaultReservationIP, defaultIPPoolNamespace),
+		},
+		{
+			ipAddress:           defaultReservationIP,
+			namespace:           defaultIPPoolNamespace,
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("OverlappingRangeIPReservation 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyReservation(testCase.ipAddress, testCase.namespace))
+		}
+
+		if testCase.client {
+			testSettings = buildReservationTestClientWithDummyObject(runtimeObjects)
+		}
+
+		testBuilder, err := PullOverlappingRangeIPReservation(testSettings, testCase.ipAddress, testCase.namespace)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.ipAddress, testBuilder.Definition.Name)
+			assert.Equal(t, defaultReservationPodRef, testBuilder.Definition.Spec.PodRef)
+		}
+	}
+}
+
+func TestOverlappingRangeIPReservationCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *OverlappingRangeIPReservationBuilder
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidReservationBuilder(buildReservationTestClientWithDummyObject(nil)),
+			expectedError: "",
+		},
+		{
+			testBuilder: NewOverlappingRangeIPReservationBuilder(
+				buildReservationTestClientWithDummyObject(nil), defaultReservationIP, defaultIPPoolNamespace, ""),
+			expectedError: "OverlappingRangeIPReservation 'podRef' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder, err := testCase.testBuilder.Create()
+		if !testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			continue
+		}
+
+		assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
+		assert.Equal(t, testBuilder.Definition.Spec.PodRef, testBuilder.Object.Spec.PodRef)
+	}
+}
+
+func TestOverlappingRangeIPReservationDelete(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *OverlappingRangeIPReservationBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidReservationBuilder(buildReservationTestClientWithDummyObject(
+				[]runtime.Object{buildDummyReservation(defaultReservationIP, defaultIPPoolNamespace)})),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidReservationBuilder(buildReservationTestClientWithDummyObject(nil)),
+			expectedError: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Delete()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, testCase.testBuilder.Object)
+		}
+	}
+}
+
+func TestOverlappingRangeIPReservationWaitUntilDeleted(t *testing.T) {
+	testCases := []struct {
+		exists        bool
+		expectedError string
+	}{
+		{
+			exists:        false,
+			expectedError: "",
+		},
+		{
+			exists:        true,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyReservation(defaultReservationIP, defaultIPPoolNamespace))
+		}
+
+		testBuilder := buildValidReservationBuilder(buildReservationTestClientWithDummyObject(runtimeObjects))
+
+		err := testBuilder.WaitUntilDeleted(time.Second)
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, err) {
+			assert.Nil(t, testBuilder.Object)
+		}
+	}
+}
+
+func TestListOverlappingRangeIPReservations(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		namespace     string
+		listOptions   []metav1.ListOptions
+		expectedError error
+	}{
+		{
+			client:        true,
+			namespace:     defaultIPPoolNamespace,
+			expectedError: nil,
+		},
+		{
+			client:        true,
+			namespace:     defaultIPPoolNamespace,
+			listOptions:   []metav1.ListOptions{{}, {}},
+			expectedError: fmt.Errorf("error: more than one ListOptions was passed"),
+		},
+		{
+			client:        true,
+			namespace:     "",
+			expectedError: fmt.Errorf("failed to list OverlappingRangeIPReservations, 'nsname' parameter is empty"),
+		},
+		{
+			client:        false,
+			namespace:     defaultIPPoolNamespace,
+			expectedError: fmt.Errorf("failed to list OverlappingRangeIPReservations, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildReservationTestClientWithDummyObject([]runtime.Object{
+				buildDummyReservation(defaultReservationIP, defaultIPPoolNamespace),
+			})
+		}
+
+		reservations, err := ListOverlappingRangeIPReservations(testSettings, testCase.namespace, testCase.listOptions...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, reservations, 1)
+			assert.Equal(t, defaultReservationIP, reservations[0].Object.Name)
+		}
+	}
+}
+
+func buildValidReservationBuilder(apiClient *clients.Settings) *OverlappingRangeIPReservationBuilder {
+	return NewOverlappingRangeIPReservationBuilder(
+		apiClient, defaultReservationIP, defaultIPPoolNamespace, defaultReservationPodRef)
+}
+
+func buildDummyReservation(ipAddress, namespace string) *wbtypes.OverlappingRangeIPReservation {
+	return &wbtypes.OverlappingRangeIPReservation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ReservationNameFromIP(ipAddress),
+			Namespace: namespace,
+		},
+		Spec: wbtypes.OverlappingRangeIPReservationSpec{
+			PodRef: defaultReservationPodRef,
+		},
+	}
+}
+
+func buildReservationTestClientWithDummyObject(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		GVK:            []schema.GroupVersionKind{reservationGVK},
+	})
+}
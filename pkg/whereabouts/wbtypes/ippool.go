@@ -0,0 +1,70 @@
+package wbtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// IPPoolSpec defines the desired state of IPPool.
+type IPPoolSpec struct {
+	// Range is a RFC 4632/4291-style string that represents an IP address and prefix length in CIDR notation.
+	Range string `json:"range"`
+	// Allocations is the set of allocated IPs for the given range. Its indices are a direct mapping to the
+	// IP with the same index/offset for the pool's range.
+	Allocations map[string]IPAllocation `json:"allocations"`
+}
+
+// IPAllocation represents metadata about the pod/container owner of a specific IP.
+type IPAllocation struct {
+	ContainerID string `json:"id"`
+	PodRef      string `json:"podref"`
+	IfName      string `json:"ifname,omitempty"`
+}
+
+// IPPool is the Schema for the ippools API.
+type IPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IPPoolSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPPoolList contains a list of IPPool.
+type IPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPPool `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
+func (pool *IPPool) DeepCopy() *IPPool {
+	if pool == nil {
+		return nil
+	}
+
+	out := new(IPPool)
+	out.TypeMeta = pool.TypeMeta
+	pool.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec.Range = pool.Spec.Range
+
+	if pool.Spec.Allocations != nil {
+		out.Spec.Allocations = make(map[string]IPAllocation, len(pool.Spec.Allocations))
+
+		for offset, allocation := range pool.Spec.Allocations {
+			out.Spec.Allocations[offset] = allocation
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (pool *IPPool) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := pool.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
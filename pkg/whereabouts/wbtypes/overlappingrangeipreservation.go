@@ -0,0 +1,55 @@
+package wbtypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// OverlappingRangeIPReservationSpec defines the desired state of OverlappingRangeIPReservation.
+type OverlappingRangeIPReservationSpec struct {
+	ContainerID string `json:"containerid,omitempty"`
+	PodRef      string `json:"podref"`
+	IfName      string `json:"ifname,omitempty"`
+}
+
+// OverlappingRangeIPReservation is the Schema for the OverlappingRangeIPReservations API. It reserves an IP across
+// every IPPool with an overlapping range, the name of the object is the reserved IP.
+type OverlappingRangeIPReservation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OverlappingRangeIPReservationSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// OverlappingRangeIPReservationList contains a list of OverlappingRangeIPReservation.
+type OverlappingRangeIPReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OverlappingRangeIPReservation `json:"items"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new
+// OverlappingRangeIPReservation.
+func (reservation *OverlappingRangeIPReservation) DeepCopy() *OverlappingRangeIPReservation {
+	if reservation == nil {
+		return nil
+	}
+
+	out := new(OverlappingRangeIPReservation)
+	out.TypeMeta = reservation.TypeMeta
+	reservation.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = reservation.Spec
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (reservation *OverlappingRangeIPReservation) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := reservation.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package ztp
+
+const (
+	// RANAPIGroup represents the ztp api group of the PolicyGenTemplate and SiteConfig inputs.
+	RANAPIGroup = "ran.openshift.io"
+	// RANAPIVersion represents the version of the ztp api.
+	RANAPIVersion = "v1"
+	// PolicyGenTemplateKind represents kind of PolicyGenTemplate object.
+	PolicyGenTemplateKind = "PolicyGenTemplate"
+	// SiteConfigKind represents kind of SiteConfig object.
+	SiteConfigKind = "SiteConfig"
+	// PolicyGeneratorAPIGroup represents the api group of the open cluster management policy generator.
+	PolicyGeneratorAPIGroup = "policy.open-cluster-management.io"
+	// PolicyGeneratorAPIVersion represents the version of the open cluster management policy generator api.
+	PolicyGeneratorAPIVersion = "v1"
+	// PolicyGeneratorKind represents kind of PolicyGenerator object.
+	PolicyGeneratorKind = "PolicyGenerator"
+	// ZTPDeployWaveAnnotation is the annotation ordering the ztp policies applied to a cluster.
+	ZTPDeployWaveAnnotation = "ran.openshift.io/ztp-deploy-wave"
+)
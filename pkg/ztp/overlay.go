@@ -0,0 +1,42 @@
+package ztp
+
+import (
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+)
+
+// validateRemediationAction checks that the remediation action is supported by the policy generators.
+func validateRemediationAction(remediationAction string) error {
+	switch remediationAction {
+	case "inform", "enforce":
+		return nil
+	default:
+		logging.V(100).Infof("The remediationAction %s is not supported", remediationAction)
+
+		return fmt.Errorf("remediationAction %s is not supported, must be inform or enforce", remediationAction)
+	}
+}
+
+// mergeOverlay merges the overlay into the base, recursing into maps present in both, and returns the base. Values
+// of the overlay replace the ones of the base otherwise.
+func mergeOverlay(base, overlay map[string]interface{}) map[string]interface{} {
+	if base == nil {
+		base = make(map[string]interface{}, len(overlay))
+	}
+
+	for key, overlayValue := range overlay {
+		baseMap, baseIsMap := base[key].(map[string]interface{})
+		overlayMap, overlayIsMap := overlayValue.(map[string]interface{})
+
+		if baseIsMap && overlayIsMap {
+			base[key] = mergeOverlay(baseMap, overlayMap)
+
+			continue
+		}
+
+		base[key] = overlayValue
+	}
+
+	return base
+}
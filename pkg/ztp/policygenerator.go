@@ -0,0 +1,204 @@
+package ztp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/ztp/ztptypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyGeneratorBuilder provides struct for the PolicyGenerator object containing the PolicyGenerator definition.
+// The PolicyGenerator is an input of the policy generator kustomize plugin, so the builder renders it rather than
+// creating it on a cluster.
+type PolicyGeneratorBuilder struct {
+	// PolicyGenerator definition. Used to render the PolicyGenerator.
+	Definition *ztptypes.PolicyGenerator
+	// Used in functions that define or mutate PolicyGenerator definition. errorMsg is processed before the
+	// PolicyGenerator is built.
+	errorMsg error
+}
+
+// NewPolicyGeneratorBuilder creates a new instance of PolicyGeneratorBuilder generating policies in policyNamespace
+// placed on the clusters matching placementLabels. At least one manifest must be added before building it.
+func NewPolicyGeneratorBuilder(
+	name, policyNamespace string, placementLabels map[string]string) *PolicyGeneratorBuilder {
+	logging.V(100).Infof(
+		"Initializing new PolicyGenerator structure with the following params: name: %s, policyNamespace: %s",
+		name, policyNamespace)
+
+	builder := PolicyGeneratorBuilder{
+		Definition: &ztptypes.PolicyGenerator{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       PolicyGeneratorKind,
+				APIVersion: fmt.Sprintf("%s/%s", PolicyGeneratorAPIGroup, PolicyGeneratorAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			PlacementBindingDefaults: ztptypes.PlacementBindingDefaults{
+				Name: fmt.Sprintf("%s-placement-binding", name),
+			},
+			PolicyDefaults: ztptypes.PolicyDefaults{
+				Namespace:         policyNamespace,
+				Placement:         ztptypes.PlacementConfig{LabelSelector: placementLabels},
+				RemediationAction: "inform",
+				Severity:          "low",
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the PolicyGenerator is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PolicyGenerator 'name' cannot be empty"))
+	}
+
+	if policyNamespace == "" {
+		logging.V(100).Infof("The policy namespace of the PolicyGenerator is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("PolicyGenerator 'policyNamespace' cannot be empty"))
+	}
+
+	if len(placementLabels) == 0 {
+		logging.V(100).Infof("The placement labels of the PolicyGenerator are empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("PolicyGenerator 'placementLabels' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// WithRemediationAction sets whether the generated policies only report violations, inform, or fix them, enforce.
+func (builder *PolicyGeneratorBuilder) WithRemediationAction(remediationAction string) *PolicyGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting remediationAction %s in PolicyGenerator %s",
+		remediationAction, builder.Definition.Name)
+
+	if err := validateRemediationAction(remediationAction); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	builder.Definition.PolicyDefaults.RemediationAction = remediationAction
+
+	return builder
+}
+
+// WithDeployWave sets the ztp deploy wave annotation of the generated policies, which orders them on the cluster.
+func (builder *PolicyGeneratorBuilder) WithDeployWave(wave uint) *PolicyGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting deploy wave %d in PolicyGenerator %s", wave, builder.Definition.Name)
+
+	if builder.Definition.PolicyDefaults.PolicyAnnotations == nil {
+		builder.Definition.PolicyDefaults.PolicyAnnotations = make(map[string]string)
+	}
+
+	builder.Definition.PolicyDefaults.PolicyAnnotations[ZTPDeployWaveAnnotation] = fmt.Sprintf("%d", wave)
+
+	return builder
+}
+
+// WithManifest wraps the source CR at path into the given policy, the policy being added when it is missing. The
+// patches are merged into the source CR by the generator.
+func (builder *PolicyGeneratorBuilder) WithManifest(
+	policyName, path string, patches ...map[string]interface{}) *PolicyGeneratorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding manifest %s of policy %s to PolicyGenerator %s",
+		path, policyName, builder.Definition.Name)
+
+	if policyName == "" || path == "" {
+		logging.V(100).Infof("The policyName or path of the PolicyGenerator manifest is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("PolicyGenerator manifest 'policyName' and 'path' cannot be empty"))
+
+		return builder
+	}
+
+	policy := builder.getPolicy(policyName)
+	policy.Manifests = append(policy.Manifests, ztptypes.Manifest{Path: path, Patches: patches})
+
+	return builder
+}
+
+// Build returns the PolicyGenerator definition once it is complete enough to generate policies.
+func (builder *PolicyGeneratorBuilder) Build() (*ztptypes.PolicyGenerator, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Building PolicyGenerator %s", builder.Definition.Name)
+
+	if len(builder.Definition.Policies) == 0 {
+		return nil, fmt.Errorf("PolicyGenerator %s must have at least one policy", builder.Definition.Name)
+	}
+
+	return builder.Definition, nil
+}
+
+// ToYAML builds the PolicyGenerator and renders it as a YAML manifest.
+func (builder *PolicyGeneratorBuilder) ToYAML() ([]byte, error) {
+	policyGenerator, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.ToYAML(policyGenerator)
+}
+
+// getPolicy returns a pointer to the policy with the given name, appending it when it is missing.
+func (builder *PolicyGeneratorBuilder) getPolicy(policyName string) *ztptypes.PolicyConfig {
+	for index := range builder.Definition.Policies {
+		if builder.Definition.Policies[index].Name == policyName {
+			return &builder.Definition.Policies[index]
+		}
+	}
+
+	builder.Definition.Policies = append(builder.Definition.Policies, ztptypes.PolicyConfig{Name: policyName})
+
+	return &builder.Definition.Policies[len(builder.Definition.Policies)-1]
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PolicyGeneratorBuilder) validate() (bool, error) {
+	resourceCRD := "PolicyGenerator"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
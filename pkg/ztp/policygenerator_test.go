@@ -0,0 +1,95 @@
+package ztp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	defaultPolicyGeneratorName      = "common"
+	defaultPolicyGeneratorNamespace = "ztp-common"
+	defaultPlacementLabels          = map[string]string{"common": "true"}
+)
+
+func TestNewPolicyGeneratorBuilder(t *testing.T) {
+	testCases := []struct {
+		name            string
+		policyNamespace string
+		placementLabels map[string]string
+		expectedError   string
+	}{
+		{
+			name:            defaultPolicyGeneratorName,
+			policyNamespace: defaultPolicyGeneratorNamespace,
+			placementLabels: defaultPlacementLabels,
+			expectedError:   "",
+		},
+		{
+			name:            "",
+			policyNamespace: defaultPolicyGeneratorNamespace,
+			placementLabels: defaultPlacementLabels,
+			expectedError:   "PolicyGenerator 'name' cannot be empty",
+		},
+		{
+			name:            defaultPolicyGeneratorName,
+			policyNamespace: "",
+			placementLabels: defaultPlacementLabels,
+			expectedError:   "PolicyGenerator 'policyNamespace' cannot be empty",
+		},
+		{
+			name:            defaultPolicyGeneratorName,
+			policyNamespace: defaultPolicyGeneratorNamespace,
+			placementLabels: nil,
+			expectedError:   "PolicyGenerator 'placementLabels' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewPolicyGeneratorBuilder(testCase.name, testCase.policyNamespace, testCase.placementLabels)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, "common-placement-binding", testBuilder.Definition.PlacementBindingDefaults.Name)
+			assert.Equal(t, testCase.placementLabels, testBuilder.Definition.PolicyDefaults.Placement.LabelSelector)
+		}
+	}
+}
+
+func TestPolicyGeneratorWithManifest(t *testing.T) {
+	patch := map[string]interface{}{"spec": map[string]interface{}{"managementState": "Managed"}}
+
+	testBuilder := buildValidPolicyGeneratorBuilder().
+		WithRemediationAction("enforce").
+		WithDeployWave(10).
+		WithManifest("config-policy", "source-crs/StorageLV.yaml").
+		WithManifest("config-policy", "source-crs/ClusterLogOperator.yaml", patch).
+		WithManifest("subscriptions-policy", "source-crs/ClusterLogSubscription.yaml")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "enforce", testBuilder.Definition.PolicyDefaults.RemediationAction)
+	assert.Equal(t, "10", testBuilder.Definition.PolicyDefaults.PolicyAnnotations[ZTPDeployWaveAnnotation])
+	assert.Len(t, testBuilder.Definition.Policies, 2)
+	assert.Len(t, testBuilder.Definition.Policies[0].Manifests, 2)
+	assert.Equal(t, []map[string]interface{}{patch}, testBuilder.Definition.Policies[0].Manifests[1].Patches)
+
+	testBuilder = buildValidPolicyGeneratorBuilder().WithManifest("config-policy", "")
+	assert.EqualError(t, testBuilder.errorMsg, "PolicyGenerator manifest 'policyName' and 'path' cannot be empty")
+}
+
+func TestPolicyGeneratorBuild(t *testing.T) {
+	_, err := buildValidPolicyGeneratorBuilder().Build()
+	assert.Equal(t, fmt.Errorf("PolicyGenerator %s must have at least one policy", defaultPolicyGeneratorName), err)
+
+	rendered, err := buildValidPolicyGeneratorBuilder().
+		WithManifest("config-policy", "source-crs/StorageLV.yaml").
+		ToYAML()
+	assert.Nil(t, err)
+	assert.Contains(t, string(rendered), "kind: PolicyGenerator")
+	assert.Contains(t, string(rendered), "path: source-crs/StorageLV.yaml")
+}
+
+func buildValidPolicyGeneratorBuilder() *PolicyGeneratorBuilder {
+	return NewPolicyGeneratorBuilder(defaultPolicyGeneratorName, defaultPolicyGeneratorNamespace, defaultPlacementLabels)
+}
@@ -0,0 +1,331 @@
+package ztp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/ztp/ztptypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyGenTemplateBuilder provides struct for the PolicyGenTemplate object containing the PolicyGenTemplate
+// definition. The PolicyGenTemplate is an input of the ztp kustomize plugin, so the builder renders it rather than
+// creating it on a cluster.
+type PolicyGenTemplateBuilder struct {
+	// PolicyGenTemplate definition. Used to render the PolicyGenTemplate.
+	Definition *ztptypes.PolicyGenTemplate
+	// Used in functions that define or mutate PolicyGenTemplate definition. errorMsg is processed before the
+	// PolicyGenTemplate is built.
+	errorMsg error
+}
+
+// NewPolicyGenTemplateBuilder creates a new instance of PolicyGenTemplateBuilder. At least one binding rule and one
+// source file must be added before building it.
+func NewPolicyGenTemplateBuilder(name, nsname string) *PolicyGenTemplateBuilder {
+	logging.V(100).Infof(
+		"Initializing new PolicyGenTemplate structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	builder := PolicyGenTemplateBuilder{
+		Definition: &ztptypes.PolicyGenTemplate{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       PolicyGenTemplateKind,
+				APIVersion: fmt.Sprintf("%s/%s", RANAPIGroup, RANAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the PolicyGenTemplate is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PolicyGenTemplate 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the PolicyGenTemplate is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PolicyGenTemplate 'namespace' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// WithBindingRule binds the generated policies to the clusters with the given label.
+func (builder *PolicyGenTemplateBuilder) WithBindingRule(key, value string) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding binding rule %s=%s to PolicyGenTemplate %s", key, value, builder.Definition.Name)
+
+	if key == "" {
+		logging.V(100).Infof("The key of the PolicyGenTemplate binding rule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PolicyGenTemplate binding rule 'key' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.BindingRules == nil {
+		builder.Definition.Spec.BindingRules = make(map[string]string)
+	}
+
+	builder.Definition.Spec.BindingRules[key] = value
+
+	return builder
+}
+
+// WithBindingExcludedRule excludes the clusters with the given label from the generated policies.
+func (builder *PolicyGenTemplateBuilder) WithBindingExcludedRule(key, value string) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding binding excluded rule %s=%s to PolicyGenTemplate %s",
+		key, value, builder.Definition.Name)
+
+	if key == "" {
+		logging.V(100).Infof("The key of the PolicyGenTemplate binding excluded rule is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("PolicyGenTemplate binding excluded rule 'key' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.BindingExcludedRules == nil {
+		builder.Definition.Spec.BindingExcludedRules = make(map[string]string)
+	}
+
+	builder.Definition.Spec.BindingExcludedRules[key] = value
+
+	return builder
+}
+
+// WithMCP sets the machine config pool targeted by the generated MachineConfigs.
+func (builder *PolicyGenTemplateBuilder) WithMCP(mcp string) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting mcp %s in PolicyGenTemplate %s", mcp, builder.Definition.Name)
+
+	if mcp == "" {
+		logging.V(100).Infof("The mcp of the PolicyGenTemplate is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("PolicyGenTemplate 'mcp' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Mcp = mcp
+
+	return builder
+}
+
+// WithRemediationAction sets whether the generated policies only report violations, inform, or fix them, enforce.
+func (builder *PolicyGenTemplateBuilder) WithRemediationAction(remediationAction string) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting remediationAction %s in PolicyGenTemplate %s",
+		remediationAction, builder.Definition.Name)
+
+	if err := validateRemediationAction(remediationAction); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.RemediationAction = remediationAction
+
+	return builder
+}
+
+// WithEvaluationInterval sets how often the generated policies are evaluated when compliant and non compliant.
+func (builder *PolicyGenTemplateBuilder) WithEvaluationInterval(
+	compliant, nonCompliant string) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting evaluationInterval compliant: %s, noncompliant: %s in PolicyGenTemplate %s",
+		compliant, nonCompliant, builder.Definition.Name)
+
+	builder.Definition.Spec.EvaluationInterval = ztptypes.EvaluationInterval{
+		Compliant:    compliant,
+		NonCompliant: nonCompliant,
+	}
+
+	return builder
+}
+
+// WithSourceFile wraps the source CR with the given file name into the given policy. Adding a source file which is
+// already wrapped into the policy is a no-op.
+func (builder *PolicyGenTemplateBuilder) WithSourceFile(fileName, policyName string) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding source file %s of policy %s to PolicyGenTemplate %s",
+		fileName, policyName, builder.Definition.Name)
+
+	builder.getSourceFile(fileName, policyName)
+
+	return builder
+}
+
+// WithSourceCRMetadata overlays the name, namespace, labels and annotations of the given source CR. Empty values
+// keep the ones of the source CR.
+func (builder *PolicyGenTemplateBuilder) WithSourceCRMetadata(
+	fileName, policyName string, metadata ztptypes.SourceFileMetadata) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Overlaying metadata of source file %s of policy %s in PolicyGenTemplate %s",
+		fileName, policyName, builder.Definition.Name)
+
+	sourceFile := builder.getSourceFile(fileName, policyName)
+	if sourceFile == nil {
+		return builder
+	}
+
+	sourceFile.Metadata = metadata
+
+	return builder
+}
+
+// WithSourceCRSpec overlays the spec of the given source CR. Successive overlays of the same source CR are merged,
+// nested maps included.
+func (builder *PolicyGenTemplateBuilder) WithSourceCRSpec(
+	fileName, policyName string, spec map[string]interface{}) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Overlaying spec of source file %s of policy %s in PolicyGenTemplate %s",
+		fileName, policyName, builder.Definition.Name)
+
+	sourceFile := builder.getSourceFile(fileName, policyName)
+	if sourceFile == nil {
+		return builder
+	}
+
+	sourceFile.Spec = mergeOverlay(sourceFile.Spec, spec)
+
+	return builder
+}
+
+// WithSourceCRData overlays the data of the given source CR, usually a ConfigMap. Successive overlays of the same
+// source CR are merged.
+func (builder *PolicyGenTemplateBuilder) WithSourceCRData(
+	fileName, policyName string, data map[string]interface{}) *PolicyGenTemplateBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Overlaying data of source file %s of policy %s in PolicyGenTemplate %s",
+		fileName, policyName, builder.Definition.Name)
+
+	sourceFile := builder.getSourceFile(fileName, policyName)
+	if sourceFile == nil {
+		return builder
+	}
+
+	sourceFile.Data = mergeOverlay(sourceFile.Data, data)
+
+	return builder
+}
+
+// Build returns the PolicyGenTemplate definition once it is complete enough to generate policies.
+func (builder *PolicyGenTemplateBuilder) Build() (*ztptypes.PolicyGenTemplate, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Building PolicyGenTemplate %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(builder.Definition.Spec.BindingRules) == 0 {
+		return nil, fmt.Errorf("PolicyGenTemplate %s must have at least one binding rule", builder.Definition.Name)
+	}
+
+	if len(builder.Definition.Spec.SourceFiles) == 0 {
+		return nil, fmt.Errorf("PolicyGenTemplate %s must have at least one source file", builder.Definition.Name)
+	}
+
+	return builder.Definition, nil
+}
+
+// ToYAML builds the PolicyGenTemplate and renders it as a YAML manifest.
+func (builder *PolicyGenTemplateBuilder) ToYAML() ([]byte, error) {
+	policyGenTemplate, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.ToYAML(policyGenTemplate)
+}
+
+// getSourceFile returns a pointer to the source file with the given file and policy names, appending it when it is
+// missing. It returns nil and sets errorMsg when either name is empty.
+func (builder *PolicyGenTemplateBuilder) getSourceFile(fileName, policyName string) *ztptypes.SourceFile {
+	if fileName == "" || policyName == "" {
+		logging.V(100).Infof("The fileName or policyName of the PolicyGenTemplate source file is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("PolicyGenTemplate source file 'fileName' and 'policyName' cannot be empty"))
+
+		return nil
+	}
+
+	for index := range builder.Definition.Spec.SourceFiles {
+		sourceFile := &builder.Definition.Spec.SourceFiles[index]
+
+		if sourceFile.FileName == fileName && sourceFile.PolicyName == policyName {
+			return sourceFile
+		}
+	}
+
+	builder.Definition.Spec.SourceFiles = append(builder.Definition.Spec.SourceFiles,
+		ztptypes.SourceFile{FileName: fileName, PolicyName: policyName})
+
+	return &builder.Definition.Spec.SourceFiles[len(builder.Definition.Spec.SourceFiles)-1]
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *PolicyGenTemplateBuilder) validate() (bool, error) {
+	resourceCRD := "PolicyGenTemplate"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,141 @@
+package ztp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/ztp/ztptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	defaultPolicyGenTemplateName   = "common"
+	defaultPolicyGenTemplateNsName = "ztp-common"
+)
+
+func TestNewPolicyGenTemplateBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		expectedError string
+	}{
+		{
+			name:          defaultPolicyGenTemplateName,
+			nsname:        defaultPolicyGenTemplateNsName,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultPolicyGenTemplateNsName,
+			expectedError: "PolicyGenTemplate 'name' cannot be empty",
+		},
+		{
+			name:          defaultPolicyGenTemplateName,
+			nsname:        "",
+			expectedError: "PolicyGenTemplate 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewPolicyGenTemplateBuilder(testCase.name, testCase.nsname)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, PolicyGenTemplateKind, testBuilder.Definition.Kind)
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestPolicyGenTemplateWithBindingRules(t *testing.T) {
+	testBuilder := buildValidPolicyGenTemplateBuilder().
+		WithBindingRule("common", "true").
+		WithBindingExcludedRule("du-profile", "none").
+		WithMCP("master").
+		WithRemediationAction("enforce").
+		WithEvaluationInterval("10m", "10s")
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, map[string]string{"common": "true"}, testBuilder.Definition.Spec.BindingRules)
+	assert.Equal(t, map[string]string{"du-profile": "none"}, testBuilder.Definition.Spec.BindingExcludedRules)
+	assert.Equal(t, "master", testBuilder.Definition.Spec.Mcp)
+	assert.Equal(t, "enforce", testBuilder.Definition.Spec.RemediationAction)
+	assert.Equal(t, ztptypes.EvaluationInterval{Compliant: "10m", NonCompliant: "10s"},
+		testBuilder.Definition.Spec.EvaluationInterval)
+
+	testBuilder = buildValidPolicyGenTemplateBuilder().WithBindingRule("", "true")
+	assert.EqualError(t, testBuilder.errorMsg, "PolicyGenTemplate binding rule 'key' cannot be empty")
+
+	testBuilder = buildValidPolicyGenTemplateBuilder().WithRemediationAction("fix")
+	assert.EqualError(t, testBuilder.errorMsg, "remediationAction fix is not supported, must be inform or enforce")
+}
+
+func TestPolicyGenTemplateWithSourceCROverlays(t *testing.T) {
+	testBuilder := buildValidPolicyGenTemplateBuilder().
+		WithSourceFile("ClusterLogForwarder.yaml", "config-policy").
+		WithSourceCRMetadata("ClusterLogForwarder.yaml", "config-policy",
+			ztptypes.SourceFileMetadata{Annotations: map[string]string{"ran.openshift.io/ztp-deploy-wave": "10"}}).
+		WithSourceCRSpec("ClusterLogForwarder.yaml", "config-policy", map[string]interface{}{
+			"outputs": map[string]interface{}{"type": "kafka", "url": "tcp://kafka:9092"},
+		}).
+		WithSourceCRSpec("ClusterLogForwarder.yaml", "config-policy", map[string]interface{}{
+			"outputs": map[string]interface{}{"url": "tcp://kafka:9093"},
+		}).
+		WithSourceCRData("ReduceMonitoringFootprint.yaml", "config-policy", map[string]interface{}{
+			"config.yaml": "alertmanagerMain:\n  enabled: false\n",
+		})
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Len(t, testBuilder.Definition.Spec.SourceFiles, 2)
+	assert.Equal(t, "10",
+		testBuilder.Definition.Spec.SourceFiles[0].Metadata.Annotations["ran.openshift.io/ztp-deploy-wave"])
+	assert.Equal(t, map[string]interface{}{
+		"outputs": map[string]interface{}{"type": "kafka", "url": "tcp://kafka:9093"},
+	}, testBuilder.Definition.Spec.SourceFiles[0].Spec)
+	assert.Equal(t, "ReduceMonitoringFootprint.yaml", testBuilder.Definition.Spec.SourceFiles[1].FileName)
+
+	testBuilder = buildValidPolicyGenTemplateBuilder().WithSourceFile("", "config-policy")
+	assert.EqualError(t, testBuilder.errorMsg,
+		"PolicyGenTemplate source file 'fileName' and 'policyName' cannot be empty")
+}
+
+func TestPolicyGenTemplateBuild(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *PolicyGenTemplateBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidPolicyGenTemplateBuilder().
+				WithBindingRule("common", "true").WithSourceFile("StorageLV.yaml", "config-policy"),
+			expectedError: nil,
+		},
+		{
+			testBuilder: buildValidPolicyGenTemplateBuilder().WithSourceFile("StorageLV.yaml", "config-policy"),
+			expectedError: fmt.Errorf(
+				"PolicyGenTemplate %s must have at least one binding rule", defaultPolicyGenTemplateName),
+		},
+		{
+			testBuilder: buildValidPolicyGenTemplateBuilder().WithBindingRule("common", "true"),
+			expectedError: fmt.Errorf(
+				"PolicyGenTemplate %s must have at least one source file", defaultPolicyGenTemplateName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		policyGenTemplate, err := testCase.testBuilder.Build()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.testBuilder.Definition, policyGenTemplate)
+
+			rendered, err := testCase.testBuilder.ToYAML()
+			assert.Nil(t, err)
+			assert.Contains(t, string(rendered), "kind: PolicyGenTemplate")
+			assert.Contains(t, string(rendered), "fileName: StorageLV.yaml")
+		}
+	}
+}
+
+func buildValidPolicyGenTemplateBuilder() *PolicyGenTemplateBuilder {
+	return NewPolicyGenTemplateBuilder(defaultPolicyGenTemplateName, defaultPolicyGenTemplateNsName)
+}
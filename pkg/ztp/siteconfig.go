@@ -0,0 +1,323 @@
+package ztp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/logging"
+	"github.com/openshift-kni/eco-goinfra/pkg/manifest"
+	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/ztp/ztptypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SiteConfigBuilder provides struct for the SiteConfig object containing the SiteConfig definition. The SiteConfig is
+// an input of the ztp kustomize plugin, so the builder renders it rather than creating it on a cluster.
+type SiteConfigBuilder struct {
+	// SiteConfig definition. Used to render the SiteConfig.
+	Definition *ztptypes.SiteConfig
+	// Used in functions that define or mutate SiteConfig definition. errorMsg is processed before the SiteConfig is
+	// built.
+	errorMsg error
+}
+
+// NewSiteConfigBuilder creates a new instance of SiteConfigBuilder. At least one cluster with one node must be added
+// before building it.
+func NewSiteConfigBuilder(
+	name, nsname, baseDomain, pullSecretName, clusterImageSetName string) *SiteConfigBuilder {
+	logging.V(100).Infof(
+		"Initializing new SiteConfig structure with the following params: name: %s, namespace: %s, "+
+			"baseDomain: %s, pullSecretName: %s, clusterImageSetName: %s",
+		name, nsname, baseDomain, pullSecretName, clusterImageSetName)
+
+	builder := SiteConfigBuilder{
+		Definition: &ztptypes.SiteConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       SiteConfigKind,
+				APIVersion: fmt.Sprintf("%s/%s", RANAPIGroup, RANAPIVersion),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			Spec: ztptypes.SiteConfigSpec{
+				BaseDomain:             baseDomain,
+				PullSecretRef:          ztptypes.ResourceRef{Name: pullSecretName},
+				ClusterImageSetNameRef: clusterImageSetName,
+			},
+		},
+	}
+
+	if name == "" {
+		logging.V(100).Infof("The name of the SiteConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig 'name' cannot be empty"))
+	}
+
+	if nsname == "" {
+		logging.V(100).Infof("The namespace of the SiteConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig 'namespace' cannot be empty"))
+	}
+
+	if baseDomain == "" {
+		logging.V(100).Infof("The baseDomain of the SiteConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig 'baseDomain' cannot be empty"))
+	}
+
+	if pullSecretName == "" {
+		logging.V(100).Infof("The pullSecretName of the SiteConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig 'pullSecretName' cannot be empty"))
+	}
+
+	if clusterImageSetName == "" {
+		logging.V(100).Infof("The clusterImageSetName of the SiteConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("SiteConfig 'clusterImageSetName' cannot be empty"))
+	}
+
+	return &builder
+}
+
+// WithSSHPublicKey sets the ssh public key authorized on the nodes of all the clusters.
+func (builder *SiteConfigBuilder) WithSSHPublicKey(sshPublicKey string) *SiteConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Setting sshPublicKey in SiteConfig %s", builder.Definition.Name)
+
+	if sshPublicKey == "" {
+		logging.V(100).Infof("The sshPublicKey of the SiteConfig is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig 'sshPublicKey' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SSHPublicKey = sshPublicKey
+
+	return builder
+}
+
+// WithCluster adds the given cluster to the SiteConfig. Nodes can be part of the cluster or added afterwards with
+// WithNode.
+func (builder *SiteConfigBuilder) WithCluster(cluster ztptypes.Cluster) *SiteConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding cluster %s to SiteConfig %s", cluster.ClusterName, builder.Definition.Name)
+
+	if cluster.ClusterName == "" {
+		logging.V(100).Infof("The clusterName of the SiteConfig cluster is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig cluster 'clusterName' cannot be empty"))
+
+		return builder
+	}
+
+	for _, existingCluster := range builder.Definition.Spec.Clusters {
+		if existingCluster.ClusterName == cluster.ClusterName {
+			logging.V(100).Infof("The SiteConfig already has cluster %s", cluster.ClusterName)
+
+			builder.errorMsg = errors.Join(builder.errorMsg,
+				fmt.Errorf("SiteConfig already has cluster %s", cluster.ClusterName))
+
+			return builder
+		}
+	}
+
+	for _, node := range cluster.Nodes {
+		if err := validateSiteConfigNode(node); err != nil {
+			builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Clusters = append(builder.Definition.Spec.Clusters, cluster)
+
+	return builder
+}
+
+// WithClusterLabel sets a label of the given cluster. The labels are set on the ManagedCluster and matched by the
+// PolicyGenTemplate binding rules.
+func (builder *SiteConfigBuilder) WithClusterLabel(clusterName, key, value string) *SiteConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding label %s=%s to cluster %s of SiteConfig %s",
+		key, value, clusterName, builder.Definition.Name)
+
+	if key == "" {
+		logging.V(100).Infof("The key of the SiteConfig cluster label is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig cluster label 'key' cannot be empty"))
+
+		return builder
+	}
+
+	cluster := builder.getCluster(clusterName)
+	if cluster == nil {
+		return builder
+	}
+
+	if cluster.ClusterLabels == nil {
+		cluster.ClusterLabels = make(map[string]string)
+	}
+
+	cluster.ClusterLabels[key] = value
+
+	return builder
+}
+
+// WithExtraManifestSearchPath adds a directory the extra manifests of the given cluster are read from.
+func (builder *SiteConfigBuilder) WithExtraManifestSearchPath(clusterName, path string) *SiteConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding extra manifest search path %s to cluster %s of SiteConfig %s",
+		path, clusterName, builder.Definition.Name)
+
+	if path == "" {
+		logging.V(100).Infof("The extra manifest search path of the SiteConfig cluster is empty")
+
+		builder.errorMsg = errors.Join(builder.errorMsg,
+			fmt.Errorf("SiteConfig extra manifest search 'path' cannot be empty"))
+
+		return builder
+	}
+
+	cluster := builder.getCluster(clusterName)
+	if cluster == nil {
+		return builder
+	}
+
+	cluster.ExtraManifests.SearchPaths = append(cluster.ExtraManifests.SearchPaths, path)
+
+	return builder
+}
+
+// WithNode adds the given node to the given cluster.
+func (builder *SiteConfigBuilder) WithNode(clusterName string, node ztptypes.Node) *SiteConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	logging.V(100).Infof("Adding node %s to cluster %s of SiteConfig %s",
+		node.HostName, clusterName, builder.Definition.Name)
+
+	if err := validateSiteConfigNode(node); err != nil {
+		builder.errorMsg = errors.Join(builder.errorMsg, err)
+
+		return builder
+	}
+
+	cluster := builder.getCluster(clusterName)
+	if cluster == nil {
+		return builder
+	}
+
+	cluster.Nodes = append(cluster.Nodes, node)
+
+	return builder
+}
+
+// Build returns the SiteConfig definition once every cluster has at least one node.
+func (builder *SiteConfigBuilder) Build() (*ztptypes.SiteConfig, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	logging.V(100).Infof("Building SiteConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(builder.Definition.Spec.Clusters) == 0 {
+		return nil, fmt.Errorf("SiteConfig %s must have at least one cluster", builder.Definition.Name)
+	}
+
+	for _, cluster := range builder.Definition.Spec.Clusters {
+		if len(cluster.Nodes) == 0 {
+			return nil, fmt.Errorf("cluster %s of SiteConfig %s must have at least one node",
+				cluster.ClusterName, builder.Definition.Name)
+		}
+	}
+
+	return builder.Definition, nil
+}
+
+// ToYAML builds the SiteConfig and renders it as a YAML manifest.
+func (builder *SiteConfigBuilder) ToYAML() ([]byte, error) {
+	siteConfig, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.ToYAML(siteConfig)
+}
+
+// getCluster returns a pointer to the cluster with the given name. It returns nil and sets errorMsg when the cluster
+// does not exist.
+func (builder *SiteConfigBuilder) getCluster(clusterName string) *ztptypes.Cluster {
+	for index := range builder.Definition.Spec.Clusters {
+		if builder.Definition.Spec.Clusters[index].ClusterName == clusterName {
+			return &builder.Definition.Spec.Clusters[index]
+		}
+	}
+
+	logging.V(100).Infof("The SiteConfig has no cluster %s", clusterName)
+
+	builder.errorMsg = errors.Join(builder.errorMsg, fmt.Errorf("SiteConfig has no cluster %s", clusterName))
+
+	return nil
+}
+
+// validateSiteConfigNode checks that the fields the installation cannot do without are set on the node.
+func validateSiteConfigNode(node ztptypes.Node) error {
+	if node.HostName == "" {
+		return fmt.Errorf("SiteConfig node 'hostName' cannot be empty")
+	}
+
+	if node.BmcAddress == "" || node.BmcCredentialsName.Name == "" {
+		return fmt.Errorf("SiteConfig node %s 'bmcAddress' and 'bmcCredentialsName' cannot be empty", node.HostName)
+	}
+
+	if node.BootMACAddress == "" {
+		return fmt.Errorf("SiteConfig node %s 'bootMACAddress' cannot be empty", node.HostName)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *SiteConfigBuilder) validate() (bool, error) {
+	resourceCRD := "SiteConfig"
+
+	if builder == nil {
+		logging.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	err := builder.errorMsg
+
+	if builder.Definition == nil {
+		logging.V(100).Infof("The %s is undefined", resourceCRD)
+
+		err = errors.Join(err, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD)))
+	}
+
+	if err != nil {
+		logging.V(100).Infof("The %s builder has error message: %s", resourceCRD, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
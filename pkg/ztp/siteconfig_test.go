@@ -0,0 +1,169 @@
+package ztp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/testhelper"
+	"github.com/openshift-kni/eco-goinfra/pkg/ztp/ztptypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	defaultSiteConfigName        = "sno"
+	defaultSiteConfigNsName      = "ztp-sites"
+	defaultSiteConfigClusterName = "sno-1"
+	defaultSiteConfigNode        = ztptypes.Node{
+		HostName:           "sno-1.example.com",
+		Role:               "master",
+		BmcAddress:         "redfish-virtualmedia://10.1.1.1/redfish/v1/Systems/1",
+		BmcCredentialsName: ztptypes.ResourceRef{Name: "sno-1-bmc-secret"},
+		BootMACAddress:     "00:00:00:01:20:30",
+	}
+)
+
+func TestNewSiteConfigBuilder(t *testing.T) {
+	testCases := []struct {
+		name                string
+		nsname              string
+		baseDomain          string
+		pullSecretName      string
+		clusterImageSetName string
+		expectedError       string
+	}{
+		{
+			name:                defaultSiteConfigName,
+			nsname:              defaultSiteConfigNsName,
+			baseDomain:          "example.com",
+			pullSecretName:      "assisted-deployment-pull-secret",
+			clusterImageSetName: "openshift-4.16",
+			expectedError:       "",
+		},
+		{
+			name:                "",
+			nsname:              defaultSiteConfigNsName,
+			baseDomain:          "example.com",
+			pullSecretName:      "assisted-deployment-pull-secret",
+			clusterImageSetName: "openshift-4.16",
+			expectedError:       "SiteConfig 'name' cannot be empty",
+		},
+		{
+			name:                defaultSiteConfigName,
+			nsname:              "",
+			baseDomain:          "example.com",
+			pullSecretName:      "assisted-deployment-pull-secret",
+			clusterImageSetName: "openshift-4.16",
+			expectedError:       "SiteConfig 'namespace' cannot be empty",
+		},
+		{
+			name:                defaultSiteConfigName,
+			nsname:              defaultSiteConfigNsName,
+			baseDomain:          "",
+			pullSecretName:      "assisted-deployment-pull-secret",
+			clusterImageSetName: "openshift-4.16",
+			expectedError:       "SiteConfig 'baseDomain' cannot be empty",
+		},
+		{
+			name:                defaultSiteConfigName,
+			nsname:              defaultSiteConfigNsName,
+			baseDomain:          "example.com",
+			pullSecretName:      "",
+			clusterImageSetName: "openshift-4.16",
+			expectedError:       "SiteConfig 'pullSecretName' cannot be empty",
+		},
+		{
+			name:                defaultSiteConfigName,
+			nsname:              defaultSiteConfigNsName,
+			baseDomain:          "example.com",
+			pullSecretName:      "assisted-deployment-pull-secret",
+			clusterImageSetName: "",
+			expectedError:       "SiteConfig 'clusterImageSetName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewSiteConfigBuilder(testCase.name, testCase.nsname,
+			testCase.baseDomain, testCase.pullSecretName, testCase.clusterImageSetName)
+
+		if testhelper.AssertErrorMsg(t, testCase.expectedError, testBuilder.errorMsg) {
+			assert.Equal(t, SiteConfigKind, testBuilder.Definition.Kind)
+			assert.Equal(t, testCase.pullSecretName, testBuilder.Definition.Spec.PullSecretRef.Name)
+		}
+	}
+}
+
+func TestSiteConfigWithCluster(t *testing.T) {
+	testBuilder := buildValidSiteConfigBuilder().
+		WithSSHPublicKey("ssh-rsa AAAA").
+		WithCluster(ztptypes.Cluster{ClusterName: defaultSiteConfigClusterName, NetworkType: "OVNKubernetes"}).
+		WithClusterLabel(defaultSiteConfigClusterName, "du-profile", "latest").
+		WithExtraManifestSearchPath(defaultSiteConfigClusterName, "extra-manifests/").
+		WithNode(defaultSiteConfigClusterName, defaultSiteConfigNode)
+
+	assert.Nil(t, testBuilder.errorMsg)
+	assert.Equal(t, "ssh-rsa AAAA", testBuilder.Definition.Spec.SSHPublicKey)
+	assert.Len(t, testBuilder.Definition.Spec.Clusters, 1)
+
+	cluster := testBuilder.Definition.Spec.Clusters[0]
+	assert.Equal(t, map[string]string{"du-profile": "latest"}, cluster.ClusterLabels)
+	assert.Equal(t, []string{"extra-manifests/"}, cluster.ExtraManifests.SearchPaths)
+	assert.Equal(t, []ztptypes.Node{defaultSiteConfigNode}, cluster.Nodes)
+
+	testBuilder = buildValidSiteConfigBuilder().
+		WithCluster(ztptypes.Cluster{ClusterName: defaultSiteConfigClusterName}).
+		WithCluster(ztptypes.Cluster{ClusterName: defaultSiteConfigClusterName})
+	assert.EqualError(t, testBuilder.errorMsg, "SiteConfig already has cluster sno-1")
+
+	testBuilder = buildValidSiteConfigBuilder().WithClusterLabel("missing", "du-profile", "latest")
+	assert.EqualError(t, testBuilder.errorMsg, "SiteConfig has no cluster missing")
+
+	testBuilder = buildValidSiteConfigBuilder().
+		WithCluster(ztptypes.Cluster{ClusterName: defaultSiteConfigClusterName}).
+		WithNode(defaultSiteConfigClusterName, ztptypes.Node{HostName: "sno-1.example.com"})
+	assert.EqualError(t, testBuilder.errorMsg,
+		"SiteConfig node sno-1.example.com 'bmcAddress' and 'bmcCredentialsName' cannot be empty")
+}
+
+func TestSiteConfigBuild(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *SiteConfigBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidSiteConfigBuilder().WithCluster(ztptypes.Cluster{
+				ClusterName: defaultSiteConfigClusterName,
+				Nodes:       []ztptypes.Node{defaultSiteConfigNode},
+			}),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidSiteConfigBuilder(),
+			expectedError: fmt.Errorf("SiteConfig %s must have at least one cluster", defaultSiteConfigName),
+		},
+		{
+			testBuilder: buildValidSiteConfigBuilder().
+				WithCluster(ztptypes.Cluster{ClusterName: defaultSiteConfigClusterName}),
+			expectedError: fmt.Errorf("cluster %s of SiteConfig %s must have at least one node",
+				defaultSiteConfigClusterName, defaultSiteConfigName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		siteConfig, err := testCase.testBuilder.Build()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.testBuilder.Definition, siteConfig)
+
+			rendered, err := testCase.testBuilder.ToYAML()
+			assert.Nil(t, err)
+			assert.Contains(t, string(rendered), "kind: SiteConfig")
+			assert.Contains(t, string(rendered), "clusterName: sno-1")
+		}
+	}
+}
+
+func buildValidSiteConfigBuilder() *SiteConfigBuilder {
+	return NewSiteConfigBuilder(defaultSiteConfigName, defaultSiteConfigNsName,
+		"example.com", "assisted-deployment-pull-secret", "openshift-4.16")
+}
@@ -0,0 +1,108 @@
+package ztptypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PlacementBindingDefaults defines the placementBinding shared by the generated policies.
+type PlacementBindingDefaults struct {
+	// name of the placementBinding.
+	Name string `json:"name,omitempty"`
+}
+
+// PlacementConfig defines the clusters the generated policies are placed on.
+type PlacementConfig struct {
+	// labelSelector matches the labels of the clusters.
+	LabelSelector map[string]string `json:"labelSelector,omitempty"`
+}
+
+// PolicyDefaults defines the settings shared by the generated policies.
+type PolicyDefaults struct {
+	// namespace of the generated policies.
+	Namespace string `json:"namespace"`
+	// placement of the generated policies.
+	Placement PlacementConfig `json:"placement,omitempty"`
+	// remediationAction of the generated policies.
+	RemediationAction string `json:"remediationAction,omitempty"`
+	// severity of the generated policies.
+	Severity string `json:"severity,omitempty"`
+	// policyAnnotations are added to the generated policies, e.g. the ztp deploy wave.
+	PolicyAnnotations map[string]string `json:"policyAnnotations,omitempty"`
+}
+
+// Manifest is a source CR wrapped into a generated policy.
+type Manifest struct {
+	// path of the source CR relative to the kustomization.
+	Path string `json:"path"`
+	// patches are merged into the source CR.
+	Patches []map[string]interface{} `json:"patches,omitempty"`
+}
+
+// PolicyConfig defines a generated policy.
+type PolicyConfig struct {
+	// name of the policy.
+	Name string `json:"name"`
+	// manifests wrapped into the policy.
+	Manifests []Manifest `json:"manifests"`
+}
+
+// PolicyGenerator is the input of the open cluster management policy generator kustomize plugin. It is committed to
+// git rather than created on the hub.
+type PolicyGenerator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	PlacementBindingDefaults PlacementBindingDefaults `json:"placementBindingDefaults,omitempty"`
+	PolicyDefaults           PolicyDefaults           `json:"policyDefaults"`
+	Policies                 []PolicyConfig           `json:"policies"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyGenerator.
+func (in *PolicyGenerator) DeepCopy() *PolicyGenerator {
+	if in == nil {
+		return nil
+	}
+
+	out := new(PolicyGenerator)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.PlacementBindingDefaults = in.PlacementBindingDefaults
+	out.PolicyDefaults = in.PolicyDefaults
+	out.PolicyDefaults.Placement.LabelSelector = copyStringMap(in.PolicyDefaults.Placement.LabelSelector)
+	out.PolicyDefaults.PolicyAnnotations = copyStringMap(in.PolicyDefaults.PolicyAnnotations)
+
+	if in.Policies != nil {
+		out.Policies = make([]PolicyConfig, len(in.Policies))
+
+		for policyIndex, policy := range in.Policies {
+			out.Policies[policyIndex].Name = policy.Name
+
+			if policy.Manifests == nil {
+				continue
+			}
+
+			out.Policies[policyIndex].Manifests = make([]Manifest, len(policy.Manifests))
+
+			for manifestIndex, manifest := range policy.Manifests {
+				out.Policies[policyIndex].Manifests[manifestIndex].Path = manifest.Path
+
+				for _, patch := range manifest.Patches {
+					out.Policies[policyIndex].Manifests[manifestIndex].Patches = append(
+						out.Policies[policyIndex].Manifests[manifestIndex].Patches, copyJSONMap(patch))
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyGenerator) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
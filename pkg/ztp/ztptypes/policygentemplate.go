@@ -0,0 +1,147 @@
+package ztptypes
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EvaluationInterval defines how often the policies are evaluated depending on their compliance state.
+type EvaluationInterval struct {
+	// compliant is the interval, e.g. 10m, between evaluations of a compliant policy, never stops evaluating.
+	Compliant string `json:"compliant,omitempty"`
+	// noncompliant is the interval between evaluations of a non compliant policy.
+	NonCompliant string `json:"noncompliant,omitempty"`
+}
+
+// SourceFileMetadata overlays the metadata of a source CR.
+type SourceFileMetadata struct {
+	// name of the generated object.
+	Name string `json:"name,omitempty"`
+	// namespace of the generated object.
+	Namespace string `json:"namespace,omitempty"`
+	// labels added to the generated object.
+	Labels map[string]string `json:"labels,omitempty"`
+	// annotations added to the generated object.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// SourceFile references a source CR of the ztp container and the overlays applied to it before it is wrapped into a
+// policy.
+type SourceFile struct {
+	// fileName of the source CR, e.g. ClusterLogForwarder.yaml.
+	FileName string `json:"fileName"`
+	// policyName of the policy the source CR is wrapped into.
+	PolicyName string `json:"policyName,omitempty"`
+	// metadata overlaid on the source CR.
+	Metadata SourceFileMetadata `json:"metadata,omitempty"`
+	// spec overlaid on the spec of the source CR.
+	Spec map[string]interface{} `json:"spec,omitempty"`
+	// data overlaid on the data of the source CR.
+	Data map[string]interface{} `json:"data,omitempty"`
+	// complianceType of the source CR, overriding the one of the PolicyGenTemplate.
+	ComplianceType string `json:"complianceType,omitempty"`
+	// remediationAction of the source CR, overriding the one of the PolicyGenTemplate.
+	RemediationAction string `json:"remediationAction,omitempty"`
+}
+
+// PolicyGenTemplateSpec defines the policies generated from source CRs and the clusters they are bound to.
+type PolicyGenTemplateSpec struct {
+	// bindingRules are the labels of the clusters the generated policies are bound to.
+	BindingRules map[string]string `json:"bindingRules,omitempty"`
+	// bindingExcludedRules are the labels of the clusters excluded from the generated policies.
+	BindingExcludedRules map[string]string `json:"bindingExcludedRules,omitempty"`
+	// mcp is the machine config pool the generated MachineConfigs target.
+	Mcp string `json:"mcp,omitempty"`
+	// remediationAction of the generated policies, inform by default.
+	RemediationAction string `json:"remediationAction,omitempty"`
+	// complianceType of the generated policies, musthave by default.
+	ComplianceType string `json:"complianceType,omitempty"`
+	// evaluationInterval of the generated policies.
+	EvaluationInterval EvaluationInterval `json:"evaluationInterval,omitempty"`
+	// sourceFiles are the source CRs wrapped into the generated policies.
+	SourceFiles []SourceFile `json:"sourceFiles,omitempty"`
+}
+
+// PolicyGenTemplate is the input of the ztp policy generator kustomize plugin. It is committed to git rather than
+// created on the hub.
+type PolicyGenTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PolicyGenTemplateSpec `json:"spec"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyGenTemplate.
+func (in *PolicyGenTemplate) DeepCopy() *PolicyGenTemplate {
+	if in == nil {
+		return nil
+	}
+
+	out := new(PolicyGenTemplate)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Spec.BindingRules = copyStringMap(in.Spec.BindingRules)
+	out.Spec.BindingExcludedRules = copyStringMap(in.Spec.BindingExcludedRules)
+
+	if in.Spec.SourceFiles != nil {
+		out.Spec.SourceFiles = make([]SourceFile, len(in.Spec.SourceFiles))
+
+		for index, sourceFile := range in.Spec.SourceFiles {
+			out.Spec.SourceFiles[index] = sourceFile
+			out.Spec.SourceFiles[index].Metadata.Labels = copyStringMap(sourceFile.Metadata.Labels)
+			out.Spec.SourceFiles[index].Metadata.Annotations = copyStringMap(sourceFile.Metadata.Annotations)
+			out.Spec.SourceFiles[index].Spec = copyJSONMap(sourceFile.Spec)
+			out.Spec.SourceFiles[index].Data = copyJSONMap(sourceFile.Data)
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyGenTemplate) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// copyStringMap returns a copy of the map, nil when the map is nil.
+func copyStringMap(in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+
+	out := make(map[string]string, len(in))
+
+	for key, value := range in {
+		out[key] = value
+	}
+
+	return out
+}
+
+// copyJSONMap returns a deep copy of an overlay. Overlays hold arbitrary values set by callers, such as int, which
+// runtime.DeepCopyJSON does not support, so they are copied through a JSON round trip instead.
+func copyJSONMap(in map[string]interface{}) map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return in
+	}
+
+	out := map[string]interface{}{}
+
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return in
+	}
+
+	return out
+}
@@ -0,0 +1,139 @@
+package ztptypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ResourceRef references a secret or configmap by name.
+type ResourceRef struct {
+	// name of the referenced object.
+	Name string `json:"name"`
+}
+
+// ClusterNetworkEntry is a block of pod ip addresses.
+type ClusterNetworkEntry struct {
+	// cidr of the block.
+	CIDR string `json:"cidr"`
+	// hostPrefix is the prefix length of the block allocated to each node.
+	HostPrefix int32 `json:"hostPrefix,omitempty"`
+}
+
+// MachineNetworkEntry is a block of node ip addresses.
+type MachineNetworkEntry struct {
+	// cidr of the block.
+	CIDR string `json:"cidr"`
+}
+
+// ExtraManifests defines where the extra manifests applied at install time are read from.
+type ExtraManifests struct {
+	// searchPaths are the directories, relative to the kustomization, the extra manifests are read from.
+	SearchPaths []string `json:"searchPaths,omitempty"`
+}
+
+// Node defines a host of a cluster of the SiteConfig.
+type Node struct {
+	// hostName of the node.
+	HostName string `json:"hostName"`
+	// role of the node, master or worker.
+	Role string `json:"role,omitempty"`
+	// bmcAddress is the address of the baseboard management controller of the node.
+	BmcAddress string `json:"bmcAddress"`
+	// bmcCredentialsName references the secret holding the credentials of the baseboard management controller.
+	BmcCredentialsName ResourceRef `json:"bmcCredentialsName"`
+	// bootMACAddress is the mac address of the nic the node boots from.
+	BootMACAddress string `json:"bootMACAddress"`
+	// bootMode of the node, UEFI, UEFISecureBoot or legacy.
+	BootMode string `json:"bootMode,omitempty"`
+	// installerArgs are the arguments passed to coreos-installer, as a JSON list.
+	InstallerArgs string `json:"installerArgs,omitempty"`
+	// ignitionConfigOverride overrides the ignition config of the node, as JSON.
+	IgnitionConfigOverride string `json:"ignitionConfigOverride,omitempty"`
+}
+
+// Cluster defines a cluster of the SiteConfig.
+type Cluster struct {
+	// clusterName of the cluster.
+	ClusterName string `json:"clusterName"`
+	// networkType of the cluster, OVNKubernetes by default.
+	NetworkType string `json:"networkType,omitempty"`
+	// clusterLabels are set on the ManagedCluster and used by the PolicyGenTemplate binding rules.
+	ClusterLabels map[string]string `json:"clusterLabels,omitempty"`
+	// clusterNetwork are the pod networks of the cluster.
+	ClusterNetwork []ClusterNetworkEntry `json:"clusterNetwork,omitempty"`
+	// machineNetwork are the node networks of the cluster.
+	MachineNetwork []MachineNetworkEntry `json:"machineNetwork,omitempty"`
+	// serviceNetwork are the service networks of the cluster.
+	ServiceNetwork []string `json:"serviceNetwork,omitempty"`
+	// apiVIPs are the virtual ips of the api of a multi node cluster.
+	APIVIPs []string `json:"apiVIPs,omitempty"`
+	// ingressVIPs are the virtual ips of the ingress of a multi node cluster.
+	IngressVIPs []string `json:"ingressVIPs,omitempty"`
+	// extraManifests applied at install time.
+	ExtraManifests ExtraManifests `json:"extraManifests,omitempty"`
+	// nodes of the cluster.
+	Nodes []Node `json:"nodes"`
+}
+
+// SiteConfigSpec defines the clusters deployed by the SiteConfig.
+type SiteConfigSpec struct {
+	// baseDomain of the clusters.
+	BaseDomain string `json:"baseDomain"`
+	// pullSecretRef references the pull secret of the clusters.
+	PullSecretRef ResourceRef `json:"pullSecretRef"`
+	// clusterImageSetNameRef is the ClusterImageSet the clusters are installed with.
+	ClusterImageSetNameRef string `json:"clusterImageSetNameRef"`
+	// sshPublicKey authorized on the nodes.
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
+	// clusters deployed by the SiteConfig.
+	Clusters []Cluster `json:"clusters"`
+}
+
+// SiteConfig is the input of the ztp siteconfig kustomize plugin. It is committed to git rather than created on the
+// hub.
+type SiteConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SiteConfigSpec `json:"spec"`
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SiteConfig.
+func (in *SiteConfig) DeepCopy() *SiteConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := new(SiteConfig)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+
+	if in.Spec.Clusters != nil {
+		out.Spec.Clusters = make([]Cluster, len(in.Spec.Clusters))
+
+		for index, cluster := range in.Spec.Clusters {
+			out.Spec.Clusters[index] = cluster
+			out.Spec.Clusters[index].ClusterLabels = copyStringMap(cluster.ClusterLabels)
+			out.Spec.Clusters[index].ClusterNetwork = append([]ClusterNetworkEntry(nil), cluster.ClusterNetwork...)
+			out.Spec.Clusters[index].MachineNetwork = append([]MachineNetworkEntry(nil), cluster.MachineNetwork...)
+			out.Spec.Clusters[index].ServiceNetwork = append([]string(nil), cluster.ServiceNetwork...)
+			out.Spec.Clusters[index].APIVIPs = append([]string(nil), cluster.APIVIPs...)
+			out.Spec.Clusters[index].IngressVIPs = append([]string(nil), cluster.IngressVIPs...)
+			out.Spec.Clusters[index].ExtraManifests.SearchPaths = append(
+				[]string(nil), cluster.ExtraManifests.SearchPaths...)
+			out.Spec.Clusters[index].Nodes = append([]Node(nil), cluster.Nodes...)
+		}
+	}
+
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SiteConfig) DeepCopyObject() runtime.Object { //nolint:ireturn
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}